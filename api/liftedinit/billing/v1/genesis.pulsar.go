@@ -116,508 +116,1729 @@ func (x *_GenesisState_3_list) IsValid() bool {
 	return x.list != nil
 }
 
-var (
-	md_GenesisState                 protoreflect.MessageDescriptor
-	fd_GenesisState_params          protoreflect.FieldDescriptor
-	fd_GenesisState_leases          protoreflect.FieldDescriptor
-	fd_GenesisState_credit_accounts protoreflect.FieldDescriptor
-	fd_GenesisState_lease_sequence  protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
 
-func init() {
-	file_liftedinit_billing_v1_genesis_proto_init()
-	md_GenesisState = File_liftedinit_billing_v1_genesis_proto.Messages().ByName("GenesisState")
-	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
-	fd_GenesisState_leases = md_GenesisState.Fields().ByName("leases")
-	fd_GenesisState_credit_accounts = md_GenesisState.Fields().ByName("credit_accounts")
-	fd_GenesisState_lease_sequence = md_GenesisState.Fields().ByName("lease_sequence")
+type _GenesisState_5_list struct {
+	list *[]*LeaseAmendment
 }
 
-var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-type fastReflection_GenesisState GenesisState
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
 
-func (x *GenesisState) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GenesisState)(x)
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LeaseAmendment)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *GenesisState) slowProtoReflect() protoreflect.Message {
-	mi := &file_liftedinit_billing_v1_genesis_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LeaseAmendment)
+	*x.list = append(*x.list, concreteValue)
 }
 
-var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
-var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(LeaseAmendment)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
 
-type fastReflection_GenesisState_messageType struct{}
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
 
-func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(LeaseAmendment)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
-func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
 }
-func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*LeaseUsage
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
-	return _fastReflection_GenesisState_messageType
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GenesisState) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LeaseUsage)
+	(*x.list)[i] = concreteValue
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
-	return (*GenesisState)(x)
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LeaseUsage)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_GenesisState_params, value) {
-			return
-		}
-	}
-	if len(x.Leases) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.Leases})
-		if !f(fd_GenesisState_leases, value) {
-			return
-		}
-	}
-	if len(x.CreditAccounts) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.CreditAccounts})
-		if !f(fd_GenesisState_credit_accounts, value) {
-			return
-		}
-	}
-	if x.LeaseSequence != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.LeaseSequence)
-		if !f(fd_GenesisState_lease_sequence, value) {
-			return
-		}
-	}
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(LeaseUsage)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "liftedinit.billing.v1.GenesisState.params":
-		return x.Params != nil
-	case "liftedinit.billing.v1.GenesisState.leases":
-		return len(x.Leases) != 0
-	case "liftedinit.billing.v1.GenesisState.credit_accounts":
-		return len(x.CreditAccounts) != 0
-	case "liftedinit.billing.v1.GenesisState.lease_sequence":
-		return x.LeaseSequence != uint64(0)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message liftedinit.billing.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "liftedinit.billing.v1.GenesisState.params":
-		x.Params = nil
-	case "liftedinit.billing.v1.GenesisState.leases":
-		x.Leases = nil
-	case "liftedinit.billing.v1.GenesisState.credit_accounts":
-		x.CreditAccounts = nil
-	case "liftedinit.billing.v1.GenesisState.lease_sequence":
-		x.LeaseSequence = uint64(0)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message liftedinit.billing.v1.GenesisState does not contain field %s", fd.FullName()))
-	}
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(LeaseUsage)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "liftedinit.billing.v1.GenesisState.params":
-		value := x.Params
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "liftedinit.billing.v1.GenesisState.leases":
-		if len(x.Leases) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_2_list{})
-		}
-		listValue := &_GenesisState_2_list{list: &x.Leases}
-		return protoreflect.ValueOfList(listValue)
-	case "liftedinit.billing.v1.GenesisState.credit_accounts":
-		if len(x.CreditAccounts) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_3_list{})
-		}
-		listValue := &_GenesisState_3_list{list: &x.CreditAccounts}
-		return protoreflect.ValueOfList(listValue)
-	case "liftedinit.billing.v1.GenesisState.lease_sequence":
-		value := x.LeaseSequence
-		return protoreflect.ValueOfUint64(value)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message liftedinit.billing.v1.GenesisState does not contain field %s", descriptor.FullName()))
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*Dispute
+}
+
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "liftedinit.billing.v1.GenesisState.params":
-		x.Params = value.Message().Interface().(*Params)
-	case "liftedinit.billing.v1.GenesisState.leases":
-		lv := value.List()
-		clv := lv.(*_GenesisState_2_list)
-		x.Leases = *clv.list
-	case "liftedinit.billing.v1.GenesisState.credit_accounts":
-		lv := value.List()
-		clv := lv.(*_GenesisState_3_list)
-		x.CreditAccounts = *clv.list
-	case "liftedinit.billing.v1.GenesisState.lease_sequence":
-		x.LeaseSequence = value.Uint()
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message liftedinit.billing.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Dispute)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Dispute)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(Dispute)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "liftedinit.billing.v1.GenesisState.params":
-		if x.Params == nil {
-			x.Params = new(Params)
-		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-	case "liftedinit.billing.v1.GenesisState.leases":
-		if x.Leases == nil {
-			x.Leases = []*Lease{}
-		}
-		value := &_GenesisState_2_list{list: &x.Leases}
-		return protoreflect.ValueOfList(value)
-	case "liftedinit.billing.v1.GenesisState.credit_accounts":
-		if x.CreditAccounts == nil {
-			x.CreditAccounts = []*CreditAccount{}
-		}
-		value := &_GenesisState_3_list{list: &x.CreditAccounts}
-		return protoreflect.ValueOfList(value)
-	case "liftedinit.billing.v1.GenesisState.lease_sequence":
-		panic(fmt.Errorf("field lease_sequence of message liftedinit.billing.v1.GenesisState is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message liftedinit.billing.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(Dispute)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]*ProviderPolicy
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "liftedinit.billing.v1.GenesisState.params":
-		m := new(Params)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "liftedinit.billing.v1.GenesisState.leases":
-		list := []*Lease{}
-		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
-	case "liftedinit.billing.v1.GenesisState.credit_accounts":
-		list := []*CreditAccount{}
-		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
-	case "liftedinit.billing.v1.GenesisState.lease_sequence":
-		return protoreflect.ValueOfUint64(uint64(0))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message liftedinit.billing.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProviderPolicy)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProviderPolicy)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(ProviderPolicy)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in liftedinit.billing.v1.GenesisState", d.FullName()))
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(ProviderPolicy)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*ProviderStats
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	panic("unreachable")
+	return len(*x.list)
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProviderStats)
+	(*x.list)[i] = concreteValue
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_GenesisState) IsValid() bool {
-	return x != nil
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProviderStats)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.Params != nil {
-			l = options.Size(x.Params)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.Leases) > 0 {
-			for _, e := range x.Leases {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.CreditAccounts) > 0 {
-			for _, e := range x.CreditAccounts {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.LeaseSequence != 0 {
-			n += 1 + runtime.Sov(uint64(x.LeaseSequence))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(ProviderStats)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if x.LeaseSequence != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.LeaseSequence))
-			i--
-			dAtA[i] = 0x20
-		}
-		if len(x.CreditAccounts) > 0 {
-			for iNdEx := len(x.CreditAccounts) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.CreditAccounts[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1a
-			}
-		}
-		if len(x.Leases) > 0 {
-			for iNdEx := len(x.Leases) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Leases[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(ProviderStats)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]*LeaseSettlement
+}
+
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LeaseSettlement)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LeaseSettlement)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(LeaseSettlement)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(LeaseSettlement)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_13_list)(nil)
+
+type _GenesisState_13_list struct {
+	list *[]*PromoGrant
+}
+
+func (x *_GenesisState_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PromoGrant)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PromoGrant)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_13_list) AppendMutable() protoreflect.Value {
+	v := new(PromoGrant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_13_list) NewElement() protoreflect.Value {
+	v := new(PromoGrant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_15_list)(nil)
+
+type _GenesisState_15_list struct {
+	list *[]*ExchangeRate
+}
+
+func (x *_GenesisState_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExchangeRate)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExchangeRate)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_15_list) AppendMutable() protoreflect.Value {
+	v := new(ExchangeRate)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_15_list) NewElement() protoreflect.Value {
+	v := new(ExchangeRate)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_16_list)(nil)
+
+type _GenesisState_16_list struct {
+	list *[]*CreditDeposit
+}
+
+func (x *_GenesisState_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CreditDeposit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CreditDeposit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_16_list) AppendMutable() protoreflect.Value {
+	v := new(CreditDeposit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_16_list) NewElement() protoreflect.Value {
+	v := new(CreditDeposit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GenesisState                      protoreflect.MessageDescriptor
+	fd_GenesisState_params               protoreflect.FieldDescriptor
+	fd_GenesisState_leases               protoreflect.FieldDescriptor
+	fd_GenesisState_credit_accounts      protoreflect.FieldDescriptor
+	fd_GenesisState_lease_sequence       protoreflect.FieldDescriptor
+	fd_GenesisState_lease_amendments     protoreflect.FieldDescriptor
+	fd_GenesisState_lease_usages         protoreflect.FieldDescriptor
+	fd_GenesisState_disputes             protoreflect.FieldDescriptor
+	fd_GenesisState_provider_policies    protoreflect.FieldDescriptor
+	fd_GenesisState_provider_stats       protoreflect.FieldDescriptor
+	fd_GenesisState_lease_settlements    protoreflect.FieldDescriptor
+	fd_GenesisState_settlement_sequence  protoreflect.FieldDescriptor
+	fd_GenesisState_promo_grants         protoreflect.FieldDescriptor
+	fd_GenesisState_promo_grant_sequence protoreflect.FieldDescriptor
+	fd_GenesisState_exchange_rates       protoreflect.FieldDescriptor
+	fd_GenesisState_credit_deposits      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_liftedinit_billing_v1_genesis_proto_init()
+	md_GenesisState = File_liftedinit_billing_v1_genesis_proto.Messages().ByName("GenesisState")
+	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_leases = md_GenesisState.Fields().ByName("leases")
+	fd_GenesisState_credit_accounts = md_GenesisState.Fields().ByName("credit_accounts")
+	fd_GenesisState_lease_sequence = md_GenesisState.Fields().ByName("lease_sequence")
+	fd_GenesisState_lease_amendments = md_GenesisState.Fields().ByName("lease_amendments")
+	fd_GenesisState_lease_usages = md_GenesisState.Fields().ByName("lease_usages")
+	fd_GenesisState_disputes = md_GenesisState.Fields().ByName("disputes")
+	fd_GenesisState_provider_policies = md_GenesisState.Fields().ByName("provider_policies")
+	fd_GenesisState_provider_stats = md_GenesisState.Fields().ByName("provider_stats")
+	fd_GenesisState_lease_settlements = md_GenesisState.Fields().ByName("lease_settlements")
+	fd_GenesisState_settlement_sequence = md_GenesisState.Fields().ByName("settlement_sequence")
+	fd_GenesisState_promo_grants = md_GenesisState.Fields().ByName("promo_grants")
+	fd_GenesisState_promo_grant_sequence = md_GenesisState.Fields().ByName("promo_grant_sequence")
+	fd_GenesisState_exchange_rates = md_GenesisState.Fields().ByName("exchange_rates")
+	fd_GenesisState_credit_deposits = md_GenesisState.Fields().ByName("credit_deposits")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+
+type fastReflection_GenesisState GenesisState
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisState)(x)
+}
+
+func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+	mi := &file_liftedinit_billing_v1_genesis_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+
+type fastReflection_GenesisState_messageType struct{}
+
+func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisState)(nil)
+}
+func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisState) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
+	return (*GenesisState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_GenesisState_params, value) {
+			return
+		}
+	}
+	if len(x.Leases) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.Leases})
+		if !f(fd_GenesisState_leases, value) {
+			return
+		}
+	}
+	if len(x.CreditAccounts) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.CreditAccounts})
+		if !f(fd_GenesisState_credit_accounts, value) {
+			return
+		}
+	}
+	if x.LeaseSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LeaseSequence)
+		if !f(fd_GenesisState_lease_sequence, value) {
+			return
+		}
+	}
+	if len(x.LeaseAmendments) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.LeaseAmendments})
+		if !f(fd_GenesisState_lease_amendments, value) {
+			return
+		}
+	}
+	if len(x.LeaseUsages) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.LeaseUsages})
+		if !f(fd_GenesisState_lease_usages, value) {
+			return
+		}
+	}
+	if len(x.Disputes) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.Disputes})
+		if !f(fd_GenesisState_disputes, value) {
+			return
+		}
+	}
+	if len(x.ProviderPolicies) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.ProviderPolicies})
+		if !f(fd_GenesisState_provider_policies, value) {
+			return
+		}
+	}
+	if len(x.ProviderStats) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.ProviderStats})
+		if !f(fd_GenesisState_provider_stats, value) {
+			return
+		}
+	}
+	if len(x.LeaseSettlements) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.LeaseSettlements})
+		if !f(fd_GenesisState_lease_settlements, value) {
+			return
+		}
+	}
+	if x.SettlementSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SettlementSequence)
+		if !f(fd_GenesisState_settlement_sequence, value) {
+			return
+		}
+	}
+	if len(x.PromoGrants) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_13_list{list: &x.PromoGrants})
+		if !f(fd_GenesisState_promo_grants, value) {
+			return
+		}
+	}
+	if x.PromoGrantSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PromoGrantSequence)
+		if !f(fd_GenesisState_promo_grant_sequence, value) {
+			return
+		}
+	}
+	if len(x.ExchangeRates) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_15_list{list: &x.ExchangeRates})
+		if !f(fd_GenesisState_exchange_rates, value) {
+			return
+		}
+	}
+	if len(x.CreditDeposits) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_16_list{list: &x.CreditDeposits})
+		if !f(fd_GenesisState_credit_deposits, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.GenesisState.params":
+		return x.Params != nil
+	case "liftedinit.billing.v1.GenesisState.leases":
+		return len(x.Leases) != 0
+	case "liftedinit.billing.v1.GenesisState.credit_accounts":
+		return len(x.CreditAccounts) != 0
+	case "liftedinit.billing.v1.GenesisState.lease_sequence":
+		return x.LeaseSequence != uint64(0)
+	case "liftedinit.billing.v1.GenesisState.lease_amendments":
+		return len(x.LeaseAmendments) != 0
+	case "liftedinit.billing.v1.GenesisState.lease_usages":
+		return len(x.LeaseUsages) != 0
+	case "liftedinit.billing.v1.GenesisState.disputes":
+		return len(x.Disputes) != 0
+	case "liftedinit.billing.v1.GenesisState.provider_policies":
+		return len(x.ProviderPolicies) != 0
+	case "liftedinit.billing.v1.GenesisState.provider_stats":
+		return len(x.ProviderStats) != 0
+	case "liftedinit.billing.v1.GenesisState.lease_settlements":
+		return len(x.LeaseSettlements) != 0
+	case "liftedinit.billing.v1.GenesisState.settlement_sequence":
+		return x.SettlementSequence != uint64(0)
+	case "liftedinit.billing.v1.GenesisState.promo_grants":
+		return len(x.PromoGrants) != 0
+	case "liftedinit.billing.v1.GenesisState.promo_grant_sequence":
+		return x.PromoGrantSequence != uint64(0)
+	case "liftedinit.billing.v1.GenesisState.exchange_rates":
+		return len(x.ExchangeRates) != 0
+	case "liftedinit.billing.v1.GenesisState.credit_deposits":
+		return len(x.CreditDeposits) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.GenesisState.params":
+		x.Params = nil
+	case "liftedinit.billing.v1.GenesisState.leases":
+		x.Leases = nil
+	case "liftedinit.billing.v1.GenesisState.credit_accounts":
+		x.CreditAccounts = nil
+	case "liftedinit.billing.v1.GenesisState.lease_sequence":
+		x.LeaseSequence = uint64(0)
+	case "liftedinit.billing.v1.GenesisState.lease_amendments":
+		x.LeaseAmendments = nil
+	case "liftedinit.billing.v1.GenesisState.lease_usages":
+		x.LeaseUsages = nil
+	case "liftedinit.billing.v1.GenesisState.disputes":
+		x.Disputes = nil
+	case "liftedinit.billing.v1.GenesisState.provider_policies":
+		x.ProviderPolicies = nil
+	case "liftedinit.billing.v1.GenesisState.provider_stats":
+		x.ProviderStats = nil
+	case "liftedinit.billing.v1.GenesisState.lease_settlements":
+		x.LeaseSettlements = nil
+	case "liftedinit.billing.v1.GenesisState.settlement_sequence":
+		x.SettlementSequence = uint64(0)
+	case "liftedinit.billing.v1.GenesisState.promo_grants":
+		x.PromoGrants = nil
+	case "liftedinit.billing.v1.GenesisState.promo_grant_sequence":
+		x.PromoGrantSequence = uint64(0)
+	case "liftedinit.billing.v1.GenesisState.exchange_rates":
+		x.ExchangeRates = nil
+	case "liftedinit.billing.v1.GenesisState.credit_deposits":
+		x.CreditDeposits = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "liftedinit.billing.v1.GenesisState.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "liftedinit.billing.v1.GenesisState.leases":
+		if len(x.Leases) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_2_list{})
+		}
+		listValue := &_GenesisState_2_list{list: &x.Leases}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.GenesisState.credit_accounts":
+		if len(x.CreditAccounts) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_3_list{})
+		}
+		listValue := &_GenesisState_3_list{list: &x.CreditAccounts}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.GenesisState.lease_sequence":
+		value := x.LeaseSequence
+		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.billing.v1.GenesisState.lease_amendments":
+		if len(x.LeaseAmendments) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.LeaseAmendments}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.GenesisState.lease_usages":
+		if len(x.LeaseUsages) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.LeaseUsages}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.GenesisState.disputes":
+		if len(x.Disputes) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.Disputes}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.GenesisState.provider_policies":
+		if len(x.ProviderPolicies) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.ProviderPolicies}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.GenesisState.provider_stats":
+		if len(x.ProviderStats) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.ProviderStats}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.GenesisState.lease_settlements":
+		if len(x.LeaseSettlements) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.LeaseSettlements}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.GenesisState.settlement_sequence":
+		value := x.SettlementSequence
+		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.billing.v1.GenesisState.promo_grants":
+		if len(x.PromoGrants) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_13_list{})
+		}
+		listValue := &_GenesisState_13_list{list: &x.PromoGrants}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.GenesisState.promo_grant_sequence":
+		value := x.PromoGrantSequence
+		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.billing.v1.GenesisState.exchange_rates":
+		if len(x.ExchangeRates) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_15_list{})
+		}
+		listValue := &_GenesisState_15_list{list: &x.ExchangeRates}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.GenesisState.credit_deposits":
+		if len(x.CreditDeposits) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_16_list{})
+		}
+		listValue := &_GenesisState_16_list{list: &x.CreditDeposits}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.GenesisState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.GenesisState.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "liftedinit.billing.v1.GenesisState.leases":
+		lv := value.List()
+		clv := lv.(*_GenesisState_2_list)
+		x.Leases = *clv.list
+	case "liftedinit.billing.v1.GenesisState.credit_accounts":
+		lv := value.List()
+		clv := lv.(*_GenesisState_3_list)
+		x.CreditAccounts = *clv.list
+	case "liftedinit.billing.v1.GenesisState.lease_sequence":
+		x.LeaseSequence = value.Uint()
+	case "liftedinit.billing.v1.GenesisState.lease_amendments":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.LeaseAmendments = *clv.list
+	case "liftedinit.billing.v1.GenesisState.lease_usages":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.LeaseUsages = *clv.list
+	case "liftedinit.billing.v1.GenesisState.disputes":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.Disputes = *clv.list
+	case "liftedinit.billing.v1.GenesisState.provider_policies":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.ProviderPolicies = *clv.list
+	case "liftedinit.billing.v1.GenesisState.provider_stats":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.ProviderStats = *clv.list
+	case "liftedinit.billing.v1.GenesisState.lease_settlements":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.LeaseSettlements = *clv.list
+	case "liftedinit.billing.v1.GenesisState.settlement_sequence":
+		x.SettlementSequence = value.Uint()
+	case "liftedinit.billing.v1.GenesisState.promo_grants":
+		lv := value.List()
+		clv := lv.(*_GenesisState_13_list)
+		x.PromoGrants = *clv.list
+	case "liftedinit.billing.v1.GenesisState.promo_grant_sequence":
+		x.PromoGrantSequence = value.Uint()
+	case "liftedinit.billing.v1.GenesisState.exchange_rates":
+		lv := value.List()
+		clv := lv.(*_GenesisState_15_list)
+		x.ExchangeRates = *clv.list
+	case "liftedinit.billing.v1.GenesisState.credit_deposits":
+		lv := value.List()
+		clv := lv.(*_GenesisState_16_list)
+		x.CreditDeposits = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.GenesisState.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "liftedinit.billing.v1.GenesisState.leases":
+		if x.Leases == nil {
+			x.Leases = []*Lease{}
+		}
+		value := &_GenesisState_2_list{list: &x.Leases}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.GenesisState.credit_accounts":
+		if x.CreditAccounts == nil {
+			x.CreditAccounts = []*CreditAccount{}
+		}
+		value := &_GenesisState_3_list{list: &x.CreditAccounts}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.GenesisState.lease_amendments":
+		if x.LeaseAmendments == nil {
+			x.LeaseAmendments = []*LeaseAmendment{}
+		}
+		value := &_GenesisState_5_list{list: &x.LeaseAmendments}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.GenesisState.lease_usages":
+		if x.LeaseUsages == nil {
+			x.LeaseUsages = []*LeaseUsage{}
+		}
+		value := &_GenesisState_7_list{list: &x.LeaseUsages}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.GenesisState.disputes":
+		if x.Disputes == nil {
+			x.Disputes = []*Dispute{}
+		}
+		value := &_GenesisState_8_list{list: &x.Disputes}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.GenesisState.provider_policies":
+		if x.ProviderPolicies == nil {
+			x.ProviderPolicies = []*ProviderPolicy{}
+		}
+		value := &_GenesisState_9_list{list: &x.ProviderPolicies}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.GenesisState.provider_stats":
+		if x.ProviderStats == nil {
+			x.ProviderStats = []*ProviderStats{}
+		}
+		value := &_GenesisState_10_list{list: &x.ProviderStats}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.GenesisState.lease_settlements":
+		if x.LeaseSettlements == nil {
+			x.LeaseSettlements = []*LeaseSettlement{}
+		}
+		value := &_GenesisState_11_list{list: &x.LeaseSettlements}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.GenesisState.promo_grants":
+		if x.PromoGrants == nil {
+			x.PromoGrants = []*PromoGrant{}
+		}
+		value := &_GenesisState_13_list{list: &x.PromoGrants}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.GenesisState.exchange_rates":
+		if x.ExchangeRates == nil {
+			x.ExchangeRates = []*ExchangeRate{}
+		}
+		value := &_GenesisState_15_list{list: &x.ExchangeRates}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.GenesisState.credit_deposits":
+		if x.CreditDeposits == nil {
+			x.CreditDeposits = []*CreditDeposit{}
+		}
+		value := &_GenesisState_16_list{list: &x.CreditDeposits}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.GenesisState.lease_sequence":
+		panic(fmt.Errorf("field lease_sequence of message liftedinit.billing.v1.GenesisState is not mutable"))
+	case "liftedinit.billing.v1.GenesisState.settlement_sequence":
+		panic(fmt.Errorf("field settlement_sequence of message liftedinit.billing.v1.GenesisState is not mutable"))
+	case "liftedinit.billing.v1.GenesisState.promo_grant_sequence":
+		panic(fmt.Errorf("field promo_grant_sequence of message liftedinit.billing.v1.GenesisState is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.GenesisState.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "liftedinit.billing.v1.GenesisState.leases":
+		list := []*Lease{}
+		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "liftedinit.billing.v1.GenesisState.credit_accounts":
+		list := []*CreditAccount{}
+		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "liftedinit.billing.v1.GenesisState.lease_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.billing.v1.GenesisState.lease_amendments":
+		list := []*LeaseAmendment{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "liftedinit.billing.v1.GenesisState.lease_usages":
+		list := []*LeaseUsage{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "liftedinit.billing.v1.GenesisState.disputes":
+		list := []*Dispute{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "liftedinit.billing.v1.GenesisState.provider_policies":
+		list := []*ProviderPolicy{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "liftedinit.billing.v1.GenesisState.provider_stats":
+		list := []*ProviderStats{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "liftedinit.billing.v1.GenesisState.lease_settlements":
+		list := []*LeaseSettlement{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "liftedinit.billing.v1.GenesisState.settlement_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.billing.v1.GenesisState.promo_grants":
+		list := []*PromoGrant{}
+		return protoreflect.ValueOfList(&_GenesisState_13_list{list: &list})
+	case "liftedinit.billing.v1.GenesisState.promo_grant_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.billing.v1.GenesisState.exchange_rates":
+		list := []*ExchangeRate{}
+		return protoreflect.ValueOfList(&_GenesisState_15_list{list: &list})
+	case "liftedinit.billing.v1.GenesisState.credit_deposits":
+		list := []*CreditDeposit{}
+		return protoreflect.ValueOfList(&_GenesisState_16_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in liftedinit.billing.v1.GenesisState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Leases) > 0 {
+			for _, e := range x.Leases {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.CreditAccounts) > 0 {
+			for _, e := range x.CreditAccounts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.LeaseSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.LeaseSequence))
+		}
+		if len(x.LeaseAmendments) > 0 {
+			for _, e := range x.LeaseAmendments {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.LeaseUsages) > 0 {
+			for _, e := range x.LeaseUsages {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Disputes) > 0 {
+			for _, e := range x.Disputes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ProviderPolicies) > 0 {
+			for _, e := range x.ProviderPolicies {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ProviderStats) > 0 {
+			for _, e := range x.ProviderStats {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.LeaseSettlements) > 0 {
+			for _, e := range x.LeaseSettlements {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.SettlementSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.SettlementSequence))
+		}
+		if len(x.PromoGrants) > 0 {
+			for _, e := range x.PromoGrants {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.PromoGrantSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.PromoGrantSequence))
+		}
+		if len(x.ExchangeRates) > 0 {
+			for _, e := range x.ExchangeRates {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.CreditDeposits) > 0 {
+			for _, e := range x.CreditDeposits {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.CreditDeposits) > 0 {
+			for iNdEx := len(x.CreditDeposits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CreditDeposits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if len(x.ExchangeRates) > 0 {
+			for iNdEx := len(x.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ExchangeRates[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if x.PromoGrantSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PromoGrantSequence))
+			i--
+			dAtA[i] = 0x70
+		}
+		if len(x.PromoGrants) > 0 {
+			for iNdEx := len(x.PromoGrants) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PromoGrants[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if x.SettlementSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SettlementSequence))
+			i--
+			dAtA[i] = 0x60
+		}
+		if len(x.LeaseSettlements) > 0 {
+			for iNdEx := len(x.LeaseSettlements) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LeaseSettlements[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.ProviderStats) > 0 {
+			for iNdEx := len(x.ProviderStats) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ProviderStats[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.ProviderPolicies) > 0 {
+			for iNdEx := len(x.ProviderPolicies) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ProviderPolicies[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.Disputes) > 0 {
+			for iNdEx := len(x.Disputes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Disputes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.LeaseUsages) > 0 {
+			for iNdEx := len(x.LeaseUsages) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LeaseUsages[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.LeaseAmendments) > 0 {
+			for iNdEx := len(x.LeaseAmendments) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LeaseAmendments[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.LeaseSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LeaseSequence))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.CreditAccounts) > 0 {
+			for iNdEx := len(x.CreditAccounts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CreditAccounts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Leases) > 0 {
+			for iNdEx := len(x.Leases) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Leases[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Leases", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Leases = append(x.Leases, &Lease{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Leases[len(x.Leases)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreditAccounts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CreditAccounts = append(x.CreditAccounts, &CreditAccount{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreditAccounts[len(x.CreditAccounts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LeaseSequence", wireType)
+				}
+				x.LeaseSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LeaseSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LeaseAmendments", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LeaseAmendments = append(x.LeaseAmendments, &LeaseAmendment{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LeaseAmendments[len(x.LeaseAmendments)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LeaseUsages", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LeaseUsages = append(x.LeaseUsages, &LeaseUsage{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LeaseUsages[len(x.LeaseUsages)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Disputes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if iNdEx >= l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.Disputes = append(x.Disputes, &Dispute{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Disputes[len(x.Disputes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				iNdEx = postIndex
+			case 9:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProviderPolicies", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -644,16 +1865,48 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
+				x.ProviderPolicies = append(x.ProviderPolicies, &ProviderPolicy{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProviderPolicies[len(x.ProviderPolicies)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProviderStats", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProviderStats = append(x.ProviderStats, &ProviderStats{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProviderStats[len(x.ProviderStats)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 11:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Leases", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LeaseSettlements", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -680,14 +1933,33 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Leases = append(x.Leases, &Lease{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Leases[len(x.Leases)-1]); err != nil {
+				x.LeaseSettlements = append(x.LeaseSettlements, &LeaseSettlement{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LeaseSettlements[len(x.LeaseSettlements)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SettlementSequence", wireType)
+				}
+				x.SettlementSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SettlementSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreditAccounts", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PromoGrants", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -714,16 +1986,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CreditAccounts = append(x.CreditAccounts, &CreditAccount{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreditAccounts[len(x.CreditAccounts)-1]); err != nil {
+				x.PromoGrants = append(x.PromoGrants, &PromoGrant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PromoGrants[len(x.PromoGrants)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 4:
+			case 14:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LeaseSequence", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PromoGrantSequence", wireType)
 				}
-				x.LeaseSequence = 0
+				x.PromoGrantSequence = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -733,11 +2005,79 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.LeaseSequence |= uint64(b&0x7F) << shift
+					x.PromoGrantSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExchangeRates = append(x.ExchangeRates, &ExchangeRate{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExchangeRates[len(x.ExchangeRates)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreditDeposits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CreditDeposits = append(x.CreditDeposits, &CreditDeposit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreditDeposits[len(x.CreditDeposits)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -801,6 +2141,29 @@ type GenesisState struct {
 	// lease_sequence is the sequence counter for deterministic lease UUID generation.
 	// Exported via Sequence.Peek(); the next Sequence.Next() call will return this value.
 	LeaseSequence uint64 `protobuf:"varint,4,opt,name=lease_sequence,json=leaseSequence,proto3" json:"lease_sequence,omitempty"`
+	// lease_amendments is the list of pending lease amendments.
+	LeaseAmendments []*LeaseAmendment `protobuf:"bytes,5,rep,name=lease_amendments,json=leaseAmendments,proto3" json:"lease_amendments,omitempty"`
+	// lease_usages is the metering state of leases with metered items.
+	LeaseUsages []*LeaseUsage `protobuf:"bytes,7,rep,name=lease_usages,json=leaseUsages,proto3" json:"lease_usages,omitempty"`
+	// disputes are the lease disputes, open or settled.
+	Disputes []*Dispute `protobuf:"bytes,8,rep,name=disputes,proto3" json:"disputes,omitempty"`
+	// provider_policies are the lease policies set by providers.
+	ProviderPolicies []*ProviderPolicy `protobuf:"bytes,9,rep,name=provider_policies,json=providerPolicies,proto3" json:"provider_policies,omitempty"`
+	// provider_stats are the lease counters of each provider.
+	ProviderStats []*ProviderStats `protobuf:"bytes,10,rep,name=provider_stats,json=providerStats,proto3" json:"provider_stats,omitempty"`
+	// lease_settlements are the settlement records of the leases in state.
+	LeaseSettlements []*LeaseSettlement `protobuf:"bytes,11,rep,name=lease_settlements,json=leaseSettlements,proto3" json:"lease_settlements,omitempty"`
+	// settlement_sequence is the id the next LeaseSettlement will get.
+	SettlementSequence uint64 `protobuf:"varint,12,opt,name=settlement_sequence,json=settlementSequence,proto3" json:"settlement_sequence,omitempty"`
+	// promo_grants are the unexpired promotional credit grants.
+	PromoGrants []*PromoGrant `protobuf:"bytes,13,rep,name=promo_grants,json=promoGrants,proto3" json:"promo_grants,omitempty"`
+	// promo_grant_sequence is the id the next PromoGrant will get.
+	PromoGrantSequence uint64 `protobuf:"varint,14,opt,name=promo_grant_sequence,json=promoGrantSequence,proto3" json:"promo_grant_sequence,omitempty"`
+	// exchange_rates is the exchange-rate table.
+	ExchangeRates []*ExchangeRate `protobuf:"bytes,15,rep,name=exchange_rates,json=exchangeRates,proto3" json:"exchange_rates,omitempty"`
+	// credit_deposits are the credit deposits still within the withdrawal
+	// cooldown.
+	CreditDeposits []*CreditDeposit `protobuf:"bytes,16,rep,name=credit_deposits,json=creditDeposits,proto3" json:"credit_deposits,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -851,6 +2214,83 @@ func (x *GenesisState) GetLeaseSequence() uint64 {
 	return 0
 }
 
+func (x *GenesisState) GetLeaseAmendments() []*LeaseAmendment {
+	if x != nil {
+		return x.LeaseAmendments
+	}
+	return nil
+}
+
+func (x *GenesisState) GetLeaseUsages() []*LeaseUsage {
+	if x != nil {
+		return x.LeaseUsages
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDisputes() []*Dispute {
+	if x != nil {
+		return x.Disputes
+	}
+	return nil
+}
+
+func (x *GenesisState) GetProviderPolicies() []*ProviderPolicy {
+	if x != nil {
+		return x.ProviderPolicies
+	}
+	return nil
+}
+
+func (x *GenesisState) GetProviderStats() []*ProviderStats {
+	if x != nil {
+		return x.ProviderStats
+	}
+	return nil
+}
+
+func (x *GenesisState) GetLeaseSettlements() []*LeaseSettlement {
+	if x != nil {
+		return x.LeaseSettlements
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSettlementSequence() uint64 {
+	if x != nil {
+		return x.SettlementSequence
+	}
+	return 0
+}
+
+func (x *GenesisState) GetPromoGrants() []*PromoGrant {
+	if x != nil {
+		return x.PromoGrants
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPromoGrantSequence() uint64 {
+	if x != nil {
+		return x.PromoGrantSequence
+	}
+	return 0
+}
+
+func (x *GenesisState) GetExchangeRates() []*ExchangeRate {
+	if x != nil {
+		return x.ExchangeRates
+	}
+	return nil
+}
+
+func (x *GenesisState) GetCreditDeposits() []*CreditDeposit {
+	if x != nil {
+		return x.CreditDeposits
+	}
+	return nil
+}
+
 var File_liftedinit_billing_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_liftedinit_billing_v1_genesis_proto_rawDesc = []byte{
@@ -862,7 +2302,7 @@ var file_liftedinit_billing_v1_genesis_proto_rawDesc = []byte{
 	0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69,
 	0x74, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe0, 0x02, 0x0a, 0x0c, 0x47, 0x65, 0x6e,
+	0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x96, 0x0b, 0x0a, 0x0c, 0x47, 0x65, 0x6e,
 	0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x70, 0x61, 0x72,
 	0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x69, 0x66, 0x74,
 	0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76,
@@ -882,25 +2322,92 @@ var file_liftedinit_billing_v1_genesis_proto_rawDesc = []byte{
 	0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
 	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x6c, 0x65, 0x61, 0x73,
 	0x65, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0d, 0x6c, 0x65, 0x61, 0x73,
-	0x65, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x20, 0x8a, 0xe7, 0xb0, 0x2a, 0x1b,
-	0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2f, 0x47,
-	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0xf0, 0x01, 0x0a, 0x19,
-	0x63, 0x6f, 0x6d, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x62,
-	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
-	0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2d, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2d,
-	0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6c, 0x69, 0x66, 0x74, 0x65,
-	0x64, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31,
-	0x3b, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4c, 0x42, 0x58,
-	0xaa, 0x02, 0x15, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x42, 0x69,
-	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x15, 0x4c, 0x69, 0x66, 0x74, 0x65,
-	0x64, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31,
-	0xe2, 0x02, 0x21, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x42, 0x69,
-	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69,
-	0x74, 0x3a, 0x3a, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x6a, 0x0a, 0x10, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x5f, 0x61, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74,
+	0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x18, 0xc8, 0xde, 0x1f, 0x00,
+	0xea, 0xde, 0x1f, 0x10, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x6d, 0x65, 0x6e, 0x64, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x0f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x6d, 0x65, 0x6e, 0x64,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x5a, 0x0a, 0x0c, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x69,
+	0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x42, 0x14,
+	0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0c, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x75, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x52, 0x0b, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x12, 0x4c, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74,
+	0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x70,
+	0x75, 0x74, 0x65, 0x42, 0x10, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x08, 0x64, 0x69, 0x73,
+	0x70, 0x75, 0x74, 0x65, 0x73, 0x52, 0x08, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x73, 0x12,
+	0x6d, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x69, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x66,
+	0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x42, 0x19, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x10, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x63,
+	0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69,
+	0x6e, 0x69, 0x74, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x16, 0xc8, 0xde,
+	0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x6e, 0x0a, 0x11, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x62, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x19, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x11,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x10, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x48, 0x0a, 0x13, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x17, 0xea, 0xde, 0x1f, 0x13, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x12, 0x73, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x5a, 0x0a,
+	0x0c, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x0d, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74,
+	0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x42, 0x14, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0c,
+	0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x0b, 0x70, 0x72,
+	0x6f, 0x6d, 0x6f, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x4a, 0x0a, 0x14, 0x70, 0x72, 0x6f,
+	0x6d, 0x6f, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x42, 0x18, 0xea, 0xde, 0x1f, 0x14, 0x70, 0x72, 0x6f,
+	0x6d, 0x6f, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x62, 0x0a, 0x0e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x42, 0x16, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0e, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x66, 0x0a, 0x0f, 0x63, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x10, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x42, 0x17, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde,
+	0x1f, 0x0f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x73, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x73, 0x3a, 0x20, 0x8a, 0xe7, 0xb0, 0x2a, 0x1b, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x2f, 0x62,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2f, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x52, 0x16, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x73, 0x42, 0xf0, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64,
+	0x69, 0x6e, 0x69, 0x74, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42,
+	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x2d, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x62, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x4c, 0x42, 0x58, 0xaa, 0x02, 0x15, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69,
+	0x6e, 0x69, 0x74, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x15, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x42, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69,
+	0x6e, 0x69, 0x74, 0x5c, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x4c, 0x69, 0x66,
+	0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x3a, 0x3a, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -917,20 +2424,38 @@ func file_liftedinit_billing_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_liftedinit_billing_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_liftedinit_billing_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),  // 0: liftedinit.billing.v1.GenesisState
-	(*Params)(nil),        // 1: liftedinit.billing.v1.Params
-	(*Lease)(nil),         // 2: liftedinit.billing.v1.Lease
-	(*CreditAccount)(nil), // 3: liftedinit.billing.v1.CreditAccount
+	(*GenesisState)(nil),    // 0: liftedinit.billing.v1.GenesisState
+	(*Params)(nil),          // 1: liftedinit.billing.v1.Params
+	(*Lease)(nil),           // 2: liftedinit.billing.v1.Lease
+	(*CreditAccount)(nil),   // 3: liftedinit.billing.v1.CreditAccount
+	(*LeaseAmendment)(nil),  // 4: liftedinit.billing.v1.LeaseAmendment
+	(*LeaseUsage)(nil),      // 5: liftedinit.billing.v1.LeaseUsage
+	(*Dispute)(nil),         // 6: liftedinit.billing.v1.Dispute
+	(*ProviderPolicy)(nil),  // 7: liftedinit.billing.v1.ProviderPolicy
+	(*ProviderStats)(nil),   // 8: liftedinit.billing.v1.ProviderStats
+	(*LeaseSettlement)(nil), // 9: liftedinit.billing.v1.LeaseSettlement
+	(*PromoGrant)(nil),      // 10: liftedinit.billing.v1.PromoGrant
+	(*ExchangeRate)(nil),    // 11: liftedinit.billing.v1.ExchangeRate
+	(*CreditDeposit)(nil),   // 12: liftedinit.billing.v1.CreditDeposit
 }
 var file_liftedinit_billing_v1_genesis_proto_depIdxs = []int32{
-	1, // 0: liftedinit.billing.v1.GenesisState.params:type_name -> liftedinit.billing.v1.Params
-	2, // 1: liftedinit.billing.v1.GenesisState.leases:type_name -> liftedinit.billing.v1.Lease
-	3, // 2: liftedinit.billing.v1.GenesisState.credit_accounts:type_name -> liftedinit.billing.v1.CreditAccount
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	1,  // 0: liftedinit.billing.v1.GenesisState.params:type_name -> liftedinit.billing.v1.Params
+	2,  // 1: liftedinit.billing.v1.GenesisState.leases:type_name -> liftedinit.billing.v1.Lease
+	3,  // 2: liftedinit.billing.v1.GenesisState.credit_accounts:type_name -> liftedinit.billing.v1.CreditAccount
+	4,  // 3: liftedinit.billing.v1.GenesisState.lease_amendments:type_name -> liftedinit.billing.v1.LeaseAmendment
+	5,  // 4: liftedinit.billing.v1.GenesisState.lease_usages:type_name -> liftedinit.billing.v1.LeaseUsage
+	6,  // 5: liftedinit.billing.v1.GenesisState.disputes:type_name -> liftedinit.billing.v1.Dispute
+	7,  // 6: liftedinit.billing.v1.GenesisState.provider_policies:type_name -> liftedinit.billing.v1.ProviderPolicy
+	8,  // 7: liftedinit.billing.v1.GenesisState.provider_stats:type_name -> liftedinit.billing.v1.ProviderStats
+	9,  // 8: liftedinit.billing.v1.GenesisState.lease_settlements:type_name -> liftedinit.billing.v1.LeaseSettlement
+	10, // 9: liftedinit.billing.v1.GenesisState.promo_grants:type_name -> liftedinit.billing.v1.PromoGrant
+	11, // 10: liftedinit.billing.v1.GenesisState.exchange_rates:type_name -> liftedinit.billing.v1.ExchangeRate
+	12, // 11: liftedinit.billing.v1.GenesisState.credit_deposits:type_name -> liftedinit.billing.v1.CreditDeposit
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_liftedinit_billing_v1_genesis_proto_init() }
@@ -32,6 +32,18 @@ const (
 	Query_LeasesBySKU_FullMethodName          = "/liftedinit.billing.v1.Query/LeasesBySKU"
 	Query_CreditEstimate_FullMethodName       = "/liftedinit.billing.v1.Query/CreditEstimate"
 	Query_LeaseByCustomDomain_FullMethodName  = "/liftedinit.billing.v1.Query/LeaseByCustomDomain"
+	Query_AvailableCredit_FullMethodName      = "/liftedinit.billing.v1.Query/AvailableCredit"
+	Query_LeaseAmendment_FullMethodName       = "/liftedinit.billing.v1.Query/LeaseAmendment"
+	Query_LeaseAmendments_FullMethodName      = "/liftedinit.billing.v1.Query/LeaseAmendments"
+	Query_LeaseUsage_FullMethodName           = "/liftedinit.billing.v1.Query/LeaseUsage"
+	Query_Dispute_FullMethodName              = "/liftedinit.billing.v1.Query/Dispute"
+	Query_Disputes_FullMethodName             = "/liftedinit.billing.v1.Query/Disputes"
+	Query_ProviderPolicy_FullMethodName       = "/liftedinit.billing.v1.Query/ProviderPolicy"
+	Query_ProviderPolicies_FullMethodName     = "/liftedinit.billing.v1.Query/ProviderPolicies"
+	Query_ProviderStats_FullMethodName        = "/liftedinit.billing.v1.Query/ProviderStats"
+	Query_LeaseSettlements_FullMethodName     = "/liftedinit.billing.v1.Query/LeaseSettlements"
+	Query_ExchangeRates_FullMethodName        = "/liftedinit.billing.v1.Query/ExchangeRates"
+	Query_SKUAvailability_FullMethodName      = "/liftedinit.billing.v1.Query/SKUAvailability"
 )
 
 // QueryClient is the client API for Query service.
@@ -65,6 +77,36 @@ type QueryClient interface {
 	// LeaseByCustomDomain returns the active or pending lease that has claimed
 	// the given custom_domain, if any.
 	LeaseByCustomDomain(ctx context.Context, in *QueryLeaseByCustomDomainRequest, opts ...grpc.CallOption) (*QueryLeaseByCustomDomainResponse, error)
+	// AvailableCredit returns the amount of credit a tenant can withdraw via
+	// MsgWithdrawCredit, together with the reserved and unsettled amounts that
+	// are held back and the time at which the withdrawal cooldown ends.
+	AvailableCredit(ctx context.Context, in *QueryAvailableCreditRequest, opts ...grpc.CallOption) (*QueryAvailableCreditResponse, error)
+	// LeaseAmendment returns the pending amendment of a lease.
+	LeaseAmendment(ctx context.Context, in *QueryLeaseAmendmentRequest, opts ...grpc.CallOption) (*QueryLeaseAmendmentResponse, error)
+	// LeaseAmendments returns all pending lease amendments, optionally filtered
+	// by provider.
+	LeaseAmendments(ctx context.Context, in *QueryLeaseAmendmentsRequest, opts ...grpc.CallOption) (*QueryLeaseAmendmentsResponse, error)
+	// LeaseUsage returns the usage counters and spend limit of a metered lease.
+	LeaseUsage(ctx context.Context, in *QueryLeaseUsageRequest, opts ...grpc.CallOption) (*QueryLeaseUsageResponse, error)
+	// Dispute returns the dispute of a lease.
+	Dispute(ctx context.Context, in *QueryDisputeRequest, opts ...grpc.CallOption) (*QueryDisputeResponse, error)
+	// Disputes returns all disputes, optionally filtered by provider and state.
+	Disputes(ctx context.Context, in *QueryDisputesRequest, opts ...grpc.CallOption) (*QueryDisputesResponse, error)
+	// ProviderPolicy returns the lease policy of a provider with the pending
+	// timeout and grace period that apply to its leases.
+	ProviderPolicy(ctx context.Context, in *QueryProviderPolicyRequest, opts ...grpc.CallOption) (*QueryProviderPolicyResponse, error)
+	// ProviderPolicies returns all provider lease policies.
+	ProviderPolicies(ctx context.Context, in *QueryProviderPoliciesRequest, opts ...grpc.CallOption) (*QueryProviderPoliciesResponse, error)
+	// ProviderStats returns the lease counters of a provider together with the
+	// rates and averages derived from them.
+	ProviderStats(ctx context.Context, in *QueryProviderStatsRequest, opts ...grpc.CallOption) (*QueryProviderStatsResponse, error)
+	// LeaseSettlements returns the settlement records of a lease, oldest first.
+	LeaseSettlements(ctx context.Context, in *QueryLeaseSettlementsRequest, opts ...grpc.CallOption) (*QueryLeaseSettlementsResponse, error)
+	// ExchangeRates returns the exchange-rate table and its reference denom.
+	ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error)
+	// SKUAvailability returns how much of a SKU's capacity PENDING and ACTIVE
+	// leases hold and how much is left.
+	SKUAvailability(ctx context.Context, in *QuerySKUAvailabilityRequest, opts ...grpc.CallOption) (*QuerySKUAvailabilityResponse, error)
 }
 
 type queryClient struct {
@@ -192,6 +234,114 @@ func (c *queryClient) LeaseByCustomDomain(ctx context.Context, in *QueryLeaseByC
 	return out, nil
 }
 
+func (c *queryClient) AvailableCredit(ctx context.Context, in *QueryAvailableCreditRequest, opts ...grpc.CallOption) (*QueryAvailableCreditResponse, error) {
+	out := new(QueryAvailableCreditResponse)
+	err := c.cc.Invoke(ctx, Query_AvailableCredit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LeaseAmendment(ctx context.Context, in *QueryLeaseAmendmentRequest, opts ...grpc.CallOption) (*QueryLeaseAmendmentResponse, error) {
+	out := new(QueryLeaseAmendmentResponse)
+	err := c.cc.Invoke(ctx, Query_LeaseAmendment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LeaseAmendments(ctx context.Context, in *QueryLeaseAmendmentsRequest, opts ...grpc.CallOption) (*QueryLeaseAmendmentsResponse, error) {
+	out := new(QueryLeaseAmendmentsResponse)
+	err := c.cc.Invoke(ctx, Query_LeaseAmendments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LeaseUsage(ctx context.Context, in *QueryLeaseUsageRequest, opts ...grpc.CallOption) (*QueryLeaseUsageResponse, error) {
+	out := new(QueryLeaseUsageResponse)
+	err := c.cc.Invoke(ctx, Query_LeaseUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Dispute(ctx context.Context, in *QueryDisputeRequest, opts ...grpc.CallOption) (*QueryDisputeResponse, error) {
+	out := new(QueryDisputeResponse)
+	err := c.cc.Invoke(ctx, Query_Dispute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Disputes(ctx context.Context, in *QueryDisputesRequest, opts ...grpc.CallOption) (*QueryDisputesResponse, error) {
+	out := new(QueryDisputesResponse)
+	err := c.cc.Invoke(ctx, Query_Disputes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProviderPolicy(ctx context.Context, in *QueryProviderPolicyRequest, opts ...grpc.CallOption) (*QueryProviderPolicyResponse, error) {
+	out := new(QueryProviderPolicyResponse)
+	err := c.cc.Invoke(ctx, Query_ProviderPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProviderPolicies(ctx context.Context, in *QueryProviderPoliciesRequest, opts ...grpc.CallOption) (*QueryProviderPoliciesResponse, error) {
+	out := new(QueryProviderPoliciesResponse)
+	err := c.cc.Invoke(ctx, Query_ProviderPolicies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProviderStats(ctx context.Context, in *QueryProviderStatsRequest, opts ...grpc.CallOption) (*QueryProviderStatsResponse, error) {
+	out := new(QueryProviderStatsResponse)
+	err := c.cc.Invoke(ctx, Query_ProviderStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LeaseSettlements(ctx context.Context, in *QueryLeaseSettlementsRequest, opts ...grpc.CallOption) (*QueryLeaseSettlementsResponse, error) {
+	out := new(QueryLeaseSettlementsResponse)
+	err := c.cc.Invoke(ctx, Query_LeaseSettlements_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error) {
+	out := new(QueryExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, Query_ExchangeRates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SKUAvailability(ctx context.Context, in *QuerySKUAvailabilityRequest, opts ...grpc.CallOption) (*QuerySKUAvailabilityResponse, error) {
+	out := new(QuerySKUAvailabilityResponse)
+	err := c.cc.Invoke(ctx, Query_SKUAvailability_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -223,6 +373,36 @@ type QueryServer interface {
 	// LeaseByCustomDomain returns the active or pending lease that has claimed
 	// the given custom_domain, if any.
 	LeaseByCustomDomain(context.Context, *QueryLeaseByCustomDomainRequest) (*QueryLeaseByCustomDomainResponse, error)
+	// AvailableCredit returns the amount of credit a tenant can withdraw via
+	// MsgWithdrawCredit, together with the reserved and unsettled amounts that
+	// are held back and the time at which the withdrawal cooldown ends.
+	AvailableCredit(context.Context, *QueryAvailableCreditRequest) (*QueryAvailableCreditResponse, error)
+	// LeaseAmendment returns the pending amendment of a lease.
+	LeaseAmendment(context.Context, *QueryLeaseAmendmentRequest) (*QueryLeaseAmendmentResponse, error)
+	// LeaseAmendments returns all pending lease amendments, optionally filtered
+	// by provider.
+	LeaseAmendments(context.Context, *QueryLeaseAmendmentsRequest) (*QueryLeaseAmendmentsResponse, error)
+	// LeaseUsage returns the usage counters and spend limit of a metered lease.
+	LeaseUsage(context.Context, *QueryLeaseUsageRequest) (*QueryLeaseUsageResponse, error)
+	// Dispute returns the dispute of a lease.
+	Dispute(context.Context, *QueryDisputeRequest) (*QueryDisputeResponse, error)
+	// Disputes returns all disputes, optionally filtered by provider and state.
+	Disputes(context.Context, *QueryDisputesRequest) (*QueryDisputesResponse, error)
+	// ProviderPolicy returns the lease policy of a provider with the pending
+	// timeout and grace period that apply to its leases.
+	ProviderPolicy(context.Context, *QueryProviderPolicyRequest) (*QueryProviderPolicyResponse, error)
+	// ProviderPolicies returns all provider lease policies.
+	ProviderPolicies(context.Context, *QueryProviderPoliciesRequest) (*QueryProviderPoliciesResponse, error)
+	// ProviderStats returns the lease counters of a provider together with the
+	// rates and averages derived from them.
+	ProviderStats(context.Context, *QueryProviderStatsRequest) (*QueryProviderStatsResponse, error)
+	// LeaseSettlements returns the settlement records of a lease, oldest first.
+	LeaseSettlements(context.Context, *QueryLeaseSettlementsRequest) (*QueryLeaseSettlementsResponse, error)
+	// ExchangeRates returns the exchange-rate table and its reference denom.
+	ExchangeRates(context.Context, *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error)
+	// SKUAvailability returns how much of a SKU's capacity PENDING and ACTIVE
+	// leases hold and how much is left.
+	SKUAvailability(context.Context, *QuerySKUAvailabilityRequest) (*QuerySKUAvailabilityResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -269,6 +449,42 @@ func (UnimplementedQueryServer) CreditEstimate(context.Context, *QueryCreditEsti
 func (UnimplementedQueryServer) LeaseByCustomDomain(context.Context, *QueryLeaseByCustomDomainRequest) (*QueryLeaseByCustomDomainResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaseByCustomDomain not implemented")
 }
+func (UnimplementedQueryServer) AvailableCredit(context.Context, *QueryAvailableCreditRequest) (*QueryAvailableCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AvailableCredit not implemented")
+}
+func (UnimplementedQueryServer) LeaseAmendment(context.Context, *QueryLeaseAmendmentRequest) (*QueryLeaseAmendmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaseAmendment not implemented")
+}
+func (UnimplementedQueryServer) LeaseAmendments(context.Context, *QueryLeaseAmendmentsRequest) (*QueryLeaseAmendmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaseAmendments not implemented")
+}
+func (UnimplementedQueryServer) LeaseUsage(context.Context, *QueryLeaseUsageRequest) (*QueryLeaseUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaseUsage not implemented")
+}
+func (UnimplementedQueryServer) Dispute(context.Context, *QueryDisputeRequest) (*QueryDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Dispute not implemented")
+}
+func (UnimplementedQueryServer) Disputes(context.Context, *QueryDisputesRequest) (*QueryDisputesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Disputes not implemented")
+}
+func (UnimplementedQueryServer) ProviderPolicy(context.Context, *QueryProviderPolicyRequest) (*QueryProviderPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderPolicy not implemented")
+}
+func (UnimplementedQueryServer) ProviderPolicies(context.Context, *QueryProviderPoliciesRequest) (*QueryProviderPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderPolicies not implemented")
+}
+func (UnimplementedQueryServer) ProviderStats(context.Context, *QueryProviderStatsRequest) (*QueryProviderStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderStats not implemented")
+}
+func (UnimplementedQueryServer) LeaseSettlements(context.Context, *QueryLeaseSettlementsRequest) (*QueryLeaseSettlementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaseSettlements not implemented")
+}
+func (UnimplementedQueryServer) ExchangeRates(context.Context, *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRates not implemented")
+}
+func (UnimplementedQueryServer) SKUAvailability(context.Context, *QuerySKUAvailabilityRequest) (*QuerySKUAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SKUAvailability not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -516,6 +732,222 @@ func _Query_LeaseByCustomDomain_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AvailableCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAvailableCreditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AvailableCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AvailableCredit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AvailableCredit(ctx, req.(*QueryAvailableCreditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LeaseAmendment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLeaseAmendmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LeaseAmendment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LeaseAmendment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LeaseAmendment(ctx, req.(*QueryLeaseAmendmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LeaseAmendments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLeaseAmendmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LeaseAmendments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LeaseAmendments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LeaseAmendments(ctx, req.(*QueryLeaseAmendmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LeaseUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLeaseUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LeaseUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LeaseUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LeaseUsage(ctx, req.(*QueryLeaseUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Dispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Dispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Dispute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Dispute(ctx, req.(*QueryDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Disputes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDisputesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Disputes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Disputes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Disputes(ctx, req.(*QueryDisputesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProviderPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderPolicy(ctx, req.(*QueryProviderPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProviderPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderPolicies(ctx, req.(*QueryProviderPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProviderStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderStats(ctx, req.(*QueryProviderStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LeaseSettlements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLeaseSettlementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LeaseSettlements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LeaseSettlements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LeaseSettlements(ctx, req.(*QueryLeaseSettlementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRates(ctx, req.(*QueryExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SKUAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySKUAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SKUAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SKUAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SKUAvailability(ctx, req.(*QuerySKUAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -575,6 +1007,54 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LeaseByCustomDomain",
 			Handler:    _Query_LeaseByCustomDomain_Handler,
 		},
+		{
+			MethodName: "AvailableCredit",
+			Handler:    _Query_AvailableCredit_Handler,
+		},
+		{
+			MethodName: "LeaseAmendment",
+			Handler:    _Query_LeaseAmendment_Handler,
+		},
+		{
+			MethodName: "LeaseAmendments",
+			Handler:    _Query_LeaseAmendments_Handler,
+		},
+		{
+			MethodName: "LeaseUsage",
+			Handler:    _Query_LeaseUsage_Handler,
+		},
+		{
+			MethodName: "Dispute",
+			Handler:    _Query_Dispute_Handler,
+		},
+		{
+			MethodName: "Disputes",
+			Handler:    _Query_Disputes_Handler,
+		},
+		{
+			MethodName: "ProviderPolicy",
+			Handler:    _Query_ProviderPolicy_Handler,
+		},
+		{
+			MethodName: "ProviderPolicies",
+			Handler:    _Query_ProviderPolicies_Handler,
+		},
+		{
+			MethodName: "ProviderStats",
+			Handler:    _Query_ProviderStats_Handler,
+		},
+		{
+			MethodName: "LeaseSettlements",
+			Handler:    _Query_LeaseSettlements_Handler,
+		},
+		{
+			MethodName: "ExchangeRates",
+			Handler:    _Query_ExchangeRates_Handler,
+		},
+		{
+			MethodName: "SKUAvailability",
+			Handler:    _Query_SKUAvailability_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "liftedinit/billing/v1/query.proto",
@@ -19,16 +19,33 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_FundCredit_FullMethodName           = "/liftedinit.billing.v1.Msg/FundCredit"
-	Msg_CreateLease_FullMethodName          = "/liftedinit.billing.v1.Msg/CreateLease"
-	Msg_CreateLeaseForTenant_FullMethodName = "/liftedinit.billing.v1.Msg/CreateLeaseForTenant"
-	Msg_AcknowledgeLease_FullMethodName     = "/liftedinit.billing.v1.Msg/AcknowledgeLease"
-	Msg_RejectLease_FullMethodName          = "/liftedinit.billing.v1.Msg/RejectLease"
-	Msg_CancelLease_FullMethodName          = "/liftedinit.billing.v1.Msg/CancelLease"
-	Msg_CloseLease_FullMethodName           = "/liftedinit.billing.v1.Msg/CloseLease"
-	Msg_Withdraw_FullMethodName             = "/liftedinit.billing.v1.Msg/Withdraw"
-	Msg_UpdateParams_FullMethodName         = "/liftedinit.billing.v1.Msg/UpdateParams"
-	Msg_SetItemCustomDomain_FullMethodName  = "/liftedinit.billing.v1.Msg/SetItemCustomDomain"
+	Msg_FundCredit_FullMethodName                = "/liftedinit.billing.v1.Msg/FundCredit"
+	Msg_CreateLease_FullMethodName               = "/liftedinit.billing.v1.Msg/CreateLease"
+	Msg_CreateLeaseForTenant_FullMethodName      = "/liftedinit.billing.v1.Msg/CreateLeaseForTenant"
+	Msg_AcknowledgeLease_FullMethodName          = "/liftedinit.billing.v1.Msg/AcknowledgeLease"
+	Msg_RejectLease_FullMethodName               = "/liftedinit.billing.v1.Msg/RejectLease"
+	Msg_CancelLease_FullMethodName               = "/liftedinit.billing.v1.Msg/CancelLease"
+	Msg_CloseLease_FullMethodName                = "/liftedinit.billing.v1.Msg/CloseLease"
+	Msg_Withdraw_FullMethodName                  = "/liftedinit.billing.v1.Msg/Withdraw"
+	Msg_UpdateParams_FullMethodName              = "/liftedinit.billing.v1.Msg/UpdateParams"
+	Msg_SetItemCustomDomain_FullMethodName       = "/liftedinit.billing.v1.Msg/SetItemCustomDomain"
+	Msg_WithdrawCredit_FullMethodName            = "/liftedinit.billing.v1.Msg/WithdrawCredit"
+	Msg_UpdateLeaseItems_FullMethodName          = "/liftedinit.billing.v1.Msg/UpdateLeaseItems"
+	Msg_ProposeLeaseAmendment_FullMethodName     = "/liftedinit.billing.v1.Msg/ProposeLeaseAmendment"
+	Msg_AcknowledgeLeaseAmendment_FullMethodName = "/liftedinit.billing.v1.Msg/AcknowledgeLeaseAmendment"
+	Msg_RejectLeaseAmendment_FullMethodName      = "/liftedinit.billing.v1.Msg/RejectLeaseAmendment"
+	Msg_SetLeaseScheduledEnd_FullMethodName      = "/liftedinit.billing.v1.Msg/SetLeaseScheduledEnd"
+	Msg_ReportUsage_FullMethodName               = "/liftedinit.billing.v1.Msg/ReportUsage"
+	Msg_SetUsageSpendLimit_FullMethodName        = "/liftedinit.billing.v1.Msg/SetUsageSpendLimit"
+	Msg_OpenDispute_FullMethodName               = "/liftedinit.billing.v1.Msg/OpenDispute"
+	Msg_RespondToDispute_FullMethodName          = "/liftedinit.billing.v1.Msg/RespondToDispute"
+	Msg_ResolveDispute_FullMethodName            = "/liftedinit.billing.v1.Msg/ResolveDispute"
+	Msg_TransferCredit_FullMethodName            = "/liftedinit.billing.v1.Msg/TransferCredit"
+	Msg_CreateLeases_FullMethodName              = "/liftedinit.billing.v1.Msg/CreateLeases"
+	Msg_CreateLeasesForTenant_FullMethodName     = "/liftedinit.billing.v1.Msg/CreateLeasesForTenant"
+	Msg_SetProviderPolicy_FullMethodName         = "/liftedinit.billing.v1.Msg/SetProviderPolicy"
+	Msg_GrantPromoCredit_FullMethodName          = "/liftedinit.billing.v1.Msg/GrantPromoCredit"
+	Msg_SetExchangeRates_FullMethodName          = "/liftedinit.billing.v1.Msg/SetExchangeRates"
 )
 
 // MsgClient is the client API for Msg service.
@@ -66,6 +83,65 @@ type MsgClient interface {
 	// lease must be in PENDING or ACTIVE state; an empty custom_domain clears
 	// the field and frees the index entry.
 	SetItemCustomDomain(ctx context.Context, in *MsgSetItemCustomDomain, opts ...grpc.CallOption) (*MsgSetItemCustomDomainResponse, error)
+	// WithdrawCredit allows a tenant to withdraw unreserved credit from their
+	// credit account to any address. Only credit that is neither reserved by
+	// PENDING/ACTIVE leases nor owed to providers for unsettled usage can be
+	// withdrawn, and only once params.credit_withdrawal_cooldown has elapsed
+	// since the account was last funded.
+	WithdrawCredit(ctx context.Context, in *MsgWithdrawCredit, opts ...grpc.CallOption) (*MsgWithdrawCreditResponse, error)
+	// UpdateLeaseItems changes the quantities of items on an ACTIVE lease. The
+	// lease is settled up to the current block time first, then the new
+	// quantities apply at each item's original locked_price. Scale-up requires
+	// enough available credit to cover the added reservation; scale-down never
+	// requires provider approval.
+	UpdateLeaseItems(ctx context.Context, in *MsgUpdateLeaseItems, opts ...grpc.CallOption) (*MsgUpdateLeaseItemsResponse, error)
+	// ProposeLeaseAmendment proposes adding and/or removing items on an ACTIVE
+	// lease. Credit for the added items is reserved at current SKU prices until
+	// the provider acknowledges or rejects the amendment, or it expires.
+	ProposeLeaseAmendment(ctx context.Context, in *MsgProposeLeaseAmendment, opts ...grpc.CallOption) (*MsgProposeLeaseAmendmentResponse, error)
+	// AcknowledgeLeaseAmendment allows the provider (or authority) to accept a
+	// pending amendment. The lease is settled and its item list replaced.
+	AcknowledgeLeaseAmendment(ctx context.Context, in *MsgAcknowledgeLeaseAmendment, opts ...grpc.CallOption) (*MsgAcknowledgeLeaseAmendmentResponse, error)
+	// RejectLeaseAmendment allows the provider (or authority) to reject a
+	// pending amendment, releasing its credit reservation.
+	RejectLeaseAmendment(ctx context.Context, in *MsgRejectLeaseAmendment, opts ...grpc.CallOption) (*MsgRejectLeaseAmendmentResponse, error)
+	// SetLeaseScheduledEnd sets or extends the scheduled end time of a PENDING
+	// or ACTIVE lease. Once block time reaches scheduled_end_at, the EndBlocker
+	// settles and closes the lease.
+	SetLeaseScheduledEnd(ctx context.Context, in *MsgSetLeaseScheduledEnd, opts ...grpc.CallOption) (*MsgSetLeaseScheduledEndResponse, error)
+	// ReportUsage allows a provider to report cumulative usage counters for the
+	// metered items of a lease. The increase since the last report is charged
+	// to the tenant's credit, capped by the tenant's spend limit.
+	ReportUsage(ctx context.Context, in *MsgReportUsage, opts ...grpc.CallOption) (*MsgReportUsageResponse, error)
+	// SetUsageSpendLimit allows a tenant to set how much usage may be charged
+	// to a lease per spend period.
+	SetUsageSpendLimit(ctx context.Context, in *MsgSetUsageSpendLimit, opts ...grpc.CallOption) (*MsgSetUsageSpendLimitResponse, error)
+	// OpenDispute allows a tenant to dispute an ACTIVE lease. Until the
+	// dispute is settled, amounts settled for the lease are held in escrow.
+	OpenDispute(ctx context.Context, in *MsgOpenDispute, opts ...grpc.CallOption) (*MsgOpenDisputeResponse, error)
+	// RespondToDispute allows the provider of a disputed lease to answer the
+	// dispute before its response deadline.
+	RespondToDispute(ctx context.Context, in *MsgRespondToDispute, opts ...grpc.CallOption) (*MsgRespondToDisputeResponse, error)
+	// ResolveDispute allows the authority to settle an open dispute by
+	// splitting the escrow between the tenant and the provider.
+	ResolveDispute(ctx context.Context, in *MsgResolveDispute, opts ...grpc.CallOption) (*MsgResolveDisputeResponse, error)
+	// TransferCredit moves unreserved credit from one tenant's credit account
+	// to another's. Signed by the source tenant or by the authority.
+	TransferCredit(ctx context.Context, in *MsgTransferCredit, opts ...grpc.CallOption) (*MsgTransferCreditResponse, error)
+	// CreateLeases creates several leases for the tenant at once, each for its
+	// own provider. Either all leases are created or none are.
+	CreateLeases(ctx context.Context, in *MsgCreateLeases, opts ...grpc.CallOption) (*MsgCreateLeasesResponse, error)
+	// CreateLeasesForTenant is the batch variant of CreateLeaseForTenant.
+	CreateLeasesForTenant(ctx context.Context, in *MsgCreateLeasesForTenant, opts ...grpc.CallOption) (*MsgCreateLeasesForTenantResponse, error)
+	// SetProviderPolicy sets or removes a provider's lease policy, including its
+	// grace period override. Signed by the provider or by the authority.
+	SetProviderPolicy(ctx context.Context, in *MsgSetProviderPolicy, opts ...grpc.CallOption) (*MsgSetProviderPolicyResponse, error)
+	// GrantPromoCredit grants a tenant promotional credit funded by the
+	// authority. Signed by the authority.
+	GrantPromoCredit(ctx context.Context, in *MsgGrantPromoCredit, opts ...grpc.CallOption) (*MsgGrantPromoCreditResponse, error)
+	// SetExchangeRates sets or removes entries of the exchange-rate table.
+	// Signed by a price feeder from params or the authority.
+	SetExchangeRates(ctx context.Context, in *MsgSetExchangeRates, opts ...grpc.CallOption) (*MsgSetExchangeRatesResponse, error)
 }
 
 type msgClient struct {
@@ -166,6 +242,159 @@ func (c *msgClient) SetItemCustomDomain(ctx context.Context, in *MsgSetItemCusto
 	return out, nil
 }
 
+func (c *msgClient) WithdrawCredit(ctx context.Context, in *MsgWithdrawCredit, opts ...grpc.CallOption) (*MsgWithdrawCreditResponse, error) {
+	out := new(MsgWithdrawCreditResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawCredit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateLeaseItems(ctx context.Context, in *MsgUpdateLeaseItems, opts ...grpc.CallOption) (*MsgUpdateLeaseItemsResponse, error) {
+	out := new(MsgUpdateLeaseItemsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateLeaseItems_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposeLeaseAmendment(ctx context.Context, in *MsgProposeLeaseAmendment, opts ...grpc.CallOption) (*MsgProposeLeaseAmendmentResponse, error) {
+	out := new(MsgProposeLeaseAmendmentResponse)
+	err := c.cc.Invoke(ctx, Msg_ProposeLeaseAmendment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcknowledgeLeaseAmendment(ctx context.Context, in *MsgAcknowledgeLeaseAmendment, opts ...grpc.CallOption) (*MsgAcknowledgeLeaseAmendmentResponse, error) {
+	out := new(MsgAcknowledgeLeaseAmendmentResponse)
+	err := c.cc.Invoke(ctx, Msg_AcknowledgeLeaseAmendment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RejectLeaseAmendment(ctx context.Context, in *MsgRejectLeaseAmendment, opts ...grpc.CallOption) (*MsgRejectLeaseAmendmentResponse, error) {
+	out := new(MsgRejectLeaseAmendmentResponse)
+	err := c.cc.Invoke(ctx, Msg_RejectLeaseAmendment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetLeaseScheduledEnd(ctx context.Context, in *MsgSetLeaseScheduledEnd, opts ...grpc.CallOption) (*MsgSetLeaseScheduledEndResponse, error) {
+	out := new(MsgSetLeaseScheduledEndResponse)
+	err := c.cc.Invoke(ctx, Msg_SetLeaseScheduledEnd_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReportUsage(ctx context.Context, in *MsgReportUsage, opts ...grpc.CallOption) (*MsgReportUsageResponse, error) {
+	out := new(MsgReportUsageResponse)
+	err := c.cc.Invoke(ctx, Msg_ReportUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetUsageSpendLimit(ctx context.Context, in *MsgSetUsageSpendLimit, opts ...grpc.CallOption) (*MsgSetUsageSpendLimitResponse, error) {
+	out := new(MsgSetUsageSpendLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_SetUsageSpendLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) OpenDispute(ctx context.Context, in *MsgOpenDispute, opts ...grpc.CallOption) (*MsgOpenDisputeResponse, error) {
+	out := new(MsgOpenDisputeResponse)
+	err := c.cc.Invoke(ctx, Msg_OpenDispute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RespondToDispute(ctx context.Context, in *MsgRespondToDispute, opts ...grpc.CallOption) (*MsgRespondToDisputeResponse, error) {
+	out := new(MsgRespondToDisputeResponse)
+	err := c.cc.Invoke(ctx, Msg_RespondToDispute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResolveDispute(ctx context.Context, in *MsgResolveDispute, opts ...grpc.CallOption) (*MsgResolveDisputeResponse, error) {
+	out := new(MsgResolveDisputeResponse)
+	err := c.cc.Invoke(ctx, Msg_ResolveDispute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferCredit(ctx context.Context, in *MsgTransferCredit, opts ...grpc.CallOption) (*MsgTransferCreditResponse, error) {
+	out := new(MsgTransferCreditResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferCredit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateLeases(ctx context.Context, in *MsgCreateLeases, opts ...grpc.CallOption) (*MsgCreateLeasesResponse, error) {
+	out := new(MsgCreateLeasesResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateLeases_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateLeasesForTenant(ctx context.Context, in *MsgCreateLeasesForTenant, opts ...grpc.CallOption) (*MsgCreateLeasesForTenantResponse, error) {
+	out := new(MsgCreateLeasesForTenantResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateLeasesForTenant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetProviderPolicy(ctx context.Context, in *MsgSetProviderPolicy, opts ...grpc.CallOption) (*MsgSetProviderPolicyResponse, error) {
+	out := new(MsgSetProviderPolicyResponse)
+	err := c.cc.Invoke(ctx, Msg_SetProviderPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantPromoCredit(ctx context.Context, in *MsgGrantPromoCredit, opts ...grpc.CallOption) (*MsgGrantPromoCreditResponse, error) {
+	out := new(MsgGrantPromoCreditResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantPromoCredit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetExchangeRates(ctx context.Context, in *MsgSetExchangeRates, opts ...grpc.CallOption) (*MsgSetExchangeRatesResponse, error) {
+	out := new(MsgSetExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, Msg_SetExchangeRates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -201,6 +430,65 @@ type MsgServer interface {
 	// lease must be in PENDING or ACTIVE state; an empty custom_domain clears
 	// the field and frees the index entry.
 	SetItemCustomDomain(context.Context, *MsgSetItemCustomDomain) (*MsgSetItemCustomDomainResponse, error)
+	// WithdrawCredit allows a tenant to withdraw unreserved credit from their
+	// credit account to any address. Only credit that is neither reserved by
+	// PENDING/ACTIVE leases nor owed to providers for unsettled usage can be
+	// withdrawn, and only once params.credit_withdrawal_cooldown has elapsed
+	// since the account was last funded.
+	WithdrawCredit(context.Context, *MsgWithdrawCredit) (*MsgWithdrawCreditResponse, error)
+	// UpdateLeaseItems changes the quantities of items on an ACTIVE lease. The
+	// lease is settled up to the current block time first, then the new
+	// quantities apply at each item's original locked_price. Scale-up requires
+	// enough available credit to cover the added reservation; scale-down never
+	// requires provider approval.
+	UpdateLeaseItems(context.Context, *MsgUpdateLeaseItems) (*MsgUpdateLeaseItemsResponse, error)
+	// ProposeLeaseAmendment proposes adding and/or removing items on an ACTIVE
+	// lease. Credit for the added items is reserved at current SKU prices until
+	// the provider acknowledges or rejects the amendment, or it expires.
+	ProposeLeaseAmendment(context.Context, *MsgProposeLeaseAmendment) (*MsgProposeLeaseAmendmentResponse, error)
+	// AcknowledgeLeaseAmendment allows the provider (or authority) to accept a
+	// pending amendment. The lease is settled and its item list replaced.
+	AcknowledgeLeaseAmendment(context.Context, *MsgAcknowledgeLeaseAmendment) (*MsgAcknowledgeLeaseAmendmentResponse, error)
+	// RejectLeaseAmendment allows the provider (or authority) to reject a
+	// pending amendment, releasing its credit reservation.
+	RejectLeaseAmendment(context.Context, *MsgRejectLeaseAmendment) (*MsgRejectLeaseAmendmentResponse, error)
+	// SetLeaseScheduledEnd sets or extends the scheduled end time of a PENDING
+	// or ACTIVE lease. Once block time reaches scheduled_end_at, the EndBlocker
+	// settles and closes the lease.
+	SetLeaseScheduledEnd(context.Context, *MsgSetLeaseScheduledEnd) (*MsgSetLeaseScheduledEndResponse, error)
+	// ReportUsage allows a provider to report cumulative usage counters for the
+	// metered items of a lease. The increase since the last report is charged
+	// to the tenant's credit, capped by the tenant's spend limit.
+	ReportUsage(context.Context, *MsgReportUsage) (*MsgReportUsageResponse, error)
+	// SetUsageSpendLimit allows a tenant to set how much usage may be charged
+	// to a lease per spend period.
+	SetUsageSpendLimit(context.Context, *MsgSetUsageSpendLimit) (*MsgSetUsageSpendLimitResponse, error)
+	// OpenDispute allows a tenant to dispute an ACTIVE lease. Until the
+	// dispute is settled, amounts settled for the lease are held in escrow.
+	OpenDispute(context.Context, *MsgOpenDispute) (*MsgOpenDisputeResponse, error)
+	// RespondToDispute allows the provider of a disputed lease to answer the
+	// dispute before its response deadline.
+	RespondToDispute(context.Context, *MsgRespondToDispute) (*MsgRespondToDisputeResponse, error)
+	// ResolveDispute allows the authority to settle an open dispute by
+	// splitting the escrow between the tenant and the provider.
+	ResolveDispute(context.Context, *MsgResolveDispute) (*MsgResolveDisputeResponse, error)
+	// TransferCredit moves unreserved credit from one tenant's credit account
+	// to another's. Signed by the source tenant or by the authority.
+	TransferCredit(context.Context, *MsgTransferCredit) (*MsgTransferCreditResponse, error)
+	// CreateLeases creates several leases for the tenant at once, each for its
+	// own provider. Either all leases are created or none are.
+	CreateLeases(context.Context, *MsgCreateLeases) (*MsgCreateLeasesResponse, error)
+	// CreateLeasesForTenant is the batch variant of CreateLeaseForTenant.
+	CreateLeasesForTenant(context.Context, *MsgCreateLeasesForTenant) (*MsgCreateLeasesForTenantResponse, error)
+	// SetProviderPolicy sets or removes a provider's lease policy, including its
+	// grace period override. Signed by the provider or by the authority.
+	SetProviderPolicy(context.Context, *MsgSetProviderPolicy) (*MsgSetProviderPolicyResponse, error)
+	// GrantPromoCredit grants a tenant promotional credit funded by the
+	// authority. Signed by the authority.
+	GrantPromoCredit(context.Context, *MsgGrantPromoCredit) (*MsgGrantPromoCreditResponse, error)
+	// SetExchangeRates sets or removes entries of the exchange-rate table.
+	// Signed by a price feeder from params or the authority.
+	SetExchangeRates(context.Context, *MsgSetExchangeRates) (*MsgSetExchangeRatesResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -238,6 +526,57 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) SetItemCustomDomain(context.Context, *MsgSetItemCustomDomain) (*MsgSetItemCustomDomainResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetItemCustomDomain not implemented")
 }
+func (UnimplementedMsgServer) WithdrawCredit(context.Context, *MsgWithdrawCredit) (*MsgWithdrawCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCredit not implemented")
+}
+func (UnimplementedMsgServer) UpdateLeaseItems(context.Context, *MsgUpdateLeaseItems) (*MsgUpdateLeaseItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLeaseItems not implemented")
+}
+func (UnimplementedMsgServer) ProposeLeaseAmendment(context.Context, *MsgProposeLeaseAmendment) (*MsgProposeLeaseAmendmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeLeaseAmendment not implemented")
+}
+func (UnimplementedMsgServer) AcknowledgeLeaseAmendment(context.Context, *MsgAcknowledgeLeaseAmendment) (*MsgAcknowledgeLeaseAmendmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeLeaseAmendment not implemented")
+}
+func (UnimplementedMsgServer) RejectLeaseAmendment(context.Context, *MsgRejectLeaseAmendment) (*MsgRejectLeaseAmendmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectLeaseAmendment not implemented")
+}
+func (UnimplementedMsgServer) SetLeaseScheduledEnd(context.Context, *MsgSetLeaseScheduledEnd) (*MsgSetLeaseScheduledEndResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLeaseScheduledEnd not implemented")
+}
+func (UnimplementedMsgServer) ReportUsage(context.Context, *MsgReportUsage) (*MsgReportUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportUsage not implemented")
+}
+func (UnimplementedMsgServer) SetUsageSpendLimit(context.Context, *MsgSetUsageSpendLimit) (*MsgSetUsageSpendLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUsageSpendLimit not implemented")
+}
+func (UnimplementedMsgServer) OpenDispute(context.Context, *MsgOpenDispute) (*MsgOpenDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenDispute not implemented")
+}
+func (UnimplementedMsgServer) RespondToDispute(context.Context, *MsgRespondToDispute) (*MsgRespondToDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondToDispute not implemented")
+}
+func (UnimplementedMsgServer) ResolveDispute(context.Context, *MsgResolveDispute) (*MsgResolveDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveDispute not implemented")
+}
+func (UnimplementedMsgServer) TransferCredit(context.Context, *MsgTransferCredit) (*MsgTransferCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferCredit not implemented")
+}
+func (UnimplementedMsgServer) CreateLeases(context.Context, *MsgCreateLeases) (*MsgCreateLeasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLeases not implemented")
+}
+func (UnimplementedMsgServer) CreateLeasesForTenant(context.Context, *MsgCreateLeasesForTenant) (*MsgCreateLeasesForTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLeasesForTenant not implemented")
+}
+func (UnimplementedMsgServer) SetProviderPolicy(context.Context, *MsgSetProviderPolicy) (*MsgSetProviderPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProviderPolicy not implemented")
+}
+func (UnimplementedMsgServer) GrantPromoCredit(context.Context, *MsgGrantPromoCredit) (*MsgGrantPromoCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantPromoCredit not implemented")
+}
+func (UnimplementedMsgServer) SetExchangeRates(context.Context, *MsgSetExchangeRates) (*MsgSetExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExchangeRates not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -431,6 +770,312 @@ func _Msg_SetItemCustomDomain_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawCredit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawCredit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawCredit(ctx, req.(*MsgWithdrawCredit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateLeaseItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateLeaseItems)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateLeaseItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateLeaseItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateLeaseItems(ctx, req.(*MsgUpdateLeaseItems))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposeLeaseAmendment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeLeaseAmendment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeLeaseAmendment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ProposeLeaseAmendment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeLeaseAmendment(ctx, req.(*MsgProposeLeaseAmendment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcknowledgeLeaseAmendment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcknowledgeLeaseAmendment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcknowledgeLeaseAmendment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcknowledgeLeaseAmendment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcknowledgeLeaseAmendment(ctx, req.(*MsgAcknowledgeLeaseAmendment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RejectLeaseAmendment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectLeaseAmendment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RejectLeaseAmendment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RejectLeaseAmendment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectLeaseAmendment(ctx, req.(*MsgRejectLeaseAmendment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetLeaseScheduledEnd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetLeaseScheduledEnd)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetLeaseScheduledEnd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetLeaseScheduledEnd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetLeaseScheduledEnd(ctx, req.(*MsgSetLeaseScheduledEnd))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReportUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportUsage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReportUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReportUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportUsage(ctx, req.(*MsgReportUsage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetUsageSpendLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetUsageSpendLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetUsageSpendLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetUsageSpendLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetUsageSpendLimit(ctx, req.(*MsgSetUsageSpendLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OpenDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOpenDispute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OpenDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_OpenDispute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OpenDispute(ctx, req.(*MsgOpenDispute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RespondToDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRespondToDispute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RespondToDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RespondToDispute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RespondToDispute(ctx, req.(*MsgRespondToDispute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResolveDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolveDispute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResolveDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ResolveDispute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolveDispute(ctx, req.(*MsgResolveDispute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferCredit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferCredit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferCredit(ctx, req.(*MsgTransferCredit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateLeases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateLeases)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateLeases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateLeases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateLeases(ctx, req.(*MsgCreateLeases))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateLeasesForTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateLeasesForTenant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateLeasesForTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateLeasesForTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateLeasesForTenant(ctx, req.(*MsgCreateLeasesForTenant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetProviderPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetProviderPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetProviderPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetProviderPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetProviderPolicy(ctx, req.(*MsgSetProviderPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantPromoCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantPromoCredit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantPromoCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantPromoCredit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantPromoCredit(ctx, req.(*MsgGrantPromoCredit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetExchangeRates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetExchangeRates(ctx, req.(*MsgSetExchangeRates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -478,6 +1123,74 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetItemCustomDomain",
 			Handler:    _Msg_SetItemCustomDomain_Handler,
 		},
+		{
+			MethodName: "WithdrawCredit",
+			Handler:    _Msg_WithdrawCredit_Handler,
+		},
+		{
+			MethodName: "UpdateLeaseItems",
+			Handler:    _Msg_UpdateLeaseItems_Handler,
+		},
+		{
+			MethodName: "ProposeLeaseAmendment",
+			Handler:    _Msg_ProposeLeaseAmendment_Handler,
+		},
+		{
+			MethodName: "AcknowledgeLeaseAmendment",
+			Handler:    _Msg_AcknowledgeLeaseAmendment_Handler,
+		},
+		{
+			MethodName: "RejectLeaseAmendment",
+			Handler:    _Msg_RejectLeaseAmendment_Handler,
+		},
+		{
+			MethodName: "SetLeaseScheduledEnd",
+			Handler:    _Msg_SetLeaseScheduledEnd_Handler,
+		},
+		{
+			MethodName: "ReportUsage",
+			Handler:    _Msg_ReportUsage_Handler,
+		},
+		{
+			MethodName: "SetUsageSpendLimit",
+			Handler:    _Msg_SetUsageSpendLimit_Handler,
+		},
+		{
+			MethodName: "OpenDispute",
+			Handler:    _Msg_OpenDispute_Handler,
+		},
+		{
+			MethodName: "RespondToDispute",
+			Handler:    _Msg_RespondToDispute_Handler,
+		},
+		{
+			MethodName: "ResolveDispute",
+			Handler:    _Msg_ResolveDispute_Handler,
+		},
+		{
+			MethodName: "TransferCredit",
+			Handler:    _Msg_TransferCredit_Handler,
+		},
+		{
+			MethodName: "CreateLeases",
+			Handler:    _Msg_CreateLeases_Handler,
+		},
+		{
+			MethodName: "CreateLeasesForTenant",
+			Handler:    _Msg_CreateLeasesForTenant_Handler,
+		},
+		{
+			MethodName: "SetProviderPolicy",
+			Handler:    _Msg_SetProviderPolicy_Handler,
+		},
+		{
+			MethodName: "GrantPromoCredit",
+			Handler:    _Msg_GrantPromoCredit_Handler,
+		},
+		{
+			MethodName: "SetExchangeRates",
+			Handler:    _Msg_SetExchangeRates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "liftedinit/billing/v1/tx.proto",
@@ -109,6 +109,52 @@ func (x *_Params_7_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Params_13_list)(nil)
+
+type _Params_13_list struct {
+	list *[]string
+}
+
+func (x *_Params_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_13_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field PriceFeeders as it is not of Message kind"))
+}
+
+func (x *_Params_13_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_13_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_13_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Params                               protoreflect.MessageDescriptor
 	fd_Params_max_leases_per_tenant         protoreflect.FieldDescriptor
@@ -118,6 +164,13 @@ var (
 	fd_Params_max_pending_leases_per_tenant protoreflect.FieldDescriptor
 	fd_Params_pending_timeout               protoreflect.FieldDescriptor
 	fd_Params_reserved_domain_suffixes      protoreflect.FieldDescriptor
+	fd_Params_credit_withdrawal_cooldown    protoreflect.FieldDescriptor
+	fd_Params_grace_period                  protoreflect.FieldDescriptor
+	fd_Params_lease_retention_period        protoreflect.FieldDescriptor
+	fd_Params_dispute_response_period       protoreflect.FieldDescriptor
+	fd_Params_reference_denom               protoreflect.FieldDescriptor
+	fd_Params_price_feeders                 protoreflect.FieldDescriptor
+	fd_Params_max_provider_grace_period     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -130,6 +183,13 @@ func init() {
 	fd_Params_max_pending_leases_per_tenant = md_Params.Fields().ByName("max_pending_leases_per_tenant")
 	fd_Params_pending_timeout = md_Params.Fields().ByName("pending_timeout")
 	fd_Params_reserved_domain_suffixes = md_Params.Fields().ByName("reserved_domain_suffixes")
+	fd_Params_credit_withdrawal_cooldown = md_Params.Fields().ByName("credit_withdrawal_cooldown")
+	fd_Params_grace_period = md_Params.Fields().ByName("grace_period")
+	fd_Params_lease_retention_period = md_Params.Fields().ByName("lease_retention_period")
+	fd_Params_dispute_response_period = md_Params.Fields().ByName("dispute_response_period")
+	fd_Params_reference_denom = md_Params.Fields().ByName("reference_denom")
+	fd_Params_price_feeders = md_Params.Fields().ByName("price_feeders")
+	fd_Params_max_provider_grace_period = md_Params.Fields().ByName("max_provider_grace_period")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -239,6 +299,48 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.CreditWithdrawalCooldown != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CreditWithdrawalCooldown)
+		if !f(fd_Params_credit_withdrawal_cooldown, value) {
+			return
+		}
+	}
+	if x.GracePeriod != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GracePeriod)
+		if !f(fd_Params_grace_period, value) {
+			return
+		}
+	}
+	if x.LeaseRetentionPeriod != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LeaseRetentionPeriod)
+		if !f(fd_Params_lease_retention_period, value) {
+			return
+		}
+	}
+	if x.DisputeResponsePeriod != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DisputeResponsePeriod)
+		if !f(fd_Params_dispute_response_period, value) {
+			return
+		}
+	}
+	if x.ReferenceDenom != "" {
+		value := protoreflect.ValueOfString(x.ReferenceDenom)
+		if !f(fd_Params_reference_denom, value) {
+			return
+		}
+	}
+	if len(x.PriceFeeders) != 0 {
+		value := protoreflect.ValueOfList(&_Params_13_list{list: &x.PriceFeeders})
+		if !f(fd_Params_price_feeders, value) {
+			return
+		}
+	}
+	if x.MaxProviderGracePeriod != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxProviderGracePeriod)
+		if !f(fd_Params_max_provider_grace_period, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -268,6 +370,20 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.PendingTimeout != uint64(0)
 	case "liftedinit.billing.v1.Params.reserved_domain_suffixes":
 		return len(x.ReservedDomainSuffixes) != 0
+	case "liftedinit.billing.v1.Params.credit_withdrawal_cooldown":
+		return x.CreditWithdrawalCooldown != uint64(0)
+	case "liftedinit.billing.v1.Params.grace_period":
+		return x.GracePeriod != uint64(0)
+	case "liftedinit.billing.v1.Params.lease_retention_period":
+		return x.LeaseRetentionPeriod != uint64(0)
+	case "liftedinit.billing.v1.Params.dispute_response_period":
+		return x.DisputeResponsePeriod != uint64(0)
+	case "liftedinit.billing.v1.Params.reference_denom":
+		return x.ReferenceDenom != ""
+	case "liftedinit.billing.v1.Params.price_feeders":
+		return len(x.PriceFeeders) != 0
+	case "liftedinit.billing.v1.Params.max_provider_grace_period":
+		return x.MaxProviderGracePeriod != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.Params"))
@@ -298,6 +414,20 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.PendingTimeout = uint64(0)
 	case "liftedinit.billing.v1.Params.reserved_domain_suffixes":
 		x.ReservedDomainSuffixes = nil
+	case "liftedinit.billing.v1.Params.credit_withdrawal_cooldown":
+		x.CreditWithdrawalCooldown = uint64(0)
+	case "liftedinit.billing.v1.Params.grace_period":
+		x.GracePeriod = uint64(0)
+	case "liftedinit.billing.v1.Params.lease_retention_period":
+		x.LeaseRetentionPeriod = uint64(0)
+	case "liftedinit.billing.v1.Params.dispute_response_period":
+		x.DisputeResponsePeriod = uint64(0)
+	case "liftedinit.billing.v1.Params.reference_denom":
+		x.ReferenceDenom = ""
+	case "liftedinit.billing.v1.Params.price_feeders":
+		x.PriceFeeders = nil
+	case "liftedinit.billing.v1.Params.max_provider_grace_period":
+		x.MaxProviderGracePeriod = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.Params"))
@@ -341,6 +471,30 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 		}
 		listValue := &_Params_7_list{list: &x.ReservedDomainSuffixes}
 		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.Params.credit_withdrawal_cooldown":
+		value := x.CreditWithdrawalCooldown
+		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.billing.v1.Params.grace_period":
+		value := x.GracePeriod
+		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.billing.v1.Params.lease_retention_period":
+		value := x.LeaseRetentionPeriod
+		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.billing.v1.Params.dispute_response_period":
+		value := x.DisputeResponsePeriod
+		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.billing.v1.Params.reference_denom":
+		value := x.ReferenceDenom
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.billing.v1.Params.price_feeders":
+		if len(x.PriceFeeders) == 0 {
+			return protoreflect.ValueOfList(&_Params_13_list{})
+		}
+		listValue := &_Params_13_list{list: &x.PriceFeeders}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.Params.max_provider_grace_period":
+		value := x.MaxProviderGracePeriod
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.Params"))
@@ -379,6 +533,22 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		lv := value.List()
 		clv := lv.(*_Params_7_list)
 		x.ReservedDomainSuffixes = *clv.list
+	case "liftedinit.billing.v1.Params.credit_withdrawal_cooldown":
+		x.CreditWithdrawalCooldown = value.Uint()
+	case "liftedinit.billing.v1.Params.grace_period":
+		x.GracePeriod = value.Uint()
+	case "liftedinit.billing.v1.Params.lease_retention_period":
+		x.LeaseRetentionPeriod = value.Uint()
+	case "liftedinit.billing.v1.Params.dispute_response_period":
+		x.DisputeResponsePeriod = value.Uint()
+	case "liftedinit.billing.v1.Params.reference_denom":
+		x.ReferenceDenom = value.Interface().(string)
+	case "liftedinit.billing.v1.Params.price_feeders":
+		lv := value.List()
+		clv := lv.(*_Params_13_list)
+		x.PriceFeeders = *clv.list
+	case "liftedinit.billing.v1.Params.max_provider_grace_period":
+		x.MaxProviderGracePeriod = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.Params"))
@@ -411,6 +581,12 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Params_7_list{list: &x.ReservedDomainSuffixes}
 		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.Params.price_feeders":
+		if x.PriceFeeders == nil {
+			x.PriceFeeders = []string{}
+		}
+		value := &_Params_13_list{list: &x.PriceFeeders}
+		return protoreflect.ValueOfList(value)
 	case "liftedinit.billing.v1.Params.max_leases_per_tenant":
 		panic(fmt.Errorf("field max_leases_per_tenant of message liftedinit.billing.v1.Params is not mutable"))
 	case "liftedinit.billing.v1.Params.max_items_per_lease":
@@ -421,6 +597,18 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field max_pending_leases_per_tenant of message liftedinit.billing.v1.Params is not mutable"))
 	case "liftedinit.billing.v1.Params.pending_timeout":
 		panic(fmt.Errorf("field pending_timeout of message liftedinit.billing.v1.Params is not mutable"))
+	case "liftedinit.billing.v1.Params.credit_withdrawal_cooldown":
+		panic(fmt.Errorf("field credit_withdrawal_cooldown of message liftedinit.billing.v1.Params is not mutable"))
+	case "liftedinit.billing.v1.Params.grace_period":
+		panic(fmt.Errorf("field grace_period of message liftedinit.billing.v1.Params is not mutable"))
+	case "liftedinit.billing.v1.Params.lease_retention_period":
+		panic(fmt.Errorf("field lease_retention_period of message liftedinit.billing.v1.Params is not mutable"))
+	case "liftedinit.billing.v1.Params.dispute_response_period":
+		panic(fmt.Errorf("field dispute_response_period of message liftedinit.billing.v1.Params is not mutable"))
+	case "liftedinit.billing.v1.Params.reference_denom":
+		panic(fmt.Errorf("field reference_denom of message liftedinit.billing.v1.Params is not mutable"))
+	case "liftedinit.billing.v1.Params.max_provider_grace_period":
+		panic(fmt.Errorf("field max_provider_grace_period of message liftedinit.billing.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.Params"))
@@ -450,6 +638,21 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	case "liftedinit.billing.v1.Params.reserved_domain_suffixes":
 		list := []string{}
 		return protoreflect.ValueOfList(&_Params_7_list{list: &list})
+	case "liftedinit.billing.v1.Params.credit_withdrawal_cooldown":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.billing.v1.Params.grace_period":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.billing.v1.Params.lease_retention_period":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.billing.v1.Params.dispute_response_period":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.billing.v1.Params.reference_denom":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.billing.v1.Params.price_feeders":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_13_list{list: &list})
+	case "liftedinit.billing.v1.Params.max_provider_grace_period":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.Params"))
@@ -546,6 +749,31 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.CreditWithdrawalCooldown != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreditWithdrawalCooldown))
+		}
+		if x.GracePeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.GracePeriod))
+		}
+		if x.LeaseRetentionPeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.LeaseRetentionPeriod))
+		}
+		if x.DisputeResponsePeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.DisputeResponsePeriod))
+		}
+		l = len(x.ReferenceDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.PriceFeeders) > 0 {
+			for _, s := range x.PriceFeeders {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxProviderGracePeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxProviderGracePeriod))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -575,6 +803,47 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxProviderGracePeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxProviderGracePeriod))
+			i--
+			dAtA[i] = 0x70
+		}
+		if len(x.PriceFeeders) > 0 {
+			for iNdEx := len(x.PriceFeeders) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.PriceFeeders[iNdEx])
+				copy(dAtA[i:], x.PriceFeeders[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PriceFeeders[iNdEx])))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.ReferenceDenom) > 0 {
+			i -= len(x.ReferenceDenom)
+			copy(dAtA[i:], x.ReferenceDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReferenceDenom)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.DisputeResponsePeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DisputeResponsePeriod))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.LeaseRetentionPeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LeaseRetentionPeriod))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.GracePeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GracePeriod))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.CreditWithdrawalCooldown != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreditWithdrawalCooldown))
+			i--
+			dAtA[i] = 0x40
+		}
 		if len(x.ReservedDomainSuffixes) > 0 {
 			for iNdEx := len(x.ReservedDomainSuffixes) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.ReservedDomainSuffixes[iNdEx])
@@ -826,6 +1095,165 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.ReservedDomainSuffixes = append(x.ReservedDomainSuffixes, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreditWithdrawalCooldown", wireType)
+				}
+				x.CreditWithdrawalCooldown = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CreditWithdrawalCooldown |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+				}
+				x.GracePeriod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GracePeriod |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LeaseRetentionPeriod", wireType)
+				}
+				x.LeaseRetentionPeriod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LeaseRetentionPeriod |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisputeResponsePeriod", wireType)
+				}
+				x.DisputeResponsePeriod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DisputeResponsePeriod |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReferenceDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReferenceDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceFeeders", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PriceFeeders = append(x.PriceFeeders, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxProviderGracePeriod", wireType)
+				}
+				x.MaxProviderGracePeriod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxProviderGracePeriod |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -862,12 +1290,16 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_LeaseItem               protoreflect.MessageDescriptor
-	fd_LeaseItem_sku_uuid      protoreflect.FieldDescriptor
-	fd_LeaseItem_quantity      protoreflect.FieldDescriptor
-	fd_LeaseItem_locked_price  protoreflect.FieldDescriptor
-	fd_LeaseItem_service_name  protoreflect.FieldDescriptor
-	fd_LeaseItem_custom_domain protoreflect.FieldDescriptor
+	md_LeaseItem                protoreflect.MessageDescriptor
+	fd_LeaseItem_sku_uuid       protoreflect.FieldDescriptor
+	fd_LeaseItem_quantity       protoreflect.FieldDescriptor
+	fd_LeaseItem_locked_price   protoreflect.FieldDescriptor
+	fd_LeaseItem_service_name   protoreflect.FieldDescriptor
+	fd_LeaseItem_custom_domain  protoreflect.FieldDescriptor
+	fd_LeaseItem_metered        protoreflect.FieldDescriptor
+	fd_LeaseItem_locked_rate    protoreflect.FieldDescriptor
+	fd_LeaseItem_calendar_month protoreflect.FieldDescriptor
+	fd_LeaseItem_monthly_price  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -878,6 +1310,10 @@ func init() {
 	fd_LeaseItem_locked_price = md_LeaseItem.Fields().ByName("locked_price")
 	fd_LeaseItem_service_name = md_LeaseItem.Fields().ByName("service_name")
 	fd_LeaseItem_custom_domain = md_LeaseItem.Fields().ByName("custom_domain")
+	fd_LeaseItem_metered = md_LeaseItem.Fields().ByName("metered")
+	fd_LeaseItem_locked_rate = md_LeaseItem.Fields().ByName("locked_rate")
+	fd_LeaseItem_calendar_month = md_LeaseItem.Fields().ByName("calendar_month")
+	fd_LeaseItem_monthly_price = md_LeaseItem.Fields().ByName("monthly_price")
 }
 
 var _ protoreflect.Message = (*fastReflection_LeaseItem)(nil)
@@ -975,6 +1411,30 @@ func (x *fastReflection_LeaseItem) Range(f func(protoreflect.FieldDescriptor, pr
 			return
 		}
 	}
+	if x.Metered != false {
+		value := protoreflect.ValueOfBool(x.Metered)
+		if !f(fd_LeaseItem_metered, value) {
+			return
+		}
+	}
+	if x.LockedRate != "" {
+		value := protoreflect.ValueOfString(x.LockedRate)
+		if !f(fd_LeaseItem_locked_rate, value) {
+			return
+		}
+	}
+	if x.CalendarMonth != false {
+		value := protoreflect.ValueOfBool(x.CalendarMonth)
+		if !f(fd_LeaseItem_calendar_month, value) {
+			return
+		}
+	}
+	if x.MonthlyPrice != nil {
+		value := protoreflect.ValueOfMessage(x.MonthlyPrice.ProtoReflect())
+		if !f(fd_LeaseItem_monthly_price, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1000,6 +1460,14 @@ func (x *fastReflection_LeaseItem) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.ServiceName != ""
 	case "liftedinit.billing.v1.LeaseItem.custom_domain":
 		return x.CustomDomain != ""
+	case "liftedinit.billing.v1.LeaseItem.metered":
+		return x.Metered != false
+	case "liftedinit.billing.v1.LeaseItem.locked_rate":
+		return x.LockedRate != ""
+	case "liftedinit.billing.v1.LeaseItem.calendar_month":
+		return x.CalendarMonth != false
+	case "liftedinit.billing.v1.LeaseItem.monthly_price":
+		return x.MonthlyPrice != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.LeaseItem"))
@@ -1026,6 +1494,14 @@ func (x *fastReflection_LeaseItem) Clear(fd protoreflect.FieldDescriptor) {
 		x.ServiceName = ""
 	case "liftedinit.billing.v1.LeaseItem.custom_domain":
 		x.CustomDomain = ""
+	case "liftedinit.billing.v1.LeaseItem.metered":
+		x.Metered = false
+	case "liftedinit.billing.v1.LeaseItem.locked_rate":
+		x.LockedRate = ""
+	case "liftedinit.billing.v1.LeaseItem.calendar_month":
+		x.CalendarMonth = false
+	case "liftedinit.billing.v1.LeaseItem.monthly_price":
+		x.MonthlyPrice = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.LeaseItem"))
@@ -1057,6 +1533,18 @@ func (x *fastReflection_LeaseItem) Get(descriptor protoreflect.FieldDescriptor)
 	case "liftedinit.billing.v1.LeaseItem.custom_domain":
 		value := x.CustomDomain
 		return protoreflect.ValueOfString(value)
+	case "liftedinit.billing.v1.LeaseItem.metered":
+		value := x.Metered
+		return protoreflect.ValueOfBool(value)
+	case "liftedinit.billing.v1.LeaseItem.locked_rate":
+		value := x.LockedRate
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.billing.v1.LeaseItem.calendar_month":
+		value := x.CalendarMonth
+		return protoreflect.ValueOfBool(value)
+	case "liftedinit.billing.v1.LeaseItem.monthly_price":
+		value := x.MonthlyPrice
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.LeaseItem"))
@@ -1087,6 +1575,14 @@ func (x *fastReflection_LeaseItem) Set(fd protoreflect.FieldDescriptor, value pr
 		x.ServiceName = value.Interface().(string)
 	case "liftedinit.billing.v1.LeaseItem.custom_domain":
 		x.CustomDomain = value.Interface().(string)
+	case "liftedinit.billing.v1.LeaseItem.metered":
+		x.Metered = value.Bool()
+	case "liftedinit.billing.v1.LeaseItem.locked_rate":
+		x.LockedRate = value.Interface().(string)
+	case "liftedinit.billing.v1.LeaseItem.calendar_month":
+		x.CalendarMonth = value.Bool()
+	case "liftedinit.billing.v1.LeaseItem.monthly_price":
+		x.MonthlyPrice = value.Message().Interface().(*types.Coin)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.LeaseItem"))
@@ -1112,6 +1608,11 @@ func (x *fastReflection_LeaseItem) Mutable(fd protoreflect.FieldDescriptor) prot
 			x.LockedPrice = new(types.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.LockedPrice.ProtoReflect())
+	case "liftedinit.billing.v1.LeaseItem.monthly_price":
+		if x.MonthlyPrice == nil {
+			x.MonthlyPrice = new(types.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.MonthlyPrice.ProtoReflect())
 	case "liftedinit.billing.v1.LeaseItem.sku_uuid":
 		panic(fmt.Errorf("field sku_uuid of message liftedinit.billing.v1.LeaseItem is not mutable"))
 	case "liftedinit.billing.v1.LeaseItem.quantity":
@@ -1120,6 +1621,12 @@ func (x *fastReflection_LeaseItem) Mutable(fd protoreflect.FieldDescriptor) prot
 		panic(fmt.Errorf("field service_name of message liftedinit.billing.v1.LeaseItem is not mutable"))
 	case "liftedinit.billing.v1.LeaseItem.custom_domain":
 		panic(fmt.Errorf("field custom_domain of message liftedinit.billing.v1.LeaseItem is not mutable"))
+	case "liftedinit.billing.v1.LeaseItem.metered":
+		panic(fmt.Errorf("field metered of message liftedinit.billing.v1.LeaseItem is not mutable"))
+	case "liftedinit.billing.v1.LeaseItem.locked_rate":
+		panic(fmt.Errorf("field locked_rate of message liftedinit.billing.v1.LeaseItem is not mutable"))
+	case "liftedinit.billing.v1.LeaseItem.calendar_month":
+		panic(fmt.Errorf("field calendar_month of message liftedinit.billing.v1.LeaseItem is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.LeaseItem"))
@@ -1144,6 +1651,15 @@ func (x *fastReflection_LeaseItem) NewField(fd protoreflect.FieldDescriptor) pro
 		return protoreflect.ValueOfString("")
 	case "liftedinit.billing.v1.LeaseItem.custom_domain":
 		return protoreflect.ValueOfString("")
+	case "liftedinit.billing.v1.LeaseItem.metered":
+		return protoreflect.ValueOfBool(false)
+	case "liftedinit.billing.v1.LeaseItem.locked_rate":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.billing.v1.LeaseItem.calendar_month":
+		return protoreflect.ValueOfBool(false)
+	case "liftedinit.billing.v1.LeaseItem.monthly_price":
+		m := new(types.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.LeaseItem"))
@@ -1232,6 +1748,20 @@ func (x *fastReflection_LeaseItem) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Metered {
+			n += 2
+		}
+		l = len(x.LockedRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CalendarMonth {
+			n += 2
+		}
+		if x.MonthlyPrice != nil {
+			l = options.Size(x.MonthlyPrice)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1261,6 +1791,47 @@ func (x *fastReflection_LeaseItem) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MonthlyPrice != nil {
+			encoded, err := options.Marshal(x.MonthlyPrice)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.CalendarMonth {
+			i--
+			if x.CalendarMonth {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.LockedRate) > 0 {
+			i -= len(x.LockedRate)
+			copy(dAtA[i:], x.LockedRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LockedRate)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Metered {
+			i--
+			if x.Metered {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
 		if len(x.CustomDomain) > 0 {
 			i -= len(x.CustomDomain)
 			copy(dAtA[i:], x.CustomDomain)
@@ -1501,6 +2072,114 @@ func (x *fastReflection_LeaseItem) ProtoMethods() *protoiface.Methods {
 				}
 				x.CustomDomain = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metered", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Metered = bool(v != 0)
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockedRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LockedRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CalendarMonth", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.CalendarMonth = bool(v != 0)
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MonthlyPrice", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MonthlyPrice == nil {
+					x.MonthlyPrice = &types.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MonthlyPrice); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1587,6 +2266,159 @@ func (x *_Lease_4_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Lease_18_list)(nil)
+
+type _Lease_18_list struct {
+	list *[]*types.Coin
+}
+
+func (x *_Lease_18_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Lease_18_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Lease_18_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Lease_18_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Lease_18_list) AppendMutable() protoreflect.Value {
+	v := new(types.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Lease_18_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Lease_18_list) NewElement() protoreflect.Value {
+	v := new(types.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Lease_18_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Lease_20_list)(nil)
+
+type _Lease_20_list struct {
+	list *[]*types.Coin
+}
+
+func (x *_Lease_20_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Lease_20_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Lease_20_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Lease_20_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Lease_20_list) AppendMutable() protoreflect.Value {
+	v := new(types.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Lease_20_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Lease_20_list) NewElement() protoreflect.Value {
+	v := new(types.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Lease_20_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Lease_21_list)(nil)
+
+type _Lease_21_list struct {
+	list *[]*types.DecCoin
+}
+
+func (x *_Lease_21_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Lease_21_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Lease_21_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.DecCoin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Lease_21_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.DecCoin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Lease_21_list) AppendMutable() protoreflect.Value {
+	v := new(types.DecCoin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Lease_21_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Lease_21_list) NewElement() protoreflect.Value {
+	v := new(types.DecCoin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Lease_21_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Lease                                protoreflect.MessageDescriptor
 	fd_Lease_uuid                           protoreflect.FieldDescriptor
@@ -1604,6 +2436,12 @@ var (
 	fd_Lease_closure_reason                 protoreflect.FieldDescriptor
 	fd_Lease_meta_hash                      protoreflect.FieldDescriptor
 	fd_Lease_min_lease_duration_at_creation protoreflect.FieldDescriptor
+	fd_Lease_scheduled_end_at               protoreflect.FieldDescriptor
+	fd_Lease_grace_ends_at                  protoreflect.FieldDescriptor
+	fd_Lease_debt                           protoreflect.FieldDescriptor
+	fd_Lease_disputed                       protoreflect.FieldDescriptor
+	fd_Lease_total_settled                  protoreflect.FieldDescriptor
+	fd_Lease_accrual_carry                  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1624,6 +2462,12 @@ func init() {
 	fd_Lease_closure_reason = md_Lease.Fields().ByName("closure_reason")
 	fd_Lease_meta_hash = md_Lease.Fields().ByName("meta_hash")
 	fd_Lease_min_lease_duration_at_creation = md_Lease.Fields().ByName("min_lease_duration_at_creation")
+	fd_Lease_scheduled_end_at = md_Lease.Fields().ByName("scheduled_end_at")
+	fd_Lease_grace_ends_at = md_Lease.Fields().ByName("grace_ends_at")
+	fd_Lease_debt = md_Lease.Fields().ByName("debt")
+	fd_Lease_disputed = md_Lease.Fields().ByName("disputed")
+	fd_Lease_total_settled = md_Lease.Fields().ByName("total_settled")
+	fd_Lease_accrual_carry = md_Lease.Fields().ByName("accrual_carry")
 }
 
 var _ protoreflect.Message = (*fastReflection_Lease)(nil)
@@ -1781,15 +2625,51 @@ func (x *fastReflection_Lease) Range(f func(protoreflect.FieldDescriptor, protor
 			return
 		}
 	}
-}
-
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
+	if x.ScheduledEndAt != nil {
+		value := protoreflect.ValueOfMessage(x.ScheduledEndAt.ProtoReflect())
+		if !f(fd_Lease_scheduled_end_at, value) {
+			return
+		}
+	}
+	if x.GraceEndsAt != nil {
+		value := protoreflect.ValueOfMessage(x.GraceEndsAt.ProtoReflect())
+		if !f(fd_Lease_grace_ends_at, value) {
+			return
+		}
+	}
+	if len(x.Debt) != 0 {
+		value := protoreflect.ValueOfList(&_Lease_18_list{list: &x.Debt})
+		if !f(fd_Lease_debt, value) {
+			return
+		}
+	}
+	if x.Disputed != false {
+		value := protoreflect.ValueOfBool(x.Disputed)
+		if !f(fd_Lease_disputed, value) {
+			return
+		}
+	}
+	if len(x.TotalSettled) != 0 {
+		value := protoreflect.ValueOfList(&_Lease_20_list{list: &x.TotalSettled})
+		if !f(fd_Lease_total_settled, value) {
+			return
+		}
+	}
+	if len(x.AccrualCarry) != 0 {
+		value := protoreflect.ValueOfList(&_Lease_21_list{list: &x.AccrualCarry})
+		if !f(fd_Lease_accrual_carry, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
 //
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
@@ -1826,6 +2706,18 @@ func (x *fastReflection_Lease) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.MetaHash) != 0
 	case "liftedinit.billing.v1.Lease.min_lease_duration_at_creation":
 		return x.MinLeaseDurationAtCreation != uint64(0)
+	case "liftedinit.billing.v1.Lease.scheduled_end_at":
+		return x.ScheduledEndAt != nil
+	case "liftedinit.billing.v1.Lease.grace_ends_at":
+		return x.GraceEndsAt != nil
+	case "liftedinit.billing.v1.Lease.debt":
+		return len(x.Debt) != 0
+	case "liftedinit.billing.v1.Lease.disputed":
+		return x.Disputed != false
+	case "liftedinit.billing.v1.Lease.total_settled":
+		return len(x.TotalSettled) != 0
+	case "liftedinit.billing.v1.Lease.accrual_carry":
+		return len(x.AccrualCarry) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.Lease"))
@@ -1872,6 +2764,18 @@ func (x *fastReflection_Lease) Clear(fd protoreflect.FieldDescriptor) {
 		x.MetaHash = nil
 	case "liftedinit.billing.v1.Lease.min_lease_duration_at_creation":
 		x.MinLeaseDurationAtCreation = uint64(0)
+	case "liftedinit.billing.v1.Lease.scheduled_end_at":
+		x.ScheduledEndAt = nil
+	case "liftedinit.billing.v1.Lease.grace_ends_at":
+		x.GraceEndsAt = nil
+	case "liftedinit.billing.v1.Lease.debt":
+		x.Debt = nil
+	case "liftedinit.billing.v1.Lease.disputed":
+		x.Disputed = false
+	case "liftedinit.billing.v1.Lease.total_settled":
+		x.TotalSettled = nil
+	case "liftedinit.billing.v1.Lease.accrual_carry":
+		x.AccrualCarry = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.Lease"))
@@ -1936,6 +2840,33 @@ func (x *fastReflection_Lease) Get(descriptor protoreflect.FieldDescriptor) prot
 	case "liftedinit.billing.v1.Lease.min_lease_duration_at_creation":
 		value := x.MinLeaseDurationAtCreation
 		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.billing.v1.Lease.scheduled_end_at":
+		value := x.ScheduledEndAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "liftedinit.billing.v1.Lease.grace_ends_at":
+		value := x.GraceEndsAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "liftedinit.billing.v1.Lease.debt":
+		if len(x.Debt) == 0 {
+			return protoreflect.ValueOfList(&_Lease_18_list{})
+		}
+		listValue := &_Lease_18_list{list: &x.Debt}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.Lease.disputed":
+		value := x.Disputed
+		return protoreflect.ValueOfBool(value)
+	case "liftedinit.billing.v1.Lease.total_settled":
+		if len(x.TotalSettled) == 0 {
+			return protoreflect.ValueOfList(&_Lease_20_list{})
+		}
+		listValue := &_Lease_20_list{list: &x.TotalSettled}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.Lease.accrual_carry":
+		if len(x.AccrualCarry) == 0 {
+			return protoreflect.ValueOfList(&_Lease_21_list{})
+		}
+		listValue := &_Lease_21_list{list: &x.AccrualCarry}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.Lease"))
@@ -1988,6 +2919,24 @@ func (x *fastReflection_Lease) Set(fd protoreflect.FieldDescriptor, value protor
 		x.MetaHash = value.Bytes()
 	case "liftedinit.billing.v1.Lease.min_lease_duration_at_creation":
 		x.MinLeaseDurationAtCreation = value.Uint()
+	case "liftedinit.billing.v1.Lease.scheduled_end_at":
+		x.ScheduledEndAt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "liftedinit.billing.v1.Lease.grace_ends_at":
+		x.GraceEndsAt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "liftedinit.billing.v1.Lease.debt":
+		lv := value.List()
+		clv := lv.(*_Lease_18_list)
+		x.Debt = *clv.list
+	case "liftedinit.billing.v1.Lease.disputed":
+		x.Disputed = value.Bool()
+	case "liftedinit.billing.v1.Lease.total_settled":
+		lv := value.List()
+		clv := lv.(*_Lease_20_list)
+		x.TotalSettled = *clv.list
+	case "liftedinit.billing.v1.Lease.accrual_carry":
+		lv := value.List()
+		clv := lv.(*_Lease_21_list)
+		x.AccrualCarry = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.Lease"))
@@ -2044,6 +2993,34 @@ func (x *fastReflection_Lease) Mutable(fd protoreflect.FieldDescriptor) protoref
 			x.ExpiredAt = new(timestamppb.Timestamp)
 		}
 		return protoreflect.ValueOfMessage(x.ExpiredAt.ProtoReflect())
+	case "liftedinit.billing.v1.Lease.scheduled_end_at":
+		if x.ScheduledEndAt == nil {
+			x.ScheduledEndAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ScheduledEndAt.ProtoReflect())
+	case "liftedinit.billing.v1.Lease.grace_ends_at":
+		if x.GraceEndsAt == nil {
+			x.GraceEndsAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.GraceEndsAt.ProtoReflect())
+	case "liftedinit.billing.v1.Lease.debt":
+		if x.Debt == nil {
+			x.Debt = []*types.Coin{}
+		}
+		value := &_Lease_18_list{list: &x.Debt}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.Lease.total_settled":
+		if x.TotalSettled == nil {
+			x.TotalSettled = []*types.Coin{}
+		}
+		value := &_Lease_20_list{list: &x.TotalSettled}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.Lease.accrual_carry":
+		if x.AccrualCarry == nil {
+			x.AccrualCarry = []*types.DecCoin{}
+		}
+		value := &_Lease_21_list{list: &x.AccrualCarry}
+		return protoreflect.ValueOfList(value)
 	case "liftedinit.billing.v1.Lease.uuid":
 		panic(fmt.Errorf("field uuid of message liftedinit.billing.v1.Lease is not mutable"))
 	case "liftedinit.billing.v1.Lease.tenant":
@@ -2060,6 +3037,8 @@ func (x *fastReflection_Lease) Mutable(fd protoreflect.FieldDescriptor) protoref
 		panic(fmt.Errorf("field meta_hash of message liftedinit.billing.v1.Lease is not mutable"))
 	case "liftedinit.billing.v1.Lease.min_lease_duration_at_creation":
 		panic(fmt.Errorf("field min_lease_duration_at_creation of message liftedinit.billing.v1.Lease is not mutable"))
+	case "liftedinit.billing.v1.Lease.disputed":
+		panic(fmt.Errorf("field disputed of message liftedinit.billing.v1.Lease is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.Lease"))
@@ -2110,6 +3089,23 @@ func (x *fastReflection_Lease) NewField(fd protoreflect.FieldDescriptor) protore
 		return protoreflect.ValueOfBytes(nil)
 	case "liftedinit.billing.v1.Lease.min_lease_duration_at_creation":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.billing.v1.Lease.scheduled_end_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "liftedinit.billing.v1.Lease.grace_ends_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "liftedinit.billing.v1.Lease.debt":
+		list := []*types.Coin{}
+		return protoreflect.ValueOfList(&_Lease_18_list{list: &list})
+	case "liftedinit.billing.v1.Lease.disputed":
+		return protoreflect.ValueOfBool(false)
+	case "liftedinit.billing.v1.Lease.total_settled":
+		list := []*types.Coin{}
+		return protoreflect.ValueOfList(&_Lease_20_list{list: &list})
+	case "liftedinit.billing.v1.Lease.accrual_carry":
+		list := []*types.DecCoin{}
+		return protoreflect.ValueOfList(&_Lease_21_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.Lease"))
@@ -2239,6 +3235,35 @@ func (x *fastReflection_Lease) ProtoMethods() *protoiface.Methods {
 		if x.MinLeaseDurationAtCreation != 0 {
 			n += 1 + runtime.Sov(uint64(x.MinLeaseDurationAtCreation))
 		}
+		if x.ScheduledEndAt != nil {
+			l = options.Size(x.ScheduledEndAt)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.GraceEndsAt != nil {
+			l = options.Size(x.GraceEndsAt)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Debt) > 0 {
+			for _, e := range x.Debt {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Disputed {
+			n += 3
+		}
+		if len(x.TotalSettled) > 0 {
+			for _, e := range x.TotalSettled {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AccrualCarry) > 0 {
+			for _, e := range x.AccrualCarry {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2268,6 +3293,104 @@ func (x *fastReflection_Lease) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.AccrualCarry) > 0 {
+			for iNdEx := len(x.AccrualCarry) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AccrualCarry[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xaa
+			}
+		}
+		if len(x.TotalSettled) > 0 {
+			for iNdEx := len(x.TotalSettled) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TotalSettled[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xa2
+			}
+		}
+		if x.Disputed {
+			i--
+			if x.Disputed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x98
+		}
+		if len(x.Debt) > 0 {
+			for iNdEx := len(x.Debt) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Debt[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if x.GraceEndsAt != nil {
+			encoded, err := options.Marshal(x.GraceEndsAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if x.ScheduledEndAt != nil {
+			encoded, err := options.Marshal(x.ScheduledEndAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
 		if x.MinLeaseDurationAtCreation != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinLeaseDurationAtCreation))
 			i--
@@ -2951,197 +4074,623 @@ func (x *fastReflection_Lease) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScheduledEndAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ScheduledEndAt == nil {
+					x.ScheduledEndAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ScheduledEndAt); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GraceEndsAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if x.GraceEndsAt == nil {
+					x.GraceEndsAt = &timestamppb.Timestamp{}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_CustomDomainTarget              protoreflect.MessageDescriptor
-	fd_CustomDomainTarget_lease_uuid   protoreflect.FieldDescriptor
-	fd_CustomDomainTarget_service_name protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_liftedinit_billing_v1_types_proto_init()
-	md_CustomDomainTarget = File_liftedinit_billing_v1_types_proto.Messages().ByName("CustomDomainTarget")
-	fd_CustomDomainTarget_lease_uuid = md_CustomDomainTarget.Fields().ByName("lease_uuid")
-	fd_CustomDomainTarget_service_name = md_CustomDomainTarget.Fields().ByName("service_name")
-}
-
-var _ protoreflect.Message = (*fastReflection_CustomDomainTarget)(nil)
-
-type fastReflection_CustomDomainTarget CustomDomainTarget
-
-func (x *CustomDomainTarget) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CustomDomainTarget)(x)
-}
-
-func (x *CustomDomainTarget) slowProtoReflect() protoreflect.Message {
-	mi := &file_liftedinit_billing_v1_types_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_CustomDomainTarget_messageType fastReflection_CustomDomainTarget_messageType
-var _ protoreflect.MessageType = fastReflection_CustomDomainTarget_messageType{}
-
-type fastReflection_CustomDomainTarget_messageType struct{}
-
-func (x fastReflection_CustomDomainTarget_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CustomDomainTarget)(nil)
-}
-func (x fastReflection_CustomDomainTarget_messageType) New() protoreflect.Message {
-	return new(fastReflection_CustomDomainTarget)
-}
-func (x fastReflection_CustomDomainTarget_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CustomDomainTarget
-}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GraceEndsAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Debt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Debt = append(x.Debt, &types.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Debt[len(x.Debt)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 19:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Disputed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Disputed = bool(v != 0)
+			case 20:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalSettled", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalSettled = append(x.TotalSettled, &types.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalSettled[len(x.TotalSettled)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 21:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccrualCarry", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AccrualCarry = append(x.AccrualCarry, &types.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AccrualCarry[len(x.AccrualCarry)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_CustomDomainTarget) Descriptor() protoreflect.MessageDescriptor {
-	return md_CustomDomainTarget
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CustomDomainTarget) Type() protoreflect.MessageType {
-	return _fastReflection_CustomDomainTarget_messageType
+var _ protoreflect.List = (*_LeaseAmendment_4_list)(nil)
+
+type _LeaseAmendment_4_list struct {
+	list *[]*LeaseItem
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CustomDomainTarget) New() protoreflect.Message {
-	return new(fastReflection_CustomDomainTarget)
+func (x *_LeaseAmendment_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_CustomDomainTarget) Interface() protoreflect.ProtoMessage {
-	return (*CustomDomainTarget)(x)
+func (x *_LeaseAmendment_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_CustomDomainTarget) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.LeaseUuid != "" {
-		value := protoreflect.ValueOfString(x.LeaseUuid)
-		if !f(fd_CustomDomainTarget_lease_uuid, value) {
-			return
-		}
-	}
-	if x.ServiceName != "" {
-		value := protoreflect.ValueOfString(x.ServiceName)
-		if !f(fd_CustomDomainTarget_service_name, value) {
-			return
-		}
-	}
+func (x *_LeaseAmendment_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LeaseItem)
+	(*x.list)[i] = concreteValue
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_CustomDomainTarget) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "liftedinit.billing.v1.CustomDomainTarget.lease_uuid":
-		return x.LeaseUuid != ""
-	case "liftedinit.billing.v1.CustomDomainTarget.service_name":
-		return x.ServiceName != ""
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CustomDomainTarget"))
-		}
-		panic(fmt.Errorf("message liftedinit.billing.v1.CustomDomainTarget does not contain field %s", fd.FullName()))
-	}
+func (x *_LeaseAmendment_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LeaseItem)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CustomDomainTarget) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "liftedinit.billing.v1.CustomDomainTarget.lease_uuid":
-		x.LeaseUuid = ""
-	case "liftedinit.billing.v1.CustomDomainTarget.service_name":
-		x.ServiceName = ""
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CustomDomainTarget"))
-		}
-		panic(fmt.Errorf("message liftedinit.billing.v1.CustomDomainTarget does not contain field %s", fd.FullName()))
-	}
+func (x *_LeaseAmendment_4_list) AppendMutable() protoreflect.Value {
+	v := new(LeaseItem)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CustomDomainTarget) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "liftedinit.billing.v1.CustomDomainTarget.lease_uuid":
-		value := x.LeaseUuid
-		return protoreflect.ValueOfString(value)
-	case "liftedinit.billing.v1.CustomDomainTarget.service_name":
-		value := x.ServiceName
-		return protoreflect.ValueOfString(value)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CustomDomainTarget"))
-		}
-		panic(fmt.Errorf("message liftedinit.billing.v1.CustomDomainTarget does not contain field %s", descriptor.FullName()))
+func (x *_LeaseAmendment_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_LeaseAmendment_4_list) NewElement() protoreflect.Value {
+	v := new(LeaseItem)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LeaseAmendment_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_LeaseAmendment_5_list)(nil)
+
+type _LeaseAmendment_5_list struct {
+	list *[]string
+}
+
+func (x *_LeaseAmendment_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_LeaseAmendment_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_LeaseAmendment_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_LeaseAmendment_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_LeaseAmendment_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message LeaseAmendment at list field RemoveItems as it is not of Message kind"))
+}
+
+func (x *_LeaseAmendment_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_LeaseAmendment_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_LeaseAmendment_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_LeaseAmendment_6_list)(nil)
+
+type _LeaseAmendment_6_list struct {
+	list *[]*types.Coin
+}
+
+func (x *_LeaseAmendment_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_LeaseAmendment_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_LeaseAmendment_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_LeaseAmendment_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_LeaseAmendment_6_list) AppendMutable() protoreflect.Value {
+	v := new(types.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LeaseAmendment_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_LeaseAmendment_6_list) NewElement() protoreflect.Value {
+	v := new(types.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LeaseAmendment_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_LeaseAmendment                  protoreflect.MessageDescriptor
+	fd_LeaseAmendment_lease_uuid       protoreflect.FieldDescriptor
+	fd_LeaseAmendment_tenant           protoreflect.FieldDescriptor
+	fd_LeaseAmendment_provider_uuid    protoreflect.FieldDescriptor
+	fd_LeaseAmendment_add_items        protoreflect.FieldDescriptor
+	fd_LeaseAmendment_remove_items     protoreflect.FieldDescriptor
+	fd_LeaseAmendment_reserved_amounts protoreflect.FieldDescriptor
+	fd_LeaseAmendment_created_at       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_liftedinit_billing_v1_types_proto_init()
+	md_LeaseAmendment = File_liftedinit_billing_v1_types_proto.Messages().ByName("LeaseAmendment")
+	fd_LeaseAmendment_lease_uuid = md_LeaseAmendment.Fields().ByName("lease_uuid")
+	fd_LeaseAmendment_tenant = md_LeaseAmendment.Fields().ByName("tenant")
+	fd_LeaseAmendment_provider_uuid = md_LeaseAmendment.Fields().ByName("provider_uuid")
+	fd_LeaseAmendment_add_items = md_LeaseAmendment.Fields().ByName("add_items")
+	fd_LeaseAmendment_remove_items = md_LeaseAmendment.Fields().ByName("remove_items")
+	fd_LeaseAmendment_reserved_amounts = md_LeaseAmendment.Fields().ByName("reserved_amounts")
+	fd_LeaseAmendment_created_at = md_LeaseAmendment.Fields().ByName("created_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_LeaseAmendment)(nil)
+
+type fastReflection_LeaseAmendment LeaseAmendment
+
+func (x *LeaseAmendment) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LeaseAmendment)(x)
+}
+
+func (x *LeaseAmendment) slowProtoReflect() protoreflect.Message {
+	mi := &file_liftedinit_billing_v1_types_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LeaseAmendment_messageType fastReflection_LeaseAmendment_messageType
+var _ protoreflect.MessageType = fastReflection_LeaseAmendment_messageType{}
+
+type fastReflection_LeaseAmendment_messageType struct{}
+
+func (x fastReflection_LeaseAmendment_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LeaseAmendment)(nil)
+}
+func (x fastReflection_LeaseAmendment_messageType) New() protoreflect.Message {
+	return new(fastReflection_LeaseAmendment)
+}
+func (x fastReflection_LeaseAmendment_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LeaseAmendment
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LeaseAmendment) Descriptor() protoreflect.MessageDescriptor {
+	return md_LeaseAmendment
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LeaseAmendment) Type() protoreflect.MessageType {
+	return _fastReflection_LeaseAmendment_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LeaseAmendment) New() protoreflect.Message {
+	return new(fastReflection_LeaseAmendment)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LeaseAmendment) Interface() protoreflect.ProtoMessage {
+	return (*LeaseAmendment)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LeaseAmendment) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LeaseUuid != "" {
+		value := protoreflect.ValueOfString(x.LeaseUuid)
+		if !f(fd_LeaseAmendment_lease_uuid, value) {
+			return
+		}
+	}
+	if x.Tenant != "" {
+		value := protoreflect.ValueOfString(x.Tenant)
+		if !f(fd_LeaseAmendment_tenant, value) {
+			return
+		}
+	}
+	if x.ProviderUuid != "" {
+		value := protoreflect.ValueOfString(x.ProviderUuid)
+		if !f(fd_LeaseAmendment_provider_uuid, value) {
+			return
+		}
+	}
+	if len(x.AddItems) != 0 {
+		value := protoreflect.ValueOfList(&_LeaseAmendment_4_list{list: &x.AddItems})
+		if !f(fd_LeaseAmendment_add_items, value) {
+			return
+		}
+	}
+	if len(x.RemoveItems) != 0 {
+		value := protoreflect.ValueOfList(&_LeaseAmendment_5_list{list: &x.RemoveItems})
+		if !f(fd_LeaseAmendment_remove_items, value) {
+			return
+		}
+	}
+	if len(x.ReservedAmounts) != 0 {
+		value := protoreflect.ValueOfList(&_LeaseAmendment_6_list{list: &x.ReservedAmounts})
+		if !f(fd_LeaseAmendment_reserved_amounts, value) {
+			return
+		}
+	}
+	if x.CreatedAt != nil {
+		value := protoreflect.ValueOfMessage(x.CreatedAt.ProtoReflect())
+		if !f(fd_LeaseAmendment_created_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LeaseAmendment) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.LeaseAmendment.lease_uuid":
+		return x.LeaseUuid != ""
+	case "liftedinit.billing.v1.LeaseAmendment.tenant":
+		return x.Tenant != ""
+	case "liftedinit.billing.v1.LeaseAmendment.provider_uuid":
+		return x.ProviderUuid != ""
+	case "liftedinit.billing.v1.LeaseAmendment.add_items":
+		return len(x.AddItems) != 0
+	case "liftedinit.billing.v1.LeaseAmendment.remove_items":
+		return len(x.RemoveItems) != 0
+	case "liftedinit.billing.v1.LeaseAmendment.reserved_amounts":
+		return len(x.ReservedAmounts) != 0
+	case "liftedinit.billing.v1.LeaseAmendment.created_at":
+		return x.CreatedAt != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.LeaseAmendment"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.LeaseAmendment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LeaseAmendment) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.LeaseAmendment.lease_uuid":
+		x.LeaseUuid = ""
+	case "liftedinit.billing.v1.LeaseAmendment.tenant":
+		x.Tenant = ""
+	case "liftedinit.billing.v1.LeaseAmendment.provider_uuid":
+		x.ProviderUuid = ""
+	case "liftedinit.billing.v1.LeaseAmendment.add_items":
+		x.AddItems = nil
+	case "liftedinit.billing.v1.LeaseAmendment.remove_items":
+		x.RemoveItems = nil
+	case "liftedinit.billing.v1.LeaseAmendment.reserved_amounts":
+		x.ReservedAmounts = nil
+	case "liftedinit.billing.v1.LeaseAmendment.created_at":
+		x.CreatedAt = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.LeaseAmendment"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.LeaseAmendment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LeaseAmendment) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "liftedinit.billing.v1.LeaseAmendment.lease_uuid":
+		value := x.LeaseUuid
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.billing.v1.LeaseAmendment.tenant":
+		value := x.Tenant
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.billing.v1.LeaseAmendment.provider_uuid":
+		value := x.ProviderUuid
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.billing.v1.LeaseAmendment.add_items":
+		if len(x.AddItems) == 0 {
+			return protoreflect.ValueOfList(&_LeaseAmendment_4_list{})
+		}
+		listValue := &_LeaseAmendment_4_list{list: &x.AddItems}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.LeaseAmendment.remove_items":
+		if len(x.RemoveItems) == 0 {
+			return protoreflect.ValueOfList(&_LeaseAmendment_5_list{})
+		}
+		listValue := &_LeaseAmendment_5_list{list: &x.RemoveItems}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.LeaseAmendment.reserved_amounts":
+		if len(x.ReservedAmounts) == 0 {
+			return protoreflect.ValueOfList(&_LeaseAmendment_6_list{})
+		}
+		listValue := &_LeaseAmendment_6_list{list: &x.ReservedAmounts}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.LeaseAmendment.created_at":
+		value := x.CreatedAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.LeaseAmendment"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.LeaseAmendment does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3155,17 +4704,33 @@ func (x *fastReflection_CustomDomainTarget) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CustomDomainTarget) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_LeaseAmendment) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "liftedinit.billing.v1.CustomDomainTarget.lease_uuid":
+	case "liftedinit.billing.v1.LeaseAmendment.lease_uuid":
 		x.LeaseUuid = value.Interface().(string)
-	case "liftedinit.billing.v1.CustomDomainTarget.service_name":
-		x.ServiceName = value.Interface().(string)
+	case "liftedinit.billing.v1.LeaseAmendment.tenant":
+		x.Tenant = value.Interface().(string)
+	case "liftedinit.billing.v1.LeaseAmendment.provider_uuid":
+		x.ProviderUuid = value.Interface().(string)
+	case "liftedinit.billing.v1.LeaseAmendment.add_items":
+		lv := value.List()
+		clv := lv.(*_LeaseAmendment_4_list)
+		x.AddItems = *clv.list
+	case "liftedinit.billing.v1.LeaseAmendment.remove_items":
+		lv := value.List()
+		clv := lv.(*_LeaseAmendment_5_list)
+		x.RemoveItems = *clv.list
+	case "liftedinit.billing.v1.LeaseAmendment.reserved_amounts":
+		lv := value.List()
+		clv := lv.(*_LeaseAmendment_6_list)
+		x.ReservedAmounts = *clv.list
+	case "liftedinit.billing.v1.LeaseAmendment.created_at":
+		x.CreatedAt = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CustomDomainTarget"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.LeaseAmendment"))
 		}
-		panic(fmt.Errorf("message liftedinit.billing.v1.CustomDomainTarget does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message liftedinit.billing.v1.LeaseAmendment does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3179,44 +4744,83 @@ func (x *fastReflection_CustomDomainTarget) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CustomDomainTarget) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LeaseAmendment) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "liftedinit.billing.v1.CustomDomainTarget.lease_uuid":
-		panic(fmt.Errorf("field lease_uuid of message liftedinit.billing.v1.CustomDomainTarget is not mutable"))
-	case "liftedinit.billing.v1.CustomDomainTarget.service_name":
-		panic(fmt.Errorf("field service_name of message liftedinit.billing.v1.CustomDomainTarget is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CustomDomainTarget"))
+	case "liftedinit.billing.v1.LeaseAmendment.add_items":
+		if x.AddItems == nil {
+			x.AddItems = []*LeaseItem{}
 		}
-		panic(fmt.Errorf("message liftedinit.billing.v1.CustomDomainTarget does not contain field %s", fd.FullName()))
-	}
+		value := &_LeaseAmendment_4_list{list: &x.AddItems}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.LeaseAmendment.remove_items":
+		if x.RemoveItems == nil {
+			x.RemoveItems = []string{}
+		}
+		value := &_LeaseAmendment_5_list{list: &x.RemoveItems}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.LeaseAmendment.reserved_amounts":
+		if x.ReservedAmounts == nil {
+			x.ReservedAmounts = []*types.Coin{}
+		}
+		value := &_LeaseAmendment_6_list{list: &x.ReservedAmounts}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.LeaseAmendment.created_at":
+		if x.CreatedAt == nil {
+			x.CreatedAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.CreatedAt.ProtoReflect())
+	case "liftedinit.billing.v1.LeaseAmendment.lease_uuid":
+		panic(fmt.Errorf("field lease_uuid of message liftedinit.billing.v1.LeaseAmendment is not mutable"))
+	case "liftedinit.billing.v1.LeaseAmendment.tenant":
+		panic(fmt.Errorf("field tenant of message liftedinit.billing.v1.LeaseAmendment is not mutable"))
+	case "liftedinit.billing.v1.LeaseAmendment.provider_uuid":
+		panic(fmt.Errorf("field provider_uuid of message liftedinit.billing.v1.LeaseAmendment is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.LeaseAmendment"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.LeaseAmendment does not contain field %s", fd.FullName()))
+	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CustomDomainTarget) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LeaseAmendment) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "liftedinit.billing.v1.CustomDomainTarget.lease_uuid":
+	case "liftedinit.billing.v1.LeaseAmendment.lease_uuid":
 		return protoreflect.ValueOfString("")
-	case "liftedinit.billing.v1.CustomDomainTarget.service_name":
+	case "liftedinit.billing.v1.LeaseAmendment.tenant":
 		return protoreflect.ValueOfString("")
+	case "liftedinit.billing.v1.LeaseAmendment.provider_uuid":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.billing.v1.LeaseAmendment.add_items":
+		list := []*LeaseItem{}
+		return protoreflect.ValueOfList(&_LeaseAmendment_4_list{list: &list})
+	case "liftedinit.billing.v1.LeaseAmendment.remove_items":
+		list := []string{}
+		return protoreflect.ValueOfList(&_LeaseAmendment_5_list{list: &list})
+	case "liftedinit.billing.v1.LeaseAmendment.reserved_amounts":
+		list := []*types.Coin{}
+		return protoreflect.ValueOfList(&_LeaseAmendment_6_list{list: &list})
+	case "liftedinit.billing.v1.LeaseAmendment.created_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CustomDomainTarget"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.LeaseAmendment"))
 		}
-		panic(fmt.Errorf("message liftedinit.billing.v1.CustomDomainTarget does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message liftedinit.billing.v1.LeaseAmendment does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CustomDomainTarget) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_LeaseAmendment) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in liftedinit.billing.v1.CustomDomainTarget", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in liftedinit.billing.v1.LeaseAmendment", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3224,7 +4828,7 @@ func (x *fastReflection_CustomDomainTarget) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CustomDomainTarget) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_LeaseAmendment) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3235,7 +4839,7 @@ func (x *fastReflection_CustomDomainTarget) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CustomDomainTarget) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_LeaseAmendment) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3247,7 +4851,7 @@ func (x *fastReflection_CustomDomainTarget) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CustomDomainTarget) IsValid() bool {
+func (x *fastReflection_LeaseAmendment) IsValid() bool {
 	return x != nil
 }
 
@@ -3257,9 +4861,9 @@ func (x *fastReflection_CustomDomainTarget) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CustomDomainTarget) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_LeaseAmendment) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CustomDomainTarget)
+		x := input.Message.Interface().(*LeaseAmendment)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3275,10 +4879,36 @@ func (x *fastReflection_CustomDomainTarget) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ServiceName)
+		l = len(x.Tenant)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ProviderUuid)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.AddItems) > 0 {
+			for _, e := range x.AddItems {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RemoveItems) > 0 {
+			for _, s := range x.RemoveItems {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ReservedAmounts) > 0 {
+			for _, e := range x.ReservedAmounts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.CreatedAt != nil {
+			l = options.Size(x.CreatedAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3289,7 +4919,7 @@ func (x *fastReflection_CustomDomainTarget) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CustomDomainTarget)
+		x := input.Message.Interface().(*LeaseAmendment)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3308,10 +4938,72 @@ func (x *fastReflection_CustomDomainTarget) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ServiceName) > 0 {
-			i -= len(x.ServiceName)
-			copy(dAtA[i:], x.ServiceName)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ServiceName)))
+		if x.CreatedAt != nil {
+			encoded, err := options.Marshal(x.CreatedAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.ReservedAmounts) > 0 {
+			for iNdEx := len(x.ReservedAmounts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ReservedAmounts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.RemoveItems) > 0 {
+			for iNdEx := len(x.RemoveItems) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RemoveItems[iNdEx])
+				copy(dAtA[i:], x.RemoveItems[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RemoveItems[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.AddItems) > 0 {
+			for iNdEx := len(x.AddItems) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AddItems[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.ProviderUuid) > 0 {
+			i -= len(x.ProviderUuid)
+			copy(dAtA[i:], x.ProviderUuid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProviderUuid)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Tenant) > 0 {
+			i -= len(x.Tenant)
+			copy(dAtA[i:], x.Tenant)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tenant)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -3333,7 +5025,7 @@ func (x *fastReflection_CustomDomainTarget) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CustomDomainTarget)
+		x := input.Message.Interface().(*LeaseAmendment)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3365,10 +5057,10 @@ func (x *fastReflection_CustomDomainTarget) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CustomDomainTarget: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LeaseAmendment: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CustomDomainTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LeaseAmendment: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -3405,7 +5097,7 @@ func (x *fastReflection_CustomDomainTarget) ProtoMethods() *protoiface.Methods {
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3433,122 +5125,235 @@ func (x *fastReflection_CustomDomainTarget) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ServiceName = string(dAtA[iNdEx:postIndex])
+				x.Tenant = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProviderUuid", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProviderUuid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddItems", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AddItems = append(x.AddItems, &LeaseItem{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AddItems[len(x.AddItems)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemoveItems", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.RemoveItems = append(x.RemoveItems, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReservedAmounts", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var _ protoreflect.List = (*_CreditAccount_5_list)(nil)
-
-type _CreditAccount_5_list struct {
-	list *[]*types.Coin
-}
-
-func (x *_CreditAccount_5_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_CreditAccount_5_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_CreditAccount_5_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*types.Coin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_CreditAccount_5_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*types.Coin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_CreditAccount_5_list) AppendMutable() protoreflect.Value {
-	v := new(types.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReservedAmounts = append(x.ReservedAmounts, &types.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReservedAmounts[len(x.ReservedAmounts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CreatedAt == nil {
+					x.CreatedAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreatedAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
 
-func (x *_CreditAccount_5_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
 	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_CreditAccount_5_list) NewElement() protoreflect.Value {
-	v := new(types.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_CreditAccount_5_list) IsValid() bool {
-	return x.list != nil
 }
 
 var (
-	md_CreditAccount                     protoreflect.MessageDescriptor
-	fd_CreditAccount_tenant              protoreflect.FieldDescriptor
-	fd_CreditAccount_credit_address      protoreflect.FieldDescriptor
-	fd_CreditAccount_active_lease_count  protoreflect.FieldDescriptor
-	fd_CreditAccount_pending_lease_count protoreflect.FieldDescriptor
-	fd_CreditAccount_reserved_amounts    protoreflect.FieldDescriptor
+	md_UsageCounter              protoreflect.MessageDescriptor
+	fd_UsageCounter_sku_uuid     protoreflect.FieldDescriptor
+	fd_UsageCounter_service_name protoreflect.FieldDescriptor
+	fd_UsageCounter_counter      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_liftedinit_billing_v1_types_proto_init()
-	md_CreditAccount = File_liftedinit_billing_v1_types_proto.Messages().ByName("CreditAccount")
-	fd_CreditAccount_tenant = md_CreditAccount.Fields().ByName("tenant")
-	fd_CreditAccount_credit_address = md_CreditAccount.Fields().ByName("credit_address")
-	fd_CreditAccount_active_lease_count = md_CreditAccount.Fields().ByName("active_lease_count")
-	fd_CreditAccount_pending_lease_count = md_CreditAccount.Fields().ByName("pending_lease_count")
-	fd_CreditAccount_reserved_amounts = md_CreditAccount.Fields().ByName("reserved_amounts")
+	md_UsageCounter = File_liftedinit_billing_v1_types_proto.Messages().ByName("UsageCounter")
+	fd_UsageCounter_sku_uuid = md_UsageCounter.Fields().ByName("sku_uuid")
+	fd_UsageCounter_service_name = md_UsageCounter.Fields().ByName("service_name")
+	fd_UsageCounter_counter = md_UsageCounter.Fields().ByName("counter")
 }
 
-var _ protoreflect.Message = (*fastReflection_CreditAccount)(nil)
+var _ protoreflect.Message = (*fastReflection_UsageCounter)(nil)
 
-type fastReflection_CreditAccount CreditAccount
+type fastReflection_UsageCounter UsageCounter
 
-func (x *CreditAccount) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CreditAccount)(x)
+func (x *UsageCounter) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_UsageCounter)(x)
 }
 
-func (x *CreditAccount) slowProtoReflect() protoreflect.Message {
+func (x *UsageCounter) slowProtoReflect() protoreflect.Message {
 	mi := &file_liftedinit_billing_v1_types_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3560,43 +5365,43 @@ func (x *CreditAccount) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CreditAccount_messageType fastReflection_CreditAccount_messageType
-var _ protoreflect.MessageType = fastReflection_CreditAccount_messageType{}
+var _fastReflection_UsageCounter_messageType fastReflection_UsageCounter_messageType
+var _ protoreflect.MessageType = fastReflection_UsageCounter_messageType{}
 
-type fastReflection_CreditAccount_messageType struct{}
+type fastReflection_UsageCounter_messageType struct{}
 
-func (x fastReflection_CreditAccount_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CreditAccount)(nil)
+func (x fastReflection_UsageCounter_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_UsageCounter)(nil)
 }
-func (x fastReflection_CreditAccount_messageType) New() protoreflect.Message {
-	return new(fastReflection_CreditAccount)
+func (x fastReflection_UsageCounter_messageType) New() protoreflect.Message {
+	return new(fastReflection_UsageCounter)
 }
-func (x fastReflection_CreditAccount_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CreditAccount
+func (x fastReflection_UsageCounter_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_UsageCounter
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CreditAccount) Descriptor() protoreflect.MessageDescriptor {
-	return md_CreditAccount
+func (x *fastReflection_UsageCounter) Descriptor() protoreflect.MessageDescriptor {
+	return md_UsageCounter
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CreditAccount) Type() protoreflect.MessageType {
-	return _fastReflection_CreditAccount_messageType
+func (x *fastReflection_UsageCounter) Type() protoreflect.MessageType {
+	return _fastReflection_UsageCounter_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CreditAccount) New() protoreflect.Message {
-	return new(fastReflection_CreditAccount)
+func (x *fastReflection_UsageCounter) New() protoreflect.Message {
+	return new(fastReflection_UsageCounter)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CreditAccount) Interface() protoreflect.ProtoMessage {
-	return (*CreditAccount)(x)
+func (x *fastReflection_UsageCounter) Interface() protoreflect.ProtoMessage {
+	return (*UsageCounter)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3604,34 +5409,22 @@ func (x *fastReflection_CreditAccount) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CreditAccount) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Tenant != "" {
-		value := protoreflect.ValueOfString(x.Tenant)
-		if !f(fd_CreditAccount_tenant, value) {
-			return
-		}
-	}
-	if x.CreditAddress != "" {
-		value := protoreflect.ValueOfString(x.CreditAddress)
-		if !f(fd_CreditAccount_credit_address, value) {
-			return
-		}
-	}
-	if x.ActiveLeaseCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ActiveLeaseCount)
-		if !f(fd_CreditAccount_active_lease_count, value) {
+func (x *fastReflection_UsageCounter) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SkuUuid != "" {
+		value := protoreflect.ValueOfString(x.SkuUuid)
+		if !f(fd_UsageCounter_sku_uuid, value) {
 			return
 		}
 	}
-	if x.PendingLeaseCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.PendingLeaseCount)
-		if !f(fd_CreditAccount_pending_lease_count, value) {
+	if x.ServiceName != "" {
+		value := protoreflect.ValueOfString(x.ServiceName)
+		if !f(fd_UsageCounter_service_name, value) {
 			return
 		}
 	}
-	if len(x.ReservedAmounts) != 0 {
-		value := protoreflect.ValueOfList(&_CreditAccount_5_list{list: &x.ReservedAmounts})
-		if !f(fd_CreditAccount_reserved_amounts, value) {
+	if x.Counter != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Counter)
+		if !f(fd_UsageCounter_counter, value) {
 			return
 		}
 	}
@@ -3648,23 +5441,19 @@ func (x *fastReflection_CreditAccount) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CreditAccount) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_UsageCounter) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "liftedinit.billing.v1.CreditAccount.tenant":
-		return x.Tenant != ""
-	case "liftedinit.billing.v1.CreditAccount.credit_address":
-		return x.CreditAddress != ""
-	case "liftedinit.billing.v1.CreditAccount.active_lease_count":
-		return x.ActiveLeaseCount != uint64(0)
-	case "liftedinit.billing.v1.CreditAccount.pending_lease_count":
-		return x.PendingLeaseCount != uint64(0)
-	case "liftedinit.billing.v1.CreditAccount.reserved_amounts":
-		return len(x.ReservedAmounts) != 0
+	case "liftedinit.billing.v1.UsageCounter.sku_uuid":
+		return x.SkuUuid != ""
+	case "liftedinit.billing.v1.UsageCounter.service_name":
+		return x.ServiceName != ""
+	case "liftedinit.billing.v1.UsageCounter.counter":
+		return x.Counter != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CreditAccount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.UsageCounter"))
 		}
-		panic(fmt.Errorf("message liftedinit.billing.v1.CreditAccount does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message liftedinit.billing.v1.UsageCounter does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3674,23 +5463,19 @@ func (x *fastReflection_CreditAccount) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CreditAccount) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_UsageCounter) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "liftedinit.billing.v1.CreditAccount.tenant":
-		x.Tenant = ""
-	case "liftedinit.billing.v1.CreditAccount.credit_address":
-		x.CreditAddress = ""
-	case "liftedinit.billing.v1.CreditAccount.active_lease_count":
-		x.ActiveLeaseCount = uint64(0)
-	case "liftedinit.billing.v1.CreditAccount.pending_lease_count":
-		x.PendingLeaseCount = uint64(0)
-	case "liftedinit.billing.v1.CreditAccount.reserved_amounts":
-		x.ReservedAmounts = nil
+	case "liftedinit.billing.v1.UsageCounter.sku_uuid":
+		x.SkuUuid = ""
+	case "liftedinit.billing.v1.UsageCounter.service_name":
+		x.ServiceName = ""
+	case "liftedinit.billing.v1.UsageCounter.counter":
+		x.Counter = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CreditAccount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.UsageCounter"))
 		}
-		panic(fmt.Errorf("message liftedinit.billing.v1.CreditAccount does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message liftedinit.billing.v1.UsageCounter does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3700,31 +5485,22 @@ func (x *fastReflection_CreditAccount) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CreditAccount) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_UsageCounter) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "liftedinit.billing.v1.CreditAccount.tenant":
-		value := x.Tenant
+	case "liftedinit.billing.v1.UsageCounter.sku_uuid":
+		value := x.SkuUuid
 		return protoreflect.ValueOfString(value)
-	case "liftedinit.billing.v1.CreditAccount.credit_address":
-		value := x.CreditAddress
+	case "liftedinit.billing.v1.UsageCounter.service_name":
+		value := x.ServiceName
 		return protoreflect.ValueOfString(value)
-	case "liftedinit.billing.v1.CreditAccount.active_lease_count":
-		value := x.ActiveLeaseCount
-		return protoreflect.ValueOfUint64(value)
-	case "liftedinit.billing.v1.CreditAccount.pending_lease_count":
-		value := x.PendingLeaseCount
+	case "liftedinit.billing.v1.UsageCounter.counter":
+		value := x.Counter
 		return protoreflect.ValueOfUint64(value)
-	case "liftedinit.billing.v1.CreditAccount.reserved_amounts":
-		if len(x.ReservedAmounts) == 0 {
-			return protoreflect.ValueOfList(&_CreditAccount_5_list{})
-		}
-		listValue := &_CreditAccount_5_list{list: &x.ReservedAmounts}
-		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CreditAccount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.UsageCounter"))
 		}
-		panic(fmt.Errorf("message liftedinit.billing.v1.CreditAccount does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message liftedinit.billing.v1.UsageCounter does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3738,25 +5514,19 @@ func (x *fastReflection_CreditAccount) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CreditAccount) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_UsageCounter) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "liftedinit.billing.v1.CreditAccount.tenant":
-		x.Tenant = value.Interface().(string)
-	case "liftedinit.billing.v1.CreditAccount.credit_address":
-		x.CreditAddress = value.Interface().(string)
-	case "liftedinit.billing.v1.CreditAccount.active_lease_count":
-		x.ActiveLeaseCount = value.Uint()
-	case "liftedinit.billing.v1.CreditAccount.pending_lease_count":
-		x.PendingLeaseCount = value.Uint()
-	case "liftedinit.billing.v1.CreditAccount.reserved_amounts":
-		lv := value.List()
-		clv := lv.(*_CreditAccount_5_list)
-		x.ReservedAmounts = *clv.list
-	default:
+	case "liftedinit.billing.v1.UsageCounter.sku_uuid":
+		x.SkuUuid = value.Interface().(string)
+	case "liftedinit.billing.v1.UsageCounter.service_name":
+		x.ServiceName = value.Interface().(string)
+	case "liftedinit.billing.v1.UsageCounter.counter":
+		x.Counter = value.Uint()
+	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CreditAccount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.UsageCounter"))
 		}
-		panic(fmt.Errorf("message liftedinit.billing.v1.CreditAccount does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message liftedinit.billing.v1.UsageCounter does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3770,61 +5540,48 @@ func (x *fastReflection_CreditAccount) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CreditAccount) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_UsageCounter) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "liftedinit.billing.v1.CreditAccount.reserved_amounts":
-		if x.ReservedAmounts == nil {
-			x.ReservedAmounts = []*types.Coin{}
-		}
-		value := &_CreditAccount_5_list{list: &x.ReservedAmounts}
-		return protoreflect.ValueOfList(value)
-	case "liftedinit.billing.v1.CreditAccount.tenant":
-		panic(fmt.Errorf("field tenant of message liftedinit.billing.v1.CreditAccount is not mutable"))
-	case "liftedinit.billing.v1.CreditAccount.credit_address":
-		panic(fmt.Errorf("field credit_address of message liftedinit.billing.v1.CreditAccount is not mutable"))
-	case "liftedinit.billing.v1.CreditAccount.active_lease_count":
-		panic(fmt.Errorf("field active_lease_count of message liftedinit.billing.v1.CreditAccount is not mutable"))
-	case "liftedinit.billing.v1.CreditAccount.pending_lease_count":
-		panic(fmt.Errorf("field pending_lease_count of message liftedinit.billing.v1.CreditAccount is not mutable"))
+	case "liftedinit.billing.v1.UsageCounter.sku_uuid":
+		panic(fmt.Errorf("field sku_uuid of message liftedinit.billing.v1.UsageCounter is not mutable"))
+	case "liftedinit.billing.v1.UsageCounter.service_name":
+		panic(fmt.Errorf("field service_name of message liftedinit.billing.v1.UsageCounter is not mutable"))
+	case "liftedinit.billing.v1.UsageCounter.counter":
+		panic(fmt.Errorf("field counter of message liftedinit.billing.v1.UsageCounter is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CreditAccount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.UsageCounter"))
 		}
-		panic(fmt.Errorf("message liftedinit.billing.v1.CreditAccount does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message liftedinit.billing.v1.UsageCounter does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CreditAccount) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_UsageCounter) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "liftedinit.billing.v1.CreditAccount.tenant":
+	case "liftedinit.billing.v1.UsageCounter.sku_uuid":
 		return protoreflect.ValueOfString("")
-	case "liftedinit.billing.v1.CreditAccount.credit_address":
+	case "liftedinit.billing.v1.UsageCounter.service_name":
 		return protoreflect.ValueOfString("")
-	case "liftedinit.billing.v1.CreditAccount.active_lease_count":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "liftedinit.billing.v1.CreditAccount.pending_lease_count":
+	case "liftedinit.billing.v1.UsageCounter.counter":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "liftedinit.billing.v1.CreditAccount.reserved_amounts":
-		list := []*types.Coin{}
-		return protoreflect.ValueOfList(&_CreditAccount_5_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CreditAccount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.UsageCounter"))
 		}
-		panic(fmt.Errorf("message liftedinit.billing.v1.CreditAccount does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message liftedinit.billing.v1.UsageCounter does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CreditAccount) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_UsageCounter) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in liftedinit.billing.v1.CreditAccount", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in liftedinit.billing.v1.UsageCounter", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3832,7 +5589,7 @@ func (x *fastReflection_CreditAccount) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CreditAccount) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_UsageCounter) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3843,7 +5600,7 @@ func (x *fastReflection_CreditAccount) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CreditAccount) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_UsageCounter) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3855,7 +5612,7 @@ func (x *fastReflection_CreditAccount) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CreditAccount) IsValid() bool {
+func (x *fastReflection_UsageCounter) IsValid() bool {
 	return x != nil
 }
 
@@ -3865,9 +5622,9 @@ func (x *fastReflection_CreditAccount) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CreditAccount) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_UsageCounter) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CreditAccount)
+		x := input.Message.Interface().(*UsageCounter)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3879,25 +5636,16 @@ func (x *fastReflection_CreditAccount) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Tenant)
+		l = len(x.SkuUuid)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.CreditAddress)
+		l = len(x.ServiceName)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.ActiveLeaseCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.ActiveLeaseCount))
-		}
-		if x.PendingLeaseCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.PendingLeaseCount))
-		}
-		if len(x.ReservedAmounts) > 0 {
-			for _, e := range x.ReservedAmounts {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.Counter != 0 {
+			n += 1 + runtime.Sov(uint64(x.Counter))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3909,7 +5657,7 @@ func (x *fastReflection_CreditAccount) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CreditAccount)
+		x := input.Message.Interface().(*UsageCounter)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3928,43 +5676,22 @@ func (x *fastReflection_CreditAccount) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ReservedAmounts) > 0 {
-			for iNdEx := len(x.ReservedAmounts) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.ReservedAmounts[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x2a
-			}
-		}
-		if x.PendingLeaseCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.PendingLeaseCount))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.ActiveLeaseCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ActiveLeaseCount))
+		if x.Counter != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Counter))
 			i--
 			dAtA[i] = 0x18
 		}
-		if len(x.CreditAddress) > 0 {
-			i -= len(x.CreditAddress)
-			copy(dAtA[i:], x.CreditAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CreditAddress)))
+		if len(x.ServiceName) > 0 {
+			i -= len(x.ServiceName)
+			copy(dAtA[i:], x.ServiceName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ServiceName)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Tenant) > 0 {
-			i -= len(x.Tenant)
-			copy(dAtA[i:], x.Tenant)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tenant)))
+		if len(x.SkuUuid) > 0 {
+			i -= len(x.SkuUuid)
+			copy(dAtA[i:], x.SkuUuid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SkuUuid)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3979,7 +5706,7 @@ func (x *fastReflection_CreditAccount) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CreditAccount)
+		x := input.Message.Interface().(*UsageCounter)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4011,15 +5738,15 @@ func (x *fastReflection_CreditAccount) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CreditAccount: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UsageCounter: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CreditAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UsageCounter: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SkuUuid", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4047,11 +5774,11 @@ func (x *fastReflection_CreditAccount) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Tenant = string(dAtA[iNdEx:postIndex])
+				x.SkuUuid = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreditAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4079,51 +5806,13 @@ func (x *fastReflection_CreditAccount) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CreditAddress = string(dAtA[iNdEx:postIndex])
+				x.ServiceName = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActiveLeaseCount", wireType)
-				}
-				x.ActiveLeaseCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ActiveLeaseCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingLeaseCount", wireType)
-				}
-				x.PendingLeaseCount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.PendingLeaseCount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReservedAmounts", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
 				}
-				var msglen int
+				x.Counter = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4133,26 +5822,11 @@ func (x *fastReflection_CreditAccount) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Counter |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ReservedAmounts = append(x.ReservedAmounts, &types.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReservedAmounts[len(x.ReservedAmounts)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4172,601 +5846,11718 @@ func (x *fastReflection_CreditAccount) ProtoMethods() *protoiface.Methods {
 			}
 		}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_LeaseUsage_2_list)(nil)
+
+type _LeaseUsage_2_list struct {
+	list *[]*UsageCounter
+}
+
+func (x *_LeaseUsage_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_LeaseUsage_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_LeaseUsage_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UsageCounter)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_LeaseUsage_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UsageCounter)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_LeaseUsage_2_list) AppendMutable() protoreflect.Value {
+	v := new(UsageCounter)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LeaseUsage_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_LeaseUsage_2_list) NewElement() protoreflect.Value {
+	v := new(UsageCounter)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LeaseUsage_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_LeaseUsage_3_list)(nil)
+
+type _LeaseUsage_3_list struct {
+	list *[]*types.Coin
+}
+
+func (x *_LeaseUsage_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_LeaseUsage_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_LeaseUsage_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_LeaseUsage_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_LeaseUsage_3_list) AppendMutable() protoreflect.Value {
+	v := new(types.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LeaseUsage_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_LeaseUsage_3_list) NewElement() protoreflect.Value {
+	v := new(types.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LeaseUsage_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_LeaseUsage_6_list)(nil)
+
+type _LeaseUsage_6_list struct {
+	list *[]*types.Coin
+}
+
+func (x *_LeaseUsage_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_LeaseUsage_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_LeaseUsage_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_LeaseUsage_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_LeaseUsage_6_list) AppendMutable() protoreflect.Value {
+	v := new(types.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LeaseUsage_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_LeaseUsage_6_list) NewElement() protoreflect.Value {
+	v := new(types.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LeaseUsage_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_LeaseUsage                   protoreflect.MessageDescriptor
+	fd_LeaseUsage_lease_uuid        protoreflect.FieldDescriptor
+	fd_LeaseUsage_counters          protoreflect.FieldDescriptor
+	fd_LeaseUsage_spend_limit       protoreflect.FieldDescriptor
+	fd_LeaseUsage_spend_period      protoreflect.FieldDescriptor
+	fd_LeaseUsage_period_started_at protoreflect.FieldDescriptor
+	fd_LeaseUsage_period_spent      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_liftedinit_billing_v1_types_proto_init()
+	md_LeaseUsage = File_liftedinit_billing_v1_types_proto.Messages().ByName("LeaseUsage")
+	fd_LeaseUsage_lease_uuid = md_LeaseUsage.Fields().ByName("lease_uuid")
+	fd_LeaseUsage_counters = md_LeaseUsage.Fields().ByName("counters")
+	fd_LeaseUsage_spend_limit = md_LeaseUsage.Fields().ByName("spend_limit")
+	fd_LeaseUsage_spend_period = md_LeaseUsage.Fields().ByName("spend_period")
+	fd_LeaseUsage_period_started_at = md_LeaseUsage.Fields().ByName("period_started_at")
+	fd_LeaseUsage_period_spent = md_LeaseUsage.Fields().ByName("period_spent")
+}
+
+var _ protoreflect.Message = (*fastReflection_LeaseUsage)(nil)
+
+type fastReflection_LeaseUsage LeaseUsage
+
+func (x *LeaseUsage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LeaseUsage)(x)
+}
+
+func (x *LeaseUsage) slowProtoReflect() protoreflect.Message {
+	mi := &file_liftedinit_billing_v1_types_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LeaseUsage_messageType fastReflection_LeaseUsage_messageType
+var _ protoreflect.MessageType = fastReflection_LeaseUsage_messageType{}
+
+type fastReflection_LeaseUsage_messageType struct{}
+
+func (x fastReflection_LeaseUsage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LeaseUsage)(nil)
+}
+func (x fastReflection_LeaseUsage_messageType) New() protoreflect.Message {
+	return new(fastReflection_LeaseUsage)
+}
+func (x fastReflection_LeaseUsage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LeaseUsage
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LeaseUsage) Descriptor() protoreflect.MessageDescriptor {
+	return md_LeaseUsage
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LeaseUsage) Type() protoreflect.MessageType {
+	return _fastReflection_LeaseUsage_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LeaseUsage) New() protoreflect.Message {
+	return new(fastReflection_LeaseUsage)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LeaseUsage) Interface() protoreflect.ProtoMessage {
+	return (*LeaseUsage)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LeaseUsage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LeaseUuid != "" {
+		value := protoreflect.ValueOfString(x.LeaseUuid)
+		if !f(fd_LeaseUsage_lease_uuid, value) {
+			return
+		}
+	}
+	if len(x.Counters) != 0 {
+		value := protoreflect.ValueOfList(&_LeaseUsage_2_list{list: &x.Counters})
+		if !f(fd_LeaseUsage_counters, value) {
+			return
+		}
+	}
+	if len(x.SpendLimit) != 0 {
+		value := protoreflect.ValueOfList(&_LeaseUsage_3_list{list: &x.SpendLimit})
+		if !f(fd_LeaseUsage_spend_limit, value) {
+			return
+		}
+	}
+	if x.SpendPeriod != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SpendPeriod)
+		if !f(fd_LeaseUsage_spend_period, value) {
+			return
+		}
+	}
+	if x.PeriodStartedAt != nil {
+		value := protoreflect.ValueOfMessage(x.PeriodStartedAt.ProtoReflect())
+		if !f(fd_LeaseUsage_period_started_at, value) {
+			return
+		}
+	}
+	if len(x.PeriodSpent) != 0 {
+		value := protoreflect.ValueOfList(&_LeaseUsage_6_list{list: &x.PeriodSpent})
+		if !f(fd_LeaseUsage_period_spent, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LeaseUsage) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.LeaseUsage.lease_uuid":
+		return x.LeaseUuid != ""
+	case "liftedinit.billing.v1.LeaseUsage.counters":
+		return len(x.Counters) != 0
+	case "liftedinit.billing.v1.LeaseUsage.spend_limit":
+		return len(x.SpendLimit) != 0
+	case "liftedinit.billing.v1.LeaseUsage.spend_period":
+		return x.SpendPeriod != uint64(0)
+	case "liftedinit.billing.v1.LeaseUsage.period_started_at":
+		return x.PeriodStartedAt != nil
+	case "liftedinit.billing.v1.LeaseUsage.period_spent":
+		return len(x.PeriodSpent) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.LeaseUsage"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.LeaseUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LeaseUsage) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.LeaseUsage.lease_uuid":
+		x.LeaseUuid = ""
+	case "liftedinit.billing.v1.LeaseUsage.counters":
+		x.Counters = nil
+	case "liftedinit.billing.v1.LeaseUsage.spend_limit":
+		x.SpendLimit = nil
+	case "liftedinit.billing.v1.LeaseUsage.spend_period":
+		x.SpendPeriod = uint64(0)
+	case "liftedinit.billing.v1.LeaseUsage.period_started_at":
+		x.PeriodStartedAt = nil
+	case "liftedinit.billing.v1.LeaseUsage.period_spent":
+		x.PeriodSpent = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.LeaseUsage"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.LeaseUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LeaseUsage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "liftedinit.billing.v1.LeaseUsage.lease_uuid":
+		value := x.LeaseUuid
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.billing.v1.LeaseUsage.counters":
+		if len(x.Counters) == 0 {
+			return protoreflect.ValueOfList(&_LeaseUsage_2_list{})
+		}
+		listValue := &_LeaseUsage_2_list{list: &x.Counters}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.LeaseUsage.spend_limit":
+		if len(x.SpendLimit) == 0 {
+			return protoreflect.ValueOfList(&_LeaseUsage_3_list{})
+		}
+		listValue := &_LeaseUsage_3_list{list: &x.SpendLimit}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.LeaseUsage.spend_period":
+		value := x.SpendPeriod
+		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.billing.v1.LeaseUsage.period_started_at":
+		value := x.PeriodStartedAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "liftedinit.billing.v1.LeaseUsage.period_spent":
+		if len(x.PeriodSpent) == 0 {
+			return protoreflect.ValueOfList(&_LeaseUsage_6_list{})
+		}
+		listValue := &_LeaseUsage_6_list{list: &x.PeriodSpent}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.LeaseUsage"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.LeaseUsage does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LeaseUsage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.LeaseUsage.lease_uuid":
+		x.LeaseUuid = value.Interface().(string)
+	case "liftedinit.billing.v1.LeaseUsage.counters":
+		lv := value.List()
+		clv := lv.(*_LeaseUsage_2_list)
+		x.Counters = *clv.list
+	case "liftedinit.billing.v1.LeaseUsage.spend_limit":
+		lv := value.List()
+		clv := lv.(*_LeaseUsage_3_list)
+		x.SpendLimit = *clv.list
+	case "liftedinit.billing.v1.LeaseUsage.spend_period":
+		x.SpendPeriod = value.Uint()
+	case "liftedinit.billing.v1.LeaseUsage.period_started_at":
+		x.PeriodStartedAt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "liftedinit.billing.v1.LeaseUsage.period_spent":
+		lv := value.List()
+		clv := lv.(*_LeaseUsage_6_list)
+		x.PeriodSpent = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.LeaseUsage"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.LeaseUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LeaseUsage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.LeaseUsage.counters":
+		if x.Counters == nil {
+			x.Counters = []*UsageCounter{}
+		}
+		value := &_LeaseUsage_2_list{list: &x.Counters}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.LeaseUsage.spend_limit":
+		if x.SpendLimit == nil {
+			x.SpendLimit = []*types.Coin{}
+		}
+		value := &_LeaseUsage_3_list{list: &x.SpendLimit}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.LeaseUsage.period_started_at":
+		if x.PeriodStartedAt == nil {
+			x.PeriodStartedAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.PeriodStartedAt.ProtoReflect())
+	case "liftedinit.billing.v1.LeaseUsage.period_spent":
+		if x.PeriodSpent == nil {
+			x.PeriodSpent = []*types.Coin{}
+		}
+		value := &_LeaseUsage_6_list{list: &x.PeriodSpent}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.LeaseUsage.lease_uuid":
+		panic(fmt.Errorf("field lease_uuid of message liftedinit.billing.v1.LeaseUsage is not mutable"))
+	case "liftedinit.billing.v1.LeaseUsage.spend_period":
+		panic(fmt.Errorf("field spend_period of message liftedinit.billing.v1.LeaseUsage is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.LeaseUsage"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.LeaseUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LeaseUsage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.LeaseUsage.lease_uuid":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.billing.v1.LeaseUsage.counters":
+		list := []*UsageCounter{}
+		return protoreflect.ValueOfList(&_LeaseUsage_2_list{list: &list})
+	case "liftedinit.billing.v1.LeaseUsage.spend_limit":
+		list := []*types.Coin{}
+		return protoreflect.ValueOfList(&_LeaseUsage_3_list{list: &list})
+	case "liftedinit.billing.v1.LeaseUsage.spend_period":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.billing.v1.LeaseUsage.period_started_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "liftedinit.billing.v1.LeaseUsage.period_spent":
+		list := []*types.Coin{}
+		return protoreflect.ValueOfList(&_LeaseUsage_6_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.LeaseUsage"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.LeaseUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LeaseUsage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in liftedinit.billing.v1.LeaseUsage", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LeaseUsage) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LeaseUsage) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LeaseUsage) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LeaseUsage) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*LeaseUsage)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.LeaseUuid)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Counters) > 0 {
+			for _, e := range x.Counters {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.SpendLimit) > 0 {
+			for _, e := range x.SpendLimit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.SpendPeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.SpendPeriod))
+		}
+		if x.PeriodStartedAt != nil {
+			l = options.Size(x.PeriodStartedAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.PeriodSpent) > 0 {
+			for _, e := range x.PeriodSpent {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*LeaseUsage)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.PeriodSpent) > 0 {
+			for iNdEx := len(x.PeriodSpent) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PeriodSpent[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.PeriodStartedAt != nil {
+			encoded, err := options.Marshal(x.PeriodStartedAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.SpendPeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SpendPeriod))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.SpendLimit) > 0 {
+			for iNdEx := len(x.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SpendLimit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Counters) > 0 {
+			for iNdEx := len(x.Counters) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Counters[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.LeaseUuid) > 0 {
+			i -= len(x.LeaseUuid)
+			copy(dAtA[i:], x.LeaseUuid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LeaseUuid)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*LeaseUsage)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LeaseUsage: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LeaseUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LeaseUuid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Counters", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Counters = append(x.Counters, &UsageCounter{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Counters[len(x.Counters)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SpendLimit = append(x.SpendLimit, &types.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SpendLimit[len(x.SpendLimit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpendPeriod", wireType)
+				}
+				x.SpendPeriod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SpendPeriod |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PeriodStartedAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PeriodStartedAt == nil {
+					x.PeriodStartedAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PeriodStartedAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PeriodSpent", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PeriodSpent = append(x.PeriodSpent, &types.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PeriodSpent[len(x.PeriodSpent)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_LeaseSettlement_7_list)(nil)
+
+type _LeaseSettlement_7_list struct {
+	list *[]*types.Coin
+}
+
+func (x *_LeaseSettlement_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_LeaseSettlement_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_LeaseSettlement_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_LeaseSettlement_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_LeaseSettlement_7_list) AppendMutable() protoreflect.Value {
+	v := new(types.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LeaseSettlement_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_LeaseSettlement_7_list) NewElement() protoreflect.Value {
+	v := new(types.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LeaseSettlement_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_LeaseSettlement_8_list)(nil)
+
+type _LeaseSettlement_8_list struct {
+	list *[]*types.Coin
+}
+
+func (x *_LeaseSettlement_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_LeaseSettlement_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_LeaseSettlement_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_LeaseSettlement_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_LeaseSettlement_8_list) AppendMutable() protoreflect.Value {
+	v := new(types.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LeaseSettlement_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_LeaseSettlement_8_list) NewElement() protoreflect.Value {
+	v := new(types.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LeaseSettlement_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_LeaseSettlement_9_list)(nil)
+
+type _LeaseSettlement_9_list struct {
+	list *[]*types.Coin
+}
+
+func (x *_LeaseSettlement_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_LeaseSettlement_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_LeaseSettlement_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_LeaseSettlement_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_LeaseSettlement_9_list) AppendMutable() protoreflect.Value {
+	v := new(types.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LeaseSettlement_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_LeaseSettlement_9_list) NewElement() protoreflect.Value {
+	v := new(types.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LeaseSettlement_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_LeaseSettlement_10_list)(nil)
+
+type _LeaseSettlement_10_list struct {
+	list *[]*types.Coin
+}
+
+func (x *_LeaseSettlement_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_LeaseSettlement_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_LeaseSettlement_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_LeaseSettlement_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_LeaseSettlement_10_list) AppendMutable() protoreflect.Value {
+	v := new(types.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LeaseSettlement_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_LeaseSettlement_10_list) NewElement() protoreflect.Value {
+	v := new(types.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LeaseSettlement_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_LeaseSettlement_11_list)(nil)
+
+type _LeaseSettlement_11_list struct {
+	list *[]*types.Coin
+}
+
+func (x *_LeaseSettlement_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_LeaseSettlement_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_LeaseSettlement_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_LeaseSettlement_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_LeaseSettlement_11_list) AppendMutable() protoreflect.Value {
+	v := new(types.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LeaseSettlement_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_LeaseSettlement_11_list) NewElement() protoreflect.Value {
+	v := new(types.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LeaseSettlement_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_LeaseSettlement_12_list)(nil)
+
+type _LeaseSettlement_12_list struct {
+	list *[]*types.Coin
+}
+
+func (x *_LeaseSettlement_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_LeaseSettlement_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_LeaseSettlement_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_LeaseSettlement_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_LeaseSettlement_12_list) AppendMutable() protoreflect.Value {
+	v := new(types.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LeaseSettlement_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_LeaseSettlement_12_list) NewElement() protoreflect.Value {
+	v := new(types.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LeaseSettlement_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_LeaseSettlement                 protoreflect.MessageDescriptor
+	fd_LeaseSettlement_id              protoreflect.FieldDescriptor
+	fd_LeaseSettlement_lease_uuid      protoreflect.FieldDescriptor
+	fd_LeaseSettlement_trigger         protoreflect.FieldDescriptor
+	fd_LeaseSettlement_period_start    protoreflect.FieldDescriptor
+	fd_LeaseSettlement_period_end      protoreflect.FieldDescriptor
+	fd_LeaseSettlement_settled_at      protoreflect.FieldDescriptor
+	fd_LeaseSettlement_accrued         protoreflect.FieldDescriptor
+	fd_LeaseSettlement_transferred     protoreflect.FieldDescriptor
+	fd_LeaseSettlement_shortfall       protoreflect.FieldDescriptor
+	fd_LeaseSettlement_promo           protoreflect.FieldDescriptor
+	fd_LeaseSettlement_converted       protoreflect.FieldDescriptor
+	fd_LeaseSettlement_converted_value protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_liftedinit_billing_v1_types_proto_init()
+	md_LeaseSettlement = File_liftedinit_billing_v1_types_proto.Messages().ByName("LeaseSettlement")
+	fd_LeaseSettlement_id = md_LeaseSettlement.Fields().ByName("id")
+	fd_LeaseSettlement_lease_uuid = md_LeaseSettlement.Fields().ByName("lease_uuid")
+	fd_LeaseSettlement_trigger = md_LeaseSettlement.Fields().ByName("trigger")
+	fd_LeaseSettlement_period_start = md_LeaseSettlement.Fields().ByName("period_start")
+	fd_LeaseSettlement_period_end = md_LeaseSettlement.Fields().ByName("period_end")
+	fd_LeaseSettlement_settled_at = md_LeaseSettlement.Fields().ByName("settled_at")
+	fd_LeaseSettlement_accrued = md_LeaseSettlement.Fields().ByName("accrued")
+	fd_LeaseSettlement_transferred = md_LeaseSettlement.Fields().ByName("transferred")
+	fd_LeaseSettlement_shortfall = md_LeaseSettlement.Fields().ByName("shortfall")
+	fd_LeaseSettlement_promo = md_LeaseSettlement.Fields().ByName("promo")
+	fd_LeaseSettlement_converted = md_LeaseSettlement.Fields().ByName("converted")
+	fd_LeaseSettlement_converted_value = md_LeaseSettlement.Fields().ByName("converted_value")
+}
+
+var _ protoreflect.Message = (*fastReflection_LeaseSettlement)(nil)
+
+type fastReflection_LeaseSettlement LeaseSettlement
+
+func (x *LeaseSettlement) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LeaseSettlement)(x)
+}
+
+func (x *LeaseSettlement) slowProtoReflect() protoreflect.Message {
+	mi := &file_liftedinit_billing_v1_types_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LeaseSettlement_messageType fastReflection_LeaseSettlement_messageType
+var _ protoreflect.MessageType = fastReflection_LeaseSettlement_messageType{}
+
+type fastReflection_LeaseSettlement_messageType struct{}
+
+func (x fastReflection_LeaseSettlement_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LeaseSettlement)(nil)
+}
+func (x fastReflection_LeaseSettlement_messageType) New() protoreflect.Message {
+	return new(fastReflection_LeaseSettlement)
+}
+func (x fastReflection_LeaseSettlement_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LeaseSettlement
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LeaseSettlement) Descriptor() protoreflect.MessageDescriptor {
+	return md_LeaseSettlement
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LeaseSettlement) Type() protoreflect.MessageType {
+	return _fastReflection_LeaseSettlement_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LeaseSettlement) New() protoreflect.Message {
+	return new(fastReflection_LeaseSettlement)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LeaseSettlement) Interface() protoreflect.ProtoMessage {
+	return (*LeaseSettlement)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LeaseSettlement) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_LeaseSettlement_id, value) {
+			return
+		}
+	}
+	if x.LeaseUuid != "" {
+		value := protoreflect.ValueOfString(x.LeaseUuid)
+		if !f(fd_LeaseSettlement_lease_uuid, value) {
+			return
+		}
+	}
+	if x.Trigger != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Trigger))
+		if !f(fd_LeaseSettlement_trigger, value) {
+			return
+		}
+	}
+	if x.PeriodStart != nil {
+		value := protoreflect.ValueOfMessage(x.PeriodStart.ProtoReflect())
+		if !f(fd_LeaseSettlement_period_start, value) {
+			return
+		}
+	}
+	if x.PeriodEnd != nil {
+		value := protoreflect.ValueOfMessage(x.PeriodEnd.ProtoReflect())
+		if !f(fd_LeaseSettlement_period_end, value) {
+			return
+		}
+	}
+	if x.SettledAt != nil {
+		value := protoreflect.ValueOfMessage(x.SettledAt.ProtoReflect())
+		if !f(fd_LeaseSettlement_settled_at, value) {
+			return
+		}
+	}
+	if len(x.Accrued) != 0 {
+		value := protoreflect.ValueOfList(&_LeaseSettlement_7_list{list: &x.Accrued})
+		if !f(fd_LeaseSettlement_accrued, value) {
+			return
+		}
+	}
+	if len(x.Transferred) != 0 {
+		value := protoreflect.ValueOfList(&_LeaseSettlement_8_list{list: &x.Transferred})
+		if !f(fd_LeaseSettlement_transferred, value) {
+			return
+		}
+	}
+	if len(x.Shortfall) != 0 {
+		value := protoreflect.ValueOfList(&_LeaseSettlement_9_list{list: &x.Shortfall})
+		if !f(fd_LeaseSettlement_shortfall, value) {
+			return
+		}
+	}
+	if len(x.Promo) != 0 {
+		value := protoreflect.ValueOfList(&_LeaseSettlement_10_list{list: &x.Promo})
+		if !f(fd_LeaseSettlement_promo, value) {
+			return
+		}
+	}
+	if len(x.Converted) != 0 {
+		value := protoreflect.ValueOfList(&_LeaseSettlement_11_list{list: &x.Converted})
+		if !f(fd_LeaseSettlement_converted, value) {
+			return
+		}
+	}
+	if len(x.ConvertedValue) != 0 {
+		value := protoreflect.ValueOfList(&_LeaseSettlement_12_list{list: &x.ConvertedValue})
+		if !f(fd_LeaseSettlement_converted_value, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LeaseSettlement) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.LeaseSettlement.id":
+		return x.Id != uint64(0)
+	case "liftedinit.billing.v1.LeaseSettlement.lease_uuid":
+		return x.LeaseUuid != ""
+	case "liftedinit.billing.v1.LeaseSettlement.trigger":
+		return x.Trigger != 0
+	case "liftedinit.billing.v1.LeaseSettlement.period_start":
+		return x.PeriodStart != nil
+	case "liftedinit.billing.v1.LeaseSettlement.period_end":
+		return x.PeriodEnd != nil
+	case "liftedinit.billing.v1.LeaseSettlement.settled_at":
+		return x.SettledAt != nil
+	case "liftedinit.billing.v1.LeaseSettlement.accrued":
+		return len(x.Accrued) != 0
+	case "liftedinit.billing.v1.LeaseSettlement.transferred":
+		return len(x.Transferred) != 0
+	case "liftedinit.billing.v1.LeaseSettlement.shortfall":
+		return len(x.Shortfall) != 0
+	case "liftedinit.billing.v1.LeaseSettlement.promo":
+		return len(x.Promo) != 0
+	case "liftedinit.billing.v1.LeaseSettlement.converted":
+		return len(x.Converted) != 0
+	case "liftedinit.billing.v1.LeaseSettlement.converted_value":
+		return len(x.ConvertedValue) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.LeaseSettlement"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.LeaseSettlement does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LeaseSettlement) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.LeaseSettlement.id":
+		x.Id = uint64(0)
+	case "liftedinit.billing.v1.LeaseSettlement.lease_uuid":
+		x.LeaseUuid = ""
+	case "liftedinit.billing.v1.LeaseSettlement.trigger":
+		x.Trigger = 0
+	case "liftedinit.billing.v1.LeaseSettlement.period_start":
+		x.PeriodStart = nil
+	case "liftedinit.billing.v1.LeaseSettlement.period_end":
+		x.PeriodEnd = nil
+	case "liftedinit.billing.v1.LeaseSettlement.settled_at":
+		x.SettledAt = nil
+	case "liftedinit.billing.v1.LeaseSettlement.accrued":
+		x.Accrued = nil
+	case "liftedinit.billing.v1.LeaseSettlement.transferred":
+		x.Transferred = nil
+	case "liftedinit.billing.v1.LeaseSettlement.shortfall":
+		x.Shortfall = nil
+	case "liftedinit.billing.v1.LeaseSettlement.promo":
+		x.Promo = nil
+	case "liftedinit.billing.v1.LeaseSettlement.converted":
+		x.Converted = nil
+	case "liftedinit.billing.v1.LeaseSettlement.converted_value":
+		x.ConvertedValue = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.LeaseSettlement"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.LeaseSettlement does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LeaseSettlement) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "liftedinit.billing.v1.LeaseSettlement.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.billing.v1.LeaseSettlement.lease_uuid":
+		value := x.LeaseUuid
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.billing.v1.LeaseSettlement.trigger":
+		value := x.Trigger
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "liftedinit.billing.v1.LeaseSettlement.period_start":
+		value := x.PeriodStart
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "liftedinit.billing.v1.LeaseSettlement.period_end":
+		value := x.PeriodEnd
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "liftedinit.billing.v1.LeaseSettlement.settled_at":
+		value := x.SettledAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "liftedinit.billing.v1.LeaseSettlement.accrued":
+		if len(x.Accrued) == 0 {
+			return protoreflect.ValueOfList(&_LeaseSettlement_7_list{})
+		}
+		listValue := &_LeaseSettlement_7_list{list: &x.Accrued}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.LeaseSettlement.transferred":
+		if len(x.Transferred) == 0 {
+			return protoreflect.ValueOfList(&_LeaseSettlement_8_list{})
+		}
+		listValue := &_LeaseSettlement_8_list{list: &x.Transferred}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.LeaseSettlement.shortfall":
+		if len(x.Shortfall) == 0 {
+			return protoreflect.ValueOfList(&_LeaseSettlement_9_list{})
+		}
+		listValue := &_LeaseSettlement_9_list{list: &x.Shortfall}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.LeaseSettlement.promo":
+		if len(x.Promo) == 0 {
+			return protoreflect.ValueOfList(&_LeaseSettlement_10_list{})
+		}
+		listValue := &_LeaseSettlement_10_list{list: &x.Promo}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.LeaseSettlement.converted":
+		if len(x.Converted) == 0 {
+			return protoreflect.ValueOfList(&_LeaseSettlement_11_list{})
+		}
+		listValue := &_LeaseSettlement_11_list{list: &x.Converted}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.LeaseSettlement.converted_value":
+		if len(x.ConvertedValue) == 0 {
+			return protoreflect.ValueOfList(&_LeaseSettlement_12_list{})
+		}
+		listValue := &_LeaseSettlement_12_list{list: &x.ConvertedValue}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.LeaseSettlement"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.LeaseSettlement does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LeaseSettlement) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.LeaseSettlement.id":
+		x.Id = value.Uint()
+	case "liftedinit.billing.v1.LeaseSettlement.lease_uuid":
+		x.LeaseUuid = value.Interface().(string)
+	case "liftedinit.billing.v1.LeaseSettlement.trigger":
+		x.Trigger = (SettlementTrigger)(value.Enum())
+	case "liftedinit.billing.v1.LeaseSettlement.period_start":
+		x.PeriodStart = value.Message().Interface().(*timestamppb.Timestamp)
+	case "liftedinit.billing.v1.LeaseSettlement.period_end":
+		x.PeriodEnd = value.Message().Interface().(*timestamppb.Timestamp)
+	case "liftedinit.billing.v1.LeaseSettlement.settled_at":
+		x.SettledAt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "liftedinit.billing.v1.LeaseSettlement.accrued":
+		lv := value.List()
+		clv := lv.(*_LeaseSettlement_7_list)
+		x.Accrued = *clv.list
+	case "liftedinit.billing.v1.LeaseSettlement.transferred":
+		lv := value.List()
+		clv := lv.(*_LeaseSettlement_8_list)
+		x.Transferred = *clv.list
+	case "liftedinit.billing.v1.LeaseSettlement.shortfall":
+		lv := value.List()
+		clv := lv.(*_LeaseSettlement_9_list)
+		x.Shortfall = *clv.list
+	case "liftedinit.billing.v1.LeaseSettlement.promo":
+		lv := value.List()
+		clv := lv.(*_LeaseSettlement_10_list)
+		x.Promo = *clv.list
+	case "liftedinit.billing.v1.LeaseSettlement.converted":
+		lv := value.List()
+		clv := lv.(*_LeaseSettlement_11_list)
+		x.Converted = *clv.list
+	case "liftedinit.billing.v1.LeaseSettlement.converted_value":
+		lv := value.List()
+		clv := lv.(*_LeaseSettlement_12_list)
+		x.ConvertedValue = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.LeaseSettlement"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.LeaseSettlement does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LeaseSettlement) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.LeaseSettlement.period_start":
+		if x.PeriodStart == nil {
+			x.PeriodStart = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.PeriodStart.ProtoReflect())
+	case "liftedinit.billing.v1.LeaseSettlement.period_end":
+		if x.PeriodEnd == nil {
+			x.PeriodEnd = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.PeriodEnd.ProtoReflect())
+	case "liftedinit.billing.v1.LeaseSettlement.settled_at":
+		if x.SettledAt == nil {
+			x.SettledAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.SettledAt.ProtoReflect())
+	case "liftedinit.billing.v1.LeaseSettlement.accrued":
+		if x.Accrued == nil {
+			x.Accrued = []*types.Coin{}
+		}
+		value := &_LeaseSettlement_7_list{list: &x.Accrued}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.LeaseSettlement.transferred":
+		if x.Transferred == nil {
+			x.Transferred = []*types.Coin{}
+		}
+		value := &_LeaseSettlement_8_list{list: &x.Transferred}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.LeaseSettlement.shortfall":
+		if x.Shortfall == nil {
+			x.Shortfall = []*types.Coin{}
+		}
+		value := &_LeaseSettlement_9_list{list: &x.Shortfall}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.LeaseSettlement.promo":
+		if x.Promo == nil {
+			x.Promo = []*types.Coin{}
+		}
+		value := &_LeaseSettlement_10_list{list: &x.Promo}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.LeaseSettlement.converted":
+		if x.Converted == nil {
+			x.Converted = []*types.Coin{}
+		}
+		value := &_LeaseSettlement_11_list{list: &x.Converted}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.LeaseSettlement.converted_value":
+		if x.ConvertedValue == nil {
+			x.ConvertedValue = []*types.Coin{}
+		}
+		value := &_LeaseSettlement_12_list{list: &x.ConvertedValue}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.LeaseSettlement.id":
+		panic(fmt.Errorf("field id of message liftedinit.billing.v1.LeaseSettlement is not mutable"))
+	case "liftedinit.billing.v1.LeaseSettlement.lease_uuid":
+		panic(fmt.Errorf("field lease_uuid of message liftedinit.billing.v1.LeaseSettlement is not mutable"))
+	case "liftedinit.billing.v1.LeaseSettlement.trigger":
+		panic(fmt.Errorf("field trigger of message liftedinit.billing.v1.LeaseSettlement is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.LeaseSettlement"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.LeaseSettlement does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LeaseSettlement) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.LeaseSettlement.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.billing.v1.LeaseSettlement.lease_uuid":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.billing.v1.LeaseSettlement.trigger":
+		return protoreflect.ValueOfEnum(0)
+	case "liftedinit.billing.v1.LeaseSettlement.period_start":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "liftedinit.billing.v1.LeaseSettlement.period_end":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "liftedinit.billing.v1.LeaseSettlement.settled_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "liftedinit.billing.v1.LeaseSettlement.accrued":
+		list := []*types.Coin{}
+		return protoreflect.ValueOfList(&_LeaseSettlement_7_list{list: &list})
+	case "liftedinit.billing.v1.LeaseSettlement.transferred":
+		list := []*types.Coin{}
+		return protoreflect.ValueOfList(&_LeaseSettlement_8_list{list: &list})
+	case "liftedinit.billing.v1.LeaseSettlement.shortfall":
+		list := []*types.Coin{}
+		return protoreflect.ValueOfList(&_LeaseSettlement_9_list{list: &list})
+	case "liftedinit.billing.v1.LeaseSettlement.promo":
+		list := []*types.Coin{}
+		return protoreflect.ValueOfList(&_LeaseSettlement_10_list{list: &list})
+	case "liftedinit.billing.v1.LeaseSettlement.converted":
+		list := []*types.Coin{}
+		return protoreflect.ValueOfList(&_LeaseSettlement_11_list{list: &list})
+	case "liftedinit.billing.v1.LeaseSettlement.converted_value":
+		list := []*types.Coin{}
+		return protoreflect.ValueOfList(&_LeaseSettlement_12_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.LeaseSettlement"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.LeaseSettlement does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LeaseSettlement) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in liftedinit.billing.v1.LeaseSettlement", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LeaseSettlement) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LeaseSettlement) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LeaseSettlement) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LeaseSettlement) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*LeaseSettlement)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.LeaseUuid)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Trigger != 0 {
+			n += 1 + runtime.Sov(uint64(x.Trigger))
+		}
+		if x.PeriodStart != nil {
+			l = options.Size(x.PeriodStart)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PeriodEnd != nil {
+			l = options.Size(x.PeriodEnd)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SettledAt != nil {
+			l = options.Size(x.SettledAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Accrued) > 0 {
+			for _, e := range x.Accrued {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Transferred) > 0 {
+			for _, e := range x.Transferred {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Shortfall) > 0 {
+			for _, e := range x.Shortfall {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Promo) > 0 {
+			for _, e := range x.Promo {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Converted) > 0 {
+			for _, e := range x.Converted {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ConvertedValue) > 0 {
+			for _, e := range x.ConvertedValue {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*LeaseSettlement)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ConvertedValue) > 0 {
+			for iNdEx := len(x.ConvertedValue) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ConvertedValue[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.Converted) > 0 {
+			for iNdEx := len(x.Converted) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Converted[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.Promo) > 0 {
+			for iNdEx := len(x.Promo) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Promo[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.Shortfall) > 0 {
+			for iNdEx := len(x.Shortfall) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Shortfall[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.Transferred) > 0 {
+			for iNdEx := len(x.Transferred) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Transferred[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.Accrued) > 0 {
+			for iNdEx := len(x.Accrued) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Accrued[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.SettledAt != nil {
+			encoded, err := options.Marshal(x.SettledAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.PeriodEnd != nil {
+			encoded, err := options.Marshal(x.PeriodEnd)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.PeriodStart != nil {
+			encoded, err := options.Marshal(x.PeriodStart)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Trigger != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Trigger))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.LeaseUuid) > 0 {
+			i -= len(x.LeaseUuid)
+			copy(dAtA[i:], x.LeaseUuid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LeaseUuid)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*LeaseSettlement)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LeaseSettlement: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LeaseSettlement: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LeaseUuid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+				}
+				x.Trigger = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Trigger |= SettlementTrigger(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PeriodStart", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PeriodStart == nil {
+					x.PeriodStart = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PeriodStart); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PeriodEnd", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PeriodEnd == nil {
+					x.PeriodEnd = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PeriodEnd); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SettledAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SettledAt == nil {
+					x.SettledAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SettledAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Accrued", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Accrued = append(x.Accrued, &types.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Accrued[len(x.Accrued)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Transferred", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Transferred = append(x.Transferred, &types.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Transferred[len(x.Transferred)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Shortfall", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Shortfall = append(x.Shortfall, &types.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Shortfall[len(x.Shortfall)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Promo", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Promo = append(x.Promo, &types.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Promo[len(x.Promo)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Converted", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Converted = append(x.Converted, &types.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Converted[len(x.Converted)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConvertedValue", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConvertedValue = append(x.ConvertedValue, &types.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ConvertedValue[len(x.ConvertedValue)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Dispute_6_list)(nil)
+
+type _Dispute_6_list struct {
+	list *[]*types.Coin
+}
+
+func (x *_Dispute_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Dispute_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Dispute_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Dispute_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Dispute_6_list) AppendMutable() protoreflect.Value {
+	v := new(types.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Dispute_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Dispute_6_list) NewElement() protoreflect.Value {
+	v := new(types.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Dispute_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Dispute_12_list)(nil)
+
+type _Dispute_12_list struct {
+	list *[]*types.Coin
+}
+
+func (x *_Dispute_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Dispute_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Dispute_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Dispute_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Dispute_12_list) AppendMutable() protoreflect.Value {
+	v := new(types.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Dispute_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Dispute_12_list) NewElement() protoreflect.Value {
+	v := new(types.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Dispute_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Dispute_13_list)(nil)
+
+type _Dispute_13_list struct {
+	list *[]*types.Coin
+}
+
+func (x *_Dispute_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Dispute_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Dispute_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Dispute_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Dispute_13_list) AppendMutable() protoreflect.Value {
+	v := new(types.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Dispute_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Dispute_13_list) NewElement() protoreflect.Value {
+	v := new(types.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Dispute_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Dispute                   protoreflect.MessageDescriptor
+	fd_Dispute_lease_uuid        protoreflect.FieldDescriptor
+	fd_Dispute_tenant            protoreflect.FieldDescriptor
+	fd_Dispute_provider_uuid     protoreflect.FieldDescriptor
+	fd_Dispute_state             protoreflect.FieldDescriptor
+	fd_Dispute_reason            protoreflect.FieldDescriptor
+	fd_Dispute_escrowed          protoreflect.FieldDescriptor
+	fd_Dispute_opened_at         protoreflect.FieldDescriptor
+	fd_Dispute_response_deadline protoreflect.FieldDescriptor
+	fd_Dispute_response          protoreflect.FieldDescriptor
+	fd_Dispute_responded_at      protoreflect.FieldDescriptor
+	fd_Dispute_resolved_at       protoreflect.FieldDescriptor
+	fd_Dispute_tenant_amounts    protoreflect.FieldDescriptor
+	fd_Dispute_provider_amounts  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_liftedinit_billing_v1_types_proto_init()
+	md_Dispute = File_liftedinit_billing_v1_types_proto.Messages().ByName("Dispute")
+	fd_Dispute_lease_uuid = md_Dispute.Fields().ByName("lease_uuid")
+	fd_Dispute_tenant = md_Dispute.Fields().ByName("tenant")
+	fd_Dispute_provider_uuid = md_Dispute.Fields().ByName("provider_uuid")
+	fd_Dispute_state = md_Dispute.Fields().ByName("state")
+	fd_Dispute_reason = md_Dispute.Fields().ByName("reason")
+	fd_Dispute_escrowed = md_Dispute.Fields().ByName("escrowed")
+	fd_Dispute_opened_at = md_Dispute.Fields().ByName("opened_at")
+	fd_Dispute_response_deadline = md_Dispute.Fields().ByName("response_deadline")
+	fd_Dispute_response = md_Dispute.Fields().ByName("response")
+	fd_Dispute_responded_at = md_Dispute.Fields().ByName("responded_at")
+	fd_Dispute_resolved_at = md_Dispute.Fields().ByName("resolved_at")
+	fd_Dispute_tenant_amounts = md_Dispute.Fields().ByName("tenant_amounts")
+	fd_Dispute_provider_amounts = md_Dispute.Fields().ByName("provider_amounts")
+}
+
+var _ protoreflect.Message = (*fastReflection_Dispute)(nil)
+
+type fastReflection_Dispute Dispute
+
+func (x *Dispute) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Dispute)(x)
+}
+
+func (x *Dispute) slowProtoReflect() protoreflect.Message {
+	mi := &file_liftedinit_billing_v1_types_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Dispute_messageType fastReflection_Dispute_messageType
+var _ protoreflect.MessageType = fastReflection_Dispute_messageType{}
+
+type fastReflection_Dispute_messageType struct{}
+
+func (x fastReflection_Dispute_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Dispute)(nil)
+}
+func (x fastReflection_Dispute_messageType) New() protoreflect.Message {
+	return new(fastReflection_Dispute)
+}
+func (x fastReflection_Dispute_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Dispute
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Dispute) Descriptor() protoreflect.MessageDescriptor {
+	return md_Dispute
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Dispute) Type() protoreflect.MessageType {
+	return _fastReflection_Dispute_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Dispute) New() protoreflect.Message {
+	return new(fastReflection_Dispute)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Dispute) Interface() protoreflect.ProtoMessage {
+	return (*Dispute)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Dispute) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LeaseUuid != "" {
+		value := protoreflect.ValueOfString(x.LeaseUuid)
+		if !f(fd_Dispute_lease_uuid, value) {
+			return
+		}
+	}
+	if x.Tenant != "" {
+		value := protoreflect.ValueOfString(x.Tenant)
+		if !f(fd_Dispute_tenant, value) {
+			return
+		}
+	}
+	if x.ProviderUuid != "" {
+		value := protoreflect.ValueOfString(x.ProviderUuid)
+		if !f(fd_Dispute_provider_uuid, value) {
+			return
+		}
+	}
+	if x.State != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.State))
+		if !f(fd_Dispute_state, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_Dispute_reason, value) {
+			return
+		}
+	}
+	if len(x.Escrowed) != 0 {
+		value := protoreflect.ValueOfList(&_Dispute_6_list{list: &x.Escrowed})
+		if !f(fd_Dispute_escrowed, value) {
+			return
+		}
+	}
+	if x.OpenedAt != nil {
+		value := protoreflect.ValueOfMessage(x.OpenedAt.ProtoReflect())
+		if !f(fd_Dispute_opened_at, value) {
+			return
+		}
+	}
+	if x.ResponseDeadline != nil {
+		value := protoreflect.ValueOfMessage(x.ResponseDeadline.ProtoReflect())
+		if !f(fd_Dispute_response_deadline, value) {
+			return
+		}
+	}
+	if x.Response != "" {
+		value := protoreflect.ValueOfString(x.Response)
+		if !f(fd_Dispute_response, value) {
+			return
+		}
+	}
+	if x.RespondedAt != nil {
+		value := protoreflect.ValueOfMessage(x.RespondedAt.ProtoReflect())
+		if !f(fd_Dispute_responded_at, value) {
+			return
+		}
+	}
+	if x.ResolvedAt != nil {
+		value := protoreflect.ValueOfMessage(x.ResolvedAt.ProtoReflect())
+		if !f(fd_Dispute_resolved_at, value) {
+			return
+		}
+	}
+	if len(x.TenantAmounts) != 0 {
+		value := protoreflect.ValueOfList(&_Dispute_12_list{list: &x.TenantAmounts})
+		if !f(fd_Dispute_tenant_amounts, value) {
+			return
+		}
+	}
+	if len(x.ProviderAmounts) != 0 {
+		value := protoreflect.ValueOfList(&_Dispute_13_list{list: &x.ProviderAmounts})
+		if !f(fd_Dispute_provider_amounts, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Dispute) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.Dispute.lease_uuid":
+		return x.LeaseUuid != ""
+	case "liftedinit.billing.v1.Dispute.tenant":
+		return x.Tenant != ""
+	case "liftedinit.billing.v1.Dispute.provider_uuid":
+		return x.ProviderUuid != ""
+	case "liftedinit.billing.v1.Dispute.state":
+		return x.State != 0
+	case "liftedinit.billing.v1.Dispute.reason":
+		return x.Reason != ""
+	case "liftedinit.billing.v1.Dispute.escrowed":
+		return len(x.Escrowed) != 0
+	case "liftedinit.billing.v1.Dispute.opened_at":
+		return x.OpenedAt != nil
+	case "liftedinit.billing.v1.Dispute.response_deadline":
+		return x.ResponseDeadline != nil
+	case "liftedinit.billing.v1.Dispute.response":
+		return x.Response != ""
+	case "liftedinit.billing.v1.Dispute.responded_at":
+		return x.RespondedAt != nil
+	case "liftedinit.billing.v1.Dispute.resolved_at":
+		return x.ResolvedAt != nil
+	case "liftedinit.billing.v1.Dispute.tenant_amounts":
+		return len(x.TenantAmounts) != 0
+	case "liftedinit.billing.v1.Dispute.provider_amounts":
+		return len(x.ProviderAmounts) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.Dispute"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.Dispute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Dispute) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.Dispute.lease_uuid":
+		x.LeaseUuid = ""
+	case "liftedinit.billing.v1.Dispute.tenant":
+		x.Tenant = ""
+	case "liftedinit.billing.v1.Dispute.provider_uuid":
+		x.ProviderUuid = ""
+	case "liftedinit.billing.v1.Dispute.state":
+		x.State = 0
+	case "liftedinit.billing.v1.Dispute.reason":
+		x.Reason = ""
+	case "liftedinit.billing.v1.Dispute.escrowed":
+		x.Escrowed = nil
+	case "liftedinit.billing.v1.Dispute.opened_at":
+		x.OpenedAt = nil
+	case "liftedinit.billing.v1.Dispute.response_deadline":
+		x.ResponseDeadline = nil
+	case "liftedinit.billing.v1.Dispute.response":
+		x.Response = ""
+	case "liftedinit.billing.v1.Dispute.responded_at":
+		x.RespondedAt = nil
+	case "liftedinit.billing.v1.Dispute.resolved_at":
+		x.ResolvedAt = nil
+	case "liftedinit.billing.v1.Dispute.tenant_amounts":
+		x.TenantAmounts = nil
+	case "liftedinit.billing.v1.Dispute.provider_amounts":
+		x.ProviderAmounts = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.Dispute"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.Dispute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Dispute) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "liftedinit.billing.v1.Dispute.lease_uuid":
+		value := x.LeaseUuid
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.billing.v1.Dispute.tenant":
+		value := x.Tenant
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.billing.v1.Dispute.provider_uuid":
+		value := x.ProviderUuid
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.billing.v1.Dispute.state":
+		value := x.State
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "liftedinit.billing.v1.Dispute.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.billing.v1.Dispute.escrowed":
+		if len(x.Escrowed) == 0 {
+			return protoreflect.ValueOfList(&_Dispute_6_list{})
+		}
+		listValue := &_Dispute_6_list{list: &x.Escrowed}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.Dispute.opened_at":
+		value := x.OpenedAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "liftedinit.billing.v1.Dispute.response_deadline":
+		value := x.ResponseDeadline
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "liftedinit.billing.v1.Dispute.response":
+		value := x.Response
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.billing.v1.Dispute.responded_at":
+		value := x.RespondedAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "liftedinit.billing.v1.Dispute.resolved_at":
+		value := x.ResolvedAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "liftedinit.billing.v1.Dispute.tenant_amounts":
+		if len(x.TenantAmounts) == 0 {
+			return protoreflect.ValueOfList(&_Dispute_12_list{})
+		}
+		listValue := &_Dispute_12_list{list: &x.TenantAmounts}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.Dispute.provider_amounts":
+		if len(x.ProviderAmounts) == 0 {
+			return protoreflect.ValueOfList(&_Dispute_13_list{})
+		}
+		listValue := &_Dispute_13_list{list: &x.ProviderAmounts}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.Dispute"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.Dispute does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Dispute) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.Dispute.lease_uuid":
+		x.LeaseUuid = value.Interface().(string)
+	case "liftedinit.billing.v1.Dispute.tenant":
+		x.Tenant = value.Interface().(string)
+	case "liftedinit.billing.v1.Dispute.provider_uuid":
+		x.ProviderUuid = value.Interface().(string)
+	case "liftedinit.billing.v1.Dispute.state":
+		x.State = (DisputeState)(value.Enum())
+	case "liftedinit.billing.v1.Dispute.reason":
+		x.Reason = value.Interface().(string)
+	case "liftedinit.billing.v1.Dispute.escrowed":
+		lv := value.List()
+		clv := lv.(*_Dispute_6_list)
+		x.Escrowed = *clv.list
+	case "liftedinit.billing.v1.Dispute.opened_at":
+		x.OpenedAt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "liftedinit.billing.v1.Dispute.response_deadline":
+		x.ResponseDeadline = value.Message().Interface().(*timestamppb.Timestamp)
+	case "liftedinit.billing.v1.Dispute.response":
+		x.Response = value.Interface().(string)
+	case "liftedinit.billing.v1.Dispute.responded_at":
+		x.RespondedAt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "liftedinit.billing.v1.Dispute.resolved_at":
+		x.ResolvedAt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "liftedinit.billing.v1.Dispute.tenant_amounts":
+		lv := value.List()
+		clv := lv.(*_Dispute_12_list)
+		x.TenantAmounts = *clv.list
+	case "liftedinit.billing.v1.Dispute.provider_amounts":
+		lv := value.List()
+		clv := lv.(*_Dispute_13_list)
+		x.ProviderAmounts = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.Dispute"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.Dispute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Dispute) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.Dispute.escrowed":
+		if x.Escrowed == nil {
+			x.Escrowed = []*types.Coin{}
+		}
+		value := &_Dispute_6_list{list: &x.Escrowed}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.Dispute.opened_at":
+		if x.OpenedAt == nil {
+			x.OpenedAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.OpenedAt.ProtoReflect())
+	case "liftedinit.billing.v1.Dispute.response_deadline":
+		if x.ResponseDeadline == nil {
+			x.ResponseDeadline = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ResponseDeadline.ProtoReflect())
+	case "liftedinit.billing.v1.Dispute.responded_at":
+		if x.RespondedAt == nil {
+			x.RespondedAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.RespondedAt.ProtoReflect())
+	case "liftedinit.billing.v1.Dispute.resolved_at":
+		if x.ResolvedAt == nil {
+			x.ResolvedAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ResolvedAt.ProtoReflect())
+	case "liftedinit.billing.v1.Dispute.tenant_amounts":
+		if x.TenantAmounts == nil {
+			x.TenantAmounts = []*types.Coin{}
+		}
+		value := &_Dispute_12_list{list: &x.TenantAmounts}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.Dispute.provider_amounts":
+		if x.ProviderAmounts == nil {
+			x.ProviderAmounts = []*types.Coin{}
+		}
+		value := &_Dispute_13_list{list: &x.ProviderAmounts}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.Dispute.lease_uuid":
+		panic(fmt.Errorf("field lease_uuid of message liftedinit.billing.v1.Dispute is not mutable"))
+	case "liftedinit.billing.v1.Dispute.tenant":
+		panic(fmt.Errorf("field tenant of message liftedinit.billing.v1.Dispute is not mutable"))
+	case "liftedinit.billing.v1.Dispute.provider_uuid":
+		panic(fmt.Errorf("field provider_uuid of message liftedinit.billing.v1.Dispute is not mutable"))
+	case "liftedinit.billing.v1.Dispute.state":
+		panic(fmt.Errorf("field state of message liftedinit.billing.v1.Dispute is not mutable"))
+	case "liftedinit.billing.v1.Dispute.reason":
+		panic(fmt.Errorf("field reason of message liftedinit.billing.v1.Dispute is not mutable"))
+	case "liftedinit.billing.v1.Dispute.response":
+		panic(fmt.Errorf("field response of message liftedinit.billing.v1.Dispute is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.Dispute"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.Dispute does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Dispute) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.Dispute.lease_uuid":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.billing.v1.Dispute.tenant":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.billing.v1.Dispute.provider_uuid":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.billing.v1.Dispute.state":
+		return protoreflect.ValueOfEnum(0)
+	case "liftedinit.billing.v1.Dispute.reason":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.billing.v1.Dispute.escrowed":
+		list := []*types.Coin{}
+		return protoreflect.ValueOfList(&_Dispute_6_list{list: &list})
+	case "liftedinit.billing.v1.Dispute.opened_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "liftedinit.billing.v1.Dispute.response_deadline":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "liftedinit.billing.v1.Dispute.response":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.billing.v1.Dispute.responded_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "liftedinit.billing.v1.Dispute.resolved_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "liftedinit.billing.v1.Dispute.tenant_amounts":
+		list := []*types.Coin{}
+		return protoreflect.ValueOfList(&_Dispute_12_list{list: &list})
+	case "liftedinit.billing.v1.Dispute.provider_amounts":
+		list := []*types.Coin{}
+		return protoreflect.ValueOfList(&_Dispute_13_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.Dispute"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.Dispute does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Dispute) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in liftedinit.billing.v1.Dispute", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Dispute) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Dispute) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Dispute) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Dispute) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Dispute)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.LeaseUuid)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Tenant)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ProviderUuid)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.State != 0 {
+			n += 1 + runtime.Sov(uint64(x.State))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Escrowed) > 0 {
+			for _, e := range x.Escrowed {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.OpenedAt != nil {
+			l = options.Size(x.OpenedAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ResponseDeadline != nil {
+			l = options.Size(x.ResponseDeadline)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Response)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RespondedAt != nil {
+			l = options.Size(x.RespondedAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ResolvedAt != nil {
+			l = options.Size(x.ResolvedAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.TenantAmounts) > 0 {
+			for _, e := range x.TenantAmounts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ProviderAmounts) > 0 {
+			for _, e := range x.ProviderAmounts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Dispute)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ProviderAmounts) > 0 {
+			for iNdEx := len(x.ProviderAmounts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ProviderAmounts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.TenantAmounts) > 0 {
+			for iNdEx := len(x.TenantAmounts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TenantAmounts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if x.ResolvedAt != nil {
+			encoded, err := options.Marshal(x.ResolvedAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.RespondedAt != nil {
+			encoded, err := options.Marshal(x.RespondedAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.Response) > 0 {
+			i -= len(x.Response)
+			copy(dAtA[i:], x.Response)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Response)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.ResponseDeadline != nil {
+			encoded, err := options.Marshal(x.ResponseDeadline)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.OpenedAt != nil {
+			encoded, err := options.Marshal(x.OpenedAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Escrowed) > 0 {
+			for iNdEx := len(x.Escrowed) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Escrowed[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.State != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.State))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.ProviderUuid) > 0 {
+			i -= len(x.ProviderUuid)
+			copy(dAtA[i:], x.ProviderUuid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProviderUuid)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Tenant) > 0 {
+			i -= len(x.Tenant)
+			copy(dAtA[i:], x.Tenant)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tenant)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.LeaseUuid) > 0 {
+			i -= len(x.LeaseUuid)
+			copy(dAtA[i:], x.LeaseUuid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LeaseUuid)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Dispute)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Dispute: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Dispute: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LeaseUuid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Tenant = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProviderUuid", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProviderUuid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				}
+				x.State = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.State |= DisputeState(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Escrowed = append(x.Escrowed, &types.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Escrowed[len(x.Escrowed)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OpenedAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.OpenedAt == nil {
+					x.OpenedAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OpenedAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResponseDeadline", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ResponseDeadline == nil {
+					x.ResponseDeadline = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ResponseDeadline); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Response = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RespondedAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RespondedAt == nil {
+					x.RespondedAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RespondedAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolvedAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ResolvedAt == nil {
+					x.ResolvedAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ResolvedAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TenantAmounts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TenantAmounts = append(x.TenantAmounts, &types.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TenantAmounts[len(x.TenantAmounts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProviderAmounts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProviderAmounts = append(x.ProviderAmounts, &types.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProviderAmounts[len(x.ProviderAmounts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ProviderPolicy_4_list)(nil)
+
+type _ProviderPolicy_4_list struct {
+	list *[]string
+}
+
+func (x *_ProviderPolicy_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ProviderPolicy_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ProviderPolicy_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ProviderPolicy_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ProviderPolicy_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ProviderPolicy at list field AllowedTenants as it is not of Message kind"))
+}
+
+func (x *_ProviderPolicy_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ProviderPolicy_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ProviderPolicy_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_ProviderPolicy_5_list)(nil)
+
+type _ProviderPolicy_5_list struct {
+	list *[]string
+}
+
+func (x *_ProviderPolicy_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ProviderPolicy_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ProviderPolicy_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ProviderPolicy_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ProviderPolicy_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ProviderPolicy at list field DeniedTenants as it is not of Message kind"))
+}
+
+func (x *_ProviderPolicy_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ProviderPolicy_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ProviderPolicy_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ProviderPolicy                       protoreflect.MessageDescriptor
+	fd_ProviderPolicy_provider_uuid         protoreflect.FieldDescriptor
+	fd_ProviderPolicy_pending_timeout       protoreflect.FieldDescriptor
+	fd_ProviderPolicy_auto_acknowledge      protoreflect.FieldDescriptor
+	fd_ProviderPolicy_allowed_tenants       protoreflect.FieldDescriptor
+	fd_ProviderPolicy_denied_tenants        protoreflect.FieldDescriptor
+	fd_ProviderPolicy_override_grace_period protoreflect.FieldDescriptor
+	fd_ProviderPolicy_grace_period          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_liftedinit_billing_v1_types_proto_init()
+	md_ProviderPolicy = File_liftedinit_billing_v1_types_proto.Messages().ByName("ProviderPolicy")
+	fd_ProviderPolicy_provider_uuid = md_ProviderPolicy.Fields().ByName("provider_uuid")
+	fd_ProviderPolicy_pending_timeout = md_ProviderPolicy.Fields().ByName("pending_timeout")
+	fd_ProviderPolicy_auto_acknowledge = md_ProviderPolicy.Fields().ByName("auto_acknowledge")
+	fd_ProviderPolicy_allowed_tenants = md_ProviderPolicy.Fields().ByName("allowed_tenants")
+	fd_ProviderPolicy_denied_tenants = md_ProviderPolicy.Fields().ByName("denied_tenants")
+	fd_ProviderPolicy_override_grace_period = md_ProviderPolicy.Fields().ByName("override_grace_period")
+	fd_ProviderPolicy_grace_period = md_ProviderPolicy.Fields().ByName("grace_period")
+}
+
+var _ protoreflect.Message = (*fastReflection_ProviderPolicy)(nil)
+
+type fastReflection_ProviderPolicy ProviderPolicy
+
+func (x *ProviderPolicy) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ProviderPolicy)(x)
+}
+
+func (x *ProviderPolicy) slowProtoReflect() protoreflect.Message {
+	mi := &file_liftedinit_billing_v1_types_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ProviderPolicy_messageType fastReflection_ProviderPolicy_messageType
+var _ protoreflect.MessageType = fastReflection_ProviderPolicy_messageType{}
+
+type fastReflection_ProviderPolicy_messageType struct{}
+
+func (x fastReflection_ProviderPolicy_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ProviderPolicy)(nil)
+}
+func (x fastReflection_ProviderPolicy_messageType) New() protoreflect.Message {
+	return new(fastReflection_ProviderPolicy)
+}
+func (x fastReflection_ProviderPolicy_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProviderPolicy
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ProviderPolicy) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProviderPolicy
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ProviderPolicy) Type() protoreflect.MessageType {
+	return _fastReflection_ProviderPolicy_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ProviderPolicy) New() protoreflect.Message {
+	return new(fastReflection_ProviderPolicy)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ProviderPolicy) Interface() protoreflect.ProtoMessage {
+	return (*ProviderPolicy)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ProviderPolicy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProviderUuid != "" {
+		value := protoreflect.ValueOfString(x.ProviderUuid)
+		if !f(fd_ProviderPolicy_provider_uuid, value) {
+			return
+		}
+	}
+	if x.PendingTimeout != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PendingTimeout)
+		if !f(fd_ProviderPolicy_pending_timeout, value) {
+			return
+		}
+	}
+	if x.AutoAcknowledge != false {
+		value := protoreflect.ValueOfBool(x.AutoAcknowledge)
+		if !f(fd_ProviderPolicy_auto_acknowledge, value) {
+			return
+		}
+	}
+	if len(x.AllowedTenants) != 0 {
+		value := protoreflect.ValueOfList(&_ProviderPolicy_4_list{list: &x.AllowedTenants})
+		if !f(fd_ProviderPolicy_allowed_tenants, value) {
+			return
+		}
+	}
+	if len(x.DeniedTenants) != 0 {
+		value := protoreflect.ValueOfList(&_ProviderPolicy_5_list{list: &x.DeniedTenants})
+		if !f(fd_ProviderPolicy_denied_tenants, value) {
+			return
+		}
+	}
+	if x.OverrideGracePeriod != false {
+		value := protoreflect.ValueOfBool(x.OverrideGracePeriod)
+		if !f(fd_ProviderPolicy_override_grace_period, value) {
+			return
+		}
+	}
+	if x.GracePeriod != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GracePeriod)
+		if !f(fd_ProviderPolicy_grace_period, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ProviderPolicy) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.ProviderPolicy.provider_uuid":
+		return x.ProviderUuid != ""
+	case "liftedinit.billing.v1.ProviderPolicy.pending_timeout":
+		return x.PendingTimeout != uint64(0)
+	case "liftedinit.billing.v1.ProviderPolicy.auto_acknowledge":
+		return x.AutoAcknowledge != false
+	case "liftedinit.billing.v1.ProviderPolicy.allowed_tenants":
+		return len(x.AllowedTenants) != 0
+	case "liftedinit.billing.v1.ProviderPolicy.denied_tenants":
+		return len(x.DeniedTenants) != 0
+	case "liftedinit.billing.v1.ProviderPolicy.override_grace_period":
+		return x.OverrideGracePeriod != false
+	case "liftedinit.billing.v1.ProviderPolicy.grace_period":
+		return x.GracePeriod != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.ProviderPolicy"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.ProviderPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderPolicy) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.ProviderPolicy.provider_uuid":
+		x.ProviderUuid = ""
+	case "liftedinit.billing.v1.ProviderPolicy.pending_timeout":
+		x.PendingTimeout = uint64(0)
+	case "liftedinit.billing.v1.ProviderPolicy.auto_acknowledge":
+		x.AutoAcknowledge = false
+	case "liftedinit.billing.v1.ProviderPolicy.allowed_tenants":
+		x.AllowedTenants = nil
+	case "liftedinit.billing.v1.ProviderPolicy.denied_tenants":
+		x.DeniedTenants = nil
+	case "liftedinit.billing.v1.ProviderPolicy.override_grace_period":
+		x.OverrideGracePeriod = false
+	case "liftedinit.billing.v1.ProviderPolicy.grace_period":
+		x.GracePeriod = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.ProviderPolicy"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.ProviderPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ProviderPolicy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "liftedinit.billing.v1.ProviderPolicy.provider_uuid":
+		value := x.ProviderUuid
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.billing.v1.ProviderPolicy.pending_timeout":
+		value := x.PendingTimeout
+		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.billing.v1.ProviderPolicy.auto_acknowledge":
+		value := x.AutoAcknowledge
+		return protoreflect.ValueOfBool(value)
+	case "liftedinit.billing.v1.ProviderPolicy.allowed_tenants":
+		if len(x.AllowedTenants) == 0 {
+			return protoreflect.ValueOfList(&_ProviderPolicy_4_list{})
+		}
+		listValue := &_ProviderPolicy_4_list{list: &x.AllowedTenants}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.ProviderPolicy.denied_tenants":
+		if len(x.DeniedTenants) == 0 {
+			return protoreflect.ValueOfList(&_ProviderPolicy_5_list{})
+		}
+		listValue := &_ProviderPolicy_5_list{list: &x.DeniedTenants}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.ProviderPolicy.override_grace_period":
+		value := x.OverrideGracePeriod
+		return protoreflect.ValueOfBool(value)
+	case "liftedinit.billing.v1.ProviderPolicy.grace_period":
+		value := x.GracePeriod
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.ProviderPolicy"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.ProviderPolicy does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderPolicy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.ProviderPolicy.provider_uuid":
+		x.ProviderUuid = value.Interface().(string)
+	case "liftedinit.billing.v1.ProviderPolicy.pending_timeout":
+		x.PendingTimeout = value.Uint()
+	case "liftedinit.billing.v1.ProviderPolicy.auto_acknowledge":
+		x.AutoAcknowledge = value.Bool()
+	case "liftedinit.billing.v1.ProviderPolicy.allowed_tenants":
+		lv := value.List()
+		clv := lv.(*_ProviderPolicy_4_list)
+		x.AllowedTenants = *clv.list
+	case "liftedinit.billing.v1.ProviderPolicy.denied_tenants":
+		lv := value.List()
+		clv := lv.(*_ProviderPolicy_5_list)
+		x.DeniedTenants = *clv.list
+	case "liftedinit.billing.v1.ProviderPolicy.override_grace_period":
+		x.OverrideGracePeriod = value.Bool()
+	case "liftedinit.billing.v1.ProviderPolicy.grace_period":
+		x.GracePeriod = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.ProviderPolicy"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.ProviderPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderPolicy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.ProviderPolicy.allowed_tenants":
+		if x.AllowedTenants == nil {
+			x.AllowedTenants = []string{}
+		}
+		value := &_ProviderPolicy_4_list{list: &x.AllowedTenants}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.ProviderPolicy.denied_tenants":
+		if x.DeniedTenants == nil {
+			x.DeniedTenants = []string{}
+		}
+		value := &_ProviderPolicy_5_list{list: &x.DeniedTenants}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.ProviderPolicy.provider_uuid":
+		panic(fmt.Errorf("field provider_uuid of message liftedinit.billing.v1.ProviderPolicy is not mutable"))
+	case "liftedinit.billing.v1.ProviderPolicy.pending_timeout":
+		panic(fmt.Errorf("field pending_timeout of message liftedinit.billing.v1.ProviderPolicy is not mutable"))
+	case "liftedinit.billing.v1.ProviderPolicy.auto_acknowledge":
+		panic(fmt.Errorf("field auto_acknowledge of message liftedinit.billing.v1.ProviderPolicy is not mutable"))
+	case "liftedinit.billing.v1.ProviderPolicy.override_grace_period":
+		panic(fmt.Errorf("field override_grace_period of message liftedinit.billing.v1.ProviderPolicy is not mutable"))
+	case "liftedinit.billing.v1.ProviderPolicy.grace_period":
+		panic(fmt.Errorf("field grace_period of message liftedinit.billing.v1.ProviderPolicy is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.ProviderPolicy"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.ProviderPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ProviderPolicy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.ProviderPolicy.provider_uuid":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.billing.v1.ProviderPolicy.pending_timeout":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.billing.v1.ProviderPolicy.auto_acknowledge":
+		return protoreflect.ValueOfBool(false)
+	case "liftedinit.billing.v1.ProviderPolicy.allowed_tenants":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ProviderPolicy_4_list{list: &list})
+	case "liftedinit.billing.v1.ProviderPolicy.denied_tenants":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ProviderPolicy_5_list{list: &list})
+	case "liftedinit.billing.v1.ProviderPolicy.override_grace_period":
+		return protoreflect.ValueOfBool(false)
+	case "liftedinit.billing.v1.ProviderPolicy.grace_period":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.ProviderPolicy"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.ProviderPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ProviderPolicy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in liftedinit.billing.v1.ProviderPolicy", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ProviderPolicy) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderPolicy) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ProviderPolicy) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ProviderPolicy) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ProviderPolicy)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ProviderUuid)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PendingTimeout != 0 {
+			n += 1 + runtime.Sov(uint64(x.PendingTimeout))
+		}
+		if x.AutoAcknowledge {
+			n += 2
+		}
+		if len(x.AllowedTenants) > 0 {
+			for _, s := range x.AllowedTenants {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DeniedTenants) > 0 {
+			for _, s := range x.DeniedTenants {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.OverrideGracePeriod {
+			n += 2
+		}
+		if x.GracePeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.GracePeriod))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ProviderPolicy)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.GracePeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GracePeriod))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.OverrideGracePeriod {
+			i--
+			if x.OverrideGracePeriod {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.DeniedTenants) > 0 {
+			for iNdEx := len(x.DeniedTenants) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.DeniedTenants[iNdEx])
+				copy(dAtA[i:], x.DeniedTenants[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DeniedTenants[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.AllowedTenants) > 0 {
+			for iNdEx := len(x.AllowedTenants) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedTenants[iNdEx])
+				copy(dAtA[i:], x.AllowedTenants[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedTenants[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.AutoAcknowledge {
+			i--
+			if x.AutoAcknowledge {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.PendingTimeout != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PendingTimeout))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ProviderUuid) > 0 {
+			i -= len(x.ProviderUuid)
+			copy(dAtA[i:], x.ProviderUuid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProviderUuid)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ProviderPolicy)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProviderPolicy: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProviderPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProviderUuid", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProviderUuid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingTimeout", wireType)
+				}
+				x.PendingTimeout = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PendingTimeout |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoAcknowledge", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AutoAcknowledge = bool(v != 0)
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedTenants", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedTenants = append(x.AllowedTenants, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeniedTenants", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DeniedTenants = append(x.DeniedTenants, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OverrideGracePeriod", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.OverrideGracePeriod = bool(v != 0)
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+				}
+				x.GracePeriod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GracePeriod |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ProviderStats_10_list)(nil)
+
+type _ProviderStats_10_list struct {
+	list *[]uint64
+}
+
+func (x *_ProviderStats_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ProviderStats_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_ProviderStats_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ProviderStats_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ProviderStats_10_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ProviderStats at list field AckLatencyBuckets as it is not of Message kind"))
+}
+
+func (x *_ProviderStats_10_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ProviderStats_10_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_ProviderStats_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ProviderStats                                 protoreflect.MessageDescriptor
+	fd_ProviderStats_provider_uuid                   protoreflect.FieldDescriptor
+	fd_ProviderStats_leases_created                  protoreflect.FieldDescriptor
+	fd_ProviderStats_leases_acknowledged             protoreflect.FieldDescriptor
+	fd_ProviderStats_leases_rejected                 protoreflect.FieldDescriptor
+	fd_ProviderStats_leases_cancelled                protoreflect.FieldDescriptor
+	fd_ProviderStats_leases_expired                  protoreflect.FieldDescriptor
+	fd_ProviderStats_leases_closed                   protoreflect.FieldDescriptor
+	fd_ProviderStats_credit_exhaustion_closures      protoreflect.FieldDescriptor
+	fd_ProviderStats_ack_latency_sum                 protoreflect.FieldDescriptor
+	fd_ProviderStats_ack_latency_buckets             protoreflect.FieldDescriptor
+	fd_ProviderStats_lifetime_sum                    protoreflect.FieldDescriptor
+	fd_ProviderStats_leases_acknowledged_at_creation protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_liftedinit_billing_v1_types_proto_init()
+	md_ProviderStats = File_liftedinit_billing_v1_types_proto.Messages().ByName("ProviderStats")
+	fd_ProviderStats_provider_uuid = md_ProviderStats.Fields().ByName("provider_uuid")
+	fd_ProviderStats_leases_created = md_ProviderStats.Fields().ByName("leases_created")
+	fd_ProviderStats_leases_acknowledged = md_ProviderStats.Fields().ByName("leases_acknowledged")
+	fd_ProviderStats_leases_rejected = md_ProviderStats.Fields().ByName("leases_rejected")
+	fd_ProviderStats_leases_cancelled = md_ProviderStats.Fields().ByName("leases_cancelled")
+	fd_ProviderStats_leases_expired = md_ProviderStats.Fields().ByName("leases_expired")
+	fd_ProviderStats_leases_closed = md_ProviderStats.Fields().ByName("leases_closed")
+	fd_ProviderStats_credit_exhaustion_closures = md_ProviderStats.Fields().ByName("credit_exhaustion_closures")
+	fd_ProviderStats_ack_latency_sum = md_ProviderStats.Fields().ByName("ack_latency_sum")
+	fd_ProviderStats_ack_latency_buckets = md_ProviderStats.Fields().ByName("ack_latency_buckets")
+	fd_ProviderStats_lifetime_sum = md_ProviderStats.Fields().ByName("lifetime_sum")
+	fd_ProviderStats_leases_acknowledged_at_creation = md_ProviderStats.Fields().ByName("leases_acknowledged_at_creation")
+}
+
+var _ protoreflect.Message = (*fastReflection_ProviderStats)(nil)
+
+type fastReflection_ProviderStats ProviderStats
+
+func (x *ProviderStats) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ProviderStats)(x)
+}
+
+func (x *ProviderStats) slowProtoReflect() protoreflect.Message {
+	mi := &file_liftedinit_billing_v1_types_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ProviderStats_messageType fastReflection_ProviderStats_messageType
+var _ protoreflect.MessageType = fastReflection_ProviderStats_messageType{}
+
+type fastReflection_ProviderStats_messageType struct{}
+
+func (x fastReflection_ProviderStats_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ProviderStats)(nil)
+}
+func (x fastReflection_ProviderStats_messageType) New() protoreflect.Message {
+	return new(fastReflection_ProviderStats)
+}
+func (x fastReflection_ProviderStats_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProviderStats
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ProviderStats) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProviderStats
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ProviderStats) Type() protoreflect.MessageType {
+	return _fastReflection_ProviderStats_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ProviderStats) New() protoreflect.Message {
+	return new(fastReflection_ProviderStats)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ProviderStats) Interface() protoreflect.ProtoMessage {
+	return (*ProviderStats)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ProviderStats) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProviderUuid != "" {
+		value := protoreflect.ValueOfString(x.ProviderUuid)
+		if !f(fd_ProviderStats_provider_uuid, value) {
+			return
+		}
+	}
+	if x.LeasesCreated != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LeasesCreated)
+		if !f(fd_ProviderStats_leases_created, value) {
+			return
+		}
+	}
+	if x.LeasesAcknowledged != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LeasesAcknowledged)
+		if !f(fd_ProviderStats_leases_acknowledged, value) {
+			return
+		}
+	}
+	if x.LeasesRejected != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LeasesRejected)
+		if !f(fd_ProviderStats_leases_rejected, value) {
+			return
+		}
+	}
+	if x.LeasesCancelled != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LeasesCancelled)
+		if !f(fd_ProviderStats_leases_cancelled, value) {
+			return
+		}
+	}
+	if x.LeasesExpired != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LeasesExpired)
+		if !f(fd_ProviderStats_leases_expired, value) {
+			return
+		}
+	}
+	if x.LeasesClosed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LeasesClosed)
+		if !f(fd_ProviderStats_leases_closed, value) {
+			return
+		}
+	}
+	if x.CreditExhaustionClosures != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CreditExhaustionClosures)
+		if !f(fd_ProviderStats_credit_exhaustion_closures, value) {
+			return
+		}
+	}
+	if x.AckLatencySum != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AckLatencySum)
+		if !f(fd_ProviderStats_ack_latency_sum, value) {
+			return
+		}
+	}
+	if len(x.AckLatencyBuckets) != 0 {
+		value := protoreflect.ValueOfList(&_ProviderStats_10_list{list: &x.AckLatencyBuckets})
+		if !f(fd_ProviderStats_ack_latency_buckets, value) {
+			return
+		}
+	}
+	if x.LifetimeSum != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LifetimeSum)
+		if !f(fd_ProviderStats_lifetime_sum, value) {
+			return
+		}
+	}
+	if x.LeasesAcknowledgedAtCreation != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LeasesAcknowledgedAtCreation)
+		if !f(fd_ProviderStats_leases_acknowledged_at_creation, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ProviderStats) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.ProviderStats.provider_uuid":
+		return x.ProviderUuid != ""
+	case "liftedinit.billing.v1.ProviderStats.leases_created":
+		return x.LeasesCreated != uint64(0)
+	case "liftedinit.billing.v1.ProviderStats.leases_acknowledged":
+		return x.LeasesAcknowledged != uint64(0)
+	case "liftedinit.billing.v1.ProviderStats.leases_rejected":
+		return x.LeasesRejected != uint64(0)
+	case "liftedinit.billing.v1.ProviderStats.leases_cancelled":
+		return x.LeasesCancelled != uint64(0)
+	case "liftedinit.billing.v1.ProviderStats.leases_expired":
+		return x.LeasesExpired != uint64(0)
+	case "liftedinit.billing.v1.ProviderStats.leases_closed":
+		return x.LeasesClosed != uint64(0)
+	case "liftedinit.billing.v1.ProviderStats.credit_exhaustion_closures":
+		return x.CreditExhaustionClosures != uint64(0)
+	case "liftedinit.billing.v1.ProviderStats.ack_latency_sum":
+		return x.AckLatencySum != uint64(0)
+	case "liftedinit.billing.v1.ProviderStats.ack_latency_buckets":
+		return len(x.AckLatencyBuckets) != 0
+	case "liftedinit.billing.v1.ProviderStats.lifetime_sum":
+		return x.LifetimeSum != uint64(0)
+	case "liftedinit.billing.v1.ProviderStats.leases_acknowledged_at_creation":
+		return x.LeasesAcknowledgedAtCreation != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.ProviderStats"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.ProviderStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderStats) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.ProviderStats.provider_uuid":
+		x.ProviderUuid = ""
+	case "liftedinit.billing.v1.ProviderStats.leases_created":
+		x.LeasesCreated = uint64(0)
+	case "liftedinit.billing.v1.ProviderStats.leases_acknowledged":
+		x.LeasesAcknowledged = uint64(0)
+	case "liftedinit.billing.v1.ProviderStats.leases_rejected":
+		x.LeasesRejected = uint64(0)
+	case "liftedinit.billing.v1.ProviderStats.leases_cancelled":
+		x.LeasesCancelled = uint64(0)
+	case "liftedinit.billing.v1.ProviderStats.leases_expired":
+		x.LeasesExpired = uint64(0)
+	case "liftedinit.billing.v1.ProviderStats.leases_closed":
+		x.LeasesClosed = uint64(0)
+	case "liftedinit.billing.v1.ProviderStats.credit_exhaustion_closures":
+		x.CreditExhaustionClosures = uint64(0)
+	case "liftedinit.billing.v1.ProviderStats.ack_latency_sum":
+		x.AckLatencySum = uint64(0)
+	case "liftedinit.billing.v1.ProviderStats.ack_latency_buckets":
+		x.AckLatencyBuckets = nil
+	case "liftedinit.billing.v1.ProviderStats.lifetime_sum":
+		x.LifetimeSum = uint64(0)
+	case "liftedinit.billing.v1.ProviderStats.leases_acknowledged_at_creation":
+		x.LeasesAcknowledgedAtCreation = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.ProviderStats"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.ProviderStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ProviderStats) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "liftedinit.billing.v1.ProviderStats.provider_uuid":
+		value := x.ProviderUuid
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.billing.v1.ProviderStats.leases_created":
+		value := x.LeasesCreated
+		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.billing.v1.ProviderStats.leases_acknowledged":
+		value := x.LeasesAcknowledged
+		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.billing.v1.ProviderStats.leases_rejected":
+		value := x.LeasesRejected
+		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.billing.v1.ProviderStats.leases_cancelled":
+		value := x.LeasesCancelled
+		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.billing.v1.ProviderStats.leases_expired":
+		value := x.LeasesExpired
+		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.billing.v1.ProviderStats.leases_closed":
+		value := x.LeasesClosed
+		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.billing.v1.ProviderStats.credit_exhaustion_closures":
+		value := x.CreditExhaustionClosures
+		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.billing.v1.ProviderStats.ack_latency_sum":
+		value := x.AckLatencySum
+		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.billing.v1.ProviderStats.ack_latency_buckets":
+		if len(x.AckLatencyBuckets) == 0 {
+			return protoreflect.ValueOfList(&_ProviderStats_10_list{})
+		}
+		listValue := &_ProviderStats_10_list{list: &x.AckLatencyBuckets}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.ProviderStats.lifetime_sum":
+		value := x.LifetimeSum
+		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.billing.v1.ProviderStats.leases_acknowledged_at_creation":
+		value := x.LeasesAcknowledgedAtCreation
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.ProviderStats"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.ProviderStats does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderStats) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.ProviderStats.provider_uuid":
+		x.ProviderUuid = value.Interface().(string)
+	case "liftedinit.billing.v1.ProviderStats.leases_created":
+		x.LeasesCreated = value.Uint()
+	case "liftedinit.billing.v1.ProviderStats.leases_acknowledged":
+		x.LeasesAcknowledged = value.Uint()
+	case "liftedinit.billing.v1.ProviderStats.leases_rejected":
+		x.LeasesRejected = value.Uint()
+	case "liftedinit.billing.v1.ProviderStats.leases_cancelled":
+		x.LeasesCancelled = value.Uint()
+	case "liftedinit.billing.v1.ProviderStats.leases_expired":
+		x.LeasesExpired = value.Uint()
+	case "liftedinit.billing.v1.ProviderStats.leases_closed":
+		x.LeasesClosed = value.Uint()
+	case "liftedinit.billing.v1.ProviderStats.credit_exhaustion_closures":
+		x.CreditExhaustionClosures = value.Uint()
+	case "liftedinit.billing.v1.ProviderStats.ack_latency_sum":
+		x.AckLatencySum = value.Uint()
+	case "liftedinit.billing.v1.ProviderStats.ack_latency_buckets":
+		lv := value.List()
+		clv := lv.(*_ProviderStats_10_list)
+		x.AckLatencyBuckets = *clv.list
+	case "liftedinit.billing.v1.ProviderStats.lifetime_sum":
+		x.LifetimeSum = value.Uint()
+	case "liftedinit.billing.v1.ProviderStats.leases_acknowledged_at_creation":
+		x.LeasesAcknowledgedAtCreation = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.ProviderStats"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.ProviderStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderStats) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.ProviderStats.ack_latency_buckets":
+		if x.AckLatencyBuckets == nil {
+			x.AckLatencyBuckets = []uint64{}
+		}
+		value := &_ProviderStats_10_list{list: &x.AckLatencyBuckets}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.ProviderStats.provider_uuid":
+		panic(fmt.Errorf("field provider_uuid of message liftedinit.billing.v1.ProviderStats is not mutable"))
+	case "liftedinit.billing.v1.ProviderStats.leases_created":
+		panic(fmt.Errorf("field leases_created of message liftedinit.billing.v1.ProviderStats is not mutable"))
+	case "liftedinit.billing.v1.ProviderStats.leases_acknowledged":
+		panic(fmt.Errorf("field leases_acknowledged of message liftedinit.billing.v1.ProviderStats is not mutable"))
+	case "liftedinit.billing.v1.ProviderStats.leases_rejected":
+		panic(fmt.Errorf("field leases_rejected of message liftedinit.billing.v1.ProviderStats is not mutable"))
+	case "liftedinit.billing.v1.ProviderStats.leases_cancelled":
+		panic(fmt.Errorf("field leases_cancelled of message liftedinit.billing.v1.ProviderStats is not mutable"))
+	case "liftedinit.billing.v1.ProviderStats.leases_expired":
+		panic(fmt.Errorf("field leases_expired of message liftedinit.billing.v1.ProviderStats is not mutable"))
+	case "liftedinit.billing.v1.ProviderStats.leases_closed":
+		panic(fmt.Errorf("field leases_closed of message liftedinit.billing.v1.ProviderStats is not mutable"))
+	case "liftedinit.billing.v1.ProviderStats.credit_exhaustion_closures":
+		panic(fmt.Errorf("field credit_exhaustion_closures of message liftedinit.billing.v1.ProviderStats is not mutable"))
+	case "liftedinit.billing.v1.ProviderStats.ack_latency_sum":
+		panic(fmt.Errorf("field ack_latency_sum of message liftedinit.billing.v1.ProviderStats is not mutable"))
+	case "liftedinit.billing.v1.ProviderStats.lifetime_sum":
+		panic(fmt.Errorf("field lifetime_sum of message liftedinit.billing.v1.ProviderStats is not mutable"))
+	case "liftedinit.billing.v1.ProviderStats.leases_acknowledged_at_creation":
+		panic(fmt.Errorf("field leases_acknowledged_at_creation of message liftedinit.billing.v1.ProviderStats is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.ProviderStats"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.ProviderStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ProviderStats) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.ProviderStats.provider_uuid":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.billing.v1.ProviderStats.leases_created":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.billing.v1.ProviderStats.leases_acknowledged":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.billing.v1.ProviderStats.leases_rejected":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.billing.v1.ProviderStats.leases_cancelled":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.billing.v1.ProviderStats.leases_expired":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.billing.v1.ProviderStats.leases_closed":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.billing.v1.ProviderStats.credit_exhaustion_closures":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.billing.v1.ProviderStats.ack_latency_sum":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.billing.v1.ProviderStats.ack_latency_buckets":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_ProviderStats_10_list{list: &list})
+	case "liftedinit.billing.v1.ProviderStats.lifetime_sum":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.billing.v1.ProviderStats.leases_acknowledged_at_creation":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.ProviderStats"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.ProviderStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ProviderStats) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in liftedinit.billing.v1.ProviderStats", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ProviderStats) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderStats) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ProviderStats) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ProviderStats) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ProviderStats)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ProviderUuid)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LeasesCreated != 0 {
+			n += 1 + runtime.Sov(uint64(x.LeasesCreated))
+		}
+		if x.LeasesAcknowledged != 0 {
+			n += 1 + runtime.Sov(uint64(x.LeasesAcknowledged))
+		}
+		if x.LeasesRejected != 0 {
+			n += 1 + runtime.Sov(uint64(x.LeasesRejected))
+		}
+		if x.LeasesCancelled != 0 {
+			n += 1 + runtime.Sov(uint64(x.LeasesCancelled))
+		}
+		if x.LeasesExpired != 0 {
+			n += 1 + runtime.Sov(uint64(x.LeasesExpired))
+		}
+		if x.LeasesClosed != 0 {
+			n += 1 + runtime.Sov(uint64(x.LeasesClosed))
+		}
+		if x.CreditExhaustionClosures != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreditExhaustionClosures))
+		}
+		if x.AckLatencySum != 0 {
+			n += 1 + runtime.Sov(uint64(x.AckLatencySum))
+		}
+		if len(x.AckLatencyBuckets) > 0 {
+			l = 0
+			for _, e := range x.AckLatencyBuckets {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.LifetimeSum != 0 {
+			n += 1 + runtime.Sov(uint64(x.LifetimeSum))
+		}
+		if x.LeasesAcknowledgedAtCreation != 0 {
+			n += 1 + runtime.Sov(uint64(x.LeasesAcknowledgedAtCreation))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ProviderStats)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LeasesAcknowledgedAtCreation != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LeasesAcknowledgedAtCreation))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.LifetimeSum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LifetimeSum))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.AckLatencyBuckets) > 0 {
+			var pksize2 int
+			for _, num := range x.AckLatencyBuckets {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.AckLatencyBuckets {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.AckLatencySum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AckLatencySum))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.CreditExhaustionClosures != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreditExhaustionClosures))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.LeasesClosed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LeasesClosed))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.LeasesExpired != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LeasesExpired))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.LeasesCancelled != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LeasesCancelled))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.LeasesRejected != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LeasesRejected))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.LeasesAcknowledged != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LeasesAcknowledged))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.LeasesCreated != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LeasesCreated))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ProviderUuid) > 0 {
+			i -= len(x.ProviderUuid)
+			copy(dAtA[i:], x.ProviderUuid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProviderUuid)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ProviderStats)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProviderStats: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProviderStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProviderUuid", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProviderUuid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LeasesCreated", wireType)
+				}
+				x.LeasesCreated = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LeasesCreated |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LeasesAcknowledged", wireType)
+				}
+				x.LeasesAcknowledged = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LeasesAcknowledged |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LeasesRejected", wireType)
+				}
+				x.LeasesRejected = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LeasesRejected |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LeasesCancelled", wireType)
+				}
+				x.LeasesCancelled = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LeasesCancelled |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LeasesExpired", wireType)
+				}
+				x.LeasesExpired = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LeasesExpired |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LeasesClosed", wireType)
+				}
+				x.LeasesClosed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LeasesClosed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreditExhaustionClosures", wireType)
+				}
+				x.CreditExhaustionClosures = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CreditExhaustionClosures |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AckLatencySum", wireType)
+				}
+				x.AckLatencySum = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AckLatencySum |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.AckLatencyBuckets = append(x.AckLatencyBuckets, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.AckLatencyBuckets) == 0 {
+						x.AckLatencyBuckets = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.AckLatencyBuckets = append(x.AckLatencyBuckets, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AckLatencyBuckets", wireType)
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LifetimeSum", wireType)
+				}
+				x.LifetimeSum = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LifetimeSum |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LeasesAcknowledgedAtCreation", wireType)
+				}
+				x.LeasesAcknowledgedAtCreation = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LeasesAcknowledgedAtCreation |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CustomDomainTarget              protoreflect.MessageDescriptor
+	fd_CustomDomainTarget_lease_uuid   protoreflect.FieldDescriptor
+	fd_CustomDomainTarget_service_name protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_liftedinit_billing_v1_types_proto_init()
+	md_CustomDomainTarget = File_liftedinit_billing_v1_types_proto.Messages().ByName("CustomDomainTarget")
+	fd_CustomDomainTarget_lease_uuid = md_CustomDomainTarget.Fields().ByName("lease_uuid")
+	fd_CustomDomainTarget_service_name = md_CustomDomainTarget.Fields().ByName("service_name")
+}
+
+var _ protoreflect.Message = (*fastReflection_CustomDomainTarget)(nil)
+
+type fastReflection_CustomDomainTarget CustomDomainTarget
+
+func (x *CustomDomainTarget) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CustomDomainTarget)(x)
+}
+
+func (x *CustomDomainTarget) slowProtoReflect() protoreflect.Message {
+	mi := &file_liftedinit_billing_v1_types_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CustomDomainTarget_messageType fastReflection_CustomDomainTarget_messageType
+var _ protoreflect.MessageType = fastReflection_CustomDomainTarget_messageType{}
+
+type fastReflection_CustomDomainTarget_messageType struct{}
+
+func (x fastReflection_CustomDomainTarget_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CustomDomainTarget)(nil)
+}
+func (x fastReflection_CustomDomainTarget_messageType) New() protoreflect.Message {
+	return new(fastReflection_CustomDomainTarget)
+}
+func (x fastReflection_CustomDomainTarget_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CustomDomainTarget
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CustomDomainTarget) Descriptor() protoreflect.MessageDescriptor {
+	return md_CustomDomainTarget
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CustomDomainTarget) Type() protoreflect.MessageType {
+	return _fastReflection_CustomDomainTarget_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CustomDomainTarget) New() protoreflect.Message {
+	return new(fastReflection_CustomDomainTarget)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CustomDomainTarget) Interface() protoreflect.ProtoMessage {
+	return (*CustomDomainTarget)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CustomDomainTarget) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LeaseUuid != "" {
+		value := protoreflect.ValueOfString(x.LeaseUuid)
+		if !f(fd_CustomDomainTarget_lease_uuid, value) {
+			return
+		}
+	}
+	if x.ServiceName != "" {
+		value := protoreflect.ValueOfString(x.ServiceName)
+		if !f(fd_CustomDomainTarget_service_name, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CustomDomainTarget) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.CustomDomainTarget.lease_uuid":
+		return x.LeaseUuid != ""
+	case "liftedinit.billing.v1.CustomDomainTarget.service_name":
+		return x.ServiceName != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CustomDomainTarget"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.CustomDomainTarget does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CustomDomainTarget) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.CustomDomainTarget.lease_uuid":
+		x.LeaseUuid = ""
+	case "liftedinit.billing.v1.CustomDomainTarget.service_name":
+		x.ServiceName = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CustomDomainTarget"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.CustomDomainTarget does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CustomDomainTarget) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "liftedinit.billing.v1.CustomDomainTarget.lease_uuid":
+		value := x.LeaseUuid
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.billing.v1.CustomDomainTarget.service_name":
+		value := x.ServiceName
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CustomDomainTarget"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.CustomDomainTarget does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CustomDomainTarget) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.CustomDomainTarget.lease_uuid":
+		x.LeaseUuid = value.Interface().(string)
+	case "liftedinit.billing.v1.CustomDomainTarget.service_name":
+		x.ServiceName = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CustomDomainTarget"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.CustomDomainTarget does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CustomDomainTarget) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.CustomDomainTarget.lease_uuid":
+		panic(fmt.Errorf("field lease_uuid of message liftedinit.billing.v1.CustomDomainTarget is not mutable"))
+	case "liftedinit.billing.v1.CustomDomainTarget.service_name":
+		panic(fmt.Errorf("field service_name of message liftedinit.billing.v1.CustomDomainTarget is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CustomDomainTarget"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.CustomDomainTarget does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CustomDomainTarget) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.CustomDomainTarget.lease_uuid":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.billing.v1.CustomDomainTarget.service_name":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CustomDomainTarget"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.CustomDomainTarget does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CustomDomainTarget) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in liftedinit.billing.v1.CustomDomainTarget", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CustomDomainTarget) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CustomDomainTarget) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CustomDomainTarget) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CustomDomainTarget) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CustomDomainTarget)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.LeaseUuid)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ServiceName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CustomDomainTarget)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ServiceName) > 0 {
+			i -= len(x.ServiceName)
+			copy(dAtA[i:], x.ServiceName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ServiceName)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.LeaseUuid) > 0 {
+			i -= len(x.LeaseUuid)
+			copy(dAtA[i:], x.LeaseUuid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LeaseUuid)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CustomDomainTarget)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CustomDomainTarget: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CustomDomainTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LeaseUuid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ServiceName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_CreditAccount_5_list)(nil)
+
+type _CreditAccount_5_list struct {
+	list *[]*types.Coin
+}
+
+func (x *_CreditAccount_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_CreditAccount_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_CreditAccount_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_CreditAccount_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_CreditAccount_5_list) AppendMutable() protoreflect.Value {
+	v := new(types.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_CreditAccount_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_CreditAccount_5_list) NewElement() protoreflect.Value {
+	v := new(types.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_CreditAccount_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_CreditAccount                     protoreflect.MessageDescriptor
+	fd_CreditAccount_tenant              protoreflect.FieldDescriptor
+	fd_CreditAccount_credit_address      protoreflect.FieldDescriptor
+	fd_CreditAccount_active_lease_count  protoreflect.FieldDescriptor
+	fd_CreditAccount_pending_lease_count protoreflect.FieldDescriptor
+	fd_CreditAccount_reserved_amounts    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_liftedinit_billing_v1_types_proto_init()
+	md_CreditAccount = File_liftedinit_billing_v1_types_proto.Messages().ByName("CreditAccount")
+	fd_CreditAccount_tenant = md_CreditAccount.Fields().ByName("tenant")
+	fd_CreditAccount_credit_address = md_CreditAccount.Fields().ByName("credit_address")
+	fd_CreditAccount_active_lease_count = md_CreditAccount.Fields().ByName("active_lease_count")
+	fd_CreditAccount_pending_lease_count = md_CreditAccount.Fields().ByName("pending_lease_count")
+	fd_CreditAccount_reserved_amounts = md_CreditAccount.Fields().ByName("reserved_amounts")
+}
+
+var _ protoreflect.Message = (*fastReflection_CreditAccount)(nil)
+
+type fastReflection_CreditAccount CreditAccount
+
+func (x *CreditAccount) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CreditAccount)(x)
+}
+
+func (x *CreditAccount) slowProtoReflect() protoreflect.Message {
+	mi := &file_liftedinit_billing_v1_types_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CreditAccount_messageType fastReflection_CreditAccount_messageType
+var _ protoreflect.MessageType = fastReflection_CreditAccount_messageType{}
+
+type fastReflection_CreditAccount_messageType struct{}
+
+func (x fastReflection_CreditAccount_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CreditAccount)(nil)
+}
+func (x fastReflection_CreditAccount_messageType) New() protoreflect.Message {
+	return new(fastReflection_CreditAccount)
+}
+func (x fastReflection_CreditAccount_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CreditAccount
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CreditAccount) Descriptor() protoreflect.MessageDescriptor {
+	return md_CreditAccount
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CreditAccount) Type() protoreflect.MessageType {
+	return _fastReflection_CreditAccount_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CreditAccount) New() protoreflect.Message {
+	return new(fastReflection_CreditAccount)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CreditAccount) Interface() protoreflect.ProtoMessage {
+	return (*CreditAccount)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CreditAccount) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Tenant != "" {
+		value := protoreflect.ValueOfString(x.Tenant)
+		if !f(fd_CreditAccount_tenant, value) {
+			return
+		}
+	}
+	if x.CreditAddress != "" {
+		value := protoreflect.ValueOfString(x.CreditAddress)
+		if !f(fd_CreditAccount_credit_address, value) {
+			return
+		}
+	}
+	if x.ActiveLeaseCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ActiveLeaseCount)
+		if !f(fd_CreditAccount_active_lease_count, value) {
+			return
+		}
+	}
+	if x.PendingLeaseCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PendingLeaseCount)
+		if !f(fd_CreditAccount_pending_lease_count, value) {
+			return
+		}
+	}
+	if len(x.ReservedAmounts) != 0 {
+		value := protoreflect.ValueOfList(&_CreditAccount_5_list{list: &x.ReservedAmounts})
+		if !f(fd_CreditAccount_reserved_amounts, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CreditAccount) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.CreditAccount.tenant":
+		return x.Tenant != ""
+	case "liftedinit.billing.v1.CreditAccount.credit_address":
+		return x.CreditAddress != ""
+	case "liftedinit.billing.v1.CreditAccount.active_lease_count":
+		return x.ActiveLeaseCount != uint64(0)
+	case "liftedinit.billing.v1.CreditAccount.pending_lease_count":
+		return x.PendingLeaseCount != uint64(0)
+	case "liftedinit.billing.v1.CreditAccount.reserved_amounts":
+		return len(x.ReservedAmounts) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CreditAccount"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.CreditAccount does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CreditAccount) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.CreditAccount.tenant":
+		x.Tenant = ""
+	case "liftedinit.billing.v1.CreditAccount.credit_address":
+		x.CreditAddress = ""
+	case "liftedinit.billing.v1.CreditAccount.active_lease_count":
+		x.ActiveLeaseCount = uint64(0)
+	case "liftedinit.billing.v1.CreditAccount.pending_lease_count":
+		x.PendingLeaseCount = uint64(0)
+	case "liftedinit.billing.v1.CreditAccount.reserved_amounts":
+		x.ReservedAmounts = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CreditAccount"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.CreditAccount does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CreditAccount) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "liftedinit.billing.v1.CreditAccount.tenant":
+		value := x.Tenant
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.billing.v1.CreditAccount.credit_address":
+		value := x.CreditAddress
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.billing.v1.CreditAccount.active_lease_count":
+		value := x.ActiveLeaseCount
+		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.billing.v1.CreditAccount.pending_lease_count":
+		value := x.PendingLeaseCount
+		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.billing.v1.CreditAccount.reserved_amounts":
+		if len(x.ReservedAmounts) == 0 {
+			return protoreflect.ValueOfList(&_CreditAccount_5_list{})
+		}
+		listValue := &_CreditAccount_5_list{list: &x.ReservedAmounts}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CreditAccount"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.CreditAccount does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CreditAccount) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.CreditAccount.tenant":
+		x.Tenant = value.Interface().(string)
+	case "liftedinit.billing.v1.CreditAccount.credit_address":
+		x.CreditAddress = value.Interface().(string)
+	case "liftedinit.billing.v1.CreditAccount.active_lease_count":
+		x.ActiveLeaseCount = value.Uint()
+	case "liftedinit.billing.v1.CreditAccount.pending_lease_count":
+		x.PendingLeaseCount = value.Uint()
+	case "liftedinit.billing.v1.CreditAccount.reserved_amounts":
+		lv := value.List()
+		clv := lv.(*_CreditAccount_5_list)
+		x.ReservedAmounts = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CreditAccount"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.CreditAccount does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CreditAccount) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.CreditAccount.reserved_amounts":
+		if x.ReservedAmounts == nil {
+			x.ReservedAmounts = []*types.Coin{}
+		}
+		value := &_CreditAccount_5_list{list: &x.ReservedAmounts}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.CreditAccount.tenant":
+		panic(fmt.Errorf("field tenant of message liftedinit.billing.v1.CreditAccount is not mutable"))
+	case "liftedinit.billing.v1.CreditAccount.credit_address":
+		panic(fmt.Errorf("field credit_address of message liftedinit.billing.v1.CreditAccount is not mutable"))
+	case "liftedinit.billing.v1.CreditAccount.active_lease_count":
+		panic(fmt.Errorf("field active_lease_count of message liftedinit.billing.v1.CreditAccount is not mutable"))
+	case "liftedinit.billing.v1.CreditAccount.pending_lease_count":
+		panic(fmt.Errorf("field pending_lease_count of message liftedinit.billing.v1.CreditAccount is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CreditAccount"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.CreditAccount does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CreditAccount) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.CreditAccount.tenant":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.billing.v1.CreditAccount.credit_address":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.billing.v1.CreditAccount.active_lease_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.billing.v1.CreditAccount.pending_lease_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.billing.v1.CreditAccount.reserved_amounts":
+		list := []*types.Coin{}
+		return protoreflect.ValueOfList(&_CreditAccount_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CreditAccount"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.CreditAccount does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CreditAccount) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in liftedinit.billing.v1.CreditAccount", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CreditAccount) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CreditAccount) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CreditAccount) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CreditAccount) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CreditAccount)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Tenant)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CreditAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ActiveLeaseCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ActiveLeaseCount))
+		}
+		if x.PendingLeaseCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.PendingLeaseCount))
+		}
+		if len(x.ReservedAmounts) > 0 {
+			for _, e := range x.ReservedAmounts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CreditAccount)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ReservedAmounts) > 0 {
+			for iNdEx := len(x.ReservedAmounts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ReservedAmounts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.PendingLeaseCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PendingLeaseCount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ActiveLeaseCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ActiveLeaseCount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.CreditAddress) > 0 {
+			i -= len(x.CreditAddress)
+			copy(dAtA[i:], x.CreditAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CreditAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Tenant) > 0 {
+			i -= len(x.Tenant)
+			copy(dAtA[i:], x.Tenant)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tenant)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CreditAccount)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CreditAccount: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CreditAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Tenant = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreditAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CreditAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActiveLeaseCount", wireType)
+				}
+				x.ActiveLeaseCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ActiveLeaseCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingLeaseCount", wireType)
+				}
+				x.PendingLeaseCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PendingLeaseCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReservedAmounts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReservedAmounts = append(x.ReservedAmounts, &types.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReservedAmounts[len(x.ReservedAmounts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CreditDeposit              protoreflect.MessageDescriptor
+	fd_CreditDeposit_tenant       protoreflect.FieldDescriptor
+	fd_CreditDeposit_amount       protoreflect.FieldDescriptor
+	fd_CreditDeposit_deposited_at protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_liftedinit_billing_v1_types_proto_init()
+	md_CreditDeposit = File_liftedinit_billing_v1_types_proto.Messages().ByName("CreditDeposit")
+	fd_CreditDeposit_tenant = md_CreditDeposit.Fields().ByName("tenant")
+	fd_CreditDeposit_amount = md_CreditDeposit.Fields().ByName("amount")
+	fd_CreditDeposit_deposited_at = md_CreditDeposit.Fields().ByName("deposited_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_CreditDeposit)(nil)
+
+type fastReflection_CreditDeposit CreditDeposit
+
+func (x *CreditDeposit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CreditDeposit)(x)
+}
+
+func (x *CreditDeposit) slowProtoReflect() protoreflect.Message {
+	mi := &file_liftedinit_billing_v1_types_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CreditDeposit_messageType fastReflection_CreditDeposit_messageType
+var _ protoreflect.MessageType = fastReflection_CreditDeposit_messageType{}
+
+type fastReflection_CreditDeposit_messageType struct{}
+
+func (x fastReflection_CreditDeposit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CreditDeposit)(nil)
+}
+func (x fastReflection_CreditDeposit_messageType) New() protoreflect.Message {
+	return new(fastReflection_CreditDeposit)
+}
+func (x fastReflection_CreditDeposit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CreditDeposit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CreditDeposit) Descriptor() protoreflect.MessageDescriptor {
+	return md_CreditDeposit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CreditDeposit) Type() protoreflect.MessageType {
+	return _fastReflection_CreditDeposit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CreditDeposit) New() protoreflect.Message {
+	return new(fastReflection_CreditDeposit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CreditDeposit) Interface() protoreflect.ProtoMessage {
+	return (*CreditDeposit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CreditDeposit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Tenant != "" {
+		value := protoreflect.ValueOfString(x.Tenant)
+		if !f(fd_CreditDeposit_tenant, value) {
+			return
+		}
+	}
+	if x.Amount != nil {
+		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		if !f(fd_CreditDeposit_amount, value) {
+			return
+		}
+	}
+	if x.DepositedAt != nil {
+		value := protoreflect.ValueOfMessage(x.DepositedAt.ProtoReflect())
+		if !f(fd_CreditDeposit_deposited_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CreditDeposit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.CreditDeposit.tenant":
+		return x.Tenant != ""
+	case "liftedinit.billing.v1.CreditDeposit.amount":
+		return x.Amount != nil
+	case "liftedinit.billing.v1.CreditDeposit.deposited_at":
+		return x.DepositedAt != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CreditDeposit"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.CreditDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CreditDeposit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.CreditDeposit.tenant":
+		x.Tenant = ""
+	case "liftedinit.billing.v1.CreditDeposit.amount":
+		x.Amount = nil
+	case "liftedinit.billing.v1.CreditDeposit.deposited_at":
+		x.DepositedAt = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CreditDeposit"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.CreditDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CreditDeposit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "liftedinit.billing.v1.CreditDeposit.tenant":
+		value := x.Tenant
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.billing.v1.CreditDeposit.amount":
+		value := x.Amount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "liftedinit.billing.v1.CreditDeposit.deposited_at":
+		value := x.DepositedAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CreditDeposit"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.CreditDeposit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CreditDeposit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.CreditDeposit.tenant":
+		x.Tenant = value.Interface().(string)
+	case "liftedinit.billing.v1.CreditDeposit.amount":
+		x.Amount = value.Message().Interface().(*types.Coin)
+	case "liftedinit.billing.v1.CreditDeposit.deposited_at":
+		x.DepositedAt = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CreditDeposit"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.CreditDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CreditDeposit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.CreditDeposit.amount":
+		if x.Amount == nil {
+			x.Amount = new(types.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+	case "liftedinit.billing.v1.CreditDeposit.deposited_at":
+		if x.DepositedAt == nil {
+			x.DepositedAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.DepositedAt.ProtoReflect())
+	case "liftedinit.billing.v1.CreditDeposit.tenant":
+		panic(fmt.Errorf("field tenant of message liftedinit.billing.v1.CreditDeposit is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CreditDeposit"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.CreditDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CreditDeposit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.CreditDeposit.tenant":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.billing.v1.CreditDeposit.amount":
+		m := new(types.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "liftedinit.billing.v1.CreditDeposit.deposited_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.CreditDeposit"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.CreditDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CreditDeposit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in liftedinit.billing.v1.CreditDeposit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CreditDeposit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CreditDeposit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CreditDeposit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CreditDeposit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CreditDeposit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Tenant)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Amount != nil {
+			l = options.Size(x.Amount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DepositedAt != nil {
+			l = options.Size(x.DepositedAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CreditDeposit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.DepositedAt != nil {
+			encoded, err := options.Marshal(x.DepositedAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Amount != nil {
+			encoded, err := options.Marshal(x.Amount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Tenant) > 0 {
+			i -= len(x.Tenant)
+			copy(dAtA[i:], x.Tenant)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tenant)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CreditDeposit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CreditDeposit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CreditDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Tenant = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Amount == nil {
+					x.Amount = &types.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositedAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DepositedAt == nil {
+					x.DepositedAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DepositedAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_PromoGrant_4_list)(nil)
+
+type _PromoGrant_4_list struct {
+	list *[]*types.Coin
+}
+
+func (x *_PromoGrant_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PromoGrant_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PromoGrant_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PromoGrant_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PromoGrant_4_list) AppendMutable() protoreflect.Value {
+	v := new(types.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PromoGrant_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PromoGrant_4_list) NewElement() protoreflect.Value {
+	v := new(types.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PromoGrant_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_PromoGrant_5_list)(nil)
+
+type _PromoGrant_5_list struct {
+	list *[]*types.Coin
+}
+
+func (x *_PromoGrant_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PromoGrant_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PromoGrant_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PromoGrant_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*types.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PromoGrant_5_list) AppendMutable() protoreflect.Value {
+	v := new(types.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PromoGrant_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PromoGrant_5_list) NewElement() protoreflect.Value {
+	v := new(types.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PromoGrant_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_PromoGrant_8_list)(nil)
+
+type _PromoGrant_8_list struct {
+	list *[]string
+}
+
+func (x *_PromoGrant_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PromoGrant_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_PromoGrant_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PromoGrant_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PromoGrant_8_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message PromoGrant at list field ProviderUuids as it is not of Message kind"))
+}
+
+func (x *_PromoGrant_8_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PromoGrant_8_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_PromoGrant_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_PromoGrant_9_list)(nil)
+
+type _PromoGrant_9_list struct {
+	list *[]string
+}
+
+func (x *_PromoGrant_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PromoGrant_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_PromoGrant_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PromoGrant_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PromoGrant_9_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message PromoGrant at list field SkuUuids as it is not of Message kind"))
+}
+
+func (x *_PromoGrant_9_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PromoGrant_9_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_PromoGrant_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PromoGrant                protoreflect.MessageDescriptor
+	fd_PromoGrant_id             protoreflect.FieldDescriptor
+	fd_PromoGrant_tenant         protoreflect.FieldDescriptor
+	fd_PromoGrant_granter        protoreflect.FieldDescriptor
+	fd_PromoGrant_amount         protoreflect.FieldDescriptor
+	fd_PromoGrant_remaining      protoreflect.FieldDescriptor
+	fd_PromoGrant_created_at     protoreflect.FieldDescriptor
+	fd_PromoGrant_expires_at     protoreflect.FieldDescriptor
+	fd_PromoGrant_provider_uuids protoreflect.FieldDescriptor
+	fd_PromoGrant_sku_uuids      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_liftedinit_billing_v1_types_proto_init()
+	md_PromoGrant = File_liftedinit_billing_v1_types_proto.Messages().ByName("PromoGrant")
+	fd_PromoGrant_id = md_PromoGrant.Fields().ByName("id")
+	fd_PromoGrant_tenant = md_PromoGrant.Fields().ByName("tenant")
+	fd_PromoGrant_granter = md_PromoGrant.Fields().ByName("granter")
+	fd_PromoGrant_amount = md_PromoGrant.Fields().ByName("amount")
+	fd_PromoGrant_remaining = md_PromoGrant.Fields().ByName("remaining")
+	fd_PromoGrant_created_at = md_PromoGrant.Fields().ByName("created_at")
+	fd_PromoGrant_expires_at = md_PromoGrant.Fields().ByName("expires_at")
+	fd_PromoGrant_provider_uuids = md_PromoGrant.Fields().ByName("provider_uuids")
+	fd_PromoGrant_sku_uuids = md_PromoGrant.Fields().ByName("sku_uuids")
+}
+
+var _ protoreflect.Message = (*fastReflection_PromoGrant)(nil)
+
+type fastReflection_PromoGrant PromoGrant
+
+func (x *PromoGrant) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PromoGrant)(x)
+}
+
+func (x *PromoGrant) slowProtoReflect() protoreflect.Message {
+	mi := &file_liftedinit_billing_v1_types_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PromoGrant_messageType fastReflection_PromoGrant_messageType
+var _ protoreflect.MessageType = fastReflection_PromoGrant_messageType{}
+
+type fastReflection_PromoGrant_messageType struct{}
+
+func (x fastReflection_PromoGrant_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PromoGrant)(nil)
+}
+func (x fastReflection_PromoGrant_messageType) New() protoreflect.Message {
+	return new(fastReflection_PromoGrant)
+}
+func (x fastReflection_PromoGrant_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PromoGrant
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PromoGrant) Descriptor() protoreflect.MessageDescriptor {
+	return md_PromoGrant
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PromoGrant) Type() protoreflect.MessageType {
+	return _fastReflection_PromoGrant_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PromoGrant) New() protoreflect.Message {
+	return new(fastReflection_PromoGrant)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PromoGrant) Interface() protoreflect.ProtoMessage {
+	return (*PromoGrant)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PromoGrant) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_PromoGrant_id, value) {
+			return
+		}
+	}
+	if x.Tenant != "" {
+		value := protoreflect.ValueOfString(x.Tenant)
+		if !f(fd_PromoGrant_tenant, value) {
+			return
+		}
+	}
+	if x.Granter != "" {
+		value := protoreflect.ValueOfString(x.Granter)
+		if !f(fd_PromoGrant_granter, value) {
+			return
+		}
+	}
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_PromoGrant_4_list{list: &x.Amount})
+		if !f(fd_PromoGrant_amount, value) {
+			return
+		}
+	}
+	if len(x.Remaining) != 0 {
+		value := protoreflect.ValueOfList(&_PromoGrant_5_list{list: &x.Remaining})
+		if !f(fd_PromoGrant_remaining, value) {
+			return
+		}
+	}
+	if x.CreatedAt != nil {
+		value := protoreflect.ValueOfMessage(x.CreatedAt.ProtoReflect())
+		if !f(fd_PromoGrant_created_at, value) {
+			return
+		}
+	}
+	if x.ExpiresAt != nil {
+		value := protoreflect.ValueOfMessage(x.ExpiresAt.ProtoReflect())
+		if !f(fd_PromoGrant_expires_at, value) {
+			return
+		}
+	}
+	if len(x.ProviderUuids) != 0 {
+		value := protoreflect.ValueOfList(&_PromoGrant_8_list{list: &x.ProviderUuids})
+		if !f(fd_PromoGrant_provider_uuids, value) {
+			return
+		}
+	}
+	if len(x.SkuUuids) != 0 {
+		value := protoreflect.ValueOfList(&_PromoGrant_9_list{list: &x.SkuUuids})
+		if !f(fd_PromoGrant_sku_uuids, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PromoGrant) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.PromoGrant.id":
+		return x.Id != uint64(0)
+	case "liftedinit.billing.v1.PromoGrant.tenant":
+		return x.Tenant != ""
+	case "liftedinit.billing.v1.PromoGrant.granter":
+		return x.Granter != ""
+	case "liftedinit.billing.v1.PromoGrant.amount":
+		return len(x.Amount) != 0
+	case "liftedinit.billing.v1.PromoGrant.remaining":
+		return len(x.Remaining) != 0
+	case "liftedinit.billing.v1.PromoGrant.created_at":
+		return x.CreatedAt != nil
+	case "liftedinit.billing.v1.PromoGrant.expires_at":
+		return x.ExpiresAt != nil
+	case "liftedinit.billing.v1.PromoGrant.provider_uuids":
+		return len(x.ProviderUuids) != 0
+	case "liftedinit.billing.v1.PromoGrant.sku_uuids":
+		return len(x.SkuUuids) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.PromoGrant"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.PromoGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PromoGrant) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.PromoGrant.id":
+		x.Id = uint64(0)
+	case "liftedinit.billing.v1.PromoGrant.tenant":
+		x.Tenant = ""
+	case "liftedinit.billing.v1.PromoGrant.granter":
+		x.Granter = ""
+	case "liftedinit.billing.v1.PromoGrant.amount":
+		x.Amount = nil
+	case "liftedinit.billing.v1.PromoGrant.remaining":
+		x.Remaining = nil
+	case "liftedinit.billing.v1.PromoGrant.created_at":
+		x.CreatedAt = nil
+	case "liftedinit.billing.v1.PromoGrant.expires_at":
+		x.ExpiresAt = nil
+	case "liftedinit.billing.v1.PromoGrant.provider_uuids":
+		x.ProviderUuids = nil
+	case "liftedinit.billing.v1.PromoGrant.sku_uuids":
+		x.SkuUuids = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.PromoGrant"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.PromoGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PromoGrant) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "liftedinit.billing.v1.PromoGrant.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.billing.v1.PromoGrant.tenant":
+		value := x.Tenant
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.billing.v1.PromoGrant.granter":
+		value := x.Granter
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.billing.v1.PromoGrant.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_PromoGrant_4_list{})
+		}
+		listValue := &_PromoGrant_4_list{list: &x.Amount}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.PromoGrant.remaining":
+		if len(x.Remaining) == 0 {
+			return protoreflect.ValueOfList(&_PromoGrant_5_list{})
+		}
+		listValue := &_PromoGrant_5_list{list: &x.Remaining}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.PromoGrant.created_at":
+		value := x.CreatedAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "liftedinit.billing.v1.PromoGrant.expires_at":
+		value := x.ExpiresAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "liftedinit.billing.v1.PromoGrant.provider_uuids":
+		if len(x.ProviderUuids) == 0 {
+			return protoreflect.ValueOfList(&_PromoGrant_8_list{})
+		}
+		listValue := &_PromoGrant_8_list{list: &x.ProviderUuids}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.billing.v1.PromoGrant.sku_uuids":
+		if len(x.SkuUuids) == 0 {
+			return protoreflect.ValueOfList(&_PromoGrant_9_list{})
+		}
+		listValue := &_PromoGrant_9_list{list: &x.SkuUuids}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.PromoGrant"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.PromoGrant does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PromoGrant) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.PromoGrant.id":
+		x.Id = value.Uint()
+	case "liftedinit.billing.v1.PromoGrant.tenant":
+		x.Tenant = value.Interface().(string)
+	case "liftedinit.billing.v1.PromoGrant.granter":
+		x.Granter = value.Interface().(string)
+	case "liftedinit.billing.v1.PromoGrant.amount":
+		lv := value.List()
+		clv := lv.(*_PromoGrant_4_list)
+		x.Amount = *clv.list
+	case "liftedinit.billing.v1.PromoGrant.remaining":
+		lv := value.List()
+		clv := lv.(*_PromoGrant_5_list)
+		x.Remaining = *clv.list
+	case "liftedinit.billing.v1.PromoGrant.created_at":
+		x.CreatedAt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "liftedinit.billing.v1.PromoGrant.expires_at":
+		x.ExpiresAt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "liftedinit.billing.v1.PromoGrant.provider_uuids":
+		lv := value.List()
+		clv := lv.(*_PromoGrant_8_list)
+		x.ProviderUuids = *clv.list
+	case "liftedinit.billing.v1.PromoGrant.sku_uuids":
+		lv := value.List()
+		clv := lv.(*_PromoGrant_9_list)
+		x.SkuUuids = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.PromoGrant"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.PromoGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PromoGrant) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.PromoGrant.amount":
+		if x.Amount == nil {
+			x.Amount = []*types.Coin{}
+		}
+		value := &_PromoGrant_4_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.PromoGrant.remaining":
+		if x.Remaining == nil {
+			x.Remaining = []*types.Coin{}
+		}
+		value := &_PromoGrant_5_list{list: &x.Remaining}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.PromoGrant.created_at":
+		if x.CreatedAt == nil {
+			x.CreatedAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.CreatedAt.ProtoReflect())
+	case "liftedinit.billing.v1.PromoGrant.expires_at":
+		if x.ExpiresAt == nil {
+			x.ExpiresAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExpiresAt.ProtoReflect())
+	case "liftedinit.billing.v1.PromoGrant.provider_uuids":
+		if x.ProviderUuids == nil {
+			x.ProviderUuids = []string{}
+		}
+		value := &_PromoGrant_8_list{list: &x.ProviderUuids}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.PromoGrant.sku_uuids":
+		if x.SkuUuids == nil {
+			x.SkuUuids = []string{}
+		}
+		value := &_PromoGrant_9_list{list: &x.SkuUuids}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.billing.v1.PromoGrant.id":
+		panic(fmt.Errorf("field id of message liftedinit.billing.v1.PromoGrant is not mutable"))
+	case "liftedinit.billing.v1.PromoGrant.tenant":
+		panic(fmt.Errorf("field tenant of message liftedinit.billing.v1.PromoGrant is not mutable"))
+	case "liftedinit.billing.v1.PromoGrant.granter":
+		panic(fmt.Errorf("field granter of message liftedinit.billing.v1.PromoGrant is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.PromoGrant"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.PromoGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PromoGrant) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.PromoGrant.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.billing.v1.PromoGrant.tenant":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.billing.v1.PromoGrant.granter":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.billing.v1.PromoGrant.amount":
+		list := []*types.Coin{}
+		return protoreflect.ValueOfList(&_PromoGrant_4_list{list: &list})
+	case "liftedinit.billing.v1.PromoGrant.remaining":
+		list := []*types.Coin{}
+		return protoreflect.ValueOfList(&_PromoGrant_5_list{list: &list})
+	case "liftedinit.billing.v1.PromoGrant.created_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "liftedinit.billing.v1.PromoGrant.expires_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "liftedinit.billing.v1.PromoGrant.provider_uuids":
+		list := []string{}
+		return protoreflect.ValueOfList(&_PromoGrant_8_list{list: &list})
+	case "liftedinit.billing.v1.PromoGrant.sku_uuids":
+		list := []string{}
+		return protoreflect.ValueOfList(&_PromoGrant_9_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.PromoGrant"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.PromoGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PromoGrant) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in liftedinit.billing.v1.PromoGrant", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PromoGrant) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PromoGrant) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PromoGrant) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PromoGrant) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PromoGrant)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Tenant)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Granter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Remaining) > 0 {
+			for _, e := range x.Remaining {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.CreatedAt != nil {
+			l = options.Size(x.CreatedAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExpiresAt != nil {
+			l = options.Size(x.ExpiresAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ProviderUuids) > 0 {
+			for _, s := range x.ProviderUuids {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.SkuUuids) > 0 {
+			for _, s := range x.SkuUuids {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PromoGrant)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.SkuUuids) > 0 {
+			for iNdEx := len(x.SkuUuids) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.SkuUuids[iNdEx])
+				copy(dAtA[i:], x.SkuUuids[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SkuUuids[iNdEx])))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.ProviderUuids) > 0 {
+			for iNdEx := len(x.ProviderUuids) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ProviderUuids[iNdEx])
+				copy(dAtA[i:], x.ProviderUuids[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProviderUuids[iNdEx])))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if x.ExpiresAt != nil {
+			encoded, err := options.Marshal(x.ExpiresAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.CreatedAt != nil {
+			encoded, err := options.Marshal(x.CreatedAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Remaining) > 0 {
+			for iNdEx := len(x.Remaining) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Remaining[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Granter) > 0 {
+			i -= len(x.Granter)
+			copy(dAtA[i:], x.Granter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Granter)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Tenant) > 0 {
+			i -= len(x.Tenant)
+			copy(dAtA[i:], x.Tenant)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tenant)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PromoGrant)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PromoGrant: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PromoGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Tenant = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Granter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = append(x.Amount, &types.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Remaining = append(x.Remaining, &types.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Remaining[len(x.Remaining)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CreatedAt == nil {
+					x.CreatedAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreatedAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExpiresAt == nil {
+					x.ExpiresAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpiresAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProviderUuids", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProviderUuids = append(x.ProviderUuids, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SkuUuids", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SkuUuids = append(x.SkuUuids, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ExchangeRate             protoreflect.MessageDescriptor
+	fd_ExchangeRate_denom       protoreflect.FieldDescriptor
+	fd_ExchangeRate_rate        protoreflect.FieldDescriptor
+	fd_ExchangeRate_valid_from  protoreflect.FieldDescriptor
+	fd_ExchangeRate_valid_until protoreflect.FieldDescriptor
+	fd_ExchangeRate_set_by      protoreflect.FieldDescriptor
+	fd_ExchangeRate_set_at      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_liftedinit_billing_v1_types_proto_init()
+	md_ExchangeRate = File_liftedinit_billing_v1_types_proto.Messages().ByName("ExchangeRate")
+	fd_ExchangeRate_denom = md_ExchangeRate.Fields().ByName("denom")
+	fd_ExchangeRate_rate = md_ExchangeRate.Fields().ByName("rate")
+	fd_ExchangeRate_valid_from = md_ExchangeRate.Fields().ByName("valid_from")
+	fd_ExchangeRate_valid_until = md_ExchangeRate.Fields().ByName("valid_until")
+	fd_ExchangeRate_set_by = md_ExchangeRate.Fields().ByName("set_by")
+	fd_ExchangeRate_set_at = md_ExchangeRate.Fields().ByName("set_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_ExchangeRate)(nil)
+
+type fastReflection_ExchangeRate ExchangeRate
+
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ExchangeRate)(x)
+}
+
+func (x *ExchangeRate) slowProtoReflect() protoreflect.Message {
+	mi := &file_liftedinit_billing_v1_types_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ExchangeRate_messageType fastReflection_ExchangeRate_messageType
+var _ protoreflect.MessageType = fastReflection_ExchangeRate_messageType{}
+
+type fastReflection_ExchangeRate_messageType struct{}
+
+func (x fastReflection_ExchangeRate_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ExchangeRate)(nil)
+}
+func (x fastReflection_ExchangeRate_messageType) New() protoreflect.Message {
+	return new(fastReflection_ExchangeRate)
+}
+func (x fastReflection_ExchangeRate_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExchangeRate
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ExchangeRate) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExchangeRate
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ExchangeRate) Type() protoreflect.MessageType {
+	return _fastReflection_ExchangeRate_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ExchangeRate) New() protoreflect.Message {
+	return new(fastReflection_ExchangeRate)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ExchangeRate) Interface() protoreflect.ProtoMessage {
+	return (*ExchangeRate)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ExchangeRate) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_ExchangeRate_denom, value) {
+			return
+		}
+	}
+	if x.Rate != "" {
+		value := protoreflect.ValueOfString(x.Rate)
+		if !f(fd_ExchangeRate_rate, value) {
+			return
+		}
+	}
+	if x.ValidFrom != nil {
+		value := protoreflect.ValueOfMessage(x.ValidFrom.ProtoReflect())
+		if !f(fd_ExchangeRate_valid_from, value) {
+			return
+		}
+	}
+	if x.ValidUntil != nil {
+		value := protoreflect.ValueOfMessage(x.ValidUntil.ProtoReflect())
+		if !f(fd_ExchangeRate_valid_until, value) {
+			return
+		}
+	}
+	if x.SetBy != "" {
+		value := protoreflect.ValueOfString(x.SetBy)
+		if !f(fd_ExchangeRate_set_by, value) {
+			return
+		}
+	}
+	if x.SetAt != nil {
+		value := protoreflect.ValueOfMessage(x.SetAt.ProtoReflect())
+		if !f(fd_ExchangeRate_set_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ExchangeRate) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.ExchangeRate.denom":
+		return x.Denom != ""
+	case "liftedinit.billing.v1.ExchangeRate.rate":
+		return x.Rate != ""
+	case "liftedinit.billing.v1.ExchangeRate.valid_from":
+		return x.ValidFrom != nil
+	case "liftedinit.billing.v1.ExchangeRate.valid_until":
+		return x.ValidUntil != nil
+	case "liftedinit.billing.v1.ExchangeRate.set_by":
+		return x.SetBy != ""
+	case "liftedinit.billing.v1.ExchangeRate.set_at":
+		return x.SetAt != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.ExchangeRate"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.ExchangeRate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExchangeRate) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.ExchangeRate.denom":
+		x.Denom = ""
+	case "liftedinit.billing.v1.ExchangeRate.rate":
+		x.Rate = ""
+	case "liftedinit.billing.v1.ExchangeRate.valid_from":
+		x.ValidFrom = nil
+	case "liftedinit.billing.v1.ExchangeRate.valid_until":
+		x.ValidUntil = nil
+	case "liftedinit.billing.v1.ExchangeRate.set_by":
+		x.SetBy = ""
+	case "liftedinit.billing.v1.ExchangeRate.set_at":
+		x.SetAt = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.ExchangeRate"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.ExchangeRate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ExchangeRate) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "liftedinit.billing.v1.ExchangeRate.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.billing.v1.ExchangeRate.rate":
+		value := x.Rate
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.billing.v1.ExchangeRate.valid_from":
+		value := x.ValidFrom
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "liftedinit.billing.v1.ExchangeRate.valid_until":
+		value := x.ValidUntil
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "liftedinit.billing.v1.ExchangeRate.set_by":
+		value := x.SetBy
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.billing.v1.ExchangeRate.set_at":
+		value := x.SetAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.ExchangeRate"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.ExchangeRate does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExchangeRate) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.ExchangeRate.denom":
+		x.Denom = value.Interface().(string)
+	case "liftedinit.billing.v1.ExchangeRate.rate":
+		x.Rate = value.Interface().(string)
+	case "liftedinit.billing.v1.ExchangeRate.valid_from":
+		x.ValidFrom = value.Message().Interface().(*timestamppb.Timestamp)
+	case "liftedinit.billing.v1.ExchangeRate.valid_until":
+		x.ValidUntil = value.Message().Interface().(*timestamppb.Timestamp)
+	case "liftedinit.billing.v1.ExchangeRate.set_by":
+		x.SetBy = value.Interface().(string)
+	case "liftedinit.billing.v1.ExchangeRate.set_at":
+		x.SetAt = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.ExchangeRate"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.ExchangeRate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExchangeRate) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.ExchangeRate.valid_from":
+		if x.ValidFrom == nil {
+			x.ValidFrom = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ValidFrom.ProtoReflect())
+	case "liftedinit.billing.v1.ExchangeRate.valid_until":
+		if x.ValidUntil == nil {
+			x.ValidUntil = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ValidUntil.ProtoReflect())
+	case "liftedinit.billing.v1.ExchangeRate.set_at":
+		if x.SetAt == nil {
+			x.SetAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.SetAt.ProtoReflect())
+	case "liftedinit.billing.v1.ExchangeRate.denom":
+		panic(fmt.Errorf("field denom of message liftedinit.billing.v1.ExchangeRate is not mutable"))
+	case "liftedinit.billing.v1.ExchangeRate.rate":
+		panic(fmt.Errorf("field rate of message liftedinit.billing.v1.ExchangeRate is not mutable"))
+	case "liftedinit.billing.v1.ExchangeRate.set_by":
+		panic(fmt.Errorf("field set_by of message liftedinit.billing.v1.ExchangeRate is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.ExchangeRate"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.ExchangeRate does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ExchangeRate) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.billing.v1.ExchangeRate.denom":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.billing.v1.ExchangeRate.rate":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.billing.v1.ExchangeRate.valid_from":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "liftedinit.billing.v1.ExchangeRate.valid_until":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "liftedinit.billing.v1.ExchangeRate.set_by":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.billing.v1.ExchangeRate.set_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.billing.v1.ExchangeRate"))
+		}
+		panic(fmt.Errorf("message liftedinit.billing.v1.ExchangeRate does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ExchangeRate) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in liftedinit.billing.v1.ExchangeRate", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ExchangeRate) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExchangeRate) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ExchangeRate) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ExchangeRate) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ExchangeRate)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Rate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ValidFrom != nil {
+			l = options.Size(x.ValidFrom)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ValidUntil != nil {
+			l = options.Size(x.ValidUntil)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SetBy)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SetAt != nil {
+			l = options.Size(x.SetAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ExchangeRate)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SetAt != nil {
+			encoded, err := options.Marshal(x.SetAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.SetBy) > 0 {
+			i -= len(x.SetBy)
+			copy(dAtA[i:], x.SetBy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SetBy)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.ValidUntil != nil {
+			encoded, err := options.Marshal(x.ValidUntil)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.ValidFrom != nil {
+			encoded, err := options.Marshal(x.ValidFrom)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Rate) > 0 {
+			i -= len(x.Rate)
+			copy(dAtA[i:], x.Rate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Rate)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ExchangeRate)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExchangeRate: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExchangeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Rate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ValidFrom == nil {
+					x.ValidFrom = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidFrom); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ValidUntil == nil {
+					x.ValidUntil = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidUntil); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SetBy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SetBy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SetAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SetAt == nil {
+					x.SetAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SetAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: liftedinit/billing/v1/types.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// LeaseState defines the state of a lease.
+type LeaseState int32
+
+const (
+	// LEASE_STATE_UNSPECIFIED is the default value.
+	LeaseState_LEASE_STATE_UNSPECIFIED LeaseState = 0
+	// LEASE_STATE_PENDING indicates the lease is awaiting provider acknowledgement.
+	// Credit is locked but billing has not started.
+	LeaseState_LEASE_STATE_PENDING LeaseState = 1
+	// LEASE_STATE_ACTIVE indicates the lease is active and accruing charges.
+	// Provider has acknowledged and resources are provisioned.
+	LeaseState_LEASE_STATE_ACTIVE LeaseState = 2
+	// LEASE_STATE_CLOSED indicates the lease has been closed normally.
+	// Final settlement has occurred.
+	LeaseState_LEASE_STATE_CLOSED LeaseState = 3
+	// LEASE_STATE_REJECTED indicates the provider rejected the lease.
+	// Credit has been unlocked and returned to tenant.
+	LeaseState_LEASE_STATE_REJECTED LeaseState = 4
+	// LEASE_STATE_EXPIRED indicates the lease expired while in PENDING state.
+	// Provider did not acknowledge within the timeout period.
+	// Credit has been unlocked and returned to tenant.
+	LeaseState_LEASE_STATE_EXPIRED LeaseState = 5
+)
+
+// Enum value maps for LeaseState.
+var (
+	LeaseState_name = map[int32]string{
+		0: "LEASE_STATE_UNSPECIFIED",
+		1: "LEASE_STATE_PENDING",
+		2: "LEASE_STATE_ACTIVE",
+		3: "LEASE_STATE_CLOSED",
+		4: "LEASE_STATE_REJECTED",
+		5: "LEASE_STATE_EXPIRED",
+	}
+	LeaseState_value = map[string]int32{
+		"LEASE_STATE_UNSPECIFIED": 0,
+		"LEASE_STATE_PENDING":     1,
+		"LEASE_STATE_ACTIVE":      2,
+		"LEASE_STATE_CLOSED":      3,
+		"LEASE_STATE_REJECTED":    4,
+		"LEASE_STATE_EXPIRED":     5,
+	}
+)
+
+func (x LeaseState) Enum() *LeaseState {
+	p := new(LeaseState)
+	*p = x
+	return p
+}
+
+func (x LeaseState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaseState) Descriptor() protoreflect.EnumDescriptor {
+	return file_liftedinit_billing_v1_types_proto_enumTypes[0].Descriptor()
+}
+
+func (LeaseState) Type() protoreflect.EnumType {
+	return &file_liftedinit_billing_v1_types_proto_enumTypes[0]
+}
+
+func (x LeaseState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaseState.Descriptor instead.
+func (LeaseState) EnumDescriptor() ([]byte, []int) {
+	return file_liftedinit_billing_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+// SettlementTrigger defines what caused a lease settlement.
+type SettlementTrigger int32
+
+const (
+	// SETTLEMENT_TRIGGER_UNSPECIFIED is the default value.
+	SettlementTrigger_SETTLEMENT_TRIGGER_UNSPECIFIED SettlementTrigger = 0
+	// SETTLEMENT_TRIGGER_WITHDRAW is a provider withdrawal.
+	SettlementTrigger_SETTLEMENT_TRIGGER_WITHDRAW SettlementTrigger = 1
+	// SETTLEMENT_TRIGGER_CLOSE is the final settlement of MsgCloseLease.
+	SettlementTrigger_SETTLEMENT_TRIGGER_CLOSE SettlementTrigger = 2
+	// SETTLEMENT_TRIGGER_AUTO_CLOSE is the final settlement of a lease closed by
+	// the module: credit exhaustion, grace period expiry or its scheduled end.
+	SettlementTrigger_SETTLEMENT_TRIGGER_AUTO_CLOSE SettlementTrigger = 3
+	// SETTLEMENT_TRIGGER_ITEMS_CHANGED settles at the old prices before a
+	// quantity update or lease amendment takes effect.
+	SettlementTrigger_SETTLEMENT_TRIGGER_ITEMS_CHANGED SettlementTrigger = 4
+	// SETTLEMENT_TRIGGER_GRACE_PERIOD settles a lease as it enters its grace
+	// period, or when the credit account is funded during it.
+	SettlementTrigger_SETTLEMENT_TRIGGER_GRACE_PERIOD SettlementTrigger = 5
+	// SETTLEMENT_TRIGGER_DISPUTE settles a lease before a dispute starts
+	// escrowing its payments.
+	SettlementTrigger_SETTLEMENT_TRIGGER_DISPUTE SettlementTrigger = 6
+	// SETTLEMENT_TRIGGER_USAGE charges reported usage of metered items.
+	SettlementTrigger_SETTLEMENT_TRIGGER_USAGE SettlementTrigger = 7
+	// SETTLEMENT_TRIGGER_DEBT_PAYMENT pays grace period debt out of new credit.
+	SettlementTrigger_SETTLEMENT_TRIGGER_DEBT_PAYMENT SettlementTrigger = 8
+)
+
+// Enum value maps for SettlementTrigger.
+var (
+	SettlementTrigger_name = map[int32]string{
+		0: "SETTLEMENT_TRIGGER_UNSPECIFIED",
+		1: "SETTLEMENT_TRIGGER_WITHDRAW",
+		2: "SETTLEMENT_TRIGGER_CLOSE",
+		3: "SETTLEMENT_TRIGGER_AUTO_CLOSE",
+		4: "SETTLEMENT_TRIGGER_ITEMS_CHANGED",
+		5: "SETTLEMENT_TRIGGER_GRACE_PERIOD",
+		6: "SETTLEMENT_TRIGGER_DISPUTE",
+		7: "SETTLEMENT_TRIGGER_USAGE",
+		8: "SETTLEMENT_TRIGGER_DEBT_PAYMENT",
+	}
+	SettlementTrigger_value = map[string]int32{
+		"SETTLEMENT_TRIGGER_UNSPECIFIED":   0,
+		"SETTLEMENT_TRIGGER_WITHDRAW":      1,
+		"SETTLEMENT_TRIGGER_CLOSE":         2,
+		"SETTLEMENT_TRIGGER_AUTO_CLOSE":    3,
+		"SETTLEMENT_TRIGGER_ITEMS_CHANGED": 4,
+		"SETTLEMENT_TRIGGER_GRACE_PERIOD":  5,
+		"SETTLEMENT_TRIGGER_DISPUTE":       6,
+		"SETTLEMENT_TRIGGER_USAGE":         7,
+		"SETTLEMENT_TRIGGER_DEBT_PAYMENT":  8,
+	}
+)
+
+func (x SettlementTrigger) Enum() *SettlementTrigger {
+	p := new(SettlementTrigger)
+	*p = x
+	return p
+}
+
+func (x SettlementTrigger) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SettlementTrigger) Descriptor() protoreflect.EnumDescriptor {
+	return file_liftedinit_billing_v1_types_proto_enumTypes[1].Descriptor()
+}
+
+func (SettlementTrigger) Type() protoreflect.EnumType {
+	return &file_liftedinit_billing_v1_types_proto_enumTypes[1]
+}
+
+func (x SettlementTrigger) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SettlementTrigger.Descriptor instead.
+func (SettlementTrigger) EnumDescriptor() ([]byte, []int) {
+	return file_liftedinit_billing_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+// DisputeState defines the state of a lease dispute.
+type DisputeState int32
+
+const (
+	// DISPUTE_STATE_UNSPECIFIED is the default value.
+	DisputeState_DISPUTE_STATE_UNSPECIFIED DisputeState = 0
+	// DISPUTE_STATE_OPEN indicates the dispute awaits resolution. Amounts
+	// settled for the lease are held in escrow.
+	DisputeState_DISPUTE_STATE_OPEN DisputeState = 1
+	// DISPUTE_STATE_RESOLVED indicates the authority split the escrow between
+	// the tenant and the provider.
+	DisputeState_DISPUTE_STATE_RESOLVED DisputeState = 2
+	// DISPUTE_STATE_DEFAULTED indicates the provider did not respond in time and
+	// the escrow was returned to the tenant.
+	DisputeState_DISPUTE_STATE_DEFAULTED DisputeState = 3
+)
+
+// Enum value maps for DisputeState.
+var (
+	DisputeState_name = map[int32]string{
+		0: "DISPUTE_STATE_UNSPECIFIED",
+		1: "DISPUTE_STATE_OPEN",
+		2: "DISPUTE_STATE_RESOLVED",
+		3: "DISPUTE_STATE_DEFAULTED",
+	}
+	DisputeState_value = map[string]int32{
+		"DISPUTE_STATE_UNSPECIFIED": 0,
+		"DISPUTE_STATE_OPEN":        1,
+		"DISPUTE_STATE_RESOLVED":    2,
+		"DISPUTE_STATE_DEFAULTED":   3,
+	}
+)
+
+func (x DisputeState) Enum() *DisputeState {
+	p := new(DisputeState)
+	*p = x
+	return p
+}
+
+func (x DisputeState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DisputeState) Descriptor() protoreflect.EnumDescriptor {
+	return file_liftedinit_billing_v1_types_proto_enumTypes[2].Descriptor()
+}
+
+func (DisputeState) Type() protoreflect.EnumType {
+	return &file_liftedinit_billing_v1_types_proto_enumTypes[2]
+}
+
+func (x DisputeState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DisputeState.Descriptor instead.
+func (DisputeState) EnumDescriptor() ([]byte, []int) {
+	return file_liftedinit_billing_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+// Params defines the parameters for the billing module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// max_leases_per_tenant is the maximum number of active leases a tenant can have.
+	// Must be greater than zero.
+	MaxLeasesPerTenant uint64 `protobuf:"varint,1,opt,name=max_leases_per_tenant,json=maxLeasesPerTenant,proto3" json:"max_leases_per_tenant,omitempty"`
+	// allowed_list is the list of addresses allowed to create leases on behalf of tenants
+	// in addition to the module authority.
+	AllowedList []string `protobuf:"bytes,2,rep,name=allowed_list,json=allowedList,proto3" json:"allowed_list,omitempty"`
+	// max_items_per_lease is the maximum number of items (SKUs) allowed in a single lease.
+	// Must be greater than zero. Prevents excessive gas consumption from large leases.
+	MaxItemsPerLease uint64 `protobuf:"varint,3,opt,name=max_items_per_lease,json=maxItemsPerLease,proto3" json:"max_items_per_lease,omitempty"`
+	// min_lease_duration is the minimum duration (in seconds) that a tenant's credit balance
+	// must be able to cover when creating a lease. This prevents tenants from creating leases
+	// that would immediately exhaust their credit. Default is 3600 (1 hour).
+	MinLeaseDuration uint64 `protobuf:"varint,4,opt,name=min_lease_duration,json=minLeaseDuration,proto3" json:"min_lease_duration,omitempty"`
+	// max_pending_leases_per_tenant is the maximum number of PENDING leases a tenant can have.
+	// Prevents spam attacks where tenants create many leases that providers must process.
+	// Default is 10.
+	MaxPendingLeasesPerTenant uint64 `protobuf:"varint,5,opt,name=max_pending_leases_per_tenant,json=maxPendingLeasesPerTenant,proto3" json:"max_pending_leases_per_tenant,omitempty"`
+	// pending_timeout is the duration in seconds that a lease can remain in PENDING state
+	// before it expires. Applies globally to all providers. Default is 1800 (30 minutes).
+	// Must be between 60 (1 minute) and 86400 (24 hours).
+	PendingTimeout uint64 `protobuf:"varint,6,opt,name=pending_timeout,json=pendingTimeout,proto3" json:"pending_timeout,omitempty"`
+	// reserved_domain_suffixes is the list of DNS suffixes (each beginning with `.`)
+	// that tenants are forbidden from claiming as a LeaseItem.custom_domain. These match
+	// provider wildcard zones (e.g. `.barney0.manifest0.net`) where the provider
+	// already serves auto-generated subdomains under a wildcard TLS cert. The match
+	// is a case-insensitive label-boundary suffix check and also covers the apex
+	// (e.g. `barney0.manifest0.net` itself). Tunable via MsgUpdateParams so new
+	// provider zones can be reserved without a chain upgrade.
+	ReservedDomainSuffixes []string `protobuf:"bytes,7,rep,name=reserved_domain_suffixes,json=reservedDomainSuffixes,proto3" json:"reserved_domain_suffixes,omitempty"`
+	// credit_withdrawal_cooldown is the notice period (in seconds) each deposit
+	// to a credit account is locked for before the tenant may withdraw or
+	// transfer it. This stops a tenant from depositing credit to pass a
+	// lease-creation check and pulling it back in the next block. Zero disables
+	// the cooldown. Default is 86400 (24 hours); maximum is 30 days.
+	CreditWithdrawalCooldown uint64 `protobuf:"varint,8,opt,name=credit_withdrawal_cooldown,json=creditWithdrawalCooldown,proto3" json:"credit_withdrawal_cooldown,omitempty"`
+	// grace_period is how long (in seconds) an ACTIVE lease keeps running after
+	// its tenant's credit is exhausted. During grace the lease accrues debt
+	// instead of being closed; MsgFundCredit pays the debt and ends the grace
+	// period, otherwise the lease is closed when it expires. It may be
+	// overridden per provider. Zero closes leases as soon as credit is
+	// exhausted. Default is 0; maximum is 30 days.
+	GracePeriod uint64 `protobuf:"varint,9,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"`
+	// lease_retention_period is how long (in seconds) a CLOSED, REJECTED or
+	// EXPIRED lease is kept in state after it reached that state. Older leases
+	// are pruned by the EndBlocker, which first emits EventLeaseArchived with
+	// the full lease. Leases with unpaid debt are kept until the debt is paid.
+	// Zero keeps terminal leases forever. Default is 0; maximum is 10 years.
+	LeaseRetentionPeriod uint64 `protobuf:"varint,10,opt,name=lease_retention_period,json=leaseRetentionPeriod,proto3" json:"lease_retention_period,omitempty"`
+	// dispute_response_period is how long (in seconds) a provider has to
+	// respond to a dispute. A dispute left unanswered is resolved in the
+	// tenant's favor by the EndBlocker. Zero disables disputes. Default is
+	// 259200 (3 days); otherwise must be between 1 hour and 30 days.
+	DisputeResponsePeriod uint64 `protobuf:"varint,11,opt,name=dispute_response_period,json=disputeResponsePeriod,proto3" json:"dispute_response_period,omitempty"`
+	// reference_denom is the denom exchange rates are quoted in. When set,
+	// leases priced in the reference denom or a denom with a valid exchange
+	// rate can be paid from credit in any other such denom. Empty disables
+	// conversion.
+	ReferenceDenom string `protobuf:"bytes,12,opt,name=reference_denom,json=referenceDenom,proto3" json:"reference_denom,omitempty"`
+	// price_feeders is the list of addresses allowed to set exchange rates with
+	// MsgSetExchangeRates, in addition to the module authority.
+	PriceFeeders []string `protobuf:"bytes,13,rep,name=price_feeders,json=priceFeeders,proto3" json:"price_feeders,omitempty"`
+	// max_provider_grace_period is the longest grace period override (in
+	// seconds) that a provider or the authority can set in the provider's
+	// ProviderPolicy. Stored overrides are clamped to it when read. Zero
+	// disables overrides. Default is 0; maximum is 30 days.
+	MaxProviderGracePeriod uint64 `protobuf:"varint,14,opt,name=max_provider_grace_period,json=maxProviderGracePeriod,proto3" json:"max_provider_grace_period,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_liftedinit_billing_v1_types_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_liftedinit_billing_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetMaxLeasesPerTenant() uint64 {
+	if x != nil {
+		return x.MaxLeasesPerTenant
+	}
+	return 0
+}
+
+func (x *Params) GetAllowedList() []string {
+	if x != nil {
+		return x.AllowedList
+	}
+	return nil
+}
+
+func (x *Params) GetMaxItemsPerLease() uint64 {
+	if x != nil {
+		return x.MaxItemsPerLease
+	}
+	return 0
+}
+
+func (x *Params) GetMinLeaseDuration() uint64 {
+	if x != nil {
+		return x.MinLeaseDuration
+	}
+	return 0
+}
+
+func (x *Params) GetMaxPendingLeasesPerTenant() uint64 {
+	if x != nil {
+		return x.MaxPendingLeasesPerTenant
+	}
+	return 0
+}
+
+func (x *Params) GetPendingTimeout() uint64 {
+	if x != nil {
+		return x.PendingTimeout
+	}
+	return 0
+}
+
+func (x *Params) GetReservedDomainSuffixes() []string {
+	if x != nil {
+		return x.ReservedDomainSuffixes
+	}
+	return nil
+}
+
+func (x *Params) GetCreditWithdrawalCooldown() uint64 {
+	if x != nil {
+		return x.CreditWithdrawalCooldown
+	}
+	return 0
+}
+
+func (x *Params) GetGracePeriod() uint64 {
+	if x != nil {
+		return x.GracePeriod
+	}
+	return 0
+}
+
+func (x *Params) GetLeaseRetentionPeriod() uint64 {
+	if x != nil {
+		return x.LeaseRetentionPeriod
+	}
+	return 0
+}
+
+func (x *Params) GetDisputeResponsePeriod() uint64 {
+	if x != nil {
+		return x.DisputeResponsePeriod
+	}
+	return 0
+}
+
+func (x *Params) GetReferenceDenom() string {
+	if x != nil {
+		return x.ReferenceDenom
+	}
+	return ""
+}
+
+func (x *Params) GetPriceFeeders() []string {
+	if x != nil {
+		return x.PriceFeeders
+	}
+	return nil
+}
+
+func (x *Params) GetMaxProviderGracePeriod() uint64 {
+	if x != nil {
+		return x.MaxProviderGracePeriod
+	}
+	return 0
+}
+
+// LeaseItem represents a single SKU item within a lease.
+type LeaseItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sku_uuid is the UUID of the SKU being leased.
+	SkuUuid string `protobuf:"bytes,1,opt,name=sku_uuid,json=skuUuid,proto3" json:"sku_uuid,omitempty"`
+	// quantity is the number of instances of this SKU.
+	Quantity uint64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// locked_price is the price of one unit locked at lease creation. The denom
+	// comes from the SKU's base_price at lease creation time. For a time-billed
+	// item the amount is the per-second locked_rate rounded up to a whole unit
+	// and only informational; accrual and reservations use locked_rate. For a
+	// metered item it is the price per unit of reported usage instead.
+	LockedPrice *types.Coin `protobuf:"bytes,3,opt,name=locked_price,json=lockedPrice,proto3" json:"locked_price,omitempty"`
+	// service_name is an optional DNS-label identifier for this item within a stack deployment.
+	// Must be a valid RFC 1123 DNS label: 1-63 lowercase alphanumeric characters or hyphens,
+	// must not start or end with a hyphen (e.g., "web", "db", "my-service-1").
+	// When used, all items must have a service_name and uniqueness shifts from sku_uuid to service_name,
+	// allowing the same SKU to appear multiple times (e.g., "web" and "db" both using docker-small).
+	//
+	// COMPUTE-SPECIFIC: this field belongs in a future x/deployment module rather
+	// than the generic billing lease. It lives here today as a pragmatic shortcut
+	// alongside LeaseItem.custom_domain. When a non-compute lease kind ships,
+	// migrate this (and custom_domain) into x/deployment via a state migration.
+	// Tracked: ENG-80.
+	ServiceName string `protobuf:"bytes,4,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// custom_domain is the optional FQDN the tenant has assigned to this item.
+	// When set, requests to this domain are routed to this item's container by
+	// the provider, with a TLS cert provisioned via HTTP-01.
+	//
+	// COMPUTE-SPECIFIC: this field belongs in a future x/deployment module rather
+	// than the generic billing lease. It lives here today as a pragmatic shortcut
+	// alongside LeaseItem.service_name. When a non-compute lease kind ships,
+	// migrate this (and service_name) into x/deployment via a state migration.
+	// Tracked: ENG-80.
+	CustomDomain string `protobuf:"bytes,5,opt,name=custom_domain,json=customDomain,proto3" json:"custom_domain,omitempty"`
+	// metered is true when the item's SKU is billed per unit of usage
+	// (UNIT_PER_USAGE). Metered items do not accrue over time; they are charged
+	// when the provider reports usage with MsgReportUsage.
+	Metered bool `protobuf:"varint,6,opt,name=metered,proto3" json:"metered,omitempty"`
+	// locked_rate is the exact per-second price per unit of a time-billed item,
+	// in locked_price's denom: the SKU's price for the tenant's volume tier
+	// divided by the seconds in its unit. It is zero for metered items.
+	LockedRate string `protobuf:"bytes,7,opt,name=locked_rate,json=lockedRate,proto3" json:"locked_rate,omitempty"`
+	// calendar_month is true when the item's SKU is billed per calendar month
+	// (UNIT_PER_MONTH). It accrues monthly_price over each month's actual
+	// length, and locked_rate is the highest rate that gives, in a 28-day
+	// month, used for reservations.
+	CalendarMonth bool `protobuf:"varint,8,opt,name=calendar_month,json=calendarMonth,proto3" json:"calendar_month,omitempty"`
+	// monthly_price is the price of one unit per UTC calendar month of a
+	// calendar_month item, in locked_price's denom. It is unset for other
+	// items.
+	MonthlyPrice *types.Coin `protobuf:"bytes,9,opt,name=monthly_price,json=monthlyPrice,proto3" json:"monthly_price,omitempty"`
+}
+
+func (x *LeaseItem) Reset() {
+	*x = LeaseItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_liftedinit_billing_v1_types_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseItem) ProtoMessage() {}
+
+// Deprecated: Use LeaseItem.ProtoReflect.Descriptor instead.
+func (*LeaseItem) Descriptor() ([]byte, []int) {
+	return file_liftedinit_billing_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LeaseItem) GetSkuUuid() string {
+	if x != nil {
+		return x.SkuUuid
+	}
+	return ""
+}
+
+func (x *LeaseItem) GetQuantity() uint64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *LeaseItem) GetLockedPrice() *types.Coin {
+	if x != nil {
+		return x.LockedPrice
+	}
+	return nil
+}
+
+func (x *LeaseItem) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *LeaseItem) GetCustomDomain() string {
+	if x != nil {
+		return x.CustomDomain
+	}
+	return ""
+}
+
+func (x *LeaseItem) GetMetered() bool {
+	if x != nil {
+		return x.Metered
+	}
+	return false
+}
+
+func (x *LeaseItem) GetLockedRate() string {
+	if x != nil {
+		return x.LockedRate
+	}
+	return ""
+}
+
+func (x *LeaseItem) GetCalendarMonth() bool {
+	if x != nil {
+		return x.CalendarMonth
+	}
+	return false
+}
+
+func (x *LeaseItem) GetMonthlyPrice() *types.Coin {
+	if x != nil {
+		return x.MonthlyPrice
+	}
+	return nil
+}
+
+// Lease represents a billing lease between a tenant and provider.
+type Lease struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uuid is the unique identifier of the lease (UUIDv7 format).
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// tenant is the address of the tenant who owns this lease.
+	Tenant string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// provider_uuid is the provider UUID (denormalized from SKUs for efficient querying).
+	ProviderUuid string `protobuf:"bytes,3,opt,name=provider_uuid,json=providerUuid,proto3" json:"provider_uuid,omitempty"`
+	// items is the list of SKU items in this lease.
+	Items []*LeaseItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	// state is the current state of the lease.
+	State LeaseState `protobuf:"varint,5,opt,name=state,proto3,enum=liftedinit.billing.v1.LeaseState" json:"state,omitempty"`
+	// created_at is the timestamp when the lease was created.
+	// Credit is locked at this time.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// closed_at is the timestamp when the lease was closed (if CLOSED state).
+	ClosedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	// last_settled_at is the timestamp of the last settlement/withdrawal.
+	// Only applicable for ACTIVE and CLOSED states.
+	LastSettledAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_settled_at,json=lastSettledAt,proto3" json:"last_settled_at,omitempty"`
+	// acknowledged_at is the timestamp when the provider acknowledged the lease.
+	// Only set when state transitions from PENDING to ACTIVE.
+	// Billing starts from this timestamp.
+	AcknowledgedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=acknowledged_at,json=acknowledgedAt,proto3" json:"acknowledged_at,omitempty"`
+	// rejected_at is the timestamp when the provider rejected the lease.
+	// Only set when state is REJECTED.
+	RejectedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=rejected_at,json=rejectedAt,proto3" json:"rejected_at,omitempty"`
+	// rejection_reason is a free-form explanation for why the lease was rejected.
+	// Only set when state is REJECTED. Maximum 256 characters.
+	RejectionReason string `protobuf:"bytes,11,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	// expired_at is the timestamp when the lease expired while in PENDING state.
+	// Only set when state is EXPIRED.
+	ExpiredAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=expired_at,json=expiredAt,proto3" json:"expired_at,omitempty"`
+	// closure_reason is a free-form explanation for why the lease was closed.
+	// Only set when state is CLOSED. Maximum 256 characters.
+	ClosureReason string `protobuf:"bytes,13,opt,name=closure_reason,json=closureReason,proto3" json:"closure_reason,omitempty"`
+	// meta_hash is an optional hash/reference to off-chain deployment data.
+	// Set once at lease creation and immutable. Maximum 64 bytes.
+	MetaHash []byte `protobuf:"bytes,14,opt,name=meta_hash,json=metaHash,proto3" json:"meta_hash,omitempty"`
+	// min_lease_duration_at_creation stores the min_lease_duration parameter value
+	// at the time this lease was created. This ensures consistent reservation
+	// calculation regardless of subsequent parameter changes.
+	// reservation = sum(ceil(locked_rate × quantity × min_lease_duration_at_creation))
+	MinLeaseDurationAtCreation uint64 `protobuf:"varint,15,opt,name=min_lease_duration_at_creation,json=minLeaseDurationAtCreation,proto3" json:"min_lease_duration_at_creation,omitempty"`
+	// scheduled_end_at is the optional time at which an ACTIVE lease is settled
+	// and closed by the EndBlocker, with closure_reason "scheduled end".
+	// Set at creation or later by the tenant; it can only be moved later.
+	ScheduledEndAt *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=scheduled_end_at,json=scheduledEndAt,proto3" json:"scheduled_end_at,omitempty"`
+	// grace_ends_at is set when the tenant's credit ran out while the lease was
+	// ACTIVE and a grace period applied. The lease keeps accruing, as debt, and
+	// is closed at this time unless the tenant funds the credit account first.
+	// It is kept on the lease after closure for reference.
+	GraceEndsAt *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=grace_ends_at,json=graceEndsAt,proto3" json:"grace_ends_at,omitempty"`
+	// debt is the amount accrued during a grace period that the credit account
+	// could not cover. It is owed to the provider and paid first out of the next
+	// MsgFundCredit, including after the lease has been closed.
+	Debt []*types.Coin `protobuf:"bytes,18,rep,name=debt,proto3" json:"debt,omitempty"`
+	// disputed is true while the tenant's dispute of the lease is open. Amounts
+	// settled for the lease go to the dispute escrow instead of the provider,
+	// and provider withdrawals of the lease are refused.
+	Disputed bool `protobuf:"varint,19,opt,name=disputed,proto3" json:"disputed,omitempty"`
+	// total_settled is the sum of the transferred amounts of every
+	// LeaseSettlement recorded for the lease, including amounts escrowed while
+	// it was disputed. Leases settled before settlements were recorded only
+	// count what has been settled since.
+	TotalSettled []*types.Coin `protobuf:"bytes,20,rep,name=total_settled,json=totalSettled,proto3" json:"total_settled,omitempty"`
+	// accrual_carry is the fraction of a unit, per denom, that accrued since
+	// the lease started but has not been charged yet because settlements only
+	// transfer whole units. It is added to the next settlement's accrual, so
+	// truncation neither loses nor creates value across settlements. Each
+	// amount is below one.
+	AccrualCarry []*types.DecCoin `protobuf:"bytes,21,rep,name=accrual_carry,json=accrualCarry,proto3" json:"accrual_carry,omitempty"`
+}
+
+func (x *Lease) Reset() {
+	*x = Lease{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_liftedinit_billing_v1_types_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Lease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lease) ProtoMessage() {}
+
+// Deprecated: Use Lease.ProtoReflect.Descriptor instead.
+func (*Lease) Descriptor() ([]byte, []int) {
+	return file_liftedinit_billing_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Lease) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Lease) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *Lease) GetProviderUuid() string {
+	if x != nil {
+		return x.ProviderUuid
+	}
+	return ""
+}
+
+func (x *Lease) GetItems() []*LeaseItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Lease) GetState() LeaseState {
+	if x != nil {
+		return x.State
+	}
+	return LeaseState_LEASE_STATE_UNSPECIFIED
+}
+
+func (x *Lease) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Lease) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+func (x *Lease) GetLastSettledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSettledAt
+	}
+	return nil
+}
+
+func (x *Lease) GetAcknowledgedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcknowledgedAt
+	}
+	return nil
+}
+
+func (x *Lease) GetRejectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RejectedAt
+	}
+	return nil
+}
+
+func (x *Lease) GetRejectionReason() string {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return ""
+}
+
+func (x *Lease) GetExpiredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiredAt
+	}
+	return nil
+}
+
+func (x *Lease) GetClosureReason() string {
+	if x != nil {
+		return x.ClosureReason
+	}
+	return ""
+}
+
+func (x *Lease) GetMetaHash() []byte {
+	if x != nil {
+		return x.MetaHash
+	}
+	return nil
+}
+
+func (x *Lease) GetMinLeaseDurationAtCreation() uint64 {
+	if x != nil {
+		return x.MinLeaseDurationAtCreation
+	}
+	return 0
+}
+
+func (x *Lease) GetScheduledEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledEndAt
+	}
+	return nil
+}
+
+func (x *Lease) GetGraceEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GraceEndsAt
+	}
+	return nil
+}
+
+func (x *Lease) GetDebt() []*types.Coin {
+	if x != nil {
+		return x.Debt
+	}
+	return nil
+}
+
+func (x *Lease) GetDisputed() bool {
+	if x != nil {
+		return x.Disputed
+	}
+	return false
+}
+
+func (x *Lease) GetTotalSettled() []*types.Coin {
+	if x != nil {
+		return x.TotalSettled
+	}
+	return nil
+}
+
+func (x *Lease) GetAccrualCarry() []*types.DecCoin {
+	if x != nil {
+		return x.AccrualCarry
+	}
+	return nil
+}
+
+// LeaseAmendment is a tenant-proposed change to the item list of an ACTIVE
+// lease. It waits for the provider to acknowledge or reject it and expires
+// after params.pending_timeout. At most one amendment is pending per lease.
+type LeaseAmendment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// lease_uuid is the UUID of the lease being amended.
+	LeaseUuid string `protobuf:"bytes,1,opt,name=lease_uuid,json=leaseUuid,proto3" json:"lease_uuid,omitempty"`
+	// tenant is the address of the lease owner who proposed the amendment.
+	Tenant string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// provider_uuid is the provider that must approve the amendment
+	// (denormalized from the lease).
+	ProviderUuid string `protobuf:"bytes,3,opt,name=provider_uuid,json=providerUuid,proto3" json:"provider_uuid,omitempty"`
+	// add_items are the items appended to the lease on acknowledgement. Their
+	// locked_price is the SKU price at the time the amendment was proposed.
+	AddItems []*LeaseItem `protobuf:"bytes,4,rep,name=add_items,json=addItems,proto3" json:"add_items,omitempty"`
+	// remove_items identifies the lease items dropped on acknowledgement: the
+	// service_name for service-name mode leases, the sku_uuid for legacy leases.
+	RemoveItems []string `protobuf:"bytes,5,rep,name=remove_items,json=removeItems,proto3" json:"remove_items,omitempty"`
+	// reserved_amounts is the credit reserved for add_items while the amendment
+	// is pending. It is folded into the lease reservation on acknowledgement and
+	// released on rejection or expiry.
+	ReservedAmounts []*types.Coin `protobuf:"bytes,6,rep,name=reserved_amounts,json=reservedAmounts,proto3" json:"reserved_amounts,omitempty"`
+	// created_at is the block time at which the amendment was proposed.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *LeaseAmendment) Reset() {
+	*x = LeaseAmendment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_liftedinit_billing_v1_types_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseAmendment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseAmendment) ProtoMessage() {}
+
+// Deprecated: Use LeaseAmendment.ProtoReflect.Descriptor instead.
+func (*LeaseAmendment) Descriptor() ([]byte, []int) {
+	return file_liftedinit_billing_v1_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LeaseAmendment) GetLeaseUuid() string {
+	if x != nil {
+		return x.LeaseUuid
+	}
+	return ""
+}
+
+func (x *LeaseAmendment) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *LeaseAmendment) GetProviderUuid() string {
+	if x != nil {
+		return x.ProviderUuid
+	}
+	return ""
+}
+
+func (x *LeaseAmendment) GetAddItems() []*LeaseItem {
+	if x != nil {
+		return x.AddItems
+	}
+	return nil
+}
+
+func (x *LeaseAmendment) GetRemoveItems() []string {
+	if x != nil {
+		return x.RemoveItems
+	}
+	return nil
+}
+
+func (x *LeaseAmendment) GetReservedAmounts() []*types.Coin {
+	if x != nil {
+		return x.ReservedAmounts
+	}
+	return nil
+}
+
+func (x *LeaseAmendment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// UsageCounter is the cumulative usage charged for one metered lease item.
+type UsageCounter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sku_uuid is the SKU of the metered item.
+	SkuUuid string `protobuf:"bytes,1,opt,name=sku_uuid,json=skuUuid,proto3" json:"sku_uuid,omitempty"`
+	// service_name identifies the item in service-name mode leases.
+	ServiceName string `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// counter is the total usage charged for the item since the lease started.
+	// Reported usage above it did not fit the spend limit or the credit yet and
+	// is charged by a later report.
+	Counter uint64 `protobuf:"varint,3,opt,name=counter,proto3" json:"counter,omitempty"`
+}
+
+func (x *UsageCounter) Reset() {
+	*x = UsageCounter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_liftedinit_billing_v1_types_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsageCounter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageCounter) ProtoMessage() {}
+
+// Deprecated: Use UsageCounter.ProtoReflect.Descriptor instead.
+func (*UsageCounter) Descriptor() ([]byte, []int) {
+	return file_liftedinit_billing_v1_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UsageCounter) GetSkuUuid() string {
+	if x != nil {
+		return x.SkuUuid
+	}
+	return ""
+}
+
+func (x *UsageCounter) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *UsageCounter) GetCounter() uint64 {
+	if x != nil {
+		return x.Counter
+	}
+	return 0
+}
+
+// LeaseUsage is the metering state of a lease with metered items: the usage
+// counters reported by the provider and the tenant's spend limit.
+type LeaseUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// lease_uuid is the UUID of the metered lease.
+	LeaseUuid string `protobuf:"bytes,1,opt,name=lease_uuid,json=leaseUuid,proto3" json:"lease_uuid,omitempty"`
+	// counters holds the charged counter of each metered item.
+	Counters []*UsageCounter `protobuf:"bytes,2,rep,name=counters,proto3" json:"counters,omitempty"`
+	// spend_limit is the most usage charges the tenant allows per spend period.
+	// Usage beyond the limit is held back, not charged, until a later period
+	// or a higher limit makes room. Without a limit no usage is charged.
+	SpendLimit []*types.Coin `protobuf:"bytes,3,rep,name=spend_limit,json=spendLimit,proto3" json:"spend_limit,omitempty"`
+	// spend_period is the length of a spend period in seconds.
+	SpendPeriod uint64 `protobuf:"varint,4,opt,name=spend_period,json=spendPeriod,proto3" json:"spend_period,omitempty"`
+	// period_started_at is the start of the current spend period.
+	PeriodStartedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=period_started_at,json=periodStartedAt,proto3" json:"period_started_at,omitempty"`
+	// period_spent is the usage charged in the current spend period.
+	PeriodSpent []*types.Coin `protobuf:"bytes,6,rep,name=period_spent,json=periodSpent,proto3" json:"period_spent,omitempty"`
+}
+
+func (x *LeaseUsage) Reset() {
+	*x = LeaseUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_liftedinit_billing_v1_types_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseUsage) ProtoMessage() {}
+
+// Deprecated: Use LeaseUsage.ProtoReflect.Descriptor instead.
+func (*LeaseUsage) Descriptor() ([]byte, []int) {
+	return file_liftedinit_billing_v1_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LeaseUsage) GetLeaseUuid() string {
+	if x != nil {
+		return x.LeaseUuid
+	}
+	return ""
+}
+
+func (x *LeaseUsage) GetCounters() []*UsageCounter {
+	if x != nil {
+		return x.Counters
+	}
+	return nil
+}
+
+func (x *LeaseUsage) GetSpendLimit() []*types.Coin {
+	if x != nil {
+		return x.SpendLimit
+	}
+	return nil
+}
+
+func (x *LeaseUsage) GetSpendPeriod() uint64 {
+	if x != nil {
+		return x.SpendPeriod
+	}
+	return 0
+}
+
+func (x *LeaseUsage) GetPeriodStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStartedAt
+	}
+	return nil
+}
+
+func (x *LeaseUsage) GetPeriodSpent() []*types.Coin {
+	if x != nil {
+		return x.PeriodSpent
+	}
+	return nil
+}
+
+// LeaseSettlement records one transfer of a lease's charges out of the
+// tenant's credit account. Records are kept until the lease is pruned.
+type LeaseSettlement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the chain-wide sequence number of the settlement.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// lease_uuid is the UUID of the settled lease.
+	LeaseUuid string `protobuf:"bytes,2,opt,name=lease_uuid,json=leaseUuid,proto3" json:"lease_uuid,omitempty"`
+	// trigger is what caused the settlement.
+	Trigger SettlementTrigger `protobuf:"varint,3,opt,name=trigger,proto3,enum=liftedinit.billing.v1.SettlementTrigger" json:"trigger,omitempty"`
+	// period_start is the start of the settled accrual period. It equals
+	// period_end for usage charges and debt payments.
+	PeriodStart *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	// period_end is the end of the settled accrual period. It is earlier than
+	// settled_at when a lease is closed at the time its credit ran out.
+	PeriodEnd *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	// settled_at is the block time of the settlement.
+	SettledAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=settled_at,json=settledAt,proto3" json:"settled_at,omitempty"`
+	// accrued is the amount charged for the period. It is empty for debt
+	// payments, whose charge was recorded as shortfall when it accrued.
+	Accrued []*types.Coin `protobuf:"bytes,7,rep,name=accrued,proto3" json:"accrued,omitempty"`
+	// transferred is the amount taken from the credit account, to the provider
+	// or to the dispute escrow.
+	Transferred []*types.Coin `protobuf:"bytes,8,rep,name=transferred,proto3" json:"transferred,omitempty"`
+	// shortfall is the part of accrued the credit account could not cover. It
+	// became debt if the lease was in its grace period and was forgiven
+	// otherwise.
+	Shortfall []*types.Coin `protobuf:"bytes,9,rep,name=shortfall,proto3" json:"shortfall,omitempty"`
+	// promo is the part of transferred paid out of promotional credit grants
+	// rather than the credit account.
+	Promo []*types.Coin `protobuf:"bytes,10,rep,name=promo,proto3" json:"promo,omitempty"`
+	// converted is the part of transferred paid in denoms other than the one
+	// accrued, at the exchange rates of the block.
+	Converted []*types.Coin `protobuf:"bytes,11,rep,name=converted,proto3" json:"converted,omitempty"`
+	// converted_value is what converted paid for, in the accrued denoms. The
+	// accrued amount equals transferred - converted + converted_value +
+	// shortfall.
+	ConvertedValue []*types.Coin `protobuf:"bytes,12,rep,name=converted_value,json=convertedValue,proto3" json:"converted_value,omitempty"`
+}
+
+func (x *LeaseSettlement) Reset() {
+	*x = LeaseSettlement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_liftedinit_billing_v1_types_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseSettlement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseSettlement) ProtoMessage() {}
+
+// Deprecated: Use LeaseSettlement.ProtoReflect.Descriptor instead.
+func (*LeaseSettlement) Descriptor() ([]byte, []int) {
+	return file_liftedinit_billing_v1_types_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LeaseSettlement) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LeaseSettlement) GetLeaseUuid() string {
+	if x != nil {
+		return x.LeaseUuid
+	}
+	return ""
+}
+
+func (x *LeaseSettlement) GetTrigger() SettlementTrigger {
+	if x != nil {
+		return x.Trigger
+	}
+	return SettlementTrigger_SETTLEMENT_TRIGGER_UNSPECIFIED
+}
+
+func (x *LeaseSettlement) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *LeaseSettlement) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+func (x *LeaseSettlement) GetSettledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SettledAt
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return nil
+}
+
+func (x *LeaseSettlement) GetAccrued() []*types.Coin {
+	if x != nil {
+		return x.Accrued
 	}
+	return nil
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: liftedinit/billing/v1/types.proto
+func (x *LeaseSettlement) GetTransferred() []*types.Coin {
+	if x != nil {
+		return x.Transferred
+	}
+	return nil
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (x *LeaseSettlement) GetShortfall() []*types.Coin {
+	if x != nil {
+		return x.Shortfall
+	}
+	return nil
+}
 
-// LeaseState defines the state of a lease.
-type LeaseState int32
+func (x *LeaseSettlement) GetPromo() []*types.Coin {
+	if x != nil {
+		return x.Promo
+	}
+	return nil
+}
 
-const (
-	// LEASE_STATE_UNSPECIFIED is the default value.
-	LeaseState_LEASE_STATE_UNSPECIFIED LeaseState = 0
-	// LEASE_STATE_PENDING indicates the lease is awaiting provider acknowledgement.
-	// Credit is locked but billing has not started.
-	LeaseState_LEASE_STATE_PENDING LeaseState = 1
-	// LEASE_STATE_ACTIVE indicates the lease is active and accruing charges.
-	// Provider has acknowledged and resources are provisioned.
-	LeaseState_LEASE_STATE_ACTIVE LeaseState = 2
-	// LEASE_STATE_CLOSED indicates the lease has been closed normally.
-	// Final settlement has occurred.
-	LeaseState_LEASE_STATE_CLOSED LeaseState = 3
-	// LEASE_STATE_REJECTED indicates the provider rejected the lease.
-	// Credit has been unlocked and returned to tenant.
-	LeaseState_LEASE_STATE_REJECTED LeaseState = 4
-	// LEASE_STATE_EXPIRED indicates the lease expired while in PENDING state.
-	// Provider did not acknowledge within the timeout period.
-	// Credit has been unlocked and returned to tenant.
-	LeaseState_LEASE_STATE_EXPIRED LeaseState = 5
-)
+func (x *LeaseSettlement) GetConverted() []*types.Coin {
+	if x != nil {
+		return x.Converted
+	}
+	return nil
+}
 
-// Enum value maps for LeaseState.
-var (
-	LeaseState_name = map[int32]string{
-		0: "LEASE_STATE_UNSPECIFIED",
-		1: "LEASE_STATE_PENDING",
-		2: "LEASE_STATE_ACTIVE",
-		3: "LEASE_STATE_CLOSED",
-		4: "LEASE_STATE_REJECTED",
-		5: "LEASE_STATE_EXPIRED",
+func (x *LeaseSettlement) GetConvertedValue() []*types.Coin {
+	if x != nil {
+		return x.ConvertedValue
 	}
-	LeaseState_value = map[string]int32{
-		"LEASE_STATE_UNSPECIFIED": 0,
-		"LEASE_STATE_PENDING":     1,
-		"LEASE_STATE_ACTIVE":      2,
-		"LEASE_STATE_CLOSED":      3,
-		"LEASE_STATE_REJECTED":    4,
-		"LEASE_STATE_EXPIRED":     5,
+	return nil
+}
+
+// Dispute is a tenant's claim that a lease was not delivered as paid for.
+// A lease can be disputed once; the record is kept until the lease is pruned.
+type Dispute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// lease_uuid is the UUID of the disputed lease.
+	LeaseUuid string `protobuf:"bytes,1,opt,name=lease_uuid,json=leaseUuid,proto3" json:"lease_uuid,omitempty"`
+	// tenant is the address of the lease owner who opened the dispute.
+	Tenant string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// provider_uuid is the provider of the lease (denormalized from the lease).
+	ProviderUuid string `protobuf:"bytes,3,opt,name=provider_uuid,json=providerUuid,proto3" json:"provider_uuid,omitempty"`
+	// state is the current state of the dispute.
+	State DisputeState `protobuf:"varint,4,opt,name=state,proto3,enum=liftedinit.billing.v1.DisputeState" json:"state,omitempty"`
+	// reason is the tenant's description of the problem. Maximum 256 characters.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// escrowed is the amount settled for the lease while the dispute is open,
+	// held by the dispute escrow account.
+	Escrowed []*types.Coin `protobuf:"bytes,6,rep,name=escrowed,proto3" json:"escrowed,omitempty"`
+	// opened_at is the block time at which the dispute was opened.
+	OpenedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=opened_at,json=openedAt,proto3" json:"opened_at,omitempty"`
+	// response_deadline is the time by which the provider must respond.
+	ResponseDeadline *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=response_deadline,json=responseDeadline,proto3" json:"response_deadline,omitempty"`
+	// response is the provider's answer to the dispute. Maximum 256 characters.
+	Response string `protobuf:"bytes,9,opt,name=response,proto3" json:"response,omitempty"`
+	// responded_at is the block time at which the provider responded.
+	RespondedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=responded_at,json=respondedAt,proto3" json:"responded_at,omitempty"`
+	// resolved_at is the block time at which the dispute was resolved or
+	// defaulted.
+	ResolvedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	// tenant_amounts is the part of the escrow returned to the tenant's credit
+	// account.
+	TenantAmounts []*types.Coin `protobuf:"bytes,12,rep,name=tenant_amounts,json=tenantAmounts,proto3" json:"tenant_amounts,omitempty"`
+	// provider_amounts is the part of the escrow paid to the provider.
+	ProviderAmounts []*types.Coin `protobuf:"bytes,13,rep,name=provider_amounts,json=providerAmounts,proto3" json:"provider_amounts,omitempty"`
+}
+
+func (x *Dispute) Reset() {
+	*x = Dispute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_liftedinit_billing_v1_types_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-)
+}
 
-func (x LeaseState) Enum() *LeaseState {
-	p := new(LeaseState)
-	*p = x
-	return p
+func (x *Dispute) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x LeaseState) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (*Dispute) ProtoMessage() {}
+
+// Deprecated: Use Dispute.ProtoReflect.Descriptor instead.
+func (*Dispute) Descriptor() ([]byte, []int) {
+	return file_liftedinit_billing_v1_types_proto_rawDescGZIP(), []int{7}
 }
 
-func (LeaseState) Descriptor() protoreflect.EnumDescriptor {
-	return file_liftedinit_billing_v1_types_proto_enumTypes[0].Descriptor()
+func (x *Dispute) GetLeaseUuid() string {
+	if x != nil {
+		return x.LeaseUuid
+	}
+	return ""
 }
 
-func (LeaseState) Type() protoreflect.EnumType {
-	return &file_liftedinit_billing_v1_types_proto_enumTypes[0]
+func (x *Dispute) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
 }
 
-func (x LeaseState) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *Dispute) GetProviderUuid() string {
+	if x != nil {
+		return x.ProviderUuid
+	}
+	return ""
 }
 
-// Deprecated: Use LeaseState.Descriptor instead.
-func (LeaseState) EnumDescriptor() ([]byte, []int) {
-	return file_liftedinit_billing_v1_types_proto_rawDescGZIP(), []int{0}
+func (x *Dispute) GetState() DisputeState {
+	if x != nil {
+		return x.State
+	}
+	return DisputeState_DISPUTE_STATE_UNSPECIFIED
 }
 
-// Params defines the parameters for the billing module.
-type Params struct {
+func (x *Dispute) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Dispute) GetEscrowed() []*types.Coin {
+	if x != nil {
+		return x.Escrowed
+	}
+	return nil
+}
+
+func (x *Dispute) GetOpenedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpenedAt
+	}
+	return nil
+}
+
+func (x *Dispute) GetResponseDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResponseDeadline
+	}
+	return nil
+}
+
+func (x *Dispute) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *Dispute) GetRespondedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RespondedAt
+	}
+	return nil
+}
+
+func (x *Dispute) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+func (x *Dispute) GetTenantAmounts() []*types.Coin {
+	if x != nil {
+		return x.TenantAmounts
+	}
+	return nil
+}
+
+func (x *Dispute) GetProviderAmounts() []*types.Coin {
+	if x != nil {
+		return x.ProviderAmounts
+	}
+	return nil
+}
+
+// ProviderPolicy holds the lease handling settings a provider chooses for its
+// own leases.
+type ProviderPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// max_leases_per_tenant is the maximum number of active leases a tenant can have.
-	// Must be greater than zero.
-	MaxLeasesPerTenant uint64 `protobuf:"varint,1,opt,name=max_leases_per_tenant,json=maxLeasesPerTenant,proto3" json:"max_leases_per_tenant,omitempty"`
-	// allowed_list is the list of addresses allowed to create leases on behalf of tenants
-	// in addition to the module authority.
-	AllowedList []string `protobuf:"bytes,2,rep,name=allowed_list,json=allowedList,proto3" json:"allowed_list,omitempty"`
-	// max_items_per_lease is the maximum number of items (SKUs) allowed in a single lease.
-	// Must be greater than zero. Prevents excessive gas consumption from large leases.
-	MaxItemsPerLease uint64 `protobuf:"varint,3,opt,name=max_items_per_lease,json=maxItemsPerLease,proto3" json:"max_items_per_lease,omitempty"`
-	// min_lease_duration is the minimum duration (in seconds) that a tenant's credit balance
-	// must be able to cover when creating a lease. This prevents tenants from creating leases
-	// that would immediately exhaust their credit. Default is 3600 (1 hour).
-	MinLeaseDuration uint64 `protobuf:"varint,4,opt,name=min_lease_duration,json=minLeaseDuration,proto3" json:"min_lease_duration,omitempty"`
-	// max_pending_leases_per_tenant is the maximum number of PENDING leases a tenant can have.
-	// Prevents spam attacks where tenants create many leases that providers must process.
-	// Default is 10.
-	MaxPendingLeasesPerTenant uint64 `protobuf:"varint,5,opt,name=max_pending_leases_per_tenant,json=maxPendingLeasesPerTenant,proto3" json:"max_pending_leases_per_tenant,omitempty"`
-	// pending_timeout is the duration in seconds that a lease can remain in PENDING state
-	// before it expires. Applies globally to all providers. Default is 1800 (30 minutes).
-	// Must be between 60 (1 minute) and 86400 (24 hours).
-	PendingTimeout uint64 `protobuf:"varint,6,opt,name=pending_timeout,json=pendingTimeout,proto3" json:"pending_timeout,omitempty"`
-	// reserved_domain_suffixes is the list of DNS suffixes (each beginning with `.`)
-	// that tenants are forbidden from claiming as a LeaseItem.custom_domain. These match
-	// provider wildcard zones (e.g. `.barney0.manifest0.net`) where the provider
-	// already serves auto-generated subdomains under a wildcard TLS cert. The match
-	// is a case-insensitive label-boundary suffix check and also covers the apex
-	// (e.g. `barney0.manifest0.net` itself). Tunable via MsgUpdateParams so new
-	// provider zones can be reserved without a chain upgrade.
-	ReservedDomainSuffixes []string `protobuf:"bytes,7,rep,name=reserved_domain_suffixes,json=reservedDomainSuffixes,proto3" json:"reserved_domain_suffixes,omitempty"`
+	// provider_uuid is the provider the policy applies to.
+	ProviderUuid string `protobuf:"bytes,1,opt,name=provider_uuid,json=providerUuid,proto3" json:"provider_uuid,omitempty"`
+	// pending_timeout overrides params.pending_timeout for the provider's
+	// PENDING leases, in seconds. Zero keeps params.pending_timeout.
+	PendingTimeout uint64 `protobuf:"varint,2,opt,name=pending_timeout,json=pendingTimeout,proto3" json:"pending_timeout,omitempty"`
+	// auto_acknowledge creates the provider's leases directly in the ACTIVE
+	// state, without waiting for MsgAcknowledgeLease.
+	AutoAcknowledge bool `protobuf:"varint,3,opt,name=auto_acknowledge,json=autoAcknowledge,proto3" json:"auto_acknowledge,omitempty"`
+	// allowed_tenants, when not empty, are the only tenants that may create
+	// leases with the provider.
+	AllowedTenants []string `protobuf:"bytes,4,rep,name=allowed_tenants,json=allowedTenants,proto3" json:"allowed_tenants,omitempty"`
+	// denied_tenants may not create leases with the provider.
+	DeniedTenants []string `protobuf:"bytes,5,rep,name=denied_tenants,json=deniedTenants,proto3" json:"denied_tenants,omitempty"`
+	// override_grace_period applies grace_period to the provider's leases in
+	// place of params.grace_period. It can only be set while
+	// params.max_provider_grace_period is not zero.
+	OverrideGracePeriod bool `protobuf:"varint,6,opt,name=override_grace_period,json=overrideGracePeriod,proto3" json:"override_grace_period,omitempty"`
+	// grace_period is the provider's grace period in seconds, used when
+	// override_grace_period is set; zero then disables grace for the
+	// provider's leases. It is clamped to params.max_provider_grace_period when
+	// read, and must be zero when override_grace_period is not set.
+	GracePeriod uint64 `protobuf:"varint,7,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"`
+}
+
+func (x *ProviderPolicy) Reset() {
+	*x = ProviderPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_liftedinit_billing_v1_types_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderPolicy) ProtoMessage() {}
+
+// Deprecated: Use ProviderPolicy.ProtoReflect.Descriptor instead.
+func (*ProviderPolicy) Descriptor() ([]byte, []int) {
+	return file_liftedinit_billing_v1_types_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ProviderPolicy) GetProviderUuid() string {
+	if x != nil {
+		return x.ProviderUuid
+	}
+	return ""
+}
+
+func (x *ProviderPolicy) GetPendingTimeout() uint64 {
+	if x != nil {
+		return x.PendingTimeout
+	}
+	return 0
+}
+
+func (x *ProviderPolicy) GetAutoAcknowledge() bool {
+	if x != nil {
+		return x.AutoAcknowledge
+	}
+	return false
+}
+
+func (x *ProviderPolicy) GetAllowedTenants() []string {
+	if x != nil {
+		return x.AllowedTenants
+	}
+	return nil
+}
+
+func (x *ProviderPolicy) GetDeniedTenants() []string {
+	if x != nil {
+		return x.DeniedTenants
+	}
+	return nil
+}
+
+func (x *ProviderPolicy) GetOverrideGracePeriod() bool {
+	if x != nil {
+		return x.OverrideGracePeriod
+	}
+	return false
+}
+
+func (x *ProviderPolicy) GetGracePeriod() uint64 {
+	if x != nil {
+		return x.GracePeriod
+	}
+	return 0
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
+// ProviderStats holds the lease counters of one provider, updated as its
+// leases change state. Durations are in seconds.
+type ProviderStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// provider_uuid is the provider the counters belong to.
+	ProviderUuid string `protobuf:"bytes,1,opt,name=provider_uuid,json=providerUuid,proto3" json:"provider_uuid,omitempty"`
+	// leases_created counts the leases created with the provider.
+	LeasesCreated uint64 `protobuf:"varint,2,opt,name=leases_created,json=leasesCreated,proto3" json:"leases_created,omitempty"`
+	// leases_acknowledged counts the leases that moved from PENDING to ACTIVE,
+	// including those auto-acknowledged at creation.
+	LeasesAcknowledged uint64 `protobuf:"varint,3,opt,name=leases_acknowledged,json=leasesAcknowledged,proto3" json:"leases_acknowledged,omitempty"`
+	// leases_rejected counts the PENDING leases the provider rejected.
+	LeasesRejected uint64 `protobuf:"varint,4,opt,name=leases_rejected,json=leasesRejected,proto3" json:"leases_rejected,omitempty"`
+	// leases_cancelled counts the PENDING leases their tenant cancelled.
+	LeasesCancelled uint64 `protobuf:"varint,5,opt,name=leases_cancelled,json=leasesCancelled,proto3" json:"leases_cancelled,omitempty"`
+	// leases_expired counts the PENDING leases that expired unacknowledged.
+	LeasesExpired uint64 `protobuf:"varint,6,opt,name=leases_expired,json=leasesExpired,proto3" json:"leases_expired,omitempty"`
+	// leases_closed counts the ACTIVE leases that were closed, for any reason.
+	LeasesClosed uint64 `protobuf:"varint,7,opt,name=leases_closed,json=leasesClosed,proto3" json:"leases_closed,omitempty"`
+	// credit_exhaustion_closures counts the closures of leases whose tenant ran
+	// out of credit. They are included in leases_closed.
+	CreditExhaustionClosures uint64 `protobuf:"varint,8,opt,name=credit_exhaustion_closures,json=creditExhaustionClosures,proto3" json:"credit_exhaustion_closures,omitempty"`
+	// ack_latency_sum is the sum over acknowledged leases, other than those
+	// counted in leases_acknowledged_at_creation, of the time between creation
+	// and acknowledgement.
+	AckLatencySum uint64 `protobuf:"varint,9,opt,name=ack_latency_sum,json=ackLatencySum,proto3" json:"ack_latency_sum,omitempty"`
+	// ack_latency_buckets counts the acknowledged leases per latency bucket,
+	// leaving out those counted in leases_acknowledged_at_creation. Entry i
+	// counts latencies up to AckLatencyBucketBounds()[i] that do not fit an
+	// earlier bucket; the last entry counts those above every bound.
+	AckLatencyBuckets []uint64 `protobuf:"varint,10,rep,packed,name=ack_latency_buckets,json=ackLatencyBuckets,proto3" json:"ack_latency_buckets,omitempty"`
+	// lifetime_sum is the sum over closed leases of the time between
+	// acknowledgement and closure.
+	LifetimeSum uint64 `protobuf:"varint,11,opt,name=lifetime_sum,json=lifetimeSum,proto3" json:"lifetime_sum,omitempty"`
+	// leases_acknowledged_at_creation counts the leases acknowledged in the
+	// block that created them, which includes every lease auto-acknowledged by
+	// the provider's policy. They are included in leases_acknowledged but not in
+	// the latency sum and buckets, which measure the provider's response time.
+	LeasesAcknowledgedAtCreation uint64 `protobuf:"varint,12,opt,name=leases_acknowledged_at_creation,json=leasesAcknowledgedAtCreation,proto3" json:"leases_acknowledged_at_creation,omitempty"`
+}
+
+func (x *ProviderStats) Reset() {
+	*x = ProviderStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_liftedinit_billing_v1_types_proto_msgTypes[0]
+		mi := &file_liftedinit_billing_v1_types_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Params) String() string {
+func (x *ProviderStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Params) ProtoMessage() {}
+func (*ProviderStats) ProtoMessage() {}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_liftedinit_billing_v1_types_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ProviderStats.ProtoReflect.Descriptor instead.
+func (*ProviderStats) Descriptor() ([]byte, []int) {
+	return file_liftedinit_billing_v1_types_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Params) GetMaxLeasesPerTenant() uint64 {
+func (x *ProviderStats) GetProviderUuid() string {
 	if x != nil {
-		return x.MaxLeasesPerTenant
+		return x.ProviderUuid
+	}
+	return ""
+}
+
+func (x *ProviderStats) GetLeasesCreated() uint64 {
+	if x != nil {
+		return x.LeasesCreated
 	}
 	return 0
 }
 
-func (x *Params) GetAllowedList() []string {
+func (x *ProviderStats) GetLeasesAcknowledged() uint64 {
 	if x != nil {
-		return x.AllowedList
+		return x.LeasesAcknowledged
 	}
-	return nil
+	return 0
 }
 
-func (x *Params) GetMaxItemsPerLease() uint64 {
+func (x *ProviderStats) GetLeasesRejected() uint64 {
 	if x != nil {
-		return x.MaxItemsPerLease
+		return x.LeasesRejected
 	}
 	return 0
 }
 
-func (x *Params) GetMinLeaseDuration() uint64 {
+func (x *ProviderStats) GetLeasesCancelled() uint64 {
 	if x != nil {
-		return x.MinLeaseDuration
+		return x.LeasesCancelled
 	}
 	return 0
 }
 
-func (x *Params) GetMaxPendingLeasesPerTenant() uint64 {
+func (x *ProviderStats) GetLeasesExpired() uint64 {
 	if x != nil {
-		return x.MaxPendingLeasesPerTenant
+		return x.LeasesExpired
 	}
 	return 0
 }
 
-func (x *Params) GetPendingTimeout() uint64 {
+func (x *ProviderStats) GetLeasesClosed() uint64 {
 	if x != nil {
-		return x.PendingTimeout
+		return x.LeasesClosed
 	}
 	return 0
 }
 
-func (x *Params) GetReservedDomainSuffixes() []string {
+func (x *ProviderStats) GetCreditExhaustionClosures() uint64 {
 	if x != nil {
-		return x.ReservedDomainSuffixes
+		return x.CreditExhaustionClosures
+	}
+	return 0
+}
+
+func (x *ProviderStats) GetAckLatencySum() uint64 {
+	if x != nil {
+		return x.AckLatencySum
+	}
+	return 0
+}
+
+func (x *ProviderStats) GetAckLatencyBuckets() []uint64 {
+	if x != nil {
+		return x.AckLatencyBuckets
 	}
 	return nil
 }
 
-// LeaseItem represents a single SKU item within a lease.
-type LeaseItem struct {
+func (x *ProviderStats) GetLifetimeSum() uint64 {
+	if x != nil {
+		return x.LifetimeSum
+	}
+	return 0
+}
+
+func (x *ProviderStats) GetLeasesAcknowledgedAtCreation() uint64 {
+	if x != nil {
+		return x.LeasesAcknowledgedAtCreation
+	}
+	return 0
+}
+
+// CustomDomainTarget identifies which lease + item a custom_domain resolves to.
+// Used as the value type of the CustomDomainIndex reverse-lookup map so that
+// queries by domain return the routing target without iterating lease items.
+type CustomDomainTarget struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// sku_uuid is the UUID of the SKU being leased.
-	SkuUuid string `protobuf:"bytes,1,opt,name=sku_uuid,json=skuUuid,proto3" json:"sku_uuid,omitempty"`
-	// quantity is the number of instances of this SKU.
-	Quantity uint64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	// locked_price is the price per unit locked at lease creation (per second).
-	// The denom comes from the SKU's base_price at lease creation time.
-	LockedPrice *types.Coin `protobuf:"bytes,3,opt,name=locked_price,json=lockedPrice,proto3" json:"locked_price,omitempty"`
-	// service_name is an optional DNS-label identifier for this item within a stack deployment.
-	// Must be a valid RFC 1123 DNS label: 1-63 lowercase alphanumeric characters or hyphens,
-	// must not start or end with a hyphen (e.g., "web", "db", "my-service-1").
-	// When used, all items must have a service_name and uniqueness shifts from sku_uuid to service_name,
-	// allowing the same SKU to appear multiple times (e.g., "web" and "db" both using docker-small).
-	//
-	// COMPUTE-SPECIFIC: this field belongs in a future x/deployment module rather
-	// than the generic billing lease. It lives here today as a pragmatic shortcut
-	// alongside LeaseItem.custom_domain. When a non-compute lease kind ships,
-	// migrate this (and custom_domain) into x/deployment via a state migration.
-	// Tracked: ENG-80.
-	ServiceName string `protobuf:"bytes,4,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
-	// custom_domain is the optional FQDN the tenant has assigned to this item.
-	// When set, requests to this domain are routed to this item's container by
-	// the provider, with a TLS cert provisioned via HTTP-01.
-	//
-	// COMPUTE-SPECIFIC: this field belongs in a future x/deployment module rather
-	// than the generic billing lease. It lives here today as a pragmatic shortcut
-	// alongside LeaseItem.service_name. When a non-compute lease kind ships,
-	// migrate this (and service_name) into x/deployment via a state migration.
-	// Tracked: ENG-80.
-	CustomDomain string `protobuf:"bytes,5,opt,name=custom_domain,json=customDomain,proto3" json:"custom_domain,omitempty"`
+	// lease_uuid is the UUID of the lease that holds the domain claim.
+	LeaseUuid string `protobuf:"bytes,1,opt,name=lease_uuid,json=leaseUuid,proto3" json:"lease_uuid,omitempty"`
+	// service_name is the addressing key of the LeaseItem within that lease.
+	// For a 1-item legacy lease (item.service_name == ""), this is "".
+	ServiceName string `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
 }
 
-func (x *LeaseItem) Reset() {
-	*x = LeaseItem{}
+func (x *CustomDomainTarget) Reset() {
+	*x = CustomDomainTarget{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_liftedinit_billing_v1_types_proto_msgTypes[1]
+		mi := &file_liftedinit_billing_v1_types_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LeaseItem) String() string {
+func (x *CustomDomainTarget) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaseItem) ProtoMessage() {}
+func (*CustomDomainTarget) ProtoMessage() {}
 
-// Deprecated: Use LeaseItem.ProtoReflect.Descriptor instead.
-func (*LeaseItem) Descriptor() ([]byte, []int) {
-	return file_liftedinit_billing_v1_types_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use CustomDomainTarget.ProtoReflect.Descriptor instead.
+func (*CustomDomainTarget) Descriptor() ([]byte, []int) {
+	return file_liftedinit_billing_v1_types_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *LeaseItem) GetSkuUuid() string {
+func (x *CustomDomainTarget) GetLeaseUuid() string {
 	if x != nil {
-		return x.SkuUuid
+		return x.LeaseUuid
 	}
 	return ""
 }
 
-func (x *LeaseItem) GetQuantity() uint64 {
-	if x != nil {
-		return x.Quantity
-	}
-	return 0
-}
-
-func (x *LeaseItem) GetLockedPrice() *types.Coin {
-	if x != nil {
-		return x.LockedPrice
-	}
-	return nil
-}
-
-func (x *LeaseItem) GetServiceName() string {
+func (x *CustomDomainTarget) GetServiceName() string {
 	if x != nil {
 		return x.ServiceName
 	}
 	return ""
 }
 
-func (x *LeaseItem) GetCustomDomain() string {
-	if x != nil {
-		return x.CustomDomain
-	}
-	return ""
-}
-
-// Lease represents a billing lease between a tenant and provider.
-type Lease struct {
+// CreditAccount represents a tenant's credit account.
+// The actual balance is tracked by the bank module at the credit_address.
+type CreditAccount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// uuid is the unique identifier of the lease (UUIDv7 format).
-	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	// tenant is the address of the tenant who owns this lease.
-	Tenant string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
-	// provider_uuid is the provider UUID (denormalized from SKUs for efficient querying).
-	ProviderUuid string `protobuf:"bytes,3,opt,name=provider_uuid,json=providerUuid,proto3" json:"provider_uuid,omitempty"`
-	// items is the list of SKU items in this lease.
-	Items []*LeaseItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
-	// state is the current state of the lease.
-	State LeaseState `protobuf:"varint,5,opt,name=state,proto3,enum=liftedinit.billing.v1.LeaseState" json:"state,omitempty"`
-	// created_at is the timestamp when the lease was created.
-	// Credit is locked at this time.
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// closed_at is the timestamp when the lease was closed (if CLOSED state).
-	ClosedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
-	// last_settled_at is the timestamp of the last settlement/withdrawal.
-	// Only applicable for ACTIVE and CLOSED states.
-	LastSettledAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_settled_at,json=lastSettledAt,proto3" json:"last_settled_at,omitempty"`
-	// acknowledged_at is the timestamp when the provider acknowledged the lease.
-	// Only set when state transitions from PENDING to ACTIVE.
-	// Billing starts from this timestamp.
-	AcknowledgedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=acknowledged_at,json=acknowledgedAt,proto3" json:"acknowledged_at,omitempty"`
-	// rejected_at is the timestamp when the provider rejected the lease.
-	// Only set when state is REJECTED.
-	RejectedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=rejected_at,json=rejectedAt,proto3" json:"rejected_at,omitempty"`
-	// rejection_reason is a free-form explanation for why the lease was rejected.
-	// Only set when state is REJECTED. Maximum 256 characters.
-	RejectionReason string `protobuf:"bytes,11,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
-	// expired_at is the timestamp when the lease expired while in PENDING state.
-	// Only set when state is EXPIRED.
-	ExpiredAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=expired_at,json=expiredAt,proto3" json:"expired_at,omitempty"`
-	// closure_reason is a free-form explanation for why the lease was closed.
-	// Only set when state is CLOSED. Maximum 256 characters.
-	ClosureReason string `protobuf:"bytes,13,opt,name=closure_reason,json=closureReason,proto3" json:"closure_reason,omitempty"`
-	// meta_hash is an optional hash/reference to off-chain deployment data.
-	// Set once at lease creation and immutable. Maximum 64 bytes.
-	MetaHash []byte `protobuf:"bytes,14,opt,name=meta_hash,json=metaHash,proto3" json:"meta_hash,omitempty"`
-	// min_lease_duration_at_creation stores the min_lease_duration parameter value
-	// at the time this lease was created. This ensures consistent reservation
-	// calculation regardless of subsequent parameter changes.
-	// reservation = sum(locked_price × quantity) × min_lease_duration_at_creation
-	MinLeaseDurationAtCreation uint64 `protobuf:"varint,15,opt,name=min_lease_duration_at_creation,json=minLeaseDurationAtCreation,proto3" json:"min_lease_duration_at_creation,omitempty"`
+	// tenant is the address of the tenant who owns this credit account.
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// credit_address is the derived address where credit funds are held.
+	CreditAddress string `protobuf:"bytes,2,opt,name=credit_address,json=creditAddress,proto3" json:"credit_address,omitempty"`
+	// active_lease_count tracks the number of active leases for O(1) count queries.
+	ActiveLeaseCount uint64 `protobuf:"varint,3,opt,name=active_lease_count,json=activeLeaseCount,proto3" json:"active_lease_count,omitempty"`
+	// pending_lease_count tracks the number of pending leases for O(1) count queries.
+	// Used to enforce max_pending_leases_per_tenant limit.
+	PendingLeaseCount uint64 `protobuf:"varint,4,opt,name=pending_lease_count,json=pendingLeaseCount,proto3" json:"pending_lease_count,omitempty"`
+	// reserved_amounts holds the sum of all credit reservations for active and pending leases.
+	// Each lease reserves: rate_per_second × min_lease_duration for each denom.
+	// This prevents overbooking by ensuring credit availability before lease creation.
+	ReservedAmounts []*types.Coin `protobuf:"bytes,5,rep,name=reserved_amounts,json=reservedAmounts,proto3" json:"reserved_amounts,omitempty"`
 }
 
-func (x *Lease) Reset() {
-	*x = Lease{}
+func (x *CreditAccount) Reset() {
+	*x = CreditAccount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_liftedinit_billing_v1_types_proto_msgTypes[2]
+		mi := &file_liftedinit_billing_v1_types_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Lease) String() string {
+func (x *CreditAccount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Lease) ProtoMessage() {}
+func (*CreditAccount) ProtoMessage() {}
 
-// Deprecated: Use Lease.ProtoReflect.Descriptor instead.
-func (*Lease) Descriptor() ([]byte, []int) {
-	return file_liftedinit_billing_v1_types_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use CreditAccount.ProtoReflect.Descriptor instead.
+func (*CreditAccount) Descriptor() ([]byte, []int) {
+	return file_liftedinit_billing_v1_types_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Lease) GetUuid() string {
+func (x *CreditAccount) GetTenant() string {
 	if x != nil {
-		return x.Uuid
+		return x.Tenant
 	}
 	return ""
 }
 
-func (x *Lease) GetTenant() string {
+func (x *CreditAccount) GetCreditAddress() string {
 	if x != nil {
-		return x.Tenant
+		return x.CreditAddress
 	}
 	return ""
 }
 
-func (x *Lease) GetProviderUuid() string {
+func (x *CreditAccount) GetActiveLeaseCount() uint64 {
 	if x != nil {
-		return x.ProviderUuid
+		return x.ActiveLeaseCount
 	}
-	return ""
+	return 0
 }
 
-func (x *Lease) GetItems() []*LeaseItem {
+func (x *CreditAccount) GetPendingLeaseCount() uint64 {
 	if x != nil {
-		return x.Items
+		return x.PendingLeaseCount
 	}
-	return nil
+	return 0
 }
 
-func (x *Lease) GetState() LeaseState {
+func (x *CreditAccount) GetReservedAmounts() []*types.Coin {
 	if x != nil {
-		return x.State
+		return x.ReservedAmounts
 	}
-	return LeaseState_LEASE_STATE_UNSPECIFIED
+	return nil
+}
+
+// CreditDeposit is credit that reached a tenant's credit account less than
+// params.credit_withdrawal_cooldown ago through MsgFundCredit from any
+// sender or MsgTransferCredit. It cannot be withdrawn or
+// transferred until the cooldown has passed since deposited_at, but it can
+// back leases straight away. Inflows are grouped per denom and hour, so
+// deposited_at is the end of the hour they arrived in.
+type CreditDeposit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tenant is the address of the tenant whose credit account was funded.
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// amount is the credit that arrived in the hour.
+	Amount *types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// deposited_at is the end of the hour the credit arrived in. The credit
+	// unlocks credit_withdrawal_cooldown seconds later.
+	DepositedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=deposited_at,json=depositedAt,proto3" json:"deposited_at,omitempty"`
+}
+
+func (x *CreditDeposit) Reset() {
+	*x = CreditDeposit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_liftedinit_billing_v1_types_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreditDeposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditDeposit) ProtoMessage() {}
+
+// Deprecated: Use CreditDeposit.ProtoReflect.Descriptor instead.
+func (*CreditDeposit) Descriptor() ([]byte, []int) {
+	return file_liftedinit_billing_v1_types_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Lease) GetCreatedAt() *timestamppb.Timestamp {
+func (x *CreditDeposit) GetTenant() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Tenant
 	}
-	return nil
+	return ""
 }
 
-func (x *Lease) GetClosedAt() *timestamppb.Timestamp {
+func (x *CreditDeposit) GetAmount() *types.Coin {
 	if x != nil {
-		return x.ClosedAt
+		return x.Amount
 	}
 	return nil
 }
 
-func (x *Lease) GetLastSettledAt() *timestamppb.Timestamp {
+func (x *CreditDeposit) GetDepositedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.LastSettledAt
+		return x.DepositedAt
 	}
 	return nil
 }
 
-func (x *Lease) GetAcknowledgedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.AcknowledgedAt
-	}
-	return nil
+// PromoGrant is promotional credit granted to a tenant by the authority. It
+// is held by the promo escrow account, not the tenant's credit address, so it
+// can only be spent by settlement: it cannot be withdrawn or transferred.
+type PromoGrant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the chain-wide sequence number of the grant.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// tenant is the address of the tenant the grant was made to.
+	Tenant string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// granter is the authority that funded the grant. What is left of the
+	// grant at expiry is returned to it.
+	Granter string `protobuf:"bytes,3,opt,name=granter,proto3" json:"granter,omitempty"`
+	// amount is the amount originally granted.
+	Amount []*types.Coin `protobuf:"bytes,4,rep,name=amount,proto3" json:"amount,omitempty"`
+	// remaining is the part of amount not spent yet.
+	Remaining []*types.Coin `protobuf:"bytes,5,rep,name=remaining,proto3" json:"remaining,omitempty"`
+	// created_at is the block time at which the grant was made.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// expires_at is the time from which the grant can no longer be spent. The
+	// EndBlocker then reclaims what remains.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// provider_uuids restricts the grant to leases of these providers. Empty
+	// means any provider.
+	ProviderUuids []string `protobuf:"bytes,8,rep,name=provider_uuids,json=providerUuids,proto3" json:"provider_uuids,omitempty"`
+	// sku_uuids restricts the grant to lease items of these SKUs. Empty means
+	// any SKU.
+	SkuUuids []string `protobuf:"bytes,9,rep,name=sku_uuids,json=skuUuids,proto3" json:"sku_uuids,omitempty"`
 }
 
-func (x *Lease) GetRejectedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.RejectedAt
+func (x *PromoGrant) Reset() {
+	*x = PromoGrant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_liftedinit_billing_v1_types_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Lease) GetRejectionReason() string {
+func (x *PromoGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoGrant) ProtoMessage() {}
+
+// Deprecated: Use PromoGrant.ProtoReflect.Descriptor instead.
+func (*PromoGrant) Descriptor() ([]byte, []int) {
+	return file_liftedinit_billing_v1_types_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PromoGrant) GetId() uint64 {
 	if x != nil {
-		return x.RejectionReason
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *Lease) GetExpiredAt() *timestamppb.Timestamp {
+func (x *PromoGrant) GetTenant() string {
 	if x != nil {
-		return x.ExpiredAt
+		return x.Tenant
 	}
-	return nil
+	return ""
 }
 
-func (x *Lease) GetClosureReason() string {
+func (x *PromoGrant) GetGranter() string {
 	if x != nil {
-		return x.ClosureReason
+		return x.Granter
 	}
 	return ""
 }
 
-func (x *Lease) GetMetaHash() []byte {
+func (x *PromoGrant) GetAmount() []*types.Coin {
 	if x != nil {
-		return x.MetaHash
+		return x.Amount
 	}
 	return nil
 }
 
-func (x *Lease) GetMinLeaseDurationAtCreation() uint64 {
+func (x *PromoGrant) GetRemaining() []*types.Coin {
 	if x != nil {
-		return x.MinLeaseDurationAtCreation
+		return x.Remaining
 	}
-	return 0
-}
-
-// CustomDomainTarget identifies which lease + item a custom_domain resolves to.
-// Used as the value type of the CustomDomainIndex reverse-lookup map so that
-// queries by domain return the routing target without iterating lease items.
-type CustomDomainTarget struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// lease_uuid is the UUID of the lease that holds the domain claim.
-	LeaseUuid string `protobuf:"bytes,1,opt,name=lease_uuid,json=leaseUuid,proto3" json:"lease_uuid,omitempty"`
-	// service_name is the addressing key of the LeaseItem within that lease.
-	// For a 1-item legacy lease (item.service_name == ""), this is "".
-	ServiceName string `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	return nil
 }
 
-func (x *CustomDomainTarget) Reset() {
-	*x = CustomDomainTarget{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_liftedinit_billing_v1_types_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *PromoGrant) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
+	return nil
 }
 
-func (x *CustomDomainTarget) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CustomDomainTarget) ProtoMessage() {}
-
-// Deprecated: Use CustomDomainTarget.ProtoReflect.Descriptor instead.
-func (*CustomDomainTarget) Descriptor() ([]byte, []int) {
-	return file_liftedinit_billing_v1_types_proto_rawDescGZIP(), []int{3}
+func (x *PromoGrant) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
 }
 
-func (x *CustomDomainTarget) GetLeaseUuid() string {
+func (x *PromoGrant) GetProviderUuids() []string {
 	if x != nil {
-		return x.LeaseUuid
+		return x.ProviderUuids
 	}
-	return ""
+	return nil
 }
 
-func (x *CustomDomainTarget) GetServiceName() string {
+func (x *PromoGrant) GetSkuUuids() []string {
 	if x != nil {
-		return x.ServiceName
+		return x.SkuUuids
 	}
-	return ""
+	return nil
 }
 
-// CreditAccount represents a tenant's credit account.
-// The actual balance is tracked by the bank module at the credit_address.
-type CreditAccount struct {
+// ExchangeRate is the value of one unit of a denom in the reference denom of
+// the module params, usable between valid_from and valid_until.
+type ExchangeRate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// tenant is the address of the tenant who owns this credit account.
-	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
-	// credit_address is the derived address where credit funds are held.
-	CreditAddress string `protobuf:"bytes,2,opt,name=credit_address,json=creditAddress,proto3" json:"credit_address,omitempty"`
-	// active_lease_count tracks the number of active leases for O(1) count queries.
-	ActiveLeaseCount uint64 `protobuf:"varint,3,opt,name=active_lease_count,json=activeLeaseCount,proto3" json:"active_lease_count,omitempty"`
-	// pending_lease_count tracks the number of pending leases for O(1) count queries.
-	// Used to enforce max_pending_leases_per_tenant limit.
-	PendingLeaseCount uint64 `protobuf:"varint,4,opt,name=pending_lease_count,json=pendingLeaseCount,proto3" json:"pending_lease_count,omitempty"`
-	// reserved_amounts holds the sum of all credit reservations for active and pending leases.
-	// Each lease reserves: rate_per_second × min_lease_duration for each denom.
-	// This prevents overbooking by ensuring credit availability before lease creation.
-	ReservedAmounts []*types.Coin `protobuf:"bytes,5,rep,name=reserved_amounts,json=reservedAmounts,proto3" json:"reserved_amounts,omitempty"`
+	// denom is the denom the rate applies to.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate is the amount of reference denom one unit of denom is worth. It is
+	// always positive.
+	Rate string `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	// valid_from is the first time the rate may be used.
+	ValidFrom *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	// valid_until is the time from which the rate may no longer be used.
+	ValidUntil *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	// set_by is the address of the price feeder or authority that set the
+	// rate.
+	SetBy string `protobuf:"bytes,5,opt,name=set_by,json=setBy,proto3" json:"set_by,omitempty"`
+	// set_at is the block time at which the rate was set.
+	SetAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=set_at,json=setAt,proto3" json:"set_at,omitempty"`
 }
 
-func (x *CreditAccount) Reset() {
-	*x = CreditAccount{}
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_liftedinit_billing_v1_types_proto_msgTypes[4]
+		mi := &file_liftedinit_billing_v1_types_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreditAccount) String() string {
+func (x *ExchangeRate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreditAccount) ProtoMessage() {}
+func (*ExchangeRate) ProtoMessage() {}
 
-// Deprecated: Use CreditAccount.ProtoReflect.Descriptor instead.
-func (*CreditAccount) Descriptor() ([]byte, []int) {
-	return file_liftedinit_billing_v1_types_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_liftedinit_billing_v1_types_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *CreditAccount) GetTenant() string {
+func (x *ExchangeRate) GetDenom() string {
 	if x != nil {
-		return x.Tenant
+		return x.Denom
 	}
 	return ""
 }
 
-func (x *CreditAccount) GetCreditAddress() string {
+func (x *ExchangeRate) GetRate() string {
 	if x != nil {
-		return x.CreditAddress
+		return x.Rate
 	}
 	return ""
 }
 
-func (x *CreditAccount) GetActiveLeaseCount() uint64 {
+func (x *ExchangeRate) GetValidFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ActiveLeaseCount
+		return x.ValidFrom
 	}
-	return 0
+	return nil
 }
 
-func (x *CreditAccount) GetPendingLeaseCount() uint64 {
+func (x *ExchangeRate) GetValidUntil() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PendingLeaseCount
+		return x.ValidUntil
 	}
-	return 0
+	return nil
 }
 
-func (x *CreditAccount) GetReservedAmounts() []*types.Coin {
+func (x *ExchangeRate) GetSetBy() string {
 	if x != nil {
-		return x.ReservedAmounts
+		return x.SetBy
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetSetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SetAt
 	}
 	return nil
 }
@@ -4786,7 +17577,7 @@ var file_liftedinit_billing_v1_types_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
 	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0xb5, 0x05, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x5d, 0x0a, 0x15, 0x6d, 0x61,
+	0xc0, 0x0a, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x5d, 0x0a, 0x15, 0x6d, 0x61,
 	0x78, 0x5f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x74, 0x65, 0x6e,
 	0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2a, 0xea, 0xde, 0x1f, 0x26, 0x6d,
 	0x61, 0x78, 0x5f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x74, 0x65,
@@ -4827,178 +17618,746 @@ var file_liftedinit_billing_v1_types_proto_rawDesc = []byte{
 	0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x75, 0x66,
 	0x66, 0x69, 0x78, 0x65, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52,
 	0x16, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x53,
-	0x75, 0x66, 0x66, 0x69, 0x78, 0x65, 0x73, 0x3a, 0x1e, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0,
-	0x2a, 0x15, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
-	0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xed, 0x02, 0x0a, 0x09, 0x4c, 0x65, 0x61, 0x73,
-	0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x6b, 0x75, 0x5f, 0x75, 0x75, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0xea, 0xde, 0x1f, 0x12, 0x73, 0x6b, 0x75,
-	0x5f, 0x75, 0x75, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52,
-	0x07, 0x73, 0x6b, 0x75, 0x55, 0x75, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1d, 0xea, 0xde, 0x1f, 0x19,
-	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x2c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x12, 0x52, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x75, 0x66, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x6d, 0x0a, 0x1a, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6f,
+	0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2f, 0xea, 0xde, 0x1f,
+	0x2b, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x18, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x43, 0x6f,
+	0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x44, 0x0a, 0x0c, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x42, 0x21, 0xea, 0xde,
+	0x1f, 0x1d, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x0b, 0x67, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x61, 0x0a, 0x16,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2b, 0xea, 0xde,
+	0x1f, 0x27, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x2c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x64, 0x0a, 0x17, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x2c, 0xea, 0xde, 0x1f, 0x28, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x15,
+	0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x46, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d,
+	0xea, 0xde, 0x1f, 0x19, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0e, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x58, 0x0a,
+	0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x65, 0x72, 0x73, 0x18, 0x0d,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x33, 0xea, 0xde, 0x1f, 0x17, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
+	0x66, 0x65, 0x65, 0x64, 0x65, 0x72, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x46, 0x65, 0x65, 0x64, 0x65, 0x72, 0x73, 0x12, 0x69, 0x0a, 0x19, 0x6d, 0x61, 0x78, 0x5f, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2e, 0xea, 0xde, 0x1f, 0x2a,
+	0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x61,
+	0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x2c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x47, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x3a, 0x1e, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x15, 0x6c, 0x69, 0x66,
+	0x74, 0x65, 0x64, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2f, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x22, 0xa7, 0x05, 0x0a, 0x09, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x12, 0x31, 0x0a, 0x08, 0x73, 0x6b, 0x75, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x16, 0xea, 0xde, 0x1f, 0x12, 0x73, 0x6b, 0x75, 0x5f, 0x75, 0x75, 0x69, 0x64,
+	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x07, 0x73, 0x6b, 0x75, 0x55,
+	0x75, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1d, 0xea, 0xde, 0x1f, 0x19, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x52,
+	0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x14, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xea, 0xde, 0x1f, 0x16, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x40, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xea, 0xde, 0x1f, 0x17, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x42, 0x15, 0xea, 0xde, 0x1f, 0x11, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x65,
+	0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x07, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x12, 0x61, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x40, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xea, 0xde,
+	0x1f, 0x0b, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x65, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0a, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x52, 0x61, 0x74, 0x65, 0x12, 0x43, 0x0a, 0x0e, 0x63, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x42,
+	0x1c, 0xea, 0xde, 0x1f, 0x18, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x6d, 0x6f,
+	0x6e, 0x74, 0x68, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0d, 0x63,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x5f, 0x0a, 0x0d,
+	0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x1f,
+	0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x17, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52,
+	0x0c, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x3a, 0x1d, 0x8a,
+	0xe7, 0xb0, 0x2a, 0x18, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x2f, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x22, 0xe1, 0x0e, 0x0a,
+	0x05, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x75, 0x75, 0x69, 0x64, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x44,
+	0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c,
+	0xea, 0xde, 0x1f, 0x10, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xea, 0xde, 0x1f,
+	0x17, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x12, 0x45, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e,
+	0x69, 0x74, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x4c, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6c,
+	0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42,
+	0x13, 0xea, 0xde, 0x1f, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x51, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x16, 0xc8, 0xde, 0x1f,
+	0x00, 0xea, 0xde, 0x1f, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x90,
+	0xdf, 0x1f, 0x01, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x54,
+	0x0a, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x1b, 0xea,
+	0xde, 0x1f, 0x13, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x08, 0x63, 0x6c, 0x6f, 0x73,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x5f, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x1b, 0xc8, 0xde, 0x1f, 0x00, 0xea,
+	0xde, 0x1f, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x64, 0x41, 0x74, 0x12, 0x66, 0x0a, 0x0f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x21, 0xea, 0xde, 0x1f, 0x19,
+	0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x61,
+	0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x41, 0x74, 0x12, 0x5a, 0x0a,
+	0x0b, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x1d,
+	0xea, 0xde, 0x1f, 0x15, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x72,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x49, 0x0a, 0x10, 0x72, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1e, 0xea, 0xde, 0x1f, 0x1a, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x1c, 0xea, 0xde, 0x1f, 0x14, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x90, 0xdf,
+	0x1f, 0x01, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x43, 0x0a,
+	0x0e, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xea, 0xde, 0x1f, 0x18, 0x63, 0x6c, 0x6f, 0x73, 0x75,
+	0x72, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x52, 0x0d, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x17, 0xea, 0xde, 0x1f, 0x13, 0x6d, 0x65, 0x74, 0x61, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x12, 0x77, 0x0a, 0x1e, 0x6d, 0x69, 0x6e, 0x5f,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61,
+	0x74, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x33, 0xea, 0xde, 0x1f, 0x2f, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x1a, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x68, 0x0a, 0x10, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x65,
+	0x6e, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x22, 0xea, 0xde, 0x1f, 0x1a, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x74, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x45, 0x6e, 0x64, 0x41, 0x74, 0x12, 0x5f, 0x0a, 0x0d, 0x67,
+	0x72, 0x61, 0x63, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x1f,
+	0xea, 0xde, 0x1f, 0x17, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x61,
+	0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x90, 0xdf, 0x1f, 0x01, 0x52,
+	0x0b, 0x67, 0x72, 0x61, 0x63, 0x65, 0x45, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x12, 0x71, 0x0a, 0x04,
+	0x64, 0x65, 0x62, 0x74, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x42, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0e, 0x64,
+	0x65, 0x62, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xaa, 0xdf, 0x1f,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x04, 0x64, 0x65, 0x62, 0x74, 0x12,
+	0x32, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x08, 0x42, 0x16, 0xea, 0xde, 0x1f, 0x12, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x64, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x08, 0x64, 0x69, 0x73, 0x70, 0x75,
+	0x74, 0x65, 0x64, 0x12, 0x8b, 0x01, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x64, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x4b, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x17,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x73, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x64, 0x12, 0x91, 0x01, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x72, 0x75, 0x61, 0x6c, 0x5f, 0x63, 0x61,
+	0x72, 0x72, 0x79, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x14, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0c, 0x6c, 0x6f,
-	0x63, 0x6b, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x6b,
-	0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xea,
-	0xde, 0x1f, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x2c,
-	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xea,
-	0xde, 0x1f, 0x17, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x3a, 0x1d, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x6c,
-	0x69, 0x66, 0x74, 0x65, 0x64, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2f, 0x4c, 0x65,
-	0x61, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x22, 0xcd, 0x09, 0x0a, 0x05, 0x4c, 0x65, 0x61, 0x73,
-	0x65, 0x12, 0x26, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x12, 0xea, 0xde, 0x1f, 0x0e, 0x75, 0x75, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
-	0x70, 0x74, 0x79, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x44, 0x0a, 0x06, 0x74, 0x65, 0x6e,
-	0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xea, 0xde, 0x1f, 0x10, 0x74,
-	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xd2,
-	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12,
-	0x40, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xea, 0xde, 0x1f, 0x17, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
-	0x70, 0x74, 0x79, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x75, 0x69,
-	0x64, 0x12, 0x45, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x20, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x62, 0x69,
-	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x74,
-	0x65, 0x6d, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x05, 0x69, 0x74, 0x65, 0x6d,
-	0x73, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x4c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64,
+	0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x4e, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f,
+	0x17, 0x61, 0x63, 0x63, 0x72, 0x75, 0x61, 0x6c, 0x5f, 0x63, 0x61, 0x72, 0x72, 0x79, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x72, 0x75, 0x61, 0x6c,
+	0x43, 0x61, 0x72, 0x72, 0x79, 0x3a, 0x19, 0x8a, 0xe7, 0xb0, 0x2a, 0x14, 0x6c, 0x69, 0x66, 0x74,
+	0x65, 0x64, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2f, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x22, 0xe6, 0x04, 0x0a, 0x0e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xea, 0xde, 0x1f, 0x14, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x52, 0x09, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x44, 0x0a, 0x06,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xea, 0xde,
+	0x1f, 0x10, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x12, 0x40, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xea, 0xde, 0x1f, 0x17, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x55, 0x75, 0x69, 0x64, 0x12, 0x50, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64,
 	0x69, 0x6e, 0x69, 0x74, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
-	0x4c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x13, 0xea, 0xde, 0x1f, 0x0f,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x51, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x16, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0a,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x54, 0x0a, 0x09, 0x63, 0x6c, 0x6f,
-	0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x1b, 0xea, 0xde, 0x1f, 0x13, 0x63, 0x6c,
-	0x6f, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x5f, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x11, 0xc8, 0xde, 0x1f, 0x00, 0xea,
+	0xde, 0x1f, 0x09, 0x61, 0x64, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x08, 0x61, 0x64,
+	0x64, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1a, 0xea, 0xde,
+	0x1f, 0x16, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x8a, 0x01, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x44, 0xc8, 0xde, 0x1f,
+	0x00, 0xea, 0xde, 0x1f, 0x10, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x12, 0x51, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x16, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x3a, 0x22, 0x8a, 0xe7, 0xb0, 0x2a, 0x1d, 0x6c, 0x69, 0x66, 0x74,
+	0x65, 0x64, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2f, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xda, 0x01, 0x0a, 0x0c, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x6b,
+	0x75, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0xea, 0xde,
+	0x1f, 0x12, 0x73, 0x6b, 0x75, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x52, 0x07, 0x73, 0x6b, 0x75, 0x55, 0x75, 0x69, 0x64, 0x12, 0x3d, 0x0a,
+	0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1a, 0xea, 0xde, 0x1f, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52,
+	0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x07,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xea,
+	0xde, 0x1f, 0x18, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x2c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x3a, 0x20, 0x8a, 0xe7, 0xb0, 0x2a, 0x1b, 0x6c, 0x69, 0x66, 0x74, 0x65,
+	0x64, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2f, 0x55, 0x73, 0x61, 0x67, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x22, 0xe2, 0x04, 0x0a, 0x0a, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xea, 0xde, 0x1f, 0x14, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x52, 0x09, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x51,
+	0x0a, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x62, 0x69,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x42, 0x10, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x08, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x7b, 0x0a, 0x0b, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x3f, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0b, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x73, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x44,
+	0x0a, 0x0c, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x21, 0xea, 0xde, 0x1f, 0x1d, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x2c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x12, 0x65, 0x0a, 0x11, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x1d, 0xc8, 0xde, 0x1f,
+	0x00, 0xea, 0xde, 0x1f, 0x11, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0f, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x7e, 0x0a, 0x0c, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x40, 0xc8, 0xde,
+	0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0c, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x70, 0x65,
+	0x6e, 0x74, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0b,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x3a, 0x1e, 0x8a, 0xe7, 0xb0,
+	0x2a, 0x19, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x2f, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x22, 0xbe, 0x09, 0x0a, 0x0f,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x27, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x17, 0xea, 0xde, 0x1f,
+	0x13, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x0a, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xea, 0xde,
+	0x1f, 0x14, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x09, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x55, 0x75, 0x69,
+	0x64, 0x12, 0x4f, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x28, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x42, 0x0b, 0xea, 0xde,
+	0x1f, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x12, 0x57, 0x0a, 0x0c, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x42, 0x1b, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0f, 0x6c, 0x61,
-	0x73, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x90, 0xdf, 0x1f,
-	0x01, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x66, 0x0a, 0x0f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x21, 0xea, 0xde, 0x1f, 0x19, 0x61, 0x63, 0x6b, 0x6e, 0x6f,
-	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77,
-	0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x41, 0x74, 0x12, 0x5a, 0x0a, 0x0b, 0x72, 0x65, 0x6a, 0x65,
-	0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x1d, 0xea, 0xde, 0x1f, 0x15, 0x72,
-	0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x49, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e,
-	0xea, 0xde, 0x1f, 0x1a, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0f,
-	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
-	0x57, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
-	0x1c, 0xea, 0xde, 0x1f, 0x14, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x2c,
-	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x65,
-	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x43, 0x0a, 0x0e, 0x63, 0x6c, 0x6f, 0x73,
-	0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x1c, 0xea, 0xde, 0x1f, 0x18, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0d,
-	0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x34, 0x0a,
-	0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c,
-	0x42, 0x17, 0xea, 0xde, 0x1f, 0x13, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x2c,
-	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x48,
-	0x61, 0x73, 0x68, 0x12, 0x77, 0x0a, 0x1e, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x61, 0x73, 0x65,
-	0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x74, 0x5f, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x42, 0x33, 0xea, 0xde, 0x1f,
-	0x2f, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2c,
-	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x52, 0x1a, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x41, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x19, 0x8a, 0xe7,
-	0xb0, 0x2a, 0x14, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
-	0x67, 0x2f, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x12, 0x43, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x37,
-	0x0a, 0x0a, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x18, 0xea, 0xde, 0x1f, 0x14, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x75, 0x75,
-	0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x09, 0x6c, 0x65,
-	0x61, 0x73, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xea,
-	0xde, 0x1f, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x2c,
-	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x93, 0x04, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x64, 0x69,
-	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xea, 0xde, 0x1f, 0x10, 0x74, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xd2, 0xb4,
-	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x5b,
-	0x0a, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x34, 0xea, 0xde, 0x1f, 0x18, 0x63, 0x72, 0x65, 0x64,
-	0x69, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x63, 0x72,
-	0x65, 0x64, 0x69, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x55, 0x0a, 0x12, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x27, 0xea, 0xde, 0x1f, 0x23, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x5f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2c,
-	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x58, 0x0a, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x65,
-	0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42,
-	0x28, 0xea, 0xde, 0x1f, 0x24, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x65, 0x61,
-	0x73, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x2c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x11, 0x70, 0x65, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x8a, 0x01, 0x0a,
-	0x10, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x18, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0c, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0b,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x51, 0x0a, 0x0a, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x16, 0xc8, 0xde, 0x1f,
+	0x00, 0xea, 0xde, 0x1f, 0x0a, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x90,
+	0xdf, 0x1f, 0x01, 0x52, 0x09, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45, 0x6e, 0x64, 0x12, 0x51,
+	0x0a, 0x0a, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x16,
+	0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0a, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x70, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x72, 0x75, 0x65, 0x64, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x3b, 0xc8,
+	0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x07, 0x61, 0x63, 0x63, 0x72, 0x75, 0x65, 0x64, 0xaa, 0xdf,
+	0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x07, 0x61, 0x63, 0x63, 0x72,
+	0x75, 0x65, 0x64, 0x12, 0x7c, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72,
+	0x65, 0x64, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x3f, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0b, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65,
+	0x64, 0x12, 0x76, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x66, 0x61, 0x6c, 0x6c, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x3d, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x66, 0x61,
+	0x6c, 0x6c, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x09,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x66, 0x61, 0x6c, 0x6c, 0x12, 0x6a, 0x0a, 0x05, 0x70, 0x72, 0x6f,
+	0x6d, 0x6f, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x39, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x05, 0x70, 0x72, 0x6f,
+	0x6d, 0x6f, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x05,
+	0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x12, 0x76, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x64, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x3d, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x09, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x73, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x12, 0x87, 0x01,
+	0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x43, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0f, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0xaa, 0xdf, 0x1f, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x23, 0x8a, 0xe7, 0xb0, 0x2a, 0x1e, 0x6c, 0x69,
+	0x66, 0x74, 0x65, 0x64, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2f, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x95, 0x09, 0x0a,
+	0x07, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xea, 0xde,
+	0x1f, 0x14, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x09, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x55, 0x75, 0x69,
+	0x64, 0x12, 0x44, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x2c, 0xea, 0xde, 0x1f, 0x10, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b,
+	0xea, 0xde, 0x1f, 0x17, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69,
+	0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0c, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x12, 0x4e, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65,
+	0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x13, 0xea,
+	0xde, 0x1f, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xea, 0xde, 0x1f, 0x10, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x73, 0x0a, 0x08, 0x65, 0x73, 0x63, 0x72, 0x6f,
+	0x77, 0x65, 0x64, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x08, 0x65, 0x73,
+	0x63, 0x72, 0x6f, 0x77, 0x65, 0x64, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x73, 0x52, 0x08, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x09,
+	0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x15, 0xc8, 0xde, 0x1f,
+	0x00, 0xea, 0xde, 0x1f, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x90, 0xdf,
+	0x1f, 0x01, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x12, 0x66, 0x0a, 0x11,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x11, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x90, 0xdf,
+	0x1f, 0x01, 0x52, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0xea, 0xde, 0x1f, 0x12, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x1e, 0xea, 0xde, 0x1f, 0x16,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x5a, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x1d, 0xea, 0xde, 0x1f, 0x15, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x84, 0x01, 0x0a, 0x0e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x42, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f,
+	0x0e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0xaa,
+	0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0d, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x8a, 0x01, 0x0a, 0x10, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18,
+	0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x44, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x10, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x3a, 0x1b, 0x8a, 0xe7, 0xb0, 0x2a, 0x16, 0x6c, 0x69,
+	0x66, 0x74, 0x65, 0x64, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2f, 0x44, 0x69, 0x73,
+	0x70, 0x75, 0x74, 0x65, 0x22, 0xbc, 0x04, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x40, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b,
+	0xea, 0xde, 0x1f, 0x17, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69,
+	0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0c, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x12, 0x4d, 0x0a, 0x0f, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x24, 0xea, 0xde, 0x1f, 0x20, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x2c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x49, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x6f,
+	0x5f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x1e, 0xea, 0xde, 0x1f, 0x1a, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x61, 0x63, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x52, 0x0f, 0x61, 0x75, 0x74, 0x6f, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x12, 0x46, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1d, 0xea, 0xde,
+	0x1f, 0x19, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0e, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x0e, 0x64,
+	0x65, 0x6e, 0x69, 0x65, 0x64, 0x5f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x1c, 0xea, 0xde, 0x1f, 0x18, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x5f,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x52, 0x0d, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73,
+	0x12, 0x57, 0x0a, 0x15, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x67, 0x72, 0x61,
+	0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42,
+	0x23, 0xea, 0xde, 0x1f, 0x1f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x67, 0x72,
+	0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x52, 0x13, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x47, 0x72,
+	0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x44, 0x0a, 0x0c, 0x67, 0x72, 0x61,
+	0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x21, 0xea, 0xde, 0x1f, 0x1d, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x3a,
+	0x22, 0x8a, 0xe7, 0xb0, 0x2a, 0x1d, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x2f, 0x62, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x2f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x22, 0xa4, 0x08, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xea, 0xde,
+	0x1f, 0x17, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x12, 0x4a, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x73, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x23, 0xea, 0xde, 0x1f, 0x1f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x5f, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x59, 0x0a, 0x13, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x5f, 0x61, 0x63,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x28, 0xea, 0xde, 0x1f, 0x24, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x5f, 0x61, 0x63, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x2c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x12, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x73, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x12, 0x4d,
+	0x0a, 0x0f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xea, 0xde, 0x1f, 0x20, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x50, 0x0a,
+	0x10, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x25, 0xea, 0xde, 0x1f, 0x21, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x73, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x12,
+	0x4a, 0x0a, 0x0e, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x23, 0xea, 0xde, 0x1f, 0x1f, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x73, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x73, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x47, 0x0a, 0x0d, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x22, 0xea, 0xde, 0x1f, 0x1e, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x5f, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x64, 0x12, 0x6d, 0x0a, 0x1a, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x65,
+	0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72,
+	0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2f, 0xea, 0xde, 0x1f, 0x2b, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x5f, 0x65, 0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x2c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x18, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x45, 0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x75,
+	0x72, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x0f, 0x61, 0x63, 0x6b, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x5f, 0x73, 0x75, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xea, 0xde,
+	0x1f, 0x20, 0x61, 0x63, 0x6b, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x73, 0x75,
+	0x6d, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x0d, 0x61, 0x63, 0x6b, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x75,
+	0x6d, 0x12, 0x51, 0x0a, 0x13, 0x61, 0x63, 0x6b, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x04, 0x42, 0x21,
+	0xea, 0xde, 0x1f, 0x1d, 0x61, 0x63, 0x6b, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x52, 0x11, 0x61, 0x63, 0x6b, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x12, 0x44, 0x0a, 0x0c, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x73, 0x75, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x42, 0x21, 0xea, 0xde, 0x1f, 0x1d,
+	0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x75, 0x6d, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x6c,
+	0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x75, 0x6d, 0x12, 0x7b, 0x0a, 0x1f, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x73, 0x5f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x34, 0xea, 0xde, 0x1f, 0x30, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x5f,
+	0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x2c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x1c, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x73, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x41, 0x74, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x21, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c, 0x6c, 0x69,
+	0x66, 0x74, 0x65, 0x64, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2f, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x12, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x12, 0x37, 0x0a, 0x0a, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xea, 0xde, 0x1f, 0x14, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x5f, 0x75, 0x75, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52,
+	0x09, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1a, 0xea, 0xde, 0x1f, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0b, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x93, 0x04, 0x0a, 0x0d, 0x43, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x06, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xea, 0xde, 0x1f,
+	0x10, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x12, 0x5b, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x34, 0xea, 0xde, 0x1f, 0x18, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x0d, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x55,
+	0x0a, 0x12, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x27, 0xea, 0xde, 0x1f, 0x23,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x58, 0x0a, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x28, 0xea, 0xde, 0x1f, 0x24, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x11, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x8a, 0x01, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x44, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x10, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0xaa,
+	0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x3a, 0x21, 0x8a, 0xe7,
+	0xb0, 0x2a, 0x1c, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x2f, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x94, 0x02, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x12, 0x44, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x2c, 0xea, 0xde, 0x1f, 0x10, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x41, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
-	0x69, 0x6e, 0x42, 0x44, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x10, 0x72, 0x65, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0xaa, 0xdf, 0x1f, 0x28,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x3a, 0x21, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c,
-	0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2f, 0x43,
-	0x72, 0x65, 0x64, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2a, 0xab, 0x01, 0x0a,
-	0x0a, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x4c,
-	0x45, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x45, 0x41, 0x53,
-	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
-	0x01, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
-	0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x45, 0x41,
-	0x53, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10,
-	0x03, 0x12, 0x18, 0x0a, 0x14, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
-	0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x4c,
-	0x45, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52,
-	0x45, 0x44, 0x10, 0x05, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xee, 0x01, 0x0a, 0x19, 0x63,
-	0x6f, 0x6d, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x62, 0x69,
-	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2d, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x2f, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2d, 0x6c, 0x65, 0x64,
-	0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e,
-	0x69, 0x74, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x69,
-	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4c, 0x42, 0x58, 0xaa, 0x02, 0x15,
-	0x4c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x69,
-	0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x15, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e,
-	0x69, 0x74, 0x5c, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21,
-	0x4c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x42, 0x69, 0x6c, 0x6c, 0x69,
-	0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x17, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x3a, 0x3a,
-	0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x69, 0x6e, 0x42, 0x0e, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x57, 0x0a, 0x0c, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x18, 0xc8, 0xde,
+	0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0c, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x3a, 0x21, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64,
+	0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2f, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0xec, 0x05, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x17, 0xea, 0xde, 0x1f, 0x13, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x2c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x02, 0x69, 0x64, 0x12, 0x44,
+	0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c,
+	0xea, 0xde, 0x1f, 0x10, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0xea, 0xde, 0x1f, 0x11, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x65, 0x72, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x6d, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x3a, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde,
+	0x1f, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x73, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x76, 0x0a, 0x09,
+	0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x3d, 0xc8, 0xde, 0x1f, 0x00,
+	0xea, 0xde, 0x1f, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0xaa, 0xdf, 0x1f,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x12, 0x51, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x16, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x51, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x16, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x90, 0xdf, 0x1f, 0x01, 0x52,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x43, 0x0a, 0x0e, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x1c, 0xea, 0xde, 0x1f, 0x18, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x5f, 0x75, 0x75, 0x69, 0x64, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x52, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x73, 0x12,
+	0x34, 0x0a, 0x09, 0x73, 0x6b, 0x75, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x17, 0xea, 0xde, 0x1f, 0x13, 0x73, 0x6b, 0x75, 0x5f, 0x75, 0x75, 0x69, 0x64,
+	0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x08, 0x73, 0x6b, 0x75,
+	0x55, 0x75, 0x69, 0x64, 0x73, 0x3a, 0x1e, 0x8a, 0xe7, 0xb0, 0x2a, 0x19, 0x6c, 0x69, 0x66, 0x74,
+	0x65, 0x64, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2f, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x22, 0xdf, 0x03, 0x0a, 0x0c, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0xea, 0xde, 0x1f, 0x0f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x12, 0x4d, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x39, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xea, 0xde, 0x1f, 0x04, 0x72, 0x61, 0x74, 0x65, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65,
+	0x12, 0x51, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x16, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x46,
+	0x72, 0x6f, 0x6d, 0x12, 0x54, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75, 0x6e, 0x74,
+	0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x17, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0b, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x43, 0x0a, 0x06, 0x73, 0x65, 0x74,
+	0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xea, 0xde, 0x1f, 0x10, 0x73,
+	0x65, 0x74, 0x5f, 0x62, 0x79, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x73, 0x65, 0x74, 0x42, 0x79, 0x12, 0x45,
+	0x0a, 0x06, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x12, 0xc8, 0xde, 0x1f, 0x00,
+	0xea, 0xde, 0x1f, 0x06, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x74, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x05,
+	0x73, 0x65, 0x74, 0x41, 0x74, 0x3a, 0x20, 0x8a, 0xe7, 0xb0, 0x2a, 0x1b, 0x6c, 0x69, 0x66, 0x74,
+	0x65, 0x64, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2f, 0x45, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x2a, 0xab, 0x01, 0x0a, 0x0a, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12,
+	0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x56, 0x45, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14,
+	0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x4a, 0x45,
+	0x43, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x05, 0x1a,
+	0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0xcd, 0x02, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x1e, 0x53,
+	0x45, 0x54, 0x54, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45,
+	0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1f, 0x0a, 0x1b, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x52,
+	0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x44, 0x52, 0x41, 0x57, 0x10, 0x01,
+	0x12, 0x1c, 0x0a, 0x18, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54,
+	0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x02, 0x12, 0x21,
+	0x0a, 0x1d, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x52, 0x49,
+	0x47, 0x47, 0x45, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10,
+	0x03, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x53, 0x5f, 0x43, 0x48,
+	0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x04, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x45, 0x54, 0x54, 0x4c,
+	0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x47, 0x52,
+	0x41, 0x43, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x10, 0x05, 0x12, 0x1e, 0x0a, 0x1a,
+	0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x52, 0x49, 0x47, 0x47,
+	0x45, 0x52, 0x5f, 0x44, 0x49, 0x53, 0x50, 0x55, 0x54, 0x45, 0x10, 0x06, 0x12, 0x1c, 0x0a, 0x18,
+	0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x52, 0x49, 0x47, 0x47,
+	0x45, 0x52, 0x5f, 0x55, 0x53, 0x41, 0x47, 0x45, 0x10, 0x07, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x45,
+	0x54, 0x54, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52,
+	0x5f, 0x44, 0x45, 0x42, 0x54, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x08, 0x1a,
+	0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0x84, 0x01, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x49, 0x53, 0x50, 0x55, 0x54,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x49, 0x53, 0x50, 0x55, 0x54, 0x45,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x1a, 0x0a,
+	0x16, 0x44, 0x49, 0x53, 0x50, 0x55, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52,
+	0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x49, 0x53,
+	0x50, 0x55, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55,
+	0x4c, 0x54, 0x45, 0x44, 0x10, 0x03, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xee, 0x01, 0x0a,
+	0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2d, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2d, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64,
+	0x69, 0x6e, 0x69, 0x74, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4c, 0x42, 0x58, 0xaa,
+	0x02, 0x15, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x42, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x15, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64,
+	0x69, 0x6e, 0x69, 0x74, 0x5c, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x21, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x42, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74,
+	0x3a, 0x3a, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5013,34 +18372,87 @@ func file_liftedinit_billing_v1_types_proto_rawDescGZIP() []byte {
 	return file_liftedinit_billing_v1_types_proto_rawDescData
 }
 
-var file_liftedinit_billing_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_liftedinit_billing_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_liftedinit_billing_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_liftedinit_billing_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_liftedinit_billing_v1_types_proto_goTypes = []interface{}{
 	(LeaseState)(0),               // 0: liftedinit.billing.v1.LeaseState
-	(*Params)(nil),                // 1: liftedinit.billing.v1.Params
-	(*LeaseItem)(nil),             // 2: liftedinit.billing.v1.LeaseItem
-	(*Lease)(nil),                 // 3: liftedinit.billing.v1.Lease
-	(*CustomDomainTarget)(nil),    // 4: liftedinit.billing.v1.CustomDomainTarget
-	(*CreditAccount)(nil),         // 5: liftedinit.billing.v1.CreditAccount
-	(*types.Coin)(nil),            // 6: cosmos.base.v1beta1.Coin
-	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(SettlementTrigger)(0),        // 1: liftedinit.billing.v1.SettlementTrigger
+	(DisputeState)(0),             // 2: liftedinit.billing.v1.DisputeState
+	(*Params)(nil),                // 3: liftedinit.billing.v1.Params
+	(*LeaseItem)(nil),             // 4: liftedinit.billing.v1.LeaseItem
+	(*Lease)(nil),                 // 5: liftedinit.billing.v1.Lease
+	(*LeaseAmendment)(nil),        // 6: liftedinit.billing.v1.LeaseAmendment
+	(*UsageCounter)(nil),          // 7: liftedinit.billing.v1.UsageCounter
+	(*LeaseUsage)(nil),            // 8: liftedinit.billing.v1.LeaseUsage
+	(*LeaseSettlement)(nil),       // 9: liftedinit.billing.v1.LeaseSettlement
+	(*Dispute)(nil),               // 10: liftedinit.billing.v1.Dispute
+	(*ProviderPolicy)(nil),        // 11: liftedinit.billing.v1.ProviderPolicy
+	(*ProviderStats)(nil),         // 12: liftedinit.billing.v1.ProviderStats
+	(*CustomDomainTarget)(nil),    // 13: liftedinit.billing.v1.CustomDomainTarget
+	(*CreditAccount)(nil),         // 14: liftedinit.billing.v1.CreditAccount
+	(*CreditDeposit)(nil),         // 15: liftedinit.billing.v1.CreditDeposit
+	(*PromoGrant)(nil),            // 16: liftedinit.billing.v1.PromoGrant
+	(*ExchangeRate)(nil),          // 17: liftedinit.billing.v1.ExchangeRate
+	(*types.Coin)(nil),            // 18: cosmos.base.v1beta1.Coin
+	(*timestamppb.Timestamp)(nil), // 19: google.protobuf.Timestamp
+	(*types.DecCoin)(nil),         // 20: cosmos.base.v1beta1.DecCoin
 }
 var file_liftedinit_billing_v1_types_proto_depIdxs = []int32{
-	6,  // 0: liftedinit.billing.v1.LeaseItem.locked_price:type_name -> cosmos.base.v1beta1.Coin
-	2,  // 1: liftedinit.billing.v1.Lease.items:type_name -> liftedinit.billing.v1.LeaseItem
-	0,  // 2: liftedinit.billing.v1.Lease.state:type_name -> liftedinit.billing.v1.LeaseState
-	7,  // 3: liftedinit.billing.v1.Lease.created_at:type_name -> google.protobuf.Timestamp
-	7,  // 4: liftedinit.billing.v1.Lease.closed_at:type_name -> google.protobuf.Timestamp
-	7,  // 5: liftedinit.billing.v1.Lease.last_settled_at:type_name -> google.protobuf.Timestamp
-	7,  // 6: liftedinit.billing.v1.Lease.acknowledged_at:type_name -> google.protobuf.Timestamp
-	7,  // 7: liftedinit.billing.v1.Lease.rejected_at:type_name -> google.protobuf.Timestamp
-	7,  // 8: liftedinit.billing.v1.Lease.expired_at:type_name -> google.protobuf.Timestamp
-	6,  // 9: liftedinit.billing.v1.CreditAccount.reserved_amounts:type_name -> cosmos.base.v1beta1.Coin
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	18, // 0: liftedinit.billing.v1.LeaseItem.locked_price:type_name -> cosmos.base.v1beta1.Coin
+	18, // 1: liftedinit.billing.v1.LeaseItem.monthly_price:type_name -> cosmos.base.v1beta1.Coin
+	4,  // 2: liftedinit.billing.v1.Lease.items:type_name -> liftedinit.billing.v1.LeaseItem
+	0,  // 3: liftedinit.billing.v1.Lease.state:type_name -> liftedinit.billing.v1.LeaseState
+	19, // 4: liftedinit.billing.v1.Lease.created_at:type_name -> google.protobuf.Timestamp
+	19, // 5: liftedinit.billing.v1.Lease.closed_at:type_name -> google.protobuf.Timestamp
+	19, // 6: liftedinit.billing.v1.Lease.last_settled_at:type_name -> google.protobuf.Timestamp
+	19, // 7: liftedinit.billing.v1.Lease.acknowledged_at:type_name -> google.protobuf.Timestamp
+	19, // 8: liftedinit.billing.v1.Lease.rejected_at:type_name -> google.protobuf.Timestamp
+	19, // 9: liftedinit.billing.v1.Lease.expired_at:type_name -> google.protobuf.Timestamp
+	19, // 10: liftedinit.billing.v1.Lease.scheduled_end_at:type_name -> google.protobuf.Timestamp
+	19, // 11: liftedinit.billing.v1.Lease.grace_ends_at:type_name -> google.protobuf.Timestamp
+	18, // 12: liftedinit.billing.v1.Lease.debt:type_name -> cosmos.base.v1beta1.Coin
+	18, // 13: liftedinit.billing.v1.Lease.total_settled:type_name -> cosmos.base.v1beta1.Coin
+	20, // 14: liftedinit.billing.v1.Lease.accrual_carry:type_name -> cosmos.base.v1beta1.DecCoin
+	4,  // 15: liftedinit.billing.v1.LeaseAmendment.add_items:type_name -> liftedinit.billing.v1.LeaseItem
+	18, // 16: liftedinit.billing.v1.LeaseAmendment.reserved_amounts:type_name -> cosmos.base.v1beta1.Coin
+	19, // 17: liftedinit.billing.v1.LeaseAmendment.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 18: liftedinit.billing.v1.LeaseUsage.counters:type_name -> liftedinit.billing.v1.UsageCounter
+	18, // 19: liftedinit.billing.v1.LeaseUsage.spend_limit:type_name -> cosmos.base.v1beta1.Coin
+	19, // 20: liftedinit.billing.v1.LeaseUsage.period_started_at:type_name -> google.protobuf.Timestamp
+	18, // 21: liftedinit.billing.v1.LeaseUsage.period_spent:type_name -> cosmos.base.v1beta1.Coin
+	1,  // 22: liftedinit.billing.v1.LeaseSettlement.trigger:type_name -> liftedinit.billing.v1.SettlementTrigger
+	19, // 23: liftedinit.billing.v1.LeaseSettlement.period_start:type_name -> google.protobuf.Timestamp
+	19, // 24: liftedinit.billing.v1.LeaseSettlement.period_end:type_name -> google.protobuf.Timestamp
+	19, // 25: liftedinit.billing.v1.LeaseSettlement.settled_at:type_name -> google.protobuf.Timestamp
+	18, // 26: liftedinit.billing.v1.LeaseSettlement.accrued:type_name -> cosmos.base.v1beta1.Coin
+	18, // 27: liftedinit.billing.v1.LeaseSettlement.transferred:type_name -> cosmos.base.v1beta1.Coin
+	18, // 28: liftedinit.billing.v1.LeaseSettlement.shortfall:type_name -> cosmos.base.v1beta1.Coin
+	18, // 29: liftedinit.billing.v1.LeaseSettlement.promo:type_name -> cosmos.base.v1beta1.Coin
+	18, // 30: liftedinit.billing.v1.LeaseSettlement.converted:type_name -> cosmos.base.v1beta1.Coin
+	18, // 31: liftedinit.billing.v1.LeaseSettlement.converted_value:type_name -> cosmos.base.v1beta1.Coin
+	2,  // 32: liftedinit.billing.v1.Dispute.state:type_name -> liftedinit.billing.v1.DisputeState
+	18, // 33: liftedinit.billing.v1.Dispute.escrowed:type_name -> cosmos.base.v1beta1.Coin
+	19, // 34: liftedinit.billing.v1.Dispute.opened_at:type_name -> google.protobuf.Timestamp
+	19, // 35: liftedinit.billing.v1.Dispute.response_deadline:type_name -> google.protobuf.Timestamp
+	19, // 36: liftedinit.billing.v1.Dispute.responded_at:type_name -> google.protobuf.Timestamp
+	19, // 37: liftedinit.billing.v1.Dispute.resolved_at:type_name -> google.protobuf.Timestamp
+	18, // 38: liftedinit.billing.v1.Dispute.tenant_amounts:type_name -> cosmos.base.v1beta1.Coin
+	18, // 39: liftedinit.billing.v1.Dispute.provider_amounts:type_name -> cosmos.base.v1beta1.Coin
+	18, // 40: liftedinit.billing.v1.CreditAccount.reserved_amounts:type_name -> cosmos.base.v1beta1.Coin
+	18, // 41: liftedinit.billing.v1.CreditDeposit.amount:type_name -> cosmos.base.v1beta1.Coin
+	19, // 42: liftedinit.billing.v1.CreditDeposit.deposited_at:type_name -> google.protobuf.Timestamp
+	18, // 43: liftedinit.billing.v1.PromoGrant.amount:type_name -> cosmos.base.v1beta1.Coin
+	18, // 44: liftedinit.billing.v1.PromoGrant.remaining:type_name -> cosmos.base.v1beta1.Coin
+	19, // 45: liftedinit.billing.v1.PromoGrant.created_at:type_name -> google.protobuf.Timestamp
+	19, // 46: liftedinit.billing.v1.PromoGrant.expires_at:type_name -> google.protobuf.Timestamp
+	19, // 47: liftedinit.billing.v1.ExchangeRate.valid_from:type_name -> google.protobuf.Timestamp
+	19, // 48: liftedinit.billing.v1.ExchangeRate.valid_until:type_name -> google.protobuf.Timestamp
+	19, // 49: liftedinit.billing.v1.ExchangeRate.set_at:type_name -> google.protobuf.Timestamp
+	50, // [50:50] is the sub-list for method output_type
+	50, // [50:50] is the sub-list for method input_type
+	50, // [50:50] is the sub-list for extension type_name
+	50, // [50:50] is the sub-list for extension extendee
+	0,  // [0:50] is the sub-list for field type_name
 }
 
 func init() { file_liftedinit_billing_v1_types_proto_init() }
@@ -5086,7 +18498,7 @@ func file_liftedinit_billing_v1_types_proto_init() {
 			}
 		}
 		file_liftedinit_billing_v1_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CustomDomainTarget); i {
+			switch v := v.(*LeaseAmendment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5098,6 +18510,90 @@ func file_liftedinit_billing_v1_types_proto_init() {
 			}
 		}
 		file_liftedinit_billing_v1_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsageCounter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_liftedinit_billing_v1_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_liftedinit_billing_v1_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseSettlement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_liftedinit_billing_v1_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Dispute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_liftedinit_billing_v1_types_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_liftedinit_billing_v1_types_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_liftedinit_billing_v1_types_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomDomainTarget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_liftedinit_billing_v1_types_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreditAccount); i {
 			case 0:
 				return &v.state
@@ -5109,14 +18605,50 @@ func file_liftedinit_billing_v1_types_proto_init() {
 				return nil
 			}
 		}
+		file_liftedinit_billing_v1_types_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreditDeposit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_liftedinit_billing_v1_types_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromoGrant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_liftedinit_billing_v1_types_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExchangeRate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_liftedinit_billing_v1_types_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   5,
+			NumEnums:      3,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -115,13 +115,118 @@ func (x *_GenesisState_3_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*PriceHistoryEntry
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceHistoryEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceHistoryEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(PriceHistoryEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(PriceHistoryEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*PendingPriceChange
+}
+
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingPriceChange)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingPriceChange)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(PendingPriceChange)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(PendingPriceChange)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState                   protoreflect.MessageDescriptor
-	fd_GenesisState_params            protoreflect.FieldDescriptor
-	fd_GenesisState_providers         protoreflect.FieldDescriptor
-	fd_GenesisState_skus              protoreflect.FieldDescriptor
-	fd_GenesisState_provider_sequence protoreflect.FieldDescriptor
-	fd_GenesisState_sku_sequence      protoreflect.FieldDescriptor
+	md_GenesisState                        protoreflect.MessageDescriptor
+	fd_GenesisState_params                 protoreflect.FieldDescriptor
+	fd_GenesisState_providers              protoreflect.FieldDescriptor
+	fd_GenesisState_skus                   protoreflect.FieldDescriptor
+	fd_GenesisState_provider_sequence      protoreflect.FieldDescriptor
+	fd_GenesisState_sku_sequence           protoreflect.FieldDescriptor
+	fd_GenesisState_price_history          protoreflect.FieldDescriptor
+	fd_GenesisState_price_history_sequence protoreflect.FieldDescriptor
+	fd_GenesisState_pending_price_changes  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -132,6 +237,9 @@ func init() {
 	fd_GenesisState_skus = md_GenesisState.Fields().ByName("skus")
 	fd_GenesisState_provider_sequence = md_GenesisState.Fields().ByName("provider_sequence")
 	fd_GenesisState_sku_sequence = md_GenesisState.Fields().ByName("sku_sequence")
+	fd_GenesisState_price_history = md_GenesisState.Fields().ByName("price_history")
+	fd_GenesisState_price_history_sequence = md_GenesisState.Fields().ByName("price_history_sequence")
+	fd_GenesisState_pending_price_changes = md_GenesisState.Fields().ByName("pending_price_changes")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -229,6 +337,24 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.PriceHistory) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.PriceHistory})
+		if !f(fd_GenesisState_price_history, value) {
+			return
+		}
+	}
+	if x.PriceHistorySequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PriceHistorySequence)
+		if !f(fd_GenesisState_price_history_sequence, value) {
+			return
+		}
+	}
+	if len(x.PendingPriceChanges) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.PendingPriceChanges})
+		if !f(fd_GenesisState_pending_price_changes, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -254,6 +380,12 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.ProviderSequence != uint64(0)
 	case "liftedinit.sku.v1.GenesisState.sku_sequence":
 		return x.SkuSequence != uint64(0)
+	case "liftedinit.sku.v1.GenesisState.price_history":
+		return len(x.PriceHistory) != 0
+	case "liftedinit.sku.v1.GenesisState.price_history_sequence":
+		return x.PriceHistorySequence != uint64(0)
+	case "liftedinit.sku.v1.GenesisState.pending_price_changes":
+		return len(x.PendingPriceChanges) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.GenesisState"))
@@ -280,6 +412,12 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.ProviderSequence = uint64(0)
 	case "liftedinit.sku.v1.GenesisState.sku_sequence":
 		x.SkuSequence = uint64(0)
+	case "liftedinit.sku.v1.GenesisState.price_history":
+		x.PriceHistory = nil
+	case "liftedinit.sku.v1.GenesisState.price_history_sequence":
+		x.PriceHistorySequence = uint64(0)
+	case "liftedinit.sku.v1.GenesisState.pending_price_changes":
+		x.PendingPriceChanges = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.GenesisState"))
@@ -317,6 +455,21 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "liftedinit.sku.v1.GenesisState.sku_sequence":
 		value := x.SkuSequence
 		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.sku.v1.GenesisState.price_history":
+		if len(x.PriceHistory) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.PriceHistory}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.sku.v1.GenesisState.price_history_sequence":
+		value := x.PriceHistorySequence
+		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.sku.v1.GenesisState.pending_price_changes":
+		if len(x.PendingPriceChanges) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.PendingPriceChanges}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.GenesisState"))
@@ -351,6 +504,16 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.ProviderSequence = value.Uint()
 	case "liftedinit.sku.v1.GenesisState.sku_sequence":
 		x.SkuSequence = value.Uint()
+	case "liftedinit.sku.v1.GenesisState.price_history":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.PriceHistory = *clv.list
+	case "liftedinit.sku.v1.GenesisState.price_history_sequence":
+		x.PriceHistorySequence = value.Uint()
+	case "liftedinit.sku.v1.GenesisState.pending_price_changes":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.PendingPriceChanges = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.GenesisState"))
@@ -388,10 +551,24 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_3_list{list: &x.Skus}
 		return protoreflect.ValueOfList(value)
+	case "liftedinit.sku.v1.GenesisState.price_history":
+		if x.PriceHistory == nil {
+			x.PriceHistory = []*PriceHistoryEntry{}
+		}
+		value := &_GenesisState_6_list{list: &x.PriceHistory}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.sku.v1.GenesisState.pending_price_changes":
+		if x.PendingPriceChanges == nil {
+			x.PendingPriceChanges = []*PendingPriceChange{}
+		}
+		value := &_GenesisState_8_list{list: &x.PendingPriceChanges}
+		return protoreflect.ValueOfList(value)
 	case "liftedinit.sku.v1.GenesisState.provider_sequence":
 		panic(fmt.Errorf("field provider_sequence of message liftedinit.sku.v1.GenesisState is not mutable"))
 	case "liftedinit.sku.v1.GenesisState.sku_sequence":
 		panic(fmt.Errorf("field sku_sequence of message liftedinit.sku.v1.GenesisState is not mutable"))
+	case "liftedinit.sku.v1.GenesisState.price_history_sequence":
+		panic(fmt.Errorf("field price_history_sequence of message liftedinit.sku.v1.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.GenesisState"))
@@ -418,6 +595,14 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "liftedinit.sku.v1.GenesisState.sku_sequence":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.sku.v1.GenesisState.price_history":
+		list := []*PriceHistoryEntry{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "liftedinit.sku.v1.GenesisState.price_history_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.sku.v1.GenesisState.pending_price_changes":
+		list := []*PendingPriceChange{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.GenesisState"))
@@ -509,6 +694,21 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		if x.SkuSequence != 0 {
 			n += 1 + runtime.Sov(uint64(x.SkuSequence))
 		}
+		if len(x.PriceHistory) > 0 {
+			for _, e := range x.PriceHistory {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.PriceHistorySequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.PriceHistorySequence))
+		}
+		if len(x.PendingPriceChanges) > 0 {
+			for _, e := range x.PendingPriceChanges {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -538,6 +738,43 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.PendingPriceChanges) > 0 {
+			for iNdEx := len(x.PendingPriceChanges) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PendingPriceChanges[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if x.PriceHistorySequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PriceHistorySequence))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.PriceHistory) > 0 {
+			for iNdEx := len(x.PriceHistory) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PriceHistory[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
 		if x.SkuSequence != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.SkuSequence))
 			i--
@@ -785,6 +1022,93 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceHistory", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PriceHistory = append(x.PriceHistory, &PriceHistoryEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PriceHistory[len(x.PriceHistory)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceHistorySequence", wireType)
+				}
+				x.PriceHistorySequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PriceHistorySequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingPriceChanges", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PendingPriceChanges = append(x.PendingPriceChanges, &PendingPriceChange{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingPriceChanges[len(x.PendingPriceChanges)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -851,6 +1175,12 @@ type GenesisState struct {
 	// sku_sequence is the sequence counter for deterministic SKU UUID generation.
 	// Exported via Sequence.Peek(); the next Sequence.Next() call will return this value.
 	SkuSequence uint64 `protobuf:"varint,5,opt,name=sku_sequence,json=skuSequence,proto3" json:"sku_sequence,omitempty"`
+	// price_history is the price history of the SKUs.
+	PriceHistory []*PriceHistoryEntry `protobuf:"bytes,6,rep,name=price_history,json=priceHistory,proto3" json:"price_history,omitempty"`
+	// price_history_sequence is the id the next PriceHistoryEntry will get.
+	PriceHistorySequence uint64 `protobuf:"varint,7,opt,name=price_history_sequence,json=priceHistorySequence,proto3" json:"price_history_sequence,omitempty"`
+	// pending_price_changes are the scheduled SKU price changes.
+	PendingPriceChanges []*PendingPriceChange `protobuf:"bytes,8,rep,name=pending_price_changes,json=pendingPriceChanges,proto3" json:"pending_price_changes,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -908,6 +1238,27 @@ func (x *GenesisState) GetSkuSequence() uint64 {
 	return 0
 }
 
+func (x *GenesisState) GetPriceHistory() []*PriceHistoryEntry {
+	if x != nil {
+		return x.PriceHistory
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPriceHistorySequence() uint64 {
+	if x != nil {
+		return x.PriceHistorySequence
+	}
+	return 0
+}
+
+func (x *GenesisState) GetPendingPriceChanges() []*PendingPriceChange {
+	if x != nil {
+		return x.PendingPriceChanges
+	}
+	return nil
+}
+
 var File_liftedinit_sku_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_liftedinit_sku_v1_genesis_proto_rawDesc = []byte{
@@ -917,7 +1268,7 @@ var file_liftedinit_sku_v1_genesis_proto_rawDesc = []byte{
 	0x75, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
 	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x6c, 0x69, 0x66, 0x74,
 	0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x73, 0x6b, 0x75, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8a, 0x02, 0x0a, 0x0c, 0x47, 0x65,
+	0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf2, 0x03, 0x0a, 0x0c, 0x47, 0x65,
 	0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x61,
 	0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x66,
 	0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x50,
@@ -934,21 +1285,35 @@ var file_liftedinit_sku_v1_genesis_proto_rawDesc = []byte{
 	0x04, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x65, 0x71, 0x75, 0x65,
 	0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6b, 0x75, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65,
 	0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x6b, 0x75, 0x53, 0x65,
-	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x42, 0xd4, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x6c,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x4f, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x70, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x5f, 0x0a,
+	0x15, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c,
 	0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31,
-	0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e,
-	0x69, 0x66, 0x65, 0x73, 0x74, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6d, 0x61,
-	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2d, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x73, 0x6b, 0x75,
-	0x2f, 0x76, 0x31, 0x3b, 0x73, 0x6b, 0x75, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4c, 0x53, 0x58, 0xaa,
-	0x02, 0x11, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x53, 0x6b, 0x75,
-	0x2e, 0x56, 0x31, 0xca, 0x02, 0x11, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74,
-	0x5c, 0x53, 0x6b, 0x75, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1d, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64,
-	0x69, 0x6e, 0x69, 0x74, 0x5c, 0x53, 0x6b, 0x75, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64,
-	0x69, 0x6e, 0x69, 0x74, 0x3a, 0x3a, 0x53, 0x6b, 0x75, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x42, 0xd4,
+	0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69,
+	0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2d, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2d, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64,
+	0x69, 0x6e, 0x69, 0x74, 0x2f, 0x73, 0x6b, 0x75, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x6b, 0x75, 0x76,
+	0x31, 0xa2, 0x02, 0x03, 0x4c, 0x53, 0x58, 0xaa, 0x02, 0x11, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64,
+	0x69, 0x6e, 0x69, 0x74, 0x2e, 0x53, 0x6b, 0x75, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x11, 0x4c, 0x69,
+	0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x53, 0x6b, 0x75, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x1d, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x53, 0x6b, 0x75,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x13, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x3a, 0x3a, 0x53, 0x6b,
+	0x75, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -965,20 +1330,24 @@ func file_liftedinit_sku_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_liftedinit_sku_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_liftedinit_sku_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: liftedinit.sku.v1.GenesisState
-	(*Params)(nil),       // 1: liftedinit.sku.v1.Params
-	(*Provider)(nil),     // 2: liftedinit.sku.v1.Provider
-	(*SKU)(nil),          // 3: liftedinit.sku.v1.SKU
+	(*GenesisState)(nil),       // 0: liftedinit.sku.v1.GenesisState
+	(*Params)(nil),             // 1: liftedinit.sku.v1.Params
+	(*Provider)(nil),           // 2: liftedinit.sku.v1.Provider
+	(*SKU)(nil),                // 3: liftedinit.sku.v1.SKU
+	(*PriceHistoryEntry)(nil),  // 4: liftedinit.sku.v1.PriceHistoryEntry
+	(*PendingPriceChange)(nil), // 5: liftedinit.sku.v1.PendingPriceChange
 }
 var file_liftedinit_sku_v1_genesis_proto_depIdxs = []int32{
 	1, // 0: liftedinit.sku.v1.GenesisState.params:type_name -> liftedinit.sku.v1.Params
 	2, // 1: liftedinit.sku.v1.GenesisState.providers:type_name -> liftedinit.sku.v1.Provider
 	3, // 2: liftedinit.sku.v1.GenesisState.skus:type_name -> liftedinit.sku.v1.SKU
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 3: liftedinit.sku.v1.GenesisState.price_history:type_name -> liftedinit.sku.v1.PriceHistoryEntry
+	5, // 4: liftedinit.sku.v1.GenesisState.pending_price_changes:type_name -> liftedinit.sku.v1.PendingPriceChange
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_liftedinit_sku_v1_genesis_proto_init() }
@@ -19,13 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName            = "/liftedinit.sku.v1.Query/Params"
-	Query_Provider_FullMethodName          = "/liftedinit.sku.v1.Query/Provider"
-	Query_Providers_FullMethodName         = "/liftedinit.sku.v1.Query/Providers"
-	Query_SKU_FullMethodName               = "/liftedinit.sku.v1.Query/SKU"
-	Query_SKUs_FullMethodName              = "/liftedinit.sku.v1.Query/SKUs"
-	Query_SKUsByProvider_FullMethodName    = "/liftedinit.sku.v1.Query/SKUsByProvider"
-	Query_ProviderByAddress_FullMethodName = "/liftedinit.sku.v1.Query/ProviderByAddress"
+	Query_Params_FullMethodName              = "/liftedinit.sku.v1.Query/Params"
+	Query_Provider_FullMethodName            = "/liftedinit.sku.v1.Query/Provider"
+	Query_Providers_FullMethodName           = "/liftedinit.sku.v1.Query/Providers"
+	Query_SKU_FullMethodName                 = "/liftedinit.sku.v1.Query/SKU"
+	Query_SKUs_FullMethodName                = "/liftedinit.sku.v1.Query/SKUs"
+	Query_SKUsByProvider_FullMethodName      = "/liftedinit.sku.v1.Query/SKUsByProvider"
+	Query_ProviderByAddress_FullMethodName   = "/liftedinit.sku.v1.Query/ProviderByAddress"
+	Query_SearchSKUs_FullMethodName          = "/liftedinit.sku.v1.Query/SearchSKUs"
+	Query_SKUPriceHistory_FullMethodName     = "/liftedinit.sku.v1.Query/SKUPriceHistory"
+	Query_PendingPriceChanges_FullMethodName = "/liftedinit.sku.v1.Query/PendingPriceChanges"
 )
 
 // QueryClient is the client API for Query service.
@@ -46,6 +49,14 @@ type QueryClient interface {
 	SKUsByProvider(ctx context.Context, in *QuerySKUsByProviderRequest, opts ...grpc.CallOption) (*QuerySKUsByProviderResponse, error)
 	// ProviderByAddress queries a provider by management address.
 	ProviderByAddress(ctx context.Context, in *QueryProviderByAddressRequest, opts ...grpc.CallOption) (*QueryProviderByAddressResponse, error)
+	// SearchSKUs queries SKUs by their attributes and those of their provider.
+	// It is served over POST because its filters do not fit a query string.
+	SearchSKUs(ctx context.Context, in *QuerySearchSKUsRequest, opts ...grpc.CallOption) (*QuerySearchSKUsResponse, error)
+	// SKUPriceHistory queries the price history of a SKU, oldest first.
+	SKUPriceHistory(ctx context.Context, in *QuerySKUPriceHistoryRequest, opts ...grpc.CallOption) (*QuerySKUPriceHistoryResponse, error)
+	// PendingPriceChanges queries the scheduled SKU price changes, soonest
+	// first.
+	PendingPriceChanges(ctx context.Context, in *QueryPendingPriceChangesRequest, opts ...grpc.CallOption) (*QueryPendingPriceChangesResponse, error)
 }
 
 type queryClient struct {
@@ -119,6 +130,33 @@ func (c *queryClient) ProviderByAddress(ctx context.Context, in *QueryProviderBy
 	return out, nil
 }
 
+func (c *queryClient) SearchSKUs(ctx context.Context, in *QuerySearchSKUsRequest, opts ...grpc.CallOption) (*QuerySearchSKUsResponse, error) {
+	out := new(QuerySearchSKUsResponse)
+	err := c.cc.Invoke(ctx, Query_SearchSKUs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SKUPriceHistory(ctx context.Context, in *QuerySKUPriceHistoryRequest, opts ...grpc.CallOption) (*QuerySKUPriceHistoryResponse, error) {
+	out := new(QuerySKUPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_SKUPriceHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingPriceChanges(ctx context.Context, in *QueryPendingPriceChangesRequest, opts ...grpc.CallOption) (*QueryPendingPriceChangesResponse, error) {
+	out := new(QueryPendingPriceChangesResponse)
+	err := c.cc.Invoke(ctx, Query_PendingPriceChanges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -137,6 +175,14 @@ type QueryServer interface {
 	SKUsByProvider(context.Context, *QuerySKUsByProviderRequest) (*QuerySKUsByProviderResponse, error)
 	// ProviderByAddress queries a provider by management address.
 	ProviderByAddress(context.Context, *QueryProviderByAddressRequest) (*QueryProviderByAddressResponse, error)
+	// SearchSKUs queries SKUs by their attributes and those of their provider.
+	// It is served over POST because its filters do not fit a query string.
+	SearchSKUs(context.Context, *QuerySearchSKUsRequest) (*QuerySearchSKUsResponse, error)
+	// SKUPriceHistory queries the price history of a SKU, oldest first.
+	SKUPriceHistory(context.Context, *QuerySKUPriceHistoryRequest) (*QuerySKUPriceHistoryResponse, error)
+	// PendingPriceChanges queries the scheduled SKU price changes, soonest
+	// first.
+	PendingPriceChanges(context.Context, *QueryPendingPriceChangesRequest) (*QueryPendingPriceChangesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -165,6 +211,15 @@ func (UnimplementedQueryServer) SKUsByProvider(context.Context, *QuerySKUsByProv
 func (UnimplementedQueryServer) ProviderByAddress(context.Context, *QueryProviderByAddressRequest) (*QueryProviderByAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProviderByAddress not implemented")
 }
+func (UnimplementedQueryServer) SearchSKUs(context.Context, *QuerySearchSKUsRequest) (*QuerySearchSKUsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchSKUs not implemented")
+}
+func (UnimplementedQueryServer) SKUPriceHistory(context.Context, *QuerySKUPriceHistoryRequest) (*QuerySKUPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SKUPriceHistory not implemented")
+}
+func (UnimplementedQueryServer) PendingPriceChanges(context.Context, *QueryPendingPriceChangesRequest) (*QueryPendingPriceChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingPriceChanges not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -304,6 +359,60 @@ func _Query_ProviderByAddress_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SearchSKUs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySearchSKUsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SearchSKUs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SearchSKUs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SearchSKUs(ctx, req.(*QuerySearchSKUsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SKUPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySKUPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SKUPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SKUPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SKUPriceHistory(ctx, req.(*QuerySKUPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingPriceChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingPriceChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingPriceChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingPriceChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingPriceChanges(ctx, req.(*QueryPendingPriceChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -339,6 +448,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProviderByAddress",
 			Handler:    _Query_ProviderByAddress_Handler,
 		},
+		{
+			MethodName: "SearchSKUs",
+			Handler:    _Query_SearchSKUs_Handler,
+		},
+		{
+			MethodName: "SKUPriceHistory",
+			Handler:    _Query_SKUPriceHistory_Handler,
+		},
+		{
+			MethodName: "PendingPriceChanges",
+			Handler:    _Query_PendingPriceChanges_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "liftedinit/sku/v1/query.proto",
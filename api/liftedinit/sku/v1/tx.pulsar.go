@@ -12,11 +12,63 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_MsgCreateProvider_6_list)(nil)
+
+type _MsgCreateProvider_6_list struct {
+	list *[]*Attribute
+}
+
+func (x *_MsgCreateProvider_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgCreateProvider_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgCreateProvider_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Attribute)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgCreateProvider_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Attribute)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgCreateProvider_6_list) AppendMutable() protoreflect.Value {
+	v := new(Attribute)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreateProvider_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgCreateProvider_6_list) NewElement() protoreflect.Value {
+	v := new(Attribute)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreateProvider_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_MsgCreateProvider                protoreflect.MessageDescriptor
 	fd_MsgCreateProvider_authority      protoreflect.FieldDescriptor
@@ -24,6 +76,7 @@ var (
 	fd_MsgCreateProvider_payout_address protoreflect.FieldDescriptor
 	fd_MsgCreateProvider_meta_hash      protoreflect.FieldDescriptor
 	fd_MsgCreateProvider_api_url        protoreflect.FieldDescriptor
+	fd_MsgCreateProvider_attributes     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -34,6 +87,7 @@ func init() {
 	fd_MsgCreateProvider_payout_address = md_MsgCreateProvider.Fields().ByName("payout_address")
 	fd_MsgCreateProvider_meta_hash = md_MsgCreateProvider.Fields().ByName("meta_hash")
 	fd_MsgCreateProvider_api_url = md_MsgCreateProvider.Fields().ByName("api_url")
+	fd_MsgCreateProvider_attributes = md_MsgCreateProvider.Fields().ByName("attributes")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgCreateProvider)(nil)
@@ -131,6 +185,12 @@ func (x *fastReflection_MsgCreateProvider) Range(f func(protoreflect.FieldDescri
 			return
 		}
 	}
+	if len(x.Attributes) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCreateProvider_6_list{list: &x.Attributes})
+		if !f(fd_MsgCreateProvider_attributes, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -156,6 +216,8 @@ func (x *fastReflection_MsgCreateProvider) Has(fd protoreflect.FieldDescriptor)
 		return len(x.MetaHash) != 0
 	case "liftedinit.sku.v1.MsgCreateProvider.api_url":
 		return x.ApiUrl != ""
+	case "liftedinit.sku.v1.MsgCreateProvider.attributes":
+		return len(x.Attributes) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgCreateProvider"))
@@ -182,6 +244,8 @@ func (x *fastReflection_MsgCreateProvider) Clear(fd protoreflect.FieldDescriptor
 		x.MetaHash = nil
 	case "liftedinit.sku.v1.MsgCreateProvider.api_url":
 		x.ApiUrl = ""
+	case "liftedinit.sku.v1.MsgCreateProvider.attributes":
+		x.Attributes = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgCreateProvider"))
@@ -213,6 +277,12 @@ func (x *fastReflection_MsgCreateProvider) Get(descriptor protoreflect.FieldDesc
 	case "liftedinit.sku.v1.MsgCreateProvider.api_url":
 		value := x.ApiUrl
 		return protoreflect.ValueOfString(value)
+	case "liftedinit.sku.v1.MsgCreateProvider.attributes":
+		if len(x.Attributes) == 0 {
+			return protoreflect.ValueOfList(&_MsgCreateProvider_6_list{})
+		}
+		listValue := &_MsgCreateProvider_6_list{list: &x.Attributes}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgCreateProvider"))
@@ -243,6 +313,10 @@ func (x *fastReflection_MsgCreateProvider) Set(fd protoreflect.FieldDescriptor,
 		x.MetaHash = value.Bytes()
 	case "liftedinit.sku.v1.MsgCreateProvider.api_url":
 		x.ApiUrl = value.Interface().(string)
+	case "liftedinit.sku.v1.MsgCreateProvider.attributes":
+		lv := value.List()
+		clv := lv.(*_MsgCreateProvider_6_list)
+		x.Attributes = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgCreateProvider"))
@@ -263,6 +337,12 @@ func (x *fastReflection_MsgCreateProvider) Set(fd protoreflect.FieldDescriptor,
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgCreateProvider) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "liftedinit.sku.v1.MsgCreateProvider.attributes":
+		if x.Attributes == nil {
+			x.Attributes = []*Attribute{}
+		}
+		value := &_MsgCreateProvider_6_list{list: &x.Attributes}
+		return protoreflect.ValueOfList(value)
 	case "liftedinit.sku.v1.MsgCreateProvider.authority":
 		panic(fmt.Errorf("field authority of message liftedinit.sku.v1.MsgCreateProvider is not mutable"))
 	case "liftedinit.sku.v1.MsgCreateProvider.address":
@@ -296,6 +376,9 @@ func (x *fastReflection_MsgCreateProvider) NewField(fd protoreflect.FieldDescrip
 		return protoreflect.ValueOfBytes(nil)
 	case "liftedinit.sku.v1.MsgCreateProvider.api_url":
 		return protoreflect.ValueOfString("")
+	case "liftedinit.sku.v1.MsgCreateProvider.attributes":
+		list := []*Attribute{}
+		return protoreflect.ValueOfList(&_MsgCreateProvider_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgCreateProvider"))
@@ -385,6 +468,12 @@ func (x *fastReflection_MsgCreateProvider) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Attributes) > 0 {
+			for _, e := range x.Attributes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -414,6 +503,22 @@ func (x *fastReflection_MsgCreateProvider) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Attributes) > 0 {
+			for iNdEx := len(x.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Attributes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
 		if len(x.ApiUrl) > 0 {
 			i -= len(x.ApiUrl)
 			copy(dAtA[i:], x.ApiUrl)
@@ -660,6 +765,40 @@ func (x *fastReflection_MsgCreateProvider) ProtoMethods() *protoiface.Methods {
 				}
 				x.ApiUrl = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Attributes = append(x.Attributes, &Attribute{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Attributes[len(x.Attributes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1115,6 +1254,57 @@ func (x *fastReflection_MsgCreateProviderResponse) ProtoMethods() *protoiface.Me
 	}
 }
 
+var _ protoreflect.List = (*_MsgUpdateProvider_8_list)(nil)
+
+type _MsgUpdateProvider_8_list struct {
+	list *[]*Attribute
+}
+
+func (x *_MsgUpdateProvider_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgUpdateProvider_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgUpdateProvider_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Attribute)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgUpdateProvider_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Attribute)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgUpdateProvider_8_list) AppendMutable() protoreflect.Value {
+	v := new(Attribute)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdateProvider_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgUpdateProvider_8_list) NewElement() protoreflect.Value {
+	v := new(Attribute)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdateProvider_8_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_MsgUpdateProvider                protoreflect.MessageDescriptor
 	fd_MsgUpdateProvider_authority      protoreflect.FieldDescriptor
@@ -1124,6 +1314,7 @@ var (
 	fd_MsgUpdateProvider_meta_hash      protoreflect.FieldDescriptor
 	fd_MsgUpdateProvider_active         protoreflect.FieldDescriptor
 	fd_MsgUpdateProvider_api_url        protoreflect.FieldDescriptor
+	fd_MsgUpdateProvider_attributes     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1136,6 +1327,7 @@ func init() {
 	fd_MsgUpdateProvider_meta_hash = md_MsgUpdateProvider.Fields().ByName("meta_hash")
 	fd_MsgUpdateProvider_active = md_MsgUpdateProvider.Fields().ByName("active")
 	fd_MsgUpdateProvider_api_url = md_MsgUpdateProvider.Fields().ByName("api_url")
+	fd_MsgUpdateProvider_attributes = md_MsgUpdateProvider.Fields().ByName("attributes")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgUpdateProvider)(nil)
@@ -1245,6 +1437,12 @@ func (x *fastReflection_MsgUpdateProvider) Range(f func(protoreflect.FieldDescri
 			return
 		}
 	}
+	if len(x.Attributes) != 0 {
+		value := protoreflect.ValueOfList(&_MsgUpdateProvider_8_list{list: &x.Attributes})
+		if !f(fd_MsgUpdateProvider_attributes, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1274,6 +1472,8 @@ func (x *fastReflection_MsgUpdateProvider) Has(fd protoreflect.FieldDescriptor)
 		return x.Active != false
 	case "liftedinit.sku.v1.MsgUpdateProvider.api_url":
 		return x.ApiUrl != ""
+	case "liftedinit.sku.v1.MsgUpdateProvider.attributes":
+		return len(x.Attributes) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgUpdateProvider"))
@@ -1304,6 +1504,8 @@ func (x *fastReflection_MsgUpdateProvider) Clear(fd protoreflect.FieldDescriptor
 		x.Active = false
 	case "liftedinit.sku.v1.MsgUpdateProvider.api_url":
 		x.ApiUrl = ""
+	case "liftedinit.sku.v1.MsgUpdateProvider.attributes":
+		x.Attributes = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgUpdateProvider"))
@@ -1341,6 +1543,12 @@ func (x *fastReflection_MsgUpdateProvider) Get(descriptor protoreflect.FieldDesc
 	case "liftedinit.sku.v1.MsgUpdateProvider.api_url":
 		value := x.ApiUrl
 		return protoreflect.ValueOfString(value)
+	case "liftedinit.sku.v1.MsgUpdateProvider.attributes":
+		if len(x.Attributes) == 0 {
+			return protoreflect.ValueOfList(&_MsgUpdateProvider_8_list{})
+		}
+		listValue := &_MsgUpdateProvider_8_list{list: &x.Attributes}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgUpdateProvider"))
@@ -1375,6 +1583,10 @@ func (x *fastReflection_MsgUpdateProvider) Set(fd protoreflect.FieldDescriptor,
 		x.Active = value.Bool()
 	case "liftedinit.sku.v1.MsgUpdateProvider.api_url":
 		x.ApiUrl = value.Interface().(string)
+	case "liftedinit.sku.v1.MsgUpdateProvider.attributes":
+		lv := value.List()
+		clv := lv.(*_MsgUpdateProvider_8_list)
+		x.Attributes = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgUpdateProvider"))
@@ -1395,6 +1607,12 @@ func (x *fastReflection_MsgUpdateProvider) Set(fd protoreflect.FieldDescriptor,
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgUpdateProvider) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "liftedinit.sku.v1.MsgUpdateProvider.attributes":
+		if x.Attributes == nil {
+			x.Attributes = []*Attribute{}
+		}
+		value := &_MsgUpdateProvider_8_list{list: &x.Attributes}
+		return protoreflect.ValueOfList(value)
 	case "liftedinit.sku.v1.MsgUpdateProvider.authority":
 		panic(fmt.Errorf("field authority of message liftedinit.sku.v1.MsgUpdateProvider is not mutable"))
 	case "liftedinit.sku.v1.MsgUpdateProvider.uuid":
@@ -1436,6 +1654,9 @@ func (x *fastReflection_MsgUpdateProvider) NewField(fd protoreflect.FieldDescrip
 		return protoreflect.ValueOfBool(false)
 	case "liftedinit.sku.v1.MsgUpdateProvider.api_url":
 		return protoreflect.ValueOfString("")
+	case "liftedinit.sku.v1.MsgUpdateProvider.attributes":
+		list := []*Attribute{}
+		return protoreflect.ValueOfList(&_MsgUpdateProvider_8_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgUpdateProvider"))
@@ -1532,6 +1753,12 @@ func (x *fastReflection_MsgUpdateProvider) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Attributes) > 0 {
+			for _, e := range x.Attributes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1561,6 +1788,22 @@ func (x *fastReflection_MsgUpdateProvider) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Attributes) > 0 {
+			for iNdEx := len(x.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Attributes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
 		if len(x.ApiUrl) > 0 {
 			i -= len(x.ApiUrl)
 			copy(dAtA[i:], x.ApiUrl)
@@ -1876,6 +2119,40 @@ func (x *fastReflection_MsgUpdateProvider) ProtoMethods() *protoiface.Methods {
 				}
 				x.ApiUrl = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Attributes = append(x.Attributes, &Attribute{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Attributes[len(x.Attributes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3257,6 +3534,108 @@ func (x *fastReflection_MsgDeactivateProviderResponse) ProtoMethods() *protoifac
 	}
 }
 
+var _ protoreflect.List = (*_MsgCreateSKU_7_list)(nil)
+
+type _MsgCreateSKU_7_list struct {
+	list *[]*PriceTier
+}
+
+func (x *_MsgCreateSKU_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgCreateSKU_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgCreateSKU_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgCreateSKU_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgCreateSKU_7_list) AppendMutable() protoreflect.Value {
+	v := new(PriceTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreateSKU_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgCreateSKU_7_list) NewElement() protoreflect.Value {
+	v := new(PriceTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreateSKU_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgCreateSKU_9_list)(nil)
+
+type _MsgCreateSKU_9_list struct {
+	list *[]*Attribute
+}
+
+func (x *_MsgCreateSKU_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgCreateSKU_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgCreateSKU_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Attribute)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgCreateSKU_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Attribute)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgCreateSKU_9_list) AppendMutable() protoreflect.Value {
+	v := new(Attribute)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreateSKU_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgCreateSKU_9_list) NewElement() protoreflect.Value {
+	v := new(Attribute)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreateSKU_9_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_MsgCreateSKU               protoreflect.MessageDescriptor
 	fd_MsgCreateSKU_authority     protoreflect.FieldDescriptor
@@ -3265,6 +3644,9 @@ var (
 	fd_MsgCreateSKU_unit          protoreflect.FieldDescriptor
 	fd_MsgCreateSKU_base_price    protoreflect.FieldDescriptor
 	fd_MsgCreateSKU_meta_hash     protoreflect.FieldDescriptor
+	fd_MsgCreateSKU_price_tiers   protoreflect.FieldDescriptor
+	fd_MsgCreateSKU_capacity      protoreflect.FieldDescriptor
+	fd_MsgCreateSKU_attributes    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -3276,6 +3658,9 @@ func init() {
 	fd_MsgCreateSKU_unit = md_MsgCreateSKU.Fields().ByName("unit")
 	fd_MsgCreateSKU_base_price = md_MsgCreateSKU.Fields().ByName("base_price")
 	fd_MsgCreateSKU_meta_hash = md_MsgCreateSKU.Fields().ByName("meta_hash")
+	fd_MsgCreateSKU_price_tiers = md_MsgCreateSKU.Fields().ByName("price_tiers")
+	fd_MsgCreateSKU_capacity = md_MsgCreateSKU.Fields().ByName("capacity")
+	fd_MsgCreateSKU_attributes = md_MsgCreateSKU.Fields().ByName("attributes")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgCreateSKU)(nil)
@@ -3379,6 +3764,24 @@ func (x *fastReflection_MsgCreateSKU) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.PriceTiers) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCreateSKU_7_list{list: &x.PriceTiers})
+		if !f(fd_MsgCreateSKU_price_tiers, value) {
+			return
+		}
+	}
+	if x.Capacity != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Capacity)
+		if !f(fd_MsgCreateSKU_capacity, value) {
+			return
+		}
+	}
+	if len(x.Attributes) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCreateSKU_9_list{list: &x.Attributes})
+		if !f(fd_MsgCreateSKU_attributes, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -3406,6 +3809,12 @@ func (x *fastReflection_MsgCreateSKU) Has(fd protoreflect.FieldDescriptor) bool
 		return x.BasePrice != nil
 	case "liftedinit.sku.v1.MsgCreateSKU.meta_hash":
 		return len(x.MetaHash) != 0
+	case "liftedinit.sku.v1.MsgCreateSKU.price_tiers":
+		return len(x.PriceTiers) != 0
+	case "liftedinit.sku.v1.MsgCreateSKU.capacity":
+		return x.Capacity != uint64(0)
+	case "liftedinit.sku.v1.MsgCreateSKU.attributes":
+		return len(x.Attributes) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgCreateSKU"))
@@ -3434,6 +3843,12 @@ func (x *fastReflection_MsgCreateSKU) Clear(fd protoreflect.FieldDescriptor) {
 		x.BasePrice = nil
 	case "liftedinit.sku.v1.MsgCreateSKU.meta_hash":
 		x.MetaHash = nil
+	case "liftedinit.sku.v1.MsgCreateSKU.price_tiers":
+		x.PriceTiers = nil
+	case "liftedinit.sku.v1.MsgCreateSKU.capacity":
+		x.Capacity = uint64(0)
+	case "liftedinit.sku.v1.MsgCreateSKU.attributes":
+		x.Attributes = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgCreateSKU"))
@@ -3468,6 +3883,21 @@ func (x *fastReflection_MsgCreateSKU) Get(descriptor protoreflect.FieldDescripto
 	case "liftedinit.sku.v1.MsgCreateSKU.meta_hash":
 		value := x.MetaHash
 		return protoreflect.ValueOfBytes(value)
+	case "liftedinit.sku.v1.MsgCreateSKU.price_tiers":
+		if len(x.PriceTiers) == 0 {
+			return protoreflect.ValueOfList(&_MsgCreateSKU_7_list{})
+		}
+		listValue := &_MsgCreateSKU_7_list{list: &x.PriceTiers}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.sku.v1.MsgCreateSKU.capacity":
+		value := x.Capacity
+		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.sku.v1.MsgCreateSKU.attributes":
+		if len(x.Attributes) == 0 {
+			return protoreflect.ValueOfList(&_MsgCreateSKU_9_list{})
+		}
+		listValue := &_MsgCreateSKU_9_list{list: &x.Attributes}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgCreateSKU"))
@@ -3500,6 +3930,16 @@ func (x *fastReflection_MsgCreateSKU) Set(fd protoreflect.FieldDescriptor, value
 		x.BasePrice = value.Message().Interface().(*types.Coin)
 	case "liftedinit.sku.v1.MsgCreateSKU.meta_hash":
 		x.MetaHash = value.Bytes()
+	case "liftedinit.sku.v1.MsgCreateSKU.price_tiers":
+		lv := value.List()
+		clv := lv.(*_MsgCreateSKU_7_list)
+		x.PriceTiers = *clv.list
+	case "liftedinit.sku.v1.MsgCreateSKU.capacity":
+		x.Capacity = value.Uint()
+	case "liftedinit.sku.v1.MsgCreateSKU.attributes":
+		lv := value.List()
+		clv := lv.(*_MsgCreateSKU_9_list)
+		x.Attributes = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgCreateSKU"))
@@ -3525,6 +3965,18 @@ func (x *fastReflection_MsgCreateSKU) Mutable(fd protoreflect.FieldDescriptor) p
 			x.BasePrice = new(types.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.BasePrice.ProtoReflect())
+	case "liftedinit.sku.v1.MsgCreateSKU.price_tiers":
+		if x.PriceTiers == nil {
+			x.PriceTiers = []*PriceTier{}
+		}
+		value := &_MsgCreateSKU_7_list{list: &x.PriceTiers}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.sku.v1.MsgCreateSKU.attributes":
+		if x.Attributes == nil {
+			x.Attributes = []*Attribute{}
+		}
+		value := &_MsgCreateSKU_9_list{list: &x.Attributes}
+		return protoreflect.ValueOfList(value)
 	case "liftedinit.sku.v1.MsgCreateSKU.authority":
 		panic(fmt.Errorf("field authority of message liftedinit.sku.v1.MsgCreateSKU is not mutable"))
 	case "liftedinit.sku.v1.MsgCreateSKU.provider_uuid":
@@ -3535,6 +3987,8 @@ func (x *fastReflection_MsgCreateSKU) Mutable(fd protoreflect.FieldDescriptor) p
 		panic(fmt.Errorf("field unit of message liftedinit.sku.v1.MsgCreateSKU is not mutable"))
 	case "liftedinit.sku.v1.MsgCreateSKU.meta_hash":
 		panic(fmt.Errorf("field meta_hash of message liftedinit.sku.v1.MsgCreateSKU is not mutable"))
+	case "liftedinit.sku.v1.MsgCreateSKU.capacity":
+		panic(fmt.Errorf("field capacity of message liftedinit.sku.v1.MsgCreateSKU is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgCreateSKU"))
@@ -3561,6 +4015,14 @@ func (x *fastReflection_MsgCreateSKU) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "liftedinit.sku.v1.MsgCreateSKU.meta_hash":
 		return protoreflect.ValueOfBytes(nil)
+	case "liftedinit.sku.v1.MsgCreateSKU.price_tiers":
+		list := []*PriceTier{}
+		return protoreflect.ValueOfList(&_MsgCreateSKU_7_list{list: &list})
+	case "liftedinit.sku.v1.MsgCreateSKU.capacity":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.sku.v1.MsgCreateSKU.attributes":
+		list := []*Attribute{}
+		return protoreflect.ValueOfList(&_MsgCreateSKU_9_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgCreateSKU"))
@@ -3653,6 +4115,21 @@ func (x *fastReflection_MsgCreateSKU) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.PriceTiers) > 0 {
+			for _, e := range x.PriceTiers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Capacity != 0 {
+			n += 1 + runtime.Sov(uint64(x.Capacity))
+		}
+		if len(x.Attributes) > 0 {
+			for _, e := range x.Attributes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3682,6 +4159,43 @@ func (x *fastReflection_MsgCreateSKU) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Attributes) > 0 {
+			for iNdEx := len(x.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Attributes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if x.Capacity != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Capacity))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.PriceTiers) > 0 {
+			for iNdEx := len(x.PriceTiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PriceTiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
 		if len(x.MetaHash) > 0 {
 			i -= len(x.MetaHash)
 			copy(dAtA[i:], x.MetaHash)
@@ -3963,52 +4477,139 @@ func (x *fastReflection_MsgCreateSKU) ProtoMethods() *protoiface.Methods {
 					x.MetaHash = []byte{}
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceTiers", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.PriceTiers = append(x.PriceTiers, &PriceTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PriceTiers[len(x.PriceTiers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_MsgCreateSKUResponse      protoreflect.MessageDescriptor
-	fd_MsgCreateSKUResponse_uuid protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_liftedinit_sku_v1_tx_proto_init()
-	md_MsgCreateSKUResponse = File_liftedinit_sku_v1_tx_proto.Messages().ByName("MsgCreateSKUResponse")
-	fd_MsgCreateSKUResponse_uuid = md_MsgCreateSKUResponse.Fields().ByName("uuid")
-}
-
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+				}
+				x.Capacity = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Capacity |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Attributes = append(x.Attributes, &Attribute{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Attributes[len(x.Attributes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCreateSKUResponse      protoreflect.MessageDescriptor
+	fd_MsgCreateSKUResponse_uuid protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_liftedinit_sku_v1_tx_proto_init()
+	md_MsgCreateSKUResponse = File_liftedinit_sku_v1_tx_proto.Messages().ByName("MsgCreateSKUResponse")
+	fd_MsgCreateSKUResponse_uuid = md_MsgCreateSKUResponse.Fields().ByName("uuid")
+}
+
 var _ protoreflect.Message = (*fastReflection_MsgCreateSKUResponse)(nil)
 
 type fastReflection_MsgCreateSKUResponse MsgCreateSKUResponse
@@ -4418,6 +5019,108 @@ func (x *fastReflection_MsgCreateSKUResponse) ProtoMethods() *protoiface.Methods
 	}
 }
 
+var _ protoreflect.List = (*_MsgUpdateSKU_9_list)(nil)
+
+type _MsgUpdateSKU_9_list struct {
+	list *[]*PriceTier
+}
+
+func (x *_MsgUpdateSKU_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgUpdateSKU_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgUpdateSKU_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgUpdateSKU_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgUpdateSKU_9_list) AppendMutable() protoreflect.Value {
+	v := new(PriceTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdateSKU_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgUpdateSKU_9_list) NewElement() protoreflect.Value {
+	v := new(PriceTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdateSKU_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgUpdateSKU_11_list)(nil)
+
+type _MsgUpdateSKU_11_list struct {
+	list *[]*Attribute
+}
+
+func (x *_MsgUpdateSKU_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgUpdateSKU_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgUpdateSKU_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Attribute)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgUpdateSKU_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Attribute)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgUpdateSKU_11_list) AppendMutable() protoreflect.Value {
+	v := new(Attribute)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdateSKU_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgUpdateSKU_11_list) NewElement() protoreflect.Value {
+	v := new(Attribute)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdateSKU_11_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_MsgUpdateSKU               protoreflect.MessageDescriptor
 	fd_MsgUpdateSKU_authority     protoreflect.FieldDescriptor
@@ -4428,6 +5131,9 @@ var (
 	fd_MsgUpdateSKU_base_price    protoreflect.FieldDescriptor
 	fd_MsgUpdateSKU_meta_hash     protoreflect.FieldDescriptor
 	fd_MsgUpdateSKU_active        protoreflect.FieldDescriptor
+	fd_MsgUpdateSKU_price_tiers   protoreflect.FieldDescriptor
+	fd_MsgUpdateSKU_capacity      protoreflect.FieldDescriptor
+	fd_MsgUpdateSKU_attributes    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -4441,6 +5147,9 @@ func init() {
 	fd_MsgUpdateSKU_base_price = md_MsgUpdateSKU.Fields().ByName("base_price")
 	fd_MsgUpdateSKU_meta_hash = md_MsgUpdateSKU.Fields().ByName("meta_hash")
 	fd_MsgUpdateSKU_active = md_MsgUpdateSKU.Fields().ByName("active")
+	fd_MsgUpdateSKU_price_tiers = md_MsgUpdateSKU.Fields().ByName("price_tiers")
+	fd_MsgUpdateSKU_capacity = md_MsgUpdateSKU.Fields().ByName("capacity")
+	fd_MsgUpdateSKU_attributes = md_MsgUpdateSKU.Fields().ByName("attributes")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgUpdateSKU)(nil)
@@ -4556,6 +5265,24 @@ func (x *fastReflection_MsgUpdateSKU) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.PriceTiers) != 0 {
+		value := protoreflect.ValueOfList(&_MsgUpdateSKU_9_list{list: &x.PriceTiers})
+		if !f(fd_MsgUpdateSKU_price_tiers, value) {
+			return
+		}
+	}
+	if x.Capacity != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Capacity)
+		if !f(fd_MsgUpdateSKU_capacity, value) {
+			return
+		}
+	}
+	if len(x.Attributes) != 0 {
+		value := protoreflect.ValueOfList(&_MsgUpdateSKU_11_list{list: &x.Attributes})
+		if !f(fd_MsgUpdateSKU_attributes, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -4587,6 +5314,12 @@ func (x *fastReflection_MsgUpdateSKU) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.MetaHash) != 0
 	case "liftedinit.sku.v1.MsgUpdateSKU.active":
 		return x.Active != false
+	case "liftedinit.sku.v1.MsgUpdateSKU.price_tiers":
+		return len(x.PriceTiers) != 0
+	case "liftedinit.sku.v1.MsgUpdateSKU.capacity":
+		return x.Capacity != uint64(0)
+	case "liftedinit.sku.v1.MsgUpdateSKU.attributes":
+		return len(x.Attributes) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgUpdateSKU"))
@@ -4619,6 +5352,12 @@ func (x *fastReflection_MsgUpdateSKU) Clear(fd protoreflect.FieldDescriptor) {
 		x.MetaHash = nil
 	case "liftedinit.sku.v1.MsgUpdateSKU.active":
 		x.Active = false
+	case "liftedinit.sku.v1.MsgUpdateSKU.price_tiers":
+		x.PriceTiers = nil
+	case "liftedinit.sku.v1.MsgUpdateSKU.capacity":
+		x.Capacity = uint64(0)
+	case "liftedinit.sku.v1.MsgUpdateSKU.attributes":
+		x.Attributes = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgUpdateSKU"))
@@ -4659,6 +5398,21 @@ func (x *fastReflection_MsgUpdateSKU) Get(descriptor protoreflect.FieldDescripto
 	case "liftedinit.sku.v1.MsgUpdateSKU.active":
 		value := x.Active
 		return protoreflect.ValueOfBool(value)
+	case "liftedinit.sku.v1.MsgUpdateSKU.price_tiers":
+		if len(x.PriceTiers) == 0 {
+			return protoreflect.ValueOfList(&_MsgUpdateSKU_9_list{})
+		}
+		listValue := &_MsgUpdateSKU_9_list{list: &x.PriceTiers}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.sku.v1.MsgUpdateSKU.capacity":
+		value := x.Capacity
+		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.sku.v1.MsgUpdateSKU.attributes":
+		if len(x.Attributes) == 0 {
+			return protoreflect.ValueOfList(&_MsgUpdateSKU_11_list{})
+		}
+		listValue := &_MsgUpdateSKU_11_list{list: &x.Attributes}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgUpdateSKU"))
@@ -4695,6 +5449,16 @@ func (x *fastReflection_MsgUpdateSKU) Set(fd protoreflect.FieldDescriptor, value
 		x.MetaHash = value.Bytes()
 	case "liftedinit.sku.v1.MsgUpdateSKU.active":
 		x.Active = value.Bool()
+	case "liftedinit.sku.v1.MsgUpdateSKU.price_tiers":
+		lv := value.List()
+		clv := lv.(*_MsgUpdateSKU_9_list)
+		x.PriceTiers = *clv.list
+	case "liftedinit.sku.v1.MsgUpdateSKU.capacity":
+		x.Capacity = value.Uint()
+	case "liftedinit.sku.v1.MsgUpdateSKU.attributes":
+		lv := value.List()
+		clv := lv.(*_MsgUpdateSKU_11_list)
+		x.Attributes = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgUpdateSKU"))
@@ -4720,6 +5484,18 @@ func (x *fastReflection_MsgUpdateSKU) Mutable(fd protoreflect.FieldDescriptor) p
 			x.BasePrice = new(types.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.BasePrice.ProtoReflect())
+	case "liftedinit.sku.v1.MsgUpdateSKU.price_tiers":
+		if x.PriceTiers == nil {
+			x.PriceTiers = []*PriceTier{}
+		}
+		value := &_MsgUpdateSKU_9_list{list: &x.PriceTiers}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.sku.v1.MsgUpdateSKU.attributes":
+		if x.Attributes == nil {
+			x.Attributes = []*Attribute{}
+		}
+		value := &_MsgUpdateSKU_11_list{list: &x.Attributes}
+		return protoreflect.ValueOfList(value)
 	case "liftedinit.sku.v1.MsgUpdateSKU.authority":
 		panic(fmt.Errorf("field authority of message liftedinit.sku.v1.MsgUpdateSKU is not mutable"))
 	case "liftedinit.sku.v1.MsgUpdateSKU.uuid":
@@ -4734,6 +5510,8 @@ func (x *fastReflection_MsgUpdateSKU) Mutable(fd protoreflect.FieldDescriptor) p
 		panic(fmt.Errorf("field meta_hash of message liftedinit.sku.v1.MsgUpdateSKU is not mutable"))
 	case "liftedinit.sku.v1.MsgUpdateSKU.active":
 		panic(fmt.Errorf("field active of message liftedinit.sku.v1.MsgUpdateSKU is not mutable"))
+	case "liftedinit.sku.v1.MsgUpdateSKU.capacity":
+		panic(fmt.Errorf("field capacity of message liftedinit.sku.v1.MsgUpdateSKU is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgUpdateSKU"))
@@ -4764,6 +5542,14 @@ func (x *fastReflection_MsgUpdateSKU) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfBytes(nil)
 	case "liftedinit.sku.v1.MsgUpdateSKU.active":
 		return protoreflect.ValueOfBool(false)
+	case "liftedinit.sku.v1.MsgUpdateSKU.price_tiers":
+		list := []*PriceTier{}
+		return protoreflect.ValueOfList(&_MsgUpdateSKU_9_list{list: &list})
+	case "liftedinit.sku.v1.MsgUpdateSKU.capacity":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.sku.v1.MsgUpdateSKU.attributes":
+		list := []*Attribute{}
+		return protoreflect.ValueOfList(&_MsgUpdateSKU_11_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgUpdateSKU"))
@@ -4863,6 +5649,21 @@ func (x *fastReflection_MsgUpdateSKU) ProtoMethods() *protoiface.Methods {
 		if x.Active {
 			n += 2
 		}
+		if len(x.PriceTiers) > 0 {
+			for _, e := range x.PriceTiers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Capacity != 0 {
+			n += 1 + runtime.Sov(uint64(x.Capacity))
+		}
+		if len(x.Attributes) > 0 {
+			for _, e := range x.Attributes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4892,6 +5693,43 @@ func (x *fastReflection_MsgUpdateSKU) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Attributes) > 0 {
+			for iNdEx := len(x.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Attributes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if x.Capacity != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Capacity))
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.PriceTiers) > 0 {
+			for iNdEx := len(x.PriceTiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PriceTiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
 		if x.Active {
 			i--
 			if x.Active {
@@ -5242,6 +6080,93 @@ func (x *fastReflection_MsgUpdateSKU) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.Active = bool(v != 0)
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceTiers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PriceTiers = append(x.PriceTiers, &PriceTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PriceTiers[len(x.PriceTiers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+				}
+				x.Capacity = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Capacity |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Attributes = append(x.Attributes, &Attribute{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Attributes[len(x.Attributes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7328,27 +8253,1163 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: liftedinit/sku/v1/tx.proto
+var _ protoreflect.List = (*_MsgScheduleSKUPriceChange_4_list)(nil)
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+type _MsgScheduleSKUPriceChange_4_list struct {
+	list *[]*PriceTier
+}
 
-// MsgCreateProvider is the Msg/CreateProvider request type.
-type MsgCreateProvider struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *_MsgScheduleSKUPriceChange_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-	// authority is the address of the controlling account.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+func (x *_MsgScheduleSKUPriceChange_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgScheduleSKUPriceChange_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgScheduleSKUPriceChange_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgScheduleSKUPriceChange_4_list) AppendMutable() protoreflect.Value {
+	v := new(PriceTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgScheduleSKUPriceChange_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgScheduleSKUPriceChange_4_list) NewElement() protoreflect.Value {
+	v := new(PriceTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgScheduleSKUPriceChange_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgScheduleSKUPriceChange              protoreflect.MessageDescriptor
+	fd_MsgScheduleSKUPriceChange_authority    protoreflect.FieldDescriptor
+	fd_MsgScheduleSKUPriceChange_sku_uuid     protoreflect.FieldDescriptor
+	fd_MsgScheduleSKUPriceChange_base_price   protoreflect.FieldDescriptor
+	fd_MsgScheduleSKUPriceChange_price_tiers  protoreflect.FieldDescriptor
+	fd_MsgScheduleSKUPriceChange_effective_at protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_liftedinit_sku_v1_tx_proto_init()
+	md_MsgScheduleSKUPriceChange = File_liftedinit_sku_v1_tx_proto.Messages().ByName("MsgScheduleSKUPriceChange")
+	fd_MsgScheduleSKUPriceChange_authority = md_MsgScheduleSKUPriceChange.Fields().ByName("authority")
+	fd_MsgScheduleSKUPriceChange_sku_uuid = md_MsgScheduleSKUPriceChange.Fields().ByName("sku_uuid")
+	fd_MsgScheduleSKUPriceChange_base_price = md_MsgScheduleSKUPriceChange.Fields().ByName("base_price")
+	fd_MsgScheduleSKUPriceChange_price_tiers = md_MsgScheduleSKUPriceChange.Fields().ByName("price_tiers")
+	fd_MsgScheduleSKUPriceChange_effective_at = md_MsgScheduleSKUPriceChange.Fields().ByName("effective_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgScheduleSKUPriceChange)(nil)
+
+type fastReflection_MsgScheduleSKUPriceChange MsgScheduleSKUPriceChange
+
+func (x *MsgScheduleSKUPriceChange) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgScheduleSKUPriceChange)(x)
+}
+
+func (x *MsgScheduleSKUPriceChange) slowProtoReflect() protoreflect.Message {
+	mi := &file_liftedinit_sku_v1_tx_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgScheduleSKUPriceChange_messageType fastReflection_MsgScheduleSKUPriceChange_messageType
+var _ protoreflect.MessageType = fastReflection_MsgScheduleSKUPriceChange_messageType{}
+
+type fastReflection_MsgScheduleSKUPriceChange_messageType struct{}
+
+func (x fastReflection_MsgScheduleSKUPriceChange_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgScheduleSKUPriceChange)(nil)
+}
+func (x fastReflection_MsgScheduleSKUPriceChange_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgScheduleSKUPriceChange)
+}
+func (x fastReflection_MsgScheduleSKUPriceChange_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgScheduleSKUPriceChange
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgScheduleSKUPriceChange) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgScheduleSKUPriceChange
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgScheduleSKUPriceChange) Type() protoreflect.MessageType {
+	return _fastReflection_MsgScheduleSKUPriceChange_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgScheduleSKUPriceChange) New() protoreflect.Message {
+	return new(fastReflection_MsgScheduleSKUPriceChange)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgScheduleSKUPriceChange) Interface() protoreflect.ProtoMessage {
+	return (*MsgScheduleSKUPriceChange)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgScheduleSKUPriceChange) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgScheduleSKUPriceChange_authority, value) {
+			return
+		}
+	}
+	if x.SkuUuid != "" {
+		value := protoreflect.ValueOfString(x.SkuUuid)
+		if !f(fd_MsgScheduleSKUPriceChange_sku_uuid, value) {
+			return
+		}
+	}
+	if x.BasePrice != nil {
+		value := protoreflect.ValueOfMessage(x.BasePrice.ProtoReflect())
+		if !f(fd_MsgScheduleSKUPriceChange_base_price, value) {
+			return
+		}
+	}
+	if len(x.PriceTiers) != 0 {
+		value := protoreflect.ValueOfList(&_MsgScheduleSKUPriceChange_4_list{list: &x.PriceTiers})
+		if !f(fd_MsgScheduleSKUPriceChange_price_tiers, value) {
+			return
+		}
+	}
+	if x.EffectiveAt != nil {
+		value := protoreflect.ValueOfMessage(x.EffectiveAt.ProtoReflect())
+		if !f(fd_MsgScheduleSKUPriceChange_effective_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgScheduleSKUPriceChange) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.authority":
+		return x.Authority != ""
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.sku_uuid":
+		return x.SkuUuid != ""
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.base_price":
+		return x.BasePrice != nil
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.price_tiers":
+		return len(x.PriceTiers) != 0
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.effective_at":
+		return x.EffectiveAt != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgScheduleSKUPriceChange"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.MsgScheduleSKUPriceChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleSKUPriceChange) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.authority":
+		x.Authority = ""
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.sku_uuid":
+		x.SkuUuid = ""
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.base_price":
+		x.BasePrice = nil
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.price_tiers":
+		x.PriceTiers = nil
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.effective_at":
+		x.EffectiveAt = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgScheduleSKUPriceChange"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.MsgScheduleSKUPriceChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgScheduleSKUPriceChange) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.sku_uuid":
+		value := x.SkuUuid
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.base_price":
+		value := x.BasePrice
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.price_tiers":
+		if len(x.PriceTiers) == 0 {
+			return protoreflect.ValueOfList(&_MsgScheduleSKUPriceChange_4_list{})
+		}
+		listValue := &_MsgScheduleSKUPriceChange_4_list{list: &x.PriceTiers}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.effective_at":
+		value := x.EffectiveAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgScheduleSKUPriceChange"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.MsgScheduleSKUPriceChange does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleSKUPriceChange) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.authority":
+		x.Authority = value.Interface().(string)
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.sku_uuid":
+		x.SkuUuid = value.Interface().(string)
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.base_price":
+		x.BasePrice = value.Message().Interface().(*types.Coin)
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.price_tiers":
+		lv := value.List()
+		clv := lv.(*_MsgScheduleSKUPriceChange_4_list)
+		x.PriceTiers = *clv.list
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.effective_at":
+		x.EffectiveAt = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgScheduleSKUPriceChange"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.MsgScheduleSKUPriceChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleSKUPriceChange) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.base_price":
+		if x.BasePrice == nil {
+			x.BasePrice = new(types.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.BasePrice.ProtoReflect())
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.price_tiers":
+		if x.PriceTiers == nil {
+			x.PriceTiers = []*PriceTier{}
+		}
+		value := &_MsgScheduleSKUPriceChange_4_list{list: &x.PriceTiers}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.effective_at":
+		if x.EffectiveAt == nil {
+			x.EffectiveAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.EffectiveAt.ProtoReflect())
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.authority":
+		panic(fmt.Errorf("field authority of message liftedinit.sku.v1.MsgScheduleSKUPriceChange is not mutable"))
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.sku_uuid":
+		panic(fmt.Errorf("field sku_uuid of message liftedinit.sku.v1.MsgScheduleSKUPriceChange is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgScheduleSKUPriceChange"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.MsgScheduleSKUPriceChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgScheduleSKUPriceChange) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.authority":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.sku_uuid":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.base_price":
+		m := new(types.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.price_tiers":
+		list := []*PriceTier{}
+		return protoreflect.ValueOfList(&_MsgScheduleSKUPriceChange_4_list{list: &list})
+	case "liftedinit.sku.v1.MsgScheduleSKUPriceChange.effective_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgScheduleSKUPriceChange"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.MsgScheduleSKUPriceChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgScheduleSKUPriceChange) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in liftedinit.sku.v1.MsgScheduleSKUPriceChange", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgScheduleSKUPriceChange) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleSKUPriceChange) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgScheduleSKUPriceChange) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgScheduleSKUPriceChange) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgScheduleSKUPriceChange)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SkuUuid)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BasePrice != nil {
+			l = options.Size(x.BasePrice)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.PriceTiers) > 0 {
+			for _, e := range x.PriceTiers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.EffectiveAt != nil {
+			l = options.Size(x.EffectiveAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgScheduleSKUPriceChange)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.EffectiveAt != nil {
+			encoded, err := options.Marshal(x.EffectiveAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.PriceTiers) > 0 {
+			for iNdEx := len(x.PriceTiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PriceTiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.BasePrice != nil {
+			encoded, err := options.Marshal(x.BasePrice)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.SkuUuid) > 0 {
+			i -= len(x.SkuUuid)
+			copy(dAtA[i:], x.SkuUuid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SkuUuid)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgScheduleSKUPriceChange)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgScheduleSKUPriceChange: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgScheduleSKUPriceChange: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SkuUuid", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SkuUuid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BasePrice", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BasePrice == nil {
+					x.BasePrice = &types.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BasePrice); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceTiers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PriceTiers = append(x.PriceTiers, &PriceTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PriceTiers[len(x.PriceTiers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EffectiveAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EffectiveAt == nil {
+					x.EffectiveAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EffectiveAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgScheduleSKUPriceChangeResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_liftedinit_sku_v1_tx_proto_init()
+	md_MsgScheduleSKUPriceChangeResponse = File_liftedinit_sku_v1_tx_proto.Messages().ByName("MsgScheduleSKUPriceChangeResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgScheduleSKUPriceChangeResponse)(nil)
+
+type fastReflection_MsgScheduleSKUPriceChangeResponse MsgScheduleSKUPriceChangeResponse
+
+func (x *MsgScheduleSKUPriceChangeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgScheduleSKUPriceChangeResponse)(x)
+}
+
+func (x *MsgScheduleSKUPriceChangeResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_liftedinit_sku_v1_tx_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgScheduleSKUPriceChangeResponse_messageType fastReflection_MsgScheduleSKUPriceChangeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgScheduleSKUPriceChangeResponse_messageType{}
+
+type fastReflection_MsgScheduleSKUPriceChangeResponse_messageType struct{}
+
+func (x fastReflection_MsgScheduleSKUPriceChangeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgScheduleSKUPriceChangeResponse)(nil)
+}
+func (x fastReflection_MsgScheduleSKUPriceChangeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgScheduleSKUPriceChangeResponse)
+}
+func (x fastReflection_MsgScheduleSKUPriceChangeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgScheduleSKUPriceChangeResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgScheduleSKUPriceChangeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgScheduleSKUPriceChangeResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgScheduleSKUPriceChangeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgScheduleSKUPriceChangeResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgScheduleSKUPriceChangeResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgScheduleSKUPriceChangeResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgScheduleSKUPriceChangeResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgScheduleSKUPriceChangeResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgScheduleSKUPriceChangeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgScheduleSKUPriceChangeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgScheduleSKUPriceChangeResponse"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.MsgScheduleSKUPriceChangeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleSKUPriceChangeResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgScheduleSKUPriceChangeResponse"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.MsgScheduleSKUPriceChangeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgScheduleSKUPriceChangeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgScheduleSKUPriceChangeResponse"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.MsgScheduleSKUPriceChangeResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleSKUPriceChangeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgScheduleSKUPriceChangeResponse"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.MsgScheduleSKUPriceChangeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleSKUPriceChangeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgScheduleSKUPriceChangeResponse"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.MsgScheduleSKUPriceChangeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgScheduleSKUPriceChangeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.MsgScheduleSKUPriceChangeResponse"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.MsgScheduleSKUPriceChangeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgScheduleSKUPriceChangeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in liftedinit.sku.v1.MsgScheduleSKUPriceChangeResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgScheduleSKUPriceChangeResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleSKUPriceChangeResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgScheduleSKUPriceChangeResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgScheduleSKUPriceChangeResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgScheduleSKUPriceChangeResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgScheduleSKUPriceChangeResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgScheduleSKUPriceChangeResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgScheduleSKUPriceChangeResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgScheduleSKUPriceChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: liftedinit/sku/v1/tx.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MsgCreateProvider is the Msg/CreateProvider request type.
+type MsgCreateProvider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// authority is the address of the controlling account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// address is the management address of the provider.
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	// payout_address is the address where payments are sent.
@@ -7358,6 +9419,8 @@ type MsgCreateProvider struct {
 	// api_url is the HTTPS endpoint where the provider's off-chain API is hosted.
 	// Must be a valid HTTPS URL.
 	ApiUrl string `protobuf:"bytes,5,opt,name=api_url,json=apiUrl,proto3" json:"api_url,omitempty"`
+	// attributes are the optional catalog attributes; see Provider.attributes.
+	Attributes []*Attribute `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty"`
 }
 
 func (x *MsgCreateProvider) Reset() {
@@ -7415,6 +9478,13 @@ func (x *MsgCreateProvider) GetApiUrl() string {
 	return ""
 }
 
+func (x *MsgCreateProvider) GetAttributes() []*Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
 // MsgCreateProviderResponse is the Msg/CreateProvider response type.
 type MsgCreateProviderResponse struct {
 	state         protoimpl.MessageState
@@ -7474,6 +9544,9 @@ type MsgUpdateProvider struct {
 	Active bool `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`
 	// api_url is the HTTPS endpoint where the provider's off-chain API is hosted.
 	ApiUrl string `protobuf:"bytes,7,opt,name=api_url,json=apiUrl,proto3" json:"api_url,omitempty"`
+	// attributes replace the provider's catalog attributes; leave empty to
+	// remove them.
+	Attributes []*Attribute `protobuf:"bytes,8,rep,name=attributes,proto3" json:"attributes,omitempty"`
 }
 
 func (x *MsgUpdateProvider) Reset() {
@@ -7545,6 +9618,13 @@ func (x *MsgUpdateProvider) GetApiUrl() string {
 	return ""
 }
 
+func (x *MsgUpdateProvider) GetAttributes() []*Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
 // MsgUpdateProviderResponse is the Msg/UpdateProvider response type.
 type MsgUpdateProviderResponse struct {
 	state         protoimpl.MessageState
@@ -7696,6 +9776,12 @@ type MsgCreateSKU struct {
 	BasePrice *types.Coin `protobuf:"bytes,5,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
 	// meta_hash is a hash of the off-chain metadata.
 	MetaHash []byte `protobuf:"bytes,6,opt,name=meta_hash,json=metaHash,proto3" json:"meta_hash,omitempty"`
+	// price_tiers are optional volume prices; see SKU.price_tiers.
+	PriceTiers []*PriceTier `protobuf:"bytes,7,rep,name=price_tiers,json=priceTiers,proto3" json:"price_tiers,omitempty"`
+	// capacity is the optional quantity limit; see SKU.capacity.
+	Capacity uint64 `protobuf:"varint,8,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// attributes are the optional catalog attributes; see SKU.attributes.
+	Attributes []*Attribute `protobuf:"bytes,9,rep,name=attributes,proto3" json:"attributes,omitempty"`
 }
 
 func (x *MsgCreateSKU) Reset() {
@@ -7760,6 +9846,27 @@ func (x *MsgCreateSKU) GetMetaHash() []byte {
 	return nil
 }
 
+func (x *MsgCreateSKU) GetPriceTiers() []*PriceTier {
+	if x != nil {
+		return x.PriceTiers
+	}
+	return nil
+}
+
+func (x *MsgCreateSKU) GetCapacity() uint64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *MsgCreateSKU) GetAttributes() []*Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
 // MsgCreateSKUResponse is the Msg/CreateSKU response type.
 type MsgCreateSKUResponse struct {
 	state         protoimpl.MessageState
@@ -7821,6 +9928,16 @@ type MsgUpdateSKU struct {
 	MetaHash []byte `protobuf:"bytes,7,opt,name=meta_hash,json=metaHash,proto3" json:"meta_hash,omitempty"`
 	// active indicates whether the SKU is active.
 	Active bool `protobuf:"varint,8,opt,name=active,proto3" json:"active,omitempty"`
+	// price_tiers replace the SKU's volume prices; leave empty to remove them.
+	// Existing leases keep the price locked at their creation.
+	PriceTiers []*PriceTier `protobuf:"bytes,9,rep,name=price_tiers,json=priceTiers,proto3" json:"price_tiers,omitempty"`
+	// capacity replaces the SKU's quantity limit; zero means unlimited. It may
+	// be set below what leases already hold: they are kept, and new quantity
+	// is refused until enough is released.
+	Capacity uint64 `protobuf:"varint,10,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// attributes replace the SKU's catalog attributes; leave empty to remove
+	// them.
+	Attributes []*Attribute `protobuf:"bytes,11,rep,name=attributes,proto3" json:"attributes,omitempty"`
 }
 
 func (x *MsgUpdateSKU) Reset() {
@@ -7899,6 +10016,27 @@ func (x *MsgUpdateSKU) GetActive() bool {
 	return false
 }
 
+func (x *MsgUpdateSKU) GetPriceTiers() []*PriceTier {
+	if x != nil {
+		return x.PriceTiers
+	}
+	return nil
+}
+
+func (x *MsgUpdateSKU) GetCapacity() uint64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *MsgUpdateSKU) GetAttributes() []*Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
 // MsgUpdateSKUResponse is the Msg/UpdateSKU response type.
 type MsgUpdateSKUResponse struct {
 	state         protoimpl.MessageState
@@ -8073,6 +10211,110 @@ func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
 	return file_liftedinit_sku_v1_tx_proto_rawDescGZIP(), []int{13}
 }
 
+// MsgScheduleSKUPriceChange is the Msg/ScheduleSKUPriceChange request type.
+type MsgScheduleSKUPriceChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// authority is the address of the controlling account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// sku_uuid is the unique identifier of the SKU to reprice.
+	SkuUuid string `protobuf:"bytes,2,opt,name=sku_uuid,json=skuUuid,proto3" json:"sku_uuid,omitempty"`
+	// base_price is the base price the SKU takes at effective_at. It is
+	// validated against the SKU's unit both now and when it takes effect.
+	BasePrice *types.Coin `protobuf:"bytes,3,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	// price_tiers replace the SKU's volume prices at effective_at; leave empty
+	// to remove them.
+	PriceTiers []*PriceTier `protobuf:"bytes,4,rep,name=price_tiers,json=priceTiers,proto3" json:"price_tiers,omitempty"`
+	// effective_at is the time from which the new price applies. It must be
+	// after the current block time.
+	EffectiveAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
+}
+
+func (x *MsgScheduleSKUPriceChange) Reset() {
+	*x = MsgScheduleSKUPriceChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_liftedinit_sku_v1_tx_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgScheduleSKUPriceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgScheduleSKUPriceChange) ProtoMessage() {}
+
+// Deprecated: Use MsgScheduleSKUPriceChange.ProtoReflect.Descriptor instead.
+func (*MsgScheduleSKUPriceChange) Descriptor() ([]byte, []int) {
+	return file_liftedinit_sku_v1_tx_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MsgScheduleSKUPriceChange) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgScheduleSKUPriceChange) GetSkuUuid() string {
+	if x != nil {
+		return x.SkuUuid
+	}
+	return ""
+}
+
+func (x *MsgScheduleSKUPriceChange) GetBasePrice() *types.Coin {
+	if x != nil {
+		return x.BasePrice
+	}
+	return nil
+}
+
+func (x *MsgScheduleSKUPriceChange) GetPriceTiers() []*PriceTier {
+	if x != nil {
+		return x.PriceTiers
+	}
+	return nil
+}
+
+func (x *MsgScheduleSKUPriceChange) GetEffectiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return nil
+}
+
+// MsgScheduleSKUPriceChangeResponse is the Msg/ScheduleSKUPriceChange
+// response type.
+type MsgScheduleSKUPriceChangeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgScheduleSKUPriceChangeResponse) Reset() {
+	*x = MsgScheduleSKUPriceChangeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_liftedinit_sku_v1_tx_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgScheduleSKUPriceChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgScheduleSKUPriceChangeResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgScheduleSKUPriceChangeResponse.ProtoReflect.Descriptor instead.
+func (*MsgScheduleSKUPriceChangeResponse) Descriptor() ([]byte, []int) {
+	return file_liftedinit_sku_v1_tx_proto_rawDescGZIP(), []int{15}
+}
+
 var File_liftedinit_sku_v1_tx_proto protoreflect.FileDescriptor
 
 var file_liftedinit_sku_v1_tx_proto_rawDesc = []byte{
@@ -8086,209 +10328,278 @@ var file_liftedinit_sku_v1_tx_proto_rawDesc = []byte{
 	0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63,
 	0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
-	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x6c, 0x69,
-	0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x73, 0x6b, 0x75, 0x2f, 0x76, 0x31, 0x2f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xab, 0x02, 0x0a, 0x11,
-	0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09,
-	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a,
-	0x0e, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x6c,
+	0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x73, 0x6b, 0x75, 0x2f, 0x76, 0x31,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xef, 0x02, 0x0a,
+	0x11, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x0d, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b,
-	0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x61,
-	0x70, 0x69, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70,
-	0x69, 0x55, 0x72, 0x6c, 0x3a, 0x33, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c, 0x6c, 0x69, 0x66,
-	0x74, 0x65, 0x64, 0x2f, 0x73, 0x6b, 0x75, 0x2f, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x2f, 0x0a, 0x19, 0x4d, 0x73, 0x67,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0xd7, 0x02, 0x0a, 0x11, 0x4d,
-	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f,
+	0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x0d, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x12, 0x17, 0x0a, 0x07,
+	0x61, 0x70, 0x69, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x70, 0x69, 0x55, 0x72, 0x6c, 0x12, 0x42, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x69, 0x66, 0x74,
+	0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x3a, 0x33, 0xe8, 0xa0, 0x1f, 0x00, 0x82,
+	0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0,
+	0x2a, 0x1c, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x2f, 0x73, 0x6b, 0x75, 0x2f, 0x4d, 0x73, 0x67,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x2f,
+	0x0a, 0x19, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22,
+	0x9b, 0x03, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x61,
+	0x70, 0x69, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70,
+	0x69, 0x55, 0x72, 0x6c, 0x12, 0x42, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65,
+	0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x3a, 0x33, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7,
+	0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a,
+	0x1c, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x2f, 0x73, 0x6b, 0x75, 0x2f, 0x4d, 0x73, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x1b, 0x0a,
+	0x19, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb2, 0x01, 0x0a, 0x15, 0x4d,
+	0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x37, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a,
+	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x20, 0x6c,
+	0x69, 0x66, 0x74, 0x65, 0x64, 0x2f, 0x73, 0x6b, 0x75, 0x2f, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22,
+	0x6e, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x32, 0x0a, 0x15, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x73, 0x6b, 0x75, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x13, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x53, 0x6b, 0x75, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x22,
+	0x9e, 0x04, 0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x4b, 0x55,
 	0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
 	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
-	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x3f, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x75, 0x72,
-	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x69, 0x55, 0x72, 0x6c, 0x3a,
-	0x33, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x2f, 0x73,
-	0x6b, 0x75, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0xb2, 0x01, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
-	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x37, 0xe8,
-	0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x20, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x2f, 0x73, 0x6b, 0x75,
-	0x2f, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x6e, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x65, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x6b, 0x75, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x64, 0x53, 0x6b, 0x75, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x68,
-	0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68,
-	0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x22, 0xf9, 0x02, 0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x4b, 0x55, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12,
-	0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x55, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69,
-	0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x52,
-	0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x7e, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x44, 0xc8, 0xde, 0x1f, 0x00, 0xfa, 0xde, 0x1f, 0x27, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x9a, 0xe7, 0xb0, 0x2a, 0x0b, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79,
-	0x5f, 0x63, 0x6f, 0x69, 0x6e, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x68, 0x61,
-	0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x48, 0x61,
-	0x73, 0x68, 0x3a, 0x2e, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x17, 0x6c, 0x69, 0x66, 0x74, 0x65,
-	0x64, 0x2f, 0x73, 0x6b, 0x75, 0x2f, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
-	0x4b, 0x55, 0x22, 0x2a, 0x0a, 0x14, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
-	0x4b, 0x55, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0xa5,
-	0x03, 0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x4b, 0x55, 0x12,
-	0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e,
-	0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x04, 0x75, 0x6e, 0x69,
-	0x74, 0x12, 0x7e, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
-	0x42, 0x44, 0xc8, 0xde, 0x1f, 0x00, 0xfa, 0xde, 0x1f, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
-	0x6e, 0x9a, 0xe7, 0xb0, 0x2a, 0x0b, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69,
-	0x6e, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x3a, 0x2e, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a,
-	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x17, 0x6c,
-	0x69, 0x66, 0x74, 0x65, 0x64, 0x2f, 0x73, 0x6b, 0x75, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x53, 0x4b, 0x55, 0x22, 0x16, 0x0a, 0x14, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x53, 0x4b, 0x55, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x92,
-	0x01, 0x0a, 0x10, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x53, 0x4b, 0x55, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x75,
-	0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x3a,
-	0x32, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x1b, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x2f, 0x73,
-	0x6b, 0x75, 0x2f, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x53, 0x4b, 0x55, 0x22, 0x1a, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x53, 0x4b, 0x55, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0xb5, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x2b, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x17, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x7e,
+	0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x44, 0xc8,
+	0xde, 0x1f, 0x00, 0xfa, 0xde, 0x1f, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x9a, 0xe7,
+	0xb0, 0x2a, 0x0b, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x12, 0x43, 0x0a, 0x0b, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b,
+	0x75, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x65, 0x72, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x65, 0x72, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x42, 0x0a, 0x0a,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b,
+	0x75, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x3a, 0x2e, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x17, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x2f,
+	0x73, 0x6b, 0x75, 0x2f, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x4b, 0x55,
+	0x22, 0x2a, 0x0a, 0x14, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x4b, 0x55,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0xca, 0x04, 0x0a,
+	0x0c, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x4b, 0x55, 0x12, 0x36, 0x0a,
+	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b,
+	0x75, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12,
+	0x7e, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x44,
+	0xc8, 0xde, 0x1f, 0x00, 0xfa, 0xde, 0x1f, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x9a,
+	0xe7, 0xb0, 0x2a, 0x0b, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x12, 0x43, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x69,
+	0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x69, 0x66, 0x74,
+	0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x54, 0x69, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x42, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x69, 0x66, 0x74,
+	0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x3a, 0x2e, 0xe8, 0xa0, 0x1f, 0x00, 0x82,
+	0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0,
+	0x2a, 0x17, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x2f, 0x73, 0x6b, 0x75, 0x2f, 0x4d, 0x73, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x4b, 0x55, 0x22, 0x16, 0x0a, 0x14, 0x4d, 0x73, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x4b, 0x55, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x92, 0x01, 0x0a, 0x10, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x53, 0x4b, 0x55, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x3a, 0x32, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x1b, 0x6c, 0x69, 0x66, 0x74, 0x65,
+	0x64, 0x2f, 0x73, 0x6b, 0x75, 0x2f, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x53, 0x4b, 0x55, 0x22, 0x1a, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x4b, 0x55, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xb5, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x37,
+	0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x31, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0,
+	0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x1a,
+	0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x2f, 0x73, 0x6b, 0x75, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb9, 0x03, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x4b, 0x55, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x06, 0x70,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69,
+	0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x73,
+	0x6b, 0x75, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x6b, 0x75, 0x55, 0x75, 0x69, 0x64, 0x12, 0x7e, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x44, 0xc8, 0xde, 0x1f, 0x00, 0xfa, 0xde, 0x1f, 0x27, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x9a, 0xe7, 0xb0, 0x2a, 0x0b, 0x6c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x62, 0x61, 0x73,
+	0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
+	0x74, 0x69, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x69,
 	0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x3a, 0x31, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x1a, 0x6c, 0x69, 0x66,
-	0x74, 0x65, 0x64, 0x2f, 0x73, 0x6b, 0x75, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x32, 0xbb, 0x05, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x64, 0x0a, 0x0e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x6c,
-	0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x1a, 0x2c, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e,
-	0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x64, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x12, 0x24, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e,
-	0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x1a, 0x2c, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65,
-	0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x12, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x6c,
-	0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x1a, 0x30, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69,
-	0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65,
-	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x53, 0x4b, 0x55, 0x12, 0x1f, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e,
-	0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x53, 0x4b, 0x55, 0x1a, 0x27, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12, 0x47, 0x0a, 0x0c, 0x65,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8,
+	0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0b, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x41, 0x74, 0x3a, 0x3b, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x24, 0x6c, 0x69, 0x66,
+	0x74, 0x65, 0x64, 0x2f, 0x73, 0x6b, 0x75, 0x2f, 0x4d, 0x73, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x53, 0x4b, 0x55, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x22, 0x23, 0x0a, 0x21, 0x4d, 0x73, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x53, 0x4b, 0x55, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xb9, 0x06, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x64,
+	0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x24, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b,
+	0x75, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x1a, 0x2c, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69,
 	0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x4b, 0x55, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x55, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x4b, 0x55, 0x12, 0x1f, 0x2e, 0x6c,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69,
+	0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x1a, 0x2c, 0x2e, 0x6c,
 	0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x4b, 0x55, 0x1a, 0x27, 0x2e,
-	0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x4b, 0x55, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x0d, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x53, 0x4b, 0x55, 0x12, 0x23, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64,
-	0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x44,
-	0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x4b, 0x55, 0x1a, 0x2b, 0x2e, 0x6c,
-	0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x4b,
-	0x55, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0c, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x22, 0x2e, 0x6c, 0x69, 0x66, 0x74,
+	0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x12, 0x44, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x28, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b,
+	0x75, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x1a, 0x30, 0x2e, 0x6c, 0x69, 0x66,
+	0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x09,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x4b, 0x55, 0x12, 0x1f, 0x2e, 0x6c, 0x69, 0x66, 0x74,
 	0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x2a, 0x2e,
+	0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x4b, 0x55, 0x1a, 0x27, 0x2e, 0x6c, 0x69, 0x66,
+	0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x4b, 0x55, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x4b, 0x55,
+	0x12, 0x1f, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b,
+	0x75, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x4b,
+	0x55, 0x1a, 0x27, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73,
+	0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x4b, 0x55, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x0d, 0x44, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x4b, 0x55, 0x12, 0x23, 0x2e, 0x6c, 0x69,
+	0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x4b, 0x55,
+	0x1a, 0x2b, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b,
+	0x75, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x53, 0x4b, 0x55, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a,
+	0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x22, 0x2e,
 	0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76,
 	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01,
-	0x42, 0xcf, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69,
-	0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x2f, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2d, 0x6c, 0x65, 0x64, 0x67,
-	0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69,
-	0x74, 0x2f, 0x73, 0x6b, 0x75, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x6b, 0x75, 0x76, 0x31, 0xa2, 0x02,
-	0x03, 0x4c, 0x53, 0x58, 0xaa, 0x02, 0x11, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69,
-	0x74, 0x2e, 0x53, 0x6b, 0x75, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x11, 0x4c, 0x69, 0x66, 0x74, 0x65,
-	0x64, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x53, 0x6b, 0x75, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1d, 0x4c,
-	0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x53, 0x6b, 0x75, 0x5c, 0x56, 0x31,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x4c,
-	0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x3a, 0x3a, 0x53, 0x6b, 0x75, 0x3a, 0x3a,
-	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x1a, 0x2a, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73,
+	0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a,
+	0x16, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x4b, 0x55, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2c, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64,
+	0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x4b, 0x55, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0x34, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e,
+	0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x4b, 0x55, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0,
+	0x2a, 0x01, 0x42, 0xcf, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65,
+	0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2d, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2d, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69,
+	0x6e, 0x69, 0x74, 0x2f, 0x73, 0x6b, 0x75, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x6b, 0x75, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x4c, 0x53, 0x58, 0xaa, 0x02, 0x11, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69,
+	0x6e, 0x69, 0x74, 0x2e, 0x53, 0x6b, 0x75, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x11, 0x4c, 0x69, 0x66,
+	0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x53, 0x6b, 0x75, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x1d, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x53, 0x6b, 0x75, 0x5c,
+	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x13, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x3a, 0x3a, 0x53, 0x6b, 0x75,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -8303,51 +10614,67 @@ func file_liftedinit_sku_v1_tx_proto_rawDescGZIP() []byte {
 	return file_liftedinit_sku_v1_tx_proto_rawDescData
 }
 
-var file_liftedinit_sku_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_liftedinit_sku_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_liftedinit_sku_v1_tx_proto_goTypes = []interface{}{
-	(*MsgCreateProvider)(nil),             // 0: liftedinit.sku.v1.MsgCreateProvider
-	(*MsgCreateProviderResponse)(nil),     // 1: liftedinit.sku.v1.MsgCreateProviderResponse
-	(*MsgUpdateProvider)(nil),             // 2: liftedinit.sku.v1.MsgUpdateProvider
-	(*MsgUpdateProviderResponse)(nil),     // 3: liftedinit.sku.v1.MsgUpdateProviderResponse
-	(*MsgDeactivateProvider)(nil),         // 4: liftedinit.sku.v1.MsgDeactivateProvider
-	(*MsgDeactivateProviderResponse)(nil), // 5: liftedinit.sku.v1.MsgDeactivateProviderResponse
-	(*MsgCreateSKU)(nil),                  // 6: liftedinit.sku.v1.MsgCreateSKU
-	(*MsgCreateSKUResponse)(nil),          // 7: liftedinit.sku.v1.MsgCreateSKUResponse
-	(*MsgUpdateSKU)(nil),                  // 8: liftedinit.sku.v1.MsgUpdateSKU
-	(*MsgUpdateSKUResponse)(nil),          // 9: liftedinit.sku.v1.MsgUpdateSKUResponse
-	(*MsgDeactivateSKU)(nil),              // 10: liftedinit.sku.v1.MsgDeactivateSKU
-	(*MsgDeactivateSKUResponse)(nil),      // 11: liftedinit.sku.v1.MsgDeactivateSKUResponse
-	(*MsgUpdateParams)(nil),               // 12: liftedinit.sku.v1.MsgUpdateParams
-	(*MsgUpdateParamsResponse)(nil),       // 13: liftedinit.sku.v1.MsgUpdateParamsResponse
-	(Unit)(0),                             // 14: liftedinit.sku.v1.Unit
-	(*types.Coin)(nil),                    // 15: cosmos.base.v1beta1.Coin
-	(*Params)(nil),                        // 16: liftedinit.sku.v1.Params
+	(*MsgCreateProvider)(nil),                 // 0: liftedinit.sku.v1.MsgCreateProvider
+	(*MsgCreateProviderResponse)(nil),         // 1: liftedinit.sku.v1.MsgCreateProviderResponse
+	(*MsgUpdateProvider)(nil),                 // 2: liftedinit.sku.v1.MsgUpdateProvider
+	(*MsgUpdateProviderResponse)(nil),         // 3: liftedinit.sku.v1.MsgUpdateProviderResponse
+	(*MsgDeactivateProvider)(nil),             // 4: liftedinit.sku.v1.MsgDeactivateProvider
+	(*MsgDeactivateProviderResponse)(nil),     // 5: liftedinit.sku.v1.MsgDeactivateProviderResponse
+	(*MsgCreateSKU)(nil),                      // 6: liftedinit.sku.v1.MsgCreateSKU
+	(*MsgCreateSKUResponse)(nil),              // 7: liftedinit.sku.v1.MsgCreateSKUResponse
+	(*MsgUpdateSKU)(nil),                      // 8: liftedinit.sku.v1.MsgUpdateSKU
+	(*MsgUpdateSKUResponse)(nil),              // 9: liftedinit.sku.v1.MsgUpdateSKUResponse
+	(*MsgDeactivateSKU)(nil),                  // 10: liftedinit.sku.v1.MsgDeactivateSKU
+	(*MsgDeactivateSKUResponse)(nil),          // 11: liftedinit.sku.v1.MsgDeactivateSKUResponse
+	(*MsgUpdateParams)(nil),                   // 12: liftedinit.sku.v1.MsgUpdateParams
+	(*MsgUpdateParamsResponse)(nil),           // 13: liftedinit.sku.v1.MsgUpdateParamsResponse
+	(*MsgScheduleSKUPriceChange)(nil),         // 14: liftedinit.sku.v1.MsgScheduleSKUPriceChange
+	(*MsgScheduleSKUPriceChangeResponse)(nil), // 15: liftedinit.sku.v1.MsgScheduleSKUPriceChangeResponse
+	(*Attribute)(nil),                         // 16: liftedinit.sku.v1.Attribute
+	(Unit)(0),                                 // 17: liftedinit.sku.v1.Unit
+	(*types.Coin)(nil),                        // 18: cosmos.base.v1beta1.Coin
+	(*PriceTier)(nil),                         // 19: liftedinit.sku.v1.PriceTier
+	(*Params)(nil),                            // 20: liftedinit.sku.v1.Params
+	(*timestamppb.Timestamp)(nil),             // 21: google.protobuf.Timestamp
 }
 var file_liftedinit_sku_v1_tx_proto_depIdxs = []int32{
-	14, // 0: liftedinit.sku.v1.MsgCreateSKU.unit:type_name -> liftedinit.sku.v1.Unit
-	15, // 1: liftedinit.sku.v1.MsgCreateSKU.base_price:type_name -> cosmos.base.v1beta1.Coin
-	14, // 2: liftedinit.sku.v1.MsgUpdateSKU.unit:type_name -> liftedinit.sku.v1.Unit
-	15, // 3: liftedinit.sku.v1.MsgUpdateSKU.base_price:type_name -> cosmos.base.v1beta1.Coin
-	16, // 4: liftedinit.sku.v1.MsgUpdateParams.params:type_name -> liftedinit.sku.v1.Params
-	0,  // 5: liftedinit.sku.v1.Msg.CreateProvider:input_type -> liftedinit.sku.v1.MsgCreateProvider
-	2,  // 6: liftedinit.sku.v1.Msg.UpdateProvider:input_type -> liftedinit.sku.v1.MsgUpdateProvider
-	4,  // 7: liftedinit.sku.v1.Msg.DeactivateProvider:input_type -> liftedinit.sku.v1.MsgDeactivateProvider
-	6,  // 8: liftedinit.sku.v1.Msg.CreateSKU:input_type -> liftedinit.sku.v1.MsgCreateSKU
-	8,  // 9: liftedinit.sku.v1.Msg.UpdateSKU:input_type -> liftedinit.sku.v1.MsgUpdateSKU
-	10, // 10: liftedinit.sku.v1.Msg.DeactivateSKU:input_type -> liftedinit.sku.v1.MsgDeactivateSKU
-	12, // 11: liftedinit.sku.v1.Msg.UpdateParams:input_type -> liftedinit.sku.v1.MsgUpdateParams
-	1,  // 12: liftedinit.sku.v1.Msg.CreateProvider:output_type -> liftedinit.sku.v1.MsgCreateProviderResponse
-	3,  // 13: liftedinit.sku.v1.Msg.UpdateProvider:output_type -> liftedinit.sku.v1.MsgUpdateProviderResponse
-	5,  // 14: liftedinit.sku.v1.Msg.DeactivateProvider:output_type -> liftedinit.sku.v1.MsgDeactivateProviderResponse
-	7,  // 15: liftedinit.sku.v1.Msg.CreateSKU:output_type -> liftedinit.sku.v1.MsgCreateSKUResponse
-	9,  // 16: liftedinit.sku.v1.Msg.UpdateSKU:output_type -> liftedinit.sku.v1.MsgUpdateSKUResponse
-	11, // 17: liftedinit.sku.v1.Msg.DeactivateSKU:output_type -> liftedinit.sku.v1.MsgDeactivateSKUResponse
-	13, // 18: liftedinit.sku.v1.Msg.UpdateParams:output_type -> liftedinit.sku.v1.MsgUpdateParamsResponse
-	12, // [12:19] is the sub-list for method output_type
-	5,  // [5:12] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	16, // 0: liftedinit.sku.v1.MsgCreateProvider.attributes:type_name -> liftedinit.sku.v1.Attribute
+	16, // 1: liftedinit.sku.v1.MsgUpdateProvider.attributes:type_name -> liftedinit.sku.v1.Attribute
+	17, // 2: liftedinit.sku.v1.MsgCreateSKU.unit:type_name -> liftedinit.sku.v1.Unit
+	18, // 3: liftedinit.sku.v1.MsgCreateSKU.base_price:type_name -> cosmos.base.v1beta1.Coin
+	19, // 4: liftedinit.sku.v1.MsgCreateSKU.price_tiers:type_name -> liftedinit.sku.v1.PriceTier
+	16, // 5: liftedinit.sku.v1.MsgCreateSKU.attributes:type_name -> liftedinit.sku.v1.Attribute
+	17, // 6: liftedinit.sku.v1.MsgUpdateSKU.unit:type_name -> liftedinit.sku.v1.Unit
+	18, // 7: liftedinit.sku.v1.MsgUpdateSKU.base_price:type_name -> cosmos.base.v1beta1.Coin
+	19, // 8: liftedinit.sku.v1.MsgUpdateSKU.price_tiers:type_name -> liftedinit.sku.v1.PriceTier
+	16, // 9: liftedinit.sku.v1.MsgUpdateSKU.attributes:type_name -> liftedinit.sku.v1.Attribute
+	20, // 10: liftedinit.sku.v1.MsgUpdateParams.params:type_name -> liftedinit.sku.v1.Params
+	18, // 11: liftedinit.sku.v1.MsgScheduleSKUPriceChange.base_price:type_name -> cosmos.base.v1beta1.Coin
+	19, // 12: liftedinit.sku.v1.MsgScheduleSKUPriceChange.price_tiers:type_name -> liftedinit.sku.v1.PriceTier
+	21, // 13: liftedinit.sku.v1.MsgScheduleSKUPriceChange.effective_at:type_name -> google.protobuf.Timestamp
+	0,  // 14: liftedinit.sku.v1.Msg.CreateProvider:input_type -> liftedinit.sku.v1.MsgCreateProvider
+	2,  // 15: liftedinit.sku.v1.Msg.UpdateProvider:input_type -> liftedinit.sku.v1.MsgUpdateProvider
+	4,  // 16: liftedinit.sku.v1.Msg.DeactivateProvider:input_type -> liftedinit.sku.v1.MsgDeactivateProvider
+	6,  // 17: liftedinit.sku.v1.Msg.CreateSKU:input_type -> liftedinit.sku.v1.MsgCreateSKU
+	8,  // 18: liftedinit.sku.v1.Msg.UpdateSKU:input_type -> liftedinit.sku.v1.MsgUpdateSKU
+	10, // 19: liftedinit.sku.v1.Msg.DeactivateSKU:input_type -> liftedinit.sku.v1.MsgDeactivateSKU
+	12, // 20: liftedinit.sku.v1.Msg.UpdateParams:input_type -> liftedinit.sku.v1.MsgUpdateParams
+	14, // 21: liftedinit.sku.v1.Msg.ScheduleSKUPriceChange:input_type -> liftedinit.sku.v1.MsgScheduleSKUPriceChange
+	1,  // 22: liftedinit.sku.v1.Msg.CreateProvider:output_type -> liftedinit.sku.v1.MsgCreateProviderResponse
+	3,  // 23: liftedinit.sku.v1.Msg.UpdateProvider:output_type -> liftedinit.sku.v1.MsgUpdateProviderResponse
+	5,  // 24: liftedinit.sku.v1.Msg.DeactivateProvider:output_type -> liftedinit.sku.v1.MsgDeactivateProviderResponse
+	7,  // 25: liftedinit.sku.v1.Msg.CreateSKU:output_type -> liftedinit.sku.v1.MsgCreateSKUResponse
+	9,  // 26: liftedinit.sku.v1.Msg.UpdateSKU:output_type -> liftedinit.sku.v1.MsgUpdateSKUResponse
+	11, // 27: liftedinit.sku.v1.Msg.DeactivateSKU:output_type -> liftedinit.sku.v1.MsgDeactivateSKUResponse
+	13, // 28: liftedinit.sku.v1.Msg.UpdateParams:output_type -> liftedinit.sku.v1.MsgUpdateParamsResponse
+	15, // 29: liftedinit.sku.v1.Msg.ScheduleSKUPriceChange:output_type -> liftedinit.sku.v1.MsgScheduleSKUPriceChangeResponse
+	22, // [22:30] is the sub-list for method output_type
+	14, // [14:22] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_liftedinit_sku_v1_tx_proto_init() }
@@ -8525,6 +10852,30 @@ func file_liftedinit_sku_v1_tx_proto_init() {
 				return nil
 			}
 		}
+		file_liftedinit_sku_v1_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgScheduleSKUPriceChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_liftedinit_sku_v1_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgScheduleSKUPriceChangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -8532,7 +10883,7 @@ func file_liftedinit_sku_v1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_liftedinit_sku_v1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,13 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_CreateProvider_FullMethodName     = "/liftedinit.sku.v1.Msg/CreateProvider"
-	Msg_UpdateProvider_FullMethodName     = "/liftedinit.sku.v1.Msg/UpdateProvider"
-	Msg_DeactivateProvider_FullMethodName = "/liftedinit.sku.v1.Msg/DeactivateProvider"
-	Msg_CreateSKU_FullMethodName          = "/liftedinit.sku.v1.Msg/CreateSKU"
-	Msg_UpdateSKU_FullMethodName          = "/liftedinit.sku.v1.Msg/UpdateSKU"
-	Msg_DeactivateSKU_FullMethodName      = "/liftedinit.sku.v1.Msg/DeactivateSKU"
-	Msg_UpdateParams_FullMethodName       = "/liftedinit.sku.v1.Msg/UpdateParams"
+	Msg_CreateProvider_FullMethodName         = "/liftedinit.sku.v1.Msg/CreateProvider"
+	Msg_UpdateProvider_FullMethodName         = "/liftedinit.sku.v1.Msg/UpdateProvider"
+	Msg_DeactivateProvider_FullMethodName     = "/liftedinit.sku.v1.Msg/DeactivateProvider"
+	Msg_CreateSKU_FullMethodName              = "/liftedinit.sku.v1.Msg/CreateSKU"
+	Msg_UpdateSKU_FullMethodName              = "/liftedinit.sku.v1.Msg/UpdateSKU"
+	Msg_DeactivateSKU_FullMethodName          = "/liftedinit.sku.v1.Msg/DeactivateSKU"
+	Msg_UpdateParams_FullMethodName           = "/liftedinit.sku.v1.Msg/UpdateParams"
+	Msg_ScheduleSKUPriceChange_FullMethodName = "/liftedinit.sku.v1.Msg/ScheduleSKUPriceChange"
 )
 
 // MsgClient is the client API for Msg service.
@@ -52,6 +53,10 @@ type MsgClient interface {
 	DeactivateSKU(ctx context.Context, in *MsgDeactivateSKU, opts ...grpc.CallOption) (*MsgDeactivateSKUResponse, error)
 	// UpdateParams updates the module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ScheduleSKUPriceChange schedules a SKU's base price and price tiers to
+	// change at a future time, replacing any change already pending for it.
+	// Existing leases keep their locked prices.
+	ScheduleSKUPriceChange(ctx context.Context, in *MsgScheduleSKUPriceChange, opts ...grpc.CallOption) (*MsgScheduleSKUPriceChangeResponse, error)
 }
 
 type msgClient struct {
@@ -125,6 +130,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ScheduleSKUPriceChange(ctx context.Context, in *MsgScheduleSKUPriceChange, opts ...grpc.CallOption) (*MsgScheduleSKUPriceChangeResponse, error) {
+	out := new(MsgScheduleSKUPriceChangeResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleSKUPriceChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -149,6 +163,10 @@ type MsgServer interface {
 	DeactivateSKU(context.Context, *MsgDeactivateSKU) (*MsgDeactivateSKUResponse, error)
 	// UpdateParams updates the module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ScheduleSKUPriceChange schedules a SKU's base price and price tiers to
+	// change at a future time, replacing any change already pending for it.
+	// Existing leases keep their locked prices.
+	ScheduleSKUPriceChange(context.Context, *MsgScheduleSKUPriceChange) (*MsgScheduleSKUPriceChangeResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -177,6 +195,9 @@ func (UnimplementedMsgServer) DeactivateSKU(context.Context, *MsgDeactivateSKU)
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) ScheduleSKUPriceChange(context.Context, *MsgScheduleSKUPriceChange) (*MsgScheduleSKUPriceChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleSKUPriceChange not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -316,6 +337,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleSKUPriceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleSKUPriceChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleSKUPriceChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleSKUPriceChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleSKUPriceChange(ctx, req.(*MsgScheduleSKUPriceChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -351,6 +390,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ScheduleSKUPriceChange",
+			Handler:    _Msg_ScheduleSKUPriceChange_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "liftedinit/sku/v1/tx.proto",
@@ -11,77 +11,34 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
 )
 
-var _ protoreflect.List = (*_Params_1_list)(nil)
-
-type _Params_1_list struct {
-	list *[]string
-}
-
-func (x *_Params_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_Params_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_Params_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_Params_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_Params_1_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field AllowedList as it is not of Message kind"))
-}
-
-func (x *_Params_1_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Params_1_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_Params_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_Params              protoreflect.MessageDescriptor
-	fd_Params_allowed_list protoreflect.FieldDescriptor
+	md_AttributeDefinition            protoreflect.MessageDescriptor
+	fd_AttributeDefinition_key        protoreflect.FieldDescriptor
+	fd_AttributeDefinition_value_type protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_liftedinit_sku_v1_types_proto_init()
-	md_Params = File_liftedinit_sku_v1_types_proto.Messages().ByName("Params")
-	fd_Params_allowed_list = md_Params.Fields().ByName("allowed_list")
+	md_AttributeDefinition = File_liftedinit_sku_v1_types_proto.Messages().ByName("AttributeDefinition")
+	fd_AttributeDefinition_key = md_AttributeDefinition.Fields().ByName("key")
+	fd_AttributeDefinition_value_type = md_AttributeDefinition.Fields().ByName("value_type")
 }
 
-var _ protoreflect.Message = (*fastReflection_Params)(nil)
+var _ protoreflect.Message = (*fastReflection_AttributeDefinition)(nil)
 
-type fastReflection_Params Params
+type fastReflection_AttributeDefinition AttributeDefinition
 
-func (x *Params) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Params)(x)
+func (x *AttributeDefinition) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AttributeDefinition)(x)
 }
 
-func (x *Params) slowProtoReflect() protoreflect.Message {
+func (x *AttributeDefinition) slowProtoReflect() protoreflect.Message {
 	mi := &file_liftedinit_sku_v1_types_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -93,43 +50,43 @@ func (x *Params) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Params_messageType fastReflection_Params_messageType
-var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+var _fastReflection_AttributeDefinition_messageType fastReflection_AttributeDefinition_messageType
+var _ protoreflect.MessageType = fastReflection_AttributeDefinition_messageType{}
 
-type fastReflection_Params_messageType struct{}
+type fastReflection_AttributeDefinition_messageType struct{}
 
-func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Params)(nil)
+func (x fastReflection_AttributeDefinition_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AttributeDefinition)(nil)
 }
-func (x fastReflection_Params_messageType) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x fastReflection_AttributeDefinition_messageType) New() protoreflect.Message {
+	return new(fastReflection_AttributeDefinition)
 }
-func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x fastReflection_AttributeDefinition_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AttributeDefinition
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x *fastReflection_AttributeDefinition) Descriptor() protoreflect.MessageDescriptor {
+	return md_AttributeDefinition
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Params) Type() protoreflect.MessageType {
-	return _fastReflection_Params_messageType
+func (x *fastReflection_AttributeDefinition) Type() protoreflect.MessageType {
+	return _fastReflection_AttributeDefinition_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Params) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x *fastReflection_AttributeDefinition) New() protoreflect.Message {
+	return new(fastReflection_AttributeDefinition)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
-	return (*Params)(x)
+func (x *fastReflection_AttributeDefinition) Interface() protoreflect.ProtoMessage {
+	return (*AttributeDefinition)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -137,10 +94,16 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.AllowedList) != 0 {
-		value := protoreflect.ValueOfList(&_Params_1_list{list: &x.AllowedList})
-		if !f(fd_Params_allowed_list, value) {
+func (x *fastReflection_AttributeDefinition) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Key != "" {
+		value := protoreflect.ValueOfString(x.Key)
+		if !f(fd_AttributeDefinition_key, value) {
+			return
+		}
+	}
+	if x.ValueType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ValueType))
+		if !f(fd_AttributeDefinition_value_type, value) {
 			return
 		}
 	}
@@ -157,15 +120,17 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_AttributeDefinition) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "liftedinit.sku.v1.Params.allowed_list":
-		return len(x.AllowedList) != 0
+	case "liftedinit.sku.v1.AttributeDefinition.key":
+		return x.Key != ""
+	case "liftedinit.sku.v1.AttributeDefinition.value_type":
+		return x.ValueType != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.AttributeDefinition"))
 		}
-		panic(fmt.Errorf("message liftedinit.sku.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message liftedinit.sku.v1.AttributeDefinition does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -175,15 +140,17 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_AttributeDefinition) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "liftedinit.sku.v1.Params.allowed_list":
-		x.AllowedList = nil
+	case "liftedinit.sku.v1.AttributeDefinition.key":
+		x.Key = ""
+	case "liftedinit.sku.v1.AttributeDefinition.value_type":
+		x.ValueType = 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.AttributeDefinition"))
 		}
-		panic(fmt.Errorf("message liftedinit.sku.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message liftedinit.sku.v1.AttributeDefinition does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -193,19 +160,19 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AttributeDefinition) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "liftedinit.sku.v1.Params.allowed_list":
-		if len(x.AllowedList) == 0 {
-			return protoreflect.ValueOfList(&_Params_1_list{})
-		}
-		listValue := &_Params_1_list{list: &x.AllowedList}
-		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.sku.v1.AttributeDefinition.key":
+		value := x.Key
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.sku.v1.AttributeDefinition.value_type":
+		value := x.ValueType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.AttributeDefinition"))
 		}
-		panic(fmt.Errorf("message liftedinit.sku.v1.Params does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message liftedinit.sku.v1.AttributeDefinition does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -219,17 +186,17 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_AttributeDefinition) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "liftedinit.sku.v1.Params.allowed_list":
-		lv := value.List()
-		clv := lv.(*_Params_1_list)
-		x.AllowedList = *clv.list
+	case "liftedinit.sku.v1.AttributeDefinition.key":
+		x.Key = value.Interface().(string)
+	case "liftedinit.sku.v1.AttributeDefinition.value_type":
+		x.ValueType = (AttributeType)(value.Enum())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.AttributeDefinition"))
 		}
-		panic(fmt.Errorf("message liftedinit.sku.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message liftedinit.sku.v1.AttributeDefinition does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -243,45 +210,44 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AttributeDefinition) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "liftedinit.sku.v1.Params.allowed_list":
-		if x.AllowedList == nil {
-			x.AllowedList = []string{}
-		}
-		value := &_Params_1_list{list: &x.AllowedList}
-		return protoreflect.ValueOfList(value)
+	case "liftedinit.sku.v1.AttributeDefinition.key":
+		panic(fmt.Errorf("field key of message liftedinit.sku.v1.AttributeDefinition is not mutable"))
+	case "liftedinit.sku.v1.AttributeDefinition.value_type":
+		panic(fmt.Errorf("field value_type of message liftedinit.sku.v1.AttributeDefinition is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.AttributeDefinition"))
 		}
-		panic(fmt.Errorf("message liftedinit.sku.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message liftedinit.sku.v1.AttributeDefinition does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AttributeDefinition) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "liftedinit.sku.v1.Params.allowed_list":
-		list := []string{}
-		return protoreflect.ValueOfList(&_Params_1_list{list: &list})
+	case "liftedinit.sku.v1.AttributeDefinition.key":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.sku.v1.AttributeDefinition.value_type":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.AttributeDefinition"))
 		}
-		panic(fmt.Errorf("message liftedinit.sku.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message liftedinit.sku.v1.AttributeDefinition does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_AttributeDefinition) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in liftedinit.sku.v1.Params", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in liftedinit.sku.v1.AttributeDefinition", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -289,7 +255,7 @@ func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_AttributeDefinition) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -300,7 +266,7 @@ func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_AttributeDefinition) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -312,7 +278,7 @@ func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Params) IsValid() bool {
+func (x *fastReflection_AttributeDefinition) IsValid() bool {
 	return x != nil
 }
 
@@ -322,9 +288,9 @@ func (x *fastReflection_Params) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_AttributeDefinition) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*AttributeDefinition)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -336,11 +302,12 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.AllowedList) > 0 {
-			for _, s := range x.AllowedList {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.Key)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ValueType != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValueType))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -352,7 +319,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*AttributeDefinition)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -371,14 +338,17 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.AllowedList) > 0 {
-			for iNdEx := len(x.AllowedList) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.AllowedList[iNdEx])
-				copy(dAtA[i:], x.AllowedList[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedList[iNdEx])))
-				i--
-				dAtA[i] = 0xa
-			}
+		if x.ValueType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValueType))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Key) > 0 {
+			i -= len(x.Key)
+			copy(dAtA[i:], x.Key)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Key)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -391,7 +361,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*AttributeDefinition)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -423,15 +393,15 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AttributeDefinition: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AttributeDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedList", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -459,8 +429,27 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AllowedList = append(x.AllowedList, string(dAtA[iNdEx:postIndex]))
+				x.Key = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
+				}
+				x.ValueType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValueType |= AttributeType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -497,35 +486,27 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Provider                protoreflect.MessageDescriptor
-	fd_Provider_uuid           protoreflect.FieldDescriptor
-	fd_Provider_address        protoreflect.FieldDescriptor
-	fd_Provider_payout_address protoreflect.FieldDescriptor
-	fd_Provider_meta_hash      protoreflect.FieldDescriptor
-	fd_Provider_active         protoreflect.FieldDescriptor
-	fd_Provider_api_url        protoreflect.FieldDescriptor
+	md_Attribute       protoreflect.MessageDescriptor
+	fd_Attribute_key   protoreflect.FieldDescriptor
+	fd_Attribute_value protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_liftedinit_sku_v1_types_proto_init()
-	md_Provider = File_liftedinit_sku_v1_types_proto.Messages().ByName("Provider")
-	fd_Provider_uuid = md_Provider.Fields().ByName("uuid")
-	fd_Provider_address = md_Provider.Fields().ByName("address")
-	fd_Provider_payout_address = md_Provider.Fields().ByName("payout_address")
-	fd_Provider_meta_hash = md_Provider.Fields().ByName("meta_hash")
-	fd_Provider_active = md_Provider.Fields().ByName("active")
-	fd_Provider_api_url = md_Provider.Fields().ByName("api_url")
+	md_Attribute = File_liftedinit_sku_v1_types_proto.Messages().ByName("Attribute")
+	fd_Attribute_key = md_Attribute.Fields().ByName("key")
+	fd_Attribute_value = md_Attribute.Fields().ByName("value")
 }
 
-var _ protoreflect.Message = (*fastReflection_Provider)(nil)
+var _ protoreflect.Message = (*fastReflection_Attribute)(nil)
 
-type fastReflection_Provider Provider
+type fastReflection_Attribute Attribute
 
-func (x *Provider) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Provider)(x)
+func (x *Attribute) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Attribute)(x)
 }
 
-func (x *Provider) slowProtoReflect() protoreflect.Message {
+func (x *Attribute) slowProtoReflect() protoreflect.Message {
 	mi := &file_liftedinit_sku_v1_types_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -537,43 +518,43 @@ func (x *Provider) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Provider_messageType fastReflection_Provider_messageType
-var _ protoreflect.MessageType = fastReflection_Provider_messageType{}
+var _fastReflection_Attribute_messageType fastReflection_Attribute_messageType
+var _ protoreflect.MessageType = fastReflection_Attribute_messageType{}
 
-type fastReflection_Provider_messageType struct{}
+type fastReflection_Attribute_messageType struct{}
 
-func (x fastReflection_Provider_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Provider)(nil)
+func (x fastReflection_Attribute_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Attribute)(nil)
 }
-func (x fastReflection_Provider_messageType) New() protoreflect.Message {
-	return new(fastReflection_Provider)
+func (x fastReflection_Attribute_messageType) New() protoreflect.Message {
+	return new(fastReflection_Attribute)
 }
-func (x fastReflection_Provider_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Provider
+func (x fastReflection_Attribute_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Attribute
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Provider) Descriptor() protoreflect.MessageDescriptor {
-	return md_Provider
+func (x *fastReflection_Attribute) Descriptor() protoreflect.MessageDescriptor {
+	return md_Attribute
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Provider) Type() protoreflect.MessageType {
-	return _fastReflection_Provider_messageType
+func (x *fastReflection_Attribute) Type() protoreflect.MessageType {
+	return _fastReflection_Attribute_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Provider) New() protoreflect.Message {
-	return new(fastReflection_Provider)
+func (x *fastReflection_Attribute) New() protoreflect.Message {
+	return new(fastReflection_Attribute)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Provider) Interface() protoreflect.ProtoMessage {
-	return (*Provider)(x)
+func (x *fastReflection_Attribute) Interface() protoreflect.ProtoMessage {
+	return (*Attribute)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -581,40 +562,16 @@ func (x *fastReflection_Provider) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Provider) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Uuid != "" {
-		value := protoreflect.ValueOfString(x.Uuid)
-		if !f(fd_Provider_uuid, value) {
-			return
-		}
-	}
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_Provider_address, value) {
-			return
-		}
-	}
-	if x.PayoutAddress != "" {
-		value := protoreflect.ValueOfString(x.PayoutAddress)
-		if !f(fd_Provider_payout_address, value) {
-			return
-		}
-	}
-	if len(x.MetaHash) != 0 {
-		value := protoreflect.ValueOfBytes(x.MetaHash)
-		if !f(fd_Provider_meta_hash, value) {
-			return
-		}
-	}
-	if x.Active != false {
-		value := protoreflect.ValueOfBool(x.Active)
-		if !f(fd_Provider_active, value) {
+func (x *fastReflection_Attribute) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Key != "" {
+		value := protoreflect.ValueOfString(x.Key)
+		if !f(fd_Attribute_key, value) {
 			return
 		}
 	}
-	if x.ApiUrl != "" {
-		value := protoreflect.ValueOfString(x.ApiUrl)
-		if !f(fd_Provider_api_url, value) {
+	if x.Value != "" {
+		value := protoreflect.ValueOfString(x.Value)
+		if !f(fd_Attribute_value, value) {
 			return
 		}
 	}
@@ -631,25 +588,17 @@ func (x *fastReflection_Provider) Range(f func(protoreflect.FieldDescriptor, pro
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Provider) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Attribute) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "liftedinit.sku.v1.Provider.uuid":
-		return x.Uuid != ""
-	case "liftedinit.sku.v1.Provider.address":
-		return x.Address != ""
-	case "liftedinit.sku.v1.Provider.payout_address":
-		return x.PayoutAddress != ""
-	case "liftedinit.sku.v1.Provider.meta_hash":
-		return len(x.MetaHash) != 0
-	case "liftedinit.sku.v1.Provider.active":
-		return x.Active != false
-	case "liftedinit.sku.v1.Provider.api_url":
-		return x.ApiUrl != ""
+	case "liftedinit.sku.v1.Attribute.key":
+		return x.Key != ""
+	case "liftedinit.sku.v1.Attribute.value":
+		return x.Value != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Provider"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Attribute"))
 		}
-		panic(fmt.Errorf("message liftedinit.sku.v1.Provider does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message liftedinit.sku.v1.Attribute does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -659,25 +608,3678 @@ func (x *fastReflection_Provider) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Provider) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Attribute) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "liftedinit.sku.v1.Provider.uuid":
-		x.Uuid = ""
+	case "liftedinit.sku.v1.Attribute.key":
+		x.Key = ""
+	case "liftedinit.sku.v1.Attribute.value":
+		x.Value = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Attribute"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.Attribute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Attribute) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "liftedinit.sku.v1.Attribute.key":
+		value := x.Key
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.sku.v1.Attribute.value":
+		value := x.Value
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Attribute"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.Attribute does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Attribute) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.Attribute.key":
+		x.Key = value.Interface().(string)
+	case "liftedinit.sku.v1.Attribute.value":
+		x.Value = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Attribute"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.Attribute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Attribute) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.Attribute.key":
+		panic(fmt.Errorf("field key of message liftedinit.sku.v1.Attribute is not mutable"))
+	case "liftedinit.sku.v1.Attribute.value":
+		panic(fmt.Errorf("field value of message liftedinit.sku.v1.Attribute is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Attribute"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.Attribute does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Attribute) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.Attribute.key":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.sku.v1.Attribute.value":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Attribute"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.Attribute does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Attribute) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in liftedinit.sku.v1.Attribute", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Attribute) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Attribute) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Attribute) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Attribute) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Attribute)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Key)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Value)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Attribute)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Value) > 0 {
+			i -= len(x.Value)
+			copy(dAtA[i:], x.Value)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Value)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Key) > 0 {
+			i -= len(x.Key)
+			copy(dAtA[i:], x.Key)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Key)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Attribute)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Attribute: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Attribute: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Key = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Value = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Params_1_list)(nil)
+
+type _Params_1_list struct {
+	list *[]string
+}
+
+func (x *_Params_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field AllowedList as it is not of Message kind"))
+}
+
+func (x *_Params_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_2_list)(nil)
+
+type _Params_2_list struct {
+	list *[]*AttributeDefinition
+}
+
+func (x *_Params_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AttributeDefinition)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AttributeDefinition)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_2_list) AppendMutable() protoreflect.Value {
+	v := new(AttributeDefinition)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_2_list) NewElement() protoreflect.Value {
+	v := new(AttributeDefinition)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Params                  protoreflect.MessageDescriptor
+	fd_Params_allowed_list     protoreflect.FieldDescriptor
+	fd_Params_attribute_schema protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_liftedinit_sku_v1_types_proto_init()
+	md_Params = File_liftedinit_sku_v1_types_proto.Messages().ByName("Params")
+	fd_Params_allowed_list = md_Params.Fields().ByName("allowed_list")
+	fd_Params_attribute_schema = md_Params.Fields().ByName("attribute_schema")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_liftedinit_sku_v1_types_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.AllowedList) != 0 {
+		value := protoreflect.ValueOfList(&_Params_1_list{list: &x.AllowedList})
+		if !f(fd_Params_allowed_list, value) {
+			return
+		}
+	}
+	if len(x.AttributeSchema) != 0 {
+		value := protoreflect.ValueOfList(&_Params_2_list{list: &x.AttributeSchema})
+		if !f(fd_Params_attribute_schema, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.Params.allowed_list":
+		return len(x.AllowedList) != 0
+	case "liftedinit.sku.v1.Params.attribute_schema":
+		return len(x.AttributeSchema) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Params"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.Params.allowed_list":
+		x.AllowedList = nil
+	case "liftedinit.sku.v1.Params.attribute_schema":
+		x.AttributeSchema = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Params"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "liftedinit.sku.v1.Params.allowed_list":
+		if len(x.AllowedList) == 0 {
+			return protoreflect.ValueOfList(&_Params_1_list{})
+		}
+		listValue := &_Params_1_list{list: &x.AllowedList}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.sku.v1.Params.attribute_schema":
+		if len(x.AttributeSchema) == 0 {
+			return protoreflect.ValueOfList(&_Params_2_list{})
+		}
+		listValue := &_Params_2_list{list: &x.AttributeSchema}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Params"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.Params.allowed_list":
+		lv := value.List()
+		clv := lv.(*_Params_1_list)
+		x.AllowedList = *clv.list
+	case "liftedinit.sku.v1.Params.attribute_schema":
+		lv := value.List()
+		clv := lv.(*_Params_2_list)
+		x.AttributeSchema = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Params"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.Params.allowed_list":
+		if x.AllowedList == nil {
+			x.AllowedList = []string{}
+		}
+		value := &_Params_1_list{list: &x.AllowedList}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.sku.v1.Params.attribute_schema":
+		if x.AttributeSchema == nil {
+			x.AttributeSchema = []*AttributeDefinition{}
+		}
+		value := &_Params_2_list{list: &x.AttributeSchema}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Params"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.Params.allowed_list":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_1_list{list: &list})
+	case "liftedinit.sku.v1.Params.attribute_schema":
+		list := []*AttributeDefinition{}
+		return protoreflect.ValueOfList(&_Params_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Params"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in liftedinit.sku.v1.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.AllowedList) > 0 {
+			for _, s := range x.AllowedList {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AttributeSchema) > 0 {
+			for _, e := range x.AttributeSchema {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.AttributeSchema) > 0 {
+			for iNdEx := len(x.AttributeSchema) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AttributeSchema[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.AllowedList) > 0 {
+			for iNdEx := len(x.AllowedList) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedList[iNdEx])
+				copy(dAtA[i:], x.AllowedList[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedList[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedList", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedList = append(x.AllowedList, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AttributeSchema", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AttributeSchema = append(x.AttributeSchema, &AttributeDefinition{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AttributeSchema[len(x.AttributeSchema)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Provider_7_list)(nil)
+
+type _Provider_7_list struct {
+	list *[]*Attribute
+}
+
+func (x *_Provider_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Provider_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Provider_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Attribute)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Provider_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Attribute)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Provider_7_list) AppendMutable() protoreflect.Value {
+	v := new(Attribute)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Provider_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Provider_7_list) NewElement() protoreflect.Value {
+	v := new(Attribute)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Provider_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Provider                protoreflect.MessageDescriptor
+	fd_Provider_uuid           protoreflect.FieldDescriptor
+	fd_Provider_address        protoreflect.FieldDescriptor
+	fd_Provider_payout_address protoreflect.FieldDescriptor
+	fd_Provider_meta_hash      protoreflect.FieldDescriptor
+	fd_Provider_active         protoreflect.FieldDescriptor
+	fd_Provider_api_url        protoreflect.FieldDescriptor
+	fd_Provider_attributes     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_liftedinit_sku_v1_types_proto_init()
+	md_Provider = File_liftedinit_sku_v1_types_proto.Messages().ByName("Provider")
+	fd_Provider_uuid = md_Provider.Fields().ByName("uuid")
+	fd_Provider_address = md_Provider.Fields().ByName("address")
+	fd_Provider_payout_address = md_Provider.Fields().ByName("payout_address")
+	fd_Provider_meta_hash = md_Provider.Fields().ByName("meta_hash")
+	fd_Provider_active = md_Provider.Fields().ByName("active")
+	fd_Provider_api_url = md_Provider.Fields().ByName("api_url")
+	fd_Provider_attributes = md_Provider.Fields().ByName("attributes")
+}
+
+var _ protoreflect.Message = (*fastReflection_Provider)(nil)
+
+type fastReflection_Provider Provider
+
+func (x *Provider) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Provider)(x)
+}
+
+func (x *Provider) slowProtoReflect() protoreflect.Message {
+	mi := &file_liftedinit_sku_v1_types_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Provider_messageType fastReflection_Provider_messageType
+var _ protoreflect.MessageType = fastReflection_Provider_messageType{}
+
+type fastReflection_Provider_messageType struct{}
+
+func (x fastReflection_Provider_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Provider)(nil)
+}
+func (x fastReflection_Provider_messageType) New() protoreflect.Message {
+	return new(fastReflection_Provider)
+}
+func (x fastReflection_Provider_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Provider
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Provider) Descriptor() protoreflect.MessageDescriptor {
+	return md_Provider
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Provider) Type() protoreflect.MessageType {
+	return _fastReflection_Provider_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Provider) New() protoreflect.Message {
+	return new(fastReflection_Provider)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Provider) Interface() protoreflect.ProtoMessage {
+	return (*Provider)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Provider) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Uuid != "" {
+		value := protoreflect.ValueOfString(x.Uuid)
+		if !f(fd_Provider_uuid, value) {
+			return
+		}
+	}
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_Provider_address, value) {
+			return
+		}
+	}
+	if x.PayoutAddress != "" {
+		value := protoreflect.ValueOfString(x.PayoutAddress)
+		if !f(fd_Provider_payout_address, value) {
+			return
+		}
+	}
+	if len(x.MetaHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.MetaHash)
+		if !f(fd_Provider_meta_hash, value) {
+			return
+		}
+	}
+	if x.Active != false {
+		value := protoreflect.ValueOfBool(x.Active)
+		if !f(fd_Provider_active, value) {
+			return
+		}
+	}
+	if x.ApiUrl != "" {
+		value := protoreflect.ValueOfString(x.ApiUrl)
+		if !f(fd_Provider_api_url, value) {
+			return
+		}
+	}
+	if len(x.Attributes) != 0 {
+		value := protoreflect.ValueOfList(&_Provider_7_list{list: &x.Attributes})
+		if !f(fd_Provider_attributes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Provider) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.Provider.uuid":
+		return x.Uuid != ""
+	case "liftedinit.sku.v1.Provider.address":
+		return x.Address != ""
+	case "liftedinit.sku.v1.Provider.payout_address":
+		return x.PayoutAddress != ""
+	case "liftedinit.sku.v1.Provider.meta_hash":
+		return len(x.MetaHash) != 0
+	case "liftedinit.sku.v1.Provider.active":
+		return x.Active != false
+	case "liftedinit.sku.v1.Provider.api_url":
+		return x.ApiUrl != ""
+	case "liftedinit.sku.v1.Provider.attributes":
+		return len(x.Attributes) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Provider"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.Provider does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Provider) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.Provider.uuid":
+		x.Uuid = ""
+	case "liftedinit.sku.v1.Provider.address":
+		x.Address = ""
+	case "liftedinit.sku.v1.Provider.payout_address":
+		x.PayoutAddress = ""
+	case "liftedinit.sku.v1.Provider.meta_hash":
+		x.MetaHash = nil
+	case "liftedinit.sku.v1.Provider.active":
+		x.Active = false
+	case "liftedinit.sku.v1.Provider.api_url":
+		x.ApiUrl = ""
+	case "liftedinit.sku.v1.Provider.attributes":
+		x.Attributes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Provider"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.Provider does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Provider) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "liftedinit.sku.v1.Provider.uuid":
+		value := x.Uuid
+		return protoreflect.ValueOfString(value)
 	case "liftedinit.sku.v1.Provider.address":
-		x.Address = ""
+		value := x.Address
+		return protoreflect.ValueOfString(value)
 	case "liftedinit.sku.v1.Provider.payout_address":
-		x.PayoutAddress = ""
+		value := x.PayoutAddress
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.sku.v1.Provider.meta_hash":
+		value := x.MetaHash
+		return protoreflect.ValueOfBytes(value)
+	case "liftedinit.sku.v1.Provider.active":
+		value := x.Active
+		return protoreflect.ValueOfBool(value)
+	case "liftedinit.sku.v1.Provider.api_url":
+		value := x.ApiUrl
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.sku.v1.Provider.attributes":
+		if len(x.Attributes) == 0 {
+			return protoreflect.ValueOfList(&_Provider_7_list{})
+		}
+		listValue := &_Provider_7_list{list: &x.Attributes}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Provider"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.Provider does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Provider) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.Provider.uuid":
+		x.Uuid = value.Interface().(string)
+	case "liftedinit.sku.v1.Provider.address":
+		x.Address = value.Interface().(string)
+	case "liftedinit.sku.v1.Provider.payout_address":
+		x.PayoutAddress = value.Interface().(string)
+	case "liftedinit.sku.v1.Provider.meta_hash":
+		x.MetaHash = value.Bytes()
+	case "liftedinit.sku.v1.Provider.active":
+		x.Active = value.Bool()
+	case "liftedinit.sku.v1.Provider.api_url":
+		x.ApiUrl = value.Interface().(string)
+	case "liftedinit.sku.v1.Provider.attributes":
+		lv := value.List()
+		clv := lv.(*_Provider_7_list)
+		x.Attributes = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Provider"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.Provider does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Provider) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.Provider.attributes":
+		if x.Attributes == nil {
+			x.Attributes = []*Attribute{}
+		}
+		value := &_Provider_7_list{list: &x.Attributes}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.sku.v1.Provider.uuid":
+		panic(fmt.Errorf("field uuid of message liftedinit.sku.v1.Provider is not mutable"))
+	case "liftedinit.sku.v1.Provider.address":
+		panic(fmt.Errorf("field address of message liftedinit.sku.v1.Provider is not mutable"))
+	case "liftedinit.sku.v1.Provider.payout_address":
+		panic(fmt.Errorf("field payout_address of message liftedinit.sku.v1.Provider is not mutable"))
+	case "liftedinit.sku.v1.Provider.meta_hash":
+		panic(fmt.Errorf("field meta_hash of message liftedinit.sku.v1.Provider is not mutable"))
+	case "liftedinit.sku.v1.Provider.active":
+		panic(fmt.Errorf("field active of message liftedinit.sku.v1.Provider is not mutable"))
+	case "liftedinit.sku.v1.Provider.api_url":
+		panic(fmt.Errorf("field api_url of message liftedinit.sku.v1.Provider is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Provider"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.Provider does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Provider) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.Provider.uuid":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.sku.v1.Provider.address":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.sku.v1.Provider.payout_address":
+		return protoreflect.ValueOfString("")
 	case "liftedinit.sku.v1.Provider.meta_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "liftedinit.sku.v1.Provider.active":
+		return protoreflect.ValueOfBool(false)
+	case "liftedinit.sku.v1.Provider.api_url":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.sku.v1.Provider.attributes":
+		list := []*Attribute{}
+		return protoreflect.ValueOfList(&_Provider_7_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Provider"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.Provider does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Provider) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in liftedinit.sku.v1.Provider", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Provider) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Provider) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Provider) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Provider) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Provider)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Uuid)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PayoutAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MetaHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Active {
+			n += 2
+		}
+		l = len(x.ApiUrl)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Attributes) > 0 {
+			for _, e := range x.Attributes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Provider)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Attributes) > 0 {
+			for iNdEx := len(x.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Attributes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.ApiUrl) > 0 {
+			i -= len(x.ApiUrl)
+			copy(dAtA[i:], x.ApiUrl)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ApiUrl)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Active {
+			i--
+			if x.Active {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.MetaHash) > 0 {
+			i -= len(x.MetaHash)
+			copy(dAtA[i:], x.MetaHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MetaHash)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.PayoutAddress) > 0 {
+			i -= len(x.PayoutAddress)
+			copy(dAtA[i:], x.PayoutAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PayoutAddress)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Uuid) > 0 {
+			i -= len(x.Uuid)
+			copy(dAtA[i:], x.Uuid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Uuid)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Provider)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Provider: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Provider: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Uuid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PayoutAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PayoutAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MetaHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MetaHash = append(x.MetaHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.MetaHash == nil {
+					x.MetaHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Active = bool(v != 0)
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ApiUrl", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ApiUrl = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Attributes = append(x.Attributes, &Attribute{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Attributes[len(x.Attributes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_SKU_8_list)(nil)
+
+type _SKU_8_list struct {
+	list *[]*PriceTier
+}
+
+func (x *_SKU_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SKU_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_SKU_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SKU_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SKU_8_list) AppendMutable() protoreflect.Value {
+	v := new(PriceTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SKU_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SKU_8_list) NewElement() protoreflect.Value {
+	v := new(PriceTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SKU_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_SKU_10_list)(nil)
+
+type _SKU_10_list struct {
+	list *[]*Attribute
+}
+
+func (x *_SKU_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SKU_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_SKU_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Attribute)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SKU_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Attribute)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SKU_10_list) AppendMutable() protoreflect.Value {
+	v := new(Attribute)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SKU_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SKU_10_list) NewElement() protoreflect.Value {
+	v := new(Attribute)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SKU_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_SKU               protoreflect.MessageDescriptor
+	fd_SKU_uuid          protoreflect.FieldDescriptor
+	fd_SKU_provider_uuid protoreflect.FieldDescriptor
+	fd_SKU_name          protoreflect.FieldDescriptor
+	fd_SKU_unit          protoreflect.FieldDescriptor
+	fd_SKU_base_price    protoreflect.FieldDescriptor
+	fd_SKU_meta_hash     protoreflect.FieldDescriptor
+	fd_SKU_active        protoreflect.FieldDescriptor
+	fd_SKU_price_tiers   protoreflect.FieldDescriptor
+	fd_SKU_capacity      protoreflect.FieldDescriptor
+	fd_SKU_attributes    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_liftedinit_sku_v1_types_proto_init()
+	md_SKU = File_liftedinit_sku_v1_types_proto.Messages().ByName("SKU")
+	fd_SKU_uuid = md_SKU.Fields().ByName("uuid")
+	fd_SKU_provider_uuid = md_SKU.Fields().ByName("provider_uuid")
+	fd_SKU_name = md_SKU.Fields().ByName("name")
+	fd_SKU_unit = md_SKU.Fields().ByName("unit")
+	fd_SKU_base_price = md_SKU.Fields().ByName("base_price")
+	fd_SKU_meta_hash = md_SKU.Fields().ByName("meta_hash")
+	fd_SKU_active = md_SKU.Fields().ByName("active")
+	fd_SKU_price_tiers = md_SKU.Fields().ByName("price_tiers")
+	fd_SKU_capacity = md_SKU.Fields().ByName("capacity")
+	fd_SKU_attributes = md_SKU.Fields().ByName("attributes")
+}
+
+var _ protoreflect.Message = (*fastReflection_SKU)(nil)
+
+type fastReflection_SKU SKU
+
+func (x *SKU) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SKU)(x)
+}
+
+func (x *SKU) slowProtoReflect() protoreflect.Message {
+	mi := &file_liftedinit_sku_v1_types_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SKU_messageType fastReflection_SKU_messageType
+var _ protoreflect.MessageType = fastReflection_SKU_messageType{}
+
+type fastReflection_SKU_messageType struct{}
+
+func (x fastReflection_SKU_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SKU)(nil)
+}
+func (x fastReflection_SKU_messageType) New() protoreflect.Message {
+	return new(fastReflection_SKU)
+}
+func (x fastReflection_SKU_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SKU
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SKU) Descriptor() protoreflect.MessageDescriptor {
+	return md_SKU
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SKU) Type() protoreflect.MessageType {
+	return _fastReflection_SKU_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SKU) New() protoreflect.Message {
+	return new(fastReflection_SKU)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SKU) Interface() protoreflect.ProtoMessage {
+	return (*SKU)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SKU) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Uuid != "" {
+		value := protoreflect.ValueOfString(x.Uuid)
+		if !f(fd_SKU_uuid, value) {
+			return
+		}
+	}
+	if x.ProviderUuid != "" {
+		value := protoreflect.ValueOfString(x.ProviderUuid)
+		if !f(fd_SKU_provider_uuid, value) {
+			return
+		}
+	}
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_SKU_name, value) {
+			return
+		}
+	}
+	if x.Unit != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Unit))
+		if !f(fd_SKU_unit, value) {
+			return
+		}
+	}
+	if x.BasePrice != nil {
+		value := protoreflect.ValueOfMessage(x.BasePrice.ProtoReflect())
+		if !f(fd_SKU_base_price, value) {
+			return
+		}
+	}
+	if len(x.MetaHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.MetaHash)
+		if !f(fd_SKU_meta_hash, value) {
+			return
+		}
+	}
+	if x.Active != false {
+		value := protoreflect.ValueOfBool(x.Active)
+		if !f(fd_SKU_active, value) {
+			return
+		}
+	}
+	if len(x.PriceTiers) != 0 {
+		value := protoreflect.ValueOfList(&_SKU_8_list{list: &x.PriceTiers})
+		if !f(fd_SKU_price_tiers, value) {
+			return
+		}
+	}
+	if x.Capacity != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Capacity)
+		if !f(fd_SKU_capacity, value) {
+			return
+		}
+	}
+	if len(x.Attributes) != 0 {
+		value := protoreflect.ValueOfList(&_SKU_10_list{list: &x.Attributes})
+		if !f(fd_SKU_attributes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SKU) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.SKU.uuid":
+		return x.Uuid != ""
+	case "liftedinit.sku.v1.SKU.provider_uuid":
+		return x.ProviderUuid != ""
+	case "liftedinit.sku.v1.SKU.name":
+		return x.Name != ""
+	case "liftedinit.sku.v1.SKU.unit":
+		return x.Unit != 0
+	case "liftedinit.sku.v1.SKU.base_price":
+		return x.BasePrice != nil
+	case "liftedinit.sku.v1.SKU.meta_hash":
+		return len(x.MetaHash) != 0
+	case "liftedinit.sku.v1.SKU.active":
+		return x.Active != false
+	case "liftedinit.sku.v1.SKU.price_tiers":
+		return len(x.PriceTiers) != 0
+	case "liftedinit.sku.v1.SKU.capacity":
+		return x.Capacity != uint64(0)
+	case "liftedinit.sku.v1.SKU.attributes":
+		return len(x.Attributes) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.SKU"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.SKU does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SKU) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.SKU.uuid":
+		x.Uuid = ""
+	case "liftedinit.sku.v1.SKU.provider_uuid":
+		x.ProviderUuid = ""
+	case "liftedinit.sku.v1.SKU.name":
+		x.Name = ""
+	case "liftedinit.sku.v1.SKU.unit":
+		x.Unit = 0
+	case "liftedinit.sku.v1.SKU.base_price":
+		x.BasePrice = nil
+	case "liftedinit.sku.v1.SKU.meta_hash":
 		x.MetaHash = nil
-	case "liftedinit.sku.v1.Provider.active":
+	case "liftedinit.sku.v1.SKU.active":
 		x.Active = false
-	case "liftedinit.sku.v1.Provider.api_url":
-		x.ApiUrl = ""
+	case "liftedinit.sku.v1.SKU.price_tiers":
+		x.PriceTiers = nil
+	case "liftedinit.sku.v1.SKU.capacity":
+		x.Capacity = uint64(0)
+	case "liftedinit.sku.v1.SKU.attributes":
+		x.Attributes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.SKU"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.SKU does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SKU) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "liftedinit.sku.v1.SKU.uuid":
+		value := x.Uuid
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.sku.v1.SKU.provider_uuid":
+		value := x.ProviderUuid
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.sku.v1.SKU.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "liftedinit.sku.v1.SKU.unit":
+		value := x.Unit
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "liftedinit.sku.v1.SKU.base_price":
+		value := x.BasePrice
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "liftedinit.sku.v1.SKU.meta_hash":
+		value := x.MetaHash
+		return protoreflect.ValueOfBytes(value)
+	case "liftedinit.sku.v1.SKU.active":
+		value := x.Active
+		return protoreflect.ValueOfBool(value)
+	case "liftedinit.sku.v1.SKU.price_tiers":
+		if len(x.PriceTiers) == 0 {
+			return protoreflect.ValueOfList(&_SKU_8_list{})
+		}
+		listValue := &_SKU_8_list{list: &x.PriceTiers}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.sku.v1.SKU.capacity":
+		value := x.Capacity
+		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.sku.v1.SKU.attributes":
+		if len(x.Attributes) == 0 {
+			return protoreflect.ValueOfList(&_SKU_10_list{})
+		}
+		listValue := &_SKU_10_list{list: &x.Attributes}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.SKU"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.SKU does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SKU) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.SKU.uuid":
+		x.Uuid = value.Interface().(string)
+	case "liftedinit.sku.v1.SKU.provider_uuid":
+		x.ProviderUuid = value.Interface().(string)
+	case "liftedinit.sku.v1.SKU.name":
+		x.Name = value.Interface().(string)
+	case "liftedinit.sku.v1.SKU.unit":
+		x.Unit = (Unit)(value.Enum())
+	case "liftedinit.sku.v1.SKU.base_price":
+		x.BasePrice = value.Message().Interface().(*types.Coin)
+	case "liftedinit.sku.v1.SKU.meta_hash":
+		x.MetaHash = value.Bytes()
+	case "liftedinit.sku.v1.SKU.active":
+		x.Active = value.Bool()
+	case "liftedinit.sku.v1.SKU.price_tiers":
+		lv := value.List()
+		clv := lv.(*_SKU_8_list)
+		x.PriceTiers = *clv.list
+	case "liftedinit.sku.v1.SKU.capacity":
+		x.Capacity = value.Uint()
+	case "liftedinit.sku.v1.SKU.attributes":
+		lv := value.List()
+		clv := lv.(*_SKU_10_list)
+		x.Attributes = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.SKU"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.SKU does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SKU) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.SKU.base_price":
+		if x.BasePrice == nil {
+			x.BasePrice = new(types.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.BasePrice.ProtoReflect())
+	case "liftedinit.sku.v1.SKU.price_tiers":
+		if x.PriceTiers == nil {
+			x.PriceTiers = []*PriceTier{}
+		}
+		value := &_SKU_8_list{list: &x.PriceTiers}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.sku.v1.SKU.attributes":
+		if x.Attributes == nil {
+			x.Attributes = []*Attribute{}
+		}
+		value := &_SKU_10_list{list: &x.Attributes}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.sku.v1.SKU.uuid":
+		panic(fmt.Errorf("field uuid of message liftedinit.sku.v1.SKU is not mutable"))
+	case "liftedinit.sku.v1.SKU.provider_uuid":
+		panic(fmt.Errorf("field provider_uuid of message liftedinit.sku.v1.SKU is not mutable"))
+	case "liftedinit.sku.v1.SKU.name":
+		panic(fmt.Errorf("field name of message liftedinit.sku.v1.SKU is not mutable"))
+	case "liftedinit.sku.v1.SKU.unit":
+		panic(fmt.Errorf("field unit of message liftedinit.sku.v1.SKU is not mutable"))
+	case "liftedinit.sku.v1.SKU.meta_hash":
+		panic(fmt.Errorf("field meta_hash of message liftedinit.sku.v1.SKU is not mutable"))
+	case "liftedinit.sku.v1.SKU.active":
+		panic(fmt.Errorf("field active of message liftedinit.sku.v1.SKU is not mutable"))
+	case "liftedinit.sku.v1.SKU.capacity":
+		panic(fmt.Errorf("field capacity of message liftedinit.sku.v1.SKU is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.SKU"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.SKU does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SKU) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.SKU.uuid":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.sku.v1.SKU.provider_uuid":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.sku.v1.SKU.name":
+		return protoreflect.ValueOfString("")
+	case "liftedinit.sku.v1.SKU.unit":
+		return protoreflect.ValueOfEnum(0)
+	case "liftedinit.sku.v1.SKU.base_price":
+		m := new(types.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "liftedinit.sku.v1.SKU.meta_hash":
+		return protoreflect.ValueOfBytes(nil)
+	case "liftedinit.sku.v1.SKU.active":
+		return protoreflect.ValueOfBool(false)
+	case "liftedinit.sku.v1.SKU.price_tiers":
+		list := []*PriceTier{}
+		return protoreflect.ValueOfList(&_SKU_8_list{list: &list})
+	case "liftedinit.sku.v1.SKU.capacity":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.sku.v1.SKU.attributes":
+		list := []*Attribute{}
+		return protoreflect.ValueOfList(&_SKU_10_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.SKU"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.SKU does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SKU) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in liftedinit.sku.v1.SKU", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SKU) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SKU) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SKU) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SKU) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SKU)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Uuid)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ProviderUuid)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Unit != 0 {
+			n += 1 + runtime.Sov(uint64(x.Unit))
+		}
+		if x.BasePrice != nil {
+			l = options.Size(x.BasePrice)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MetaHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Active {
+			n += 2
+		}
+		if len(x.PriceTiers) > 0 {
+			for _, e := range x.PriceTiers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Capacity != 0 {
+			n += 1 + runtime.Sov(uint64(x.Capacity))
+		}
+		if len(x.Attributes) > 0 {
+			for _, e := range x.Attributes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SKU)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Attributes) > 0 {
+			for iNdEx := len(x.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Attributes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.Capacity != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Capacity))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.PriceTiers) > 0 {
+			for iNdEx := len(x.PriceTiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PriceTiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if x.Active {
+			i--
+			if x.Active {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.MetaHash) > 0 {
+			i -= len(x.MetaHash)
+			copy(dAtA[i:], x.MetaHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MetaHash)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.BasePrice != nil {
+			encoded, err := options.Marshal(x.BasePrice)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Unit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Unit))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ProviderUuid) > 0 {
+			i -= len(x.ProviderUuid)
+			copy(dAtA[i:], x.ProviderUuid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProviderUuid)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Uuid) > 0 {
+			i -= len(x.Uuid)
+			copy(dAtA[i:], x.Uuid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Uuid)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SKU)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SKU: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SKU: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Uuid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProviderUuid", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProviderUuid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+				}
+				x.Unit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Unit |= Unit(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BasePrice", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BasePrice == nil {
+					x.BasePrice = &types.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BasePrice); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MetaHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MetaHash = append(x.MetaHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.MetaHash == nil {
+					x.MetaHash = []byte{}
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Active = bool(v != 0)
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceTiers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PriceTiers = append(x.PriceTiers, &PriceTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PriceTiers[len(x.PriceTiers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+				}
+				x.Capacity = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Capacity |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Attributes = append(x.Attributes, &Attribute{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Attributes[len(x.Attributes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PriceTier              protoreflect.MessageDescriptor
+	fd_PriceTier_min_quantity protoreflect.FieldDescriptor
+	fd_PriceTier_price        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_liftedinit_sku_v1_types_proto_init()
+	md_PriceTier = File_liftedinit_sku_v1_types_proto.Messages().ByName("PriceTier")
+	fd_PriceTier_min_quantity = md_PriceTier.Fields().ByName("min_quantity")
+	fd_PriceTier_price = md_PriceTier.Fields().ByName("price")
+}
+
+var _ protoreflect.Message = (*fastReflection_PriceTier)(nil)
+
+type fastReflection_PriceTier PriceTier
+
+func (x *PriceTier) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceTier)(x)
+}
+
+func (x *PriceTier) slowProtoReflect() protoreflect.Message {
+	mi := &file_liftedinit_sku_v1_types_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PriceTier_messageType fastReflection_PriceTier_messageType
+var _ protoreflect.MessageType = fastReflection_PriceTier_messageType{}
+
+type fastReflection_PriceTier_messageType struct{}
+
+func (x fastReflection_PriceTier_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceTier)(nil)
+}
+func (x fastReflection_PriceTier_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceTier)
+}
+func (x fastReflection_PriceTier_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceTier
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PriceTier) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceTier
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PriceTier) Type() protoreflect.MessageType {
+	return _fastReflection_PriceTier_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PriceTier) New() protoreflect.Message {
+	return new(fastReflection_PriceTier)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PriceTier) Interface() protoreflect.ProtoMessage {
+	return (*PriceTier)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PriceTier) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MinQuantity != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinQuantity)
+		if !f(fd_PriceTier_min_quantity, value) {
+			return
+		}
+	}
+	if x.Price != nil {
+		value := protoreflect.ValueOfMessage(x.Price.ProtoReflect())
+		if !f(fd_PriceTier_price, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PriceTier) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.PriceTier.min_quantity":
+		return x.MinQuantity != uint64(0)
+	case "liftedinit.sku.v1.PriceTier.price":
+		return x.Price != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.PriceTier"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.PriceTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceTier) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.PriceTier.min_quantity":
+		x.MinQuantity = uint64(0)
+	case "liftedinit.sku.v1.PriceTier.price":
+		x.Price = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.PriceTier"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.PriceTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PriceTier) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "liftedinit.sku.v1.PriceTier.min_quantity":
+		value := x.MinQuantity
+		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.sku.v1.PriceTier.price":
+		value := x.Price
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.PriceTier"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.PriceTier does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceTier) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.PriceTier.min_quantity":
+		x.MinQuantity = value.Uint()
+	case "liftedinit.sku.v1.PriceTier.price":
+		x.Price = value.Message().Interface().(*types.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.PriceTier"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.PriceTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceTier) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.PriceTier.price":
+		if x.Price == nil {
+			x.Price = new(types.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Price.ProtoReflect())
+	case "liftedinit.sku.v1.PriceTier.min_quantity":
+		panic(fmt.Errorf("field min_quantity of message liftedinit.sku.v1.PriceTier is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.PriceTier"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.PriceTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PriceTier) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.PriceTier.min_quantity":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.sku.v1.PriceTier.price":
+		m := new(types.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.PriceTier"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.PriceTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PriceTier) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in liftedinit.sku.v1.PriceTier", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PriceTier) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceTier) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PriceTier) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PriceTier) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PriceTier)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.MinQuantity != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinQuantity))
+		}
+		if x.Price != nil {
+			l = options.Size(x.Price)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PriceTier)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Price != nil {
+			encoded, err := options.Marshal(x.Price)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.MinQuantity != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinQuantity))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PriceTier)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceTier: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceTier: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinQuantity", wireType)
+				}
+				x.MinQuantity = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinQuantity |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Price == nil {
+					x.Price = &types.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Price); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_PriceHistoryEntry_4_list)(nil)
+
+type _PriceHistoryEntry_4_list struct {
+	list *[]*PriceTier
+}
+
+func (x *_PriceHistoryEntry_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PriceHistoryEntry_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PriceHistoryEntry_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PriceHistoryEntry_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PriceHistoryEntry_4_list) AppendMutable() protoreflect.Value {
+	v := new(PriceTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PriceHistoryEntry_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PriceHistoryEntry_4_list) NewElement() protoreflect.Value {
+	v := new(PriceTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PriceHistoryEntry_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PriceHistoryEntry             protoreflect.MessageDescriptor
+	fd_PriceHistoryEntry_id          protoreflect.FieldDescriptor
+	fd_PriceHistoryEntry_sku_uuid    protoreflect.FieldDescriptor
+	fd_PriceHistoryEntry_base_price  protoreflect.FieldDescriptor
+	fd_PriceHistoryEntry_price_tiers protoreflect.FieldDescriptor
+	fd_PriceHistoryEntry_changed_at  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_liftedinit_sku_v1_types_proto_init()
+	md_PriceHistoryEntry = File_liftedinit_sku_v1_types_proto.Messages().ByName("PriceHistoryEntry")
+	fd_PriceHistoryEntry_id = md_PriceHistoryEntry.Fields().ByName("id")
+	fd_PriceHistoryEntry_sku_uuid = md_PriceHistoryEntry.Fields().ByName("sku_uuid")
+	fd_PriceHistoryEntry_base_price = md_PriceHistoryEntry.Fields().ByName("base_price")
+	fd_PriceHistoryEntry_price_tiers = md_PriceHistoryEntry.Fields().ByName("price_tiers")
+	fd_PriceHistoryEntry_changed_at = md_PriceHistoryEntry.Fields().ByName("changed_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_PriceHistoryEntry)(nil)
+
+type fastReflection_PriceHistoryEntry PriceHistoryEntry
+
+func (x *PriceHistoryEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceHistoryEntry)(x)
+}
+
+func (x *PriceHistoryEntry) slowProtoReflect() protoreflect.Message {
+	mi := &file_liftedinit_sku_v1_types_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PriceHistoryEntry_messageType fastReflection_PriceHistoryEntry_messageType
+var _ protoreflect.MessageType = fastReflection_PriceHistoryEntry_messageType{}
+
+type fastReflection_PriceHistoryEntry_messageType struct{}
+
+func (x fastReflection_PriceHistoryEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceHistoryEntry)(nil)
+}
+func (x fastReflection_PriceHistoryEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceHistoryEntry)
+}
+func (x fastReflection_PriceHistoryEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceHistoryEntry
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PriceHistoryEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceHistoryEntry
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PriceHistoryEntry) Type() protoreflect.MessageType {
+	return _fastReflection_PriceHistoryEntry_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PriceHistoryEntry) New() protoreflect.Message {
+	return new(fastReflection_PriceHistoryEntry)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PriceHistoryEntry) Interface() protoreflect.ProtoMessage {
+	return (*PriceHistoryEntry)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PriceHistoryEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_PriceHistoryEntry_id, value) {
+			return
+		}
+	}
+	if x.SkuUuid != "" {
+		value := protoreflect.ValueOfString(x.SkuUuid)
+		if !f(fd_PriceHistoryEntry_sku_uuid, value) {
+			return
+		}
+	}
+	if x.BasePrice != nil {
+		value := protoreflect.ValueOfMessage(x.BasePrice.ProtoReflect())
+		if !f(fd_PriceHistoryEntry_base_price, value) {
+			return
+		}
+	}
+	if len(x.PriceTiers) != 0 {
+		value := protoreflect.ValueOfList(&_PriceHistoryEntry_4_list{list: &x.PriceTiers})
+		if !f(fd_PriceHistoryEntry_price_tiers, value) {
+			return
+		}
+	}
+	if x.ChangedAt != nil {
+		value := protoreflect.ValueOfMessage(x.ChangedAt.ProtoReflect())
+		if !f(fd_PriceHistoryEntry_changed_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PriceHistoryEntry) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.PriceHistoryEntry.id":
+		return x.Id != uint64(0)
+	case "liftedinit.sku.v1.PriceHistoryEntry.sku_uuid":
+		return x.SkuUuid != ""
+	case "liftedinit.sku.v1.PriceHistoryEntry.base_price":
+		return x.BasePrice != nil
+	case "liftedinit.sku.v1.PriceHistoryEntry.price_tiers":
+		return len(x.PriceTiers) != 0
+	case "liftedinit.sku.v1.PriceHistoryEntry.changed_at":
+		return x.ChangedAt != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Provider"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.PriceHistoryEntry"))
 		}
-		panic(fmt.Errorf("message liftedinit.sku.v1.Provider does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message liftedinit.sku.v1.PriceHistoryEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceHistoryEntry) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "liftedinit.sku.v1.PriceHistoryEntry.id":
+		x.Id = uint64(0)
+	case "liftedinit.sku.v1.PriceHistoryEntry.sku_uuid":
+		x.SkuUuid = ""
+	case "liftedinit.sku.v1.PriceHistoryEntry.base_price":
+		x.BasePrice = nil
+	case "liftedinit.sku.v1.PriceHistoryEntry.price_tiers":
+		x.PriceTiers = nil
+	case "liftedinit.sku.v1.PriceHistoryEntry.changed_at":
+		x.ChangedAt = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.PriceHistoryEntry"))
+		}
+		panic(fmt.Errorf("message liftedinit.sku.v1.PriceHistoryEntry does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -687,31 +4289,31 @@ func (x *fastReflection_Provider) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Provider) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceHistoryEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "liftedinit.sku.v1.Provider.uuid":
-		value := x.Uuid
-		return protoreflect.ValueOfString(value)
-	case "liftedinit.sku.v1.Provider.address":
-		value := x.Address
-		return protoreflect.ValueOfString(value)
-	case "liftedinit.sku.v1.Provider.payout_address":
-		value := x.PayoutAddress
-		return protoreflect.ValueOfString(value)
-	case "liftedinit.sku.v1.Provider.meta_hash":
-		value := x.MetaHash
-		return protoreflect.ValueOfBytes(value)
-	case "liftedinit.sku.v1.Provider.active":
-		value := x.Active
-		return protoreflect.ValueOfBool(value)
-	case "liftedinit.sku.v1.Provider.api_url":
-		value := x.ApiUrl
+	case "liftedinit.sku.v1.PriceHistoryEntry.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "liftedinit.sku.v1.PriceHistoryEntry.sku_uuid":
+		value := x.SkuUuid
 		return protoreflect.ValueOfString(value)
+	case "liftedinit.sku.v1.PriceHistoryEntry.base_price":
+		value := x.BasePrice
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "liftedinit.sku.v1.PriceHistoryEntry.price_tiers":
+		if len(x.PriceTiers) == 0 {
+			return protoreflect.ValueOfList(&_PriceHistoryEntry_4_list{})
+		}
+		listValue := &_PriceHistoryEntry_4_list{list: &x.PriceTiers}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.sku.v1.PriceHistoryEntry.changed_at":
+		value := x.ChangedAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Provider"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.PriceHistoryEntry"))
 		}
-		panic(fmt.Errorf("message liftedinit.sku.v1.Provider does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message liftedinit.sku.v1.PriceHistoryEntry does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -725,25 +4327,25 @@ func (x *fastReflection_Provider) Get(descriptor protoreflect.FieldDescriptor) p
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Provider) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PriceHistoryEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "liftedinit.sku.v1.Provider.uuid":
-		x.Uuid = value.Interface().(string)
-	case "liftedinit.sku.v1.Provider.address":
-		x.Address = value.Interface().(string)
-	case "liftedinit.sku.v1.Provider.payout_address":
-		x.PayoutAddress = value.Interface().(string)
-	case "liftedinit.sku.v1.Provider.meta_hash":
-		x.MetaHash = value.Bytes()
-	case "liftedinit.sku.v1.Provider.active":
-		x.Active = value.Bool()
-	case "liftedinit.sku.v1.Provider.api_url":
-		x.ApiUrl = value.Interface().(string)
+	case "liftedinit.sku.v1.PriceHistoryEntry.id":
+		x.Id = value.Uint()
+	case "liftedinit.sku.v1.PriceHistoryEntry.sku_uuid":
+		x.SkuUuid = value.Interface().(string)
+	case "liftedinit.sku.v1.PriceHistoryEntry.base_price":
+		x.BasePrice = value.Message().Interface().(*types.Coin)
+	case "liftedinit.sku.v1.PriceHistoryEntry.price_tiers":
+		lv := value.List()
+		clv := lv.(*_PriceHistoryEntry_4_list)
+		x.PriceTiers = *clv.list
+	case "liftedinit.sku.v1.PriceHistoryEntry.changed_at":
+		x.ChangedAt = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Provider"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.PriceHistoryEntry"))
 		}
-		panic(fmt.Errorf("message liftedinit.sku.v1.Provider does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message liftedinit.sku.v1.PriceHistoryEntry does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -757,60 +4359,69 @@ func (x *fastReflection_Provider) Set(fd protoreflect.FieldDescriptor, value pro
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Provider) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceHistoryEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "liftedinit.sku.v1.Provider.uuid":
-		panic(fmt.Errorf("field uuid of message liftedinit.sku.v1.Provider is not mutable"))
-	case "liftedinit.sku.v1.Provider.address":
-		panic(fmt.Errorf("field address of message liftedinit.sku.v1.Provider is not mutable"))
-	case "liftedinit.sku.v1.Provider.payout_address":
-		panic(fmt.Errorf("field payout_address of message liftedinit.sku.v1.Provider is not mutable"))
-	case "liftedinit.sku.v1.Provider.meta_hash":
-		panic(fmt.Errorf("field meta_hash of message liftedinit.sku.v1.Provider is not mutable"))
-	case "liftedinit.sku.v1.Provider.active":
-		panic(fmt.Errorf("field active of message liftedinit.sku.v1.Provider is not mutable"))
-	case "liftedinit.sku.v1.Provider.api_url":
-		panic(fmt.Errorf("field api_url of message liftedinit.sku.v1.Provider is not mutable"))
+	case "liftedinit.sku.v1.PriceHistoryEntry.base_price":
+		if x.BasePrice == nil {
+			x.BasePrice = new(types.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.BasePrice.ProtoReflect())
+	case "liftedinit.sku.v1.PriceHistoryEntry.price_tiers":
+		if x.PriceTiers == nil {
+			x.PriceTiers = []*PriceTier{}
+		}
+		value := &_PriceHistoryEntry_4_list{list: &x.PriceTiers}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.sku.v1.PriceHistoryEntry.changed_at":
+		if x.ChangedAt == nil {
+			x.ChangedAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ChangedAt.ProtoReflect())
+	case "liftedinit.sku.v1.PriceHistoryEntry.id":
+		panic(fmt.Errorf("field id of message liftedinit.sku.v1.PriceHistoryEntry is not mutable"))
+	case "liftedinit.sku.v1.PriceHistoryEntry.sku_uuid":
+		panic(fmt.Errorf("field sku_uuid of message liftedinit.sku.v1.PriceHistoryEntry is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Provider"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.PriceHistoryEntry"))
 		}
-		panic(fmt.Errorf("message liftedinit.sku.v1.Provider does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message liftedinit.sku.v1.PriceHistoryEntry does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Provider) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceHistoryEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "liftedinit.sku.v1.Provider.uuid":
-		return protoreflect.ValueOfString("")
-	case "liftedinit.sku.v1.Provider.address":
-		return protoreflect.ValueOfString("")
-	case "liftedinit.sku.v1.Provider.payout_address":
-		return protoreflect.ValueOfString("")
-	case "liftedinit.sku.v1.Provider.meta_hash":
-		return protoreflect.ValueOfBytes(nil)
-	case "liftedinit.sku.v1.Provider.active":
-		return protoreflect.ValueOfBool(false)
-	case "liftedinit.sku.v1.Provider.api_url":
+	case "liftedinit.sku.v1.PriceHistoryEntry.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "liftedinit.sku.v1.PriceHistoryEntry.sku_uuid":
 		return protoreflect.ValueOfString("")
+	case "liftedinit.sku.v1.PriceHistoryEntry.base_price":
+		m := new(types.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "liftedinit.sku.v1.PriceHistoryEntry.price_tiers":
+		list := []*PriceTier{}
+		return protoreflect.ValueOfList(&_PriceHistoryEntry_4_list{list: &list})
+	case "liftedinit.sku.v1.PriceHistoryEntry.changed_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.Provider"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.PriceHistoryEntry"))
 		}
-		panic(fmt.Errorf("message liftedinit.sku.v1.Provider does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message liftedinit.sku.v1.PriceHistoryEntry does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Provider) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PriceHistoryEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in liftedinit.sku.v1.Provider", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in liftedinit.sku.v1.PriceHistoryEntry", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -818,7 +4429,7 @@ func (x *fastReflection_Provider) WhichOneof(d protoreflect.OneofDescriptor) pro
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Provider) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PriceHistoryEntry) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -829,7 +4440,7 @@ func (x *fastReflection_Provider) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Provider) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PriceHistoryEntry) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -841,7 +4452,7 @@ func (x *fastReflection_Provider) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Provider) IsValid() bool {
+func (x *fastReflection_PriceHistoryEntry) IsValid() bool {
 	return x != nil
 }
 
@@ -851,9 +4462,9 @@ func (x *fastReflection_Provider) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Provider) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PriceHistoryEntry) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Provider)
+		x := input.Message.Interface().(*PriceHistoryEntry)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -865,27 +4476,25 @@ func (x *fastReflection_Provider) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Uuid)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Address)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
 		}
-		l = len(x.PayoutAddress)
+		l = len(x.SkuUuid)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.MetaHash)
-		if l > 0 {
+		if x.BasePrice != nil {
+			l = options.Size(x.BasePrice)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Active {
-			n += 2
+		if len(x.PriceTiers) > 0 {
+			for _, e := range x.PriceTiers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.ApiUrl)
-		if l > 0 {
+		if x.ChangedAt != nil {
+			l = options.Size(x.ChangedAt)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -898,7 +4507,7 @@ func (x *fastReflection_Provider) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Provider)
+		x := input.Message.Interface().(*PriceHistoryEntry)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -917,50 +4526,61 @@ func (x *fastReflection_Provider) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ApiUrl) > 0 {
-			i -= len(x.ApiUrl)
-			copy(dAtA[i:], x.ApiUrl)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ApiUrl)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if x.Active {
-			i--
-			if x.Active {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
+		if x.ChangedAt != nil {
+			encoded, err := options.Marshal(x.ChangedAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x28
+			dAtA[i] = 0x2a
 		}
-		if len(x.MetaHash) > 0 {
-			i -= len(x.MetaHash)
-			copy(dAtA[i:], x.MetaHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MetaHash)))
-			i--
-			dAtA[i] = 0x22
+		if len(x.PriceTiers) > 0 {
+			for iNdEx := len(x.PriceTiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PriceTiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
 		}
-		if len(x.PayoutAddress) > 0 {
-			i -= len(x.PayoutAddress)
-			copy(dAtA[i:], x.PayoutAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PayoutAddress)))
+		if x.BasePrice != nil {
+			encoded, err := options.Marshal(x.BasePrice)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+		if len(x.SkuUuid) > 0 {
+			i -= len(x.SkuUuid)
+			copy(dAtA[i:], x.SkuUuid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SkuUuid)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Uuid) > 0 {
-			i -= len(x.Uuid)
-			copy(dAtA[i:], x.Uuid)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Uuid)))
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -973,7 +4593,7 @@ func (x *fastReflection_Provider) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Provider)
+		x := input.Message.Interface().(*PriceHistoryEntry)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1005,17 +4625,17 @@ func (x *fastReflection_Provider) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Provider: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceHistoryEntry: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Provider: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 				}
-				var stringLen uint64
+				x.Id = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1025,27 +4645,14 @@ func (x *fastReflection_Provider) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Id |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Uuid = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SkuUuid", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1073,13 +4680,13 @@ func (x *fastReflection_Provider) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Address = string(dAtA[iNdEx:postIndex])
+				x.SkuUuid = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PayoutAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BasePrice", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1089,63 +4696,33 @@ func (x *fastReflection_Provider) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PayoutAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MetaHash", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if x.BasePrice == nil {
+					x.BasePrice = &types.Coin{}
 				}
-				x.MetaHash = append(x.MetaHash[:0], dAtA[iNdEx:postIndex]...)
-				if x.MetaHash == nil {
-					x.MetaHash = []byte{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BasePrice); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx = postIndex
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceTiers", wireType)
 				}
-				var v int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1155,17 +4732,31 @@ func (x *fastReflection_Provider) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.Active = bool(v != 0)
-			case 6:
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PriceTiers = append(x.PriceTiers, &PriceTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PriceTiers[len(x.PriceTiers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ApiUrl", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChangedAt", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1175,23 +4766,27 @@ func (x *fastReflection_Provider) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ApiUrl = string(dAtA[iNdEx:postIndex])
+				if x.ChangedAt == nil {
+					x.ChangedAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ChangedAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1228,39 +4823,88 @@ func (x *fastReflection_Provider) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_PendingPriceChange_3_list)(nil)
+
+type _PendingPriceChange_3_list struct {
+	list *[]*PriceTier
+}
+
+func (x *_PendingPriceChange_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PendingPriceChange_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PendingPriceChange_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PendingPriceChange_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PendingPriceChange_3_list) AppendMutable() protoreflect.Value {
+	v := new(PriceTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PendingPriceChange_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PendingPriceChange_3_list) NewElement() protoreflect.Value {
+	v := new(PriceTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PendingPriceChange_3_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_SKU               protoreflect.MessageDescriptor
-	fd_SKU_uuid          protoreflect.FieldDescriptor
-	fd_SKU_provider_uuid protoreflect.FieldDescriptor
-	fd_SKU_name          protoreflect.FieldDescriptor
-	fd_SKU_unit          protoreflect.FieldDescriptor
-	fd_SKU_base_price    protoreflect.FieldDescriptor
-	fd_SKU_meta_hash     protoreflect.FieldDescriptor
-	fd_SKU_active        protoreflect.FieldDescriptor
+	md_PendingPriceChange              protoreflect.MessageDescriptor
+	fd_PendingPriceChange_sku_uuid     protoreflect.FieldDescriptor
+	fd_PendingPriceChange_base_price   protoreflect.FieldDescriptor
+	fd_PendingPriceChange_price_tiers  protoreflect.FieldDescriptor
+	fd_PendingPriceChange_effective_at protoreflect.FieldDescriptor
+	fd_PendingPriceChange_scheduled_at protoreflect.FieldDescriptor
+	fd_PendingPriceChange_scheduled_by protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_liftedinit_sku_v1_types_proto_init()
-	md_SKU = File_liftedinit_sku_v1_types_proto.Messages().ByName("SKU")
-	fd_SKU_uuid = md_SKU.Fields().ByName("uuid")
-	fd_SKU_provider_uuid = md_SKU.Fields().ByName("provider_uuid")
-	fd_SKU_name = md_SKU.Fields().ByName("name")
-	fd_SKU_unit = md_SKU.Fields().ByName("unit")
-	fd_SKU_base_price = md_SKU.Fields().ByName("base_price")
-	fd_SKU_meta_hash = md_SKU.Fields().ByName("meta_hash")
-	fd_SKU_active = md_SKU.Fields().ByName("active")
+	md_PendingPriceChange = File_liftedinit_sku_v1_types_proto.Messages().ByName("PendingPriceChange")
+	fd_PendingPriceChange_sku_uuid = md_PendingPriceChange.Fields().ByName("sku_uuid")
+	fd_PendingPriceChange_base_price = md_PendingPriceChange.Fields().ByName("base_price")
+	fd_PendingPriceChange_price_tiers = md_PendingPriceChange.Fields().ByName("price_tiers")
+	fd_PendingPriceChange_effective_at = md_PendingPriceChange.Fields().ByName("effective_at")
+	fd_PendingPriceChange_scheduled_at = md_PendingPriceChange.Fields().ByName("scheduled_at")
+	fd_PendingPriceChange_scheduled_by = md_PendingPriceChange.Fields().ByName("scheduled_by")
 }
 
-var _ protoreflect.Message = (*fastReflection_SKU)(nil)
+var _ protoreflect.Message = (*fastReflection_PendingPriceChange)(nil)
 
-type fastReflection_SKU SKU
+type fastReflection_PendingPriceChange PendingPriceChange
 
-func (x *SKU) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SKU)(x)
+func (x *PendingPriceChange) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PendingPriceChange)(x)
 }
 
-func (x *SKU) slowProtoReflect() protoreflect.Message {
-	mi := &file_liftedinit_sku_v1_types_proto_msgTypes[2]
+func (x *PendingPriceChange) slowProtoReflect() protoreflect.Message {
+	mi := &file_liftedinit_sku_v1_types_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1271,43 +4915,43 @@ func (x *SKU) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SKU_messageType fastReflection_SKU_messageType
-var _ protoreflect.MessageType = fastReflection_SKU_messageType{}
+var _fastReflection_PendingPriceChange_messageType fastReflection_PendingPriceChange_messageType
+var _ protoreflect.MessageType = fastReflection_PendingPriceChange_messageType{}
 
-type fastReflection_SKU_messageType struct{}
+type fastReflection_PendingPriceChange_messageType struct{}
 
-func (x fastReflection_SKU_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SKU)(nil)
+func (x fastReflection_PendingPriceChange_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PendingPriceChange)(nil)
 }
-func (x fastReflection_SKU_messageType) New() protoreflect.Message {
-	return new(fastReflection_SKU)
+func (x fastReflection_PendingPriceChange_messageType) New() protoreflect.Message {
+	return new(fastReflection_PendingPriceChange)
 }
-func (x fastReflection_SKU_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SKU
+func (x fastReflection_PendingPriceChange_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingPriceChange
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SKU) Descriptor() protoreflect.MessageDescriptor {
-	return md_SKU
+func (x *fastReflection_PendingPriceChange) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingPriceChange
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SKU) Type() protoreflect.MessageType {
-	return _fastReflection_SKU_messageType
+func (x *fastReflection_PendingPriceChange) Type() protoreflect.MessageType {
+	return _fastReflection_PendingPriceChange_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SKU) New() protoreflect.Message {
-	return new(fastReflection_SKU)
+func (x *fastReflection_PendingPriceChange) New() protoreflect.Message {
+	return new(fastReflection_PendingPriceChange)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SKU) Interface() protoreflect.ProtoMessage {
-	return (*SKU)(x)
+func (x *fastReflection_PendingPriceChange) Interface() protoreflect.ProtoMessage {
+	return (*PendingPriceChange)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1315,46 +4959,40 @@ func (x *fastReflection_SKU) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SKU) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Uuid != "" {
-		value := protoreflect.ValueOfString(x.Uuid)
-		if !f(fd_SKU_uuid, value) {
-			return
-		}
-	}
-	if x.ProviderUuid != "" {
-		value := protoreflect.ValueOfString(x.ProviderUuid)
-		if !f(fd_SKU_provider_uuid, value) {
+func (x *fastReflection_PendingPriceChange) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SkuUuid != "" {
+		value := protoreflect.ValueOfString(x.SkuUuid)
+		if !f(fd_PendingPriceChange_sku_uuid, value) {
 			return
 		}
 	}
-	if x.Name != "" {
-		value := protoreflect.ValueOfString(x.Name)
-		if !f(fd_SKU_name, value) {
+	if x.BasePrice != nil {
+		value := protoreflect.ValueOfMessage(x.BasePrice.ProtoReflect())
+		if !f(fd_PendingPriceChange_base_price, value) {
 			return
 		}
 	}
-	if x.Unit != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Unit))
-		if !f(fd_SKU_unit, value) {
+	if len(x.PriceTiers) != 0 {
+		value := protoreflect.ValueOfList(&_PendingPriceChange_3_list{list: &x.PriceTiers})
+		if !f(fd_PendingPriceChange_price_tiers, value) {
 			return
 		}
 	}
-	if x.BasePrice != nil {
-		value := protoreflect.ValueOfMessage(x.BasePrice.ProtoReflect())
-		if !f(fd_SKU_base_price, value) {
+	if x.EffectiveAt != nil {
+		value := protoreflect.ValueOfMessage(x.EffectiveAt.ProtoReflect())
+		if !f(fd_PendingPriceChange_effective_at, value) {
 			return
 		}
 	}
-	if len(x.MetaHash) != 0 {
-		value := protoreflect.ValueOfBytes(x.MetaHash)
-		if !f(fd_SKU_meta_hash, value) {
+	if x.ScheduledAt != nil {
+		value := protoreflect.ValueOfMessage(x.ScheduledAt.ProtoReflect())
+		if !f(fd_PendingPriceChange_scheduled_at, value) {
 			return
 		}
 	}
-	if x.Active != false {
-		value := protoreflect.ValueOfBool(x.Active)
-		if !f(fd_SKU_active, value) {
+	if x.ScheduledBy != "" {
+		value := protoreflect.ValueOfString(x.ScheduledBy)
+		if !f(fd_PendingPriceChange_scheduled_by, value) {
 			return
 		}
 	}
@@ -1371,27 +5009,25 @@ func (x *fastReflection_SKU) Range(f func(protoreflect.FieldDescriptor, protoref
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SKU) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_PendingPriceChange) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "liftedinit.sku.v1.SKU.uuid":
-		return x.Uuid != ""
-	case "liftedinit.sku.v1.SKU.provider_uuid":
-		return x.ProviderUuid != ""
-	case "liftedinit.sku.v1.SKU.name":
-		return x.Name != ""
-	case "liftedinit.sku.v1.SKU.unit":
-		return x.Unit != 0
-	case "liftedinit.sku.v1.SKU.base_price":
+	case "liftedinit.sku.v1.PendingPriceChange.sku_uuid":
+		return x.SkuUuid != ""
+	case "liftedinit.sku.v1.PendingPriceChange.base_price":
 		return x.BasePrice != nil
-	case "liftedinit.sku.v1.SKU.meta_hash":
-		return len(x.MetaHash) != 0
-	case "liftedinit.sku.v1.SKU.active":
-		return x.Active != false
+	case "liftedinit.sku.v1.PendingPriceChange.price_tiers":
+		return len(x.PriceTiers) != 0
+	case "liftedinit.sku.v1.PendingPriceChange.effective_at":
+		return x.EffectiveAt != nil
+	case "liftedinit.sku.v1.PendingPriceChange.scheduled_at":
+		return x.ScheduledAt != nil
+	case "liftedinit.sku.v1.PendingPriceChange.scheduled_by":
+		return x.ScheduledBy != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.SKU"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.PendingPriceChange"))
 		}
-		panic(fmt.Errorf("message liftedinit.sku.v1.SKU does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message liftedinit.sku.v1.PendingPriceChange does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1401,27 +5037,25 @@ func (x *fastReflection_SKU) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SKU) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PendingPriceChange) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "liftedinit.sku.v1.SKU.uuid":
-		x.Uuid = ""
-	case "liftedinit.sku.v1.SKU.provider_uuid":
-		x.ProviderUuid = ""
-	case "liftedinit.sku.v1.SKU.name":
-		x.Name = ""
-	case "liftedinit.sku.v1.SKU.unit":
-		x.Unit = 0
-	case "liftedinit.sku.v1.SKU.base_price":
+	case "liftedinit.sku.v1.PendingPriceChange.sku_uuid":
+		x.SkuUuid = ""
+	case "liftedinit.sku.v1.PendingPriceChange.base_price":
 		x.BasePrice = nil
-	case "liftedinit.sku.v1.SKU.meta_hash":
-		x.MetaHash = nil
-	case "liftedinit.sku.v1.SKU.active":
-		x.Active = false
+	case "liftedinit.sku.v1.PendingPriceChange.price_tiers":
+		x.PriceTiers = nil
+	case "liftedinit.sku.v1.PendingPriceChange.effective_at":
+		x.EffectiveAt = nil
+	case "liftedinit.sku.v1.PendingPriceChange.scheduled_at":
+		x.ScheduledAt = nil
+	case "liftedinit.sku.v1.PendingPriceChange.scheduled_by":
+		x.ScheduledBy = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.SKU"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.PendingPriceChange"))
 		}
-		panic(fmt.Errorf("message liftedinit.sku.v1.SKU does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message liftedinit.sku.v1.PendingPriceChange does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1431,34 +5065,34 @@ func (x *fastReflection_SKU) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SKU) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PendingPriceChange) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "liftedinit.sku.v1.SKU.uuid":
-		value := x.Uuid
-		return protoreflect.ValueOfString(value)
-	case "liftedinit.sku.v1.SKU.provider_uuid":
-		value := x.ProviderUuid
-		return protoreflect.ValueOfString(value)
-	case "liftedinit.sku.v1.SKU.name":
-		value := x.Name
+	case "liftedinit.sku.v1.PendingPriceChange.sku_uuid":
+		value := x.SkuUuid
 		return protoreflect.ValueOfString(value)
-	case "liftedinit.sku.v1.SKU.unit":
-		value := x.Unit
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "liftedinit.sku.v1.SKU.base_price":
+	case "liftedinit.sku.v1.PendingPriceChange.base_price":
 		value := x.BasePrice
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "liftedinit.sku.v1.SKU.meta_hash":
-		value := x.MetaHash
-		return protoreflect.ValueOfBytes(value)
-	case "liftedinit.sku.v1.SKU.active":
-		value := x.Active
-		return protoreflect.ValueOfBool(value)
+	case "liftedinit.sku.v1.PendingPriceChange.price_tiers":
+		if len(x.PriceTiers) == 0 {
+			return protoreflect.ValueOfList(&_PendingPriceChange_3_list{})
+		}
+		listValue := &_PendingPriceChange_3_list{list: &x.PriceTiers}
+		return protoreflect.ValueOfList(listValue)
+	case "liftedinit.sku.v1.PendingPriceChange.effective_at":
+		value := x.EffectiveAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "liftedinit.sku.v1.PendingPriceChange.scheduled_at":
+		value := x.ScheduledAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "liftedinit.sku.v1.PendingPriceChange.scheduled_by":
+		value := x.ScheduledBy
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.SKU"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.PendingPriceChange"))
 		}
-		panic(fmt.Errorf("message liftedinit.sku.v1.SKU does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message liftedinit.sku.v1.PendingPriceChange does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1472,27 +5106,27 @@ func (x *fastReflection_SKU) Get(descriptor protoreflect.FieldDescriptor) protor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SKU) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PendingPriceChange) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "liftedinit.sku.v1.SKU.uuid":
-		x.Uuid = value.Interface().(string)
-	case "liftedinit.sku.v1.SKU.provider_uuid":
-		x.ProviderUuid = value.Interface().(string)
-	case "liftedinit.sku.v1.SKU.name":
-		x.Name = value.Interface().(string)
-	case "liftedinit.sku.v1.SKU.unit":
-		x.Unit = (Unit)(value.Enum())
-	case "liftedinit.sku.v1.SKU.base_price":
+	case "liftedinit.sku.v1.PendingPriceChange.sku_uuid":
+		x.SkuUuid = value.Interface().(string)
+	case "liftedinit.sku.v1.PendingPriceChange.base_price":
 		x.BasePrice = value.Message().Interface().(*types.Coin)
-	case "liftedinit.sku.v1.SKU.meta_hash":
-		x.MetaHash = value.Bytes()
-	case "liftedinit.sku.v1.SKU.active":
-		x.Active = value.Bool()
+	case "liftedinit.sku.v1.PendingPriceChange.price_tiers":
+		lv := value.List()
+		clv := lv.(*_PendingPriceChange_3_list)
+		x.PriceTiers = *clv.list
+	case "liftedinit.sku.v1.PendingPriceChange.effective_at":
+		x.EffectiveAt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "liftedinit.sku.v1.PendingPriceChange.scheduled_at":
+		x.ScheduledAt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "liftedinit.sku.v1.PendingPriceChange.scheduled_by":
+		x.ScheduledBy = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.SKU"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.PendingPriceChange"))
 		}
-		panic(fmt.Errorf("message liftedinit.sku.v1.SKU does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message liftedinit.sku.v1.PendingPriceChange does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1506,68 +5140,77 @@ func (x *fastReflection_SKU) Set(fd protoreflect.FieldDescriptor, value protoref
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SKU) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PendingPriceChange) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "liftedinit.sku.v1.SKU.base_price":
+	case "liftedinit.sku.v1.PendingPriceChange.base_price":
 		if x.BasePrice == nil {
 			x.BasePrice = new(types.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.BasePrice.ProtoReflect())
-	case "liftedinit.sku.v1.SKU.uuid":
-		panic(fmt.Errorf("field uuid of message liftedinit.sku.v1.SKU is not mutable"))
-	case "liftedinit.sku.v1.SKU.provider_uuid":
-		panic(fmt.Errorf("field provider_uuid of message liftedinit.sku.v1.SKU is not mutable"))
-	case "liftedinit.sku.v1.SKU.name":
-		panic(fmt.Errorf("field name of message liftedinit.sku.v1.SKU is not mutable"))
-	case "liftedinit.sku.v1.SKU.unit":
-		panic(fmt.Errorf("field unit of message liftedinit.sku.v1.SKU is not mutable"))
-	case "liftedinit.sku.v1.SKU.meta_hash":
-		panic(fmt.Errorf("field meta_hash of message liftedinit.sku.v1.SKU is not mutable"))
-	case "liftedinit.sku.v1.SKU.active":
-		panic(fmt.Errorf("field active of message liftedinit.sku.v1.SKU is not mutable"))
+	case "liftedinit.sku.v1.PendingPriceChange.price_tiers":
+		if x.PriceTiers == nil {
+			x.PriceTiers = []*PriceTier{}
+		}
+		value := &_PendingPriceChange_3_list{list: &x.PriceTiers}
+		return protoreflect.ValueOfList(value)
+	case "liftedinit.sku.v1.PendingPriceChange.effective_at":
+		if x.EffectiveAt == nil {
+			x.EffectiveAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.EffectiveAt.ProtoReflect())
+	case "liftedinit.sku.v1.PendingPriceChange.scheduled_at":
+		if x.ScheduledAt == nil {
+			x.ScheduledAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ScheduledAt.ProtoReflect())
+	case "liftedinit.sku.v1.PendingPriceChange.sku_uuid":
+		panic(fmt.Errorf("field sku_uuid of message liftedinit.sku.v1.PendingPriceChange is not mutable"))
+	case "liftedinit.sku.v1.PendingPriceChange.scheduled_by":
+		panic(fmt.Errorf("field scheduled_by of message liftedinit.sku.v1.PendingPriceChange is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.SKU"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.PendingPriceChange"))
 		}
-		panic(fmt.Errorf("message liftedinit.sku.v1.SKU does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message liftedinit.sku.v1.PendingPriceChange does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SKU) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PendingPriceChange) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "liftedinit.sku.v1.SKU.uuid":
-		return protoreflect.ValueOfString("")
-	case "liftedinit.sku.v1.SKU.provider_uuid":
-		return protoreflect.ValueOfString("")
-	case "liftedinit.sku.v1.SKU.name":
+	case "liftedinit.sku.v1.PendingPriceChange.sku_uuid":
 		return protoreflect.ValueOfString("")
-	case "liftedinit.sku.v1.SKU.unit":
-		return protoreflect.ValueOfEnum(0)
-	case "liftedinit.sku.v1.SKU.base_price":
+	case "liftedinit.sku.v1.PendingPriceChange.base_price":
 		m := new(types.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "liftedinit.sku.v1.SKU.meta_hash":
-		return protoreflect.ValueOfBytes(nil)
-	case "liftedinit.sku.v1.SKU.active":
-		return protoreflect.ValueOfBool(false)
+	case "liftedinit.sku.v1.PendingPriceChange.price_tiers":
+		list := []*PriceTier{}
+		return protoreflect.ValueOfList(&_PendingPriceChange_3_list{list: &list})
+	case "liftedinit.sku.v1.PendingPriceChange.effective_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "liftedinit.sku.v1.PendingPriceChange.scheduled_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "liftedinit.sku.v1.PendingPriceChange.scheduled_by":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.SKU"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: liftedinit.sku.v1.PendingPriceChange"))
 		}
-		panic(fmt.Errorf("message liftedinit.sku.v1.SKU does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message liftedinit.sku.v1.PendingPriceChange does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SKU) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PendingPriceChange) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in liftedinit.sku.v1.SKU", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in liftedinit.sku.v1.PendingPriceChange", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1575,7 +5218,7 @@ func (x *fastReflection_SKU) WhichOneof(d protoreflect.OneofDescriptor) protoref
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SKU) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PendingPriceChange) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1586,7 +5229,7 @@ func (x *fastReflection_SKU) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SKU) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PendingPriceChange) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1598,7 +5241,7 @@ func (x *fastReflection_SKU) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SKU) IsValid() bool {
+func (x *fastReflection_PendingPriceChange) IsValid() bool {
 	return x != nil
 }
 
@@ -1608,9 +5251,9 @@ func (x *fastReflection_SKU) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SKU) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PendingPriceChange) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SKU)
+		x := input.Message.Interface().(*PendingPriceChange)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1622,31 +5265,31 @@ func (x *fastReflection_SKU) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Uuid)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ProviderUuid)
+		l = len(x.SkuUuid)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Name)
-		if l > 0 {
+		if x.BasePrice != nil {
+			l = options.Size(x.BasePrice)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Unit != 0 {
-			n += 1 + runtime.Sov(uint64(x.Unit))
+		if len(x.PriceTiers) > 0 {
+			for _, e := range x.PriceTiers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		if x.BasePrice != nil {
-			l = options.Size(x.BasePrice)
+		if x.EffectiveAt != nil {
+			l = options.Size(x.EffectiveAt)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.MetaHash)
-		if l > 0 {
+		if x.ScheduledAt != nil {
+			l = options.Size(x.ScheduledAt)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Active {
-			n += 2
+		l = len(x.ScheduledBy)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1658,7 +5301,7 @@ func (x *fastReflection_SKU) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SKU)
+		x := input.Message.Interface().(*PendingPriceChange)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1677,25 +5320,15 @@ func (x *fastReflection_SKU) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Active {
-			i--
-			if x.Active {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x38
-		}
-		if len(x.MetaHash) > 0 {
-			i -= len(x.MetaHash)
-			copy(dAtA[i:], x.MetaHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MetaHash)))
+		if len(x.ScheduledBy) > 0 {
+			i -= len(x.ScheduledBy)
+			copy(dAtA[i:], x.ScheduledBy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ScheduledBy)))
 			i--
 			dAtA[i] = 0x32
 		}
-		if x.BasePrice != nil {
-			encoded, err := options.Marshal(x.BasePrice)
+		if x.ScheduledAt != nil {
+			encoded, err := options.Marshal(x.ScheduledAt)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1708,29 +5341,54 @@ func (x *fastReflection_SKU) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x2a
 		}
-		if x.Unit != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Unit))
+		if x.EffectiveAt != nil {
+			encoded, err := options.Marshal(x.EffectiveAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x20
+			dAtA[i] = 0x22
 		}
-		if len(x.Name) > 0 {
-			i -= len(x.Name)
-			copy(dAtA[i:], x.Name)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
-			i--
-			dAtA[i] = 0x1a
+		if len(x.PriceTiers) > 0 {
+			for iNdEx := len(x.PriceTiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PriceTiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
 		}
-		if len(x.ProviderUuid) > 0 {
-			i -= len(x.ProviderUuid)
-			copy(dAtA[i:], x.ProviderUuid)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProviderUuid)))
+		if x.BasePrice != nil {
+			encoded, err := options.Marshal(x.BasePrice)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Uuid) > 0 {
-			i -= len(x.Uuid)
-			copy(dAtA[i:], x.Uuid)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Uuid)))
+		if len(x.SkuUuid) > 0 {
+			i -= len(x.SkuUuid)
+			copy(dAtA[i:], x.SkuUuid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SkuUuid)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -1745,7 +5403,7 @@ func (x *fastReflection_SKU) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SKU)
+		x := input.Message.Interface().(*PendingPriceChange)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1777,15 +5435,15 @@ func (x *fastReflection_SKU) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SKU: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingPriceChange: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SKU: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingPriceChange: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SkuUuid", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1813,13 +5471,13 @@ func (x *fastReflection_SKU) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Uuid = string(dAtA[iNdEx:postIndex])
+				x.SkuUuid = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProviderUuid", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BasePrice", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1829,29 +5487,33 @@ func (x *fastReflection_SKU) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ProviderUuid = string(dAtA[iNdEx:postIndex])
+				if x.BasePrice == nil {
+					x.BasePrice = &types.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BasePrice); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceTiers", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1861,29 +5523,31 @@ func (x *fastReflection_SKU) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Name = string(dAtA[iNdEx:postIndex])
+				x.PriceTiers = append(x.PriceTiers, &PriceTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PriceTiers[len(x.PriceTiers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EffectiveAt", wireType)
 				}
-				x.Unit = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1893,14 +5557,31 @@ func (x *fastReflection_SKU) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Unit |= Unit(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EffectiveAt == nil {
+					x.EffectiveAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EffectiveAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BasePrice", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1927,18 +5608,18 @@ func (x *fastReflection_SKU) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.BasePrice == nil {
-					x.BasePrice = &types.Coin{}
+				if x.ScheduledAt == nil {
+					x.ScheduledAt = &timestamppb.Timestamp{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BasePrice); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ScheduledAt); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MetaHash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScheduledBy", wireType)
 				}
-				var byteLen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1948,46 +5629,24 @@ func (x *fastReflection_SKU) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MetaHash = append(x.MetaHash[:0], dAtA[iNdEx:postIndex]...)
-				if x.MetaHash == nil {
-					x.MetaHash = []byte{}
-				}
+				x.ScheduledBy = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 7:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.Active = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2046,47 +5705,215 @@ const (
 	Unit_UNIT_PER_HOUR Unit = 1
 	// UNIT_PER_DAY is a per-day billing unit.
 	Unit_UNIT_PER_DAY Unit = 2
+	// UNIT_PER_USAGE is a metered billing unit: base_price is charged per unit
+	// of usage reported by the provider rather than over time.
+	Unit_UNIT_PER_USAGE Unit = 3
+	// UNIT_PER_MINUTE is a per-minute billing unit.
+	Unit_UNIT_PER_MINUTE Unit = 4
+	// UNIT_PER_WEEK is a per-week billing unit.
+	Unit_UNIT_PER_WEEK Unit = 5
+	// UNIT_PER_MONTH is a per-calendar-month billing unit: base_price is charged
+	// once per UTC calendar month, spread over the month's actual length.
+	Unit_UNIT_PER_MONTH Unit = 6
+)
+
+// Enum value maps for Unit.
+var (
+	Unit_name = map[int32]string{
+		0: "UNIT_UNSPECIFIED",
+		1: "UNIT_PER_HOUR",
+		2: "UNIT_PER_DAY",
+		3: "UNIT_PER_USAGE",
+		4: "UNIT_PER_MINUTE",
+		5: "UNIT_PER_WEEK",
+		6: "UNIT_PER_MONTH",
+	}
+	Unit_value = map[string]int32{
+		"UNIT_UNSPECIFIED": 0,
+		"UNIT_PER_HOUR":    1,
+		"UNIT_PER_DAY":     2,
+		"UNIT_PER_USAGE":   3,
+		"UNIT_PER_MINUTE":  4,
+		"UNIT_PER_WEEK":    5,
+		"UNIT_PER_MONTH":   6,
+	}
+)
+
+func (x Unit) Enum() *Unit {
+	p := new(Unit)
+	*p = x
+	return p
+}
+
+func (x Unit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Unit) Descriptor() protoreflect.EnumDescriptor {
+	return file_liftedinit_sku_v1_types_proto_enumTypes[0].Descriptor()
+}
+
+func (Unit) Type() protoreflect.EnumType {
+	return &file_liftedinit_sku_v1_types_proto_enumTypes[0]
+}
+
+func (x Unit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Unit.Descriptor instead.
+func (Unit) EnumDescriptor() ([]byte, []int) {
+	return file_liftedinit_sku_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+// AttributeType defines the value type of a catalog attribute.
+type AttributeType int32
+
+const (
+	// ATTRIBUTE_TYPE_UNSPECIFIED is the default unspecified type.
+	AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED AttributeType = 0
+	// ATTRIBUTE_TYPE_STRING values are free text, matched by equality.
+	AttributeType_ATTRIBUTE_TYPE_STRING AttributeType = 1
+	// ATTRIBUTE_TYPE_UINT values are unsigned integers in canonical decimal
+	// form (no sign, no leading zeros), matched by equality or range.
+	AttributeType_ATTRIBUTE_TYPE_UINT AttributeType = 2
+)
+
+// Enum value maps for AttributeType.
+var (
+	AttributeType_name = map[int32]string{
+		0: "ATTRIBUTE_TYPE_UNSPECIFIED",
+		1: "ATTRIBUTE_TYPE_STRING",
+		2: "ATTRIBUTE_TYPE_UINT",
+	}
+	AttributeType_value = map[string]int32{
+		"ATTRIBUTE_TYPE_UNSPECIFIED": 0,
+		"ATTRIBUTE_TYPE_STRING":      1,
+		"ATTRIBUTE_TYPE_UINT":        2,
+	}
 )
 
-// Enum value maps for Unit.
-var (
-	Unit_name = map[int32]string{
-		0: "UNIT_UNSPECIFIED",
-		1: "UNIT_PER_HOUR",
-		2: "UNIT_PER_DAY",
+func (x AttributeType) Enum() *AttributeType {
+	p := new(AttributeType)
+	*p = x
+	return p
+}
+
+func (x AttributeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttributeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_liftedinit_sku_v1_types_proto_enumTypes[1].Descriptor()
+}
+
+func (AttributeType) Type() protoreflect.EnumType {
+	return &file_liftedinit_sku_v1_types_proto_enumTypes[1]
+}
+
+func (x AttributeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttributeType.Descriptor instead.
+func (AttributeType) EnumDescriptor() ([]byte, []int) {
+	return file_liftedinit_sku_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+// AttributeDefinition declares an attribute key that providers and SKUs may
+// set, and the type of its values.
+type AttributeDefinition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// key is the attribute name, e.g. "region" or "memory_mb". It must start
+	// with a lowercase letter and contain only lowercase letters, digits and
+	// underscores.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value_type is the type of the attribute's values.
+	ValueType AttributeType `protobuf:"varint,2,opt,name=value_type,json=valueType,proto3,enum=liftedinit.sku.v1.AttributeType" json:"value_type,omitempty"`
+}
+
+func (x *AttributeDefinition) Reset() {
+	*x = AttributeDefinition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_liftedinit_sku_v1_types_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttributeDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeDefinition) ProtoMessage() {}
+
+// Deprecated: Use AttributeDefinition.ProtoReflect.Descriptor instead.
+func (*AttributeDefinition) Descriptor() ([]byte, []int) {
+	return file_liftedinit_sku_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AttributeDefinition) GetKey() string {
+	if x != nil {
+		return x.Key
 	}
-	Unit_value = map[string]int32{
-		"UNIT_UNSPECIFIED": 0,
-		"UNIT_PER_HOUR":    1,
-		"UNIT_PER_DAY":     2,
+	return ""
+}
+
+func (x *AttributeDefinition) GetValueType() AttributeType {
+	if x != nil {
+		return x.ValueType
 	}
-)
+	return AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED
+}
 
-func (x Unit) Enum() *Unit {
-	p := new(Unit)
-	*p = x
-	return p
+// Attribute is a typed key/value property of a provider or SKU. The key must
+// be declared in Params.attribute_schema.
+type Attribute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// key is the attribute name.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value is the attribute value, formatted for the key's type.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x Unit) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *Attribute) Reset() {
+	*x = Attribute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_liftedinit_sku_v1_types_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (Unit) Descriptor() protoreflect.EnumDescriptor {
-	return file_liftedinit_sku_v1_types_proto_enumTypes[0].Descriptor()
+func (x *Attribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (Unit) Type() protoreflect.EnumType {
-	return &file_liftedinit_sku_v1_types_proto_enumTypes[0]
+func (*Attribute) ProtoMessage() {}
+
+// Deprecated: Use Attribute.ProtoReflect.Descriptor instead.
+func (*Attribute) Descriptor() ([]byte, []int) {
+	return file_liftedinit_sku_v1_types_proto_rawDescGZIP(), []int{1}
 }
 
-func (x Unit) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *Attribute) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
 }
 
-// Deprecated: Use Unit.Descriptor instead.
-func (Unit) EnumDescriptor() ([]byte, []int) {
-	return file_liftedinit_sku_v1_types_proto_rawDescGZIP(), []int{0}
+func (x *Attribute) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
 }
 
 // Params defines the parameters for the sku module.
@@ -2098,12 +5925,16 @@ type Params struct {
 	// allowed_list is the list of addresses allowed to manage SKUs
 	// in addition to the module authority.
 	AllowedList []string `protobuf:"bytes,1,rep,name=allowed_list,json=allowedList,proto3" json:"allowed_list,omitempty"`
+	// attribute_schema declares the attribute keys providers and SKUs may set.
+	// Removing a key does not strip it from existing entries, but they cannot
+	// be saved with it again.
+	AttributeSchema []*AttributeDefinition `protobuf:"bytes,2,rep,name=attribute_schema,json=attributeSchema,proto3" json:"attribute_schema,omitempty"`
 }
 
 func (x *Params) Reset() {
 	*x = Params{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_liftedinit_sku_v1_types_proto_msgTypes[0]
+		mi := &file_liftedinit_sku_v1_types_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2117,7 +5948,7 @@ func (*Params) ProtoMessage() {}
 
 // Deprecated: Use Params.ProtoReflect.Descriptor instead.
 func (*Params) Descriptor() ([]byte, []int) {
-	return file_liftedinit_sku_v1_types_proto_rawDescGZIP(), []int{0}
+	return file_liftedinit_sku_v1_types_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Params) GetAllowedList() []string {
@@ -2127,6 +5958,13 @@ func (x *Params) GetAllowedList() []string {
 	return nil
 }
 
+func (x *Params) GetAttributeSchema() []*AttributeDefinition {
+	if x != nil {
+		return x.AttributeSchema
+	}
+	return nil
+}
+
 // Provider defines a SKU provider entity.
 type Provider struct {
 	state         protoimpl.MessageState
@@ -2147,12 +5985,15 @@ type Provider struct {
 	// Tenants use this URL to retrieve connection details for their leases.
 	// Must be a valid HTTPS URL.
 	ApiUrl string `protobuf:"bytes,6,opt,name=api_url,json=apiUrl,proto3" json:"api_url,omitempty"`
+	// attributes are the provider's catalog attributes, ordered by key, e.g.
+	// its region. At most one value per key.
+	Attributes []*Attribute `protobuf:"bytes,7,rep,name=attributes,proto3" json:"attributes,omitempty"`
 }
 
 func (x *Provider) Reset() {
 	*x = Provider{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_liftedinit_sku_v1_types_proto_msgTypes[1]
+		mi := &file_liftedinit_sku_v1_types_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2166,7 +6007,7 @@ func (*Provider) ProtoMessage() {}
 
 // Deprecated: Use Provider.ProtoReflect.Descriptor instead.
 func (*Provider) Descriptor() ([]byte, []int) {
-	return file_liftedinit_sku_v1_types_proto_rawDescGZIP(), []int{1}
+	return file_liftedinit_sku_v1_types_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Provider) GetUuid() string {
@@ -2211,6 +6052,13 @@ func (x *Provider) GetApiUrl() string {
 	return ""
 }
 
+func (x *Provider) GetAttributes() []*Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
 // SKU defines a stock keeping unit for on-chain billing.
 type SKU struct {
 	state         protoimpl.MessageState
@@ -2231,12 +6079,25 @@ type SKU struct {
 	MetaHash []byte `protobuf:"bytes,6,opt,name=meta_hash,json=metaHash,proto3" json:"meta_hash,omitempty"`
 	// active indicates whether the SKU is active.
 	Active bool `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+	// price_tiers are volume prices that replace base_price once a tenant
+	// holds at least min_quantity units, ordered by ascending min_quantity. A
+	// lease item is priced at the last tier reached by its quantity plus the
+	// tenant's quantity of this SKU in PENDING and ACTIVE leases, or at
+	// base_price below the first.
+	PriceTiers []*PriceTier `protobuf:"bytes,8,rep,name=price_tiers,json=priceTiers,proto3" json:"price_tiers,omitempty"`
+	// capacity is the total quantity of this SKU that PENDING and ACTIVE leases
+	// may hold at once. Zero means unlimited.
+	Capacity uint64 `protobuf:"varint,9,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// attributes are the SKU's catalog attributes, ordered by key, e.g. its
+	// gpu_model or memory_mb. At most one value per key. They are indexed for
+	// Query/SearchSKUs.
+	Attributes []*Attribute `protobuf:"bytes,10,rep,name=attributes,proto3" json:"attributes,omitempty"`
 }
 
 func (x *SKU) Reset() {
 	*x = SKU{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_liftedinit_sku_v1_types_proto_msgTypes[2]
+		mi := &file_liftedinit_sku_v1_types_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2250,7 +6111,7 @@ func (*SKU) ProtoMessage() {}
 
 // Deprecated: Use SKU.ProtoReflect.Descriptor instead.
 func (*SKU) Descriptor() ([]byte, []int) {
-	return file_liftedinit_sku_v1_types_proto_rawDescGZIP(), []int{2}
+	return file_liftedinit_sku_v1_types_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SKU) GetUuid() string {
@@ -2302,6 +6163,236 @@ func (x *SKU) GetActive() bool {
 	return false
 }
 
+func (x *SKU) GetPriceTiers() []*PriceTier {
+	if x != nil {
+		return x.PriceTiers
+	}
+	return nil
+}
+
+func (x *SKU) GetCapacity() uint64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *SKU) GetAttributes() []*Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// PriceTier is the price of a SKU from a quantity threshold upwards.
+type PriceTier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// min_quantity is the smallest tenant quantity priced at this tier. It
+	// must be greater than 1, since smaller quantities use the SKU's
+	// base_price.
+	MinQuantity uint64 `protobuf:"varint,1,opt,name=min_quantity,json=minQuantity,proto3" json:"min_quantity,omitempty"`
+	// price is the price per unit at this tier, in the SKU's base_price denom.
+	Price *types.Coin `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *PriceTier) Reset() {
+	*x = PriceTier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_liftedinit_sku_v1_types_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceTier) ProtoMessage() {}
+
+// Deprecated: Use PriceTier.ProtoReflect.Descriptor instead.
+func (*PriceTier) Descriptor() ([]byte, []int) {
+	return file_liftedinit_sku_v1_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PriceTier) GetMinQuantity() uint64 {
+	if x != nil {
+		return x.MinQuantity
+	}
+	return 0
+}
+
+func (x *PriceTier) GetPrice() *types.Coin {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+// PriceHistoryEntry records a price a SKU took: its base_price and
+// price_tiers as of a block. An entry is written when a SKU is created and
+// whenever its price changes, whether directly through MsgUpdateSKU or when a
+// scheduled change takes effect.
+type PriceHistoryEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the chain-wide sequence number of the entry.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sku_uuid is the UUID of the SKU.
+	SkuUuid string `protobuf:"bytes,2,opt,name=sku_uuid,json=skuUuid,proto3" json:"sku_uuid,omitempty"`
+	// base_price is the SKU's base price from changed_at.
+	BasePrice *types.Coin `protobuf:"bytes,3,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	// price_tiers are the SKU's volume prices from changed_at.
+	PriceTiers []*PriceTier `protobuf:"bytes,4,rep,name=price_tiers,json=priceTiers,proto3" json:"price_tiers,omitempty"`
+	// changed_at is the block time at which the price took effect.
+	ChangedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+}
+
+func (x *PriceHistoryEntry) Reset() {
+	*x = PriceHistoryEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_liftedinit_sku_v1_types_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceHistoryEntry) ProtoMessage() {}
+
+// Deprecated: Use PriceHistoryEntry.ProtoReflect.Descriptor instead.
+func (*PriceHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_liftedinit_sku_v1_types_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PriceHistoryEntry) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PriceHistoryEntry) GetSkuUuid() string {
+	if x != nil {
+		return x.SkuUuid
+	}
+	return ""
+}
+
+func (x *PriceHistoryEntry) GetBasePrice() *types.Coin {
+	if x != nil {
+		return x.BasePrice
+	}
+	return nil
+}
+
+func (x *PriceHistoryEntry) GetPriceTiers() []*PriceTier {
+	if x != nil {
+		return x.PriceTiers
+	}
+	return nil
+}
+
+func (x *PriceHistoryEntry) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+// PendingPriceChange is a price change scheduled for a SKU. In the first
+// block at or after effective_at, the EndBlocker replaces the SKU's
+// base_price and price_tiers with the ones below. A SKU has at most one
+// pending change.
+type PendingPriceChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sku_uuid is the UUID of the SKU.
+	SkuUuid string `protobuf:"bytes,1,opt,name=sku_uuid,json=skuUuid,proto3" json:"sku_uuid,omitempty"`
+	// base_price is the base price the SKU takes at effective_at.
+	BasePrice *types.Coin `protobuf:"bytes,2,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	// price_tiers are the volume prices the SKU takes at effective_at.
+	PriceTiers []*PriceTier `protobuf:"bytes,3,rep,name=price_tiers,json=priceTiers,proto3" json:"price_tiers,omitempty"`
+	// effective_at is the time from which the new price applies.
+	EffectiveAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
+	// scheduled_at is the block time at which the change was scheduled.
+	ScheduledAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	// scheduled_by is the address that scheduled the change.
+	ScheduledBy string `protobuf:"bytes,6,opt,name=scheduled_by,json=scheduledBy,proto3" json:"scheduled_by,omitempty"`
+}
+
+func (x *PendingPriceChange) Reset() {
+	*x = PendingPriceChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_liftedinit_sku_v1_types_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingPriceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingPriceChange) ProtoMessage() {}
+
+// Deprecated: Use PendingPriceChange.ProtoReflect.Descriptor instead.
+func (*PendingPriceChange) Descriptor() ([]byte, []int) {
+	return file_liftedinit_sku_v1_types_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PendingPriceChange) GetSkuUuid() string {
+	if x != nil {
+		return x.SkuUuid
+	}
+	return ""
+}
+
+func (x *PendingPriceChange) GetBasePrice() *types.Coin {
+	if x != nil {
+		return x.BasePrice
+	}
+	return nil
+}
+
+func (x *PendingPriceChange) GetPriceTiers() []*PriceTier {
+	if x != nil {
+		return x.PriceTiers
+	}
+	return nil
+}
+
+func (x *PendingPriceChange) GetEffectiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return nil
+}
+
+func (x *PendingPriceChange) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *PendingPriceChange) GetScheduledBy() string {
+	if x != nil {
+		return x.ScheduledBy
+	}
+	return ""
+}
+
 var File_liftedinit_sku_v1_types_proto protoreflect.FileDescriptor
 
 var file_liftedinit_sku_v1_types_proto_rawDesc = []byte{
@@ -2314,86 +6405,222 @@ var file_liftedinit_sku_v1_types_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x61, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x12, 0x3b, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x3a, 0x1a, 0xe8,
-	0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x11, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x2f, 0x73,
-	0x6b, 0x75, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x86, 0x03, 0x0a, 0x08, 0x50, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x75, 0x75, 0x69, 0x64, 0x2c, 0x6f,
-	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x47,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x2d, 0xea, 0xde, 0x1f, 0x11, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2c, 0x6f, 0x6d, 0x69,
-	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x5b, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6f, 0x75,
-	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x34, 0xea, 0xde, 0x1f, 0x18, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xd2, 0xb4, 0x2d,
-	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73,
-	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x17, 0xea, 0xde, 0x1f, 0x13, 0x6d, 0x65, 0x74,
-	0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2c, 0x0a, 0x06, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x14, 0xea, 0xde, 0x1f, 0x10,
-	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f,
-	0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xea, 0xde, 0x1f, 0x11, 0x61,
-	0x70, 0x69, 0x5f, 0x75, 0x72, 0x6c, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x52, 0x06, 0x61, 0x70, 0x69, 0x55, 0x72, 0x6c, 0x3a, 0x18, 0x8a, 0xe7, 0xb0, 0x2a, 0x13, 0x6c,
-	0x69, 0x66, 0x74, 0x65, 0x64, 0x2f, 0x73, 0x6b, 0x75, 0x2f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x22, 0xe0, 0x03, 0x0a, 0x03, 0x53, 0x4b, 0x55, 0x12, 0x26, 0x0a, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x75, 0x75,
-	0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x12, 0x40, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x75,
-	0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xea, 0xde, 0x1f, 0x17, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69,
-	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x55, 0x75, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x6f, 0x6d, 0x69,
-	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x04,
-	0x75, 0x6e, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x66,
-	0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x55,
-	0x6e, 0x69, 0x74, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x75, 0x6e, 0x69, 0x74, 0x2c, 0x6f, 0x6d,
-	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x8c, 0x01,
-	0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x52, 0xc8,
-	0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0xfa, 0xde, 0x1f, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
-	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x9a, 0xe7, 0xb0, 0x2a,
-	0x0b, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0xa8, 0xe7, 0xb0, 0x2a,
-	0x01, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x09,
-	0x6d, 0x65, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x42,
-	0x17, 0xea, 0xde, 0x1f, 0x13, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x2c, 0x6f,
-	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x48, 0x61,
-	0x73, 0x68, 0x12, 0x2c, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x08, 0x42, 0x14, 0xea, 0xde, 0x1f, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x2c, 0x6f,
-	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9b, 0x01, 0x0a, 0x13, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x23, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xea, 0xde,
+	0x1f, 0x0d, 0x6b, 0x65, 0x79, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x59, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65,
+	0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x18, 0xea, 0xde, 0x1f, 0x14,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x3a,
+	0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x5b, 0x0a, 0x09, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x12, 0x23, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x11, 0xea, 0xde, 0x1f, 0x0d, 0x6b, 0x65, 0x79, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0xea, 0xde, 0x1f, 0x0f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0xba, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3b, 0x0a,
+	0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x10, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69,
+	0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x3a, 0x1a, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x11, 0x6c, 0x69,
+	0x66, 0x74, 0x65, 0x64, 0x2f, 0x73, 0x6b, 0x75, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22,
+	0xe2, 0x03, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e,
+	0x75, 0x75, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x12, 0x47, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0xea, 0xde, 0x1f, 0x11, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x5b, 0x0a,
+	0x0e, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x34, 0xea, 0xde, 0x1f, 0x18, 0x70, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x70, 0x61, 0x79,
+	0x6f, 0x75, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x6d, 0x65,
+	0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x17, 0xea,
+	0xde, 0x1f, 0x13, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x2c, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x14, 0xea, 0xde, 0x1f, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x2e,
+	0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x15, 0xea, 0xde, 0x1f, 0x11, 0x61, 0x70, 0x69, 0x5f, 0x75, 0x72, 0x6c, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x06, 0x61, 0x70, 0x69, 0x55, 0x72, 0x6c, 0x12, 0x5a,
+	0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e,
+	0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x42, 0x1c, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x14, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0a,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x3a, 0x18, 0x8a, 0xe7, 0xb0, 0x2a,
+	0x13, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x2f, 0x73, 0x6b, 0x75, 0x2f, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x22, 0xce, 0x05, 0x0a, 0x03, 0x53, 0x4b, 0x55, 0x12, 0x26, 0x0a, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e,
+	0x75, 0x75, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xea, 0xde, 0x1f,
+	0x17, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3f,
+	0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6c,
+	0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x6e, 0x69, 0x74, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x75, 0x6e, 0x69, 0x74, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12,
+	0x8c, 0x01, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x52, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0xfa, 0xde, 0x1f, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x9a, 0xe7,
+	0xb0, 0x2a, 0x0b, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x34,
+	0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x17, 0xea, 0xde, 0x1f, 0x13, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x2c, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x42, 0x14, 0xea, 0xde, 0x1f, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x12, 0x5c, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x65, 0x72,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64,
+	0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x54, 0x69, 0x65, 0x72, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x15, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x65, 0x72, 0x73,
+	0x12, 0x32, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x16, 0xea, 0xde, 0x1f, 0x12, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x12, 0x5a, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65,
+	0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x42, 0x1c, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x14,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
 	0x3a, 0x13, 0x8a, 0xe7, 0xb0, 0x2a, 0x0e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x2f, 0x73, 0x6b,
-	0x75, 0x2f, 0x53, 0x4b, 0x55, 0x2a, 0x41, 0x0a, 0x04, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x14, 0x0a,
-	0x10, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x50, 0x45, 0x52, 0x5f,
-	0x48, 0x4f, 0x55, 0x52, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x50,
-	0x45, 0x52, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x02, 0x42, 0xd2, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d,
-	0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e,
-	0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e,
-	0x69, 0x66, 0x65, 0x73, 0x74, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6d, 0x61,
-	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2d, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x73, 0x6b, 0x75,
-	0x2f, 0x76, 0x31, 0x3b, 0x73, 0x6b, 0x75, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4c, 0x53, 0x58, 0xaa,
-	0x02, 0x11, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x53, 0x6b, 0x75,
-	0x2e, 0x56, 0x31, 0xca, 0x02, 0x11, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74,
-	0x5c, 0x53, 0x6b, 0x75, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1d, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64,
-	0x69, 0x6e, 0x69, 0x74, 0x5c, 0x53, 0x6b, 0x75, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64,
-	0x69, 0x6e, 0x69, 0x74, 0x3a, 0x3a, 0x53, 0x6b, 0x75, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x2f, 0x53, 0x4b, 0x55, 0x22, 0xca, 0x01, 0x0a, 0x09, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54,
+	0x69, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xea, 0xde, 0x1f, 0x16, 0x6d,
+	0x69, 0x6e, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x7e, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x4d, 0xc8, 0xde,
+	0x1f, 0x00, 0xea, 0xde, 0x1f, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0xfa, 0xde, 0x1f, 0x27, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x9a, 0xe7, 0xb0, 0x2a, 0x0b, 0x6c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x22, 0xd2, 0x03, 0x0a, 0x11, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x27, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x17, 0xea, 0xde, 0x1f, 0x13, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x6b, 0x75, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x16, 0xea, 0xde, 0x1f, 0x12, 0x73, 0x6b, 0x75, 0x5f, 0x75, 0x75, 0x69,
+	0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x07, 0x73, 0x6b, 0x75,
+	0x55, 0x75, 0x69, 0x64, 0x12, 0x8c, 0x01, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x52, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0a, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0xfa, 0xde, 0x1f, 0x27, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x9a, 0xe7, 0xb0, 0x2a, 0x0b, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63,
+	0x6f, 0x69, 0x6e, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x5c, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x65,
+	0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65,
+	0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x54, 0x69, 0x65, 0x72, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x15,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x65, 0x72,
+	0x73, 0x12, 0x51, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x42, 0x16, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x41, 0x74, 0x3a, 0x21, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c, 0x6c, 0x69, 0x66, 0x74, 0x65,
+	0x64, 0x2f, 0x73, 0x6b, 0x75, 0x2f, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0xe1, 0x04, 0x0a, 0x12, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x31,
+	0x0a, 0x08, 0x73, 0x6b, 0x75, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x16, 0xea, 0xde, 0x1f, 0x12, 0x73, 0x6b, 0x75, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x07, 0x73, 0x6b, 0x75, 0x55, 0x75, 0x69,
+	0x64, 0x12, 0x8c, 0x01, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x52, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0xfa, 0xde, 0x1f, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x9a, 0xe7, 0xb0, 0x2a, 0x0b, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x5c, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e,
+	0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54,
+	0x69, 0x65, 0x72, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x15, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12, 0x57,
+	0x0a, 0x0c, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x18, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0c, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x61, 0x74, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0b, 0x65, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x41, 0x74, 0x12, 0x57, 0x0a, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x18, 0xc8, 0xde, 0x1f, 0x00, 0xea,
+	0xde, 0x1f, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x90,
+	0xdf, 0x1f, 0x01, 0x52, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x55, 0x0a, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32, 0xea, 0xde, 0x1f, 0x16, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x42, 0x79, 0x3a, 0x22, 0x8a, 0xe7, 0xb0, 0x2a, 0x1d, 0x6c, 0x69,
+	0x66, 0x74, 0x65, 0x64, 0x2f, 0x73, 0x6b, 0x75, 0x2f, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2a, 0x91, 0x01, 0x0a, 0x04,
+	0x55, 0x6e, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e,
+	0x49, 0x54, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x10, 0x01, 0x12, 0x10, 0x0a,
+	0x0c, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x02, 0x12,
+	0x12, 0x0a, 0x0e, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x55, 0x53, 0x41, 0x47,
+	0x45, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x50, 0x45, 0x52, 0x5f,
+	0x4d, 0x49, 0x4e, 0x55, 0x54, 0x45, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x49, 0x54,
+	0x5f, 0x50, 0x45, 0x52, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e, 0x55,
+	0x4e, 0x49, 0x54, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x06, 0x2a,
+	0x63, 0x0a, 0x0d, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x19, 0x0a, 0x15, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x41,
+	0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x49,
+	0x4e, 0x54, 0x10, 0x02, 0x42, 0xd2, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x6c, 0x69, 0x66,
+	0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x73, 0x6b, 0x75, 0x2e, 0x76, 0x31, 0x42, 0x0a,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x47, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x2d, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6c, 0x69,
+	0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x73, 0x6b, 0x75, 0x2f, 0x76, 0x31, 0x3b,
+	0x73, 0x6b, 0x75, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4c, 0x53, 0x58, 0xaa, 0x02, 0x11, 0x4c, 0x69,
+	0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x53, 0x6b, 0x75, 0x2e, 0x56, 0x31, 0xca,
+	0x02, 0x11, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74, 0x5c, 0x53, 0x6b, 0x75,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1d, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74,
+	0x5c, 0x53, 0x6b, 0x75, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x4c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x69, 0x6e, 0x69, 0x74,
+	0x3a, 0x3a, 0x53, 0x6b, 0x75, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -2408,23 +6635,43 @@ func file_liftedinit_sku_v1_types_proto_rawDescGZIP() []byte {
 	return file_liftedinit_sku_v1_types_proto_rawDescData
 }
 
-var file_liftedinit_sku_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_liftedinit_sku_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_liftedinit_sku_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_liftedinit_sku_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_liftedinit_sku_v1_types_proto_goTypes = []interface{}{
-	(Unit)(0),          // 0: liftedinit.sku.v1.Unit
-	(*Params)(nil),     // 1: liftedinit.sku.v1.Params
-	(*Provider)(nil),   // 2: liftedinit.sku.v1.Provider
-	(*SKU)(nil),        // 3: liftedinit.sku.v1.SKU
-	(*types.Coin)(nil), // 4: cosmos.base.v1beta1.Coin
+	(Unit)(0),                     // 0: liftedinit.sku.v1.Unit
+	(AttributeType)(0),            // 1: liftedinit.sku.v1.AttributeType
+	(*AttributeDefinition)(nil),   // 2: liftedinit.sku.v1.AttributeDefinition
+	(*Attribute)(nil),             // 3: liftedinit.sku.v1.Attribute
+	(*Params)(nil),                // 4: liftedinit.sku.v1.Params
+	(*Provider)(nil),              // 5: liftedinit.sku.v1.Provider
+	(*SKU)(nil),                   // 6: liftedinit.sku.v1.SKU
+	(*PriceTier)(nil),             // 7: liftedinit.sku.v1.PriceTier
+	(*PriceHistoryEntry)(nil),     // 8: liftedinit.sku.v1.PriceHistoryEntry
+	(*PendingPriceChange)(nil),    // 9: liftedinit.sku.v1.PendingPriceChange
+	(*types.Coin)(nil),            // 10: cosmos.base.v1beta1.Coin
+	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
 }
 var file_liftedinit_sku_v1_types_proto_depIdxs = []int32{
-	0, // 0: liftedinit.sku.v1.SKU.unit:type_name -> liftedinit.sku.v1.Unit
-	4, // 1: liftedinit.sku.v1.SKU.base_price:type_name -> cosmos.base.v1beta1.Coin
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	1,  // 0: liftedinit.sku.v1.AttributeDefinition.value_type:type_name -> liftedinit.sku.v1.AttributeType
+	2,  // 1: liftedinit.sku.v1.Params.attribute_schema:type_name -> liftedinit.sku.v1.AttributeDefinition
+	3,  // 2: liftedinit.sku.v1.Provider.attributes:type_name -> liftedinit.sku.v1.Attribute
+	0,  // 3: liftedinit.sku.v1.SKU.unit:type_name -> liftedinit.sku.v1.Unit
+	10, // 4: liftedinit.sku.v1.SKU.base_price:type_name -> cosmos.base.v1beta1.Coin
+	7,  // 5: liftedinit.sku.v1.SKU.price_tiers:type_name -> liftedinit.sku.v1.PriceTier
+	3,  // 6: liftedinit.sku.v1.SKU.attributes:type_name -> liftedinit.sku.v1.Attribute
+	10, // 7: liftedinit.sku.v1.PriceTier.price:type_name -> cosmos.base.v1beta1.Coin
+	10, // 8: liftedinit.sku.v1.PriceHistoryEntry.base_price:type_name -> cosmos.base.v1beta1.Coin
+	7,  // 9: liftedinit.sku.v1.PriceHistoryEntry.price_tiers:type_name -> liftedinit.sku.v1.PriceTier
+	11, // 10: liftedinit.sku.v1.PriceHistoryEntry.changed_at:type_name -> google.protobuf.Timestamp
+	10, // 11: liftedinit.sku.v1.PendingPriceChange.base_price:type_name -> cosmos.base.v1beta1.Coin
+	7,  // 12: liftedinit.sku.v1.PendingPriceChange.price_tiers:type_name -> liftedinit.sku.v1.PriceTier
+	11, // 13: liftedinit.sku.v1.PendingPriceChange.effective_at:type_name -> google.protobuf.Timestamp
+	11, // 14: liftedinit.sku.v1.PendingPriceChange.scheduled_at:type_name -> google.protobuf.Timestamp
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_liftedinit_sku_v1_types_proto_init() }
@@ -2434,7 +6681,7 @@ func file_liftedinit_sku_v1_types_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_liftedinit_sku_v1_types_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Params); i {
+			switch v := v.(*AttributeDefinition); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2446,7 +6693,7 @@ func file_liftedinit_sku_v1_types_proto_init() {
 			}
 		}
 		file_liftedinit_sku_v1_types_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Provider); i {
+			switch v := v.(*Attribute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2458,6 +6705,30 @@ func file_liftedinit_sku_v1_types_proto_init() {
 			}
 		}
 		file_liftedinit_sku_v1_types_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Params); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_liftedinit_sku_v1_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Provider); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_liftedinit_sku_v1_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SKU); i {
 			case 0:
 				return &v.state
@@ -2469,14 +6740,50 @@ func file_liftedinit_sku_v1_types_proto_init() {
 				return nil
 			}
 		}
+		file_liftedinit_sku_v1_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceTier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_liftedinit_sku_v1_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceHistoryEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_liftedinit_sku_v1_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingPriceChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_liftedinit_sku_v1_types_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   3,
+			NumEnums:      2,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
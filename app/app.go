@@ -141,6 +141,7 @@ import (
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
 	"github.com/manifest-network/manifest-ledger/app/helpers"
+	"github.com/manifest-network/manifest-ledger/app/wasmbinding"
 	billing "github.com/manifest-network/manifest-ledger/x/billing"
 	billingkeeper "github.com/manifest-network/manifest-ledger/x/billing/keeper"
 	billingtypes "github.com/manifest-network/manifest-ledger/x/billing/types"
@@ -207,12 +208,14 @@ var maccPerms = map[string][]string{
 	govtypes.ModuleName:            {authtypes.Burner},
 
 	// non sdk modules
-	ibctransfertypes.ModuleName:  {authtypes.Minter, authtypes.Burner},
-	ibcfeetypes.ModuleName:       nil,
-	icatypes.ModuleName:          nil,
-	tokenfactorytypes.ModuleName: {authtypes.Minter, authtypes.Burner},
-	manifesttypes.ModuleName:     {authtypes.Minter, authtypes.Burner},
-	wasmtypes.ModuleName:         {authtypes.Burner},
+	ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+	ibcfeetypes.ModuleName:         nil,
+	icatypes.ModuleName:            nil,
+	tokenfactorytypes.ModuleName:   {authtypes.Minter, authtypes.Burner},
+	manifesttypes.ModuleName:       {authtypes.Minter, authtypes.Burner},
+	wasmtypes.ModuleName:           {authtypes.Burner},
+	billingtypes.DisputeEscrowName: nil,
+	billingtypes.PromoEscrowName:   nil,
 }
 
 var (
@@ -603,6 +606,9 @@ func NewApp(
 	app.BillingKeeper.SetSKUKeeper(&app.SKUKeeper)
 	app.BillingKeeper.SetBankKeeper(app.BankKeeper)
 	app.BillingKeeper.SetAccountKeeper(app.AccountKeeper)
+	// Modules that react to lease lifecycle changes register here with
+	// app.BillingKeeper.SetHooks(billingtypes.NewMultiBillingHooks(...)),
+	// before the module manager takes its copy of the keeper.
 
 	// Create the TokenFactory Keeper
 	app.TokenFactoryKeeper = tokenfactorykeeper.NewKeeper(
@@ -685,8 +691,9 @@ func NewApp(
 		homePath,
 		wasmConfig,
 		wasmtypes.VMConfig{},
-		wasmkeeper.BuiltInCapabilities(),
+		append(wasmkeeper.BuiltInCapabilities(), wasmbinding.CapabilityManifest),
 		helpers.GetPoAAdmin(),
+		wasmbinding.RegisterCustomPlugins(&app.SKUKeeper, &app.BillingKeeper)...,
 	)
 
 	app.CapabilityKeeper.Seal()
@@ -831,6 +838,7 @@ func NewApp(
 		ibcfeetypes.ModuleName,
 		tokenfactorytypes.ModuleName,
 		manifesttypes.ModuleName,
+		skutypes.ModuleName,     // scheduled price changes, before billing
 		billingtypes.ModuleName, // batch settlement
 		wasmtypes.ModuleName,
 	)
@@ -849,7 +857,7 @@ func NewApp(
 		tokenfactorytypes.ModuleName, // before bank
 		authtypes.ModuleName, banktypes.ModuleName,
 		distrtypes.ModuleName, stakingtypes.ModuleName, slashingtypes.ModuleName, govtypes.ModuleName,
-		minttypes.ModuleName, crisistypes.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
+		minttypes.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
 		feegrant.ModuleName, group.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName,
 		vestingtypes.ModuleName, consensusparamtypes.ModuleName, circuittypes.ModuleName,
 		// additional non simd modules
@@ -861,6 +869,7 @@ func NewApp(
 		manifesttypes.ModuleName,
 		skutypes.ModuleName,
 		billingtypes.ModuleName, // after sku (billing depends on sku)
+		crisistypes.ModuleName,  // asserts invariants, so after every module that registers them
 		wasmtypes.ModuleName,
 	}
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
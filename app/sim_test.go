@@ -70,6 +70,15 @@ func interBlockCacheOpt() func(*baseapp.BaseApp) {
 	return baseapp.SetInterBlockCache(store.NewCommitKVStoreCacheManager())
 }
 
+// assertInvariants runs every registered crisis invariant against the app's
+// latest state. The crisis end blocker only checks them every -Period blocks,
+// which is off by default.
+func assertInvariants(t *testing.T, bApp *app.ManifestApp) {
+	t.Helper()
+	ctx := bApp.NewContextLegacy(true, cmtproto.Header{Height: bApp.LastBlockHeight()})
+	require.NotPanics(t, func() { bApp.CrisisKeeper.AssertInvariants(ctx) })
+}
+
 // BenchmarkSimulation run the chain simulation
 // Running using starport command:
 // `ignite chain simulate -v --numBlocks 200 --blockSize 50`
@@ -183,6 +192,7 @@ func TestFullAppSimulation(t *testing.T) {
 	err = simtestutil.CheckExportSimulation(bApp, config, simParams)
 	require.NoError(t, err)
 	require.NoError(t, simErr)
+	assertInvariants(t, bApp)
 
 	if config.Commit {
 		simtestutil.PrintStats(db)
@@ -237,6 +247,7 @@ func TestAppImportExport(t *testing.T) {
 	err = simtestutil.CheckExportSimulation(bApp, config, simParams)
 	require.NoError(t, err)
 	require.NoError(t, simErr)
+	assertInvariants(t, bApp)
 
 	if config.Commit {
 		simtestutil.PrintStats(db)
@@ -0,0 +1,79 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+	"time"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	billingtypes "github.com/manifest-network/manifest-ledger/x/billing/types"
+)
+
+// EncodeManifestMsg turns a ManifestMsg into the billing message it stands
+// for, with the contract as sender. wasmd then routes it like any other
+// message, checking that the contract is its only signer.
+func EncodeManifestMsg(sender sdk.AccAddress, raw json.RawMessage) ([]sdk.Msg, error) {
+	var m ManifestMsg
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, errorsmod.Wrap(err, "manifest msg")
+	}
+
+	var msg sdk.Msg
+	switch {
+	case m.FundCredit != nil:
+		amount, ok := sdkmath.NewIntFromString(m.FundCredit.Amount.Amount)
+		if !ok {
+			return nil, wasmvmtypes.InvalidRequest{Err: "fund credit: invalid amount " + m.FundCredit.Amount.Amount}
+		}
+		tenant := m.FundCredit.Tenant
+		if tenant == "" {
+			tenant = sender.String()
+		}
+		msg = &billingtypes.MsgFundCredit{
+			Sender: sender.String(),
+			Tenant: tenant,
+			Amount: sdk.Coin{Denom: m.FundCredit.Amount.Denom, Amount: amount},
+		}
+	case m.CreateLease != nil:
+		items := make([]billingtypes.LeaseItemInput, 0, len(m.CreateLease.Items))
+		for _, item := range m.CreateLease.Items {
+			items = append(items, billingtypes.LeaseItemInput{
+				SkuUuid:     item.SKUUUID,
+				Quantity:    item.Quantity,
+				ServiceName: item.ServiceName,
+			})
+		}
+		create := &billingtypes.MsgCreateLease{
+			Tenant:   sender.String(),
+			Items:    items,
+			MetaHash: m.CreateLease.MetaHash,
+		}
+		if m.CreateLease.ScheduledEndAt != nil {
+			// #nosec G115 -- values past MaxInt64 wrap to before the epoch, which CreateLease rejects as not in the future
+			endAt := time.Unix(0, int64(*m.CreateLease.ScheduledEndAt)).UTC()
+			create.ScheduledEndAt = &endAt
+		}
+		msg = create
+	case m.CloseLease != nil:
+		msg = &billingtypes.MsgCloseLease{
+			Sender:     sender.String(),
+			LeaseUuids: m.CloseLease.LeaseUUIDs,
+			Reason:     m.CloseLease.Reason,
+		}
+	case m.Withdraw != nil:
+		msg = &billingtypes.MsgWithdraw{
+			Sender:       sender.String(),
+			LeaseUuids:   m.Withdraw.LeaseUUIDs,
+			ProviderUuid: m.Withdraw.ProviderUUID,
+			Limit:        m.Withdraw.Limit,
+		}
+	default:
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown manifest msg variant"}
+	}
+	return []sdk.Msg{msg}, nil
+}
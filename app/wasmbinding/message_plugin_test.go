@@ -0,0 +1,142 @@
+package wasmbinding_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/app/wasmbinding"
+	billingtypes "github.com/manifest-network/manifest-ledger/x/billing/types"
+)
+
+func encode(t *testing.T, sender sdk.AccAddress, m wasmbinding.ManifestMsg) ([]sdk.Msg, error) {
+	t.Helper()
+	raw, err := json.Marshal(m)
+	require.NoError(t, err)
+	return wasmbinding.EncodeManifestMsg(sender, raw)
+}
+
+// dispatch routes msgs the way wasmd does after encoding them.
+func (f *bindingsFixture) dispatch(t *testing.T, msgs []sdk.Msg) {
+	t.Helper()
+	for _, msg := range msgs {
+		handler := f.app.MsgServiceRouter().Handler(msg)
+		require.NotNil(t, handler)
+		_, err := handler(f.ctx, msg)
+		require.NoError(t, err)
+	}
+}
+
+func TestEncodeManifestMsg(t *testing.T) {
+	sender := sdk.AccAddress("contract____________")
+	endAt := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	endAtNanos := wasmvmtypes.Uint64(endAt.UnixNano())
+
+	tests := []struct {
+		name string
+		msg  wasmbinding.ManifestMsg
+		want sdk.Msg
+	}{
+		{
+			name: "fund own credit",
+			msg: wasmbinding.ManifestMsg{FundCredit: &wasmbinding.FundCredit{
+				Amount: wasmvmtypes.NewCoin(500, testDenom),
+			}},
+			want: &billingtypes.MsgFundCredit{
+				Sender: sender.String(),
+				Tenant: sender.String(),
+				Amount: sdk.NewCoin(testDenom, sdkmath.NewInt(500)),
+			},
+		},
+		{
+			name: "create lease",
+			msg: wasmbinding.ManifestMsg{CreateLease: &wasmbinding.CreateLease{
+				Items:          []wasmbinding.LeaseItem{{SKUUUID: "sku", Quantity: 3, ServiceName: "web"}},
+				MetaHash:       []byte{0xde, 0xad},
+				ScheduledEndAt: &endAtNanos,
+			}},
+			want: &billingtypes.MsgCreateLease{
+				Tenant:         sender.String(),
+				Items:          []billingtypes.LeaseItemInput{{SkuUuid: "sku", Quantity: 3, ServiceName: "web"}},
+				MetaHash:       []byte{0xde, 0xad},
+				ScheduledEndAt: &endAt,
+			},
+		},
+		{
+			name: "close lease",
+			msg: wasmbinding.ManifestMsg{CloseLease: &wasmbinding.CloseLease{
+				LeaseUUIDs: []string{"a", "b"},
+				Reason:     "done",
+			}},
+			want: &billingtypes.MsgCloseLease{Sender: sender.String(), LeaseUuids: []string{"a", "b"}, Reason: "done"},
+		},
+		{
+			name: "withdraw",
+			msg: wasmbinding.ManifestMsg{Withdraw: &wasmbinding.Withdraw{
+				ProviderUUID: "provider",
+				Limit:        5,
+			}},
+			want: &billingtypes.MsgWithdraw{Sender: sender.String(), ProviderUuid: "provider", Limit: 5},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msgs, err := encode(t, sender, tc.msg)
+			require.NoError(t, err)
+			require.Equal(t, []sdk.Msg{tc.want}, msgs)
+		})
+	}
+
+	_, err := wasmbinding.EncodeManifestMsg(sender, []byte(`{"reject_lease":{}}`))
+	require.ErrorAs(t, err, &wasmvmtypes.UnsupportedRequest{})
+
+	_, err = wasmbinding.EncodeManifestMsg(sender, []byte(`{"fund_credit":{"amount":{"denom":"umfx","amount":"x"}}}`))
+	require.ErrorAs(t, err, &wasmvmtypes.InvalidRequest{})
+}
+
+func TestEncodeManifestMsg_LeaseLifecycle(t *testing.T) {
+	f := setupBindings(t)
+	contract := f.tenant
+
+	msgs, err := encode(t, contract, wasmbinding.ManifestMsg{FundCredit: &wasmbinding.FundCredit{
+		Amount: wasmvmtypes.NewCoin(100_000, testDenom),
+	}})
+	require.NoError(t, err)
+	f.dispatch(t, msgs)
+
+	msgs, err = encode(t, contract, wasmbinding.ManifestMsg{CreateLease: &wasmbinding.CreateLease{
+		Items: []wasmbinding.LeaseItem{{SKUUUID: f.sku.Uuid, Quantity: 1}},
+	}})
+	require.NoError(t, err)
+	f.dispatch(t, msgs)
+
+	leases, err := f.app.BillingKeeper.GetLeasesByTenant(f.ctx, contract.String())
+	require.NoError(t, err)
+	require.Len(t, leases, 1)
+	require.Equal(t, billingtypes.LEASE_STATE_PENDING, leases[0].State)
+
+	// Only ACTIVE leases can be closed; the provider acknowledges directly.
+	_, err = f.app.MsgServiceRouter().Handler(&billingtypes.MsgAcknowledgeLease{})(f.ctx, &billingtypes.MsgAcknowledgeLease{
+		Sender:     f.provider.Address,
+		LeaseUuids: []string{leases[0].Uuid},
+	})
+	require.NoError(t, err)
+
+	msgs, err = encode(t, contract, wasmbinding.ManifestMsg{CloseLease: &wasmbinding.CloseLease{
+		LeaseUUIDs: []string{leases[0].Uuid},
+	}})
+	require.NoError(t, err)
+	f.dispatch(t, msgs)
+
+	lease, err := f.app.BillingKeeper.GetLease(f.ctx, leases[0].Uuid)
+	require.NoError(t, err)
+	require.Equal(t, billingtypes.LEASE_STATE_CLOSED, lease.State)
+}
@@ -0,0 +1,70 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/gogoproto/proto"
+
+	billingkeeper "github.com/manifest-network/manifest-ledger/x/billing/keeper"
+	billingtypes "github.com/manifest-network/manifest-ledger/x/billing/types"
+	skukeeper "github.com/manifest-network/manifest-ledger/x/sku/keeper"
+	skutypes "github.com/manifest-network/manifest-ledger/x/sku/types"
+)
+
+// CustomQuerier answers ManifestQuery requests through the SKU and billing
+// gRPC queriers. Responses are the gRPC responses in proto JSON, the same
+// encoding the REST gateway serves, so contracts can reuse those schemas.
+func CustomQuerier(sku *skukeeper.Keeper, billing *billingkeeper.Keeper) wasmkeeper.CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var q ManifestQuery
+		if err := json.Unmarshal(request, &q); err != nil {
+			return nil, errorsmod.Wrap(err, "manifest query")
+		}
+
+		skuQuerier := skukeeper.NewQuerier(*sku)
+		billingQuerier := billingkeeper.NewQuerier(*billing)
+
+		var (
+			res proto.Message
+			err error
+		)
+		switch {
+		case q.SKU != nil:
+			res, err = skuQuerier.SKU(ctx, &skutypes.QuerySKURequest{Uuid: q.SKU.UUID})
+		case q.SKUsByProvider != nil:
+			req := &skutypes.QuerySKUsByProviderRequest{
+				ProviderUuid: q.SKUsByProvider.ProviderUUID,
+				ActiveOnly:   q.SKUsByProvider.ActiveOnly,
+			}
+			if p := q.SKUsByProvider.Pagination; p != nil {
+				req.Pagination = &query.PageRequest{Key: p.Key, Limit: p.Limit}
+			}
+			res, err = skuQuerier.SKUsByProvider(ctx, req)
+		case q.Lease != nil:
+			res, err = billingQuerier.Lease(ctx, &billingtypes.QueryLeaseRequest{LeaseUuid: q.Lease.UUID})
+		case q.CreditAccount != nil:
+			res, err = billingQuerier.CreditAccount(ctx, &billingtypes.QueryCreditAccountRequest{Tenant: q.CreditAccount.Tenant})
+		case q.CreditEstimate != nil:
+			res, err = billingQuerier.CreditEstimate(ctx, &billingtypes.QueryCreditEstimateRequest{Tenant: q.CreditEstimate.Tenant})
+		default:
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown manifest query variant"}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := codec.ProtoMarshalJSON(res, nil)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "marshal manifest query response")
+		}
+		return bz, nil
+	}
+}
@@ -0,0 +1,142 @@
+package wasmbinding_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/app"
+	"github.com/manifest-network/manifest-ledger/app/apptesting"
+	"github.com/manifest-network/manifest-ledger/app/wasmbinding"
+	billingkeeper "github.com/manifest-network/manifest-ledger/x/billing/keeper"
+	billingtypes "github.com/manifest-network/manifest-ledger/x/billing/types"
+	skutypes "github.com/manifest-network/manifest-ledger/x/sku/types"
+)
+
+const testDenom = "umfx"
+
+type bindingsFixture struct {
+	app      *app.ManifestApp
+	ctx      sdk.Context
+	tenant   sdk.AccAddress
+	provider skutypes.Provider
+	sku      skutypes.SKU
+}
+
+// setupBindings creates a provider with one SKU priced at 1umfx per second
+// and gives the tenant spendable funds.
+func setupBindings(t *testing.T) *bindingsFixture {
+	t.Helper()
+	ctx, a := app.Setup(t)
+	require.NoError(t, a.BillingKeeper.SetParams(ctx, billingtypes.DefaultParams()))
+	accs := apptesting.CreateRandomAccounts(2)
+
+	providerUUID, err := a.SKUKeeper.GenerateProviderUUID(ctx)
+	require.NoError(t, err)
+	provider := skutypes.Provider{Uuid: providerUUID, Address: accs[1].String(), PayoutAddress: accs[1].String(), Active: true}
+	require.NoError(t, a.SKUKeeper.SetProvider(ctx, provider))
+
+	skuUUID, err := a.SKUKeeper.GenerateSKUUUID(ctx)
+	require.NoError(t, err)
+	sku := skutypes.SKU{
+		Uuid:         skuUUID,
+		ProviderUuid: providerUUID,
+		Name:         "Bindings SKU",
+		Unit:         skutypes.Unit_UNIT_PER_HOUR,
+		BasePrice:    sdk.NewCoin(testDenom, sdkmath.NewInt(3600)),
+		Active:       true,
+	}
+	require.NoError(t, a.SKUKeeper.SetSKU(ctx, sku))
+
+	coins := sdk.NewCoins(sdk.NewCoin(testDenom, sdkmath.NewInt(1_000_000)))
+	require.NoError(t, a.BankKeeper.MintCoins(ctx, "mint", coins))
+	require.NoError(t, a.BankKeeper.SendCoinsFromModuleToAccount(ctx, "mint", accs[0], coins))
+
+	return &bindingsFixture{app: a, ctx: ctx, tenant: accs[0], provider: provider, sku: sku}
+}
+
+func (f *bindingsFixture) query(t *testing.T, q wasmbinding.ManifestQuery) (map[string]any, error) {
+	t.Helper()
+	req, err := json.Marshal(q)
+	require.NoError(t, err)
+	bz, err := wasmbinding.CustomQuerier(&f.app.SKUKeeper, &f.app.BillingKeeper)(f.ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var res map[string]any
+	require.NoError(t, json.Unmarshal(bz, &res))
+	return res, nil
+}
+
+func TestCustomQuerier_SKU(t *testing.T) {
+	f := setupBindings(t)
+
+	res, err := f.query(t, wasmbinding.ManifestQuery{SKU: &wasmbinding.SKUQuery{UUID: f.sku.Uuid}})
+	require.NoError(t, err)
+	sku := res["sku"].(map[string]any)
+	require.Equal(t, f.sku.Uuid, sku["uuid"])
+	require.Equal(t, "UNIT_PER_HOUR", sku["unit"])
+	require.Equal(t, "3600", sku["base_price"].(map[string]any)["amount"])
+
+	_, err = f.query(t, wasmbinding.ManifestQuery{SKU: &wasmbinding.SKUQuery{UUID: "missing"}})
+	require.Error(t, err)
+}
+
+func TestCustomQuerier_SKUsByProvider(t *testing.T) {
+	f := setupBindings(t)
+
+	res, err := f.query(t, wasmbinding.ManifestQuery{SKUsByProvider: &wasmbinding.SKUsByProviderQuery{
+		ProviderUUID: f.provider.Uuid,
+		ActiveOnly:   true,
+		Pagination:   &wasmbinding.PageRequest{Limit: 10},
+	}})
+	require.NoError(t, err)
+	skus := res["skus"].([]any)
+	require.Len(t, skus, 1)
+	require.Equal(t, f.sku.Uuid, skus[0].(map[string]any)["uuid"])
+}
+
+func TestCustomQuerier_LeaseAndCredit(t *testing.T) {
+	f := setupBindings(t)
+	ms := billingkeeper.NewMsgServerImpl(f.app.BillingKeeper)
+
+	_, err := ms.FundCredit(f.ctx, &billingtypes.MsgFundCredit{
+		Sender: f.tenant.String(),
+		Tenant: f.tenant.String(),
+		Amount: sdk.NewCoin(testDenom, sdkmath.NewInt(100_000)),
+	})
+	require.NoError(t, err)
+	created, err := ms.CreateLease(f.ctx, &billingtypes.MsgCreateLease{
+		Tenant: f.tenant.String(),
+		Items:  []billingtypes.LeaseItemInput{{SkuUuid: f.sku.Uuid, Quantity: 2}},
+	})
+	require.NoError(t, err)
+
+	res, err := f.query(t, wasmbinding.ManifestQuery{Lease: &wasmbinding.LeaseQuery{UUID: created.LeaseUuid}})
+	require.NoError(t, err)
+	lease := res["lease"].(map[string]any)
+	require.Equal(t, created.LeaseUuid, lease["uuid"])
+	require.Equal(t, "LEASE_STATE_PENDING", lease["state"])
+
+	res, err = f.query(t, wasmbinding.ManifestQuery{CreditAccount: &wasmbinding.CreditAccountQuery{Tenant: f.tenant.String()}})
+	require.NoError(t, err)
+	require.Equal(t, f.tenant.String(), res["credit_account"].(map[string]any)["tenant"])
+	require.Equal(t, "100000", res["balances"].([]any)[0].(map[string]any)["amount"])
+
+	res, err = f.query(t, wasmbinding.ManifestQuery{CreditEstimate: &wasmbinding.CreditEstimateQuery{Tenant: f.tenant.String()}})
+	require.NoError(t, err)
+	require.Contains(t, res, "estimated_duration_seconds")
+}
+
+func TestCustomQuerier_UnknownVariant(t *testing.T) {
+	f := setupBindings(t)
+
+	_, err := wasmbinding.CustomQuerier(&f.app.SKUKeeper, &f.app.BillingKeeper)(f.ctx, []byte(`{"provider":{"uuid":"x"}}`))
+	require.ErrorAs(t, err, &wasmvmtypes.UnsupportedRequest{})
+}
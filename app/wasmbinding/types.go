@@ -0,0 +1,102 @@
+package wasmbinding
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+)
+
+// CapabilityManifest is the capability a contract declares, through the
+// requires_manifest feature of its bindings crate, to use these bindings.
+const CapabilityManifest = "manifest"
+
+// ManifestQuery is the custom query a contract sends with
+// QueryRequest::Custom. Exactly one field is set; it mirrors a Rust enum
+// serialized with serde's snake_case external tagging.
+type ManifestQuery struct {
+	SKU            *SKUQuery            `json:"sku,omitempty"`
+	SKUsByProvider *SKUsByProviderQuery `json:"skus_by_provider,omitempty"`
+	Lease          *LeaseQuery          `json:"lease,omitempty"`
+	CreditAccount  *CreditAccountQuery  `json:"credit_account,omitempty"`
+	CreditEstimate *CreditEstimateQuery `json:"credit_estimate,omitempty"`
+}
+
+// SKUQuery returns a single SKU by UUID.
+type SKUQuery struct {
+	UUID string `json:"uuid"`
+}
+
+// SKUsByProviderQuery returns a page of a provider's SKUs.
+type SKUsByProviderQuery struct {
+	ProviderUUID string       `json:"provider_uuid"`
+	ActiveOnly   bool         `json:"active_only,omitempty"`
+	Pagination   *PageRequest `json:"pagination,omitempty"`
+}
+
+// PageRequest is the subset of the SDK's key-based pagination offered to
+// contracts. Key is the next_key of a previous response.
+type PageRequest struct {
+	Key   []byte `json:"key,omitempty"`
+	Limit uint64 `json:"limit,omitempty"`
+}
+
+// LeaseQuery returns a single lease by UUID.
+type LeaseQuery struct {
+	UUID string `json:"uuid"`
+}
+
+// CreditAccountQuery returns a tenant's credit account and balances.
+type CreditAccountQuery struct {
+	Tenant string `json:"tenant"`
+}
+
+// CreditEstimateQuery returns how long a tenant's credit lasts at the
+// current burn rate.
+type CreditEstimateQuery struct {
+	Tenant string `json:"tenant"`
+}
+
+// ManifestMsg is the custom message a contract sends with CosmosMsg::Custom.
+// Exactly one field is set. The contract is always the sender: it funds
+// credit, holds leases as their tenant, and withdraws as a provider.
+type ManifestMsg struct {
+	FundCredit  *FundCredit  `json:"fund_credit,omitempty"`
+	CreateLease *CreateLease `json:"create_lease,omitempty"`
+	CloseLease  *CloseLease  `json:"close_lease,omitempty"`
+	Withdraw    *Withdraw    `json:"withdraw,omitempty"`
+}
+
+// FundCredit moves Amount from the contract to Tenant's credit account.
+// An empty Tenant funds the contract's own credit account.
+type FundCredit struct {
+	Tenant string           `json:"tenant,omitempty"`
+	Amount wasmvmtypes.Coin `json:"amount"`
+}
+
+// CreateLease creates a PENDING lease with the contract as tenant.
+type CreateLease struct {
+	Items    []LeaseItem `json:"items"`
+	MetaHash []byte      `json:"meta_hash,omitempty"`
+	// ScheduledEndAt is a block time in nanoseconds since the Unix epoch,
+	// the encoding of cosmwasm_std::Timestamp.
+	ScheduledEndAt *wasmvmtypes.Uint64 `json:"scheduled_end_at,omitempty"`
+}
+
+// LeaseItem is one SKU and quantity of a lease.
+type LeaseItem struct {
+	SKUUUID     string `json:"sku_uuid"`
+	Quantity    uint64 `json:"quantity"`
+	ServiceName string `json:"service_name,omitempty"`
+}
+
+// CloseLease closes leases the contract holds as tenant or provider.
+type CloseLease struct {
+	LeaseUUIDs []string `json:"lease_uuids"`
+	Reason     string   `json:"reason,omitempty"`
+}
+
+// Withdraw withdraws accrued funds for a provider whose address is the
+// contract, either from the given leases or provider-wide.
+type Withdraw struct {
+	LeaseUUIDs   []string `json:"lease_uuids,omitempty"`
+	ProviderUUID string   `json:"provider_uuid,omitempty"`
+	Limit        uint64   `json:"limit,omitempty"`
+}
@@ -0,0 +1,25 @@
+// Package wasmbinding implements the "manifest" CosmWasm capability: custom
+// queries over the SKU catalog and billing state, and custom messages that
+// let contracts fund credit and manage leases.
+package wasmbinding
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	billingkeeper "github.com/manifest-network/manifest-ledger/x/billing/keeper"
+	skukeeper "github.com/manifest-network/manifest-ledger/x/sku/keeper"
+)
+
+// RegisterCustomPlugins returns the wasm keeper options that install the
+// manifest query plugin and message encoder. The keepers are read when a
+// contract calls in, so they may still be configured after this returns.
+func RegisterCustomPlugins(sku *skukeeper.Keeper, billing *billingkeeper.Keeper) []wasmkeeper.Option {
+	return []wasmkeeper.Option{
+		wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
+			Custom: CustomQuerier(sku, billing),
+		}),
+		wasmkeeper.WithMessageEncoders(&wasmkeeper.MessageEncoders{
+			Custom: EncodeManifestMsg,
+		}),
+	}
+}
@@ -279,7 +279,7 @@ func testCreditEstimateQueryIndependent(t *testing.T, ctx context.Context, tc *b
 		require.NoError(t, err)
 		require.NotNil(t, res)
 		require.Equal(t, uint64(0), res.ActiveLeaseCount)
-		require.True(t, res.TotalRatePerSecond.IsZero())
+		require.True(t, res.TotalRatePerSecondDec.IsZero())
 		t.Logf("Balance: %s, Active leases: %d", res.CurrentBalance, res.ActiveLeaseCount)
 	})
 
@@ -294,10 +294,10 @@ func testCreditEstimateQueryIndependent(t *testing.T, ctx context.Context, tc *b
 		require.NoError(t, err)
 		require.NotNil(t, res)
 		require.Equal(t, uint64(1), res.ActiveLeaseCount)
-		require.False(t, res.TotalRatePerSecond.IsZero())
+		require.False(t, res.TotalRatePerSecondDec.IsZero())
 		require.Greater(t, res.EstimatedDurationSeconds, uint64(0))
 		t.Logf("Balance: %s, Rate/sec: %s, Duration: %ds",
-			res.CurrentBalance, res.TotalRatePerSecond, res.EstimatedDurationSeconds)
+			res.CurrentBalance, res.TotalRatePerSecondDec, res.EstimatedDurationSeconds)
 	})
 
 	t.Run("fail: estimate for non-existent credit account", func(t *testing.T) {
@@ -0,0 +1,132 @@
+//! Test contract for the `manifest` custom bindings.
+//!
+//! Every execute call forwards a `ManifestMsg` with the contract as sender,
+//! and every query forwards a `ManifestQuery` and returns the chain's answer
+//! unchanged. The binding types mirror app/wasmbinding/types.go.
+
+use cosmwasm_schema::cw_serde;
+use cosmwasm_std::{
+    entry_point, to_json_vec, Binary, Coin, ContractResult, CosmosMsg, CustomMsg, CustomQuery,
+    Deps, DepsMut, Env, MessageInfo, QueryRequest, Response, StdError, StdResult, SystemResult,
+    Timestamp,
+};
+
+/// Exported so wasmd only accepts this code on chains with the `manifest`
+/// capability.
+#[no_mangle]
+extern "C" fn requires_manifest() {}
+
+#[cw_serde]
+pub struct PageRequest {
+    pub key: Option<Binary>,
+    pub limit: Option<u64>,
+}
+
+#[cw_serde]
+pub enum ManifestQuery {
+    Sku {
+        uuid: String,
+    },
+    SkusByProvider {
+        provider_uuid: String,
+        active_only: Option<bool>,
+        pagination: Option<PageRequest>,
+    },
+    Lease {
+        uuid: String,
+    },
+    CreditAccount {
+        tenant: String,
+    },
+    CreditEstimate {
+        tenant: String,
+    },
+}
+
+impl CustomQuery for ManifestQuery {}
+
+#[cw_serde]
+pub struct LeaseItem {
+    pub sku_uuid: String,
+    pub quantity: u64,
+    pub service_name: Option<String>,
+}
+
+#[cw_serde]
+pub enum ManifestMsg {
+    FundCredit {
+        tenant: Option<String>,
+        amount: Coin,
+    },
+    CreateLease {
+        items: Vec<LeaseItem>,
+        meta_hash: Option<Binary>,
+        scheduled_end_at: Option<Timestamp>,
+    },
+    CloseLease {
+        lease_uuids: Vec<String>,
+        reason: Option<String>,
+    },
+    Withdraw {
+        lease_uuids: Vec<String>,
+        provider_uuid: Option<String>,
+        limit: Option<u64>,
+    },
+}
+
+impl CustomMsg for ManifestMsg {}
+
+#[cw_serde]
+pub struct InstantiateMsg {}
+
+#[cw_serde]
+pub enum ExecuteMsg {
+    Manifest { msg: ManifestMsg },
+}
+
+#[cw_serde]
+pub enum QueryMsg {
+    Manifest { query: ManifestQuery },
+}
+
+#[entry_point]
+pub fn instantiate(
+    _deps: DepsMut<ManifestQuery>,
+    _env: Env,
+    _info: MessageInfo,
+    _msg: InstantiateMsg,
+) -> StdResult<Response<ManifestMsg>> {
+    Ok(Response::new())
+}
+
+#[entry_point]
+pub fn execute(
+    _deps: DepsMut<ManifestQuery>,
+    _env: Env,
+    _info: MessageInfo,
+    msg: ExecuteMsg,
+) -> StdResult<Response<ManifestMsg>> {
+    match msg {
+        ExecuteMsg::Manifest { msg } => Ok(Response::new()
+            .add_attribute("action", "manifest")
+            .add_message(CosmosMsg::Custom(msg))),
+    }
+}
+
+#[entry_point]
+pub fn query(deps: Deps<ManifestQuery>, _env: Env, msg: QueryMsg) -> StdResult<Binary> {
+    match msg {
+        QueryMsg::Manifest { query } => {
+            let request: QueryRequest<ManifestQuery> = QueryRequest::Custom(query);
+            match deps.querier.raw_query(&to_json_vec(&request)?) {
+                SystemResult::Err(err) => Err(StdError::generic_err(format!(
+                    "querier system error: {err}"
+                ))),
+                SystemResult::Ok(ContractResult::Err(err)) => Err(StdError::generic_err(
+                    format!("querier contract error: {err}"),
+                )),
+                SystemResult::Ok(ContractResult::Ok(value)) => Ok(value),
+            }
+        }
+    }
+}
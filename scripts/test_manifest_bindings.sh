@@ -0,0 +1,85 @@
+#!/bin/bash
+# Exercises the manifest CosmWasm bindings against a node started with
+# test_node.sh: builds scripts/manifest_bindings with the CosmWasm optimizer,
+# creates a provider and SKU, then leases it through the contract.
+
+# Import environment variables that match test_node.sh
+export KEY="user1"
+export KEYRING="test"
+export CHAIN_ID=${CHAIN_ID:-"local-1"}
+export HOME_DIR=$(eval echo "${HOME_DIR:-"~/.manifest"}")
+export BINARY=${BINARY:-manifestd}
+export DENOM=${DENOM:-"umfx"}
+export OPTIMIZER=${OPTIMIZER:-"cosmwasm/optimizer:0.16.1"}
+
+set -e
+
+SCRIPT_DIR=$(cd "$(dirname "$0")" && pwd)
+CONTRACT_DIR="$SCRIPT_DIR/manifest_bindings"
+WASM="$CONTRACT_DIR/artifacts/manifest_bindings_test.wasm"
+
+# Add keys if they don't exist (same seeds as test_node.sh)
+echo "decorate bright ozone fork gallery riot bus exhaust worth way bone indoor calm squirrel merry zero scheme cotton until shop any excess stage laundry" | $BINARY keys add $KEY --keyring-backend $KEYRING --algo secp256k1 --recover --home=$HOME_DIR 2>/dev/null || true
+USER_ADDR=$($BINARY keys show $KEY -a --keyring-backend $KEYRING --home=$HOME_DIR)
+
+# Common flags using the environment variables
+FLAGS="--gas=2500000 --from=$KEY --keyring-backend=$KEYRING --chain-id=$CHAIN_ID --output=json --yes --home=$HOME_DIR"
+
+tx_event_attr() { # txhash event attribute
+  $BINARY q tx "$1" --output=json --home=$HOME_DIR | jq -r ".events[] | select(.type==\"$2\") | .attributes[] | select(.key==\"$3\") | .value" | head -n1
+}
+
+contract_query() {
+  $BINARY q wasm contract-state smart "$CONTRACT" "{\"manifest\":{\"query\":$1}}" --output=json --home=$HOME_DIR | jq .data
+}
+
+contract_exec() {
+  $BINARY tx wasm execute "$CONTRACT" "{\"manifest\":{\"msg\":$1}}" $2 $FLAGS | jq -r .txhash
+}
+
+if [ ! -f "$WASM" ]; then
+  echo "Building contract..."
+  docker run --rm -v "$CONTRACT_DIR":/code \
+    --mount type=volume,source=manifest_bindings_cache,target=/target \
+    --mount type=volume,source=registry_cache,target=/usr/local/cargo/registry \
+    $OPTIMIZER
+fi
+
+echo "Creating provider and SKU..."
+txhash=$($BINARY tx sku create-provider $USER_ADDR $USER_ADDR $FLAGS | jq -r .txhash)
+sleep 3
+PROVIDER=$(tx_event_attr $txhash provider_created provider_uuid) && echo "Provider: $PROVIDER"
+txhash=$($BINARY tx sku create-sku $PROVIDER "Bindings Test" 1 3600$DENOM $FLAGS | jq -r .txhash)
+sleep 3
+SKU=$(tx_event_attr $txhash sku_created sku_uuid) && echo "SKU: $SKU"
+
+echo "Storing and instantiating contract..."
+$BINARY tx wasm store "$WASM" $FLAGS > /dev/null
+sleep 3
+CODE_ID=$($BINARY q wasm list-code --output=json --home=$HOME_DIR | jq -r '.code_infos[-1].code_id')
+txhash=$($BINARY tx wasm instantiate $CODE_ID '{}' --label=manifest_bindings --no-admin --amount=100000000$DENOM $FLAGS | jq -r .txhash)
+sleep 3
+CONTRACT=$(tx_event_attr $txhash instantiate _contract_address) && echo "Contract: $CONTRACT"
+
+echo "Querying the SKU catalog through the contract..."
+contract_query "{\"sku\":{\"uuid\":\"$SKU\"}}"
+contract_query "{\"skus_by_provider\":{\"provider_uuid\":\"$PROVIDER\",\"active_only\":true}}"
+
+echo "Funding the contract's credit account and leasing the SKU..."
+contract_exec "{\"fund_credit\":{\"amount\":{\"denom\":\"$DENOM\",\"amount\":\"50000000\"}}}" > /dev/null
+sleep 3
+txhash=$(contract_exec "{\"create_lease\":{\"items\":[{\"sku_uuid\":\"$SKU\",\"quantity\":1}]}}")
+sleep 3
+LEASE=$(tx_event_attr $txhash lease_created lease_uuid) && echo "Lease: $LEASE"
+
+$BINARY tx billing acknowledge-lease $LEASE $FLAGS > /dev/null
+sleep 3
+
+contract_query "{\"lease\":{\"uuid\":\"$LEASE\"}}"
+contract_query "{\"credit_account\":{\"tenant\":\"$CONTRACT\"}}"
+contract_query "{\"credit_estimate\":{\"tenant\":\"$CONTRACT\"}}"
+
+echo "Closing the lease through the contract..."
+contract_exec "{\"close_lease\":{\"lease_uuids\":[\"$LEASE\"]}}" > /dev/null
+sleep 3
+contract_query "{\"lease\":{\"uuid\":\"$LEASE\"}}" | jq -r .lease.state
@@ -35,6 +35,23 @@ func parseLeaseState(s string) (types.LeaseState, error) {
 	}
 }
 
+// parseDisputeState parses a string into a DisputeState enum value.
+// An empty string returns DISPUTE_STATE_UNSPECIFIED (no filter).
+func parseDisputeState(s string) (types.DisputeState, error) {
+	switch strings.ToLower(s) {
+	case "":
+		return types.DISPUTE_STATE_UNSPECIFIED, nil
+	case "open":
+		return types.DISPUTE_STATE_OPEN, nil
+	case "resolved":
+		return types.DISPUTE_STATE_RESOLVED, nil
+	case "defaulted":
+		return types.DISPUTE_STATE_DEFAULTED, nil
+	default:
+		return types.DISPUTE_STATE_UNSPECIFIED, fmt.Errorf("unknown dispute state %q: valid values are open, resolved, defaulted", s)
+	}
+}
+
 // GetQueryCmd returns the query commands for the billing module.
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -56,9 +73,21 @@ func GetQueryCmd() *cobra.Command {
 		GetCreditAccountsCmd(),
 		GetCreditAddressCmd(),
 		GetCreditEstimateCmd(),
+		GetAvailableCreditCmd(),
 		GetWithdrawableAmountCmd(),
 		GetProviderWithdrawableCmd(),
 		GetLeaseByCustomDomainCmd(),
+		GetLeaseAmendmentCmd(),
+		GetLeaseAmendmentsCmd(),
+		GetProviderPolicyCmd(),
+		GetProviderPoliciesCmd(),
+		GetProviderStatsCmd(),
+		GetLeaseSettlementsCmd(),
+		GetExchangeRatesCmd(),
+		GetSKUAvailabilityCmd(),
+		GetLeaseUsageCmd(),
+		GetDisputeCmd(),
+		GetDisputesCmd(),
 	)
 
 	return cmd
@@ -559,6 +588,45 @@ Returns:
 	return cmd
 }
 
+// GetAvailableCreditCmd returns the command to query a tenant's withdrawable credit.
+func GetAvailableCreditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "available-credit [tenant]",
+		Short: "Query the credit a tenant can withdraw",
+		Long: `Query how much credit a tenant can withdraw with withdraw-credit.
+
+Returns:
+  - Current credit balance
+  - Amounts reserved by pending and active leases
+  - Usage accrued by active leases but not yet settled
+  - Withdrawable amount (balance - reserved - unsettled)
+  - The time the withdrawal cooldown ends, if it is still running`,
+		Example: `available-credit manifest1abc...`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AvailableCredit(cmd.Context(), &types.QueryAvailableCreditRequest{
+				Tenant: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetLeaseByCustomDomainCmd returns the command to look up a lease by its custom_domain.
 func GetLeaseByCustomDomainCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -585,3 +653,405 @@ func GetLeaseByCustomDomainCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetLeaseAmendmentCmd returns the command to query the pending amendment of a lease.
+func GetLeaseAmendmentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "lease-amendment [lease-uuid]",
+		Short:   "Query the pending amendment of a lease",
+		Example: `lease-amendment 01902a9b-1234-7000-8000-000000000001`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			leaseUUID := args[0]
+			if !pkguuid.IsValidUUID(leaseUUID) {
+				return fmt.Errorf("invalid lease_uuid format: %s", leaseUUID)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LeaseAmendment(cmd.Context(), &types.QueryLeaseAmendmentRequest{LeaseUuid: leaseUUID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetLeaseAmendmentsCmd returns the command to query pending lease amendments.
+func GetLeaseAmendmentsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "lease-amendments",
+		Short:   "Query pending lease amendments with pagination",
+		Example: `lease-amendments --provider 01902a9b-1234-7000-8000-000000000001 --limit 10`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			providerUUID, err := cmd.Flags().GetString("provider")
+			if err != nil {
+				return err
+			}
+			if providerUUID != "" && !pkguuid.IsValidUUID(providerUUID) {
+				return fmt.Errorf("invalid provider_uuid format: %s", providerUUID)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LeaseAmendments(cmd.Context(), &types.QueryLeaseAmendmentsRequest{
+				Pagination:   pageReq,
+				ProviderUuid: providerUUID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String("provider", "", "Filter by provider UUID")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "lease-amendments")
+
+	return cmd
+}
+
+// GetProviderPolicyCmd returns the command to query the lease policy of a provider.
+func GetProviderPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "provider-policy [provider-uuid]",
+		Short:   "Query the lease policy of a provider and its effective pending timeout and grace period",
+		Example: `provider-policy 01912345-6789-7abc-8def-0123456789ab`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ProviderPolicy(cmd.Context(), &types.QueryProviderPolicyRequest{
+				ProviderUuid: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetProviderPoliciesCmd returns the command to query all provider lease policies.
+func GetProviderPoliciesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "provider-policies",
+		Short:   "Query all provider lease policies with pagination",
+		Example: `provider-policies --limit 10`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ProviderPolicies(cmd.Context(), &types.QueryProviderPoliciesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "provider-policies")
+	return cmd
+}
+
+// GetProviderStatsCmd returns the command to query the lease statistics of a provider.
+func GetProviderStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "provider-stats [provider-uuid]",
+		Short:   "Query a provider's lease counters, acknowledgement latency and response rates",
+		Example: `provider-stats 01912345-6789-7abc-8def-0123456789ab`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ProviderStats(cmd.Context(), &types.QueryProviderStatsRequest{
+				ProviderUuid: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetSKUAvailabilityCmd returns the command to query how much of a SKU's
+// capacity is allocated to leases.
+func GetSKUAvailabilityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sku-availability [sku-uuid]",
+		Short: "Query a SKU's capacity, allocated quantity and remaining availability",
+		Long: `Query a SKU's capacity, the total quantity held by PENDING and ACTIVE
+leases, and the quantity still available to new leases.
+
+A capacity of zero means the SKU is unlimited.`,
+		Example: `sku-availability 01912345-6789-7abc-8def-0123456789ab`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			skuUUID := args[0]
+			if !pkguuid.IsValidUUID(skuUUID) {
+				return fmt.Errorf("invalid sku_uuid format: %s", skuUUID)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SKUAvailability(cmd.Context(), &types.QuerySKUAvailabilityRequest{
+				SkuUuid: skuUUID,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetLeaseUsageCmd returns the command to query the metering state of a lease.
+func GetLeaseUsageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "lease-usage [lease-uuid]",
+		Short:   "Query the usage counters and spend limit of a metered lease",
+		Example: `lease-usage 01902a9b-1234-7000-8000-000000000001`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			leaseUUID := args[0]
+			if !pkguuid.IsValidUUID(leaseUUID) {
+				return fmt.Errorf("invalid lease_uuid format: %s", leaseUUID)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LeaseUsage(cmd.Context(), &types.QueryLeaseUsageRequest{LeaseUuid: leaseUUID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetDisputeCmd returns the command to query the dispute of a lease.
+func GetDisputeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "dispute [lease-uuid]",
+		Short:   "Query the dispute of a lease",
+		Example: `dispute 01902a9b-1234-7000-8000-000000000001`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			leaseUUID := args[0]
+			if !pkguuid.IsValidUUID(leaseUUID) {
+				return fmt.Errorf("invalid lease_uuid format: %s", leaseUUID)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Dispute(cmd.Context(), &types.QueryDisputeRequest{LeaseUuid: leaseUUID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetDisputesCmd returns the command to query lease disputes.
+func GetDisputesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "disputes",
+		Short:   "Query lease disputes with pagination",
+		Example: `disputes --provider 01902a9b-1234-7000-8000-000000000001 --state open --limit 10`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			providerUUID, err := cmd.Flags().GetString("provider")
+			if err != nil {
+				return err
+			}
+			if providerUUID != "" && !pkguuid.IsValidUUID(providerUUID) {
+				return fmt.Errorf("invalid provider_uuid format: %s", providerUUID)
+			}
+
+			stateStr, err := cmd.Flags().GetString("state")
+			if err != nil {
+				return err
+			}
+			stateFilter, err := parseDisputeState(stateStr)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Disputes(cmd.Context(), &types.QueryDisputesRequest{
+				Pagination:   pageReq,
+				ProviderUuid: providerUUID,
+				State:        stateFilter,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String("provider", "", "Filter by provider UUID")
+	cmd.Flags().String("state", "", "Filter by dispute state (open, resolved, defaulted)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "disputes")
+
+	return cmd
+}
+
+// GetLeaseSettlementsCmd returns the command to query the settlement records of a lease.
+func GetLeaseSettlementsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "lease-settlements [lease-uuid]",
+		Short:   "Query the settlement records of a lease, oldest first, with pagination",
+		Example: `lease-settlements 01912345-6789-7abc-8def-0123456789ab --limit 20`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.LeaseSettlements(cmd.Context(), &types.QueryLeaseSettlementsRequest{
+				LeaseUuid:  args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "lease-settlements")
+	return cmd
+}
+
+// GetExchangeRatesCmd returns the command to query the exchange-rate table.
+func GetExchangeRatesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "exchange-rates",
+		Short:   "Query the exchange-rate table and the reference denom, with pagination",
+		Example: `exchange-rates --limit 20`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ExchangeRates(cmd.Context(), &types.QueryExchangeRatesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "exchange-rates")
+	return cmd
+}
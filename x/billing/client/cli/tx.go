@@ -2,12 +2,17 @@ package cli
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -29,8 +34,12 @@ func NewTxCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		NewFundCreditCmd(),
+		NewWithdrawCreditCmd(),
+		NewTransferCreditCmd(),
 		NewCreateLeaseCmd(),
 		NewCreateLeaseForTenantCmd(),
+		NewCreateLeasesCmd(),
+		NewCreateLeasesForTenantCmd(),
 		NewAcknowledgeLeaseCmd(),
 		NewRejectLeaseCmd(),
 		NewCancelLeaseCmd(),
@@ -38,6 +47,19 @@ func NewTxCmd() *cobra.Command {
 		NewWithdrawCmd(),
 		NewUpdateParamsCmd(),
 		NewSetItemCustomDomainCmd(),
+		NewUpdateLeaseItemsCmd(),
+		NewProposeLeaseAmendmentCmd(),
+		NewAcknowledgeLeaseAmendmentCmd(),
+		NewRejectLeaseAmendmentCmd(),
+		NewSetLeaseScheduledEndCmd(),
+		NewSetProviderPolicyCmd(),
+		NewReportUsageCmd(),
+		NewSetUsageSpendLimitCmd(),
+		NewOpenDisputeCmd(),
+		NewRespondToDisputeCmd(),
+		NewResolveDisputeCmd(),
+		NewGrantPromoCreditCmd(),
+		NewSetExchangeRatesCmd(),
 	)
 
 	return cmd
@@ -65,6 +87,77 @@ func parseMetaHashFlag(cmd *cobra.Command) ([]byte, error) {
 	return metaHash, nil
 }
 
+// parseScheduledEndFlag parses the optional --scheduled-end flag value.
+// Returns nil if the flag is empty.
+func parseScheduledEndFlag(cmd *cobra.Command) (*time.Time, error) {
+	value, _ := cmd.Flags().GetString("scheduled-end")
+	if value == "" {
+		return nil, nil
+	}
+	endAt, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scheduled-end: must be an RFC 3339 timestamp: %w", err)
+	}
+	return &endAt, nil
+}
+
+// leaseInputFileEntry is one lease of a create-leases JSON file.
+type leaseInputFileEntry struct {
+	Items        []string `json:"items"`
+	MetaHash     string   `json:"meta_hash,omitempty"`
+	ScheduledEnd string   `json:"scheduled_end,omitempty"`
+}
+
+// parseLeaseInputsFile reads a JSON array of leases for the batch creation
+// commands. Items use the same sku_uuid:quantity[:service_name] format as
+// create-lease, meta_hash is hex-encoded and scheduled_end is RFC 3339.
+func parseLeaseInputsFile(path string) ([]types.LeaseInput, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read leases file: %w", err)
+	}
+
+	var entries []leaseInputFileEntry
+	if err := json.Unmarshal(bz, &entries); err != nil {
+		return nil, fmt.Errorf("invalid leases file: %w", err)
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("leases file contains no leases")
+	}
+
+	leases := make([]types.LeaseInput, 0, len(entries))
+	for i, entry := range entries {
+		items, err := parseLeaseItemInputs(entry.Items)
+		if err != nil {
+			return nil, fmt.Errorf("lease %d: %w", i, err)
+		}
+
+		var metaHash []byte
+		if entry.MetaHash != "" {
+			metaHash, err = hex.DecodeString(entry.MetaHash)
+			if err != nil {
+				return nil, fmt.Errorf("lease %d: invalid meta_hash: must be hex-encoded: %w", i, err)
+			}
+		}
+
+		var scheduledEndAt *time.Time
+		if entry.ScheduledEnd != "" {
+			endAt, err := time.Parse(time.RFC3339, entry.ScheduledEnd)
+			if err != nil {
+				return nil, fmt.Errorf("lease %d: invalid scheduled_end: must be an RFC 3339 timestamp: %w", i, err)
+			}
+			scheduledEndAt = &endAt
+		}
+
+		leases = append(leases, types.LeaseInput{
+			Items:          items,
+			MetaHash:       metaHash,
+			ScheduledEndAt: scheduledEndAt,
+		})
+	}
+	return leases, nil
+}
+
 // parseLeaseItemInputs parses CLI arguments into LeaseItemInput values.
 // Format: sku_uuid:quantity or sku_uuid:quantity:service_name
 func parseLeaseItemInputs(args []string) ([]types.LeaseItemInput, error) {
@@ -137,6 +230,110 @@ fund-credit manifest1abc... 5000000umfx --from mykey`,
 	return cmd
 }
 
+// NewWithdrawCreditCmd returns the command to withdraw unreserved credit.
+func NewWithdrawCreditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-credit [recipient] [amount]",
+		Short: "Withdraw unreserved credit from your credit account",
+		Long: `Withdraw credit from the sender's credit account to the recipient address.
+Only credit that is not reserved by pending/active leases and not owed for
+unsettled lease usage can be withdrawn. Withdrawals are rejected until the
+credit_withdrawal_cooldown param has elapsed since the account was last funded.
+Use "query billing available-credit" to see the withdrawable amount.`,
+		Example: `withdraw-credit manifest1abc... 1000000umfx --from mykey
+withdraw-credit manifest1abc... 1000000umfx,500upwr --from mykey`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recipient := args[0]
+			if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+				return fmt.Errorf("invalid recipient address: %w", err)
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid amount: %w", err)
+			}
+
+			msg := &types.MsgWithdrawCredit{
+				Tenant:    clientCtx.GetFromAddress().String(),
+				Recipient: recipient,
+				Amount:    amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewTransferCreditCmd returns the command to move credit to another tenant.
+func NewTransferCreditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-credit [to-tenant] [amount]",
+		Short: "Transfer unreserved credit to another tenant's credit account",
+		Long: `Transfer credit from a tenant's credit account to another tenant's credit
+account, creating it if needed. The same credit as withdraw-credit is
+transferable, and the credit_withdrawal_cooldown applies to the source account.
+The transferred funds restart the destination account's cooldown.
+
+By default the sender's own credit is transferred. The authority may pass
+--from-tenant to transfer another tenant's credit; the cooldown does not apply
+to authority transfers.`,
+		Example: `transfer-credit manifest1abc... 1000000umfx --from mykey
+transfer-credit manifest1abc... 1000000umfx,500upwr --from-tenant manifest1xyz... --from authority`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toTenant := args[0]
+			if _, err := sdk.AccAddressFromBech32(toTenant); err != nil {
+				return fmt.Errorf("invalid to-tenant address: %w", err)
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid amount: %w", err)
+			}
+
+			sender := clientCtx.GetFromAddress().String()
+			fromTenant, err := cmd.Flags().GetString("from-tenant")
+			if err != nil {
+				return err
+			}
+			if fromTenant == "" {
+				fromTenant = sender
+			} else if _, err := sdk.AccAddressFromBech32(fromTenant); err != nil {
+				return fmt.Errorf("invalid from-tenant address: %w", err)
+			}
+
+			msg := &types.MsgTransferCredit{
+				Sender:     sender,
+				FromTenant: fromTenant,
+				ToTenant:   toTenant,
+				Amount:     amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String("from-tenant", "", "Tenant whose credit is transferred (authority only; defaults to the sender)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewCreateLeaseCmd returns the command to create a lease.
 func NewCreateLeaseCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -146,11 +343,13 @@ func NewCreateLeaseCmd() *cobra.Command {
 or sku_uuid:quantity:service_name for stack deployments. When service_name is used, all items
 must have one and the same SKU may appear multiple times with different service names.
 All SKUs must belong to the same provider.
-Use --meta-hash to include a hash/reference to off-chain deployment data (hex-encoded, max 64 bytes).`,
+Use --meta-hash to include a hash/reference to off-chain deployment data (hex-encoded, max 64 bytes).
+Use --scheduled-end to have the lease closed automatically at the given RFC 3339 time.`,
 		Example: `create-lease 01902a9b-1234-7000-8000-000000000001:2 01902a9b-1234-7000-8000-000000000002:1
 create-lease 01902a9b-1234-7000-8000-000000000005:10 --from mykey
 create-lease 01902a9b-1234-7000-8000-000000000001:1:web 01902a9b-1234-7000-8000-000000000001:1:db --from mykey
-create-lease 01902a9b-1234-7000-8000-000000000001:1 --meta-hash a1b2c3d4e5f6... --from mykey`,
+create-lease 01902a9b-1234-7000-8000-000000000001:1 --meta-hash a1b2c3d4e5f6... --from mykey
+create-lease 01902a9b-1234-7000-8000-000000000001:1 --scheduled-end 2026-01-04T00:00:00Z --from mykey`,
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -169,10 +368,16 @@ create-lease 01902a9b-1234-7000-8000-000000000001:1 --meta-hash a1b2c3d4e5f6...
 				return err
 			}
 
+			scheduledEndAt, err := parseScheduledEndFlag(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgCreateLease{
-				Tenant:   clientCtx.GetFromAddress().String(),
-				Items:    items,
-				MetaHash: metaHash,
+				Tenant:         clientCtx.GetFromAddress().String(),
+				Items:          items,
+				MetaHash:       metaHash,
+				ScheduledEndAt: scheduledEndAt,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -180,6 +385,96 @@ create-lease 01902a9b-1234-7000-8000-000000000001:1 --meta-hash a1b2c3d4e5f6...
 	}
 
 	cmd.Flags().String("meta-hash", "", "Hex-encoded hash/reference to off-chain deployment data (max 64 bytes)")
+	cmd.Flags().String("scheduled-end", "", "RFC 3339 time at which the lease is closed automatically")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCreateLeasesCmd returns the command to create several leases at once.
+func NewCreateLeasesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-leases [leases-file]",
+		Short: "Create several leases in one transaction",
+		Long: `Create several leases at once from a JSON file. Each lease may use a different
+provider. Either all leases are created or none are; their combined reservation
+must fit in the available credit and they count towards the pending lease limit
+together (max 100 leases).
+
+The file holds a JSON array of leases:
+
+  [
+    {"items": ["01902a9b-1234-7000-8000-000000000001:2"], "meta_hash": "a1b2c3"},
+    {"items": ["01902a9b-1234-7000-8000-000000000005:1:web"], "scheduled_end": "2026-01-04T00:00:00Z"}
+  ]
+
+items use the create-lease format; meta_hash (hex) and scheduled_end (RFC 3339)
+are optional.`,
+		Example: `create-leases leases.json --from mykey`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			leases, err := parseLeaseInputsFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCreateLeases{
+				Tenant: clientCtx.GetFromAddress().String(),
+				Leases: leases,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCreateLeasesForTenantCmd returns the command to create several leases on
+// behalf of a tenant at once.
+func NewCreateLeasesForTenantCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-leases-for-tenant [tenant] [leases-file]",
+		Short: "Create several leases on behalf of a tenant (authority only)",
+		Long: `Create several leases on behalf of a tenant from a JSON file, in one
+transaction. The file uses the same format as create-leases. The sender must be
+the authority or in the allowed list, and the tenant's credit account must be
+pre-funded.`,
+		Example: `create-leases-for-tenant manifest1abc... leases.json --from authority`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tenant := args[0]
+			if _, err := sdk.AccAddressFromBech32(tenant); err != nil {
+				return fmt.Errorf("invalid tenant address: %w", err)
+			}
+
+			leases, err := parseLeaseInputsFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCreateLeasesForTenant{
+				Authority: clientCtx.GetFromAddress().String(),
+				Tenant:    tenant,
+				Leases:    leases,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -195,7 +490,8 @@ to migrate off-chain leases to on-chain. Each item is specified as sku_uuid:quan
 or sku_uuid:quantity:service_name for stack deployments. When service_name is used, all items
 must have one and the same SKU may appear multiple times with different service names.
 All SKUs must belong to the same provider. The tenant's credit account must be pre-funded.
-Use --meta-hash to include a hash/reference to off-chain deployment data (hex-encoded, max 64 bytes).`,
+Use --meta-hash to include a hash/reference to off-chain deployment data (hex-encoded, max 64 bytes).
+Use --scheduled-end to have the lease closed automatically at the given RFC 3339 time.`,
 		Example: `create-lease-for-tenant manifest1abc... 01902a9b-1234-7000-8000-000000000001:2 01902a9b-1234-7000-8000-000000000002:1 --from authority
 create-lease-for-tenant manifest1xyz... 01902a9b-1234-7000-8000-000000000005:10 --from authority
 create-lease-for-tenant manifest1abc... 01902a9b-1234-7000-8000-000000000001:1:web 01902a9b-1234-7000-8000-000000000001:1:db --from authority
@@ -223,11 +519,17 @@ create-lease-for-tenant manifest1abc... 01902a9b-1234-7000-8000-000000000001:1 -
 				return err
 			}
 
+			scheduledEndAt, err := parseScheduledEndFlag(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgCreateLeaseForTenant{
-				Authority: clientCtx.GetFromAddress().String(),
-				Tenant:    tenant,
-				Items:     items,
-				MetaHash:  metaHash,
+				Authority:      clientCtx.GetFromAddress().String(),
+				Tenant:         tenant,
+				Items:          items,
+				MetaHash:       metaHash,
+				ScheduledEndAt: scheduledEndAt,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -235,6 +537,7 @@ create-lease-for-tenant manifest1abc... 01902a9b-1234-7000-8000-000000000001:1 -
 	}
 
 	cmd.Flags().String("meta-hash", "", "Hex-encoded hash/reference to off-chain deployment data (max 64 bytes)")
+	cmd.Flags().String("scheduled-end", "", "RFC 3339 time at which the lease is closed automatically")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -390,6 +693,22 @@ re-submitted unchanged so existing operator workflows (e.g. bumping a numeric
 param) cannot accidentally wipe seeded entries. Pass the flag with an empty
 value (e.g. --reserved-domain-suffixes="") to explicitly clear the list.
 
+--credit-withdrawal-cooldown is also PRESERVE-on-omit. It is the notice period
+in seconds each deposit is locked before it can be withdrawn (0 disables it).
+
+--grace-period is also PRESERVE-on-omit. It is the time in seconds a lease keeps
+running, accruing debt, after its tenant's credit runs out (0 disables it).
+
+--lease-retention-period is also PRESERVE-on-omit. It is the time in seconds
+CLOSED, REJECTED and EXPIRED leases are kept before they are pruned (0 keeps them).
+
+--dispute-response-period is also PRESERVE-on-omit. It is how long a provider
+has to answer a dispute (0 disables disputes).
+
+--reference-denom and --price-feeders are also PRESERVE-on-omit. Exchange rates
+are quoted in the reference denom (empty disables conversion); price feeders may
+set exchange rates alongside the authority.
+
 min-lease-duration is in seconds (e.g., 3600 for 1 hour).
 pending-timeout is the duration in seconds that a lease can remain in PENDING state (60-86400).`,
 		Example: `# Update only numeric params (allowed_list and reserved_domain_suffixes preserved):
@@ -399,7 +718,22 @@ update-params 100 20 3600 10 1800 --from authority
 update-params 100 20 3600 10 1800 --allowed-list manifest1abc...,manifest1xyz... --from authority
 
 # Explicitly clear reserved_domain_suffixes:
-update-params 100 20 3600 10 1800 --reserved-domain-suffixes="" --from authority`,
+update-params 100 20 3600 10 1800 --reserved-domain-suffixes="" --from authority
+
+# Shorten the credit withdrawal cooldown to one hour:
+update-params 100 20 3600 10 1800 --credit-withdrawal-cooldown 3600 --from authority
+
+# Give leases a one-day grace period before credit-exhaustion closure:
+update-params 100 20 3600 10 1800 --grace-period 86400 --from authority
+
+# Prune terminal leases after 90 days:
+update-params 100 20 3600 10 1800 --lease-retention-period 7776000 --from authority
+
+# Give providers one week to answer disputes:
+update-params 100 20 3600 10 1800 --dispute-response-period 604800 --from authority
+
+# Enable conversion with umfx as the reference denom and one price feeder:
+update-params 100 20 3600 10 1800 --reference-denom umfx --price-feeders manifest1abc... --from authority`,
 		Args: cobra.ExactArgs(5),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -437,11 +771,26 @@ update-params 100 20 3600 10 1800 --reserved-domain-suffixes="" --from authority
 			// "flag present with empty value" (explicit clear) via Flags.Changed().
 			// On absent, query the current on-chain value to round-trip.
 			var (
-				allowedList      []string
-				reservedSuffixes []string
-				preservedParams  *types.Params
+				allowedList              []string
+				reservedSuffixes         []string
+				creditWithdrawalCooldown uint64
+				gracePeriod              uint64
+				maxProviderGracePeriod   uint64
+				leaseRetentionPeriod     uint64
+				disputeResponsePeriod    uint64
+				referenceDenom           string
+				priceFeeders             []string
+				preservedParams          *types.Params
 			)
-			needsPreservedParams := !cmd.Flags().Changed("allowed-list") || !cmd.Flags().Changed("reserved-domain-suffixes")
+			needsPreservedParams := !cmd.Flags().Changed("allowed-list") ||
+				!cmd.Flags().Changed("reserved-domain-suffixes") ||
+				!cmd.Flags().Changed("credit-withdrawal-cooldown") ||
+				!cmd.Flags().Changed("grace-period") ||
+				!cmd.Flags().Changed("max-provider-grace-period") ||
+				!cmd.Flags().Changed("lease-retention-period") ||
+				!cmd.Flags().Changed("dispute-response-period") ||
+				!cmd.Flags().Changed("reference-denom") ||
+				!cmd.Flags().Changed("price-feeders")
 			if needsPreservedParams {
 				queryClient := types.NewQueryClient(clientCtx)
 				res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
@@ -469,6 +818,49 @@ update-params 100 20 3600 10 1800 --reserved-domain-suffixes="" --from authority
 				reservedSuffixes = preservedParams.ReservedDomainSuffixes
 			}
 
+			if cmd.Flags().Changed("credit-withdrawal-cooldown") {
+				creditWithdrawalCooldown, _ = cmd.Flags().GetUint64("credit-withdrawal-cooldown")
+			} else {
+				creditWithdrawalCooldown = preservedParams.CreditWithdrawalCooldown
+			}
+
+			if cmd.Flags().Changed("grace-period") {
+				gracePeriod, _ = cmd.Flags().GetUint64("grace-period")
+			} else {
+				gracePeriod = preservedParams.GracePeriod
+			}
+
+			if cmd.Flags().Changed("max-provider-grace-period") {
+				maxProviderGracePeriod, _ = cmd.Flags().GetUint64("max-provider-grace-period")
+			} else {
+				maxProviderGracePeriod = preservedParams.MaxProviderGracePeriod
+			}
+
+			if cmd.Flags().Changed("lease-retention-period") {
+				leaseRetentionPeriod, _ = cmd.Flags().GetUint64("lease-retention-period")
+			} else {
+				leaseRetentionPeriod = preservedParams.LeaseRetentionPeriod
+			}
+
+			if cmd.Flags().Changed("dispute-response-period") {
+				disputeResponsePeriod, _ = cmd.Flags().GetUint64("dispute-response-period")
+			} else {
+				disputeResponsePeriod = preservedParams.DisputeResponsePeriod
+			}
+
+			if cmd.Flags().Changed("reference-denom") {
+				referenceDenom, _ = cmd.Flags().GetString("reference-denom")
+			} else {
+				referenceDenom = preservedParams.ReferenceDenom
+			}
+
+			if cmd.Flags().Changed("price-feeders") {
+				priceFeedersStr, _ := cmd.Flags().GetString("price-feeders")
+				priceFeeders = splitAndTrim(priceFeedersStr)
+			} else {
+				priceFeeders = preservedParams.PriceFeeders
+			}
+
 			msg := &types.MsgUpdateParams{
 				Authority: clientCtx.GetFromAddress().String(),
 				Params: types.Params{
@@ -479,6 +871,13 @@ update-params 100 20 3600 10 1800 --reserved-domain-suffixes="" --from authority
 					MaxPendingLeasesPerTenant: maxPendingLeasesPerTenant,
 					PendingTimeout:            pendingTimeout,
 					ReservedDomainSuffixes:    reservedSuffixes,
+					CreditWithdrawalCooldown:  creditWithdrawalCooldown,
+					GracePeriod:               gracePeriod,
+					LeaseRetentionPeriod:      leaseRetentionPeriod,
+					DisputeResponsePeriod:     disputeResponsePeriod,
+					ReferenceDenom:            referenceDenom,
+					PriceFeeders:              priceFeeders,
+					MaxProviderGracePeriod:    maxProviderGracePeriod,
 				},
 			}
 
@@ -488,6 +887,13 @@ update-params 100 20 3600 10 1800 --reserved-domain-suffixes="" --from authority
 
 	cmd.Flags().String("allowed-list", "", "Comma-separated list of addresses allowed to create leases for tenants. Omit to preserve current; pass empty string to clear.")
 	cmd.Flags().String("reserved-domain-suffixes", "", "Comma-separated list of reserved domain suffixes (each must begin with '.'). Omit to preserve current; pass empty string to clear.")
+	cmd.Flags().Uint64("credit-withdrawal-cooldown", 0, "Seconds each deposit is locked before it can be withdrawn (0 disables). Omit to preserve current.")
+	cmd.Flags().Uint64("grace-period", 0, "Seconds a lease keeps running on debt after credit runs out (0 disables). Omit to preserve current.")
+	cmd.Flags().Uint64("max-provider-grace-period", 0, "Longest provider grace period override; stored overrides are clamped to it (0 disables overrides). Omit to preserve current.")
+	cmd.Flags().Uint64("lease-retention-period", 0, "Seconds terminal leases are kept before they are pruned (0 keeps them forever). Omit to preserve current.")
+	cmd.Flags().Uint64("dispute-response-period", 0, "Seconds a provider has to respond to a dispute (0 disables disputes, else 3600-2592000). Omit to preserve current.")
+	cmd.Flags().String("reference-denom", "", "Denom exchange rates are quoted in (empty disables conversion). Omit to preserve current.")
+	cmd.Flags().String("price-feeders", "", "Comma-separated list of addresses that may set exchange rates. Omit to preserve current; pass empty string to clear.")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -686,3 +1092,670 @@ set-item-custom-domain 01902a9b-1234-7000-8000-000000000001 web "" --from tenant
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewUpdateLeaseItemsCmd returns the command to change item quantities on an active lease.
+func NewUpdateLeaseItemsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-lease-items [lease-uuid] [sku-uuid:quantity[:service_name]] ...",
+		Short: "Change item quantities on an active lease",
+		Long: `Change the quantity of one or more items on an ACTIVE lease without closing it.
+Items use the same sku_uuid:quantity[:service_name] format as create-lease. For
+service-name mode leases each entry is matched by service_name; for legacy leases
+it is matched by sku_uuid. Items that are not listed keep their quantity.
+
+The lease is settled at the old quantities before the change, and the original
+locked prices are kept. Scaling up requires enough available credit to cover the
+larger reservation; scaling down is always allowed.`,
+		Example: `update-lease-items 01912345-6789-7abc-8def-0123456789ab 01902a9b-1234-7000-8000-000000000001:6 --from tenant
+update-lease-items 01912345-6789-7abc-8def-0123456789ab 01902a9b-1234-7000-8000-000000000001:3:web --from tenant`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			leaseUUID := args[0]
+			if !pkguuid.IsValidUUID(leaseUUID) {
+				return fmt.Errorf("invalid lease_uuid format: %s", leaseUUID)
+			}
+
+			items, err := parseLeaseItemInputs(args[1:])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateLeaseItems{
+				Tenant:    clientCtx.GetFromAddress().String(),
+				LeaseUuid: leaseUUID,
+				Items:     items,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewProposeLeaseAmendmentCmd returns a CLI command to propose adding and/or
+// removing items on an active lease.
+func NewProposeLeaseAmendmentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-lease-amendment [lease-uuid]",
+		Short: "Propose adding or removing items on an active lease",
+		Long: `Propose an amendment to the item list of an ACTIVE lease. The provider must
+acknowledge the amendment before it takes effect, and it expires after the
+module's pending_timeout.
+
+--add takes the same sku_uuid:quantity[:service_name] format as create-lease and
+may be repeated. Added items are priced at the SKU's current price, and credit
+for them is reserved while the amendment is pending.
+
+--remove may be repeated and names an existing item: its service_name for
+service-name mode leases, or its sku_uuid for legacy leases.`,
+		Example: `propose-lease-amendment 01912345-6789-7abc-8def-0123456789ab --add 01902a9b-1234-7000-8000-000000000002:1:storage --from tenant
+propose-lease-amendment 01912345-6789-7abc-8def-0123456789ab --remove cache --from tenant`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			leaseUUID := args[0]
+			if !pkguuid.IsValidUUID(leaseUUID) {
+				return fmt.Errorf("invalid lease_uuid format: %s", leaseUUID)
+			}
+
+			addArgs, err := cmd.Flags().GetStringArray("add")
+			if err != nil {
+				return err
+			}
+			removeItems, err := cmd.Flags().GetStringArray("remove")
+			if err != nil {
+				return err
+			}
+			if len(addArgs) == 0 && len(removeItems) == 0 {
+				return fmt.Errorf("at least one --add or --remove is required")
+			}
+
+			var addItems []types.LeaseItemInput
+			if len(addArgs) > 0 {
+				addItems, err = parseLeaseItemInputs(addArgs)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := &types.MsgProposeLeaseAmendment{
+				Tenant:      clientCtx.GetFromAddress().String(),
+				LeaseUuid:   leaseUUID,
+				AddItems:    addItems,
+				RemoveItems: removeItems,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringArray("add", nil, "Item to add as sku_uuid:quantity[:service_name] (repeatable)")
+	cmd.Flags().StringArray("remove", nil, "service_name or sku_uuid of an item to remove (repeatable)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewAcknowledgeLeaseAmendmentCmd returns a CLI command to accept a pending
+// lease amendment.
+func NewAcknowledgeLeaseAmendmentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "acknowledge-lease-amendment [lease-uuid]",
+		Short: "Acknowledge a pending lease amendment (provider only)",
+		Long: `Acknowledge the pending amendment of a lease. The lease is settled and its item
+list replaced in the same transaction. Only the provider of the lease or the
+module authority can acknowledge.`,
+		Example: `acknowledge-lease-amendment 01912345-6789-7abc-8def-0123456789ab --from provider-key`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !pkguuid.IsValidUUID(args[0]) {
+				return fmt.Errorf("invalid lease_uuid format: %s", args[0])
+			}
+
+			msg := &types.MsgAcknowledgeLeaseAmendment{
+				Sender:    clientCtx.GetFromAddress().String(),
+				LeaseUuid: args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRejectLeaseAmendmentCmd returns a CLI command to reject a pending lease
+// amendment.
+func NewRejectLeaseAmendmentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reject-lease-amendment [lease-uuid]",
+		Short: "Reject a pending lease amendment (provider only)",
+		Long: `Reject the pending amendment of a lease and release the credit reserved for it.
+The lease itself is unchanged. Only the provider of the lease or the module
+authority can reject.`,
+		Example: `reject-lease-amendment 01912345-6789-7abc-8def-0123456789ab --reason "no storage capacity" --from provider-key`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !pkguuid.IsValidUUID(args[0]) {
+				return fmt.Errorf("invalid lease_uuid format: %s", args[0])
+			}
+
+			reason, _ := cmd.Flags().GetString("reason")
+
+			msg := &types.MsgRejectLeaseAmendment{
+				Sender:    clientCtx.GetFromAddress().String(),
+				LeaseUuid: args[0],
+				Reason:    reason,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String("reason", "", "Reason for rejecting the amendment (max 256 characters)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetLeaseScheduledEndCmd returns a CLI command to set or extend the
+// scheduled end time of a lease.
+func NewSetLeaseScheduledEndCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-lease-scheduled-end [lease-uuid] [end-time]",
+		Short: "Set or extend the time at which a lease closes automatically",
+		Long: `Set the scheduled end of a PENDING or ACTIVE lease, as an RFC 3339 timestamp.
+At that time the lease is settled and closed with closure reason "scheduled end".
+The end time must be in the future and, if one is already set, later than it.`,
+		Example: `set-lease-scheduled-end 01912345-6789-7abc-8def-0123456789ab 2026-01-04T00:00:00Z --from tenant`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !pkguuid.IsValidUUID(args[0]) {
+				return fmt.Errorf("invalid lease_uuid format: %s", args[0])
+			}
+
+			endAt, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return fmt.Errorf("invalid end-time: must be an RFC 3339 timestamp: %w", err)
+			}
+
+			msg := &types.MsgSetLeaseScheduledEnd{
+				Tenant:         clientCtx.GetFromAddress().String(),
+				LeaseUuid:      args[0],
+				ScheduledEndAt: endAt,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetProviderPolicyCmd returns the command to set a provider's lease policy.
+func NewSetProviderPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-provider-policy [provider-uuid]",
+		Short: "Set the lease policy of a provider (provider or authority)",
+		Long: `Set how a provider's leases are handled. The new policy replaces the previous
+one entirely, so pass every setting to keep.
+
+  --pending-timeout   seconds a lease may stay PENDING (0 uses params.pending_timeout)
+  --auto-acknowledge  create leases directly in the ACTIVE state
+  --allowed-tenants   only these tenants may create leases (empty allows everyone)
+  --denied-tenants    these tenants may not create leases
+  --grace-period      seconds a lease whose tenant ran out of credit keeps
+                      running with debt (0 disables grace); when omitted,
+                      params.grace_period applies. Bounded by
+                      params.max_provider_grace_period, and refused while it is zero
+
+Pass --reset to remove the policy.`,
+		Example: `set-provider-policy 01912345-6789-7abc-8def-0123456789ab --pending-timeout 600 --auto-acknowledge --from provider
+set-provider-policy 01912345-6789-7abc-8def-0123456789ab --denied-tenants manifest1abc...,manifest1def... --from provider
+set-provider-policy 01912345-6789-7abc-8def-0123456789ab --grace-period 86400 --from provider
+set-provider-policy 01912345-6789-7abc-8def-0123456789ab --reset --from provider`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !pkguuid.IsValidUUID(args[0]) {
+				return fmt.Errorf("invalid provider_uuid format: %s", args[0])
+			}
+
+			pendingTimeout, _ := cmd.Flags().GetUint64("pending-timeout")
+			autoAcknowledge, _ := cmd.Flags().GetBool("auto-acknowledge")
+			allowedTenants, _ := cmd.Flags().GetStringSlice("allowed-tenants")
+			deniedTenants, _ := cmd.Flags().GetStringSlice("denied-tenants")
+			gracePeriod, _ := cmd.Flags().GetUint64("grace-period")
+			reset, _ := cmd.Flags().GetBool("reset")
+
+			msg := &types.MsgSetProviderPolicy{
+				Sender: clientCtx.GetFromAddress().String(),
+				Policy: types.ProviderPolicy{
+					ProviderUuid:        args[0],
+					PendingTimeout:      pendingTimeout,
+					AutoAcknowledge:     autoAcknowledge,
+					AllowedTenants:      allowedTenants,
+					DeniedTenants:       deniedTenants,
+					OverrideGracePeriod: cmd.Flags().Changed("grace-period"),
+					GracePeriod:         gracePeriod,
+				},
+				Reset_: reset,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64("pending-timeout", 0, "Pending timeout in seconds for the provider's leases (0 uses params.pending_timeout)")
+	cmd.Flags().Bool("auto-acknowledge", false, "Create the provider's leases directly in the ACTIVE state")
+	cmd.Flags().StringSlice("allowed-tenants", nil, "Comma-separated tenant addresses that may create leases (empty allows everyone)")
+	cmd.Flags().StringSlice("denied-tenants", nil, "Comma-separated tenant addresses that may not create leases")
+	cmd.Flags().Uint64("grace-period", 0, "Grace period in seconds for the provider's leases, overriding params.grace_period (0 disables grace)")
+	cmd.Flags().Bool("reset", false, "Remove the policy so the provider's leases follow params again")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseUsageReports parses CLI arguments into UsageReport values.
+// Format: sku_uuid:counter or sku_uuid:counter:service_name
+func parseUsageReports(args []string) ([]types.UsageReport, error) {
+	reports := make([]types.UsageReport, 0, len(args))
+	for _, arg := range args {
+		parts := strings.SplitN(arg, ":", 3)
+		if len(parts) < 2 {
+			return nil, fmt.Errorf("invalid usage format '%s': expected sku_uuid:counter[:service_name]", arg)
+		}
+		if !pkguuid.IsValidUUID(parts[0]) {
+			return nil, fmt.Errorf("invalid sku_uuid format: %s", parts[0])
+		}
+		counter, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid counter in '%s': %w", arg, err)
+		}
+		report := types.UsageReport{
+			SkuUuid: parts[0],
+			Counter: counter,
+		}
+		if len(parts) == 3 {
+			report.ServiceName = parts[2]
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// NewReportUsageCmd returns the command to report usage for a metered lease.
+func NewReportUsageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report-usage [lease-uuid] [sku-uuid:counter[:service-name]]...",
+		Short: "Report usage counters for the metered items of a lease (provider only)",
+		Long: `Report the cumulative usage of one or more metered (UNIT_PER_USAGE) lease items.
+Counters are totals since the lease started and may not decrease; the increase
+since the last report is charged to the tenant's credit at the item's locked
+price, up to the tenant's usage spend limit; usage that does not fit is
+charged by a later report. Items of service-name mode leases
+are addressed by sku-uuid and service-name.`,
+		Example: `report-usage 01912345-6789-7abc-8def-0123456789ab 01912345-6789-7abc-8def-0123456789ac:1500 --from provider
+report-usage 01912345-6789-7abc-8def-0123456789ab 01912345-6789-7abc-8def-0123456789ac:1500:egress --from provider`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !pkguuid.IsValidUUID(args[0]) {
+				return fmt.Errorf("invalid lease_uuid format: %s", args[0])
+			}
+
+			usages, err := parseUsageReports(args[1:])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgReportUsage{
+				Sender:    clientCtx.GetFromAddress().String(),
+				LeaseUuid: args[0],
+				Usages:    usages,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetUsageSpendLimitCmd returns the command to set the usage spend limit of a lease.
+func NewSetUsageSpendLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-usage-spend-limit [lease-uuid] [spend-limit] [period-seconds]",
+		Short: "Set how much usage may be charged to a lease per period (tenant only)",
+		Long: fmt.Sprintf(`Set the most usage charges a metered lease may bill per spend period.
+Reported usage beyond the limit is held back until there is room. Pass "" as the
+limit to stop charging usage. The period must be between %d and %d seconds;
+changing it starts a new period.`, types.MinUsageSpendPeriod, types.MaxUsageSpendPeriod),
+		Example: `set-usage-spend-limit 01912345-6789-7abc-8def-0123456789ab 5000000umfx 86400 --from tenant`,
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !pkguuid.IsValidUUID(args[0]) {
+				return fmt.Errorf("invalid lease_uuid format: %s", args[0])
+			}
+
+			limit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid spend limit: %w", err)
+			}
+
+			period, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid period: %w", err)
+			}
+
+			msg := &types.MsgSetUsageSpendLimit{
+				Tenant:     clientCtx.GetFromAddress().String(),
+				LeaseUuid:  args[0],
+				SpendLimit: limit,
+				Period:     period,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewOpenDisputeCmd returns the command to dispute a lease.
+func NewOpenDisputeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open-dispute [lease-uuid] [reason]",
+		Short: "Dispute an active lease (tenant only)",
+		Long: `Open a dispute on an ACTIVE lease that is not delivered as paid for. Until the
+dispute is settled, amounts settled for the lease are held in escrow and the
+provider cannot withdraw from it. The provider has
+params.dispute_response_period to respond; an unanswered dispute returns the
+escrow to the tenant. A lease can be disputed once.`,
+		Example: `open-dispute 01912345-6789-7abc-8def-0123456789ab "service unreachable since acknowledgement" --from tenant`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !pkguuid.IsValidUUID(args[0]) {
+				return fmt.Errorf("invalid lease_uuid format: %s", args[0])
+			}
+
+			msg := &types.MsgOpenDispute{
+				Tenant:    clientCtx.GetFromAddress().String(),
+				LeaseUuid: args[0],
+				Reason:    args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRespondToDisputeCmd returns the command to answer a dispute.
+func NewRespondToDisputeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "respond-to-dispute [lease-uuid] [response]",
+		Short: "Respond to a dispute on one of your leases (provider only)",
+		Long: `Record the provider's answer to an open dispute. Responding before the
+response deadline keeps the dispute open until the authority resolves it.`,
+		Example: `respond-to-dispute 01912345-6789-7abc-8def-0123456789ab "endpoint was up; see status page" --from provider`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !pkguuid.IsValidUUID(args[0]) {
+				return fmt.Errorf("invalid lease_uuid format: %s", args[0])
+			}
+
+			msg := &types.MsgRespondToDispute{
+				Sender:    clientCtx.GetFromAddress().String(),
+				LeaseUuid: args[0],
+				Response:  args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewResolveDisputeCmd returns the command to resolve a dispute.
+func NewResolveDisputeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve-dispute [lease-uuid] [tenant-share]",
+		Short: "Resolve an open dispute (authority only)",
+		Long: `Settle an open dispute by splitting its escrow. tenant-share is the fraction,
+between 0 and 1, returned to the tenant's credit account; the rest is paid to
+the provider.`,
+		Example: `resolve-dispute 01912345-6789-7abc-8def-0123456789ab 0.5 --from authority`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !pkguuid.IsValidUUID(args[0]) {
+				return fmt.Errorf("invalid lease_uuid format: %s", args[0])
+			}
+
+			share, err := sdkmath.LegacyNewDecFromStr(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid tenant share: %w", err)
+			}
+
+			msg := &types.MsgResolveDispute{
+				Authority:   clientCtx.GetFromAddress().String(),
+				LeaseUuid:   args[0],
+				TenantShare: share,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewGrantPromoCreditCmd returns the command to grant a tenant promotional
+// credit.
+func NewGrantPromoCreditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-promo-credit [tenant] [amount] [expires-at]",
+		Short: "Grant a tenant promotional credit (authority only)",
+		Long: `Grant a tenant promotional credit funded by the authority's account. The
+credit is spent on the tenant's leases before their own credit, cannot be
+withdrawn or transferred, and what is left at expires-at (RFC 3339) is returned
+to the authority.
+
+  --provider-uuids  only leases of these providers may spend the grant
+  --sku-uuids       only lease items of these SKUs may spend the grant`,
+		Example: `grant-promo-credit manifest1abc... 1000000upwr 2026-12-31T00:00:00Z --from authority
+grant-promo-credit manifest1abc... 1000000upwr 2026-12-31T00:00:00Z --provider-uuids 01912345-6789-7abc-8def-0123456789ab --from authority`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid amount: %w", err)
+			}
+
+			expiresAt, err := time.Parse(time.RFC3339, args[2])
+			if err != nil {
+				return fmt.Errorf("invalid expires-at: must be an RFC 3339 timestamp: %w", err)
+			}
+
+			providerUUIDs, _ := cmd.Flags().GetStringSlice("provider-uuids")
+			skuUUIDs, _ := cmd.Flags().GetStringSlice("sku-uuids")
+
+			msg := &types.MsgGrantPromoCredit{
+				Authority:     clientCtx.GetFromAddress().String(),
+				Tenant:        args[0],
+				Amount:        amount,
+				ExpiresAt:     expiresAt,
+				ProviderUuids: providerUUIDs,
+				SkuUuids:      skuUUIDs,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice("provider-uuids", nil, "Comma-separated provider UUIDs whose leases may spend the grant (empty allows all)")
+	cmd.Flags().StringSlice("sku-uuids", nil, "Comma-separated SKU UUIDs whose lease items may spend the grant (empty allows all)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseExchangeRateInputs parses CLI arguments into ExchangeRateInput values.
+// Format: denom:rate:valid-until, or denom:0 to remove a rate. valid-until is
+// an RFC 3339 timestamp and may itself contain colons.
+func parseExchangeRateInputs(args []string, validFrom *time.Time) ([]types.ExchangeRateInput, error) {
+	inputs := make([]types.ExchangeRateInput, 0, len(args))
+	for _, arg := range args {
+		parts := strings.SplitN(arg, ":", 3)
+		if len(parts) < 2 {
+			return nil, fmt.Errorf("invalid exchange rate format '%s': expected denom:rate:valid-until or denom:0", arg)
+		}
+		rate, err := sdkmath.LegacyNewDecFromStr(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate in '%s': %w", arg, err)
+		}
+		input := types.ExchangeRateInput{
+			Denom: parts[0],
+			Rate:  rate,
+		}
+		if rate.IsZero() {
+			inputs = append(inputs, input)
+			continue
+		}
+		if len(parts) < 3 {
+			return nil, fmt.Errorf("invalid exchange rate format '%s': valid-until is required", arg)
+		}
+		input.ValidUntil, err = time.Parse(time.RFC3339, parts[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid valid-until in '%s': must be an RFC 3339 timestamp: %w", arg, err)
+		}
+		input.ValidFrom = validFrom
+		inputs = append(inputs, input)
+	}
+	return inputs, nil
+}
+
+// NewSetExchangeRatesCmd returns the command to set or remove exchange rates.
+func NewSetExchangeRatesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-exchange-rates [denom:rate:valid-until]...",
+		Short: "Set or remove exchange rates (price feeders and authority only)",
+		Long: `Set the rates at which credit in one denom may pay for leases priced in
+another. A rate is the amount of the reference denom (params.reference_denom)
+one unit of denom is worth; it may be used from block time, or from
+--valid-from, until valid-until (RFC 3339). A rate of 0 removes the denom.`,
+		Example: `set-exchange-rates upwr:0.25:2026-12-31T00:00:00Z --from feeder
+set-exchange-rates upwr:0.25:2026-12-31T00:00:00Z uatom:3.5:2026-12-31T00:00:00Z --valid-from 2026-11-01T00:00:00Z --from feeder
+set-exchange-rates upwr:0 --from authority`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var validFrom *time.Time
+			if s, _ := cmd.Flags().GetString("valid-from"); s != "" {
+				t, err := time.Parse(time.RFC3339, s)
+				if err != nil {
+					return fmt.Errorf("invalid valid-from: must be an RFC 3339 timestamp: %w", err)
+				}
+				validFrom = &t
+			}
+
+			rates, err := parseExchangeRateInputs(args, validFrom)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetExchangeRates{
+				Sender: clientCtx.GetFromAddress().String(),
+				Rates:  rates,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String("valid-from", "", "RFC 3339 time from which the rates may be used (default: block time)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -8,6 +8,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
 	skutypes "github.com/manifest-network/manifest-ledger/x/sku/types"
 )
 
@@ -18,34 +19,70 @@ const MaxDurationSeconds = 100 * 365 * 24 * 60 * 60 // ~100 years in seconds
 
 // ConvertBasePriceToPerSecond converts a SKU's base price to a per-second rate.
 // The SKU's Unit determines how to interpret the base price:
+// - UNIT_PER_MINUTE: divide by 60
 // - UNIT_PER_HOUR: divide by 3600
 // - UNIT_PER_DAY: divide by 86400
-// Returns the per-second rate as a Coin with the same denom as the base price.
-// Returns an error if the conversion fails (invalid unit, zero rate, or inexact division).
+// - UNIT_PER_WEEK: divide by 604800
+// - UNIT_PER_MONTH: divide by 2419200, the seconds in a 28-day month, which
+// gives the highest rate any calendar month bills at
+// Returns the decimal per-second rate in the base price's denom, which may be
+// below one base unit. Returns an error if the conversion fails (invalid unit
+// or zero rate).
 // Note: SKUs are validated at creation time, so this should not fail for valid SKUs.
-func ConvertBasePriceToPerSecond(basePrice sdk.Coin, unit skutypes.Unit) (sdk.Coin, error) {
+func ConvertBasePriceToPerSecond(basePrice sdk.Coin, unit skutypes.Unit) (sdk.DecCoin, error) {
 	perSecond, ok := skutypes.CalculatePricePerSecond(basePrice, unit)
 	if !ok {
-		return sdk.Coin{}, fmt.Errorf("failed to convert base price %s with unit %s to per-second rate", basePrice, unit)
+		return sdk.DecCoin{}, fmt.Errorf("failed to convert base price %s with unit %s to per-second rate", basePrice, unit)
 	}
-	return sdk.NewCoin(basePrice.Denom, perSecond), nil
+	return sdk.NewDecCoinFromDec(basePrice.Denom, perSecond), nil
 }
 
-// CalculateAccruedAmount calculates the amount accrued for a lease item
+// LockItemPrice returns the pricing fields a lease item locks from its SKU:
+// locked_price, locked_rate, metered, calendar_month and monthly_price. The
+// caller fills in the item's SKU, quantity and service name. Time-based SKUs
+// lock their per-second rate, with the locked price holding that rate rounded
+// up to whole units for display; UNIT_PER_USAGE SKUs lock base_price as the
+// price per unit of reported usage and have a zero rate. UNIT_PER_MONTH SKUs
+// also lock base_price as their monthly price, and their rate is the highest
+// per-second rate, the one reservations are computed from.
+func LockItemPrice(basePrice sdk.Coin, unit skutypes.Unit) (types.LeaseItem, error) {
+	if unit.IsMetered() {
+		if !basePrice.Amount.IsPositive() {
+			return types.LeaseItem{}, fmt.Errorf("usage price %s must be positive", basePrice)
+		}
+		return types.LeaseItem{LockedPrice: basePrice, LockedRate: math.LegacyZeroDec(), Metered: true}, nil
+	}
+	perSecond, err := ConvertBasePriceToPerSecond(basePrice, unit)
+	if err != nil {
+		return types.LeaseItem{}, err
+	}
+	item := types.LeaseItem{
+		LockedPrice: sdk.NewCoin(perSecond.Denom, perSecond.Amount.Ceil().TruncateInt()),
+		LockedRate:  perSecond.Amount,
+	}
+	if unit.IsCalendarMonth() {
+		item.CalendarMonth = true
+		item.MonthlyPrice = basePrice
+	}
+	return item, nil
+}
+
+// CalculateAccruedAmount calculates the exact amount accrued for a lease item
 // over a given duration.
 // accrued = lockedPricePerSecond * quantity * durationSeconds
+// The result is decimal; callers truncate it to whole units when paying.
 // Returns an error if the calculation would overflow.
-func CalculateAccruedAmount(lockedPricePerSecond sdk.Coin, quantity uint64, duration time.Duration) (sdk.Coin, error) {
+func CalculateAccruedAmount(lockedPricePerSecond sdk.DecCoin, quantity uint64, duration time.Duration) (sdk.DecCoin, error) {
 	// Use integer division instead of duration.Seconds() (which returns float64)
 	// to avoid potential precision loss for large durations.
 	durationSeconds := int64(duration / time.Second)
 	if durationSeconds < 0 {
-		return sdk.NewCoin(lockedPricePerSecond.Denom, math.ZeroInt()), nil
+		return sdk.NewDecCoinFromDec(lockedPricePerSecond.Denom, math.LegacyZeroDec()), nil
 	}
 
 	// Check for excessive duration that could cause overflow
 	if durationSeconds > MaxDurationSeconds {
-		return sdk.Coin{}, fmt.Errorf("duration %d seconds exceeds maximum allowed %d seconds (approx 100 years)", durationSeconds, MaxDurationSeconds)
+		return sdk.DecCoin{}, fmt.Errorf("duration %d seconds exceeds maximum allowed %d seconds (approx 100 years)", durationSeconds, MaxDurationSeconds)
 	}
 
 	// accrued = price_per_second * quantity * seconds
@@ -53,39 +90,146 @@ func CalculateAccruedAmount(lockedPricePerSecond sdk.Coin, quantity uint64, dura
 	secondsInt := math.NewInt(durationSeconds)
 
 	// Perform multiplication with overflow checking
-	// math.Int uses big.Int internally, so it won't overflow, but we check for unreasonable values
-	result := lockedPricePerSecond.Amount.Mul(quantityInt).Mul(secondsInt)
+	// math.LegacyDec uses big.Int internally, so it won't overflow, but we check for unreasonable values
+	result := lockedPricePerSecond.Amount.MulInt(quantityInt).MulInt(secondsInt)
 
 	// Sanity check: ensure result is non-negative
 	if result.IsNegative() {
-		return sdk.Coin{}, fmt.Errorf("accrual calculation resulted in negative value")
+		return sdk.DecCoin{}, fmt.Errorf("accrual calculation resulted in negative value")
 	}
 
-	return sdk.NewCoin(lockedPricePerSecond.Denom, result), nil
+	return sdk.NewDecCoinFromDec(lockedPricePerSecond.Denom, result), nil
+}
+
+// CalculateCalendarMonthAccrual calculates the exact amount accrued between
+// from and to for a lease item priced per calendar month.
+// Each UTC calendar month the interval overlaps contributes
+// pricePerMonth * quantity * overlapSeconds / secondsInThatMonth, so a full
+// month accrues exactly the monthly price whatever its length.
+// Returns an error if the interval exceeds MaxDurationSeconds.
+func CalculateCalendarMonthAccrual(pricePerMonth sdk.Coin, quantity uint64, from, to time.Time) (sdk.DecCoin, error) {
+	// Split whole seconds, as CalculateAccruedAmount does, so the months add
+	// up to the same duration a per-second item accrues for.
+	durationSeconds := int64(to.Sub(from) / time.Second)
+	if durationSeconds <= 0 {
+		return sdk.NewDecCoinFromDec(pricePerMonth.Denom, math.LegacyZeroDec()), nil
+	}
+	if durationSeconds > MaxDurationSeconds {
+		return sdk.DecCoin{}, fmt.Errorf("duration %d seconds exceeds maximum allowed %d seconds (approx 100 years)", durationSeconds, MaxDurationSeconds)
+	}
+
+	price := math.LegacyNewDecFromInt(pricePerMonth.Amount).MulInt(math.NewIntFromUint64(quantity))
+	result := math.LegacyZeroDec()
+	cursor := from.UTC().Truncate(time.Second)
+	for durationSeconds > 0 {
+		seconds := min(int64(startOfNextMonth(cursor).Sub(cursor)/time.Second), durationSeconds)
+		result = result.Add(price.MulInt64(seconds).Quo(math.LegacyNewDec(secondsInMonth(cursor))))
+		cursor = cursor.Add(time.Duration(seconds) * time.Second)
+		durationSeconds -= seconds
+	}
+
+	return sdk.NewDecCoinFromDec(pricePerMonth.Denom, result), nil
+}
+
+// CalculateItemAccrual calculates the exact amount a time-billed lease item
+// accrued between from and to.
+func CalculateItemAccrual(item LeaseItemWithPrice, from, to time.Time) (sdk.DecCoin, error) {
+	if item.CalendarMonth {
+		return CalculateCalendarMonthAccrual(item.PricePerMonth, item.Quantity, from, to)
+	}
+	return CalculateAccruedAmount(item.LockedPricePerSecond, item.Quantity, to.Sub(from))
 }
 
 // CalculateTotalAccruedForLease calculates the total accrued amounts for all items
-// in a lease over the given duration. Returns a Coins collection (one entry per denom).
+// in a lease between from and to. Returns a Coins collection (one entry per
+// denom), each total truncated to whole units.
 // Returns an error if any item calculation would overflow.
-func CalculateTotalAccruedForLease(items []LeaseItemWithPrice, duration time.Duration) (sdk.Coins, error) {
-	totals := sdk.NewCoins()
+func CalculateTotalAccruedForLease(items []LeaseItemWithPrice, from, to time.Time) (sdk.Coins, error) {
+	totals, _, err := calculateAccrualWithCarry(items, nil, from, to)
+	return totals, err
+}
+
+// CalculateLeaseAccrual calculates what the lease's time-billed items accrued
+// from its last settlement until the given time, including the fractional
+// carry left by that settlement. It returns the whole units owed per denom
+// and the sub-unit remainder to carry into the next settlement.
+func CalculateLeaseAccrual(lease types.Lease, until time.Time) (sdk.Coins, sdk.DecCoins, error) {
+	return calculateAccrualWithCarry(LeaseItemsToWithPrice(lease.Items), lease.AccrualCarry, lease.LastSettledAt, until)
+}
+
+// calculateAccrualWithCarry sums the exact accruals of items between from and
+// to and carry, and splits the sum into whole units and the remainder below
+// one unit per denom.
+func calculateAccrualWithCarry(items []LeaseItemWithPrice, carry sdk.DecCoins, from, to time.Time) (sdk.Coins, sdk.DecCoins, error) {
+	exact := sdk.NewDecCoins(carry...)
 
 	for _, item := range items {
-		accrued, err := CalculateAccruedAmount(item.LockedPricePerSecond, item.Quantity, duration)
+		accrued, err := CalculateItemAccrual(item, from, to)
 		if err != nil {
-			return nil, fmt.Errorf("overflow calculating accrual for sku %s: %w", item.SkuUUID, err)
+			return nil, nil, fmt.Errorf("overflow calculating accrual for sku %s: %w", item.SkuUUID, err)
 		}
 		if accrued.IsPositive() {
-			totals = totals.Add(accrued)
+			exact = exact.Add(accrued)
 		}
 	}
 
-	return totals, nil
+	totals, remainder := exact.TruncateDecimal()
+	return totals, remainder, nil
 }
 
 // LeaseItemWithPrice holds a lease item with its locked price per second.
+// Items billed per calendar month set CalendarMonth and accrue PricePerMonth
+// over each month's length instead of LockedPricePerSecond.
 type LeaseItemWithPrice struct {
 	SkuUUID              string
 	Quantity             uint64
-	LockedPricePerSecond sdk.Coin
+	LockedPricePerSecond sdk.DecCoin
+	CalendarMonth        bool
+	PricePerMonth        sdk.Coin
+}
+
+// startOfNextMonth returns the start of the UTC calendar month after the one
+// containing t.
+func startOfNextMonth(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// secondsInMonth returns the length in seconds of the UTC calendar month
+// containing t.
+func secondsInMonth(t time.Time) int64 {
+	t = t.UTC()
+	start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return int64(startOfNextMonth(t).Sub(start) / time.Second)
+}
+
+// accrualSeconds returns the exact number of seconds, counted from now, it
+// takes ratePerSecond plus perMonth, charged per UTC calendar month over its
+// actual length, to accrue amount. The combined rate changes at each month
+// boundary, so the months are walked one at a time; past MaxDurationSeconds
+// the last month's rate is extrapolated instead. The second return is false
+// when nothing accrues.
+func accrualSeconds(amount, ratePerSecond, perMonth math.LegacyDec, now time.Time) (math.LegacyDec, bool) {
+	if !perMonth.IsPositive() {
+		if !ratePerSecond.IsPositive() {
+			return math.LegacyDec{}, false
+		}
+		return amount.Quo(ratePerSecond), true
+	}
+
+	remaining := amount
+	elapsed := math.LegacyZeroDec()
+	cursor := now.UTC().Truncate(time.Second)
+	for {
+		rate := ratePerSecond.Add(perMonth.Quo(math.LegacyNewDec(secondsInMonth(cursor))))
+		next := startOfNextMonth(cursor)
+		seconds := math.LegacyNewDec(int64(next.Sub(cursor) / time.Second))
+		accrued := rate.Mul(seconds)
+		if accrued.GTE(remaining) || elapsed.GT(math.LegacyNewDec(MaxDurationSeconds)) {
+			return elapsed.Add(remaining.Quo(rate)), true
+		}
+		remaining = remaining.Sub(accrued)
+		elapsed = elapsed.Add(seconds)
+		cursor = next
+	}
 }
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/keeper"
+	skutypes "github.com/manifest-network/manifest-ledger/x/sku/types"
+)
+
+// dailyPrice is a price per day whose per-second rate is well below one base
+// unit.
+const dailyPrice = 10
+
+func TestFractionalPricing_LocksDecimalRate(t *testing.T) {
+	s := setupLeaseScaling(t, 1_000, skutypes.Unit_UNIT_PER_DAY, dailyPrice)
+	lease, err := s.f.App.BillingKeeper.GetLease(s.f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+
+	rate, ok := skutypes.CalculatePricePerSecond(s.sku.BasePrice, s.sku.Unit)
+	require.True(t, ok)
+	require.True(t, rate.Equal(lease.Items[0].LockedRate))
+	// The whole-unit locked_price is the rate rounded up.
+	require.Equal(t, sdkmath.NewInt(1), lease.Items[0].LockedPrice.Amount)
+
+	// 3600s of min_lease_duration for two units at ~0.42 per hour each
+	// reserves one whole unit.
+	require.Equal(t, sdkmath.NewInt(1), s.reserved(t))
+}
+
+func TestFractionalPricing_CarryAcrossSettlements(t *testing.T) {
+	s := setupLeaseScaling(t, 1_000, skutypes.Unit_UNIT_PER_DAY, dailyPrice)
+	f := s.f
+	k := f.App.BillingKeeper
+	start := f.Ctx.BlockTime()
+	payoutBefore := f.App.BankKeeper.GetBalance(f.Ctx, s.payoutAddr, testDenom).Amount
+
+	// Each 7h withdrawal accrues ~5.83, so truncating every period on its own
+	// would pay 50 over ten withdrawals instead of 58.
+	for i := 1; i <= 10; i++ {
+		f.Ctx = f.Ctx.WithBlockTime(start.Add(time.Duration(i) * 7 * time.Hour))
+		withdrawLease(t, s)
+	}
+
+	lease, err := k.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	exact := lease.Items[0].LockedRate.MulInt64(2 * 70 * 3600)
+	paid := exact.TruncateInt()
+	require.Equal(t, sdkmath.NewInt(58), paid)
+
+	payout := f.App.BankKeeper.GetBalance(f.Ctx, s.payoutAddr, testDenom).Amount.Sub(payoutBefore)
+	require.Equal(t, paid, payout)
+	require.Equal(t, paid, lease.TotalSettled.AmountOf(testDenom))
+	require.True(t, exact.Sub(sdkmath.LegacyNewDecFromInt(paid)).Equal(lease.AccrualCarry.AmountOf(testDenom)))
+}
+
+func TestFractionalPricing_CarryIncludedInWithdrawable(t *testing.T) {
+	s := setupLeaseScaling(t, 1_000, skutypes.Unit_UNIT_PER_DAY, dailyPrice)
+	f := s.f
+	k := f.App.BillingKeeper
+	start := f.Ctx.BlockTime()
+
+	// ~5.83 accrues, 5 is paid and ~0.83 is carried.
+	f.Ctx = f.Ctx.WithBlockTime(start.Add(7 * time.Hour))
+	withdrawLease(t, s)
+
+	// Another hour adds ~0.83, which only pays out together with the carry.
+	f.Ctx = f.Ctx.WithBlockTime(start.Add(8 * time.Hour))
+	lease, err := k.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(1), k.CalculateWithdrawableForLease(f.Ctx, lease).AmountOf(testDenom))
+}
+
+// TestMigrate2to3_BackfillsLockedRate verifies the v2→v3 migration gives
+// time-billed items locked before decimal rates their whole-unit locked_price
+// as locked_rate.
+func TestMigrate2to3_BackfillsLockedRate(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	k := f.App.BillingKeeper
+
+	lease, err := k.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	lease.Items[0].LockedRate = sdkmath.LegacyDec{}
+	require.NoError(t, k.Leases.Set(f.Ctx, lease.Uuid, lease))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate2to3(f.Ctx))
+
+	lease, err = k.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.True(t, sdkmath.LegacyNewDec(1).Equal(lease.Items[0].LockedRate))
+}
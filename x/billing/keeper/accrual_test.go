@@ -4,6 +4,7 @@ Package keeper contains unit tests for accrual calculation functions.
 Test Coverage:
 - ConvertBasePriceToPerSecond: price conversion for different units
 - CalculateAccruedAmount: accrual calculation for single items
+- CalculateCalendarMonthAccrual: accrual across calendar months of different lengths
 - CalculateTotalAccruedForLease: total accrual for multiple items
 - Precision loss scenarios with various price/duration combinations
 - Overflow protection for long-running leases
@@ -31,35 +32,56 @@ func TestConvertBasePriceToPerSecond(t *testing.T) {
 		name      string
 		basePrice sdk.Coin
 		unit      skutypes.Unit
-		expected  sdk.Coin
+		expected  sdk.DecCoin
 		expectErr bool
 	}{
 		{
 			name:      "per hour: 3600 -> 1 per second",
 			basePrice: sdk.NewCoin(testDenom, math.NewInt(3600)),
 			unit:      skutypes.Unit_UNIT_PER_HOUR,
-			expected:  sdk.NewCoin(testDenom, math.NewInt(1)),
+			expected:  sdk.NewDecCoin(testDenom, math.NewInt(1)),
 			expectErr: false,
 		},
 		{
 			name:      "per hour: 7200 -> 2 per second",
 			basePrice: sdk.NewCoin(testDenom, math.NewInt(7200)),
 			unit:      skutypes.Unit_UNIT_PER_HOUR,
-			expected:  sdk.NewCoin(testDenom, math.NewInt(2)),
+			expected:  sdk.NewDecCoin(testDenom, math.NewInt(2)),
 			expectErr: false,
 		},
 		{
 			name:      "per day: 86400 -> 1 per second",
 			basePrice: sdk.NewCoin(testDenom, math.NewInt(86400)),
 			unit:      skutypes.Unit_UNIT_PER_DAY,
-			expected:  sdk.NewCoin(testDenom, math.NewInt(1)),
+			expected:  sdk.NewDecCoin(testDenom, math.NewInt(1)),
 			expectErr: false,
 		},
 		{
 			name:      "per day: 172800 -> 2 per second",
 			basePrice: sdk.NewCoin(testDenom, math.NewInt(172800)),
 			unit:      skutypes.Unit_UNIT_PER_DAY,
-			expected:  sdk.NewCoin(testDenom, math.NewInt(2)),
+			expected:  sdk.NewDecCoin(testDenom, math.NewInt(2)),
+			expectErr: false,
+		},
+		{
+			name:      "per minute: 60 -> 1 per second",
+			basePrice: sdk.NewCoin(testDenom, math.NewInt(60)),
+			unit:      skutypes.Unit_UNIT_PER_MINUTE,
+			expected:  sdk.NewDecCoin(testDenom, math.NewInt(1)),
+			expectErr: false,
+		},
+		{
+			name:      "per week: 1209600 -> 2 per second",
+			basePrice: sdk.NewCoin(testDenom, math.NewInt(1209600)),
+			unit:      skutypes.Unit_UNIT_PER_WEEK,
+			expected:  sdk.NewDecCoin(testDenom, math.NewInt(2)),
+			expectErr: false,
+		},
+		{
+			name:      "per month: the rate of a 28-day month",
+			basePrice: sdk.NewCoin(testDenom, math.NewInt(2419200)),
+			unit:      skutypes.Unit_UNIT_PER_MONTH,
+			expected:  sdk.NewDecCoin(testDenom, math.NewInt(1)),
 			expectErr: false,
 		},
 		{
@@ -69,23 +91,31 @@ func TestConvertBasePriceToPerSecond(t *testing.T) {
 			expectErr: true,
 		},
 		{
-			name:      "per hour: small amount returns error (zero rate)",
+			name:      "per hour: small amount gives a fractional rate",
 			basePrice: sdk.NewCoin(testDenom, math.NewInt(100)),
 			unit:      skutypes.Unit_UNIT_PER_HOUR,
-			expectErr: true, // 100/3600 = 0, which is invalid
+			expected:  sdk.NewDecCoinFromDec(testDenom, math.LegacyMustNewDecFromStr("0.027777777777777778")),
+			expectErr: false,
+		},
+		{
+			name:      "per hour: zero price returns error",
+			basePrice: sdk.NewCoin(testDenom, math.ZeroInt()),
+			unit:      skutypes.Unit_UNIT_PER_HOUR,
+			expectErr: true,
 		},
 		{
 			name:      "per hour: large amount",
 			basePrice: sdk.NewCoin(testDenom, math.NewInt(36000000)),
 			unit:      skutypes.Unit_UNIT_PER_HOUR,
-			expected:  sdk.NewCoin(testDenom, math.NewInt(10000)),
+			expected:  sdk.NewDecCoin(testDenom, math.NewInt(10000)),
 			expectErr: false,
 		},
 		{
-			name:      "per hour: inexact division returns error",
+			name:      "per hour: inexact division keeps the fraction",
 			basePrice: sdk.NewCoin(testDenom, math.NewInt(3601)), // Not evenly divisible by 3600
 			unit:      skutypes.Unit_UNIT_PER_HOUR,
-			expectErr: true,
+			expected:  sdk.NewDecCoinFromDec(testDenom, math.LegacyMustNewDecFromStr("1.000277777777777778")),
+			expectErr: false,
 		},
 	}
 
@@ -105,66 +135,73 @@ func TestConvertBasePriceToPerSecond(t *testing.T) {
 func TestCalculateAccruedAmount(t *testing.T) {
 	tests := []struct {
 		name                 string
-		lockedPricePerSecond sdk.Coin
+		lockedPricePerSecond sdk.DecCoin
 		quantity             uint64
 		duration             time.Duration
-		expected             sdk.Coin
+		expected             sdk.DecCoin
 	}{
 		{
 			name:                 "1 per second, 1 quantity, 100 seconds",
-			lockedPricePerSecond: sdk.NewCoin(testDenom, math.NewInt(1)),
+			lockedPricePerSecond: sdk.NewDecCoin(testDenom, math.NewInt(1)),
 			quantity:             1,
 			duration:             100 * time.Second,
-			expected:             sdk.NewCoin(testDenom, math.NewInt(100)),
+			expected:             sdk.NewDecCoin(testDenom, math.NewInt(100)),
 		},
 		{
 			name:                 "1 per second, 5 quantity, 100 seconds",
-			lockedPricePerSecond: sdk.NewCoin(testDenom, math.NewInt(1)),
+			lockedPricePerSecond: sdk.NewDecCoin(testDenom, math.NewInt(1)),
 			quantity:             5,
 			duration:             100 * time.Second,
-			expected:             sdk.NewCoin(testDenom, math.NewInt(500)),
+			expected:             sdk.NewDecCoin(testDenom, math.NewInt(500)),
 		},
 		{
 			name:                 "10 per second, 2 quantity, 60 seconds",
-			lockedPricePerSecond: sdk.NewCoin(testDenom, math.NewInt(10)),
+			lockedPricePerSecond: sdk.NewDecCoin(testDenom, math.NewInt(10)),
 			quantity:             2,
 			duration:             60 * time.Second,
-			expected:             sdk.NewCoin(testDenom, math.NewInt(1200)),
+			expected:             sdk.NewDecCoin(testDenom, math.NewInt(1200)),
 		},
 		{
 			name:                 "zero duration",
-			lockedPricePerSecond: sdk.NewCoin(testDenom, math.NewInt(100)),
+			lockedPricePerSecond: sdk.NewDecCoin(testDenom, math.NewInt(100)),
 			quantity:             5,
 			duration:             0,
-			expected:             sdk.NewCoin(testDenom, math.NewInt(0)),
+			expected:             sdk.NewDecCoin(testDenom, math.NewInt(0)),
 		},
 		{
 			name:                 "negative duration",
-			lockedPricePerSecond: sdk.NewCoin(testDenom, math.NewInt(100)),
+			lockedPricePerSecond: sdk.NewDecCoin(testDenom, math.NewInt(100)),
 			quantity:             5,
 			duration:             -10 * time.Second,
-			expected:             sdk.NewCoin(testDenom, math.NewInt(0)),
+			expected:             sdk.NewDecCoin(testDenom, math.NewInt(0)),
 		},
 		{
 			name:                 "zero price",
-			lockedPricePerSecond: sdk.NewCoin(testDenom, math.ZeroInt()),
+			lockedPricePerSecond: sdk.NewDecCoin(testDenom, math.ZeroInt()),
 			quantity:             5,
 			duration:             100 * time.Second,
-			expected:             sdk.NewCoin(testDenom, math.NewInt(0)),
+			expected:             sdk.NewDecCoin(testDenom, math.NewInt(0)),
+		},
+		{
+			name:                 "fractional rate keeps the fraction",
+			lockedPricePerSecond: sdk.NewDecCoinFromDec(testDenom, math.LegacyMustNewDecFromStr("0.5")),
+			quantity:             3,
+			duration:             7 * time.Second,
+			expected:             sdk.NewDecCoinFromDec(testDenom, math.LegacyMustNewDecFromStr("10.5")),
 		},
 		{
 			name:                 "1 hour duration",
-			lockedPricePerSecond: sdk.NewCoin(testDenom, math.NewInt(1)),
+			lockedPricePerSecond: sdk.NewDecCoin(testDenom, math.NewInt(1)),
 			quantity:             1,
 			duration:             time.Hour,
-			expected:             sdk.NewCoin(testDenom, math.NewInt(3600)),
+			expected:             sdk.NewDecCoin(testDenom, math.NewInt(3600)),
 		},
 		{
 			name:                 "24 hour duration",
-			lockedPricePerSecond: sdk.NewCoin(testDenom, math.NewInt(1)),
+			lockedPricePerSecond: sdk.NewDecCoin(testDenom, math.NewInt(1)),
 			quantity:             1,
 			duration:             24 * time.Hour,
-			expected:             sdk.NewCoin(testDenom, math.NewInt(86400)),
+			expected:             sdk.NewDecCoin(testDenom, math.NewInt(86400)),
 		},
 	}
 
@@ -177,6 +214,88 @@ func TestCalculateAccruedAmount(t *testing.T) {
 	}
 }
 
+func TestCalculateCalendarMonthAccrual(t *testing.T) {
+	// 74995200 is a whole number of units per second in both a 31-day and a
+	// 28-day month: 28/s in January and 31/s in February 2026.
+	price := sdk.NewCoin(testDenom, math.NewInt(74995200))
+	date := func(month time.Month, day, hour int) time.Time {
+		return time.Date(2026, month, day, hour, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		quantity uint64
+		from     time.Time
+		to       time.Time
+		expected math.LegacyDec
+	}{
+		{
+			name:     "full 31-day month accrues the monthly price",
+			quantity: 1,
+			from:     date(time.January, 1, 0),
+			to:       date(time.February, 1, 0),
+			expected: math.LegacyNewDec(74995200),
+		},
+		{
+			name:     "full 28-day month accrues the monthly price",
+			quantity: 1,
+			from:     date(time.February, 1, 0),
+			to:       date(time.March, 1, 0),
+			expected: math.LegacyNewDec(74995200),
+		},
+		{
+			name:     "one day in january",
+			quantity: 2,
+			from:     date(time.January, 10, 0),
+			to:       date(time.January, 11, 0),
+			expected: math.LegacyNewDec(2 * 28 * 86400),
+		},
+		{
+			name:     "across the month boundary",
+			quantity: 1,
+			from:     date(time.January, 31, 12),
+			to:       date(time.February, 1, 12),
+			expected: math.LegacyNewDec(28*43200 + 31*43200),
+		},
+		{
+			name:     "across a year boundary",
+			quantity: 1,
+			from:     time.Date(2025, time.December, 31, 0, 0, 0, 0, time.UTC),
+			to:       date(time.January, 2, 0),
+			expected: math.LegacyNewDec(2 * 28 * 86400),
+		},
+		{
+			name:     "zero duration",
+			quantity: 1,
+			from:     date(time.January, 1, 0),
+			to:       date(time.January, 1, 0),
+			expected: math.LegacyZeroDec(),
+		},
+		{
+			name:     "negative duration",
+			quantity: 1,
+			from:     date(time.January, 2, 0),
+			to:       date(time.January, 1, 0),
+			expected: math.LegacyZeroDec(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := CalculateCalendarMonthAccrual(price, tc.quantity, tc.from, tc.to)
+			require.NoError(t, err)
+			require.Equal(t, testDenom, result.Denom)
+			require.True(t, tc.expected.Equal(result.Amount), "expected %s, got %s", tc.expected, result.Amount)
+		})
+	}
+
+	t.Run("exceeds max duration", func(t *testing.T) {
+		from := date(time.January, 1, 0)
+		_, err := CalculateCalendarMonthAccrual(price, 1, from, from.Add(time.Duration(MaxDurationSeconds+1)*time.Second))
+		require.Error(t, err)
+	})
+}
+
 func TestCalculateTotalAccruedForLease(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -187,7 +306,7 @@ func TestCalculateTotalAccruedForLease(t *testing.T) {
 		{
 			name: "single item",
 			items: []LeaseItemWithPrice{
-				{SkuUUID: "sku-1", Quantity: 2, LockedPricePerSecond: sdk.NewCoin(testDenom, math.NewInt(10))},
+				{SkuUUID: "sku-1", Quantity: 2, LockedPricePerSecond: sdk.NewDecCoin(testDenom, math.NewInt(10))},
 			},
 			duration: 100 * time.Second,
 			expected: sdk.NewCoins(sdk.NewCoin(testDenom, math.NewInt(2000))), // 10 * 2 * 100
@@ -195,9 +314,9 @@ func TestCalculateTotalAccruedForLease(t *testing.T) {
 		{
 			name: "multiple items same denom",
 			items: []LeaseItemWithPrice{
-				{SkuUUID: "sku-1", Quantity: 1, LockedPricePerSecond: sdk.NewCoin(testDenom, math.NewInt(10))},
-				{SkuUUID: "sku-2", Quantity: 2, LockedPricePerSecond: sdk.NewCoin(testDenom, math.NewInt(5))},
-				{SkuUUID: "sku-3", Quantity: 3, LockedPricePerSecond: sdk.NewCoin(testDenom, math.NewInt(1))},
+				{SkuUUID: "sku-1", Quantity: 1, LockedPricePerSecond: sdk.NewDecCoin(testDenom, math.NewInt(10))},
+				{SkuUUID: "sku-2", Quantity: 2, LockedPricePerSecond: sdk.NewDecCoin(testDenom, math.NewInt(5))},
+				{SkuUUID: "sku-3", Quantity: 3, LockedPricePerSecond: sdk.NewDecCoin(testDenom, math.NewInt(1))},
 			},
 			duration: 100 * time.Second,
 			expected: sdk.NewCoins(sdk.NewCoin(testDenom, math.NewInt(2300))), // (10*1 + 5*2 + 1*3) * 100 = 23 * 100
@@ -205,8 +324,8 @@ func TestCalculateTotalAccruedForLease(t *testing.T) {
 		{
 			name: "multiple items different denoms",
 			items: []LeaseItemWithPrice{
-				{SkuUUID: "sku-1", Quantity: 1, LockedPricePerSecond: sdk.NewCoin(testDenom, math.NewInt(10))},
-				{SkuUUID: "sku-2", Quantity: 2, LockedPricePerSecond: sdk.NewCoin("uother", math.NewInt(5))},
+				{SkuUUID: "sku-1", Quantity: 1, LockedPricePerSecond: sdk.NewDecCoin(testDenom, math.NewInt(10))},
+				{SkuUUID: "sku-2", Quantity: 2, LockedPricePerSecond: sdk.NewDecCoin("uother", math.NewInt(5))},
 			},
 			duration: 100 * time.Second,
 			expected: sdk.NewCoins(
@@ -214,6 +333,15 @@ func TestCalculateTotalAccruedForLease(t *testing.T) {
 				sdk.NewCoin("uother", math.NewInt(1000)),  // 5 * 2 * 100
 			),
 		},
+		{
+			name: "fractional rates summed before truncating",
+			items: []LeaseItemWithPrice{
+				{SkuUUID: "sku-1", Quantity: 1, LockedPricePerSecond: sdk.NewDecCoinFromDec(testDenom, math.LegacyMustNewDecFromStr("0.4"))},
+				{SkuUUID: "sku-2", Quantity: 1, LockedPricePerSecond: sdk.NewDecCoinFromDec(testDenom, math.LegacyMustNewDecFromStr("0.4"))},
+			},
+			duration: 3 * time.Second,
+			expected: sdk.NewCoins(sdk.NewCoin(testDenom, math.NewInt(2))), // 0.8 * 3 = 2.4
+		},
 		{
 			name:     "empty items",
 			items:    []LeaseItemWithPrice{},
@@ -223,16 +351,17 @@ func TestCalculateTotalAccruedForLease(t *testing.T) {
 		{
 			name: "zero duration",
 			items: []LeaseItemWithPrice{
-				{SkuUUID: "sku-1", Quantity: 5, LockedPricePerSecond: sdk.NewCoin(testDenom, math.NewInt(100))},
+				{SkuUUID: "sku-1", Quantity: 5, LockedPricePerSecond: sdk.NewDecCoin(testDenom, math.NewInt(100))},
 			},
 			duration: 0,
 			expected: sdk.NewCoins(),
 		},
 	}
 
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			result, err := CalculateTotalAccruedForLease(tc.items, tc.duration)
+			result, err := CalculateTotalAccruedForLease(tc.items, start, start.Add(tc.duration))
 			require.NoError(t, err)
 			require.True(t, tc.expected.Equal(result), "expected %s, got %s", tc.expected, result)
 		})
@@ -262,7 +391,7 @@ func TestCalculateAccruedAmountOverflow(t *testing.T) {
 		},
 	}
 
-	pricePerSecond := sdk.NewCoin(testDenom, math.NewInt(1))
+	pricePerSecond := sdk.NewInt64DecCoin(testDenom, 1)
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -281,7 +410,7 @@ func TestLargeValueCalculations(t *testing.T) {
 	// but should work with math.Int (big.Int)
 
 	// Large price: 1 trillion per second
-	largePrice := sdk.NewCoin(testDenom, math.NewInt(1_000_000_000_000))
+	largePrice := sdk.NewInt64DecCoin(testDenom, 1_000_000_000_000)
 
 	// 1 year duration
 	yearSeconds := int64(365 * 24 * 60 * 60)
@@ -296,36 +425,36 @@ func TestLargeValueCalculations(t *testing.T) {
 }
 
 func TestPrecisionLoss(t *testing.T) {
-	// Test that invalid pricing returns errors as expected
+	// Prices that do not divide the unit period convert to decimal rates, so
+	// nothing is lost to integer division.
 
-	// Price of 1 per hour should error (would result in 0 per second)
-	// 1 / 3600 = 0
+	// 1 per hour converts to a rate below one unit per second
 	basePrice := sdk.NewCoin(testDenom, math.NewInt(1))
-	_, err := ConvertBasePriceToPerSecond(basePrice, skutypes.Unit_UNIT_PER_HOUR)
-	require.Error(t, err, "zero rate should return error")
+	perSecond, err := ConvertBasePriceToPerSecond(basePrice, skutypes.Unit_UNIT_PER_HOUR)
+	require.NoError(t, err)
+	require.True(t, perSecond.Amount.IsPositive())
+	require.True(t, perSecond.Amount.LT(math.LegacyOneDec()))
 
-	// Price of 3599 per hour should error (not evenly divisible)
+	// 3599 per hour accrues 3599 over an hour (within the 18-decimal rounding
+	// of the rate)
 	basePrice = sdk.NewCoin(testDenom, math.NewInt(3599))
-	_, err = ConvertBasePriceToPerSecond(basePrice, skutypes.Unit_UNIT_PER_HOUR)
-	require.Error(t, err, "inexact division should return error")
+	perSecond, err = ConvertBasePriceToPerSecond(basePrice, skutypes.Unit_UNIT_PER_HOUR)
+	require.NoError(t, err)
+	accrued, err := CalculateAccruedAmount(perSecond, 1, time.Hour)
+	require.NoError(t, err)
+	require.True(t, accrued.Amount.Sub(math.LegacyNewDec(3599)).Abs().LT(math.LegacyNewDecWithPrec(1, 12)))
 
-	// Price of 3600 per hour should be exactly 1 per second
+	// 3600 per hour is exactly 1 per second
 	basePrice = sdk.NewCoin(testDenom, math.NewInt(3600))
-	perSecond, err := ConvertBasePriceToPerSecond(basePrice, skutypes.Unit_UNIT_PER_HOUR)
+	perSecond, err = ConvertBasePriceToPerSecond(basePrice, skutypes.Unit_UNIT_PER_HOUR)
 	require.NoError(t, err)
-	require.Equal(t, math.NewInt(1), perSecond.Amount)
-
-	// Price of 7199 per hour should error (not evenly divisible)
-	// The SKU module now requires exact divisibility for valid pricing
-	basePrice = sdk.NewCoin(testDenom, math.NewInt(7199))
-	_, err = ConvertBasePriceToPerSecond(basePrice, skutypes.Unit_UNIT_PER_HOUR)
-	require.Error(t, err, "inexact division should return error")
+	require.True(t, math.LegacyNewDec(1).Equal(perSecond.Amount))
 
-	// Price of 7200 per hour should be exactly 2 per second
+	// 7200 per hour is exactly 2 per second
 	basePrice = sdk.NewCoin(testDenom, math.NewInt(7200))
 	perSecond, err = ConvertBasePriceToPerSecond(basePrice, skutypes.Unit_UNIT_PER_HOUR)
 	require.NoError(t, err)
-	require.Equal(t, math.NewInt(2), perSecond.Amount)
+	require.True(t, math.LegacyNewDec(2).Equal(perSecond.Amount))
 }
 
 // ============================================================================
@@ -349,7 +478,7 @@ func BenchmarkConvertBasePriceToPerSecond(b *testing.B) {
 }
 
 func BenchmarkCalculateAccruedAmount(b *testing.B) {
-	pricePerSecond := sdk.NewCoin(testDenom, math.NewInt(1000))
+	pricePerSecond := sdk.NewInt64DecCoin(testDenom, 1000)
 
 	b.Run("SmallDuration_100s", func(b *testing.B) {
 		for i := 0; i < b.N; i++ {
@@ -370,7 +499,7 @@ func BenchmarkCalculateAccruedAmount(b *testing.B) {
 	})
 
 	b.Run("LargePrice_Trillion", func(b *testing.B) {
-		largePrice := sdk.NewCoin(testDenom, math.NewInt(1_000_000_000_000))
+		largePrice := sdk.NewInt64DecCoin(testDenom, 1_000_000_000_000)
 		for i := 0; i < b.N; i++ {
 			_, _ = CalculateAccruedAmount(largePrice, 100, 365*24*time.Hour)
 		}
@@ -379,15 +508,15 @@ func BenchmarkCalculateAccruedAmount(b *testing.B) {
 
 func BenchmarkCalculateTotalAccruedForLease(b *testing.B) {
 	singleItem := []LeaseItemWithPrice{
-		{SkuUUID: "sku-1", Quantity: 1, LockedPricePerSecond: sdk.NewCoin(testDenom, math.NewInt(100))},
+		{SkuUUID: "sku-1", Quantity: 1, LockedPricePerSecond: sdk.NewDecCoin(testDenom, math.NewInt(100))},
 	}
 
 	fiveItems := []LeaseItemWithPrice{
-		{SkuUUID: "sku-1", Quantity: 1, LockedPricePerSecond: sdk.NewCoin(testDenom, math.NewInt(100))},
-		{SkuUUID: "sku-2", Quantity: 2, LockedPricePerSecond: sdk.NewCoin(testDenom, math.NewInt(200))},
-		{SkuUUID: "sku-3", Quantity: 3, LockedPricePerSecond: sdk.NewCoin("umfx", math.NewInt(300))},
-		{SkuUUID: "sku-4", Quantity: 4, LockedPricePerSecond: sdk.NewCoin("uother", math.NewInt(400))},
-		{SkuUUID: "sku-5", Quantity: 5, LockedPricePerSecond: sdk.NewCoin(testDenom, math.NewInt(500))},
+		{SkuUUID: "sku-1", Quantity: 1, LockedPricePerSecond: sdk.NewDecCoin(testDenom, math.NewInt(100))},
+		{SkuUUID: "sku-2", Quantity: 2, LockedPricePerSecond: sdk.NewDecCoin(testDenom, math.NewInt(200))},
+		{SkuUUID: "sku-3", Quantity: 3, LockedPricePerSecond: sdk.NewDecCoin("umfx", math.NewInt(300))},
+		{SkuUUID: "sku-4", Quantity: 4, LockedPricePerSecond: sdk.NewDecCoin("uother", math.NewInt(400))},
+		{SkuUUID: "sku-5", Quantity: 5, LockedPricePerSecond: sdk.NewDecCoin(testDenom, math.NewInt(500))},
 	}
 
 	twentyItems := make([]LeaseItemWithPrice, 20)
@@ -395,27 +524,28 @@ func BenchmarkCalculateTotalAccruedForLease(b *testing.B) {
 		twentyItems[i] = LeaseItemWithPrice{
 			SkuUUID:              "sku-" + string(rune('a'+i)),
 			Quantity:             uint64(i) + 1, //nolint:gosec // i is bounded [0,19]
-			LockedPricePerSecond: sdk.NewCoin(testDenom, math.NewInt(int64(100*(i+1)))),
+			LockedPricePerSecond: sdk.NewDecCoin(testDenom, math.NewInt(int64(100*(i+1)))),
 		}
 	}
 
-	duration := time.Hour
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
 
 	b.Run("SingleItem", func(b *testing.B) {
 		for i := 0; i < b.N; i++ {
-			_, _ = CalculateTotalAccruedForLease(singleItem, duration)
+			_, _ = CalculateTotalAccruedForLease(singleItem, start, end)
 		}
 	})
 
 	b.Run("FiveItems_MultiDenom", func(b *testing.B) {
 		for i := 0; i < b.N; i++ {
-			_, _ = CalculateTotalAccruedForLease(fiveItems, duration)
+			_, _ = CalculateTotalAccruedForLease(fiveItems, start, end)
 		}
 	})
 
 	b.Run("TwentyItems", func(b *testing.B) {
 		for i := 0; i < b.N; i++ {
-			_, _ = CalculateTotalAccruedForLease(twentyItems, duration)
+			_, _ = CalculateTotalAccruedForLease(twentyItems, start, end)
 		}
 	})
 }
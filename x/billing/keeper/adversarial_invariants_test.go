@@ -300,9 +300,17 @@ func RunAllInvariants(
 		CheckNoOrphanedLeasesInvariant(ctx, k),
 		CheckValidTimestampsInvariant(ctx, k, blockTime),
 		CheckGenesisRoundTripInvariant(ctx, k),
+		CheckRegisteredInvariants(ctx, k),
 	}
 }
 
+// CheckRegisteredInvariants runs the invariants the module registers with the
+// crisis module.
+func CheckRegisteredInvariants(ctx context.Context, k keeper.Keeper) InvariantResult {
+	msg, broken := keeper.AllInvariants(k)(sdk.UnwrapSDKContext(ctx))
+	return InvariantResult{Name: "Registered", Passed: !broken, Message: msg}
+}
+
 // =============================================================================
 // Tests Using Invariant Checkers
 // =============================================================================
@@ -104,7 +104,7 @@ func TestAdversarial_SettlementWithZeroDuration(t *testing.T) {
 	require.NoError(t, err)
 
 	// Settle at the exact LastSettledAt time — duration = 0
-	result, err := f.App.BillingKeeper.PerformSettlement(f.Ctx, &lease, now)
+	result, err := f.App.BillingKeeper.PerformSettlement(f.Ctx, &lease, now, types.SETTLEMENT_TRIGGER_WITHDRAW)
 	require.NoError(t, err)
 	require.True(t, result.TransferAmounts.IsZero(), "zero duration should produce zero transfer")
 	require.True(t, result.AccruedAmounts.IsZero(), "zero duration should produce zero accrual")
@@ -141,7 +141,7 @@ func TestAdversarial_SettlementWithNegativeDuration(t *testing.T) {
 
 	// Settle BEFORE LastSettledAt
 	pastTime := now.Add(-100 * time.Second)
-	result, err := f.App.BillingKeeper.PerformSettlement(f.Ctx, &lease, pastTime)
+	result, err := f.App.BillingKeeper.PerformSettlement(f.Ctx, &lease, pastTime, types.SETTLEMENT_TRIGGER_WITHDRAW)
 	require.NoError(t, err)
 	require.True(t, result.TransferAmounts.IsZero(), "negative duration should produce zero transfer")
 }
@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/keeper"
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+	skutypes "github.com/manifest-network/manifest-ledger/x/sku/types"
+)
+
+// monthlyPrice bills a whole number of units per second in both a 31-day and
+// a 28-day month: 28/s in January and 31/s in February 2026.
+const monthlyPrice = 74995200
+
+func TestCalendarMonth_LocksMonthlyPrice(t *testing.T) {
+	s := setupLeaseScaling(t, 10_000_000, skutypes.Unit_UNIT_PER_MONTH, monthlyPrice)
+	lease, err := s.f.App.BillingKeeper.GetLease(s.f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+
+	item := lease.Items[0]
+	require.True(t, item.CalendarMonth)
+	require.Equal(t, s.sku.BasePrice, item.MonthlyPrice)
+	// locked_rate is the rate of a 28-day month, and locked_price that rate
+	// in whole units, as for any time-billed item.
+	require.True(t, sdkmath.LegacyNewDec(31).Equal(item.LockedRate))
+	require.Equal(t, sdk.NewInt64Coin(testDenom, 31), item.LockedPrice)
+
+	// 3600s of min_lease_duration for two units at the highest rate.
+	require.Equal(t, sdkmath.NewInt(2*31*3600), s.reserved(t))
+}
+
+func TestCalendarMonth_AccruesAcrossMonthBoundary(t *testing.T) {
+	s := setupLeaseScaling(t, 10_000_000, skutypes.Unit_UNIT_PER_MONTH, monthlyPrice)
+	f := s.f
+	k := f.App.BillingKeeper
+	payoutBefore := f.App.BankKeeper.GetBalance(f.Ctx, s.payoutAddr, testDenom).Amount
+
+	// Twelve hours of January at 28/s and twelve of February at 31/s, for
+	// two units.
+	f.Ctx = f.Ctx.WithBlockTime(time.Date(2026, time.February, 1, 12, 0, 0, 0, time.UTC))
+	lease, err := k.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	expected := sdkmath.NewInt(2 * (28*43200 + 31*43200))
+	require.Equal(t, expected, k.CalculateWithdrawableForLease(f.Ctx, lease).AmountOf(testDenom))
+
+	withdrawLease(t, s)
+	payout := f.App.BankKeeper.GetBalance(f.Ctx, s.payoutAddr, testDenom).Amount.Sub(payoutBefore)
+	require.Equal(t, expected, payout)
+}
+
+func TestCalendarMonth_CreditEstimateAndProjection(t *testing.T) {
+	s := setupLeaseScaling(t, 10_000_000, skutypes.Unit_UNIT_PER_MONTH, monthlyPrice)
+	f := s.f
+	k := f.App.BillingKeeper
+
+	estimate, err := keeper.NewQuerier(k).CreditEstimate(f.Ctx, &types.QueryCreditEstimateRequest{Tenant: s.tenant.String()})
+	require.NoError(t, err)
+	// The rate shown is January's, for two units.
+	require.True(t, sdkmath.LegacyNewDec(56).Equal(estimate.TotalRatePerSecondDec.AmountOf(testDenom)))
+	// 2419200 is spent by the end of January; the remaining 7580800 lasts
+	// 122270.97s at February's 62/s.
+	require.Equal(t, uint64(43200+122270), estimate.EstimatedDurationSeconds)
+
+	at, err := k.CreditExhaustion.Get(f.Ctx, collections.Join(s.tenant, testDenom))
+	require.NoError(t, err)
+	require.Equal(t, f.Ctx.BlockTime().Add((43200+122271)*time.Second), at)
+}
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+)
+
+// creditDepositBucket is the granularity at which inflows are grouped in
+// CreditDeposits. It bounds the entries a tenant can accumulate per denom to
+// credit_withdrawal_cooldown / creditDepositBucket, however many transfers
+// are made into the account.
+const creditDepositBucket = time.Hour
+
+// creditDepositTime returns the CreditDeposits bucket of an inflow at t: the
+// end of the hour it falls in, so grouped credit never unlocks early.
+func creditDepositTime(t time.Time) time.Time {
+	t = t.UTC()
+	bucket := t.Truncate(creditDepositBucket)
+	if bucket.Before(t) {
+		bucket = bucket.Add(creditDepositBucket)
+	}
+	return bucket
+}
+
+// creditDepositCooldown returns params.credit_withdrawal_cooldown as a
+// duration.
+func creditDepositCooldown(params types.Params) time.Duration {
+	return time.Duration(params.CreditWithdrawalCooldown) * time.Second
+}
+
+// recordCreditDeposit locks amount in the tenant's credit account for
+// params.credit_withdrawal_cooldown. Entries of the same denoms that have
+// already unlocked are pruned on the way. Nothing is recorded while the
+// cooldown is disabled.
+func (k *Keeper) recordCreditDeposit(ctx context.Context, tenantAddr sdk.AccAddress, amount sdk.Coins) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if params.CreditWithdrawalCooldown == 0 {
+		return nil
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	cutoff := blockTime.Add(-creditDepositCooldown(params))
+	depositedAt := creditDepositTime(blockTime)
+
+	for _, coin := range amount {
+		if !coin.IsPositive() {
+			continue
+		}
+		if err := k.pruneCreditDeposits(ctx, tenantAddr, coin.Denom, cutoff); err != nil {
+			return err
+		}
+
+		key := collections.Join3(tenantAddr, coin.Denom, depositedAt)
+		deposited, err := k.CreditDeposits.Get(ctx, key)
+		if err != nil {
+			if !errors.Is(err, collections.ErrNotFound) {
+				return err
+			}
+			deposited = sdkmath.ZeroInt()
+		}
+		if err := k.CreditDeposits.Set(ctx, key, deposited.Add(coin.Amount)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pruneCreditDeposits removes the tenant's deposits of denom made at or
+// before cutoff, which have finished their cooldown.
+func (k *Keeper) pruneCreditDeposits(ctx context.Context, tenantAddr sdk.AccAddress, denom string, cutoff time.Time) error {
+	var unlocked []collections.Triple[sdk.AccAddress, string, time.Time]
+	rng := collections.NewSuperPrefixedTripleRange[sdk.AccAddress, string, time.Time](tenantAddr, denom)
+	err := k.CreditDeposits.Walk(ctx, rng, func(key collections.Triple[sdk.AccAddress, string, time.Time], _ sdkmath.Int) (bool, error) {
+		if key.K3().After(cutoff) {
+			return true, nil
+		}
+		unlocked = append(unlocked, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, key := range unlocked {
+		if err := k.CreditDeposits.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetLockedCredit returns the part of the tenant's credit in denoms that is
+// still within the withdrawal cooldown, and the block time at which all of it
+// has unlocked. Locked credit can back leases but cannot be withdrawn or
+// transferred. The time is zero when nothing is locked.
+func (k *Keeper) GetLockedCredit(ctx context.Context, tenant string, denoms []string) (sdk.Coins, time.Time, error) {
+	tenantAddr, err := sdk.AccAddressFromBech32(tenant)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	locked := sdk.NewCoins()
+	var unlockAt time.Time
+	if params.CreditWithdrawalCooldown == 0 {
+		return locked, unlockAt, nil
+	}
+
+	cooldown := creditDepositCooldown(params)
+	cutoff := sdk.UnwrapSDKContext(ctx).BlockTime().Add(-cooldown)
+	for _, denom := range denoms {
+		rng := collections.NewSuperPrefixedTripleRange[sdk.AccAddress, string, time.Time](tenantAddr, denom)
+		err := k.CreditDeposits.Walk(ctx, rng, func(key collections.Triple[sdk.AccAddress, string, time.Time], amount sdkmath.Int) (bool, error) {
+			if !key.K3().After(cutoff) {
+				return false, nil
+			}
+			locked = locked.Add(sdk.NewCoin(denom, amount))
+			if at := key.K3().Add(cooldown); at.After(unlockAt) {
+				unlockAt = at
+			}
+			return false, nil
+		})
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+	}
+	return locked, unlockAt, nil
+}
+
+// GetAllCreditDeposits returns every CreditDeposit in the store.
+func (k *Keeper) GetAllCreditDeposits(ctx context.Context) ([]types.CreditDeposit, error) {
+	var deposits []types.CreditDeposit
+	err := k.CreditDeposits.Walk(ctx, nil, func(key collections.Triple[sdk.AccAddress, string, time.Time], amount sdkmath.Int) (bool, error) {
+		deposits = append(deposits, types.CreditDeposit{
+			Tenant:      key.K1().String(),
+			Amount:      sdk.NewCoin(key.K2(), amount),
+			DepositedAt: key.K3(),
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deposits, nil
+}
@@ -0,0 +1,392 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+)
+
+// ProjectCreditExhaustion returns the first whole second, counted from now, at
+// which ratePerSecond and perMonth, charged per calendar month, accruing on
+// top of unsettled reach balance. This is the point where ShouldAutoCloseLease
+// starts reporting the credit as exhausted. A balance already covered by
+// unsettled accruals is exhausted now. Rates and unsettled accruals are
+// decimal, the latter including the fractional carry of each lease. The
+// second return is false when nothing accrues or the projection lies beyond
+// MaxDurationSeconds.
+func ProjectCreditExhaustion(balance sdkmath.Int, unsettled, ratePerSecond, perMonth sdkmath.LegacyDec, now time.Time) (time.Time, bool) {
+	if !ratePerSecond.IsPositive() && !perMonth.IsPositive() {
+		return time.Time{}, false
+	}
+
+	remaining := sdkmath.LegacyNewDecFromInt(balance).Sub(unsettled)
+	if !remaining.IsPositive() {
+		return now, true
+	}
+
+	exact, _ := accrualSeconds(remaining, ratePerSecond, perMonth, now)
+	seconds := exact.Ceil().TruncateInt()
+	if seconds.GT(sdkmath.NewInt(MaxDurationSeconds)) {
+		return time.Time{}, false
+	}
+
+	return now.Add(time.Duration(seconds.Int64()) * time.Second), true
+}
+
+// activeLeaseRatesAndAccruals returns the total per-second rate, the total
+// charged per calendar month and the exact unsettled accruals, fractional
+// carry included, of a tenant's ACTIVE leases, by denom. Leases in their grace period are left out: their credit is
+// already exhausted and they close at grace_ends_at rather than at a
+// projected time.
+func (k *Keeper) activeLeaseRatesAndAccruals(ctx context.Context, tenantAddr sdk.AccAddress, blockTime time.Time) (sdk.DecCoins, sdk.DecCoins, sdk.DecCoins, error) {
+	iter, err := k.Leases.Indexes.TenantState.MatchExact(ctx, collections.Join(tenantAddr, int32(types.LEASE_STATE_ACTIVE)))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	defer iter.Close()
+
+	rates := sdk.NewDecCoins()
+	monthly := sdk.NewDecCoins()
+	unsettled := sdk.NewDecCoins()
+	for ; iter.Valid(); iter.Next() {
+		leaseUUID, err := iter.PrimaryKey()
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		lease, err := k.Leases.Get(ctx, leaseUUID)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if lease.GraceEndsAt != nil {
+			continue
+		}
+
+		perSecond, perMonth := leaseItemSchedule(lease.Items)
+		rates = rates.Add(perSecond...)
+		monthly = monthly.Add(perMonth...)
+
+		if !blockTime.After(lease.LastSettledAt) {
+			unsettled = unsettled.Add(lease.AccrualCarry...)
+			continue
+		}
+		accrued, carry, err := CalculateLeaseAccrual(lease, blockTime)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		unsettled = unsettled.Add(sdk.NewDecCoinsFromCoins(accrued...)...).Add(carry...)
+	}
+
+	return rates, monthly, unsettled, nil
+}
+
+// refreshCreditExhaustion recomputes the projected exhaustion time of every
+// denom funding the tenant's ACTIVE leases and rewrites CreditExhaustion and
+// its queue accordingly. Denoms no longer used by an ACTIVE lease are dropped.
+//
+// Unexpired promotional credit that can pay for the ACTIVE leases counts
+// towards the balance. It must run whenever the tenant's balance or the set
+// of accruing leases changes: funding, credit withdrawal, acknowledgement,
+// closure, rate changes, and promo grants being made or reclaimed. Plain
+// settlement moves the balance and the unsettled accruals by the same amount
+// and so leaves the projection unchanged.
+//
+// Denoms with an exchange rate share one projection: the time at which the
+// accruals in all of them together are worth as much as the credit held in
+// all of them. Exchange-rate changes do not refresh projections; a projection
+// that became stale is corrected when its entry falls due and is re-checked.
+func (k *Keeper) refreshCreditExhaustion(ctx context.Context, tenant string) error {
+	tenantAddr, err := sdk.AccAddressFromBech32(tenant)
+	if err != nil {
+		return err
+	}
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	rates, monthly, unsettled, err := k.activeLeaseRatesAndAccruals(ctx, tenantAddr, blockTime)
+	if err != nil {
+		return err
+	}
+	// Any amount stands in for each denom that accrues at all.
+	accruing := rates.Add(monthly...)
+
+	projected := make(map[string]time.Time, len(accruing))
+	if !accruing.IsZero() {
+		exchange, err := k.getExchangeRates(ctx)
+		if err != nil {
+			return err
+		}
+		denoms := rateDenoms(accruing)
+		for _, denom := range exchange.denoms() {
+			if accruing.AmountOf(denom).IsZero() {
+				denoms = append(denoms, denom)
+			}
+		}
+		balances, err := k.getCreditBalancesForDenoms(ctx, tenant, denoms)
+		if err != nil {
+			return err
+		}
+		promo, err := k.getPromoBalanceForActiveLeases(ctx, tenant)
+		if err != nil {
+			return err
+		}
+		balances = balances.Add(promo...)
+
+		pooledAt, pooled := exchange.projectPooledExhaustion(balances, unsettled, rates, monthly, blockTime)
+		for _, rate := range accruing {
+			if exchange.convertible([]string{rate.Denom}) {
+				if pooled {
+					projected[rate.Denom] = pooledAt.UTC()
+				}
+				continue
+			}
+			at, ok := ProjectCreditExhaustion(balances.AmountOf(rate.Denom), unsettled.AmountOf(rate.Denom), rates.AmountOf(rate.Denom), monthly.AmountOf(rate.Denom), blockTime)
+			if ok {
+				projected[rate.Denom] = at.UTC()
+			}
+		}
+	}
+
+	// Drop stale entries; entries that did not move are kept as they are.
+	iter, err := k.CreditExhaustion.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, string](tenantAddr))
+	if err != nil {
+		return err
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+	for _, kv := range kvs {
+		denom := kv.Key.K2()
+		if at, ok := projected[denom]; ok && at.Equal(kv.Value) {
+			delete(projected, denom)
+			continue
+		}
+		if err := k.CreditExhaustionQueue.Remove(ctx, collections.Join3(kv.Value, tenantAddr, denom)); err != nil {
+			return err
+		}
+		if err := k.CreditExhaustion.Remove(ctx, kv.Key); err != nil {
+			return err
+		}
+	}
+
+	// Iterate rates rather than the map for deterministic write order.
+	for _, rate := range accruing {
+		at, ok := projected[rate.Denom]
+		if !ok {
+			continue
+		}
+		if err := k.CreditExhaustion.Set(ctx, collections.Join(tenantAddr, rate.Denom), at); err != nil {
+			return err
+		}
+		if err := k.CreditExhaustionQueue.Set(ctx, collections.Join3(at, tenantAddr, rate.Denom)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// closeExhaustedLeases auto-closes the ACTIVE leases of tenants whose credit
+// in some denom has run out, so lease state does not depend on someone
+// touching the lease. Due (tenant, denom) entries are collected from
+// CreditExhaustionQueue first and then processed, at most
+// MaxCreditExhaustionsPerBlock per block.
+//
+// Each entry is re-checked against the live balance, since funds may have
+// arrived outside FundCredit. When the credit is really exhausted, every
+// ACTIVE lease of the tenant billing in that denom is closed at block time,
+// exactly as AutoCloseLease does on touch, or enters its grace period if its
+// provider grants one; otherwise the entry is just rescheduled. An entry whose
+// projection cannot be refreshed is retried one second later.
+func (k *Keeper) closeExhaustedLeases(ctx context.Context, minLeaseDuration uint64) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+
+	iter, err := k.CreditExhaustionQueue.Iterate(ctx, collections.NewPrefixUntilTripleRange[time.Time, sdk.AccAddress, string](blockTime))
+	if err != nil {
+		return err
+	}
+
+	var due []collections.Triple[time.Time, sdk.AccAddress, string]
+	for ; iter.Valid(); iter.Next() {
+		if len(due) >= types.MaxCreditExhaustionsPerBlock {
+			break
+		}
+		key, err := iter.Key()
+		if err != nil {
+			k.logger.Error("failed to decode credit exhaustion queue key", "error", err)
+			continue
+		}
+		due = append(due, key)
+	}
+
+	if err := iter.Close(); err != nil {
+		k.logger.Error("failed to close iterator", "error", err)
+	}
+
+	closedCount := 0
+	for _, key := range due {
+		tenant := key.K2().String()
+		denom := key.K3()
+
+		closed, err := k.closeExhaustedTenantLeases(ctx, tenant, denom, minLeaseDuration)
+		closedCount += closed
+		if err != nil {
+			k.logger.Error("failed to close leases with exhausted credit",
+				"tenant", tenant,
+				"denom", denom,
+				"error", err,
+			)
+		}
+
+		cacheCtx, writeCache := sdkCtx.CacheContext()
+		if err := k.refreshCreditExhaustion(cacheCtx, tenant); err != nil {
+			k.logger.Error("failed to refresh credit exhaustion",
+				"tenant", tenant,
+				"error", err,
+			)
+			// Retry in a later block, behind the entries already due, so
+			// entries that keep failing cannot hold up the rest of the queue.
+			requeueCtx, writeRequeue := sdkCtx.CacheContext()
+			if err := k.requeueCreditExhaustion(requeueCtx, key, blockTime.Add(time.Second)); err != nil {
+				k.logger.Error("failed to requeue credit exhaustion",
+					"tenant", tenant,
+					"denom", denom,
+					"error", err,
+				)
+				continue
+			}
+			writeRequeue()
+			continue
+		}
+		writeCache()
+	}
+
+	if closedCount > 0 {
+		k.logger.Info("auto-closed leases with exhausted credit in EndBlocker",
+			"closed_count", closedCount,
+			"collected_count", len(due),
+		)
+	}
+
+	if len(due) >= types.MaxCreditExhaustionsPerBlock {
+		k.logger.Warn("reached max credit exhaustions per block",
+			"limit", types.MaxCreditExhaustionsPerBlock,
+		)
+	}
+
+	return nil
+}
+
+// requeueCreditExhaustion moves the queue entry key to at, keeping the
+// tenant's CreditExhaustion entry for the denom in step.
+func (k *Keeper) requeueCreditExhaustion(ctx context.Context, key collections.Triple[time.Time, sdk.AccAddress, string], at time.Time) error {
+	if err := k.CreditExhaustionQueue.Remove(ctx, key); err != nil {
+		return err
+	}
+	if err := k.CreditExhaustion.Set(ctx, collections.Join(key.K2(), key.K3()), at); err != nil {
+		return err
+	}
+	return k.CreditExhaustionQueue.Set(ctx, collections.Join3(at, key.K2(), key.K3()))
+}
+
+// closeExhaustedTenantLeases closes the tenant's ACTIVE leases billing in
+// denom if the credit in that denom is exhausted, and returns how many were
+// closed. Leases whose provider grants a grace period enter it instead, and
+// leases already in grace are left to closeGraceExpiredLeases. Each lease is
+// handled in its own CacheContext; the first failure stops the pass and is
+// returned.
+func (k *Keeper) closeExhaustedTenantLeases(ctx context.Context, tenant, denom string, minLeaseDuration uint64) (int, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+
+	tenantAddr, err := sdk.AccAddressFromBech32(tenant)
+	if err != nil {
+		return 0, err
+	}
+
+	_, _, unsettled, err := k.activeLeaseRatesAndAccruals(ctx, tenantAddr, blockTime)
+	if err != nil {
+		return 0, err
+	}
+	balance, err := k.GetCreditBalance(ctx, tenant, denom)
+	if err != nil {
+		return 0, err
+	}
+	promo, err := k.getPromoBalanceForActiveLeases(ctx, tenant)
+	if err != nil {
+		return 0, err
+	}
+	owed, _ := unsettled.TruncateDecimal()
+	if balance.Amount.Add(promo.AmountOf(denom)).GT(owed.AmountOf(denom)) {
+		return 0, nil
+	}
+	covered, err := k.exceedsByConversion(ctx, tenant, []string{denom}, owed, promo)
+	if err != nil {
+		return 0, err
+	}
+	if covered {
+		return 0, nil
+	}
+
+	leases, err := k.GetLeasesByTenantAndState(ctx, tenant, types.LEASE_STATE_ACTIVE)
+	if err != nil {
+		return 0, err
+	}
+
+	closed := 0
+	for i := range leases {
+		lease := &leases[i]
+		if !leaseBillsInDenom(*lease, denom) || lease.GraceEndsAt != nil {
+			continue
+		}
+
+		gracePeriod, _, err := k.GetEffectiveGracePeriod(ctx, lease.ProviderUuid)
+		if err != nil {
+			return closed, err
+		}
+		if gracePeriod > 0 {
+			cacheCtx, writeCache := sdkCtx.CacheContext()
+			if _, err := k.startGracePeriod(cacheCtx, lease, gracePeriod); err != nil {
+				return closed, types.ErrInvalidLease.Wrapf("failed to start grace period of lease %s: %s", lease.Uuid, err)
+			}
+			writeCache()
+			continue
+		}
+
+		cacheCtx, writeCache := sdkCtx.CacheContext()
+		if _, err := k.AutoCloseLease(cacheCtx, lease, blockTime, minLeaseDuration); err != nil {
+			return closed, types.ErrInvalidLease.Wrapf("failed to auto-close lease %s: %s", lease.Uuid, err)
+		}
+		writeCache()
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeLeaseAutoClose,
+				sdk.NewAttribute(types.AttributeKeyLeaseUUID, lease.Uuid),
+				sdk.NewAttribute(types.AttributeKeyTenant, lease.Tenant),
+				sdk.NewAttribute(types.AttributeKeyProviderUUID, lease.ProviderUuid),
+				sdk.NewAttribute(types.AttributeKeyReason, "credit_exhausted"),
+			),
+		)
+		closed++
+	}
+
+	return closed, nil
+}
+
+// leaseBillsInDenom reports whether any of the lease's time-billed items is
+// priced in denom.
+func leaseBillsInDenom(lease types.Lease, denom string) bool {
+	for _, item := range lease.Items {
+		if !item.Metered && item.LockedPrice.Denom == denom {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,276 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/keeper"
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+	skutypes "github.com/manifest-network/manifest-ledger/x/sku/types"
+)
+
+func TestProjectCreditExhaustion(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		balance   int64
+		unsettled int64
+		rate      int64
+		expected  time.Duration
+		ok        bool
+	}{
+		{name: "exact multiple", balance: 100, rate: 2, expected: 50 * time.Second, ok: true},
+		{name: "rounds up to the next second", balance: 101, rate: 2, expected: 51 * time.Second, ok: true},
+		{name: "unsettled accruals count against balance", balance: 100, unsettled: 40, rate: 2, expected: 30 * time.Second, ok: true},
+		{name: "already exhausted", balance: 100, unsettled: 100, rate: 2, expected: 0, ok: true},
+		{name: "empty balance", balance: 0, rate: 2, expected: 0, ok: true},
+		{name: "nothing accrues", balance: 100, rate: 0, ok: false},
+		{name: "beyond max duration", balance: keeper.MaxDurationSeconds + 1, rate: 1, ok: false},
+	}
+
+	t.Run("fractional rate and unsettled", func(t *testing.T) {
+		// 99.6 left at 0.3/s runs out after 332s.
+		at, ok := keeper.ProjectCreditExhaustion(
+			sdkmath.NewInt(100), sdkmath.LegacyMustNewDecFromStr("0.4"), sdkmath.LegacyMustNewDecFromStr("0.3"), sdkmath.LegacyZeroDec(), now,
+		)
+		require.True(t, ok)
+		require.Equal(t, now.Add(332*time.Second), at)
+	})
+
+	t.Run("calendar month rate changes at the month boundary", func(t *testing.T) {
+		// 2678400 per month bills 1/s in the 15 days left of March, on top of
+		// 1/s per-second, and then ~1.0333/s in April. What remains after
+		// March lasts ~1000s into April.
+		start := time.Date(2026, 3, 17, 0, 0, 0, 0, time.UTC)
+		march := int64(15 * 86400)
+		at, ok := keeper.ProjectCreditExhaustion(
+			sdkmath.NewInt(2*march+2033), sdkmath.LegacyZeroDec(), sdkmath.LegacyNewDec(1), sdkmath.LegacyNewDec(2678400), start,
+		)
+		require.True(t, ok)
+		require.Equal(t, start.Add(time.Duration(march+1000)*time.Second), at)
+	})
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			at, ok := keeper.ProjectCreditExhaustion(
+				sdkmath.NewInt(tc.balance), sdkmath.LegacyNewDec(tc.unsettled), sdkmath.LegacyNewDec(tc.rate), sdkmath.LegacyZeroDec(), now,
+			)
+			require.Equal(t, tc.ok, ok)
+			if tc.ok {
+				require.Equal(t, now.Add(tc.expected), at)
+			}
+		})
+	}
+}
+
+func TestCreditExhaustion_EndBlockerClosesLease(t *testing.T) {
+	// 2 units at 1/s each: 10_000 lasts 5000s.
+	s := setupLeaseScaling(t, 10_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	start := f.Ctx.BlockTime()
+	exhaustAt := start.Add(5000 * time.Second).UTC()
+
+	at, err := f.App.BillingKeeper.CreditExhaustion.Get(f.Ctx, collections.Join(s.tenant, testDenom))
+	require.NoError(t, err)
+	require.True(t, at.Equal(exhaustAt))
+
+	f.Ctx = f.Ctx.WithBlockTime(exhaustAt.Add(-time.Second))
+	require.NoError(t, f.App.BillingKeeper.EndBlocker(f.Ctx))
+	lease, err := f.App.BillingKeeper.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_ACTIVE, lease.State)
+
+	payoutBefore := f.App.BankKeeper.GetBalance(f.Ctx, s.payoutAddr, testDenom).Amount
+	f.Ctx = f.Ctx.WithBlockTime(exhaustAt)
+	f.Ctx = f.Ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.App.BillingKeeper.EndBlocker(f.Ctx))
+
+	lease, err = f.App.BillingKeeper.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_CLOSED, lease.State)
+	require.Equal(t, types.ClosureReasonCreditExhausted, lease.ClosureReason)
+	require.True(t, lease.ClosedAt.Equal(exhaustAt))
+	require.Equal(t, payoutBefore.AddRaw(10_000), f.App.BankKeeper.GetBalance(f.Ctx, s.payoutAddr, testDenom).Amount)
+	require.Equal(t, 1, countEvents(f.Ctx, types.EventTypeLeaseAutoClose))
+
+	ca, err := f.App.BillingKeeper.GetCreditAccount(f.Ctx, s.tenant.String())
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), ca.ActiveLeaseCount)
+	require.True(t, ca.ReservedAmounts.IsZero())
+
+	// No ACTIVE lease is left to project.
+	has, err := f.App.BillingKeeper.CreditExhaustion.Has(f.Ctx, collections.Join(s.tenant, testDenom))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.App.BillingKeeper.CreditExhaustionQueue.Has(f.Ctx, collections.Join3(exhaustAt, s.tenant, testDenom))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	leases, err := f.App.BillingKeeper.GetLeasesByProviderAndState(f.Ctx, s.provider.Uuid, types.LEASE_STATE_ACTIVE)
+	require.NoError(t, err)
+	require.Empty(t, leases)
+}
+
+func TestCreditExhaustion_ClosesAllLeasesOfDenom(t *testing.T) {
+	s := setupLeaseScaling(t, 20_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	start := f.Ctx.BlockTime()
+
+	second := f.createAndAcknowledgeLease(t, s.msgServer, s.tenant, s.providerAddr, []types.LeaseItemInput{
+		{SkuUuid: s.sku.Uuid, Quantity: 2},
+	})
+
+	// Each lease alone would last 10_000s; together they run dry at 5000s.
+	exhaustAt := start.Add(5000 * time.Second).UTC()
+	at, err := f.App.BillingKeeper.CreditExhaustion.Get(f.Ctx, collections.Join(s.tenant, testDenom))
+	require.NoError(t, err)
+	require.True(t, at.Equal(exhaustAt))
+
+	f.Ctx = f.Ctx.WithBlockTime(exhaustAt.Add(10 * time.Second))
+	require.NoError(t, f.App.BillingKeeper.EndBlocker(f.Ctx))
+
+	for _, uuid := range []string{s.leaseUUID, second} {
+		lease, err := f.App.BillingKeeper.GetLease(f.Ctx, uuid)
+		require.NoError(t, err)
+		require.Equal(t, types.LEASE_STATE_CLOSED, lease.State, uuid)
+	}
+	require.True(t, f.App.BillingKeeper.GetBankKeeper().GetBalance(f.Ctx, creditAddress(t, s.tenant), testDenom).IsZero())
+}
+
+func TestCreditExhaustion_RefreshedOnFundAndClose(t *testing.T) {
+	s := setupLeaseScaling(t, 10_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	start := f.Ctx.BlockTime()
+	key := collections.Join(s.tenant, testDenom)
+
+	f.fundCreditViaMsg(t, s.msgServer, s.tenant, 2_000)
+
+	at, err := f.App.BillingKeeper.CreditExhaustion.Get(f.Ctx, key)
+	require.NoError(t, err)
+	require.True(t, at.Equal(start.Add(6000*time.Second)))
+
+	// The superseded queue entry is gone.
+	has, err := f.App.BillingKeeper.CreditExhaustionQueue.Has(f.Ctx, collections.Join3(start.Add(5000*time.Second).UTC(), s.tenant, testDenom))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	_, err = s.msgServer.CloseLease(f.Ctx, &types.MsgCloseLease{
+		Sender:     s.tenant.String(),
+		LeaseUuids: []string{s.leaseUUID},
+	})
+	require.NoError(t, err)
+
+	has, err = f.App.BillingKeeper.CreditExhaustion.Has(f.Ctx, key)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestCreditExhaustion_DirectTransferReschedules(t *testing.T) {
+	s := setupLeaseScaling(t, 10_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	exhaustAt := f.Ctx.BlockTime().Add(5000 * time.Second).UTC()
+
+	// Funds sent straight to the credit address are not seen by the projection
+	// until the EndBlocker re-checks the balance.
+	f.fundAccount(t, creditAddress(t, s.tenant), sdk.NewCoins(sdk.NewCoin(testDenom, sdkmath.NewInt(4_000))))
+
+	f.Ctx = f.Ctx.WithBlockTime(exhaustAt)
+	require.NoError(t, f.App.BillingKeeper.EndBlocker(f.Ctx))
+
+	lease, err := f.App.BillingKeeper.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_ACTIVE, lease.State)
+
+	at, err := f.App.BillingKeeper.CreditExhaustion.Get(f.Ctx, collections.Join(s.tenant, testDenom))
+	require.NoError(t, err)
+	require.True(t, at.Equal(exhaustAt.Add(2000*time.Second)))
+}
+
+// TestCreditExhaustion_FailingRefreshDoesNotBlockQueue verifies that entries
+// whose projection cannot be refreshed are retried behind the entries already
+// due, instead of filling every block's batch.
+func TestCreditExhaustion_FailingRefreshDoesNotBlockQueue(t *testing.T) {
+	s := setupLeaseScaling(t, 10_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	k := f.App.BillingKeeper
+	exhaustAt := f.Ctx.BlockTime().Add(5000 * time.Second).UTC()
+
+	// A full batch of tenants due before s.tenant whose ACTIVE lease index
+	// points at a lease that does not exist, so every refresh fails.
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(f.App.GetKey(types.StoreKey)))
+	tenantState := collections.NewKeySet(sb, types.LeaseByTenantStateIndexKey, "leases_by_tenant_state",
+		collections.PairKeyCodec(collections.PairKeyCodec(sdk.AccAddressKey, collections.Int32Key), collections.StringKey))
+	failingAt := exhaustAt.Add(-time.Second)
+	failing := make([]sdk.AccAddress, types.MaxCreditExhaustionsPerBlock)
+	for i := range failing {
+		failing[i] = sdk.AccAddress(fmt.Sprintf("failing-tenant-%05d", i))
+		require.NoError(t, tenantState.Set(f.Ctx, collections.Join(collections.Join(failing[i], int32(types.LEASE_STATE_ACTIVE)), fmt.Sprintf("missing-%d", i))))
+		require.NoError(t, k.CreditExhaustion.Set(f.Ctx, collections.Join(failing[i], testDenom), failingAt))
+		require.NoError(t, k.CreditExhaustionQueue.Set(f.Ctx, collections.Join3(failingAt, failing[i], testDenom)))
+	}
+
+	// The failing entries take the whole batch and are moved one second on.
+	f.Ctx = f.Ctx.WithBlockTime(exhaustAt)
+	require.NoError(t, k.EndBlocker(f.Ctx))
+	lease, err := k.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_ACTIVE, lease.State)
+	for _, tenant := range failing {
+		has, err := k.CreditExhaustionQueue.Has(f.Ctx, collections.Join3(failingAt, tenant, testDenom))
+		require.NoError(t, err)
+		require.False(t, has)
+		at, err := k.CreditExhaustion.Get(f.Ctx, collections.Join(tenant, testDenom))
+		require.NoError(t, err)
+		require.True(t, at.Equal(exhaustAt.Add(time.Second)))
+	}
+
+	// In the next block s.tenant's entry comes first and its lease closes,
+	// while the failing entries keep being retried.
+	f.Ctx = f.Ctx.WithBlockTime(exhaustAt.Add(time.Second))
+	require.NoError(t, k.EndBlocker(f.Ctx))
+	lease, err = k.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_CLOSED, lease.State)
+	has, err := k.CreditExhaustionQueue.Has(f.Ctx, collections.Join3(exhaustAt.Add(2*time.Second), failing[0], testDenom))
+	require.NoError(t, err)
+	require.True(t, has)
+}
+
+// TestMigrate2to3_SeedsCreditExhaustion verifies the v2→v3 migration seeds the
+// exhaustion projection of existing tenants.
+func TestMigrate2to3_SeedsCreditExhaustion(t *testing.T) {
+	s := setupLeaseScaling(t, 10_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	key := collections.Join(s.tenant, testDenom)
+
+	at, err := f.App.BillingKeeper.CreditExhaustion.Get(f.Ctx, key)
+	require.NoError(t, err)
+	require.NoError(t, f.App.BillingKeeper.CreditExhaustion.Remove(f.Ctx, key))
+	require.NoError(t, f.App.BillingKeeper.CreditExhaustionQueue.Remove(f.Ctx, collections.Join3(at, s.tenant, testDenom)))
+
+	require.NoError(t, keeper.NewMigrator(f.App.BillingKeeper).Migrate2to3(f.Ctx))
+
+	seeded, err := f.App.BillingKeeper.CreditExhaustion.Get(f.Ctx, key)
+	require.NoError(t, err)
+	require.True(t, seeded.Equal(at))
+	has, err := f.App.BillingKeeper.CreditExhaustionQueue.Has(f.Ctx, collections.Join3(at, s.tenant, testDenom))
+	require.NoError(t, err)
+	require.True(t, has)
+}
+
+func creditAddress(t *testing.T, tenant sdk.AccAddress) sdk.AccAddress {
+	t.Helper()
+	addr, err := types.DeriveCreditAddressFromBech32(tenant.String())
+	require.NoError(t, err)
+	return addr
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+)
+
+// TransferCredit moves amount from fromTenant's credit account to toTenant's,
+// creating the destination account if it does not exist. Every denom in
+// amount must be covered by fromTenant's withdrawable credit, the same bound
+// MsgWithdrawCredit applies. Credit still locked by the withdrawal cooldown
+// cannot be moved unless byAuthority is set. The transferred amount is locked
+// in the destination as a deposit of its own; the rest of the destination's
+// credit keeps its locks. Returns the source and destination credit addresses.
+func (k *Keeper) TransferCredit(ctx context.Context, fromTenant, toTenant string, amount sdk.Coins, byAuthority bool) (sdk.AccAddress, sdk.AccAddress, error) {
+	from, err := k.GetCreditAccount(ctx, fromTenant)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := k.checkWithdrawableCredit(ctx, from, amount, !byAuthority); err != nil {
+		return nil, nil, err
+	}
+
+	fromAddr, err := sdk.AccAddressFromBech32(from.CreditAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+	toTenantAddr, err := sdk.AccAddressFromBech32(toTenant)
+	if err != nil {
+		return nil, nil, err
+	}
+	toAddr := types.DeriveCreditAddress(toTenantAddr)
+
+	to, err := k.getOrCreateCreditAccount(ctx, toTenant, toAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := k.SetCreditAccount(ctx, to); err != nil {
+		return nil, nil, err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, fromAddr, toAddr, amount); err != nil {
+		return nil, nil, types.ErrInvalidCreditOperation.Wrapf("failed to transfer tokens: %s", err)
+	}
+	// The transferred credit is locked in the destination like a deposit.
+	if err := k.recordCreditDeposit(ctx, toTenantAddr, amount); err != nil {
+		return nil, nil, err
+	}
+
+	if err := k.payCreditDebt(ctx, toTenant); err != nil {
+		return nil, nil, err
+	}
+	if err := k.refreshCreditExhaustion(ctx, toTenant); err != nil {
+		return nil, nil, err
+	}
+	if err := k.refreshCreditExhaustion(ctx, fromTenant); err != nil {
+		return nil, nil, err
+	}
+
+	return fromAddr, toAddr, nil
+}
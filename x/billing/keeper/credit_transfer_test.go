@@ -0,0 +1,225 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/keeper"
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+)
+
+func TestMsgTransferCredit(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.App.BillingKeeper)
+
+	from := f.TestAccs[0]
+	to := f.TestAccs[1]
+	fromCredit := f.fundCreditViaMsg(t, msgServer, from, 1_000_000)
+	amount := sdk.NewCoins(sdk.NewCoin(testDenom, sdkmath.NewInt(400_000)))
+
+	// Still inside the source's cooldown window.
+	_, err := msgServer.TransferCredit(f.Ctx, &types.MsgTransferCredit{
+		Sender:     from.String(),
+		FromTenant: from.String(),
+		ToTenant:   to.String(),
+		Amount:     amount,
+	})
+	require.ErrorIs(t, err, types.ErrCreditWithdrawalCooldown)
+
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(time.Duration(types.DefaultCreditWithdrawalCooldown)*time.Second + time.Hour))
+	f.Ctx = f.Ctx.WithEventManager(sdk.NewEventManager())
+
+	resp, err := msgServer.TransferCredit(f.Ctx, &types.MsgTransferCredit{
+		Sender:     from.String(),
+		FromTenant: from.String(),
+		ToTenant:   to.String(),
+		Amount:     amount,
+	})
+	require.NoError(t, err)
+	require.Equal(t, fromCredit.String(), resp.FromCreditAddress)
+
+	toCredit := types.DeriveCreditAddress(to)
+	require.Equal(t, toCredit.String(), resp.ToCreditAddress)
+	require.Equal(t, sdkmath.NewInt(600_000), f.App.BankKeeper.GetBalance(f.Ctx, fromCredit, testDenom).Amount)
+	require.Equal(t, sdkmath.NewInt(400_000), f.App.BankKeeper.GetBalance(f.Ctx, toCredit, testDenom).Amount)
+
+	// The destination account is created and the transferred credit is locked.
+	ca, err := f.App.BillingKeeper.GetCreditAccount(f.Ctx, to.String())
+	require.NoError(t, err)
+	require.Equal(t, toCredit.String(), ca.CreditAddress)
+
+	_, err = msgServer.WithdrawCredit(f.Ctx, &types.MsgWithdrawCredit{
+		Tenant:    to.String(),
+		Recipient: to.String(),
+		Amount:    amount,
+	})
+	require.ErrorIs(t, err, types.ErrCreditWithdrawalCooldown)
+
+	var found bool
+	for _, ev := range f.Ctx.EventManager().Events() {
+		if ev.Type != types.EventTypeCreditTransferred {
+			continue
+		}
+		found = true
+		attrs := make(map[string]string)
+		for _, attr := range ev.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+		require.Equal(t, from.String(), attrs[types.AttributeKeyFromTenant])
+		require.Equal(t, to.String(), attrs[types.AttributeKeyToTenant])
+		require.Equal(t, "400000"+testDenom, attrs[types.AttributeKeyAmount])
+		require.Equal(t, types.AttributeValueRoleTenant, attrs[types.AttributeKeyTransferredBy])
+	}
+	require.True(t, found, "expected %s event", types.EventTypeCreditTransferred)
+}
+
+func TestMsgTransferCredit_Authority(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.App.BillingKeeper)
+
+	from := f.TestAccs[0]
+	to := f.TestAccs[1]
+	stranger := f.TestAccs[2]
+	f.fundCreditViaMsg(t, msgServer, from, 1_000)
+	amount := sdk.NewCoins(sdk.NewCoin(testDenom, sdkmath.NewInt(1_000)))
+
+	_, err := msgServer.TransferCredit(f.Ctx, &types.MsgTransferCredit{
+		Sender:     stranger.String(),
+		FromTenant: from.String(),
+		ToTenant:   to.String(),
+		Amount:     amount,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// The authority is not bound by the source's cooldown.
+	_, err = msgServer.TransferCredit(f.Ctx, &types.MsgTransferCredit{
+		Sender:     f.App.BillingKeeper.GetAuthority(),
+		FromTenant: from.String(),
+		ToTenant:   to.String(),
+		Amount:     amount,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(1_000), f.App.BankKeeper.GetBalance(f.Ctx, types.DeriveCreditAddress(to), testDenom).Amount)
+	require.True(t, f.App.BankKeeper.GetBalance(f.Ctx, types.DeriveCreditAddress(from), testDenom).Amount.IsZero())
+}
+
+// TestMsgTransferCredit_DoesNotRestartDestinationCooldown verifies that an
+// inbound transfer locks only the transferred amount, so dust sent from
+// another tenant cannot keep the destination's own credit locked.
+func TestMsgTransferCredit_DoesNotRestartDestinationCooldown(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.App.BillingKeeper)
+
+	cooldown := time.Duration(types.DefaultCreditWithdrawalCooldown) * time.Second
+	griefer := f.TestAccs[0]
+	victim := f.TestAccs[1]
+	f.fundCreditViaMsg(t, msgServer, griefer, 1_000)
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(cooldown / 2))
+	f.fundCreditViaMsg(t, msgServer, victim, 1_000_000)
+
+	// Just before the victim's deposit unlocks, the griefer transfers dust.
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(cooldown - time.Minute))
+	_, err := msgServer.TransferCredit(f.Ctx, &types.MsgTransferCredit{
+		Sender:     griefer.String(),
+		FromTenant: griefer.String(),
+		ToTenant:   victim.String(),
+		Amount:     sdk.NewCoins(sdk.NewCoin(testDenom, sdkmath.NewInt(1))),
+	})
+	require.NoError(t, err)
+
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(time.Hour + time.Minute))
+	_, err = msgServer.WithdrawCredit(f.Ctx, &types.MsgWithdrawCredit{
+		Tenant:    victim.String(),
+		Recipient: victim.String(),
+		Amount:    sdk.NewCoins(sdk.NewCoin(testDenom, sdkmath.NewInt(1_000_000))),
+	})
+	require.NoError(t, err)
+
+	// Only the dust is still locked.
+	_, err = msgServer.WithdrawCredit(f.Ctx, &types.MsgWithdrawCredit{
+		Tenant:    victim.String(),
+		Recipient: victim.String(),
+		Amount:    sdk.NewCoins(sdk.NewCoin(testDenom, sdkmath.NewInt(1))),
+	})
+	require.ErrorIs(t, err, types.ErrCreditWithdrawalCooldown)
+}
+
+func TestMsgTransferCredit_RespectsReservationsAndAccruals(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.App.BillingKeeper)
+
+	params, err := f.App.BillingKeeper.GetParams(f.Ctx)
+	require.NoError(t, err)
+	params.CreditWithdrawalCooldown = 0
+	require.NoError(t, f.App.BillingKeeper.SetParams(f.Ctx, params))
+
+	tenant := f.TestAccs[0]
+	providerAddr := f.TestAccs[1]
+	payoutAddr := f.TestAccs[2]
+	to := f.TestAccs[3]
+
+	provider := f.createTestProvider(t, providerAddr.String(), payoutAddr.String())
+	sku := f.createTestSKU(t, provider.Uuid, 3600) // 1 unit per second
+
+	f.fundCreditViaMsg(t, msgServer, tenant, 10_000)
+	f.createAndAcknowledgeLease(t, msgServer, tenant, providerAddr, []types.LeaseItemInput{
+		{SkuUuid: sku.Uuid, Quantity: 1},
+	})
+
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(100 * time.Second))
+
+	querier := keeper.NewQuerier(f.App.BillingKeeper)
+	avail, err := querier.AvailableCredit(f.Ctx, &types.QueryAvailableCreditRequest{Tenant: tenant.String()})
+	require.NoError(t, err)
+	expected := avail.Available.AmountOf(testDenom)
+	require.True(t, expected.IsPositive())
+
+	_, err = msgServer.TransferCredit(f.Ctx, &types.MsgTransferCredit{
+		Sender:     tenant.String(),
+		FromTenant: tenant.String(),
+		ToTenant:   to.String(),
+		Amount:     sdk.NewCoins(sdk.NewCoin(testDenom, expected.AddRaw(1))),
+	})
+	require.ErrorIs(t, err, types.ErrInsufficientCredit)
+
+	_, err = msgServer.TransferCredit(f.Ctx, &types.MsgTransferCredit{
+		Sender:     tenant.String(),
+		FromTenant: tenant.String(),
+		ToTenant:   to.String(),
+		Amount:     sdk.NewCoins(sdk.NewCoin(testDenom, expected)),
+	})
+	require.NoError(t, err)
+}
+
+func TestMsgTransferCredit_Errors(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.App.BillingKeeper)
+
+	tenant := f.TestAccs[0]
+	stranger := f.TestAccs[1]
+	amount := sdk.NewCoins(sdk.NewCoin(testDenom, sdkmath.NewInt(10)))
+
+	// No source credit account.
+	_, err := msgServer.TransferCredit(f.Ctx, &types.MsgTransferCredit{
+		Sender:     stranger.String(),
+		FromTenant: stranger.String(),
+		ToTenant:   tenant.String(),
+		Amount:     amount,
+	})
+	require.ErrorIs(t, err, types.ErrCreditAccountNotFound)
+
+	// Self transfer fails ValidateBasic.
+	_, err = msgServer.TransferCredit(f.Ctx, &types.MsgTransferCredit{
+		Sender:     tenant.String(),
+		FromTenant: tenant.String(),
+		ToTenant:   tenant.String(),
+		Amount:     amount,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidCreditOperation)
+}
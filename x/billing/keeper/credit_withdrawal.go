@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+)
+
+// GetUnsettledAccruals returns the amounts accrued by a tenant's ACTIVE leases
+// since each lease was last settled, as of the current block time. These funds
+// still sit in the credit account but are owed to providers, so they must never
+// be withdrawable by the tenant.
+//
+// The iteration is bounded by params.MaxLeasesPerTenant via the TenantState index.
+func (k *Keeper) GetUnsettledAccruals(ctx context.Context, tenant string) (sdk.Coins, error) {
+	tenantAddr, err := sdk.AccAddressFromBech32(tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	iter, err := k.Leases.Indexes.TenantState.MatchExact(ctx, collections.Join(tenantAddr, int32(types.LEASE_STATE_ACTIVE)))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	unsettled := sdk.NewCoins()
+	for ; iter.Valid(); iter.Next() {
+		leaseUUID, err := iter.PrimaryKey()
+		if err != nil {
+			return nil, err
+		}
+		lease, err := k.Leases.Get(ctx, leaseUUID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !blockTime.After(lease.LastSettledAt) {
+			continue
+		}
+
+		accrued, _, err := CalculateLeaseAccrual(lease, blockTime)
+		if err != nil {
+			return nil, err
+		}
+		unsettled = unsettled.Add(accrued...)
+	}
+
+	return unsettled, nil
+}
+
+// GetWithdrawableCredit returns the portion of balances the tenant may withdraw:
+// balances minus the larger of the obligations (lease reservations plus
+// unsettled accruals) and the credit still locked by the withdrawal cooldown,
+// floored at zero per denom. Locked credit may back the obligations, so the
+// two are not added together.
+func GetWithdrawableCredit(balances, reserved, unsettled, locked sdk.Coins) sdk.Coins {
+	return types.GetAvailableCredit(balances, reserved.Add(unsettled...).Max(locked))
+}
+
+// getTenantCreditBalances returns the credit balances relevant to a tenant.
+// Only denoms referenced by the tenant's PENDING/ACTIVE leases or reservations
+// are fetched (DoS mitigation against dust). Accounts that have no such denoms
+// fall back to GetAllBalances, which is only reachable for funded accounts
+// without leases.
+func (k *Keeper) getTenantCreditBalances(ctx context.Context, tenant string, reserved sdk.Coins) (sdk.Coins, error) {
+	relevantDenoms, err := k.getRelevantDenomsForTenant(ctx, tenant, reserved)
+	if err != nil {
+		return nil, err
+	}
+	if len(relevantDenoms) > 0 {
+		return k.getCreditBalancesForDenoms(ctx, tenant, relevantDenoms)
+	}
+
+	creditAddr, err := types.DeriveCreditAddressFromBech32(tenant)
+	if err != nil {
+		return nil, err
+	}
+	return k.bankKeeper.GetAllBalances(ctx, creditAddr), nil
+}
+
+// checkWithdrawableCredit verifies that every denom in amount is covered by the
+// tenant's withdrawable credit: balance minus reservations, unsettled accruals
+// and lease debt. Reservations may have been accepted against credit in other
+// convertible denoms (see requireAvailableCredit), so the convertible credit
+// left behind must also still be worth all of them. Unless enforceCooldown is
+// false, credit deposited within the withdrawal cooldown is excluded as well.
+// Returns the credit balances of the denoms in amount.
+func (k *Keeper) checkWithdrawableCredit(ctx context.Context, ca types.CreditAccount, amount sdk.Coins, enforceCooldown bool) (sdk.Coins, error) {
+	denoms := make([]string, 0, len(amount))
+	for _, coin := range amount {
+		denoms = append(denoms, coin.Denom)
+	}
+	balances, err := k.getCreditBalancesForDenoms(ctx, ca.Tenant, denoms)
+	if err != nil {
+		return nil, err
+	}
+
+	unsettled, err := k.GetUnsettledAccruals(ctx, ca.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	// Debt from a grace period is owed to providers just like accruals.
+	debt, err := k.GetTenantDebt(ctx, ca.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	unsettled = unsettled.Add(debt...)
+
+	unobligated := GetWithdrawableCredit(balances, ca.ReservedAmounts, unsettled, nil)
+	if !unobligated.IsAllGTE(amount) {
+		return nil, types.ErrInsufficientCredit.Wrapf("requested %s exceeds withdrawable credit %s", amount, unobligated)
+	}
+	obligations := ca.ReservedAmounts.Add(unsettled...)
+	retained, err := k.retainsConvertibleValue(ctx, ca.Tenant, amount, obligations)
+	if err != nil {
+		return nil, err
+	}
+	if !retained {
+		return nil, types.ErrInsufficientCredit.Wrapf("requested %s would leave too little convertible credit to back %s reserved and owed", amount, obligations)
+	}
+	if !enforceCooldown {
+		return balances, nil
+	}
+
+	locked, unlockAt, err := k.GetLockedCredit(ctx, ca.Tenant, denoms)
+	if err != nil {
+		return nil, err
+	}
+	withdrawable := GetWithdrawableCredit(balances, ca.ReservedAmounts, unsettled, locked)
+	if !withdrawable.IsAllGTE(amount) {
+		return nil, types.ErrCreditWithdrawalCooldown.Wrapf("requested %s exceeds withdrawable credit %s; %s was deposited within the cooldown and is fully unlocked at %s",
+			amount, withdrawable, locked, unlockAt.UTC().Format(time.RFC3339))
+	}
+	return balances, nil
+}
+
+// WithdrawCredit transfers amount from the tenant's credit account to recipient.
+// Every denom in amount must be covered by the withdrawable credit: balance
+// minus reservations, unsettled accruals and lease debt, and minus the credit
+// deposited within the withdrawal cooldown. Returns the credit address and the
+// remaining balances of the withdrawn denoms.
+func (k *Keeper) WithdrawCredit(ctx context.Context, tenant, recipient string, amount sdk.Coins) (sdk.AccAddress, sdk.Coins, error) {
+	ca, err := k.GetCreditAccount(ctx, tenant)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return nil, nil, types.ErrInvalidCreditOperation.Wrapf("invalid recipient address: %s", err)
+	}
+	if k.bankKeeper.BlockedAddr(recipientAddr) {
+		return nil, nil, types.ErrInvalidCreditOperation.Wrapf("recipient %s is not allowed to receive funds", recipient)
+	}
+
+	balances, err := k.checkWithdrawableCredit(ctx, ca, amount, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	creditAddr, err := sdk.AccAddressFromBech32(ca.CreditAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, creditAddr, recipientAddr, amount); err != nil {
+		return nil, nil, types.ErrInvalidCreditOperation.Wrapf("failed to transfer tokens: %s", err)
+	}
+	if err := k.refreshCreditExhaustion(ctx, tenant); err != nil {
+		return nil, nil, err
+	}
+
+	remaining := balances.Sub(amount...)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreditWithdrawn,
+			sdk.NewAttribute(types.AttributeKeyTenant, tenant),
+			sdk.NewAttribute(types.AttributeKeyCreditAddress, ca.CreditAddress),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyNewBalance, remaining.String()),
+		),
+	)
+
+	return creditAddr, remaining, nil
+}
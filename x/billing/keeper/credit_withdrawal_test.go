@@ -0,0 +1,365 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/keeper"
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+)
+
+// fundCreditViaMsg funds the tenant's credit account through MsgFundCredit,
+// which locks the deposit for the withdrawal cooldown.
+func (f *testFixture) fundCreditViaMsg(t *testing.T, msgServer types.MsgServer, tenant sdk.AccAddress, amount int64) sdk.AccAddress {
+	t.Helper()
+	coin := sdk.NewCoin(testDenom, sdkmath.NewInt(amount))
+	f.fundAccount(t, tenant, sdk.NewCoins(coin))
+	resp, err := msgServer.FundCredit(f.Ctx, &types.MsgFundCredit{
+		Sender: tenant.String(),
+		Tenant: tenant.String(),
+		Amount: coin,
+	})
+	require.NoError(t, err)
+	return sdk.MustAccAddressFromBech32(resp.CreditAddress)
+}
+
+func TestMsgWithdrawCredit(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.App.BillingKeeper)
+
+	tenant := f.TestAccs[0]
+	recipient := f.TestAccs[1]
+
+	creditAddr := f.fundCreditViaMsg(t, msgServer, tenant, 1_000_000)
+
+	// Still inside the cooldown window.
+	_, err := msgServer.WithdrawCredit(f.Ctx, &types.MsgWithdrawCredit{
+		Tenant:    tenant.String(),
+		Recipient: recipient.String(),
+		Amount:    sdk.NewCoins(sdk.NewCoin(testDenom, sdkmath.NewInt(100))),
+	})
+	require.ErrorIs(t, err, types.ErrCreditWithdrawalCooldown)
+
+	// Deposits are grouped by the hour, so they unlock within an hour after
+	// the cooldown.
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(time.Duration(types.DefaultCreditWithdrawalCooldown)*time.Second + time.Hour))
+
+	recipientBefore := f.App.BankKeeper.GetBalance(f.Ctx, recipient, testDenom)
+	f.Ctx = f.Ctx.WithEventManager(sdk.NewEventManager())
+
+	resp, err := msgServer.WithdrawCredit(f.Ctx, &types.MsgWithdrawCredit{
+		Tenant:    tenant.String(),
+		Recipient: recipient.String(),
+		Amount:    sdk.NewCoins(sdk.NewCoin(testDenom, sdkmath.NewInt(400_000))),
+	})
+	require.NoError(t, err)
+	require.Equal(t, creditAddr.String(), resp.CreditAddress)
+	require.Equal(t, sdkmath.NewInt(600_000), resp.RemainingBalances.AmountOf(testDenom))
+
+	require.Equal(t, sdkmath.NewInt(600_000), f.App.BankKeeper.GetBalance(f.Ctx, creditAddr, testDenom).Amount)
+	require.Equal(t, recipientBefore.Amount.AddRaw(400_000), f.App.BankKeeper.GetBalance(f.Ctx, recipient, testDenom).Amount)
+
+	var found bool
+	for _, ev := range f.Ctx.EventManager().Events() {
+		if ev.Type != types.EventTypeCreditWithdrawn {
+			continue
+		}
+		found = true
+		attrs := make(map[string]string)
+		for _, attr := range ev.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+		require.Equal(t, tenant.String(), attrs[types.AttributeKeyTenant])
+		require.Equal(t, recipient.String(), attrs[types.AttributeKeyRecipient])
+		require.Equal(t, "400000"+testDenom, attrs[types.AttributeKeyAmount])
+	}
+	require.True(t, found, "expected %s event", types.EventTypeCreditWithdrawn)
+
+	// Withdrawing more than the remaining balance fails.
+	_, err = msgServer.WithdrawCredit(f.Ctx, &types.MsgWithdrawCredit{
+		Tenant:    tenant.String(),
+		Recipient: recipient.String(),
+		Amount:    sdk.NewCoins(sdk.NewCoin(testDenom, sdkmath.NewInt(600_001))),
+	})
+	require.ErrorIs(t, err, types.ErrInsufficientCredit)
+}
+
+func TestMsgWithdrawCredit_CooldownLocksEachDeposit(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.App.BillingKeeper)
+
+	tenant := f.TestAccs[0]
+	f.fundCreditViaMsg(t, msgServer, tenant, 1_000_000)
+
+	// A second deposit half way through the cooldown is locked on its own
+	// and does not extend the lock of the first.
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(time.Duration(types.DefaultCreditWithdrawalCooldown/2) * time.Second))
+	f.fundCreditViaMsg(t, msgServer, tenant, 500)
+
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(time.Duration(types.DefaultCreditWithdrawalCooldown/2)*time.Second + time.Hour))
+	_, err := msgServer.WithdrawCredit(f.Ctx, &types.MsgWithdrawCredit{
+		Tenant:    tenant.String(),
+		Recipient: tenant.String(),
+		Amount:    sdk.NewCoins(sdk.NewCoin(testDenom, sdkmath.NewInt(1_000_001))),
+	})
+	require.ErrorIs(t, err, types.ErrCreditWithdrawalCooldown)
+
+	_, err = msgServer.WithdrawCredit(f.Ctx, &types.MsgWithdrawCredit{
+		Tenant:    tenant.String(),
+		Recipient: tenant.String(),
+		Amount:    sdk.NewCoins(sdk.NewCoin(testDenom, sdkmath.NewInt(1_000_000))),
+	})
+	require.NoError(t, err)
+
+	// With the cooldown disabled, withdrawal is immediate.
+	params, err := f.App.BillingKeeper.GetParams(f.Ctx)
+	require.NoError(t, err)
+	params.CreditWithdrawalCooldown = 0
+	require.NoError(t, f.App.BillingKeeper.SetParams(f.Ctx, params))
+
+	_, err = msgServer.WithdrawCredit(f.Ctx, &types.MsgWithdrawCredit{
+		Tenant:    tenant.String(),
+		Recipient: tenant.String(),
+		Amount:    sdk.NewCoins(sdk.NewCoin(testDenom, sdkmath.NewInt(500))),
+	})
+	require.NoError(t, err)
+}
+
+// TestMsgWithdrawCredit_CooldownCoversThirdPartyFunding verifies that credit
+// from a third party's MsgFundCredit is locked like the tenant's own deposits
+// and locks only itself, while a plain bank send locks nothing.
+func TestMsgWithdrawCredit_CooldownCoversThirdPartyFunding(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.App.BillingKeeper)
+
+	tenant := f.TestAccs[0]
+	funder := f.TestAccs[1]
+	creditAddr := f.fundCreditViaMsg(t, msgServer, tenant, 1_000_000)
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(time.Duration(types.DefaultCreditWithdrawalCooldown)*time.Second + time.Hour))
+
+	// A third party funds the account through MsgFundCredit...
+	gift := sdk.NewCoin(testDenom, sdkmath.NewInt(300))
+	f.fundAccount(t, funder, sdk.NewCoins(gift))
+	_, err := msgServer.FundCredit(f.Ctx, &types.MsgFundCredit{
+		Sender: funder.String(),
+		Tenant: tenant.String(),
+		Amount: gift,
+	})
+	require.NoError(t, err)
+
+	// ...and someone sends to the credit address directly, which runs no
+	// billing code.
+	direct := sdk.NewCoin(testDenom, sdkmath.NewInt(200))
+	f.fundAccount(t, funder, sdk.NewCoins(direct))
+	require.NoError(t, f.App.BankKeeper.SendCoins(f.Ctx, funder, creditAddr, sdk.NewCoins(direct)))
+
+	querier := keeper.NewQuerier(f.App.BillingKeeper)
+	avail, err := querier.AvailableCredit(f.Ctx, &types.QueryAvailableCreditRequest{Tenant: tenant.String()})
+	require.NoError(t, err)
+	require.True(t, sdkmath.NewInt(300).Equal(avail.LockedAmounts.AmountOf(testDenom)))
+	require.True(t, sdkmath.NewInt(1_000_200).Equal(avail.Available.AmountOf(testDenom)))
+	require.NotNil(t, avail.WithdrawableAfter)
+
+	_, err = msgServer.WithdrawCredit(f.Ctx, &types.MsgWithdrawCredit{
+		Tenant:    tenant.String(),
+		Recipient: tenant.String(),
+		Amount:    sdk.NewCoins(sdk.NewCoin(testDenom, sdkmath.NewInt(1_000_201))),
+	})
+	require.ErrorIs(t, err, types.ErrCreditWithdrawalCooldown)
+
+	// The earlier deposit and the bank send are withdrawable in full.
+	_, err = msgServer.WithdrawCredit(f.Ctx, &types.MsgWithdrawCredit{
+		Tenant:    tenant.String(),
+		Recipient: tenant.String(),
+		Amount:    sdk.NewCoins(sdk.NewCoin(testDenom, sdkmath.NewInt(1_000_200))),
+	})
+	require.NoError(t, err)
+
+	f.Ctx = f.Ctx.WithBlockTime(*avail.WithdrawableAfter)
+	_, err = msgServer.WithdrawCredit(f.Ctx, &types.MsgWithdrawCredit{
+		Tenant:    tenant.String(),
+		Recipient: tenant.String(),
+		Amount:    sdk.NewCoins(sdk.NewCoin(testDenom, sdkmath.NewInt(300))),
+	})
+	require.NoError(t, err)
+}
+
+// TestMsgWithdrawCredit_LockedCreditBacksLeases verifies that locked credit
+// counts towards lease reservations rather than adding to them.
+func TestMsgWithdrawCredit_LockedCreditBacksLeases(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.App.BillingKeeper)
+
+	tenant := f.TestAccs[0]
+	providerAddr := f.TestAccs[1]
+	provider := f.createTestProvider(t, providerAddr.String(), f.TestAccs[2].String())
+	sku := f.createTestSKU(t, provider.Uuid, 3600)
+
+	f.fundCreditViaMsg(t, msgServer, tenant, 10_000)
+	f.createAndAcknowledgeLease(t, msgServer, tenant, providerAddr, []types.LeaseItemInput{
+		{SkuUuid: sku.Uuid, Quantity: 1},
+	})
+
+	querier := keeper.NewQuerier(f.App.BillingKeeper)
+	avail, err := querier.AvailableCredit(f.Ctx, &types.QueryAvailableCreditRequest{Tenant: tenant.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(10_000), avail.LockedAmounts.AmountOf(testDenom))
+	require.True(t, avail.Available.IsZero())
+}
+
+func TestMsgWithdrawCredit_RespectsReservationsAndAccruals(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.App.BillingKeeper)
+
+	params, err := f.App.BillingKeeper.GetParams(f.Ctx)
+	require.NoError(t, err)
+	params.CreditWithdrawalCooldown = 0
+	require.NoError(t, f.App.BillingKeeper.SetParams(f.Ctx, params))
+
+	tenant := f.TestAccs[0]
+	providerAddr := f.TestAccs[1]
+	payoutAddr := f.TestAccs[2]
+
+	provider := f.createTestProvider(t, providerAddr.String(), payoutAddr.String())
+	sku := f.createTestSKU(t, provider.Uuid, 3600) // 1 unit per second
+
+	f.fundCreditViaMsg(t, msgServer, tenant, 10_000)
+	f.createAndAcknowledgeLease(t, msgServer, tenant, providerAddr, []types.LeaseItemInput{
+		{SkuUuid: sku.Uuid, Quantity: 1},
+	})
+
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(100 * time.Second))
+
+	querier := keeper.NewQuerier(f.App.BillingKeeper)
+	avail, err := querier.AvailableCredit(f.Ctx, &types.QueryAvailableCreditRequest{Tenant: tenant.String()})
+	require.NoError(t, err)
+	require.Nil(t, avail.WithdrawableAfter)
+
+	reserved := avail.ReservedAmounts.AmountOf(testDenom)
+	require.True(t, reserved.IsPositive())
+	require.Equal(t, sdkmath.NewInt(100), avail.UnsettledAmounts.AmountOf(testDenom))
+	expected := sdkmath.NewInt(10_000).Sub(reserved).SubRaw(100)
+	require.Equal(t, expected, avail.Available.AmountOf(testDenom))
+
+	_, err = msgServer.WithdrawCredit(f.Ctx, &types.MsgWithdrawCredit{
+		Tenant:    tenant.String(),
+		Recipient: tenant.String(),
+		Amount:    sdk.NewCoins(sdk.NewCoin(testDenom, expected.AddRaw(1))),
+	})
+	require.ErrorIs(t, err, types.ErrInsufficientCredit)
+
+	_, err = msgServer.WithdrawCredit(f.Ctx, &types.MsgWithdrawCredit{
+		Tenant:    tenant.String(),
+		Recipient: tenant.String(),
+		Amount:    sdk.NewCoins(sdk.NewCoin(testDenom, expected)),
+	})
+	require.NoError(t, err)
+}
+
+func TestMsgWithdrawCredit_Errors(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.App.BillingKeeper)
+
+	tenant := f.TestAccs[0]
+	stranger := f.TestAccs[1]
+	f.fundCreditViaMsg(t, msgServer, tenant, 1_000)
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(time.Duration(types.DefaultCreditWithdrawalCooldown) * time.Second))
+
+	amount := sdk.NewCoins(sdk.NewCoin(testDenom, sdkmath.NewInt(10)))
+
+	// No credit account.
+	_, err := msgServer.WithdrawCredit(f.Ctx, &types.MsgWithdrawCredit{
+		Tenant:    stranger.String(),
+		Recipient: stranger.String(),
+		Amount:    amount,
+	})
+	require.ErrorIs(t, err, types.ErrCreditAccountNotFound)
+
+	// Blocked module account recipient.
+	_, err = msgServer.WithdrawCredit(f.Ctx, &types.MsgWithdrawCredit{
+		Tenant:    tenant.String(),
+		Recipient: authtypes.NewModuleAddress(distrtypes.ModuleName).String(),
+		Amount:    amount,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidCreditOperation)
+
+	// Denom not held by the credit account.
+	_, err = msgServer.WithdrawCredit(f.Ctx, &types.MsgWithdrawCredit{
+		Tenant:    tenant.String(),
+		Recipient: tenant.String(),
+		Amount:    sdk.NewCoins(sdk.NewCoin("uother", sdkmath.NewInt(1))),
+	})
+	require.ErrorIs(t, err, types.ErrInsufficientCredit)
+
+	// ValidateBasic failure.
+	_, err = msgServer.WithdrawCredit(f.Ctx, &types.MsgWithdrawCredit{
+		Tenant:    tenant.String(),
+		Recipient: tenant.String(),
+		Amount:    sdk.NewCoins(),
+	})
+	require.Error(t, err)
+}
+
+func TestQueryAvailableCredit_WithdrawableAfter(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.App.BillingKeeper)
+	querier := keeper.NewQuerier(f.App.BillingKeeper)
+
+	tenant := f.TestAccs[0]
+	f.fundCreditViaMsg(t, msgServer, tenant, 5_000)
+	fundedAt := f.Ctx.BlockTime()
+
+	resp, err := querier.AvailableCredit(f.Ctx, &types.QueryAvailableCreditRequest{Tenant: tenant.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(5_000), resp.LockedAmounts.AmountOf(testDenom))
+	require.True(t, resp.Available.IsZero())
+	require.NotNil(t, resp.WithdrawableAfter)
+	cooldownEnd := fundedAt.Add(time.Duration(types.DefaultCreditWithdrawalCooldown) * time.Second)
+	require.False(t, resp.WithdrawableAfter.Before(cooldownEnd))
+	require.True(t, resp.WithdrawableAfter.Before(cooldownEnd.Add(time.Hour)) || resp.WithdrawableAfter.Equal(cooldownEnd.Add(time.Hour)))
+
+	f.Ctx = f.Ctx.WithBlockTime(*resp.WithdrawableAfter)
+	resp, err = querier.AvailableCredit(f.Ctx, &types.QueryAvailableCreditRequest{Tenant: tenant.String()})
+	require.NoError(t, err)
+	require.Nil(t, resp.WithdrawableAfter)
+	require.True(t, resp.LockedAmounts.IsZero())
+	require.Equal(t, sdkmath.NewInt(5_000), resp.Available.AmountOf(testDenom))
+
+	_, err = querier.AvailableCredit(f.Ctx, &types.QueryAvailableCreditRequest{Tenant: f.TestAccs[1].String()})
+	require.Error(t, err)
+	_, err = querier.AvailableCredit(f.Ctx, &types.QueryAvailableCreditRequest{Tenant: "invalid"})
+	require.Error(t, err)
+	_, err = querier.AvailableCredit(f.Ctx, nil)
+	require.Error(t, err)
+}
+
+// TestMigrate2to3 verifies the v2→v3 migration seeds the withdrawal cooldown
+// only when it is unset.
+func TestMigrate2to3(t *testing.T) {
+	f := initFixture(t)
+
+	params, err := f.App.BillingKeeper.GetParams(f.Ctx)
+	require.NoError(t, err)
+	params.CreditWithdrawalCooldown = 0
+	require.NoError(t, f.App.BillingKeeper.SetParams(f.Ctx, params))
+
+	require.NoError(t, keeper.NewMigrator(f.App.BillingKeeper).Migrate2to3(f.Ctx))
+	params, err = f.App.BillingKeeper.GetParams(f.Ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultCreditWithdrawalCooldown, params.CreditWithdrawalCooldown)
+
+	params.CreditWithdrawalCooldown = 60
+	require.NoError(t, f.App.BillingKeeper.SetParams(f.Ctx, params))
+	require.NoError(t, keeper.NewMigrator(f.App.BillingKeeper).Migrate2to3(f.Ctx))
+	params, err = f.App.BillingKeeper.GetParams(f.Ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(60), params.CreditWithdrawalCooldown)
+}
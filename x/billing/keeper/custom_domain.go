@@ -78,7 +78,7 @@ func (k *Keeper) GetLeaseByCustomDomain(ctx context.Context, domain string) (typ
 // addressed for custom_domain — every match key is empty, so msg.service_name
 // can only be "" and would match all items. Reject at the lookup site rather
 // than relying on construction-time invariants enforced elsewhere
-// (ValidateLeaseItems, genesis defensive check, lease.Items immutability). The
+// (ValidateLeaseItems, genesis defensive check, the lease-amendment item checks). The
 // match-counting loop below would also produce >1 matches in this exact case,
 // but the explicit guard documents the addressing contract here.
 func findLeaseItemByServiceName(lease types.Lease, serviceName string) (int, error) {
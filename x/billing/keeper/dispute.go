@@ -0,0 +1,419 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/manifest-network/manifest-ledger/pkg/sanitize"
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+)
+
+// DisputeSettlement holds the outcome of a resolved or defaulted dispute for
+// the msg server response and events.
+type DisputeSettlement struct {
+	Dispute types.Dispute
+	// Share is the fraction of the escrow returned to the tenant.
+	Share sdkmath.LegacyDec
+}
+
+// disputeEscrowAddress returns the module account that holds the withdrawals
+// of disputed leases.
+func disputeEscrowAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(types.DisputeEscrowName)
+}
+
+// hasDeadlineEntry reports whether a dispute belongs in DisputesByDeadline:
+// it is open and the provider has not responded yet.
+func hasDeadlineEntry(dispute types.Dispute) bool {
+	return dispute.State == types.DISPUTE_STATE_OPEN && dispute.RespondedAt == nil
+}
+
+// GetDispute returns the dispute raised on a lease.
+func (k *Keeper) GetDispute(ctx context.Context, leaseUUID string) (types.Dispute, error) {
+	dispute, err := k.Disputes.Get(ctx, leaseUUID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Dispute{}, types.ErrDisputeNotFound.Wrapf("no dispute on lease %s", leaseUUID)
+	}
+	return dispute, err
+}
+
+// SetDispute stores a dispute and keeps DisputesByDeadline in sync with it.
+func (k *Keeper) SetDispute(ctx context.Context, dispute types.Dispute) error {
+	key := collections.Join(dispute.ResponseDeadline, dispute.LeaseUuid)
+	if hasDeadlineEntry(dispute) {
+		if err := k.DisputesByDeadline.Set(ctx, key); err != nil {
+			return err
+		}
+	} else if err := k.DisputesByDeadline.Remove(ctx, key); err != nil {
+		return err
+	}
+	return k.Disputes.Set(ctx, dispute.LeaseUuid, dispute)
+}
+
+// escrowDisputedAmounts moves amounts withdrawn from a disputed lease into the
+// escrow module account instead of paying the provider, and adds them to the
+// dispute's escrow. Billing hooks are not called: nothing is settled until the
+// dispute is.
+func (k *Keeper) escrowDisputedAmounts(ctx context.Context, lease types.Lease, from sdk.AccAddress, amounts sdk.Coins) error {
+	dispute, err := k.GetDispute(ctx, lease.Uuid)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, types.DisputeEscrowName, amounts); err != nil {
+		return types.ErrInvalidCreditOperation.Wrapf("failed to escrow: %s", err)
+	}
+	dispute.Escrowed = dispute.Escrowed.Add(amounts...)
+	return k.SetDispute(ctx, dispute)
+}
+
+// escrowAccruals settles an ACTIVE lease up to block time so what it accrued
+// before a dispute changes hands is escrowed with the rest. A lease whose
+// credit is already exhausted is left to the EndBlocker.
+func (k *Keeper) escrowAccruals(ctx context.Context, lease *types.Lease) error {
+	if lease.State != types.LEASE_STATE_ACTIVE {
+		return nil
+	}
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	exhausted, err := k.isCreditExhausted(ctx, lease, blockTime)
+	if err != nil || exhausted {
+		return err
+	}
+	if _, err := k.PerformSettlement(ctx, lease, blockTime, types.SETTLEMENT_TRIGGER_DISPUTE); err != nil {
+		return err
+	}
+	lease.LastSettledAt = blockTime
+	return k.SetLease(ctx, *lease)
+}
+
+// OpenDispute lets the tenant of an ACTIVE lease contest what the provider is
+// paid for it. From then on every withdrawal from the lease is escrowed until
+// the dispute is resolved or defaulted. A lease can only be disputed once, and
+// not at all while dispute_response_period is zero. A CLOSED lease cannot be
+// disputed: it was paid out when it closed, so there is nothing to escrow.
+func (k *Keeper) OpenDispute(ctx context.Context, tenant, leaseUUID, reason string) (types.Dispute, error) {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	lease, err := k.GetLease(ctx, leaseUUID)
+	if err != nil {
+		return types.Dispute{}, err
+	}
+	if lease.Tenant != tenant {
+		return types.Dispute{}, types.ErrUnauthorized.Wrapf("sender %s is not the tenant of lease %s", tenant, leaseUUID)
+	}
+	has, err := k.Disputes.Has(ctx, leaseUUID)
+	if err != nil {
+		return types.Dispute{}, err
+	}
+	if has || lease.Disputed {
+		return types.Dispute{}, types.ErrLeaseDisputed.Wrapf("lease %s has already been disputed", leaseUUID)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.Dispute{}, err
+	}
+	if params.DisputeResponsePeriod == 0 {
+		return types.Dispute{}, types.ErrInvalidRequest.Wrap("disputes are disabled")
+	}
+	if lease.State != types.LEASE_STATE_ACTIVE {
+		return types.Dispute{}, types.ErrLeaseNotActive.Wrapf("lease %s is %s", leaseUUID, lease.State)
+	}
+
+	// #nosec G115 -- DisputeResponsePeriod is validated to be at most MaxDisputeResponsePeriod
+	deadline := blockTime.Add(time.Duration(params.DisputeResponsePeriod) * time.Second)
+	if err := k.SetDispute(ctx, types.Dispute{
+		LeaseUuid:        leaseUUID,
+		Tenant:           lease.Tenant,
+		ProviderUuid:     lease.ProviderUuid,
+		State:            types.DISPUTE_STATE_OPEN,
+		Reason:           reason,
+		Escrowed:         sdk.NewCoins(),
+		OpenedAt:         blockTime,
+		ResponseDeadline: deadline,
+	}); err != nil {
+		return types.Dispute{}, err
+	}
+
+	lease.Disputed = true
+	if err := k.SetLease(ctx, lease); err != nil {
+		return types.Dispute{}, err
+	}
+	if err := k.escrowAccruals(ctx, &lease); err != nil {
+		return types.Dispute{}, err
+	}
+	if err := k.refreshCreditExhaustion(ctx, lease.Tenant); err != nil {
+		return types.Dispute{}, err
+	}
+
+	return k.GetDispute(ctx, leaseUUID)
+}
+
+// RespondToDispute records the provider's answer to an open dispute. Only one
+// response is accepted, and only before the response deadline; an answered
+// dispute is no longer defaulted and waits for the authority.
+func (k *Keeper) RespondToDispute(ctx context.Context, sender, leaseUUID, response string) (types.Dispute, error) {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	dispute, err := k.GetDispute(ctx, leaseUUID)
+	if err != nil {
+		return types.Dispute{}, err
+	}
+	provider, err := k.skuKeeper.GetProvider(ctx, dispute.ProviderUuid)
+	if err != nil {
+		return types.Dispute{}, types.ErrProviderNotFound.Wrapf("provider_uuid %s not found", dispute.ProviderUuid)
+	}
+	if provider.Address != sender {
+		return types.Dispute{}, types.ErrUnauthorized.Wrapf("sender %s is not the provider of lease %s", sender, leaseUUID)
+	}
+	if dispute.State != types.DISPUTE_STATE_OPEN {
+		return types.Dispute{}, types.ErrDisputeNotOpen.Wrapf("dispute on lease %s is %s", leaseUUID, dispute.State)
+	}
+	if dispute.RespondedAt != nil {
+		return types.Dispute{}, types.ErrInvalidRequest.Wrapf("dispute on lease %s has already been answered", leaseUUID)
+	}
+	if blockTime.After(dispute.ResponseDeadline) {
+		return types.Dispute{}, types.ErrInvalidRequest.Wrapf("response deadline %s has passed", dispute.ResponseDeadline)
+	}
+
+	dispute.Response = response
+	dispute.RespondedAt = &blockTime
+	if err := k.SetDispute(ctx, dispute); err != nil {
+		return types.Dispute{}, err
+	}
+	return dispute, nil
+}
+
+// ResolveDispute settles an open dispute, returning tenantShare of the escrow
+// to the tenant's credit account and paying the rest to the provider.
+// Authorization is checked by the msg server.
+func (k *Keeper) ResolveDispute(ctx context.Context, leaseUUID string, tenantShare sdkmath.LegacyDec) (*DisputeSettlement, error) {
+	dispute, err := k.GetDispute(ctx, leaseUUID)
+	if err != nil {
+		return nil, err
+	}
+	if dispute.State != types.DISPUTE_STATE_OPEN {
+		return nil, types.ErrDisputeNotOpen.Wrapf("dispute on lease %s is %s", leaseUUID, dispute.State)
+	}
+	return k.settleDispute(ctx, dispute, tenantShare, types.DISPUTE_STATE_RESOLVED)
+}
+
+// settleDispute releases the escrow of an open dispute, tenantShare of each
+// denom (rounded down) to the tenant's credit account and the rest to the
+// provider's payout address, and lifts the dispute from the lease.
+func (k *Keeper) settleDispute(ctx context.Context, dispute types.Dispute, tenantShare sdkmath.LegacyDec, state types.DisputeState) (*DisputeSettlement, error) {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	lease, err := k.GetLease(ctx, dispute.LeaseUuid)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.escrowAccruals(ctx, &lease); err != nil {
+		return nil, err
+	}
+	dispute, err = k.GetDispute(ctx, dispute.LeaseUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantAmounts := sdk.NewCoins()
+	for _, coin := range dispute.Escrowed {
+		amount := tenantShare.MulInt(coin.Amount).TruncateInt()
+		if amount.IsPositive() {
+			tenantAmounts = tenantAmounts.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	providerAmounts := dispute.Escrowed.Sub(tenantAmounts...)
+
+	if !tenantAmounts.IsZero() {
+		creditAddr, err := types.DeriveCreditAddressFromBech32(lease.Tenant)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.DisputeEscrowName, creditAddr, tenantAmounts); err != nil {
+			return nil, types.ErrInvalidCreditOperation.Wrapf("failed to refund escrow: %s", err)
+		}
+	}
+
+	lease.Disputed = false
+	if !providerAmounts.IsZero() {
+		provider, err := k.skuKeeper.GetProvider(ctx, lease.ProviderUuid)
+		if err != nil {
+			return nil, types.ErrProviderNotFound.Wrapf("provider_uuid %s not found", lease.ProviderUuid)
+		}
+		payoutAddr, err := sdk.AccAddressFromBech32(provider.PayoutAddress)
+		if err != nil {
+			return nil, types.ErrProviderNotFound.Wrapf("invalid payout address: %s", err)
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.DisputeEscrowName, payoutAddr, providerAmounts); err != nil {
+			return nil, types.ErrInvalidCreditOperation.Wrapf("failed to release escrow: %s", err)
+		}
+		if err := k.Hooks().AfterLeaseSettled(ctx, lease, providerAmounts); err != nil {
+			return nil, err
+		}
+	}
+	if err := k.SetLease(ctx, lease); err != nil {
+		return nil, err
+	}
+
+	dispute.State = state
+	dispute.ResolvedAt = &blockTime
+	dispute.TenantAmounts = tenantAmounts
+	dispute.ProviderAmounts = providerAmounts
+	if err := k.SetDispute(ctx, dispute); err != nil {
+		return nil, err
+	}
+
+	if !tenantAmounts.IsZero() {
+		if err := k.refreshCreditExhaustion(ctx, lease.Tenant); err != nil {
+			return nil, err
+		}
+	}
+
+	return &DisputeSettlement{Dispute: dispute, Share: tenantShare}, nil
+}
+
+// defaultUnansweredDisputes settles in the tenant's favour every open dispute
+// the provider let pass its response deadline without answering: the whole
+// escrow is returned to the tenant. It collects first and then mutates,
+// capped at MaxDisputeDefaultsPerBlock.
+func (k *Keeper) defaultUnansweredDisputes(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+
+	iter, err := k.DisputesByDeadline.Iterate(ctx, collections.NewPrefixUntilPairRange[time.Time, string](blockTime))
+	if err != nil {
+		return err
+	}
+
+	var dueUUIDs []string
+	for ; iter.Valid(); iter.Next() {
+		if len(dueUUIDs) >= types.MaxDisputeDefaultsPerBlock {
+			break
+		}
+		key, err := iter.Key()
+		if err != nil {
+			k.logger.Error("failed to decode dispute deadline index key", "error", err)
+			continue
+		}
+		if !key.K1().Before(blockTime) {
+			continue
+		}
+		dueUUIDs = append(dueUUIDs, key.K2())
+	}
+
+	if err := iter.Close(); err != nil {
+		k.logger.Error("failed to close iterator", "error", err)
+	}
+
+	defaultedCount := 0
+	for _, leaseUUID := range dueUUIDs {
+		dispute, err := k.GetDispute(ctx, leaseUUID)
+		if err != nil {
+			k.logger.Error("failed to get dispute for default",
+				"lease_uuid", leaseUUID,
+				"error", err,
+			)
+			continue
+		}
+		if !hasDeadlineEntry(dispute) {
+			k.logger.Error("dispute deadline index references a dispute that cannot be defaulted",
+				"lease_uuid", leaseUUID,
+				"state", dispute.State.String(),
+			)
+			continue
+		}
+
+		cacheCtx, writeCache := sdkCtx.CacheContext()
+		result, err := k.settleDispute(cacheCtx, dispute, sdkmath.LegacyOneDec(), types.DISPUTE_STATE_DEFAULTED)
+		if err != nil {
+			k.logger.Error("failed to default dispute",
+				"lease_uuid", leaseUUID,
+				"error", err,
+			)
+			continue
+		}
+		writeCache()
+		emitDisputeDefaultedEvent(sdkCtx, result.Dispute)
+		defaultedCount++
+	}
+
+	if defaultedCount > 0 {
+		k.logger.Info("defaulted unanswered disputes in EndBlocker",
+			"defaulted_count", defaultedCount,
+			"collected_count", len(dueUUIDs),
+		)
+	}
+
+	if len(dueUUIDs) >= types.MaxDisputeDefaultsPerBlock {
+		k.logger.Warn("reached max dispute defaults per block",
+			"limit", types.MaxDisputeDefaultsPerBlock,
+		)
+	}
+
+	return nil
+}
+
+// emitDisputeOpenedEvent emits a dispute_opened event.
+func emitDisputeOpenedEvent(ctx sdk.Context, dispute types.Dispute) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDisputeOpened,
+			sdk.NewAttribute(types.AttributeKeyLeaseUUID, dispute.LeaseUuid),
+			sdk.NewAttribute(types.AttributeKeyTenant, dispute.Tenant),
+			sdk.NewAttribute(types.AttributeKeyProviderUUID, dispute.ProviderUuid),
+			sdk.NewAttribute(types.AttributeKeyReason, sanitize.EventAttribute(dispute.Reason)),
+			sdk.NewAttribute(types.AttributeKeyEscrowed, dispute.Escrowed.String()),
+			sdk.NewAttribute(types.AttributeKeyResponseDeadline, dispute.ResponseDeadline.Format(time.RFC3339)),
+		),
+	)
+}
+
+// emitDisputeRespondedEvent emits a dispute_responded event.
+func emitDisputeRespondedEvent(ctx sdk.Context, sender string, dispute types.Dispute) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDisputeResponded,
+			sdk.NewAttribute(types.AttributeKeyLeaseUUID, dispute.LeaseUuid),
+			sdk.NewAttribute(types.AttributeKeyProviderUUID, dispute.ProviderUuid),
+			sdk.NewAttribute(types.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeKeyResponse, sanitize.EventAttribute(dispute.Response)),
+		),
+	)
+}
+
+// emitDisputeResolvedEvent emits a dispute_resolved event.
+func emitDisputeResolvedEvent(ctx sdk.Context, authority string, result *DisputeSettlement) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDisputeResolved,
+			sdk.NewAttribute(types.AttributeKeyLeaseUUID, result.Dispute.LeaseUuid),
+			sdk.NewAttribute(types.AttributeKeyTenant, result.Dispute.Tenant),
+			sdk.NewAttribute(types.AttributeKeyProviderUUID, result.Dispute.ProviderUuid),
+			sdk.NewAttribute(types.AttributeKeyResolvedBy, authority),
+			sdk.NewAttribute(types.AttributeKeyTenantShare, result.Share.String()),
+			sdk.NewAttribute(types.AttributeKeyTenantAmounts, result.Dispute.TenantAmounts.String()),
+			sdk.NewAttribute(types.AttributeKeyProviderAmounts, result.Dispute.ProviderAmounts.String()),
+		),
+	)
+}
+
+// emitDisputeDefaultedEvent emits a dispute_defaulted event.
+func emitDisputeDefaultedEvent(ctx sdk.Context, dispute types.Dispute) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDisputeDefaulted,
+			sdk.NewAttribute(types.AttributeKeyLeaseUUID, dispute.LeaseUuid),
+			sdk.NewAttribute(types.AttributeKeyTenant, dispute.Tenant),
+			sdk.NewAttribute(types.AttributeKeyProviderUUID, dispute.ProviderUuid),
+			sdk.NewAttribute(types.AttributeKeyTenantAmounts, dispute.TenantAmounts.String()),
+		),
+	)
+}
@@ -0,0 +1,385 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/keeper"
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+	skutypes "github.com/manifest-network/manifest-ledger/x/sku/types"
+)
+
+// setDisputeResponsePeriod sets params.dispute_response_period for the fixture.
+func setDisputeResponsePeriod(t *testing.T, f *testFixture, seconds uint64) {
+	t.Helper()
+	params, err := f.App.BillingKeeper.GetParams(f.Ctx)
+	require.NoError(t, err)
+	params.DisputeResponsePeriod = seconds
+	require.NoError(t, f.App.BillingKeeper.SetParams(f.Ctx, params))
+}
+
+func openDispute(t *testing.T, s *leaseScalingSetup) *types.MsgOpenDisputeResponse {
+	t.Helper()
+	resp, err := s.msgServer.OpenDispute(s.f.Ctx, &types.MsgOpenDispute{
+		Tenant:    s.tenant.String(),
+		LeaseUuid: s.leaseUUID,
+		Reason:    "service was down",
+	})
+	require.NoError(t, err)
+	return resp
+}
+
+func escrowBalance(f *testFixture) sdkmath.Int {
+	return f.App.BankKeeper.GetBalance(f.Ctx, authtypes.NewModuleAddress(types.DisputeEscrowName), testDenom).Amount
+}
+
+func TestDispute_OpenEscrowsWithdrawals(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	k := f.App.BillingKeeper
+	payoutBefore := f.App.BankKeeper.GetBalance(f.Ctx, s.payoutAddr, testDenom).Amount
+
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(100 * time.Second))
+	resp := openDispute(t, s)
+	require.Equal(t, sdkmath.NewInt(200), resp.Escrowed.AmountOf(testDenom), "accruals up to the dispute are escrowed")
+	require.Equal(t, f.Ctx.BlockTime().Add(time.Duration(types.DefaultDisputeResponsePeriod)*time.Second), resp.ResponseDeadline)
+	require.Equal(t, sdkmath.NewInt(200), escrowBalance(f))
+	require.Equal(t, 1, countEvents(f.Ctx, types.EventTypeDisputeOpened))
+
+	lease, err := k.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.True(t, lease.Disputed)
+
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(50 * time.Second))
+	_, err = s.msgServer.Withdraw(f.Ctx, &types.MsgWithdraw{
+		Sender:     s.providerAddr.String(),
+		LeaseUuids: []string{s.leaseUUID},
+	})
+	require.ErrorIs(t, err, types.ErrLeaseDisputed)
+
+	withdrawable, err := keeper.NewQuerier(k).WithdrawableAmount(f.Ctx, &types.QueryWithdrawableAmountRequest{LeaseUuid: s.leaseUUID})
+	require.NoError(t, err)
+	require.True(t, withdrawable.Amounts.IsZero())
+
+	_, err = s.msgServer.Withdraw(f.Ctx, &types.MsgWithdraw{
+		Sender:       s.providerAddr.String(),
+		ProviderUuid: s.provider.Uuid,
+	})
+	require.NoError(t, err, "provider-wide withdrawal skips disputed leases")
+
+	closeLease(t, s, s.leaseUUID)
+	dispute, err := k.GetDispute(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(300), dispute.Escrowed.AmountOf(testDenom), "closing settles into the escrow")
+	require.Equal(t, sdkmath.NewInt(300), escrowBalance(f))
+	require.Equal(t, payoutBefore, f.App.BankKeeper.GetBalance(f.Ctx, s.payoutAddr, testDenom).Amount)
+}
+
+func TestDispute_ResolveSplitsEscrow(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	k := f.App.BillingKeeper
+	payoutBefore := f.App.BankKeeper.GetBalance(f.Ctx, s.payoutAddr, testDenom).Amount
+
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(100 * time.Second))
+	openDispute(t, s)
+
+	_, err := s.msgServer.RespondToDispute(f.Ctx, &types.MsgRespondToDispute{
+		Sender:    s.tenant.String(),
+		LeaseUuid: s.leaseUUID,
+		Response:  "logs attached",
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.RespondToDispute(f.Ctx, &types.MsgRespondToDispute{
+		Sender:    s.providerAddr.String(),
+		LeaseUuid: s.leaseUUID,
+		Response:  "logs attached",
+	})
+	require.NoError(t, err)
+	_, err = s.msgServer.RespondToDispute(f.Ctx, &types.MsgRespondToDispute{
+		Sender:    s.providerAddr.String(),
+		LeaseUuid: s.leaseUUID,
+		Response:  "more logs",
+	})
+	require.ErrorIs(t, err, types.ErrInvalidRequest, "only one response is accepted")
+
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(100 * time.Second))
+	_, err = s.msgServer.ResolveDispute(f.Ctx, &types.MsgResolveDispute{
+		Authority:   s.tenant.String(),
+		LeaseUuid:   s.leaseUUID,
+		TenantShare: sdkmath.LegacyNewDecWithPrec(25, 2),
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	creditBefore := f.App.BankKeeper.GetBalance(f.Ctx, creditAddress(t, s.tenant), testDenom).Amount
+	resp, err := s.msgServer.ResolveDispute(f.Ctx, &types.MsgResolveDispute{
+		Authority:   k.GetAuthority(),
+		LeaseUuid:   s.leaseUUID,
+		TenantShare: sdkmath.LegacyNewDecWithPrec(25, 2),
+	})
+	require.NoError(t, err)
+	// 400 escrowed: 200 at opening plus 200 settled at resolution.
+	require.Equal(t, sdkmath.NewInt(100), resp.TenantAmounts.AmountOf(testDenom))
+	require.Equal(t, sdkmath.NewInt(300), resp.ProviderAmounts.AmountOf(testDenom))
+	require.True(t, escrowBalance(f).IsZero())
+	require.Equal(t, payoutBefore.AddRaw(300), f.App.BankKeeper.GetBalance(f.Ctx, s.payoutAddr, testDenom).Amount)
+	require.Equal(t, creditBefore.SubRaw(200).AddRaw(100), f.App.BankKeeper.GetBalance(f.Ctx, creditAddress(t, s.tenant), testDenom).Amount)
+	require.Equal(t, 1, countEvents(f.Ctx, types.EventTypeDisputeResolved))
+
+	lease, err := k.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.False(t, lease.Disputed)
+	dispute, err := k.GetDispute(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.DISPUTE_STATE_RESOLVED, dispute.State)
+	require.NotNil(t, dispute.ResolvedAt)
+
+	_, err = s.msgServer.ResolveDispute(f.Ctx, &types.MsgResolveDispute{
+		Authority:   k.GetAuthority(),
+		LeaseUuid:   s.leaseUUID,
+		TenantShare: sdkmath.LegacyOneDec(),
+	})
+	require.ErrorIs(t, err, types.ErrDisputeNotOpen)
+	_, err = s.msgServer.OpenDispute(f.Ctx, &types.MsgOpenDispute{Tenant: s.tenant.String(), LeaseUuid: s.leaseUUID, Reason: "again"})
+	require.ErrorIs(t, err, types.ErrLeaseDisputed, "a lease can only be disputed once")
+
+	// Withdrawals go to the provider again.
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(10 * time.Second))
+	_, err = s.msgServer.Withdraw(f.Ctx, &types.MsgWithdraw{
+		Sender:     s.providerAddr.String(),
+		LeaseUuids: []string{s.leaseUUID},
+	})
+	require.NoError(t, err)
+	require.Equal(t, payoutBefore.AddRaw(320), f.App.BankKeeper.GetBalance(f.Ctx, s.payoutAddr, testDenom).Amount)
+}
+
+func TestDispute_DefaultsWithoutResponse(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	k := f.App.BillingKeeper
+	setDisputeResponsePeriod(t, f, 3600)
+
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(100 * time.Second))
+	resp := openDispute(t, s)
+	creditBefore := f.App.BankKeeper.GetBalance(f.Ctx, creditAddress(t, s.tenant), testDenom).Amount
+
+	f.Ctx = f.Ctx.WithBlockTime(resp.ResponseDeadline).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.EndBlocker(f.Ctx))
+	require.Equal(t, 0, countEvents(f.Ctx, types.EventTypeDisputeDefaulted), "the deadline itself is still in time")
+
+	f.Ctx = f.Ctx.WithBlockTime(resp.ResponseDeadline.Add(time.Second))
+	_, err := s.msgServer.RespondToDispute(f.Ctx, &types.MsgRespondToDispute{
+		Sender:    s.providerAddr.String(),
+		LeaseUuid: s.leaseUUID,
+		Response:  "too late",
+	})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	require.NoError(t, k.EndBlocker(f.Ctx))
+	require.Equal(t, 1, countEvents(f.Ctx, types.EventTypeDisputeDefaulted))
+
+	dispute, err := k.GetDispute(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.DISPUTE_STATE_DEFAULTED, dispute.State)
+	require.Equal(t, dispute.Escrowed, dispute.TenantAmounts)
+	require.True(t, dispute.ProviderAmounts.IsZero())
+	require.True(t, escrowBalance(f).IsZero())
+	// Everything accrued since the dispute was opened, and the 200 escrowed
+	// before, goes back to the tenant.
+	require.Equal(t, creditBefore.AddRaw(200), f.App.BankKeeper.GetBalance(f.Ctx, creditAddress(t, s.tenant), testDenom).Amount)
+
+	has, err := k.DisputesByDeadline.Has(f.Ctx, collections.Join(resp.ResponseDeadline, s.leaseUUID))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestDispute_AnsweredDisputeIsNotDefaulted(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	k := f.App.BillingKeeper
+	setDisputeResponsePeriod(t, f, 3600)
+
+	resp := openDispute(t, s)
+	_, err := s.msgServer.RespondToDispute(f.Ctx, &types.MsgRespondToDispute{
+		Sender:    s.providerAddr.String(),
+		LeaseUuid: s.leaseUUID,
+		Response:  "the service was up",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, countEvents(f.Ctx, types.EventTypeDisputeResponded))
+
+	f.Ctx = f.Ctx.WithBlockTime(resp.ResponseDeadline.Add(time.Hour))
+	require.NoError(t, k.EndBlocker(f.Ctx))
+
+	dispute, err := k.GetDispute(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.DISPUTE_STATE_OPEN, dispute.State)
+	require.Equal(t, "the service was up", dispute.Response)
+}
+
+func TestDispute_OpenErrors(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+
+	_, err := s.msgServer.OpenDispute(f.Ctx, &types.MsgOpenDispute{
+		Tenant:    s.providerAddr.String(),
+		LeaseUuid: s.leaseUUID,
+		Reason:    "not mine",
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	setDisputeResponsePeriod(t, f, 0)
+	_, err = s.msgServer.OpenDispute(f.Ctx, &types.MsgOpenDispute{
+		Tenant:    s.tenant.String(),
+		LeaseUuid: s.leaseUUID,
+		Reason:    "disabled",
+	})
+	require.ErrorIs(t, err, types.ErrInvalidRequest, "a zero response period disables disputes")
+	setDisputeResponsePeriod(t, f, types.DefaultDisputeResponsePeriod)
+
+	closeLease(t, s, s.leaseUUID)
+	_, err = s.msgServer.OpenDispute(f.Ctx, &types.MsgOpenDispute{
+		Tenant:    s.tenant.String(),
+		LeaseUuid: s.leaseUUID,
+		Reason:    "closed",
+	})
+	require.ErrorIs(t, err, types.ErrLeaseNotActive, "a closed lease was already paid out")
+
+	pending, err := s.msgServer.CreateLease(f.Ctx, &types.MsgCreateLease{
+		Tenant: s.tenant.String(),
+		Items:  []types.LeaseItemInput{{SkuUuid: s.sku.Uuid, Quantity: 1}},
+	})
+	require.NoError(t, err)
+	_, err = s.msgServer.OpenDispute(f.Ctx, &types.MsgOpenDispute{
+		Tenant:    s.tenant.String(),
+		LeaseUuid: pending.LeaseUuid,
+		Reason:    "not started",
+	})
+	require.ErrorIs(t, err, types.ErrLeaseNotActive)
+}
+
+func TestDispute_DisputedLeaseIsNotPruned(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	k := f.App.BillingKeeper
+	setLeaseRetention(t, f, 60)
+
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(100 * time.Second))
+	openDispute(t, s)
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(100 * time.Second))
+	closeLease(t, s, s.leaseUUID)
+	dispute, err := k.GetDispute(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(400), dispute.Escrowed.AmountOf(testDenom), "the closing settlement is escrowed")
+
+	// The lease is kept past its retention while the dispute is open.
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, k.EndBlocker(f.Ctx))
+	_, err = k.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err, "a disputed lease must not be pruned")
+
+	_, err = s.msgServer.ResolveDispute(f.Ctx, &types.MsgResolveDispute{
+		Authority:   k.GetAuthority(),
+		LeaseUuid:   s.leaseUUID,
+		TenantShare: sdkmath.LegacyZeroDec(),
+	})
+	require.NoError(t, err)
+	require.NoError(t, k.EndBlocker(f.Ctx))
+	_, err = k.GetLease(f.Ctx, s.leaseUUID)
+	require.ErrorIs(t, err, types.ErrLeaseNotFound)
+	_, err = k.GetDispute(f.Ctx, s.leaseUUID)
+	require.ErrorIs(t, err, types.ErrDisputeNotFound, "the dispute is pruned with its lease")
+}
+
+func TestDispute_Queries(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	q := keeper.NewQuerier(f.App.BillingKeeper)
+
+	_, err := q.Dispute(f.Ctx, &types.QueryDisputeRequest{LeaseUuid: s.leaseUUID})
+	require.ErrorContains(t, err, "NotFound")
+
+	openDispute(t, s)
+
+	resp, err := q.Dispute(f.Ctx, &types.QueryDisputeRequest{LeaseUuid: s.leaseUUID})
+	require.NoError(t, err)
+	require.Equal(t, "service was down", resp.Dispute.Reason)
+
+	list, err := q.Disputes(f.Ctx, &types.QueryDisputesRequest{
+		ProviderUuid: s.provider.Uuid,
+		State:        types.DISPUTE_STATE_OPEN,
+		Pagination:   &query.PageRequest{Limit: 10},
+	})
+	require.NoError(t, err)
+	require.Len(t, list.Disputes, 1)
+
+	list, err = q.Disputes(f.Ctx, &types.QueryDisputesRequest{State: types.DISPUTE_STATE_RESOLVED})
+	require.NoError(t, err)
+	require.Empty(t, list.Disputes)
+}
+
+func TestDispute_GenesisRoundTrip(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	k := f.App.BillingKeeper
+	start := f.Ctx.BlockTime()
+
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(100 * time.Second))
+	resp := openDispute(t, s)
+
+	exported := k.ExportGenesis(f.Ctx)
+	require.Len(t, exported.Disputes, 1)
+	require.NoError(t, exported.Validate())
+
+	g := initFixture(t)
+	g.Ctx = g.Ctx.WithBlockTime(start)
+	provider := g.createTestProvider(t, s.providerAddr.String(), s.payoutAddr.String())
+	require.Equal(t, s.provider.Uuid, provider.Uuid)
+	g.createTestSKU(t, provider.Uuid, 3600)
+
+	g.Ctx = g.Ctx.WithBlockTime(f.Ctx.BlockTime())
+	require.NoError(t, g.App.BillingKeeper.InitGenesis(g.Ctx, exported))
+	dispute, err := g.App.BillingKeeper.GetDispute(g.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.DISPUTE_STATE_OPEN, dispute.State)
+	has, err := g.App.BillingKeeper.DisputesByDeadline.Has(g.Ctx, collections.Join(resp.ResponseDeadline, s.leaseUUID))
+	require.NoError(t, err)
+	require.True(t, has, "the deadline index is rebuilt on import")
+}
+
+// TestMigrate2to3_SeedsDisputeParams verifies the v2→v3 migration seeds the
+// dispute response period and keeps a value already set.
+func TestMigrate2to3_SeedsDisputeParams(t *testing.T) {
+	f := initFixture(t)
+	k := f.App.BillingKeeper
+
+	params, err := k.GetParams(f.Ctx)
+	require.NoError(t, err)
+	params.DisputeResponsePeriod = 0
+	// Bypass validation, as state decoded from v2 would.
+	require.NoError(t, k.Params.Set(f.Ctx, params))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate2to3(f.Ctx))
+
+	params, err = k.GetParams(f.Ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultDisputeResponsePeriod, params.DisputeResponsePeriod)
+
+	params.DisputeResponsePeriod = 7200
+	require.NoError(t, k.Params.Set(f.Ctx, params))
+	require.NoError(t, keeper.NewMigrator(k).Migrate2to3(f.Ctx))
+
+	params, err = k.GetParams(f.Ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7200), params.DisputeResponsePeriod)
+}
@@ -0,0 +1,340 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+)
+
+// exchangeRates holds the rates usable at one block time by denom, in units
+// of the reference denom, which is itself included at one. An empty set means
+// conversion is disabled.
+type exchangeRates map[string]sdkmath.LegacyDec
+
+// denoms returns the convertible denoms in sorted order, the order in which
+// conversion draws on balances.
+func (r exchangeRates) denoms() []string {
+	denoms := make([]string, 0, len(r))
+	for denom := range r {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+	return denoms
+}
+
+// convertible reports whether every denom has a rate.
+func (r exchangeRates) convertible(denoms []string) bool {
+	if len(r) == 0 {
+		return false
+	}
+	for _, denom := range denoms {
+		if _, ok := r[denom]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// value returns what coins are worth in the reference denom. Coins in denoms
+// without a rate are left out.
+func (r exchangeRates) value(coins sdk.Coins) sdkmath.LegacyDec {
+	total := sdkmath.LegacyZeroDec()
+	for _, coin := range coins {
+		if rate, ok := r[coin.Denom]; ok {
+			total = total.Add(rate.MulInt(coin.Amount))
+		}
+	}
+	return total
+}
+
+// decValue is value for decimal amounts.
+func (r exchangeRates) decValue(coins sdk.DecCoins) sdkmath.LegacyDec {
+	total := sdkmath.LegacyZeroDec()
+	for _, coin := range coins {
+		if rate, ok := r[coin.Denom]; ok {
+			total = total.Add(rate.Mul(coin.Amount))
+		}
+	}
+	return total
+}
+
+// payByConversion pays owed out of balances held in other convertible
+// denoms, drawing on them in denom order. It returns the coins taken from
+// balances and the part of owed they cover. Each payment is rounded up, so
+// the provider never receives less than was owed; when a balance runs out,
+// the part of owed it covers is rounded down. Owed amounts in denoms without
+// a rate are left unpaid.
+func (r exchangeRates) payByConversion(owed, balances sdk.Coins) (paid, covered sdk.Coins) {
+	paid = sdk.NewCoins()
+	covered = sdk.NewCoins()
+	left := balances
+	denoms := r.denoms()
+
+	for _, coin := range owed {
+		owedRate, ok := r[coin.Denom]
+		if !ok {
+			continue
+		}
+		remaining := coin.Amount
+		for _, denom := range denoms {
+			if !remaining.IsPositive() {
+				break
+			}
+			available := left.AmountOf(denom)
+			if denom == coin.Denom || !available.IsPositive() {
+				continue
+			}
+			rate := r[denom]
+
+			cost := owedRate.MulInt(remaining).Quo(rate).Ceil().TruncateInt()
+			cover := remaining
+			if cost.GT(available) {
+				cost = available
+				cover = rate.MulInt(available).Quo(owedRate).TruncateInt()
+				if !cover.IsPositive() {
+					// Too little to pay for a single unit.
+					continue
+				}
+			}
+
+			payment := sdk.NewCoin(denom, cost)
+			paid = paid.Add(payment)
+			covered = covered.Add(sdk.NewCoin(coin.Denom, cover))
+			left = left.Sub(payment)
+			remaining = remaining.Sub(cover)
+		}
+	}
+
+	return paid, covered
+}
+
+// projectPooledExhaustion is ProjectCreditExhaustion over all convertible
+// denoms at once: it returns the first whole second at which ratesPerSecond
+// and perMonth, charged per calendar month, accruing on top of unsettled, are
+// worth as much as balances. Amounts in denoms without a rate are left out.
+// The second return is false when nothing convertible accrues or the
+// projection lies beyond MaxDurationSeconds.
+func (r exchangeRates) projectPooledExhaustion(balances sdk.Coins, unsettled, ratesPerSecond, perMonth sdk.DecCoins, now time.Time) (time.Time, bool) {
+	ratePerSecond := r.decValue(ratesPerSecond)
+	monthly := r.decValue(perMonth)
+	if !ratePerSecond.IsPositive() && !monthly.IsPositive() {
+		return time.Time{}, false
+	}
+
+	remaining := r.value(balances).Sub(r.decValue(unsettled))
+	if !remaining.IsPositive() {
+		return now, true
+	}
+
+	exact, _ := accrualSeconds(remaining, ratePerSecond, monthly, now)
+	seconds := exact.Ceil().TruncateInt()
+	if seconds.GT(sdkmath.NewInt(MaxDurationSeconds)) {
+		return time.Time{}, false
+	}
+
+	return now.Add(time.Duration(seconds.Int64()) * time.Second), true
+}
+
+// getExchangeRates returns the exchange rates valid at block time, with the
+// reference denom at one. It is empty when Params.ReferenceDenom is unset.
+func (k *Keeper) getExchangeRates(ctx context.Context) (exchangeRates, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if params.ReferenceDenom == "" {
+		return exchangeRates{}, nil
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	rates := exchangeRates{params.ReferenceDenom: sdkmath.LegacyOneDec()}
+	err = k.ExchangeRates.Walk(ctx, nil, func(denom string, rate types.ExchangeRate) (bool, error) {
+		if denom != params.ReferenceDenom && rate.IsValidAt(blockTime) {
+			rates[denom] = rate.Rate
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
+
+// getConvertibleCreditBalances returns the tenant's credit balances in the
+// denoms of rates.
+func (k *Keeper) getConvertibleCreditBalances(ctx context.Context, tenant string, rates exchangeRates) (sdk.Coins, error) {
+	return k.getCreditBalancesForDenoms(ctx, tenant, rates.denoms())
+}
+
+// coversByConversion reports whether the tenant's credit in convertible
+// denoms, plus extra, is worth at least owed plus reserved at the current
+// exchange rates. It is false when conversion is disabled or any denom of
+// owed has no rate; reserved amounts in such denoms are left out, since they
+// cannot draw on convertible credit.
+func (k *Keeper) coversByConversion(ctx context.Context, tenant string, owed, reserved, extra sdk.Coins) (bool, error) {
+	rates, err := k.getExchangeRates(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !rates.convertible(owed.Denoms()) {
+		return false, nil
+	}
+
+	balances, err := k.getConvertibleCreditBalances(ctx, tenant, rates)
+	if err != nil {
+		return false, err
+	}
+	return rates.value(balances.Add(extra...)).GTE(rates.value(owed.Add(reserved...))), nil
+}
+
+// exceedsByConversion reports whether the tenant's credit in convertible
+// denoms, plus extra, is worth more than owed at the current exchange rates.
+// It is false when conversion is disabled or any of denoms has no rate;
+// amounts in denoms without a rate are left out on both sides.
+func (k *Keeper) exceedsByConversion(ctx context.Context, tenant string, denoms []string, owed, extra sdk.Coins) (bool, error) {
+	rates, err := k.getExchangeRates(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !rates.convertible(denoms) {
+		return false, nil
+	}
+
+	balances, err := k.getConvertibleCreditBalances(ctx, tenant, rates)
+	if err != nil {
+		return false, err
+	}
+	return rates.value(balances.Add(extra...)).GT(rates.value(owed)), nil
+}
+
+// retainsConvertibleValue reports whether the tenant's credit in convertible
+// denoms, less amount, is still worth at least obligations at the current
+// exchange rates. It is true when conversion is disabled. Amounts in denoms
+// without a rate are left out on both sides; those obligations can only be
+// met in their own denom and are checked per denom.
+func (k *Keeper) retainsConvertibleValue(ctx context.Context, tenant string, amount, obligations sdk.Coins) (bool, error) {
+	rates, err := k.getExchangeRates(ctx)
+	if err != nil {
+		return false, err
+	}
+	if len(rates) == 0 {
+		return true, nil
+	}
+
+	balances, err := k.getConvertibleCreditBalances(ctx, tenant, rates)
+	if err != nil {
+		return false, err
+	}
+	return rates.value(balances).Sub(rates.value(amount)).GTE(rates.value(obligations)), nil
+}
+
+// SetExchangeRates applies the entries of MsgSetExchangeRates for sender, a
+// price feeder from params or the authority. A zero rate removes its denom
+// from the table; any other rate replaces the denom's entry, valid from
+// valid_from (block time when unset) until valid_until. It returns the
+// entries set and the denoms removed, in input order.
+func (k *Keeper) SetExchangeRates(ctx context.Context, sender string, inputs []types.ExchangeRateInput) ([]types.ExchangeRate, []string, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if sender != k.GetAuthority() && !params.IsPriceFeeder(sender) {
+		return nil, nil, types.ErrUnauthorized.Wrapf("%s is neither a price feeder nor the authority", sender)
+	}
+	if params.ReferenceDenom == "" {
+		return nil, nil, types.ErrInvalidExchangeRate.Wrap("reference_denom is not set; conversion is disabled")
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	var (
+		set     []types.ExchangeRate
+		removed []string
+	)
+	for _, input := range inputs {
+		if input.Denom == params.ReferenceDenom {
+			return nil, nil, types.ErrInvalidExchangeRate.Wrapf("%s is the reference denom", input.Denom)
+		}
+
+		if input.Rate.IsZero() {
+			has, err := k.ExchangeRates.Has(ctx, input.Denom)
+			if err != nil {
+				return nil, nil, err
+			}
+			if !has {
+				return nil, nil, types.ErrInvalidExchangeRate.Wrapf("no exchange rate set for %s", input.Denom)
+			}
+			if err := k.ExchangeRates.Remove(ctx, input.Denom); err != nil {
+				return nil, nil, err
+			}
+			removed = append(removed, input.Denom)
+			continue
+		}
+
+		if !input.ValidUntil.After(blockTime) {
+			return nil, nil, types.ErrInvalidExchangeRate.Wrapf("rate of %s has valid_until %s, not after block time %s",
+				input.Denom, input.ValidUntil, blockTime)
+		}
+		validFrom := blockTime
+		if input.ValidFrom != nil {
+			validFrom = input.ValidFrom.UTC()
+		}
+		rate := types.ExchangeRate{
+			Denom:      input.Denom,
+			Rate:       input.Rate,
+			ValidFrom:  validFrom,
+			ValidUntil: input.ValidUntil.UTC(),
+			SetBy:      sender,
+			SetAt:      blockTime,
+		}
+		if err := k.ExchangeRates.Set(ctx, rate.Denom, rate); err != nil {
+			return nil, nil, err
+		}
+		set = append(set, rate)
+	}
+
+	count := 0
+	err = k.ExchangeRates.Walk(ctx, nil, func(_ string, _ types.ExchangeRate) (bool, error) {
+		count++
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if count > types.MaxExchangeRates {
+		return nil, nil, types.ErrInvalidExchangeRate.Wrapf("the exchange-rate table would hold %d denoms, maximum is %d",
+			count, types.MaxExchangeRates)
+	}
+
+	return set, removed, nil
+}
+
+// emitExchangeRateSetEvent emits an exchange_rate_set event.
+func emitExchangeRateSetEvent(ctx sdk.Context, rate types.ExchangeRate) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExchangeRateSet,
+			sdk.NewAttribute(types.AttributeKeyDenom, rate.Denom),
+			sdk.NewAttribute(types.AttributeKeyExchangeRate, rate.Rate.String()),
+			sdk.NewAttribute(types.AttributeKeyValidFrom, rate.ValidFrom.Format(time.RFC3339)),
+			sdk.NewAttribute(types.AttributeKeyValidUntil, rate.ValidUntil.Format(time.RFC3339)),
+			sdk.NewAttribute(types.AttributeKeySender, rate.SetBy),
+		),
+	)
+}
+
+// emitExchangeRateRemovedEvent emits an exchange_rate_removed event.
+func emitExchangeRateRemovedEvent(ctx sdk.Context, denom, sender string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExchangeRateRemoved,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeySender, sender),
+		),
+	)
+}
@@ -0,0 +1,296 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/keeper"
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+	skutypes "github.com/manifest-network/manifest-ledger/x/sku/types"
+)
+
+// enableConversion makes testDenom the reference denom and feeders the price
+// feeders.
+func enableConversion(t *testing.T, f *testFixture, feeders ...string) {
+	t.Helper()
+	params, err := f.App.BillingKeeper.GetParams(f.Ctx)
+	require.NoError(t, err)
+	params.ReferenceDenom = testDenom
+	params.PriceFeeders = feeders
+	require.NoError(t, f.App.BillingKeeper.SetParams(f.Ctx, params))
+}
+
+// setTestDenom2Rate sets the rate of testDenom2 as the authority, valid for
+// ttl from block time.
+func setTestDenom2Rate(t *testing.T, s *leaseScalingSetup, rate string, ttl time.Duration) {
+	t.Helper()
+	_, err := s.msgServer.SetExchangeRates(s.f.Ctx, &types.MsgSetExchangeRates{
+		Sender: s.f.Authority.String(),
+		Rates: []types.ExchangeRateInput{{
+			Denom:      testDenom2,
+			Rate:       sdkmath.LegacyMustNewDecFromStr(rate),
+			ValidUntil: s.f.Ctx.BlockTime().Add(ttl),
+		}},
+	})
+	require.NoError(t, err)
+}
+
+// fundTestDenom2 adds amount of testDenom2 to the tenant's credit.
+func fundTestDenom2(t *testing.T, s *leaseScalingSetup, amount int64) {
+	t.Helper()
+	coin := sdk.NewInt64Coin(testDenom2, amount)
+	s.f.fundAccount(t, s.tenant, sdk.NewCoins(coin))
+	_, err := s.msgServer.FundCredit(s.f.Ctx, &types.MsgFundCredit{
+		Sender: s.tenant.String(),
+		Tenant: s.tenant.String(),
+		Amount: coin,
+	})
+	require.NoError(t, err)
+}
+
+func TestMsgSetExchangeRates(t *testing.T) {
+	s := setupLeaseScaling(t, 10_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	querier := keeper.NewQuerier(f.App.BillingKeeper)
+	feeder := f.TestAccs[3]
+	validUntil := f.Ctx.BlockTime().Add(time.Hour)
+
+	msg := func(sender sdk.AccAddress, denom, rate string) *types.MsgSetExchangeRates {
+		return &types.MsgSetExchangeRates{
+			Sender: sender.String(),
+			Rates: []types.ExchangeRateInput{{
+				Denom:      denom,
+				Rate:       sdkmath.LegacyMustNewDecFromStr(rate),
+				ValidUntil: validUntil,
+			}},
+		}
+	}
+
+	_, err := s.msgServer.SetExchangeRates(f.Ctx, msg(f.Authority, testDenom2, "0.5"))
+	require.ErrorContains(t, err, "conversion is disabled")
+
+	enableConversion(t, f, feeder.String())
+
+	_, err = s.msgServer.SetExchangeRates(f.Ctx, msg(s.tenant, testDenom2, "0.5"))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = s.msgServer.SetExchangeRates(f.Ctx, msg(feeder, testDenom, "0.5"))
+	require.ErrorContains(t, err, "reference denom")
+	_, err = s.msgServer.SetExchangeRates(f.Ctx, msg(feeder, testDenom2, "0"))
+	require.ErrorContains(t, err, "no exchange rate set")
+
+	expired := msg(feeder, testDenom2, "0.5")
+	expired.Rates[0].ValidUntil = f.Ctx.BlockTime()
+	_, err = s.msgServer.SetExchangeRates(f.Ctx, expired)
+	require.ErrorContains(t, err, "not after block time")
+
+	f.Ctx = f.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.SetExchangeRates(f.Ctx, msg(feeder, testDenom2, "0.5"))
+	require.NoError(t, err)
+	require.Equal(t, 1, countEvents(f.Ctx, types.EventTypeExchangeRateSet))
+
+	res, err := querier.ExchangeRates(f.Ctx, &types.QueryExchangeRatesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, testDenom, res.ReferenceDenom)
+	require.Len(t, res.Rates, 1)
+	require.Equal(t, testDenom2, res.Rates[0].Denom)
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("0.5"), res.Rates[0].Rate)
+	require.Equal(t, feeder.String(), res.Rates[0].SetBy)
+	require.True(t, res.Rates[0].ValidFrom.Equal(f.Ctx.BlockTime()))
+
+	f.Ctx = f.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.SetExchangeRates(f.Ctx, msg(f.Authority, testDenom2, "0"))
+	require.NoError(t, err)
+	require.Equal(t, 1, countEvents(f.Ctx, types.EventTypeExchangeRateRemoved))
+
+	res, err = querier.ExchangeRates(f.Ctx, &types.QueryExchangeRatesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Rates)
+}
+
+func TestExchangeRates_ReservationByConversion(t *testing.T) {
+	// The 10_000 covers the first lease's 7_200 reservation but not another 3_600.
+	s := setupLeaseScaling(t, 10_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	fundTestDenom2(t, s, 2_000)
+
+	create := &types.MsgCreateLease{
+		Tenant: s.tenant.String(),
+		Items:  []types.LeaseItemInput{{SkuUuid: s.sku.Uuid, Quantity: 1}},
+	}
+	_, err := s.msgServer.CreateLease(f.Ctx, create)
+	require.ErrorIs(t, err, types.ErrInsufficientCredit)
+
+	enableConversion(t, f)
+	setTestDenom2Rate(t, s, "0.5", time.Hour)
+
+	// 10_000 + 2_000 * 0.5 = 11_000 covers 7_200 + 3_600.
+	_, err = s.msgServer.CreateLease(f.Ctx, create)
+	require.NoError(t, err)
+}
+
+// TestExchangeRates_WithdrawKeepsConversionBacking verifies that credit backing
+// reservations by conversion cannot be withdrawn or transferred away, even
+// though its own denom has no reservation.
+func TestExchangeRates_WithdrawKeepsConversionBacking(t *testing.T) {
+	s := setupLeaseScaling(t, 10_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	other := f.TestAccs[3]
+
+	params, err := f.App.BillingKeeper.GetParams(f.Ctx)
+	require.NoError(t, err)
+	params.CreditWithdrawalCooldown = 0
+	require.NoError(t, f.App.BillingKeeper.SetParams(f.Ctx, params))
+
+	fundTestDenom2(t, s, 2_000)
+	enableConversion(t, f)
+	setTestDenom2Rate(t, s, "0.5", time.Hour)
+
+	// 10_000 + 2_000 * 0.5 = 11_000 backs the 7_200 + 3_600 reservations.
+	_, err = s.msgServer.CreateLease(f.Ctx, &types.MsgCreateLease{
+		Tenant: s.tenant.String(),
+		Items:  []types.LeaseItemInput{{SkuUuid: s.sku.Uuid, Quantity: 1}},
+	})
+	require.NoError(t, err)
+
+	all := sdk.NewCoins(sdk.NewInt64Coin(testDenom2, 2_000))
+	_, err = s.msgServer.WithdrawCredit(f.Ctx, &types.MsgWithdrawCredit{
+		Tenant:    s.tenant.String(),
+		Recipient: s.tenant.String(),
+		Amount:    all,
+	})
+	require.ErrorIs(t, err, types.ErrInsufficientCredit)
+	_, err = s.msgServer.TransferCredit(f.Ctx, &types.MsgTransferCredit{
+		Sender:     s.tenant.String(),
+		FromTenant: s.tenant.String(),
+		ToTenant:   other.String(),
+		Amount:     all,
+	})
+	require.ErrorIs(t, err, types.ErrInsufficientCredit)
+
+	// 11_000 - 400 * 0.5 = 10_800 still backs them.
+	_, err = s.msgServer.WithdrawCredit(f.Ctx, &types.MsgWithdrawCredit{
+		Tenant:    s.tenant.String(),
+		Recipient: s.tenant.String(),
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin(testDenom2, 400)),
+	})
+	require.NoError(t, err)
+	_, err = s.msgServer.WithdrawCredit(f.Ctx, &types.MsgWithdrawCredit{
+		Tenant:    s.tenant.String(),
+		Recipient: s.tenant.String(),
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin(testDenom2, 1)),
+	})
+	require.ErrorIs(t, err, types.ErrInsufficientCredit)
+}
+
+func TestExchangeRates_SettlementPaysInOtherDenom(t *testing.T) {
+	// 2 units per second; 7_200 umfx lasts 3600s.
+	s := setupLeaseScaling(t, 7_200, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	querier := keeper.NewQuerier(f.App.BillingKeeper)
+	creditAddr, err := types.DeriveCreditAddressFromBech32(s.tenant.String())
+	require.NoError(t, err)
+
+	enableConversion(t, f)
+	setTestDenom2Rate(t, s, "3", 24*time.Hour)
+	fundTestDenom2(t, s, 1_000)
+
+	start := f.Ctx.BlockTime()
+	payoutBefore := f.App.BankKeeper.GetBalance(f.Ctx, s.payoutAddr, testDenom).Amount
+
+	f.Ctx = f.Ctx.WithBlockTime(start.Add(3700 * time.Second))
+	withdrawLease(t, s)
+
+	// 7_400 accrued: 7_200 umfx native, the other 200 for ceil(200 / 3) = 67 upwr.
+	require.Equal(t, payoutBefore.AddRaw(7_200), f.App.BankKeeper.GetBalance(f.Ctx, s.payoutAddr, testDenom).Amount)
+	require.Equal(t, sdkmath.NewInt(67), f.App.BankKeeper.GetBalance(f.Ctx, s.payoutAddr, testDenom2).Amount)
+	require.Equal(t, sdkmath.NewInt(933), f.App.BankKeeper.GetBalance(f.Ctx, creditAddr, testDenom2).Amount)
+
+	settlements, err := querier.LeaseSettlements(f.Ctx, &types.QueryLeaseSettlementsRequest{LeaseUuid: s.leaseUUID})
+	require.NoError(t, err)
+	require.Len(t, settlements.Settlements, 1)
+	record := settlements.Settlements[0]
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(testDenom2, 67)), record.Converted)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 200)), record.ConvertedValue)
+	require.True(t, record.Shortfall.IsZero())
+
+	lease, err := f.App.BillingKeeper.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_ACTIVE, lease.State, "the remaining upwr keeps the lease running")
+
+	msg, broken := keeper.AllInvariants(f.App.BillingKeeper)(f.Ctx)
+	require.False(t, broken, msg)
+}
+
+func TestExchangeRates_EndBlockerUsesPooledCredit(t *testing.T) {
+	s := setupLeaseScaling(t, 7_200, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	start := f.Ctx.BlockTime()
+
+	enableConversion(t, f)
+	setTestDenom2Rate(t, s, "3", 24*time.Hour)
+	fundTestDenom2(t, s, 1_000)
+
+	// 7_200 + 1_000 * 3 = 10_200 lasts 5100s at 2/s.
+	exhaustAt := start.Add(5100 * time.Second).UTC()
+	at, err := f.App.BillingKeeper.CreditExhaustion.Get(f.Ctx, collections.Join(s.tenant, testDenom))
+	require.NoError(t, err)
+	require.True(t, at.Equal(exhaustAt))
+
+	f.Ctx = f.Ctx.WithBlockTime(start.Add(3700 * time.Second))
+	require.NoError(t, f.App.BillingKeeper.EndBlocker(f.Ctx))
+	lease, err := f.App.BillingKeeper.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_ACTIVE, lease.State)
+
+	f.Ctx = f.Ctx.WithBlockTime(exhaustAt)
+	require.NoError(t, f.App.BillingKeeper.EndBlocker(f.Ctx))
+	lease, err = f.App.BillingKeeper.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_CLOSED, lease.State)
+	require.Equal(t, types.ClosureReasonCreditExhausted, lease.ClosureReason)
+	require.Equal(t, sdkmath.NewInt(1_000), f.App.BankKeeper.GetBalance(f.Ctx, s.payoutAddr, testDenom2).Amount)
+
+	msg, broken := keeper.AllInvariants(f.App.BillingKeeper)(f.Ctx)
+	require.False(t, broken, msg)
+}
+
+func TestExchangeRates_ExpiredOrDisabledRateNotUsed(t *testing.T) {
+	s := setupLeaseScaling(t, 7_200, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	k := f.App.BillingKeeper
+	start := f.Ctx.BlockTime()
+
+	enableConversion(t, f)
+	setTestDenom2Rate(t, s, "3", 2*time.Hour)
+	fundTestDenom2(t, s, 1_000)
+
+	lease, err := k.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+
+	f.Ctx = f.Ctx.WithBlockTime(start.Add(3700 * time.Second))
+	shouldClose, _, err := k.ShouldAutoCloseLease(f.Ctx, &lease)
+	require.NoError(t, err)
+	require.False(t, shouldClose)
+
+	// Clearing the reference denom disables conversion.
+	params, err := k.GetParams(f.Ctx)
+	require.NoError(t, err)
+	params.ReferenceDenom = ""
+	require.NoError(t, k.SetParams(f.Ctx, params))
+	shouldClose, _, err = k.ShouldAutoCloseLease(f.Ctx, &lease)
+	require.NoError(t, err)
+	require.True(t, shouldClose)
+
+	enableConversion(t, f)
+	f.Ctx = f.Ctx.WithBlockTime(start.Add(2 * time.Hour))
+	shouldClose, _, err = k.ShouldAutoCloseLease(f.Ctx, &lease)
+	require.NoError(t, err)
+	require.True(t, shouldClose, "an expired rate is not used")
+}
@@ -0,0 +1,460 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+)
+
+// hasGraceEndEntry reports whether a lease belongs in LeasesByGraceEnd. Only
+// ACTIVE leases are indexed: closure drops the entry even though grace_ends_at
+// stays on the record.
+func hasGraceEndEntry(lease types.Lease) bool {
+	return lease.State == types.LEASE_STATE_ACTIVE && lease.GraceEndsAt != nil
+}
+
+// reconcileGraceIndexes keeps LeasesByGraceEnd and LeasesWithDebt in step with
+// a SetLease write.
+func (k *Keeper) reconcileGraceIndexes(ctx context.Context, prev types.Lease, hadPrev bool, lease types.Lease) error {
+	if hadPrev && hasGraceEndEntry(prev) {
+		if !hasGraceEndEntry(lease) || !prev.GraceEndsAt.Equal(*lease.GraceEndsAt) {
+			if err := k.LeasesByGraceEnd.Remove(ctx, collections.Join(*prev.GraceEndsAt, prev.Uuid)); err != nil {
+				return err
+			}
+		}
+	}
+	if hasGraceEndEntry(lease) {
+		if err := k.LeasesByGraceEnd.Set(ctx, collections.Join(*lease.GraceEndsAt, lease.Uuid)); err != nil {
+			return err
+		}
+	}
+
+	tenantAddr, err := sdk.AccAddressFromBech32(lease.Tenant)
+	if err != nil {
+		return err
+	}
+	key := collections.Join(tenantAddr, lease.Uuid)
+	if lease.Debt.IsZero() {
+		if hadPrev && !prev.Debt.IsZero() {
+			return k.LeasesWithDebt.Remove(ctx, key)
+		}
+		return nil
+	}
+	return k.LeasesWithDebt.Set(ctx, key)
+}
+
+// GetEffectiveGracePeriod returns the grace period in seconds that applies to
+// the leases of a provider: its policy's grace_period if the policy overrides
+// it, params.grace_period otherwise. Overrides are clamped to
+// params.max_provider_grace_period when read, so lowering it also shortens
+// overrides stored before, and are ignored while it is zero. The second return
+// reports whether an override applied. Leases already in their grace period
+// keep their grace_ends_at when the policy changes.
+func (k *Keeper) GetEffectiveGracePeriod(ctx context.Context, providerUUID string) (uint64, bool, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+	if params.MaxProviderGracePeriod == 0 {
+		return params.GracePeriod, false, nil
+	}
+
+	policy, found, err := k.GetProviderPolicy(ctx, providerUUID)
+	if err != nil {
+		return 0, false, err
+	}
+	if found && policy.OverrideGracePeriod {
+		return min(policy.GracePeriod, params.MaxProviderGracePeriod), true, nil
+	}
+	return params.GracePeriod, false, nil
+}
+
+// GetTenantDebt returns the debt recorded on the tenant's leases, by denom.
+// Accruals of leases in their grace period since their last settlement are
+// not included until they are settled.
+func (k *Keeper) GetTenantDebt(ctx context.Context, tenant string) (sdk.Coins, error) {
+	tenantAddr, err := sdk.AccAddressFromBech32(tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := k.LeasesWithDebt.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, string](tenantAddr))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	debt := sdk.NewCoins()
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		lease, err := k.Leases.Get(ctx, key.K2())
+		if err != nil {
+			return nil, err
+		}
+		debt = debt.Add(lease.Debt...)
+	}
+
+	return debt, nil
+}
+
+// graceCloseTime returns the time a lease whose grace period has ended is
+// closed at: grace_ends_at, or its last settlement if that is later.
+func graceCloseTime(lease *types.Lease) time.Time {
+	if lease.GraceEndsAt.Before(lease.LastSettledAt) {
+		return lease.LastSettledAt
+	}
+	return *lease.GraceEndsAt
+}
+
+// startGracePeriodIfExhausted puts an ACTIVE lease whose credit is exhausted
+// into its grace period, if its provider grants one. It reports whether grace
+// started and, if so, the amounts settled to the provider on the way in.
+func (k *Keeper) startGracePeriodIfExhausted(ctx context.Context, lease *types.Lease) (bool, sdk.Coins, error) {
+	if lease.State != types.LEASE_STATE_ACTIVE || lease.GraceEndsAt != nil {
+		return false, nil, nil
+	}
+
+	gracePeriod, _, err := k.GetEffectiveGracePeriod(ctx, lease.ProviderUuid)
+	if err != nil || gracePeriod == 0 {
+		return false, nil, err
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	exhausted, err := k.isCreditExhausted(ctx, lease, blockTime)
+	if err != nil || !exhausted {
+		return false, nil, err
+	}
+
+	transferred, err := k.startGracePeriod(ctx, lease, gracePeriod)
+	if err != nil {
+		return false, nil, err
+	}
+	return true, transferred, nil
+}
+
+// startGracePeriod settles the lease up to block time with grace_ends_at
+// already set, so whatever the balance cannot cover becomes debt, and then
+// persists the lease. The lease keeps accruing until grace_ends_at; the caller
+// refreshes the tenant's exhaustion projection.
+func (k *Keeper) startGracePeriod(ctx context.Context, lease *types.Lease, gracePeriod uint64) (sdk.Coins, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+
+	graceEndsAt := blockTime.Add(time.Duration(gracePeriod) * time.Second).UTC()
+	lease.GraceEndsAt = &graceEndsAt
+
+	result, err := k.PerformSettlementSilent(ctx, lease, blockTime, types.SETTLEMENT_TRIGGER_GRACE_PERIOD)
+	if err != nil {
+		return nil, err
+	}
+	lease.LastSettledAt = blockTime
+
+	if err := k.SetLease(ctx, *lease); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeLeaseGraceStarted,
+			sdk.NewAttribute(types.AttributeKeyLeaseUUID, lease.Uuid),
+			sdk.NewAttribute(types.AttributeKeyTenant, lease.Tenant),
+			sdk.NewAttribute(types.AttributeKeyProviderUUID, lease.ProviderUuid),
+			sdk.NewAttribute(types.AttributeKeyGraceEndsAt, graceEndsAt.Format(time.RFC3339)),
+			sdk.NewAttribute(types.AttributeKeyDebt, lease.Debt.String()),
+		),
+	)
+
+	return result.TransferAmounts, nil
+}
+
+// payCreditDebt spends a tenant's credit balance on what is owed to providers
+// before anything else. Debt recorded on the tenant's leases, in any state, is
+// paid first in lease UUID order; then every lease still in its grace period
+// is settled up to block time. A lease left without debt and with enough
+// credit to keep accruing leaves its grace period.
+//
+// It runs on MsgFundCredit and for the recipient of MsgTransferCredit, before
+// the exhaustion projection is refreshed. Credit that arrives any other way,
+// such as a plain bank send to the credit address, is picked up when the
+// lease's grace period ends; see closeGraceExpiredLeases.
+func (k *Keeper) payCreditDebt(ctx context.Context, tenant string) error {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	tenantAddr, err := sdk.AccAddressFromBech32(tenant)
+	if err != nil {
+		return err
+	}
+
+	iter, err := k.LeasesWithDebt.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, string](tenantAddr))
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		lease, err := k.Leases.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		if err := k.payLeaseDebt(ctx, &lease); err != nil {
+			return err
+		}
+	}
+
+	leases, err := k.GetLeasesByTenantAndState(ctx, tenant, types.LEASE_STATE_ACTIVE)
+	if err != nil {
+		return err
+	}
+	for i := range leases {
+		lease := &leases[i]
+		// A lease past its grace_ends_at is left to the EndBlocker.
+		if lease.GraceEndsAt == nil || !blockTime.Before(*lease.GraceEndsAt) {
+			continue
+		}
+		if _, err := k.endGraceIfFunded(ctx, lease); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// payLeaseDebt pays as much of the lease's debt as the tenant's credit
+// balance in the debt denoms covers, and stores the lease. Promotional credit
+// never pays debt, so a promotion does not pay for service delivered before
+// it was granted.
+func (k *Keeper) payLeaseDebt(ctx context.Context, lease *types.Lease) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+
+	balances, err := k.getCreditBalancesForDenoms(ctx, lease.Tenant, lease.Debt.Denoms())
+	if err != nil {
+		return err
+	}
+	paid := CalculateTransferAmounts(lease.Debt, balances)
+	if paid.IsZero() {
+		return nil
+	}
+
+	lease.Debt = lease.Debt.Sub(paid...)
+	err = k.recordSettlement(ctx, lease, types.LeaseSettlement{
+		Trigger:     types.SETTLEMENT_TRIGGER_DEBT_PAYMENT,
+		PeriodStart: blockTime,
+		PeriodEnd:   blockTime,
+		Transferred: paid,
+	})
+	if err != nil {
+		return err
+	}
+	if err := k.SetLease(ctx, *lease); err != nil {
+		return err
+	}
+	// The lease is stored first so the billing hooks see the reduced debt.
+	// Debt paid on a disputed lease is escrowed like any other withdrawal.
+	payoutAddr, err := k.transferToProvider(ctx, *lease, paid)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeLeaseDebtPaid,
+			sdk.NewAttribute(types.AttributeKeyLeaseUUID, lease.Uuid),
+			sdk.NewAttribute(types.AttributeKeyTenant, lease.Tenant),
+			sdk.NewAttribute(types.AttributeKeyProviderUUID, lease.ProviderUuid),
+			sdk.NewAttribute(types.AttributeKeyAmount, paid.String()),
+			sdk.NewAttribute(types.AttributeKeyPayoutAddress, payoutAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyDebt, lease.Debt.String()),
+		),
+	)
+	return nil
+}
+
+// endGraceIfFunded settles a lease in its grace period up to block time and
+// stores it. If that leaves it without debt and with enough credit to keep
+// accruing, its grace period ends and true is returned.
+func (k *Keeper) endGraceIfFunded(ctx context.Context, lease *types.Lease) (bool, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+
+	if _, err := k.PerformSettlementSilent(ctx, lease, blockTime, types.SETTLEMENT_TRIGGER_GRACE_PERIOD); err != nil {
+		return false, err
+	}
+	lease.LastSettledAt = blockTime
+
+	ended := false
+	if lease.Debt.IsZero() {
+		exhausted, err := k.isCreditExhausted(ctx, lease, blockTime)
+		if err != nil {
+			return false, err
+		}
+		if !exhausted {
+			lease.GraceEndsAt = nil
+			ended = true
+		}
+	}
+
+	if err := k.SetLease(ctx, *lease); err != nil {
+		return false, err
+	}
+
+	if ended {
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeLeaseGraceEnded,
+				sdk.NewAttribute(types.AttributeKeyLeaseUUID, lease.Uuid),
+				sdk.NewAttribute(types.AttributeKeyTenant, lease.Tenant),
+				sdk.NewAttribute(types.AttributeKeyProviderUUID, lease.ProviderUuid),
+			),
+		)
+	}
+	return ended, nil
+}
+
+// restoreFundedGraceLease gives a lease whose grace period has just ended one
+// last chance: credit that reached the account without MsgFundCredit or
+// MsgTransferCredit pays its debt and, if enough is left, the lease keeps
+// running. It returns whether the lease left its grace period; the caller
+// discards the writes otherwise.
+func (k *Keeper) restoreFundedGraceLease(ctx context.Context, lease *types.Lease) (bool, error) {
+	if !lease.Debt.IsZero() {
+		if err := k.payLeaseDebt(ctx, lease); err != nil {
+			return false, err
+		}
+		if !lease.Debt.IsZero() {
+			return false, nil
+		}
+	}
+	restored, err := k.endGraceIfFunded(ctx, lease)
+	if err != nil || !restored {
+		return false, err
+	}
+	return true, k.refreshCreditExhaustion(ctx, lease.Tenant)
+}
+
+// closeGraceExpiredLeases closes the ACTIVE leases whose grace period has
+// ended without the tenant topping up. Like the other EndBlocker passes it
+// collects first and then mutates, capped at MaxGraceExpirationsPerBlock.
+// Each lease is settled and closed at its grace_ends_at, so the accruals of the
+// whole grace period become debt that the tenant's next MsgFundCredit pays. A
+// lease whose tenant's credit covers its debt and more is kept running
+// instead, so credit sent to the credit address by a plain bank send is not
+// ignored.
+func (k *Keeper) closeGraceExpiredLeases(ctx context.Context, minLeaseDuration uint64) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+
+	iter, err := k.LeasesByGraceEnd.Iterate(ctx, collections.NewPrefixUntilPairRange[time.Time, string](blockTime))
+	if err != nil {
+		return err
+	}
+
+	var dueUUIDs []string
+	for ; iter.Valid(); iter.Next() {
+		if len(dueUUIDs) >= types.MaxGraceExpirationsPerBlock {
+			break
+		}
+		key, err := iter.Key()
+		if err != nil {
+			k.logger.Error("failed to decode grace end index key", "error", err)
+			continue
+		}
+		dueUUIDs = append(dueUUIDs, key.K2())
+	}
+
+	if err := iter.Close(); err != nil {
+		k.logger.Error("failed to close iterator", "error", err)
+	}
+
+	closedCount, restoredCount := 0, 0
+	for _, leaseUUID := range dueUUIDs {
+		lease, err := k.Leases.Get(ctx, leaseUUID)
+		if err != nil {
+			k.logger.Error("failed to get lease for grace expiration",
+				"lease_uuid", leaseUUID,
+				"error", err,
+			)
+			continue
+		}
+		if !hasGraceEndEntry(lease) {
+			// SetLease keeps the index to ACTIVE leases in grace; this is only
+			// reachable through an inconsistent store.
+			k.logger.Error("grace end index references a lease that is not in grace",
+				"lease_uuid", leaseUUID,
+				"state", lease.State.String(),
+			)
+			continue
+		}
+
+		restoreCtx, writeRestore := sdkCtx.CacheContext()
+		restoreLease := lease
+		restored, err := k.restoreFundedGraceLease(restoreCtx, &restoreLease)
+		if err != nil {
+			k.logger.Error("failed to restore funded lease at grace expiration",
+				"lease_uuid", leaseUUID,
+				"error", err,
+			)
+		}
+		if err == nil && restored {
+			writeRestore()
+			restoredCount++
+			continue
+		}
+
+		cacheCtx, writeCache := sdkCtx.CacheContext()
+		if _, err := k.AutoCloseLease(cacheCtx, &lease, graceCloseTime(&lease), minLeaseDuration); err != nil {
+			k.logger.Error("failed to close lease at grace expiration",
+				"lease_uuid", leaseUUID,
+				"error", err,
+			)
+			continue
+		}
+		if err := k.refreshCreditExhaustion(cacheCtx, lease.Tenant); err != nil {
+			k.logger.Error("failed to refresh credit exhaustion after grace expiration",
+				"lease_uuid", leaseUUID,
+				"error", err,
+			)
+			continue
+		}
+		writeCache()
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeLeaseAutoClose,
+				sdk.NewAttribute(types.AttributeKeyLeaseUUID, lease.Uuid),
+				sdk.NewAttribute(types.AttributeKeyTenant, lease.Tenant),
+				sdk.NewAttribute(types.AttributeKeyProviderUUID, lease.ProviderUuid),
+				sdk.NewAttribute(types.AttributeKeyReason, "credit_exhausted"),
+				sdk.NewAttribute(types.AttributeKeyDebt, lease.Debt.String()),
+			),
+		)
+		closedCount++
+	}
+
+	if closedCount > 0 || restoredCount > 0 {
+		k.logger.Info("closed leases at grace expiration in EndBlocker",
+			"closed_count", closedCount,
+			"restored_count", restoredCount,
+			"collected_count", len(dueUUIDs),
+		)
+	}
+
+	if len(dueUUIDs) >= types.MaxGraceExpirationsPerBlock {
+		k.logger.Warn("reached max grace expirations per block",
+			"limit", types.MaxGraceExpirationsPerBlock,
+		)
+	}
+
+	return nil
+}
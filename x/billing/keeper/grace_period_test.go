@@ -0,0 +1,329 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/keeper"
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+	skutypes "github.com/manifest-network/manifest-ledger/x/sku/types"
+)
+
+// setGracePeriod sets params.grace_period for the fixture.
+func setGracePeriod(t *testing.T, f *testFixture, seconds uint64) {
+	t.Helper()
+	params, err := f.App.BillingKeeper.GetParams(f.Ctx)
+	require.NoError(t, err)
+	params.GracePeriod = seconds
+	require.NoError(t, f.App.BillingKeeper.SetParams(f.Ctx, params))
+}
+
+func TestGracePeriod_ClosesAtGraceEndWithDebt(t *testing.T) {
+	// 2 units at 1/s each: 10_000 lasts 5000s, then 1000s of grace.
+	s := setupLeaseScaling(t, 10_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	setGracePeriod(t, f, 1000)
+	exhaustAt := f.Ctx.BlockTime().Add(5000 * time.Second).UTC()
+	graceEndsAt := exhaustAt.Add(1000 * time.Second)
+
+	f.Ctx = f.Ctx.WithBlockTime(exhaustAt).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.App.BillingKeeper.EndBlocker(f.Ctx))
+	require.Equal(t, 1, countEvents(f.Ctx, types.EventTypeLeaseGraceStarted))
+	require.Equal(t, 0, countEvents(f.Ctx, types.EventTypeLeaseAutoClose))
+
+	lease, err := f.App.BillingKeeper.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_ACTIVE, lease.State)
+	require.NotNil(t, lease.GraceEndsAt)
+	require.True(t, lease.GraceEndsAt.Equal(graceEndsAt))
+	require.True(t, lease.Debt.IsZero())
+
+	// In grace the lease no longer has a projection of its own.
+	has, err := f.App.BillingKeeper.CreditExhaustion.Has(f.Ctx, collections.Join(s.tenant, testDenom))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	f.Ctx = f.Ctx.WithBlockTime(graceEndsAt.Add(-time.Second))
+	require.NoError(t, f.App.BillingKeeper.EndBlocker(f.Ctx))
+	lease, err = f.App.BillingKeeper.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_ACTIVE, lease.State)
+
+	// Closure is settled at grace_ends_at even when the block comes later.
+	f.Ctx = f.Ctx.WithBlockTime(graceEndsAt.Add(30 * time.Second)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.App.BillingKeeper.EndBlocker(f.Ctx))
+	require.Equal(t, 1, countEvents(f.Ctx, types.EventTypeLeaseAutoClose))
+
+	lease, err = f.App.BillingKeeper.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_CLOSED, lease.State)
+	require.Equal(t, types.ClosureReasonCreditExhausted, lease.ClosureReason)
+	require.True(t, lease.ClosedAt.Equal(graceEndsAt))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 2000)), lease.Debt)
+
+	resp, err := keeper.NewQuerier(f.App.BillingKeeper).CreditAccount(f.Ctx, &types.QueryCreditAccountRequest{Tenant: s.tenant.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 2000)), resp.Debt)
+
+	// The next top-up pays the provider first.
+	payoutBefore := f.App.BankKeeper.GetBalance(f.Ctx, s.payoutAddr, testDenom).Amount
+	f.Ctx = f.Ctx.WithEventManager(sdk.NewEventManager())
+	f.fundCreditViaMsg(t, s.msgServer, s.tenant, 5000)
+	require.Equal(t, 1, countEvents(f.Ctx, types.EventTypeLeaseDebtPaid))
+	require.Equal(t, payoutBefore.AddRaw(2000), f.App.BankKeeper.GetBalance(f.Ctx, s.payoutAddr, testDenom).Amount)
+	require.Equal(t, sdkmath.NewInt(3000), f.App.BankKeeper.GetBalance(f.Ctx, creditAddress(t, s.tenant), testDenom).Amount)
+
+	lease, err = f.App.BillingKeeper.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.True(t, lease.Debt.IsZero())
+	has, err = f.App.BillingKeeper.LeasesWithDebt.Has(f.Ctx, collections.Join(s.tenant, s.leaseUUID))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestGracePeriod_BankSendRestoresLeaseAtGraceEnd(t *testing.T) {
+	s := setupLeaseScaling(t, 10_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	setGracePeriod(t, f, 1000)
+	exhaustAt := f.Ctx.BlockTime().Add(5000 * time.Second).UTC()
+
+	f.Ctx = f.Ctx.WithBlockTime(exhaustAt)
+	require.NoError(t, f.App.BillingKeeper.EndBlocker(f.Ctx))
+
+	f.Ctx = f.Ctx.WithBlockTime(exhaustAt.Add(300 * time.Second))
+	_, err := s.msgServer.Withdraw(f.Ctx, &types.MsgWithdraw{
+		Sender:     s.providerAddr.String(),
+		LeaseUuids: []string{s.leaseUUID},
+	})
+	require.NoError(t, err)
+
+	// Credit sent with a plain bank send pays nothing until the grace period ends.
+	coins := sdk.NewCoins(sdk.NewInt64Coin(testDenom, 20_000))
+	f.fundAccount(t, s.tenant, coins)
+	require.NoError(t, f.App.BankKeeper.SendCoins(f.Ctx, s.tenant, creditAddress(t, s.tenant), coins))
+	lease, err := f.App.BillingKeeper.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 600)), lease.Debt)
+
+	payoutBefore := f.App.BankKeeper.GetBalance(f.Ctx, s.payoutAddr, testDenom).Amount
+	f.Ctx = f.Ctx.WithBlockTime(exhaustAt.Add(1010 * time.Second)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.App.BillingKeeper.EndBlocker(f.Ctx))
+	require.Equal(t, 1, countEvents(f.Ctx, types.EventTypeLeaseDebtPaid))
+	require.Equal(t, 1, countEvents(f.Ctx, types.EventTypeLeaseGraceEnded))
+	require.Equal(t, 0, countEvents(f.Ctx, types.EventTypeLeaseAutoClose))
+
+	// 600 of debt plus the 1_420 accrued since the withdrawal.
+	require.True(t, payoutBefore.AddRaw(2020).Equal(f.App.BankKeeper.GetBalance(f.Ctx, s.payoutAddr, testDenom).Amount))
+
+	lease, err = f.App.BillingKeeper.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_ACTIVE, lease.State)
+	require.Nil(t, lease.GraceEndsAt)
+	require.True(t, lease.Debt.IsZero())
+	has, err := f.App.BillingKeeper.CreditExhaustion.Has(f.Ctx, collections.Join(s.tenant, testDenom))
+	require.NoError(t, err)
+	require.True(t, has)
+}
+
+func TestGracePeriod_FundCreditRestoresLease(t *testing.T) {
+	s := setupLeaseScaling(t, 10_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	setGracePeriod(t, f, 1000)
+	exhaustAt := f.Ctx.BlockTime().Add(5000 * time.Second).UTC()
+
+	f.Ctx = f.Ctx.WithBlockTime(exhaustAt)
+	require.NoError(t, f.App.BillingKeeper.EndBlocker(f.Ctx))
+
+	// A withdrawal during grace finds no credit and records the accruals as debt.
+	f.Ctx = f.Ctx.WithBlockTime(exhaustAt.Add(300 * time.Second))
+	_, err := s.msgServer.Withdraw(f.Ctx, &types.MsgWithdraw{
+		Sender:     s.providerAddr.String(),
+		LeaseUuids: []string{s.leaseUUID},
+	})
+	require.NoError(t, err)
+	lease, err := f.App.BillingKeeper.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 600)), lease.Debt)
+
+	payoutBefore := f.App.BankKeeper.GetBalance(f.Ctx, s.payoutAddr, testDenom).Amount
+	f.Ctx = f.Ctx.WithBlockTime(exhaustAt.Add(400 * time.Second)).WithEventManager(sdk.NewEventManager())
+	f.fundCreditViaMsg(t, s.msgServer, s.tenant, 20_000)
+	require.Equal(t, 1, countEvents(f.Ctx, types.EventTypeLeaseDebtPaid))
+	require.Equal(t, 1, countEvents(f.Ctx, types.EventTypeLeaseGraceEnded))
+
+	// 600 of debt plus the 200 accrued since the withdrawal.
+	require.Equal(t, payoutBefore.AddRaw(800), f.App.BankKeeper.GetBalance(f.Ctx, s.payoutAddr, testDenom).Amount)
+
+	lease, err = f.App.BillingKeeper.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_ACTIVE, lease.State)
+	require.Nil(t, lease.GraceEndsAt)
+	require.True(t, lease.Debt.IsZero())
+	require.True(t, lease.LastSettledAt.Equal(f.Ctx.BlockTime()))
+
+	// Back under the projection: 19_200 left at 2/s.
+	at, err := f.App.BillingKeeper.CreditExhaustion.Get(f.Ctx, collections.Join(s.tenant, testDenom))
+	require.NoError(t, err)
+	require.True(t, at.Equal(f.Ctx.BlockTime().Add(9600*time.Second)))
+
+	// The grace end no longer closes the lease.
+	f.Ctx = f.Ctx.WithBlockTime(exhaustAt.Add(1000 * time.Second))
+	require.NoError(t, f.App.BillingKeeper.EndBlocker(f.Ctx))
+	lease, err = f.App.BillingKeeper.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_ACTIVE, lease.State)
+}
+
+func TestGracePeriod_WithdrawStartsGrace(t *testing.T) {
+	s := setupLeaseScaling(t, 10_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	setGracePeriod(t, f, 1000)
+
+	// Touched 10s past exhaustion, before any EndBlocker ran.
+	now := f.Ctx.BlockTime().Add(5010 * time.Second)
+	f.Ctx = f.Ctx.WithBlockTime(now).WithEventManager(sdk.NewEventManager())
+	resp, err := s.msgServer.Withdraw(f.Ctx, &types.MsgWithdraw{
+		Sender:     s.providerAddr.String(),
+		LeaseUuids: []string{s.leaseUUID},
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 10_000)), resp.TotalAmounts)
+	require.Equal(t, 1, countEvents(f.Ctx, types.EventTypeLeaseGraceStarted))
+
+	lease, err := f.App.BillingKeeper.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_ACTIVE, lease.State)
+	require.True(t, lease.GraceEndsAt.Equal(now.Add(1000*time.Second)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 20)), lease.Debt)
+
+	// Rate changes wait until the tenant is back in credit.
+	_, err = s.msgServer.UpdateLeaseItems(f.Ctx, &types.MsgUpdateLeaseItems{
+		Tenant:    s.tenant.String(),
+		LeaseUuid: s.leaseUUID,
+		Items:     []types.LeaseItemInput{{SkuUuid: s.sku.Uuid, Quantity: 1}},
+	})
+	require.ErrorIs(t, err, types.ErrInsufficientCredit)
+}
+
+// setProviderGracePeriod sets the grace period override of the fixture
+// provider's policy, signed by sender.
+func setProviderGracePeriod(s *leaseScalingSetup, sender string, seconds uint64) error {
+	_, err := s.msgServer.SetProviderPolicy(s.f.Ctx, &types.MsgSetProviderPolicy{
+		Sender: sender,
+		Policy: types.ProviderPolicy{
+			ProviderUuid:        s.provider.Uuid,
+			OverrideGracePeriod: true,
+			GracePeriod:         seconds,
+		},
+	})
+	return err
+}
+
+func TestGracePeriod_ProviderOverride(t *testing.T) {
+	s := setupLeaseScaling(t, 10_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	k := f.App.BillingKeeper
+	querier := keeper.NewQuerier(k)
+
+	require.ErrorIs(t, setProviderGracePeriod(s, s.tenant.String(), 500), types.ErrUnauthorized)
+
+	// Overrides are refused while params.max_provider_grace_period is zero,
+	// its default.
+	require.ErrorIs(t, setProviderGracePeriod(s, k.GetAuthority(), 500), types.ErrInvalidProviderPolicy)
+
+	params, err := k.GetParams(f.Ctx)
+	require.NoError(t, err)
+	params.MaxProviderGracePeriod = 500
+	require.NoError(t, k.SetParams(f.Ctx, params))
+
+	require.ErrorIs(t, setProviderGracePeriod(s, s.providerAddr.String(), 501), types.ErrInvalidProviderPolicy)
+	// The authority is bounded too.
+	require.ErrorIs(t, setProviderGracePeriod(s, k.GetAuthority(), 5000), types.ErrInvalidProviderPolicy)
+
+	f.Ctx = f.Ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, setProviderGracePeriod(s, s.providerAddr.String(), 500))
+	require.Equal(t, 1, countEvents(f.Ctx, types.EventTypeProviderPolicySet))
+
+	resp, err := querier.ProviderPolicy(f.Ctx, &types.QueryProviderPolicyRequest{ProviderUuid: s.provider.Uuid})
+	require.NoError(t, err)
+	require.True(t, resp.Policy.OverrideGracePeriod)
+	require.Equal(t, uint64(500), resp.EffectiveGracePeriod)
+
+	// The override applies although params.grace_period is zero.
+	exhaustAt := f.Ctx.BlockTime().Add(5000 * time.Second).UTC()
+	f.Ctx = f.Ctx.WithBlockTime(exhaustAt)
+	require.NoError(t, k.EndBlocker(f.Ctx))
+	lease, err := k.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_ACTIVE, lease.State)
+	require.True(t, lease.GraceEndsAt.Equal(exhaustAt.Add(500*time.Second)))
+
+	_, err = s.msgServer.SetProviderPolicy(f.Ctx, &types.MsgSetProviderPolicy{
+		Sender: k.GetAuthority(),
+		Policy: types.ProviderPolicy{ProviderUuid: s.provider.Uuid},
+		Reset_: true,
+	})
+	require.NoError(t, err)
+
+	resp, err = querier.ProviderPolicy(f.Ctx, &types.QueryProviderPolicyRequest{ProviderUuid: s.provider.Uuid})
+	require.NoError(t, err)
+	require.False(t, resp.Found)
+	require.Zero(t, resp.EffectiveGracePeriod)
+
+	// A lease already in grace keeps its grace_ends_at.
+	lease, err = k.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.True(t, lease.GraceEndsAt.Equal(exhaustAt.Add(500*time.Second)))
+
+	exported := k.ExportGenesis(f.Ctx)
+	require.Empty(t, exported.ProviderPolicies)
+}
+
+func TestGracePeriod_OverrideClampedToMax(t *testing.T) {
+	s := setupLeaseScaling(t, 10_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	k := f.App.BillingKeeper
+	setGracePeriod(t, f, 100)
+
+	setMax := func(seconds uint64) {
+		params, err := k.GetParams(f.Ctx)
+		require.NoError(t, err)
+		params.MaxProviderGracePeriod = seconds
+		require.NoError(t, k.SetParams(f.Ctx, params))
+	}
+	setMax(500)
+	require.NoError(t, setProviderGracePeriod(s, s.providerAddr.String(), 500))
+
+	// Lowering the bound shortens the stored override.
+	setMax(200)
+	gracePeriod, overridden, err := k.GetEffectiveGracePeriod(f.Ctx, s.provider.Uuid)
+	require.NoError(t, err)
+	require.Equal(t, uint64(200), gracePeriod)
+	require.True(t, overridden)
+
+	// A zero bound disables overrides.
+	setMax(0)
+	gracePeriod, overridden, err = k.GetEffectiveGracePeriod(f.Ctx, s.provider.Uuid)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), gracePeriod)
+	require.False(t, overridden)
+
+	// A policy without the override follows params.
+	setMax(500)
+	_, err = s.msgServer.SetProviderPolicy(f.Ctx, &types.MsgSetProviderPolicy{
+		Sender: s.providerAddr.String(),
+		Policy: types.ProviderPolicy{ProviderUuid: s.provider.Uuid, PendingTimeout: 600},
+	})
+	require.NoError(t, err)
+	gracePeriod, overridden, err = k.GetEffectiveGracePeriod(f.Ctx, s.provider.Uuid)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), gracePeriod)
+	require.False(t, overridden)
+}
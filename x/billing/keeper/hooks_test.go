@@ -0,0 +1,250 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/keeper"
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+	skutypes "github.com/manifest-network/manifest-ledger/x/sku/types"
+)
+
+// recordedHook is a single call observed by recordingHooks.
+type recordedHook struct {
+	name   string
+	lease  string
+	state  types.LeaseState
+	amount sdk.Coins
+}
+
+// recordingHooks records every billing hook call and fails the hook named in
+// failOn.
+type recordingHooks struct {
+	calls  []recordedHook
+	failOn string
+}
+
+var _ types.BillingHooks = (*recordingHooks)(nil)
+
+func (h *recordingHooks) record(name string, lease types.Lease, amount sdk.Coins) error {
+	h.calls = append(h.calls, recordedHook{name: name, lease: lease.Uuid, state: lease.State, amount: amount})
+	if name == h.failOn {
+		return errors.New("hook failed")
+	}
+	return nil
+}
+
+func (h *recordingHooks) names() []string {
+	names := make([]string, 0, len(h.calls))
+	for _, c := range h.calls {
+		names = append(names, c.name)
+	}
+	return names
+}
+
+func (h *recordingHooks) AfterLeaseCreated(_ context.Context, lease types.Lease) error {
+	return h.record("created", lease, nil)
+}
+
+func (h *recordingHooks) AfterLeaseAcknowledged(_ context.Context, lease types.Lease) error {
+	return h.record("acknowledged", lease, nil)
+}
+
+func (h *recordingHooks) AfterLeaseRejected(_ context.Context, lease types.Lease) error {
+	return h.record("rejected", lease, nil)
+}
+
+func (h *recordingHooks) AfterLeaseCancelled(_ context.Context, lease types.Lease) error {
+	return h.record("cancelled", lease, nil)
+}
+
+func (h *recordingHooks) AfterLeaseExpired(_ context.Context, lease types.Lease) error {
+	return h.record("expired", lease, nil)
+}
+
+func (h *recordingHooks) AfterLeaseClosed(_ context.Context, lease types.Lease) error {
+	return h.record("closed", lease, nil)
+}
+
+func (h *recordingHooks) AfterLeaseSettled(_ context.Context, lease types.Lease, amount sdk.Coins) error {
+	return h.record("settled", lease, amount)
+}
+
+func (h *recordingHooks) AfterCreditFunded(_ context.Context, tenant string, amount sdk.Coin) error {
+	h.calls = append(h.calls, recordedHook{name: "funded", lease: tenant, amount: sdk.NewCoins(amount)})
+	if h.failOn == "funded" {
+		return errors.New("hook failed")
+	}
+	return nil
+}
+
+type hooksSetup struct {
+	f            *testFixture
+	hooks        *recordingHooks
+	msgServer    types.MsgServer
+	tenant       sdk.AccAddress
+	providerAddr sdk.AccAddress
+	sku          skutypes.SKU
+}
+
+// setupHooks registers recording hooks before the message server copies the
+// keeper, and funds the tenant's credit account with credit.
+func setupHooks(t *testing.T, credit int64) *hooksSetup {
+	t.Helper()
+	f := initFixture(t)
+	hooks := &recordingHooks{}
+	f.App.BillingKeeper.SetHooks(hooks)
+	msgServer := keeper.NewMsgServerImpl(f.App.BillingKeeper)
+
+	tenant := f.TestAccs[0]
+	providerAddr := f.TestAccs[1]
+	provider := f.createTestProvider(t, providerAddr.String(), f.TestAccs[2].String())
+	sku := f.createTestSKU(t, provider.Uuid, 3600)
+
+	f.fundCreditViaMsg(t, msgServer, tenant, credit)
+
+	return &hooksSetup{f: f, hooks: hooks, msgServer: msgServer, tenant: tenant, providerAddr: providerAddr, sku: sku}
+}
+
+func (s *hooksSetup) createLease(t *testing.T) string {
+	t.Helper()
+	resp, err := s.msgServer.CreateLease(s.f.Ctx, &types.MsgCreateLease{
+		Tenant: s.tenant.String(),
+		Items:  []types.LeaseItemInput{{SkuUuid: s.sku.Uuid, Quantity: 2}},
+	})
+	require.NoError(t, err)
+	return resp.LeaseUuid
+}
+
+func TestHooks_LeaseLifecycle(t *testing.T) {
+	s := setupHooks(t, 100_000)
+	f := s.f
+	require.Equal(t, []string{"funded"}, s.hooks.names())
+	require.Equal(t, s.tenant.String(), s.hooks.calls[0].lease)
+
+	leaseUUID := f.createAndAcknowledgeLease(t, s.msgServer, s.tenant, s.providerAddr, []types.LeaseItemInput{
+		{SkuUuid: s.sku.Uuid, Quantity: 2},
+	})
+	require.Equal(t, []string{"funded", "created", "acknowledged"}, s.hooks.names())
+	require.Equal(t, types.LEASE_STATE_PENDING, s.hooks.calls[1].state)
+	require.Equal(t, types.LEASE_STATE_ACTIVE, s.hooks.calls[2].state)
+
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(100 * time.Second))
+	_, err := s.msgServer.CloseLease(f.Ctx, &types.MsgCloseLease{
+		Sender:     s.tenant.String(),
+		LeaseUuids: []string{leaseUUID},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"funded", "created", "acknowledged", "settled", "closed"}, s.hooks.names())
+	settled := s.hooks.calls[3]
+	require.Equal(t, leaseUUID, settled.lease)
+	require.Equal(t, sdkmath.NewInt(200), settled.amount.AmountOf(testDenom))
+	require.Equal(t, types.LEASE_STATE_CLOSED, s.hooks.calls[4].state)
+}
+
+func TestHooks_PendingLeaseOutcomes(t *testing.T) {
+	s := setupHooks(t, 100_000)
+	f := s.f
+
+	rejected := s.createLease(t)
+	_, err := s.msgServer.RejectLease(f.Ctx, &types.MsgRejectLease{
+		Sender:     s.providerAddr.String(),
+		LeaseUuids: []string{rejected},
+	})
+	require.NoError(t, err)
+
+	cancelled := s.createLease(t)
+	_, err = s.msgServer.CancelLease(f.Ctx, &types.MsgCancelLease{
+		Tenant:     s.tenant.String(),
+		LeaseUuids: []string{cancelled},
+	})
+	require.NoError(t, err)
+
+	expired := s.createLease(t)
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(time.Duration(types.DefaultPendingTimeout+1) * time.Second))
+	require.NoError(t, f.App.BillingKeeper.EndBlocker(f.Ctx))
+
+	require.Equal(t, []string{
+		"funded",
+		"created", "rejected",
+		"created", "cancelled",
+		"created", "expired",
+	}, s.hooks.names())
+	require.Equal(t, rejected, s.hooks.calls[2].lease)
+	require.Equal(t, types.LEASE_STATE_REJECTED, s.hooks.calls[2].state)
+	require.Equal(t, cancelled, s.hooks.calls[4].lease)
+	require.Equal(t, types.LEASE_STATE_REJECTED, s.hooks.calls[4].state)
+	require.Equal(t, expired, s.hooks.calls[6].lease)
+	require.Equal(t, types.LEASE_STATE_EXPIRED, s.hooks.calls[6].state)
+}
+
+func TestHooks_AutoCloseInEndBlocker(t *testing.T) {
+	s := setupHooks(t, 10_000)
+	f := s.f
+	leaseUUID := f.createAndAcknowledgeLease(t, s.msgServer, s.tenant, s.providerAddr, []types.LeaseItemInput{
+		{SkuUuid: s.sku.Uuid, Quantity: 2},
+	})
+	s.hooks.calls = nil
+
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(5000 * time.Second))
+	require.NoError(t, f.App.BillingKeeper.EndBlocker(f.Ctx))
+
+	require.Equal(t, []string{"settled", "closed"}, s.hooks.names())
+	require.Equal(t, leaseUUID, s.hooks.calls[1].lease)
+	require.Equal(t, sdkmath.NewInt(10_000), s.hooks.calls[0].amount.AmountOf(testDenom))
+
+	lease, err := f.App.BillingKeeper.GetLease(f.Ctx, leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.ClosureReasonCreditExhausted, lease.ClosureReason)
+}
+
+func TestHooks_ErrorAbortsOperation(t *testing.T) {
+	s := setupHooks(t, 100_000)
+	f := s.f
+	leaseUUID := s.createLease(t)
+
+	s.hooks.failOn = "acknowledged"
+	_, err := s.msgServer.AcknowledgeLease(f.Ctx, &types.MsgAcknowledgeLease{
+		Sender:     s.providerAddr.String(),
+		LeaseUuids: []string{leaseUUID},
+	})
+	require.ErrorContains(t, err, "hook failed")
+
+	lease, err := f.App.BillingKeeper.GetLease(f.Ctx, leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_PENDING, lease.State)
+
+	// A failing hook in the EndBlocker only skips the affected lease.
+	s.hooks.failOn = "expired"
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(time.Duration(types.DefaultPendingTimeout+1) * time.Second))
+	require.NoError(t, f.App.BillingKeeper.EndBlocker(f.Ctx))
+
+	lease, err = f.App.BillingKeeper.GetLease(f.Ctx, leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_PENDING, lease.State)
+}
+
+func TestMultiBillingHooks(t *testing.T) {
+	first := &recordingHooks{}
+	second := &recordingHooks{}
+	multi := types.NewMultiBillingHooks(first, second)
+	lease := types.Lease{Uuid: "lease"}
+
+	require.NoError(t, multi.AfterLeaseCreated(context.Background(), lease))
+	require.Equal(t, []string{"created"}, first.names())
+	require.Equal(t, []string{"created"}, second.names())
+
+	first.failOn = "closed"
+	require.ErrorContains(t, multi.AfterLeaseClosed(context.Background(), lease), "hook failed")
+	require.Equal(t, []string{"created", "closed"}, first.names())
+	require.Equal(t, []string{"created"}, second.names(), "the chain stops at the first error")
+}
@@ -0,0 +1,400 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+)
+
+// Invariant route names, registered under the billing module. They can be
+// checked on demand with MsgVerifyInvariant, e.g. billing/reserved-amounts.
+const (
+	ReservedAmountsInvariantName = "reserved-amounts"
+	LeaseCountsInvariantName     = "lease-counts"
+	CustomDomainsInvariantName   = "custom-domains"
+	LeaseReferencesInvariantName = "lease-references"
+	CreditBalancesInvariantName  = "credit-balances"
+	PromoGrantsInvariantName     = "promo-grants"
+	SKUAllocationsInvariantName  = "sku-allocations"
+)
+
+// RegisterInvariants registers all billing invariants with the crisis module.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, ReservedAmountsInvariantName, ReservedAmountsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, LeaseCountsInvariantName, LeaseCountsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, CustomDomainsInvariantName, CustomDomainsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, LeaseReferencesInvariantName, LeaseReferencesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, CreditBalancesInvariantName, CreditBalancesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, PromoGrantsInvariantName, PromoGrantsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, SKUAllocationsInvariantName, SKUAllocationsInvariant(k))
+}
+
+// AllInvariants runs all billing invariants and reports the first broken one.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			ReservedAmountsInvariant(k),
+			LeaseCountsInvariant(k),
+			CustomDomainsInvariant(k),
+			LeaseReferencesInvariant(k),
+			CreditBalancesInvariant(k),
+			PromoGrantsInvariant(k),
+			SKUAllocationsInvariant(k),
+		} {
+			if res, broken := inv(ctx); broken {
+				return res, broken
+			}
+		}
+		return "", false
+	}
+}
+
+// ReservedAmountsInvariant checks that every credit account's
+// ReservedAmounts equals the reservations of the tenant's PENDING and ACTIVE
+// leases plus those of its pending amendments, and that no tenant holds
+// reservations without a credit account.
+func ReservedAmountsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return invariantError(ReservedAmountsInvariantName, err)
+		}
+		leases, err := k.GetAllLeases(ctx)
+		if err != nil {
+			return invariantError(ReservedAmountsInvariantName, err)
+		}
+
+		expected := types.CalculateExpectedReservationsByTenant(leases, params.MinLeaseDuration)
+		err = k.LeaseAmendments.Walk(ctx, nil, func(_ string, amendment types.LeaseAmendment) (bool, error) {
+			expected[amendment.Tenant] = expected[amendment.Tenant].Add(amendment.ReservedAmounts...)
+			return false, nil
+		})
+		if err != nil {
+			return invariantError(ReservedAmountsInvariantName, err)
+		}
+
+		err = k.CreditAccounts.Walk(ctx, nil, func(_ sdk.AccAddress, ca types.CreditAccount) (bool, error) {
+			reserved := sdk.NewCoins(ca.ReservedAmounts...)
+			want := sdk.NewCoins(expected[ca.Tenant]...)
+			if !reserved.Equal(want) {
+				broken++
+				msg += fmt.Sprintf("\ttenant %s has reserved %s but leases and amendments reserve %s\n",
+					ca.Tenant, reserved, want)
+			}
+			delete(expected, ca.Tenant)
+			return false, nil
+		})
+		if err != nil {
+			return invariantError(ReservedAmountsInvariantName, err)
+		}
+
+		for tenant, want := range expected {
+			if !want.IsZero() {
+				broken++
+				msg += fmt.Sprintf("\ttenant %s reserves %s but has no credit account\n", tenant, want)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, ReservedAmountsInvariantName,
+			fmt.Sprintf("found %d credit accounts with wrong reserved amounts\n%s", broken, msg)), broken != 0
+	}
+}
+
+// LeaseCountsInvariant checks that every credit account's ActiveLeaseCount
+// and PendingLeaseCount match the tenant's entries in the (tenant, state)
+// lease index.
+func LeaseCountsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		err := k.CreditAccounts.Walk(ctx, nil, func(tenant sdk.AccAddress, ca types.CreditAccount) (bool, error) {
+			active, err := k.countLeasesByTenantState(ctx, tenant, types.LEASE_STATE_ACTIVE)
+			if err != nil {
+				return true, err
+			}
+			pending, err := k.countLeasesByTenantState(ctx, tenant, types.LEASE_STATE_PENDING)
+			if err != nil {
+				return true, err
+			}
+
+			if ca.ActiveLeaseCount != active || ca.PendingLeaseCount != pending {
+				broken++
+				msg += fmt.Sprintf("\ttenant %s counts %d active and %d pending leases but has %d active and %d pending\n",
+					ca.Tenant, ca.ActiveLeaseCount, ca.PendingLeaseCount, active, pending)
+			}
+			return false, nil
+		})
+		if err != nil {
+			return invariantError(LeaseCountsInvariantName, err)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, LeaseCountsInvariantName,
+			fmt.Sprintf("found %d credit accounts with wrong lease counts\n%s", broken, msg)), broken != 0
+	}
+}
+
+// CustomDomainsInvariant checks that the CustomDomainIndex holds exactly one
+// entry for each custom domain set on an item of a PENDING or ACTIVE lease,
+// and that each entry points back to that item.
+func CustomDomainsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		claimed := make(map[string]types.CustomDomainTarget)
+		err := k.Leases.Walk(ctx, nil, func(uuid string, lease types.Lease) (bool, error) {
+			if lease.State != types.LEASE_STATE_PENDING && lease.State != types.LEASE_STATE_ACTIVE {
+				return false, nil
+			}
+			for _, item := range lease.Items {
+				if item.CustomDomain == "" {
+					continue
+				}
+				if other, ok := claimed[item.CustomDomain]; ok {
+					broken++
+					msg += fmt.Sprintf("\tdomain %s is set on lease %s and lease %s\n",
+						item.CustomDomain, other.LeaseUuid, uuid)
+					continue
+				}
+				claimed[item.CustomDomain] = types.CustomDomainTarget{LeaseUuid: uuid, ServiceName: item.ServiceName}
+			}
+			return false, nil
+		})
+		if err != nil {
+			return invariantError(CustomDomainsInvariantName, err)
+		}
+
+		err = k.CustomDomainIndex.Walk(ctx, nil, func(domain string, target types.CustomDomainTarget) (bool, error) {
+			want, ok := claimed[domain]
+			switch {
+			case !ok:
+				broken++
+				msg += fmt.Sprintf("\tdomain %s is indexed to lease %s but no pending or active lease item sets it\n",
+					domain, target.LeaseUuid)
+			case want != target:
+				broken++
+				msg += fmt.Sprintf("\tdomain %s is indexed to lease %s service %q but set on lease %s service %q\n",
+					domain, target.LeaseUuid, target.ServiceName, want.LeaseUuid, want.ServiceName)
+			}
+			delete(claimed, domain)
+			return false, nil
+		})
+		if err != nil {
+			return invariantError(CustomDomainsInvariantName, err)
+		}
+
+		for domain, target := range claimed {
+			broken++
+			msg += fmt.Sprintf("\tdomain %s is set on lease %s service %q but not indexed\n",
+				domain, target.LeaseUuid, target.ServiceName)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, CustomDomainsInvariantName,
+			fmt.Sprintf("found %d custom domain index mismatches\n%s", broken, msg)), broken != 0
+	}
+}
+
+// SKUAllocationsInvariant checks that SKUAllocations holds, for every SKU, the
+// total item quantity of its PENDING and ACTIVE leases. Allocations above a
+// SKU's capacity are not a violation: capacity can be lowered below them.
+func SKUAllocationsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		expected := make(map[string]uint64)
+		err := k.Leases.Walk(ctx, nil, func(_ string, lease types.Lease) (bool, error) {
+			if !allocatesCapacity(lease.State) {
+				return false, nil
+			}
+			for _, item := range lease.Items {
+				expected[item.SkuUuid] += item.Quantity
+			}
+			return false, nil
+		})
+		if err != nil {
+			return invariantError(SKUAllocationsInvariantName, err)
+		}
+
+		err = k.SKUAllocations.Walk(ctx, nil, func(skuUUID string, allocated uint64) (bool, error) {
+			if allocated != expected[skuUUID] {
+				broken++
+				msg += fmt.Sprintf("\tsku %s: allocated %d, leases hold %d\n",
+					skuUUID, allocated, expected[skuUUID])
+			}
+			delete(expected, skuUUID)
+			return false, nil
+		})
+		if err != nil {
+			return invariantError(SKUAllocationsInvariantName, err)
+		}
+
+		for _, skuUUID := range sortedSKUs(expected) {
+			broken++
+			msg += fmt.Sprintf("\tsku %s: allocated 0, leases hold %d\n", skuUUID, expected[skuUUID])
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, SKUAllocationsInvariantName,
+			fmt.Sprintf("found %d sku allocation mismatches\n%s", broken, msg)), broken != 0
+	}
+}
+
+// LeaseReferencesInvariant checks that every lease references an existing
+// provider and that each of its items references an existing SKU of that
+// provider.
+func LeaseReferencesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		err := k.Leases.Walk(ctx, nil, func(uuid string, lease types.Lease) (bool, error) {
+			if _, err := k.skuKeeper.GetProvider(ctx, lease.ProviderUuid); err != nil {
+				broken++
+				msg += fmt.Sprintf("\tlease %s references provider %s: %s\n", uuid, lease.ProviderUuid, err)
+			}
+			for i, item := range lease.Items {
+				sku, err := k.skuKeeper.GetSKU(ctx, item.SkuUuid)
+				switch {
+				case err != nil:
+					broken++
+					msg += fmt.Sprintf("\tlease %s item %d references SKU %s: %s\n", uuid, i, item.SkuUuid, err)
+				case sku.ProviderUuid != lease.ProviderUuid:
+					broken++
+					msg += fmt.Sprintf("\tlease %s item %d SKU %s belongs to provider %s, not %s\n",
+						uuid, i, item.SkuUuid, sku.ProviderUuid, lease.ProviderUuid)
+				}
+			}
+			return false, nil
+		})
+		if err != nil {
+			return invariantError(LeaseReferencesInvariantName, err)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, LeaseReferencesInvariantName,
+			fmt.Sprintf("found %d dangling lease references\n%s", broken, msg)), broken != 0
+	}
+}
+
+// CreditBalancesInvariant checks that no credit address holds a negative
+// balance.
+func CreditBalancesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		err := k.CreditAccounts.Walk(ctx, nil, func(_ sdk.AccAddress, ca types.CreditAccount) (bool, error) {
+			creditAddr, err := sdk.AccAddressFromBech32(ca.CreditAddress)
+			if err != nil {
+				return true, err
+			}
+			if balances := k.bankKeeper.GetAllBalances(ctx, creditAddr); balances.IsAnyNegative() {
+				broken++
+				msg += fmt.Sprintf("\tcredit address %s of tenant %s has balance %s\n", ca.CreditAddress, ca.Tenant, balances)
+			}
+			return false, nil
+		})
+		if err != nil {
+			return invariantError(CreditBalancesInvariantName, err)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, CreditBalancesInvariantName,
+			fmt.Sprintf("found %d negative credit balances\n%s", broken, msg)), broken != 0
+	}
+}
+
+// PromoGrantsInvariant checks that the promo escrow account holds at least
+// the remaining amount of every promo grant, and that PromoGrantsByExpiry has
+// exactly one entry per grant.
+func PromoGrantsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		remaining := sdk.NewCoins()
+		expiries := make(map[collections.Pair[string, uint64]]time.Time)
+		err := k.PromoGrants.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, uint64], grant types.PromoGrant) (bool, error) {
+			remaining = remaining.Add(grant.Remaining...)
+			has, err := k.PromoGrantsByExpiry.Has(ctx, collections.Join3(grant.ExpiresAt, key.K1(), key.K2()))
+			if err != nil {
+				return true, err
+			}
+			if !has {
+				broken++
+				msg += fmt.Sprintf("\tpromo grant %d of tenant %s is not indexed by expiry\n", grant.Id, grant.Tenant)
+			}
+			expiries[collections.Join(key.K1().String(), key.K2())] = grant.ExpiresAt
+			return false, nil
+		})
+		if err != nil {
+			return invariantError(PromoGrantsInvariantName, err)
+		}
+
+		err = k.PromoGrantsByExpiry.Walk(ctx, nil, func(key collections.Triple[time.Time, sdk.AccAddress, uint64]) (bool, error) {
+			if expiresAt, ok := expiries[collections.Join(key.K2().String(), key.K3())]; !ok || !expiresAt.Equal(key.K1()) {
+				broken++
+				msg += fmt.Sprintf("\texpiry index entry %s for promo grant %d of tenant %s matches no grant\n",
+					key.K1(), key.K3(), key.K2())
+			}
+			return false, nil
+		})
+		if err != nil {
+			return invariantError(PromoGrantsInvariantName, err)
+		}
+
+		escrowed := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.PromoEscrowName))
+		if !remaining.IsAllLTE(escrowed) {
+			broken++
+			msg += fmt.Sprintf("\tpromo escrow holds %s but grants have %s remaining\n", escrowed, remaining)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, PromoGrantsInvariantName,
+			fmt.Sprintf("found %d promo grant inconsistencies\n%s", broken, msg)), broken != 0
+	}
+}
+
+// countLeasesByTenantState counts a tenant's leases in state using the
+// (tenant, state) index alone.
+func (k *Keeper) countLeasesByTenantState(ctx context.Context, tenant sdk.AccAddress, state types.LeaseState) (uint64, error) {
+	iter, err := k.Leases.Indexes.TenantState.MatchExact(ctx, collections.Join(tenant, int32(state)))
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	var count uint64
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	return count, nil
+}
+
+// invariantError reports a store error as a broken invariant, since the
+// state it was meant to check could not be read.
+func invariantError(name string, err error) (string, bool) {
+	return sdk.FormatInvariant(types.ModuleName, name, fmt.Sprintf("failed to read state: %s", err)), true
+}
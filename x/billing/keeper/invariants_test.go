@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/keeper"
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+)
+
+func TestInvariants_DetectCorruption(t *testing.T) {
+	s := setupCustomDomain(t)
+	k := s.f.App.BillingKeeper
+	_, err := k.SetItemCustomDomain(s.f.Ctx, s.tenant.String(), s.leaseUUID, "", "app.example.com")
+	require.NoError(t, err)
+
+	msg, broken := keeper.AllInvariants(k)(s.f.Ctx)
+	require.False(t, broken, msg)
+
+	tests := []struct {
+		name      string
+		invariant func(keeper.Keeper) sdk.Invariant
+		corrupt   func(t *testing.T, ctx sdk.Context)
+	}{
+		{
+			name:      "reservation dropped",
+			invariant: keeper.ReservedAmountsInvariant,
+			corrupt: func(t *testing.T, ctx sdk.Context) {
+				ca, err := k.GetCreditAccount(ctx, s.tenant.String())
+				require.NoError(t, err)
+				ca.ReservedAmounts = sdk.NewCoins()
+				require.NoError(t, k.SetCreditAccount(ctx, ca))
+			},
+		},
+		{
+			name:      "active count drifted",
+			invariant: keeper.LeaseCountsInvariant,
+			corrupt: func(t *testing.T, ctx sdk.Context) {
+				ca, err := k.GetCreditAccount(ctx, s.tenant.String())
+				require.NoError(t, err)
+				ca.ActiveLeaseCount++
+				require.NoError(t, k.SetCreditAccount(ctx, ca))
+			},
+		},
+		{
+			name:      "domain index entry missing",
+			invariant: keeper.CustomDomainsInvariant,
+			corrupt: func(t *testing.T, ctx sdk.Context) {
+				require.NoError(t, k.CustomDomainIndex.Remove(ctx, "app.example.com"))
+			},
+		},
+		{
+			name:      "stray domain index entry",
+			invariant: keeper.CustomDomainsInvariant,
+			corrupt: func(t *testing.T, ctx sdk.Context) {
+				require.NoError(t, k.CustomDomainIndex.Set(ctx, "other.example.com", types.CustomDomainTarget{LeaseUuid: s.leaseUUID}))
+			},
+		},
+		{
+			name:      "unknown SKU",
+			invariant: keeper.LeaseReferencesInvariant,
+			corrupt: func(t *testing.T, ctx sdk.Context) {
+				lease, err := k.GetLease(ctx, s.leaseUUID)
+				require.NoError(t, err)
+				lease.Items[0].SkuUuid = "01912345-6789-7abc-8def-0123456789ab"
+				require.NoError(t, k.Leases.Set(ctx, lease.Uuid, lease))
+			},
+		},
+		{
+			name:      "sku allocation drifted",
+			invariant: keeper.SKUAllocationsInvariant,
+			corrupt: func(t *testing.T, ctx sdk.Context) {
+				require.NoError(t, k.SKUAllocations.Remove(ctx, s.sku.Uuid))
+			},
+		},
+		{
+			name:      "promo grant not funded",
+			invariant: keeper.PromoGrantsInvariant,
+			corrupt: func(t *testing.T, ctx sdk.Context) {
+				grant := promoGrantFixture(s.tenant, ctx.BlockTime())
+				require.NoError(t, k.PromoGrants.Set(ctx, collections.Join(s.tenant, grant.Id), grant))
+				require.NoError(t, k.PromoGrantsByExpiry.Set(ctx, collections.Join3(grant.ExpiresAt, s.tenant, grant.Id)))
+			},
+		},
+		{
+			name:      "promo grant expiry index entry missing",
+			invariant: keeper.PromoGrantsInvariant,
+			corrupt: func(t *testing.T, ctx sdk.Context) {
+				grant := promoGrantFixture(s.tenant, ctx.BlockTime())
+				grant.Remaining = sdk.NewCoins()
+				require.NoError(t, k.PromoGrants.Set(ctx, collections.Join(s.tenant, grant.Id), grant))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := s.f.Ctx.CacheContext()
+			tc.corrupt(t, ctx)
+
+			_, broken := tc.invariant(k)(ctx)
+			require.True(t, broken)
+			_, broken = keeper.AllInvariants(k)(ctx)
+			require.True(t, broken)
+		})
+	}
+}
+
+// promoGrantFixture returns a grant of 100 testDenom to tenant, valid for an
+// hour from now.
+func promoGrantFixture(tenant sdk.AccAddress, now time.Time) types.PromoGrant {
+	amount := sdk.NewCoins(sdk.NewInt64Coin(testDenom, 100))
+	return types.PromoGrant{
+		Tenant:    tenant.String(),
+		Granter:   tenant.String(),
+		Amount:    amount,
+		Remaining: amount,
+		CreatedAt: now,
+		ExpiresAt: now.Add(time.Hour),
+	}
+}
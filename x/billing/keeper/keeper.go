@@ -11,6 +11,7 @@ import (
 	"cosmossdk.io/collections/indexes"
 	storetypes "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -152,9 +153,81 @@ type Keeper struct {
 	// (wraps the lease + index updates in a CacheContext), so a uniqueness
 	// conflict cannot leave a partially-applied lease record.
 	CustomDomainIndex collections.Map[string, types.CustomDomainTarget]
+	// LeaseAmendments holds the pending amendment of each lease, keyed by lease
+	// UUID. An entry exists only while the amendment awaits the provider.
+	LeaseAmendments collections.Map[string, types.LeaseAmendment]
+	// LeaseAmendmentsByCreatedAt indexes pending amendments by created_at so
+	// the EndBlocker can range over the timed-out ones. Maintained by
+	// SetLeaseAmendment and removeLeaseAmendment.
+	LeaseAmendmentsByCreatedAt collections.KeySet[collections.Pair[time.Time, string]]
+	// LeasesByScheduledEnd indexes ACTIVE leases that have a scheduled_end_at,
+	// ordered by that time so the EndBlocker can range over the due ones.
+	// Maintained by SetLease.
+	LeasesByScheduledEnd collections.KeySet[collections.Pair[time.Time, string]]
+	// CreditExhaustion holds, per (tenant, denom), the projected time at which
+	// the credit balance stops covering the tenant's ACTIVE leases in that
+	// denom. CreditExhaustionQueue mirrors it ordered by time for the
+	// EndBlocker. Both are maintained by refreshCreditExhaustion.
+	CreditExhaustion      collections.Map[collections.Pair[sdk.AccAddress, string], time.Time]
+	CreditExhaustionQueue collections.KeySet[collections.Triple[time.Time, sdk.AccAddress, string]]
+	// LeasesByGraceEnd indexes ACTIVE leases in their grace period, ordered by
+	// grace_ends_at so the EndBlocker can range over the expired ones.
+	// Maintained by SetLease.
+	LeasesByGraceEnd collections.KeySet[collections.Pair[time.Time, string]]
+	// LeasesWithDebt indexes, per tenant, the leases carrying unpaid debt so
+	// FundCredit and the credit account query do not scan every lease.
+	// Maintained by SetLease.
+	LeasesWithDebt collections.KeySet[collections.Pair[sdk.AccAddress, string]]
+	// LeasesByTerminalAt indexes CLOSED, REJECTED and EXPIRED leases without
+	// debt by the time they reached that state, so the EndBlocker can prune
+	// those past lease_retention_period. Maintained by SetLease.
+	LeasesByTerminalAt collections.KeySet[collections.Pair[time.Time, string]]
+	// LeaseUsages holds the usage counters and spend limit of leases with
+	// metered items, keyed by lease UUID.
+	LeaseUsages collections.Map[string, types.LeaseUsage]
+	// Disputes holds the lease disputes, open or settled, keyed by lease UUID.
+	Disputes collections.Map[string, types.Dispute]
+	// DisputesByDeadline indexes OPEN disputes the provider has not answered
+	// by their response_deadline, so the EndBlocker can default them. Maintained
+	// by SetDispute.
+	DisputesByDeadline collections.KeySet[collections.Pair[time.Time, string]]
+	// ProviderPolicies holds the lease policies providers set for their own
+	// leases, keyed by provider UUID.
+	ProviderPolicies collections.Map[string, types.ProviderPolicy]
+	// ProviderStats holds per-provider lease counters, keyed by provider UUID.
+	// Maintained by the keeper's internal billing hooks.
+	ProviderStats collections.Map[string, types.ProviderStats]
+	// LeaseSettlements holds the settlement records of each lease, keyed by
+	// (lease UUID, settlement id) so a lease's records read oldest first.
+	// Removed with the lease when it is pruned.
+	LeaseSettlements collections.Map[collections.Pair[string, uint64], types.LeaseSettlement]
+	// SettlementSequence assigns LeaseSettlement ids.
+	SettlementSequence collections.Sequence
+	// PromoGrants holds the unexpired promotional credit grants, keyed by
+	// (tenant, id) so a tenant's grants can be walked together. Their funds
+	// are held by the promo escrow account.
+	PromoGrants collections.Map[collections.Pair[sdk.AccAddress, uint64], types.PromoGrant]
+	// PromoGrantsByExpiry indexes PromoGrants by expires_at so the EndBlocker
+	// can reclaim them. Maintained by setPromoGrant and removePromoGrant.
+	PromoGrantsByExpiry collections.KeySet[collections.Triple[time.Time, sdk.AccAddress, uint64]]
+	// PromoGrantSequence assigns PromoGrant ids.
+	PromoGrantSequence collections.Sequence
+	// ExchangeRates holds the exchange-rate table, keyed by denom. Rates are
+	// quoted in Params.ReferenceDenom.
+	ExchangeRates collections.Map[string, types.ExchangeRate]
+	// SKUAllocations holds the quantity of each SKU held by PENDING and
+	// ACTIVE leases, checked against SKU.capacity. Maintained by SetLease.
+	SKUAllocations collections.Map[string, uint64]
+	// CreditDeposits holds the credit that reached each credit account less
+	// than params.credit_withdrawal_cooldown ago, keyed by (tenant, denom,
+	// deposited_at). Written by MsgFundCredit and MsgTransferCredit.
+	CreditDeposits collections.Map[collections.Triple[sdk.AccAddress, string, time.Time], sdkmath.Int]
 
 	authority string
 
+	// hooks are called on lease and credit lifecycle changes; nil until SetHooks.
+	hooks types.BillingHooks
+
 	// keepers (to be set via setters for now, full DI later)
 	skuKeeper     SKUKeeper
 	bankKeeper    bankkeeper.Keeper
@@ -224,6 +297,141 @@ func NewKeeper(
 			collections.StringKey,
 			codec.CollValue[types.CustomDomainTarget](cdc),
 		),
+		LeaseAmendments: collections.NewMap(
+			sb,
+			types.LeaseAmendmentKey,
+			"lease_amendments",
+			collections.StringKey,
+			codec.CollValue[types.LeaseAmendment](cdc),
+		),
+		LeaseAmendmentsByCreatedAt: collections.NewKeySet(
+			sb,
+			types.LeaseAmendmentByCreatedAtIndexKey,
+			"lease_amendments_by_created_at",
+			collections.PairKeyCodec(sdk.TimeKey, collections.StringKey),
+		),
+		LeasesByScheduledEnd: collections.NewKeySet(
+			sb,
+			types.LeaseByScheduledEndIndexKey,
+			"leases_by_scheduled_end",
+			collections.PairKeyCodec(sdk.TimeKey, collections.StringKey),
+		),
+		CreditExhaustion: collections.NewMap(
+			sb,
+			types.CreditExhaustionKey,
+			"credit_exhaustion",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), // (tenant, denom)
+			collcodec.KeyToValueCodec(sdk.TimeKey),
+		),
+		CreditExhaustionQueue: collections.NewKeySet(
+			sb,
+			types.CreditExhaustionQueueKey,
+			"credit_exhaustion_queue",
+			collections.TripleKeyCodec(sdk.TimeKey, sdk.AccAddressKey, collections.StringKey),
+		),
+		LeasesByGraceEnd: collections.NewKeySet(
+			sb,
+			types.LeaseByGraceEndIndexKey,
+			"leases_by_grace_end",
+			collections.PairKeyCodec(sdk.TimeKey, collections.StringKey),
+		),
+		LeasesWithDebt: collections.NewKeySet(
+			sb,
+			types.LeaseWithDebtIndexKey,
+			"leases_with_debt",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), // (tenant, lease_uuid)
+		),
+		LeasesByTerminalAt: collections.NewKeySet(
+			sb,
+			types.LeaseByTerminalAtIndexKey,
+			"leases_by_terminal_at",
+			collections.PairKeyCodec(sdk.TimeKey, collections.StringKey),
+		),
+		LeaseUsages: collections.NewMap(
+			sb,
+			types.LeaseUsageKey,
+			"lease_usages",
+			collections.StringKey,
+			codec.CollValue[types.LeaseUsage](cdc),
+		),
+		Disputes: collections.NewMap(
+			sb,
+			types.DisputeKey,
+			"disputes",
+			collections.StringKey,
+			codec.CollValue[types.Dispute](cdc),
+		),
+		DisputesByDeadline: collections.NewKeySet(
+			sb,
+			types.DisputeByDeadlineIndexKey,
+			"disputes_by_deadline",
+			collections.PairKeyCodec(sdk.TimeKey, collections.StringKey),
+		),
+		ProviderPolicies: collections.NewMap(
+			sb,
+			types.ProviderPolicyKey,
+			"provider_policies",
+			collections.StringKey,
+			codec.CollValue[types.ProviderPolicy](cdc),
+		),
+		ProviderStats: collections.NewMap(
+			sb,
+			types.ProviderStatsKey,
+			"provider_stats",
+			collections.StringKey,
+			codec.CollValue[types.ProviderStats](cdc),
+		),
+		LeaseSettlements: collections.NewMap(
+			sb,
+			types.LeaseSettlementKey,
+			"lease_settlements",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.LeaseSettlement](cdc),
+		),
+		SettlementSequence: collections.NewSequence(
+			sb,
+			types.SettlementSequenceKey,
+			"settlement_sequence",
+		),
+		PromoGrants: collections.NewMap(
+			sb,
+			types.PromoGrantKey,
+			"promo_grants",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key),
+			codec.CollValue[types.PromoGrant](cdc),
+		),
+		PromoGrantsByExpiry: collections.NewKeySet(
+			sb,
+			types.PromoGrantByExpiryIndexKey,
+			"promo_grants_by_expiry",
+			collections.TripleKeyCodec(sdk.TimeKey, sdk.AccAddressKey, collections.Uint64Key),
+		),
+		PromoGrantSequence: collections.NewSequence(
+			sb,
+			types.PromoGrantSequenceKey,
+			"promo_grant_sequence",
+		),
+		ExchangeRates: collections.NewMap(
+			sb,
+			types.ExchangeRateKey,
+			"exchange_rates",
+			collections.StringKey,
+			codec.CollValue[types.ExchangeRate](cdc),
+		),
+		SKUAllocations: collections.NewMap(
+			sb,
+			types.SKUAllocationKey,
+			"sku_allocations",
+			collections.StringKey,
+			collections.Uint64Value,
+		),
+		CreditDeposits: collections.NewMap(
+			sb,
+			types.CreditDepositKey,
+			"credit_deposits",
+			collections.TripleKeyCodec(sdk.AccAddressKey, collections.StringKey, sdk.TimeKey),
+			sdk.IntValue,
+		),
 	}
 
 	schema, err := sb.Build()
@@ -266,6 +474,26 @@ func (k *Keeper) SetAccountKeeper(ak accountkeeper.AccountKeeper) {
 	k.accountKeeper = ak
 }
 
+// SetHooks sets the billing hooks. It panics if hooks are already set; combine
+// several with types.NewMultiBillingHooks. The keeper is copied by value into
+// the module and message server, so hooks must be set before those are built.
+func (k *Keeper) SetHooks(bh types.BillingHooks) {
+	if k.hooks != nil {
+		panic("cannot set billing hooks twice")
+	}
+	k.hooks = bh
+}
+
+// Hooks returns the billing hooks. The keeper's own provider stats tracking
+// always runs first, followed by the hooks set with SetHooks, if any.
+func (k *Keeper) Hooks() types.BillingHooks {
+	stats := providerStatsHooks{k: k}
+	if k.hooks == nil {
+		return stats
+	}
+	return types.MultiBillingHooks{stats, k.hooks}
+}
+
 // GetAccountKeeper returns the account keeper (for simulation).
 func (k *Keeper) GetAccountKeeper() accountkeeper.AccountKeeper {
 	return k.accountKeeper
@@ -340,6 +568,91 @@ func (k *Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error
 		}
 	}
 
+	// Exhaustion projections are derived state and are not exported; rebuild
+	// them from the imported leases and balances.
+	for _, ca := range gs.CreditAccounts {
+		if err := k.refreshCreditExhaustion(ctx, ca.Tenant); err != nil {
+			return err
+		}
+	}
+
+	for _, amendment := range gs.LeaseAmendments {
+		if err := k.SetLeaseAmendment(ctx, amendment); err != nil {
+			return err
+		}
+	}
+
+	for _, usage := range gs.LeaseUsages {
+		if err := k.LeaseUsages.Set(ctx, usage.LeaseUuid, usage); err != nil {
+			return err
+		}
+	}
+
+	for _, dispute := range gs.Disputes {
+		if err := k.SetDispute(ctx, dispute); err != nil {
+			return err
+		}
+	}
+
+	for _, policy := range gs.ProviderPolicies {
+		if _, err := k.skuKeeper.GetProvider(ctx, policy.ProviderUuid); err != nil {
+			return fmt.Errorf("provider policy references non-existent provider %s: %w",
+				policy.ProviderUuid, err)
+		}
+		if err := k.ProviderPolicies.Set(ctx, policy.ProviderUuid, policy); err != nil {
+			return err
+		}
+	}
+
+	for _, stats := range gs.ProviderStats {
+		if _, err := k.skuKeeper.GetProvider(ctx, stats.ProviderUuid); err != nil {
+			return fmt.Errorf("provider stats reference non-existent provider %s: %w",
+				stats.ProviderUuid, err)
+		}
+		if err := k.ProviderStats.Set(ctx, stats.ProviderUuid, stats); err != nil {
+			return err
+		}
+	}
+
+	for _, settlement := range gs.LeaseSettlements {
+		if err := k.LeaseSettlements.Set(ctx, collections.Join(settlement.LeaseUuid, settlement.Id), settlement); err != nil {
+			return err
+		}
+	}
+	if gs.SettlementSequence > 0 {
+		if err := k.SettlementSequence.Set(ctx, gs.SettlementSequence); err != nil {
+			return err
+		}
+	}
+
+	for _, grant := range gs.PromoGrants {
+		if err := k.setPromoGrant(ctx, grant); err != nil {
+			return err
+		}
+	}
+	if gs.PromoGrantSequence > 0 {
+		if err := k.PromoGrantSequence.Set(ctx, gs.PromoGrantSequence); err != nil {
+			return err
+		}
+	}
+
+	for _, rate := range gs.ExchangeRates {
+		if err := k.ExchangeRates.Set(ctx, rate.Denom, rate); err != nil {
+			return err
+		}
+	}
+
+	for _, deposit := range gs.CreditDeposits {
+		tenantAddr, err := sdk.AccAddressFromBech32(deposit.Tenant)
+		if err != nil {
+			return err
+		}
+		key := collections.Join3(tenantAddr, deposit.Amount.Denom, deposit.DepositedAt)
+		if err := k.CreditDeposits.Set(ctx, key, deposit.Amount.Amount); err != nil {
+			return err
+		}
+	}
+
 	// Restore UUID generation sequence so new leases don't collide
 	// with previously generated UUIDs after a genesis export/import cycle.
 	if gs.LeaseSequence > 0 {
@@ -381,11 +694,109 @@ func (k *Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	var amendments []types.LeaseAmendment
+	err = k.LeaseAmendments.Walk(ctx, nil, func(_ string, amendment types.LeaseAmendment) (bool, error) {
+		amendments = append(amendments, amendment)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var usages []types.LeaseUsage
+	err = k.LeaseUsages.Walk(ctx, nil, func(_ string, usage types.LeaseUsage) (bool, error) {
+		usages = append(usages, usage)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var disputes []types.Dispute
+	err = k.Disputes.Walk(ctx, nil, func(_ string, dispute types.Dispute) (bool, error) {
+		disputes = append(disputes, dispute)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var policies []types.ProviderPolicy
+	err = k.ProviderPolicies.Walk(ctx, nil, func(_ string, policy types.ProviderPolicy) (bool, error) {
+		policies = append(policies, policy)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	settlementSeq, err := k.SettlementSequence.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	var settlements []types.LeaseSettlement
+	err = k.LeaseSettlements.Walk(ctx, nil, func(_ collections.Pair[string, uint64], s types.LeaseSettlement) (bool, error) {
+		settlements = append(settlements, s)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var stats []types.ProviderStats
+	err = k.ProviderStats.Walk(ctx, nil, func(_ string, s types.ProviderStats) (bool, error) {
+		stats = append(stats, s)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	promoGrantSeq, err := k.PromoGrantSequence.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	var promoGrants []types.PromoGrant
+	err = k.PromoGrants.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, uint64], grant types.PromoGrant) (bool, error) {
+		promoGrants = append(promoGrants, grant)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var exchangeRates []types.ExchangeRate
+	err = k.ExchangeRates.Walk(ctx, nil, func(_ string, rate types.ExchangeRate) (bool, error) {
+		exchangeRates = append(exchangeRates, rate)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	creditDeposits, err := k.GetAllCreditDeposits(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
-		Params:         params,
-		Leases:         leases,
-		CreditAccounts: creditAccounts,
-		LeaseSequence:  leaseSeq,
+		Params:             params,
+		Leases:             leases,
+		CreditAccounts:     creditAccounts,
+		LeaseSequence:      leaseSeq,
+		LeaseAmendments:    amendments,
+		LeaseUsages:        usages,
+		Disputes:           disputes,
+		ProviderPolicies:   policies,
+		ProviderStats:      stats,
+		LeaseSettlements:   settlements,
+		SettlementSequence: settlementSeq,
+		PromoGrants:        promoGrants,
+		PromoGrantSequence: promoGrantSeq,
+		ExchangeRates:      exchangeRates,
+		CreditDeposits:     creditDeposits,
 	}
 }
 
@@ -404,13 +815,15 @@ func (k *Keeper) GetLease(ctx context.Context, uuid string) (types.Lease, error)
 }
 
 // SetLease sets a Lease in the store and reconciles all derived indexes.
-// The SKU index update is idempotent. The custom_domain reverse index is
+// The SKU index update is idempotent; entries for SKUs that no longer appear in
+// the lease (after an amendment removed them) are deleted. The custom_domain reverse index is
 // reconciled per-item from (lease.State, item.CustomDomain) for each item: if
 // the lease is editable (PENDING or ACTIVE) and the item's CustomDomain is
 // non-empty, an index entry points the domain at (lease.Uuid, item.ServiceName);
 // otherwise the entry (if any) is removed. This collapses lifecycle cleanup
 // into a single rule and removes the need for callers to clear index entries
-// around state transitions.
+// around state transitions. SKUAllocations follows the same rule: an editable
+// lease holds its item quantities, any other lease holds none.
 //
 // The previous lease (if any) is read once to detect renames (the old domain
 // must be released even if the new domain belongs to the same item) and to
@@ -435,17 +848,45 @@ func (k *Keeper) SetLease(ctx context.Context, lease types.Lease) error {
 		return err
 	}
 
+	current := make(map[string]bool, len(lease.Items))
 	for _, item := range lease.Items {
+		current[item.SkuUuid] = true
 		key := collections.Join(item.SkuUuid, lease.Uuid)
 		if err := k.LeaseBySKUIndex.Set(cacheCtx, key, true); err != nil {
 			return err
 		}
 	}
+	if hadPrev {
+		for _, item := range prev.Items {
+			if current[item.SkuUuid] {
+				continue
+			}
+			if err := k.LeaseBySKUIndex.Remove(cacheCtx, collections.Join(item.SkuUuid, lease.Uuid)); err != nil {
+				return err
+			}
+		}
+	}
 
 	if err := k.reconcileCustomDomainIndex(cacheCtx, prev, hadPrev, lease); err != nil {
 		return err
 	}
 
+	if err := k.reconcileScheduledEndIndex(cacheCtx, prev, hadPrev, lease); err != nil {
+		return err
+	}
+
+	if err := k.reconcileGraceIndexes(cacheCtx, prev, hadPrev, lease); err != nil {
+		return err
+	}
+
+	if err := k.reconcileTerminalIndex(cacheCtx, prev, hadPrev, lease); err != nil {
+		return err
+	}
+
+	if err := k.reconcileSKUAllocations(cacheCtx, prev, hadPrev, lease); err != nil {
+		return err
+	}
+
 	write()
 	return nil
 }
@@ -472,8 +913,8 @@ func (k *Keeper) getPreviousLease(ctx context.Context, uuid string) (types.Lease
 // if installing an entry would overwrite a claim by a different (lease, item)
 // — a defence-in-depth check above SetItemCustomDomain's pre-check.
 //
-// Items in lease.Items are immutable post-creation today, so the "item removed
-// in update" branch is theoretical but cheap to support.
+// Items leave lease.Items when an acknowledged amendment removes them; their
+// domains are released by the same diff.
 func (k *Keeper) reconcileCustomDomainIndex(ctx context.Context, prev types.Lease, hadPrev bool, lease types.Lease) error {
 	editable := lease.State == types.LEASE_STATE_PENDING || lease.State == types.LEASE_STATE_ACTIVE
 
@@ -711,6 +1152,30 @@ func (k *Keeper) SetCreditAccount(ctx context.Context, ca types.CreditAccount) e
 	return k.CreditAddressIndex.Set(ctx, derivedAddr, tenantAddr)
 }
 
+// getOrCreateCreditAccount returns the tenant's CreditAccount, or a new empty
+// one for creditAddr if the tenant has none yet. A new account is not stored;
+// the caller persists it with SetCreditAccount. The credit address is
+// registered in the account keeper if needed.
+func (k *Keeper) getOrCreateCreditAccount(ctx context.Context, tenant string, creditAddr sdk.AccAddress) (types.CreditAccount, error) {
+	creditAccount, err := k.GetCreditAccount(ctx, tenant)
+	if err == nil {
+		return creditAccount, nil
+	}
+	if !errors.Is(err, types.ErrCreditAccountNotFound) {
+		return types.CreditAccount{}, types.ErrInvalidCreditOperation.Wrapf("failed to get credit account: %s", err)
+	}
+
+	if k.accountKeeper.GetAccount(ctx, creditAddr) == nil {
+		acc := k.accountKeeper.NewAccountWithAddress(ctx, creditAddr)
+		k.accountKeeper.SetAccount(ctx, acc)
+	}
+
+	return types.CreditAccount{
+		Tenant:        tenant,
+		CreditAddress: creditAddr.String(),
+	}, nil
+}
+
 // GetAllCreditAccounts returns all CreditAccounts in the store.
 func (k *Keeper) GetAllCreditAccounts(ctx context.Context) ([]types.CreditAccount, error) {
 	var accounts []types.CreditAccount
@@ -857,31 +1322,35 @@ func (k *Keeper) getRelevantDenomsForTenant(ctx context.Context, tenant string,
 
 // CalculateWithdrawableForLease calculates the amounts that can be withdrawn from a lease.
 // It considers the time since last settlement and the credit balance available.
-// Returns a Coins collection (one entry per denom).
+// Returns a Coins collection (one entry per denom). Nothing can be withdrawn
+// from a disputed lease.
 func (k *Keeper) CalculateWithdrawableForLease(ctx context.Context, lease types.Lease) sdk.Coins {
+	if lease.Disputed {
+		return sdk.NewCoins()
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	blockTime := sdkCtx.BlockTime()
 
-	// Calculate duration since last settlement
-	var duration time.Duration
+	// Accrual runs from the last settlement until now
+	var until time.Time
 	if lease.State == types.LEASE_STATE_ACTIVE {
-		duration = blockTime.Sub(lease.LastSettledAt)
+		until = blockTime
 	} else {
-		// For inactive leases, calculate from last settled to closed
+		// For inactive leases, until the lease closed
 		if lease.ClosedAt != nil {
-			duration = lease.ClosedAt.Sub(lease.LastSettledAt)
+			until = *lease.ClosedAt
 		} else {
 			return sdk.NewCoins()
 		}
 	}
 
-	if duration <= 0 {
+	if !until.After(lease.LastSettledAt) {
 		return sdk.NewCoins()
 	}
 
 	// Calculate total accrued with overflow handling
-	items := LeaseItemsToWithPrice(lease.Items)
-	accruedAmounts, err := CalculateTotalAccruedForLease(items, duration)
+	accruedAmounts, _, err := CalculateLeaseAccrual(lease, until)
 	if err != nil {
 		// Log overflow error and return empty
 		k.logger.Error("accrual calculation overflow in withdrawable calculation",
@@ -923,9 +1392,15 @@ func (k *Keeper) CalculateWithdrawableForLease(ctx context.Context, lease types.
 }
 
 // ShouldAutoCloseLease checks if a lease should be auto-closed due to exhausted credit.
-// This implements "lazy evaluation" / "check on touch" pattern.
+// This implements the "check on touch" pattern used by message handlers; the
+// EndBlocker instead relies on the projected exhaustion times in CreditExhaustion
+// (see closeExhaustedLeases) so it never scans all leases.
 // Returns true if the lease should be closed, along with the close time to use.
-// This is O(1) per lease check, avoiding O(n) scanning of all leases in EndBlock.
+//
+// A lease in its grace period is not closed for exhausted credit: it closes
+// once block time reaches grace_ends_at, at that time. Callers that honour the
+// grace period call startGracePeriodIfExhausted first, so an exhausted lease
+// whose provider grants grace enters it instead of being reported here.
 //
 // IMPORTANT: This function does NOT modify any state. The caller is responsible for:
 // 1. Calling PerformSettlementSilent to settle the lease
@@ -943,9 +1418,30 @@ func (k *Keeper) ShouldAutoCloseLease(ctx context.Context, lease *types.Lease) (
 		return false, time.Time{}, nil
 	}
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	blockTime := sdkCtx.BlockTime()
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
 
+	if lease.GraceEndsAt != nil {
+		if blockTime.Before(*lease.GraceEndsAt) {
+			return false, time.Time{}, nil
+		}
+		return true, graceCloseTime(lease), nil
+	}
+
+	exhausted, err := k.isCreditExhausted(ctx, lease, blockTime)
+	if err != nil || !exhausted {
+		return false, time.Time{}, err
+	}
+
+	return true, blockTime, nil
+}
+
+// isCreditExhausted reports whether the accruals of an ACTIVE lease since its
+// last settlement, up to blockTime, reach the tenant's balance in any of the
+// lease's denoms. Promotional credit that can pay for the lease counts
+// towards the balance. When every denom the lease bills in has an exchange
+// rate, the lease is only exhausted once the tenant's convertible credit as a
+// whole is worth no more than the accruals.
+func (k *Keeper) isCreditExhausted(ctx context.Context, lease *types.Lease, blockTime time.Time) (bool, error) {
 	// Calculate duration since last settlement
 	duration := blockTime.Sub(lease.LastSettledAt)
 	if duration < 0 {
@@ -959,25 +1455,32 @@ func (k *Keeper) ShouldAutoCloseLease(ctx context.Context, lease *types.Lease) (
 			"block_time", blockTime,
 			"difference", -duration,
 		)
-		return false, time.Time{}, types.ErrInvalidLease.Wrapf(
+		return false, types.ErrInvalidLease.Wrapf(
 			"lease %s has LastSettledAt (%s) in the future relative to block time (%s)",
 			lease.Uuid, lease.LastSettledAt, blockTime,
 		)
 	}
 
-	// Check tenant's credit balances for only the lease's denoms
+	// Check tenant's credit balances for only the lease's denoms, plus the
+	// promotional credit that can pay for the lease.
 	creditBalances, err := k.getCreditBalancesForDenoms(ctx, lease.Tenant, leaseItemDenoms(lease.Items))
 	if err != nil {
-		return false, time.Time{}, err
+		return false, err
 	}
+	grants, err := k.GetSpendablePromoGrants(ctx, lease.Tenant)
+	if err != nil {
+		return false, err
+	}
+	promo := promoBalanceForLeases(grants, []types.Lease{*lease})
+	creditBalances = creditBalances.Add(promo...)
 
 	// Calculate what would be accrued for each denom
-	items := LeaseItemsToWithPrice(lease.Items)
+	accruedAmounts := sdk.NewCoins()
 
 	// If duration is zero, no accrual - check if any balance is exhausted
-	shouldClose = false
+	exhausted := false
 	if duration > 0 {
-		accruedAmounts, calcErr := CalculateTotalAccruedForLease(items, duration)
+		accrued, _, calcErr := CalculateLeaseAccrual(*lease, blockTime)
 		if calcErr != nil {
 			// Overflow in accrual calculation means the accrued amount is extremely large,
 			// which certainly exceeds any credit balance. Defensively close the lease.
@@ -987,33 +1490,41 @@ func (k *Keeper) ShouldAutoCloseLease(ctx context.Context, lease *types.Lease) (
 				"duration", duration.String(),
 				"error", calcErr,
 			)
-			shouldClose = true
-		} else {
-			// Check if any denom's accrued amount exceeds the balance
-			for _, accrued := range accruedAmounts {
-				balance := creditBalances.AmountOf(accrued.Denom)
-				if accrued.Amount.GTE(balance) {
-					shouldClose = true
-					break
-				}
+			return true, nil
+		}
+		accruedAmounts = accrued
+		// Check if any denom's accrued amount exceeds the balance
+		for _, accrued := range accruedAmounts {
+			balance := creditBalances.AmountOf(accrued.Denom)
+			if accrued.Amount.GTE(balance) {
+				exhausted = true
+				break
 			}
 		}
 	} else {
 		// Check if any required denom balance is zero
 		for _, item := range lease.Items {
+			if item.Metered {
+				continue
+			}
 			balance := creditBalances.AmountOf(item.LockedPrice.Denom)
 			if balance.IsZero() {
-				shouldClose = true
+				exhausted = true
 				break
 			}
 		}
 	}
-
-	if !shouldClose {
-		return false, time.Time{}, nil
+	if !exhausted {
+		return false, nil
 	}
 
-	return true, blockTime, nil
+	// Credit in other denoms keeps the lease running while, at the current
+	// exchange rates, it is still worth more than the accruals.
+	covered, err := k.exceedsByConversion(ctx, lease.Tenant, rateDenoms(leaseItemRates(lease.Items)), accruedAmounts, promo)
+	if err != nil {
+		return false, err
+	}
+	return !covered, nil
 }
 
 // AutoCloseLeaseResult holds the result of an auto-close operation.
@@ -1023,10 +1534,17 @@ type AutoCloseLeaseResult struct {
 
 // AutoCloseLease performs the auto-close sequence for a lease with exhausted credit.
 // It settles the lease, updates its state to CLOSED, decrements the active lease count,
-// and releases the reservation. All changes are applied to the provided context.
+// and releases the reservation (including that of a pending amendment). All changes are applied to the provided context.
 // The caller is responsible for CacheContext management and event emission.
 func (k *Keeper) AutoCloseLease(ctx context.Context, lease *types.Lease, closeTime time.Time, minLeaseDuration uint64) (*AutoCloseLeaseResult, error) {
-	result, err := k.PerformSettlementSilent(ctx, lease, closeTime)
+	return k.closeLeaseAt(ctx, lease, closeTime, minLeaseDuration, types.ClosureReasonCreditExhausted)
+}
+
+// closeLeaseAt settles an ACTIVE lease up to closeTime and closes it with the
+// given reason. It is shared by the module-initiated closures (credit
+// exhaustion and scheduled end).
+func (k *Keeper) closeLeaseAt(ctx context.Context, lease *types.Lease, closeTime time.Time, minLeaseDuration uint64, reason string) (*AutoCloseLeaseResult, error) {
+	result, err := k.PerformSettlementSilent(ctx, lease, closeTime, types.SETTLEMENT_TRIGGER_AUTO_CLOSE)
 	if err != nil {
 		return nil, err
 	}
@@ -1034,7 +1552,7 @@ func (k *Keeper) AutoCloseLease(ctx context.Context, lease *types.Lease, closeTi
 	lease.State = types.LEASE_STATE_CLOSED
 	lease.ClosedAt = &closeTime
 	lease.LastSettledAt = closeTime
-	lease.ClosureReason = types.ClosureReasonCreditExhausted
+	lease.ClosureReason = reason
 
 	if err := k.SetLease(ctx, *lease); err != nil {
 		return nil, err
@@ -1047,11 +1565,18 @@ func (k *Keeper) AutoCloseLease(ctx context.Context, lease *types.Lease, closeTi
 
 	k.DecrementActiveLeaseCount(&creditAccount, lease.Uuid)
 	k.ReleaseLeaseReservation(&creditAccount, lease, minLeaseDuration)
+	if _, err := k.releaseLeaseAmendment(ctx, &creditAccount, lease.Uuid); err != nil {
+		return nil, err
+	}
 
 	if err := k.SetCreditAccount(ctx, creditAccount); err != nil {
 		return nil, err
 	}
 
+	if err := k.Hooks().AfterLeaseClosed(ctx, *lease); err != nil {
+		return nil, err
+	}
+
 	return &AutoCloseLeaseResult{TransferAmounts: result.TransferAmounts}, nil
 }
 
@@ -1308,6 +1833,10 @@ func (k *Keeper) ExpirePendingLease(ctx context.Context, lease *types.Lease) err
 		return err
 	}
 
+	if err := k.Hooks().AfterLeaseExpired(cacheCtx, *lease); err != nil {
+		return err
+	}
+
 	// Commit all state changes atomically
 	write()
 
@@ -1331,10 +1860,12 @@ func (k *Keeper) ExpirePendingLease(ctx context.Context, lease *types.Lease) err
 	return nil
 }
 
-// EndBlocker processes pending lease expirations.
+// EndBlocker processes pending lease expirations, scheduled, grace-expiry and
+// credit-exhaustion lease closures, lease amendment expirations, and the
+// pruning of terminal leases past their retention period.
 // It uses an iterator to process leases one-by-one without loading all into memory,
 // preventing DoS attacks from large numbers of pending leases.
-// Rate limited to MaxPendingLeaseExpirationsPerBlock expirations per block.
+// Each pass is rate limited by its own per-block maximum.
 func (k *Keeper) EndBlocker(ctx context.Context) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	blockTime := sdkCtx.BlockTime()
@@ -1344,9 +1875,9 @@ func (k *Keeper) EndBlocker(ctx context.Context) error {
 		return err
 	}
 
-	// Get pending timeout duration
-	// #nosec G115 -- PendingTimeout is validated in params to be within safe bounds (60-86400 seconds)
-	pendingTimeout := time.Duration(params.PendingTimeout) * time.Second
+	// Provider policies may override params.pending_timeout for their own
+	// leases and amendments; the overrides are looked up once per provider.
+	providerTimeouts := make(map[string]time.Duration)
 
 	// Collect pending lease UUIDs that need expiration first, then process them.
 	// This two-pass approach avoids iterator invalidation: when ExpirePendingLease
@@ -1387,8 +1918,16 @@ func (k *Keeper) EndBlocker(ctx context.Context) error {
 			continue
 		}
 
-		// Check if lease has exceeded pending timeout
-		expirationTime := lease.CreatedAt.Add(pendingTimeout)
+		// Check if lease has exceeded its provider's pending timeout
+		timeout, err := k.cachedPendingTimeout(ctx, providerTimeouts, lease.ProviderUuid, params.PendingTimeout)
+		if err != nil {
+			k.logger.Error("failed to get provider pending timeout",
+				"provider_uuid", lease.ProviderUuid,
+				"error", err,
+			)
+			continue
+		}
+		expirationTime := lease.CreatedAt.Add(timeout)
 		if blockTime.After(expirationTime) {
 			expiredUUIDs = append(expiredUUIDs, leaseUUID)
 		}
@@ -1434,5 +1973,33 @@ func (k *Keeper) EndBlocker(ctx context.Context) error {
 		)
 	}
 
-	return nil
+	if err := k.closeScheduledLeases(ctx, params.MinLeaseDuration); err != nil {
+		return err
+	}
+
+	if err := k.closeGraceExpiredLeases(ctx, params.MinLeaseDuration); err != nil {
+		return err
+	}
+
+	// Runs after scheduled closures so a lease that reached its end is settled
+	// up to scheduled_end_at rather than closed for lack of credit.
+	if err := k.closeExhaustedLeases(ctx, params.MinLeaseDuration); err != nil {
+		return err
+	}
+
+	// Amendments follow the pending timeout of their provider, counted from
+	// the time they were proposed.
+	if err := k.expireLeaseAmendments(ctx, params.PendingTimeout, providerTimeouts); err != nil {
+		return err
+	}
+
+	if err := k.defaultUnansweredDisputes(ctx); err != nil {
+		return err
+	}
+
+	if err := k.reclaimExpiredPromoGrants(ctx); err != nil {
+		return err
+	}
+
+	return k.pruneTerminalLeases(ctx, params.LeaseRetentionPeriod)
 }
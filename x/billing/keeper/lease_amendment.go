@@ -0,0 +1,550 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/pkg/sanitize"
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+)
+
+// LeaseAmendmentResult holds the outcome of AcknowledgeLeaseAmendment for the
+// msg server response and events.
+type LeaseAmendmentResult struct {
+	Lease          types.Lease
+	Amendment      types.LeaseAmendment
+	SettledAmounts sdk.Coins
+	PreviousRate   sdk.DecCoins
+	NewRate        sdk.DecCoins
+}
+
+// GetLeaseAmendment returns the pending amendment of a lease.
+func (k *Keeper) GetLeaseAmendment(ctx context.Context, leaseUUID string) (types.LeaseAmendment, error) {
+	amendment, err := k.LeaseAmendments.Get(ctx, leaseUUID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.LeaseAmendment{}, types.ErrLeaseAmendmentNotFound.Wrapf("lease %s has no pending amendment", leaseUUID)
+		}
+		return types.LeaseAmendment{}, err
+	}
+	return amendment, nil
+}
+
+// amendLeaseItems returns the item list that results from dropping the items
+// addressed by removeKeys and appending added. The result must still be a
+// valid lease: non-empty, in a single service_name mode, with a unique
+// addressing key per item. A legacy lease that holds a custom_domain cannot
+// grow past one item, because the domain could no longer be addressed.
+func amendLeaseItems(lease types.Lease, added []types.LeaseItem, removeKeys []string) ([]types.LeaseItem, error) {
+	serviceMode := len(lease.Items) > 0 && lease.Items[0].ServiceName != ""
+
+	removed := make(map[int]bool, len(removeKeys))
+	for _, key := range removeKeys {
+		idx := -1
+		for i, item := range lease.Items {
+			if (serviceMode && item.ServiceName == key) || (!serviceMode && item.SkuUuid == key) {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			if serviceMode {
+				return nil, types.ErrLeaseItemNotFound.Wrapf("lease %s has no item with service_name %q", lease.Uuid, key)
+			}
+			return nil, types.ErrLeaseItemNotFound.Wrapf("lease %s has no item with sku_uuid %s", lease.Uuid, key)
+		}
+		removed[idx] = true
+	}
+
+	result := make([]types.LeaseItem, 0, len(lease.Items)-len(removed)+len(added))
+	for i, item := range lease.Items {
+		if !removed[i] {
+			result = append(result, item)
+		}
+	}
+	for _, item := range added {
+		if serviceMode != (item.ServiceName != "") {
+			return nil, types.ErrInvalidServiceName.Wrapf(
+				"lease %s service_name mode does not match added item for sku_uuid %s", lease.Uuid, item.SkuUuid,
+			)
+		}
+		result = append(result, item)
+	}
+
+	if len(result) == 0 {
+		return nil, types.ErrEmptyLeaseItems.Wrapf("amendment would remove every item from lease %s; close the lease instead", lease.Uuid)
+	}
+
+	// Reuse the creation rules for uniqueness and the hard item limit.
+	inputs := make([]types.LeaseItemInput, len(result))
+	for i, item := range result {
+		inputs[i] = types.LeaseItemInput{SkuUuid: item.SkuUuid, Quantity: item.Quantity, ServiceName: item.ServiceName}
+	}
+	if err := types.ValidateLeaseItems(inputs); err != nil {
+		return nil, err
+	}
+
+	if !serviceMode && len(result) > 1 {
+		for _, item := range result {
+			if item.CustomDomain != "" {
+				return nil, types.ErrAmbiguousLeaseItem.Wrapf(
+					"lease %s is in legacy mode and item %s holds custom_domain %q; clear it before adding items",
+					lease.Uuid, item.SkuUuid, item.CustomDomain,
+				)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// priceAmendmentItems resolves added item inputs against the SKU catalog,
+// locking each at the SKU's current price for the tenant's committed volume
+// tier, which includes the lease's current items. Every SKU must be active and
+// belong to the lease's provider, which must itself be active.
+func (k *Keeper) priceAmendmentItems(ctx context.Context, lease types.Lease, inputs []types.LeaseItemInput) ([]types.LeaseItem, error) {
+	if len(inputs) == 0 {
+		return nil, nil
+	}
+
+	provider, err := k.skuKeeper.GetProvider(ctx, lease.ProviderUuid)
+	if err != nil {
+		return nil, types.ErrProviderNotFound.Wrapf("provider_uuid %s not found", lease.ProviderUuid)
+	}
+	if !provider.Active {
+		return nil, types.ErrProviderNotActive.Wrapf("provider_uuid %s is not active", lease.ProviderUuid)
+	}
+
+	committed, err := k.committedQuantities(ctx, lease.Tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]types.LeaseItem, 0, len(inputs))
+	for _, input := range inputs {
+		sku, err := k.skuKeeper.GetSKU(ctx, input.SkuUuid)
+		if err != nil {
+			return nil, types.ErrSKUNotFound.Wrapf("sku_uuid %s not found", input.SkuUuid)
+		}
+		if !sku.Active {
+			return nil, types.ErrSKUNotActive.Wrapf("sku_uuid %s is not active", input.SkuUuid)
+		}
+		if sku.ProviderUuid != lease.ProviderUuid {
+			return nil, types.ErrMixedProviders.Wrapf(
+				"sku_uuid %s belongs to provider %s, expected provider %s",
+				input.SkuUuid,
+				sku.ProviderUuid,
+				lease.ProviderUuid,
+			)
+		}
+
+		item, err := LockItemPrice(volumePrice(sku, input.Quantity, committed), sku.Unit)
+		if err != nil {
+			return nil, types.ErrSKUNotFound.Wrapf("invalid SKU pricing: %s", err)
+		}
+		item.SkuUuid = input.SkuUuid
+		item.Quantity = input.Quantity
+		item.ServiceName = input.ServiceName
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// ProposeLeaseAmendment records a pending amendment on an ACTIVE lease owned
+// by tenant. Added items are priced at the current SKU price and their credit
+// is reserved immediately, using the lease's min_lease_duration_at_creation so
+// the reservation folds cleanly into the lease's own on acknowledgement.
+// Removals reserve nothing; their reservation is released when the amendment
+// is acknowledged. Added quantities must fit the SKUs' capacity, but do not
+// hold it until acknowledgement.
+func (k *Keeper) ProposeLeaseAmendment(ctx context.Context, tenant, leaseUUID string, addItems []types.LeaseItemInput, removeItems []string) (*types.LeaseAmendment, error) {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	lease, err := k.GetLease(ctx, leaseUUID)
+	if err != nil {
+		return nil, err
+	}
+	if lease.Tenant != tenant {
+		return nil, types.ErrUnauthorized.Wrapf("sender %s is not the tenant of lease %s", tenant, leaseUUID)
+	}
+	if lease.State != types.LEASE_STATE_ACTIVE {
+		return nil, types.ErrLeaseNotActive.Wrapf("lease %s is not active", leaseUUID)
+	}
+
+	pending, err := k.LeaseAmendments.Has(ctx, leaseUUID)
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return nil, types.ErrLeaseAmendmentPending.Wrapf("lease %s already has a pending amendment", leaseUUID)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	added, err := k.priceAmendmentItems(ctx, lease, addItems)
+	if err != nil {
+		return nil, err
+	}
+
+	newItems, err := amendLeaseItems(lease, added, removeItems)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(newItems)) > params.MaxItemsPerLease {
+		return nil, types.ErrTooManyLeaseItems.Wrapf(
+			"amended lease would have %d items, maximum allowed is %d",
+			len(newItems),
+			params.MaxItemsPerLease,
+		)
+	}
+	// Capacity is only allocated on acknowledgement, where it is checked
+	// again; checking here turns away amendments that cannot fit today.
+	if err := k.requireSKUCapacity(ctx, lease.Items, newItems); err != nil {
+		return nil, err
+	}
+
+	duration := lease.MinLeaseDurationAtCreation
+	if duration == 0 {
+		duration = params.MinLeaseDuration
+	}
+	reservation := types.CalculateLeaseReservation(added, duration)
+
+	creditAccount, err := k.GetCreditAccount(ctx, tenant)
+	if err != nil {
+		return nil, types.ErrCreditAccountNotFound.Wrapf("tenant %s has no credit account", tenant)
+	}
+	if err := k.requireAvailableCredit(ctx, creditAccount, reservation); err != nil {
+		return nil, err
+	}
+	creditAccount.ReservedAmounts = types.AddReservation(creditAccount.ReservedAmounts, reservation)
+
+	amendment := types.LeaseAmendment{
+		LeaseUuid:       lease.Uuid,
+		Tenant:          lease.Tenant,
+		ProviderUuid:    lease.ProviderUuid,
+		AddItems:        added,
+		RemoveItems:     removeItems,
+		ReservedAmounts: reservation,
+		CreatedAt:       blockTime,
+	}
+
+	if err := k.SetCreditAccount(ctx, creditAccount); err != nil {
+		return nil, err
+	}
+	if err := k.SetLeaseAmendment(ctx, amendment); err != nil {
+		return nil, err
+	}
+
+	return &amendment, nil
+}
+
+// AcknowledgeLeaseAmendment applies the pending amendment of an ACTIVE lease.
+// The lease is settled up to block time with its old items, then its item list
+// is replaced and its reservation recomputed; the amendment's own reservation
+// is folded in rather than checked again. SKU capacity is checked again, since
+// other leases may have taken it since the proposal. SetLease keeps
+// LeaseBySKUIndex, CustomDomainIndex and SKUAllocations in step with the new
+// item list, releasing any domain held by a removed item. Caller authorization is checked by the msg server.
+func (k *Keeper) AcknowledgeLeaseAmendment(ctx context.Context, leaseUUID string) (*LeaseAmendmentResult, error) {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	amendment, err := k.GetLeaseAmendment(ctx, leaseUUID)
+	if err != nil {
+		return nil, err
+	}
+	lease, err := k.GetLease(ctx, leaseUUID)
+	if err != nil {
+		return nil, err
+	}
+	if lease.State != types.LEASE_STATE_ACTIVE {
+		return nil, types.ErrLeaseNotActive.Wrapf("lease %s is not active", leaseUUID)
+	}
+
+	// Re-apply against the current items: quantities may have changed since
+	// the amendment was proposed.
+	newItems, err := amendLeaseItems(lease, amendment.AddItems, amendment.RemoveItems)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.requireSKUCapacity(ctx, lease.Items, newItems); err != nil {
+		return nil, err
+	}
+
+	shouldClose, _, err := k.ShouldAutoCloseLease(ctx, &lease)
+	if err != nil {
+		return nil, err
+	}
+	if shouldClose || lease.GraceEndsAt != nil {
+		return nil, types.ErrInsufficientCredit.Wrapf("credit for lease %s is exhausted; the amendment cannot be applied", leaseUUID)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settlement, err := k.PerformSettlement(ctx, &lease, blockTime, types.SETTLEMENT_TRIGGER_ITEMS_CHANGED)
+	if err != nil {
+		return nil, err
+	}
+
+	creditAccount, err := k.GetCreditAccount(ctx, lease.Tenant)
+	if err != nil {
+		return nil, types.ErrCreditAccountNotFound.Wrapf("tenant %s has no credit account", lease.Tenant)
+	}
+
+	updated := lease
+	updated.Items = newItems
+	updated.LastSettledAt = blockTime
+
+	k.ReleaseLeaseReservation(&creditAccount, &lease, params.MinLeaseDuration)
+	if _, err := k.releaseLeaseAmendment(ctx, &creditAccount, leaseUUID); err != nil {
+		return nil, err
+	}
+	creditAccount.ReservedAmounts = types.AddReservation(
+		creditAccount.ReservedAmounts,
+		types.GetLeaseReservationAmount(&updated, params.MinLeaseDuration),
+	)
+
+	if err := k.SetLease(ctx, updated); err != nil {
+		return nil, err
+	}
+	if err := k.SetCreditAccount(ctx, creditAccount); err != nil {
+		return nil, err
+	}
+	if err := k.refreshCreditExhaustion(ctx, lease.Tenant); err != nil {
+		return nil, err
+	}
+
+	return &LeaseAmendmentResult{
+		Lease:          updated,
+		Amendment:      amendment,
+		SettledAmounts: settlement.TransferAmounts,
+		PreviousRate:   leaseItemRates(lease.Items),
+		NewRate:        leaseItemRates(newItems),
+	}, nil
+}
+
+// RejectLeaseAmendment drops the pending amendment of a lease and releases its
+// reservation. Caller authorization is checked by the msg server.
+func (k *Keeper) RejectLeaseAmendment(ctx context.Context, leaseUUID string) (types.LeaseAmendment, error) {
+	amendment, err := k.GetLeaseAmendment(ctx, leaseUUID)
+	if err != nil {
+		return types.LeaseAmendment{}, err
+	}
+	if err := k.dropLeaseAmendment(ctx, amendment); err != nil {
+		return types.LeaseAmendment{}, err
+	}
+	return amendment, nil
+}
+
+// dropLeaseAmendment deletes amendment and releases its reservation from the
+// tenant's credit account.
+func (k *Keeper) dropLeaseAmendment(ctx context.Context, amendment types.LeaseAmendment) error {
+	creditAccount, err := k.GetCreditAccount(ctx, amendment.Tenant)
+	if err != nil {
+		return err
+	}
+	if _, err := k.releaseLeaseAmendment(ctx, &creditAccount, amendment.LeaseUuid); err != nil {
+		return err
+	}
+	return k.SetCreditAccount(ctx, creditAccount)
+}
+
+// releaseLeaseAmendment deletes the pending amendment of leaseUUID, if any, and
+// subtracts its reservation from ca. The caller persists ca. Lease close paths
+// call this so an amendment never outlives its lease.
+func (k *Keeper) releaseLeaseAmendment(ctx context.Context, ca *types.CreditAccount, leaseUUID string) (bool, error) {
+	amendment, err := k.LeaseAmendments.Get(ctx, leaseUUID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if underflows := types.CheckReservationRelease(ca.ReservedAmounts, amendment.ReservedAmounts); len(underflows) > 0 {
+		k.logger.Warn("data inconsistency: amendment reservation release would underflow",
+			"tenant", ca.Tenant,
+			"lease_uuid", leaseUUID,
+			"underflows", underflows,
+			"current_reserved", ca.ReservedAmounts.String(),
+			"attempting_to_release", amendment.ReservedAmounts.String(),
+		)
+	}
+	ca.ReservedAmounts = types.SubtractReservation(ca.ReservedAmounts, amendment.ReservedAmounts)
+
+	if err := k.removeLeaseAmendment(ctx, amendment); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// SetLeaseAmendment stores an amendment and keeps LeaseAmendmentsByCreatedAt
+// in sync with it.
+func (k *Keeper) SetLeaseAmendment(ctx context.Context, amendment types.LeaseAmendment) error {
+	prev, err := k.LeaseAmendments.Get(ctx, amendment.LeaseUuid)
+	switch {
+	case err == nil:
+		if err := k.LeaseAmendmentsByCreatedAt.Remove(ctx, collections.Join(prev.CreatedAt, prev.LeaseUuid)); err != nil {
+			return err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+	if err := k.LeaseAmendmentsByCreatedAt.Set(ctx, collections.Join(amendment.CreatedAt, amendment.LeaseUuid)); err != nil {
+		return err
+	}
+	return k.LeaseAmendments.Set(ctx, amendment.LeaseUuid, amendment)
+}
+
+// removeLeaseAmendment deletes amendment together with its index entry.
+func (k *Keeper) removeLeaseAmendment(ctx context.Context, amendment types.LeaseAmendment) error {
+	if err := k.LeaseAmendmentsByCreatedAt.Remove(ctx, collections.Join(amendment.CreatedAt, amendment.LeaseUuid)); err != nil {
+		return err
+	}
+	return k.LeaseAmendments.Remove(ctx, amendment.LeaseUuid)
+}
+
+// expireLeaseAmendments drops amendments that have been pending for longer
+// than the pending timeout of their provider, at most
+// MaxLeaseAmendmentExpirationsPerBlock per call. defaultTimeout and
+// providerTimeouts are passed to cachedPendingTimeout. Only amendments older
+// than MinPendingTimeout, the shortest timeout a provider can have, are read,
+// by ranging LeaseAmendmentsByCreatedAt up to blockTime - MinPendingTimeout.
+// Like pending-lease expiry it collects first and mutates second, and each
+// expiry is applied in its own CacheContext so one failure does not block
+// the rest.
+func (k *Keeper) expireLeaseAmendments(ctx context.Context, defaultTimeout uint64, providerTimeouts map[string]time.Duration) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+	// #nosec G115 -- MinPendingTimeout is a small constant
+	cutoff := blockTime.Add(-time.Duration(types.MinPendingTimeout) * time.Second)
+
+	iter, err := k.LeaseAmendmentsByCreatedAt.Iterate(ctx, collections.NewPrefixUntilPairRange[time.Time, string](cutoff))
+	if err != nil {
+		return err
+	}
+
+	var expired []types.LeaseAmendment
+	for ; iter.Valid(); iter.Next() {
+		if len(expired) >= types.MaxLeaseAmendmentExpirationsPerBlock {
+			break
+		}
+		key, err := iter.Key()
+		if err != nil {
+			k.logger.Error("failed to decode lease amendment index key", "error", err)
+			continue
+		}
+		if !key.K1().Before(cutoff) {
+			continue
+		}
+		amendment, err := k.LeaseAmendments.Get(ctx, key.K2())
+		if err != nil {
+			k.logger.Error("failed to get lease amendment",
+				"lease_uuid", key.K2(),
+				"error", err,
+			)
+			continue
+		}
+		timeout, err := k.cachedPendingTimeout(ctx, providerTimeouts, amendment.ProviderUuid, defaultTimeout)
+		if err != nil {
+			k.logger.Error("failed to get provider pending timeout",
+				"provider_uuid", amendment.ProviderUuid,
+				"error", err,
+			)
+			continue
+		}
+		// An amendment expires once strictly more than its timeout has passed.
+		if !amendment.CreatedAt.Before(blockTime.Add(-timeout)) {
+			continue
+		}
+		expired = append(expired, amendment)
+	}
+
+	if err := iter.Close(); err != nil {
+		k.logger.Error("failed to close iterator", "error", err)
+	}
+
+	for _, amendment := range expired {
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := k.dropLeaseAmendment(cacheCtx, amendment); err != nil {
+			k.logger.Error("failed to expire lease amendment",
+				"lease_uuid", amendment.LeaseUuid,
+				"error", err,
+			)
+			continue
+		}
+		write()
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeLeaseAmendmentExpired,
+				sdk.NewAttribute(types.AttributeKeyLeaseUUID, amendment.LeaseUuid),
+				sdk.NewAttribute(types.AttributeKeyTenant, amendment.Tenant),
+				sdk.NewAttribute(types.AttributeKeyProviderUUID, amendment.ProviderUuid),
+				sdk.NewAttribute(types.AttributeKeyReason, "pending_timeout"),
+			),
+		)
+	}
+
+	return nil
+}
+
+// emitLeaseAmendmentProposedEvent emits a lease_amendment_proposed event.
+func emitLeaseAmendmentProposedEvent(ctx sdk.Context, amendment *types.LeaseAmendment) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeLeaseAmendmentProposed,
+			sdk.NewAttribute(types.AttributeKeyLeaseUUID, amendment.LeaseUuid),
+			sdk.NewAttribute(types.AttributeKeyTenant, amendment.Tenant),
+			sdk.NewAttribute(types.AttributeKeyProviderUUID, amendment.ProviderUuid),
+			sdk.NewAttribute(types.AttributeKeyAddedCount, strconv.Itoa(len(amendment.AddItems))),
+			sdk.NewAttribute(types.AttributeKeyRemovedCount, strconv.Itoa(len(amendment.RemoveItems))),
+			sdk.NewAttribute(types.AttributeKeyReservedAmounts, amendment.ReservedAmounts.String()),
+		),
+	)
+}
+
+// emitLeaseAmendmentAcknowledgedEvent emits a lease_amendment_acknowledged event.
+func emitLeaseAmendmentAcknowledgedEvent(ctx sdk.Context, result *LeaseAmendmentResult, acknowledgedBy string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeLeaseAmendmentAcked,
+			sdk.NewAttribute(types.AttributeKeyLeaseUUID, result.Lease.Uuid),
+			sdk.NewAttribute(types.AttributeKeyTenant, result.Lease.Tenant),
+			sdk.NewAttribute(types.AttributeKeyProviderUUID, result.Lease.ProviderUuid),
+			sdk.NewAttribute(types.AttributeKeyAcknowledgedBy, acknowledgedBy),
+			sdk.NewAttribute(types.AttributeKeyAddedCount, strconv.Itoa(len(result.Amendment.AddItems))),
+			sdk.NewAttribute(types.AttributeKeyRemovedCount, strconv.Itoa(len(result.Amendment.RemoveItems))),
+			sdk.NewAttribute(types.AttributeKeyItemCount, strconv.Itoa(len(result.Lease.Items))),
+			sdk.NewAttribute(types.AttributeKeyPreviousRate, result.PreviousRate.String()),
+			sdk.NewAttribute(types.AttributeKeyTotalRate, result.NewRate.String()),
+			sdk.NewAttribute(types.AttributeKeySettledAmounts, result.SettledAmounts.String()),
+		),
+	)
+}
+
+// emitLeaseAmendmentRejectedEvent emits a lease_amendment_rejected event. The
+// reason is sanitized like lease rejection reasons.
+func emitLeaseAmendmentRejectedEvent(ctx sdk.Context, amendment types.LeaseAmendment, rejectedBy, reason string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeLeaseAmendmentRejected,
+			sdk.NewAttribute(types.AttributeKeyLeaseUUID, amendment.LeaseUuid),
+			sdk.NewAttribute(types.AttributeKeyTenant, amendment.Tenant),
+			sdk.NewAttribute(types.AttributeKeyProviderUUID, amendment.ProviderUuid),
+			sdk.NewAttribute(types.AttributeKeyRejectedBy, rejectedBy),
+			sdk.NewAttribute(types.AttributeKeyRejectionReason, sanitize.EventAttribute(reason)),
+		),
+	)
+}
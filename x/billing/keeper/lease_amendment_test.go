@@ -0,0 +1,371 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/keeper"
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+	skutypes "github.com/manifest-network/manifest-ledger/x/sku/types"
+)
+
+func TestLeaseAmendment_AddAndRemove(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	otherSKU := f.createTestSKU(t, s.provider.Uuid, 3600)
+
+	f.Ctx = f.Ctx.WithEventManager(sdk.NewEventManager())
+	proposeResp, err := s.msgServer.ProposeLeaseAmendment(f.Ctx, &types.MsgProposeLeaseAmendment{
+		Tenant:    s.tenant.String(),
+		LeaseUuid: s.leaseUUID,
+		AddItems:  []types.LeaseItemInput{{SkuUuid: otherSKU.Uuid, Quantity: 1}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(3600), proposeResp.ReservedAmounts.AmountOf(testDenom))
+	require.Equal(t, sdkmath.NewInt(7200+3600), s.reserved(t))
+	require.Equal(t, 1, countEvents(f.Ctx, types.EventTypeLeaseAmendmentProposed))
+
+	// The lease is untouched until the provider acknowledges.
+	lease, err := f.App.BillingKeeper.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Len(t, lease.Items, 1)
+
+	querier := keeper.NewQuerier(f.App.BillingKeeper)
+	qResp, err := querier.LeaseAmendment(f.Ctx, &types.QueryLeaseAmendmentRequest{LeaseUuid: s.leaseUUID})
+	require.NoError(t, err)
+	require.Equal(t, s.provider.Uuid, qResp.Amendment.ProviderUuid)
+	listResp, err := querier.LeaseAmendments(f.Ctx, &types.QueryLeaseAmendmentsRequest{ProviderUuid: s.provider.Uuid})
+	require.NoError(t, err)
+	require.Len(t, listResp.Amendments, 1)
+
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(100 * time.Second))
+	f.Ctx = f.Ctx.WithEventManager(sdk.NewEventManager())
+	ackResp, err := s.msgServer.AcknowledgeLeaseAmendment(f.Ctx, &types.MsgAcknowledgeLeaseAmendment{
+		Sender:    s.providerAddr.String(),
+		LeaseUuid: s.leaseUUID,
+	})
+	require.NoError(t, err)
+	require.Len(t, ackResp.Items, 2)
+	// The first 100s are settled on the old item list.
+	require.Equal(t, sdkmath.NewInt(200), ackResp.SettledAmounts.AmountOf(testDenom))
+	require.Equal(t, 1, countEvents(f.Ctx, types.EventTypeLeaseAmendmentAcked))
+
+	// The amendment's reservation is folded into the lease's.
+	require.Equal(t, sdkmath.NewInt(7200+3600), s.reserved(t))
+	_, err = f.App.BillingKeeper.GetLeaseAmendment(f.Ctx, s.leaseUUID)
+	require.ErrorIs(t, err, types.ErrLeaseAmendmentNotFound)
+
+	has, err := f.App.BillingKeeper.LeaseBySKUIndex.Has(f.Ctx, collections.Join(otherSKU.Uuid, s.leaseUUID))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// Removing the original SKU releases its reservation and index entry.
+	_, err = s.msgServer.ProposeLeaseAmendment(f.Ctx, &types.MsgProposeLeaseAmendment{
+		Tenant:      s.tenant.String(),
+		LeaseUuid:   s.leaseUUID,
+		RemoveItems: []string{s.sku.Uuid},
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(7200+3600), s.reserved(t))
+
+	_, err = s.msgServer.AcknowledgeLeaseAmendment(f.Ctx, &types.MsgAcknowledgeLeaseAmendment{
+		Sender:    s.providerAddr.String(),
+		LeaseUuid: s.leaseUUID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(3600), s.reserved(t))
+
+	lease, err = f.App.BillingKeeper.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Len(t, lease.Items, 1)
+	require.Equal(t, otherSKU.Uuid, lease.Items[0].SkuUuid)
+
+	has, err = f.App.BillingKeeper.LeaseBySKUIndex.Has(f.Ctx, collections.Join(s.sku.Uuid, s.leaseUUID))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestLeaseAmendment_RemoveReleasesCustomDomain(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+
+	leaseUUID := f.createAndAcknowledgeLease(t, s.msgServer, s.tenant, s.providerAddr, []types.LeaseItemInput{
+		{SkuUuid: s.sku.Uuid, Quantity: 1, ServiceName: "web"},
+		{SkuUuid: s.sku.Uuid, Quantity: 1, ServiceName: "db"},
+	})
+	_, err := f.App.BillingKeeper.SetItemCustomDomain(f.Ctx, s.tenant.String(), leaseUUID, "db", "db.example.com")
+	require.NoError(t, err)
+
+	_, err = s.msgServer.ProposeLeaseAmendment(f.Ctx, &types.MsgProposeLeaseAmendment{
+		Tenant:      s.tenant.String(),
+		LeaseUuid:   leaseUUID,
+		RemoveItems: []string{"db"},
+	})
+	require.NoError(t, err)
+	_, err = s.msgServer.AcknowledgeLeaseAmendment(f.Ctx, &types.MsgAcknowledgeLeaseAmendment{
+		Sender:    s.providerAddr.String(),
+		LeaseUuid: leaseUUID,
+	})
+	require.NoError(t, err)
+
+	has, err := f.App.BillingKeeper.CustomDomainIndex.Has(f.Ctx, "db.example.com")
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// The SKU is still referenced by "web", so its index entry stays.
+	has, err = f.App.BillingKeeper.LeaseBySKUIndex.Has(f.Ctx, collections.Join(s.sku.Uuid, leaseUUID))
+	require.NoError(t, err)
+	require.True(t, has)
+}
+
+func TestLeaseAmendment_Reject(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	otherSKU := f.createTestSKU(t, s.provider.Uuid, 3600)
+
+	_, err := s.msgServer.ProposeLeaseAmendment(f.Ctx, &types.MsgProposeLeaseAmendment{
+		Tenant:    s.tenant.String(),
+		LeaseUuid: s.leaseUUID,
+		AddItems:  []types.LeaseItemInput{{SkuUuid: otherSKU.Uuid, Quantity: 2}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(7200+7200), s.reserved(t))
+
+	// Only the provider (or authority) may decide on the amendment.
+	_, err = s.msgServer.RejectLeaseAmendment(f.Ctx, &types.MsgRejectLeaseAmendment{
+		Sender:    s.tenant.String(),
+		LeaseUuid: s.leaseUUID,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = s.msgServer.AcknowledgeLeaseAmendment(f.Ctx, &types.MsgAcknowledgeLeaseAmendment{
+		Sender:    f.TestAccs[3].String(),
+		LeaseUuid: s.leaseUUID,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	f.Ctx = f.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.RejectLeaseAmendment(f.Ctx, &types.MsgRejectLeaseAmendment{
+		Sender:    s.providerAddr.String(),
+		LeaseUuid: s.leaseUUID,
+		Reason:    "no capacity",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, countEvents(f.Ctx, types.EventTypeLeaseAmendmentRejected))
+	require.Equal(t, sdkmath.NewInt(7200), s.reserved(t))
+
+	lease, err := f.App.BillingKeeper.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Len(t, lease.Items, 1)
+
+	_, err = s.msgServer.RejectLeaseAmendment(f.Ctx, &types.MsgRejectLeaseAmendment{
+		Sender:    s.providerAddr.String(),
+		LeaseUuid: s.leaseUUID,
+	})
+	require.ErrorIs(t, err, types.ErrLeaseAmendmentNotFound)
+}
+
+func TestLeaseAmendment_ProposeErrors(t *testing.T) {
+	s := setupLeaseScaling(t, 10_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	otherSKU := f.createTestSKU(t, s.provider.Uuid, 3600)
+
+	otherProvider := f.createTestProvider(t, f.TestAccs[3].String(), f.TestAccs[4].String())
+	foreignSKU := f.createTestSKU(t, otherProvider.Uuid, 3600)
+
+	tests := []struct {
+		name   string
+		msg    *types.MsgProposeLeaseAmendment
+		target error
+	}{
+		{
+			name: "not the tenant",
+			msg: &types.MsgProposeLeaseAmendment{
+				Tenant:    s.providerAddr.String(),
+				LeaseUuid: s.leaseUUID,
+				AddItems:  []types.LeaseItemInput{{SkuUuid: otherSKU.Uuid, Quantity: 1}},
+			},
+			target: types.ErrUnauthorized,
+		},
+		{
+			name: "sku from another provider",
+			msg: &types.MsgProposeLeaseAmendment{
+				Tenant:    s.tenant.String(),
+				LeaseUuid: s.leaseUUID,
+				AddItems:  []types.LeaseItemInput{{SkuUuid: foreignSKU.Uuid, Quantity: 1}},
+			},
+			target: types.ErrMixedProviders,
+		},
+		{
+			name: "remove unknown item",
+			msg: &types.MsgProposeLeaseAmendment{
+				Tenant:      s.tenant.String(),
+				LeaseUuid:   s.leaseUUID,
+				RemoveItems: []string{otherSKU.Uuid},
+			},
+			target: types.ErrLeaseItemNotFound,
+		},
+		{
+			name: "remove every item",
+			msg: &types.MsgProposeLeaseAmendment{
+				Tenant:      s.tenant.String(),
+				LeaseUuid:   s.leaseUUID,
+				RemoveItems: []string{s.sku.Uuid},
+			},
+			target: types.ErrEmptyLeaseItems,
+		},
+		{
+			name: "service_name on legacy lease",
+			msg: &types.MsgProposeLeaseAmendment{
+				Tenant:    s.tenant.String(),
+				LeaseUuid: s.leaseUUID,
+				AddItems:  []types.LeaseItemInput{{SkuUuid: otherSKU.Uuid, Quantity: 1, ServiceName: "web"}},
+			},
+			target: types.ErrInvalidServiceName,
+		},
+		{
+			// 10_000 credit minus the 7_200 reservation cannot cover 3_600 more.
+			name: "insufficient credit",
+			msg: &types.MsgProposeLeaseAmendment{
+				Tenant:    s.tenant.String(),
+				LeaseUuid: s.leaseUUID,
+				AddItems:  []types.LeaseItemInput{{SkuUuid: otherSKU.Uuid, Quantity: 1}},
+			},
+			target: types.ErrInsufficientCredit,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := s.msgServer.ProposeLeaseAmendment(f.Ctx, tc.msg)
+			require.ErrorIs(t, err, tc.target)
+		})
+	}
+
+	// One pending amendment per lease.
+	f.fundCreditViaMsg(t, s.msgServer, s.tenant, 100_000)
+
+	msg := &types.MsgProposeLeaseAmendment{
+		Tenant:    s.tenant.String(),
+		LeaseUuid: s.leaseUUID,
+		AddItems:  []types.LeaseItemInput{{SkuUuid: otherSKU.Uuid, Quantity: 1}},
+	}
+	_, err := s.msgServer.ProposeLeaseAmendment(f.Ctx, msg)
+	require.NoError(t, err)
+	_, err = s.msgServer.ProposeLeaseAmendment(f.Ctx, msg)
+	require.ErrorIs(t, err, types.ErrLeaseAmendmentPending)
+}
+
+func TestLeaseAmendment_ExpiresAfterPendingTimeout(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	otherSKU := f.createTestSKU(t, s.provider.Uuid, 3600)
+
+	_, err := s.msgServer.ProposeLeaseAmendment(f.Ctx, &types.MsgProposeLeaseAmendment{
+		Tenant:    s.tenant.String(),
+		LeaseUuid: s.leaseUUID,
+		AddItems:  []types.LeaseItemInput{{SkuUuid: otherSKU.Uuid, Quantity: 1}},
+	})
+	require.NoError(t, err)
+
+	// Still pending at exactly pending_timeout.
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(1800 * time.Second))
+	require.NoError(t, f.App.BillingKeeper.EndBlocker(f.Ctx))
+	_, err = f.App.BillingKeeper.GetLeaseAmendment(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(time.Second))
+	f.Ctx = f.Ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.App.BillingKeeper.EndBlocker(f.Ctx))
+	_, err = f.App.BillingKeeper.GetLeaseAmendment(f.Ctx, s.leaseUUID)
+	require.ErrorIs(t, err, types.ErrLeaseAmendmentNotFound)
+	require.Equal(t, 1, countEvents(f.Ctx, types.EventTypeLeaseAmendmentExpired))
+	require.Equal(t, sdkmath.NewInt(7200), s.reserved(t))
+	requireNoAmendmentIndexEntries(t, f)
+}
+
+func TestLeaseAmendment_ExpiresAfterProviderPendingTimeout(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	k := f.App.BillingKeeper
+	otherSKU := f.createTestSKU(t, s.provider.Uuid, 3600)
+
+	setPendingTimeout := func(seconds uint64) {
+		_, err := s.msgServer.SetProviderPolicy(f.Ctx, &types.MsgSetProviderPolicy{
+			Sender: s.providerAddr.String(),
+			Policy: types.ProviderPolicy{ProviderUuid: s.provider.Uuid, PendingTimeout: seconds},
+		})
+		require.NoError(t, err)
+	}
+	propose := func() {
+		_, err := s.msgServer.ProposeLeaseAmendment(f.Ctx, &types.MsgProposeLeaseAmendment{
+			Tenant:    s.tenant.String(),
+			LeaseUuid: s.leaseUUID,
+			AddItems:  []types.LeaseItemInput{{SkuUuid: otherSKU.Uuid, Quantity: 1}},
+		})
+		require.NoError(t, err)
+	}
+	advance := func(seconds int64) {
+		f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(time.Duration(seconds) * time.Second))
+		require.NoError(t, k.EndBlocker(f.Ctx))
+	}
+
+	// A longer provider timeout keeps the amendment past params.pending_timeout.
+	setPendingTimeout(3600)
+	propose()
+	advance(1801)
+	_, err := k.GetLeaseAmendment(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	advance(1800)
+	_, err = k.GetLeaseAmendment(f.Ctx, s.leaseUUID)
+	require.ErrorIs(t, err, types.ErrLeaseAmendmentNotFound)
+
+	// A shorter one expires it sooner, including for amendments already pending.
+	propose()
+	setPendingTimeout(types.MinPendingTimeout)
+	advance(int64(types.MinPendingTimeout))
+	_, err = k.GetLeaseAmendment(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	advance(1)
+	_, err = k.GetLeaseAmendment(f.Ctx, s.leaseUUID)
+	require.ErrorIs(t, err, types.ErrLeaseAmendmentNotFound)
+	requireNoAmendmentIndexEntries(t, f)
+}
+
+func TestLeaseAmendment_CloseLeaseDropsAmendment(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	otherSKU := f.createTestSKU(t, s.provider.Uuid, 3600)
+
+	_, err := s.msgServer.ProposeLeaseAmendment(f.Ctx, &types.MsgProposeLeaseAmendment{
+		Tenant:    s.tenant.String(),
+		LeaseUuid: s.leaseUUID,
+		AddItems:  []types.LeaseItemInput{{SkuUuid: otherSKU.Uuid, Quantity: 1}},
+	})
+	require.NoError(t, err)
+
+	_, err = s.msgServer.CloseLease(f.Ctx, &types.MsgCloseLease{
+		Sender:     s.tenant.String(),
+		LeaseUuids: []string{s.leaseUUID},
+	})
+	require.NoError(t, err)
+
+	_, err = f.App.BillingKeeper.GetLeaseAmendment(f.Ctx, s.leaseUUID)
+	require.ErrorIs(t, err, types.ErrLeaseAmendmentNotFound)
+	require.True(t, s.reserved(t).IsZero())
+	requireNoAmendmentIndexEntries(t, f)
+}
+
+func requireNoAmendmentIndexEntries(t *testing.T, f *testFixture) {
+	t.Helper()
+	iter, err := f.App.BillingKeeper.LeaseAmendmentsByCreatedAt.Iterate(f.Ctx, nil)
+	require.NoError(t, err)
+	defer iter.Close()
+	require.False(t, iter.Valid(), "expected no lease amendment index entries")
+}
@@ -0,0 +1,211 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/keeper"
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+	skutypes "github.com/manifest-network/manifest-ledger/x/sku/types"
+)
+
+// setupLeaseBatch creates two providers with one 1/s SKU each and returns the
+// SKU UUIDs. The tenant's credit is funded with credit (umfx).
+func setupLeaseBatch(t *testing.T, credit int64) (*testFixture, types.MsgServer, sdk.AccAddress, []string) {
+	t.Helper()
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.App.BillingKeeper)
+
+	tenant := f.TestAccs[0]
+	skus := make([]string, 0, 2)
+	for _, providerAddr := range []sdk.AccAddress{f.TestAccs[1], f.TestAccs[2]} {
+		provider := f.createTestProvider(t, providerAddr.String(), providerAddr.String())
+		skus = append(skus, f.createTestSKU(t, provider.Uuid, 3600).Uuid)
+	}
+	f.fundCreditViaMsg(t, msgServer, tenant, credit)
+
+	return f, msgServer, tenant, skus
+}
+
+func TestMsgCreateLeases(t *testing.T) {
+	f, msgServer, tenant, skus := setupLeaseBatch(t, 10_000)
+	f.Ctx = f.Ctx.WithEventManager(sdk.NewEventManager())
+
+	resp, err := msgServer.CreateLeases(f.Ctx, &types.MsgCreateLeases{
+		Tenant: tenant.String(),
+		Leases: []types.LeaseInput{
+			{Items: []types.LeaseItemInput{{SkuUuid: skus[0], Quantity: 1}}, MetaHash: []byte{0x01}},
+			{Items: []types.LeaseItemInput{{SkuUuid: skus[1], Quantity: 1}}, MetaHash: []byte{0x02}},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.LeaseUuids, 2)
+
+	for i, uuid := range resp.LeaseUuids {
+		lease, err := f.App.BillingKeeper.GetLease(f.Ctx, uuid)
+		require.NoError(t, err)
+		require.Equal(t, types.LEASE_STATE_PENDING, lease.State)
+		require.Equal(t, []byte{byte(i + 1)}, lease.MetaHash)
+		sku, err := f.App.SKUKeeper.GetSKU(f.Ctx, skus[i])
+		require.NoError(t, err)
+		require.Equal(t, sku.ProviderUuid, lease.ProviderUuid)
+	}
+
+	ca, err := f.App.BillingKeeper.GetCreditAccount(f.Ctx, tenant.String())
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), ca.PendingLeaseCount)
+	require.Equal(t, sdkmath.NewInt(7_200), ca.ReservedAmounts.AmountOf(testDenom))
+
+	require.Equal(t, 2, countEvents(f.Ctx, types.EventTypeLeaseCreated))
+}
+
+func TestMsgCreateLeases_CombinedReservation(t *testing.T) {
+	// Each lease reserves 3600 on its own, but not both together.
+	f, msgServer, tenant, skus := setupLeaseBatch(t, 5_000)
+
+	_, err := msgServer.CreateLeases(f.Ctx, &types.MsgCreateLeases{
+		Tenant: tenant.String(),
+		Leases: []types.LeaseInput{
+			{Items: []types.LeaseItemInput{{SkuUuid: skus[0], Quantity: 1}}},
+			{Items: []types.LeaseItemInput{{SkuUuid: skus[1], Quantity: 1}}},
+		},
+	})
+	require.ErrorIs(t, err, types.ErrInsufficientCredit)
+
+	leases, err := f.App.BillingKeeper.GetLeasesByTenant(f.Ctx, tenant.String())
+	require.NoError(t, err)
+	require.Empty(t, leases)
+
+	ca, err := f.App.BillingKeeper.GetCreditAccount(f.Ctx, tenant.String())
+	require.NoError(t, err)
+	require.Zero(t, ca.PendingLeaseCount)
+	require.True(t, ca.ReservedAmounts.IsZero())
+}
+
+func TestMsgCreateLeases_PendingLimitAggregate(t *testing.T) {
+	f, msgServer, tenant, skus := setupLeaseBatch(t, 100_000)
+
+	params, err := f.App.BillingKeeper.GetParams(f.Ctx)
+	require.NoError(t, err)
+	params.MaxPendingLeasesPerTenant = 2
+	require.NoError(t, f.App.BillingKeeper.SetParams(f.Ctx, params))
+
+	lease := types.LeaseInput{Items: []types.LeaseItemInput{{SkuUuid: skus[0], Quantity: 1}}}
+
+	_, err = msgServer.CreateLeases(f.Ctx, &types.MsgCreateLeases{
+		Tenant: tenant.String(),
+		Leases: []types.LeaseInput{lease, lease, lease},
+	})
+	require.ErrorIs(t, err, types.ErrMaxPendingLeasesReached)
+
+	resp, err := msgServer.CreateLeases(f.Ctx, &types.MsgCreateLeases{
+		Tenant: tenant.String(),
+		Leases: []types.LeaseInput{lease, lease},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.LeaseUuids, 2)
+}
+
+func TestMsgCreateLeases_AllOrNothing(t *testing.T) {
+	f, msgServer, tenant, skus := setupLeaseBatch(t, 100_000)
+	f.Ctx = f.Ctx.WithEventManager(sdk.NewEventManager())
+
+	_, err := msgServer.CreateLeases(f.Ctx, &types.MsgCreateLeases{
+		Tenant: tenant.String(),
+		Leases: []types.LeaseInput{
+			{Items: []types.LeaseItemInput{{SkuUuid: skus[0], Quantity: 1}}},
+			{Items: []types.LeaseItemInput{{SkuUuid: "01912345-6789-7abc-8def-0123456789ab", Quantity: 1}}},
+		},
+	})
+	require.ErrorIs(t, err, types.ErrSKUNotFound)
+
+	leases, err := f.App.BillingKeeper.GetLeasesByTenant(f.Ctx, tenant.String())
+	require.NoError(t, err)
+	require.Empty(t, leases)
+	require.Zero(t, countEvents(f.Ctx, types.EventTypeLeaseCreated))
+}
+
+func TestMsgCreateLeases_PriceTiers(t *testing.T) {
+	f, msgServer, tenant, skus := setupLeaseBatch(t, 1_000_000)
+
+	sku, err := f.App.SKUKeeper.GetSKU(f.Ctx, skus[0])
+	require.NoError(t, err)
+	sku.BasePrice = sdk.NewInt64Coin(testDenom, 7200)
+	sku.PriceTiers = []skutypes.PriceTier{{MinQuantity: 5, Price: sdk.NewInt64Coin(testDenom, 3600)}}
+	require.NoError(t, f.App.SKUKeeper.SetSKU(f.Ctx, sku))
+
+	lockedPrice := func(leaseUUID string) sdk.Coin {
+		lease, err := f.App.BillingKeeper.GetLease(f.Ctx, leaseUUID)
+		require.NoError(t, err)
+		return lease.Items[0].LockedPrice
+	}
+
+	// The second lease reaches the tier with the 3 units of the first.
+	resp, err := msgServer.CreateLeases(f.Ctx, &types.MsgCreateLeases{
+		Tenant: tenant.String(),
+		Leases: []types.LeaseInput{
+			{Items: []types.LeaseItemInput{{SkuUuid: skus[0], Quantity: 3}}},
+			{Items: []types.LeaseItemInput{{SkuUuid: skus[0], Quantity: 2}}},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(testDenom, 2), lockedPrice(resp.LeaseUuids[0]))
+	require.Equal(t, sdk.NewInt64Coin(testDenom, 1), lockedPrice(resp.LeaseUuids[1]))
+
+	// Open leases count towards the tier of later leases.
+	single, err := msgServer.CreateLease(f.Ctx, &types.MsgCreateLease{
+		Tenant: tenant.String(),
+		Items:  []types.LeaseItemInput{{SkuUuid: skus[0], Quantity: 1}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(testDenom, 1), lockedPrice(single.LeaseUuid))
+
+	// Cancelled leases no longer do.
+	_, err = msgServer.CancelLease(f.Ctx, &types.MsgCancelLease{
+		Tenant:     tenant.String(),
+		LeaseUuids: append(resp.LeaseUuids, single.LeaseUuid),
+	})
+	require.NoError(t, err)
+	single, err = msgServer.CreateLease(f.Ctx, &types.MsgCreateLease{
+		Tenant: tenant.String(),
+		Items:  []types.LeaseItemInput{{SkuUuid: skus[0], Quantity: 1}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(testDenom, 2), lockedPrice(single.LeaseUuid))
+}
+
+func TestMsgCreateLeasesForTenant(t *testing.T) {
+	f, msgServer, tenant, skus := setupLeaseBatch(t, 100_000)
+	stranger := f.TestAccs[3]
+
+	leases := []types.LeaseInput{
+		{Items: []types.LeaseItemInput{{SkuUuid: skus[0], Quantity: 1}}},
+		{Items: []types.LeaseItemInput{{SkuUuid: skus[1], Quantity: 1}}},
+	}
+
+	_, err := msgServer.CreateLeasesForTenant(f.Ctx, &types.MsgCreateLeasesForTenant{
+		Authority: stranger.String(),
+		Tenant:    tenant.String(),
+		Leases:    leases,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	resp, err := msgServer.CreateLeasesForTenant(f.Ctx, &types.MsgCreateLeasesForTenant{
+		Authority: f.App.BillingKeeper.GetAuthority(),
+		Tenant:    tenant.String(),
+		Leases:    leases,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.LeaseUuids, 2)
+
+	for _, uuid := range resp.LeaseUuids {
+		lease, err := f.App.BillingKeeper.GetLease(f.Ctx, uuid)
+		require.NoError(t, err)
+		require.Equal(t, tenant.String(), lease.Tenant)
+	}
+}
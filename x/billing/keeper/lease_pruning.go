@@ -0,0 +1,202 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+)
+
+// terminalAt returns the time a lease reached its terminal state, and false
+// for PENDING and ACTIVE leases.
+func terminalAt(lease types.Lease) (time.Time, bool) {
+	var at *time.Time
+	switch lease.State {
+	case types.LEASE_STATE_CLOSED:
+		at = lease.ClosedAt
+	case types.LEASE_STATE_REJECTED:
+		at = lease.RejectedAt
+	case types.LEASE_STATE_EXPIRED:
+		at = lease.ExpiredAt
+	}
+	if at == nil {
+		return time.Time{}, false
+	}
+	return *at, true
+}
+
+// hasTerminalEntry reports whether a lease belongs in LeasesByTerminalAt.
+// Leases still owing debt or under an open dispute are left out so they are
+// not pruned before the provider is paid or the dispute settled; they are
+// indexed once that is done.
+func hasTerminalEntry(lease types.Lease) bool {
+	_, ok := terminalAt(lease)
+	return ok && lease.Debt.IsZero() && !lease.Disputed
+}
+
+// reconcileTerminalIndex keeps LeasesByTerminalAt in step with a SetLease
+// write. Terminal timestamps never change once set, so only entering and
+// leaving the index need handling.
+func (k *Keeper) reconcileTerminalIndex(ctx context.Context, prev types.Lease, hadPrev bool, lease types.Lease) error {
+	if hadPrev && hasTerminalEntry(prev) && !hasTerminalEntry(lease) {
+		at, _ := terminalAt(prev)
+		if err := k.LeasesByTerminalAt.Remove(ctx, collections.Join(at, prev.Uuid)); err != nil {
+			return err
+		}
+	}
+	if hasTerminalEntry(lease) {
+		at, _ := terminalAt(lease)
+		return k.LeasesByTerminalAt.Set(ctx, collections.Join(at, lease.Uuid))
+	}
+	return nil
+}
+
+// removeLease deletes a terminal lease from the primary collection and every
+// index that references it. Custom domain entries are only removed while they
+// still point at this lease: terminal leases keep their custom_domain fields,
+// but the domains may have been claimed by another lease since.
+func (k *Keeper) removeLease(ctx context.Context, lease types.Lease) error {
+	for _, item := range lease.Items {
+		if err := k.LeaseBySKUIndex.Remove(ctx, collections.Join(item.SkuUuid, lease.Uuid)); err != nil {
+			return err
+		}
+		if item.CustomDomain == "" {
+			continue
+		}
+		target, err := k.CustomDomainIndex.Get(ctx, item.CustomDomain)
+		switch {
+		case err == nil:
+			if target.LeaseUuid != lease.Uuid {
+				continue
+			}
+			if err := k.CustomDomainIndex.Remove(ctx, item.CustomDomain); err != nil {
+				return err
+			}
+		case errors.Is(err, collections.ErrNotFound):
+		default:
+			return err
+		}
+	}
+
+	switch amendment, err := k.LeaseAmendments.Get(ctx, lease.Uuid); {
+	case err == nil:
+		if err := k.removeLeaseAmendment(ctx, amendment); err != nil {
+			return err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+	if err := k.LeaseUsages.Remove(ctx, lease.Uuid); err != nil {
+		return err
+	}
+	if err := k.Disputes.Remove(ctx, lease.Uuid); err != nil {
+		return err
+	}
+	if err := k.LeaseSettlements.Clear(ctx, collections.NewPrefixedPairRange[string, uint64](lease.Uuid)); err != nil {
+		return err
+	}
+
+	if at, ok := terminalAt(lease); ok {
+		if err := k.LeasesByTerminalAt.Remove(ctx, collections.Join(at, lease.Uuid)); err != nil {
+			return err
+		}
+	}
+
+	// Removing from the IndexedMap also clears the six LeaseIndexes entries.
+	return k.Leases.Remove(ctx, lease.Uuid)
+}
+
+// pruneTerminalLeases deletes terminal leases that have been CLOSED, REJECTED
+// or EXPIRED for longer than retention. Each lease is archived with an
+// EventLeaseArchived carrying the full record before it is removed. It
+// collects first and then mutates, capped at MaxLeasePrunesPerBlock.
+func (k *Keeper) pruneTerminalLeases(ctx context.Context, retention uint64) error {
+	if retention == 0 {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	// #nosec G115 -- LeaseRetentionPeriod is validated in params to be at most 10 years
+	cutoff := sdkCtx.BlockTime().Add(-time.Duration(retention) * time.Second)
+
+	iter, err := k.LeasesByTerminalAt.Iterate(ctx, collections.NewPrefixUntilPairRange[time.Time, string](cutoff))
+	if err != nil {
+		return err
+	}
+
+	var dueUUIDs []string
+	for ; iter.Valid(); iter.Next() {
+		if len(dueUUIDs) >= types.MaxLeasePrunesPerBlock {
+			break
+		}
+		key, err := iter.Key()
+		if err != nil {
+			k.logger.Error("failed to decode terminal lease index key", "error", err)
+			continue
+		}
+		dueUUIDs = append(dueUUIDs, key.K2())
+	}
+
+	if err := iter.Close(); err != nil {
+		k.logger.Error("failed to close iterator", "error", err)
+	}
+
+	prunedCount := 0
+	for _, leaseUUID := range dueUUIDs {
+		lease, err := k.Leases.Get(ctx, leaseUUID)
+		if err != nil {
+			k.logger.Error("failed to get lease for pruning",
+				"lease_uuid", leaseUUID,
+				"error", err,
+			)
+			continue
+		}
+		if !hasTerminalEntry(lease) {
+			// SetLease keeps the index to terminal leases without debt; this
+			// is only reachable through an inconsistent store.
+			k.logger.Error("terminal lease index references a lease that cannot be pruned",
+				"lease_uuid", leaseUUID,
+				"state", lease.State.String(),
+			)
+			continue
+		}
+
+		cacheCtx, writeCache := sdkCtx.CacheContext()
+		if err := cacheCtx.EventManager().EmitTypedEvent(&types.EventLeaseArchived{Lease: lease}); err != nil {
+			k.logger.Error("failed to emit lease archived event",
+				"lease_uuid", leaseUUID,
+				"error", err,
+			)
+			continue
+		}
+		if err := k.removeLease(cacheCtx, lease); err != nil {
+			k.logger.Error("failed to prune lease",
+				"lease_uuid", leaseUUID,
+				"error", err,
+			)
+			continue
+		}
+		writeCache()
+		prunedCount++
+	}
+
+	if prunedCount > 0 {
+		k.logger.Info("pruned terminal leases in EndBlocker",
+			"pruned_count", prunedCount,
+			"collected_count", len(dueUUIDs),
+		)
+	}
+
+	if len(dueUUIDs) >= types.MaxLeasePrunesPerBlock {
+		k.logger.Warn("reached max lease prunes per block",
+			"limit", types.MaxLeasePrunesPerBlock,
+		)
+	}
+
+	return nil
+}
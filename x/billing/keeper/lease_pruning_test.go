@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/keeper"
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+	skutypes "github.com/manifest-network/manifest-ledger/x/sku/types"
+)
+
+const eventTypeLeaseArchived = "liftedinit.billing.v1.EventLeaseArchived"
+
+// setLeaseRetention sets params.lease_retention_period for the fixture.
+func setLeaseRetention(t *testing.T, f *testFixture, seconds uint64) {
+	t.Helper()
+	params, err := f.App.BillingKeeper.GetParams(f.Ctx)
+	require.NoError(t, err)
+	params.LeaseRetentionPeriod = seconds
+	require.NoError(t, params.Validate())
+	require.NoError(t, f.App.BillingKeeper.SetParams(f.Ctx, params))
+}
+
+func closeLease(t *testing.T, s *leaseScalingSetup, leaseUUID string) {
+	t.Helper()
+	_, err := s.msgServer.CloseLease(s.f.Ctx, &types.MsgCloseLease{
+		Sender:     s.tenant.String(),
+		LeaseUuids: []string{leaseUUID},
+	})
+	require.NoError(t, err)
+}
+
+func TestLeasePruning_PrunesAfterRetention(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	k := f.App.BillingKeeper
+	setLeaseRetention(t, f, 1000)
+
+	closedAt := f.Ctx.BlockTime().Add(100 * time.Second).UTC()
+	f.Ctx = f.Ctx.WithBlockTime(closedAt)
+	closeLease(t, s, s.leaseUUID)
+
+	has, err := k.LeasesByTerminalAt.Has(f.Ctx, collections.Join(closedAt, s.leaseUUID))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	f.Ctx = f.Ctx.WithBlockTime(closedAt.Add(999 * time.Second)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.EndBlocker(f.Ctx))
+	require.Equal(t, 0, countEvents(f.Ctx, eventTypeLeaseArchived))
+	_, err = k.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+
+	f.Ctx = f.Ctx.WithBlockTime(closedAt.Add(1000 * time.Second)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.EndBlocker(f.Ctx))
+	require.Equal(t, 1, countEvents(f.Ctx, eventTypeLeaseArchived))
+
+	_, err = k.GetLease(f.Ctx, s.leaseUUID)
+	require.ErrorIs(t, err, types.ErrLeaseNotFound)
+
+	has, err = k.LeasesByTerminalAt.Has(f.Ctx, collections.Join(closedAt, s.leaseUUID))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = k.LeaseBySKUIndex.Has(f.Ctx, collections.Join(s.sku.Uuid, s.leaseUUID))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	leases, err := k.GetLeasesByTenant(f.Ctx, s.tenant.String())
+	require.NoError(t, err)
+	require.Empty(t, leases)
+	leases, err = k.GetLeasesByProviderAndState(f.Ctx, s.provider.Uuid, types.LEASE_STATE_CLOSED)
+	require.NoError(t, err)
+	require.Empty(t, leases)
+}
+
+func TestLeasePruning_DisabledByDefault(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	closeLease(t, s, s.leaseUUID)
+
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(365 * 24 * time.Hour))
+	require.NoError(t, f.App.BillingKeeper.EndBlocker(f.Ctx))
+
+	lease, err := f.App.BillingKeeper.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_CLOSED, lease.State)
+}
+
+func TestLeasePruning_KeepsLeaseUntilDebtPaid(t *testing.T) {
+	s := setupLeaseScaling(t, 10_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	k := f.App.BillingKeeper
+	setGracePeriod(t, f, 1000)
+	setLeaseRetention(t, f, 60)
+
+	exhaustAt := f.Ctx.BlockTime().Add(5000 * time.Second).UTC()
+	f.Ctx = f.Ctx.WithBlockTime(exhaustAt)
+	require.NoError(t, k.EndBlocker(f.Ctx))
+	f.Ctx = f.Ctx.WithBlockTime(exhaustAt.Add(1000 * time.Second))
+	require.NoError(t, k.EndBlocker(f.Ctx))
+
+	lease, err := k.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_CLOSED, lease.State)
+	require.False(t, lease.Debt.IsZero())
+
+	f.Ctx = f.Ctx.WithBlockTime(exhaustAt.Add(2000 * time.Second))
+	require.NoError(t, k.EndBlocker(f.Ctx))
+	_, err = k.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err, "a lease owing debt must not be pruned")
+
+	f.fundCreditViaMsg(t, s.msgServer, s.tenant, 5_000)
+	require.NoError(t, k.EndBlocker(f.Ctx))
+	_, err = k.GetLease(f.Ctx, s.leaseUUID)
+	require.ErrorIs(t, err, types.ErrLeaseNotFound)
+}
+
+func TestLeasePruning_GenesisRoundTrip(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	k := f.App.BillingKeeper
+	start := f.Ctx.BlockTime()
+	setLeaseRetention(t, f, 60)
+
+	kept := f.createAndAcknowledgeLease(t, s.msgServer, s.tenant, s.providerAddr, []types.LeaseItemInput{
+		{SkuUuid: s.sku.Uuid, Quantity: 1},
+	})
+	closeLease(t, s, s.leaseUUID)
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(60 * time.Second))
+	require.NoError(t, k.EndBlocker(f.Ctx))
+
+	exported := k.ExportGenesis(f.Ctx)
+	require.Len(t, exported.Leases, 1)
+	require.Equal(t, kept, exported.Leases[0].Uuid)
+	require.NoError(t, exported.Validate())
+	require.NoError(t, exported.ValidateWithBlockTime(f.Ctx.BlockTime()))
+
+	// Import into a fresh chain with the same provider and SKU.
+	g := initFixture(t)
+	g.Ctx = g.Ctx.WithBlockTime(start)
+	provider := g.createTestProvider(t, s.providerAddr.String(), s.payoutAddr.String())
+	require.Equal(t, s.provider.Uuid, provider.Uuid)
+	sku := g.createTestSKU(t, provider.Uuid, 3600)
+	require.Equal(t, s.sku.Uuid, sku.Uuid)
+
+	g.Ctx = g.Ctx.WithBlockTime(f.Ctx.BlockTime())
+	require.NoError(t, g.App.BillingKeeper.InitGenesis(g.Ctx, exported))
+	lease, err := g.App.BillingKeeper.GetLease(g.Ctx, kept)
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_ACTIVE, lease.State)
+}
+
+// TestMigrate2to3_IndexesTerminalLeases verifies the v2→v3 migration indexes
+// existing terminal leases by their terminal time.
+func TestMigrate2to3_IndexesTerminalLeases(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	k := f.App.BillingKeeper
+	closeLease(t, s, s.leaseUUID)
+
+	lease, err := k.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	key := collections.Join(*lease.ClosedAt, s.leaseUUID)
+	require.NoError(t, k.LeasesByTerminalAt.Remove(f.Ctx, key))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate2to3(f.Ctx))
+
+	has, err := k.LeasesByTerminalAt.Has(f.Ctx, key)
+	require.NoError(t, err)
+	require.True(t, has)
+}
@@ -0,0 +1,343 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+)
+
+// LeaseItemsUpdateResult holds the outcome of UpdateLeaseItems for the msg
+// server response and events.
+type LeaseItemsUpdateResult struct {
+	Lease          types.Lease
+	SettledAmounts sdk.Coins
+	PreviousRate   sdk.DecCoins
+	NewRate        sdk.DecCoins
+	// Changes lists only the items whose quantity actually changed, in lease
+	// item order.
+	Changes []LeaseItemQuantityChange
+}
+
+// LeaseItemQuantityChange records a single item's quantity transition.
+type LeaseItemQuantityChange struct {
+	SkuUUID     string
+	ServiceName string
+	OldQuantity uint64
+	NewQuantity uint64
+}
+
+// findLeaseItemForUpdate resolves an update entry to the index of the lease item
+// it addresses. Service-name mode leases are addressed by service_name (the SKU
+// must still match, so a typo cannot silently retarget another item); legacy
+// leases are addressed by sku_uuid, which is unique in that mode.
+func findLeaseItemForUpdate(lease types.Lease, update types.LeaseItemInput) (int, error) {
+	serviceMode := len(lease.Items) > 0 && lease.Items[0].ServiceName != ""
+	if serviceMode != (update.ServiceName != "") {
+		return -1, types.ErrInvalidServiceName.Wrapf(
+			"lease %s service_name mode does not match update for sku_uuid %s", lease.Uuid, update.SkuUuid,
+		)
+	}
+
+	for i, item := range lease.Items {
+		if serviceMode {
+			if item.ServiceName != update.ServiceName {
+				continue
+			}
+			if item.SkuUuid != update.SkuUuid {
+				return -1, types.ErrLeaseItemNotFound.Wrapf(
+					"item %q on lease %s uses sku_uuid %s, not %s", item.ServiceName, lease.Uuid, item.SkuUuid, update.SkuUuid,
+				)
+			}
+			return i, nil
+		}
+		if item.SkuUuid == update.SkuUuid {
+			return i, nil
+		}
+	}
+
+	if serviceMode {
+		return -1, types.ErrLeaseItemNotFound.Wrapf("lease %s has no item with service_name %q", lease.Uuid, update.ServiceName)
+	}
+	return -1, types.ErrLeaseItemNotFound.Wrapf("lease %s has no item with sku_uuid %s", lease.Uuid, update.SkuUuid)
+}
+
+// leaseItemRates returns the total per-second rate of the given items by
+// denom. Metered items have no per-second rate; calendar-month items count at
+// their locked rate, the highest their monthly price bills at.
+func leaseItemRates(items []types.LeaseItem) sdk.DecCoins {
+	rates := sdk.NewDecCoins()
+	for _, item := range items {
+		if item.Metered {
+			continue
+		}
+		rates = rates.Add(sdk.NewDecCoinFromDec(item.LockedPrice.Denom, item.RatePerSecond().MulInt(sdkmath.NewIntFromUint64(item.Quantity))))
+	}
+	return rates
+}
+
+// leaseItemSchedule splits what the given items accrue over time into a
+// per-second rate and an amount charged per calendar month, both by denom.
+// Unlike leaseItemRates, which counts calendar-month items at their highest
+// rate, it lets projections follow the actual length of each month.
+func leaseItemSchedule(items []types.LeaseItem) (perSecond, perMonth sdk.DecCoins) {
+	perSecond, perMonth = sdk.NewDecCoins(), sdk.NewDecCoins()
+	for _, item := range items {
+		switch {
+		case item.Metered:
+		case item.CalendarMonth:
+			perMonth = perMonth.Add(sdk.NewDecCoinFromDec(item.MonthlyPrice.Denom, sdkmath.LegacyNewDecFromInt(item.MonthlyPrice.Amount).MulInt(sdkmath.NewIntFromUint64(item.Quantity))))
+		default:
+			perSecond = perSecond.Add(sdk.NewDecCoinFromDec(item.LockedPrice.Denom, item.RatePerSecond().MulInt(sdkmath.NewIntFromUint64(item.Quantity))))
+		}
+	}
+	return perSecond, perMonth
+}
+
+// rateDenoms returns the denoms of rates, in their sorted order.
+func rateDenoms(rates sdk.DecCoins) []string {
+	denoms := make([]string, len(rates))
+	for i, rate := range rates {
+		denoms[i] = rate.Denom
+	}
+	return denoms
+}
+
+// UpdateLeaseItems changes item quantities on an ACTIVE lease owned by tenant.
+//
+// The lease is settled up to block time at the old quantities, so the new
+// quantities only bill from this block onwards. Prices are never re-locked:
+// every item keeps its original LockedPrice. The lease's reservation is then
+// recomputed with the same min_lease_duration used at creation, and any
+// increase must be covered by the tenant's available credit and the SKU's
+// capacity, exactly as at lease creation. Scale-downs only release
+// reservation and capacity and need neither provider approval nor an active
+// SKU.
+//
+// Leases whose credit is already exhausted are rejected rather than resized;
+// they must be closed (or the credit topped up) first.
+func (k *Keeper) UpdateLeaseItems(ctx context.Context, tenant, leaseUUID string, updates []types.LeaseItemInput) (*LeaseItemsUpdateResult, error) {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	lease, err := k.GetLease(ctx, leaseUUID)
+	if err != nil {
+		return nil, err
+	}
+	if lease.Tenant != tenant {
+		return nil, types.ErrUnauthorized.Wrapf("sender %s is not the tenant of lease %s", tenant, leaseUUID)
+	}
+	if lease.State != types.LEASE_STATE_ACTIVE {
+		return nil, types.ErrLeaseNotActive.Wrapf("lease %s is not active", leaseUUID)
+	}
+
+	// Resolve the new item list without touching the stored lease yet.
+	newItems := make([]types.LeaseItem, len(lease.Items))
+	copy(newItems, lease.Items)
+	scaledUp := false
+	for _, update := range updates {
+		idx, err := findLeaseItemForUpdate(lease, update)
+		if err != nil {
+			return nil, err
+		}
+		if update.Quantity > newItems[idx].Quantity {
+			scaledUp = true
+		}
+		newItems[idx].Quantity = update.Quantity
+	}
+
+	changes := make([]LeaseItemQuantityChange, 0, len(updates))
+	for i := range lease.Items {
+		if lease.Items[i].Quantity != newItems[i].Quantity {
+			changes = append(changes, LeaseItemQuantityChange{
+				SkuUUID:     lease.Items[i].SkuUuid,
+				ServiceName: lease.Items[i].ServiceName,
+				OldQuantity: lease.Items[i].Quantity,
+				NewQuantity: newItems[i].Quantity,
+			})
+		}
+	}
+	if len(changes) == 0 {
+		return nil, types.ErrInvalidRequest.Wrapf("update does not change any quantity on lease %s", leaseUUID)
+	}
+
+	if scaledUp {
+		if err := k.validateScaleUpCatalog(ctx, lease, changes); err != nil {
+			return nil, err
+		}
+		if err := k.requireSKUCapacity(ctx, lease.Items, newItems); err != nil {
+			return nil, err
+		}
+	}
+
+	shouldClose, _, err := k.ShouldAutoCloseLease(ctx, &lease)
+	if err != nil {
+		return nil, err
+	}
+	if shouldClose || lease.GraceEndsAt != nil {
+		return nil, types.ErrInsufficientCredit.Wrapf("credit for lease %s is exhausted; close the lease or fund the credit account first", leaseUUID)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Settle at the old quantities.
+	settlement, err := k.PerformSettlement(ctx, &lease, blockTime, types.SETTLEMENT_TRIGGER_ITEMS_CHANGED)
+	if err != nil {
+		return nil, err
+	}
+
+	creditAccount, err := k.GetCreditAccount(ctx, tenant)
+	if err != nil {
+		return nil, types.ErrCreditAccountNotFound.Wrapf("tenant %s has no credit account", tenant)
+	}
+
+	updated := lease
+	updated.Items = newItems
+	updated.LastSettledAt = blockTime
+
+	oldReservation := types.GetLeaseReservationAmount(&lease, params.MinLeaseDuration)
+	newReservation := types.GetLeaseReservationAmount(&updated, params.MinLeaseDuration)
+
+	// Release the old reservation before checking, so the lease's own reservation
+	// counts towards the credit it may use; only the increase must be available.
+	k.ReleaseLeaseReservation(&creditAccount, &lease, params.MinLeaseDuration)
+
+	if scaledUp {
+		grown := sdk.NewCoins()
+		for _, res := range newReservation {
+			if res.Amount.GT(oldReservation.AmountOf(res.Denom)) {
+				grown = grown.Add(res)
+			}
+		}
+		if err := k.requireAvailableCredit(ctx, creditAccount, grown); err != nil {
+			return nil, err
+		}
+	}
+
+	creditAccount.ReservedAmounts = types.AddReservation(creditAccount.ReservedAmounts, newReservation)
+
+	if err := k.SetLease(ctx, updated); err != nil {
+		return nil, err
+	}
+	if err := k.SetCreditAccount(ctx, creditAccount); err != nil {
+		return nil, err
+	}
+	if err := k.refreshCreditExhaustion(ctx, tenant); err != nil {
+		return nil, err
+	}
+
+	return &LeaseItemsUpdateResult{
+		Lease:          updated,
+		SettledAmounts: settlement.TransferAmounts,
+		PreviousRate:   leaseItemRates(lease.Items),
+		NewRate:        leaseItemRates(newItems),
+		Changes:        changes,
+	}, nil
+}
+
+// requireAvailableCredit returns ErrInsufficientCredit unless the tenant's
+// available credit (balance minus ca.ReservedAmounts) covers need in every
+// denom. When it does not, but every denom of need has an exchange rate, the
+// tenant's credit in all convertible denoms may cover the reservations and
+// need together instead.
+func (k *Keeper) requireAvailableCredit(ctx context.Context, ca types.CreditAccount, need sdk.Coins) error {
+	if need.IsZero() {
+		return nil
+	}
+	denoms := make([]string, 0, len(need))
+	for _, coin := range need {
+		denoms = append(denoms, coin.Denom)
+	}
+	creditBalances, err := k.getCreditBalancesForDenoms(ctx, ca.Tenant, denoms)
+	if err != nil {
+		return err
+	}
+	availableCredit := types.GetAvailableCredit(creditBalances, ca.ReservedAmounts)
+	for _, res := range need {
+		available := availableCredit.AmountOf(res.Denom)
+		if available.GTE(res.Amount) {
+			continue
+		}
+
+		covered, err := k.coversByConversion(ctx, ca.Tenant, need, ca.ReservedAmounts, nil)
+		if err != nil {
+			return err
+		}
+		if covered {
+			return nil
+		}
+		return types.ErrInsufficientCredit.Wrapf(
+			"insufficient available credit for denom %s: need %s, have %s available (balance: %s, reserved: %s)",
+			res.Denom,
+			res.Amount.String(),
+			available.String(),
+			creditBalances.AmountOf(res.Denom).String(),
+			ca.ReservedAmounts.AmountOf(res.Denom).String(),
+		)
+	}
+	return nil
+}
+
+// validateScaleUpCatalog checks that capacity is only added for SKUs and a
+// provider that are still active, mirroring the lease-creation checks. The
+// locked price is kept regardless of the SKU's current price.
+func (k *Keeper) validateScaleUpCatalog(ctx context.Context, lease types.Lease, changes []LeaseItemQuantityChange) error {
+	provider, err := k.skuKeeper.GetProvider(ctx, lease.ProviderUuid)
+	if err != nil {
+		return types.ErrProviderNotFound.Wrapf("provider_uuid %s not found", lease.ProviderUuid)
+	}
+	if !provider.Active {
+		return types.ErrProviderNotActive.Wrapf("provider_uuid %s is not active", lease.ProviderUuid)
+	}
+
+	for _, change := range changes {
+		if change.NewQuantity <= change.OldQuantity {
+			continue
+		}
+		sku, err := k.skuKeeper.GetSKU(ctx, change.SkuUUID)
+		if err != nil {
+			return types.ErrSKUNotFound.Wrapf("sku_uuid %s not found", change.SkuUUID)
+		}
+		if !sku.Active {
+			return types.ErrSKUNotActive.Wrapf("sku_uuid %s is not active", change.SkuUUID)
+		}
+	}
+	return nil
+}
+
+// emitLeaseItemsUpdatedEvents emits one lease_item_scaled event per changed item
+// followed by a lease_items_updated summary event.
+func emitLeaseItemsUpdatedEvents(ctx sdk.Context, result *LeaseItemsUpdateResult) {
+	lease := result.Lease
+	for _, change := range result.Changes {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeLeaseItemScaled,
+				sdk.NewAttribute(types.AttributeKeyLeaseUUID, lease.Uuid),
+				sdk.NewAttribute(types.AttributeKeySkuUUID, change.SkuUUID),
+				sdk.NewAttribute(types.AttributeKeyServiceName, change.ServiceName),
+				sdk.NewAttribute(types.AttributeKeyOldQuantity, strconv.FormatUint(change.OldQuantity, 10)),
+				sdk.NewAttribute(types.AttributeKeyNewQuantity, strconv.FormatUint(change.NewQuantity, 10)),
+			),
+		)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeLeaseItemsUpdated,
+			sdk.NewAttribute(types.AttributeKeyLeaseUUID, lease.Uuid),
+			sdk.NewAttribute(types.AttributeKeyTenant, lease.Tenant),
+			sdk.NewAttribute(types.AttributeKeyProviderUUID, lease.ProviderUuid),
+			sdk.NewAttribute(types.AttributeKeyItemCount, strconv.Itoa(len(result.Changes))),
+			sdk.NewAttribute(types.AttributeKeyPreviousRate, result.PreviousRate.String()),
+			sdk.NewAttribute(types.AttributeKeyTotalRate, result.NewRate.String()),
+			sdk.NewAttribute(types.AttributeKeySettledAmounts, result.SettledAmounts.String()),
+		),
+	)
+}
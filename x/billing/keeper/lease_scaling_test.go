@@ -0,0 +1,280 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/keeper"
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+	skutypes "github.com/manifest-network/manifest-ledger/x/sku/types"
+)
+
+// leaseScalingStart is the block time at which setupLeaseScaling creates and
+// acknowledges its lease: noon on 31 January 2026, half a day before a month
+// boundary.
+var leaseScalingStart = time.Date(2026, time.January, 31, 12, 0, 0, 0, time.UTC)
+
+// leaseScalingSetup holds an ACTIVE 1-item legacy lease (quantity 2) on a SKU
+// priced at price per unit. Most tests use 3600umfx/hour, i.e. 1umfx per
+// second per unit.
+type leaseScalingSetup struct {
+	f            *testFixture
+	msgServer    types.MsgServer
+	tenant       sdk.AccAddress
+	providerAddr sdk.AccAddress
+	payoutAddr   sdk.AccAddress
+	provider     skutypes.Provider
+	sku          skutypes.SKU
+	leaseUUID    string
+}
+
+// setupLeaseScaling funds the tenant with credit and creates the lease at
+// leaseScalingStart on a new SKU billed per unit at price.
+func setupLeaseScaling(t *testing.T, credit int64, unit skutypes.Unit, price int64) *leaseScalingSetup {
+	t.Helper()
+	f := initFixture(t)
+	f.Ctx = f.Ctx.WithBlockTime(leaseScalingStart)
+	msgServer := keeper.NewMsgServerImpl(f.App.BillingKeeper)
+
+	tenant := f.TestAccs[0]
+	providerAddr := f.TestAccs[1]
+	payoutAddr := f.TestAccs[2]
+
+	provider := f.createTestProvider(t, providerAddr.String(), payoutAddr.String())
+	skuUUID, err := f.App.SKUKeeper.GenerateSKUUUID(f.Ctx)
+	require.NoError(t, err)
+	sku := skutypes.SKU{
+		Uuid:         skuUUID,
+		ProviderUuid: provider.Uuid,
+		Name:         "Test SKU",
+		Unit:         unit,
+		BasePrice:    sdk.NewCoin(testDenom, sdkmath.NewInt(price)),
+		Active:       true,
+	}
+	require.NoError(t, f.App.SKUKeeper.SetSKU(f.Ctx, sku))
+
+	f.fundCreditViaMsg(t, msgServer, tenant, credit)
+
+	leaseUUID := f.createAndAcknowledgeLease(t, msgServer, tenant, providerAddr, []types.LeaseItemInput{
+		{SkuUuid: sku.Uuid, Quantity: 2},
+	})
+
+	return &leaseScalingSetup{
+		f: f, msgServer: msgServer,
+		tenant: tenant, providerAddr: providerAddr, payoutAddr: payoutAddr,
+		provider: provider, sku: sku, leaseUUID: leaseUUID,
+	}
+}
+
+func (s *leaseScalingSetup) reserved(t *testing.T) sdkmath.Int {
+	t.Helper()
+	ca, err := s.f.App.BillingKeeper.GetCreditAccount(s.f.Ctx, s.tenant.String())
+	require.NoError(t, err)
+	return ca.ReservedAmounts.AmountOf(testDenom)
+}
+
+func TestMsgUpdateLeaseItems_ScaleUp(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+
+	// 2 units * 1/s * 3600s min_lease_duration
+	require.Equal(t, sdkmath.NewInt(7200), s.reserved(t))
+
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(100 * time.Second))
+	f.Ctx = f.Ctx.WithEventManager(sdk.NewEventManager())
+	payoutBefore := f.App.BankKeeper.GetBalance(f.Ctx, s.payoutAddr, testDenom).Amount
+
+	resp, err := s.msgServer.UpdateLeaseItems(f.Ctx, &types.MsgUpdateLeaseItems{
+		Tenant:    s.tenant.String(),
+		LeaseUuid: s.leaseUUID,
+		Items:     []types.LeaseItemInput{{SkuUuid: s.sku.Uuid, Quantity: 6}},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 1)
+	require.Equal(t, uint64(6), resp.Items[0].Quantity)
+
+	// The first 100s are settled at the old quantity.
+	require.Equal(t, sdkmath.NewInt(200), resp.SettledAmounts.AmountOf(testDenom))
+	require.Equal(t, payoutBefore.AddRaw(200), f.App.BankKeeper.GetBalance(f.Ctx, s.payoutAddr, testDenom).Amount)
+
+	lease, err := f.App.BillingKeeper.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), lease.Items[0].Quantity)
+	require.True(t, lease.LastSettledAt.Equal(f.Ctx.BlockTime()))
+	require.Equal(t, types.LEASE_STATE_ACTIVE, lease.State)
+	require.Equal(t, sdkmath.NewInt(21600), s.reserved(t))
+
+	var scaled, summary int
+	for _, ev := range f.Ctx.EventManager().Events() {
+		switch ev.Type {
+		case types.EventTypeLeaseItemScaled:
+			scaled++
+		case types.EventTypeLeaseItemsUpdated:
+			summary++
+		}
+	}
+	require.Equal(t, 1, scaled)
+	require.Equal(t, 1, summary)
+
+	// New quantities bill from the update onwards.
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(10 * time.Second))
+	withdrawable := f.App.BillingKeeper.CalculateWithdrawableForLease(f.Ctx, lease)
+	require.Equal(t, sdkmath.NewInt(60), withdrawable.AmountOf(testDenom))
+}
+
+func TestMsgUpdateLeaseItems_ScaleUpInsufficientCredit(t *testing.T) {
+	// 10_000 covers the initial 7_200 reservation but not 3 units (10_800).
+	s := setupLeaseScaling(t, 10_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+
+	_, err := s.msgServer.UpdateLeaseItems(s.f.Ctx, &types.MsgUpdateLeaseItems{
+		Tenant:    s.tenant.String(),
+		LeaseUuid: s.leaseUUID,
+		Items:     []types.LeaseItemInput{{SkuUuid: s.sku.Uuid, Quantity: 3}},
+	})
+	require.ErrorIs(t, err, types.ErrInsufficientCredit)
+
+	lease, err := s.f.App.BillingKeeper.GetLease(s.f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), lease.Items[0].Quantity)
+	require.Equal(t, sdkmath.NewInt(7200), s.reserved(t))
+}
+
+func TestMsgUpdateLeaseItems_ScaleDown(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+
+	// Deactivating the SKU blocks scale-up but never scale-down.
+	sku := s.sku
+	sku.Active = false
+	require.NoError(t, f.App.SKUKeeper.SetSKU(f.Ctx, sku))
+
+	_, err := s.msgServer.UpdateLeaseItems(f.Ctx, &types.MsgUpdateLeaseItems{
+		Tenant:    s.tenant.String(),
+		LeaseUuid: s.leaseUUID,
+		Items:     []types.LeaseItemInput{{SkuUuid: s.sku.Uuid, Quantity: 3}},
+	})
+	require.ErrorIs(t, err, types.ErrSKUNotActive)
+
+	_, err = s.msgServer.UpdateLeaseItems(f.Ctx, &types.MsgUpdateLeaseItems{
+		Tenant:    s.tenant.String(),
+		LeaseUuid: s.leaseUUID,
+		Items:     []types.LeaseItemInput{{SkuUuid: s.sku.Uuid, Quantity: 1}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(3600), s.reserved(t))
+}
+
+func TestMsgUpdateLeaseItems_Errors(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+
+	otherSKU := f.createTestSKU(t, s.provider.Uuid, 3600)
+
+	tests := []struct {
+		name   string
+		msg    *types.MsgUpdateLeaseItems
+		target error
+	}{
+		{
+			name: "not the tenant",
+			msg: &types.MsgUpdateLeaseItems{
+				Tenant:    s.providerAddr.String(),
+				LeaseUuid: s.leaseUUID,
+				Items:     []types.LeaseItemInput{{SkuUuid: s.sku.Uuid, Quantity: 3}},
+			},
+			target: types.ErrUnauthorized,
+		},
+		{
+			name: "sku not in lease",
+			msg: &types.MsgUpdateLeaseItems{
+				Tenant:    s.tenant.String(),
+				LeaseUuid: s.leaseUUID,
+				Items:     []types.LeaseItemInput{{SkuUuid: otherSKU.Uuid, Quantity: 3}},
+			},
+			target: types.ErrLeaseItemNotFound,
+		},
+		{
+			name: "service_name on legacy lease",
+			msg: &types.MsgUpdateLeaseItems{
+				Tenant:    s.tenant.String(),
+				LeaseUuid: s.leaseUUID,
+				Items:     []types.LeaseItemInput{{SkuUuid: s.sku.Uuid, Quantity: 3, ServiceName: "web"}},
+			},
+			target: types.ErrInvalidServiceName,
+		},
+		{
+			name: "no quantity change",
+			msg: &types.MsgUpdateLeaseItems{
+				Tenant:    s.tenant.String(),
+				LeaseUuid: s.leaseUUID,
+				Items:     []types.LeaseItemInput{{SkuUuid: s.sku.Uuid, Quantity: 2}},
+			},
+			target: types.ErrInvalidRequest,
+		},
+		{
+			name: "zero quantity",
+			msg: &types.MsgUpdateLeaseItems{
+				Tenant:    s.tenant.String(),
+				LeaseUuid: s.leaseUUID,
+				Items:     []types.LeaseItemInput{{SkuUuid: s.sku.Uuid, Quantity: 0}},
+			},
+			target: types.ErrInvalidQuantity,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := s.msgServer.UpdateLeaseItems(f.Ctx, tc.msg)
+			require.ErrorIs(t, err, tc.target)
+		})
+	}
+
+	// PENDING leases cannot be scaled.
+	createResp, err := s.msgServer.CreateLease(f.Ctx, &types.MsgCreateLease{
+		Tenant: s.tenant.String(),
+		Items:  []types.LeaseItemInput{{SkuUuid: otherSKU.Uuid, Quantity: 1}},
+	})
+	require.NoError(t, err)
+	_, err = s.msgServer.UpdateLeaseItems(f.Ctx, &types.MsgUpdateLeaseItems{
+		Tenant:    s.tenant.String(),
+		LeaseUuid: createResp.LeaseUuid,
+		Items:     []types.LeaseItemInput{{SkuUuid: otherSKU.Uuid, Quantity: 2}},
+	})
+	require.ErrorIs(t, err, types.ErrLeaseNotActive)
+}
+
+func TestMsgUpdateLeaseItems_ServiceNameMode(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+
+	leaseUUID := f.createAndAcknowledgeLease(t, s.msgServer, s.tenant, s.providerAddr, []types.LeaseItemInput{
+		{SkuUuid: s.sku.Uuid, Quantity: 1, ServiceName: "web"},
+		{SkuUuid: s.sku.Uuid, Quantity: 1, ServiceName: "db"},
+	})
+
+	// Items are addressed by service_name; the SKU must match.
+	otherSKU := f.createTestSKU(t, s.provider.Uuid, 3600)
+	_, err := s.msgServer.UpdateLeaseItems(f.Ctx, &types.MsgUpdateLeaseItems{
+		Tenant:    s.tenant.String(),
+		LeaseUuid: leaseUUID,
+		Items:     []types.LeaseItemInput{{SkuUuid: otherSKU.Uuid, Quantity: 4, ServiceName: "web"}},
+	})
+	require.ErrorIs(t, err, types.ErrLeaseItemNotFound)
+
+	resp, err := s.msgServer.UpdateLeaseItems(f.Ctx, &types.MsgUpdateLeaseItems{
+		Tenant:    s.tenant.String(),
+		LeaseUuid: leaseUUID,
+		Items:     []types.LeaseItemInput{{SkuUuid: s.sku.Uuid, Quantity: 4, ServiceName: "web"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "web", resp.Items[0].ServiceName)
+	require.Equal(t, uint64(4), resp.Items[0].Quantity)
+	require.Equal(t, "db", resp.Items[1].ServiceName)
+	require.Equal(t, uint64(1), resp.Items[1].Quantity)
+}
@@ -0,0 +1,216 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+)
+
+// hasScheduledEndEntry reports whether a lease belongs in LeasesByScheduledEnd.
+// Only ACTIVE leases are indexed: a PENDING lease gets its entry when it is
+// acknowledged, and any terminal state drops it.
+func hasScheduledEndEntry(lease types.Lease) bool {
+	return lease.State == types.LEASE_STATE_ACTIVE && lease.ScheduledEndAt != nil
+}
+
+// reconcileScheduledEndIndex keeps LeasesByScheduledEnd in step with a
+// SetLease write: the previous entry is removed when the lease left ACTIVE or
+// its end time moved, and the current one installed.
+func (k *Keeper) reconcileScheduledEndIndex(ctx context.Context, prev types.Lease, hadPrev bool, lease types.Lease) error {
+	if hadPrev && hasScheduledEndEntry(prev) {
+		if !hasScheduledEndEntry(lease) || !prev.ScheduledEndAt.Equal(*lease.ScheduledEndAt) {
+			if err := k.LeasesByScheduledEnd.Remove(ctx, collections.Join(*prev.ScheduledEndAt, prev.Uuid)); err != nil {
+				return err
+			}
+		}
+	}
+	if hasScheduledEndEntry(lease) {
+		return k.LeasesByScheduledEnd.Set(ctx, collections.Join(*lease.ScheduledEndAt, lease.Uuid))
+	}
+	return nil
+}
+
+// validateScheduledEnd checks a requested scheduled_end_at against block time.
+func validateScheduledEnd(endAt, blockTime time.Time) error {
+	if !endAt.After(blockTime) {
+		return types.ErrInvalidLease.Wrapf(
+			"scheduled_end_at %s must be after the current block time %s",
+			endAt.UTC().Format(time.RFC3339), blockTime.UTC().Format(time.RFC3339),
+		)
+	}
+	return nil
+}
+
+// SetLeaseScheduledEnd sets or extends the scheduled end of a PENDING or
+// ACTIVE lease owned by tenant. An existing end time can only be moved later;
+// a tenant who wants to stop sooner closes the lease instead. It returns the
+// updated lease and the previous end time, if any.
+func (k *Keeper) SetLeaseScheduledEnd(ctx context.Context, tenant, leaseUUID string, endAt time.Time) (types.Lease, *time.Time, error) {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	lease, err := k.GetLease(ctx, leaseUUID)
+	if err != nil {
+		return types.Lease{}, nil, err
+	}
+	if lease.Tenant != tenant {
+		return types.Lease{}, nil, types.ErrUnauthorized.Wrapf("sender %s is not the tenant of lease %s", tenant, leaseUUID)
+	}
+	if lease.State != types.LEASE_STATE_PENDING && lease.State != types.LEASE_STATE_ACTIVE {
+		return types.Lease{}, nil, types.ErrLeaseNotActive.Wrapf("lease %s is %s", leaseUUID, lease.State)
+	}
+
+	if err := validateScheduledEnd(endAt, blockTime); err != nil {
+		return types.Lease{}, nil, err
+	}
+
+	previous := lease.ScheduledEndAt
+	if previous != nil && !endAt.After(*previous) {
+		return types.Lease{}, nil, types.ErrInvalidLease.Wrapf(
+			"scheduled_end_at can only be extended: %s is not after the current %s",
+			endAt.UTC().Format(time.RFC3339), previous.UTC().Format(time.RFC3339),
+		)
+	}
+
+	endAt = endAt.UTC()
+	lease.ScheduledEndAt = &endAt
+	if err := k.SetLease(ctx, lease); err != nil {
+		return types.Lease{}, nil, err
+	}
+
+	return lease, previous, nil
+}
+
+// closeScheduledLeases settles and closes ACTIVE leases whose scheduled end
+// has been reached. Like pending-lease expiry it collects first and then
+// mutates, capped at MaxScheduledLeaseClosuresPerBlock. A lease is settled up
+// to its scheduled end rather than block time, so one carried over to a later
+// block is not billed for the delay.
+func (k *Keeper) closeScheduledLeases(ctx context.Context, minLeaseDuration uint64) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+
+	iter, err := k.LeasesByScheduledEnd.Iterate(ctx, collections.NewPrefixUntilPairRange[time.Time, string](blockTime))
+	if err != nil {
+		return err
+	}
+
+	var dueUUIDs []string
+	for ; iter.Valid(); iter.Next() {
+		if len(dueUUIDs) >= types.MaxScheduledLeaseClosuresPerBlock {
+			break
+		}
+		key, err := iter.Key()
+		if err != nil {
+			k.logger.Error("failed to decode scheduled end index key", "error", err)
+			continue
+		}
+		dueUUIDs = append(dueUUIDs, key.K2())
+	}
+
+	if err := iter.Close(); err != nil {
+		k.logger.Error("failed to close iterator", "error", err)
+	}
+
+	closedCount := 0
+	for _, leaseUUID := range dueUUIDs {
+		lease, err := k.Leases.Get(ctx, leaseUUID)
+		if err != nil {
+			k.logger.Error("failed to get lease for scheduled closure",
+				"lease_uuid", leaseUUID,
+				"error", err,
+			)
+			continue
+		}
+		if lease.State != types.LEASE_STATE_ACTIVE || lease.ScheduledEndAt == nil {
+			// SetLease keeps the index to ACTIVE leases; this is only reachable
+			// through an inconsistent store.
+			k.logger.Error("scheduled end index references a lease that is not due",
+				"lease_uuid", leaseUUID,
+				"state", lease.State.String(),
+			)
+			continue
+		}
+
+		closeTime := *lease.ScheduledEndAt
+		if closeTime.Before(lease.LastSettledAt) {
+			closeTime = lease.LastSettledAt
+		}
+		duration := closeTime.Sub(lease.LastSettledAt)
+
+		cacheCtx, writeCache := sdkCtx.CacheContext()
+		result, err := k.closeLeaseAt(cacheCtx, &lease, closeTime, minLeaseDuration, types.ClosureReasonScheduledEnd)
+		if err != nil {
+			k.logger.Error("failed to close lease at scheduled end",
+				"lease_uuid", leaseUUID,
+				"error", err,
+			)
+			continue
+		}
+		creditAccount, err := k.GetCreditAccount(cacheCtx, lease.Tenant)
+		if err != nil {
+			k.logger.Error("failed to get credit account after scheduled closure",
+				"lease_uuid", leaseUUID,
+				"error", err,
+			)
+			continue
+		}
+		if err := k.refreshCreditExhaustion(cacheCtx, lease.Tenant); err != nil {
+			k.logger.Error("failed to refresh credit exhaustion after scheduled closure",
+				"lease_uuid", leaseUUID,
+				"error", err,
+			)
+			continue
+		}
+		writeCache()
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeLeaseClosed,
+				sdk.NewAttribute(types.AttributeKeyLeaseUUID, lease.Uuid),
+				sdk.NewAttribute(types.AttributeKeyTenant, lease.Tenant),
+				sdk.NewAttribute(types.AttributeKeyProviderUUID, lease.ProviderUuid),
+				sdk.NewAttribute(types.AttributeKeySettledAmounts, result.TransferAmounts.String()),
+				sdk.NewAttribute(types.AttributeKeyClosedBy, "scheduled_end"),
+				sdk.NewAttribute(types.AttributeKeyDuration, strconv.FormatInt(int64(duration/time.Second), 10)),
+				sdk.NewAttribute(types.AttributeKeyActiveLeaseCount, strconv.FormatUint(creditAccount.ActiveLeaseCount, 10)),
+				sdk.NewAttribute(types.AttributeKeyClosureReason, types.ClosureReasonScheduledEnd),
+			),
+		)
+		closedCount++
+	}
+
+	if closedCount > 0 {
+		k.logger.Info("closed leases at scheduled end in EndBlocker",
+			"closed_count", closedCount,
+			"collected_count", len(dueUUIDs),
+		)
+	}
+
+	if len(dueUUIDs) >= types.MaxScheduledLeaseClosuresPerBlock {
+		k.logger.Warn("reached max scheduled lease closures per block",
+			"limit", types.MaxScheduledLeaseClosuresPerBlock,
+		)
+	}
+
+	return nil
+}
+
+// emitLeaseScheduledEndSetEvent emits a lease_scheduled_end_set event.
+func emitLeaseScheduledEndSetEvent(ctx sdk.Context, lease types.Lease, previous *time.Time) {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyLeaseUUID, lease.Uuid),
+		sdk.NewAttribute(types.AttributeKeyTenant, lease.Tenant),
+		sdk.NewAttribute(types.AttributeKeyProviderUUID, lease.ProviderUuid),
+		sdk.NewAttribute(types.AttributeKeyScheduledEndAt, lease.ScheduledEndAt.Format(time.RFC3339)),
+	}
+	if previous != nil {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyPreviousEndAt, previous.UTC().Format(time.RFC3339)))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeLeaseScheduledEndSet, attrs...))
+}
@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+	skutypes "github.com/manifest-network/manifest-ledger/x/sku/types"
+)
+
+func TestScheduledEnd_ClosesLeaseInEndBlocker(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+
+	endAt := f.Ctx.BlockTime().Add(1000 * time.Second).UTC()
+	createResp, err := s.msgServer.CreateLease(f.Ctx, &types.MsgCreateLease{
+		Tenant:         s.tenant.String(),
+		Items:          []types.LeaseItemInput{{SkuUuid: s.sku.Uuid, Quantity: 1}},
+		ScheduledEndAt: &endAt,
+	})
+	require.NoError(t, err)
+	leaseUUID := createResp.LeaseUuid
+
+	// PENDING leases are not indexed until acknowledged.
+	has, err := f.App.BillingKeeper.LeasesByScheduledEnd.Has(f.Ctx, collections.Join(endAt, leaseUUID))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	_, err = s.msgServer.AcknowledgeLease(f.Ctx, &types.MsgAcknowledgeLease{
+		Sender:     s.providerAddr.String(),
+		LeaseUuids: []string{leaseUUID},
+	})
+	require.NoError(t, err)
+	has, err = f.App.BillingKeeper.LeasesByScheduledEnd.Has(f.Ctx, collections.Join(endAt, leaseUUID))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	f.Ctx = f.Ctx.WithBlockTime(endAt.Add(-time.Second))
+	require.NoError(t, f.App.BillingKeeper.EndBlocker(f.Ctx))
+	lease, err := f.App.BillingKeeper.GetLease(f.Ctx, leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_ACTIVE, lease.State)
+
+	// Closed in a block after the scheduled end, but billed only up to it.
+	payoutBefore := f.App.BankKeeper.GetBalance(f.Ctx, s.payoutAddr, testDenom).Amount
+	f.Ctx = f.Ctx.WithBlockTime(endAt.Add(500 * time.Second))
+	f.Ctx = f.Ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.App.BillingKeeper.EndBlocker(f.Ctx))
+
+	lease, err = f.App.BillingKeeper.GetLease(f.Ctx, leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_CLOSED, lease.State)
+	require.Equal(t, types.ClosureReasonScheduledEnd, lease.ClosureReason)
+	require.True(t, lease.ClosedAt.Equal(endAt))
+	require.Equal(t, payoutBefore.AddRaw(1000), f.App.BankKeeper.GetBalance(f.Ctx, s.payoutAddr, testDenom).Amount)
+	require.Equal(t, 1, countEvents(f.Ctx, types.EventTypeLeaseClosed))
+
+	has, err = f.App.BillingKeeper.LeasesByScheduledEnd.Has(f.Ctx, collections.Join(endAt, leaseUUID))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// Only the setup lease's reservation remains.
+	require.Equal(t, sdkmath.NewInt(7200), s.reserved(t))
+	ca, err := f.App.BillingKeeper.GetCreditAccount(f.Ctx, s.tenant.String())
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), ca.ActiveLeaseCount)
+}
+
+func TestScheduledEnd_MustBeInFuture(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+
+	endAt := f.Ctx.BlockTime()
+	_, err := s.msgServer.CreateLease(f.Ctx, &types.MsgCreateLease{
+		Tenant:         s.tenant.String(),
+		Items:          []types.LeaseItemInput{{SkuUuid: s.sku.Uuid, Quantity: 1}},
+		ScheduledEndAt: &endAt,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidLease)
+
+	_, err = s.msgServer.SetLeaseScheduledEnd(f.Ctx, &types.MsgSetLeaseScheduledEnd{
+		Tenant:         s.tenant.String(),
+		LeaseUuid:      s.leaseUUID,
+		ScheduledEndAt: endAt.Add(-time.Hour),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidLease)
+}
+
+func TestMsgSetLeaseScheduledEnd(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+
+	first := f.Ctx.BlockTime().Add(time.Hour).UTC()
+	f.Ctx = f.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err := s.msgServer.SetLeaseScheduledEnd(f.Ctx, &types.MsgSetLeaseScheduledEnd{
+		Tenant:         s.tenant.String(),
+		LeaseUuid:      s.leaseUUID,
+		ScheduledEndAt: first,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, countEvents(f.Ctx, types.EventTypeLeaseScheduledEndSet))
+
+	_, err = s.msgServer.SetLeaseScheduledEnd(f.Ctx, &types.MsgSetLeaseScheduledEnd{
+		Tenant:         s.providerAddr.String(),
+		LeaseUuid:      s.leaseUUID,
+		ScheduledEndAt: first.Add(time.Hour),
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// Moving the end earlier is refused; the tenant can close instead.
+	_, err = s.msgServer.SetLeaseScheduledEnd(f.Ctx, &types.MsgSetLeaseScheduledEnd{
+		Tenant:         s.tenant.String(),
+		LeaseUuid:      s.leaseUUID,
+		ScheduledEndAt: first.Add(-time.Minute),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidLease)
+
+	extended := first.Add(24 * time.Hour)
+	_, err = s.msgServer.SetLeaseScheduledEnd(f.Ctx, &types.MsgSetLeaseScheduledEnd{
+		Tenant:         s.tenant.String(),
+		LeaseUuid:      s.leaseUUID,
+		ScheduledEndAt: extended,
+	})
+	require.NoError(t, err)
+
+	lease, err := f.App.BillingKeeper.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.True(t, lease.ScheduledEndAt.Equal(extended))
+
+	has, err := f.App.BillingKeeper.LeasesByScheduledEnd.Has(f.Ctx, collections.Join(first, s.leaseUUID))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.App.BillingKeeper.LeasesByScheduledEnd.Has(f.Ctx, collections.Join(extended, s.leaseUUID))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// The original end passes without closing the lease.
+	f.Ctx = f.Ctx.WithBlockTime(first.Add(time.Minute))
+	require.NoError(t, f.App.BillingKeeper.EndBlocker(f.Ctx))
+	lease, err = f.App.BillingKeeper.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_ACTIVE, lease.State)
+
+	// A manual close drops the index entry.
+	_, err = s.msgServer.CloseLease(f.Ctx, &types.MsgCloseLease{
+		Sender:     s.tenant.String(),
+		LeaseUuids: []string{s.leaseUUID},
+	})
+	require.NoError(t, err)
+	has, err = f.App.BillingKeeper.LeasesByScheduledEnd.Has(f.Ctx, collections.Join(extended, s.leaseUUID))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	_, err = s.msgServer.SetLeaseScheduledEnd(f.Ctx, &types.MsgSetLeaseScheduledEnd{
+		Tenant:         s.tenant.String(),
+		LeaseUuid:      s.leaseUUID,
+		ScheduledEndAt: extended.Add(time.Hour),
+	})
+	require.ErrorIs(t, err, types.ErrLeaseNotActive)
+}
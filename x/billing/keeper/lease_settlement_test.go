@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/keeper"
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+	skutypes "github.com/manifest-network/manifest-ledger/x/sku/types"
+)
+
+func TestLeaseSettlements_Recorded(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600) // 2 units per second
+	f := s.f
+	k := f.App.BillingKeeper
+	querier := keeper.NewQuerier(k)
+	start := f.Ctx.BlockTime()
+
+	f.Ctx = f.Ctx.WithBlockTime(start.Add(100 * time.Second))
+	_, err := s.msgServer.Withdraw(f.Ctx, &types.MsgWithdraw{
+		Sender:     s.providerAddr.String(),
+		LeaseUuids: []string{s.leaseUUID},
+	})
+	require.NoError(t, err)
+
+	f.Ctx = f.Ctx.WithBlockTime(start.Add(150 * time.Second))
+	closeLease(t, s, s.leaseUUID)
+
+	resp, err := querier.LeaseSettlements(f.Ctx, &types.QueryLeaseSettlementsRequest{LeaseUuid: s.leaseUUID})
+	require.NoError(t, err)
+	require.Len(t, resp.Settlements, 2)
+
+	withdrawal := resp.Settlements[0]
+	require.Equal(t, types.SETTLEMENT_TRIGGER_WITHDRAW, withdrawal.Trigger)
+	require.Equal(t, s.leaseUUID, withdrawal.LeaseUuid)
+	require.True(t, withdrawal.PeriodStart.Equal(start))
+	require.True(t, withdrawal.PeriodEnd.Equal(start.Add(100*time.Second)))
+	require.True(t, withdrawal.SettledAt.Equal(withdrawal.PeriodEnd))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 200)), withdrawal.Accrued)
+	require.Equal(t, withdrawal.Accrued, withdrawal.Transferred)
+	require.True(t, withdrawal.Shortfall.IsZero())
+
+	closure := resp.Settlements[1]
+	require.Equal(t, types.SETTLEMENT_TRIGGER_CLOSE, closure.Trigger)
+	require.Greater(t, closure.Id, withdrawal.Id)
+	require.True(t, closure.PeriodStart.Equal(withdrawal.PeriodEnd))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 100)), closure.Transferred)
+
+	lease, err := k.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 300)), lease.TotalSettled)
+
+	page, err := querier.LeaseSettlements(f.Ctx, &types.QueryLeaseSettlementsRequest{
+		LeaseUuid:  s.leaseUUID,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.LeaseSettlement{withdrawal}, page.Settlements)
+	require.NotEmpty(t, page.Pagination.NextKey)
+
+	exported := k.ExportGenesis(f.Ctx)
+	require.Equal(t, resp.Settlements, exported.LeaseSettlements)
+	require.Equal(t, closure.Id+1, exported.SettlementSequence)
+	require.NoError(t, exported.Validate())
+
+	// Records are pruned with their lease.
+	setLeaseRetention(t, f, 1000)
+	f.Ctx = f.Ctx.WithBlockTime(start.Add(1150 * time.Second))
+	require.NoError(t, k.EndBlocker(f.Ctx))
+
+	resp, err = querier.LeaseSettlements(f.Ctx, &types.QueryLeaseSettlementsRequest{LeaseUuid: s.leaseUUID})
+	require.NoError(t, err)
+	require.Empty(t, resp.Settlements)
+}
+
+func TestLeaseSettlements_AutoCloseShortfall(t *testing.T) {
+	f, msgServer, tenant, skus := setupLeaseBatch(t, 5_000)
+	k := f.App.BillingKeeper
+
+	leaseUUID := f.createAndAcknowledgeLease(t, msgServer, tenant, f.TestAccs[1], []types.LeaseItemInput{
+		{SkuUuid: skus[0], Quantity: 1},
+	})
+
+	// The EndBlocker runs a minute after the credit ran out; the closure
+	// settles up to block time and the last minute is forgiven.
+	exhaustAt, err := k.CreditExhaustion.Get(f.Ctx, collections.Join(tenant, testDenom))
+	require.NoError(t, err)
+	f.Ctx = f.Ctx.WithBlockTime(exhaustAt.Add(time.Minute))
+	require.NoError(t, k.EndBlocker(f.Ctx))
+
+	resp, err := keeper.NewQuerier(k).LeaseSettlements(f.Ctx, &types.QueryLeaseSettlementsRequest{LeaseUuid: leaseUUID})
+	require.NoError(t, err)
+	require.Len(t, resp.Settlements, 1)
+
+	settlement := resp.Settlements[0]
+	require.Equal(t, types.SETTLEMENT_TRIGGER_AUTO_CLOSE, settlement.Trigger)
+	require.True(t, settlement.PeriodEnd.Equal(f.Ctx.BlockTime()))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 5_060)), settlement.Accrued)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 5_000)), settlement.Transferred)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 60)), settlement.Shortfall)
+}
+
+func TestLeaseSettlements_EmptyLeaseUUID(t *testing.T) {
+	f := initFixture(t)
+	_, err := keeper.NewQuerier(f.App.BillingKeeper).LeaseSettlements(f.Ctx, &types.QueryLeaseSettlementsRequest{})
+	require.Error(t, err)
+}
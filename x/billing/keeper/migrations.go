@@ -1,7 +1,12 @@
 package keeper
 
 import (
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
 )
 
 // Migrator is a wrapper around Keeper used to register state migrations.
@@ -33,3 +38,106 @@ func NewMigrator(k Keeper) Migrator {
 func (m Migrator) Migrate1to2(_ sdk.Context) error {
 	return nil
 }
+
+// Migrate2to3 upgrades the state of the released consensus version 2 to the
+// layout of version 3. The stores introduced in v3 for state that cannot
+// exist before the upgrade (lease amendments, usage, disputes, settlement
+// records, promotional grants, exchange rates, credit deposits, provider
+// policies, grace periods and debt) start empty and need nothing. The rest is
+// derived from the params, leases and credit accounts in state:
+//
+//   - Params fields whose zero value would change behaviour get their
+//     defaults unless the upgrade plan already set them: a zero
+//     credit_withdrawal_cooldown would let tenants deposit and withdraw in the
+//     same block, and a zero dispute_response_period would disable disputes. The other new fields decode as zero,
+//     which leaves grace periods, pruning and conversion disabled.
+//   - Time-billed lease items get their decimal locked_rate from the exact
+//     whole-unit per-second rate in locked_price.
+//   - LeasesByTerminalAt, SKUAllocations and ProviderStats are built from the
+//     leases in state. Leases no longer in state cannot be counted, so
+//     provider stats start from the retained history.
+//   - The credit exhaustion projection of every credit account is seeded
+//     last, from the backfilled rates, so the EndBlocker closes the leases of
+//     tenants that were already running dry at upgrade time.
+//
+// Indexes are cleared before they are rebuilt, so the migration can be rerun
+// safely.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params, err := m.keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if params.CreditWithdrawalCooldown == 0 {
+		params.CreditWithdrawalCooldown = types.DefaultCreditWithdrawalCooldown
+	}
+	if params.DisputeResponsePeriod == 0 {
+		params.DisputeResponsePeriod = types.DefaultDisputeResponsePeriod
+	}
+	if err := m.keeper.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	var leases []types.Lease
+	err = m.keeper.Leases.Walk(ctx, nil, func(_ string, lease types.Lease) (bool, error) {
+		leases = append(leases, lease)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := m.keeper.LeasesByTerminalAt.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := m.keeper.SKUAllocations.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := m.keeper.ProviderStats.Clear(ctx, nil); err != nil {
+		return err
+	}
+	for _, lease := range leases {
+		if backfillLockedRates(lease.Items) {
+			if err := m.keeper.Leases.Set(ctx, lease.Uuid, lease); err != nil {
+				return err
+			}
+		}
+		if hasTerminalEntry(lease) {
+			at, _ := terminalAt(lease)
+			if err := m.keeper.LeasesByTerminalAt.Set(ctx, collections.Join(at, lease.Uuid)); err != nil {
+				return err
+			}
+		}
+		if err := m.keeper.reconcileSKUAllocations(ctx, types.Lease{}, false, lease); err != nil {
+			return err
+		}
+		if err := m.keeper.recordLeaseHistory(ctx, lease); err != nil {
+			return err
+		}
+	}
+
+	accounts, err := m.keeper.GetAllCreditAccounts(ctx)
+	if err != nil {
+		return err
+	}
+	for _, ca := range accounts {
+		if err := m.keeper.refreshCreditExhaustion(ctx, ca.Tenant); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backfillLockedRates sets locked_rate from locked_price on the time-billed
+// items that have none, and reports whether any item changed.
+func backfillLockedRates(items []types.LeaseItem) bool {
+	changed := false
+	for i := range items {
+		item := &items[i]
+		if item.Metered || (!item.LockedRate.IsNil() && item.LockedRate.IsPositive()) {
+			continue
+		}
+		item.LockedRate = sdkmath.LegacyNewDecFromInt(item.LockedPrice.Amount)
+		changed = true
+	}
+	return changed
+}
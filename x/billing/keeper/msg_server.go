@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
+	"slices"
 	"strconv"
 	"time"
 
@@ -46,10 +47,11 @@ func (ms msgServer) FundCredit(ctx context.Context, msg *types.MsgFundCredit) (*
 	}
 
 	// Derive credit address for the tenant
-	creditAddr, err := types.DeriveCreditAddressFromBech32(msg.Tenant)
+	tenantAddr, err := sdk.AccAddressFromBech32(msg.Tenant)
 	if err != nil {
 		return nil, err
 	}
+	creditAddr := types.DeriveCreditAddress(tenantAddr)
 
 	// Parse sender address
 	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
@@ -62,33 +64,37 @@ func (ms msgServer) FundCredit(ctx context.Context, msg *types.MsgFundCredit) (*
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	cacheCtx, writeCache := sdkCtx.CacheContext()
 
+	// Get or create credit account
+	creditAccount, err := ms.k.getOrCreateCreditAccount(cacheCtx, msg.Tenant, creditAddr)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.k.SetCreditAccount(cacheCtx, creditAccount); err != nil {
+		return nil, err
+	}
+
 	// Transfer tokens from sender to credit address
 	if err := ms.k.bankKeeper.SendCoins(cacheCtx, senderAddr, creditAddr, sdk.NewCoins(msg.Amount)); err != nil {
 		return nil, types.ErrInvalidCreditOperation.Wrapf("failed to transfer tokens: %s", err)
 	}
 
-	// Get or create credit account
-	creditAccount, err := ms.k.GetCreditAccount(cacheCtx, msg.Tenant)
-	if err != nil {
-		if !errors.Is(err, types.ErrCreditAccountNotFound) {
-			return nil, types.ErrInvalidCreditOperation.Wrapf("failed to get credit account: %s", err)
-		}
-		// Credit account doesn't exist, create it
-		creditAccount = types.CreditAccount{
-			Tenant:            msg.Tenant,
-			CreditAddress:     creditAddr.String(),
-			ActiveLeaseCount:  0,
-			PendingLeaseCount: 0,
-		}
+	// The deposit is locked for the credit_withdrawal_cooldown notice period,
+	// whoever sent it; it locks only itself.
+	if err := ms.k.recordCreditDeposit(cacheCtx, tenantAddr, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, err
+	}
 
-		// Ensure the credit account address is registered in the account keeper
-		if ms.k.accountKeeper.GetAccount(cacheCtx, creditAddr) == nil {
-			acc := ms.k.accountKeeper.NewAccountWithAddress(cacheCtx, creditAddr)
-			ms.k.accountKeeper.SetAccount(cacheCtx, acc)
-		}
+	// Debt and grace-period accruals are paid before the new funds count
+	// towards the exhaustion projection.
+	if err := ms.k.payCreditDebt(cacheCtx, msg.Tenant); err != nil {
+		return nil, err
 	}
 
-	if err := ms.k.SetCreditAccount(cacheCtx, creditAccount); err != nil {
+	if err := ms.k.refreshCreditExhaustion(cacheCtx, msg.Tenant); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.Hooks().AfterCreditFunded(cacheCtx, msg.Tenant, msg.Amount); err != nil {
 		return nil, err
 	}
 
@@ -121,14 +127,47 @@ type leaseCreationResult struct {
 	leaseUUID     string
 	providerUUID  string
 	itemCount     int
-	totalRates    sdk.Coins // total rate per second by denom
+	totalRates    sdk.DecCoins // total rate per second by denom
 	pendingLeases uint64
 	metaHash      []byte
+	// autoAcknowledged is set when the provider's policy created the lease
+	// directly in the ACTIVE state.
+	autoAcknowledged bool
+}
+
+// preparedLease holds a validated and priced lease input, ready to be stored.
+type preparedLease struct {
+	providerUUID   string
+	items          []types.LeaseItem
+	totalRates     sdk.DecCoins // total rate per second by denom
+	reservation    sdk.Coins
+	metaHash       []byte
+	scheduledEndAt *time.Time
+	// autoAcknowledge is copied from the provider's policy.
+	autoAcknowledge bool
 }
 
-// createLeaseInternal contains the shared lease creation logic.
-// It validates inputs, creates the lease, and returns the result for event emission.
-func (ms msgServer) createLeaseInternal(ctx context.Context, tenant string, items []types.LeaseItemInput, metaHash []byte) (*leaseCreationResult, error) {
+// createLeaseInternal creates a single lease; see createLeasesInternal.
+func (ms msgServer) createLeaseInternal(ctx context.Context, tenant string, items []types.LeaseItemInput, metaHash []byte, scheduledEndAt *time.Time) (*leaseCreationResult, error) {
+	results, err := ms.createLeasesInternal(ctx, tenant, []types.LeaseInput{{
+		Items:          items,
+		MetaHash:       metaHash,
+		ScheduledEndAt: scheduledEndAt,
+	}})
+	if err != nil {
+		return nil, err
+	}
+	return results[0], nil
+}
+
+// createLeasesInternal contains the shared lease creation logic.
+// Every input is validated and priced before anything is stored; the lease
+// limits and the combined reservation are then checked once for the whole
+// batch. Leases of providers whose policy sets auto_acknowledge start ACTIVE
+// and count towards max_leases_per_tenant instead of the pending limit.
+// Returns one result per input, in order, for event emission.
+// Callers needing all-or-nothing semantics must run it in a cache context.
+func (ms msgServer) createLeasesInternal(ctx context.Context, tenant string, inputs []types.LeaseInput) ([]*leaseCreationResult, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	blockTime := sdkCtx.BlockTime()
 
@@ -137,13 +176,20 @@ func (ms msgServer) createLeaseInternal(ctx context.Context, tenant string, item
 		return nil, err
 	}
 
-	// 0. Verify item count doesn't exceed max_items_per_lease param
-	if uint64(len(items)) > params.MaxItemsPerLease {
-		return nil, types.ErrTooManyLeaseItems.Wrapf(
-			"lease has %d items, maximum allowed is %d",
-			len(items),
-			params.MaxItemsPerLease,
-		)
+	// 0. Verify scheduled ends and item counts (max_items_per_lease param)
+	for _, input := range inputs {
+		if input.ScheduledEndAt != nil {
+			if err := validateScheduledEnd(*input.ScheduledEndAt, blockTime); err != nil {
+				return nil, err
+			}
+		}
+		if uint64(len(input.Items)) > params.MaxItemsPerLease {
+			return nil, types.ErrTooManyLeaseItems.Wrapf(
+				"lease has %d items, maximum allowed is %d",
+				len(input.Items),
+				params.MaxItemsPerLease,
+			)
+		}
 	}
 
 	// 1. Get credit account and verify tenant hasn't exceeded max leases (O(1) check)
@@ -160,35 +206,174 @@ func (ms msgServer) createLeaseInternal(ctx context.Context, tenant string, item
 		)
 	}
 
-	// Also check pending lease limit
-	if creditAccount.PendingLeaseCount >= params.MaxPendingLeasesPerTenant {
+	// 2. Verify SKUs, providers and provider policies and lock prices for each
+	// lease. Volume tiers count the tenant's open leases and the earlier items
+	// of the batch.
+	committed, err := ms.k.committedQuantities(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	prepared := make([]preparedLease, 0, len(inputs))
+	totalReservation := sdk.NewCoins()
+	var newPending, newActive uint64
+	for _, input := range inputs {
+		lease, err := ms.prepareLease(ctx, tenant, input.Items, params.MinLeaseDuration, committed)
+		if err != nil {
+			return nil, err
+		}
+		if lease.autoAcknowledge {
+			newActive++
+		} else {
+			newPending++
+		}
+		lease.metaHash = input.MetaHash
+		if input.ScheduledEndAt != nil {
+			endAt := input.ScheduledEndAt.UTC()
+			lease.scheduledEndAt = &endAt
+		}
+		prepared = append(prepared, lease)
+		totalReservation = totalReservation.Add(lease.reservation...)
+	}
+
+	// Check the lease limits against every lease of the batch
+	if creditAccount.PendingLeaseCount+newPending > params.MaxPendingLeasesPerTenant {
 		return nil, types.ErrMaxPendingLeasesReached.Wrapf(
-			"tenant has %d pending leases, max is %d",
+			"tenant has %d pending leases and requested %d more, max is %d",
 			creditAccount.PendingLeaseCount,
+			newPending,
 			params.MaxPendingLeasesPerTenant,
 		)
 	}
+	if creditAccount.ActiveLeaseCount+newActive > params.MaxLeasesPerTenant {
+		return nil, types.ErrMaxLeasesReached.Wrapf(
+			"tenant has %d active leases and %d more would be auto-acknowledged, max is %d",
+			creditAccount.ActiveLeaseCount,
+			newActive,
+			params.MaxLeasesPerTenant,
+		)
+	}
+
+	// Check SKU capacity against the combined quantities of the batch
+	var requested []types.LeaseItem
+	for _, p := range prepared {
+		requested = append(requested, p.items...)
+	}
+	if err := ms.k.requireSKUCapacity(ctx, nil, requested); err != nil {
+		return nil, err
+	}
+
+	// 3. Verify tenant has enough AVAILABLE credit for the combined reservation
+	// Available credit = balance - already reserved amounts
+	// This prevents overbooking where multiple leases could exhaust the same credit.
+	// Credit in other denoms counts when the reservation can be converted.
+	if err := ms.k.requireAvailableCredit(ctx, creditAccount, totalReservation); err != nil {
+		return nil, err
+	}
+
+	// Reserve credit immediately (PENDING leases lock credit before billing starts)
+	creditAccount.ReservedAmounts = types.AddReservation(creditAccount.ReservedAmounts, totalReservation)
+
+	// 4. Create leases with deterministic UUIDv7s
+	leases := make([]types.Lease, 0, len(prepared))
+	results := make([]*leaseCreationResult, 0, len(prepared))
+	for _, p := range prepared {
+		leaseSeq, err := ms.k.GetNextLeaseSequence(ctx)
+		if err != nil {
+			return nil, err
+		}
+		leaseUUID := pkguuid.GenerateUUIDv7(sdkCtx, types.ModuleName, leaseSeq)
+
+		lease := types.Lease{
+			Uuid:                       leaseUUID,
+			Tenant:                     tenant,
+			ProviderUuid:               p.providerUUID,
+			Items:                      p.items,
+			State:                      types.LEASE_STATE_PENDING, // Start in PENDING, awaiting provider acknowledgement
+			CreatedAt:                  blockTime,
+			LastSettledAt:              blockTime, // Will be updated to AcknowledgedAt when provider acknowledges
+			MetaHash:                   p.metaHash,
+			MinLeaseDurationAtCreation: params.MinLeaseDuration, // Store for consistent reservation release
+			ScheduledEndAt:             p.scheduledEndAt,
+		}
+
+		if p.autoAcknowledge {
+			// The provider's policy acknowledges the lease now; billing starts
+			// at creation.
+			lease.State = types.LEASE_STATE_ACTIVE
+			lease.AcknowledgedAt = &blockTime
+		}
+
+		if err := ms.k.SetLease(ctx, lease); err != nil {
+			return nil, err
+		}
+
+		// 5. Increment the lease count in credit account for the lease's state
+		if p.autoAcknowledge {
+			creditAccount.ActiveLeaseCount++
+		} else {
+			creditAccount.PendingLeaseCount++
+		}
+		leases = append(leases, lease)
+		results = append(results, &leaseCreationResult{
+			leaseUUID:        leaseUUID,
+			providerUUID:     p.providerUUID,
+			itemCount:        len(p.items),
+			totalRates:       p.totalRates,
+			pendingLeases:    creditAccount.PendingLeaseCount,
+			metaHash:         p.metaHash,
+			autoAcknowledged: p.autoAcknowledge,
+		})
+	}
+
+	if err := ms.k.SetCreditAccount(ctx, creditAccount); err != nil {
+		return nil, err
+	}
+	if newActive > 0 {
+		// Auto-acknowledged leases are billed from now on, so they enter the
+		// exhaustion projection here as they would on MsgAcknowledgeLease.
+		if err := ms.k.refreshCreditExhaustion(ctx, tenant); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, lease := range leases {
+		if err := ms.k.Hooks().AfterLeaseCreated(ctx, lease); err != nil {
+			return nil, err
+		}
+		if lease.State == types.LEASE_STATE_ACTIVE {
+			if err := ms.k.Hooks().AfterLeaseAcknowledged(ctx, lease); err != nil {
+				return nil, err
+			}
+		}
+	}
 
-	// 3. Verify all SKUs exist, are active, and belong to the same provider
+	return results, nil
+}
+
+// prepareLease verifies that all SKUs exist, are active and belong to the same
+// active provider whose policy accepts the tenant, locks their prices and
+// computes the lease's reservation. Each item is priced at the volume tier for
+// the tenant's committed quantity of its SKU, which it then adds to.
+func (ms msgServer) prepareLease(ctx context.Context, tenant string, items []types.LeaseItemInput, minLeaseDuration uint64, committed map[string]uint64) (preparedLease, error) {
 	var providerUUID string
 	leaseItems := make([]types.LeaseItem, 0, len(items))
-	totalRatesPerSecond := sdk.NewCoins() // Accumulate rates by denom
+	totalRatesPerSecond := sdk.NewDecCoins() // Accumulate rates by denom
 
 	for i, inputItem := range items {
 		sku, err := ms.k.skuKeeper.GetSKU(ctx, inputItem.SkuUuid)
 		if err != nil {
-			return nil, types.ErrSKUNotFound.Wrapf("sku_uuid %s not found", inputItem.SkuUuid)
+			return preparedLease{}, types.ErrSKUNotFound.Wrapf("sku_uuid %s not found", inputItem.SkuUuid)
 		}
 
 		if !sku.Active {
-			return nil, types.ErrSKUNotActive.Wrapf("sku_uuid %s is not active", inputItem.SkuUuid)
+			return preparedLease{}, types.ErrSKUNotActive.Wrapf("sku_uuid %s is not active", inputItem.SkuUuid)
 		}
 
 		// Check provider consistency
 		if i == 0 {
 			providerUUID = sku.ProviderUuid
 		} else if sku.ProviderUuid != providerUUID {
-			return nil, types.ErrMixedProviders.Wrapf(
+			return preparedLease{}, types.ErrMixedProviders.Wrapf(
 				"sku_uuid %s belongs to provider %s, expected provider %s",
 				inputItem.SkuUuid,
 				sku.ProviderUuid,
@@ -196,111 +381,56 @@ func (ms msgServer) createLeaseInternal(ctx context.Context, tenant string, item
 			)
 		}
 
-		// Lock price from SKU (convert to per-second rate, preserving denom)
-		lockedPricePerSecond, err := ConvertBasePriceToPerSecond(sku.BasePrice, sku.Unit)
+		// Lock the SKU's price for the tenant's committed volume tier (convert
+		// to per-second rate, preserving denom; metered SKUs keep their
+		// per-usage price)
+		item, err := LockItemPrice(volumePrice(sku, inputItem.Quantity, committed), sku.Unit)
 		if err != nil {
 			// This should not happen for valid SKUs (validated at creation time)
-			return nil, types.ErrSKUNotFound.Wrapf("invalid SKU pricing: %s", err)
+			return preparedLease{}, types.ErrSKUNotFound.Wrapf("invalid SKU pricing: %s", err)
+		}
+		item.SkuUuid = inputItem.SkuUuid
+		item.Quantity = inputItem.Quantity
+		item.ServiceName = inputItem.ServiceName
+
+		// Accumulate total rate for each denom. Metered items are charged as
+		// usage is reported and reserve nothing up front.
+		if !item.Metered {
+			itemRate := sdk.NewDecCoinFromDec(item.LockedPrice.Denom, item.LockedRate.MulInt(sdkmath.NewIntFromUint64(item.Quantity)))
+			totalRatesPerSecond = totalRatesPerSecond.Add(itemRate)
 		}
 
-		// Accumulate total rate for each denom
-		itemRate := sdk.NewCoin(lockedPricePerSecond.Denom, lockedPricePerSecond.Amount.Mul(sdkmath.NewIntFromUint64(inputItem.Quantity)))
-		totalRatesPerSecond = totalRatesPerSecond.Add(itemRate)
-
-		leaseItems = append(leaseItems, types.LeaseItem{
-			SkuUuid:     inputItem.SkuUuid,
-			Quantity:    inputItem.Quantity,
-			LockedPrice: lockedPricePerSecond,
-			ServiceName: inputItem.ServiceName,
-		})
+		leaseItems = append(leaseItems, item)
 	}
 
-	// 4. Verify provider is active (only need to check once since all SKUs belong to same provider)
+	// Verify provider is active (only need to check once since all SKUs belong to same provider)
 	provider, err := ms.k.skuKeeper.GetProvider(ctx, providerUUID)
 	if err != nil {
-		return nil, types.ErrProviderNotFound.Wrapf("provider_uuid %s not found", providerUUID)
+		return preparedLease{}, types.ErrProviderNotFound.Wrapf("provider_uuid %s not found", providerUUID)
 	}
 	if !provider.Active {
-		return nil, types.ErrProviderNotActive.Wrapf("provider_uuid %s is not active", providerUUID)
-	}
-
-	// 5. Calculate reservation and verify tenant has enough AVAILABLE credit
-	// Available credit = balance - already reserved amounts
-	// This prevents overbooking where multiple leases could exhaust the same credit
-	reservationAmount := types.CalculateLeaseReservationFromRates(totalRatesPerSecond, params.MinLeaseDuration)
-
-	// Fetch credit balances for only the denoms needed by this lease.
-	// This avoids loading dust from unrelated token sends to the credit address.
-	// totalRatesPerSecond is sdk.Coins (sorted, deduplicated) so leaseDenoms has no duplicates.
-	leaseDenoms := make([]string, 0, len(totalRatesPerSecond))
-	for _, coin := range totalRatesPerSecond {
-		leaseDenoms = append(leaseDenoms, coin.Denom)
-	}
-	creditBalances, err := ms.k.getCreditBalancesForDenoms(ctx, tenant, leaseDenoms)
-	if err != nil {
-		return nil, err
-	}
-
-	availableCredit := types.GetAvailableCredit(creditBalances, creditAccount.ReservedAmounts)
-
-	// Check each denom in the reservation has sufficient available credit
-	for _, res := range reservationAmount {
-		available := availableCredit.AmountOf(res.Denom)
-		if available.LT(res.Amount) {
-			return nil, types.ErrInsufficientCredit.Wrapf(
-				"insufficient available credit for denom %s: need %s, have %s available (balance: %s, reserved: %s)",
-				res.Denom,
-				res.Amount.String(),
-				available.String(),
-				creditBalances.AmountOf(res.Denom).String(),
-				creditAccount.ReservedAmounts.AmountOf(res.Denom).String(),
-			)
-		}
+		return preparedLease{}, types.ErrProviderNotActive.Wrapf("provider_uuid %s is not active", providerUUID)
 	}
 
-	// Reserve credit immediately (lease is PENDING but credit is locked)
-	creditAccount.ReservedAmounts = types.AddReservation(creditAccount.ReservedAmounts, reservationAmount)
-
-	// 6. Create lease with deterministic UUIDv7
-	leaseSeq, err := ms.k.GetNextLeaseSequence(ctx)
+	policy, _, err := ms.k.GetProviderPolicy(ctx, providerUUID)
 	if err != nil {
-		return nil, err
+		return preparedLease{}, err
 	}
-	leaseUUID := pkguuid.GenerateUUIDv7(sdkCtx, types.ModuleName, leaseSeq)
-
-	lease := types.Lease{
-		Uuid:                       leaseUUID,
-		Tenant:                     tenant,
-		ProviderUuid:               providerUUID,
-		Items:                      leaseItems,
-		State:                      types.LEASE_STATE_PENDING, // Start in PENDING, awaiting provider acknowledgement
-		CreatedAt:                  blockTime,
-		LastSettledAt:              blockTime, // Will be updated to AcknowledgedAt when provider acknowledges
-		MetaHash:                   metaHash,
-		MinLeaseDurationAtCreation: params.MinLeaseDuration, // Store for consistent reservation release
-	}
-
-	if err := ms.k.SetLease(ctx, lease); err != nil {
-		return nil, err
+	if !policy.AllowsTenant(tenant) {
+		return preparedLease{}, types.ErrTenantNotAllowed.Wrapf("provider %s does not accept leases from %s", providerUUID, tenant)
 	}
 
-	// 7. Increment pending lease count in credit account (lease starts in PENDING state)
-	creditAccount.PendingLeaseCount++
-	if err := ms.k.SetCreditAccount(ctx, creditAccount); err != nil {
-		return nil, err
-	}
-
-	return &leaseCreationResult{
-		leaseUUID:     leaseUUID,
-		providerUUID:  providerUUID,
-		itemCount:     len(leaseItems),
-		totalRates:    totalRatesPerSecond,
-		pendingLeases: creditAccount.PendingLeaseCount,
-		metaHash:      metaHash,
+	return preparedLease{
+		providerUUID:    providerUUID,
+		items:           leaseItems,
+		totalRates:      totalRatesPerSecond,
+		reservation:     types.CalculateLeaseReservation(leaseItems, minLeaseDuration),
+		autoAcknowledge: policy.AutoAcknowledge,
 	}, nil
 }
 
-// emitLeaseCreatedEvent emits a lease_created event with the given parameters.
+// emitLeaseCreatedEvent emits a lease_created event with the given parameters,
+// followed by a lease_acknowledged event for an auto-acknowledged lease.
 func emitLeaseCreatedEvent(ctx sdk.Context, result *leaseCreationResult, tenant, createdBy string) {
 	eventAttrs := []sdk.Attribute{
 		sdk.NewAttribute(types.AttributeKeyLeaseUUID, result.leaseUUID),
@@ -315,6 +445,18 @@ func emitLeaseCreatedEvent(ctx sdk.Context, result *leaseCreationResult, tenant,
 		eventAttrs = append(eventAttrs, sdk.NewAttribute(types.AttributeKeyMetaHash, hex.EncodeToString(result.metaHash)))
 	}
 	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeLeaseCreated, eventAttrs...))
+
+	if result.autoAcknowledged {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeLeaseAcknowledged,
+				sdk.NewAttribute(types.AttributeKeyLeaseUUID, result.leaseUUID),
+				sdk.NewAttribute(types.AttributeKeyTenant, tenant),
+				sdk.NewAttribute(types.AttributeKeyProviderUUID, result.providerUUID),
+				sdk.NewAttribute(types.AttributeKeyAcknowledgedBy, types.AcknowledgedByProviderPolicy),
+			),
+		)
+	}
 }
 
 // CreateLease creates a new lease for the tenant.
@@ -323,7 +465,7 @@ func (ms msgServer) CreateLease(ctx context.Context, msg *types.MsgCreateLease)
 		return nil, err
 	}
 
-	result, err := ms.createLeaseInternal(ctx, msg.Tenant, msg.Items, msg.MetaHash)
+	result, err := ms.createLeaseInternal(ctx, msg.Tenant, msg.Items, msg.MetaHash, msg.ScheduledEndAt)
 	if err != nil {
 		return nil, err
 	}
@@ -351,7 +493,7 @@ func (ms msgServer) CreateLeaseForTenant(ctx context.Context, msg *types.MsgCrea
 		return nil, types.ErrUnauthorized.Wrapf("%s is not the authority or in the allowed list", msg.Authority)
 	}
 
-	result, err := ms.createLeaseInternal(ctx, msg.Tenant, msg.Items, msg.MetaHash)
+	result, err := ms.createLeaseInternal(ctx, msg.Tenant, msg.Items, msg.MetaHash, msg.ScheduledEndAt)
 	if err != nil {
 		return nil, err
 	}
@@ -363,6 +505,69 @@ func (ms msgServer) CreateLeaseForTenant(ctx context.Context, msg *types.MsgCrea
 	}, nil
 }
 
+// createLeasesAtomic creates a batch of leases in a cache context so that
+// either every lease is created or none is, then emits one lease_created
+// event per lease. Returns the lease UUIDs in input order.
+func (ms msgServer) createLeasesAtomic(ctx context.Context, tenant string, inputs []types.LeaseInput, createdBy string) ([]string, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, writeCache := sdkCtx.CacheContext()
+
+	results, err := ms.createLeasesInternal(cacheCtx, tenant, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	writeCache()
+
+	leaseUUIDs := make([]string, 0, len(results))
+	for _, result := range results {
+		emitLeaseCreatedEvent(sdkCtx, result, tenant, createdBy)
+		leaseUUIDs = append(leaseUUIDs, result.leaseUUID)
+	}
+	return leaseUUIDs, nil
+}
+
+// CreateLeases creates several leases for the tenant in one atomic operation.
+func (ms msgServer) CreateLeases(ctx context.Context, msg *types.MsgCreateLeases) (*types.MsgCreateLeasesResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	leaseUUIDs, err := ms.createLeasesAtomic(ctx, msg.Tenant, msg.Leases, "tenant")
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateLeasesResponse{
+		LeaseUuids: leaseUUIDs,
+	}, nil
+}
+
+// CreateLeasesForTenant allows authority or allowed addresses to create
+// several leases on behalf of a tenant in one atomic operation.
+func (ms msgServer) CreateLeasesForTenant(ctx context.Context, msg *types.MsgCreateLeasesForTenant) (*types.MsgCreateLeasesForTenantResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	authorized, err := ms.isAuthorizedForTenantLeaseCreation(ctx, msg.Authority)
+	if err != nil {
+		return nil, types.ErrUnauthorized.Wrapf("failed to check authorization: %s", err)
+	}
+	if !authorized {
+		return nil, types.ErrUnauthorized.Wrapf("%s is not the authority or in the allowed list", msg.Authority)
+	}
+
+	leaseUUIDs, err := ms.createLeasesAtomic(ctx, msg.Tenant, msg.Leases, "authority")
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateLeasesForTenantResponse{
+		LeaseUuids: leaseUUIDs,
+	}, nil
+}
+
 // CloseLease closes one or more active leases.
 // Sender must be authorized for each lease (tenant, provider, or authority).
 // This is an atomic operation: all leases succeed or all fail.
@@ -512,7 +717,7 @@ func (ms msgServer) CloseLease(ctx context.Context, msg *types.MsgCloseLease) (*
 			duration = closeTime.Sub(leases[i].LastSettledAt)
 
 			// Perform settlement using silent mode (doesn't fail on overflow)
-			result, err := ms.k.PerformSettlementSilent(cacheCtx, &leases[i], closeTime)
+			result, err := ms.k.PerformSettlementSilent(cacheCtx, &leases[i], closeTime, types.SETTLEMENT_TRIGGER_AUTO_CLOSE)
 			if err != nil {
 				return nil, err
 			}
@@ -554,8 +759,11 @@ func (ms msgServer) CloseLease(ctx context.Context, msg *types.MsgCloseLease) (*
 		creditAccount := creditAccounts[leases[i].Tenant]
 		ms.k.DecrementActiveLeaseCount(&creditAccount, leases[i].Uuid)
 
-		// Release reservation for this lease
+		// Release reservation for this lease and drop any pending amendment
 		ms.k.ReleaseLeaseReservation(&creditAccount, &leases[i], params.MinLeaseDuration)
+		if _, err := ms.k.releaseLeaseAmendment(cacheCtx, &creditAccount, leases[i].Uuid); err != nil {
+			return nil, err
+		}
 
 		creditAccounts[leases[i].Tenant] = creditAccount
 
@@ -582,6 +790,15 @@ func (ms msgServer) CloseLease(ctx context.Context, msg *types.MsgCloseLease) (*
 		if err := ms.k.SetCreditAccount(cacheCtx, creditAccounts[tenant]); err != nil {
 			return nil, err
 		}
+		if err := ms.k.refreshCreditExhaustion(cacheCtx, tenant); err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range leases {
+		if err := ms.k.Hooks().AfterLeaseClosed(cacheCtx, leases[i]); err != nil {
+			return nil, err
+		}
 	}
 
 	// All operations succeeded - commit the cache to the main context
@@ -679,6 +896,9 @@ func (ms msgServer) withdrawFromLeases(ctx context.Context, msg *types.MsgWithdr
 				providerUUID,
 			)
 		}
+		if lease.Disputed {
+			return nil, types.ErrLeaseDisputed.Wrapf("lease %s is under dispute; its accruals are escrowed", leaseUUID)
+		}
 
 		leases = append(leases, lease)
 	}
@@ -689,6 +909,7 @@ func (ms msgServer) withdrawFromLeases(ctx context.Context, msg *types.MsgWithdr
 	totalAmounts := sdk.NewCoins()
 	withdrawalCount := uint64(0)
 	autoClosedLeases := make([]string, 0)
+	refreshTenants := make([]string, 0)        // deterministic order, for the exhaustion refresh
 	leaseAmounts := make(map[string]sdk.Coins) // Track per-lease amounts for events
 
 	for i := range leases {
@@ -696,6 +917,22 @@ func (ms msgServer) withdrawFromLeases(ctx context.Context, msg *types.MsgWithdr
 
 		// For active leases, check if we need to auto-close due to exhausted credit
 		if lease.State == types.LEASE_STATE_ACTIVE {
+			started, transferred, err := ms.k.startGracePeriodIfExhausted(cacheCtx, lease)
+			if err != nil {
+				return nil, err
+			}
+			if started {
+				if !slices.Contains(refreshTenants, lease.Tenant) {
+					refreshTenants = append(refreshTenants, lease.Tenant)
+				}
+				if !transferred.IsZero() {
+					totalAmounts = totalAmounts.Add(transferred...)
+					leaseAmounts[lease.Uuid] = transferred
+				}
+				withdrawalCount++
+				continue
+			}
+
 			shouldAutoClose, closeTime, err := ms.k.ShouldAutoCloseLease(cacheCtx, lease)
 			if err != nil {
 				return nil, err
@@ -707,6 +944,9 @@ func (ms msgServer) withdrawFromLeases(ctx context.Context, msg *types.MsgWithdr
 				}
 
 				autoClosedLeases = append(autoClosedLeases, lease.Uuid)
+				if !slices.Contains(refreshTenants, lease.Tenant) {
+					refreshTenants = append(refreshTenants, lease.Tenant)
+				}
 				if !result.TransferAmounts.IsZero() {
 					totalAmounts = totalAmounts.Add(result.TransferAmounts...)
 					leaseAmounts[lease.Uuid] = result.TransferAmounts
@@ -728,7 +968,7 @@ func (ms msgServer) withdrawFromLeases(ctx context.Context, msg *types.MsgWithdr
 		}
 
 		// Perform settlement
-		result, err := ms.k.PerformSettlement(cacheCtx, lease, settleTime)
+		result, err := ms.k.PerformSettlement(cacheCtx, lease, settleTime, types.SETTLEMENT_TRIGGER_WITHDRAW)
 		if err != nil {
 			return nil, err
 		}
@@ -754,6 +994,14 @@ func (ms msgServer) withdrawFromLeases(ctx context.Context, msg *types.MsgWithdr
 		return nil, types.ErrNoWithdrawableAmount
 	}
 
+	// Settlement alone leaves the exhaustion projection unchanged; closures and
+	// grace periods do not.
+	for _, tenant := range refreshTenants {
+		if err := ms.k.refreshCreditExhaustion(cacheCtx, tenant); err != nil {
+			return nil, err
+		}
+	}
+
 	// All operations succeeded - commit the cache to the main context
 	writeCache()
 
@@ -894,6 +1142,10 @@ func (ms msgServer) withdrawFromProvider(ctx context.Context, msg *types.MsgWith
 			)
 			continue
 		}
+		if lease.Disputed {
+			// Accruals of a disputed lease are escrowed until the dispute is settled.
+			continue
+		}
 
 		// Use CacheContext to make all state changes atomic per lease.
 		// If any operation fails, the cache is discarded and no state changes
@@ -902,6 +1154,25 @@ func (ms msgServer) withdrawFromProvider(ctx context.Context, msg *types.MsgWith
 
 		// For active leases, check if we need to auto-close due to exhausted credit
 		if lease.State == types.LEASE_STATE_ACTIVE {
+			started, transferred, graceErr := ms.k.startGracePeriodIfExhausted(cacheCtx, &lease)
+			if graceErr == nil && started {
+				graceErr = ms.k.refreshCreditExhaustion(cacheCtx, lease.Tenant)
+			}
+			if graceErr != nil {
+				ms.k.Logger().Error("failed to start grace period for lease",
+					"lease_id", lease.Uuid,
+					"tenant", lease.Tenant,
+					"error", graceErr,
+				)
+				continue
+			}
+			if started {
+				write()
+				totalAmounts = totalAmounts.Add(transferred...)
+				withdrawalCount++
+				continue
+			}
+
 			shouldAutoClose, closeTime, checkErr := ms.k.ShouldAutoCloseLease(cacheCtx, &lease)
 			if checkErr != nil {
 				ms.k.Logger().Error("failed to check auto-close for lease",
@@ -921,6 +1192,14 @@ func (ms msgServer) withdrawFromProvider(ctx context.Context, msg *types.MsgWith
 					)
 					continue
 				}
+				if rfErr := ms.k.refreshCreditExhaustion(cacheCtx, lease.Tenant); rfErr != nil {
+					ms.k.Logger().Error("failed to refresh credit exhaustion",
+						"lease_id", lease.Uuid,
+						"tenant", lease.Tenant,
+						"error", rfErr,
+					)
+					continue
+				}
 
 				// Commit all changes atomically (lease + credit account)
 				write()
@@ -962,7 +1241,7 @@ func (ms msgServer) withdrawFromProvider(ctx context.Context, msg *types.MsgWith
 		}
 
 		// Perform settlement (silent mode: doesn't fail on overflow)
-		result, settleErr := ms.k.PerformSettlementSilent(cacheCtx, &lease, settleTime)
+		result, settleErr := ms.k.PerformSettlementSilent(cacheCtx, &lease, settleTime, types.SETTLEMENT_TRIGGER_WITHDRAW)
 		if settleErr != nil {
 			// Log error but continue with other leases (cache discarded)
 			ms.k.Logger().Error("failed to withdraw from lease",
@@ -1048,10 +1327,395 @@ func (ms msgServer) SetItemCustomDomain(ctx context.Context, msg *types.MsgSetIt
 	return &types.MsgSetItemCustomDomainResponse{}, nil
 }
 
+// WithdrawCredit withdraws unreserved credit from the tenant's credit account.
+func (ms msgServer) WithdrawCredit(ctx context.Context, msg *types.MsgWithdrawCredit) (*types.MsgWithdrawCreditResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	creditAddr, remaining, err := ms.k.WithdrawCredit(ctx, msg.Tenant, msg.Recipient, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawCreditResponse{
+		CreditAddress:     creditAddr.String(),
+		RemainingBalances: remaining,
+	}, nil
+}
+
+// TransferCredit moves withdrawable credit from one tenant's credit account to
+// another's. The source tenant or the module authority may transfer.
+func (ms msgServer) TransferCredit(ctx context.Context, msg *types.MsgTransferCredit) (*types.MsgTransferCreditResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	var transferredBy string
+	switch msg.Sender {
+	case msg.FromTenant:
+		transferredBy = types.AttributeValueRoleTenant
+	case ms.k.GetAuthority():
+		transferredBy = types.AttributeValueRoleAuthority
+	default:
+		return nil, types.ErrUnauthorized.Wrap("only the source tenant or authority can transfer credit")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, writeCache := sdkCtx.CacheContext()
+
+	fromAddr, toAddr, err := ms.k.TransferCredit(cacheCtx, msg.FromTenant, msg.ToTenant, msg.Amount, transferredBy == types.AttributeValueRoleAuthority)
+	if err != nil {
+		return nil, err
+	}
+
+	writeCache()
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreditTransferred,
+			sdk.NewAttribute(types.AttributeKeyFromTenant, msg.FromTenant),
+			sdk.NewAttribute(types.AttributeKeyToTenant, msg.ToTenant),
+			sdk.NewAttribute(types.AttributeKeyFromCreditAddress, fromAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyToCreditAddress, toAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyTransferredBy, transferredBy),
+		),
+	)
+
+	return &types.MsgTransferCreditResponse{
+		FromCreditAddress: fromAddr.String(),
+		ToCreditAddress:   toAddr.String(),
+	}, nil
+}
+
+// UpdateLeaseItems changes item quantities on an ACTIVE lease.
+func (ms msgServer) UpdateLeaseItems(ctx context.Context, msg *types.MsgUpdateLeaseItems) (*types.MsgUpdateLeaseItemsResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, writeCache := sdkCtx.CacheContext()
+
+	result, err := ms.k.UpdateLeaseItems(cacheCtx, msg.Tenant, msg.LeaseUuid, msg.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	writeCache()
+	emitLeaseItemsUpdatedEvents(sdkCtx, result)
+
+	return &types.MsgUpdateLeaseItemsResponse{
+		Items:          result.Lease.Items,
+		SettledAmounts: result.SettledAmounts,
+	}, nil
+}
+
+// ProposeLeaseAmendment proposes adding and/or removing items on an ACTIVE lease.
+func (ms msgServer) ProposeLeaseAmendment(ctx context.Context, msg *types.MsgProposeLeaseAmendment) (*types.MsgProposeLeaseAmendmentResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, writeCache := sdkCtx.CacheContext()
+
+	amendment, err := ms.k.ProposeLeaseAmendment(cacheCtx, msg.Tenant, msg.LeaseUuid, msg.AddItems, msg.RemoveItems)
+	if err != nil {
+		return nil, err
+	}
+
+	writeCache()
+	emitLeaseAmendmentProposedEvent(sdkCtx, amendment)
+
+	return &types.MsgProposeLeaseAmendmentResponse{
+		ReservedAmounts: amendment.ReservedAmounts,
+	}, nil
+}
+
+// AcknowledgeLeaseAmendment applies a pending lease amendment.
+// Sender must be the lease's provider or the authority, as for AcknowledgeLease.
+func (ms msgServer) AcknowledgeLeaseAmendment(ctx context.Context, msg *types.MsgAcknowledgeLeaseAmendment) (*types.MsgAcknowledgeLeaseAmendmentResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	amendment, err := ms.k.GetLeaseAmendment(ctx, msg.LeaseUuid)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := ms.validateProviderAuthorization(ctx, msg.Sender, amendment.ProviderUuid, "acknowledge amendments on"); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, writeCache := sdkCtx.CacheContext()
+
+	result, err := ms.k.AcknowledgeLeaseAmendment(cacheCtx, msg.LeaseUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	writeCache()
+	emitLeaseAmendmentAcknowledgedEvent(sdkCtx, result, msg.Sender)
+
+	return &types.MsgAcknowledgeLeaseAmendmentResponse{
+		Items:          result.Lease.Items,
+		SettledAmounts: result.SettledAmounts,
+	}, nil
+}
+
+// RejectLeaseAmendment rejects a pending lease amendment.
+// Sender must be the lease's provider or the authority, as for RejectLease.
+func (ms msgServer) RejectLeaseAmendment(ctx context.Context, msg *types.MsgRejectLeaseAmendment) (*types.MsgRejectLeaseAmendmentResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	amendment, err := ms.k.GetLeaseAmendment(ctx, msg.LeaseUuid)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := ms.validateProviderAuthorization(ctx, msg.Sender, amendment.ProviderUuid, "reject amendments on"); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, writeCache := sdkCtx.CacheContext()
+
+	if _, err := ms.k.RejectLeaseAmendment(cacheCtx, msg.LeaseUuid); err != nil {
+		return nil, err
+	}
+
+	writeCache()
+	emitLeaseAmendmentRejectedEvent(sdkCtx, amendment, msg.Sender, msg.Reason)
+
+	return &types.MsgRejectLeaseAmendmentResponse{}, nil
+}
+
+// SetLeaseScheduledEnd sets or extends the scheduled end time of a lease.
+func (ms msgServer) SetLeaseScheduledEnd(ctx context.Context, msg *types.MsgSetLeaseScheduledEnd) (*types.MsgSetLeaseScheduledEndResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, writeCache := sdkCtx.CacheContext()
+
+	lease, previous, err := ms.k.SetLeaseScheduledEnd(cacheCtx, msg.Tenant, msg.LeaseUuid, msg.ScheduledEndAt)
+	if err != nil {
+		return nil, err
+	}
+
+	writeCache()
+	emitLeaseScheduledEndSetEvent(sdkCtx, lease, previous)
+
+	return &types.MsgSetLeaseScheduledEndResponse{}, nil
+}
+
+// SetProviderPolicy sets or removes a provider's lease policy. The provider
+// itself or the module authority may do so. A grace period override is
+// accepted up to params.max_provider_grace_period and refused while it is
+// zero.
+func (ms msgServer) SetProviderPolicy(ctx context.Context, msg *types.MsgSetProviderPolicy) (*types.MsgSetProviderPolicyResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if _, err := ms.validateProviderAuthorization(ctx, msg.Sender, msg.Policy.ProviderUuid, "set the lease policy of"); err != nil {
+		return nil, err
+	}
+
+	policy := msg.Policy
+	if msg.Reset_ {
+		policy = types.ProviderPolicy{ProviderUuid: msg.Policy.ProviderUuid}
+	}
+
+	// GetEffectiveGracePeriod clamps overrides to the same bound when read.
+	if policy.OverrideGracePeriod {
+		params, err := ms.k.GetParams(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if params.MaxProviderGracePeriod == 0 {
+			return nil, types.ErrInvalidProviderPolicy.Wrap("provider grace period overrides are disabled: max_provider_grace_period is zero")
+		}
+		if policy.GracePeriod > params.MaxProviderGracePeriod {
+			return nil, types.ErrInvalidProviderPolicy.Wrapf("grace_period %d exceeds max_provider_grace_period of %d seconds", policy.GracePeriod, params.MaxProviderGracePeriod)
+		}
+	}
+
+	if err := ms.k.SetProviderPolicy(ctx, policy, msg.Reset_); err != nil {
+		return nil, err
+	}
+
+	emitProviderPolicySetEvent(sdk.UnwrapSDKContext(ctx), msg.Sender, policy, msg.Reset_)
+
+	return &types.MsgSetProviderPolicyResponse{}, nil
+}
+
+// GrantPromoCredit grants a tenant promotional credit funded by the authority.
+func (ms msgServer) GrantPromoCredit(ctx context.Context, msg *types.MsgGrantPromoCredit) (*types.MsgGrantPromoCreditResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if ms.k.GetAuthority() != msg.Authority {
+		return nil, types.ErrUnauthorized.Wrapf("expected %s, got %s", ms.k.GetAuthority(), msg.Authority)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, writeCache := sdkCtx.CacheContext()
+
+	grant, err := ms.k.GrantPromoCredit(cacheCtx, msg.Authority, msg.Tenant, msg.Amount, msg.ExpiresAt, msg.ProviderUuids, msg.SkuUuids)
+	if err != nil {
+		return nil, err
+	}
+
+	writeCache()
+	emitPromoCreditGrantedEvent(sdkCtx, grant)
+
+	return &types.MsgGrantPromoCreditResponse{GrantId: grant.Id}, nil
+}
+
+// SetExchangeRates sets or removes entries of the exchange-rate table. Only a
+// price feeder from params or the module authority may set rates.
+func (ms msgServer) SetExchangeRates(ctx context.Context, msg *types.MsgSetExchangeRates) (*types.MsgSetExchangeRatesResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, writeCache := sdkCtx.CacheContext()
+
+	set, removed, err := ms.k.SetExchangeRates(cacheCtx, msg.Sender, msg.Rates)
+	if err != nil {
+		return nil, err
+	}
+
+	writeCache()
+	for _, rate := range set {
+		emitExchangeRateSetEvent(sdkCtx, rate)
+	}
+	for _, denom := range removed {
+		emitExchangeRateRemovedEvent(sdkCtx, denom, msg.Sender)
+	}
+
+	return &types.MsgSetExchangeRatesResponse{}, nil
+}
+
+// ReportUsage records a provider's usage counters for the metered items of a
+// lease and charges the increase to the tenant's credit.
+func (ms msgServer) ReportUsage(ctx context.Context, msg *types.MsgReportUsage) (*types.MsgReportUsageResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, writeCache := sdkCtx.CacheContext()
+
+	result, err := ms.k.ReportUsage(cacheCtx, msg.Sender, msg.LeaseUuid, msg.Usages)
+	if err != nil {
+		return nil, err
+	}
+
+	writeCache()
+	emitUsageReportedEvent(sdkCtx, msg.Sender, result)
+
+	return &types.MsgReportUsageResponse{
+		ChargedAmounts:   result.Charged,
+		UnchargedAmounts: result.Uncharged,
+	}, nil
+}
+
+// SetUsageSpendLimit sets how much usage may be charged to a lease per spend
+// period.
+func (ms msgServer) SetUsageSpendLimit(ctx context.Context, msg *types.MsgSetUsageSpendLimit) (*types.MsgSetUsageSpendLimitResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	usage, err := ms.k.SetUsageSpendLimit(ctx, msg.Tenant, msg.LeaseUuid, msg.SpendLimit, msg.Period)
+	if err != nil {
+		return nil, err
+	}
+	emitUsageSpendLimitSetEvent(sdk.UnwrapSDKContext(ctx), msg.Tenant, usage)
+
+	return &types.MsgSetUsageSpendLimitResponse{}, nil
+}
+
+// OpenDispute opens a dispute on a lease and starts escrowing its withdrawals.
+func (ms msgServer) OpenDispute(ctx context.Context, msg *types.MsgOpenDispute) (*types.MsgOpenDisputeResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, writeCache := sdkCtx.CacheContext()
+
+	dispute, err := ms.k.OpenDispute(cacheCtx, msg.Tenant, msg.LeaseUuid, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	writeCache()
+	emitDisputeOpenedEvent(sdkCtx, dispute)
+
+	return &types.MsgOpenDisputeResponse{
+		Escrowed:         dispute.Escrowed,
+		ResponseDeadline: dispute.ResponseDeadline,
+	}, nil
+}
+
+// RespondToDispute records the provider's response to a dispute.
+func (ms msgServer) RespondToDispute(ctx context.Context, msg *types.MsgRespondToDispute) (*types.MsgRespondToDisputeResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	dispute, err := ms.k.RespondToDispute(ctx, msg.Sender, msg.LeaseUuid, msg.Response)
+	if err != nil {
+		return nil, err
+	}
+	emitDisputeRespondedEvent(sdk.UnwrapSDKContext(ctx), msg.Sender, dispute)
+
+	return &types.MsgRespondToDisputeResponse{}, nil
+}
+
+// ResolveDispute splits the escrow of an open dispute between the tenant and
+// the provider. Only the module authority may resolve disputes.
+func (ms msgServer) ResolveDispute(ctx context.Context, msg *types.MsgResolveDispute) (*types.MsgResolveDisputeResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if ms.k.GetAuthority() != msg.Authority {
+		return nil, types.ErrUnauthorized.Wrapf("expected %s, got %s", ms.k.GetAuthority(), msg.Authority)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, writeCache := sdkCtx.CacheContext()
+
+	result, err := ms.k.ResolveDispute(cacheCtx, msg.LeaseUuid, msg.TenantShare)
+	if err != nil {
+		return nil, err
+	}
+
+	writeCache()
+	emitDisputeResolvedEvent(sdkCtx, msg.Authority, result)
+
+	return &types.MsgResolveDisputeResponse{
+		TenantAmounts:   result.Dispute.TenantAmounts,
+		ProviderAmounts: result.Dispute.ProviderAmounts,
+	}, nil
+}
+
 // settleLease calculates and transfers accrued charges from tenant's credit account
 // to the provider's payout address. Returns the amounts settled (one per denom).
 func (ms msgServer) settleLease(ctx context.Context, lease *types.Lease, settleTime time.Time) (sdk.Coins, error) {
-	result, err := ms.k.PerformSettlement(ctx, lease, settleTime)
+	result, err := ms.k.PerformSettlement(ctx, lease, settleTime, types.SETTLEMENT_TRIGGER_CLOSE)
 	if err != nil {
 		return sdk.NewCoins(), err
 	}
@@ -1205,10 +1869,20 @@ func (ms msgServer) AcknowledgeLease(ctx context.Context, msg *types.MsgAcknowle
 
 	// Persist all credit account updates to the cache context.
 	// Use validated.tenantOrder (insertion-order slice) instead of ranging over the map.
+	// Billing starts here, so this is where the leases enter the exhaustion projection.
 	for _, tenant := range validated.tenantOrder {
 		if err := ms.k.SetCreditAccount(cacheCtx, creditAccounts[tenant]); err != nil {
 			return nil, err
 		}
+		if err := ms.k.refreshCreditExhaustion(cacheCtx, tenant); err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range leases {
+		if err := ms.k.Hooks().AfterLeaseAcknowledged(cacheCtx, leases[i]); err != nil {
+			return nil, err
+		}
 	}
 
 	// All operations succeeded - commit the cache to the main context
@@ -1323,6 +1997,12 @@ func (ms msgServer) RejectLease(ctx context.Context, msg *types.MsgRejectLease)
 		}
 	}
 
+	for i := range leases {
+		if err := ms.k.Hooks().AfterLeaseRejected(cacheCtx, leases[i]); err != nil {
+			return nil, err
+		}
+	}
+
 	// All operations succeeded - commit the cache to the main context
 	writeCache()
 
@@ -1439,6 +2119,12 @@ func (ms msgServer) CancelLease(ctx context.Context, msg *types.MsgCancelLease)
 		return nil, err
 	}
 
+	for i := range leases {
+		if err := ms.k.Hooks().AfterLeaseCancelled(cacheCtx, leases[i]); err != nil {
+			return nil, err
+		}
+	}
+
 	// All operations succeeded - commit the cache to the main context
 	writeCache()
 
@@ -0,0 +1,378 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+)
+
+// GrantPromoCredit moves amount from the granter into the promo escrow
+// account and records it as a grant to tenant, spendable until expiresAt on
+// the given providers and SKUs (any when empty). The tenant's credit account
+// is created if needed, so the grant shows up in Query/CreditAccount.
+func (k *Keeper) GrantPromoCredit(ctx context.Context, granter, tenant string, amount sdk.Coins, expiresAt time.Time, providerUUIDs, skuUUIDs []string) (types.PromoGrant, error) {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if !expiresAt.After(blockTime) {
+		return types.PromoGrant{}, types.ErrInvalidPromoGrant.Wrapf("expires_at %s is not after block time %s", expiresAt, blockTime)
+	}
+
+	granterAddr, err := sdk.AccAddressFromBech32(granter)
+	if err != nil {
+		return types.PromoGrant{}, types.ErrUnauthorized.Wrapf("invalid granter address: %s", err)
+	}
+	tenantAddr, err := sdk.AccAddressFromBech32(tenant)
+	if err != nil {
+		return types.PromoGrant{}, types.ErrInvalidPromoGrant.Wrapf("invalid tenant address: %s", err)
+	}
+
+	grants, err := k.getTenantPromoGrants(ctx, tenantAddr)
+	if err != nil {
+		return types.PromoGrant{}, err
+	}
+	if len(grants) >= types.MaxPromoGrantsPerTenant {
+		return types.PromoGrant{}, types.ErrInvalidPromoGrant.Wrapf("tenant %s already holds %d promo grants, maximum is %d",
+			tenant, len(grants), types.MaxPromoGrantsPerTenant)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, granterAddr, types.PromoEscrowName, amount); err != nil {
+		return types.PromoGrant{}, types.ErrInvalidCreditOperation.Wrapf("failed to escrow promo credit: %s", err)
+	}
+
+	ca, err := k.getOrCreateCreditAccount(ctx, tenant, types.DeriveCreditAddress(tenantAddr))
+	if err != nil {
+		return types.PromoGrant{}, err
+	}
+	if err := k.SetCreditAccount(ctx, ca); err != nil {
+		return types.PromoGrant{}, err
+	}
+
+	id, err := k.PromoGrantSequence.Next(ctx)
+	if err != nil {
+		return types.PromoGrant{}, err
+	}
+	grant := types.PromoGrant{
+		Id:            id,
+		Tenant:        tenant,
+		Granter:       granter,
+		Amount:        amount,
+		Remaining:     amount,
+		CreatedAt:     blockTime,
+		ExpiresAt:     expiresAt.UTC(),
+		ProviderUuids: providerUUIDs,
+		SkuUuids:      skuUUIDs,
+	}
+	if err := k.setPromoGrant(ctx, grant); err != nil {
+		return types.PromoGrant{}, err
+	}
+
+	return grant, k.refreshCreditExhaustion(ctx, tenant)
+}
+
+// setPromoGrant stores a grant and its PromoGrantsByExpiry entry.
+func (k *Keeper) setPromoGrant(ctx context.Context, grant types.PromoGrant) error {
+	tenantAddr, err := sdk.AccAddressFromBech32(grant.Tenant)
+	if err != nil {
+		return err
+	}
+	if err := k.PromoGrantsByExpiry.Set(ctx, collections.Join3(grant.ExpiresAt, tenantAddr, grant.Id)); err != nil {
+		return err
+	}
+	return k.PromoGrants.Set(ctx, collections.Join(tenantAddr, grant.Id), grant)
+}
+
+// removePromoGrant deletes a grant and its PromoGrantsByExpiry entry. It does
+// not move any funds.
+func (k *Keeper) removePromoGrant(ctx context.Context, grant types.PromoGrant) error {
+	tenantAddr, err := sdk.AccAddressFromBech32(grant.Tenant)
+	if err != nil {
+		return err
+	}
+	if err := k.PromoGrantsByExpiry.Remove(ctx, collections.Join3(grant.ExpiresAt, tenantAddr, grant.Id)); err != nil {
+		return err
+	}
+	return k.PromoGrants.Remove(ctx, collections.Join(tenantAddr, grant.Id))
+}
+
+// getTenantPromoGrants returns the tenant's grants, soonest expiry first and
+// then by id.
+func (k *Keeper) getTenantPromoGrants(ctx context.Context, tenantAddr sdk.AccAddress) ([]types.PromoGrant, error) {
+	iter, err := k.PromoGrants.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, uint64](tenantAddr))
+	if err != nil {
+		return nil, err
+	}
+	grants, err := iter.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(grants, func(a, b types.PromoGrant) int {
+		return a.ExpiresAt.Compare(b.ExpiresAt)
+	})
+	return grants, nil
+}
+
+// GetSpendablePromoGrants returns the tenant's grants that are unexpired at
+// block time and have something left, soonest expiry first.
+func (k *Keeper) GetSpendablePromoGrants(ctx context.Context, tenant string) ([]types.PromoGrant, error) {
+	tenantAddr, err := sdk.AccAddressFromBech32(tenant)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := k.getTenantPromoGrants(ctx, tenantAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	return slices.DeleteFunc(grants, func(g types.PromoGrant) bool {
+		return g.IsExpired(blockTime) || g.Remaining.IsZero()
+	}), nil
+}
+
+// promoBalanceForLeases returns the total remaining of grants that can pay
+// for at least one of leases. Grants restricted to some of a lease's SKUs
+// are counted in full, so this is an upper bound on what they will pay.
+func promoBalanceForLeases(grants []types.PromoGrant, leases []types.Lease) sdk.Coins {
+	total := sdk.NewCoins()
+	for _, grant := range grants {
+		for _, lease := range leases {
+			if grant.AppliesToLease(lease) {
+				total = total.Add(grant.Remaining...)
+				break
+			}
+		}
+	}
+	return total
+}
+
+// getPromoBalanceForActiveLeases returns the promo credit that can pay for
+// the tenant's ACTIVE leases outside their grace period.
+func (k *Keeper) getPromoBalanceForActiveLeases(ctx context.Context, tenant string) (sdk.Coins, error) {
+	grants, err := k.GetSpendablePromoGrants(ctx, tenant)
+	if err != nil || len(grants) == 0 {
+		return sdk.NewCoins(), err
+	}
+
+	leases, err := k.GetLeasesByTenantAndState(ctx, tenant, types.LEASE_STATE_ACTIVE)
+	if err != nil {
+		return nil, err
+	}
+	leases = slices.DeleteFunc(leases, func(l types.Lease) bool { return l.GraceEndsAt != nil })
+
+	return promoBalanceForLeases(grants, leases), nil
+}
+
+// spendPromoCredit pays what the lease's time-billed items accrued from their
+// last settlement until settleTime out of the tenant's spendable grants, as far as they reach.
+// Grants are drawn soonest expiry first, each only for the items it applies
+// to. The amount spent is moved from the promo escrow to the tenant's credit
+// address, for the caller to pass on to the provider together with the rest
+// of the settlement, and returned. Grants that run out are removed.
+func (k *Keeper) spendPromoCredit(ctx context.Context, lease types.Lease, settleTime time.Time) (sdk.Coins, error) {
+	spent := sdk.NewCoins()
+
+	grants, err := k.GetSpendablePromoGrants(ctx, lease.Tenant)
+	if err != nil || len(grants) == 0 {
+		return spent, err
+	}
+
+	items := LeaseItemsToWithPrice(lease.Items)
+	owed := make([]sdk.Coin, len(items))
+	for i, item := range items {
+		accrued, err := CalculateItemAccrual(item, lease.LastSettledAt, settleTime)
+		if err != nil {
+			return nil, err
+		}
+		// Promo credit pays whole units only; the fraction is left to the
+		// credit account's share of the settlement.
+		owed[i], _ = accrued.TruncateDecimal()
+	}
+
+	for _, grant := range grants {
+		used := sdk.NewCoins()
+		for i, item := range items {
+			if !owed[i].IsPositive() || !grant.AppliesTo(lease.ProviderUuid, item.SkuUUID) {
+				continue
+			}
+			left := grant.Remaining.AmountOf(owed[i].Denom).Sub(used.AmountOf(owed[i].Denom))
+			take := sdk.NewCoin(owed[i].Denom, owed[i].Amount)
+			if left.LT(take.Amount) {
+				take.Amount = left
+			}
+			if !take.IsPositive() {
+				continue
+			}
+			owed[i] = owed[i].Sub(take)
+			used = used.Add(take)
+		}
+		if used.IsZero() {
+			continue
+		}
+
+		if err := k.usePromoGrant(ctx, grant, used); err != nil {
+			return nil, err
+		}
+		spent = spent.Add(used...)
+	}
+
+	if err := k.releasePromoCredit(ctx, lease.Tenant, spent); err != nil {
+		return nil, err
+	}
+	return spent, nil
+}
+
+// usePromoGrant takes used off what is left of a grant, removing the grant
+// once it runs out.
+func (k *Keeper) usePromoGrant(ctx context.Context, grant types.PromoGrant, used sdk.Coins) error {
+	grant.Remaining = grant.Remaining.Sub(used...)
+	if grant.Remaining.IsZero() {
+		return k.removePromoGrant(ctx, grant)
+	}
+	return k.setPromoGrant(ctx, grant)
+}
+
+// releasePromoCredit moves spent from the promo escrow to the tenant's credit
+// address, from where it is paid out with the rest of the charge.
+func (k *Keeper) releasePromoCredit(ctx context.Context, tenant string, spent sdk.Coins) error {
+	if spent.IsZero() {
+		return nil
+	}
+	creditAddr, err := types.DeriveCreditAddressFromBech32(tenant)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.PromoEscrowName, creditAddr, spent); err != nil {
+		return types.ErrInvalidCreditOperation.Wrapf("failed to release promo credit: %s", err)
+	}
+	return nil
+}
+
+// reclaimExpiredPromoGrants returns what is left of expired grants to their
+// granters and removes the grants, at most MaxPromoGrantReclaimsPerBlock per
+// block. Due entries are collected from PromoGrantsByExpiry first and then
+// processed, each in its own CacheContext.
+func (k *Keeper) reclaimExpiredPromoGrants(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+
+	iter, err := k.PromoGrantsByExpiry.Iterate(ctx, collections.NewPrefixUntilTripleRange[time.Time, sdk.AccAddress, uint64](blockTime))
+	if err != nil {
+		return err
+	}
+
+	var due []collections.Pair[sdk.AccAddress, uint64]
+	for ; iter.Valid(); iter.Next() {
+		if len(due) >= types.MaxPromoGrantReclaimsPerBlock {
+			break
+		}
+		key, err := iter.Key()
+		if err != nil {
+			k.logger.Error("failed to decode promo grant expiry index key", "error", err)
+			continue
+		}
+		due = append(due, collections.Join(key.K2(), key.K3()))
+	}
+
+	if err := iter.Close(); err != nil {
+		k.logger.Error("failed to close iterator", "error", err)
+	}
+
+	reclaimedCount := 0
+	for _, key := range due {
+		grant, err := k.PromoGrants.Get(ctx, key)
+		if err != nil {
+			k.logger.Error("failed to get promo grant for reclaim",
+				"tenant", key.K1().String(),
+				"grant_id", key.K2(),
+				"error", err,
+			)
+			continue
+		}
+
+		cacheCtx, writeCache := sdkCtx.CacheContext()
+		if err := k.reclaimPromoGrant(cacheCtx, grant); err != nil {
+			k.logger.Error("failed to reclaim promo grant",
+				"tenant", grant.Tenant,
+				"grant_id", grant.Id,
+				"error", err,
+			)
+			continue
+		}
+		writeCache()
+		emitPromoCreditReclaimedEvent(sdkCtx, grant)
+		reclaimedCount++
+	}
+
+	if reclaimedCount > 0 {
+		k.logger.Info("reclaimed expired promo grants in EndBlocker",
+			"reclaimed_count", reclaimedCount,
+			"collected_count", len(due),
+		)
+	}
+
+	if len(due) >= types.MaxPromoGrantReclaimsPerBlock {
+		k.logger.Warn("reached max promo grant reclaims per block",
+			"limit", types.MaxPromoGrantReclaimsPerBlock,
+		)
+	}
+
+	return nil
+}
+
+// reclaimPromoGrant removes an expired grant, returns its remaining amount
+// to the granter and reprojects the tenant's credit exhaustion without it.
+func (k *Keeper) reclaimPromoGrant(ctx context.Context, grant types.PromoGrant) error {
+	if err := k.removePromoGrant(ctx, grant); err != nil {
+		return err
+	}
+
+	if !grant.Remaining.IsZero() {
+		granterAddr, err := sdk.AccAddressFromBech32(grant.Granter)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.PromoEscrowName, granterAddr, grant.Remaining); err != nil {
+			return types.ErrInvalidCreditOperation.Wrapf("failed to return promo credit: %s", err)
+		}
+	}
+
+	return k.refreshCreditExhaustion(ctx, grant.Tenant)
+}
+
+// emitPromoCreditGrantedEvent emits a promo_credit_granted event.
+func emitPromoCreditGrantedEvent(ctx sdk.Context, grant types.PromoGrant) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePromoCreditGranted,
+			sdk.NewAttribute(types.AttributeKeyGrantID, strconv.FormatUint(grant.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyTenant, grant.Tenant),
+			sdk.NewAttribute(types.AttributeKeyGranter, grant.Granter),
+			sdk.NewAttribute(types.AttributeKeyAmount, grant.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyExpiresAt, grant.ExpiresAt.Format(time.RFC3339)),
+			sdk.NewAttribute(types.AttributeKeyProviderUUIDs, strings.Join(grant.ProviderUuids, ",")),
+			sdk.NewAttribute(types.AttributeKeySkuUUIDs, strings.Join(grant.SkuUuids, ",")),
+		),
+	)
+}
+
+// emitPromoCreditReclaimedEvent emits a promo_credit_reclaimed event for a
+// grant removed at expiry, with the amount returned to the granter.
+func emitPromoCreditReclaimedEvent(ctx sdk.Context, grant types.PromoGrant) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePromoCreditReclaimed,
+			sdk.NewAttribute(types.AttributeKeyGrantID, strconv.FormatUint(grant.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyTenant, grant.Tenant),
+			sdk.NewAttribute(types.AttributeKeyGranter, grant.Granter),
+			sdk.NewAttribute(types.AttributeKeyAmount, grant.Remaining.String()),
+		),
+	)
+}
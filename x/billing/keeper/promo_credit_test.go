@@ -0,0 +1,288 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/keeper"
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+	skutypes "github.com/manifest-network/manifest-ledger/x/sku/types"
+)
+
+const promoTestUUID = "01912345-6789-7abc-8def-0123456789ab"
+
+// grantPromo grants the tenant amount of testDenom expiring after ttl,
+// funding the authority first.
+func grantPromo(t *testing.T, s *leaseScalingSetup, amount int64, ttl time.Duration, providerUUIDs, skuUUIDs []string) uint64 {
+	t.Helper()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(testDenom, amount))
+	s.f.fundAccount(t, s.f.Authority, coins)
+	resp, err := s.msgServer.GrantPromoCredit(s.f.Ctx, &types.MsgGrantPromoCredit{
+		Authority:     s.f.Authority.String(),
+		Tenant:        s.tenant.String(),
+		Amount:        coins,
+		ExpiresAt:     s.f.Ctx.BlockTime().Add(ttl),
+		ProviderUuids: providerUUIDs,
+		SkuUuids:      skuUUIDs,
+	})
+	require.NoError(t, err)
+	return resp.GrantId
+}
+
+func promoEscrowBalance(f *testFixture) sdkmath.Int {
+	return f.App.BankKeeper.GetBalance(f.Ctx, authtypes.NewModuleAddress(types.PromoEscrowName), testDenom).Amount
+}
+
+func withdrawLease(t *testing.T, s *leaseScalingSetup) {
+	t.Helper()
+	_, err := s.msgServer.Withdraw(s.f.Ctx, &types.MsgWithdraw{
+		Sender:     s.providerAddr.String(),
+		LeaseUuids: []string{s.leaseUUID},
+	})
+	require.NoError(t, err)
+}
+
+func TestPromoCredit_SpentBeforePaidCredit(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600) // 2 units per second
+	f := s.f
+	k := f.App.BillingKeeper
+	querier := keeper.NewQuerier(k)
+	creditAddr, err := types.DeriveCreditAddressFromBech32(s.tenant.String())
+	require.NoError(t, err)
+
+	f.Ctx = f.Ctx.WithEventManager(sdk.NewEventManager())
+	grantID := grantPromo(t, s, 300, time.Hour, nil, nil)
+	require.Equal(t, 1, countEvents(f.Ctx, types.EventTypePromoCreditGranted))
+	require.Equal(t, sdkmath.NewInt(300), promoEscrowBalance(f))
+	require.Equal(t, sdkmath.NewInt(100_000), f.App.BankKeeper.GetBalance(f.Ctx, creditAddr, testDenom).Amount,
+		"the grant is not held at the credit address")
+
+	account, err := querier.CreditAccount(f.Ctx, &types.QueryCreditAccountRequest{Tenant: s.tenant.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(100_000), account.Balances.AmountOf(testDenom))
+	require.Equal(t, sdkmath.NewInt(300), account.PromoBalances.AmountOf(testDenom))
+	require.Len(t, account.PromoGrants, 1)
+	require.Equal(t, grantID, account.PromoGrants[0].Id)
+
+	start := f.Ctx.BlockTime()
+	payoutBefore := f.App.BankKeeper.GetBalance(f.Ctx, s.payoutAddr, testDenom).Amount
+
+	f.Ctx = f.Ctx.WithBlockTime(start.Add(100 * time.Second))
+	withdrawLease(t, s)
+	require.Equal(t, sdkmath.NewInt(100_000), f.App.BankKeeper.GetBalance(f.Ctx, creditAddr, testDenom).Amount,
+		"the promo grant pays the whole period")
+	require.Equal(t, payoutBefore.AddRaw(200), f.App.BankKeeper.GetBalance(f.Ctx, s.payoutAddr, testDenom).Amount)
+	require.Equal(t, sdkmath.NewInt(100), promoEscrowBalance(f))
+
+	f.Ctx = f.Ctx.WithBlockTime(start.Add(200 * time.Second))
+	withdrawLease(t, s)
+	require.Equal(t, sdkmath.NewInt(99_900), f.App.BankKeeper.GetBalance(f.Ctx, creditAddr, testDenom).Amount,
+		"paid credit covers what the grant no longer can")
+	require.Equal(t, payoutBefore.AddRaw(400), f.App.BankKeeper.GetBalance(f.Ctx, s.payoutAddr, testDenom).Amount)
+	require.True(t, promoEscrowBalance(f).IsZero())
+
+	has, err := k.PromoGrants.Has(f.Ctx, collections.Join(s.tenant, grantID))
+	require.NoError(t, err)
+	require.False(t, has, "a spent grant is removed")
+
+	settlements, err := querier.LeaseSettlements(f.Ctx, &types.QueryLeaseSettlementsRequest{LeaseUuid: s.leaseUUID})
+	require.NoError(t, err)
+	require.Len(t, settlements.Settlements, 2)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 200)), settlements.Settlements[0].Promo)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 100)), settlements.Settlements[1].Promo)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 200)), settlements.Settlements[1].Transferred)
+
+	msg, broken := keeper.AllInvariants(k)(f.Ctx)
+	require.False(t, broken, msg)
+}
+
+func TestPromoCredit_NotWithdrawable(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	grantPromo(t, s, 5_000, time.Hour, nil, nil)
+	// Without the withdrawal cooldown the funded credit is not locked.
+	params, err := f.App.BillingKeeper.GetParams(f.Ctx)
+	require.NoError(t, err)
+	params.CreditWithdrawalCooldown = 0
+	require.NoError(t, f.App.BillingKeeper.SetParams(f.Ctx, params))
+
+	available, err := keeper.NewQuerier(f.App.BillingKeeper).AvailableCredit(f.Ctx, &types.QueryAvailableCreditRequest{Tenant: s.tenant.String()})
+	require.NoError(t, err)
+	// 100_000 funded minus the 7_200 reservation; the grant adds nothing.
+	require.True(t, sdkmath.NewInt(100_000).Equal(available.Balances.AmountOf(testDenom)))
+	require.True(t, sdkmath.NewInt(92_800).Equal(available.Available.AmountOf(testDenom)))
+}
+
+func TestPromoCredit_Restrictions(t *testing.T) {
+	tests := []struct {
+		name          string
+		providerUUIDs func(s *leaseScalingSetup) []string
+		skuUUIDs      func(s *leaseScalingSetup) []string
+		spent         int64
+	}{
+		{
+			name:          "other provider",
+			providerUUIDs: func(*leaseScalingSetup) []string { return []string{promoTestUUID} },
+			skuUUIDs:      func(*leaseScalingSetup) []string { return nil },
+			spent:         0,
+		},
+		{
+			name:          "other SKU",
+			providerUUIDs: func(*leaseScalingSetup) []string { return nil },
+			skuUUIDs:      func(*leaseScalingSetup) []string { return []string{promoTestUUID} },
+			spent:         0,
+		},
+		{
+			name:          "lease provider and SKU",
+			providerUUIDs: func(s *leaseScalingSetup) []string { return []string{s.provider.Uuid} },
+			skuUUIDs:      func(s *leaseScalingSetup) []string { return []string{s.sku.Uuid} },
+			spent:         200,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+			f := s.f
+			grantPromo(t, s, 1_000, time.Hour, tc.providerUUIDs(s), tc.skuUUIDs(s))
+
+			f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(100 * time.Second))
+			withdrawLease(t, s)
+			require.Equal(t, sdkmath.NewInt(1_000-tc.spent), promoEscrowBalance(f))
+		})
+	}
+}
+
+func TestPromoCredit_ReclaimedAtExpiry(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	k := f.App.BillingKeeper
+	grantID := grantPromo(t, s, 1_000, 50*time.Second, nil, nil)
+	authorityBefore := f.App.BankKeeper.GetBalance(f.Ctx, f.Authority, testDenom).Amount
+
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(40 * time.Second))
+	withdrawLease(t, s) // 80 paid out of the grant
+	require.NoError(t, k.EndBlocker(f.Ctx))
+	require.Equal(t, sdkmath.NewInt(920), promoEscrowBalance(f), "the grant is not reclaimed before expiry")
+
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(10 * time.Second))
+	f.Ctx = f.Ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.EndBlocker(f.Ctx))
+	require.Equal(t, 1, countEvents(f.Ctx, types.EventTypePromoCreditReclaimed))
+	require.True(t, promoEscrowBalance(f).IsZero())
+	require.Equal(t, authorityBefore.AddRaw(920), f.App.BankKeeper.GetBalance(f.Ctx, f.Authority, testDenom).Amount)
+
+	has, err := k.PromoGrants.Has(f.Ctx, collections.Join(s.tenant, grantID))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	msg, broken := keeper.AllInvariants(k)(f.Ctx)
+	require.False(t, broken, msg)
+}
+
+func TestPromoCredit_ExpiredGrantNotSpent(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	grantPromo(t, s, 1_000, 50*time.Second, nil, nil)
+
+	// Settled only after expiry, before the EndBlocker reclaimed the grant.
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(100 * time.Second))
+	withdrawLease(t, s)
+	require.Equal(t, sdkmath.NewInt(1_000), promoEscrowBalance(f))
+}
+
+func TestPromoCredit_ExtendsCreditEstimate(t *testing.T) {
+	s := setupLeaseScaling(t, 7_200, skutypes.Unit_UNIT_PER_HOUR, 3600) // exactly the reservation: 3600s at 2/s
+	f := s.f
+	k := f.App.BillingKeeper
+	querier := keeper.NewQuerier(k)
+
+	estimate, err := querier.CreditEstimate(f.Ctx, &types.QueryCreditEstimateRequest{Tenant: s.tenant.String()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3600), estimate.EstimatedDurationSeconds)
+
+	grantPromo(t, s, 2_000, 24*time.Hour, nil, nil)
+
+	estimate, err = querier.CreditEstimate(f.Ctx, &types.QueryCreditEstimateRequest{Tenant: s.tenant.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(7_200), estimate.CurrentBalance.AmountOf(testDenom))
+	require.Equal(t, sdkmath.NewInt(2_000), estimate.PromoBalance.AmountOf(testDenom))
+	require.Equal(t, uint64(4600), estimate.EstimatedDurationSeconds)
+
+	exhaustsAt, err := k.CreditExhaustion.Get(f.Ctx, collections.Join(s.tenant, testDenom))
+	require.NoError(t, err)
+	require.True(t, exhaustsAt.Equal(f.Ctx.BlockTime().Add(4600*time.Second)), "the projection counts the grant")
+
+	// Past the paid credit alone the lease keeps running on the grant.
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(4000 * time.Second))
+	require.NoError(t, k.EndBlocker(f.Ctx))
+	lease, err := k.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_ACTIVE, lease.State)
+}
+
+func TestMsgGrantPromoCredit_Rejections(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	coins := sdk.NewCoins(sdk.NewInt64Coin(testDenom, 100))
+	f.fundAccount(t, f.Authority, coins)
+
+	valid := types.MsgGrantPromoCredit{
+		Authority: f.Authority.String(),
+		Tenant:    s.tenant.String(),
+		Amount:    coins,
+		ExpiresAt: f.Ctx.BlockTime().Add(time.Hour),
+	}
+
+	msg := valid
+	msg.Authority = s.tenant.String()
+	_, err := s.msgServer.GrantPromoCredit(f.Ctx, &msg)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	msg = valid
+	msg.ExpiresAt = f.Ctx.BlockTime()
+	_, err = s.msgServer.GrantPromoCredit(f.Ctx, &msg)
+	require.ErrorIs(t, err, types.ErrInvalidPromoGrant)
+
+	msg = valid
+	msg.Amount = sdk.NewCoins(sdk.NewInt64Coin(testDenom, 101))
+	_, err = s.msgServer.GrantPromoCredit(f.Ctx, &msg)
+	require.ErrorIs(t, err, types.ErrInvalidCreditOperation, "the authority must fund the grant")
+
+	f.fundAccount(t, f.Authority, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 100*types.MaxPromoGrantsPerTenant)))
+	for range types.MaxPromoGrantsPerTenant {
+		_, err = s.msgServer.GrantPromoCredit(f.Ctx, &valid)
+		require.NoError(t, err)
+	}
+	_, err = s.msgServer.GrantPromoCredit(f.Ctx, &valid)
+	require.ErrorIs(t, err, types.ErrInvalidPromoGrant)
+}
+
+func TestMsgGrantPromoCredit_CreatesCreditAccount(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	newcomer := f.TestAccs[3]
+	coins := sdk.NewCoins(sdk.NewInt64Coin(testDenom, 500))
+	f.fundAccount(t, f.Authority, coins)
+
+	_, err := s.msgServer.GrantPromoCredit(f.Ctx, &types.MsgGrantPromoCredit{
+		Authority: f.Authority.String(),
+		Tenant:    newcomer.String(),
+		Amount:    coins,
+		ExpiresAt: f.Ctx.BlockTime().Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	account, err := keeper.NewQuerier(f.App.BillingKeeper).CreditAccount(f.Ctx, &types.QueryCreditAccountRequest{Tenant: newcomer.String()})
+	require.NoError(t, err)
+	require.True(t, account.Balances.IsZero())
+	require.Equal(t, coins, account.PromoBalances)
+}
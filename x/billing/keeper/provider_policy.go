@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+)
+
+// GetProviderPolicy returns the lease policy of a provider. The second return
+// reports whether the provider has set one; when it has not, the returned
+// policy only carries the provider UUID.
+func (k *Keeper) GetProviderPolicy(ctx context.Context, providerUUID string) (types.ProviderPolicy, bool, error) {
+	policy, err := k.ProviderPolicies.Get(ctx, providerUUID)
+	if err == nil {
+		return policy, true, nil
+	}
+	if !errors.Is(err, collections.ErrNotFound) {
+		return types.ProviderPolicy{}, false, err
+	}
+	return types.ProviderPolicy{ProviderUuid: providerUUID}, false, nil
+}
+
+// SetProviderPolicy stores the lease policy of a provider, replacing any
+// previous one, or removes it when reset is true. The policy applies to leases
+// created afterwards and, for the pending timeout, to leases already PENDING.
+func (k *Keeper) SetProviderPolicy(ctx context.Context, policy types.ProviderPolicy, reset bool) error {
+	if reset {
+		return k.ProviderPolicies.Remove(ctx, policy.ProviderUuid)
+	}
+	return k.ProviderPolicies.Set(ctx, policy.ProviderUuid, policy)
+}
+
+// GetEffectivePendingTimeout returns the pending timeout in seconds that
+// applies to the leases of a provider: its policy's pending_timeout if set,
+// params.pending_timeout otherwise.
+func (k *Keeper) GetEffectivePendingTimeout(ctx context.Context, providerUUID string) (uint64, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return k.pendingTimeoutOrDefault(ctx, providerUUID, params.PendingTimeout)
+}
+
+// pendingTimeoutOrDefault returns the pending_timeout of the provider's
+// policy, or defaultTimeout when the provider has no policy or its policy
+// leaves the timeout unset.
+func (k *Keeper) pendingTimeoutOrDefault(ctx context.Context, providerUUID string, defaultTimeout uint64) (uint64, error) {
+	policy, found, err := k.GetProviderPolicy(ctx, providerUUID)
+	if err != nil {
+		return 0, err
+	}
+	if found && policy.PendingTimeout != 0 {
+		return policy.PendingTimeout, nil
+	}
+	return defaultTimeout, nil
+}
+
+// cachedPendingTimeout returns the pending timeout of a provider as a
+// duration, like pendingTimeoutOrDefault, looking each provider up once per
+// cache.
+func (k *Keeper) cachedPendingTimeout(ctx context.Context, cache map[string]time.Duration, providerUUID string, defaultTimeout uint64) (time.Duration, error) {
+	if timeout, ok := cache[providerUUID]; ok {
+		return timeout, nil
+	}
+	seconds, err := k.pendingTimeoutOrDefault(ctx, providerUUID, defaultTimeout)
+	if err != nil {
+		return 0, err
+	}
+	// #nosec G115 -- pending timeouts are validated to at most MaxPendingTimeout
+	timeout := time.Duration(seconds) * time.Second
+	cache[providerUUID] = timeout
+	return timeout, nil
+}
+
+// emitProviderPolicySetEvent emits the event for a MsgSetProviderPolicy.
+func emitProviderPolicySetEvent(ctx sdk.Context, sender string, policy types.ProviderPolicy, reset bool) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProviderPolicySet,
+			sdk.NewAttribute(types.AttributeKeyProviderUUID, policy.ProviderUuid),
+			sdk.NewAttribute(types.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeKeyPendingTimeout, strconv.FormatUint(policy.PendingTimeout, 10)),
+			sdk.NewAttribute(types.AttributeKeyAutoAcknowledge, strconv.FormatBool(policy.AutoAcknowledge)),
+			sdk.NewAttribute(types.AttributeKeyOverrideGracePeriod, strconv.FormatBool(policy.OverrideGracePeriod)),
+			sdk.NewAttribute(types.AttributeKeyGracePeriod, strconv.FormatUint(policy.GracePeriod, 10)),
+			sdk.NewAttribute(types.AttributeKeyAllowedTenants, strings.Join(policy.AllowedTenants, ",")),
+			sdk.NewAttribute(types.AttributeKeyDeniedTenants, strings.Join(policy.DeniedTenants, ",")),
+			sdk.NewAttribute(types.AttributeKeyReset, strconv.FormatBool(reset)),
+		),
+	)
+}
@@ -0,0 +1,215 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/keeper"
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+)
+
+// skuProvider returns the UUID of the provider selling skuUUID.
+func skuProvider(t *testing.T, f *testFixture, skuUUID string) string {
+	t.Helper()
+	sku, err := f.App.SKUKeeper.GetSKU(f.Ctx, skuUUID)
+	require.NoError(t, err)
+	return sku.ProviderUuid
+}
+
+func TestMsgSetProviderPolicy(t *testing.T) {
+	f, msgServer, tenant, skus := setupLeaseBatch(t, 10_000)
+	k := f.App.BillingKeeper
+	querier := keeper.NewQuerier(k)
+	providerUUID := skuProvider(t, f, skus[0])
+	providerAddr := f.TestAccs[1]
+
+	policy := types.ProviderPolicy{
+		ProviderUuid:   providerUUID,
+		PendingTimeout: 600,
+		DeniedTenants:  []string{f.TestAccs[3].String()},
+	}
+
+	_, err := msgServer.SetProviderPolicy(f.Ctx, &types.MsgSetProviderPolicy{Sender: tenant.String(), Policy: policy})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	f.Ctx = f.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.SetProviderPolicy(f.Ctx, &types.MsgSetProviderPolicy{Sender: providerAddr.String(), Policy: policy})
+	require.NoError(t, err)
+	require.Equal(t, 1, countEvents(f.Ctx, types.EventTypeProviderPolicySet))
+
+	resp, err := querier.ProviderPolicy(f.Ctx, &types.QueryProviderPolicyRequest{ProviderUuid: providerUUID})
+	require.NoError(t, err)
+	require.True(t, resp.Found)
+	require.Equal(t, policy, resp.Policy)
+	require.Equal(t, uint64(600), resp.EffectivePendingTimeout)
+
+	listResp, err := querier.ProviderPolicies(f.Ctx, &types.QueryProviderPoliciesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.ProviderPolicy{policy}, listResp.Policies)
+
+	exported := k.ExportGenesis(f.Ctx)
+	require.Equal(t, []types.ProviderPolicy{policy}, exported.ProviderPolicies)
+
+	_, err = msgServer.SetProviderPolicy(f.Ctx, &types.MsgSetProviderPolicy{
+		Sender: k.GetAuthority(),
+		Policy: types.ProviderPolicy{ProviderUuid: providerUUID},
+		Reset_: true,
+	})
+	require.NoError(t, err)
+
+	resp, err = querier.ProviderPolicy(f.Ctx, &types.QueryProviderPolicyRequest{ProviderUuid: providerUUID})
+	require.NoError(t, err)
+	require.False(t, resp.Found)
+	require.Equal(t, types.DefaultPendingTimeout, resp.EffectivePendingTimeout)
+}
+
+func TestProviderPolicy_PendingTimeout(t *testing.T) {
+	f, msgServer, tenant, skus := setupLeaseBatch(t, 100_000)
+	k := f.App.BillingKeeper
+
+	_, err := msgServer.SetProviderPolicy(f.Ctx, &types.MsgSetProviderPolicy{
+		Sender: f.TestAccs[1].String(),
+		Policy: types.ProviderPolicy{ProviderUuid: skuProvider(t, f, skus[0]), PendingTimeout: 120},
+	})
+	require.NoError(t, err)
+
+	resp, err := msgServer.CreateLeases(f.Ctx, &types.MsgCreateLeases{
+		Tenant: tenant.String(),
+		Leases: []types.LeaseInput{
+			{Items: []types.LeaseItemInput{{SkuUuid: skus[0], Quantity: 1}}},
+			{Items: []types.LeaseItemInput{{SkuUuid: skus[1], Quantity: 1}}},
+		},
+	})
+	require.NoError(t, err)
+	overridden, inherited := resp.LeaseUuids[0], resp.LeaseUuids[1]
+	createdAt := f.Ctx.BlockTime()
+
+	// Past the provider's timeout, within params.pending_timeout.
+	f.Ctx = f.Ctx.WithBlockTime(createdAt.Add(121 * time.Second))
+	require.NoError(t, k.EndBlocker(f.Ctx))
+
+	lease, err := k.GetLease(f.Ctx, overridden)
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_EXPIRED, lease.State)
+	lease, err = k.GetLease(f.Ctx, inherited)
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_PENDING, lease.State)
+
+	f.Ctx = f.Ctx.WithBlockTime(createdAt.Add(time.Duration(types.DefaultPendingTimeout+1) * time.Second))
+	require.NoError(t, k.EndBlocker(f.Ctx))
+
+	lease, err = k.GetLease(f.Ctx, inherited)
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_EXPIRED, lease.State)
+}
+
+func TestProviderPolicy_AutoAcknowledge(t *testing.T) {
+	f, msgServer, tenant, skus := setupLeaseBatch(t, 100_000)
+	k := f.App.BillingKeeper
+
+	_, err := msgServer.SetProviderPolicy(f.Ctx, &types.MsgSetProviderPolicy{
+		Sender: f.TestAccs[1].String(),
+		Policy: types.ProviderPolicy{ProviderUuid: skuProvider(t, f, skus[0]), AutoAcknowledge: true},
+	})
+	require.NoError(t, err)
+
+	f.Ctx = f.Ctx.WithEventManager(sdk.NewEventManager())
+	resp, err := msgServer.CreateLeases(f.Ctx, &types.MsgCreateLeases{
+		Tenant: tenant.String(),
+		Leases: []types.LeaseInput{
+			{Items: []types.LeaseItemInput{{SkuUuid: skus[0], Quantity: 1}}},
+			{Items: []types.LeaseItemInput{{SkuUuid: skus[1], Quantity: 1}}},
+		},
+	})
+	require.NoError(t, err)
+
+	auto, err := k.GetLease(f.Ctx, resp.LeaseUuids[0])
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_ACTIVE, auto.State)
+	require.NotNil(t, auto.AcknowledgedAt)
+	require.True(t, auto.AcknowledgedAt.Equal(f.Ctx.BlockTime()))
+	require.True(t, auto.LastSettledAt.Equal(f.Ctx.BlockTime()))
+
+	manual, err := k.GetLease(f.Ctx, resp.LeaseUuids[1])
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_PENDING, manual.State)
+
+	ca, err := k.GetCreditAccount(f.Ctx, tenant.String())
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), ca.ActiveLeaseCount)
+	require.Equal(t, uint64(1), ca.PendingLeaseCount)
+
+	// The auto-acknowledged lease is billed, so it is projected to exhaust.
+	has, err := k.CreditExhaustion.Has(f.Ctx, collections.Join(tenant, testDenom))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	require.Equal(t, 2, countEvents(f.Ctx, types.EventTypeLeaseCreated))
+	require.Equal(t, 1, countEvents(f.Ctx, types.EventTypeLeaseAcknowledged))
+	for _, ev := range f.Ctx.EventManager().Events() {
+		if ev.Type != types.EventTypeLeaseAcknowledged {
+			continue
+		}
+		attr, ok := ev.GetAttribute(types.AttributeKeyAcknowledgedBy)
+		require.True(t, ok)
+		require.Equal(t, types.AcknowledgedByProviderPolicy, attr.Value)
+	}
+
+	// Auto-acknowledged leases count towards max_leases_per_tenant.
+	params, err := k.GetParams(f.Ctx)
+	require.NoError(t, err)
+	params.MaxLeasesPerTenant = 2
+	require.NoError(t, k.SetParams(f.Ctx, params))
+
+	lease := types.LeaseInput{Items: []types.LeaseItemInput{{SkuUuid: skus[0], Quantity: 1}}}
+	_, err = msgServer.CreateLeases(f.Ctx, &types.MsgCreateLeases{
+		Tenant: tenant.String(),
+		Leases: []types.LeaseInput{lease, lease},
+	})
+	require.ErrorIs(t, err, types.ErrMaxLeasesReached)
+}
+
+func TestProviderPolicy_TenantLists(t *testing.T) {
+	f, msgServer, tenant, skus := setupLeaseBatch(t, 100_000)
+	other := f.TestAccs[3]
+	providerUUID := skuProvider(t, f, skus[0])
+	items := []types.LeaseItemInput{{SkuUuid: skus[0], Quantity: 1}}
+
+	setPolicy := func(policy types.ProviderPolicy) {
+		t.Helper()
+		policy.ProviderUuid = providerUUID
+		_, err := msgServer.SetProviderPolicy(f.Ctx, &types.MsgSetProviderPolicy{Sender: f.TestAccs[1].String(), Policy: policy})
+		require.NoError(t, err)
+	}
+
+	setPolicy(types.ProviderPolicy{DeniedTenants: []string{tenant.String()}})
+	_, err := msgServer.CreateLease(f.Ctx, &types.MsgCreateLease{Tenant: tenant.String(), Items: items})
+	require.ErrorIs(t, err, types.ErrTenantNotAllowed)
+
+	// A denied lease fails the whole batch.
+	_, err = msgServer.CreateLeases(f.Ctx, &types.MsgCreateLeases{
+		Tenant: tenant.String(),
+		Leases: []types.LeaseInput{
+			{Items: []types.LeaseItemInput{{SkuUuid: skus[1], Quantity: 1}}},
+			{Items: items},
+		},
+	})
+	require.ErrorIs(t, err, types.ErrTenantNotAllowed)
+	leases, err := f.App.BillingKeeper.GetLeasesByTenant(f.Ctx, tenant.String())
+	require.NoError(t, err)
+	require.Empty(t, leases)
+
+	setPolicy(types.ProviderPolicy{AllowedTenants: []string{other.String()}})
+	_, err = msgServer.CreateLease(f.Ctx, &types.MsgCreateLease{Tenant: tenant.String(), Items: items})
+	require.ErrorIs(t, err, types.ErrTenantNotAllowed)
+
+	setPolicy(types.ProviderPolicy{AllowedTenants: []string{other.String(), tenant.String()}})
+	_, err = msgServer.CreateLease(f.Ctx, &types.MsgCreateLease{Tenant: tenant.String(), Items: items})
+	require.NoError(t, err)
+}
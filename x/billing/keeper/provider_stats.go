@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+)
+
+// GetProviderStats returns the lease counters of a provider, or empty counters
+// if none of its leases has changed state yet.
+func (k *Keeper) GetProviderStats(ctx context.Context, providerUUID string) (types.ProviderStats, error) {
+	stats, err := k.ProviderStats.Get(ctx, providerUUID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.NewProviderStats(providerUUID), nil
+	}
+	return stats, err
+}
+
+// updateProviderStats applies update to the counters of a provider and
+// stores them. Nothing is stored if update fails.
+func (k *Keeper) updateProviderStats(ctx context.Context, providerUUID string, update func(*types.ProviderStats) error) error {
+	stats, err := k.GetProviderStats(ctx, providerUUID)
+	if err != nil {
+		return err
+	}
+	if err := update(&stats); err != nil {
+		return err
+	}
+	return k.ProviderStats.Set(ctx, providerUUID, stats)
+}
+
+// secondsBetween returns the whole seconds from start to end, or zero if end
+// is not after start.
+func secondsBetween(start, end time.Time) uint64 {
+	if !end.After(start) {
+		return 0
+	}
+	// #nosec G115 -- checked positive above
+	return uint64(end.Sub(start) / time.Second)
+}
+
+// ackLatency returns the seconds between a lease's creation and its
+// acknowledgement.
+func ackLatency(lease types.Lease) uint64 {
+	if lease.AcknowledgedAt == nil {
+		return 0
+	}
+	return secondsBetween(lease.CreatedAt, *lease.AcknowledgedAt)
+}
+
+// acknowledgedAtCreation reports whether a lease was acknowledged in the block
+// that created it, as every lease auto-acknowledged by a provider policy is.
+func acknowledgedAtCreation(lease types.Lease) bool {
+	return lease.AcknowledgedAt != nil && lease.AcknowledgedAt.Equal(lease.CreatedAt)
+}
+
+// recordAcknowledgement counts the acknowledgement of lease in s.
+func recordAcknowledgement(s *types.ProviderStats, lease types.Lease) error {
+	return s.RecordAcknowledgement(ackLatency(lease), acknowledgedAtCreation(lease))
+}
+
+// activeLifetime returns the seconds a closed lease spent ACTIVE.
+func activeLifetime(lease types.Lease) uint64 {
+	if lease.AcknowledgedAt == nil || lease.ClosedAt == nil {
+		return 0
+	}
+	return secondsBetween(*lease.AcknowledgedAt, *lease.ClosedAt)
+}
+
+// providerStatsHooks keeps ProviderStats in step with lease state changes. It
+// is always run ahead of the hooks registered with SetHooks, so the counters
+// follow every transition those hooks see.
+type providerStatsHooks struct {
+	k *Keeper
+}
+
+var _ types.BillingHooks = providerStatsHooks{}
+
+// AfterLeaseCreated implements types.BillingHooks.
+func (h providerStatsHooks) AfterLeaseCreated(ctx context.Context, lease types.Lease) error {
+	return h.k.updateProviderStats(ctx, lease.ProviderUuid, func(s *types.ProviderStats) error {
+		s.LeasesCreated++
+		return nil
+	})
+}
+
+// AfterLeaseAcknowledged implements types.BillingHooks.
+func (h providerStatsHooks) AfterLeaseAcknowledged(ctx context.Context, lease types.Lease) error {
+	return h.k.updateProviderStats(ctx, lease.ProviderUuid, func(s *types.ProviderStats) error {
+		return recordAcknowledgement(s, lease)
+	})
+}
+
+// AfterLeaseRejected implements types.BillingHooks.
+func (h providerStatsHooks) AfterLeaseRejected(ctx context.Context, lease types.Lease) error {
+	return h.k.updateProviderStats(ctx, lease.ProviderUuid, func(s *types.ProviderStats) error {
+		s.LeasesRejected++
+		return nil
+	})
+}
+
+// AfterLeaseCancelled implements types.BillingHooks.
+func (h providerStatsHooks) AfterLeaseCancelled(ctx context.Context, lease types.Lease) error {
+	return h.k.updateProviderStats(ctx, lease.ProviderUuid, func(s *types.ProviderStats) error {
+		s.LeasesCancelled++
+		return nil
+	})
+}
+
+// AfterLeaseExpired implements types.BillingHooks.
+func (h providerStatsHooks) AfterLeaseExpired(ctx context.Context, lease types.Lease) error {
+	return h.k.updateProviderStats(ctx, lease.ProviderUuid, func(s *types.ProviderStats) error {
+		s.LeasesExpired++
+		return nil
+	})
+}
+
+// AfterLeaseClosed implements types.BillingHooks.
+func (h providerStatsHooks) AfterLeaseClosed(ctx context.Context, lease types.Lease) error {
+	return h.k.updateProviderStats(ctx, lease.ProviderUuid, func(s *types.ProviderStats) error {
+		s.RecordClosure(activeLifetime(lease), lease.ClosureReason == types.ClosureReasonCreditExhausted)
+		return nil
+	})
+}
+
+// AfterLeaseSettled implements types.BillingHooks.
+func (providerStatsHooks) AfterLeaseSettled(context.Context, types.Lease, sdk.Coins) error {
+	return nil
+}
+
+// AfterCreditFunded implements types.BillingHooks.
+func (providerStatsHooks) AfterCreditFunded(context.Context, string, sdk.Coin) error {
+	return nil
+}
+
+// recordLeaseHistory adds to the provider's counters every transition a
+// stored lease has already gone through. It is used to seed ProviderStats
+// from the leases in state.
+func (k *Keeper) recordLeaseHistory(ctx context.Context, lease types.Lease) error {
+	return k.updateProviderStats(ctx, lease.ProviderUuid, func(s *types.ProviderStats) error {
+		s.LeasesCreated++
+		if lease.AcknowledgedAt != nil {
+			if err := recordAcknowledgement(s, lease); err != nil {
+				return err
+			}
+		}
+
+		switch lease.State {
+		case types.LEASE_STATE_REJECTED:
+			if lease.RejectionReason == types.RejectionReasonCancelledByTenant {
+				s.LeasesCancelled++
+			} else {
+				s.LeasesRejected++
+			}
+		case types.LEASE_STATE_EXPIRED:
+			s.LeasesExpired++
+		case types.LEASE_STATE_CLOSED:
+			if lease.AcknowledgedAt != nil {
+				s.RecordClosure(activeLifetime(lease), lease.ClosureReason == types.ClosureReasonCreditExhausted)
+			}
+		}
+		return nil
+	})
+}
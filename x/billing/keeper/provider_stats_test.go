@@ -0,0 +1,164 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/keeper"
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+)
+
+func TestProviderStats_Lifecycle(t *testing.T) {
+	f, msgServer, tenant, skus := setupLeaseBatch(t, 100_000)
+	k := f.App.BillingKeeper
+	querier := keeper.NewQuerier(k)
+	providerUUID := skuProvider(t, f, skus[0])
+	providerAddr := f.TestAccs[1].String()
+
+	lease := types.LeaseInput{Items: []types.LeaseItemInput{{SkuUuid: skus[0], Quantity: 1}}}
+	resp, err := msgServer.CreateLeases(f.Ctx, &types.MsgCreateLeases{
+		Tenant: tenant.String(),
+		Leases: []types.LeaseInput{lease, lease, lease, lease, lease},
+	})
+	require.NoError(t, err)
+	fast, slow, rejected, cancelled := resp.LeaseUuids[0], resp.LeaseUuids[1], resp.LeaseUuids[2], resp.LeaseUuids[3]
+	createdAt := f.Ctx.BlockTime()
+
+	f.Ctx = f.Ctx.WithBlockTime(createdAt.Add(20 * time.Second))
+	_, err = msgServer.AcknowledgeLease(f.Ctx, &types.MsgAcknowledgeLease{Sender: providerAddr, LeaseUuids: []string{fast}})
+	require.NoError(t, err)
+
+	f.Ctx = f.Ctx.WithBlockTime(createdAt.Add(100 * time.Second))
+	_, err = msgServer.AcknowledgeLease(f.Ctx, &types.MsgAcknowledgeLease{Sender: providerAddr, LeaseUuids: []string{slow}})
+	require.NoError(t, err)
+	_, err = msgServer.RejectLease(f.Ctx, &types.MsgRejectLease{Sender: providerAddr, LeaseUuids: []string{rejected}})
+	require.NoError(t, err)
+	_, err = msgServer.CancelLease(f.Ctx, &types.MsgCancelLease{Tenant: tenant.String(), LeaseUuids: []string{cancelled}})
+	require.NoError(t, err)
+
+	// The fifth lease expires unanswered.
+	closedAt := createdAt.Add(time.Duration(types.DefaultPendingTimeout+1) * time.Second)
+	f.Ctx = f.Ctx.WithBlockTime(closedAt)
+	require.NoError(t, k.EndBlocker(f.Ctx))
+
+	_, err = msgServer.CloseLease(f.Ctx, &types.MsgCloseLease{Sender: tenant.String(), LeaseUuids: []string{fast}})
+	require.NoError(t, err)
+
+	stats, err := querier.ProviderStats(f.Ctx, &types.QueryProviderStatsRequest{ProviderUuid: providerUUID})
+	require.NoError(t, err)
+
+	lifetime := uint64(closedAt.Sub(createdAt.Add(20*time.Second)) / time.Second)
+	expected := types.NewProviderStats(providerUUID)
+	expected.LeasesCreated = 5
+	expected.LeasesAcknowledged = 2
+	expected.AckLatencySum = 120
+	expected.AckLatencyBuckets[1] = 1 // 20s, within 30s
+	expected.AckLatencyBuckets[3] = 1 // 100s, within 300s
+	expected.LeasesRejected = 1
+	expected.LeasesCancelled = 1
+	expected.LeasesExpired = 1
+	expected.LeasesClosed = 1
+	expected.LifetimeSum = lifetime
+	require.Equal(t, expected, stats.Stats)
+
+	require.Equal(t, types.AckLatencyBucketBounds(), stats.AckLatencyBucketBounds)
+	require.Equal(t, uint64(60), stats.AverageAckLatency)
+	require.Equal(t, lifetime, stats.AverageLeaseLifetime)
+	// Cancellations are left out of the rates: 2 acknowledged, 1 rejected
+	// and 1 expired.
+	require.Equal(t, sdkmath.LegacyNewDecWithPrec(5, 1), stats.AcknowledgementRate)
+	require.Equal(t, sdkmath.LegacyNewDecWithPrec(25, 2), stats.RejectionRate)
+	require.Equal(t, sdkmath.LegacyNewDecWithPrec(25, 2), stats.ExpiryRate)
+
+	// The other provider is untouched.
+	other, err := querier.ProviderStats(f.Ctx, &types.QueryProviderStatsRequest{ProviderUuid: skuProvider(t, f, skus[1])})
+	require.NoError(t, err)
+	require.Zero(t, other.Stats.LeasesCreated)
+	require.True(t, other.AcknowledgementRate.IsZero())
+
+	exported := k.ExportGenesis(f.Ctx)
+	require.Equal(t, []types.ProviderStats{expected}, exported.ProviderStats)
+
+	// Rebuilding the stats from the leases in state gives the same counters.
+	require.NoError(t, keeper.NewMigrator(k).Migrate2to3(f.Ctx))
+	rebuilt, err := k.GetProviderStats(f.Ctx, providerUUID)
+	require.NoError(t, err)
+	require.Equal(t, expected, rebuilt)
+}
+
+func TestProviderStats_CreditExhaustionClosure(t *testing.T) {
+	f, msgServer, tenant, skus := setupLeaseBatch(t, 5_000)
+	k := f.App.BillingKeeper
+	providerUUID := skuProvider(t, f, skus[0])
+
+	f.createAndAcknowledgeLease(t, msgServer, tenant, f.TestAccs[1], []types.LeaseItemInput{
+		{SkuUuid: skus[0], Quantity: 1},
+	})
+	ackedAt := f.Ctx.BlockTime()
+
+	exhaustAt, err := k.CreditExhaustion.Get(f.Ctx, collections.Join(tenant, testDenom))
+	require.NoError(t, err)
+	f.Ctx = f.Ctx.WithBlockTime(exhaustAt)
+	require.NoError(t, k.EndBlocker(f.Ctx))
+
+	stats, err := k.GetProviderStats(f.Ctx, providerUUID)
+	require.NoError(t, err)
+	// Acknowledged in its creation block, so outside the latency histogram.
+	require.Equal(t, uint64(1), stats.LeasesAcknowledged)
+	require.Equal(t, uint64(1), stats.LeasesAcknowledgedAtCreation)
+	require.Zero(t, stats.AckLatencyBuckets[0])
+	require.Equal(t, uint64(1), stats.LeasesClosed)
+	require.Equal(t, uint64(1), stats.CreditExhaustionClosures)
+	require.Equal(t, uint64(exhaustAt.Sub(ackedAt)/time.Second), stats.LifetimeSum)
+}
+
+func TestProviderStats_AutoAcknowledgementOutsideLatency(t *testing.T) {
+	f, msgServer, tenant, skus := setupLeaseBatch(t, 100_000)
+	k := f.App.BillingKeeper
+	providerUUID := skuProvider(t, f, skus[0])
+	providerAddr := f.TestAccs[1].String()
+
+	resp, err := msgServer.CreateLease(f.Ctx, &types.MsgCreateLease{
+		Tenant: tenant.String(),
+		Items:  []types.LeaseItemInput{{SkuUuid: skus[0], Quantity: 1}},
+	})
+	require.NoError(t, err)
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(40 * time.Second))
+	_, err = msgServer.AcknowledgeLease(f.Ctx, &types.MsgAcknowledgeLease{Sender: providerAddr, LeaseUuids: []string{resp.LeaseUuid}})
+	require.NoError(t, err)
+
+	_, err = msgServer.SetProviderPolicy(f.Ctx, &types.MsgSetProviderPolicy{
+		Sender: providerAddr,
+		Policy: types.ProviderPolicy{ProviderUuid: providerUUID, AutoAcknowledge: true},
+	})
+	require.NoError(t, err)
+	for range 3 {
+		_, err = msgServer.CreateLease(f.Ctx, &types.MsgCreateLease{
+			Tenant: tenant.String(),
+			Items:  []types.LeaseItemInput{{SkuUuid: skus[0], Quantity: 1}},
+		})
+		require.NoError(t, err)
+	}
+
+	stats, err := keeper.NewQuerier(k).ProviderStats(f.Ctx, &types.QueryProviderStatsRequest{ProviderUuid: providerUUID})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), stats.Stats.LeasesAcknowledged)
+	require.Equal(t, uint64(3), stats.Stats.LeasesAcknowledgedAtCreation)
+	require.Equal(t, []uint64{0, 0, 1, 0, 0, 0, 0, 0, 0}, stats.Stats.AckLatencyBuckets)
+	// The average covers the manual acknowledgement only.
+	require.Equal(t, uint64(40), stats.AverageAckLatency)
+	expected := stats.Stats
+
+	// Seeding the stats from the leases in state, as the upgrade does,
+	// counts the auto-acknowledgements apart from the histogram too.
+	require.NoError(t, k.ProviderStats.Remove(f.Ctx, providerUUID))
+	require.NoError(t, keeper.NewMigrator(k).Migrate2to3(f.Ctx))
+	migrated, err := k.GetProviderStats(f.Ctx, providerUUID)
+	require.NoError(t, err)
+	require.Equal(t, expected, migrated)
+}
@@ -252,41 +252,40 @@ func (q Querier) CreditAccount(ctx context.Context, req *types.QueryCreditAccoun
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
 
-	// Collect relevant denoms from the tenant's leases and reserved amounts.
+	// Collect balances for the denoms relevant to the tenant's leases and reservations.
 	// Uses per-denom GetBalance to avoid loading dust from unrelated token sends (DoS mitigation).
-	relevantDenoms, err := q.k.getRelevantDenomsForTenant(ctx, req.Tenant, ca.ReservedAmounts)
+	balances, err := q.k.getTenantCreditBalances(ctx, req.Tenant, ca.ReservedAmounts)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	var balances sdk.Coins
-	if len(relevantDenoms) > 0 {
-		// Primary path: per-denom queries using only lease/reservation denoms (DoS-safe).
-		balances, err = q.k.getCreditBalancesForDenoms(ctx, req.Tenant, relevantDenoms)
-		if err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
-		}
-	} else {
-		// Fallback for pre-lease accounts (funded but no active/pending leases or reservations).
-		// Uses GetAllBalances since we have no denom hints. This path is only reachable in the
-		// narrow window between FundCredit and lease creation; once a lease exists, the primary
-		// path above handles all queries. The node-side cost is O(n) where n is the number of
-		// denoms on the credit address, but exploiting this requires spending gas to send dust.
-		creditAddr, addrErr := types.DeriveCreditAddressFromBech32(req.Tenant)
-		if addrErr != nil {
-			return nil, status.Error(codes.Internal, addrErr.Error())
-		}
-		balances = q.k.bankKeeper.GetAllBalances(ctx, creditAddr)
-	}
-
 	// Calculate available balances (balance - reserved amounts)
 	// This is what can be used for new lease reservations
 	availableBalances := types.GetAvailableCredit(balances, ca.ReservedAmounts)
 
+	debt, err := q.k.GetTenantDebt(ctx, req.Tenant)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Promotional credit is reported on its own: it is spent before the
+	// balances but cannot be withdrawn or reserved.
+	grants, err := q.k.GetSpendablePromoGrants(ctx, req.Tenant)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	promoBalances := sdk.NewCoins()
+	for _, grant := range grants {
+		promoBalances = promoBalances.Add(grant.Remaining...)
+	}
+
 	return &types.QueryCreditAccountResponse{
 		CreditAccount:     ca,
 		Balances:          balances,
 		AvailableBalances: availableBalances,
+		Debt:              debt,
+		PromoBalances:     promoBalances,
+		PromoGrants:       grants,
 	}, nil
 }
 
@@ -478,8 +477,11 @@ func (q Querier) CreditEstimate(ctx context.Context, req *types.QueryCreditEstim
 	// Calculate total rate per second across all active leases.
 	// Also collect relevant denoms for per-denom balance queries (DoS mitigation).
 	// Limited to MaxCreditEstimateLeases to prevent DoS on tenants with many leases.
-	totalRatePerSecond := sdk.NewCoins()
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	totalPerSecond := sdk.NewDecCoins()
+	totalPerMonth := sdk.NewDecCoins()
 	var activeLeaseCount uint64
+	var activeLeases []types.Lease
 	denomSet := make(map[string]struct{})
 	denoms := make([]string, 0, 4)
 
@@ -508,27 +510,41 @@ func (q Querier) CreditEstimate(ctx context.Context, req *types.QueryCreditEstim
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
-
-		// Sum up rates for all items in this lease
-		for _, item := range lease.Items {
-			// Rate per second = locked_price * quantity
-			// locked_price is already in per-second terms
-			itemRate := sdk.NewCoin(item.LockedPrice.Denom, item.LockedPrice.Amount.Mul(sdkmath.NewIntFromUint64(item.Quantity)))
-			totalRatePerSecond = totalRatePerSecond.Add(itemRate)
-			if _, ok := denomSet[item.LockedPrice.Denom]; !ok {
-				denomSet[item.LockedPrice.Denom] = struct{}{}
-				denoms = append(denoms, item.LockedPrice.Denom)
+		activeLeases = append(activeLeases, lease)
+
+		// Sum up rates for all items in this lease. Metered items are charged
+		// per reported usage and do not drain credit over time.
+		perSecond, perMonth := leaseItemSchedule(lease.Items)
+		totalPerSecond = totalPerSecond.Add(perSecond...)
+		totalPerMonth = totalPerMonth.Add(perMonth...)
+		for _, rate := range perSecond.Add(perMonth...) {
+			if _, ok := denomSet[rate.Denom]; !ok {
+				denomSet[rate.Denom] = struct{}{}
+				denoms = append(denoms, rate.Denom)
 			}
 		}
 	}
 
+	// Calendar-month items count at the rate of the current month.
+	monthSeconds := sdkmath.LegacyNewDec(secondsInMonth(blockTime))
+	totalRatePerSecond := totalPerSecond
+	for _, perMonth := range totalPerMonth {
+		totalRatePerSecond = totalRatePerSecond.Add(sdk.NewDecCoinFromDec(perMonth.Denom, perMonth.Amount.Quo(monthSeconds)))
+	}
+
 	// Fetch balances for only the denoms used by active leases (DoS mitigation).
 	currentBalance, err := q.k.getCreditBalancesForDenoms(ctx, req.Tenant, denoms)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	// Calculate estimated duration
+	grants, err := q.k.GetSpendablePromoGrants(ctx, req.Tenant)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	promoBalance := promoBalanceForLeases(grants, activeLeases)
+
+	// Calculate estimated duration, promotional credit included
 	// Find minimum duration across all denoms: min(balance[denom] / rate[denom])
 	var estimatedDurationSeconds uint64
 	if activeLeaseCount > 0 && !totalRatePerSecond.IsZero() {
@@ -539,14 +555,16 @@ func (q Querier) CreditEstimate(ctx context.Context, req *types.QueryCreditEstim
 			if rateCoin.Amount.IsZero() {
 				continue
 			}
-			balanceAmount := currentBalance.AmountOf(rateCoin.Denom)
+			balanceAmount := currentBalance.AmountOf(rateCoin.Denom).Add(promoBalance.AmountOf(rateCoin.Denom))
 			if balanceAmount.IsZero() {
 				// No balance for this denom means immediate exhaustion
 				estimatedDurationSeconds = 0
 				break
 			}
-			// Duration = balance / rate (integer division, rounds down)
-			quotient := balanceAmount.Quo(rateCoin.Amount)
+			// Duration = balance / rate, rounded down to whole seconds. The
+			// rate of calendar-month items changes with each month's length.
+			exact, _ := accrualSeconds(sdkmath.LegacyNewDecFromInt(balanceAmount), totalPerSecond.AmountOf(rateCoin.Denom), totalPerMonth.AmountOf(rateCoin.Denom), blockTime)
+			quotient := exact.TruncateInt()
 			var duration uint64
 			if quotient.IsUint64() {
 				duration = quotient.Uint64()
@@ -562,11 +580,19 @@ func (q Querier) CreditEstimate(ctx context.Context, req *types.QueryCreditEstim
 		}
 	}
 
+	// The deprecated integer rate rounds each denom up, as locked_price does.
+	roundedRatePerSecond := sdk.NewCoins()
+	for _, rate := range totalRatePerSecond {
+		roundedRatePerSecond = roundedRatePerSecond.Add(sdk.NewCoin(rate.Denom, rate.Amount.Ceil().TruncateInt()))
+	}
+
 	return &types.QueryCreditEstimateResponse{
 		CurrentBalance:           currentBalance,
-		TotalRatePerSecond:       totalRatePerSecond,
+		TotalRatePerSecond:       roundedRatePerSecond,
 		EstimatedDurationSeconds: estimatedDurationSeconds,
 		ActiveLeaseCount:         activeLeaseCount,
+		PromoBalance:             promoBalance,
+		TotalRatePerSecondDec:    totalRatePerSecond,
 	}, nil
 }
 
@@ -690,3 +716,378 @@ func (q Querier) LeaseByCustomDomain(ctx context.Context, req *types.QueryLeaseB
 	}
 	return &types.QueryLeaseByCustomDomainResponse{Lease: lease, ServiceName: serviceName}, nil
 }
+
+// AvailableCredit returns the credit a tenant can withdraw via MsgWithdrawCredit.
+func (q Querier) AvailableCredit(ctx context.Context, req *types.QueryAvailableCreditRequest) (*types.QueryAvailableCreditResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Tenant == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Tenant); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid tenant address")
+	}
+
+	ca, err := q.k.GetCreditAccount(ctx, req.Tenant)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	balances, err := q.k.getTenantCreditBalances(ctx, req.Tenant, ca.ReservedAmounts)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	unsettled, err := q.k.GetUnsettledAccruals(ctx, req.Tenant)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	locked, unlockAt, err := q.k.GetLockedCredit(ctx, req.Tenant, balances.Denoms())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &types.QueryAvailableCreditResponse{
+		Balances:         balances,
+		ReservedAmounts:  ca.ReservedAmounts,
+		UnsettledAmounts: unsettled,
+		Available:        GetWithdrawableCredit(balances, ca.ReservedAmounts, unsettled, locked),
+		LockedAmounts:    locked,
+	}
+
+	// Only report the unlock time while some credit is still locked.
+	if !locked.IsZero() {
+		resp.WithdrawableAfter = &unlockAt
+	}
+
+	return resp, nil
+}
+
+// LeaseAmendment returns the pending amendment of a lease.
+func (q Querier) LeaseAmendment(ctx context.Context, req *types.QueryLeaseAmendmentRequest) (*types.QueryLeaseAmendmentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.LeaseUuid == "" {
+		return nil, status.Error(codes.InvalidArgument, "lease_uuid cannot be empty")
+	}
+
+	amendment, err := q.k.GetLeaseAmendment(ctx, req.LeaseUuid)
+	if err != nil {
+		if errors.Is(err, types.ErrLeaseAmendmentNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLeaseAmendmentResponse{Amendment: amendment}, nil
+}
+
+// LeaseAmendments returns all pending lease amendments with pagination,
+// optionally filtered by provider.
+func (q Querier) LeaseAmendments(ctx context.Context, req *types.QueryLeaseAmendmentsRequest) (*types.QueryLeaseAmendmentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	amendments, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.LeaseAmendments,
+		req.Pagination,
+		func(_ string, amendment types.LeaseAmendment) (bool, error) {
+			return req.ProviderUuid == "" || amendment.ProviderUuid == req.ProviderUuid, nil
+		},
+		func(_ string, amendment types.LeaseAmendment) (types.LeaseAmendment, error) {
+			return amendment, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLeaseAmendmentsResponse{
+		Amendments: amendments,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ProviderPolicy returns the lease policy of a provider and the pending
+// timeout and grace period that apply to its leases.
+func (q Querier) ProviderPolicy(ctx context.Context, req *types.QueryProviderPolicyRequest) (*types.QueryProviderPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ProviderUuid == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider_uuid cannot be empty")
+	}
+
+	policy, found, err := q.k.GetProviderPolicy(ctx, req.ProviderUuid)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	pendingTimeout, err := q.k.GetEffectivePendingTimeout(ctx, req.ProviderUuid)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	gracePeriod, _, err := q.k.GetEffectiveGracePeriod(ctx, req.ProviderUuid)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProviderPolicyResponse{
+		Policy:                  policy,
+		Found:                   found,
+		EffectivePendingTimeout: pendingTimeout,
+		EffectiveGracePeriod:    gracePeriod,
+	}, nil
+}
+
+// ProviderPolicies returns all provider lease policies with pagination.
+func (q Querier) ProviderPolicies(ctx context.Context, req *types.QueryProviderPoliciesRequest) (*types.QueryProviderPoliciesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	policies, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.ProviderPolicies,
+		req.Pagination,
+		func(_ string, policy types.ProviderPolicy) (types.ProviderPolicy, error) {
+			return policy, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProviderPoliciesResponse{
+		Policies:   policies,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ProviderStats returns the lease counters of a provider together with the
+// averages and rates derived from them.
+func (q Querier) ProviderStats(ctx context.Context, req *types.QueryProviderStatsRequest) (*types.QueryProviderStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ProviderUuid == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider_uuid cannot be empty")
+	}
+
+	stats, err := q.k.GetProviderStats(ctx, req.ProviderUuid)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &types.QueryProviderStatsResponse{
+		Stats:                  stats,
+		AckLatencyBucketBounds: types.AckLatencyBucketBounds(),
+		AcknowledgementRate:    sdkmath.LegacyZeroDec(),
+		RejectionRate:          sdkmath.LegacyZeroDec(),
+		ExpiryRate:             sdkmath.LegacyZeroDec(),
+	}
+	if timed := stats.LeasesAcknowledged - stats.LeasesAcknowledgedAtCreation; timed > 0 {
+		resp.AverageAckLatency = stats.AckLatencySum / timed
+	}
+	if stats.LeasesClosed > 0 {
+		resp.AverageLeaseLifetime = stats.LifetimeSum / stats.LeasesClosed
+	}
+
+	decided := sdkmath.NewIntFromUint64(stats.LeasesAcknowledged).
+		Add(sdkmath.NewIntFromUint64(stats.LeasesRejected)).
+		Add(sdkmath.NewIntFromUint64(stats.LeasesExpired))
+	if decided.IsPositive() {
+		rate := func(n uint64) sdkmath.LegacyDec {
+			return sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(n)).QuoInt(decided)
+		}
+		resp.AcknowledgementRate = rate(stats.LeasesAcknowledged)
+		resp.RejectionRate = rate(stats.LeasesRejected)
+		resp.ExpiryRate = rate(stats.LeasesExpired)
+	}
+
+	return resp, nil
+}
+
+// LeaseSettlements returns the settlement records of a lease, oldest first, with
+// pagination. A pruned or unknown lease has none.
+func (q Querier) LeaseSettlements(ctx context.Context, req *types.QueryLeaseSettlementsRequest) (*types.QueryLeaseSettlementsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.LeaseUuid == "" {
+		return nil, status.Error(codes.InvalidArgument, "lease_uuid cannot be empty")
+	}
+
+	settlements, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.LeaseSettlements,
+		req.Pagination,
+		func(_ collections.Pair[string, uint64], settlement types.LeaseSettlement) (types.LeaseSettlement, error) {
+			return settlement, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.LeaseUuid),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLeaseSettlementsResponse{
+		Settlements: settlements,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// Dispute returns the dispute raised on a lease.
+func (q Querier) Dispute(ctx context.Context, req *types.QueryDisputeRequest) (*types.QueryDisputeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.LeaseUuid == "" {
+		return nil, status.Error(codes.InvalidArgument, "lease_uuid cannot be empty")
+	}
+
+	dispute, err := q.k.GetDispute(ctx, req.LeaseUuid)
+	if err != nil {
+		if errors.Is(err, types.ErrDisputeNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDisputeResponse{Dispute: dispute}, nil
+}
+
+// Disputes returns all disputes with pagination, optionally filtered by
+// provider and state.
+func (q Querier) Disputes(ctx context.Context, req *types.QueryDisputesRequest) (*types.QueryDisputesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	disputes, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.Disputes,
+		req.Pagination,
+		func(_ string, dispute types.Dispute) (bool, error) {
+			if req.ProviderUuid != "" && dispute.ProviderUuid != req.ProviderUuid {
+				return false, nil
+			}
+			return req.State == types.DISPUTE_STATE_UNSPECIFIED || dispute.State == req.State, nil
+		},
+		func(_ string, dispute types.Dispute) (types.Dispute, error) {
+			return dispute, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDisputesResponse{
+		Disputes:   disputes,
+		Pagination: pageRes,
+	}, nil
+}
+
+// LeaseUsage returns the usage counters and spend limit of a metered lease.
+func (q Querier) LeaseUsage(ctx context.Context, req *types.QueryLeaseUsageRequest) (*types.QueryLeaseUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.LeaseUuid == "" {
+		return nil, status.Error(codes.InvalidArgument, "lease_uuid cannot be empty")
+	}
+
+	lease, err := q.k.GetLease(ctx, req.LeaseUuid)
+	if err != nil {
+		if errors.Is(err, types.ErrLeaseNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !types.HasMeteredItems(lease.Items) {
+		return nil, status.Errorf(codes.NotFound, "lease %s has no metered items", req.LeaseUuid)
+	}
+
+	usage, err := q.k.GetLeaseUsage(ctx, req.LeaseUuid)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLeaseUsageResponse{Usage: usage}, nil
+}
+
+// ExchangeRates returns the exchange-rate table with pagination, together with
+// the reference denom the rates are quoted in.
+func (q Querier) ExchangeRates(ctx context.Context, req *types.QueryExchangeRatesRequest) (*types.QueryExchangeRatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := q.k.GetParams(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	rates, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.ExchangeRates,
+		req.Pagination,
+		func(_ string, rate types.ExchangeRate) (types.ExchangeRate, error) {
+			return rate, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryExchangeRatesResponse{
+		Rates:          rates,
+		ReferenceDenom: params.ReferenceDenom,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// SKUAvailability returns a SKU's capacity together with the quantity PENDING
+// and ACTIVE leases hold and the quantity left for new leases.
+func (q Querier) SKUAvailability(ctx context.Context, req *types.QuerySKUAvailabilityRequest) (*types.QuerySKUAvailabilityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.SkuUuid == "" {
+		return nil, status.Error(codes.InvalidArgument, "sku_uuid cannot be empty")
+	}
+
+	sku, err := q.k.skuKeeper.GetSKU(ctx, req.SkuUuid)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "sku %s not found", req.SkuUuid)
+	}
+
+	allocated, err := q.k.GetSKUAllocation(ctx, req.SkuUuid)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var available uint64
+	if sku.Capacity > allocated {
+		available = sku.Capacity - allocated
+	}
+
+	return &types.QuerySKUAvailabilityResponse{
+		Capacity:  sku.Capacity,
+		Allocated: allocated,
+		Available: available,
+	}, nil
+}
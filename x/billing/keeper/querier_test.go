@@ -1443,7 +1443,7 @@ func TestQueryCreditEstimate(t *testing.T) {
 		require.NotNil(t, resp)
 		require.Equal(t, uint64(0), resp.ActiveLeaseCount)
 		require.Equal(t, uint64(0), resp.EstimatedDurationSeconds)
-		require.True(t, resp.TotalRatePerSecond.IsZero())
+		require.True(t, resp.TotalRatePerSecondDec.IsZero())
 		// With no active leases, only denoms from active leases are fetched (DoS mitigation)
 		require.True(t, resp.CurrentBalance.IsZero())
 	})
@@ -1482,7 +1482,8 @@ func TestQueryCreditEstimate(t *testing.T) {
 		require.Equal(t, uint64(1), resp.ActiveLeaseCount)
 
 		// Rate should be 200 per second (100 * 2 quantity)
-		require.Equal(t, sdkmath.NewInt(200), resp.TotalRatePerSecond.AmountOf(testDenom))
+		require.Equal(t, sdkmath.LegacyNewDec(200), resp.TotalRatePerSecondDec.AmountOf(testDenom))
+		require.Equal(t, sdkmath.NewInt(200), resp.TotalRatePerSecond.AmountOf(testDenom)) //nolint:staticcheck // the deprecated field is still filled
 
 		// With 1,000,000 balance and 200/second rate, should last 5000 seconds
 		require.Equal(t, uint64(5000), resp.EstimatedDurationSeconds)
@@ -236,12 +236,12 @@ func TestSecurity_AccrualOverflowProtection(t *testing.T) {
 	veryFarFuture := now.Add(101 * 365 * 24 * time.Hour)
 
 	// PerformSettlement should error on overflow
-	_, err = k.PerformSettlement(f.Ctx, &lease, veryFarFuture)
+	_, err = k.PerformSettlement(f.Ctx, &lease, veryFarFuture, types.SETTLEMENT_TRIGGER_WITHDRAW)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "overflow")
 
 	// PerformSettlementSilent should NOT error (silently handles overflow)
-	result, err := k.PerformSettlementSilent(f.Ctx, &lease, veryFarFuture)
+	result, err := k.PerformSettlementSilent(f.Ctx, &lease, veryFarFuture, types.SETTLEMENT_TRIGGER_WITHDRAW)
 	require.NoError(t, err)
 	// On overflow, all remaining credit should be transferred to the provider
 	require.Equal(t, int64(1_000_000_000_000), result.AccruedAmounts.AmountOf(testDenom).Int64())
@@ -843,7 +843,7 @@ func TestSecurity_DenomSpamDoesNotLeakOnOverflow(t *testing.T) {
 	// PerformSettlementSilent handles overflow by transferring all remaining
 	// credit rather than returning an error.
 	overflowTime := f.Ctx.BlockTime().Add(101 * 365 * 24 * time.Hour)
-	result, err := f.App.BillingKeeper.PerformSettlementSilent(f.Ctx, &lease, overflowTime)
+	result, err := f.App.BillingKeeper.PerformSettlementSilent(f.Ctx, &lease, overflowTime, types.SETTLEMENT_TRIGGER_WITHDRAW)
 	require.NoError(t, err)
 	require.NotNil(t, result)
 
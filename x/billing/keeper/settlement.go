@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/manifest-network/manifest-ledger/x/billing/types"
@@ -11,7 +13,8 @@ import (
 
 // SettlementResult contains the results of a settlement operation.
 type SettlementResult struct {
-	// TransferAmounts is the actual amount transferred to the provider.
+	// TransferAmounts is the actual amount transferred to the provider,
+	// promotional credit included.
 	TransferAmounts sdk.Coins
 	// AccruedAmounts is the total amount accrued (may be higher than transferred if credit is insufficient).
 	AccruedAmounts sdk.Coins
@@ -33,18 +36,35 @@ func leaseItemDenoms(items []types.LeaseItem) []string {
 }
 
 // LeaseItemsToWithPrice converts lease items to LeaseItemWithPrice for accrual calculations.
+// Metered items are left out: they are charged as usage is reported, not over time.
 func LeaseItemsToWithPrice(items []types.LeaseItem) []LeaseItemWithPrice {
 	result := make([]LeaseItemWithPrice, 0, len(items))
 	for _, item := range items {
+		if item.Metered {
+			continue
+		}
 		result = append(result, LeaseItemWithPrice{
 			SkuUUID:              item.SkuUuid,
 			Quantity:             item.Quantity,
-			LockedPricePerSecond: item.LockedPrice,
+			LockedPricePerSecond: sdk.NewDecCoinFromDec(item.LockedPrice.Denom, item.RatePerSecond()),
+			CalendarMonth:        item.CalendarMonth,
+			PricePerMonth:        item.MonthlyPrice,
 		})
 	}
 	return result
 }
 
+// recordGraceDebt adds the shortfall between accrued and paid, both in the
+// accrued denoms, to the debt of a lease in its grace period.
+func recordGraceDebt(lease *types.Lease, accrued, paid sdk.Coins) {
+	if lease.GraceEndsAt == nil {
+		return
+	}
+	if shortfall := accrued.Sub(paid...); !shortfall.IsZero() {
+		lease.Debt = lease.Debt.Add(shortfall...)
+	}
+}
+
 // CalculateTransferAmounts returns the minimum of accrued and available for each denom.
 // This ensures we never try to transfer more than what's available in the credit account.
 func CalculateTransferAmounts(accrued, available sdk.Coins) sdk.Coins {
@@ -69,28 +89,38 @@ func CalculateTransferAmounts(accrued, available sdk.Coins) sdk.Coins {
 //   - ctx: the context
 //   - lease: the lease to settle (LastSettledAt will NOT be modified - caller must handle this)
 //   - settleTime: the time to settle up to
+//   - trigger: what caused the settlement, stored on its LeaseSettlement record
 //
 // Returns:
 //   - SettlementResult containing transfer amounts and balances
 //   - error if settlement fails (including accrual calculation errors)
 //
 // Note: This function does NOT update the lease's LastSettledAt - the caller is responsible
-// for updating the lease state after a successful settlement.
-func (k *Keeper) PerformSettlement(ctx context.Context, lease *types.Lease, settleTime time.Time) (*SettlementResult, error) {
-	return k.performSettlementCore(ctx, lease, settleTime, false)
+// for updating the lease state after a successful settlement. Whenever anything
+// accrued, a LeaseSettlement is stored and added to lease.TotalSettled, which
+// the caller persists with the rest of the lease.
+func (k *Keeper) PerformSettlement(ctx context.Context, lease *types.Lease, settleTime time.Time, trigger types.SettlementTrigger) (*SettlementResult, error) {
+	return k.performSettlementCore(ctx, lease, settleTime, trigger, false)
 }
 
 // PerformSettlementSilent is like PerformSettlement but returns empty amounts on overflow
 // instead of an error. This is useful for close operations where we want to proceed
 // even if accrual calculation fails due to overflow.
-func (k *Keeper) PerformSettlementSilent(ctx context.Context, lease *types.Lease, settleTime time.Time) (*SettlementResult, error) {
-	return k.performSettlementCore(ctx, lease, settleTime, true)
+func (k *Keeper) PerformSettlementSilent(ctx context.Context, lease *types.Lease, settleTime time.Time, trigger types.SettlementTrigger) (*SettlementResult, error) {
+	return k.performSettlementCore(ctx, lease, settleTime, trigger, true)
 }
 
 // performSettlementCore contains the shared settlement logic.
 // If silentOnOverflow is true, accrual calculation errors result in empty amounts
 // rather than returning an error.
-func (k *Keeper) performSettlementCore(ctx context.Context, lease *types.Lease, settleTime time.Time, silentOnOverflow bool) (*SettlementResult, error) {
+//
+// Accruals are paid out of the tenant's promotional credit grants first, then
+// out of the credit account in the accrued denom, and then out of the credit
+// account's other denoms at the current exchange rates. For a lease in its
+// grace period the part none of them can cover is added to lease.Debt;
+// outside grace it is forgiven. Like LastSettledAt, the debt and the
+// fractional accrual carry are only persisted by the caller's SetLease.
+func (k *Keeper) performSettlementCore(ctx context.Context, lease *types.Lease, settleTime time.Time, trigger types.SettlementTrigger, silentOnOverflow bool) (*SettlementResult, error) {
 	// Calculate duration since last settlement
 	duration := settleTime.Sub(lease.LastSettledAt)
 	if duration <= 0 {
@@ -101,9 +131,10 @@ func (k *Keeper) performSettlementCore(ctx context.Context, lease *types.Lease,
 		}, nil
 	}
 
-	// Calculate accrued amounts
-	items := LeaseItemsToWithPrice(lease.Items)
-	accruedAmounts, err := CalculateTotalAccruedForLease(items, duration)
+	// Calculate accrued amounts. The sub-unit remainder is carried on the
+	// lease into the next settlement rather than dropped.
+	accruedAmounts, carry, err := CalculateLeaseAccrual(*lease, settleTime)
+	lease.AccrualCarry = carry
 	overflowed := false
 	if err != nil {
 		if silentOnOverflow {
@@ -146,8 +177,42 @@ func (k *Keeper) performSettlementCore(ctx context.Context, lease *types.Lease,
 		}, nil
 	}
 
-	// Calculate transfer amounts (min of accrued and available)
-	transferAmounts := CalculateTransferAmounts(accruedAmounts, creditBalances)
+	// Promotional credit pays first; the credit account covers the rest, as
+	// far as its balance reaches.
+	promoAmounts := sdk.NewCoins()
+	if !overflowed {
+		promoAmounts, err = k.spendPromoCredit(ctx, *lease, settleTime)
+		if err != nil {
+			return nil, err
+		}
+	}
+	paidAmounts := CalculateTransferAmounts(accruedAmounts.Sub(promoAmounts...), creditBalances)
+	convertedAmounts, convertedValue := sdk.NewCoins(), sdk.NewCoins()
+	if owed := accruedAmounts.Sub(promoAmounts...).Sub(paidAmounts...); !owed.IsZero() && !overflowed {
+		convertedAmounts, convertedValue, err = k.payByConversion(ctx, lease.Tenant, owed, paidAmounts)
+		if err != nil {
+			return nil, err
+		}
+	}
+	transferAmounts := promoAmounts.Add(paidAmounts...).Add(convertedAmounts...)
+	settledValue := promoAmounts.Add(paidAmounts...).Add(convertedValue...)
+
+	recordGraceDebt(lease, accruedAmounts, settledValue)
+
+	err = k.recordSettlement(ctx, lease, types.LeaseSettlement{
+		Trigger:        trigger,
+		PeriodStart:    lease.LastSettledAt,
+		PeriodEnd:      settleTime,
+		Accrued:        accruedAmounts,
+		Transferred:    transferAmounts,
+		Shortfall:      accruedAmounts.Sub(settledValue...),
+		Promo:          promoAmounts,
+		Converted:      convertedAmounts,
+		ConvertedValue: convertedValue,
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	// If nothing to transfer, return early
 	if transferAmounts.IsZero() {
@@ -158,13 +223,82 @@ func (k *Keeper) performSettlementCore(ctx context.Context, lease *types.Lease,
 		}, nil
 	}
 
-	// Get credit address for the transfer
+	if _, err := k.transferToProvider(ctx, *lease, transferAmounts); err != nil {
+		return nil, err
+	}
+
+	balanceAfter := creditBalances.Sub(paidAmounts...)
+	for _, coin := range convertedAmounts {
+		if balanceAfter.AmountOf(coin.Denom).IsPositive() {
+			balanceAfter = balanceAfter.Sub(coin)
+		}
+	}
+
+	return &SettlementResult{
+		TransferAmounts:    transferAmounts,
+		AccruedAmounts:     accruedAmounts,
+		CreditBalanceAfter: balanceAfter,
+	}, nil
+}
+
+// payByConversion pays owed, what the tenant's credit could not cover in the
+// accrued denoms, out of its credit in other convertible denoms. alreadyPaid
+// is what the settlement already takes from the credit account. It returns
+// the coins to transfer and the part of owed they cover; both are empty when
+// conversion is disabled.
+func (k *Keeper) payByConversion(ctx context.Context, tenant string, owed, alreadyPaid sdk.Coins) (sdk.Coins, sdk.Coins, error) {
+	rates, err := k.getExchangeRates(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(rates) < 2 {
+		return sdk.NewCoins(), sdk.NewCoins(), nil
+	}
+
+	balances, err := k.getConvertibleCreditBalances(ctx, tenant, rates)
+	if err != nil {
+		return nil, nil, err
+	}
+	spare, _ := balances.SafeSub(alreadyPaid...)
+	paid, covered := rates.payByConversion(owed, spare)
+	return paid, covered, nil
+}
+
+// recordSettlement stores a settlement of the lease, filling in its id, lease
+// UUID and settled_at, and adds its transferred amount to lease.TotalSettled.
+// The caller persists the lease.
+func (k *Keeper) recordSettlement(ctx context.Context, lease *types.Lease, settlement types.LeaseSettlement) error {
+	id, err := k.SettlementSequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+	settlement.Id = id
+	settlement.LeaseUuid = lease.Uuid
+	settlement.SettledAt = sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	if err := k.LeaseSettlements.Set(ctx, collections.Join(lease.Uuid, id), settlement); err != nil {
+		return err
+	}
+	lease.TotalSettled = lease.TotalSettled.Add(settlement.Transferred...)
+	return nil
+}
+
+// transferToProvider moves amounts from the tenant's credit account to the
+// provider's payout address and reports the transfer to the billing hooks.
+// Amounts withdrawn from a disputed lease are escrowed instead. Returns the
+// address the amounts were sent to.
+func (k *Keeper) transferToProvider(ctx context.Context, lease types.Lease, amounts sdk.Coins) (sdk.AccAddress, error) {
 	creditAddr, err := types.DeriveCreditAddressFromBech32(lease.Tenant)
 	if err != nil {
 		return nil, err
 	}
+	if lease.Disputed {
+		if err := k.escrowDisputedAmounts(ctx, lease, creditAddr, amounts); err != nil {
+			return nil, err
+		}
+		return disputeEscrowAddress(), nil
+	}
 
-	// Get provider payout address
 	provider, err := k.skuKeeper.GetProvider(ctx, lease.ProviderUuid)
 	if err != nil {
 		return nil, types.ErrProviderNotFound.Wrapf("provider_uuid %s not found", lease.ProviderUuid)
@@ -175,14 +309,12 @@ func (k *Keeper) performSettlementCore(ctx context.Context, lease *types.Lease,
 		return nil, types.ErrProviderNotFound.Wrapf("invalid payout address: %s", err)
 	}
 
-	// Transfer funds
-	if err := k.bankKeeper.SendCoins(ctx, creditAddr, payoutAddr, transferAmounts); err != nil {
+	if err := k.bankKeeper.SendCoins(ctx, creditAddr, payoutAddr, amounts); err != nil {
 		return nil, types.ErrInvalidCreditOperation.Wrapf("failed to transfer: %s", err)
 	}
 
-	return &SettlementResult{
-		TransferAmounts:    transferAmounts,
-		AccruedAmounts:     accruedAmounts,
-		CreditBalanceAfter: creditBalances.Sub(transferAmounts...),
-	}, nil
+	if err := k.Hooks().AfterLeaseSettled(ctx, lease, amounts); err != nil {
+		return nil, err
+	}
+	return payoutAddr, nil
 }
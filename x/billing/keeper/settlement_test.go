@@ -44,7 +44,7 @@ func TestLeaseItemsToWithPrice(t *testing.T) {
 				{SkuUuid: "sku-1", Quantity: 2, LockedPrice: sdk.NewCoin("upwr", sdkmath.NewInt(100))},
 			},
 			expected: []keeper.LeaseItemWithPrice{
-				{SkuUUID: "sku-1", Quantity: 2, LockedPricePerSecond: sdk.NewCoin("upwr", sdkmath.NewInt(100))},
+				{SkuUUID: "sku-1", Quantity: 2, LockedPricePerSecond: sdk.NewDecCoin("upwr", sdkmath.NewInt(100))},
 			},
 		},
 		{
@@ -55,9 +55,9 @@ func TestLeaseItemsToWithPrice(t *testing.T) {
 				{SkuUuid: "sku-3", Quantity: 1, LockedPrice: sdk.NewCoin("upwr", sdkmath.NewInt(50))},
 			},
 			expected: []keeper.LeaseItemWithPrice{
-				{SkuUUID: "sku-1", Quantity: 2, LockedPricePerSecond: sdk.NewCoin("upwr", sdkmath.NewInt(100))},
-				{SkuUUID: "sku-2", Quantity: 5, LockedPricePerSecond: sdk.NewCoin("umfx", sdkmath.NewInt(200))},
-				{SkuUUID: "sku-3", Quantity: 1, LockedPricePerSecond: sdk.NewCoin("upwr", sdkmath.NewInt(50))},
+				{SkuUUID: "sku-1", Quantity: 2, LockedPricePerSecond: sdk.NewDecCoin("upwr", sdkmath.NewInt(100))},
+				{SkuUUID: "sku-2", Quantity: 5, LockedPricePerSecond: sdk.NewDecCoin("umfx", sdkmath.NewInt(200))},
+				{SkuUUID: "sku-3", Quantity: 1, LockedPricePerSecond: sdk.NewDecCoin("upwr", sdkmath.NewInt(50))},
 			},
 		},
 		{
@@ -66,7 +66,7 @@ func TestLeaseItemsToWithPrice(t *testing.T) {
 				{SkuUuid: "sku-1", Quantity: 0, LockedPrice: sdk.NewCoin("upwr", sdkmath.NewInt(100))},
 			},
 			expected: []keeper.LeaseItemWithPrice{
-				{SkuUUID: "sku-1", Quantity: 0, LockedPricePerSecond: sdk.NewCoin("upwr", sdkmath.NewInt(100))},
+				{SkuUUID: "sku-1", Quantity: 0, LockedPricePerSecond: sdk.NewDecCoin("upwr", sdkmath.NewInt(100))},
 			},
 		},
 	}
@@ -79,7 +79,7 @@ func TestLeaseItemsToWithPrice(t *testing.T) {
 			for i, item := range result {
 				require.Equal(t, tc.expected[i].SkuUUID, item.SkuUUID)
 				require.Equal(t, tc.expected[i].Quantity, item.Quantity)
-				require.True(t, tc.expected[i].LockedPricePerSecond.Equal(item.LockedPricePerSecond),
+				require.True(t, tc.expected[i].LockedPricePerSecond.IsEqual(item.LockedPricePerSecond),
 					"item %d: expected %s, got %s", i, tc.expected[i].LockedPricePerSecond, item.LockedPricePerSecond)
 			}
 		})
@@ -270,7 +270,7 @@ func TestPerformSettlement(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			result, err := k.PerformSettlement(f.Ctx, &lease, tc.settleTime)
+			result, err := k.PerformSettlement(f.Ctx, &lease, tc.settleTime, types.SETTLEMENT_TRIGGER_WITHDRAW)
 
 			if tc.expectErr {
 				require.Error(t, err)
@@ -323,7 +323,7 @@ func TestPerformSettlement_InsufficientCredit(t *testing.T) {
 	require.NoError(t, err)
 
 	// Try to settle for 100 seconds (100 tokens accrued, only 50 available)
-	result, err := k.PerformSettlement(f.Ctx, &lease, now.Add(100*time.Second))
+	result, err := k.PerformSettlement(f.Ctx, &lease, now.Add(100*time.Second), types.SETTLEMENT_TRIGGER_WITHDRAW)
 	require.NoError(t, err)
 
 	// Should accrue 100 but transfer only 50
@@ -358,7 +358,7 @@ func TestPerformSettlement_ProviderNotFound(t *testing.T) {
 	}
 
 	// Settlement should fail due to missing provider
-	_, err = k.PerformSettlement(f.Ctx, &lease, now.Add(100*time.Second))
+	_, err = k.PerformSettlement(f.Ctx, &lease, now.Add(100*time.Second), types.SETTLEMENT_TRIGGER_WITHDRAW)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "not found")
 }
@@ -402,7 +402,7 @@ func TestPerformSettlementSilent_OverflowHandling(t *testing.T) {
 	veryFarFuture := now.Add(101 * 365 * 24 * time.Hour)
 
 	// PerformSettlementSilent should NOT error on overflow
-	result, err := k.PerformSettlementSilent(f.Ctx, &lease, veryFarFuture)
+	result, err := k.PerformSettlementSilent(f.Ctx, &lease, veryFarFuture, types.SETTLEMENT_TRIGGER_WITHDRAW)
 	require.NoError(t, err)
 	require.NotNil(t, result)
 
@@ -445,7 +445,7 @@ func TestPerformSettlementSilent_NormalOperation(t *testing.T) {
 	require.NoError(t, err)
 
 	// Normal settlement should work the same as PerformSettlement
-	result, err := k.PerformSettlementSilent(f.Ctx, &lease, now.Add(100*time.Second))
+	result, err := k.PerformSettlementSilent(f.Ctx, &lease, now.Add(100*time.Second), types.SETTLEMENT_TRIGGER_WITHDRAW)
 	require.NoError(t, err)
 	require.Equal(t, int64(100), result.AccruedAmounts.AmountOf(testDenom).Int64())
 	require.Equal(t, int64(100), result.TransferAmounts.AmountOf(testDenom).Int64())
@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"cosmossdk.io/collections"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+)
+
+// allocatesCapacity reports whether a lease in state holds SKU capacity.
+func allocatesCapacity(state types.LeaseState) bool {
+	return state == types.LEASE_STATE_PENDING || state == types.LEASE_STATE_ACTIVE
+}
+
+// skuQuantities sums item quantities per SKU.
+func skuQuantities(items []types.LeaseItem) map[string]uint64 {
+	quantities := make(map[string]uint64, len(items))
+	for _, item := range items {
+		quantities[item.SkuUuid] += item.Quantity
+	}
+	return quantities
+}
+
+// sortedSKUs returns the keys of quantities in sorted order, so that store
+// writes and errors do not depend on map iteration order.
+func sortedSKUs(quantities ...map[string]uint64) []string {
+	seen := make(map[string]bool)
+	var skus []string
+	for _, q := range quantities {
+		for sku := range q {
+			if !seen[sku] {
+				seen[sku] = true
+				skus = append(skus, sku)
+			}
+		}
+	}
+	sort.Strings(skus)
+	return skus
+}
+
+// GetSKUAllocation returns the quantity of a SKU held by PENDING and ACTIVE
+// leases.
+func (k *Keeper) GetSKUAllocation(ctx context.Context, skuUUID string) (uint64, error) {
+	allocated, err := k.SKUAllocations.Get(ctx, skuUUID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return allocated, err
+}
+
+// reconcileSKUAllocations moves SKUAllocations from the previous snapshot of
+// a lease to the current one after a SetLease write. A lease holds its item
+// quantities while PENDING or ACTIVE and nothing otherwise, so closing,
+// rejecting, expiring or cancelling a lease releases its capacity, and
+// scaling or amending it moves the difference. Capacity is not checked here:
+// callers admitting new demand use requireSKUCapacity first.
+func (k *Keeper) reconcileSKUAllocations(ctx context.Context, prev types.Lease, hadPrev bool, lease types.Lease) error {
+	var before, after map[string]uint64
+	if hadPrev && allocatesCapacity(prev.State) {
+		before = skuQuantities(prev.Items)
+	}
+	if allocatesCapacity(lease.State) {
+		after = skuQuantities(lease.Items)
+	}
+
+	for _, skuUUID := range sortedSKUs(before, after) {
+		if before[skuUUID] == after[skuUUID] {
+			continue
+		}
+		allocated, err := k.GetSKUAllocation(ctx, skuUUID)
+		if err != nil {
+			return err
+		}
+		// Allocations are only ever built from the same snapshots, so the
+		// subtraction cannot underflow; clamp anyway rather than wrap.
+		if allocated >= before[skuUUID] {
+			allocated -= before[skuUUID]
+		} else {
+			allocated = 0
+		}
+		allocated += after[skuUUID]
+		if allocated == 0 {
+			if err := k.SKUAllocations.Remove(ctx, skuUUID); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := k.SKUAllocations.Set(ctx, skuUUID, allocated); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// requireSKUCapacity checks that replacing the items in from with the items in
+// to stays within the capacity of every SKU whose quantity grows. SKUs with a
+// zero capacity are unlimited. Only growth is checked, so leases already over
+// a lowered capacity can still shrink or keep their quantities. Returns
+// ErrSKUCapacityExceeded for the first SKU, in UUID order, that would go over.
+func (k *Keeper) requireSKUCapacity(ctx context.Context, from, to []types.LeaseItem) error {
+	before := skuQuantities(from)
+	after := skuQuantities(to)
+
+	for _, skuUUID := range sortedSKUs(after) {
+		if after[skuUUID] <= before[skuUUID] {
+			continue
+		}
+		growth := after[skuUUID] - before[skuUUID]
+
+		sku, err := k.skuKeeper.GetSKU(ctx, skuUUID)
+		if err != nil {
+			return types.ErrSKUNotFound.Wrapf("sku_uuid %s not found", skuUUID)
+		}
+		if sku.Capacity == 0 {
+			continue
+		}
+		allocated, err := k.GetSKUAllocation(ctx, skuUUID)
+		if err != nil {
+			return err
+		}
+		if allocated >= sku.Capacity || growth > sku.Capacity-allocated {
+			return types.ErrSKUCapacityExceeded.Wrapf(
+				"sku_uuid %s has %d of %d allocated; %d more requested",
+				skuUUID, allocated, sku.Capacity, growth,
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/keeper"
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+	skutypes "github.com/manifest-network/manifest-ledger/x/sku/types"
+)
+
+// setSKUCapacity sets the capacity of the setup's SKU.
+func (s *leaseScalingSetup) setSKUCapacity(t *testing.T, capacity uint64) {
+	t.Helper()
+	s.sku.Capacity = capacity
+	require.NoError(t, s.f.App.SKUKeeper.SetSKU(s.f.Ctx, s.sku))
+}
+
+func (s *leaseScalingSetup) availability(t *testing.T) *types.QuerySKUAvailabilityResponse {
+	t.Helper()
+	resp, err := keeper.NewQuerier(s.f.App.BillingKeeper).SKUAvailability(s.f.Ctx, &types.QuerySKUAvailabilityRequest{
+		SkuUuid: s.sku.Uuid,
+	})
+	require.NoError(t, err)
+	return resp
+}
+
+func TestSKUCapacity_CreateAndRelease(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	s.setSKUCapacity(t, 3)
+
+	// The acknowledged lease holds 2 of 3.
+	require.Equal(t, &types.QuerySKUAvailabilityResponse{Capacity: 3, Allocated: 2, Available: 1}, s.availability(t))
+
+	_, err := s.msgServer.CreateLease(s.f.Ctx, &types.MsgCreateLease{
+		Tenant: s.tenant.String(),
+		Items:  []types.LeaseItemInput{{SkuUuid: s.sku.Uuid, Quantity: 2}},
+	})
+	require.ErrorIs(t, err, types.ErrSKUCapacityExceeded)
+
+	// A PENDING lease holds capacity too.
+	pending, err := s.msgServer.CreateLease(s.f.Ctx, &types.MsgCreateLease{
+		Tenant: s.tenant.String(),
+		Items:  []types.LeaseItemInput{{SkuUuid: s.sku.Uuid, Quantity: 1}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), s.availability(t).Available)
+
+	_, err = s.msgServer.CancelLease(s.f.Ctx, &types.MsgCancelLease{
+		Tenant:     s.tenant.String(),
+		LeaseUuids: []string{pending.LeaseUuid},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), s.availability(t).Allocated)
+
+	_, err = s.msgServer.CloseLease(s.f.Ctx, &types.MsgCloseLease{
+		Sender:     s.tenant.String(),
+		LeaseUuids: []string{s.leaseUUID},
+	})
+	require.NoError(t, err)
+	require.Equal(t, &types.QuerySKUAvailabilityResponse{Capacity: 3, Allocated: 0, Available: 3}, s.availability(t))
+}
+
+func TestSKUCapacity_BatchCountsEveryLease(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	s.setSKUCapacity(t, 4)
+
+	_, err := s.msgServer.CreateLeases(s.f.Ctx, &types.MsgCreateLeases{
+		Tenant: s.tenant.String(),
+		Leases: []types.LeaseInput{
+			{Items: []types.LeaseItemInput{{SkuUuid: s.sku.Uuid, Quantity: 1}}},
+			{Items: []types.LeaseItemInput{{SkuUuid: s.sku.Uuid, Quantity: 2}}},
+		},
+	})
+	require.ErrorIs(t, err, types.ErrSKUCapacityExceeded)
+	require.Equal(t, uint64(2), s.availability(t).Allocated)
+}
+
+func TestSKUCapacity_RejectReleases(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	s.setSKUCapacity(t, 3)
+
+	pending, err := s.msgServer.CreateLease(s.f.Ctx, &types.MsgCreateLease{
+		Tenant: s.tenant.String(),
+		Items:  []types.LeaseItemInput{{SkuUuid: s.sku.Uuid, Quantity: 1}},
+	})
+	require.NoError(t, err)
+
+	_, err = s.msgServer.RejectLease(s.f.Ctx, &types.MsgRejectLease{
+		Sender:     s.providerAddr.String(),
+		LeaseUuids: []string{pending.LeaseUuid},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), s.availability(t).Available)
+}
+
+func TestSKUCapacity_LoweringKeepsLeases(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+	s.setSKUCapacity(t, 1)
+
+	lease, err := f.App.BillingKeeper.GetLease(f.Ctx, s.leaseUUID)
+	require.NoError(t, err)
+	require.Equal(t, types.LEASE_STATE_ACTIVE, lease.State)
+	require.Equal(t, &types.QuerySKUAvailabilityResponse{Capacity: 1, Allocated: 2, Available: 0}, s.availability(t))
+
+	// The over-allocated lease can shrink but not grow back.
+	_, err = s.msgServer.UpdateLeaseItems(f.Ctx, &types.MsgUpdateLeaseItems{
+		Tenant:    s.tenant.String(),
+		LeaseUuid: s.leaseUUID,
+		Items:     []types.LeaseItemInput{{SkuUuid: s.sku.Uuid, Quantity: 1}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), s.availability(t).Allocated)
+
+	_, err = s.msgServer.UpdateLeaseItems(f.Ctx, &types.MsgUpdateLeaseItems{
+		Tenant:    s.tenant.String(),
+		LeaseUuid: s.leaseUUID,
+		Items:     []types.LeaseItemInput{{SkuUuid: s.sku.Uuid, Quantity: 2}},
+	})
+	require.ErrorIs(t, err, types.ErrSKUCapacityExceeded)
+}
+
+func TestSKUAvailability_Unlimited(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+
+	require.Equal(t, &types.QuerySKUAvailabilityResponse{Allocated: 2}, s.availability(t))
+
+	_, err := keeper.NewQuerier(s.f.App.BillingKeeper).SKUAvailability(s.f.Ctx, &types.QuerySKUAvailabilityRequest{
+		SkuUuid: "01912345-6789-7abc-8def-0123456789ab",
+	})
+	require.Error(t, err)
+}
+
+// TestMigrate2to3_BackfillsSKUAllocations verifies the v2→v3 migration
+// rebuilds SKUAllocations from the leases in state.
+func TestMigrate2to3_BackfillsSKUAllocations(t *testing.T) {
+	s := setupLeaseScaling(t, 100_000, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	k := s.f.App.BillingKeeper
+
+	require.NoError(t, k.SKUAllocations.Clear(s.f.Ctx, nil))
+	require.Equal(t, uint64(0), s.availability(t).Allocated)
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate2to3(s.f.Ctx))
+	require.Equal(t, uint64(2), s.availability(t).Allocated)
+}
@@ -0,0 +1,440 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+)
+
+// UsageReportResult holds the outcome of ReportUsage for the msg server
+// response and events.
+type UsageReportResult struct {
+	Lease types.Lease
+	Usage types.LeaseUsage
+	// Charged is the usage cost billed to the tenant: paid to the provider,
+	// or recorded as debt for a lease in its grace period.
+	Charged sdk.Coins
+	// Uncharged is the usage cost that did not fit the spend limit or what
+	// the tenant can pay. Its counters are held back, so it is charged by a
+	// later report once there is room.
+	Uncharged sdk.Coins
+}
+
+// GetLeaseUsage returns the metering state of a lease. A lease on which no
+// usage has been reported and no spend limit set yields an empty record.
+func (k *Keeper) GetLeaseUsage(ctx context.Context, leaseUUID string) (types.LeaseUsage, error) {
+	usage, err := k.LeaseUsages.Get(ctx, leaseUUID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.LeaseUsage{LeaseUuid: leaseUUID}, nil
+	}
+	return usage, err
+}
+
+// rollUsagePeriod starts a new spend period once the current one has run
+// its course, forgetting what was spent in it.
+func rollUsagePeriod(usage *types.LeaseUsage, blockTime time.Time) {
+	if usage.SpendPeriod == 0 {
+		return
+	}
+	// #nosec G115 -- SpendPeriod is validated to be at most MaxUsageSpendPeriod
+	periodEnd := usage.PeriodStartedAt.Add(time.Duration(usage.SpendPeriod) * time.Second)
+	if blockTime.Before(periodEnd) {
+		return
+	}
+	usage.PeriodStartedAt = blockTime
+	usage.PeriodSpent = sdk.NewCoins()
+}
+
+// pendingUsage is a validated usage report awaiting its charge.
+type pendingUsage struct {
+	counterIdx int
+	skuUUID    string
+	reported   uint64
+	price      sdk.Coin
+}
+
+// spendBudget returns how much of cost fits what is left of the spend limit
+// for the current period. Denoms missing from the spend limit have no
+// budget.
+func spendBudget(usage types.LeaseUsage, cost sdk.Coins) sdk.Coins {
+	budget := sdk.NewCoins()
+	for _, coin := range cost {
+		room := usage.SpendLimit.AmountOf(coin.Denom).Sub(usage.PeriodSpent.AmountOf(coin.Denom))
+		if !room.IsPositive() {
+			continue
+		}
+		budget = budget.Add(sdk.NewCoin(coin.Denom, sdkmath.MinInt(coin.Amount, room)))
+	}
+	return budget
+}
+
+// usagePayer pays the usage charges of a lease in the order a settlement
+// pays accruals: out of the tenant's promotional credit grants first, then
+// out of the credit account in the charged denom, and then out of its other
+// denoms at the current exchange rates. It works on copies of the grants and
+// balances; nothing moves until the caller stores the grants it drew on.
+type usagePayer struct {
+	providerUUID string
+	grants       []types.PromoGrant
+	// used is what each of grants has paid so far.
+	used     []sdk.Coins
+	balances sdk.Coins
+	rates    exchangeRates
+
+	promo          sdk.Coins
+	paid           sdk.Coins
+	converted      sdk.Coins
+	convertedValue sdk.Coins
+}
+
+// newUsagePayer loads what the tenant of lease can pay charges in denoms
+// with.
+func (k *Keeper) newUsagePayer(ctx context.Context, lease types.Lease, denoms []string) (*usagePayer, error) {
+	grants, err := k.GetSpendablePromoGrants(ctx, lease.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	rates, err := k.getExchangeRates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	denoms = slices.Concat(denoms, rates.denoms())
+	slices.Sort(denoms)
+	balances, err := k.getCreditBalancesForDenoms(ctx, lease.Tenant, slices.Compact(denoms))
+	if err != nil {
+		return nil, err
+	}
+	return &usagePayer{
+		providerUUID:   lease.ProviderUuid,
+		grants:         grants,
+		used:           make([]sdk.Coins, len(grants)),
+		balances:       balances,
+		rates:          rates,
+		promo:          sdk.NewCoins(),
+		paid:           sdk.NewCoins(),
+		converted:      sdk.NewCoins(),
+		convertedValue: sdk.NewCoins(),
+	}, nil
+}
+
+// promoLeft returns what grant i can still pay in denom for an item of
+// skuUUID.
+func (p *usagePayer) promoLeft(i int, skuUUID, denom string) sdkmath.Int {
+	if !p.grants[i].AppliesTo(p.providerUUID, skuUUID) {
+		return sdkmath.ZeroInt()
+	}
+	return p.grants[i].Remaining.AmountOf(denom).Sub(p.used[i].AmountOf(denom))
+}
+
+// available returns how much of charge, for an item of skuUUID, the payer
+// can cover.
+func (p *usagePayer) available(skuUUID string, charge sdk.Coin) sdkmath.Int {
+	covered := p.balances.AmountOf(charge.Denom)
+	for i := range p.grants {
+		covered = covered.Add(p.promoLeft(i, skuUUID, charge.Denom))
+	}
+	if covered.GTE(charge.Amount) {
+		return charge.Amount
+	}
+	owed := sdk.NewCoins(sdk.NewCoin(charge.Denom, charge.Amount.Sub(covered)))
+	_, byConversion := p.rates.payByConversion(owed, p.balances)
+	return covered.Add(byConversion.AmountOf(charge.Denom))
+}
+
+// pay draws charge, for an item of skuUUID, from the payer's sources as far
+// as they reach.
+func (p *usagePayer) pay(skuUUID string, charge sdk.Coin) {
+	rest := charge.Amount
+	for i := range p.grants {
+		take := sdkmath.MinInt(rest, p.promoLeft(i, skuUUID, charge.Denom))
+		if !take.IsPositive() {
+			continue
+		}
+		coin := sdk.NewCoin(charge.Denom, take)
+		p.used[i] = p.used[i].Add(coin)
+		p.promo = p.promo.Add(coin)
+		rest = rest.Sub(take)
+	}
+	if take := sdkmath.MinInt(rest, p.balances.AmountOf(charge.Denom)); take.IsPositive() {
+		coin := sdk.NewCoin(charge.Denom, take)
+		p.balances = p.balances.Sub(coin)
+		p.paid = p.paid.Add(coin)
+		rest = rest.Sub(take)
+	}
+	if !rest.IsPositive() {
+		return
+	}
+	converted, covered := p.rates.payByConversion(sdk.NewCoins(sdk.NewCoin(charge.Denom, rest)), p.balances)
+	p.balances = p.balances.Sub(converted...)
+	p.converted = p.converted.Add(converted...)
+	p.convertedValue = p.convertedValue.Add(covered...)
+}
+
+// transferred returns the coins the payer moves to the provider.
+func (p *usagePayer) transferred() sdk.Coins {
+	return p.promo.Add(p.paid...).Add(p.converted...)
+}
+
+// settled returns the part of the charges the payer covered, in the charged
+// denoms.
+func (p *usagePayer) settled() sdk.Coins {
+	return p.promo.Add(p.paid...).Add(p.convertedValue...)
+}
+
+// spendPromo stores the grants the payer drew on and releases what they paid
+// to the tenant's credit address.
+func (k *Keeper) spendPromo(ctx context.Context, tenant string, p *usagePayer) error {
+	for i, grant := range p.grants {
+		if p.used[i].IsZero() {
+			continue
+		}
+		if err := k.usePromoGrant(ctx, grant, p.used[i]); err != nil {
+			return err
+		}
+	}
+	return k.releasePromoCredit(ctx, tenant, p.promo)
+}
+
+// SetUsageSpendLimit sets how much usage may be charged to a PENDING or
+// ACTIVE lease owned by tenant per spend period. Changing the period starts a
+// new one at block time; keeping it only replaces the limit.
+func (k *Keeper) SetUsageSpendLimit(ctx context.Context, tenant, leaseUUID string, limit sdk.Coins, period uint64) (types.LeaseUsage, error) {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	lease, err := k.GetLease(ctx, leaseUUID)
+	if err != nil {
+		return types.LeaseUsage{}, err
+	}
+	if lease.Tenant != tenant {
+		return types.LeaseUsage{}, types.ErrUnauthorized.Wrapf("sender %s is not the tenant of lease %s", tenant, leaseUUID)
+	}
+	if lease.State != types.LEASE_STATE_PENDING && lease.State != types.LEASE_STATE_ACTIVE {
+		return types.LeaseUsage{}, types.ErrLeaseNotActive.Wrapf("lease %s is %s", leaseUUID, lease.State)
+	}
+	if !types.HasMeteredItems(lease.Items) {
+		return types.LeaseUsage{}, types.ErrLeaseItemNotMetered.Wrapf("lease %s has no metered items", leaseUUID)
+	}
+
+	usage, err := k.GetLeaseUsage(ctx, leaseUUID)
+	if err != nil {
+		return types.LeaseUsage{}, err
+	}
+	if usage.SpendPeriod != period {
+		usage.SpendPeriod = period
+		usage.PeriodStartedAt = blockTime
+		usage.PeriodSpent = sdk.NewCoins()
+	}
+	usage.SpendLimit = limit
+
+	if err := k.LeaseUsages.Set(ctx, leaseUUID, usage); err != nil {
+		return types.LeaseUsage{}, err
+	}
+	return usage, nil
+}
+
+// ReportUsage records the provider's cumulative usage counters for the
+// metered items of a lease and charges the increase since the last report.
+//
+// Counters may only grow, so a retried report charges nothing twice. The cost
+// of the increase is capped by what is left of the tenant's spend limit for
+// the current period and then by what the tenant can pay, like a settlement:
+// promotional credit grants for the item first, then the credit account in
+// the charged denom, then its other denoms at the current exchange rates. A
+// lease in its grace period records the part none of them covers as debt
+// instead. Each stored counter
+// only advances by the units actually charged, in report order, so usage
+// that does not fit stays billable and is charged by a later report once
+// the limit or the credit allows. Usage may still be reported on a CLOSED
+// lease until it is pruned, so the last stretch before closure can be billed.
+func (k *Keeper) ReportUsage(ctx context.Context, sender, leaseUUID string, reports []types.UsageReport) (*UsageReportResult, error) {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	lease, err := k.GetLease(ctx, leaseUUID)
+	if err != nil {
+		return nil, err
+	}
+	provider, err := k.skuKeeper.GetProvider(ctx, lease.ProviderUuid)
+	if err != nil {
+		return nil, types.ErrProviderNotFound.Wrapf("provider_uuid %s not found", lease.ProviderUuid)
+	}
+	if provider.Address != sender {
+		return nil, types.ErrUnauthorized.Wrapf("sender %s is not the provider of lease %s", sender, leaseUUID)
+	}
+	if lease.State != types.LEASE_STATE_ACTIVE && lease.State != types.LEASE_STATE_CLOSED {
+		return nil, types.ErrLeaseNotActive.Wrapf("lease %s is %s", leaseUUID, lease.State)
+	}
+
+	usage, err := k.GetLeaseUsage(ctx, leaseUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reports are validated and collected first; counters only move once it
+	// is known how much of the increase can be charged.
+	var pending []pendingUsage
+	cost := sdk.NewCoins()
+	for _, report := range reports {
+		idx, err := findLeaseItemForUpdate(lease, types.LeaseItemInput{SkuUuid: report.SkuUuid, ServiceName: report.ServiceName})
+		if err != nil {
+			return nil, err
+		}
+		item := lease.Items[idx]
+		if !item.Metered {
+			return nil, types.ErrLeaseItemNotMetered.Wrapf("item %s on lease %s is billed over time", report.SkuUuid, leaseUUID)
+		}
+
+		counterIdx := findUsageCounter(&usage, item)
+		last := usage.Counters[counterIdx].Counter
+		for _, p := range pending {
+			if p.counterIdx == counterIdx {
+				last = max(last, p.reported)
+			}
+		}
+		if report.Counter < last {
+			return nil, types.ErrUsageCounterDecreased.Wrapf(
+				"counter %d for sku_uuid %s is below the last reported %d", report.Counter, item.SkuUuid, last,
+			)
+		}
+		pending = append(pending, pendingUsage{counterIdx: counterIdx, skuUUID: item.SkuUuid, reported: report.Counter, price: item.LockedPrice})
+		delta := sdkmath.NewIntFromUint64(report.Counter - last)
+		if delta.IsPositive() {
+			cost = cost.Add(sdk.NewCoin(item.LockedPrice.Denom, item.LockedPrice.Amount.Mul(delta)))
+		}
+	}
+
+	rollUsagePeriod(&usage, blockTime)
+
+	payer, err := k.newUsagePayer(ctx, lease, cost.Denoms())
+	if err != nil {
+		return nil, err
+	}
+	inGrace := lease.GraceEndsAt != nil
+	budget := spendBudget(usage, cost)
+
+	allowed := sdk.NewCoins()
+	for _, p := range pending {
+		counter := &usage.Counters[p.counterIdx]
+		if p.reported <= counter.Counter {
+			continue
+		}
+		units := sdkmath.NewIntFromUint64(p.reported - counter.Counter)
+		if p.price.Amount.IsPositive() {
+			units = sdkmath.MinInt(units, budget.AmountOf(p.price.Denom).Quo(p.price.Amount))
+			if !inGrace {
+				want := sdk.NewCoin(p.price.Denom, p.price.Amount.Mul(units))
+				units = sdkmath.MinInt(units, payer.available(p.skuUUID, want).Quo(p.price.Amount))
+			}
+		}
+		if !units.IsPositive() {
+			continue
+		}
+		counter.Counter += units.Uint64()
+		if amount := p.price.Amount.Mul(units); amount.IsPositive() {
+			charge := sdk.NewCoin(p.price.Denom, amount)
+			budget = budget.Sub(charge)
+			allowed = allowed.Add(charge)
+			payer.pay(p.skuUUID, charge)
+		}
+	}
+	transferred := payer.transferred()
+	settled := payer.settled()
+
+	charged := settled
+	if inGrace {
+		recordGraceDebt(&lease, allowed, settled)
+		charged = allowed
+	}
+	usage.PeriodSpent = usage.PeriodSpent.Add(charged...)
+
+	if err := k.spendPromo(ctx, lease.Tenant, payer); err != nil {
+		return nil, err
+	}
+	if !transferred.IsZero() {
+		if _, err := k.transferToProvider(ctx, lease, transferred); err != nil {
+			return nil, err
+		}
+	}
+	if err := k.LeaseUsages.Set(ctx, leaseUUID, usage); err != nil {
+		return nil, err
+	}
+	if !allowed.IsZero() {
+		err := k.recordSettlement(ctx, &lease, types.LeaseSettlement{
+			Trigger:        types.SETTLEMENT_TRIGGER_USAGE,
+			PeriodStart:    blockTime,
+			PeriodEnd:      blockTime,
+			Accrued:        allowed,
+			Transferred:    transferred,
+			Shortfall:      allowed.Sub(settled...),
+			Promo:          payer.promo,
+			Converted:      payer.converted,
+			ConvertedValue: payer.convertedValue,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := k.SetLease(ctx, lease); err != nil {
+			return nil, err
+		}
+	}
+	if !transferred.IsZero() {
+		if err := k.refreshCreditExhaustion(ctx, lease.Tenant); err != nil {
+			return nil, err
+		}
+	}
+
+	return &UsageReportResult{
+		Lease:     lease,
+		Usage:     usage,
+		Charged:   charged,
+		Uncharged: cost.Sub(charged...),
+	}, nil
+}
+
+// findUsageCounter returns the index of the stored counter of a metered
+// item, adding a zero counter if the item has not been reported before.
+func findUsageCounter(usage *types.LeaseUsage, item types.LeaseItem) int {
+	for i := range usage.Counters {
+		if usage.Counters[i].SkuUuid == item.SkuUuid && usage.Counters[i].ServiceName == item.ServiceName {
+			return i
+		}
+	}
+	usage.Counters = append(usage.Counters, types.UsageCounter{SkuUuid: item.SkuUuid, ServiceName: item.ServiceName})
+	return len(usage.Counters) - 1
+}
+
+// emitUsageReportedEvent emits a usage_reported event.
+func emitUsageReportedEvent(ctx sdk.Context, sender string, result *UsageReportResult) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUsageReported,
+			sdk.NewAttribute(types.AttributeKeyLeaseUUID, result.Lease.Uuid),
+			sdk.NewAttribute(types.AttributeKeyTenant, result.Lease.Tenant),
+			sdk.NewAttribute(types.AttributeKeyProviderUUID, result.Lease.ProviderUuid),
+			sdk.NewAttribute(types.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeKeyChargedAmounts, result.Charged.String()),
+			sdk.NewAttribute(types.AttributeKeyUnchargedAmounts, result.Uncharged.String()),
+		),
+	)
+}
+
+// emitUsageSpendLimitSetEvent emits a usage_spend_limit_set event.
+func emitUsageSpendLimitSetEvent(ctx sdk.Context, tenant string, usage types.LeaseUsage) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUsageSpendLimitSet,
+			sdk.NewAttribute(types.AttributeKeyLeaseUUID, usage.LeaseUuid),
+			sdk.NewAttribute(types.AttributeKeyTenant, tenant),
+			sdk.NewAttribute(types.AttributeKeySpendLimit, usage.SpendLimit.String()),
+			sdk.NewAttribute(types.AttributeKeySpendPeriod, strconv.FormatUint(usage.SpendPeriod, 10)),
+		),
+	)
+}
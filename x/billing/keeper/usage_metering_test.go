@@ -0,0 +1,389 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/keeper"
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+	skutypes "github.com/manifest-network/manifest-ledger/x/sku/types"
+)
+
+// meteringSetup extends leaseScalingSetup with an ACTIVE lease holding one
+// time-billed item (1umfx per second) and one metered item priced at 5umfx
+// per unit of usage.
+type meteringSetup struct {
+	*leaseScalingSetup
+	meteredSKU   skutypes.SKU
+	meteredLease string
+}
+
+func setupMetering(t *testing.T, credit int64) *meteringSetup {
+	t.Helper()
+	s := setupLeaseScaling(t, credit, skutypes.Unit_UNIT_PER_HOUR, 3600)
+	f := s.f
+
+	skuUUID, err := f.App.SKUKeeper.GenerateSKUUUID(f.Ctx)
+	require.NoError(t, err)
+	meteredSKU := skutypes.SKU{
+		Uuid:         skuUUID,
+		ProviderUuid: s.provider.Uuid,
+		Name:         "Egress GB",
+		Unit:         skutypes.Unit_UNIT_PER_USAGE,
+		BasePrice:    sdk.NewCoin(testDenom, sdkmath.NewInt(5)),
+		Active:       true,
+	}
+	require.NoError(t, f.App.SKUKeeper.SetSKU(f.Ctx, meteredSKU))
+
+	leaseUUID := f.createAndAcknowledgeLease(t, s.msgServer, s.tenant, s.providerAddr, []types.LeaseItemInput{
+		{SkuUuid: s.sku.Uuid, Quantity: 1},
+		{SkuUuid: meteredSKU.Uuid, Quantity: 1},
+	})
+
+	return &meteringSetup{leaseScalingSetup: s, meteredSKU: meteredSKU, meteredLease: leaseUUID}
+}
+
+func (s *meteringSetup) setSpendLimit(t *testing.T, amount int64, period uint64) {
+	t.Helper()
+	_, err := s.msgServer.SetUsageSpendLimit(s.f.Ctx, &types.MsgSetUsageSpendLimit{
+		Tenant:     s.tenant.String(),
+		LeaseUuid:  s.meteredLease,
+		SpendLimit: sdk.NewCoins(sdk.NewCoin(testDenom, sdkmath.NewInt(amount))),
+		Period:     period,
+	})
+	require.NoError(t, err)
+}
+
+func (s *meteringSetup) report(counter uint64) (*types.MsgReportUsageResponse, error) {
+	return s.msgServer.ReportUsage(s.f.Ctx, &types.MsgReportUsage{
+		Sender:    s.providerAddr.String(),
+		LeaseUuid: s.meteredLease,
+		Usages:    []types.UsageReport{{SkuUuid: s.meteredSKU.Uuid, Counter: counter}},
+	})
+}
+
+func (s *meteringSetup) payoutBalance() sdkmath.Int {
+	return s.f.App.BankKeeper.GetBalance(s.f.Ctx, s.payoutAddr, testDenom).Amount
+}
+
+func TestMeteredLease_LocksUsagePrice(t *testing.T) {
+	s := setupMetering(t, 100_000)
+	f := s.f
+
+	lease, err := f.App.BillingKeeper.GetLease(f.Ctx, s.meteredLease)
+	require.NoError(t, err)
+	require.False(t, lease.Items[0].Metered)
+	require.True(t, lease.Items[1].Metered)
+	require.Equal(t, sdkmath.NewInt(5), lease.Items[1].LockedPrice.Amount)
+
+	// Only the time-billed items of both leases are reserved: (2 + 1) * 1/s * min_lease_duration.
+	params, err := f.App.BillingKeeper.GetParams(f.Ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewIntFromUint64(3*params.MinLeaseDuration), s.reserved(t))
+
+	// Closing after 100s settles the time-billed item only.
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(100 * time.Second))
+	resp, err := s.msgServer.CloseLease(f.Ctx, &types.MsgCloseLease{
+		Sender:     s.tenant.String(),
+		LeaseUuids: []string{s.meteredLease},
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(100), resp.TotalSettledAmounts.AmountOf(testDenom))
+}
+
+func TestReportUsage_ChargesIncrementWithinSpendLimit(t *testing.T) {
+	s := setupMetering(t, 100_000)
+	f := s.f
+	s.setSpendLimit(t, 10_000, 3600)
+	before := s.payoutBalance()
+
+	f.Ctx = f.Ctx.WithEventManager(sdk.NewEventManager())
+	resp, err := s.report(100)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(500), resp.ChargedAmounts.AmountOf(testDenom))
+	require.True(t, resp.UnchargedAmounts.IsZero())
+	require.Equal(t, before.AddRaw(500), s.payoutBalance())
+	require.Equal(t, 1, countEvents(f.Ctx, types.EventTypeUsageReported))
+
+	// Reporting the same counter again charges nothing.
+	resp, err = s.report(100)
+	require.NoError(t, err)
+	require.True(t, resp.ChargedAmounts.IsZero())
+
+	resp, err = s.report(140)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(200), resp.ChargedAmounts.AmountOf(testDenom))
+	require.Equal(t, before.AddRaw(700), s.payoutBalance())
+
+	_, err = s.report(139)
+	require.ErrorIs(t, err, types.ErrUsageCounterDecreased)
+
+	usage, err := f.App.BillingKeeper.GetLeaseUsage(f.Ctx, s.meteredLease)
+	require.NoError(t, err)
+	require.Len(t, usage.Counters, 1)
+	require.Equal(t, uint64(140), usage.Counters[0].Counter)
+	require.Equal(t, sdkmath.NewInt(700), usage.PeriodSpent.AmountOf(testDenom))
+}
+
+func TestReportUsage_CapsAtSpendLimitPerPeriod(t *testing.T) {
+	s := setupMetering(t, 100_000)
+	f := s.f
+	s.setSpendLimit(t, 1000, 3600)
+
+	// 300 units cost 1500, of which only 1000 fit in the period.
+	resp, err := s.report(300)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(1000), resp.ChargedAmounts.AmountOf(testDenom))
+	require.Equal(t, sdkmath.NewInt(500), resp.UnchargedAmounts.AmountOf(testDenom))
+
+	// The counter stops at the last unit charged.
+	usage, err := f.App.BillingKeeper.GetLeaseUsage(f.Ctx, s.meteredLease)
+	require.NoError(t, err)
+	require.Equal(t, uint64(200), usage.Counters[0].Counter)
+
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(3599 * time.Second))
+	resp, err = s.report(310)
+	require.NoError(t, err)
+	require.True(t, resp.ChargedAmounts.IsZero())
+	require.Equal(t, sdkmath.NewInt(550), resp.UnchargedAmounts.AmountOf(testDenom))
+
+	// A new period starts once spend_period has elapsed, and the usage held
+	// back so far is charged along with the new increase.
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(time.Second))
+	resp, err = s.report(320)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(600), resp.ChargedAmounts.AmountOf(testDenom))
+	require.True(t, resp.UnchargedAmounts.IsZero())
+}
+
+func TestReportUsage_WithoutSpendLimitDefersCharges(t *testing.T) {
+	s := setupMetering(t, 100_000)
+	before := s.payoutBalance()
+
+	resp, err := s.report(100)
+	require.NoError(t, err)
+	require.True(t, resp.ChargedAmounts.IsZero())
+	require.Equal(t, sdkmath.NewInt(500), resp.UnchargedAmounts.AmountOf(testDenom))
+	require.Equal(t, before, s.payoutBalance())
+
+	// The counter is held back, so usage reported without a limit is billed
+	// once one is set.
+	s.setSpendLimit(t, 10_000, 3600)
+	resp, err = s.report(110)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(550), resp.ChargedAmounts.AmountOf(testDenom))
+	require.Equal(t, before.AddRaw(550), s.payoutBalance())
+}
+
+func TestReportUsage_CappedByCredit(t *testing.T) {
+	s := setupMetering(t, 100_000)
+	f := s.f
+	s.setSpendLimit(t, 1_000_000, 3600)
+
+	resp, err := s.report(100_000)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(100_000), resp.ChargedAmounts.AmountOf(testDenom))
+	require.Equal(t, sdkmath.NewInt(400_000), resp.UnchargedAmounts.AmountOf(testDenom))
+
+	usage, err := f.App.BillingKeeper.GetLeaseUsage(f.Ctx, s.meteredLease)
+	require.NoError(t, err)
+	require.Equal(t, uint64(20_000), usage.Counters[0].Counter)
+
+	creditAddr, err := types.DeriveCreditAddressFromBech32(s.tenant.String())
+	require.NoError(t, err)
+	require.True(t, f.App.BankKeeper.GetBalance(f.Ctx, creditAddr, testDenom).IsZero())
+}
+
+func TestReportUsage_PaidByPromoCreditFirst(t *testing.T) {
+	s := setupMetering(t, 100_000)
+	f := s.f
+	s.setSpendLimit(t, 10_000, 3600)
+	grantPromo(t, s.leaseScalingSetup, 300, 24*time.Hour, nil, []string{s.meteredSKU.Uuid})
+	creditAddr, err := types.DeriveCreditAddressFromBech32(s.tenant.String())
+	require.NoError(t, err)
+	creditBefore := f.App.BankKeeper.GetBalance(f.Ctx, creditAddr, testDenom).Amount
+	escrowBefore := promoEscrowBalance(f)
+	payoutBefore := s.payoutBalance()
+
+	// 100 units cost 500: 300 from the grant, 200 from the credit account.
+	resp, err := s.report(100)
+	require.NoError(t, err)
+	require.True(t, sdkmath.NewInt(500).Equal(resp.ChargedAmounts.AmountOf(testDenom)))
+	require.True(t, payoutBefore.AddRaw(500).Equal(s.payoutBalance()))
+	require.True(t, escrowBefore.SubRaw(300).Equal(promoEscrowBalance(f)))
+	require.True(t, creditBefore.SubRaw(200).Equal(f.App.BankKeeper.GetBalance(f.Ctx, creditAddr, testDenom).Amount))
+
+	grants, err := f.App.BillingKeeper.GetSpendablePromoGrants(f.Ctx, s.tenant.String())
+	require.NoError(t, err)
+	require.Empty(t, grants)
+
+	settlements, err := keeper.NewQuerier(f.App.BillingKeeper).LeaseSettlements(f.Ctx, &types.QueryLeaseSettlementsRequest{LeaseUuid: s.meteredLease})
+	require.NoError(t, err)
+	record := settlements.Settlements[len(settlements.Settlements)-1]
+	require.Equal(t, types.SETTLEMENT_TRIGGER_USAGE, record.Trigger)
+	require.True(t, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 300)).Equal(record.Promo))
+	require.True(t, record.Shortfall.IsZero())
+
+	msg, broken := keeper.AllInvariants(f.App.BillingKeeper)(f.Ctx)
+	require.False(t, broken, msg)
+}
+
+func TestReportUsage_PaidByConversion(t *testing.T) {
+	s := setupMetering(t, 100_000)
+	f := s.f
+	s.setSpendLimit(t, 1_000_000, 3600)
+	enableConversion(t, f)
+	setTestDenom2Rate(t, s.leaseScalingSetup, "3", 24*time.Hour)
+	fundTestDenom2(t, s.leaseScalingSetup, 1_000)
+	payoutBefore := s.payoutBalance()
+
+	// The 100_000 umfx of credit pay for 20_000 units and the 1_000 upwr,
+	// worth 3_000 umfx, for another 600. The provider receives the upwr as is.
+	resp, err := s.report(100_000)
+	require.NoError(t, err)
+	require.True(t, sdkmath.NewInt(103_000).Equal(resp.ChargedAmounts.AmountOf(testDenom)))
+	require.True(t, sdkmath.NewInt(397_000).Equal(resp.UnchargedAmounts.AmountOf(testDenom)))
+	require.True(t, payoutBefore.AddRaw(100_000).Equal(s.payoutBalance()))
+	require.True(t, sdkmath.NewInt(1_000).Equal(f.App.BankKeeper.GetBalance(f.Ctx, s.payoutAddr, testDenom2).Amount))
+
+	usage, err := f.App.BillingKeeper.GetLeaseUsage(f.Ctx, s.meteredLease)
+	require.NoError(t, err)
+	require.Equal(t, uint64(20_600), usage.Counters[0].Counter)
+	require.True(t, sdkmath.NewInt(103_000).Equal(usage.PeriodSpent.AmountOf(testDenom)))
+
+	settlements, err := keeper.NewQuerier(f.App.BillingKeeper).LeaseSettlements(f.Ctx, &types.QueryLeaseSettlementsRequest{LeaseUuid: s.meteredLease})
+	require.NoError(t, err)
+	record := settlements.Settlements[len(settlements.Settlements)-1]
+	require.True(t, sdk.NewCoins(sdk.NewInt64Coin(testDenom2, 1_000)).Equal(record.Converted))
+	require.True(t, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 3_000)).Equal(record.ConvertedValue))
+	require.True(t, record.Shortfall.IsZero())
+
+	msg, broken := keeper.AllInvariants(f.App.BillingKeeper)(f.Ctx)
+	require.False(t, broken, msg)
+}
+
+func TestReportUsage_Authorization(t *testing.T) {
+	s := setupMetering(t, 100_000)
+	f := s.f
+
+	_, err := s.msgServer.ReportUsage(f.Ctx, &types.MsgReportUsage{
+		Sender:    s.tenant.String(),
+		LeaseUuid: s.meteredLease,
+		Usages:    []types.UsageReport{{SkuUuid: s.meteredSKU.Uuid, Counter: 1}},
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.ReportUsage(f.Ctx, &types.MsgReportUsage{
+		Sender:    s.providerAddr.String(),
+		LeaseUuid: s.meteredLease,
+		Usages:    []types.UsageReport{{SkuUuid: s.sku.Uuid, Counter: 1}},
+	})
+	require.ErrorIs(t, err, types.ErrLeaseItemNotMetered)
+
+	resp, err := s.msgServer.CreateLease(f.Ctx, &types.MsgCreateLease{
+		Tenant: s.tenant.String(),
+		Items:  []types.LeaseItemInput{{SkuUuid: s.meteredSKU.Uuid, Quantity: 1}},
+	})
+	require.NoError(t, err)
+	_, err = s.msgServer.ReportUsage(f.Ctx, &types.MsgReportUsage{
+		Sender:    s.providerAddr.String(),
+		LeaseUuid: resp.LeaseUuid,
+		Usages:    []types.UsageReport{{SkuUuid: s.meteredSKU.Uuid, Counter: 1}},
+	})
+	require.ErrorIs(t, err, types.ErrLeaseNotActive)
+}
+
+func TestReportUsage_AfterClose(t *testing.T) {
+	s := setupMetering(t, 100_000)
+	f := s.f
+	s.setSpendLimit(t, 10_000, 3600)
+
+	_, err := s.msgServer.CloseLease(f.Ctx, &types.MsgCloseLease{
+		Sender:     s.tenant.String(),
+		LeaseUuids: []string{s.meteredLease},
+	})
+	require.NoError(t, err)
+
+	resp, err := s.report(10)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(50), resp.ChargedAmounts.AmountOf(testDenom))
+
+	// The spend limit can no longer be changed once the lease is closed.
+	_, err = s.msgServer.SetUsageSpendLimit(f.Ctx, &types.MsgSetUsageSpendLimit{
+		Tenant:    s.tenant.String(),
+		LeaseUuid: s.meteredLease,
+		Period:    3600,
+	})
+	require.ErrorIs(t, err, types.ErrLeaseNotActive)
+}
+
+func TestSetUsageSpendLimit(t *testing.T) {
+	s := setupMetering(t, 100_000)
+	f := s.f
+
+	_, err := s.msgServer.SetUsageSpendLimit(f.Ctx, &types.MsgSetUsageSpendLimit{
+		Tenant:    s.providerAddr.String(),
+		LeaseUuid: s.meteredLease,
+		Period:    3600,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.SetUsageSpendLimit(f.Ctx, &types.MsgSetUsageSpendLimit{
+		Tenant:    s.tenant.String(),
+		LeaseUuid: s.leaseUUID,
+		Period:    3600,
+	})
+	require.ErrorIs(t, err, types.ErrLeaseItemNotMetered)
+
+	s.setSpendLimit(t, 1000, 3600)
+	_, err = s.report(100)
+	require.NoError(t, err)
+
+	// Keeping the period keeps what was spent in it; changing it starts over.
+	s.setSpendLimit(t, 2000, 3600)
+	usage, err := f.App.BillingKeeper.GetLeaseUsage(f.Ctx, s.meteredLease)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(500), usage.PeriodSpent.AmountOf(testDenom))
+
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(10 * time.Second))
+	s.setSpendLimit(t, 2000, 7200)
+	res, err := keeper.NewQuerier(f.App.BillingKeeper).LeaseUsage(f.Ctx, &types.QueryLeaseUsageRequest{LeaseUuid: s.meteredLease})
+	require.NoError(t, err)
+	require.True(t, res.Usage.PeriodSpent.IsZero())
+	require.Equal(t, uint64(7200), res.Usage.SpendPeriod)
+	require.Equal(t, f.Ctx.BlockTime(), res.Usage.PeriodStartedAt)
+	require.Equal(t, uint64(100), res.Usage.Counters[0].Counter)
+}
+
+func TestLeaseUsage_GenesisAndPruning(t *testing.T) {
+	s := setupMetering(t, 100_000)
+	f := s.f
+	k := f.App.BillingKeeper
+	s.setSpendLimit(t, 1000, 3600)
+	_, err := s.report(10)
+	require.NoError(t, err)
+
+	exported := k.ExportGenesis(f.Ctx)
+	require.Len(t, exported.LeaseUsages, 1)
+	require.Equal(t, s.meteredLease, exported.LeaseUsages[0].LeaseUuid)
+	require.NoError(t, exported.Validate())
+
+	setLeaseRetention(t, f, 60)
+	_, err = s.msgServer.CloseLease(f.Ctx, &types.MsgCloseLease{
+		Sender:     s.tenant.String(),
+		LeaseUuids: []string{s.meteredLease},
+	})
+	require.NoError(t, err)
+	f.Ctx = f.Ctx.WithBlockTime(f.Ctx.BlockTime().Add(60 * time.Second))
+	require.NoError(t, k.EndBlocker(f.Ctx))
+
+	has, err := k.LeaseUsages.Has(f.Ctx, s.meteredLease)
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/billing/types"
+	skutypes "github.com/manifest-network/manifest-ledger/x/sku/types"
+)
+
+// committedQuantities returns the quantity of each SKU that tenant holds in
+// its PENDING and ACTIVE leases. A new item is priced at the SKU tier for its
+// own quantity plus the tenant's committed quantity of the same SKU, so
+// volume discounts apply across a tenant's leases rather than per item. The
+// read is bounded by the per-tenant pending and active lease limits.
+func (k *Keeper) committedQuantities(ctx context.Context, tenant string) (map[string]uint64, error) {
+	committed := make(map[string]uint64)
+	for _, state := range []types.LeaseState{types.LEASE_STATE_PENDING, types.LEASE_STATE_ACTIVE} {
+		leases, err := k.GetLeasesByTenantAndState(ctx, tenant, state)
+		if err != nil {
+			return nil, err
+		}
+		for _, lease := range leases {
+			for skuUUID, quantity := range skuQuantities(lease.Items) {
+				committed[skuUUID] += quantity
+			}
+		}
+	}
+	return committed, nil
+}
+
+// volumePrice returns the price of sku for an item of quantity units added to
+// a tenant's committed quantities, and commits the item's quantity so later
+// items of the same message are priced with it.
+func volumePrice(sku skutypes.SKU, quantity uint64, committed map[string]uint64) sdk.Coin {
+	committed[sku.Uuid] += quantity
+	return sku.PriceForQuantity(committed[sku.Uuid])
+}
@@ -37,7 +37,15 @@ const (
 	// state shape is forward-compatible (proto3 zero values + a fresh store
 	// prefix), and operators seed ReservedDomainSuffixes at upgrade time or via
 	// post-upgrade MsgUpdateParams rather than baking values into the binary.
-	ConsensusVersion = 2
+	//
+	// v3 introduced, among others, credit withdrawal with a per-deposit
+	// cooldown, EndBlocker closure of exhausted leases, grace periods, lease
+	// pruning, disputes, provider policies and stats, settlement records,
+	// promotional credit, exchange rates, decimal rates and SKU capacity.
+	// Migrate2to3 seeds the params whose zero value would change behaviour
+	// and builds the indexes, stats and exhaustion projections derived from
+	// the leases and credit accounts in state.
+	ConsensusVersion = 3
 )
 
 var (
@@ -160,7 +168,8 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, marshaler codec.JSONCodec) js
 }
 
 // RegisterInvariants registers the module's invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
 }
 
 // QuerierRoute returns the module's query routing key.
@@ -177,6 +186,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 1, migrator.Migrate1to2); err != nil {
 		panic(fmt.Errorf("failed to register %s migration v1→v2: %w", types.ModuleName, err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, migrator.Migrate2to3); err != nil {
+		panic(fmt.Errorf("failed to register %s migration v2→v3: %w", types.ModuleName, err))
+	}
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
@@ -41,10 +41,16 @@ func randomParams(r *rand.Rand) types.Params {
 	// Random pending timeout: 1-60 minutes (60-3600 seconds)
 	pendingTimeout := uint64(r.Intn(3540) + 60) //nolint:gosec
 
+	// Random credit withdrawal cooldown: 0-24 hours (0 disables the cooldown)
+	creditWithdrawalCooldown := uint64(r.Intn(86401)) //nolint:gosec
+
 	// Empty allowed list for simulation (only authority can create leases for tenants)
 	allowedList := []string{}
 
-	return types.NewParams(maxLeasesPerTenant, allowedList, maxItemsPerLease, minLeaseDuration, maxPendingLeasesPerTenant, pendingTimeout)
+	params := types.NewParams(maxLeasesPerTenant, allowedList, maxItemsPerLease, minLeaseDuration, maxPendingLeasesPerTenant, pendingTimeout)
+	params.CreditWithdrawalCooldown = creditWithdrawalCooldown
+
+	return params
 }
 
 // GetGenesisStateFromAppState returns the billing module GenesisState from app state.
@@ -20,6 +20,23 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgRejectLease{}, "lifted/billing/MsgRejectLease")
 	legacy.RegisterAminoMsg(cdc, &MsgCancelLease{}, "lifted/billing/MsgCancelLease")
 	legacy.RegisterAminoMsg(cdc, &MsgSetItemCustomDomain{}, "lifted/billing/MsgSetItemCustomDomain")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawCredit{}, "lifted/billing/MsgWithdrawCredit")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateLeaseItems{}, "lifted/billing/MsgUpdateLeaseItems")
+	legacy.RegisterAminoMsg(cdc, &MsgProposeLeaseAmendment{}, "lifted/billing/MsgProposeLeaseAmendment")
+	legacy.RegisterAminoMsg(cdc, &MsgAcknowledgeLeaseAmendment{}, "lifted/billing/MsgAckLeaseAmendment")
+	legacy.RegisterAminoMsg(cdc, &MsgRejectLeaseAmendment{}, "lifted/billing/MsgRejectLeaseAmendment")
+	legacy.RegisterAminoMsg(cdc, &MsgSetLeaseScheduledEnd{}, "lifted/billing/MsgSetLeaseScheduledEnd")
+	legacy.RegisterAminoMsg(cdc, &MsgReportUsage{}, "lifted/billing/MsgReportUsage")
+	legacy.RegisterAminoMsg(cdc, &MsgSetUsageSpendLimit{}, "lifted/billing/MsgSetUsageSpendLimit")
+	legacy.RegisterAminoMsg(cdc, &MsgOpenDispute{}, "lifted/billing/MsgOpenDispute")
+	legacy.RegisterAminoMsg(cdc, &MsgRespondToDispute{}, "lifted/billing/MsgRespondToDispute")
+	legacy.RegisterAminoMsg(cdc, &MsgResolveDispute{}, "lifted/billing/MsgResolveDispute")
+	legacy.RegisterAminoMsg(cdc, &MsgTransferCredit{}, "lifted/billing/MsgTransferCredit")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateLeases{}, "lifted/billing/MsgCreateLeases")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateLeasesForTenant{}, "lifted/billing/MsgCreateLeasesForTenant")
+	legacy.RegisterAminoMsg(cdc, &MsgSetProviderPolicy{}, "lifted/billing/MsgSetProviderPolicy")
+	legacy.RegisterAminoMsg(cdc, &MsgGrantPromoCredit{}, "lifted/billing/MsgGrantPromoCredit")
+	legacy.RegisterAminoMsg(cdc, &MsgSetExchangeRates{}, "lifted/billing/MsgSetExchangeRates")
 }
 
 // RegisterInterfaces registers the module's interface types.
@@ -36,6 +53,23 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgRejectLease{},
 		&MsgCancelLease{},
 		&MsgSetItemCustomDomain{},
+		&MsgWithdrawCredit{},
+		&MsgUpdateLeaseItems{},
+		&MsgProposeLeaseAmendment{},
+		&MsgAcknowledgeLeaseAmendment{},
+		&MsgRejectLeaseAmendment{},
+		&MsgSetLeaseScheduledEnd{},
+		&MsgReportUsage{},
+		&MsgSetUsageSpendLimit{},
+		&MsgOpenDispute{},
+		&MsgRespondToDispute{},
+		&MsgResolveDispute{},
+		&MsgTransferCredit{},
+		&MsgCreateLeases{},
+		&MsgCreateLeasesForTenant{},
+		&MsgSetProviderPolicy{},
+		&MsgGrantPromoCredit{},
+		&MsgSetExchangeRates{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
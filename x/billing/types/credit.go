@@ -102,45 +102,51 @@ func SubtractReservation(reserved, toSubtract sdk.Coins) sdk.Coins {
 	return result
 }
 
-// CalculateLeaseReservation calculates the reservation amount for a lease.
-// reservation = sum(rate_per_second * quantity) * min_lease_duration for each denom.
-func CalculateLeaseReservation(items []LeaseItem, minLeaseDuration uint64) sdk.Coins {
-	if len(items) == 0 || minLeaseDuration == 0 {
-		return sdk.NewCoins()
-	}
-
-	// Calculate total rates per denom
-	totalRates := sdk.NewCoins()
+// HasMeteredItems reports whether any of the items is billed per unit of usage.
+func HasMeteredItems(items []LeaseItem) bool {
 	for _, item := range items {
-		// Rate = locked_price * quantity
-		itemRate := sdk.NewCoin(
-			item.LockedPrice.Denom,
-			item.LockedPrice.Amount.Mul(sdkmath.NewIntFromUint64(item.Quantity)),
-		)
-		totalRates = totalRates.Add(itemRate)
+		if item.Metered {
+			return true
+		}
 	}
+	return false
+}
 
-	// Multiply by min_lease_duration to get reservation
-	reservation := sdk.NewCoins()
-	minDuration := sdkmath.NewIntFromUint64(minLeaseDuration)
-	for _, rate := range totalRates {
-		reservation = reservation.Add(sdk.NewCoin(rate.Denom, rate.Amount.Mul(minDuration)))
+// RatePerSecond returns the decimal per-second rate the item locked, per unit
+// of quantity. Items locked before rates were kept in decimal have no
+// locked_rate; their whole-unit locked_price is the rate. Metered items have
+// no per-second rate. Calendar-month items always have a locked_rate: the
+// rate of a 28-day month, the highest their monthly price bills at.
+func (item LeaseItem) RatePerSecond() sdkmath.LegacyDec {
+	if item.Metered {
+		return sdkmath.LegacyZeroDec()
 	}
-
-	return reservation
+	if !item.LockedRate.IsNil() && item.LockedRate.IsPositive() {
+		return item.LockedRate
+	}
+	return sdkmath.LegacyNewDecFromInt(item.LockedPrice.Amount)
 }
 
-// CalculateLeaseReservationFromRates calculates the reservation from pre-computed rates.
-// This is useful when total rates are already calculated during lease creation.
-func CalculateLeaseReservationFromRates(totalRatesPerSecond sdk.Coins, minLeaseDuration uint64) sdk.Coins {
-	if totalRatesPerSecond.IsZero() || minLeaseDuration == 0 {
+// CalculateLeaseReservation calculates the reservation amount for a lease.
+// reservation = sum(ceil(rate_per_second * quantity * min_lease_duration)) for each denom.
+// Each item's share is rounded up on its own, so the reservation of a set of
+// items is the sum of the reservations of its parts. Metered items have no
+// per-second rate and reserve nothing. Calendar-month items reserve at their
+// highest rate, which covers min_lease_duration in any month and across
+// month boundaries.
+func CalculateLeaseReservation(items []LeaseItem, minLeaseDuration uint64) sdk.Coins {
+	if len(items) == 0 || minLeaseDuration == 0 {
 		return sdk.NewCoins()
 	}
 
 	reservation := sdk.NewCoins()
 	minDuration := sdkmath.NewIntFromUint64(minLeaseDuration)
-	for _, rate := range totalRatesPerSecond {
-		reservation = reservation.Add(sdk.NewCoin(rate.Denom, rate.Amount.Mul(minDuration)))
+	for _, item := range items {
+		if item.Metered {
+			continue
+		}
+		amount := item.RatePerSecond().MulInt(sdkmath.NewIntFromUint64(item.Quantity)).MulInt(minDuration).Ceil().TruncateInt()
+		reservation = reservation.Add(sdk.NewCoin(item.LockedPrice.Denom, amount))
 	}
 
 	return reservation
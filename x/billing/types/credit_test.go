@@ -293,6 +293,24 @@ func TestCalculateLeaseReservation(t *testing.T) {
 			minLeaseDuration: 3600,
 			expected:         sdk.NewCoins(sdk.NewCoin("upwr", math.NewInt(288000))), // (10*2 + 20*3) * 3600 = 80 * 3600
 		},
+		{
+			name: "metered items reserve nothing",
+			items: []types.LeaseItem{
+				{
+					SkuUuid:     "01912345-6789-7abc-8def-0123456789ab",
+					Quantity:    1,
+					LockedPrice: sdk.NewCoin("upwr", math.NewInt(10)),
+				},
+				{
+					SkuUuid:     "01912345-6789-7abc-8def-0123456789ac",
+					Quantity:    1,
+					LockedPrice: sdk.NewCoin("upwr", math.NewInt(500)), // per unit of usage
+					Metered:     true,
+				},
+			},
+			minLeaseDuration: 3600,
+			expected:         sdk.NewCoins(sdk.NewCoin("upwr", math.NewInt(36000))), // only the time-billed item
+		},
 		{
 			name: "multiple items - different denoms",
 			items: []types.LeaseItem{
@@ -347,57 +365,6 @@ func TestCalculateLeaseReservation(t *testing.T) {
 	}
 }
 
-// ============================================================================
-// CalculateLeaseReservationFromRates Tests
-// ============================================================================
-
-func TestCalculateLeaseReservationFromRates(t *testing.T) {
-	tests := []struct {
-		name                string
-		totalRatesPerSecond sdk.Coins
-		minLeaseDuration    uint64
-		expected            sdk.Coins
-	}{
-		{
-			name:                "single denom rate",
-			totalRatesPerSecond: sdk.NewCoins(sdk.NewCoin("upwr", math.NewInt(100))),
-			minLeaseDuration:    3600,
-			expected:            sdk.NewCoins(sdk.NewCoin("upwr", math.NewInt(360000))),
-		},
-		{
-			name: "multi denom rates",
-			totalRatesPerSecond: sdk.NewCoins(
-				sdk.NewCoin("upwr", math.NewInt(100)),
-				sdk.NewCoin("uatom", math.NewInt(50)),
-			),
-			minLeaseDuration: 3600,
-			expected: sdk.NewCoins(
-				sdk.NewCoin("uatom", math.NewInt(180000)),
-				sdk.NewCoin("upwr", math.NewInt(360000)),
-			),
-		},
-		{
-			name:                "zero rates - zero reservation",
-			totalRatesPerSecond: sdk.NewCoins(),
-			minLeaseDuration:    3600,
-			expected:            sdk.NewCoins(),
-		},
-		{
-			name:                "zero duration - zero reservation",
-			totalRatesPerSecond: sdk.NewCoins(sdk.NewCoin("upwr", math.NewInt(100))),
-			minLeaseDuration:    0,
-			expected:            sdk.NewCoins(),
-		},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			result := types.CalculateLeaseReservationFromRates(tc.totalRatesPerSecond, tc.minLeaseDuration)
-			require.Equal(t, tc.expected, result)
-		})
-	}
-}
-
 // ============================================================================
 // Integration Scenarios
 // ============================================================================
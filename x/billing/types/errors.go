@@ -36,6 +36,20 @@ var (
 	ErrLeaseNotEditable           = errors.Register(ModuleName, 31, "lease state does not allow custom_domain edit")
 	ErrLeaseItemNotFound          = errors.Register(ModuleName, 32, "lease item not found")
 	ErrAmbiguousLeaseItem         = errors.Register(ModuleName, 33, "lease item lookup is ambiguous")
+	ErrCreditWithdrawalCooldown   = errors.Register(ModuleName, 34, "credit withdrawal cooldown has not elapsed")
+	ErrLeaseAmendmentNotFound     = errors.Register(ModuleName, 35, "lease amendment not found")
+	ErrLeaseAmendmentPending      = errors.Register(ModuleName, 36, "lease already has a pending amendment")
+	ErrLeaseItemNotMetered        = errors.Register(ModuleName, 37, "lease item is not metered")
+	ErrUsageCounterDecreased      = errors.Register(ModuleName, 38, "usage counter is lower than the last report")
+	ErrDisputeNotFound            = errors.Register(ModuleName, 39, "dispute not found")
+	ErrLeaseDisputed              = errors.Register(ModuleName, 40, "lease is disputed")
+	ErrDisputeNotOpen             = errors.Register(ModuleName, 41, "dispute is not open")
+	ErrInvalidDisputeReason       = errors.Register(ModuleName, 42, "invalid dispute reason")
+	ErrTenantNotAllowed           = errors.Register(ModuleName, 43, "tenant not allowed by provider policy")
+	ErrInvalidProviderPolicy      = errors.Register(ModuleName, 44, "invalid provider policy")
+	ErrInvalidPromoGrant          = errors.Register(ModuleName, 45, "invalid promo grant")
+	ErrInvalidExchangeRate        = errors.Register(ModuleName, 46, "invalid exchange rate")
+	ErrSKUCapacityExceeded        = errors.Register(ModuleName, 47, "sku capacity exceeded")
 )
 
 // MaxItemsPerLeaseHardLimit is the absolute maximum number of items per lease.
@@ -55,6 +69,26 @@ const MaxRejectionReasonLength = 256
 // MaxClosureReasonLength is the maximum length of a closure reason.
 const MaxClosureReasonLength = 256
 
+// MaxDisputeReasonLength is the maximum length of a dispute reason or a
+// provider's response to it.
+const MaxDisputeReasonLength = 256
+
+// MaxPolicyTenants is the maximum number of addresses in each of a provider
+// policy's allowed_tenants and denied_tenants lists.
+const MaxPolicyTenants = 100
+
+// MaxPromoGrantRestrictions is the maximum number of entries in each of a
+// promo grant's provider_uuids and sku_uuids lists.
+const MaxPromoGrantRestrictions = 100
+
+// MaxPromoGrantsPerTenant is the maximum number of unexpired promo grants a
+// tenant can hold. Settlement walks all of them, so this bounds its cost.
+const MaxPromoGrantsPerTenant = 20
+
+// MaxExchangeRates is the maximum number of denoms in the exchange-rate table.
+// Conversion reads the whole table, so this bounds its cost.
+const MaxExchangeRates = 20
+
 // MaxMetaHashLength is the maximum length of a meta_hash field.
 // 64 bytes accommodates SHA-256 (32 bytes) and SHA-512 (64 bytes) hashes.
 const MaxMetaHashLength = 64
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate checks that an ExchangeRate is well formed: a valid denom, a
+// positive rate, a validity window that is not empty and a valid set_by
+// address.
+func (r ExchangeRate) Validate() error {
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return ErrInvalidExchangeRate.Wrapf("invalid denom %q: %s", r.Denom, err)
+	}
+	if r.Rate.IsNil() || !r.Rate.IsPositive() {
+		return ErrInvalidExchangeRate.Wrapf("rate of %s must be positive", r.Denom)
+	}
+	if !r.ValidUntil.After(r.ValidFrom) {
+		return ErrInvalidExchangeRate.Wrapf("rate of %s has valid_until at or before valid_from", r.Denom)
+	}
+	if _, err := sdk.AccAddressFromBech32(r.SetBy); err != nil {
+		return ErrInvalidExchangeRate.Wrapf("rate of %s has invalid set_by address: %s", r.Denom, err)
+	}
+	return nil
+}
+
+// IsValidAt reports whether the rate may be used at t: valid_from <= t <
+// valid_until.
+func (r ExchangeRate) IsValidAt(t time.Time) bool {
+	return !t.Before(r.ValidFrom) && t.Before(r.ValidUntil)
+}
+
+// validate checks an entry of MsgSetExchangeRates. Whether the window lies in
+// the future is checked in the keeper against block time.
+func (r ExchangeRateInput) validate() error {
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return ErrInvalidExchangeRate.Wrapf("invalid denom %q: %s", r.Denom, err)
+	}
+	if r.Rate.IsNil() || r.Rate.IsNegative() {
+		return ErrInvalidExchangeRate.Wrapf("rate of %s must not be negative", r.Denom)
+	}
+	if r.Rate.IsZero() {
+		return nil
+	}
+	if r.ValidUntil.IsZero() {
+		return ErrInvalidExchangeRate.Wrapf("rate of %s needs valid_until", r.Denom)
+	}
+	if r.ValidFrom != nil && !r.ValidUntil.After(*r.ValidFrom) {
+		return ErrInvalidExchangeRate.Wrapf("rate of %s has valid_until at or before valid_from", r.Denom)
+	}
+	return nil
+}
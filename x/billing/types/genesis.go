@@ -3,6 +3,8 @@ package types
 import (
 	"time"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	pkguuid "github.com/manifest-network/manifest-ledger/pkg/uuid"
@@ -11,9 +13,18 @@ import (
 // DefaultGenesis returns the default genesis state.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:         DefaultParams(),
-		Leases:         []Lease{},
-		CreditAccounts: []CreditAccount{},
+		Params:           DefaultParams(),
+		Leases:           []Lease{},
+		CreditAccounts:   []CreditAccount{},
+		LeaseAmendments:  []LeaseAmendment{},
+		LeaseUsages:      []LeaseUsage{},
+		Disputes:         []Dispute{},
+		LeaseSettlements: []LeaseSettlement{},
+		ProviderPolicies: []ProviderPolicy{},
+		ProviderStats:    []ProviderStats{},
+		PromoGrants:      []PromoGrant{},
+		ExchangeRates:    []ExchangeRate{},
+		CreditDeposits:   []CreditDeposit{},
 	}
 }
 
@@ -83,6 +94,19 @@ func (gs *GenesisState) Validate() error {
 			if !item.LockedPrice.IsValid() || item.LockedPrice.IsZero() {
 				return ErrInvalidLease.Wrapf("lease %s item %d has invalid locked_price", lease.Uuid, i)
 			}
+			if !item.LockedRate.IsNil() && item.LockedRate.IsNegative() {
+				return ErrInvalidLease.Wrapf("lease %s item %d has negative locked_rate", lease.Uuid, i)
+			}
+			if item.CalendarMonth && (item.Metered || item.LockedRate.IsNil() || !item.LockedRate.IsPositive()) {
+				return ErrInvalidLease.Wrapf("lease %s item %d is billed per calendar month but is metered or has no locked_rate", lease.Uuid, i)
+			}
+			if item.CalendarMonth && (!item.MonthlyPrice.IsValid() || item.MonthlyPrice.IsZero() || item.MonthlyPrice.Denom != item.LockedPrice.Denom) {
+				return ErrInvalidLease.Wrapf("lease %s item %d is billed per calendar month but has an invalid monthly_price", lease.Uuid, i)
+			}
+			// A stored item without a monthly price reads back with a zero amount.
+			if !item.CalendarMonth && (item.MonthlyPrice.Denom != "" || (!item.MonthlyPrice.Amount.IsNil() && !item.MonthlyPrice.Amount.IsZero())) {
+				return ErrInvalidLease.Wrapf("lease %s item %d sets monthly_price but is not billed per calendar month", lease.Uuid, i)
+			}
 			if item.ServiceName != "" {
 				hasServiceName++
 			}
@@ -159,6 +183,34 @@ func (gs *GenesisState) Validate() error {
 		// Note: min_lease_duration_at_creation is a uint64 and doesn't require validation.
 		// Zero value is valid for legacy leases (will fall back to current param).
 
+		// A scheduled end must follow creation. One that has already passed
+		// is allowed: the EndBlocker closes the lease in the first block.
+		if lease.ScheduledEndAt != nil && !lease.ScheduledEndAt.After(lease.CreatedAt) {
+			return ErrInvalidLease.Wrapf("lease %s has scheduled_end_at not after created_at", lease.Uuid)
+		}
+
+		// Debt only accrues during a grace period, which only ACTIVE leases
+		// enter; a lease closed at grace expiry keeps its debt until paid.
+		if !lease.Debt.IsValid() {
+			return ErrInvalidLease.Wrapf("lease %s has invalid debt", lease.Uuid)
+		}
+		if !lease.TotalSettled.IsValid() {
+			return ErrInvalidLease.Wrapf("lease %s has invalid total_settled", lease.Uuid)
+		}
+		// The carry is the fraction of a base unit left over by the last
+		// settlement; a whole unit would have been paid.
+		if !lease.AccrualCarry.IsValid() {
+			return ErrInvalidLease.Wrapf("lease %s has invalid accrual_carry", lease.Uuid)
+		}
+		for _, carry := range lease.AccrualCarry {
+			if carry.Amount.GTE(sdkmath.LegacyOneDec()) {
+				return ErrInvalidLease.Wrapf("lease %s accrual_carry %s is not below one unit", lease.Uuid, carry)
+			}
+		}
+		if lease.GraceEndsAt != nil && lease.State != LEASE_STATE_ACTIVE && lease.State != LEASE_STATE_CLOSED {
+			return ErrInvalidLease.Wrapf("lease %s has grace_ends_at but is %s", lease.Uuid, lease.State)
+		}
+
 		// For inactive leases, validate closed_at is set
 		if lease.State == LEASE_STATE_CLOSED {
 			if lease.ClosedAt == nil || lease.ClosedAt.IsZero() {
@@ -207,9 +259,62 @@ func (gs *GenesisState) Validate() error {
 		// Balance is tracked in bank module, no validation needed here
 	}
 
+	if err := gs.validateLeaseAmendments(); err != nil {
+		return err
+	}
+
+	if err := gs.validateLeaseUsages(); err != nil {
+		return err
+	}
+
+	if err := gs.validateDisputes(); err != nil {
+		return err
+	}
+
+	if err := gs.validateLeaseSettlements(); err != nil {
+		return err
+	}
+
+	if err := gs.validatePromoGrants(); err != nil {
+		return err
+	}
+
+	if err := gs.validateExchangeRates(); err != nil {
+		return err
+	}
+
+	if err := gs.validateCreditDeposits(); err != nil {
+		return err
+	}
+
+	seenPolicies := make(map[string]bool, len(gs.ProviderPolicies))
+	for _, policy := range gs.ProviderPolicies {
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+		if seenPolicies[policy.ProviderUuid] {
+			return ErrInvalidProviderPolicy.Wrapf("duplicate policy for provider %s", policy.ProviderUuid)
+		}
+		seenPolicies[policy.ProviderUuid] = true
+	}
+
+	seenStats := make(map[string]bool, len(gs.ProviderStats))
+	for _, stats := range gs.ProviderStats {
+		if err := stats.Validate(); err != nil {
+			return err
+		}
+		if seenStats[stats.ProviderUuid] {
+			return ErrInvalidRequest.Wrapf("duplicate stats for provider %s", stats.ProviderUuid)
+		}
+		seenStats[stats.ProviderUuid] = true
+	}
+
 	// Cross-validate: reserved_amounts must match sum of lease reservations per tenant
-	// Only PENDING and ACTIVE leases have reservations
+	// Only PENDING and ACTIVE leases have reservations, plus pending amendments
 	expectedReservations := CalculateExpectedReservationsByTenant(gs.Leases, gs.Params.MinLeaseDuration)
+	for _, amendment := range gs.LeaseAmendments {
+		expectedReservations[amendment.Tenant] = expectedReservations[amendment.Tenant].Add(amendment.ReservedAmounts...)
+	}
 
 	// Check each credit account's reserved_amounts matches expected
 	for _, ca := range gs.CreditAccounts {
@@ -283,6 +388,282 @@ func (gs *GenesisState) Validate() error {
 	return nil
 }
 
+// validateLeaseAmendments checks that every pending amendment belongs to an
+// ACTIVE lease in the genesis, matches its tenant and provider, and carries
+// well-formed items. At most one amendment may exist per lease.
+func (gs *GenesisState) validateLeaseAmendments() error {
+	leasesByUUID := make(map[string]Lease, len(gs.Leases))
+	for _, lease := range gs.Leases {
+		leasesByUUID[lease.Uuid] = lease
+	}
+
+	seen := make(map[string]bool, len(gs.LeaseAmendments))
+	for _, amendment := range gs.LeaseAmendments {
+		if seen[amendment.LeaseUuid] {
+			return ErrLeaseAmendmentPending.Wrapf("duplicate amendment for lease %s", amendment.LeaseUuid)
+		}
+		seen[amendment.LeaseUuid] = true
+
+		lease, ok := leasesByUUID[amendment.LeaseUuid]
+		if !ok {
+			return ErrInvalidLease.Wrapf("amendment references unknown lease %s", amendment.LeaseUuid)
+		}
+		if lease.State != LEASE_STATE_ACTIVE {
+			return ErrInvalidLease.Wrapf("amendment references lease %s which is not active", amendment.LeaseUuid)
+		}
+		if amendment.Tenant != lease.Tenant || amendment.ProviderUuid != lease.ProviderUuid {
+			return ErrInvalidLease.Wrapf("amendment for lease %s does not match the lease tenant/provider", amendment.LeaseUuid)
+		}
+		if len(amendment.AddItems) == 0 && len(amendment.RemoveItems) == 0 {
+			return ErrInvalidRequest.Wrapf("amendment for lease %s neither adds nor removes items", amendment.LeaseUuid)
+		}
+		for i, item := range amendment.AddItems {
+			if !pkguuid.IsValidUUID(item.SkuUuid) {
+				return ErrInvalidLease.Wrapf("amendment for lease %s item %d has invalid sku_uuid: %s", amendment.LeaseUuid, i, item.SkuUuid)
+			}
+			if item.Quantity == 0 {
+				return ErrInvalidLease.Wrapf("amendment for lease %s item %d has zero quantity", amendment.LeaseUuid, i)
+			}
+			if !item.LockedPrice.IsValid() || item.LockedPrice.IsZero() {
+				return ErrInvalidLease.Wrapf("amendment for lease %s item %d has invalid locked_price", amendment.LeaseUuid, i)
+			}
+		}
+		if !amendment.ReservedAmounts.IsValid() {
+			return ErrInvalidCreditOperation.Wrapf("amendment for lease %s has invalid reserved_amounts", amendment.LeaseUuid)
+		}
+	}
+
+	return nil
+}
+
+// validateLeaseUsages checks that every metering record belongs to a lease in
+// the genesis with at least one metered item, and that its counters and spend
+// limit are well-formed. At most one record may exist per lease.
+func (gs *GenesisState) validateLeaseUsages() error {
+	leasesByUUID := make(map[string]Lease, len(gs.Leases))
+	for _, lease := range gs.Leases {
+		leasesByUUID[lease.Uuid] = lease
+	}
+
+	seen := make(map[string]bool, len(gs.LeaseUsages))
+	for _, usage := range gs.LeaseUsages {
+		if seen[usage.LeaseUuid] {
+			return ErrInvalidLease.Wrapf("duplicate usage record for lease %s", usage.LeaseUuid)
+		}
+		seen[usage.LeaseUuid] = true
+
+		lease, ok := leasesByUUID[usage.LeaseUuid]
+		if !ok {
+			return ErrInvalidLease.Wrapf("usage record references unknown lease %s", usage.LeaseUuid)
+		}
+		if !HasMeteredItems(lease.Items) {
+			return ErrLeaseItemNotMetered.Wrapf("usage record references lease %s which has no metered items", usage.LeaseUuid)
+		}
+
+		seenCounters := make(map[string]bool, len(usage.Counters))
+		for i, counter := range usage.Counters {
+			if !pkguuid.IsValidUUID(counter.SkuUuid) {
+				return ErrInvalidLease.Wrapf("usage record for lease %s counter %d has invalid sku_uuid: %s", usage.LeaseUuid, i, counter.SkuUuid)
+			}
+			key := counter.SkuUuid + "/" + counter.ServiceName
+			if seenCounters[key] {
+				return ErrInvalidLease.Wrapf("usage record for lease %s has duplicate counter %d", usage.LeaseUuid, i)
+			}
+			seenCounters[key] = true
+		}
+
+		if !usage.SpendLimit.IsValid() || !usage.PeriodSpent.IsValid() {
+			return ErrInvalidRequest.Wrapf("usage record for lease %s has invalid spend amounts", usage.LeaseUuid)
+		}
+		if !usage.SpendLimit.IsZero() && (usage.SpendPeriod < MinUsageSpendPeriod || usage.SpendPeriod > MaxUsageSpendPeriod) {
+			return ErrInvalidRequest.Wrapf("usage record for lease %s has spend_period %d outside [%d, %d]",
+				usage.LeaseUuid, usage.SpendPeriod, MinUsageSpendPeriod, MaxUsageSpendPeriod)
+		}
+	}
+
+	return nil
+}
+
+// validateDisputes checks that every dispute belongs to a lease in the genesis
+// with the same tenant and provider, that its amounts are well-formed, and that
+// a lease is flagged as disputed exactly when its dispute is OPEN.
+func (gs *GenesisState) validateDisputes() error {
+	leasesByUUID := make(map[string]Lease, len(gs.Leases))
+	for _, lease := range gs.Leases {
+		leasesByUUID[lease.Uuid] = lease
+	}
+
+	open := make(map[string]bool, len(gs.Disputes))
+	seen := make(map[string]bool, len(gs.Disputes))
+	for _, dispute := range gs.Disputes {
+		if seen[dispute.LeaseUuid] {
+			return ErrInvalidLease.Wrapf("duplicate dispute for lease %s", dispute.LeaseUuid)
+		}
+		seen[dispute.LeaseUuid] = true
+
+		lease, ok := leasesByUUID[dispute.LeaseUuid]
+		if !ok {
+			return ErrDisputeNotFound.Wrapf("dispute references unknown lease %s", dispute.LeaseUuid)
+		}
+		if dispute.Tenant != lease.Tenant || dispute.ProviderUuid != lease.ProviderUuid {
+			return ErrInvalidLease.Wrapf("dispute for lease %s does not match the lease's tenant and provider", dispute.LeaseUuid)
+		}
+
+		switch dispute.State {
+		case DISPUTE_STATE_OPEN:
+			open[dispute.LeaseUuid] = true
+		case DISPUTE_STATE_RESOLVED, DISPUTE_STATE_DEFAULTED:
+			if dispute.ResolvedAt == nil {
+				return ErrInvalidLease.Wrapf("settled dispute for lease %s has no resolved_at", dispute.LeaseUuid)
+			}
+		default:
+			return ErrInvalidLease.Wrapf("dispute for lease %s has invalid state %s", dispute.LeaseUuid, dispute.State)
+		}
+
+		if len(dispute.Reason) > MaxDisputeReasonLength || len(dispute.Response) > MaxDisputeReasonLength {
+			return ErrInvalidDisputeReason.Wrapf("dispute for lease %s exceeds maximum text length of %d characters", dispute.LeaseUuid, MaxDisputeReasonLength)
+		}
+		if !dispute.Escrowed.IsValid() || !dispute.TenantAmounts.IsValid() || !dispute.ProviderAmounts.IsValid() {
+			return ErrInvalidRequest.Wrapf("dispute for lease %s has invalid amounts", dispute.LeaseUuid)
+		}
+	}
+
+	for _, lease := range gs.Leases {
+		if lease.Disputed != open[lease.Uuid] {
+			return ErrInvalidLease.Wrapf("lease %s disputed flag does not match its dispute", lease.Uuid)
+		}
+	}
+
+	return nil
+}
+
+// validateLeaseSettlements checks that every settlement record belongs to a
+// lease in the genesis, that its id is unique and below settlement_sequence,
+// and that each lease's total_settled is the sum of its records.
+func (gs *GenesisState) validateLeaseSettlements() error {
+	totals := make(map[string]sdk.Coins, len(gs.Leases))
+	for _, lease := range gs.Leases {
+		totals[lease.Uuid] = sdk.NewCoins()
+	}
+
+	seen := make(map[uint64]bool, len(gs.LeaseSettlements))
+	for _, settlement := range gs.LeaseSettlements {
+		if seen[settlement.Id] {
+			return ErrInvalidLease.Wrapf("duplicate settlement id %d", settlement.Id)
+		}
+		seen[settlement.Id] = true
+		if settlement.Id >= gs.SettlementSequence {
+			return ErrInvalidLease.Wrapf("settlement id %d is not below settlement_sequence %d", settlement.Id, gs.SettlementSequence)
+		}
+
+		total, ok := totals[settlement.LeaseUuid]
+		if !ok {
+			return ErrInvalidLease.Wrapf("settlement %d references unknown lease %s", settlement.Id, settlement.LeaseUuid)
+		}
+		if settlement.Trigger == SETTLEMENT_TRIGGER_UNSPECIFIED || SettlementTrigger_name[int32(settlement.Trigger)] == "" {
+			return ErrInvalidLease.Wrapf("settlement %d has invalid trigger %s", settlement.Id, settlement.Trigger)
+		}
+		if settlement.PeriodEnd.Before(settlement.PeriodStart) {
+			return ErrInvalidLease.Wrapf("settlement %d ends before it starts", settlement.Id)
+		}
+		if !settlement.Accrued.IsValid() || !settlement.Transferred.IsValid() || !settlement.Shortfall.IsValid() || !settlement.Promo.IsValid() ||
+			!settlement.Converted.IsValid() || !settlement.ConvertedValue.IsValid() {
+			return ErrInvalidRequest.Wrapf("settlement %d has invalid amounts", settlement.Id)
+		}
+		if !settlement.Promo.IsAllLTE(settlement.Transferred) {
+			return ErrInvalidRequest.Wrapf("settlement %d promo %s exceeds transferred %s", settlement.Id, settlement.Promo, settlement.Transferred)
+		}
+		if !settlement.Converted.IsAllLTE(settlement.Transferred) {
+			return ErrInvalidRequest.Wrapf("settlement %d converted %s exceeds transferred %s", settlement.Id, settlement.Converted, settlement.Transferred)
+		}
+		totals[settlement.LeaseUuid] = total.Add(settlement.Transferred...)
+	}
+
+	for _, lease := range gs.Leases {
+		if !lease.TotalSettled.Equal(totals[lease.Uuid]) {
+			return ErrInvalidLease.Wrapf("lease %s total_settled %s does not match its settlement records %s",
+				lease.Uuid, lease.TotalSettled, totals[lease.Uuid])
+		}
+	}
+
+	return nil
+}
+
+// validatePromoGrants checks every promo grant and that its id is unique and
+// below promo_grant_sequence.
+func (gs *GenesisState) validatePromoGrants() error {
+	seen := make(map[uint64]bool, len(gs.PromoGrants))
+	perTenant := make(map[string]int, len(gs.PromoGrants))
+	for _, grant := range gs.PromoGrants {
+		if err := grant.Validate(); err != nil {
+			return err
+		}
+		if seen[grant.Id] {
+			return ErrInvalidPromoGrant.Wrapf("duplicate promo grant id %d", grant.Id)
+		}
+		seen[grant.Id] = true
+		if grant.Id >= gs.PromoGrantSequence {
+			return ErrInvalidPromoGrant.Wrapf("promo grant id %d is not below promo_grant_sequence %d", grant.Id, gs.PromoGrantSequence)
+		}
+		perTenant[grant.Tenant]++
+		if perTenant[grant.Tenant] > MaxPromoGrantsPerTenant {
+			return ErrInvalidPromoGrant.Wrapf("tenant %s has more than %d promo grants", grant.Tenant, MaxPromoGrantsPerTenant)
+		}
+	}
+	return nil
+}
+
+// validateExchangeRates checks every exchange rate, that each denom appears
+// once, that the table fits MaxExchangeRates and that the reference denom has
+// no rate of its own.
+func (gs *GenesisState) validateExchangeRates() error {
+	if len(gs.ExchangeRates) > MaxExchangeRates {
+		return ErrInvalidExchangeRate.Wrapf("%d exchange rates exceed the maximum of %d", len(gs.ExchangeRates), MaxExchangeRates)
+	}
+	seen := make(map[string]bool, len(gs.ExchangeRates))
+	for _, rate := range gs.ExchangeRates {
+		if err := rate.Validate(); err != nil {
+			return err
+		}
+		if seen[rate.Denom] {
+			return ErrInvalidExchangeRate.Wrapf("duplicate exchange rate for %s", rate.Denom)
+		}
+		seen[rate.Denom] = true
+		if rate.Denom == gs.Params.ReferenceDenom {
+			return ErrInvalidExchangeRate.Wrapf("reference denom %s cannot have an exchange rate", rate.Denom)
+		}
+	}
+	return nil
+}
+
+// validateCreditDeposits checks that every credit deposit belongs to a
+// credit account, holds a positive amount, falls on a deposit bucket boundary
+// and appears once.
+func (gs *GenesisState) validateCreditDeposits() error {
+	tenants := make(map[string]bool, len(gs.CreditAccounts))
+	for _, ca := range gs.CreditAccounts {
+		tenants[ca.Tenant] = true
+	}
+	seen := make(map[string]bool, len(gs.CreditDeposits))
+	for _, deposit := range gs.CreditDeposits {
+		if !tenants[deposit.Tenant] {
+			return ErrInvalidCreditOperation.Wrapf("credit deposit references tenant %s without a credit account", deposit.Tenant)
+		}
+		if !deposit.Amount.IsValid() || !deposit.Amount.IsPositive() {
+			return ErrInvalidCreditOperation.Wrapf("credit deposit for %s has invalid amount %s", deposit.Tenant, deposit.Amount)
+		}
+		if !deposit.DepositedAt.Equal(deposit.DepositedAt.Truncate(time.Hour)) {
+			return ErrInvalidCreditOperation.Wrapf("credit deposit for %s has deposited_at %s off the hour", deposit.Tenant, deposit.DepositedAt)
+		}
+		key := deposit.Tenant + "/" + deposit.Amount.Denom + "/" + deposit.DepositedAt.UTC().String()
+		if seen[key] {
+			return ErrInvalidCreditOperation.Wrapf("duplicate credit deposit for %s of %s at %s", deposit.Tenant, deposit.Amount.Denom, deposit.DepositedAt)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
 // ValidateWithBlockTime performs additional genesis state validation that requires block time.
 // This is called during InitGenesis when block time is available.
 // It validates that LastSettledAt timestamps are not in the future relative to block time.
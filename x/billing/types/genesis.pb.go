@@ -35,6 +35,29 @@ type GenesisState struct {
 	// lease_sequence is the sequence counter for deterministic lease UUID generation.
 	// Exported via Sequence.Peek(); the next Sequence.Next() call will return this value.
 	LeaseSequence uint64 `protobuf:"varint,4,opt,name=lease_sequence,json=leaseSequence,proto3" json:"lease_sequence"`
+	// lease_amendments is the list of pending lease amendments.
+	LeaseAmendments []LeaseAmendment `protobuf:"bytes,5,rep,name=lease_amendments,json=leaseAmendments,proto3" json:"lease_amendments"`
+	// lease_usages is the metering state of leases with metered items.
+	LeaseUsages []LeaseUsage `protobuf:"bytes,7,rep,name=lease_usages,json=leaseUsages,proto3" json:"lease_usages"`
+	// disputes are the lease disputes, open or settled.
+	Disputes []Dispute `protobuf:"bytes,8,rep,name=disputes,proto3" json:"disputes"`
+	// provider_policies are the lease policies set by providers.
+	ProviderPolicies []ProviderPolicy `protobuf:"bytes,9,rep,name=provider_policies,json=providerPolicies,proto3" json:"provider_policies"`
+	// provider_stats are the lease counters of each provider.
+	ProviderStats []ProviderStats `protobuf:"bytes,10,rep,name=provider_stats,json=providerStats,proto3" json:"provider_stats"`
+	// lease_settlements are the settlement records of the leases in state.
+	LeaseSettlements []LeaseSettlement `protobuf:"bytes,11,rep,name=lease_settlements,json=leaseSettlements,proto3" json:"lease_settlements"`
+	// settlement_sequence is the id the next LeaseSettlement will get.
+	SettlementSequence uint64 `protobuf:"varint,12,opt,name=settlement_sequence,json=settlementSequence,proto3" json:"settlement_sequence"`
+	// promo_grants are the unexpired promotional credit grants.
+	PromoGrants []PromoGrant `protobuf:"bytes,13,rep,name=promo_grants,json=promoGrants,proto3" json:"promo_grants"`
+	// promo_grant_sequence is the id the next PromoGrant will get.
+	PromoGrantSequence uint64 `protobuf:"varint,14,opt,name=promo_grant_sequence,json=promoGrantSequence,proto3" json:"promo_grant_sequence"`
+	// exchange_rates is the exchange-rate table.
+	ExchangeRates []ExchangeRate `protobuf:"bytes,15,rep,name=exchange_rates,json=exchangeRates,proto3" json:"exchange_rates"`
+	// credit_deposits are the credit deposits still within the withdrawal
+	// cooldown.
+	CreditDeposits []CreditDeposit `protobuf:"bytes,16,rep,name=credit_deposits,json=creditDeposits,proto3" json:"credit_deposits"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -98,6 +121,83 @@ func (m *GenesisState) GetLeaseSequence() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetLeaseAmendments() []LeaseAmendment {
+	if m != nil {
+		return m.LeaseAmendments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLeaseUsages() []LeaseUsage {
+	if m != nil {
+		return m.LeaseUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDisputes() []Dispute {
+	if m != nil {
+		return m.Disputes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProviderPolicies() []ProviderPolicy {
+	if m != nil {
+		return m.ProviderPolicies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProviderStats() []ProviderStats {
+	if m != nil {
+		return m.ProviderStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLeaseSettlements() []LeaseSettlement {
+	if m != nil {
+		return m.LeaseSettlements
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSettlementSequence() uint64 {
+	if m != nil {
+		return m.SettlementSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPromoGrants() []PromoGrant {
+	if m != nil {
+		return m.PromoGrants
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPromoGrantSequence() uint64 {
+	if m != nil {
+		return m.PromoGrantSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetExchangeRates() []ExchangeRate {
+	if m != nil {
+		return m.ExchangeRates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCreditDeposits() []CreditDeposit {
+	if m != nil {
+		return m.CreditDeposits
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "liftedinit.billing.v1.GenesisState")
 }
@@ -107,30 +207,51 @@ func init() {
 }
 
 var fileDescriptor_1ab22347d6e73fe1 = []byte{
-	// 358 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xce, 0xc9, 0x4c, 0x2b,
-	0x49, 0x4d, 0xc9, 0xcc, 0xcb, 0x2c, 0xd1, 0x4f, 0xca, 0xcc, 0xc9, 0xc9, 0xcc, 0x4b, 0xd7, 0x2f,
-	0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x45, 0x28, 0xd2, 0x83, 0x2a, 0xd2, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb,
-	0xd7, 0x07, 0x93, 0x10, 0x95, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05,
-	0x15, 0x55, 0xc4, 0x6e, 0x49, 0x49, 0x65, 0x41, 0x2a, 0xd4, 0x0a, 0xa5, 0x07, 0x4c, 0x5c, 0x3c,
-	0xee, 0x10, 0x4b, 0x83, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0x5c, 0xb9, 0xd8, 0x0a, 0x12, 0x8b, 0x12,
-	0x73, 0x8b, 0x25, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0x64, 0xf5, 0xb0, 0x3a, 0x42, 0x2f, 0x00,
-	0xac, 0xc8, 0x89, 0xef, 0xc4, 0x3d, 0x79, 0x86, 0x57, 0xf7, 0xe4, 0xa1, 0x9a, 0x82, 0xa0, 0xb4,
-	0x90, 0x0b, 0x17, 0x5b, 0x4e, 0x6a, 0x62, 0x71, 0x6a, 0xb1, 0x04, 0x93, 0x02, 0xb3, 0x06, 0xb7,
-	0x91, 0x0c, 0x0e, 0x63, 0x7c, 0x40, 0x8a, 0x10, 0xa6, 0x40, 0xf4, 0x04, 0x41, 0x69, 0xa1, 0x34,
-	0x2e, 0xfe, 0xe4, 0xa2, 0xd4, 0x94, 0xcc, 0x92, 0xf8, 0xc4, 0xe4, 0xe4, 0xfc, 0xd2, 0xbc, 0x92,
-	0x62, 0x09, 0x66, 0xb0, 0x71, 0x2a, 0x38, 0x8c, 0x73, 0x06, 0xab, 0x76, 0x84, 0x28, 0x76, 0x12,
-	0x87, 0x1a, 0x8b, 0x6e, 0x48, 0x10, 0x5f, 0x32, 0xb2, 0xba, 0x62, 0x21, 0x4b, 0x2e, 0x3e, 0xb0,
-	0x8d, 0xf1, 0xc5, 0xa9, 0x85, 0xa5, 0xa9, 0x79, 0xc9, 0xa9, 0x12, 0x2c, 0x0a, 0x8c, 0x1a, 0x2c,
-	0x4e, 0x42, 0xaf, 0xee, 0xc9, 0xa3, 0xc9, 0x04, 0xf1, 0x82, 0xf9, 0xc1, 0x50, 0xae, 0x95, 0x42,
-	0xd7, 0xf3, 0x0d, 0x5a, 0xd2, 0x10, 0xd7, 0xc0, 0x03, 0x19, 0x39, 0x44, 0x9d, 0x42, 0x4f, 0x3c,
-	0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e,
-	0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x3a, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49,
-	0x2f, 0x39, 0x3f, 0x57, 0x3f, 0x37, 0x31, 0x2f, 0x33, 0x2d, 0xb5, 0xb8, 0x44, 0x37, 0x2f, 0xb5,
-	0xa4, 0x3c, 0xbf, 0x28, 0x1b, 0x21, 0x90, 0x93, 0x9a, 0x92, 0x9e, 0x5a, 0xa4, 0x5f, 0x01, 0x37,
-	0x1d, 0x1c, 0x7f, 0x49, 0x6c, 0xe0, 0x08, 0x34, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x13,
-	0x28, 0xab, 0x4a, 0x02, 0x00, 0x00,
+	// 693 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x4e, 0xdb, 0x4a,
+	0x14, 0xc6, 0x93, 0x0b, 0x37, 0x70, 0x27, 0x7f, 0x08, 0x73, 0x29, 0xb8, 0xb4, 0x4d, 0x42, 0x69,
+	0x2b, 0x54, 0xa9, 0x89, 0xa0, 0xab, 0xb6, 0x2b, 0x5c, 0x10, 0x15, 0x62, 0x11, 0x0d, 0x62, 0xc3,
+	0x26, 0x9a, 0xd8, 0x07, 0x33, 0xad, 0xed, 0x71, 0x3d, 0x13, 0x0a, 0xaf, 0xd0, 0x55, 0x57, 0x7d,
+	0x8e, 0x3e, 0x06, 0x4b, 0x96, 0x5d, 0x45, 0x15, 0x2c, 0x2a, 0xe5, 0x29, 0x2a, 0x8f, 0x27, 0xb6,
+	0x43, 0xfe, 0xa8, 0x9b, 0x78, 0x3c, 0xf3, 0x3b, 0xdf, 0x77, 0x9c, 0xf9, 0x74, 0xd0, 0xa6, 0xcb,
+	0xce, 0x24, 0xd8, 0xcc, 0x67, 0xb2, 0xd5, 0x65, 0xae, 0xcb, 0x7c, 0xa7, 0x75, 0xb1, 0xdd, 0x72,
+	0xc0, 0x07, 0xc1, 0x44, 0x33, 0x08, 0xb9, 0xe4, 0xf8, 0x41, 0x0a, 0x35, 0x35, 0xd4, 0xbc, 0xd8,
+	0x5e, 0x5f, 0xa6, 0x1e, 0xf3, 0x79, 0x4b, 0xfd, 0xc6, 0xe4, 0xfa, 0x8a, 0xc3, 0x1d, 0xae, 0x96,
+	0xad, 0x68, 0xa5, 0x77, 0x37, 0x26, 0x9b, 0xc8, 0xab, 0x00, 0xb4, 0xc5, 0xd3, 0xef, 0x45, 0x54,
+	0x3a, 0x88, 0x4d, 0x8f, 0x25, 0x95, 0x80, 0xf7, 0x51, 0x21, 0xa0, 0x21, 0xf5, 0x84, 0x91, 0x6f,
+	0xe4, 0xb7, 0x8a, 0x3b, 0x4f, 0x9a, 0x13, 0x9b, 0x68, 0xb6, 0x15, 0x64, 0x56, 0xae, 0xfb, 0xf5,
+	0xdc, 0xa0, 0x5f, 0xd7, 0x45, 0x44, 0x3f, 0xf1, 0x1e, 0x2a, 0xb8, 0x40, 0x05, 0x08, 0xe3, 0x9f,
+	0xc6, 0xdc, 0x56, 0x71, 0xe7, 0xf1, 0x14, 0x99, 0xa3, 0x08, 0x4a, 0x55, 0xe2, 0x1a, 0xa2, 0x9f,
+	0xf8, 0x0c, 0x2d, 0x59, 0x21, 0xd8, 0x4c, 0x76, 0xa8, 0x65, 0xf1, 0x9e, 0x2f, 0x85, 0x31, 0xa7,
+	0xe4, 0x9e, 0x4d, 0x91, 0x7b, 0xaf, 0xe8, 0xdd, 0x18, 0x36, 0xd7, 0xb4, 0xec, 0x7d, 0x11, 0x52,
+	0xb1, 0xb2, 0x9c, 0xc0, 0x6f, 0x50, 0x45, 0x39, 0x76, 0x04, 0x7c, 0xee, 0x81, 0x6f, 0x81, 0x31,
+	0xdf, 0xc8, 0x6f, 0xcd, 0x9b, 0x78, 0xd0, 0xaf, 0xdf, 0x3b, 0x21, 0x65, 0xf5, 0x7e, 0xac, 0x5f,
+	0xf1, 0x47, 0x54, 0x8d, 0x01, 0xea, 0x81, 0x6f, 0x7b, 0x10, 0xf5, 0xf8, 0xaf, 0xea, 0xf1, 0xf9,
+	0xac, 0x4f, 0xde, 0x1d, 0xd2, 0xa6, 0xa1, 0x9b, 0x1c, 0x93, 0x21, 0x4b, 0xee, 0x08, 0x29, 0xf0,
+	0x29, 0x2a, 0xc5, 0x50, 0x4f, 0x50, 0x07, 0x84, 0xb1, 0xa0, 0x7c, 0x36, 0x66, 0xf9, 0x9c, 0x44,
+	0xa4, 0xb9, 0xa2, 0x3d, 0x46, 0xca, 0x49, 0xd1, 0x4d, 0x08, 0x81, 0x8f, 0xd0, 0xa2, 0xcd, 0x44,
+	0xd0, 0x93, 0x20, 0x8c, 0x45, 0xa5, 0x5b, 0x9b, 0xa2, 0xbb, 0x17, 0x63, 0x66, 0x55, 0x8b, 0x26,
+	0x75, 0x24, 0x59, 0x61, 0x0f, 0x2d, 0x07, 0x21, 0xbf, 0x60, 0x36, 0x84, 0x9d, 0x80, 0xbb, 0xcc,
+	0x62, 0x20, 0x8c, 0xff, 0x66, 0xfe, 0x2d, 0x6d, 0xcd, 0xb7, 0x23, 0xfc, 0xca, 0x7c, 0xa8, 0xd5,
+	0xc7, 0x75, 0x48, 0x35, 0xc8, 0xa2, 0x0c, 0x04, 0xb6, 0x50, 0x25, 0xc1, 0x84, 0xa4, 0x52, 0x18,
+	0x68, 0x66, 0x4c, 0x86, 0x5e, 0x51, 0xe4, 0x85, 0xb9, 0xaa, 0xad, 0xee, 0x69, 0x90, 0x72, 0x90,
+	0xc5, 0xb0, 0x8f, 0x96, 0x87, 0x51, 0x90, 0xd2, 0x85, 0xf8, 0xaa, 0x8b, 0xca, 0xe7, 0xc5, 0xac,
+	0x2b, 0x38, 0x4e, 0xf0, 0xf4, 0xa3, 0xc6, 0x84, 0x48, 0xd5, 0x1d, 0x65, 0x05, 0xfe, 0x80, 0xfe,
+	0x4f, 0x81, 0x34, 0x99, 0x25, 0x95, 0xcc, 0xb5, 0x41, 0xbf, 0x3e, 0xe9, 0x98, 0xe0, 0x74, 0x33,
+	0xc9, 0xe8, 0x29, 0x2a, 0x05, 0x21, 0xf7, 0x78, 0xc7, 0x09, 0x69, 0xd4, 0x74, 0x79, 0x66, 0x6e,
+	0xda, 0x11, 0x7a, 0x10, 0x91, 0x69, 0x6e, 0xb2, 0xe5, 0xa4, 0x18, 0x24, 0x84, 0xc0, 0x87, 0x68,
+	0x25, 0x73, 0x98, 0xb6, 0x59, 0x51, 0x6d, 0x1a, 0x83, 0x7e, 0x7d, 0xe2, 0x39, 0xc1, 0xa9, 0x48,
+	0xd2, 0x67, 0x17, 0x55, 0xe0, 0xd2, 0x3a, 0xa7, 0xbe, 0x03, 0x9d, 0x90, 0x46, 0x49, 0x5c, 0x52,
+	0x9d, 0x6e, 0x4e, 0xe9, 0x74, 0x5f, 0xc3, 0x84, 0x4a, 0x48, 0x6f, 0x71, 0x54, 0x82, 0x94, 0x21,
+	0x43, 0x65, 0x47, 0x8a, 0x0d, 0x01, 0x17, 0x4c, 0x0a, 0xa3, 0xfa, 0x17, 0x23, 0x65, 0x2f, 0x86,
+	0xc7, 0x46, 0xca, 0x50, 0x64, 0x38, 0x52, 0x34, 0x27, 0xde, 0x36, 0xbe, 0xfe, 0xfe, 0xf1, 0xf2,
+	0x51, 0x2c, 0x99, 0x0c, 0xdf, 0xec, 0xa4, 0x3d, 0x9c, 0x5f, 0x2c, 0x54, 0x17, 0xc8, 0x6a, 0x12,
+	0x3a, 0x27, 0xa4, 0x16, 0x74, 0x02, 0x08, 0x19, 0xb7, 0x85, 0x79, 0x72, 0x7d, 0x5b, 0xcb, 0xdf,
+	0xdc, 0xd6, 0xf2, 0xbf, 0x6e, 0x6b, 0xf9, 0x6f, 0x77, 0xb5, 0xdc, 0xcd, 0x5d, 0x2d, 0xf7, 0xf3,
+	0xae, 0x96, 0x3b, 0x7d, 0xe7, 0x30, 0x79, 0xde, 0xeb, 0x36, 0x2d, 0xee, 0xb5, 0x3c, 0xea, 0xb3,
+	0x33, 0x10, 0xf2, 0x95, 0x0f, 0xf2, 0x0b, 0x0f, 0x3f, 0xa5, 0x1b, 0x2e, 0xd8, 0x0e, 0x84, 0xad,
+	0xcb, 0xc4, 0x5b, 0x4d, 0xfd, 0x6e, 0x41, 0x8d, 0xfd, 0xd7, 0x7f, 0x06, 0x00, 0xe6, 0x40, 0x0b,
+	0x24, 0x80, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -153,6 +274,144 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CreditDeposits) > 0 {
+		for iNdEx := len(m.CreditDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreditDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.ExchangeRates) > 0 {
+		for iNdEx := len(m.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.PromoGrantSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PromoGrantSequence))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.PromoGrants) > 0 {
+		for iNdEx := len(m.PromoGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PromoGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.SettlementSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SettlementSequence))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.LeaseSettlements) > 0 {
+		for iNdEx := len(m.LeaseSettlements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LeaseSettlements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ProviderStats) > 0 {
+		for iNdEx := len(m.ProviderStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ProviderPolicies) > 0 {
+		for iNdEx := len(m.ProviderPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Disputes) > 0 {
+		for iNdEx := len(m.Disputes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Disputes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.LeaseUsages) > 0 {
+		for iNdEx := len(m.LeaseUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LeaseUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.LeaseAmendments) > 0 {
+		for iNdEx := len(m.LeaseAmendments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LeaseAmendments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.LeaseSequence != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.LeaseSequence))
 		i--
@@ -233,6 +492,66 @@ func (m *GenesisState) Size() (n int) {
 	if m.LeaseSequence != 0 {
 		n += 1 + sovGenesis(uint64(m.LeaseSequence))
 	}
+	if len(m.LeaseAmendments) > 0 {
+		for _, e := range m.LeaseAmendments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LeaseUsages) > 0 {
+		for _, e := range m.LeaseUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Disputes) > 0 {
+		for _, e := range m.Disputes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProviderPolicies) > 0 {
+		for _, e := range m.ProviderPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProviderStats) > 0 {
+		for _, e := range m.ProviderStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LeaseSettlements) > 0 {
+		for _, e := range m.LeaseSettlements {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.SettlementSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.SettlementSequence))
+	}
+	if len(m.PromoGrants) > 0 {
+		for _, e := range m.PromoGrants {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PromoGrantSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.PromoGrantSequence))
+	}
+	if len(m.ExchangeRates) > 0 {
+		for _, e := range m.ExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CreditDeposits) > 0 {
+		for _, e := range m.CreditDeposits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -391,6 +710,350 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseAmendments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaseAmendments = append(m.LeaseAmendments, LeaseAmendment{})
+			if err := m.LeaseAmendments[len(m.LeaseAmendments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaseUsages = append(m.LeaseUsages, LeaseUsage{})
+			if err := m.LeaseUsages[len(m.LeaseUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disputes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Disputes = append(m.Disputes, Dispute{})
+			if err := m.Disputes[len(m.Disputes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderPolicies = append(m.ProviderPolicies, ProviderPolicy{})
+			if err := m.ProviderPolicies[len(m.ProviderPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderStats = append(m.ProviderStats, ProviderStats{})
+			if err := m.ProviderStats[len(m.ProviderStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseSettlements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaseSettlements = append(m.LeaseSettlements, LeaseSettlement{})
+			if err := m.LeaseSettlements[len(m.LeaseSettlements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementSequence", wireType)
+			}
+			m.SettlementSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromoGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PromoGrants = append(m.PromoGrants, PromoGrant{})
+			if err := m.PromoGrants[len(m.PromoGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromoGrantSequence", wireType)
+			}
+			m.PromoGrantSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PromoGrantSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = append(m.ExchangeRates, ExchangeRate{})
+			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditDeposits = append(m.CreditDeposits, CreditDeposit{})
+			if err := m.CreditDeposits[len(m.CreditDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,129 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BillingHooks is the interface other modules implement to react to lease and
+// credit lifecycle changes. Hooks run inside the same context as the state
+// change, after it has been written, so returning an error aborts the whole
+// operation: the transaction for message handlers, or the single lease for
+// EndBlocker passes.
+type BillingHooks interface {
+	// AfterLeaseCreated is called once a lease is stored in PENDING state.
+	AfterLeaseCreated(ctx context.Context, lease Lease) error
+	// AfterLeaseAcknowledged is called once a lease moves from PENDING to ACTIVE.
+	AfterLeaseAcknowledged(ctx context.Context, lease Lease) error
+	// AfterLeaseRejected is called once a provider rejects a PENDING lease.
+	AfterLeaseRejected(ctx context.Context, lease Lease) error
+	// AfterLeaseCancelled is called once a tenant cancels a PENDING lease.
+	AfterLeaseCancelled(ctx context.Context, lease Lease) error
+	// AfterLeaseExpired is called once a PENDING lease expires in the EndBlocker.
+	AfterLeaseExpired(ctx context.Context, lease Lease) error
+	// AfterLeaseClosed is called once an ACTIVE lease is closed, whether by
+	// MsgCloseLease, credit exhaustion, the end of its grace period or its
+	// scheduled end.
+	AfterLeaseClosed(ctx context.Context, lease Lease) error
+	// AfterLeaseSettled is called whenever credit is transferred to the
+	// provider for a lease, including the payment of recorded debt and the
+	// provider's part of a settled dispute. Transfers into dispute escrow do
+	// not count. The lease is passed as it was when the transfer happened;
+	// last_settled_at may not have been advanced yet.
+	AfterLeaseSettled(ctx context.Context, lease Lease, amount sdk.Coins) error
+	// AfterCreditFunded is called once MsgFundCredit has transferred amount to
+	// the tenant's credit account.
+	AfterCreditFunded(ctx context.Context, tenant string, amount sdk.Coin) error
+}
+
+var _ BillingHooks = MultiBillingHooks{}
+
+// MultiBillingHooks combines multiple billing hooks; they are called in order
+// and the first error stops the chain.
+type MultiBillingHooks []BillingHooks
+
+// NewMultiBillingHooks returns the given hooks combined into one.
+func NewMultiBillingHooks(hooks ...BillingHooks) MultiBillingHooks {
+	return hooks
+}
+
+// AfterLeaseCreated implements BillingHooks.
+func (h MultiBillingHooks) AfterLeaseCreated(ctx context.Context, lease Lease) error {
+	for i := range h {
+		if err := h[i].AfterLeaseCreated(ctx, lease); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterLeaseAcknowledged implements BillingHooks.
+func (h MultiBillingHooks) AfterLeaseAcknowledged(ctx context.Context, lease Lease) error {
+	for i := range h {
+		if err := h[i].AfterLeaseAcknowledged(ctx, lease); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterLeaseRejected implements BillingHooks.
+func (h MultiBillingHooks) AfterLeaseRejected(ctx context.Context, lease Lease) error {
+	for i := range h {
+		if err := h[i].AfterLeaseRejected(ctx, lease); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterLeaseCancelled implements BillingHooks.
+func (h MultiBillingHooks) AfterLeaseCancelled(ctx context.Context, lease Lease) error {
+	for i := range h {
+		if err := h[i].AfterLeaseCancelled(ctx, lease); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterLeaseExpired implements BillingHooks.
+func (h MultiBillingHooks) AfterLeaseExpired(ctx context.Context, lease Lease) error {
+	for i := range h {
+		if err := h[i].AfterLeaseExpired(ctx, lease); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterLeaseClosed implements BillingHooks.
+func (h MultiBillingHooks) AfterLeaseClosed(ctx context.Context, lease Lease) error {
+	for i := range h {
+		if err := h[i].AfterLeaseClosed(ctx, lease); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterLeaseSettled implements BillingHooks.
+func (h MultiBillingHooks) AfterLeaseSettled(ctx context.Context, lease Lease, amount sdk.Coins) error {
+	for i := range h {
+		if err := h[i].AfterLeaseSettled(ctx, lease, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterCreditFunded implements BillingHooks.
+func (h MultiBillingHooks) AfterCreditFunded(ctx context.Context, tenant string, amount sdk.Coin) error {
+	for i := range h {
+		if err := h[i].AfterCreditFunded(ctx, tenant, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
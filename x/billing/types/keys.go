@@ -54,6 +54,85 @@ var (
 	// SetItemCustomDomain. Entries are reconciled by reconcileCustomDomainIndex
 	// inside SetLease, derived from each item's (state, custom_domain).
 	CustomDomainIndexKey = collections.NewPrefix(12)
+
+	// LeaseAmendmentKey saves the pending LeaseAmendments, keyed by lease UUID.
+	LeaseAmendmentKey = collections.NewPrefix(13)
+
+	// LeaseByScheduledEndIndexKey saves the (scheduled_end_at, lease_uuid) index
+	// of ACTIVE leases with a scheduled end, walked in time order by the EndBlocker.
+	LeaseByScheduledEndIndexKey = collections.NewPrefix(14)
+
+	// CreditExhaustionKey saves the projected credit exhaustion time of each
+	// (tenant, denom) pair that funds at least one ACTIVE lease.
+	CreditExhaustionKey = collections.NewPrefix(15)
+
+	// CreditExhaustionQueueKey saves the (exhaustion_time, tenant, denom) queue
+	// mirroring CreditExhaustionKey, walked in time order by the EndBlocker.
+	CreditExhaustionQueueKey = collections.NewPrefix(16)
+
+	// LeaseByGraceEndIndexKey saves the (grace_ends_at, lease_uuid) index of
+	// ACTIVE leases in their grace period, walked in time order by the EndBlocker.
+	LeaseByGraceEndIndexKey = collections.NewPrefix(17)
+
+	// LeaseWithDebtIndexKey saves the (tenant, lease_uuid) index of leases that
+	// carry unpaid debt, in any state.
+	LeaseWithDebtIndexKey = collections.NewPrefix(18)
+
+	// LeaseByTerminalAtIndexKey saves the (terminal_at, lease_uuid) index of
+	// CLOSED, REJECTED and EXPIRED leases without debt, walked in time order by
+	// the EndBlocker pruner.
+	LeaseByTerminalAtIndexKey = collections.NewPrefix(19)
+
+	// LeaseUsageKey saves the metering state of leases with metered items,
+	// keyed by lease UUID.
+	LeaseUsageKey = collections.NewPrefix(20)
+
+	// DisputeKey saves the lease Disputes, keyed by lease UUID.
+	DisputeKey = collections.NewPrefix(21)
+
+	// DisputeByDeadlineIndexKey saves the (response_deadline, lease_uuid) index
+	// of OPEN disputes the provider has not responded to, walked in time order
+	// by the EndBlocker.
+	DisputeByDeadlineIndexKey = collections.NewPrefix(22)
+
+	// ProviderPolicyKey saves the ProviderPolicies, keyed by provider UUID.
+	ProviderPolicyKey = collections.NewPrefix(23)
+
+	// ProviderStatsKey saves the per-provider lease counters, keyed by
+	// provider UUID.
+	ProviderStatsKey = collections.NewPrefix(24)
+
+	// LeaseSettlementKey saves the settlement records, keyed by
+	// (lease UUID, settlement id).
+	LeaseSettlementKey = collections.NewPrefix(25)
+
+	// SettlementSequenceKey saves the next LeaseSettlement id.
+	SettlementSequenceKey = collections.NewPrefix(26)
+
+	// PromoGrantKey saves the unexpired PromoGrants, keyed by (tenant, id).
+	PromoGrantKey = collections.NewPrefix(27)
+
+	// PromoGrantSequenceKey saves the next PromoGrant id.
+	PromoGrantSequenceKey = collections.NewPrefix(28)
+
+	// PromoGrantByExpiryIndexKey saves the (expires_at, tenant, id) queue of
+	// PromoGrants, walked in time order by the EndBlocker.
+	PromoGrantByExpiryIndexKey = collections.NewPrefix(29)
+
+	// ExchangeRateKey saves the exchange-rate table, keyed by denom.
+	ExchangeRateKey = collections.NewPrefix(30)
+
+	// SKUAllocationKey saves the quantity of each SKU held by PENDING and
+	// ACTIVE leases, keyed by sku_uuid.
+	SKUAllocationKey = collections.NewPrefix(31)
+
+	// LeaseAmendmentByCreatedAtIndexKey saves the (created_at, lease_uuid)
+	// index of pending LeaseAmendments, walked in time order by the EndBlocker.
+	LeaseAmendmentByCreatedAtIndexKey = collections.NewPrefix(32)
+
+	// CreditDepositKey saves the credit still within the withdrawal cooldown,
+	// keyed by (tenant, denom, deposited_at).
+	CreditDepositKey = collections.NewPrefix(33)
 )
 
 const (
@@ -62,6 +141,14 @@ const (
 	StoreKey = ModuleName
 
 	QuerierRoute = ModuleName
+
+	// DisputeEscrowName is the module account holding the amounts settled for
+	// leases under dispute.
+	DisputeEscrowName = "billing_dispute_escrow"
+
+	// PromoEscrowName is the module account holding the unspent promotional
+	// credit grants.
+	PromoEscrowName = "billing_promo_escrow"
 )
 
 // CreditAccountAddressPrefix is the prefix used to derive credit account addresses.
@@ -94,6 +181,38 @@ const (
 	// that can be expired in a single block. This prevents DoS attacks where
 	// many pending leases expire simultaneously, causing block timeouts.
 	MaxPendingLeaseExpirationsPerBlock = 100
+
+	// MaxLeaseAmendmentExpirationsPerBlock is the maximum number of pending
+	// lease amendments that can be expired in a single block.
+	MaxLeaseAmendmentExpirationsPerBlock = 100
+
+	// MaxScheduledLeaseClosuresPerBlock is the maximum number of leases that
+	// can be closed at their scheduled end in a single block. Leases past the
+	// limit are closed in later blocks and settled up to their scheduled end.
+	MaxScheduledLeaseClosuresPerBlock = 100
+
+	// MaxCreditExhaustionsPerBlock is the maximum number of due (tenant, denom)
+	// credit exhaustion entries processed in a single block. Each entry closes
+	// at most max_leases_per_tenant leases, so this is kept well below the
+	// other limits.
+	MaxCreditExhaustionsPerBlock = 20
+
+	// MaxGraceExpirationsPerBlock is the maximum number of leases that can be
+	// closed at the end of their grace period in a single block. Leases past
+	// the limit are closed in later blocks, still at their grace_ends_at.
+	MaxGraceExpirationsPerBlock = 100
+
+	// MaxLeasePrunesPerBlock is the maximum number of terminal leases pruned
+	// in a single block once their retention period has passed.
+	MaxLeasePrunesPerBlock = 100
+
+	// MaxDisputeDefaultsPerBlock is the maximum number of unanswered disputes
+	// resolved in the tenant's favor in a single block.
+	MaxDisputeDefaultsPerBlock = 100
+
+	// MaxPromoGrantReclaimsPerBlock is the maximum number of expired promo
+	// grants reclaimed in a single block.
+	MaxPromoGrantReclaimsPerBlock = 100
 )
 
 // Event types for the billing module.
@@ -115,37 +234,104 @@ const (
 	EventTypeParamsUpdated            = "params_updated"
 	EventTypeLeaseCustomDomainSet     = "lease_custom_domain_set"
 	EventTypeLeaseCustomDomainCleared = "lease_custom_domain_cleared"
+	EventTypeCreditWithdrawn          = "credit_withdrawn"
+	EventTypeLeaseItemsUpdated        = "lease_items_updated"
+	EventTypeLeaseItemScaled          = "lease_item_scaled"
+	EventTypeLeaseAmendmentProposed   = "lease_amendment_proposed"
+	EventTypeLeaseAmendmentAcked      = "lease_amendment_acknowledged"
+	EventTypeLeaseAmendmentRejected   = "lease_amendment_rejected"
+	EventTypeLeaseAmendmentExpired    = "lease_amendment_expired"
+	EventTypeLeaseScheduledEndSet     = "lease_scheduled_end_set"
+	EventTypeLeaseGraceStarted        = "lease_grace_started"
+	EventTypeLeaseGraceEnded          = "lease_grace_ended"
+	EventTypeLeaseDebtPaid            = "lease_debt_paid"
+	EventTypeUsageReported            = "usage_reported"
+	EventTypeUsageSpendLimitSet       = "usage_spend_limit_set"
+	EventTypeDisputeOpened            = "dispute_opened"
+	EventTypeDisputeResponded         = "dispute_responded"
+	EventTypeDisputeResolved          = "dispute_resolved"
+	EventTypeDisputeDefaulted         = "dispute_defaulted"
+	EventTypeCreditTransferred        = "credit_transferred"
+	EventTypeProviderPolicySet        = "provider_policy_set"
+	EventTypePromoCreditGranted       = "promo_credit_granted"
+	EventTypePromoCreditReclaimed     = "promo_credit_reclaimed"
+	EventTypeExchangeRateSet          = "exchange_rate_set"
+	EventTypeExchangeRateRemoved      = "exchange_rate_removed"
 
 	// Attribute keys for events.
-	AttributeKeyTenant            = "tenant"
-	AttributeKeyCreditAddress     = "credit_address"
-	AttributeKeyLeaseUUID         = "lease_uuid"
-	AttributeKeyProviderUUID      = "provider_uuid"
-	AttributeKeyAmount            = "amount"
-	AttributeKeySettledAmount     = "settled_amount"
-	AttributeKeySettledAmounts    = "settled_amounts"
-	AttributeKeyPayoutAddress     = "payout_address"
-	AttributeKeyLeaseCount        = "lease_count"
-	AttributeKeySender            = "sender"
-	AttributeKeyNewBalance        = "new_balance"
-	AttributeKeyItemCount         = "item_count"
-	AttributeKeyTotalRate         = "total_rate_per_second"
-	AttributeKeyClosedBy          = "closed_by"
-	AttributeKeyDuration          = "duration_seconds"
-	AttributeKeyActiveLeaseCount  = "active_lease_count"
-	AttributeKeyPendingLeaseCount = "pending_lease_count"
-	AttributeKeyCreatedBy         = "created_by"
-	AttributeKeyReason            = "reason"
-	AttributeKeyAcknowledgedBy    = "acknowledged_by"
-	AttributeKeyRejectedBy        = "rejected_by"
-	AttributeKeyRejectionReason   = "rejection_reason"
-	AttributeKeyClosureReason     = "closure_reason"
-	AttributeKeyCancelledBy       = "cancelled_by"
-	AttributeKeyAutoClosed        = "auto_closed"
-	AttributeKeyMetaHash          = "meta_hash"
-	AttributeKeyCustomDomain      = "custom_domain"
-	AttributeKeySetBy             = "set_by"
-	AttributeKeyServiceName       = "service_name"
+	AttributeKeyTenant              = "tenant"
+	AttributeKeyCreditAddress       = "credit_address"
+	AttributeKeyLeaseUUID           = "lease_uuid"
+	AttributeKeyProviderUUID        = "provider_uuid"
+	AttributeKeyAmount              = "amount"
+	AttributeKeySettledAmount       = "settled_amount"
+	AttributeKeySettledAmounts      = "settled_amounts"
+	AttributeKeyPayoutAddress       = "payout_address"
+	AttributeKeyLeaseCount          = "lease_count"
+	AttributeKeySender              = "sender"
+	AttributeKeyNewBalance          = "new_balance"
+	AttributeKeyItemCount           = "item_count"
+	AttributeKeyTotalRate           = "total_rate_per_second"
+	AttributeKeyClosedBy            = "closed_by"
+	AttributeKeyDuration            = "duration_seconds"
+	AttributeKeyActiveLeaseCount    = "active_lease_count"
+	AttributeKeyPendingLeaseCount   = "pending_lease_count"
+	AttributeKeyCreatedBy           = "created_by"
+	AttributeKeyReason              = "reason"
+	AttributeKeyAcknowledgedBy      = "acknowledged_by"
+	AttributeKeyRejectedBy          = "rejected_by"
+	AttributeKeyRejectionReason     = "rejection_reason"
+	AttributeKeyClosureReason       = "closure_reason"
+	AttributeKeyCancelledBy         = "cancelled_by"
+	AttributeKeyAutoClosed          = "auto_closed"
+	AttributeKeyMetaHash            = "meta_hash"
+	AttributeKeyCustomDomain        = "custom_domain"
+	AttributeKeySetBy               = "set_by"
+	AttributeKeyServiceName         = "service_name"
+	AttributeKeyRecipient           = "recipient"
+	AttributeKeySkuUUID             = "sku_uuid"
+	AttributeKeyOldQuantity         = "old_quantity"
+	AttributeKeyNewQuantity         = "new_quantity"
+	AttributeKeyPreviousRate        = "previous_total_rate_per_second"
+	AttributeKeyAddedCount          = "added_count"
+	AttributeKeyRemovedCount        = "removed_count"
+	AttributeKeyReservedAmounts     = "reserved_amounts"
+	AttributeKeyScheduledEndAt      = "scheduled_end_at"
+	AttributeKeyPreviousEndAt       = "previous_scheduled_end_at"
+	AttributeKeyGraceEndsAt         = "grace_ends_at"
+	AttributeKeyGracePeriod         = "grace_period_seconds"
+	AttributeKeyDebt                = "debt"
+	AttributeKeyReset               = "reset"
+	AttributeKeyChargedAmounts      = "charged_amounts"
+	AttributeKeyUnchargedAmounts    = "uncharged_amounts"
+	AttributeKeySpendLimit          = "spend_limit"
+	AttributeKeySpendPeriod         = "spend_period_seconds"
+	AttributeKeyEscrowed            = "escrowed"
+	AttributeKeyResponseDeadline    = "response_deadline"
+	AttributeKeyTenantAmounts       = "tenant_amounts"
+	AttributeKeyProviderAmounts     = "provider_amounts"
+	AttributeKeyResolvedBy          = "resolved_by"
+	AttributeKeyTenantShare         = "tenant_share"
+	AttributeKeyResponse            = "response"
+	AttributeKeyFromTenant          = "from_tenant"
+	AttributeKeyToTenant            = "to_tenant"
+	AttributeKeyFromCreditAddress   = "from_credit_address"
+	AttributeKeyToCreditAddress     = "to_credit_address"
+	AttributeKeyTransferredBy       = "transferred_by"
+	AttributeKeyPendingTimeout      = "pending_timeout_seconds"
+	AttributeKeyAutoAcknowledge     = "auto_acknowledge"
+	AttributeKeyOverrideGracePeriod = "override_grace_period"
+	AttributeKeyAllowedTenants      = "allowed_tenants"
+	AttributeKeyDeniedTenants       = "denied_tenants"
+	AttributeKeyGrantID             = "grant_id"
+	AttributeKeyGranter             = "granter"
+	AttributeKeyExpiresAt           = "expires_at"
+	AttributeKeyProviderUUIDs       = "provider_uuids"
+	AttributeKeySkuUUIDs            = "sku_uuids"
+	AttributeKeyDenom               = "denom"
+	AttributeKeyExchangeRate        = "rate"
+	AttributeKeyValidFrom           = "valid_from"
+	AttributeKeyValidUntil          = "valid_until"
 )
 
 // Rejection reasons for lease cancellation/rejection.
@@ -158,8 +344,17 @@ const (
 const (
 	// ClosureReasonCreditExhausted is the reason set when a lease is auto-closed due to credit exhaustion.
 	ClosureReasonCreditExhausted = "credit exhausted"
+
+	// ClosureReasonScheduledEnd is the reason set when a lease is closed by the
+	// EndBlocker at its scheduled_end_at.
+	ClosureReasonScheduledEnd = "scheduled end"
 )
 
+// AcknowledgedByProviderPolicy is the acknowledged_by event value of leases
+// acknowledged at creation because their provider's policy sets
+// auto_acknowledge.
+const AcknowledgedByProviderPolicy = "provider_policy"
+
 // Authorisation role values emitted in the AttributeKeySetBy event attribute.
 // Matches the SDK convention of named string constants for closed-set values
 // (e.g. x/gov AttributeValueProposalDropped).
@@ -3,6 +3,9 @@ package types
 import (
 	"strings"
 
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	pkguuid "github.com/manifest-network/manifest-ledger/pkg/uuid"
@@ -19,6 +22,23 @@ var (
 	_ sdk.Msg = &MsgRejectLease{}
 	_ sdk.Msg = &MsgCancelLease{}
 	_ sdk.Msg = &MsgSetItemCustomDomain{}
+	_ sdk.Msg = &MsgWithdrawCredit{}
+	_ sdk.Msg = &MsgUpdateLeaseItems{}
+	_ sdk.Msg = &MsgProposeLeaseAmendment{}
+	_ sdk.Msg = &MsgAcknowledgeLeaseAmendment{}
+	_ sdk.Msg = &MsgRejectLeaseAmendment{}
+	_ sdk.Msg = &MsgSetLeaseScheduledEnd{}
+	_ sdk.Msg = &MsgReportUsage{}
+	_ sdk.Msg = &MsgSetUsageSpendLimit{}
+	_ sdk.Msg = &MsgOpenDispute{}
+	_ sdk.Msg = &MsgRespondToDispute{}
+	_ sdk.Msg = &MsgResolveDispute{}
+	_ sdk.Msg = &MsgTransferCredit{}
+	_ sdk.Msg = &MsgCreateLeases{}
+	_ sdk.Msg = &MsgCreateLeasesForTenant{}
+	_ sdk.Msg = &MsgSetProviderPolicy{}
+	_ sdk.Msg = &MsgGrantPromoCredit{}
+	_ sdk.Msg = &MsgSetExchangeRates{}
 )
 
 // IsValidDNSLabel checks whether name is a valid DNS label per RFC 1123:
@@ -163,6 +183,50 @@ func (m *MsgCreateLeaseForTenant) ValidateBasic() error {
 	return ValidateLeaseItems(m.Items)
 }
 
+// ValidateBasic performs basic validation for MsgCreateLeases.
+func (m *MsgCreateLeases) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Tenant); err != nil {
+		return ErrInvalidLease.Wrapf("invalid tenant address: %s", err)
+	}
+
+	return ValidateLeaseInputs(m.Leases)
+}
+
+// ValidateBasic performs basic validation for MsgCreateLeasesForTenant.
+func (m *MsgCreateLeasesForTenant) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return ErrUnauthorized.Wrapf("invalid authority address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Tenant); err != nil {
+		return ErrInvalidLease.Wrapf("invalid tenant address: %s", err)
+	}
+
+	return ValidateLeaseInputs(m.Leases)
+}
+
+// ValidateLeaseInputs validates the leases of a batch creation message: 1 to
+// MaxBatchLeaseSize leases, each following the single-lease creation rules.
+func ValidateLeaseInputs(leases []LeaseInput) error {
+	if len(leases) == 0 {
+		return ErrInvalidLease.Wrap("at least one lease is required")
+	}
+	if len(leases) > MaxBatchLeaseSize {
+		return ErrInvalidLease.Wrapf("too many leases: %d, maximum is %d", len(leases), MaxBatchLeaseSize)
+	}
+
+	for i, lease := range leases {
+		if len(lease.MetaHash) > MaxMetaHashLength {
+			return ErrInvalidMetaHash.Wrapf("lease %d: meta_hash exceeds maximum length of %d bytes", i, MaxMetaHashLength)
+		}
+		if err := ValidateLeaseItems(lease.Items); err != nil {
+			return errorsmod.Wrapf(err, "lease %d", i)
+		}
+	}
+
+	return nil
+}
+
 // ValidateBasic performs basic validation for MsgCloseLease.
 func (m *MsgCloseLease) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
@@ -400,3 +464,341 @@ func (m *MsgSetItemCustomDomain) ValidateBasic() error {
 	}
 	return IsValidFQDN(m.CustomDomain)
 }
+
+// ValidateBasic performs basic validation for MsgWithdrawCredit.
+func (m *MsgWithdrawCredit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Tenant); err != nil {
+		return ErrInvalidCreditOperation.Wrapf("invalid tenant address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return ErrInvalidCreditOperation.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return ErrInvalidCreditOperation.Wrap("amount must be positive")
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation for MsgTransferCredit.
+func (m *MsgTransferCredit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return ErrInvalidCreditOperation.Wrapf("invalid sender address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.FromTenant); err != nil {
+		return ErrInvalidCreditOperation.Wrapf("invalid from_tenant address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.ToTenant); err != nil {
+		return ErrInvalidCreditOperation.Wrapf("invalid to_tenant address: %s", err)
+	}
+
+	if m.FromTenant == m.ToTenant {
+		return ErrInvalidCreditOperation.Wrap("from_tenant and to_tenant must differ")
+	}
+
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return ErrInvalidCreditOperation.Wrap("amount must be positive")
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation for MsgUpdateLeaseItems.
+// The item list follows the same rules as lease creation (non-zero quantities,
+// unique addressing key, consistent service_name mode); matching the entries
+// against the lease's existing items happens in the keeper.
+func (m *MsgUpdateLeaseItems) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Tenant); err != nil {
+		return ErrInvalidLease.Wrapf("invalid tenant address: %s", err)
+	}
+
+	if err := validateLeaseUUID(m.LeaseUuid); err != nil {
+		return err
+	}
+
+	return ValidateLeaseItems(m.Items)
+}
+
+// validateLeaseUUID checks a single lease_uuid message field.
+func validateLeaseUUID(leaseUUID string) error {
+	if leaseUUID == "" {
+		return ErrInvalidLease.Wrap("lease_uuid cannot be empty")
+	}
+	if !pkguuid.IsValidUUID(leaseUUID) {
+		return ErrInvalidLease.Wrapf("invalid lease_uuid format: %s", leaseUUID)
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation for MsgProposeLeaseAmendment.
+// Added items follow the lease-creation rules on their own; whether they fit
+// the lease's service_name mode, and whether the removal keys exist, is
+// checked in the keeper against the stored lease.
+func (m *MsgProposeLeaseAmendment) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Tenant); err != nil {
+		return ErrInvalidLease.Wrapf("invalid tenant address: %s", err)
+	}
+
+	if err := validateLeaseUUID(m.LeaseUuid); err != nil {
+		return err
+	}
+
+	if len(m.AddItems) == 0 && len(m.RemoveItems) == 0 {
+		return ErrInvalidRequest.Wrap("amendment must add or remove at least one item")
+	}
+
+	if len(m.AddItems) > 0 {
+		if err := ValidateLeaseItems(m.AddItems); err != nil {
+			return err
+		}
+	}
+
+	if len(m.RemoveItems) > MaxItemsPerLeaseHardLimit {
+		return ErrTooManyLeaseItems.Wrapf("amendment removes %d items, maximum allowed is %d", len(m.RemoveItems), MaxItemsPerLeaseHardLimit)
+	}
+	seen := make(map[string]bool, len(m.RemoveItems))
+	for i, key := range m.RemoveItems {
+		if !pkguuid.IsValidUUID(key) && !IsValidDNSLabel(key) {
+			return ErrInvalidRequest.Wrapf("remove_items[%d] %q is neither a sku_uuid nor a service_name", i, key)
+		}
+		if seen[key] {
+			return ErrInvalidRequest.Wrapf("remove_items[%d] %q is listed more than once", i, key)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation for MsgAcknowledgeLeaseAmendment.
+func (m *MsgAcknowledgeLeaseAmendment) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return ErrUnauthorized.Wrapf("invalid sender address: %s", err)
+	}
+
+	return validateLeaseUUID(m.LeaseUuid)
+}
+
+// ValidateBasic performs basic validation for MsgRejectLeaseAmendment.
+func (m *MsgRejectLeaseAmendment) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return ErrUnauthorized.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := validateLeaseUUID(m.LeaseUuid); err != nil {
+		return err
+	}
+
+	if len(m.Reason) > MaxRejectionReasonLength {
+		return ErrInvalidRejectionReason.Wrapf("reason exceeds maximum length of %d characters", MaxRejectionReasonLength)
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation for MsgSetLeaseScheduledEnd.
+// Whether the time is in the future and later than the current end is checked
+// in the keeper against block time and the stored lease.
+func (m *MsgSetLeaseScheduledEnd) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Tenant); err != nil {
+		return ErrInvalidLease.Wrapf("invalid tenant address: %s", err)
+	}
+
+	if err := validateLeaseUUID(m.LeaseUuid); err != nil {
+		return err
+	}
+
+	if m.ScheduledEndAt.IsZero() {
+		return ErrInvalidLease.Wrap("scheduled_end_at cannot be empty")
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation for MsgReportUsage. Whether the
+// sender is the lease's provider, whether each report addresses a metered item
+// and whether the counters only grow are checked in the keeper.
+func (m *MsgReportUsage) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return ErrUnauthorized.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := validateLeaseUUID(m.LeaseUuid); err != nil {
+		return err
+	}
+
+	if len(m.Usages) == 0 {
+		return ErrInvalidRequest.Wrap("usages cannot be empty")
+	}
+	if len(m.Usages) > MaxItemsPerLeaseHardLimit {
+		return ErrTooManyLeaseItems.Wrapf("report has %d usages, maximum allowed is %d", len(m.Usages), MaxItemsPerLeaseHardLimit)
+	}
+
+	seen := make(map[string]bool, len(m.Usages))
+	for i, usage := range m.Usages {
+		if !pkguuid.IsValidUUID(usage.SkuUuid) {
+			return ErrInvalidRequest.Wrapf("usages[%d] has invalid sku_uuid format: %s", i, usage.SkuUuid)
+		}
+		key := usage.SkuUuid
+		if usage.ServiceName != "" {
+			if !IsValidDNSLabel(usage.ServiceName) {
+				return ErrInvalidServiceName.Wrapf("usages[%d] has invalid service_name %q", i, usage.ServiceName)
+			}
+			key = usage.ServiceName
+		}
+		if seen[key] {
+			return ErrInvalidRequest.Wrapf("usages[%d] %q is reported more than once", i, key)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation for MsgSetUsageSpendLimit.
+func (m *MsgSetUsageSpendLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Tenant); err != nil {
+		return ErrInvalidLease.Wrapf("invalid tenant address: %s", err)
+	}
+
+	if err := validateLeaseUUID(m.LeaseUuid); err != nil {
+		return err
+	}
+
+	if !m.SpendLimit.IsValid() {
+		return ErrInvalidRequest.Wrapf("invalid spend_limit: %s", m.SpendLimit)
+	}
+
+	if m.Period < MinUsageSpendPeriod || m.Period > MaxUsageSpendPeriod {
+		return ErrInvalidRequest.Wrapf("period %d must be between %d and %d seconds", m.Period, MinUsageSpendPeriod, MaxUsageSpendPeriod)
+	}
+
+	return nil
+}
+
+// validateDisputeText checks a dispute reason or response: required and at
+// most MaxDisputeReasonLength characters.
+func validateDisputeText(field, text string) error {
+	if strings.TrimSpace(text) == "" {
+		return ErrInvalidDisputeReason.Wrapf("%s cannot be empty", field)
+	}
+	if len(text) > MaxDisputeReasonLength {
+		return ErrInvalidDisputeReason.Wrapf("%s exceeds maximum length of %d characters", field, MaxDisputeReasonLength)
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation for MsgOpenDispute.
+func (m *MsgOpenDispute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Tenant); err != nil {
+		return ErrInvalidLease.Wrapf("invalid tenant address: %s", err)
+	}
+
+	if err := validateLeaseUUID(m.LeaseUuid); err != nil {
+		return err
+	}
+
+	return validateDisputeText("reason", m.Reason)
+}
+
+// ValidateBasic performs basic validation for MsgRespondToDispute.
+func (m *MsgRespondToDispute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return ErrUnauthorized.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := validateLeaseUUID(m.LeaseUuid); err != nil {
+		return err
+	}
+
+	return validateDisputeText("response", m.Response)
+}
+
+// ValidateBasic performs basic validation for MsgResolveDispute.
+func (m *MsgResolveDispute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return ErrUnauthorized.Wrapf("invalid authority address: %s", err)
+	}
+
+	if err := validateLeaseUUID(m.LeaseUuid); err != nil {
+		return err
+	}
+
+	if m.TenantShare.IsNil() || m.TenantShare.IsNegative() || m.TenantShare.GT(sdkmath.LegacyOneDec()) {
+		return ErrInvalidRequest.Wrapf("tenant_share %s must be between 0 and 1", m.TenantShare)
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation for MsgSetProviderPolicy. Whether
+// the sender may act for the provider is checked in the keeper.
+func (m *MsgSetProviderPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return ErrUnauthorized.Wrapf("invalid sender address: %s", err)
+	}
+
+	if m.Reset_ {
+		if !pkguuid.IsValidUUID(m.Policy.ProviderUuid) {
+			return ErrProviderNotFound.Wrapf("invalid provider_uuid format: %s", m.Policy.ProviderUuid)
+		}
+		return nil
+	}
+
+	return m.Policy.Validate()
+}
+
+// ValidateBasic performs basic validation for MsgGrantPromoCredit. Whether
+// expires_at lies in the future is checked in the keeper against block time.
+func (m *MsgGrantPromoCredit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return ErrUnauthorized.Wrapf("invalid authority address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Tenant); err != nil {
+		return ErrInvalidPromoGrant.Wrapf("invalid tenant address: %s", err)
+	}
+
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return ErrInvalidPromoGrant.Wrap("amount must be positive")
+	}
+
+	if m.ExpiresAt.IsZero() {
+		return ErrInvalidPromoGrant.Wrap("expires_at must be set")
+	}
+
+	return ValidatePromoRestrictions(m.ProviderUuids, m.SkuUuids)
+}
+
+// ValidateBasic performs basic validation for MsgSetExchangeRates. Whether
+// sender is a price feeder, and the rates' windows against block time and the
+// reference denom, are checked in the keeper.
+func (m *MsgSetExchangeRates) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return ErrUnauthorized.Wrapf("invalid sender address: %s", err)
+	}
+
+	if len(m.Rates) == 0 {
+		return ErrInvalidExchangeRate.Wrap("rates cannot be empty")
+	}
+	if len(m.Rates) > MaxExchangeRates {
+		return ErrInvalidExchangeRate.Wrapf("%d rates exceed the maximum of %d", len(m.Rates), MaxExchangeRates)
+	}
+
+	seen := make(map[string]bool, len(m.Rates))
+	for _, rate := range m.Rates {
+		if err := rate.validate(); err != nil {
+			return err
+		}
+		if seen[rate.Denom] {
+			return ErrInvalidExchangeRate.Wrapf("duplicate denom %s", rate.Denom)
+		}
+		seen[rate.Denom] = true
+	}
+
+	return nil
+}
@@ -40,6 +40,43 @@ const MaxPendingLeasesPerTenantUpperBound = uint64(1_000)
 // MaxMinLeaseDuration is the maximum allowed value for min_lease_duration (30 days).
 const MaxMinLeaseDuration = uint64(30 * 24 * 3600)
 
+// DefaultCreditWithdrawalCooldown is the default notice period in seconds between the
+// last FundCredit and a MsgWithdrawCredit. Set to 86400 (24 hours).
+const DefaultCreditWithdrawalCooldown = uint64(86400)
+
+// MaxCreditWithdrawalCooldown is the maximum allowed credit_withdrawal_cooldown (30 days).
+const MaxCreditWithdrawalCooldown = uint64(30 * 24 * 3600)
+
+// DefaultGracePeriod is the default grace period in seconds between credit
+// exhaustion and lease closure. Zero closes leases as soon as credit runs out.
+const DefaultGracePeriod = uint64(0)
+
+// MaxGracePeriod is the maximum allowed grace_period, module-wide or per provider (30 days).
+const MaxGracePeriod = uint64(30 * 24 * 3600)
+
+// MinUsageSpendPeriod is the shortest usage spend period a tenant may set (1 hour).
+const MinUsageSpendPeriod = uint64(3600)
+
+// MaxUsageSpendPeriod is the longest usage spend period a tenant may set (365 days).
+const MaxUsageSpendPeriod = uint64(365 * 24 * 3600)
+
+// DefaultLeaseRetentionPeriod is the default time in seconds terminal leases
+// are kept in state. Zero keeps them forever.
+const DefaultLeaseRetentionPeriod = uint64(0)
+
+// MaxLeaseRetentionPeriod is the maximum allowed lease_retention_period (10 years).
+const MaxLeaseRetentionPeriod = uint64(10 * 365 * 24 * 3600)
+
+// DefaultDisputeResponsePeriod is the default time in seconds a provider has
+// to respond to a dispute. Set to 259200 (3 days).
+const DefaultDisputeResponsePeriod = uint64(3 * 24 * 3600)
+
+// MinDisputeResponsePeriod is the minimum allowed dispute_response_period (1 hour).
+const MinDisputeResponsePeriod = uint64(3600)
+
+// MaxDisputeResponsePeriod is the maximum allowed dispute_response_period (30 days).
+const MaxDisputeResponsePeriod = uint64(30 * 24 * 3600)
+
 // DefaultParams returns the default billing module parameters.
 // ReservedDomainSuffixes is intentionally empty — operators are expected to
 // seed provider wildcard zones via genesis JSON for new chains, or via
@@ -54,12 +91,17 @@ func DefaultParams() Params {
 		MaxPendingLeasesPerTenant: DefaultMaxPendingLeasesPerTenant,
 		PendingTimeout:            DefaultPendingTimeout,
 		ReservedDomainSuffixes:    nil,
+		CreditWithdrawalCooldown:  DefaultCreditWithdrawalCooldown,
+		GracePeriod:               DefaultGracePeriod,
+		LeaseRetentionPeriod:      DefaultLeaseRetentionPeriod,
+		DisputeResponsePeriod:     DefaultDisputeResponsePeriod,
 	}
 }
 
 // NewParams creates a new Params instance using the v1 field set only.
-// ReservedDomainSuffixes (introduced in v2) is left at its zero value (nil);
-// callers who need to populate it should mutate the returned struct directly.
+// Fields introduced later (ReservedDomainSuffixes, CreditWithdrawalCooldown,
+// GracePeriod, MaxProviderGracePeriod, LeaseRetentionPeriod, DisputeResponsePeriod, ReferenceDenom,
+// PriceFeeders) are left at their zero values; callers who need to populate them should mutate the returned struct directly.
 // Kept signature-stable to avoid touching the ~30 existing test callers.
 func NewParams(maxLeasesPerTenant uint64, allowedList []string, maxItemsPerLease uint64, minLeaseDuration uint64, maxPendingLeasesPerTenant uint64, pendingTimeout uint64) Params {
 	return Params{
@@ -114,6 +156,30 @@ func (p *Params) Validate() error {
 		return ErrInvalidParams.Wrapf("pending_timeout must be at most %d seconds (24 hours)", MaxPendingTimeout)
 	}
 
+	if p.CreditWithdrawalCooldown > MaxCreditWithdrawalCooldown {
+		return ErrInvalidParams.Wrapf("credit_withdrawal_cooldown %d exceeds upper bound of %d seconds (30 days)", p.CreditWithdrawalCooldown, MaxCreditWithdrawalCooldown)
+	}
+
+	if p.GracePeriod > MaxGracePeriod {
+		return ErrInvalidParams.Wrapf("grace_period %d exceeds upper bound of %d seconds (30 days)", p.GracePeriod, MaxGracePeriod)
+	}
+
+	if p.MaxProviderGracePeriod > MaxGracePeriod {
+		return ErrInvalidParams.Wrapf("max_provider_grace_period %d exceeds upper bound of %d seconds (30 days)", p.MaxProviderGracePeriod, MaxGracePeriod)
+	}
+
+	if p.LeaseRetentionPeriod > MaxLeaseRetentionPeriod {
+		return ErrInvalidParams.Wrapf("lease_retention_period %d exceeds upper bound of %d seconds (10 years)", p.LeaseRetentionPeriod, MaxLeaseRetentionPeriod)
+	}
+
+	if p.DisputeResponsePeriod != 0 && p.DisputeResponsePeriod < MinDisputeResponsePeriod {
+		return ErrInvalidParams.Wrapf("dispute_response_period must be at least %d seconds (1 hour)", MinDisputeResponsePeriod)
+	}
+
+	if p.DisputeResponsePeriod > MaxDisputeResponsePeriod {
+		return ErrInvalidParams.Wrapf("dispute_response_period %d exceeds upper bound of %d seconds (30 days)", p.DisputeResponsePeriod, MaxDisputeResponsePeriod)
+	}
+
 	// Validate allowed list addresses
 	seen := make(map[string]bool)
 	for _, addr := range p.AllowedList {
@@ -126,6 +192,23 @@ func (p *Params) Validate() error {
 		seen[addr] = true
 	}
 
+	if p.ReferenceDenom != "" {
+		if err := sdk.ValidateDenom(p.ReferenceDenom); err != nil {
+			return ErrInvalidParams.Wrapf("invalid reference_denom %q: %s", p.ReferenceDenom, err)
+		}
+	}
+
+	seenFeeder := make(map[string]bool)
+	for _, addr := range p.PriceFeeders {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return ErrInvalidParams.Wrapf("invalid address in price feeders: %s", addr)
+		}
+		if seenFeeder[addr] {
+			return ErrInvalidParams.Wrapf("duplicate address in price feeders: %s", addr)
+		}
+		seenFeeder[addr] = true
+	}
+
 	// Validate reserved domain suffixes: each entry must start with '.' and
 	// the substring after the dot must be a valid FQDN. Duplicates rejected.
 	seenSuffix := make(map[string]bool)
@@ -149,3 +232,8 @@ func (p *Params) Validate() error {
 func (p Params) IsAllowed(addr string) bool {
 	return slices.Contains(p.AllowedList, addr)
 }
+
+// IsPriceFeeder checks if an address is in the price feeders list.
+func (p Params) IsPriceFeeder(addr string) bool {
+	return slices.Contains(p.PriceFeeders, addr)
+}
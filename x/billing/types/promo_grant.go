@@ -0,0 +1,85 @@
+package types
+
+import (
+	"slices"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	pkguuid "github.com/manifest-network/manifest-ledger/pkg/uuid"
+)
+
+// Validate checks that a PromoGrant is well formed: valid tenant and granter
+// addresses, a positive amount, a remaining amount within it, an expiry after
+// its creation and valid restriction lists.
+func (g PromoGrant) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(g.Tenant); err != nil {
+		return ErrInvalidPromoGrant.Wrapf("grant %d has invalid tenant address: %s", g.Id, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(g.Granter); err != nil {
+		return ErrInvalidPromoGrant.Wrapf("grant %d has invalid granter address: %s", g.Id, err)
+	}
+	if !g.Amount.IsValid() || g.Amount.IsZero() {
+		return ErrInvalidPromoGrant.Wrapf("grant %d amount must be positive", g.Id)
+	}
+	if !g.Remaining.IsValid() || !g.Remaining.IsAllLTE(g.Amount) {
+		return ErrInvalidPromoGrant.Wrapf("grant %d remaining %s is not within amount %s", g.Id, g.Remaining, g.Amount)
+	}
+	if !g.ExpiresAt.After(g.CreatedAt) {
+		return ErrInvalidPromoGrant.Wrapf("grant %d expires at or before its creation", g.Id)
+	}
+	return ValidatePromoRestrictions(g.ProviderUuids, g.SkuUuids)
+}
+
+// ValidatePromoRestrictions checks the provider and SKU lists of a promo
+// grant: valid, unique UUIDs, at most MaxPromoGrantRestrictions each.
+func ValidatePromoRestrictions(providerUUIDs, skuUUIDs []string) error {
+	if err := validatePromoUUIDs("provider_uuids", providerUUIDs); err != nil {
+		return err
+	}
+	return validatePromoUUIDs("sku_uuids", skuUUIDs)
+}
+
+// validatePromoUUIDs checks one restriction list of a promo grant.
+func validatePromoUUIDs(field string, uuids []string) error {
+	if len(uuids) > MaxPromoGrantRestrictions {
+		return ErrInvalidPromoGrant.Wrapf("%s has %d entries, maximum is %d", field, len(uuids), MaxPromoGrantRestrictions)
+	}
+
+	seen := make(map[string]bool, len(uuids))
+	for _, uuid := range uuids {
+		if !pkguuid.IsValidUUID(uuid) {
+			return ErrInvalidPromoGrant.Wrapf("invalid UUID %q in %s", uuid, field)
+		}
+		if seen[uuid] {
+			return ErrInvalidPromoGrant.Wrapf("duplicate UUID %s in %s", uuid, field)
+		}
+		seen[uuid] = true
+	}
+	return nil
+}
+
+// IsExpired reports whether the grant can no longer be spent at t.
+func (g PromoGrant) IsExpired(t time.Time) bool {
+	return !t.Before(g.ExpiresAt)
+}
+
+// AppliesTo reports whether the grant may pay for an item of skuUUID on a
+// lease of providerUUID. An empty restriction list matches everything.
+func (g PromoGrant) AppliesTo(providerUUID, skuUUID string) bool {
+	if len(g.ProviderUuids) > 0 && !slices.Contains(g.ProviderUuids, providerUUID) {
+		return false
+	}
+	return len(g.SkuUuids) == 0 || slices.Contains(g.SkuUuids, skuUUID)
+}
+
+// AppliesToLease reports whether the grant may pay for at least one
+// time-billed item of lease.
+func (g PromoGrant) AppliesToLease(lease Lease) bool {
+	for _, item := range lease.Items {
+		if !item.Metered && g.AppliesTo(lease.ProviderUuid, item.SkuUuid) {
+			return true
+		}
+	}
+	return false
+}
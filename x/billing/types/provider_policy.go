@@ -0,0 +1,79 @@
+package types
+
+import (
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	pkguuid "github.com/manifest-network/manifest-ledger/pkg/uuid"
+)
+
+// Validate checks that a ProviderPolicy is well formed: a valid provider UUID,
+// a pending timeout of zero or within [MinPendingTimeout, MaxPendingTimeout],
+// a grace period of at most MaxGracePeriod that is zero unless it overrides
+// params, and tenant lists of valid, unique addresses, at most
+// MaxPolicyTenants each, with no address on both.
+func (p ProviderPolicy) Validate() error {
+	if !pkguuid.IsValidUUID(p.ProviderUuid) {
+		return ErrProviderNotFound.Wrapf("invalid provider_uuid format: %s", p.ProviderUuid)
+	}
+
+	if p.PendingTimeout != 0 && (p.PendingTimeout < MinPendingTimeout || p.PendingTimeout > MaxPendingTimeout) {
+		return ErrInvalidProviderPolicy.Wrapf("pending_timeout %d must be 0 or between %d and %d seconds",
+			p.PendingTimeout, MinPendingTimeout, MaxPendingTimeout)
+	}
+
+	if !p.OverrideGracePeriod && p.GracePeriod != 0 {
+		return ErrInvalidProviderPolicy.Wrap("grace_period must be 0 unless override_grace_period is set")
+	}
+	if p.GracePeriod > MaxGracePeriod {
+		return ErrInvalidProviderPolicy.Wrapf("grace_period %d exceeds upper bound of %d seconds (30 days)", p.GracePeriod, MaxGracePeriod)
+	}
+
+	allowed, err := validatePolicyTenants("allowed_tenants", p.AllowedTenants)
+	if err != nil {
+		return err
+	}
+	denied, err := validatePolicyTenants("denied_tenants", p.DeniedTenants)
+	if err != nil {
+		return err
+	}
+	for tenant := range denied {
+		if allowed[tenant] {
+			return ErrInvalidProviderPolicy.Wrapf("tenant %s is both allowed and denied", tenant)
+		}
+	}
+
+	return nil
+}
+
+// validatePolicyTenants checks one tenant list of a ProviderPolicy and returns
+// its addresses as a set.
+func validatePolicyTenants(field string, tenants []string) (map[string]bool, error) {
+	if len(tenants) > MaxPolicyTenants {
+		return nil, ErrInvalidProviderPolicy.Wrapf("%s has %d addresses, maximum is %d", field, len(tenants), MaxPolicyTenants)
+	}
+
+	seen := make(map[string]bool, len(tenants))
+	for _, tenant := range tenants {
+		if _, err := sdk.AccAddressFromBech32(tenant); err != nil {
+			return nil, ErrInvalidProviderPolicy.Wrapf("invalid address %q in %s: %s", tenant, field, err)
+		}
+		if seen[tenant] {
+			return nil, ErrInvalidProviderPolicy.Wrapf("duplicate address %s in %s", tenant, field)
+		}
+		seen[tenant] = true
+	}
+
+	return seen, nil
+}
+
+// AllowsTenant reports whether the policy lets tenant create leases with the
+// provider. A tenant on denied_tenants is refused; when allowed_tenants is not
+// empty, only the tenants on it are accepted.
+func (p ProviderPolicy) AllowsTenant(tenant string) bool {
+	if slices.Contains(p.DeniedTenants, tenant) {
+		return false
+	}
+	return len(p.AllowedTenants) == 0 || slices.Contains(p.AllowedTenants, tenant)
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	pkguuid "github.com/manifest-network/manifest-ledger/pkg/uuid"
+)
+
+// ackLatencyBucketBounds are the upper bounds, in seconds, of the
+// acknowledgement latency histogram kept in ProviderStats. A final bucket
+// counts latencies above the last bound. Changing the bounds requires a
+// migration that rebuckets the stored histograms.
+var ackLatencyBucketBounds = [...]uint64{10, 30, 60, 300, 900, 1800, 3600, 21600}
+
+// AckLatencyBucketCount is the number of buckets in a ProviderStats latency
+// histogram: one per bound and a final unbounded one.
+const AckLatencyBucketCount = len(ackLatencyBucketBounds) + 1
+
+// AckLatencyBucketBounds returns a copy of the upper bounds, in seconds, of
+// the acknowledgement latency histogram, without the final unbounded bucket.
+func AckLatencyBucketBounds() []uint64 {
+	bounds := ackLatencyBucketBounds
+	return bounds[:]
+}
+
+// NewProviderStats returns empty counters for a provider.
+func NewProviderStats(providerUUID string) ProviderStats {
+	return ProviderStats{
+		ProviderUuid:      providerUUID,
+		AckLatencyBuckets: make([]uint64, AckLatencyBucketCount),
+	}
+}
+
+// RecordAcknowledgement counts an acknowledgement that came latency seconds
+// after the lease was created. Acknowledgements in the creation block, such
+// as those made by a provider policy, are counted apart from the latency
+// histogram. Returns an error if the stored histogram does not have
+// AckLatencyBucketCount buckets, rather than dropping its counts.
+func (s *ProviderStats) RecordAcknowledgement(latency uint64, atCreation bool) error {
+	if len(s.AckLatencyBuckets) != AckLatencyBucketCount {
+		return ErrInvalidRequest.Wrapf("provider stats for %s have %d latency buckets, expected %d",
+			s.ProviderUuid, len(s.AckLatencyBuckets), AckLatencyBucketCount)
+	}
+
+	s.LeasesAcknowledged++
+	if atCreation {
+		s.LeasesAcknowledgedAtCreation++
+		return nil
+	}
+
+	bucket := len(ackLatencyBucketBounds)
+	for i, bound := range ackLatencyBucketBounds {
+		if latency <= bound {
+			bucket = i
+			break
+		}
+	}
+	s.AckLatencySum += latency
+	s.AckLatencyBuckets[bucket]++
+	return nil
+}
+
+// RecordClosure counts the closure of a lease that was ACTIVE for lifetime
+// seconds.
+func (s *ProviderStats) RecordClosure(lifetime uint64, creditExhausted bool) {
+	s.LeasesClosed++
+	s.LifetimeSum += lifetime
+	if creditExhausted {
+		s.CreditExhaustionClosures++
+	}
+}
+
+// Validate checks that the counters are consistent with each other.
+func (s ProviderStats) Validate() error {
+	if !pkguuid.IsValidUUID(s.ProviderUuid) {
+		return ErrProviderNotFound.Wrapf("provider stats have invalid provider_uuid: %s", s.ProviderUuid)
+	}
+
+	if len(s.AckLatencyBuckets) != AckLatencyBucketCount {
+		return ErrInvalidRequest.Wrapf("provider stats for %s have %d latency buckets, expected %d",
+			s.ProviderUuid, len(s.AckLatencyBuckets), AckLatencyBucketCount)
+	}
+	if s.LeasesAcknowledgedAtCreation > s.LeasesAcknowledged {
+		return ErrInvalidRequest.Wrapf("provider stats for %s have more acknowledgements at creation than acknowledgements", s.ProviderUuid)
+	}
+	var bucketed uint64
+	for _, n := range s.AckLatencyBuckets {
+		bucketed += n
+	}
+	if bucketed != s.LeasesAcknowledged-s.LeasesAcknowledgedAtCreation {
+		return ErrInvalidRequest.Wrapf("provider stats for %s bucket %d acknowledgements but count %d after creation",
+			s.ProviderUuid, bucketed, s.LeasesAcknowledged-s.LeasesAcknowledgedAtCreation)
+	}
+
+	if s.CreditExhaustionClosures > s.LeasesClosed {
+		return ErrInvalidRequest.Wrapf("provider stats for %s have more credit exhaustion closures than closures", s.ProviderUuid)
+	}
+
+	return nil
+}
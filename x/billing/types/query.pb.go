@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -13,19 +14,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -617,6 +622,15 @@ type QueryCreditAccountResponse struct {
 	// available_balances is the amount available for new leases (balances - reserved_amounts).
 	// This is what can be used to create new leases without overbooking.
 	AvailableBalances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=available_balances,json=availableBalances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"available_balances"`
+	// debt is the total unpaid debt of the tenant's leases, accrued during
+	// grace periods. It is paid first out of the next MsgFundCredit.
+	Debt github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=debt,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"debt"`
+	// promo_balances is the unexpired promotional credit of the tenant, held
+	// apart from balances. It is spent before balances but never counts
+	// towards available_balances.
+	PromoBalances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=promo_balances,json=promoBalances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"promo_balances"`
+	// promo_grants are the tenant's unexpired promotional credit grants.
+	PromoGrants []PromoGrant `protobuf:"bytes,6,rep,name=promo_grants,json=promoGrants,proto3" json:"promo_grants"`
 }
 
 func (m *QueryCreditAccountResponse) Reset()         { *m = QueryCreditAccountResponse{} }
@@ -673,6 +687,27 @@ func (m *QueryCreditAccountResponse) GetAvailableBalances() github_com_cosmos_co
 	return nil
 }
 
+func (m *QueryCreditAccountResponse) GetDebt() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Debt
+	}
+	return nil
+}
+
+func (m *QueryCreditAccountResponse) GetPromoBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PromoBalances
+	}
+	return nil
+}
+
+func (m *QueryCreditAccountResponse) GetPromoGrants() []PromoGrant {
+	if m != nil {
+		return m.PromoGrants
+	}
+	return nil
+}
+
 // QueryCreditAddressRequest is the request type for the Query/CreditAddress RPC
 // method.
 type QueryCreditAddressRequest struct {
@@ -1260,14 +1295,23 @@ func (m *QueryCreditEstimateRequest) GetTenant() string {
 type QueryCreditEstimateResponse struct {
 	// current_balance is the tenant's current credit balance.
 	CurrentBalance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=current_balance,json=currentBalance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"current_balance"`
-	// total_rate_per_second is the combined rate of all active leases (per denom).
-	TotalRatePerSecond github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_rate_per_second,json=totalRatePerSecond,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_rate_per_second"`
+	// total_rate_per_second is total_rate_per_second_dec rounded up to whole
+	// units per denom. Deprecated: use total_rate_per_second_dec, which is
+	// exact for sub-unit rates.
+	TotalRatePerSecond github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_rate_per_second,json=totalRatePerSecond,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_rate_per_second"` // Deprecated: Do not use.
 	// estimated_duration_seconds is the estimated time until credit exhaustion.
-	// Calculated as min(balance[denom] / rate[denom]) across all denoms.
-	// Returns 0 if no active leases or no matching denoms.
+	// Calculated as min((balance[denom] + promo[denom]) / rate[denom]) across
+	// all denoms. Returns 0 if no active leases or no matching denoms.
 	EstimatedDurationSeconds uint64 `protobuf:"varint,3,opt,name=estimated_duration_seconds,json=estimatedDurationSeconds,proto3" json:"estimated_duration_seconds,omitempty,string"`
 	// active_lease_count is the number of active leases for this tenant.
 	ActiveLeaseCount uint64 `protobuf:"varint,4,opt,name=active_lease_count,json=activeLeaseCount,proto3" json:"active_lease_count,omitempty,string"`
+	// promo_balance is the unexpired promotional credit that can pay for the
+	// active leases, spent before current_balance. Grants restricted to other
+	// providers or SKUs are left out.
+	PromoBalance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=promo_balance,json=promoBalance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"promo_balance"`
+	// total_rate_per_second_dec is the combined rate of all active leases (per
+	// denom), exact to the locked_rate of their items.
+	TotalRatePerSecondDec github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,6,rep,name=total_rate_per_second_dec,json=totalRatePerSecondDec,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"total_rate_per_second_dec"`
 }
 
 func (m *QueryCreditEstimateResponse) Reset()         { *m = QueryCreditEstimateResponse{} }
@@ -1310,6 +1354,7 @@ func (m *QueryCreditEstimateResponse) GetCurrentBalance() github_com_cosmos_cosm
 	return nil
 }
 
+// Deprecated: Do not use.
 func (m *QueryCreditEstimateResponse) GetTotalRatePerSecond() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.TotalRatePerSecond
@@ -1331,6 +1376,20 @@ func (m *QueryCreditEstimateResponse) GetActiveLeaseCount() uint64 {
 	return 0
 }
 
+func (m *QueryCreditEstimateResponse) GetPromoBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PromoBalance
+	}
+	return nil
+}
+
+func (m *QueryCreditEstimateResponse) GetTotalRatePerSecondDec() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.TotalRatePerSecondDec
+	}
+	return nil
+}
+
 // QueryLeaseByCustomDomainRequest is the request type for the
 // Query/LeaseByCustomDomain RPC method.
 type QueryLeaseByCustomDomainRequest struct {
@@ -1435,2174 +1494,8378 @@ func (m *QueryLeaseByCustomDomainResponse) GetServiceName() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "liftedinit.billing.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "liftedinit.billing.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryLeaseRequest)(nil), "liftedinit.billing.v1.QueryLeaseRequest")
-	proto.RegisterType((*QueryLeaseResponse)(nil), "liftedinit.billing.v1.QueryLeaseResponse")
-	proto.RegisterType((*QueryLeasesRequest)(nil), "liftedinit.billing.v1.QueryLeasesRequest")
-	proto.RegisterType((*QueryLeasesResponse)(nil), "liftedinit.billing.v1.QueryLeasesResponse")
-	proto.RegisterType((*QueryLeasesByTenantRequest)(nil), "liftedinit.billing.v1.QueryLeasesByTenantRequest")
-	proto.RegisterType((*QueryLeasesByTenantResponse)(nil), "liftedinit.billing.v1.QueryLeasesByTenantResponse")
-	proto.RegisterType((*QueryLeasesByProviderRequest)(nil), "liftedinit.billing.v1.QueryLeasesByProviderRequest")
-	proto.RegisterType((*QueryLeasesByProviderResponse)(nil), "liftedinit.billing.v1.QueryLeasesByProviderResponse")
-	proto.RegisterType((*QueryCreditAccountRequest)(nil), "liftedinit.billing.v1.QueryCreditAccountRequest")
-	proto.RegisterType((*QueryCreditAccountResponse)(nil), "liftedinit.billing.v1.QueryCreditAccountResponse")
-	proto.RegisterType((*QueryCreditAddressRequest)(nil), "liftedinit.billing.v1.QueryCreditAddressRequest")
-	proto.RegisterType((*QueryCreditAddressResponse)(nil), "liftedinit.billing.v1.QueryCreditAddressResponse")
-	proto.RegisterType((*QueryWithdrawableAmountRequest)(nil), "liftedinit.billing.v1.QueryWithdrawableAmountRequest")
-	proto.RegisterType((*QueryWithdrawableAmountResponse)(nil), "liftedinit.billing.v1.QueryWithdrawableAmountResponse")
-	proto.RegisterType((*QueryProviderWithdrawableRequest)(nil), "liftedinit.billing.v1.QueryProviderWithdrawableRequest")
-	proto.RegisterType((*QueryProviderWithdrawableResponse)(nil), "liftedinit.billing.v1.QueryProviderWithdrawableResponse")
-	proto.RegisterType((*QueryCreditAccountsRequest)(nil), "liftedinit.billing.v1.QueryCreditAccountsRequest")
-	proto.RegisterType((*QueryCreditAccountsResponse)(nil), "liftedinit.billing.v1.QueryCreditAccountsResponse")
-	proto.RegisterType((*QueryLeasesBySKURequest)(nil), "liftedinit.billing.v1.QueryLeasesBySKURequest")
-	proto.RegisterType((*QueryLeasesBySKUResponse)(nil), "liftedinit.billing.v1.QueryLeasesBySKUResponse")
-	proto.RegisterType((*QueryCreditEstimateRequest)(nil), "liftedinit.billing.v1.QueryCreditEstimateRequest")
-	proto.RegisterType((*QueryCreditEstimateResponse)(nil), "liftedinit.billing.v1.QueryCreditEstimateResponse")
-	proto.RegisterType((*QueryLeaseByCustomDomainRequest)(nil), "liftedinit.billing.v1.QueryLeaseByCustomDomainRequest")
-	proto.RegisterType((*QueryLeaseByCustomDomainResponse)(nil), "liftedinit.billing.v1.QueryLeaseByCustomDomainResponse")
+// QueryAvailableCreditRequest is the request type for the Query/AvailableCredit
+// RPC method.
+type QueryAvailableCreditRequest struct {
+	// tenant is the address of the tenant.
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
 }
 
-func init() { proto.RegisterFile("liftedinit/billing/v1/query.proto", fileDescriptor_7b5cf57ecc9d060b) }
-
-var fileDescriptor_7b5cf57ecc9d060b = []byte{
-	// 1682 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x6f, 0x13, 0x47,
-	0x1b, 0xcf, 0x3a, 0x1f, 0x84, 0x09, 0x09, 0x30, 0x04, 0x08, 0x26, 0xd8, 0x61, 0xdf, 0xf7, 0x85,
-	0x00, 0x89, 0x17, 0x07, 0x48, 0x90, 0xe0, 0x45, 0x6f, 0x9c, 0xc0, 0xab, 0xaa, 0xb4, 0x4a, 0x9d,
-	0x46, 0xa8, 0x1f, 0x92, 0x3b, 0xde, 0x9d, 0x38, 0xab, 0x78, 0x77, 0xcd, 0xce, 0x38, 0x34, 0x8a,
-	0x72, 0xe9, 0xa1, 0xa8, 0x1f, 0x52, 0x91, 0x90, 0xda, 0x63, 0x2f, 0x5c, 0x5a, 0x21, 0xd4, 0x43,
-	0xaf, 0xed, 0xa5, 0x52, 0xc5, 0xa9, 0xa0, 0xf6, 0xd2, 0x93, 0x5b, 0x41, 0x4f, 0xfe, 0x03, 0x7a,
-	0xae, 0x3c, 0xf3, 0xac, 0xbd, 0xeb, 0xcf, 0x75, 0x65, 0xa9, 0x70, 0xda, 0xf8, 0x99, 0xe7, 0xe3,
-	0x37, 0xbf, 0x67, 0xe6, 0x99, 0x67, 0x26, 0xe8, 0x64, 0xde, 0x5c, 0xe7, 0xd4, 0x30, 0x6d, 0x93,
-	0x6b, 0x59, 0x33, 0x9f, 0x37, 0xed, 0x9c, 0xb6, 0x95, 0xd4, 0x6e, 0x17, 0xa9, 0xbb, 0x9d, 0x28,
-	0xb8, 0x0e, 0x77, 0xf0, 0xe1, 0x9a, 0x4a, 0x02, 0x54, 0x12, 0x5b, 0xc9, 0xe8, 0x59, 0xdd, 0x61,
-	0x96, 0xc3, 0xb4, 0x2c, 0x61, 0x54, 0xea, 0x6b, 0x5b, 0xc9, 0x2c, 0xe5, 0x24, 0xa9, 0x15, 0x48,
-	0xce, 0xb4, 0x09, 0x37, 0x1d, 0x5b, 0xba, 0x88, 0xc6, 0xfc, 0xba, 0x9e, 0x96, 0xee, 0x98, 0xde,
-	0xf8, 0x31, 0x39, 0x9e, 0x11, 0xbf, 0x34, 0xf9, 0x03, 0x86, 0xc6, 0x73, 0x4e, 0xce, 0x91, 0xf2,
-	0xca, 0x5f, 0x20, 0x9d, 0xcc, 0x39, 0x4e, 0x2e, 0x4f, 0x35, 0x52, 0x30, 0x35, 0x62, 0xdb, 0x0e,
-	0x17, 0xd1, 0x3c, 0x9b, 0x16, 0x93, 0xe2, 0xdb, 0x05, 0x0a, 0x2a, 0xea, 0x38, 0xc2, 0x6f, 0x54,
-	0x30, 0xaf, 0x10, 0x97, 0x58, 0x2c, 0x4d, 0x6f, 0x17, 0x29, 0xe3, 0xea, 0xbb, 0xe8, 0x50, 0x40,
-	0xca, 0x0a, 0x8e, 0xcd, 0x28, 0xbe, 0x8e, 0x86, 0x0a, 0x42, 0x32, 0xa1, 0x4c, 0x29, 0xd3, 0x23,
-	0x73, 0x27, 0x12, 0x4d, 0x29, 0x49, 0x48, 0xb3, 0xd4, 0xd8, 0xe3, 0x52, 0xbc, 0xaf, 0x5c, 0x8a,
-	0x83, 0x51, 0x1a, 0xbe, 0xea, 0x4d, 0x74, 0x50, 0x78, 0xbf, 0x49, 0x09, 0xa3, 0x10, 0x12, 0x2f,
-	0x20, 0x94, 0xaf, 0xfc, 0xce, 0x14, 0x8b, 0xa6, 0x21, 0xfc, 0xef, 0x4d, 0x4d, 0x94, 0x4b, 0xf1,
-	0xf1, 0x9a, 0x74, 0xc6, 0xb1, 0x4c, 0x4e, 0xad, 0x02, 0xdf, 0x4e, 0xef, 0x15, 0xd2, 0xb5, 0xa2,
-	0x69, 0xa8, 0xb7, 0x60, 0x06, 0xe0, 0x0d, 0xa0, 0x2e, 0xa2, 0x41, 0xa1, 0x02, 0x48, 0x27, 0x5b,
-	0x20, 0x15, 0x46, 0xa9, 0x51, 0x00, 0x2a, 0x4d, 0xd2, 0xf2, 0xa3, 0x7e, 0xaf, 0xf8, 0x3d, 0x7b,
-	0xdc, 0xe0, 0x1b, 0x08, 0xd5, 0xf2, 0x0a, 0xee, 0x4f, 0x25, 0x20, 0x57, 0x95, 0xc4, 0x26, 0xe4,
-	0xa2, 0x81, 0xf4, 0x26, 0x56, 0x48, 0xce, 0x9b, 0x64, 0xda, 0x67, 0x89, 0xdf, 0x43, 0xfb, 0x18,
-	0x27, 0x9c, 0x66, 0xd6, 0xcd, 0x3c, 0xa7, 0xee, 0x44, 0x64, 0x4a, 0x99, 0x1e, 0x9b, 0x3b, 0xd9,
-	0x0e, 0xe8, 0x6a, 0x45, 0x3f, 0x15, 0x2d, 0x97, 0xe2, 0x47, 0xfc, 0xa6, 0x3e, 0x5e, 0x46, 0x84,
-	0xfc, 0x86, 0x10, 0xab, 0x0f, 0x14, 0x48, 0xa3, 0x37, 0x01, 0xe0, 0x66, 0x19, 0x0d, 0x89, 0x19,
-	0x56, 0xd2, 0xd8, 0xdf, 0x91, 0x9c, 0x6a, 0x16, 0xa5, 0x4d, 0x1a, 0xbe, 0xf8, 0xff, 0x01, 0x1e,
-	0x22, 0x82, 0x87, 0xd3, 0x1d, 0x79, 0x90, 0x10, 0xfc, 0x44, 0xa8, 0x9f, 0x46, 0x50, 0xd4, 0x07,
-	0x33, 0xb5, 0xfd, 0x26, 0xb5, 0x89, 0xcd, 0x3d, 0xbe, 0x97, 0xd1, 0x10, 0x17, 0x02, 0x58, 0x14,
-	0x33, 0xe5, 0x52, 0xfc, 0x80, 0x94, 0xd4, 0x26, 0xfe, 0xf3, 0xb7, 0xb3, 0xe3, 0x10, 0x7a, 0xd1,
-	0x30, 0x5c, 0xca, 0xd8, 0x2a, 0x77, 0x4d, 0x3b, 0x97, 0x06, 0xdb, 0xba, 0xac, 0x45, 0x7a, 0x96,
-	0xb5, 0xfe, 0x9e, 0x67, 0xed, 0xa1, 0x82, 0x8e, 0x37, 0xa5, 0xe3, 0xc5, 0xcc, 0xde, 0xc7, 0x11,
-	0x34, 0x19, 0x80, 0xbb, 0xe2, 0x3a, 0x5b, 0xa6, 0x41, 0x5d, 0x2f, 0x7f, 0xff, 0x43, 0xa3, 0x05,
-	0x10, 0xf9, 0xf7, 0xf6, 0xf1, 0x72, 0x29, 0x7e, 0x34, 0x30, 0xe0, 0x23, 0x64, 0x9f, 0x37, 0x50,
-	0xd9, 0xe1, 0x2f, 0x51, 0xee, 0x1e, 0x29, 0xe8, 0x44, 0x0b, 0x32, 0x5e, 0xcc, 0xec, 0x11, 0x74,
-	0x4c, 0xe0, 0x5d, 0x72, 0xa9, 0x61, 0xf2, 0x45, 0x5d, 0x77, 0x8a, 0x3d, 0xde, 0x79, 0xea, 0x47,
-	0xfd, 0xb0, 0xbd, 0xeb, 0x62, 0x00, 0x21, 0x3a, 0x1a, 0xd3, 0xc5, 0x40, 0x86, 0xc8, 0x11, 0x28,
-	0xa9, 0xff, 0x6e, 0x41, 0x4c, 0xc0, 0x4b, 0xea, 0x08, 0x10, 0x54, 0xe7, 0x23, 0x3d, 0xaa, 0xfb,
-	0xd5, 0x30, 0x43, 0xc3, 0x59, 0x92, 0x27, 0xb6, 0x4e, 0xd9, 0x44, 0x44, 0xf0, 0x7e, 0x2c, 0xc0,
-	0x96, 0xc7, 0xd3, 0x92, 0x63, 0xda, 0xa9, 0xab, 0xe0, 0xb3, 0x6a, 0xf2, 0xf5, 0x6f, 0xf1, 0xe9,
-	0x9c, 0xc9, 0x37, 0x8a, 0xd9, 0x84, 0xee, 0x58, 0x70, 0x2c, 0xc3, 0x67, 0x96, 0x19, 0x9b, 0x70,
-	0xa0, 0x56, 0x8c, 0x59, 0xba, 0x6a, 0x85, 0xef, 0x29, 0x08, 0x93, 0x2d, 0x62, 0xe6, 0x49, 0x36,
-	0x4f, 0x33, 0xd5, 0xf8, 0xfd, 0x9d, 0xe2, 0xdf, 0x80, 0xf8, 0x4d, 0x8c, 0xbb, 0x42, 0x72, 0xb0,
-	0x6a, 0x9f, 0x02, 0xf3, 0xfa, 0x74, 0xcb, 0x7c, 0xf5, 0x36, 0xdd, 0xdb, 0xc1, 0x6c, 0x7b, 0x21,
-	0x20, 0xdb, 0xef, 0xd4, 0xb2, 0x2d, 0x47, 0x20, 0xd6, 0xc5, 0x72, 0x29, 0x3e, 0x11, 0x1c, 0x09,
-	0x11, 0xd3, 0xcb, 0xb2, 0x14, 0xaa, 0x6f, 0xa1, 0x98, 0x08, 0x7d, 0xcb, 0xe4, 0x1b, 0x86, 0x4b,
-	0xee, 0x54, 0xa6, 0xbe, 0x68, 0xf9, 0x57, 0xf4, 0xdf, 0x6e, 0x32, 0xee, 0x2b, 0x28, 0xde, 0xd2,
-	0x37, 0xcc, 0xad, 0x80, 0xf6, 0x10, 0x21, 0xf1, 0xf6, 0x76, 0x9b, 0x1c, 0x5f, 0x81, 0x1c, 0x7b,
-	0x16, 0x5d, 0x25, 0xd6, 0x33, 0x52, 0x3f, 0x57, 0xd0, 0x94, 0xec, 0xd3, 0xa0, 0xcc, 0xf8, 0xd1,
-	0xf5, 0xae, 0xfe, 0x9e, 0x47, 0x83, 0x79, 0xd3, 0x32, 0xb9, 0x28, 0x34, 0x03, 0xb2, 0x1a, 0x0a,
-	0x41, 0xcd, 0x62, 0x86, 0xc9, 0x8c, 0x48, 0x45, 0xf5, 0x93, 0x08, 0x3a, 0xd9, 0x06, 0xd8, 0x3f,
-	0x45, 0x18, 0x5e, 0x44, 0x23, 0x32, 0xd3, 0xb2, 0xd2, 0xc8, 0xf9, 0x4c, 0x95, 0x4b, 0xf1, 0x49,
-	0x9f, 0xb8, 0x71, 0x56, 0x72, 0xd1, 0x2c, 0x89, 0x52, 0x92, 0x44, 0xc3, 0x1b, 0x84, 0x65, 0x2c,
-	0xc7, 0xa5, 0xe2, 0x00, 0x19, 0x4e, 0x1d, 0xa9, 0xec, 0x55, 0x4f, 0xe6, 0x23, 0x71, 0xcf, 0x06,
-	0x61, 0xaf, 0x39, 0x2e, 0x55, 0x8d, 0x66, 0x05, 0xb0, 0xd7, 0xfd, 0xa4, 0xfa, 0x93, 0xd7, 0x37,
-	0xd4, 0x87, 0x01, 0xb6, 0xd7, 0xd1, 0xfe, 0x60, 0x91, 0xf4, 0x58, 0x0f, 0x57, 0x69, 0x8f, 0x42,
-	0x02, 0xea, 0x9d, 0xa4, 0xc7, 0x02, 0xa5, 0xb6, 0x87, 0x67, 0xd3, 0x9f, 0x0a, 0x3a, 0x1a, 0x38,
-	0x4c, 0x57, 0x5f, 0x5d, 0xf3, 0x48, 0x4b, 0xa2, 0x61, 0xb6, 0x59, 0xf4, 0xaf, 0x67, 0x91, 0x05,
-	0x4f, 0xe6, 0xcf, 0x02, 0xdb, 0x2c, 0xbe, 0x64, 0x5d, 0xc4, 0x57, 0x0a, 0x9a, 0x68, 0x9c, 0xf8,
-	0x8b, 0xd9, 0x40, 0x64, 0x03, 0x6b, 0xfb, 0x3a, 0xe3, 0xa6, 0x45, 0x38, 0xed, 0xed, 0x91, 0x72,
-	0x77, 0x20, 0xb0, 0xb2, 0x6b, 0x41, 0x80, 0x92, 0xbb, 0x0a, 0xda, 0xaf, 0x17, 0x5d, 0x97, 0xda,
-	0xdc, 0x3b, 0x29, 0x3b, 0x17, 0x94, 0xa5, 0xea, 0x7a, 0x0e, 0x5a, 0x76, 0x55, 0x58, 0xc6, 0xc0,
-	0x18, 0x0e, 0x58, 0xfc, 0x85, 0x82, 0x0e, 0x73, 0x87, 0x93, 0x7c, 0xc6, 0xad, 0xa4, 0xb9, 0x40,
-	0xdd, 0x0c, 0xa3, 0xba, 0x63, 0x1b, 0x9d, 0xbb, 0x8e, 0x57, 0x00, 0x4f, 0x73, 0xfb, 0xae, 0x50,
-	0x61, 0xe1, 0x22, 0x4d, 0x38, 0x5d, 0xa1, 0xee, 0xaa, 0xb0, 0xc7, 0x16, 0x8a, 0x52, 0xe0, 0xcd,
-	0xc8, 0x18, 0x45, 0x57, 0x64, 0x0f, 0x9c, 0x33, 0xb1, 0x86, 0x07, 0x52, 0x5a, 0xb9, 0x14, 0x3f,
-	0xd7, 0x5a, 0xab, 0xb1, 0x2e, 0x4e, 0x54, 0x95, 0x97, 0x41, 0x57, 0x46, 0x63, 0x78, 0x0d, 0x61,
-	0xa2, 0x73, 0x73, 0x8b, 0x66, 0xfc, 0xf5, 0x76, 0x40, 0x84, 0x39, 0x5d, 0x2e, 0xc5, 0xff, 0xd5,
-	0x38, 0xda, 0xe8, 0xfe, 0x80, 0x54, 0xba, 0x59, 0x2d, 0xbe, 0xaa, 0x0e, 0xa7, 0xb0, 0x5c, 0xdc,
-	0xdb, 0x4b, 0x45, 0xc6, 0x1d, 0x6b, 0xd9, 0xb1, 0x88, 0x69, 0xfb, 0x8e, 0x3b, 0x5d, 0x88, 0x33,
-	0x86, 0x90, 0xfb, 0x8f, 0xbb, 0xc0, 0x80, 0xff, 0xb8, 0xd3, 0x7d, 0x8e, 0x2a, 0xd7, 0xe6, 0xa9,
-	0xd6, 0x51, 0x7a, 0xf6, 0xbe, 0x80, 0xff, 0x8b, 0xf6, 0x31, 0xea, 0x6e, 0x99, 0x3a, 0xcd, 0xd8,
-	0xc4, 0xa2, 0x62, 0x17, 0xee, 0x85, 0x2a, 0xe1, 0x93, 0x07, 0xaa, 0x84, 0x94, 0xbf, 0x4e, 0x2c,
-	0x3a, 0xf7, 0xe4, 0x20, 0x1a, 0x14, 0x30, 0xf1, 0x87, 0x0a, 0x1a, 0x92, 0x4f, 0x2e, 0xf8, 0x4c,
-	0x0b, 0x1c, 0x8d, 0x6f, 0x3c, 0xd1, 0xb3, 0x61, 0x54, 0xe5, 0x6c, 0xd5, 0xff, 0x7c, 0xf0, 0xcb,
-	0x1f, 0xf7, 0x23, 0x71, 0x7c, 0x42, 0x6b, 0xfe, 0xa2, 0x24, 0x1f, 0x76, 0xf0, 0x67, 0x0a, 0x1a,
-	0x14, 0x33, 0xc6, 0xd3, 0xed, 0x9c, 0xfb, 0xdf, 0x7d, 0xa2, 0x67, 0x42, 0x68, 0x02, 0x8a, 0xa4,
-	0x40, 0x71, 0x0e, 0x9f, 0x69, 0x81, 0x42, 0xd0, 0xaa, 0xed, 0xd4, 0x7a, 0xb9, 0x5d, 0x41, 0x8d,
-	0xac, 0xa2, 0xb8, 0x73, 0xa0, 0x70, 0xd4, 0x04, 0x1f, 0x53, 0x3a, 0x52, 0x03, 0x05, 0xf7, 0x91,
-	0x82, 0xc6, 0x82, 0x17, 0x7a, 0x9c, 0xec, 0x1c, 0xa5, 0xee, 0x2d, 0x24, 0x3a, 0xd7, 0x8d, 0x09,
-	0x00, 0xbc, 0x24, 0x00, 0x6a, 0x78, 0xb6, 0x2d, 0x40, 0x4d, 0xd6, 0x5a, 0x6d, 0x47, 0x7e, 0x77,
-	0xf1, 0x77, 0x0a, 0x3a, 0x50, 0x7f, 0x8b, 0xc5, 0x17, 0xc2, 0xc4, 0xaf, 0x7b, 0x00, 0x88, 0x5e,
-	0xec, 0xce, 0x08, 0x60, 0x5f, 0x13, 0xb0, 0x2f, 0xe3, 0xf9, 0xf6, 0xb0, 0xbd, 0x46, 0x55, 0xdb,
-	0x09, 0xf4, 0xb2, 0xbb, 0xf8, 0x81, 0x82, 0x46, 0x03, 0x1d, 0x0c, 0x3e, 0xdf, 0x0e, 0x47, 0xb3,
-	0x0b, 0x70, 0x34, 0xd9, 0x85, 0x05, 0xc0, 0x4e, 0x08, 0xd8, 0xd3, 0xf8, 0x54, 0x0b, 0xd8, 0xb2,
-	0x59, 0xaa, 0xd1, 0xfc, 0xb0, 0x06, 0x53, 0x9e, 0x7d, 0xa1, 0x60, 0x06, 0x2e, 0x6e, 0xa1, 0x60,
-	0x06, 0xef, 0x61, 0xea, 0xbc, 0x80, 0x79, 0x1e, 0x27, 0xda, 0xc2, 0x9c, 0x85, 0xab, 0x58, 0x0d,
-	0xee, 0x0f, 0x0a, 0xc2, 0x8d, 0x57, 0x20, 0x7c, 0xa9, 0x1d, 0x82, 0x96, 0xd7, 0xb1, 0xe8, 0x7c,
-	0xb7, 0x66, 0xdd, 0xac, 0x8d, 0x40, 0x21, 0xd0, 0xee, 0xf8, 0xbc, 0xe1, 0x27, 0x0a, 0x1a, 0x6f,
-	0x76, 0x33, 0xc1, 0x0b, 0x6d, 0x6b, 0x62, 0xeb, 0x4b, 0x56, 0xf4, 0x72, 0xf7, 0x86, 0x30, 0x97,
-	0x65, 0x31, 0x97, 0x6b, 0xf8, 0x6a, 0xab, 0xd2, 0xda, 0x62, 0x81, 0x07, 0x67, 0xf4, 0xa5, 0x82,
-	0xc6, 0x82, 0x7d, 0x3f, 0x0e, 0xbf, 0x78, 0x59, 0xa8, 0xf2, 0xd2, 0xfc, 0x5a, 0xa1, 0x9e, 0x12,
-	0xf8, 0xa7, 0x70, 0xac, 0xed, 0x4a, 0x62, 0x95, 0xfd, 0x38, 0xe2, 0xeb, 0x67, 0x71, 0x22, 0x4c,
-	0x55, 0xa8, 0x75, 0xfc, 0x51, 0x2d, 0xb4, 0x3e, 0x00, 0xbb, 0x28, 0x80, 0x25, 0xf0, 0x4c, 0xfb,
-	0x02, 0xc2, 0x36, 0x8b, 0xda, 0x8e, 0x77, 0x6f, 0xd8, 0xc5, 0xdf, 0x54, 0x89, 0xf4, 0xda, 0xcc,
-	0x30, 0x44, 0xd6, 0xf5, 0xbd, 0x61, 0x88, 0xac, 0xef, 0x62, 0xd5, 0x05, 0x81, 0x37, 0x89, 0xb5,
-	0x70, 0x95, 0x43, 0xf3, 0x7a, 0x2f, 0xfc, 0xa3, 0x82, 0x0e, 0x35, 0x69, 0x55, 0xf0, 0x7c, 0x47,
-	0xc6, 0x9a, 0x76, 0x50, 0xd1, 0x85, 0xae, 0xed, 0xba, 0xda, 0x96, 0xd9, 0xed, 0x59, 0xd9, 0x80,
-	0x69, 0x3b, 0x81, 0x7e, 0x6c, 0x37, 0xb5, 0xf6, 0xf8, 0x59, 0x4c, 0x79, 0xfa, 0x2c, 0xa6, 0xfc,
-	0xfe, 0x2c, 0xa6, 0xdc, 0x7b, 0x1e, 0xeb, 0x7b, 0xfa, 0x3c, 0xd6, 0xf7, 0xeb, 0xf3, 0x58, 0xdf,
-	0xdb, 0x57, 0x7c, 0xbd, 0xaf, 0x45, 0x6c, 0x73, 0x9d, 0x32, 0x3e, 0x6b, 0x53, 0x7e, 0xc7, 0x71,
-	0x37, 0x6b, 0x82, 0x3c, 0x35, 0x72, 0xd4, 0xd5, 0xde, 0xaf, 0xc6, 0x14, 0x4d, 0x71, 0x76, 0x48,
-	0xfc, 0xa7, 0xeb, 0xc2, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xbc, 0x58, 0xd4, 0x0d, 0xe3, 0x1b,
-	0x00, 0x00,
+func (m *QueryAvailableCreditRequest) Reset()         { *m = QueryAvailableCreditRequest{} }
+func (m *QueryAvailableCreditRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAvailableCreditRequest) ProtoMessage()    {}
+func (*QueryAvailableCreditRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b5cf57ecc9d060b, []int{26}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the module parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Lease queries a lease by UUID.
-	Lease(ctx context.Context, in *QueryLeaseRequest, opts ...grpc.CallOption) (*QueryLeaseResponse, error)
-	// Leases queries all leases with pagination.
-	Leases(ctx context.Context, in *QueryLeasesRequest, opts ...grpc.CallOption) (*QueryLeasesResponse, error)
-	// LeasesByTenant queries leases by tenant address.
-	LeasesByTenant(ctx context.Context, in *QueryLeasesByTenantRequest, opts ...grpc.CallOption) (*QueryLeasesByTenantResponse, error)
-	// LeasesByProvider queries leases by provider.
-	LeasesByProvider(ctx context.Context, in *QueryLeasesByProviderRequest, opts ...grpc.CallOption) (*QueryLeasesByProviderResponse, error)
-	// CreditAccount queries a tenant's credit account.
-	CreditAccount(ctx context.Context, in *QueryCreditAccountRequest, opts ...grpc.CallOption) (*QueryCreditAccountResponse, error)
-	// CreditAddress derives the credit address for a tenant.
-	CreditAddress(ctx context.Context, in *QueryCreditAddressRequest, opts ...grpc.CallOption) (*QueryCreditAddressResponse, error)
-	// WithdrawableAmount queries the amount available for provider withdrawal from a lease.
-	WithdrawableAmount(ctx context.Context, in *QueryWithdrawableAmountRequest, opts ...grpc.CallOption) (*QueryWithdrawableAmountResponse, error)
-	// ProviderWithdrawable queries the total amount available for a provider to withdraw across all leases.
-	ProviderWithdrawable(ctx context.Context, in *QueryProviderWithdrawableRequest, opts ...grpc.CallOption) (*QueryProviderWithdrawableResponse, error)
-	// CreditAccounts queries all credit accounts with pagination.
-	CreditAccounts(ctx context.Context, in *QueryCreditAccountsRequest, opts ...grpc.CallOption) (*QueryCreditAccountsResponse, error)
-	// LeasesBySKU queries leases by SKU UUID.
-	LeasesBySKU(ctx context.Context, in *QueryLeasesBySKURequest, opts ...grpc.CallOption) (*QueryLeasesBySKUResponse, error)
-	// CreditEstimate estimates remaining lease duration for a tenant.
-	CreditEstimate(ctx context.Context, in *QueryCreditEstimateRequest, opts ...grpc.CallOption) (*QueryCreditEstimateResponse, error)
-	// LeaseByCustomDomain returns the active or pending lease that has claimed
-	// the given custom_domain, if any.
-	LeaseByCustomDomain(ctx context.Context, in *QueryLeaseByCustomDomainRequest, opts ...grpc.CallOption) (*QueryLeaseByCustomDomainResponse, error)
+func (m *QueryAvailableCreditRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryAvailableCreditRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAvailableCreditRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryAvailableCreditRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAvailableCreditRequest.Merge(m, src)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryAvailableCreditRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAvailableCreditRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAvailableCreditRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Lease(ctx context.Context, in *QueryLeaseRequest, opts ...grpc.CallOption) (*QueryLeaseResponse, error) {
-	out := new(QueryLeaseResponse)
-	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/Lease", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAvailableCreditRequest proto.InternalMessageInfo
+
+func (m *QueryAvailableCreditRequest) GetTenant() string {
+	if m != nil {
+		return m.Tenant
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Leases(ctx context.Context, in *QueryLeasesRequest, opts ...grpc.CallOption) (*QueryLeasesResponse, error) {
-	out := new(QueryLeasesResponse)
-	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/Leases", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryAvailableCreditResponse is the response type for the
+// Query/AvailableCredit RPC method.
+type QueryAvailableCreditResponse struct {
+	// balances is the current balances at the credit address.
+	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
+	// reserved_amounts is the credit reserved by PENDING and ACTIVE leases.
+	ReservedAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=reserved_amounts,json=reservedAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reserved_amounts"`
+	// unsettled_amounts is the usage accrued by ACTIVE leases since their last
+	// settlement. It is owed to providers and cannot be withdrawn.
+	UnsettledAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=unsettled_amounts,json=unsettledAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unsettled_amounts"`
+	// available is the withdrawable credit: balances less the larger of
+	// reserved_amounts + unsettled_amounts and locked_amounts (floored at zero
+	// per denom).
+	Available github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=available,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"available"`
+	// withdrawable_after is the block time at which all of locked_amounts has
+	// unlocked. Unset when nothing is locked.
+	WithdrawableAfter *time.Time `protobuf:"bytes,5,opt,name=withdrawable_after,json=withdrawableAfter,proto3,stdtime" json:"withdrawable_after,omitempty"`
+	// locked_amounts is the credit deposited within the withdrawal cooldown.
+	// It can back leases but cannot be withdrawn or transferred yet.
+	LockedAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=locked_amounts,json=lockedAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"locked_amounts"`
+}
+
+func (m *QueryAvailableCreditResponse) Reset()         { *m = QueryAvailableCreditResponse{} }
+func (m *QueryAvailableCreditResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAvailableCreditResponse) ProtoMessage()    {}
+func (*QueryAvailableCreditResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b5cf57ecc9d060b, []int{27}
+}
+func (m *QueryAvailableCreditResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAvailableCreditResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAvailableCreditResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAvailableCreditResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAvailableCreditResponse.Merge(m, src)
+}
+func (m *QueryAvailableCreditResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAvailableCreditResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAvailableCreditResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) LeasesByTenant(ctx context.Context, in *QueryLeasesByTenantRequest, opts ...grpc.CallOption) (*QueryLeasesByTenantResponse, error) {
-	out := new(QueryLeasesByTenantResponse)
-	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/LeasesByTenant", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAvailableCreditResponse proto.InternalMessageInfo
+
+func (m *QueryAvailableCreditResponse) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balances
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) LeasesByProvider(ctx context.Context, in *QueryLeasesByProviderRequest, opts ...grpc.CallOption) (*QueryLeasesByProviderResponse, error) {
-	out := new(QueryLeasesByProviderResponse)
-	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/LeasesByProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAvailableCreditResponse) GetReservedAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ReservedAmounts
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) CreditAccount(ctx context.Context, in *QueryCreditAccountRequest, opts ...grpc.CallOption) (*QueryCreditAccountResponse, error) {
-	out := new(QueryCreditAccountResponse)
-	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/CreditAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAvailableCreditResponse) GetUnsettledAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UnsettledAmounts
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) CreditAddress(ctx context.Context, in *QueryCreditAddressRequest, opts ...grpc.CallOption) (*QueryCreditAddressResponse, error) {
-	out := new(QueryCreditAddressResponse)
-	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/CreditAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAvailableCreditResponse) GetAvailable() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Available
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) WithdrawableAmount(ctx context.Context, in *QueryWithdrawableAmountRequest, opts ...grpc.CallOption) (*QueryWithdrawableAmountResponse, error) {
-	out := new(QueryWithdrawableAmountResponse)
-	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/WithdrawableAmount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAvailableCreditResponse) GetWithdrawableAfter() *time.Time {
+	if m != nil {
+		return m.WithdrawableAfter
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ProviderWithdrawable(ctx context.Context, in *QueryProviderWithdrawableRequest, opts ...grpc.CallOption) (*QueryProviderWithdrawableResponse, error) {
-	out := new(QueryProviderWithdrawableResponse)
-	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/ProviderWithdrawable", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAvailableCreditResponse) GetLockedAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.LockedAmounts
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) CreditAccounts(ctx context.Context, in *QueryCreditAccountsRequest, opts ...grpc.CallOption) (*QueryCreditAccountsResponse, error) {
-	out := new(QueryCreditAccountsResponse)
-	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/CreditAccounts", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryLeaseAmendmentRequest is the request type for the Query/LeaseAmendment
+// RPC method.
+type QueryLeaseAmendmentRequest struct {
+	// lease_uuid is the UUID of the amended lease.
+	LeaseUuid string `protobuf:"bytes,1,opt,name=lease_uuid,json=leaseUuid,proto3" json:"lease_uuid,omitempty"`
 }
 
-func (c *queryClient) LeasesBySKU(ctx context.Context, in *QueryLeasesBySKURequest, opts ...grpc.CallOption) (*QueryLeasesBySKUResponse, error) {
-	out := new(QueryLeasesBySKUResponse)
-	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/LeasesBySKU", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLeaseAmendmentRequest) Reset()         { *m = QueryLeaseAmendmentRequest{} }
+func (m *QueryLeaseAmendmentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLeaseAmendmentRequest) ProtoMessage()    {}
+func (*QueryLeaseAmendmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b5cf57ecc9d060b, []int{28}
+}
+func (m *QueryLeaseAmendmentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLeaseAmendmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLeaseAmendmentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryLeaseAmendmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLeaseAmendmentRequest.Merge(m, src)
+}
+func (m *QueryLeaseAmendmentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLeaseAmendmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLeaseAmendmentRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) CreditEstimate(ctx context.Context, in *QueryCreditEstimateRequest, opts ...grpc.CallOption) (*QueryCreditEstimateResponse, error) {
-	out := new(QueryCreditEstimateResponse)
-	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/CreditEstimate", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLeaseAmendmentRequest proto.InternalMessageInfo
+
+func (m *QueryLeaseAmendmentRequest) GetLeaseUuid() string {
+	if m != nil {
+		return m.LeaseUuid
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) LeaseByCustomDomain(ctx context.Context, in *QueryLeaseByCustomDomainRequest, opts ...grpc.CallOption) (*QueryLeaseByCustomDomainResponse, error) {
-	out := new(QueryLeaseByCustomDomainResponse)
-	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/LeaseByCustomDomain", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryLeaseAmendmentResponse is the response type for the
+// Query/LeaseAmendment RPC method.
+type QueryLeaseAmendmentResponse struct {
+	// amendment is the pending amendment.
+	Amendment LeaseAmendment `protobuf:"bytes,1,opt,name=amendment,proto3" json:"amendment"`
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the module parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Lease queries a lease by UUID.
-	Lease(context.Context, *QueryLeaseRequest) (*QueryLeaseResponse, error)
-	// Leases queries all leases with pagination.
-	Leases(context.Context, *QueryLeasesRequest) (*QueryLeasesResponse, error)
-	// LeasesByTenant queries leases by tenant address.
-	LeasesByTenant(context.Context, *QueryLeasesByTenantRequest) (*QueryLeasesByTenantResponse, error)
-	// LeasesByProvider queries leases by provider.
-	LeasesByProvider(context.Context, *QueryLeasesByProviderRequest) (*QueryLeasesByProviderResponse, error)
-	// CreditAccount queries a tenant's credit account.
-	CreditAccount(context.Context, *QueryCreditAccountRequest) (*QueryCreditAccountResponse, error)
-	// CreditAddress derives the credit address for a tenant.
-	CreditAddress(context.Context, *QueryCreditAddressRequest) (*QueryCreditAddressResponse, error)
-	// WithdrawableAmount queries the amount available for provider withdrawal from a lease.
-	WithdrawableAmount(context.Context, *QueryWithdrawableAmountRequest) (*QueryWithdrawableAmountResponse, error)
-	// ProviderWithdrawable queries the total amount available for a provider to withdraw across all leases.
-	ProviderWithdrawable(context.Context, *QueryProviderWithdrawableRequest) (*QueryProviderWithdrawableResponse, error)
-	// CreditAccounts queries all credit accounts with pagination.
-	CreditAccounts(context.Context, *QueryCreditAccountsRequest) (*QueryCreditAccountsResponse, error)
-	// LeasesBySKU queries leases by SKU UUID.
-	LeasesBySKU(context.Context, *QueryLeasesBySKURequest) (*QueryLeasesBySKUResponse, error)
-	// CreditEstimate estimates remaining lease duration for a tenant.
-	CreditEstimate(context.Context, *QueryCreditEstimateRequest) (*QueryCreditEstimateResponse, error)
-	// LeaseByCustomDomain returns the active or pending lease that has claimed
-	// the given custom_domain, if any.
-	LeaseByCustomDomain(context.Context, *QueryLeaseByCustomDomainRequest) (*QueryLeaseByCustomDomainResponse, error)
+func (m *QueryLeaseAmendmentResponse) Reset()         { *m = QueryLeaseAmendmentResponse{} }
+func (m *QueryLeaseAmendmentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLeaseAmendmentResponse) ProtoMessage()    {}
+func (*QueryLeaseAmendmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b5cf57ecc9d060b, []int{29}
 }
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
-}
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryLeaseAmendmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Lease(ctx context.Context, req *QueryLeaseRequest) (*QueryLeaseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Lease not implemented")
+func (m *QueryLeaseAmendmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLeaseAmendmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Leases(ctx context.Context, req *QueryLeasesRequest) (*QueryLeasesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Leases not implemented")
+func (m *QueryLeaseAmendmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLeaseAmendmentResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) LeasesByTenant(ctx context.Context, req *QueryLeasesByTenantRequest) (*QueryLeasesByTenantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LeasesByTenant not implemented")
+func (m *QueryLeaseAmendmentResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) LeasesByProvider(ctx context.Context, req *QueryLeasesByProviderRequest) (*QueryLeasesByProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LeasesByProvider not implemented")
+func (m *QueryLeaseAmendmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLeaseAmendmentResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) CreditAccount(ctx context.Context, req *QueryCreditAccountRequest) (*QueryCreditAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreditAccount not implemented")
+
+var xxx_messageInfo_QueryLeaseAmendmentResponse proto.InternalMessageInfo
+
+func (m *QueryLeaseAmendmentResponse) GetAmendment() LeaseAmendment {
+	if m != nil {
+		return m.Amendment
+	}
+	return LeaseAmendment{}
 }
-func (*UnimplementedQueryServer) CreditAddress(ctx context.Context, req *QueryCreditAddressRequest) (*QueryCreditAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreditAddress not implemented")
+
+// QueryLeaseAmendmentsRequest is the request type for the
+// Query/LeaseAmendments RPC method.
+type QueryLeaseAmendmentsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// provider_uuid filters amendments by provider. If empty, returns all.
+	ProviderUuid string `protobuf:"bytes,2,opt,name=provider_uuid,json=providerUuid,proto3" json:"provider_uuid,omitempty"`
 }
-func (*UnimplementedQueryServer) WithdrawableAmount(ctx context.Context, req *QueryWithdrawableAmountRequest) (*QueryWithdrawableAmountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawableAmount not implemented")
+
+func (m *QueryLeaseAmendmentsRequest) Reset()         { *m = QueryLeaseAmendmentsRequest{} }
+func (m *QueryLeaseAmendmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLeaseAmendmentsRequest) ProtoMessage()    {}
+func (*QueryLeaseAmendmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b5cf57ecc9d060b, []int{30}
 }
-func (*UnimplementedQueryServer) ProviderWithdrawable(ctx context.Context, req *QueryProviderWithdrawableRequest) (*QueryProviderWithdrawableResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProviderWithdrawable not implemented")
+func (m *QueryLeaseAmendmentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) CreditAccounts(ctx context.Context, req *QueryCreditAccountsRequest) (*QueryCreditAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreditAccounts not implemented")
+func (m *QueryLeaseAmendmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLeaseAmendmentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) LeasesBySKU(ctx context.Context, req *QueryLeasesBySKURequest) (*QueryLeasesBySKUResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LeasesBySKU not implemented")
+func (m *QueryLeaseAmendmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLeaseAmendmentsRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) CreditEstimate(ctx context.Context, req *QueryCreditEstimateRequest) (*QueryCreditEstimateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreditEstimate not implemented")
+func (m *QueryLeaseAmendmentsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) LeaseByCustomDomain(ctx context.Context, req *QueryLeaseByCustomDomainRequest) (*QueryLeaseByCustomDomainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LeaseByCustomDomain not implemented")
+func (m *QueryLeaseAmendmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLeaseAmendmentsRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryLeaseAmendmentsRequest proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liftedinit.billing.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryLeaseAmendmentsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Lease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLeaseRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Lease(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liftedinit.billing.v1.Query/Lease",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Lease(ctx, req.(*QueryLeaseRequest))
+func (m *QueryLeaseAmendmentsRequest) GetProviderUuid() string {
+	if m != nil {
+		return m.ProviderUuid
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Leases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLeasesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Leases(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liftedinit.billing.v1.Query/Leases",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Leases(ctx, req.(*QueryLeasesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryLeaseAmendmentsResponse is the response type for the
+// Query/LeaseAmendments RPC method.
+type QueryLeaseAmendmentsResponse struct {
+	// amendments is the list of pending amendments.
+	Amendments []LeaseAmendment `protobuf:"bytes,1,rep,name=amendments,proto3" json:"amendments"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_LeasesByTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLeasesByTenantRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LeasesByTenant(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liftedinit.billing.v1.Query/LeasesByTenant",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LeasesByTenant(ctx, req.(*QueryLeasesByTenantRequest))
+func (m *QueryLeaseAmendmentsResponse) Reset()         { *m = QueryLeaseAmendmentsResponse{} }
+func (m *QueryLeaseAmendmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLeaseAmendmentsResponse) ProtoMessage()    {}
+func (*QueryLeaseAmendmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b5cf57ecc9d060b, []int{31}
+}
+func (m *QueryLeaseAmendmentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLeaseAmendmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLeaseAmendmentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryLeaseAmendmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLeaseAmendmentsResponse.Merge(m, src)
+}
+func (m *QueryLeaseAmendmentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLeaseAmendmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLeaseAmendmentsResponse.DiscardUnknown(m)
 }
 
-func _Query_LeasesByProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLeasesByProviderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LeasesByProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liftedinit.billing.v1.Query/LeasesByProvider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LeasesByProvider(ctx, req.(*QueryLeasesByProviderRequest))
+var xxx_messageInfo_QueryLeaseAmendmentsResponse proto.InternalMessageInfo
+
+func (m *QueryLeaseAmendmentsResponse) GetAmendments() []LeaseAmendment {
+	if m != nil {
+		return m.Amendments
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_CreditAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCreditAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CreditAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liftedinit.billing.v1.Query/CreditAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CreditAccount(ctx, req.(*QueryCreditAccountRequest))
+func (m *QueryLeaseAmendmentsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_CreditAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCreditAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CreditAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liftedinit.billing.v1.Query/CreditAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CreditAddress(ctx, req.(*QueryCreditAddressRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryLeaseUsageRequest is the request type for the Query/LeaseUsage RPC
+// method.
+type QueryLeaseUsageRequest struct {
+	// lease_uuid is the UUID of the metered lease.
+	LeaseUuid string `protobuf:"bytes,1,opt,name=lease_uuid,json=leaseUuid,proto3" json:"lease_uuid,omitempty"`
 }
 
-func _Query_WithdrawableAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWithdrawableAmountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).WithdrawableAmount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liftedinit.billing.v1.Query/WithdrawableAmount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).WithdrawableAmount(ctx, req.(*QueryWithdrawableAmountRequest))
+func (m *QueryLeaseUsageRequest) Reset()         { *m = QueryLeaseUsageRequest{} }
+func (m *QueryLeaseUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLeaseUsageRequest) ProtoMessage()    {}
+func (*QueryLeaseUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b5cf57ecc9d060b, []int{32}
+}
+func (m *QueryLeaseUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLeaseUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLeaseUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryLeaseUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLeaseUsageRequest.Merge(m, src)
+}
+func (m *QueryLeaseUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLeaseUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLeaseUsageRequest.DiscardUnknown(m)
 }
 
-func _Query_ProviderWithdrawable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProviderWithdrawableRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ProviderWithdrawable(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liftedinit.billing.v1.Query/ProviderWithdrawable",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ProviderWithdrawable(ctx, req.(*QueryProviderWithdrawableRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_QueryLeaseUsageRequest proto.InternalMessageInfo
 
-func _Query_CreditAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCreditAccountsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CreditAccounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liftedinit.billing.v1.Query/CreditAccounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CreditAccounts(ctx, req.(*QueryCreditAccountsRequest))
+func (m *QueryLeaseUsageRequest) GetLeaseUuid() string {
+	if m != nil {
+		return m.LeaseUuid
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_LeasesBySKU_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLeasesBySKURequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LeasesBySKU(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liftedinit.billing.v1.Query/LeasesBySKU",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LeasesBySKU(ctx, req.(*QueryLeasesBySKURequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryLeaseUsageResponse is the response type for the Query/LeaseUsage RPC
+// method.
+type QueryLeaseUsageResponse struct {
+	// usage is the metering state of the lease.
+	Usage LeaseUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage"`
 }
 
-func _Query_CreditEstimate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCreditEstimateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CreditEstimate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liftedinit.billing.v1.Query/CreditEstimate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CreditEstimate(ctx, req.(*QueryCreditEstimateRequest))
+func (m *QueryLeaseUsageResponse) Reset()         { *m = QueryLeaseUsageResponse{} }
+func (m *QueryLeaseUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLeaseUsageResponse) ProtoMessage()    {}
+func (*QueryLeaseUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b5cf57ecc9d060b, []int{33}
+}
+func (m *QueryLeaseUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLeaseUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLeaseUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryLeaseUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLeaseUsageResponse.Merge(m, src)
+}
+func (m *QueryLeaseUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLeaseUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLeaseUsageResponse.DiscardUnknown(m)
 }
 
-func _Query_LeaseByCustomDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLeaseByCustomDomainRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LeaseByCustomDomain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liftedinit.billing.v1.Query/LeaseByCustomDomain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LeaseByCustomDomain(ctx, req.(*QueryLeaseByCustomDomainRequest))
+var xxx_messageInfo_QueryLeaseUsageResponse proto.InternalMessageInfo
+
+func (m *QueryLeaseUsageResponse) GetUsage() LeaseUsage {
+	if m != nil {
+		return m.Usage
 	}
-	return interceptor(ctx, in, info, handler)
+	return LeaseUsage{}
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "liftedinit.billing.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Lease",
-			Handler:    _Query_Lease_Handler,
-		},
-		{
-			MethodName: "Leases",
-			Handler:    _Query_Leases_Handler,
-		},
-		{
-			MethodName: "LeasesByTenant",
-			Handler:    _Query_LeasesByTenant_Handler,
-		},
-		{
-			MethodName: "LeasesByProvider",
-			Handler:    _Query_LeasesByProvider_Handler,
-		},
-		{
-			MethodName: "CreditAccount",
-			Handler:    _Query_CreditAccount_Handler,
-		},
-		{
-			MethodName: "CreditAddress",
-			Handler:    _Query_CreditAddress_Handler,
-		},
-		{
-			MethodName: "WithdrawableAmount",
-			Handler:    _Query_WithdrawableAmount_Handler,
-		},
-		{
-			MethodName: "ProviderWithdrawable",
-			Handler:    _Query_ProviderWithdrawable_Handler,
-		},
-		{
-			MethodName: "CreditAccounts",
-			Handler:    _Query_CreditAccounts_Handler,
-		},
-		{
-			MethodName: "LeasesBySKU",
-			Handler:    _Query_LeasesBySKU_Handler,
-		},
-		{
-			MethodName: "CreditEstimate",
-			Handler:    _Query_CreditEstimate_Handler,
-		},
-		{
-			MethodName: "LeaseByCustomDomain",
-			Handler:    _Query_LeaseByCustomDomain_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "liftedinit/billing/v1/query.proto",
+// QueryDisputeRequest is the request type for the Query/Dispute RPC method.
+type QueryDisputeRequest struct {
+	// lease_uuid is the UUID of the disputed lease.
+	LeaseUuid string `protobuf:"bytes,1,opt,name=lease_uuid,json=leaseUuid,proto3" json:"lease_uuid,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDisputeRequest) Reset()         { *m = QueryDisputeRequest{} }
+func (m *QueryDisputeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDisputeRequest) ProtoMessage()    {}
+func (*QueryDisputeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b5cf57ecc9d060b, []int{34}
+}
+func (m *QueryDisputeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDisputeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDisputeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDisputeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDisputeRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryDisputeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDisputeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDisputeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDisputeRequest proto.InternalMessageInfo
+
+func (m *QueryDisputeRequest) GetLeaseUuid() string {
+	if m != nil {
+		return m.LeaseUuid
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDisputeResponse is the response type for the Query/Dispute RPC method.
+type QueryDisputeResponse struct {
+	// dispute is the dispute of the lease.
+	Dispute Dispute `protobuf:"bytes,1,opt,name=dispute,proto3" json:"dispute"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryDisputeResponse) Reset()         { *m = QueryDisputeResponse{} }
+func (m *QueryDisputeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDisputeResponse) ProtoMessage()    {}
+func (*QueryDisputeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b5cf57ecc9d060b, []int{35}
+}
+func (m *QueryDisputeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDisputeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryLeaseRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDisputeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDisputeResponse.Merge(m, src)
 }
-
-func (m *QueryLeaseRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDisputeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDisputeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDisputeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLeaseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LeaseUuid) > 0 {
-		i -= len(m.LeaseUuid)
-		copy(dAtA[i:], m.LeaseUuid)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.LeaseUuid)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryDisputeResponse proto.InternalMessageInfo
+
+func (m *QueryDisputeResponse) GetDispute() Dispute {
+	if m != nil {
+		return m.Dispute
 	}
-	return len(dAtA) - i, nil
+	return Dispute{}
 }
 
-func (m *QueryLeaseResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryDisputesRequest is the request type for the Query/Disputes RPC method.
+type QueryDisputesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// provider_uuid filters disputes by provider. If empty, returns all.
+	ProviderUuid string `protobuf:"bytes,2,opt,name=provider_uuid,json=providerUuid,proto3" json:"provider_uuid,omitempty"`
+	// state filters disputes by state. If unspecified, returns all.
+	State DisputeState `protobuf:"varint,3,opt,name=state,proto3,enum=liftedinit.billing.v1.DisputeState" json:"state,omitempty"`
 }
 
-func (m *QueryLeaseResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDisputesRequest) Reset()         { *m = QueryDisputesRequest{} }
+func (m *QueryDisputesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDisputesRequest) ProtoMessage()    {}
+func (*QueryDisputesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b5cf57ecc9d060b, []int{36}
 }
-
-func (m *QueryLeaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Lease.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryDisputesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDisputesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDisputesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryDisputesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDisputesRequest.Merge(m, src)
+}
+func (m *QueryDisputesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDisputesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDisputesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryLeasesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDisputesRequest proto.InternalMessageInfo
+
+func (m *QueryDisputesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryLeasesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDisputesRequest) GetProviderUuid() string {
+	if m != nil {
+		return m.ProviderUuid
+	}
+	return ""
 }
 
-func (m *QueryLeasesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StateFilter != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StateFilter))
-		i--
-		dAtA[i] = 0x10
+func (m *QueryDisputesRequest) GetState() DisputeState {
+	if m != nil {
+		return m.State
 	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	return DISPUTE_STATE_UNSPECIFIED
+}
+
+// QueryDisputesResponse is the response type for the Query/Disputes RPC
+// method.
+type QueryDisputesResponse struct {
+	// disputes is the list of disputes.
+	Disputes []Dispute `protobuf:"bytes,1,rep,name=disputes,proto3" json:"disputes"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDisputesResponse) Reset()         { *m = QueryDisputesResponse{} }
+func (m *QueryDisputesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDisputesResponse) ProtoMessage()    {}
+func (*QueryDisputesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b5cf57ecc9d060b, []int{37}
+}
+func (m *QueryDisputesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDisputesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDisputesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDisputesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDisputesResponse.Merge(m, src)
+}
+func (m *QueryDisputesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDisputesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDisputesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLeasesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDisputesResponse proto.InternalMessageInfo
+
+func (m *QueryDisputesResponse) GetDisputes() []Dispute {
+	if m != nil {
+		return m.Disputes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryLeasesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDisputesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryLeasesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Leases) > 0 {
-		for iNdEx := len(m.Leases) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Leases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+// QueryProviderPolicyRequest is the request type for the Query/ProviderPolicy
+// RPC method.
+type QueryProviderPolicyRequest struct {
+	// provider_uuid is the UUID of the provider.
+	ProviderUuid string `protobuf:"bytes,1,opt,name=provider_uuid,json=providerUuid,proto3" json:"provider_uuid,omitempty"`
+}
+
+func (m *QueryProviderPolicyRequest) Reset()         { *m = QueryProviderPolicyRequest{} }
+func (m *QueryProviderPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderPolicyRequest) ProtoMessage()    {}
+func (*QueryProviderPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b5cf57ecc9d060b, []int{38}
+}
+func (m *QueryProviderPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryProviderPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderPolicyRequest.Merge(m, src)
+}
+func (m *QueryProviderPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderPolicyRequest.DiscardUnknown(m)
 }
 
-func (m *QueryLeasesByTenantRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProviderPolicyRequest proto.InternalMessageInfo
+
+func (m *QueryProviderPolicyRequest) GetProviderUuid() string {
+	if m != nil {
+		return m.ProviderUuid
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryLeasesByTenantRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryProviderPolicyResponse is the response type for the
+// Query/ProviderPolicy RPC method.
+type QueryProviderPolicyResponse struct {
+	// policy is the provider's policy. It is empty apart from provider_uuid
+	// when found is false.
+	Policy ProviderPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy"`
+	// found is true when the provider has set a policy.
+	Found bool `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+	// effective_pending_timeout is the pending timeout in seconds that applies
+	// to the provider's leases: policy.pending_timeout if set,
+	// params.pending_timeout otherwise.
+	EffectivePendingTimeout uint64 `protobuf:"varint,3,opt,name=effective_pending_timeout,json=effectivePendingTimeout,proto3" json:"effective_pending_timeout,omitempty,string"`
+	// effective_grace_period is the grace period in seconds that applies to the
+	// provider's leases: policy.grace_period, clamped to
+	// params.max_provider_grace_period, if the policy overrides it,
+	// params.grace_period otherwise.
+	EffectiveGracePeriod uint64 `protobuf:"varint,4,opt,name=effective_grace_period,json=effectiveGracePeriod,proto3" json:"effective_grace_period,omitempty,string"`
+}
+
+func (m *QueryProviderPolicyResponse) Reset()         { *m = QueryProviderPolicyResponse{} }
+func (m *QueryProviderPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderPolicyResponse) ProtoMessage()    {}
+func (*QueryProviderPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b5cf57ecc9d060b, []int{39}
+}
+func (m *QueryProviderPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryLeasesByTenantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StateFilter != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StateFilter))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryProviderPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Tenant) > 0 {
-		i -= len(m.Tenant)
-		copy(dAtA[i:], m.Tenant)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryProviderPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderPolicyResponse.Merge(m, src)
+}
+func (m *QueryProviderPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProviderPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryProviderPolicyResponse) GetPolicy() ProviderPolicy {
+	if m != nil {
+		return m.Policy
 	}
-	return len(dAtA) - i, nil
+	return ProviderPolicy{}
 }
 
-func (m *QueryLeasesByTenantResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProviderPolicyResponse) GetFound() bool {
+	if m != nil {
+		return m.Found
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryLeasesByTenantResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProviderPolicyResponse) GetEffectivePendingTimeout() uint64 {
+	if m != nil {
+		return m.EffectivePendingTimeout
+	}
+	return 0
 }
 
-func (m *QueryLeasesByTenantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *QueryProviderPolicyResponse) GetEffectiveGracePeriod() uint64 {
+	if m != nil {
+		return m.EffectiveGracePeriod
 	}
-	if len(m.Leases) > 0 {
-		for iNdEx := len(m.Leases) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Leases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	return 0
+}
+
+// QueryProviderPoliciesRequest is the request type for the
+// Query/ProviderPolicies RPC method.
+type QueryProviderPoliciesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProviderPoliciesRequest) Reset()         { *m = QueryProviderPoliciesRequest{} }
+func (m *QueryProviderPoliciesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderPoliciesRequest) ProtoMessage()    {}
+func (*QueryProviderPoliciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b5cf57ecc9d060b, []int{40}
+}
+func (m *QueryProviderPoliciesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderPoliciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderPoliciesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryProviderPoliciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderPoliciesRequest.Merge(m, src)
+}
+func (m *QueryProviderPoliciesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderPoliciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderPoliciesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryLeasesByProviderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProviderPoliciesRequest proto.InternalMessageInfo
+
+func (m *QueryProviderPoliciesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryLeasesByProviderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryProviderPoliciesResponse is the response type for the
+// Query/ProviderPolicies RPC method.
+type QueryProviderPoliciesResponse struct {
+	// policies is the list of provider policies.
+	Policies []ProviderPolicy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryLeasesByProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StateFilter != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StateFilter))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryProviderPoliciesResponse) Reset()         { *m = QueryProviderPoliciesResponse{} }
+func (m *QueryProviderPoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderPoliciesResponse) ProtoMessage()    {}
+func (*QueryProviderPoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b5cf57ecc9d060b, []int{41}
+}
+func (m *QueryProviderPoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderPoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderPoliciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.ProviderUuid) > 0 {
-		i -= len(m.ProviderUuid)
-		copy(dAtA[i:], m.ProviderUuid)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderUuid)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryProviderPoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderPoliciesResponse.Merge(m, src)
+}
+func (m *QueryProviderPoliciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderPoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderPoliciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProviderPoliciesResponse proto.InternalMessageInfo
+
+func (m *QueryProviderPoliciesResponse) GetPolicies() []ProviderPolicy {
+	if m != nil {
+		return m.Policies
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryLeasesByProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProviderPoliciesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryLeasesByProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryProviderStatsRequest is the request type for the Query/ProviderStats
+// RPC method.
+type QueryProviderStatsRequest struct {
+	// provider_uuid is the UUID of the provider.
+	ProviderUuid string `protobuf:"bytes,1,opt,name=provider_uuid,json=providerUuid,proto3" json:"provider_uuid,omitempty"`
 }
 
-func (m *QueryLeasesByProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryProviderStatsRequest) Reset()         { *m = QueryProviderStatsRequest{} }
+func (m *QueryProviderStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderStatsRequest) ProtoMessage()    {}
+func (*QueryProviderStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b5cf57ecc9d060b, []int{42}
+}
+func (m *QueryProviderStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Leases) > 0 {
-		for iNdEx := len(m.Leases) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Leases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+}
+func (m *QueryProviderStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderStatsRequest.Merge(m, src)
+}
+func (m *QueryProviderStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProviderStatsRequest proto.InternalMessageInfo
+
+func (m *QueryProviderStatsRequest) GetProviderUuid() string {
+	if m != nil {
+		return m.ProviderUuid
+	}
+	return ""
+}
+
+// QueryProviderStatsResponse is the response type for the Query/ProviderStats
+// RPC method.
+type QueryProviderStatsResponse struct {
+	// stats are the raw counters of the provider.
+	Stats ProviderStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats"`
+	// ack_latency_bucket_bounds are the upper bounds in seconds of
+	// stats.ack_latency_buckets, without the final unbounded bucket.
+	AckLatencyBucketBounds []uint64 `protobuf:"varint,2,rep,packed,name=ack_latency_bucket_bounds,json=ackLatencyBucketBounds,proto3" json:"ack_latency_bucket_bounds"`
+	// average_ack_latency is ack_latency_sum divided by the leases acknowledged
+	// after their creation block (leases_acknowledged -
+	// leases_acknowledged_at_creation), in seconds.
+	AverageAckLatency uint64 `protobuf:"varint,3,opt,name=average_ack_latency,json=averageAckLatency,proto3" json:"average_ack_latency,omitempty,string"`
+	// average_lease_lifetime is lifetime_sum / leases_closed, in seconds.
+	AverageLeaseLifetime uint64 `protobuf:"varint,4,opt,name=average_lease_lifetime,json=averageLeaseLifetime,proto3" json:"average_lease_lifetime,omitempty,string"`
+	// acknowledgement_rate is the fraction of the provider's decided leases
+	// (acknowledged, rejected or expired) that were acknowledged. Tenant
+	// cancellations are not counted.
+	AcknowledgementRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=acknowledgement_rate,json=acknowledgementRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"acknowledgement_rate"`
+	// rejection_rate is the fraction of decided leases that were rejected.
+	RejectionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=rejection_rate,json=rejectionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rejection_rate"`
+	// expiry_rate is the fraction of decided leases that expired.
+	ExpiryRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=expiry_rate,json=expiryRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"expiry_rate"`
+}
+
+func (m *QueryProviderStatsResponse) Reset()         { *m = QueryProviderStatsResponse{} }
+func (m *QueryProviderStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderStatsResponse) ProtoMessage()    {}
+func (*QueryProviderStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b5cf57ecc9d060b, []int{43}
+}
+func (m *QueryProviderStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryProviderStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderStatsResponse.Merge(m, src)
+}
+func (m *QueryProviderStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderStatsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCreditAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProviderStatsResponse proto.InternalMessageInfo
+
+func (m *QueryProviderStatsResponse) GetStats() ProviderStats {
+	if m != nil {
+		return m.Stats
 	}
-	return dAtA[:n], nil
+	return ProviderStats{}
 }
 
-func (m *QueryCreditAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProviderStatsResponse) GetAckLatencyBucketBounds() []uint64 {
+	if m != nil {
+		return m.AckLatencyBucketBounds
+	}
+	return nil
 }
 
-func (m *QueryCreditAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Tenant) > 0 {
-		i -= len(m.Tenant)
-		copy(dAtA[i:], m.Tenant)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryProviderStatsResponse) GetAverageAckLatency() uint64 {
+	if m != nil {
+		return m.AverageAckLatency
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryCreditAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProviderStatsResponse) GetAverageLeaseLifetime() uint64 {
+	if m != nil {
+		return m.AverageLeaseLifetime
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryCreditAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryLeaseSettlementsRequest is the request type for the
+// Query/LeaseSettlements RPC method.
+type QueryLeaseSettlementsRequest struct {
+	// lease_uuid is the UUID of the lease.
+	LeaseUuid string `protobuf:"bytes,1,opt,name=lease_uuid,json=leaseUuid,proto3" json:"lease_uuid,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryCreditAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AvailableBalances) > 0 {
-		for iNdEx := len(m.AvailableBalances) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AvailableBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *QueryLeaseSettlementsRequest) Reset()         { *m = QueryLeaseSettlementsRequest{} }
+func (m *QueryLeaseSettlementsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLeaseSettlementsRequest) ProtoMessage()    {}
+func (*QueryLeaseSettlementsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b5cf57ecc9d060b, []int{44}
+}
+func (m *QueryLeaseSettlementsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLeaseSettlementsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLeaseSettlementsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Balances) > 0 {
-		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.CreditAccount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryLeaseSettlementsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLeaseSettlementsRequest.Merge(m, src)
+}
+func (m *QueryLeaseSettlementsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLeaseSettlementsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLeaseSettlementsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryCreditAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLeaseSettlementsRequest proto.InternalMessageInfo
+
+func (m *QueryLeaseSettlementsRequest) GetLeaseUuid() string {
+	if m != nil {
+		return m.LeaseUuid
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryCreditAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryLeaseSettlementsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryCreditAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Tenant) > 0 {
-		i -= len(m.Tenant)
-		copy(dAtA[i:], m.Tenant)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryLeaseSettlementsResponse is the response type for the
+// Query/LeaseSettlements RPC method.
+type QueryLeaseSettlementsResponse struct {
+	// settlements are the settlement records of the lease.
+	Settlements []LeaseSettlement `protobuf:"bytes,1,rep,name=settlements,proto3" json:"settlements"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryCreditAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryLeaseSettlementsResponse) Reset()         { *m = QueryLeaseSettlementsResponse{} }
+func (m *QueryLeaseSettlementsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLeaseSettlementsResponse) ProtoMessage()    {}
+func (*QueryLeaseSettlementsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b5cf57ecc9d060b, []int{45}
+}
+func (m *QueryLeaseSettlementsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLeaseSettlementsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLeaseSettlementsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryCreditAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryLeaseSettlementsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLeaseSettlementsResponse.Merge(m, src)
+}
+func (m *QueryLeaseSettlementsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLeaseSettlementsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLeaseSettlementsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCreditAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CreditAddress) > 0 {
-		i -= len(m.CreditAddress)
-		copy(dAtA[i:], m.CreditAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CreditAddress)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryLeaseSettlementsResponse proto.InternalMessageInfo
+
+func (m *QueryLeaseSettlementsResponse) GetSettlements() []LeaseSettlement {
+	if m != nil {
+		return m.Settlements
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryWithdrawableAmountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryLeaseSettlementsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryWithdrawableAmountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryExchangeRatesRequest is the request type for the Query/ExchangeRates
+// RPC method.
+type QueryExchangeRatesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryWithdrawableAmountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LeaseUuid) > 0 {
-		i -= len(m.LeaseUuid)
-		copy(dAtA[i:], m.LeaseUuid)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.LeaseUuid)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryExchangeRatesRequest) Reset()         { *m = QueryExchangeRatesRequest{} }
+func (m *QueryExchangeRatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRatesRequest) ProtoMessage()    {}
+func (*QueryExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b5cf57ecc9d060b, []int{46}
+}
+func (m *QueryExchangeRatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExchangeRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExchangeRatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryExchangeRatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRatesRequest.Merge(m, src)
+}
+func (m *QueryExchangeRatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExchangeRatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRatesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryWithdrawableAmountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryExchangeRatesRequest proto.InternalMessageInfo
+
+func (m *QueryExchangeRatesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryWithdrawableAmountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryExchangeRatesResponse is the response type for the
+// Query/ExchangeRates RPC method.
+type QueryExchangeRatesResponse struct {
+	// rates are the entries of the table by denom, including rates that are
+	// not valid yet or anymore.
+	Rates []ExchangeRate `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates"`
+	// reference_denom is the denom the rates are quoted in. Empty means
+	// conversion is disabled.
+	ReferenceDenom string `protobuf:"bytes,2,opt,name=reference_denom,json=referenceDenom,proto3" json:"reference_denom,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryWithdrawableAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amounts) > 0 {
-		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryExchangeRatesResponse) Reset()         { *m = QueryExchangeRatesResponse{} }
+func (m *QueryExchangeRatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRatesResponse) ProtoMessage()    {}
+func (*QueryExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b5cf57ecc9d060b, []int{47}
+}
+func (m *QueryExchangeRatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExchangeRatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExchangeRatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryExchangeRatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRatesResponse.Merge(m, src)
+}
+func (m *QueryExchangeRatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExchangeRatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRatesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryProviderWithdrawableRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryExchangeRatesResponse proto.InternalMessageInfo
+
+func (m *QueryExchangeRatesResponse) GetRates() []ExchangeRate {
+	if m != nil {
+		return m.Rates
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryProviderWithdrawableRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryExchangeRatesResponse) GetReferenceDenom() string {
+	if m != nil {
+		return m.ReferenceDenom
+	}
+	return ""
 }
 
-func (m *QueryProviderWithdrawableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Limit != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x10
+func (m *QueryExchangeRatesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	if len(m.ProviderUuid) > 0 {
-		i -= len(m.ProviderUuid)
-		copy(dAtA[i:], m.ProviderUuid)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderUuid)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+// QuerySKUAvailabilityRequest is the request type for the
+// Query/SKUAvailability RPC method.
+type QuerySKUAvailabilityRequest struct {
+	// sku_uuid is the UUID of the SKU.
+	SkuUuid string `protobuf:"bytes,1,opt,name=sku_uuid,json=skuUuid,proto3" json:"sku_uuid,omitempty"`
+}
+
+func (m *QuerySKUAvailabilityRequest) Reset()         { *m = QuerySKUAvailabilityRequest{} }
+func (m *QuerySKUAvailabilityRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySKUAvailabilityRequest) ProtoMessage()    {}
+func (*QuerySKUAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b5cf57ecc9d060b, []int{48}
+}
+func (m *QuerySKUAvailabilityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySKUAvailabilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySKUAvailabilityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySKUAvailabilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySKUAvailabilityRequest.Merge(m, src)
+}
+func (m *QuerySKUAvailabilityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySKUAvailabilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySKUAvailabilityRequest.DiscardUnknown(m)
 }
 
-func (m *QueryProviderWithdrawableResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySKUAvailabilityRequest proto.InternalMessageInfo
+
+func (m *QuerySKUAvailabilityRequest) GetSkuUuid() string {
+	if m != nil {
+		return m.SkuUuid
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryProviderWithdrawableResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySKUAvailabilityResponse is the response type for the
+// Query/SKUAvailability RPC method.
+type QuerySKUAvailabilityResponse struct {
+	// capacity is the SKU's quantity limit. Zero means unlimited.
+	Capacity uint64 `protobuf:"varint,1,opt,name=capacity,proto3" json:"capacity,omitempty,string"`
+	// allocated is the total quantity of the SKU held by PENDING and ACTIVE
+	// leases. It can exceed capacity after capacity was lowered.
+	Allocated uint64 `protobuf:"varint,2,opt,name=allocated,proto3" json:"allocated,omitempty,string"`
+	// available is the quantity new leases can still take: capacity minus
+	// allocated, or zero once allocated reaches capacity. It is left zero for
+	// an unlimited SKU, so check capacity first.
+	Available uint64 `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty,string"`
 }
 
-func (m *QueryProviderWithdrawableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.HasMore {
-		i--
-		if m.HasMore {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QuerySKUAvailabilityResponse) Reset()         { *m = QuerySKUAvailabilityResponse{} }
+func (m *QuerySKUAvailabilityResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySKUAvailabilityResponse) ProtoMessage()    {}
+func (*QuerySKUAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b5cf57ecc9d060b, []int{49}
+}
+func (m *QuerySKUAvailabilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySKUAvailabilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySKUAvailabilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if m.LeaseCount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LeaseCount))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *QuerySKUAvailabilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySKUAvailabilityResponse.Merge(m, src)
+}
+func (m *QuerySKUAvailabilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySKUAvailabilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySKUAvailabilityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySKUAvailabilityResponse proto.InternalMessageInfo
+
+func (m *QuerySKUAvailabilityResponse) GetCapacity() uint64 {
+	if m != nil {
+		return m.Capacity
 	}
-	if len(m.Amounts) > 0 {
-		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return 0
+}
+
+func (m *QuerySKUAvailabilityResponse) GetAllocated() uint64 {
+	if m != nil {
+		return m.Allocated
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryCreditAccountsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySKUAvailabilityResponse) GetAvailable() uint64 {
+	if m != nil {
+		return m.Available
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "liftedinit.billing.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "liftedinit.billing.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryLeaseRequest)(nil), "liftedinit.billing.v1.QueryLeaseRequest")
+	proto.RegisterType((*QueryLeaseResponse)(nil), "liftedinit.billing.v1.QueryLeaseResponse")
+	proto.RegisterType((*QueryLeasesRequest)(nil), "liftedinit.billing.v1.QueryLeasesRequest")
+	proto.RegisterType((*QueryLeasesResponse)(nil), "liftedinit.billing.v1.QueryLeasesResponse")
+	proto.RegisterType((*QueryLeasesByTenantRequest)(nil), "liftedinit.billing.v1.QueryLeasesByTenantRequest")
+	proto.RegisterType((*QueryLeasesByTenantResponse)(nil), "liftedinit.billing.v1.QueryLeasesByTenantResponse")
+	proto.RegisterType((*QueryLeasesByProviderRequest)(nil), "liftedinit.billing.v1.QueryLeasesByProviderRequest")
+	proto.RegisterType((*QueryLeasesByProviderResponse)(nil), "liftedinit.billing.v1.QueryLeasesByProviderResponse")
+	proto.RegisterType((*QueryCreditAccountRequest)(nil), "liftedinit.billing.v1.QueryCreditAccountRequest")
+	proto.RegisterType((*QueryCreditAccountResponse)(nil), "liftedinit.billing.v1.QueryCreditAccountResponse")
+	proto.RegisterType((*QueryCreditAddressRequest)(nil), "liftedinit.billing.v1.QueryCreditAddressRequest")
+	proto.RegisterType((*QueryCreditAddressResponse)(nil), "liftedinit.billing.v1.QueryCreditAddressResponse")
+	proto.RegisterType((*QueryWithdrawableAmountRequest)(nil), "liftedinit.billing.v1.QueryWithdrawableAmountRequest")
+	proto.RegisterType((*QueryWithdrawableAmountResponse)(nil), "liftedinit.billing.v1.QueryWithdrawableAmountResponse")
+	proto.RegisterType((*QueryProviderWithdrawableRequest)(nil), "liftedinit.billing.v1.QueryProviderWithdrawableRequest")
+	proto.RegisterType((*QueryProviderWithdrawableResponse)(nil), "liftedinit.billing.v1.QueryProviderWithdrawableResponse")
+	proto.RegisterType((*QueryCreditAccountsRequest)(nil), "liftedinit.billing.v1.QueryCreditAccountsRequest")
+	proto.RegisterType((*QueryCreditAccountsResponse)(nil), "liftedinit.billing.v1.QueryCreditAccountsResponse")
+	proto.RegisterType((*QueryLeasesBySKURequest)(nil), "liftedinit.billing.v1.QueryLeasesBySKURequest")
+	proto.RegisterType((*QueryLeasesBySKUResponse)(nil), "liftedinit.billing.v1.QueryLeasesBySKUResponse")
+	proto.RegisterType((*QueryCreditEstimateRequest)(nil), "liftedinit.billing.v1.QueryCreditEstimateRequest")
+	proto.RegisterType((*QueryCreditEstimateResponse)(nil), "liftedinit.billing.v1.QueryCreditEstimateResponse")
+	proto.RegisterType((*QueryLeaseByCustomDomainRequest)(nil), "liftedinit.billing.v1.QueryLeaseByCustomDomainRequest")
+	proto.RegisterType((*QueryLeaseByCustomDomainResponse)(nil), "liftedinit.billing.v1.QueryLeaseByCustomDomainResponse")
+	proto.RegisterType((*QueryAvailableCreditRequest)(nil), "liftedinit.billing.v1.QueryAvailableCreditRequest")
+	proto.RegisterType((*QueryAvailableCreditResponse)(nil), "liftedinit.billing.v1.QueryAvailableCreditResponse")
+	proto.RegisterType((*QueryLeaseAmendmentRequest)(nil), "liftedinit.billing.v1.QueryLeaseAmendmentRequest")
+	proto.RegisterType((*QueryLeaseAmendmentResponse)(nil), "liftedinit.billing.v1.QueryLeaseAmendmentResponse")
+	proto.RegisterType((*QueryLeaseAmendmentsRequest)(nil), "liftedinit.billing.v1.QueryLeaseAmendmentsRequest")
+	proto.RegisterType((*QueryLeaseAmendmentsResponse)(nil), "liftedinit.billing.v1.QueryLeaseAmendmentsResponse")
+	proto.RegisterType((*QueryLeaseUsageRequest)(nil), "liftedinit.billing.v1.QueryLeaseUsageRequest")
+	proto.RegisterType((*QueryLeaseUsageResponse)(nil), "liftedinit.billing.v1.QueryLeaseUsageResponse")
+	proto.RegisterType((*QueryDisputeRequest)(nil), "liftedinit.billing.v1.QueryDisputeRequest")
+	proto.RegisterType((*QueryDisputeResponse)(nil), "liftedinit.billing.v1.QueryDisputeResponse")
+	proto.RegisterType((*QueryDisputesRequest)(nil), "liftedinit.billing.v1.QueryDisputesRequest")
+	proto.RegisterType((*QueryDisputesResponse)(nil), "liftedinit.billing.v1.QueryDisputesResponse")
+	proto.RegisterType((*QueryProviderPolicyRequest)(nil), "liftedinit.billing.v1.QueryProviderPolicyRequest")
+	proto.RegisterType((*QueryProviderPolicyResponse)(nil), "liftedinit.billing.v1.QueryProviderPolicyResponse")
+	proto.RegisterType((*QueryProviderPoliciesRequest)(nil), "liftedinit.billing.v1.QueryProviderPoliciesRequest")
+	proto.RegisterType((*QueryProviderPoliciesResponse)(nil), "liftedinit.billing.v1.QueryProviderPoliciesResponse")
+	proto.RegisterType((*QueryProviderStatsRequest)(nil), "liftedinit.billing.v1.QueryProviderStatsRequest")
+	proto.RegisterType((*QueryProviderStatsResponse)(nil), "liftedinit.billing.v1.QueryProviderStatsResponse")
+	proto.RegisterType((*QueryLeaseSettlementsRequest)(nil), "liftedinit.billing.v1.QueryLeaseSettlementsRequest")
+	proto.RegisterType((*QueryLeaseSettlementsResponse)(nil), "liftedinit.billing.v1.QueryLeaseSettlementsResponse")
+	proto.RegisterType((*QueryExchangeRatesRequest)(nil), "liftedinit.billing.v1.QueryExchangeRatesRequest")
+	proto.RegisterType((*QueryExchangeRatesResponse)(nil), "liftedinit.billing.v1.QueryExchangeRatesResponse")
+	proto.RegisterType((*QuerySKUAvailabilityRequest)(nil), "liftedinit.billing.v1.QuerySKUAvailabilityRequest")
+	proto.RegisterType((*QuerySKUAvailabilityResponse)(nil), "liftedinit.billing.v1.QuerySKUAvailabilityResponse")
+}
+
+func init() { proto.RegisterFile("liftedinit/billing/v1/query.proto", fileDescriptor_7b5cf57ecc9d060b) }
+
+var fileDescriptor_7b5cf57ecc9d060b = []byte{
+	// 3223 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x5b, 0x6c, 0x1b, 0xc7,
+	0xd5, 0xf6, 0xca, 0x96, 0x2c, 0x8d, 0xac, 0x8b, 0xc7, 0xb2, 0x2d, 0xaf, 0x65, 0xd1, 0xde, 0x24,
+	0xb6, 0x7c, 0x11, 0xd7, 0x94, 0xaf, 0xf9, 0x73, 0x41, 0x44, 0xc9, 0xce, 0x1f, 0x44, 0x09, 0x14,
+	0x2a, 0x6a, 0x2e, 0x6d, 0xca, 0x8e, 0x96, 0x23, 0x7a, 0x23, 0x72, 0x97, 0xd9, 0x5d, 0xca, 0x11,
+	0x5c, 0x3f, 0xf4, 0x82, 0x16, 0x48, 0x6f, 0x01, 0x02, 0x14, 0xe8, 0x43, 0x91, 0x02, 0x35, 0xd0,
+	0x36, 0x0d, 0xd2, 0x02, 0x35, 0xda, 0x3e, 0x34, 0x7d, 0x68, 0x81, 0x36, 0x40, 0x81, 0x36, 0x48,
+	0x11, 0xa0, 0xe8, 0x03, 0x53, 0xc4, 0x45, 0x1f, 0xf4, 0xd4, 0xa7, 0xf6, 0xb5, 0xd8, 0x99, 0x33,
+	0xbb, 0xb3, 0xe4, 0x92, 0xdc, 0x35, 0x88, 0xd6, 0x79, 0x5a, 0x72, 0xe6, 0x7c, 0x73, 0xbe, 0x39,
+	0x33, 0x73, 0xe6, 0xcc, 0x99, 0x41, 0xc7, 0x2a, 0xe6, 0xba, 0x47, 0x4b, 0xa6, 0x65, 0x7a, 0xfa,
+	0x9a, 0x59, 0xa9, 0x98, 0x56, 0x59, 0xdf, 0xcc, 0xe9, 0xaf, 0xd4, 0xa9, 0xb3, 0x95, 0xad, 0x39,
+	0xb6, 0x67, 0xe3, 0xfd, 0xa1, 0x48, 0x16, 0x44, 0xb2, 0x9b, 0x39, 0xf5, 0x94, 0x61, 0xbb, 0x55,
+	0xdb, 0xd5, 0xd7, 0x88, 0x4b, 0xb9, 0xbc, 0xbe, 0x99, 0x5b, 0xa3, 0x1e, 0xc9, 0xe9, 0x35, 0x52,
+	0x36, 0x2d, 0xe2, 0x99, 0xb6, 0xc5, 0x9b, 0x50, 0xa7, 0x65, 0x59, 0x21, 0x65, 0xd8, 0xa6, 0xa8,
+	0x3f, 0xc4, 0xeb, 0x8b, 0xec, 0x9f, 0xce, 0xff, 0x40, 0xd5, 0x44, 0xd9, 0x2e, 0xdb, 0xbc, 0xdc,
+	0xff, 0x05, 0xa5, 0x53, 0x65, 0xdb, 0x2e, 0x57, 0xa8, 0x4e, 0x6a, 0xa6, 0x4e, 0x2c, 0xcb, 0xf6,
+	0x98, 0x36, 0x81, 0xc9, 0x40, 0x2d, 0xfb, 0xb7, 0x56, 0x5f, 0xd7, 0x3d, 0xb3, 0x4a, 0x5d, 0x8f,
+	0x54, 0x6b, 0x20, 0xd0, 0xa6, 0xd7, 0xde, 0x56, 0x8d, 0x42, 0x1b, 0xda, 0x04, 0xc2, 0xcf, 0xf8,
+	0x9d, 0x5a, 0x26, 0x0e, 0xa9, 0xba, 0x05, 0xfa, 0x4a, 0x9d, 0xba, 0x9e, 0xf6, 0x19, 0xb4, 0x2f,
+	0x52, 0xea, 0xd6, 0x6c, 0xcb, 0xa5, 0xf8, 0x0a, 0x1a, 0xa8, 0xb1, 0x92, 0x49, 0xe5, 0xa8, 0x32,
+	0x33, 0x3c, 0x77, 0x24, 0x1b, 0x6b, 0xb3, 0x2c, 0x87, 0xe5, 0x47, 0xdf, 0x6b, 0x64, 0x76, 0x6c,
+	0x37, 0x32, 0x00, 0x2a, 0xc0, 0x57, 0x5b, 0x42, 0x7b, 0x59, 0xeb, 0x4b, 0x94, 0xb8, 0x14, 0x54,
+	0xe2, 0x4b, 0x08, 0x55, 0xfc, 0xff, 0xc5, 0x7a, 0xdd, 0x2c, 0xb1, 0xf6, 0x87, 0xf2, 0x93, 0xdb,
+	0x8d, 0xcc, 0x44, 0x58, 0x7a, 0xc6, 0xae, 0x9a, 0x1e, 0xad, 0xd6, 0xbc, 0xad, 0xc2, 0x10, 0x2b,
+	0x5d, 0xad, 0x9b, 0x25, 0xed, 0x39, 0x84, 0xe5, 0xd6, 0x80, 0xea, 0x3c, 0xea, 0x67, 0x22, 0xc0,
+	0x74, 0xaa, 0x0d, 0x53, 0x06, 0xca, 0x8f, 0x00, 0x51, 0x0e, 0x29, 0xf0, 0x8f, 0xf6, 0x6b, 0x45,
+	0x6e, 0x59, 0xd8, 0x06, 0x5f, 0x45, 0x28, 0x1c, 0x78, 0x68, 0xfe, 0x78, 0x16, 0x06, 0xd3, 0x1f,
+	0xf9, 0x2c, 0x9f, 0x55, 0x30, 0xfe, 0xd9, 0x65, 0x52, 0x16, 0x9d, 0x2c, 0x48, 0x48, 0xfc, 0x39,
+	0xb4, 0xc7, 0xf5, 0x88, 0x47, 0x8b, 0xeb, 0x66, 0xc5, 0xa3, 0xce, 0x64, 0xdf, 0x51, 0x65, 0x66,
+	0x74, 0xee, 0x58, 0x27, 0xa2, 0x2b, 0xbe, 0x7c, 0x5e, 0xdd, 0x6e, 0x64, 0x0e, 0xc8, 0x50, 0xc9,
+	0x2e, 0xc3, 0xac, 0xfc, 0x2a, 0x2b, 0xd6, 0x6e, 0x29, 0x30, 0x8c, 0xa2, 0x03, 0x60, 0x9b, 0x45,
+	0x34, 0xc0, 0x7a, 0xe8, 0x0f, 0xe3, 0xce, 0xae, 0xc6, 0x09, 0x46, 0x91, 0x63, 0x0a, 0xf0, 0xc5,
+	0x8f, 0x47, 0xec, 0xd0, 0xc7, 0xec, 0x70, 0xa2, 0xab, 0x1d, 0x38, 0x05, 0xd9, 0x10, 0xda, 0xd7,
+	0xfb, 0x90, 0x2a, 0xd1, 0xcc, 0x6f, 0x3d, 0x4b, 0x2d, 0x62, 0x79, 0xc2, 0xde, 0x8b, 0x68, 0xc0,
+	0x63, 0x05, 0x30, 0x29, 0xce, 0x6c, 0x37, 0x32, 0xe3, 0xbc, 0x24, 0xec, 0xf8, 0x07, 0xb7, 0x67,
+	0x27, 0x40, 0xf5, 0x7c, 0xa9, 0xe4, 0x50, 0xd7, 0x5d, 0xf1, 0x1c, 0xd3, 0x2a, 0x17, 0x00, 0x8b,
+	0xaf, 0xc6, 0xb0, 0xed, 0xc5, 0xa8, 0xed, 0xec, 0xf9, 0xa8, 0xbd, 0xad, 0xa0, 0xc3, 0xb1, 0xe6,
+	0xb8, 0x37, 0x47, 0xef, 0xb5, 0x3e, 0x34, 0x15, 0xa1, 0xbb, 0xec, 0xd8, 0x9b, 0x66, 0x89, 0x3a,
+	0x62, 0xfc, 0x1e, 0x43, 0x23, 0x35, 0x28, 0x92, 0xd7, 0xf6, 0xe1, 0xed, 0x46, 0xe6, 0x60, 0xa4,
+	0x42, 0x32, 0xc8, 0x1e, 0x51, 0xe1, 0xaf, 0xf0, 0x4f, 0xd0, 0xd8, 0xbd, 0xa3, 0xa0, 0x23, 0x6d,
+	0x8c, 0x71, 0x6f, 0x8e, 0x1e, 0x41, 0x87, 0x18, 0xdf, 0x05, 0x87, 0x96, 0x4c, 0x6f, 0xde, 0x30,
+	0xec, 0x7a, 0x8f, 0x57, 0x9e, 0xf6, 0x61, 0x3f, 0x52, 0xe3, 0x74, 0x80, 0x41, 0x0c, 0x34, 0x6a,
+	0xb0, 0x8a, 0x22, 0xe1, 0x35, 0xe0, 0x52, 0xef, 0x6f, 0x63, 0x98, 0x48, 0x2b, 0xf9, 0x03, 0x60,
+	0xa0, 0xa6, 0x36, 0x0a, 0x23, 0x86, 0x2c, 0x86, 0x5d, 0x34, 0xb8, 0x46, 0x2a, 0xc4, 0x32, 0xa8,
+	0x3b, 0xd9, 0xc7, 0xec, 0x7e, 0x28, 0x62, 0x2d, 0x61, 0xa7, 0x05, 0xdb, 0xb4, 0xf2, 0x0f, 0x43,
+	0x9b, 0x01, 0xe4, 0xad, 0x8f, 0x32, 0x33, 0x65, 0xd3, 0xbb, 0x56, 0x5f, 0xcb, 0x1a, 0x76, 0x15,
+	0xf6, 0x6d, 0xf8, 0xcc, 0xba, 0xa5, 0x0d, 0xd8, 0x50, 0x7d, 0xb0, 0x5b, 0x08, 0x50, 0xf8, 0x75,
+	0x05, 0x61, 0xb2, 0x49, 0xcc, 0x0a, 0x59, 0xab, 0xd0, 0x62, 0xa0, 0x7f, 0x67, 0x37, 0xfd, 0x57,
+	0x41, 0x7f, 0x0c, 0x38, 0x15, 0x93, 0xbd, 0x01, 0x3e, 0x2f, 0x28, 0x95, 0xd1, 0xae, 0x12, 0x5d,
+	0xf3, 0x26, 0x77, 0x75, 0xe3, 0x70, 0x19, 0x38, 0x30, 0xf1, 0x54, 0x5a, 0x19, 0x02, 0x7f, 0x59,
+	0x41, 0xa3, 0x35, 0xc7, 0xae, 0xda, 0x61, 0xbf, 0xfb, 0xbb, 0xe9, 0xcc, 0x8b, 0xb1, 0x8c, 0x02,
+	0x53, 0x69, 0x1f, 0x61, 0xd8, 0xa0, 0xbf, 0x2f, 0xa2, 0x3d, 0xbc, 0xb1, 0xb2, 0x43, 0x2c, 0xcf,
+	0x9d, 0x1c, 0x60, 0x1c, 0xda, 0xad, 0xf8, 0x65, 0x5f, 0xf4, 0x71, 0x5f, 0x32, 0x3f, 0x01, 0x5c,
+	0x22, 0xf0, 0xc2, 0x70, 0x2d, 0x90, 0x70, 0x9b, 0x97, 0x0e, 0x9f, 0xfb, 0xbd, 0x5d, 0x3a, 0x5b,
+	0xd1, 0x95, 0x23, 0x54, 0xc0, 0xca, 0xf9, 0x74, 0xb8, 0x72, 0x78, 0x0d, 0xe8, 0x3a, 0xbf, 0xdd,
+	0xc8, 0x4c, 0x46, 0x6b, 0x12, 0xe8, 0x14, 0x2b, 0x86, 0x17, 0x6a, 0x2f, 0xa0, 0x69, 0xa6, 0xfa,
+	0x39, 0xd3, 0xbb, 0x56, 0x72, 0xc8, 0x75, 0x7f, 0x1a, 0xcd, 0x57, 0x65, 0xef, 0x70, 0xd7, 0x01,
+	0xdb, 0x1b, 0x0a, 0xca, 0xb4, 0x6d, 0x1b, 0xfa, 0x56, 0x43, 0xbb, 0x09, 0x2b, 0x11, 0x7e, 0xb2,
+	0xc3, 0xbc, 0x79, 0x08, 0xc6, 0x4a, 0x20, 0x52, 0x4d, 0x18, 0x01, 0xd2, 0xbe, 0xad, 0xa0, 0xa3,
+	0x3c, 0xe6, 0x05, 0x97, 0x2d, 0xb3, 0xeb, 0xdd, 0x5e, 0x76, 0x16, 0xf5, 0x57, 0xcc, 0xaa, 0xe9,
+	0x31, 0xa7, 0xbd, 0x8b, 0xef, 0x2c, 0xac, 0x20, 0x44, 0x9c, 0x71, 0xf9, 0x88, 0x70, 0x41, 0xed,
+	0x6b, 0x7d, 0xe8, 0x58, 0x07, 0x62, 0xff, 0x2b, 0x83, 0xe1, 0x79, 0x34, 0xcc, 0x47, 0x9a, 0x7b,
+	0x6d, 0xde, 0x9f, 0xa3, 0xdb, 0x8d, 0xcc, 0x94, 0x54, 0xdc, 0xda, 0x2b, 0x3e, 0x69, 0x16, 0xfc,
+	0x4a, 0x9c, 0x43, 0x83, 0xd7, 0x88, 0x5b, 0xac, 0xda, 0x0e, 0x65, 0x9b, 0xf1, 0x60, 0xfe, 0x80,
+	0xef, 0xf7, 0x44, 0x99, 0x64, 0xc4, 0xdd, 0xd7, 0x88, 0xfb, 0x94, 0xed, 0x50, 0xad, 0x14, 0xb7,
+	0x99, 0xf4, 0x3a, 0x36, 0xd7, 0xfe, 0x28, 0x62, 0xb0, 0x66, 0x35, 0x60, 0xed, 0x75, 0x34, 0x16,
+	0xdd, 0x70, 0x84, 0xd5, 0x93, 0xed, 0x5a, 0x07, 0x61, 0x00, 0x9a, 0x1b, 0x29, 0x8c, 0x46, 0xb6,
+	0xad, 0x1e, 0xee, 0xf3, 0xff, 0x52, 0xd0, 0xc1, 0x48, 0x60, 0xb2, 0xf2, 0xe4, 0xaa, 0x30, 0x5a,
+	0x0e, 0x0d, 0xba, 0x1b, 0x75, 0x79, 0x3e, 0xb3, 0x51, 0x10, 0x65, 0xf2, 0x28, 0xb8, 0x1b, 0xf5,
+	0x4f, 0x58, 0x44, 0xf6, 0x23, 0x05, 0x4d, 0xb6, 0x76, 0xfc, 0xde, 0x0c, 0xc6, 0xd6, 0x22, 0x73,
+	0xfb, 0x8a, 0xeb, 0x99, 0x55, 0xe2, 0xd1, 0xde, 0x6e, 0x29, 0xbf, 0x1c, 0x40, 0x87, 0x63, 0x95,
+	0x80, 0x49, 0xbe, 0xaa, 0xa0, 0x31, 0xa3, 0xee, 0x38, 0xd4, 0xf2, 0xc4, 0x0e, 0xdc, 0xdd, 0xa1,
+	0x2c, 0x04, 0xf3, 0x39, 0x8a, 0x4c, 0xe5, 0x58, 0x46, 0x01, 0x0c, 0x9b, 0x37, 0xfe, 0x8e, 0x82,
+	0xf6, 0x7b, 0xb6, 0x47, 0x2a, 0x45, 0xc7, 0x1f, 0xe6, 0x1a, 0x75, 0x8a, 0x2e, 0x35, 0x6c, 0xab,
+	0xd4, 0x3d, 0x82, 0x7b, 0x12, 0xf8, 0xc4, 0xe3, 0xd3, 0xb0, 0x9a, 0x54, 0x0a, 0x98, 0x35, 0x52,
+	0x20, 0x1e, 0x5d, 0xa6, 0xce, 0x0a, 0x6b, 0x01, 0x57, 0x91, 0x4a, 0xc1, 0x72, 0xa5, 0x62, 0xa9,
+	0xee, 0xb0, 0xf1, 0x83, 0xe6, 0x5d, 0x36, 0x8b, 0x77, 0xe5, 0xf5, 0xed, 0x46, 0xe6, 0x74, 0x7b,
+	0xa9, 0x56, 0xcf, 0x38, 0x19, 0x08, 0x2f, 0x82, 0x2c, 0xd7, 0xe6, 0xe2, 0x55, 0x84, 0x89, 0xe1,
+	0x99, 0x9b, 0xb4, 0x28, 0x7b, 0xdc, 0x5d, 0x4c, 0xcd, 0x89, 0xed, 0x46, 0xe6, 0xbe, 0xd6, 0xda,
+	0xd6, 0xe6, 0xc7, 0xb9, 0xd0, 0x52, 0xe8, 0x7e, 0xbf, 0xa0, 0xa0, 0x91, 0x48, 0xac, 0xd5, 0x3d,
+	0x46, 0x9b, 0x07, 0xcb, 0x46, 0x71, 0xa9, 0xc6, 0x79, 0x8f, 0x1c, 0xa2, 0xe1, 0x1f, 0x2a, 0xe8,
+	0x50, 0xec, 0x28, 0x15, 0x4b, 0xd4, 0x80, 0x78, 0x6d, 0x2a, 0x96, 0xcf, 0x22, 0x35, 0x18, 0xa5,
+	0x65, 0xa0, 0xd4, 0xbe, 0x99, 0xb7, 0x3e, 0xca, 0x9c, 0x4e, 0x40, 0x0f, 0x1a, 0x74, 0x0b, 0xfb,
+	0x5b, 0x47, 0x7c, 0x91, 0x1a, 0x9a, 0x01, 0x61, 0x0b, 0xf7, 0x06, 0x5b, 0x0b, 0x75, 0xd7, 0xb3,
+	0xab, 0x8b, 0x76, 0x95, 0x98, 0x96, 0x14, 0x1f, 0x18, 0xac, 0xb8, 0x58, 0x62, 0xe5, 0x72, 0x7c,
+	0x10, 0xa9, 0x90, 0xe3, 0x03, 0x43, 0x6a, 0x48, 0xbb, 0x25, 0xc2, 0x90, 0x58, 0x2d, 0x3d, 0x4b,
+	0x6e, 0xe1, 0x47, 0xd0, 0x1e, 0x97, 0x3a, 0x9b, 0xa6, 0x41, 0x8b, 0x16, 0xa9, 0x52, 0xe6, 0xb6,
+	0x86, 0xc0, 0xad, 0x4a, 0xe5, 0x11, 0xb7, 0xca, 0xcb, 0x9f, 0x26, 0x55, 0xaa, 0x19, 0xe0, 0x45,
+	0xe6, 0xc5, 0x11, 0x83, 0xbb, 0x93, 0xde, 0xfa, 0xaa, 0xef, 0x0d, 0xa0, 0xa9, 0x78, 0x2d, 0x60,
+	0x07, 0xf9, 0x58, 0xa7, 0xfc, 0xb7, 0x8e, 0x75, 0xaf, 0x29, 0x68, 0xdc, 0xa1, 0xbe, 0x31, 0x68,
+	0xa9, 0x28, 0x62, 0xae, 0xae, 0x2e, 0x69, 0x11, 0xb4, 0xb7, 0x40, 0x53, 0xb1, 0x18, 0x13, 0x68,
+	0x1e, 0x2e, 0xbb, 0xf8, 0x1b, 0x0a, 0xda, 0x5b, 0xb7, 0x5c, 0xea, 0x79, 0x15, 0x89, 0x4d, 0xd7,
+	0x23, 0xe6, 0x15, 0x60, 0xd3, 0x8a, 0x4d, 0x45, 0x67, 0x3c, 0x80, 0x0b, 0x3e, 0x9b, 0x68, 0x28,
+	0x38, 0x75, 0x76, 0x3f, 0x65, 0x3e, 0x02, 0x34, 0x42, 0x4c, 0x2a, 0xf5, 0x21, 0x0c, 0xdb, 0x08,
+	0x5f, 0x97, 0xc2, 0xe2, 0x22, 0x59, 0xf7, 0xc3, 0x89, 0x7e, 0xb6, 0x3c, 0xd4, 0x2c, 0xcf, 0x93,
+	0x67, 0x45, 0x9e, 0x3c, 0xfb, 0xac, 0xc8, 0x93, 0xe7, 0xef, 0xf7, 0xe3, 0xd5, 0x56, 0x64, 0x38,
+	0x49, 0x5f, 0xff, 0x28, 0xa3, 0x14, 0xf6, 0xca, 0x12, 0xf3, 0xbe, 0x00, 0x3b, 0xe0, 0x56, 0x6c,
+	0x63, 0x43, 0xb2, 0xfa, 0x40, 0xe2, 0x03, 0x6e, 0x14, 0x98, 0xee, 0x80, 0xcb, 0xb1, 0x60, 0x6f,
+	0x6d, 0x55, 0x4e, 0x9d, 0xce, 0x57, 0xa9, 0x55, 0xaa, 0xd2, 0x1e, 0x1c, 0xd1, 0xea, 0xe8, 0x70,
+	0x6c, 0xb3, 0xb0, 0xee, 0x3e, 0x85, 0x86, 0x88, 0x28, 0x04, 0x1f, 0xf4, 0x40, 0x27, 0x1f, 0x14,
+	0xb4, 0x90, 0xdf, 0x1b, 0x8c, 0x78, 0xd0, 0x68, 0xf8, 0x53, 0xfb, 0x81, 0x12, 0xab, 0xb7, 0xe7,
+	0xa9, 0xf7, 0x96, 0x63, 0x5c, 0x5f, 0xca, 0x63, 0x9c, 0xf6, 0x1b, 0x05, 0x4d, 0xc5, 0x33, 0x05,
+	0x13, 0xbd, 0x80, 0x50, 0xd0, 0x2f, 0xe1, 0x9c, 0x12, 0xda, 0x08, 0x83, 0x8d, 0xa4, 0x06, 0x0a,
+	0xd2, 0xef, 0xde, 0xc5, 0x9b, 0xcf, 0xa0, 0x03, 0x61, 0x1f, 0x56, 0xdd, 0xd0, 0x58, 0x77, 0x3f,
+	0x71, 0x08, 0x3a, 0xd8, 0xd2, 0x24, 0x58, 0xe4, 0x2a, 0xea, 0xaf, 0xfb, 0x05, 0x30, 0x6e, 0x1d,
+	0x83, 0x7c, 0x86, 0x0c, 0x77, 0x2e, 0x86, 0x2b, 0xf0, 0x8f, 0xf6, 0x34, 0x5c, 0x6a, 0x2c, 0x9a,
+	0x6e, 0xad, 0xee, 0xf5, 0x82, 0xf2, 0x44, 0xb4, 0x3d, 0xe0, 0xfb, 0x04, 0xda, 0x5d, 0xe2, 0x45,
+	0xc0, 0x78, 0xba, 0x0d, 0x63, 0x00, 0xe6, 0xc7, 0xc4, 0xb1, 0x1a, 0x60, 0x05, 0xf1, 0x43, 0xfb,
+	0xa7, 0x12, 0xd5, 0x71, 0xef, 0x4d, 0x68, 0xfc, 0x34, 0xea, 0x67, 0xc7, 0x26, 0x38, 0x82, 0xdd,
+	0xd7, 0xb9, 0xaf, 0xfc, 0x10, 0xb6, 0xcf, 0x0f, 0xf9, 0x19, 0x4a, 0x6a, 0x96, 0x37, 0xe3, 0x67,
+	0xc2, 0xf7, 0x37, 0x75, 0x19, 0xec, 0xba, 0x84, 0x06, 0xc1, 0x2e, 0x62, 0x5d, 0x74, 0x33, 0xec,
+	0xb8, 0xd8, 0xb9, 0x05, 0xae, 0x10, 0xfc, 0xea, 0xdd, 0x62, 0xf8, 0x2c, 0x78, 0x52, 0x91, 0x65,
+	0x59, 0xb6, 0x2b, 0xa6, 0xb1, 0xd5, 0xb3, 0xc4, 0x8f, 0xf6, 0x8f, 0x3e, 0x74, 0x38, 0x56, 0x01,
+	0x98, 0xe5, 0x29, 0x34, 0x50, 0x63, 0x25, 0x5d, 0x1c, 0x6a, 0x14, 0x2e, 0xdd, 0xb1, 0xf2, 0xe6,
+	0xe0, 0x8b, 0x4f, 0xa2, 0xfe, 0x75, 0xbb, 0x6e, 0xf1, 0x99, 0x30, 0xc8, 0x87, 0x8a, 0x15, 0xc8,
+	0x43, 0xc5, 0x0a, 0xf0, 0xcb, 0xe8, 0x10, 0x5d, 0x5f, 0xa7, 0xfc, 0x08, 0x51, 0xa3, 0x56, 0xc9,
+	0xb4, 0xca, 0x45, 0xcf, 0xac, 0x52, 0xbb, 0xee, 0xc1, 0x59, 0x26, 0xbb, 0xdd, 0xc8, 0x9c, 0x6a,
+	0x2b, 0xd4, 0x7a, 0xd6, 0x38, 0x18, 0xc8, 0x2e, 0x73, 0xd1, 0x67, 0xb9, 0x24, 0x26, 0xe8, 0x40,
+	0xd8, 0x4c, 0xd9, 0x21, 0x86, 0xdf, 0x98, 0x63, 0xda, 0x25, 0x38, 0xcd, 0x9c, 0xde, 0x6e, 0x64,
+	0x4e, 0xc4, 0x4b, 0xb4, 0x6a, 0x99, 0x08, 0x04, 0x1f, 0xf7, 0xe5, 0x96, 0x99, 0x98, 0xb6, 0x0e,
+	0x9e, 0x39, 0x62, 0x28, 0xb3, 0xe7, 0x6b, 0x4e, 0x7b, 0x57, 0xdc, 0xf5, 0xb4, 0x2a, 0x82, 0x21,
+	0x5d, 0x41, 0x83, 0x35, 0x28, 0xeb, 0xb2, 0x03, 0x34, 0x0d, 0x6a, 0x30, 0xe1, 0x05, 0xbc, 0x10,
+	0xfc, 0xea, 0xdd, 0x84, 0x7f, 0x09, 0xf2, 0xd7, 0x42, 0xb7, 0xbf, 0xa6, 0xdd, 0xde, 0xcd, 0xf7,
+	0x3f, 0xf4, 0x23, 0x35, 0xae, 0xfd, 0xc0, 0xbb, 0x32, 0x47, 0xe1, 0x76, 0xb9, 0xed, 0x89, 0x80,
+	0xc3, 0x0d, 0x81, 0x41, 0xb9, 0xab, 0x71, 0xf1, 0xf3, 0xe8, 0x10, 0x31, 0x36, 0x8a, 0x15, 0xe2,
+	0x51, 0xcb, 0xd8, 0x2a, 0xae, 0xd5, 0x8d, 0x0d, 0xea, 0x15, 0xd7, 0xfc, 0xb9, 0xcd, 0x03, 0xf3,
+	0x5d, 0xf9, 0x23, 0xfe, 0xf9, 0xb0, 0xad, 0x50, 0xe1, 0x00, 0x31, 0x36, 0x96, 0x78, 0x4d, 0x9e,
+	0x55, 0xe4, 0x59, 0x39, 0x7e, 0x1e, 0xed, 0x23, 0x9b, 0xd4, 0x21, 0x65, 0x5a, 0x94, 0xc0, 0xb0,
+	0x26, 0x66, 0xb6, 0x1b, 0x99, 0xfb, 0x63, 0xaa, 0x5b, 0xe7, 0xe9, 0x5e, 0x90, 0x9a, 0x0f, 0xb4,
+	0xf8, 0xeb, 0x40, 0x40, 0xf9, 0xfe, 0x54, 0x31, 0xd7, 0xa9, 0xbf, 0x9c, 0xe4, 0x75, 0x10, 0x2f,
+	0x11, 0xb3, 0x0e, 0x40, 0x90, 0x6d, 0x9d, 0x4b, 0x20, 0x86, 0x3f, 0x8f, 0x26, 0x88, 0xb1, 0x61,
+	0xd9, 0xd7, 0x2b, 0xb4, 0x54, 0xa6, 0x7e, 0xe8, 0xc0, 0xce, 0xc6, 0x2c, 0x28, 0x1e, 0xca, 0x3f,
+	0xe1, 0x9b, 0xf2, 0xaf, 0x8d, 0xcc, 0x61, 0x3e, 0x73, 0xdc, 0xd2, 0x46, 0xd6, 0xb4, 0xf5, 0x2a,
+	0xf1, 0xae, 0x65, 0x97, 0x68, 0x99, 0x18, 0x5b, 0x8b, 0xd4, 0xf0, 0x77, 0xcf, 0xb8, 0x26, 0x3e,
+	0xb8, 0x3d, 0x8b, 0x60, 0xc2, 0x2d, 0x52, 0xa3, 0xb0, 0xaf, 0x49, 0xa6, 0x40, 0x3c, 0x8a, 0x5f,
+	0x46, 0xa3, 0x0e, 0x7d, 0xd9, 0x5f, 0x9d, 0xb6, 0xc5, 0xf5, 0x0e, 0x30, 0xbd, 0x0b, 0xc9, 0xf4,
+	0x36, 0x81, 0x9b, 0x34, 0x8e, 0x04, 0xb5, 0x4c, 0x17, 0x41, 0xc3, 0xf4, 0xd5, 0x9a, 0xe9, 0x6c,
+	0x71, 0x45, 0xbb, 0x99, 0xa2, 0xc7, 0x92, 0x29, 0x92, 0x91, 0x4d, 0x5a, 0x10, 0xaf, 0xf2, 0x55,
+	0x68, 0x6f, 0x46, 0xe2, 0xbd, 0x15, 0x76, 0xe8, 0x89, 0x84, 0xa6, 0x77, 0x1b, 0x7e, 0xf4, 0x2a,
+	0x95, 0xaa, 0xfd, 0x3e, 0x72, 0xf5, 0x1c, 0x61, 0x08, 0x4b, 0xee, 0x25, 0x34, 0xec, 0x86, 0xc5,
+	0xe0, 0x91, 0x8e, 0x77, 0xcc, 0xb5, 0x06, 0xe2, 0xf9, 0x7d, 0xb0, 0xf4, 0xe4, 0x26, 0x0a, 0xf2,
+	0x9f, 0xde, 0x39, 0x26, 0x03, 0x1c, 0xd3, 0x95, 0x57, 0x8d, 0x6b, 0xc4, 0x2a, 0x53, 0x7f, 0x00,
+	0x7a, 0xee, 0xbd, 0xff, 0xad, 0x20, 0x35, 0x4e, 0x0b, 0xd8, 0xea, 0xff, 0x51, 0xbf, 0x3f, 0x23,
+	0x84, 0x95, 0xda, 0x85, 0x43, 0x32, 0x38, 0xf4, 0x4e, 0x0c, 0x59, 0xe0, 0x1f, 0x7c, 0x15, 0x8d,
+	0x39, 0x74, 0x9d, 0x3a, 0xd4, 0x32, 0x68, 0xb1, 0x44, 0x2d, 0xbb, 0x0a, 0xc1, 0x19, 0xf3, 0x49,
+	0x4d, 0x55, 0xd2, 0x14, 0x19, 0x0d, 0xaa, 0x16, 0xfd, 0x9a, 0x26, 0xf3, 0xee, 0xbc, 0x7b, 0xf3,
+	0x2e, 0x43, 0x1c, 0xb2, 0xf2, 0xe4, 0x2a, 0xe4, 0x55, 0xcc, 0x8a, 0xe9, 0x6d, 0xdd, 0xfd, 0x6d,
+	0x80, 0xf6, 0xa1, 0x58, 0x1c, 0x2d, 0x4d, 0x82, 0x35, 0x1f, 0x44, 0x83, 0x06, 0xa9, 0x11, 0xc3,
+	0xf4, 0x78, 0x74, 0x03, 0x0e, 0x59, 0x94, 0xb5, 0x7a, 0xb4, 0x40, 0x1c, 0x3f, 0x8c, 0x86, 0x48,
+	0xa5, 0x62, 0x1b, 0xc4, 0xa3, 0x25, 0xb8, 0x63, 0x9a, 0xde, 0x6e, 0x64, 0xd4, 0xa0, 0xb0, 0x15,
+	0x1c, 0x02, 0x18, 0x3a, 0x48, 0x47, 0xec, 0x94, 0xd0, 0xa2, 0x30, 0x0e, 0x2d, 0xea, 0xe6, 0xbe,
+	0xa4, 0xa1, 0x7e, 0xd6, 0x2f, 0xfc, 0x15, 0x05, 0x0d, 0xf0, 0x47, 0x6d, 0xf8, 0x64, 0x9b, 0xa9,
+	0xd0, 0xfa, 0x8a, 0x4e, 0x3d, 0x95, 0x44, 0x94, 0x9b, 0x48, 0x7b, 0xe0, 0x8b, 0x7f, 0xfe, 0xfb,
+	0x1b, 0x7d, 0x19, 0x7c, 0x44, 0x8f, 0x7f, 0xb3, 0xc7, 0x9f, 0xce, 0xe1, 0x6f, 0x29, 0xa8, 0x9f,
+	0x2d, 0x4d, 0x3c, 0xd3, 0xa9, 0x71, 0xf9, 0x65, 0x9d, 0x7a, 0x32, 0x81, 0x24, 0xb0, 0xc8, 0x31,
+	0x16, 0xa7, 0xf1, 0xc9, 0x36, 0x2c, 0x98, 0xdb, 0xd2, 0x6f, 0x84, 0x3e, 0xed, 0x26, 0x33, 0xcd,
+	0x12, 0xbf, 0x08, 0xe9, 0xae, 0x28, 0x99, 0x69, 0xa2, 0xcf, 0xd5, 0xba, 0x9a, 0x06, 0xae, 0x61,
+	0xde, 0x51, 0xd0, 0x68, 0xf4, 0xc9, 0x14, 0xce, 0x75, 0xd7, 0xd2, 0xf4, 0xda, 0x4c, 0x9d, 0x4b,
+	0x03, 0x01, 0x82, 0x17, 0x18, 0x41, 0x1d, 0xcf, 0x76, 0x24, 0xa8, 0xf3, 0xac, 0xa6, 0x7e, 0x83,
+	0x7f, 0x6f, 0xe2, 0x77, 0x15, 0x34, 0xde, 0xfc, 0x4e, 0x08, 0x9f, 0x4b, 0xa2, 0xbf, 0xe9, 0x89,
+	0x95, 0x7a, 0x3e, 0x1d, 0x08, 0x68, 0x3f, 0xca, 0x68, 0x5f, 0xc6, 0x17, 0x3b, 0xd3, 0x16, 0x51,
+	0x9d, 0x7e, 0x23, 0x12, 0xf8, 0xdd, 0xc4, 0xb7, 0x14, 0x34, 0x12, 0xb9, 0xd7, 0xc4, 0x67, 0x3b,
+	0xf1, 0x88, 0x7b, 0x62, 0xa4, 0xe6, 0x52, 0x20, 0x80, 0x76, 0x96, 0xd1, 0x9e, 0xc1, 0xc7, 0xdb,
+	0xd0, 0xe6, 0x57, 0xa8, 0xa1, 0x99, 0xdf, 0x0e, 0x69, 0xf2, 0x2c, 0x73, 0x22, 0x9a, 0x91, 0xe7,
+	0x1c, 0x6a, 0x2e, 0x05, 0x02, 0x68, 0x5e, 0x64, 0x34, 0xcf, 0xe2, 0x6c, 0x47, 0x9a, 0xb3, 0xf0,
+	0x40, 0x23, 0xa4, 0xfb, 0x5b, 0x05, 0xe1, 0xd6, 0x87, 0x11, 0xf8, 0x42, 0x27, 0x06, 0x6d, 0x1f,
+	0x69, 0xa8, 0x17, 0xd3, 0xc2, 0xd2, 0xcc, 0x8d, 0x88, 0x23, 0xd0, 0xe5, 0x1c, 0x29, 0xfe, 0x93,
+	0x82, 0x26, 0xe2, 0xde, 0x2b, 0xe0, 0x4b, 0x1d, 0x7d, 0x62, 0xfb, 0xa7, 0x17, 0xea, 0xe5, 0xf4,
+	0x40, 0xe8, 0xcb, 0x22, 0xeb, 0xcb, 0xa3, 0xf8, 0xe1, 0x76, 0xae, 0xb5, 0xcd, 0x04, 0x8f, 0xf6,
+	0xe8, 0x4d, 0x05, 0x8d, 0x2e, 0x44, 0x6f, 0xe7, 0x93, 0x4f, 0x5e, 0x37, 0x91, 0x7b, 0x89, 0x7f,
+	0x6c, 0xa0, 0x1d, 0x67, 0xfc, 0x8f, 0xe2, 0xe9, 0x8e, 0x33, 0xc9, 0xf5, 0xd7, 0xe3, 0xb0, 0x74,
+	0xcb, 0x8d, 0xb3, 0x49, 0xbc, 0x42, 0xf8, 0x0e, 0x40, 0xd5, 0x13, 0xcb, 0x03, 0xb1, 0xf3, 0x8c,
+	0x58, 0x16, 0x9f, 0xe9, 0xec, 0x40, 0xdc, 0x8d, 0xba, 0x7e, 0x43, 0xc4, 0x0f, 0x37, 0xf1, 0x4f,
+	0x03, 0x43, 0x8a, 0xcb, 0xe7, 0x24, 0x86, 0x6c, 0xba, 0x0d, 0x57, 0xe7, 0xd2, 0x40, 0x80, 0xef,
+	0x25, 0xc6, 0x37, 0x87, 0xf5, 0x64, 0x9e, 0x43, 0x17, 0xf7, 0xb1, 0xf8, 0x77, 0x0a, 0xda, 0x17,
+	0x73, 0x1f, 0x87, 0x2f, 0x76, 0xb5, 0x58, 0xec, 0x35, 0xa1, 0x7a, 0x29, 0x35, 0x2e, 0xd5, 0xb2,
+	0x5c, 0xdb, 0x9a, 0xe5, 0xb7, 0x8c, 0xfa, 0x8d, 0xc8, 0xa5, 0xe3, 0x4d, 0x7c, 0x5b, 0x41, 0x63,
+	0x4d, 0x97, 0x69, 0xb8, 0xa3, 0x25, 0xe3, 0xef, 0xf7, 0xd4, 0x73, 0xa9, 0x30, 0x40, 0xfe, 0x32,
+	0x23, 0x3f, 0x87, 0xcf, 0x26, 0x34, 0x7f, 0x78, 0xbb, 0x73, 0x5b, 0x6c, 0xed, 0x41, 0x92, 0x3c,
+	0xc1, 0xd6, 0xde, 0x7c, 0x1b, 0xa2, 0xce, 0xa5, 0x81, 0x00, 0xe7, 0x87, 0x18, 0xe7, 0x0b, 0xf8,
+	0x5c, 0x72, 0x3f, 0x18, 0x64, 0xea, 0xf1, 0x8f, 0x15, 0x34, 0x16, 0x6d, 0xd7, 0xc5, 0x29, 0x48,
+	0xb8, 0x89, 0xac, 0xdd, 0xe6, 0x02, 0x42, 0xd3, 0x19, 0xf3, 0x93, 0xf8, 0x44, 0x27, 0xe6, 0xb3,
+	0xd2, 0xb5, 0xc2, 0x2d, 0x05, 0xa1, 0x30, 0xf9, 0x8e, 0x67, 0xbb, 0x2a, 0x95, 0x6f, 0x0c, 0xd4,
+	0x6c, 0x52, 0xf1, 0x84, 0x6b, 0x31, 0xc6, 0xb0, 0x2c, 0xfd, 0x8f, 0xbf, 0xab, 0xa0, 0xdd, 0x90,
+	0x18, 0xc6, 0x1d, 0xa3, 0xc8, 0xe8, 0xfd, 0x80, 0x7a, 0x3a, 0x91, 0x2c, 0xb0, 0x7b, 0x90, 0xb1,
+	0x3b, 0x87, 0x73, 0xc9, 0xd9, 0x41, 0x46, 0x1a, 0x7f, 0x53, 0x41, 0x83, 0x8b, 0x22, 0x3b, 0x9d,
+	0x44, 0x69, 0x30, 0xcc, 0x67, 0x92, 0x09, 0x03, 0xc5, 0x13, 0x8c, 0xe2, 0x31, 0x9c, 0x69, 0x43,
+	0x31, 0xc8, 0x90, 0xff, 0x42, 0x41, 0xa3, 0xd1, 0xfc, 0x62, 0xe7, 0xc5, 0x13, 0x9b, 0x00, 0x57,
+	0xe7, 0xd2, 0x40, 0x12, 0x7a, 0xab, 0xb6, 0x1b, 0x2f, 0xe4, 0xb0, 0x7f, 0xa2, 0xa0, 0xf1, 0xe6,
+	0xe4, 0x6a, 0xe7, 0x00, 0xb9, 0x4d, 0xce, 0x57, 0x3d, 0x9f, 0x0e, 0x04, 0xfc, 0xcf, 0x32, 0xfe,
+	0xa7, 0xf0, 0x4c, 0x17, 0xfe, 0xb3, 0x41, 0x72, 0xf6, 0x67, 0x0a, 0x1a, 0x89, 0xa4, 0x2c, 0x3b,
+	0xc7, 0x9a, 0x71, 0xa9, 0x57, 0x35, 0x97, 0x02, 0x01, 0x44, 0x1f, 0x61, 0x44, 0x2f, 0xe1, 0x0b,
+	0x69, 0x0d, 0xcd, 0x13, 0xa8, 0xbf, 0x12, 0x07, 0x11, 0x29, 0x6b, 0x94, 0xe0, 0x20, 0xd2, 0x9a,
+	0x05, 0x53, 0xcf, 0xa7, 0x03, 0x25, 0xa4, 0x1f, 0xb3, 0xda, 0xe4, 0xc4, 0xd3, 0xf7, 0x15, 0x34,
+	0x12, 0xc9, 0xe2, 0x74, 0x36, 0x7a, 0x5c, 0x5a, 0x49, 0xcd, 0xa5, 0x40, 0x00, 0xeb, 0x59, 0xc6,
+	0xfa, 0x04, 0x7e, 0xa0, 0x0d, 0x6b, 0x0a, 0xa8, 0x59, 0x9e, 0x07, 0xfa, 0xb9, 0x82, 0xc6, 0x9a,
+	0xf2, 0x23, 0x9d, 0x37, 0x83, 0xf8, 0xfc, 0x8c, 0x7a, 0x2e, 0x15, 0x06, 0xb8, 0xfe, 0x1f, 0xe3,
+	0x7a, 0x1e, 0xcf, 0xb5, 0xe1, 0x1a, 0x0d, 0xd1, 0x74, 0x22, 0xb5, 0x91, 0x5f, 0x7d, 0xef, 0xe3,
+	0x69, 0xe5, 0xfd, 0x8f, 0xa7, 0x95, 0xbf, 0x7d, 0x3c, 0xad, 0xbc, 0x7e, 0x67, 0x7a, 0xc7, 0xfb,
+	0x77, 0xa6, 0x77, 0xfc, 0xe5, 0xce, 0xf4, 0x8e, 0x17, 0x1f, 0x92, 0x5e, 0x2d, 0x54, 0x89, 0x65,
+	0xae, 0x53, 0xd7, 0x9b, 0xb5, 0xa8, 0x77, 0xdd, 0x76, 0x36, 0xc2, 0x02, 0x96, 0x17, 0x76, 0xf4,
+	0x57, 0x03, 0x7d, 0xec, 0x39, 0xc3, 0xda, 0x00, 0x7b, 0x8d, 0x71, 0xee, 0x3f, 0x03, 0x00, 0x3a,
+	0x58, 0xdf, 0x15, 0x9a, 0x39, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Lease queries a lease by UUID.
+	Lease(ctx context.Context, in *QueryLeaseRequest, opts ...grpc.CallOption) (*QueryLeaseResponse, error)
+	// Leases queries all leases with pagination.
+	Leases(ctx context.Context, in *QueryLeasesRequest, opts ...grpc.CallOption) (*QueryLeasesResponse, error)
+	// LeasesByTenant queries leases by tenant address.
+	LeasesByTenant(ctx context.Context, in *QueryLeasesByTenantRequest, opts ...grpc.CallOption) (*QueryLeasesByTenantResponse, error)
+	// LeasesByProvider queries leases by provider.
+	LeasesByProvider(ctx context.Context, in *QueryLeasesByProviderRequest, opts ...grpc.CallOption) (*QueryLeasesByProviderResponse, error)
+	// CreditAccount queries a tenant's credit account.
+	CreditAccount(ctx context.Context, in *QueryCreditAccountRequest, opts ...grpc.CallOption) (*QueryCreditAccountResponse, error)
+	// CreditAddress derives the credit address for a tenant.
+	CreditAddress(ctx context.Context, in *QueryCreditAddressRequest, opts ...grpc.CallOption) (*QueryCreditAddressResponse, error)
+	// WithdrawableAmount queries the amount available for provider withdrawal from a lease.
+	WithdrawableAmount(ctx context.Context, in *QueryWithdrawableAmountRequest, opts ...grpc.CallOption) (*QueryWithdrawableAmountResponse, error)
+	// ProviderWithdrawable queries the total amount available for a provider to withdraw across all leases.
+	ProviderWithdrawable(ctx context.Context, in *QueryProviderWithdrawableRequest, opts ...grpc.CallOption) (*QueryProviderWithdrawableResponse, error)
+	// CreditAccounts queries all credit accounts with pagination.
+	CreditAccounts(ctx context.Context, in *QueryCreditAccountsRequest, opts ...grpc.CallOption) (*QueryCreditAccountsResponse, error)
+	// LeasesBySKU queries leases by SKU UUID.
+	LeasesBySKU(ctx context.Context, in *QueryLeasesBySKURequest, opts ...grpc.CallOption) (*QueryLeasesBySKUResponse, error)
+	// CreditEstimate estimates remaining lease duration for a tenant.
+	CreditEstimate(ctx context.Context, in *QueryCreditEstimateRequest, opts ...grpc.CallOption) (*QueryCreditEstimateResponse, error)
+	// LeaseByCustomDomain returns the active or pending lease that has claimed
+	// the given custom_domain, if any.
+	LeaseByCustomDomain(ctx context.Context, in *QueryLeaseByCustomDomainRequest, opts ...grpc.CallOption) (*QueryLeaseByCustomDomainResponse, error)
+	// AvailableCredit returns the amount of credit a tenant can withdraw via
+	// MsgWithdrawCredit, together with the reserved and unsettled amounts that
+	// are held back and the time at which the withdrawal cooldown ends.
+	AvailableCredit(ctx context.Context, in *QueryAvailableCreditRequest, opts ...grpc.CallOption) (*QueryAvailableCreditResponse, error)
+	// LeaseAmendment returns the pending amendment of a lease.
+	LeaseAmendment(ctx context.Context, in *QueryLeaseAmendmentRequest, opts ...grpc.CallOption) (*QueryLeaseAmendmentResponse, error)
+	// LeaseAmendments returns all pending lease amendments, optionally filtered
+	// by provider.
+	LeaseAmendments(ctx context.Context, in *QueryLeaseAmendmentsRequest, opts ...grpc.CallOption) (*QueryLeaseAmendmentsResponse, error)
+	// LeaseUsage returns the usage counters and spend limit of a metered lease.
+	LeaseUsage(ctx context.Context, in *QueryLeaseUsageRequest, opts ...grpc.CallOption) (*QueryLeaseUsageResponse, error)
+	// Dispute returns the dispute of a lease.
+	Dispute(ctx context.Context, in *QueryDisputeRequest, opts ...grpc.CallOption) (*QueryDisputeResponse, error)
+	// Disputes returns all disputes, optionally filtered by provider and state.
+	Disputes(ctx context.Context, in *QueryDisputesRequest, opts ...grpc.CallOption) (*QueryDisputesResponse, error)
+	// ProviderPolicy returns the lease policy of a provider with the pending
+	// timeout and grace period that apply to its leases.
+	ProviderPolicy(ctx context.Context, in *QueryProviderPolicyRequest, opts ...grpc.CallOption) (*QueryProviderPolicyResponse, error)
+	// ProviderPolicies returns all provider lease policies.
+	ProviderPolicies(ctx context.Context, in *QueryProviderPoliciesRequest, opts ...grpc.CallOption) (*QueryProviderPoliciesResponse, error)
+	// ProviderStats returns the lease counters of a provider together with the
+	// rates and averages derived from them.
+	ProviderStats(ctx context.Context, in *QueryProviderStatsRequest, opts ...grpc.CallOption) (*QueryProviderStatsResponse, error)
+	// LeaseSettlements returns the settlement records of a lease, oldest first.
+	LeaseSettlements(ctx context.Context, in *QueryLeaseSettlementsRequest, opts ...grpc.CallOption) (*QueryLeaseSettlementsResponse, error)
+	// ExchangeRates returns the exchange-rate table and its reference denom.
+	ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error)
+	// SKUAvailability returns how much of a SKU's capacity PENDING and ACTIVE
+	// leases hold and how much is left.
+	SKUAvailability(ctx context.Context, in *QuerySKUAvailabilityRequest, opts ...grpc.CallOption) (*QuerySKUAvailabilityResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryCreditAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Lease(ctx context.Context, in *QueryLeaseRequest, opts ...grpc.CallOption) (*QueryLeaseResponse, error) {
+	out := new(QueryLeaseResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/Lease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryCreditAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Leases(ctx context.Context, in *QueryLeasesRequest, opts ...grpc.CallOption) (*QueryLeasesResponse, error) {
+	out := new(QueryLeasesResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/Leases", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryCreditAccountsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LeasesByTenant(ctx context.Context, in *QueryLeasesByTenantRequest, opts ...grpc.CallOption) (*QueryLeasesByTenantResponse, error) {
+	out := new(QueryLeasesByTenantResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/LeasesByTenant", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryCreditAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LeasesByProvider(ctx context.Context, in *QueryLeasesByProviderRequest, opts ...grpc.CallOption) (*QueryLeasesByProviderResponse, error) {
+	out := new(QueryLeasesByProviderResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/LeasesByProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryCreditAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.CreditAccounts) > 0 {
-		for iNdEx := len(m.CreditAccounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CreditAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) CreditAccount(ctx context.Context, in *QueryCreditAccountRequest, opts ...grpc.CallOption) (*QueryCreditAccountResponse, error) {
+	out := new(QueryCreditAccountResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/CreditAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryLeasesBySKURequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CreditAddress(ctx context.Context, in *QueryCreditAddressRequest, opts ...grpc.CallOption) (*QueryCreditAddressResponse, error) {
+	out := new(QueryCreditAddressResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/CreditAddress", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryLeasesBySKURequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) WithdrawableAmount(ctx context.Context, in *QueryWithdrawableAmountRequest, opts ...grpc.CallOption) (*QueryWithdrawableAmountResponse, error) {
+	out := new(QueryWithdrawableAmountResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/WithdrawableAmount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryLeasesBySKURequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StateFilter != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StateFilter))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SkuUuid) > 0 {
-		i -= len(m.SkuUuid)
-		copy(dAtA[i:], m.SkuUuid)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SkuUuid)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ProviderWithdrawable(ctx context.Context, in *QueryProviderWithdrawableRequest, opts ...grpc.CallOption) (*QueryProviderWithdrawableResponse, error) {
+	out := new(QueryProviderWithdrawableResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/ProviderWithdrawable", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryLeasesBySKUResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CreditAccounts(ctx context.Context, in *QueryCreditAccountsRequest, opts ...grpc.CallOption) (*QueryCreditAccountsResponse, error) {
+	out := new(QueryCreditAccountsResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/CreditAccounts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryLeasesBySKUResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LeasesBySKU(ctx context.Context, in *QueryLeasesBySKURequest, opts ...grpc.CallOption) (*QueryLeasesBySKUResponse, error) {
+	out := new(QueryLeasesBySKUResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/LeasesBySKU", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryLeasesBySKUResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Leases) > 0 {
-		for iNdEx := len(m.Leases) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Leases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) CreditEstimate(ctx context.Context, in *QueryCreditEstimateRequest, opts ...grpc.CallOption) (*QueryCreditEstimateResponse, error) {
+	out := new(QueryCreditEstimateResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/CreditEstimate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryCreditEstimateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LeaseByCustomDomain(ctx context.Context, in *QueryLeaseByCustomDomainRequest, opts ...grpc.CallOption) (*QueryLeaseByCustomDomainResponse, error) {
+	out := new(QueryLeaseByCustomDomainResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/LeaseByCustomDomain", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryCreditEstimateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AvailableCredit(ctx context.Context, in *QueryAvailableCreditRequest, opts ...grpc.CallOption) (*QueryAvailableCreditResponse, error) {
+	out := new(QueryAvailableCreditResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/AvailableCredit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryCreditEstimateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Tenant) > 0 {
-		i -= len(m.Tenant)
-		copy(dAtA[i:], m.Tenant)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) LeaseAmendment(ctx context.Context, in *QueryLeaseAmendmentRequest, opts ...grpc.CallOption) (*QueryLeaseAmendmentResponse, error) {
+	out := new(QueryLeaseAmendmentResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/LeaseAmendment", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryCreditEstimateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LeaseAmendments(ctx context.Context, in *QueryLeaseAmendmentsRequest, opts ...grpc.CallOption) (*QueryLeaseAmendmentsResponse, error) {
+	out := new(QueryLeaseAmendmentsResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/LeaseAmendments", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryCreditEstimateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LeaseUsage(ctx context.Context, in *QueryLeaseUsageRequest, opts ...grpc.CallOption) (*QueryLeaseUsageResponse, error) {
+	out := new(QueryLeaseUsageResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/LeaseUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryCreditEstimateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ActiveLeaseCount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ActiveLeaseCount))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.EstimatedDurationSeconds != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EstimatedDurationSeconds))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.TotalRatePerSecond) > 0 {
-		for iNdEx := len(m.TotalRatePerSecond) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalRatePerSecond[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.CurrentBalance) > 0 {
-		for iNdEx := len(m.CurrentBalance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CurrentBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) Dispute(ctx context.Context, in *QueryDisputeRequest, opts ...grpc.CallOption) (*QueryDisputeResponse, error) {
+	out := new(QueryDisputeResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/Dispute", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryLeaseByCustomDomainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Disputes(ctx context.Context, in *QueryDisputesRequest, opts ...grpc.CallOption) (*QueryDisputesResponse, error) {
+	out := new(QueryDisputesResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/Disputes", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryLeaseByCustomDomainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ProviderPolicy(ctx context.Context, in *QueryProviderPolicyRequest, opts ...grpc.CallOption) (*QueryProviderPolicyResponse, error) {
+	out := new(QueryProviderPolicyResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/ProviderPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryLeaseByCustomDomainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CustomDomain) > 0 {
-		i -= len(m.CustomDomain)
-		copy(dAtA[i:], m.CustomDomain)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CustomDomain)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ProviderPolicies(ctx context.Context, in *QueryProviderPoliciesRequest, opts ...grpc.CallOption) (*QueryProviderPoliciesResponse, error) {
+	out := new(QueryProviderPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/ProviderPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryLeaseByCustomDomainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ProviderStats(ctx context.Context, in *QueryProviderStatsRequest, opts ...grpc.CallOption) (*QueryProviderStatsResponse, error) {
+	out := new(QueryProviderStatsResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/ProviderStats", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryLeaseByCustomDomainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LeaseSettlements(ctx context.Context, in *QueryLeaseSettlementsRequest, opts ...grpc.CallOption) (*QueryLeaseSettlementsResponse, error) {
+	out := new(QueryLeaseSettlementsResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/LeaseSettlements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryLeaseByCustomDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ServiceName) > 0 {
-		i -= len(m.ServiceName)
-		copy(dAtA[i:], m.ServiceName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ServiceName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Lease.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error) {
+	out := new(QueryExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/ExchangeRates", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) SKUAvailability(ctx context.Context, in *QuerySKUAvailabilityRequest, opts ...grpc.CallOption) (*QuerySKUAvailabilityResponse, error) {
+	out := new(QuerySKUAvailabilityResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Query/SKUAvailability", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Lease queries a lease by UUID.
+	Lease(context.Context, *QueryLeaseRequest) (*QueryLeaseResponse, error)
+	// Leases queries all leases with pagination.
+	Leases(context.Context, *QueryLeasesRequest) (*QueryLeasesResponse, error)
+	// LeasesByTenant queries leases by tenant address.
+	LeasesByTenant(context.Context, *QueryLeasesByTenantRequest) (*QueryLeasesByTenantResponse, error)
+	// LeasesByProvider queries leases by provider.
+	LeasesByProvider(context.Context, *QueryLeasesByProviderRequest) (*QueryLeasesByProviderResponse, error)
+	// CreditAccount queries a tenant's credit account.
+	CreditAccount(context.Context, *QueryCreditAccountRequest) (*QueryCreditAccountResponse, error)
+	// CreditAddress derives the credit address for a tenant.
+	CreditAddress(context.Context, *QueryCreditAddressRequest) (*QueryCreditAddressResponse, error)
+	// WithdrawableAmount queries the amount available for provider withdrawal from a lease.
+	WithdrawableAmount(context.Context, *QueryWithdrawableAmountRequest) (*QueryWithdrawableAmountResponse, error)
+	// ProviderWithdrawable queries the total amount available for a provider to withdraw across all leases.
+	ProviderWithdrawable(context.Context, *QueryProviderWithdrawableRequest) (*QueryProviderWithdrawableResponse, error)
+	// CreditAccounts queries all credit accounts with pagination.
+	CreditAccounts(context.Context, *QueryCreditAccountsRequest) (*QueryCreditAccountsResponse, error)
+	// LeasesBySKU queries leases by SKU UUID.
+	LeasesBySKU(context.Context, *QueryLeasesBySKURequest) (*QueryLeasesBySKUResponse, error)
+	// CreditEstimate estimates remaining lease duration for a tenant.
+	CreditEstimate(context.Context, *QueryCreditEstimateRequest) (*QueryCreditEstimateResponse, error)
+	// LeaseByCustomDomain returns the active or pending lease that has claimed
+	// the given custom_domain, if any.
+	LeaseByCustomDomain(context.Context, *QueryLeaseByCustomDomainRequest) (*QueryLeaseByCustomDomainResponse, error)
+	// AvailableCredit returns the amount of credit a tenant can withdraw via
+	// MsgWithdrawCredit, together with the reserved and unsettled amounts that
+	// are held back and the time at which the withdrawal cooldown ends.
+	AvailableCredit(context.Context, *QueryAvailableCreditRequest) (*QueryAvailableCreditResponse, error)
+	// LeaseAmendment returns the pending amendment of a lease.
+	LeaseAmendment(context.Context, *QueryLeaseAmendmentRequest) (*QueryLeaseAmendmentResponse, error)
+	// LeaseAmendments returns all pending lease amendments, optionally filtered
+	// by provider.
+	LeaseAmendments(context.Context, *QueryLeaseAmendmentsRequest) (*QueryLeaseAmendmentsResponse, error)
+	// LeaseUsage returns the usage counters and spend limit of a metered lease.
+	LeaseUsage(context.Context, *QueryLeaseUsageRequest) (*QueryLeaseUsageResponse, error)
+	// Dispute returns the dispute of a lease.
+	Dispute(context.Context, *QueryDisputeRequest) (*QueryDisputeResponse, error)
+	// Disputes returns all disputes, optionally filtered by provider and state.
+	Disputes(context.Context, *QueryDisputesRequest) (*QueryDisputesResponse, error)
+	// ProviderPolicy returns the lease policy of a provider with the pending
+	// timeout and grace period that apply to its leases.
+	ProviderPolicy(context.Context, *QueryProviderPolicyRequest) (*QueryProviderPolicyResponse, error)
+	// ProviderPolicies returns all provider lease policies.
+	ProviderPolicies(context.Context, *QueryProviderPoliciesRequest) (*QueryProviderPoliciesResponse, error)
+	// ProviderStats returns the lease counters of a provider together with the
+	// rates and averages derived from them.
+	ProviderStats(context.Context, *QueryProviderStatsRequest) (*QueryProviderStatsResponse, error)
+	// LeaseSettlements returns the settlement records of a lease, oldest first.
+	LeaseSettlements(context.Context, *QueryLeaseSettlementsRequest) (*QueryLeaseSettlementsResponse, error)
+	// ExchangeRates returns the exchange-rate table and its reference denom.
+	ExchangeRates(context.Context, *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error)
+	// SKUAvailability returns how much of a SKU's capacity PENDING and ACTIVE
+	// leases hold and how much is left.
+	SKUAvailability(context.Context, *QuerySKUAvailabilityRequest) (*QuerySKUAvailabilityResponse, error)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryLeaseRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.LeaseUuid)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Lease(ctx context.Context, req *QueryLeaseRequest) (*QueryLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lease not implemented")
+}
+func (*UnimplementedQueryServer) Leases(ctx context.Context, req *QueryLeasesRequest) (*QueryLeasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Leases not implemented")
+}
+func (*UnimplementedQueryServer) LeasesByTenant(ctx context.Context, req *QueryLeasesByTenantRequest) (*QueryLeasesByTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeasesByTenant not implemented")
+}
+func (*UnimplementedQueryServer) LeasesByProvider(ctx context.Context, req *QueryLeasesByProviderRequest) (*QueryLeasesByProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeasesByProvider not implemented")
+}
+func (*UnimplementedQueryServer) CreditAccount(ctx context.Context, req *QueryCreditAccountRequest) (*QueryCreditAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreditAccount not implemented")
+}
+func (*UnimplementedQueryServer) CreditAddress(ctx context.Context, req *QueryCreditAddressRequest) (*QueryCreditAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreditAddress not implemented")
+}
+func (*UnimplementedQueryServer) WithdrawableAmount(ctx context.Context, req *QueryWithdrawableAmountRequest) (*QueryWithdrawableAmountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawableAmount not implemented")
+}
+func (*UnimplementedQueryServer) ProviderWithdrawable(ctx context.Context, req *QueryProviderWithdrawableRequest) (*QueryProviderWithdrawableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderWithdrawable not implemented")
+}
+func (*UnimplementedQueryServer) CreditAccounts(ctx context.Context, req *QueryCreditAccountsRequest) (*QueryCreditAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreditAccounts not implemented")
+}
+func (*UnimplementedQueryServer) LeasesBySKU(ctx context.Context, req *QueryLeasesBySKURequest) (*QueryLeasesBySKUResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeasesBySKU not implemented")
+}
+func (*UnimplementedQueryServer) CreditEstimate(ctx context.Context, req *QueryCreditEstimateRequest) (*QueryCreditEstimateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreditEstimate not implemented")
+}
+func (*UnimplementedQueryServer) LeaseByCustomDomain(ctx context.Context, req *QueryLeaseByCustomDomainRequest) (*QueryLeaseByCustomDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaseByCustomDomain not implemented")
+}
+func (*UnimplementedQueryServer) AvailableCredit(ctx context.Context, req *QueryAvailableCreditRequest) (*QueryAvailableCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AvailableCredit not implemented")
+}
+func (*UnimplementedQueryServer) LeaseAmendment(ctx context.Context, req *QueryLeaseAmendmentRequest) (*QueryLeaseAmendmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaseAmendment not implemented")
+}
+func (*UnimplementedQueryServer) LeaseAmendments(ctx context.Context, req *QueryLeaseAmendmentsRequest) (*QueryLeaseAmendmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaseAmendments not implemented")
+}
+func (*UnimplementedQueryServer) LeaseUsage(ctx context.Context, req *QueryLeaseUsageRequest) (*QueryLeaseUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaseUsage not implemented")
+}
+func (*UnimplementedQueryServer) Dispute(ctx context.Context, req *QueryDisputeRequest) (*QueryDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Dispute not implemented")
+}
+func (*UnimplementedQueryServer) Disputes(ctx context.Context, req *QueryDisputesRequest) (*QueryDisputesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Disputes not implemented")
+}
+func (*UnimplementedQueryServer) ProviderPolicy(ctx context.Context, req *QueryProviderPolicyRequest) (*QueryProviderPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderPolicy not implemented")
+}
+func (*UnimplementedQueryServer) ProviderPolicies(ctx context.Context, req *QueryProviderPoliciesRequest) (*QueryProviderPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderPolicies not implemented")
+}
+func (*UnimplementedQueryServer) ProviderStats(ctx context.Context, req *QueryProviderStatsRequest) (*QueryProviderStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderStats not implemented")
+}
+func (*UnimplementedQueryServer) LeaseSettlements(ctx context.Context, req *QueryLeaseSettlementsRequest) (*QueryLeaseSettlementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaseSettlements not implemented")
+}
+func (*UnimplementedQueryServer) ExchangeRates(ctx context.Context, req *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRates not implemented")
+}
+func (*UnimplementedQueryServer) SKUAvailability(ctx context.Context, req *QuerySKUAvailabilityRequest) (*QuerySKUAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SKUAvailability not implemented")
 }
 
-func (m *QueryLeaseResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Lease.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryLeasesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if m.StateFilter != 0 {
-		n += 1 + sovQuery(uint64(m.StateFilter))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Query/Params",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLeasesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Lease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Leases) > 0 {
-		for _, e := range m.Leases {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Lease(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Query/Lease",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Lease(ctx, req.(*QueryLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLeasesByTenantRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Leases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLeasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Tenant)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Leases(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Query/Leases",
 	}
-	if m.StateFilter != 0 {
-		n += 1 + sovQuery(uint64(m.StateFilter))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Leases(ctx, req.(*QueryLeasesRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLeasesByTenantResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_LeasesByTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLeasesByTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Leases) > 0 {
-		for _, e := range m.Leases {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).LeasesByTenant(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Query/LeasesByTenant",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LeasesByTenant(ctx, req.(*QueryLeasesByTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLeasesByProviderRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_LeasesByProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLeasesByProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ProviderUuid)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).LeasesByProvider(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Query/LeasesByProvider",
 	}
-	if m.StateFilter != 0 {
-		n += 1 + sovQuery(uint64(m.StateFilter))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LeasesByProvider(ctx, req.(*QueryLeasesByProviderRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLeasesByProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Leases) > 0 {
-		for _, e := range m.Leases {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func _Query_CreditAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCreditAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).CreditAccount(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryCreditAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Query/CreditAccount",
 	}
-	var l int
-	_ = l
-	l = len(m.Tenant)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreditAccount(ctx, req.(*QueryCreditAccountRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCreditAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_CreditAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCreditAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.CreditAccount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if len(m.Balances) > 0 {
-		for _, e := range m.Balances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).CreditAddress(ctx, in)
 	}
-	if len(m.AvailableBalances) > 0 {
-		for _, e := range m.AvailableBalances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Query/CreditAddress",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreditAddress(ctx, req.(*QueryCreditAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCreditAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_WithdrawableAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawableAmountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Tenant)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).WithdrawableAmount(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryCreditAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Query/WithdrawableAmount",
 	}
-	var l int
-	_ = l
-	l = len(m.CreditAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WithdrawableAmount(ctx, req.(*QueryWithdrawableAmountRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWithdrawableAmountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ProviderWithdrawable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderWithdrawableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.LeaseUuid)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderWithdrawable(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryWithdrawableAmountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Query/ProviderWithdrawable",
 	}
-	var l int
-	_ = l
-	if len(m.Amounts) > 0 {
-		for _, e := range m.Amounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderWithdrawable(ctx, req.(*QueryProviderWithdrawableRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryProviderWithdrawableRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_CreditAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCreditAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ProviderUuid)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).CreditAccounts(ctx, in)
 	}
-	if m.Limit != 0 {
-		n += 1 + sovQuery(uint64(m.Limit))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Query/CreditAccounts",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreditAccounts(ctx, req.(*QueryCreditAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryProviderWithdrawableResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_LeasesBySKU_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLeasesBySKURequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Amounts) > 0 {
-		for _, e := range m.Amounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).LeasesBySKU(ctx, in)
 	}
-	if m.LeaseCount != 0 {
-		n += 1 + sovQuery(uint64(m.LeaseCount))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Query/LeasesBySKU",
 	}
-	if m.HasMore {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LeasesBySKU(ctx, req.(*QueryLeasesBySKURequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCreditAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_CreditEstimate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCreditEstimateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).CreditEstimate(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Query/CreditEstimate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreditEstimate(ctx, req.(*QueryCreditEstimateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCreditAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_LeaseByCustomDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLeaseByCustomDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.CreditAccounts) > 0 {
-		for _, e := range m.CreditAccounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).LeaseByCustomDomain(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Query/LeaseByCustomDomain",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LeaseByCustomDomain(ctx, req.(*QueryLeaseByCustomDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLeasesBySKURequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AvailableCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAvailableCreditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.SkuUuid)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).AvailableCredit(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Query/AvailableCredit",
 	}
-	if m.StateFilter != 0 {
-		n += 1 + sovQuery(uint64(m.StateFilter))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AvailableCredit(ctx, req.(*QueryAvailableCreditRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLeasesBySKUResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_LeaseAmendment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLeaseAmendmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Leases) > 0 {
-		for _, e := range m.Leases {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).LeaseAmendment(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Query/LeaseAmendment",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LeaseAmendment(ctx, req.(*QueryLeaseAmendmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCreditEstimateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_LeaseAmendments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLeaseAmendmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Tenant)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).LeaseAmendments(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Query/LeaseAmendments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LeaseAmendments(ctx, req.(*QueryLeaseAmendmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCreditEstimateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_LeaseUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLeaseUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.CurrentBalance) > 0 {
-		for _, e := range m.CurrentBalance {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).LeaseUsage(ctx, in)
 	}
-	if len(m.TotalRatePerSecond) > 0 {
-		for _, e := range m.TotalRatePerSecond {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Query/LeaseUsage",
 	}
-	if m.EstimatedDurationSeconds != 0 {
-		n += 1 + sovQuery(uint64(m.EstimatedDurationSeconds))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LeaseUsage(ctx, req.(*QueryLeaseUsageRequest))
 	}
-	if m.ActiveLeaseCount != 0 {
-		n += 1 + sovQuery(uint64(m.ActiveLeaseCount))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Dispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).Dispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Query/Dispute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Dispute(ctx, req.(*QueryDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLeaseByCustomDomainRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Disputes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDisputesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.CustomDomain)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Disputes(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Query/Disputes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Disputes(ctx, req.(*QueryDisputesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLeaseByCustomDomainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ProviderPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Lease.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.ServiceName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderPolicy(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Query/ProviderPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderPolicy(ctx, req.(*QueryProviderPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func _Query_ProviderPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Query/ProviderPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderPolicies(ctx, req.(*QueryProviderPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func _Query_ProviderStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Query/ProviderStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderStats(ctx, req.(*QueryProviderStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LeaseSettlements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLeaseSettlementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LeaseSettlements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Query/LeaseSettlements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LeaseSettlements(ctx, req.(*QueryLeaseSettlementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Query/ExchangeRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRates(ctx, req.(*QueryExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SKUAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySKUAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SKUAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Query/SKUAvailability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SKUAvailability(ctx, req.(*QuerySKUAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "liftedinit.billing.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Lease",
+			Handler:    _Query_Lease_Handler,
+		},
+		{
+			MethodName: "Leases",
+			Handler:    _Query_Leases_Handler,
+		},
+		{
+			MethodName: "LeasesByTenant",
+			Handler:    _Query_LeasesByTenant_Handler,
+		},
+		{
+			MethodName: "LeasesByProvider",
+			Handler:    _Query_LeasesByProvider_Handler,
+		},
+		{
+			MethodName: "CreditAccount",
+			Handler:    _Query_CreditAccount_Handler,
+		},
+		{
+			MethodName: "CreditAddress",
+			Handler:    _Query_CreditAddress_Handler,
+		},
+		{
+			MethodName: "WithdrawableAmount",
+			Handler:    _Query_WithdrawableAmount_Handler,
+		},
+		{
+			MethodName: "ProviderWithdrawable",
+			Handler:    _Query_ProviderWithdrawable_Handler,
+		},
+		{
+			MethodName: "CreditAccounts",
+			Handler:    _Query_CreditAccounts_Handler,
+		},
+		{
+			MethodName: "LeasesBySKU",
+			Handler:    _Query_LeasesBySKU_Handler,
+		},
+		{
+			MethodName: "CreditEstimate",
+			Handler:    _Query_CreditEstimate_Handler,
+		},
+		{
+			MethodName: "LeaseByCustomDomain",
+			Handler:    _Query_LeaseByCustomDomain_Handler,
+		},
+		{
+			MethodName: "AvailableCredit",
+			Handler:    _Query_AvailableCredit_Handler,
+		},
+		{
+			MethodName: "LeaseAmendment",
+			Handler:    _Query_LeaseAmendment_Handler,
+		},
+		{
+			MethodName: "LeaseAmendments",
+			Handler:    _Query_LeaseAmendments_Handler,
+		},
+		{
+			MethodName: "LeaseUsage",
+			Handler:    _Query_LeaseUsage_Handler,
+		},
+		{
+			MethodName: "Dispute",
+			Handler:    _Query_Dispute_Handler,
+		},
+		{
+			MethodName: "Disputes",
+			Handler:    _Query_Disputes_Handler,
+		},
+		{
+			MethodName: "ProviderPolicy",
+			Handler:    _Query_ProviderPolicy_Handler,
+		},
+		{
+			MethodName: "ProviderPolicies",
+			Handler:    _Query_ProviderPolicies_Handler,
+		},
+		{
+			MethodName: "ProviderStats",
+			Handler:    _Query_ProviderStats_Handler,
+		},
+		{
+			MethodName: "LeaseSettlements",
+			Handler:    _Query_LeaseSettlements_Handler,
+		},
+		{
+			MethodName: "ExchangeRates",
+			Handler:    _Query_ExchangeRates_Handler,
+		},
+		{
+			MethodName: "SKUAvailability",
+			Handler:    _Query_SKUAvailability_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "liftedinit/billing/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeaseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeaseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeaseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LeaseUuid) > 0 {
+		i -= len(m.LeaseUuid)
+		copy(dAtA[i:], m.LeaseUuid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LeaseUuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Lease.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeasesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeasesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeasesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StateFilter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StateFilter))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeasesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeasesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeasesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Leases) > 0 {
+		for iNdEx := len(m.Leases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Leases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeasesByTenantRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeasesByTenantRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeasesByTenantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StateFilter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StateFilter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeasesByTenantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeasesByTenantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeasesByTenantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Leases) > 0 {
+		for iNdEx := len(m.Leases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Leases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeasesByProviderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeasesByProviderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeasesByProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StateFilter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StateFilter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderUuid) > 0 {
+		i -= len(m.ProviderUuid)
+		copy(dAtA[i:], m.ProviderUuid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderUuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeasesByProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeasesByProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeasesByProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Leases) > 0 {
+		for iNdEx := len(m.Leases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Leases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCreditAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCreditAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCreditAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCreditAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCreditAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCreditAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PromoGrants) > 0 {
+		for iNdEx := len(m.PromoGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PromoGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PromoBalances) > 0 {
+		for iNdEx := len(m.PromoBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PromoBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Debt) > 0 {
+		for iNdEx := len(m.Debt) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Debt[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AvailableBalances) > 0 {
+		for iNdEx := len(m.AvailableBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AvailableBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.CreditAccount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCreditAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCreditAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCreditAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCreditAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCreditAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCreditAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CreditAddress) > 0 {
+		i -= len(m.CreditAddress)
+		copy(dAtA[i:], m.CreditAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CreditAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawableAmountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawableAmountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawableAmountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LeaseUuid) > 0 {
+		i -= len(m.LeaseUuid)
+		copy(dAtA[i:], m.LeaseUuid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LeaseUuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawableAmountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawableAmountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawableAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderWithdrawableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderWithdrawableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderWithdrawableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProviderUuid) > 0 {
+		i -= len(m.ProviderUuid)
+		copy(dAtA[i:], m.ProviderUuid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderUuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderWithdrawableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderWithdrawableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderWithdrawableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HasMore {
+		i--
+		if m.HasMore {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LeaseCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LeaseCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCreditAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCreditAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCreditAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCreditAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCreditAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCreditAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CreditAccounts) > 0 {
+		for iNdEx := len(m.CreditAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreditAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeasesBySKURequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeasesBySKURequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeasesBySKURequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StateFilter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StateFilter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SkuUuid) > 0 {
+		i -= len(m.SkuUuid)
+		copy(dAtA[i:], m.SkuUuid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SkuUuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeasesBySKUResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeasesBySKUResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeasesBySKUResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Leases) > 0 {
+		for iNdEx := len(m.Leases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Leases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCreditEstimateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCreditEstimateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCreditEstimateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCreditEstimateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCreditEstimateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCreditEstimateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalRatePerSecondDec) > 0 {
+		for iNdEx := len(m.TotalRatePerSecondDec) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalRatePerSecondDec[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PromoBalance) > 0 {
+		for iNdEx := len(m.PromoBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PromoBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ActiveLeaseCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActiveLeaseCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EstimatedDurationSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EstimatedDurationSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TotalRatePerSecond) > 0 {
+		for iNdEx := len(m.TotalRatePerSecond) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalRatePerSecond[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CurrentBalance) > 0 {
+		for iNdEx := len(m.CurrentBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrentBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeaseByCustomDomainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeaseByCustomDomainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeaseByCustomDomainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CustomDomain) > 0 {
+		i -= len(m.CustomDomain)
+		copy(dAtA[i:], m.CustomDomain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CustomDomain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeaseByCustomDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeaseByCustomDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeaseByCustomDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ServiceName) > 0 {
+		i -= len(m.ServiceName)
+		copy(dAtA[i:], m.ServiceName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ServiceName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Lease.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAvailableCreditRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAvailableCreditRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAvailableCreditRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAvailableCreditResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAvailableCreditResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAvailableCreditResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockedAmounts) > 0 {
+		for iNdEx := len(m.LockedAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockedAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.WithdrawableAfter != nil {
+		n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.WithdrawableAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.WithdrawableAfter):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintQuery(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Available) > 0 {
+		for iNdEx := len(m.Available) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Available[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.UnsettledAmounts) > 0 {
+		for iNdEx := len(m.UnsettledAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnsettledAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ReservedAmounts) > 0 {
+		for iNdEx := len(m.ReservedAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReservedAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeaseAmendmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeaseAmendmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeaseAmendmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LeaseUuid) > 0 {
+		i -= len(m.LeaseUuid)
+		copy(dAtA[i:], m.LeaseUuid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LeaseUuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeaseAmendmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeaseAmendmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeaseAmendmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amendment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeaseAmendmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeaseAmendmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeaseAmendmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderUuid) > 0 {
+		i -= len(m.ProviderUuid)
+		copy(dAtA[i:], m.ProviderUuid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderUuid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeaseAmendmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeaseAmendmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeaseAmendmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amendments) > 0 {
+		for iNdEx := len(m.Amendments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amendments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeaseUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeaseUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeaseUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LeaseUuid) > 0 {
+		i -= len(m.LeaseUuid)
+		copy(dAtA[i:], m.LeaseUuid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LeaseUuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeaseUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeaseUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeaseUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDisputeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDisputeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDisputeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LeaseUuid) > 0 {
+		i -= len(m.LeaseUuid)
+		copy(dAtA[i:], m.LeaseUuid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LeaseUuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDisputeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Dispute.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDisputesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDisputesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDisputesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.State != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ProviderUuid) > 0 {
+		i -= len(m.ProviderUuid)
+		copy(dAtA[i:], m.ProviderUuid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderUuid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDisputesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDisputesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDisputesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Disputes) > 0 {
+		for iNdEx := len(m.Disputes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Disputes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderUuid) > 0 {
+		i -= len(m.ProviderUuid)
+		copy(dAtA[i:], m.ProviderUuid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderUuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveGracePeriod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EffectiveGracePeriod))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EffectivePendingTimeout != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EffectivePendingTimeout))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Found {
+		i--
+		if m.Found {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderPoliciesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderPoliciesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderPoliciesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderPoliciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderPoliciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderPoliciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Policies) > 0 {
+		for iNdEx := len(m.Policies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Policies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderUuid) > 0 {
+		i -= len(m.ProviderUuid)
+		copy(dAtA[i:], m.ProviderUuid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderUuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExpiryRate.Size()
+		i -= size
+		if _, err := m.ExpiryRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.RejectionRate.Size()
+		i -= size
+		if _, err := m.RejectionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.AcknowledgementRate.Size()
+		i -= size
+		if _, err := m.AcknowledgementRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.AverageLeaseLifetime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AverageLeaseLifetime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AverageAckLatency != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AverageAckLatency))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AckLatencyBucketBounds) > 0 {
+		dAtA27 := make([]byte, len(m.AckLatencyBucketBounds)*10)
+		var j26 int
+		for _, num := range m.AckLatencyBucketBounds {
+			for num >= 1<<7 {
+				dAtA27[j26] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j26++
+			}
+			dAtA27[j26] = uint8(num)
+			j26++
+		}
+		i -= j26
+		copy(dAtA[i:], dAtA27[:j26])
+		i = encodeVarintQuery(dAtA, i, uint64(j26))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeaseSettlementsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeaseSettlementsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeaseSettlementsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LeaseUuid) > 0 {
+		i -= len(m.LeaseUuid)
+		copy(dAtA[i:], m.LeaseUuid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LeaseUuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeaseSettlementsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeaseSettlementsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeaseSettlementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Settlements) > 0 {
+		for iNdEx := len(m.Settlements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Settlements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ReferenceDenom) > 0 {
+		i -= len(m.ReferenceDenom)
+		copy(dAtA[i:], m.ReferenceDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReferenceDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rates) > 0 {
+		for iNdEx := len(m.Rates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySKUAvailabilityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySKUAvailabilityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySKUAvailabilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SkuUuid) > 0 {
+		i -= len(m.SkuUuid)
+		copy(dAtA[i:], m.SkuUuid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SkuUuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySKUAvailabilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySKUAvailabilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySKUAvailabilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Available != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Available))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Allocated != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Allocated))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Capacity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Capacity))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLeaseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LeaseUuid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLeaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Lease.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLeasesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StateFilter != 0 {
+		n += 1 + sovQuery(uint64(m.StateFilter))
+	}
+	return n
+}
+
+func (m *QueryLeasesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Leases) > 0 {
+		for _, e := range m.Leases {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLeasesByTenantRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StateFilter != 0 {
+		n += 1 + sovQuery(uint64(m.StateFilter))
+	}
+	return n
+}
+
+func (m *QueryLeasesByTenantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Leases) > 0 {
+		for _, e := range m.Leases {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLeasesByProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderUuid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StateFilter != 0 {
+		n += 1 + sovQuery(uint64(m.StateFilter))
+	}
+	return n
+}
+
+func (m *QueryLeasesByProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Leases) > 0 {
+		for _, e := range m.Leases {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCreditAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCreditAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CreditAccount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.AvailableBalances) > 0 {
+		for _, e := range m.AvailableBalances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Debt) > 0 {
+		for _, e := range m.Debt {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PromoBalances) > 0 {
+		for _, e := range m.PromoBalances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PromoGrants) > 0 {
+		for _, e := range m.PromoGrants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCreditAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCreditAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CreditAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWithdrawableAmountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LeaseUuid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWithdrawableAmountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProviderWithdrawableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderUuid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryProviderWithdrawableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.LeaseCount != 0 {
+		n += 1 + sovQuery(uint64(m.LeaseCount))
+	}
+	if m.HasMore {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryCreditAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCreditAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CreditAccounts) > 0 {
+		for _, e := range m.CreditAccounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLeasesBySKURequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SkuUuid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StateFilter != 0 {
+		n += 1 + sovQuery(uint64(m.StateFilter))
+	}
+	return n
+}
+
+func (m *QueryLeasesBySKUResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Leases) > 0 {
+		for _, e := range m.Leases {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCreditEstimateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCreditEstimateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CurrentBalance) > 0 {
+		for _, e := range m.CurrentBalance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalRatePerSecond) > 0 {
+		for _, e := range m.TotalRatePerSecond {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.EstimatedDurationSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.EstimatedDurationSeconds))
+	}
+	if m.ActiveLeaseCount != 0 {
+		n += 1 + sovQuery(uint64(m.ActiveLeaseCount))
+	}
+	if len(m.PromoBalance) > 0 {
+		for _, e := range m.PromoBalance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalRatePerSecondDec) > 0 {
+		for _, e := range m.TotalRatePerSecondDec {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLeaseByCustomDomainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CustomDomain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLeaseByCustomDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Lease.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ServiceName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAvailableCreditRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAvailableCreditResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ReservedAmounts) > 0 {
+		for _, e := range m.ReservedAmounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UnsettledAmounts) > 0 {
+		for _, e := range m.UnsettledAmounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Available) > 0 {
+		for _, e := range m.Available {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.WithdrawableAfter != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.WithdrawableAfter)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.LockedAmounts) > 0 {
+		for _, e := range m.LockedAmounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLeaseAmendmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LeaseUuid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLeaseAmendmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amendment.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLeaseAmendmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProviderUuid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLeaseAmendmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amendments) > 0 {
+		for _, e := range m.Amendments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLeaseUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LeaseUuid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLeaseUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Usage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDisputeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LeaseUuid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDisputeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Dispute.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDisputesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProviderUuid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovQuery(uint64(m.State))
+	}
+	return n
+}
+
+func (m *QueryDisputesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Disputes) > 0 {
+		for _, e := range m.Disputes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderUuid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Policy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Found {
+		n += 2
+	}
+	if m.EffectivePendingTimeout != 0 {
+		n += 1 + sovQuery(uint64(m.EffectivePendingTimeout))
+	}
+	if m.EffectiveGracePeriod != 0 {
+		n += 1 + sovQuery(uint64(m.EffectiveGracePeriod))
+	}
+	return n
+}
+
+func (m *QueryProviderPoliciesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderPoliciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Policies) > 0 {
+		for _, e := range m.Policies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderUuid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.AckLatencyBucketBounds) > 0 {
+		l = 0
+		for _, e := range m.AckLatencyBucketBounds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.AverageAckLatency != 0 {
+		n += 1 + sovQuery(uint64(m.AverageAckLatency))
+	}
+	if m.AverageLeaseLifetime != 0 {
+		n += 1 + sovQuery(uint64(m.AverageLeaseLifetime))
+	}
+	l = m.AcknowledgementRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RejectionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ExpiryRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLeaseSettlementsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LeaseUuid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLeaseSettlementsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Settlements) > 0 {
+		for _, e := range m.Settlements {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExchangeRatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExchangeRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rates) > 0 {
+		for _, e := range m.Rates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.ReferenceDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySKUAvailabilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SkuUuid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySKUAvailabilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Capacity != 0 {
+		n += 1 + sovQuery(uint64(m.Capacity))
+	}
+	if m.Allocated != 0 {
+		n += 1 + sovQuery(uint64(m.Allocated))
+	}
+	if m.Available != 0 {
+		n += 1 + sovQuery(uint64(m.Available))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLeaseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLeaseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLeaseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLeaseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLeaseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLeaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Lease.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLeasesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLeasesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLeasesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateFilter", wireType)
+			}
+			m.StateFilter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StateFilter |= LeaseState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLeasesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLeasesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLeasesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leases = append(m.Leases, Lease{})
+			if err := m.Leases[len(m.Leases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLeasesByTenantRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLeasesByTenantRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLeasesByTenantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateFilter", wireType)
+			}
+			m.StateFilter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StateFilter |= LeaseState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLeasesByTenantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLeasesByTenantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLeasesByTenantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leases = append(m.Leases, Lease{})
+			if err := m.Leases[len(m.Leases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLeasesByProviderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLeasesByProviderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLeasesByProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateFilter", wireType)
+			}
+			m.StateFilter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StateFilter |= LeaseState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLeasesByProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLeasesByProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLeasesByProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leases = append(m.Leases, Lease{})
+			if err := m.Leases[len(m.Leases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCreditAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCreditAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCreditAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCreditAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCreditAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCreditAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CreditAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvailableBalances = append(m.AvailableBalances, types.Coin{})
+			if err := m.AvailableBalances[len(m.AvailableBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Debt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Debt = append(m.Debt, types.Coin{})
+			if err := m.Debt[len(m.Debt)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromoBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PromoBalances = append(m.PromoBalances, types.Coin{})
+			if err := m.PromoBalances[len(m.PromoBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromoGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PromoGrants = append(m.PromoGrants, PromoGrant{})
+			if err := m.PromoGrants[len(m.PromoGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCreditAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCreditAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCreditAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCreditAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCreditAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCreditAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawableAmountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawableAmountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawableAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawableAmountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawableAmountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawableAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amounts = append(m.Amounts, types.Coin{})
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderWithdrawableRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderWithdrawableRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderWithdrawableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderWithdrawableResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderWithdrawableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderWithdrawableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amounts = append(m.Amounts, types.Coin{})
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseCount", wireType)
+			}
+			m.LeaseCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaseCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMore", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMore = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCreditAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCreditAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCreditAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCreditAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCreditAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCreditAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditAccounts = append(m.CreditAccounts, CreditAccount{})
+			if err := m.CreditAccounts[len(m.CreditAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLeasesBySKURequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLeasesBySKURequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLeasesBySKURequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkuUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SkuUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateFilter", wireType)
+			}
+			m.StateFilter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StateFilter |= LeaseState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLeasesBySKUResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLeasesBySKUResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLeasesBySKUResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leases = append(m.Leases, Lease{})
+			if err := m.Leases[len(m.Leases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCreditEstimateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCreditEstimateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCreditEstimateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCreditEstimateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCreditEstimateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCreditEstimateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentBalance = append(m.CurrentBalance, types.Coin{})
+			if err := m.CurrentBalance[len(m.CurrentBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRatePerSecond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalRatePerSecond = append(m.TotalRatePerSecond, types.Coin{})
+			if err := m.TotalRatePerSecond[len(m.TotalRatePerSecond)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDurationSeconds", wireType)
+			}
+			m.EstimatedDurationSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedDurationSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveLeaseCount", wireType)
+			}
+			m.ActiveLeaseCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveLeaseCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromoBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PromoBalance = append(m.PromoBalance, types.Coin{})
+			if err := m.PromoBalance[len(m.PromoBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRatePerSecondDec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalRatePerSecondDec = append(m.TotalRatePerSecondDec, types.DecCoin{})
+			if err := m.TotalRatePerSecondDec[len(m.TotalRatePerSecondDec)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLeaseByCustomDomainRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3625,12 +9888,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLeaseByCustomDomainRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLeaseByCustomDomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomDomain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CustomDomain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3652,7 +9947,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLeaseByCustomDomainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3675,15 +9970,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLeaseByCustomDomainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLeaseByCustomDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3710,10 +10005,42 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Lease.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3735,7 +10062,89 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLeaseRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAvailableCreditRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAvailableCreditRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAvailableCreditRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAvailableCreditResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3745,30 +10154,132 @@ func (m *QueryLeaseRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAvailableCreditResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAvailableCreditResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReservedAmounts = append(m.ReservedAmounts, types.Coin{})
+			if err := m.ReservedAmounts[len(m.ReservedAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnsettledAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.UnsettledAmounts = append(m.UnsettledAmounts, types.Coin{})
+			if err := m.UnsettledAmounts[len(m.UnsettledAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLeaseRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLeaseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3778,77 +10289,65 @@ func (m *QueryLeaseRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.Available = append(m.Available, types.Coin{})
+			if err := m.Available[len(m.Available)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawableAfter", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryLeaseResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.WithdrawableAfter == nil {
+				m.WithdrawableAfter = new(time.Time)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLeaseResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLeaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.WithdrawableAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedAmounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3875,7 +10374,8 @@ func (m *QueryLeaseResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Lease.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LockedAmounts = append(m.LockedAmounts, types.Coin{})
+			if err := m.LockedAmounts[len(m.LockedAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3900,7 +10400,7 @@ func (m *QueryLeaseResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLeasesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLeaseAmendmentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3923,17 +10423,17 @@ func (m *QueryLeasesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLeasesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLeaseAmendmentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLeasesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLeaseAmendmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3943,47 +10443,24 @@ func (m *QueryLeasesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StateFilter", wireType)
-			}
-			m.StateFilter = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StateFilter |= LeaseState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4005,7 +10482,7 @@ func (m *QueryLeasesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLeasesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLeaseAmendmentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4028,49 +10505,15 @@ func (m *QueryLeasesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLeasesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLeaseAmendmentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLeasesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLeaseAmendmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leases", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Leases = append(m.Leases, Lease{})
-			if err := m.Leases[len(m.Leases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amendment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4097,10 +10540,7 @@ func (m *QueryLeasesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amendment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4125,7 +10565,7 @@ func (m *QueryLeasesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLeasesByTenantRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLeaseAmendmentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4148,45 +10588,13 @@ func (m *QueryLeasesByTenantRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLeasesByTenantRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLeaseAmendmentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLeasesByTenantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLeaseAmendmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Tenant = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4222,11 +10630,11 @@ func (m *QueryLeasesByTenantRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StateFilter", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderUuid", wireType)
 			}
-			m.StateFilter = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4236,11 +10644,24 @@ func (m *QueryLeasesByTenantRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StateFilter |= LeaseState(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4262,7 +10683,7 @@ func (m *QueryLeasesByTenantRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLeasesByTenantResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLeaseAmendmentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4285,15 +10706,15 @@ func (m *QueryLeasesByTenantResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLeasesByTenantResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLeaseAmendmentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLeasesByTenantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLeaseAmendmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leases", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amendments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4320,8 +10741,8 @@ func (m *QueryLeasesByTenantResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Leases = append(m.Leases, Lease{})
-			if err := m.Leases[len(m.Leases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amendments = append(m.Amendments, LeaseAmendment{})
+			if err := m.Amendments[len(m.Amendments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4382,7 +10803,7 @@ func (m *QueryLeasesByTenantResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLeasesByProviderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLeaseUsageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4405,15 +10826,15 @@ func (m *QueryLeasesByProviderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLeasesByProviderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLeaseUsageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLeasesByProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLeaseUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4441,63 +10862,8 @@ func (m *QueryLeasesByProviderRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderUuid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StateFilter", wireType)
-			}
-			m.StateFilter = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StateFilter |= LeaseState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4519,7 +10885,7 @@ func (m *QueryLeasesByProviderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLeasesByProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLeaseUsageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4542,15 +10908,15 @@ func (m *QueryLeasesByProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLeasesByProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLeaseUsageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLeasesByProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLeaseUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leases", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4577,16 +10943,65 @@ func (m *QueryLeasesByProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Leases = append(m.Leases, Lease{})
-			if err := m.Leases[len(m.Leases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDisputeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDisputeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDisputeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4596,27 +11011,23 @@ func (m *QueryLeasesByProviderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4639,7 +11050,7 @@ func (m *QueryLeasesByProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCreditAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDisputeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4662,17 +11073,17 @@ func (m *QueryCreditAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCreditAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDisputeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCreditAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dispute", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4682,23 +11093,24 @@ func (m *QueryCreditAccountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tenant = string(dAtA[iNdEx:postIndex])
+			if err := m.Dispute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4721,7 +11133,7 @@ func (m *QueryCreditAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCreditAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDisputesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4744,15 +11156,15 @@ func (m *QueryCreditAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCreditAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDisputesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCreditAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDisputesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreditAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4779,13 +11191,117 @@ func (m *QueryCreditAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CreditAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= DisputeState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDisputesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDisputesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDisputesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disputes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4812,14 +11328,14 @@ func (m *QueryCreditAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balances = append(m.Balances, types.Coin{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Disputes = append(m.Disputes, Dispute{})
+			if err := m.Disputes[len(m.Disputes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvailableBalances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4846,8 +11362,10 @@ func (m *QueryCreditAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AvailableBalances = append(m.AvailableBalances, types.Coin{})
-			if err := m.AvailableBalances[len(m.AvailableBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4872,7 +11390,7 @@ func (m *QueryCreditAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCreditAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4895,15 +11413,15 @@ func (m *QueryCreditAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCreditAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCreditAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderUuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4931,7 +11449,7 @@ func (m *QueryCreditAddressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tenant = string(dAtA[iNdEx:postIndex])
+			m.ProviderUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4954,7 +11472,7 @@ func (m *QueryCreditAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCreditAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4977,17 +11495,17 @@ func (m *QueryCreditAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCreditAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCreditAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreditAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4997,24 +11515,83 @@ func (m *QueryCreditAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreditAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Found", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Found = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectivePendingTimeout", wireType)
+			}
+			m.EffectivePendingTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectivePendingTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveGracePeriod", wireType)
+			}
+			m.EffectiveGracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveGracePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5036,7 +11613,7 @@ func (m *QueryCreditAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWithdrawableAmountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderPoliciesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5059,17 +11636,17 @@ func (m *QueryWithdrawableAmountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWithdrawableAmountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderPoliciesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWithdrawableAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderPoliciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5079,23 +11656,27 @@ func (m *QueryWithdrawableAmountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5118,7 +11699,7 @@ func (m *QueryWithdrawableAmountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWithdrawableAmountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderPoliciesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5141,15 +11722,15 @@ func (m *QueryWithdrawableAmountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWithdrawableAmountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderPoliciesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWithdrawableAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderPoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5176,8 +11757,44 @@ func (m *QueryWithdrawableAmountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amounts = append(m.Amounts, types.Coin{})
-			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Policies = append(m.Policies, ProviderPolicy{})
+			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5202,7 +11819,7 @@ func (m *QueryWithdrawableAmountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProviderWithdrawableRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5225,10 +11842,10 @@ func (m *QueryProviderWithdrawableRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProviderWithdrawableRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProviderWithdrawableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5263,25 +11880,6 @@ func (m *QueryProviderWithdrawableRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ProviderUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5303,7 +11901,7 @@ func (m *QueryProviderWithdrawableRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProviderWithdrawableResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5326,15 +11924,15 @@ func (m *QueryProviderWithdrawableResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProviderWithdrawableResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProviderWithdrawableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5361,16 +11959,91 @@ func (m *QueryProviderWithdrawableResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amounts = append(m.Amounts, types.Coin{})
-			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AckLatencyBucketBounds = append(m.AckLatencyBucketBounds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AckLatencyBucketBounds) == 0 {
+					m.AckLatencyBucketBounds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AckLatencyBucketBounds = append(m.AckLatencyBucketBounds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckLatencyBucketBounds", wireType)
+			}
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaseCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageAckLatency", wireType)
 			}
-			m.LeaseCount = 0
+			m.AverageAckLatency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5380,16 +12053,16 @@ func (m *QueryProviderWithdrawableResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LeaseCount |= uint64(b&0x7F) << shift
+				m.AverageAckLatency |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasMore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageLeaseLifetime", wireType)
 			}
-			var v int
+			m.AverageLeaseLifetime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5399,67 +12072,16 @@ func (m *QueryProviderWithdrawableResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.AverageLeaseLifetime |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasMore = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryCreditAccountsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCreditAccountsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCreditAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AcknowledgementRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5469,83 +12091,31 @@ func (m *QueryCreditAccountsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AcknowledgementRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryCreditAccountsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCreditAccountsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCreditAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreditAccounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectionRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5555,31 +12125,31 @@ func (m *QueryCreditAccountsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreditAccounts = append(m.CreditAccounts, CreditAccount{})
-			if err := m.CreditAccounts[len(m.CreditAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RejectionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5589,25 +12159,23 @@ func (m *QueryCreditAccountsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExpiryRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5632,7 +12200,7 @@ func (m *QueryCreditAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLeasesBySKURequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLeaseSettlementsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5655,15 +12223,15 @@ func (m *QueryLeasesBySKURequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLeasesBySKURequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLeaseSettlementsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLeasesBySKURequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLeaseSettlementsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkuUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5691,7 +12259,7 @@ func (m *QueryLeasesBySKURequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SkuUuid = string(dAtA[iNdEx:postIndex])
+			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -5729,25 +12297,6 @@ func (m *QueryLeasesBySKURequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StateFilter", wireType)
-			}
-			m.StateFilter = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StateFilter |= LeaseState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5769,7 +12318,7 @@ func (m *QueryLeasesBySKURequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLeasesBySKUResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLeaseSettlementsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5792,15 +12341,15 @@ func (m *QueryLeasesBySKUResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLeasesBySKUResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLeaseSettlementsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLeasesBySKUResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLeaseSettlementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leases", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Settlements", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5827,8 +12376,8 @@ func (m *QueryLeasesBySKUResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Leases = append(m.Leases, Lease{})
-			if err := m.Leases[len(m.Leases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Settlements = append(m.Settlements, LeaseSettlement{})
+			if err := m.Settlements[len(m.Settlements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5889,7 +12438,7 @@ func (m *QueryLeasesBySKUResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCreditEstimateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5912,17 +12461,17 @@ func (m *QueryCreditEstimateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCreditEstimateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExchangeRatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCreditEstimateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5932,23 +12481,27 @@ func (m *QueryCreditEstimateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tenant = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5971,7 +12524,7 @@ func (m *QueryCreditEstimateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCreditEstimateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5994,15 +12547,15 @@ func (m *QueryCreditEstimateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCreditEstimateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExchangeRatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCreditEstimateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6029,16 +12582,16 @@ func (m *QueryCreditEstimateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentBalance = append(m.CurrentBalance, types.Coin{})
-			if err := m.CurrentBalance[len(m.CurrentBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rates = append(m.Rates, ExchangeRate{})
+			if err := m.Rates[len(m.Rates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalRatePerSecond", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6048,31 +12601,29 @@ func (m *QueryCreditEstimateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalRatePerSecond = append(m.TotalRatePerSecond, types.Coin{})
-			if err := m.TotalRatePerSecond[len(m.TotalRatePerSecond)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ReferenceDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDurationSeconds", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.EstimatedDurationSeconds = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6082,30 +12633,28 @@ func (m *QueryCreditEstimateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EstimatedDurationSeconds |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveLeaseCount", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.ActiveLeaseCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ActiveLeaseCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6127,7 +12676,7 @@ func (m *QueryCreditEstimateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLeaseByCustomDomainRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySKUAvailabilityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6150,15 +12699,15 @@ func (m *QueryLeaseByCustomDomainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLeaseByCustomDomainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySKUAvailabilityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLeaseByCustomDomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySKUAvailabilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CustomDomain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SkuUuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6186,7 +12735,7 @@ func (m *QueryLeaseByCustomDomainRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CustomDomain = string(dAtA[iNdEx:postIndex])
+			m.SkuUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6209,7 +12758,7 @@ func (m *QueryLeaseByCustomDomainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLeaseByCustomDomainResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySKUAvailabilityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6232,17 +12781,17 @@ func (m *QueryLeaseByCustomDomainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLeaseByCustomDomainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySKUAvailabilityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLeaseByCustomDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySKUAvailabilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
 			}
-			var msglen int
+			m.Capacity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6252,30 +12801,16 @@ func (m *QueryLeaseByCustomDomainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Capacity |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Lease.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocated", wireType)
 			}
-			var stringLen uint64
+			m.Allocated = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6285,24 +12820,30 @@ func (m *QueryLeaseByCustomDomainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Allocated |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Available = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Available |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ServiceName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -37,6 +38,77 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ExchangeRateInput is one entry of MsgSetExchangeRates.
+type ExchangeRateInput struct {
+	// denom is the denom the rate applies to. It must differ from the
+	// reference denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate is the amount of reference denom one unit of denom is worth. Zero
+	// removes the denom from the table.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+	// valid_from is the first time the rate may be used. Unset means the
+	// current block time.
+	ValidFrom *time.Time `protobuf:"bytes,3,opt,name=valid_from,json=validFrom,proto3,stdtime" json:"valid_from,omitempty"`
+	// valid_until is the time from which the rate may no longer be used. It
+	// must be after valid_from and the current block time. Ignored when
+	// removing.
+	ValidUntil time.Time `protobuf:"bytes,4,opt,name=valid_until,json=validUntil,proto3,stdtime" json:"valid_until"`
+}
+
+func (m *ExchangeRateInput) Reset()         { *m = ExchangeRateInput{} }
+func (m *ExchangeRateInput) String() string { return proto.CompactTextString(m) }
+func (*ExchangeRateInput) ProtoMessage()    {}
+func (*ExchangeRateInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{0}
+}
+func (m *ExchangeRateInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeRateInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeRateInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeRateInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRateInput.Merge(m, src)
+}
+func (m *ExchangeRateInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeRateInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRateInput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeRateInput proto.InternalMessageInfo
+
+func (m *ExchangeRateInput) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ExchangeRateInput) GetValidFrom() *time.Time {
+	if m != nil {
+		return m.ValidFrom
+	}
+	return nil
+}
+
+func (m *ExchangeRateInput) GetValidUntil() time.Time {
+	if m != nil {
+		return m.ValidUntil
+	}
+	return time.Time{}
+}
+
 // LeaseItemInput is the input for creating a lease item.
 type LeaseItemInput struct {
 	// sku_uuid is the UUID of the SKU to lease.
@@ -54,7 +126,7 @@ func (m *LeaseItemInput) Reset()         { *m = LeaseItemInput{} }
 func (m *LeaseItemInput) String() string { return proto.CompactTextString(m) }
 func (*LeaseItemInput) ProtoMessage()    {}
 func (*LeaseItemInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e88d178776fa191, []int{0}
+	return fileDescriptor_5e88d178776fa191, []int{1}
 }
 func (m *LeaseItemInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -104,6 +176,73 @@ func (m *LeaseItemInput) GetServiceName() string {
 	return ""
 }
 
+// LeaseInput describes one lease of a batch creation message.
+type LeaseInput struct {
+	// items is the list of SKU items to include in the lease.
+	// All SKUs must belong to the same provider.
+	Items []LeaseItemInput `protobuf:"bytes,1,rep,name=items,proto3" json:"items"`
+	// meta_hash is an optional hash/reference to off-chain deployment data.
+	// Maximum 64 bytes.
+	MetaHash []byte `protobuf:"bytes,2,opt,name=meta_hash,json=metaHash,proto3" json:"meta_hash,omitempty"`
+	// scheduled_end_at is an optional time at which the lease is closed
+	// automatically. Must be after the current block time.
+	ScheduledEndAt *time.Time `protobuf:"bytes,3,opt,name=scheduled_end_at,json=scheduledEndAt,proto3,stdtime" json:"scheduled_end_at,omitempty"`
+}
+
+func (m *LeaseInput) Reset()         { *m = LeaseInput{} }
+func (m *LeaseInput) String() string { return proto.CompactTextString(m) }
+func (*LeaseInput) ProtoMessage()    {}
+func (*LeaseInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{2}
+}
+func (m *LeaseInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LeaseInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LeaseInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LeaseInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaseInput.Merge(m, src)
+}
+func (m *LeaseInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *LeaseInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaseInput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaseInput proto.InternalMessageInfo
+
+func (m *LeaseInput) GetItems() []LeaseItemInput {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *LeaseInput) GetMetaHash() []byte {
+	if m != nil {
+		return m.MetaHash
+	}
+	return nil
+}
+
+func (m *LeaseInput) GetScheduledEndAt() *time.Time {
+	if m != nil {
+		return m.ScheduledEndAt
+	}
+	return nil
+}
+
 // MsgFundCredit funds a tenant's credit account.
 type MsgFundCredit struct {
 	// sender is the address funding the credit account.
@@ -118,7 +257,7 @@ func (m *MsgFundCredit) Reset()         { *m = MsgFundCredit{} }
 func (m *MsgFundCredit) String() string { return proto.CompactTextString(m) }
 func (*MsgFundCredit) ProtoMessage()    {}
 func (*MsgFundCredit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e88d178776fa191, []int{1}
+	return fileDescriptor_5e88d178776fa191, []int{3}
 }
 func (m *MsgFundCredit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -180,7 +319,7 @@ func (m *MsgFundCreditResponse) Reset()         { *m = MsgFundCreditResponse{} }
 func (m *MsgFundCreditResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgFundCreditResponse) ProtoMessage()    {}
 func (*MsgFundCreditResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e88d178776fa191, []int{2}
+	return fileDescriptor_5e88d178776fa191, []int{4}
 }
 func (m *MsgFundCreditResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -234,13 +373,16 @@ type MsgCreateLease struct {
 	// Maximum 64 bytes to accommodate SHA-256/SHA-512 hashes.
 	// Immutable once set at lease creation.
 	MetaHash []byte `protobuf:"bytes,3,opt,name=meta_hash,json=metaHash,proto3" json:"meta_hash,omitempty"`
+	// scheduled_end_at is an optional time at which the lease is closed
+	// automatically. Must be after the current block time.
+	ScheduledEndAt *time.Time `protobuf:"bytes,4,opt,name=scheduled_end_at,json=scheduledEndAt,proto3,stdtime" json:"scheduled_end_at,omitempty"`
 }
 
 func (m *MsgCreateLease) Reset()         { *m = MsgCreateLease{} }
 func (m *MsgCreateLease) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateLease) ProtoMessage()    {}
 func (*MsgCreateLease) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e88d178776fa191, []int{3}
+	return fileDescriptor_5e88d178776fa191, []int{5}
 }
 func (m *MsgCreateLease) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -290,6 +432,13 @@ func (m *MsgCreateLease) GetMetaHash() []byte {
 	return nil
 }
 
+func (m *MsgCreateLease) GetScheduledEndAt() *time.Time {
+	if m != nil {
+		return m.ScheduledEndAt
+	}
+	return nil
+}
+
 // MsgCreateLeaseResponse is the response type for MsgCreateLease.
 type MsgCreateLeaseResponse struct {
 	// lease_uuid is the UUID of the created lease (UUIDv7 format).
@@ -300,7 +449,7 @@ func (m *MsgCreateLeaseResponse) Reset()         { *m = MsgCreateLeaseResponse{}
 func (m *MsgCreateLeaseResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateLeaseResponse) ProtoMessage()    {}
 func (*MsgCreateLeaseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e88d178776fa191, []int{4}
+	return fileDescriptor_5e88d178776fa191, []int{6}
 }
 func (m *MsgCreateLeaseResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,13 +499,16 @@ type MsgCreateLeaseForTenant struct {
 	// Maximum 64 bytes to accommodate SHA-256/SHA-512 hashes.
 	// Immutable once set at lease creation.
 	MetaHash []byte `protobuf:"bytes,4,opt,name=meta_hash,json=metaHash,proto3" json:"meta_hash,omitempty"`
+	// scheduled_end_at is an optional time at which the lease is closed
+	// automatically. Must be after the current block time.
+	ScheduledEndAt *time.Time `protobuf:"bytes,5,opt,name=scheduled_end_at,json=scheduledEndAt,proto3,stdtime" json:"scheduled_end_at,omitempty"`
 }
 
 func (m *MsgCreateLeaseForTenant) Reset()         { *m = MsgCreateLeaseForTenant{} }
 func (m *MsgCreateLeaseForTenant) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateLeaseForTenant) ProtoMessage()    {}
 func (*MsgCreateLeaseForTenant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e88d178776fa191, []int{5}
+	return fileDescriptor_5e88d178776fa191, []int{7}
 }
 func (m *MsgCreateLeaseForTenant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -413,6 +565,13 @@ func (m *MsgCreateLeaseForTenant) GetMetaHash() []byte {
 	return nil
 }
 
+func (m *MsgCreateLeaseForTenant) GetScheduledEndAt() *time.Time {
+	if m != nil {
+		return m.ScheduledEndAt
+	}
+	return nil
+}
+
 // MsgCreateLeaseForTenantResponse is the response type for MsgCreateLeaseForTenant.
 type MsgCreateLeaseForTenantResponse struct {
 	// lease_uuid is the UUID of the created lease (UUIDv7 format).
@@ -423,7 +582,7 @@ func (m *MsgCreateLeaseForTenantResponse) Reset()         { *m = MsgCreateLeaseF
 func (m *MsgCreateLeaseForTenantResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateLeaseForTenantResponse) ProtoMessage()    {}
 func (*MsgCreateLeaseForTenantResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e88d178776fa191, []int{6}
+	return fileDescriptor_5e88d178776fa191, []int{8}
 }
 func (m *MsgCreateLeaseForTenantResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -476,7 +635,7 @@ func (m *MsgCloseLease) Reset()         { *m = MsgCloseLease{} }
 func (m *MsgCloseLease) String() string { return proto.CompactTextString(m) }
 func (*MsgCloseLease) ProtoMessage()    {}
 func (*MsgCloseLease) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e88d178776fa191, []int{7}
+	return fileDescriptor_5e88d178776fa191, []int{9}
 }
 func (m *MsgCloseLease) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -540,7 +699,7 @@ func (m *MsgCloseLeaseResponse) Reset()         { *m = MsgCloseLeaseResponse{} }
 func (m *MsgCloseLeaseResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCloseLeaseResponse) ProtoMessage()    {}
 func (*MsgCloseLeaseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e88d178776fa191, []int{8}
+	return fileDescriptor_5e88d178776fa191, []int{10}
 }
 func (m *MsgCloseLeaseResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -615,7 +774,7 @@ func (m *MsgWithdraw) Reset()         { *m = MsgWithdraw{} }
 func (m *MsgWithdraw) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdraw) ProtoMessage()    {}
 func (*MsgWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e88d178776fa191, []int{9}
+	return fileDescriptor_5e88d178776fa191, []int{11}
 }
 func (m *MsgWithdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -689,7 +848,7 @@ func (m *MsgWithdrawResponse) Reset()         { *m = MsgWithdrawResponse{} }
 func (m *MsgWithdrawResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawResponse) ProtoMessage()    {}
 func (*MsgWithdrawResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e88d178776fa191, []int{10}
+	return fileDescriptor_5e88d178776fa191, []int{12}
 }
 func (m *MsgWithdrawResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -758,7 +917,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e88d178776fa191, []int{11}
+	return fileDescriptor_5e88d178776fa191, []int{13}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -809,7 +968,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e88d178776fa191, []int{12}
+	return fileDescriptor_5e88d178776fa191, []int{14}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -852,7 +1011,7 @@ func (m *MsgAcknowledgeLease) Reset()         { *m = MsgAcknowledgeLease{} }
 func (m *MsgAcknowledgeLease) String() string { return proto.CompactTextString(m) }
 func (*MsgAcknowledgeLease) ProtoMessage()    {}
 func (*MsgAcknowledgeLease) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e88d178776fa191, []int{13}
+	return fileDescriptor_5e88d178776fa191, []int{15}
 }
 func (m *MsgAcknowledgeLease) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -908,7 +1067,7 @@ func (m *MsgAcknowledgeLeaseResponse) Reset()         { *m = MsgAcknowledgeLease
 func (m *MsgAcknowledgeLeaseResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAcknowledgeLeaseResponse) ProtoMessage()    {}
 func (*MsgAcknowledgeLeaseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e88d178776fa191, []int{14}
+	return fileDescriptor_5e88d178776fa191, []int{16}
 }
 func (m *MsgAcknowledgeLeaseResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -968,7 +1127,7 @@ func (m *MsgRejectLease) Reset()         { *m = MsgRejectLease{} }
 func (m *MsgRejectLease) String() string { return proto.CompactTextString(m) }
 func (*MsgRejectLease) ProtoMessage()    {}
 func (*MsgRejectLease) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e88d178776fa191, []int{15}
+	return fileDescriptor_5e88d178776fa191, []int{17}
 }
 func (m *MsgRejectLease) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1030,7 +1189,7 @@ func (m *MsgRejectLeaseResponse) Reset()         { *m = MsgRejectLeaseResponse{}
 func (m *MsgRejectLeaseResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRejectLeaseResponse) ProtoMessage()    {}
 func (*MsgRejectLeaseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e88d178776fa191, []int{16}
+	return fileDescriptor_5e88d178776fa191, []int{18}
 }
 func (m *MsgRejectLeaseResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1087,7 +1246,7 @@ func (m *MsgCancelLease) Reset()         { *m = MsgCancelLease{} }
 func (m *MsgCancelLease) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelLease) ProtoMessage()    {}
 func (*MsgCancelLease) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e88d178776fa191, []int{17}
+	return fileDescriptor_5e88d178776fa191, []int{19}
 }
 func (m *MsgCancelLease) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1142,7 +1301,7 @@ func (m *MsgCancelLeaseResponse) Reset()         { *m = MsgCancelLeaseResponse{}
 func (m *MsgCancelLeaseResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelLeaseResponse) ProtoMessage()    {}
 func (*MsgCancelLeaseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e88d178776fa191, []int{18}
+	return fileDescriptor_5e88d178776fa191, []int{20}
 }
 func (m *MsgCancelLeaseResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1209,7 +1368,7 @@ func (m *MsgSetItemCustomDomain) Reset()         { *m = MsgSetItemCustomDomain{}
 func (m *MsgSetItemCustomDomain) String() string { return proto.CompactTextString(m) }
 func (*MsgSetItemCustomDomain) ProtoMessage()    {}
 func (*MsgSetItemCustomDomain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e88d178776fa191, []int{19}
+	return fileDescriptor_5e88d178776fa191, []int{21}
 }
 func (m *MsgSetItemCustomDomain) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1275,7 +1434,7 @@ func (m *MsgSetItemCustomDomainResponse) Reset()         { *m = MsgSetItemCustom
 func (m *MsgSetItemCustomDomainResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetItemCustomDomainResponse) ProtoMessage()    {}
 func (*MsgSetItemCustomDomainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e88d178776fa191, []int{20}
+	return fileDescriptor_5e88d178776fa191, []int{22}
 }
 func (m *MsgSetItemCustomDomainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1304,1862 +1463,11433 @@ func (m *MsgSetItemCustomDomainResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetItemCustomDomainResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*LeaseItemInput)(nil), "liftedinit.billing.v1.LeaseItemInput")
-	proto.RegisterType((*MsgFundCredit)(nil), "liftedinit.billing.v1.MsgFundCredit")
-	proto.RegisterType((*MsgFundCreditResponse)(nil), "liftedinit.billing.v1.MsgFundCreditResponse")
-	proto.RegisterType((*MsgCreateLease)(nil), "liftedinit.billing.v1.MsgCreateLease")
-	proto.RegisterType((*MsgCreateLeaseResponse)(nil), "liftedinit.billing.v1.MsgCreateLeaseResponse")
-	proto.RegisterType((*MsgCreateLeaseForTenant)(nil), "liftedinit.billing.v1.MsgCreateLeaseForTenant")
-	proto.RegisterType((*MsgCreateLeaseForTenantResponse)(nil), "liftedinit.billing.v1.MsgCreateLeaseForTenantResponse")
-	proto.RegisterType((*MsgCloseLease)(nil), "liftedinit.billing.v1.MsgCloseLease")
-	proto.RegisterType((*MsgCloseLeaseResponse)(nil), "liftedinit.billing.v1.MsgCloseLeaseResponse")
-	proto.RegisterType((*MsgWithdraw)(nil), "liftedinit.billing.v1.MsgWithdraw")
-	proto.RegisterType((*MsgWithdrawResponse)(nil), "liftedinit.billing.v1.MsgWithdrawResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "liftedinit.billing.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "liftedinit.billing.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgAcknowledgeLease)(nil), "liftedinit.billing.v1.MsgAcknowledgeLease")
-	proto.RegisterType((*MsgAcknowledgeLeaseResponse)(nil), "liftedinit.billing.v1.MsgAcknowledgeLeaseResponse")
-	proto.RegisterType((*MsgRejectLease)(nil), "liftedinit.billing.v1.MsgRejectLease")
-	proto.RegisterType((*MsgRejectLeaseResponse)(nil), "liftedinit.billing.v1.MsgRejectLeaseResponse")
-	proto.RegisterType((*MsgCancelLease)(nil), "liftedinit.billing.v1.MsgCancelLease")
-	proto.RegisterType((*MsgCancelLeaseResponse)(nil), "liftedinit.billing.v1.MsgCancelLeaseResponse")
-	proto.RegisterType((*MsgSetItemCustomDomain)(nil), "liftedinit.billing.v1.MsgSetItemCustomDomain")
-	proto.RegisterType((*MsgSetItemCustomDomainResponse)(nil), "liftedinit.billing.v1.MsgSetItemCustomDomainResponse")
+// MsgWithdrawCredit withdraws unreserved credit from a tenant's credit account.
+type MsgWithdrawCredit struct {
+	// tenant is the address of the tenant who owns the credit account.
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// recipient is the address that receives the withdrawn funds.
+	// May be the tenant itself or any other non-module account.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount to withdraw (one entry per denom). Each denom must not
+	// exceed the withdrawable credit reported by Query/AvailableCredit.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func init() { proto.RegisterFile("liftedinit/billing/v1/tx.proto", fileDescriptor_5e88d178776fa191) }
-
-var fileDescriptor_5e88d178776fa191 = []byte{
-	// 1652 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4d, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xcf, 0x3a, 0x6d, 0xfe, 0xce, 0x38, 0x6f, 0xdd, 0x24, 0x4d, 0xe2, 0xfe, 0xeb, 0x4d, 0x57,
-	0xa4, 0x8a, 0xa2, 0xc4, 0x26, 0x69, 0x0b, 0xaa, 0x11, 0x12, 0x76, 0xda, 0x8a, 0x22, 0x52, 0x8a,
-	0xdb, 0xaa, 0x52, 0x39, 0xb8, 0x63, 0xef, 0xc4, 0x5e, 0xe2, 0xdd, 0x31, 0x3b, 0xe3, 0xa4, 0x11,
-	0x12, 0x02, 0x2e, 0x48, 0x88, 0x43, 0x8f, 0x7c, 0x05, 0xb8, 0xd0, 0x43, 0x25, 0xc4, 0x91, 0x03,
-	0xa8, 0x12, 0x07, 0x2a, 0x4e, 0x88, 0xc3, 0x16, 0xda, 0x43, 0x25, 0x23, 0xc4, 0x07, 0xe0, 0x82,
-	0x76, 0x66, 0x76, 0x77, 0xd6, 0x6f, 0xdd, 0x2a, 0x39, 0x94, 0x4b, 0x36, 0xf3, 0x7b, 0x5e, 0x66,
-	0x9e, 0x97, 0x79, 0x9e, 0x67, 0x0c, 0x32, 0x0d, 0x73, 0x9b, 0x22, 0xc3, 0xb4, 0x4d, 0x9a, 0xab,
-	0x98, 0x8d, 0x86, 0x69, 0xd7, 0x72, 0xbb, 0xeb, 0x39, 0x7a, 0x27, 0xdb, 0x74, 0x30, 0xc5, 0xea,
-	0x6c, 0x48, 0xcf, 0x0a, 0x7a, 0x76, 0x77, 0x3d, 0x7d, 0x0c, 0x5a, 0xa6, 0x8d, 0x73, 0xec, 0x2f,
-	0xe7, 0x4c, 0x67, 0xaa, 0x98, 0x58, 0x98, 0xe4, 0x2a, 0x90, 0xa0, 0xdc, 0xee, 0x7a, 0x05, 0x51,
-	0xb8, 0x9e, 0xab, 0x62, 0xd3, 0x16, 0xf4, 0x39, 0x41, 0xb7, 0x08, 0xdb, 0xc1, 0x22, 0x35, 0x41,
-	0x58, 0xe0, 0x84, 0x32, 0x5b, 0xe5, 0xf8, 0x42, 0x90, 0x66, 0x6a, 0xb8, 0x86, 0x39, 0xee, 0xfd,
-	0x27, 0x50, 0xad, 0x86, 0x71, 0xad, 0x81, 0x72, 0x6c, 0x55, 0x69, 0x6d, 0xe7, 0xa8, 0x69, 0x21,
-	0x42, 0xa1, 0xd5, 0x14, 0x0c, 0xa7, 0xfa, 0x18, 0xb5, 0xdf, 0x44, 0x42, 0xb3, 0xfe, 0x87, 0x02,
-	0x26, 0xde, 0x46, 0x90, 0xa0, 0xcb, 0x14, 0x59, 0x97, 0xed, 0x66, 0x8b, 0xaa, 0xeb, 0x20, 0x49,
-	0x76, 0x5a, 0xe5, 0x56, 0xcb, 0x34, 0xe6, 0x95, 0x45, 0x65, 0x79, 0xb4, 0x78, 0xbc, 0xed, 0x6a,
-	0xaa, 0x8f, 0xad, 0x62, 0xcb, 0xa4, 0xc8, 0x6a, 0xd2, 0xfd, 0xd2, 0xff, 0xc8, 0x4e, 0xeb, 0x46,
-	0xcb, 0x34, 0xd4, 0xf3, 0x20, 0xf9, 0x41, 0x0b, 0xda, 0xd4, 0xa4, 0xfb, 0xf3, 0x89, 0x45, 0x65,
-	0xf9, 0x48, 0xf1, 0x64, 0xdb, 0xd5, 0x16, 0x7c, 0x2c, 0x14, 0x59, 0x25, 0xd4, 0x31, 0xed, 0x5a,
-	0x29, 0x60, 0x57, 0x5f, 0x07, 0x63, 0x04, 0x39, 0xbb, 0x66, 0x15, 0x95, 0x6d, 0x68, 0xa1, 0xf9,
-	0x61, 0xb6, 0x63, 0xba, 0xed, 0x6a, 0xc7, 0x65, 0x5c, 0xda, 0x35, 0x25, 0xf0, 0x2b, 0xd0, 0x42,
-	0x79, 0xfd, 0xf3, 0xa7, 0xf7, 0x56, 0x4e, 0x72, 0x3b, 0x03, 0x1b, 0xa3, 0x06, 0xe9, 0x5f, 0x24,
-	0xc0, 0xf8, 0x16, 0xa9, 0x5d, 0x6a, 0xd9, 0xc6, 0xa6, 0x83, 0x0c, 0x93, 0xaa, 0x17, 0xc0, 0x08,
-	0x41, 0xb6, 0x81, 0x1c, 0x61, 0xe0, 0x6a, 0xdb, 0xd5, 0xa6, 0x38, 0x12, 0x6e, 0xf4, 0xcb, 0xfd,
-	0xb5, 0x19, 0x11, 0x85, 0x82, 0x61, 0x38, 0x88, 0x90, 0x6b, 0xfc, 0xf0, 0x42, 0xd6, 0xd3, 0x42,
-	0x91, 0x0d, 0x6d, 0xca, 0x6c, 0x16, 0x5a, 0x38, 0x12, 0x47, 0x0b, 0xe7, 0x54, 0x0b, 0x60, 0x04,
-	0x5a, 0xb8, 0x65, 0x53, 0x66, 0x7a, 0x6a, 0x63, 0x21, 0x2b, 0xd8, 0xbd, 0x04, 0xca, 0x8a, 0x04,
-	0xca, 0x6e, 0x62, 0xd3, 0x2e, 0x4e, 0x3c, 0x70, 0xb5, 0xa1, 0xb6, 0xab, 0x09, 0x81, 0x92, 0xf8,
-	0xe6, 0x57, 0x3f, 0x7d, 0x7a, 0x6f, 0x45, 0x9c, 0xca, 0xf3, 0xc7, 0xff, 0x3b, 0xfc, 0x11, 0x31,
-	0x5e, 0xff, 0x41, 0x01, 0xb3, 0x11, 0xa4, 0x84, 0x48, 0x13, 0xdb, 0x04, 0xa9, 0xef, 0x81, 0x89,
-	0x2a, 0x43, 0xca, 0x90, 0x1f, 0x55, 0xb8, 0xe7, 0x6c, 0xdb, 0xd5, 0xe6, 0xa3, 0x94, 0x18, 0x06,
-	0x8e, 0x73, 0x09, 0x01, 0xaa, 0xef, 0x80, 0x94, 0x8d, 0xf6, 0xca, 0x15, 0xd8, 0x80, 0x76, 0x15,
-	0x31, 0x97, 0x0d, 0x34, 0x76, 0x5a, 0x18, 0x2b, 0x4b, 0x95, 0x80, 0x8d, 0xf6, 0x8a, 0xfc, 0x7f,
-	0xfd, 0xb3, 0x04, 0x98, 0xd8, 0x22, 0xb5, 0x4d, 0x07, 0x41, 0x8a, 0x58, 0xc8, 0xa5, 0x88, 0x28,
-	0x07, 0x88, 0xc8, 0x5b, 0xe0, 0xa8, 0xc7, 0x4a, 0xe6, 0x13, 0x8b, 0xc3, 0xcb, 0xa9, 0x8d, 0xa5,
-	0x6c, 0xcf, 0xbb, 0x9f, 0x8d, 0x66, 0x59, 0x71, 0x5c, 0x9c, 0x97, 0xcb, 0x96, 0xf8, 0x47, 0x3d,
-	0x0b, 0x46, 0x2d, 0x44, 0x61, 0xb9, 0x0e, 0x49, 0x9d, 0x05, 0x78, 0xac, 0x38, 0xd7, 0x76, 0xb5,
-	0xe9, 0x00, 0x94, 0x12, 0x3b, 0xe9, 0x81, 0x6f, 0x42, 0x52, 0xcf, 0xaf, 0xb1, 0x80, 0xf2, 0xe3,
-	0xf4, 0x4a, 0xf0, 0xa8, 0xd9, 0xfa, 0xbb, 0xe0, 0x78, 0x14, 0x09, 0x22, 0xfa, 0x2a, 0x00, 0x0d,
-	0x0f, 0x90, 0x6f, 0xf3, 0x7c, 0xdb, 0xd5, 0x66, 0x42, 0x54, 0x3a, 0xc0, 0x28, 0x43, 0xbd, 0x1b,
-	0xad, 0xff, 0x99, 0x00, 0x73, 0x51, 0x9d, 0x97, 0xb0, 0x73, 0x9d, 0xfb, 0x67, 0x0b, 0x8c, 0xc2,
-	0x16, 0xad, 0x63, 0xc7, 0xbb, 0xee, 0x5c, 0x67, 0xce, 0xb3, 0x29, 0x00, 0x63, 0xf8, 0x3a, 0xd4,
-	0x70, 0x48, 0xd7, 0x28, 0x08, 0xda, 0xf0, 0x21, 0x07, 0xed, 0x48, 0xdc, 0xa0, 0x9d, 0xf7, 0x82,
-	0x16, 0xda, 0xe5, 0xc5, 0xed, 0xf4, 0xc0, 0xb8, 0x05, 0x1e, 0xd5, 0x6f, 0x01, 0xad, 0x0f, 0xe9,
-	0xe0, 0x91, 0xfc, 0x4b, 0x61, 0xd5, 0x6f, 0xb3, 0x81, 0x49, 0x78, 0x4b, 0x0e, 0xa1, 0xfa, 0xe5,
-	0x41, 0x2a, 0xdc, 0x9a, 0xdf, 0x95, 0xd1, 0xe2, 0x42, 0xdb, 0xd5, 0x66, 0x25, 0x58, 0x3a, 0x12,
-	0x08, 0x8e, 0x44, 0xd4, 0x55, 0x30, 0xe2, 0x20, 0x48, 0xb0, 0x2d, 0xca, 0xfd, 0x8c, 0x77, 0x02,
-	0x8e, 0x48, 0x12, 0x82, 0x27, 0x4e, 0x79, 0x0b, 0xad, 0xd3, 0xbf, 0x4d, 0xb0, 0xf2, 0x16, 0x22,
-	0x81, 0x0b, 0xaf, 0x80, 0xd1, 0xaa, 0x87, 0x1a, 0x65, 0xc8, 0x0b, 0x44, 0x6a, 0x23, 0x9d, 0xe5,
-	0x3d, 0x34, 0xeb, 0xf7, 0xd0, 0xec, 0x75, 0xbf, 0x87, 0x16, 0x67, 0x45, 0x6e, 0x84, 0x42, 0x77,
-	0x1f, 0x69, 0x4a, 0x29, 0xc9, 0x97, 0x05, 0xaa, 0x9e, 0x01, 0x63, 0x82, 0x54, 0x65, 0xf5, 0x9b,
-	0x77, 0xbe, 0xa9, 0xb6, 0xab, 0x45, 0xf0, 0x52, 0x8a, 0xaf, 0x36, 0xbd, 0x85, 0xfa, 0xa5, 0x02,
-	0x66, 0x29, 0xa6, 0xb0, 0x51, 0x26, 0x88, 0xd2, 0x86, 0xa7, 0x97, 0x15, 0x71, 0x3f, 0x71, 0x07,
-	0x54, 0xc4, 0xcb, 0xe2, 0x40, 0xbd, 0xe5, 0xbf, 0x7e, 0xa4, 0x2d, 0xd7, 0x4c, 0x5a, 0x6f, 0x55,
-	0xb2, 0x55, 0x6c, 0x89, 0xf9, 0x41, 0x7c, 0xd6, 0x88, 0xb1, 0x23, 0xda, 0xbe, 0xa7, 0x89, 0x94,
-	0xa6, 0x99, 0x8a, 0x6b, 0x5c, 0x43, 0x81, 0x2b, 0xd0, 0xbf, 0x49, 0x80, 0xd4, 0x16, 0xa9, 0xdd,
-	0x34, 0x69, 0xdd, 0x70, 0xe0, 0xde, 0x0b, 0x90, 0x27, 0x6f, 0x80, 0xf1, 0xa6, 0x83, 0x77, 0x4d,
-	0x03, 0x39, 0x3c, 0xef, 0x79, 0xba, 0x9c, 0x68, 0xbb, 0xda, 0x5c, 0x84, 0x20, 0xc9, 0x8f, 0xf9,
-	0x04, 0x36, 0x99, 0xbc, 0x0c, 0x8e, 0x36, 0x4c, 0xcb, 0xa4, 0xec, 0x1a, 0x1f, 0xe1, 0x73, 0x05,
-	0x03, 0xba, 0x67, 0x12, 0xce, 0x98, 0x5f, 0xe9, 0xc8, 0xb6, 0x74, 0x77, 0xb6, 0xf9, 0x1e, 0xd2,
-	0xff, 0x49, 0x80, 0x69, 0x69, 0x1d, 0x64, 0xda, 0x27, 0x0a, 0x18, 0xe7, 0x41, 0xf2, 0x83, 0xab,
-	0x3c, 0x2b, 0xb8, 0x05, 0x11, 0xdc, 0xa8, 0xdc, 0x73, 0x05, 0x75, 0x8c, 0x89, 0x8a, 0x68, 0x7a,
-	0xcd, 0xbc, 0x09, 0xf7, 0x71, 0x2b, 0x6c, 0xe6, 0x89, 0xb0, 0x99, 0x47, 0x29, 0x71, 0x9a, 0x39,
-	0x97, 0xf0, 0x9b, 0xf9, 0x55, 0x30, 0xb5, 0x27, 0x8c, 0x86, 0x0d, 0x91, 0xfe, 0xc3, 0xcc, 0xc3,
-	0x4b, 0x6d, 0x57, 0x3b, 0xd5, 0x49, 0xeb, 0x76, 0xf6, 0x64, 0xc8, 0xc2, 0xef, 0xc5, 0x3a, 0x48,
-	0xd6, 0x21, 0x29, 0x5b, 0xd8, 0x41, 0x2c, 0x56, 0x49, 0x3e, 0x75, 0xfa, 0x98, 0x3c, 0x75, 0xd6,
-	0x21, 0xd9, 0xc2, 0x0e, 0xd2, 0x7f, 0x53, 0xc0, 0xe4, 0x16, 0xa9, 0xdd, 0x68, 0x1a, 0x90, 0xa2,
-	0xab, 0xd0, 0x81, 0x16, 0x39, 0xec, 0xde, 0x74, 0x11, 0x8c, 0x34, 0x99, 0x62, 0x31, 0xaf, 0x9c,
-	0xec, 0xd3, 0x56, 0xf8, 0xee, 0xe1, 0x80, 0xc6, 0x85, 0x4a, 0xe2, 0x9b, 0x5f, 0xef, 0x6e, 0x0d,
-	0x99, 0xee, 0xb4, 0x92, 0x0d, 0xd1, 0x17, 0x58, 0xff, 0x95, 0x21, 0x3f, 0xbb, 0xf4, 0x9f, 0x14,
-	0x96, 0x75, 0x85, 0xea, 0x8e, 0x8d, 0xf7, 0x1a, 0xc8, 0xa8, 0xbd, 0x28, 0x75, 0x3d, 0xbf, 0xd1,
-	0x71, 0x77, 0xf4, 0x6e, 0x23, 0x3b, 0x4f, 0xad, 0xff, 0xa8, 0x80, 0x13, 0x3d, 0xf0, 0xe0, 0x2e,
-	0xdd, 0x06, 0x93, 0x30, 0xa4, 0xc5, 0xac, 0xdd, 0x27, 0x44, 0x20, 0x3a, 0x45, 0x59, 0x05, 0x9f,
-	0x90, 0xc1, 0x02, 0x55, 0x2f, 0x02, 0x35, 0xc2, 0x26, 0x57, 0x73, 0x96, 0x84, 0xdd, 0xd4, 0xd2,
-	0x31, 0x19, 0x63, 0x19, 0xac, 0xff, 0xad, 0xb0, 0x79, 0xb4, 0x84, 0xde, 0x47, 0x55, 0xfa, 0xdf,
-	0xec, 0xb4, 0x6b, 0x1d, 0xf1, 0xeb, 0x31, 0x77, 0x4a, 0xe6, 0xe9, 0x5f, 0x29, 0x6c, 0xf0, 0x94,
-	0xa0, 0x20, 0x6a, 0xd7, 0x41, 0xca, 0x61, 0x70, 0xdc, 0x88, 0xcd, 0xf9, 0xe3, 0xbe, 0x24, 0xc6,
-	0xa2, 0x05, 0x7c, 0xa0, 0x40, 0xd5, 0xf3, 0x60, 0x22, 0x20, 0xcb, 0x51, 0x52, 0xdb, 0xae, 0xd6,
-	0x41, 0x29, 0x8d, 0xfb, 0x6b, 0x1e, 0x9d, 0xef, 0x79, 0x74, 0x36, 0xbd, 0xa7, 0x43, 0xe3, 0x30,
-	0x5f, 0x0b, 0x07, 0xb9, 0x2f, 0x71, 0xe6, 0xfc, 0xf0, 0xc0, 0xfa, 0x77, 0xdc, 0xdf, 0x12, 0x14,
-	0xf8, 0xfb, 0x26, 0x18, 0xab, 0x32, 0xb8, 0x11, 0xd7, 0xe1, 0xf3, 0xc2, 0xe1, 0x11, 0x39, 0xe6,
-	0xf1, 0x54, 0x80, 0x14, 0xa8, 0x7a, 0x09, 0x4c, 0x86, 0x0c, 0xb2, 0xcf, 0xd9, 0x0b, 0xbf, 0x83,
-	0x24, 0x99, 0x39, 0x11, 0x90, 0xb8, 0xff, 0x7f, 0x4e, 0xb0, 0xb3, 0x5f, 0x43, 0xd4, 0x9b, 0xbe,
-	0x37, 0x5b, 0x84, 0x62, 0xeb, 0x02, 0xb6, 0xa0, 0x69, 0x1f, 0xd2, 0x2d, 0x89, 0x0e, 0xc8, 0x89,
-	0xd8, 0x03, 0xf2, 0x01, 0x7f, 0x81, 0xf0, 0x66, 0x94, 0x2a, 0xb3, 0xa6, 0x6c, 0x30, 0x73, 0x58,
-	0xf7, 0x12, 0x33, 0x4a, 0x84, 0x20, 0xcf, 0x28, 0x55, 0xc9, 0xfe, 0xfc, 0xb9, 0x8e, 0x5b, 0xb7,
-	0xd4, 0x9d, 0x05, 0x3d, 0xdc, 0xa6, 0x2f, 0x82, 0x4c, 0x6f, 0x8a, 0x9f, 0x14, 0x1b, 0xf7, 0x93,
-	0x60, 0x78, 0x8b, 0xd4, 0xd4, 0xdb, 0x00, 0x48, 0x3f, 0x7e, 0xbc, 0xd4, 0xa7, 0x87, 0x45, 0x7e,
-	0x13, 0x48, 0xaf, 0xc6, 0xe1, 0x0a, 0xd2, 0xaf, 0x0a, 0x52, 0xf2, 0x3b, 0x7c, 0xa9, 0xbf, 0xb0,
-	0xc4, 0x96, 0x5e, 0x8b, 0xc5, 0x16, 0x6c, 0xf2, 0x11, 0x98, 0xe9, 0xf9, 0x1e, 0xcd, 0xc6, 0x52,
-	0x13, 0xf0, 0xa7, 0x5f, 0x79, 0x3e, 0xfe, 0x60, 0x7f, 0x07, 0x4c, 0x75, 0xf5, 0xdc, 0x95, 0xfe,
-	0xba, 0x3a, 0x79, 0xd3, 0x1b, 0xf1, 0x79, 0x65, 0xc7, 0xca, 0x0d, 0x65, 0x80, 0x63, 0x25, 0xb6,
-	0x41, 0x8e, 0xed, 0x55, 0xac, 0xbd, 0xe8, 0x49, 0x75, 0x71, 0x50, 0xf4, 0x42, 0xb6, 0x81, 0xd1,
-	0xeb, 0x51, 0xa1, 0x6e, 0x03, 0x20, 0xbd, 0x41, 0x07, 0x24, 0x61, 0xc8, 0x35, 0x28, 0x09, 0x7b,
-	0xbc, 0xef, 0x6e, 0x81, 0x64, 0xf0, 0x76, 0xd1, 0xfb, 0x4b, 0xfa, 0x3c, 0xe9, 0x95, 0x67, 0xf3,
-	0x04, 0xba, 0xb7, 0xc1, 0x58, 0x64, 0xce, 0x3c, 0xdd, 0x5f, 0x56, 0xe6, 0x4b, 0x67, 0xe3, 0xf1,
-	0x05, 0xfb, 0x7c, 0x08, 0xa6, 0x7b, 0x95, 0xc8, 0x01, 0xbe, 0xee, 0xc1, 0x9e, 0x3e, 0xf7, 0x5c,
-	0xec, 0xfe, 0xe6, 0xe9, 0xa3, 0x1f, 0x3f, 0xbd, 0xb7, 0xa2, 0x14, 0x6f, 0x3c, 0x78, 0x9c, 0x51,
-	0x1e, 0x3e, 0xce, 0x28, 0xbf, 0x3f, 0xce, 0x28, 0x77, 0x9f, 0x64, 0x86, 0x1e, 0x3e, 0xc9, 0x0c,
-	0xfd, 0xfa, 0x24, 0x33, 0x74, 0xeb, 0x35, 0xe9, 0x2d, 0x62, 0x41, 0xdb, 0xdc, 0x46, 0x84, 0xae,
-	0xd9, 0x88, 0xee, 0x61, 0x67, 0x27, 0x04, 0x58, 0x1a, 0x3b, 0xb9, 0x3b, 0x41, 0x01, 0x63, 0x8f,
-	0x94, 0xca, 0x08, 0x6b, 0x42, 0x67, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xdd, 0x84, 0xb5, 0x44,
-	0x6b, 0x17, 0x00, 0x00,
+func (m *MsgWithdrawCredit) Reset()         { *m = MsgWithdrawCredit{} }
+func (m *MsgWithdrawCredit) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawCredit) ProtoMessage()    {}
+func (*MsgWithdrawCredit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{23}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// FundCredit funds a tenant's credit account.
-	FundCredit(ctx context.Context, in *MsgFundCredit, opts ...grpc.CallOption) (*MsgFundCreditResponse, error)
-	// CreateLease creates a new lease for the tenant.
-	// The lease starts in PENDING state awaiting provider acknowledgement.
-	CreateLease(ctx context.Context, in *MsgCreateLease, opts ...grpc.CallOption) (*MsgCreateLeaseResponse, error)
-	// CreateLeaseForTenant allows authority to create a lease on behalf of a tenant.
-	// This is used for migrating off-chain leases to on-chain.
-	// The lease starts in PENDING state awaiting provider acknowledgement.
-	CreateLeaseForTenant(ctx context.Context, in *MsgCreateLeaseForTenant, opts ...grpc.CallOption) (*MsgCreateLeaseForTenantResponse, error)
-	// AcknowledgeLease allows a provider to acknowledge a PENDING lease.
-	// This transitions the lease to ACTIVE state and starts billing.
-	AcknowledgeLease(ctx context.Context, in *MsgAcknowledgeLease, opts ...grpc.CallOption) (*MsgAcknowledgeLeaseResponse, error)
-	// RejectLease allows a provider to reject a PENDING lease.
-	// This transitions the lease to REJECTED state and unlocks tenant credit.
-	RejectLease(ctx context.Context, in *MsgRejectLease, opts ...grpc.CallOption) (*MsgRejectLeaseResponse, error)
-	// CancelLease allows a tenant to cancel their own PENDING lease.
-	// This transitions the lease to REJECTED state and unlocks tenant credit.
-	CancelLease(ctx context.Context, in *MsgCancelLease, opts ...grpc.CallOption) (*MsgCancelLeaseResponse, error)
-	// CloseLease closes an active lease.
-	CloseLease(ctx context.Context, in *MsgCloseLease, opts ...grpc.CallOption) (*MsgCloseLeaseResponse, error)
-	// Withdraw allows a provider to withdraw accrued funds from leases.
-	// Two modes: specific lease UUIDs, or provider-wide with pagination.
-	Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
-	// UpdateParams updates the module parameters.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// SetItemCustomDomain sets or clears the custom_domain on a specific
-	// LeaseItem, identified by service_name. Authorised senders: the lease's
-	// tenant, the module authority, or any address in params.allowed_list. The
-	// lease must be in PENDING or ACTIVE state; an empty custom_domain clears
-	// the field and frees the index entry.
-	SetItemCustomDomain(ctx context.Context, in *MsgSetItemCustomDomain, opts ...grpc.CallOption) (*MsgSetItemCustomDomainResponse, error)
+func (m *MsgWithdrawCredit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgWithdrawCredit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawCredit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgWithdrawCredit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawCredit.Merge(m, src)
+}
+func (m *MsgWithdrawCredit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawCredit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawCredit.DiscardUnknown(m)
 }
 
-func (c *msgClient) FundCredit(ctx context.Context, in *MsgFundCredit, opts ...grpc.CallOption) (*MsgFundCreditResponse, error) {
-	out := new(MsgFundCreditResponse)
-	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/FundCredit", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgWithdrawCredit proto.InternalMessageInfo
+
+func (m *MsgWithdrawCredit) GetTenant() string {
+	if m != nil {
+		return m.Tenant
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreateLease(ctx context.Context, in *MsgCreateLease, opts ...grpc.CallOption) (*MsgCreateLeaseResponse, error) {
-	out := new(MsgCreateLeaseResponse)
-	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/CreateLease", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawCredit) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreateLeaseForTenant(ctx context.Context, in *MsgCreateLeaseForTenant, opts ...grpc.CallOption) (*MsgCreateLeaseForTenantResponse, error) {
-	out := new(MsgCreateLeaseForTenantResponse)
-	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/CreateLeaseForTenant", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawCredit) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) AcknowledgeLease(ctx context.Context, in *MsgAcknowledgeLease, opts ...grpc.CallOption) (*MsgAcknowledgeLeaseResponse, error) {
-	out := new(MsgAcknowledgeLeaseResponse)
-	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/AcknowledgeLease", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgWithdrawCreditResponse is the response type for MsgWithdrawCredit.
+type MsgWithdrawCreditResponse struct {
+	// credit_address is the derived credit account address the funds left.
+	CreditAddress string `protobuf:"bytes,1,opt,name=credit_address,json=creditAddress,proto3" json:"credit_address,omitempty"`
+	// remaining_balances is the credit account balance after the withdrawal,
+	// for the withdrawn denoms only.
+	RemainingBalances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=remaining_balances,json=remainingBalances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining_balances"`
 }
 
-func (c *msgClient) RejectLease(ctx context.Context, in *MsgRejectLease, opts ...grpc.CallOption) (*MsgRejectLeaseResponse, error) {
-	out := new(MsgRejectLeaseResponse)
-	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/RejectLease", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawCreditResponse) Reset()         { *m = MsgWithdrawCreditResponse{} }
+func (m *MsgWithdrawCreditResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawCreditResponse) ProtoMessage()    {}
+func (*MsgWithdrawCreditResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{24}
+}
+func (m *MsgWithdrawCreditResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawCreditResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawCreditResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgWithdrawCreditResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawCreditResponse.Merge(m, src)
+}
+func (m *MsgWithdrawCreditResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawCreditResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawCreditResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CancelLease(ctx context.Context, in *MsgCancelLease, opts ...grpc.CallOption) (*MsgCancelLeaseResponse, error) {
-	out := new(MsgCancelLeaseResponse)
-	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/CancelLease", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgWithdrawCreditResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawCreditResponse) GetCreditAddress() string {
+	if m != nil {
+		return m.CreditAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CloseLease(ctx context.Context, in *MsgCloseLease, opts ...grpc.CallOption) (*MsgCloseLeaseResponse, error) {
-	out := new(MsgCloseLeaseResponse)
-	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/CloseLease", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawCreditResponse) GetRemainingBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RemainingBalances
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
-	out := new(MsgWithdrawResponse)
-	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/Withdraw", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgUpdateLeaseItems changes item quantities on an ACTIVE lease.
+type MsgUpdateLeaseItems struct {
+	// tenant is the address of the lease owner.
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// lease_uuid is the UUID of the lease to update.
+	LeaseUuid string `protobuf:"bytes,2,opt,name=lease_uuid,json=leaseUuid,proto3" json:"lease_uuid,omitempty"`
+	// items lists the new quantities. Each entry addresses an existing lease item:
+	// by service_name for service-name mode leases (sku_uuid must still match),
+	// by sku_uuid for legacy leases. Items not listed keep their quantity.
+	// Adding or removing items is not supported by this message.
+	Items []LeaseItemInput `protobuf:"bytes,3,rep,name=items,proto3" json:"items"`
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateLeaseItems) Reset()         { *m = MsgUpdateLeaseItems{} }
+func (m *MsgUpdateLeaseItems) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateLeaseItems) ProtoMessage()    {}
+func (*MsgUpdateLeaseItems) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{25}
+}
+func (m *MsgUpdateLeaseItems) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateLeaseItems) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateLeaseItems.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateLeaseItems) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateLeaseItems.Merge(m, src)
+}
+func (m *MsgUpdateLeaseItems) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateLeaseItems) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateLeaseItems.DiscardUnknown(m)
 }
 
-func (c *msgClient) SetItemCustomDomain(ctx context.Context, in *MsgSetItemCustomDomain, opts ...grpc.CallOption) (*MsgSetItemCustomDomainResponse, error) {
-	out := new(MsgSetItemCustomDomainResponse)
-	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/SetItemCustomDomain", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateLeaseItems proto.InternalMessageInfo
+
+func (m *MsgUpdateLeaseItems) GetTenant() string {
+	if m != nil {
+		return m.Tenant
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// FundCredit funds a tenant's credit account.
-	FundCredit(context.Context, *MsgFundCredit) (*MsgFundCreditResponse, error)
-	// CreateLease creates a new lease for the tenant.
-	// The lease starts in PENDING state awaiting provider acknowledgement.
-	CreateLease(context.Context, *MsgCreateLease) (*MsgCreateLeaseResponse, error)
-	// CreateLeaseForTenant allows authority to create a lease on behalf of a tenant.
-	// This is used for migrating off-chain leases to on-chain.
-	// The lease starts in PENDING state awaiting provider acknowledgement.
-	CreateLeaseForTenant(context.Context, *MsgCreateLeaseForTenant) (*MsgCreateLeaseForTenantResponse, error)
-	// AcknowledgeLease allows a provider to acknowledge a PENDING lease.
-	// This transitions the lease to ACTIVE state and starts billing.
-	AcknowledgeLease(context.Context, *MsgAcknowledgeLease) (*MsgAcknowledgeLeaseResponse, error)
-	// RejectLease allows a provider to reject a PENDING lease.
-	// This transitions the lease to REJECTED state and unlocks tenant credit.
-	RejectLease(context.Context, *MsgRejectLease) (*MsgRejectLeaseResponse, error)
-	// CancelLease allows a tenant to cancel their own PENDING lease.
-	// This transitions the lease to REJECTED state and unlocks tenant credit.
-	CancelLease(context.Context, *MsgCancelLease) (*MsgCancelLeaseResponse, error)
-	// CloseLease closes an active lease.
-	CloseLease(context.Context, *MsgCloseLease) (*MsgCloseLeaseResponse, error)
-	// Withdraw allows a provider to withdraw accrued funds from leases.
-	// Two modes: specific lease UUIDs, or provider-wide with pagination.
-	Withdraw(context.Context, *MsgWithdraw) (*MsgWithdrawResponse, error)
-	// UpdateParams updates the module parameters.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// SetItemCustomDomain sets or clears the custom_domain on a specific
-	// LeaseItem, identified by service_name. Authorised senders: the lease's
-	// tenant, the module authority, or any address in params.allowed_list. The
-	// lease must be in PENDING or ACTIVE state; an empty custom_domain clears
-	// the field and frees the index entry.
-	SetItemCustomDomain(context.Context, *MsgSetItemCustomDomain) (*MsgSetItemCustomDomainResponse, error)
+func (m *MsgUpdateLeaseItems) GetLeaseUuid() string {
+	if m != nil {
+		return m.LeaseUuid
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgUpdateLeaseItems) GetItems() []LeaseItemInput {
+	if m != nil {
+		return m.Items
+	}
+	return nil
 }
 
-func (*UnimplementedMsgServer) FundCredit(ctx context.Context, req *MsgFundCredit) (*MsgFundCreditResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FundCredit not implemented")
+// MsgUpdateLeaseItemsResponse is the response type for MsgUpdateLeaseItems.
+type MsgUpdateLeaseItemsResponse struct {
+	// items is the lease's full item list after the update.
+	Items []LeaseItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items"`
+	// settled_amounts is the amount transferred to the provider when the lease
+	// was settled ahead of the quantity change.
+	SettledAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=settled_amounts,json=settledAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"settled_amounts"`
 }
-func (*UnimplementedMsgServer) CreateLease(ctx context.Context, req *MsgCreateLease) (*MsgCreateLeaseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateLease not implemented")
-}
-func (*UnimplementedMsgServer) CreateLeaseForTenant(ctx context.Context, req *MsgCreateLeaseForTenant) (*MsgCreateLeaseForTenantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateLeaseForTenant not implemented")
+
+func (m *MsgUpdateLeaseItemsResponse) Reset()         { *m = MsgUpdateLeaseItemsResponse{} }
+func (m *MsgUpdateLeaseItemsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateLeaseItemsResponse) ProtoMessage()    {}
+func (*MsgUpdateLeaseItemsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{26}
 }
-func (*UnimplementedMsgServer) AcknowledgeLease(ctx context.Context, req *MsgAcknowledgeLease) (*MsgAcknowledgeLeaseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeLease not implemented")
+func (m *MsgUpdateLeaseItemsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) RejectLease(ctx context.Context, req *MsgRejectLease) (*MsgRejectLeaseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RejectLease not implemented")
+func (m *MsgUpdateLeaseItemsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateLeaseItemsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CancelLease(ctx context.Context, req *MsgCancelLease) (*MsgCancelLeaseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelLease not implemented")
+func (m *MsgUpdateLeaseItemsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateLeaseItemsResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CloseLease(ctx context.Context, req *MsgCloseLease) (*MsgCloseLeaseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CloseLease not implemented")
+func (m *MsgUpdateLeaseItemsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdraw) (*MsgWithdrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+func (m *MsgUpdateLeaseItemsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateLeaseItemsResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+
+var xxx_messageInfo_MsgUpdateLeaseItemsResponse proto.InternalMessageInfo
+
+func (m *MsgUpdateLeaseItemsResponse) GetItems() []LeaseItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
 }
-func (*UnimplementedMsgServer) SetItemCustomDomain(ctx context.Context, req *MsgSetItemCustomDomain) (*MsgSetItemCustomDomainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetItemCustomDomain not implemented")
+
+func (m *MsgUpdateLeaseItemsResponse) GetSettledAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SettledAmounts
+	}
+	return nil
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+// MsgProposeLeaseAmendment proposes adding and/or removing items on an ACTIVE
+// lease.
+type MsgProposeLeaseAmendment struct {
+	// tenant is the address of the lease owner.
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// lease_uuid is the UUID of the lease to amend.
+	LeaseUuid string `protobuf:"bytes,2,opt,name=lease_uuid,json=leaseUuid,proto3" json:"lease_uuid,omitempty"`
+	// add_items are new items to attach to the lease. They must follow the
+	// lease's service_name mode and belong to the lease's provider.
+	AddItems []LeaseItemInput `protobuf:"bytes,3,rep,name=add_items,json=addItems,proto3" json:"add_items"`
+	// remove_items identifies existing items to drop: the service_name for
+	// service-name mode leases, the sku_uuid for legacy leases.
+	RemoveItems []string `protobuf:"bytes,4,rep,name=remove_items,json=removeItems,proto3" json:"remove_items,omitempty"`
 }
 
-func _Msg_FundCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFundCredit)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).FundCredit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liftedinit.billing.v1.Msg/FundCredit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FundCredit(ctx, req.(*MsgFundCredit))
+func (m *MsgProposeLeaseAmendment) Reset()         { *m = MsgProposeLeaseAmendment{} }
+func (m *MsgProposeLeaseAmendment) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeLeaseAmendment) ProtoMessage()    {}
+func (*MsgProposeLeaseAmendment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{27}
+}
+func (m *MsgProposeLeaseAmendment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeLeaseAmendment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeLeaseAmendment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgProposeLeaseAmendment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeLeaseAmendment.Merge(m, src)
+}
+func (m *MsgProposeLeaseAmendment) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeLeaseAmendment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeLeaseAmendment.DiscardUnknown(m)
 }
 
-func _Msg_CreateLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateLease)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateLease(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liftedinit.billing.v1.Msg/CreateLease",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateLease(ctx, req.(*MsgCreateLease))
+var xxx_messageInfo_MsgProposeLeaseAmendment proto.InternalMessageInfo
+
+func (m *MsgProposeLeaseAmendment) GetTenant() string {
+	if m != nil {
+		return m.Tenant
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateLeaseForTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateLeaseForTenant)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateLeaseForTenant(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liftedinit.billing.v1.Msg/CreateLeaseForTenant",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateLeaseForTenant(ctx, req.(*MsgCreateLeaseForTenant))
+func (m *MsgProposeLeaseAmendment) GetLeaseUuid() string {
+	if m != nil {
+		return m.LeaseUuid
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_AcknowledgeLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAcknowledgeLease)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AcknowledgeLease(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liftedinit.billing.v1.Msg/AcknowledgeLease",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AcknowledgeLease(ctx, req.(*MsgAcknowledgeLease))
+func (m *MsgProposeLeaseAmendment) GetAddItems() []LeaseItemInput {
+	if m != nil {
+		return m.AddItems
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_RejectLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRejectLease)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgProposeLeaseAmendment) GetRemoveItems() []string {
+	if m != nil {
+		return m.RemoveItems
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RejectLease(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liftedinit.billing.v1.Msg/RejectLease",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RejectLease(ctx, req.(*MsgRejectLease))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_CancelLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelLease)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelLease(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liftedinit.billing.v1.Msg/CancelLease",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelLease(ctx, req.(*MsgCancelLease))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgProposeLeaseAmendmentResponse is the response type for
+// MsgProposeLeaseAmendment.
+type MsgProposeLeaseAmendmentResponse struct {
+	// reserved_amounts is the credit reserved for the added items.
+	ReservedAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=reserved_amounts,json=reservedAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reserved_amounts"`
 }
 
-func _Msg_CloseLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCloseLease)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CloseLease(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liftedinit.billing.v1.Msg/CloseLease",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CloseLease(ctx, req.(*MsgCloseLease))
+func (m *MsgProposeLeaseAmendmentResponse) Reset()         { *m = MsgProposeLeaseAmendmentResponse{} }
+func (m *MsgProposeLeaseAmendmentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeLeaseAmendmentResponse) ProtoMessage()    {}
+func (*MsgProposeLeaseAmendmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{28}
+}
+func (m *MsgProposeLeaseAmendmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeLeaseAmendmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeLeaseAmendmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgProposeLeaseAmendmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeLeaseAmendmentResponse.Merge(m, src)
+}
+func (m *MsgProposeLeaseAmendmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeLeaseAmendmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeLeaseAmendmentResponse.DiscardUnknown(m)
 }
 
-func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdraw)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Withdraw(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liftedinit.billing.v1.Msg/Withdraw",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Withdraw(ctx, req.(*MsgWithdraw))
+var xxx_messageInfo_MsgProposeLeaseAmendmentResponse proto.InternalMessageInfo
+
+func (m *MsgProposeLeaseAmendmentResponse) GetReservedAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ReservedAmounts
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liftedinit.billing.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgAcknowledgeLeaseAmendment accepts a pending lease amendment.
+type MsgAcknowledgeLeaseAmendment struct {
+	// sender is the provider address or the module authority.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// lease_uuid is the UUID of the lease whose amendment is acknowledged.
+	LeaseUuid string `protobuf:"bytes,2,opt,name=lease_uuid,json=leaseUuid,proto3" json:"lease_uuid,omitempty"`
 }
 
-func _Msg_SetItemCustomDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetItemCustomDomain)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetItemCustomDomain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/liftedinit.billing.v1.Msg/SetItemCustomDomain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetItemCustomDomain(ctx, req.(*MsgSetItemCustomDomain))
+func (m *MsgAcknowledgeLeaseAmendment) Reset()         { *m = MsgAcknowledgeLeaseAmendment{} }
+func (m *MsgAcknowledgeLeaseAmendment) String() string { return proto.CompactTextString(m) }
+func (*MsgAcknowledgeLeaseAmendment) ProtoMessage()    {}
+func (*MsgAcknowledgeLeaseAmendment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{29}
+}
+func (m *MsgAcknowledgeLeaseAmendment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcknowledgeLeaseAmendment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcknowledgeLeaseAmendment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAcknowledgeLeaseAmendment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcknowledgeLeaseAmendment.Merge(m, src)
+}
+func (m *MsgAcknowledgeLeaseAmendment) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcknowledgeLeaseAmendment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcknowledgeLeaseAmendment.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "liftedinit.billing.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "FundCredit",
-			Handler:    _Msg_FundCredit_Handler,
-		},
-		{
-			MethodName: "CreateLease",
-			Handler:    _Msg_CreateLease_Handler,
-		},
-		{
-			MethodName: "CreateLeaseForTenant",
-			Handler:    _Msg_CreateLeaseForTenant_Handler,
-		},
-		{
-			MethodName: "AcknowledgeLease",
-			Handler:    _Msg_AcknowledgeLease_Handler,
-		},
-		{
-			MethodName: "RejectLease",
-			Handler:    _Msg_RejectLease_Handler,
-		},
-		{
-			MethodName: "CancelLease",
-			Handler:    _Msg_CancelLease_Handler,
-		},
-		{
-			MethodName: "CloseLease",
-			Handler:    _Msg_CloseLease_Handler,
-		},
-		{
-			MethodName: "Withdraw",
-			Handler:    _Msg_Withdraw_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "SetItemCustomDomain",
-			Handler:    _Msg_SetItemCustomDomain_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "liftedinit/billing/v1/tx.proto",
+var xxx_messageInfo_MsgAcknowledgeLeaseAmendment proto.InternalMessageInfo
+
+func (m *MsgAcknowledgeLeaseAmendment) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *LeaseItemInput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAcknowledgeLeaseAmendment) GetLeaseUuid() string {
+	if m != nil {
+		return m.LeaseUuid
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *LeaseItemInput) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgAcknowledgeLeaseAmendmentResponse is the response type for
+// MsgAcknowledgeLeaseAmendment.
+type MsgAcknowledgeLeaseAmendmentResponse struct {
+	// items is the lease's full item list after the amendment.
+	Items []LeaseItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items"`
+	// settled_amounts is the amount transferred to the provider when the lease
+	// was settled ahead of the item change.
+	SettledAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=settled_amounts,json=settledAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"settled_amounts"`
 }
 
-func (m *LeaseItemInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ServiceName) > 0 {
-		i -= len(m.ServiceName)
-		copy(dAtA[i:], m.ServiceName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Quantity != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Quantity))
-		i--
-		dAtA[i] = 0x10
+func (m *MsgAcknowledgeLeaseAmendmentResponse) Reset()         { *m = MsgAcknowledgeLeaseAmendmentResponse{} }
+func (m *MsgAcknowledgeLeaseAmendmentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcknowledgeLeaseAmendmentResponse) ProtoMessage()    {}
+func (*MsgAcknowledgeLeaseAmendmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{30}
+}
+func (m *MsgAcknowledgeLeaseAmendmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcknowledgeLeaseAmendmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcknowledgeLeaseAmendmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.SkuUuid) > 0 {
-		i -= len(m.SkuUuid)
-		copy(dAtA[i:], m.SkuUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SkuUuid)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgAcknowledgeLeaseAmendmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcknowledgeLeaseAmendmentResponse.Merge(m, src)
+}
+func (m *MsgAcknowledgeLeaseAmendmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcknowledgeLeaseAmendmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcknowledgeLeaseAmendmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcknowledgeLeaseAmendmentResponse proto.InternalMessageInfo
+
+func (m *MsgAcknowledgeLeaseAmendmentResponse) GetItems() []LeaseItem {
+	if m != nil {
+		return m.Items
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgFundCredit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAcknowledgeLeaseAmendmentResponse) GetSettledAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SettledAmounts
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgFundCredit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRejectLeaseAmendment rejects a pending lease amendment.
+type MsgRejectLeaseAmendment struct {
+	// sender is the provider address or the module authority.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// lease_uuid is the UUID of the lease whose amendment is rejected.
+	LeaseUuid string `protobuf:"bytes,2,opt,name=lease_uuid,json=leaseUuid,proto3" json:"lease_uuid,omitempty"`
+	// reason is an optional explanation for the rejection. Maximum 256 characters.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *MsgFundCredit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgRejectLeaseAmendment) Reset()         { *m = MsgRejectLeaseAmendment{} }
+func (m *MsgRejectLeaseAmendment) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectLeaseAmendment) ProtoMessage()    {}
+func (*MsgRejectLeaseAmendment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{31}
+}
+func (m *MsgRejectLeaseAmendment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectLeaseAmendment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectLeaseAmendment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Tenant) > 0 {
-		i -= len(m.Tenant)
-		copy(dAtA[i:], m.Tenant)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgRejectLeaseAmendment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectLeaseAmendment.Merge(m, src)
+}
+func (m *MsgRejectLeaseAmendment) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectLeaseAmendment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectLeaseAmendment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRejectLeaseAmendment proto.InternalMessageInfo
+
+func (m *MsgRejectLeaseAmendment) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgRejectLeaseAmendment) GetLeaseUuid() string {
+	if m != nil {
+		return m.LeaseUuid
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgFundCreditResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRejectLeaseAmendment) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgFundCreditResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRejectLeaseAmendmentResponse is the response type for
+// MsgRejectLeaseAmendment.
+type MsgRejectLeaseAmendmentResponse struct {
 }
 
-func (m *MsgFundCreditResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.NewBalance.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRejectLeaseAmendmentResponse) Reset()         { *m = MsgRejectLeaseAmendmentResponse{} }
+func (m *MsgRejectLeaseAmendmentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectLeaseAmendmentResponse) ProtoMessage()    {}
+func (*MsgRejectLeaseAmendmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{32}
+}
+func (m *MsgRejectLeaseAmendmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectLeaseAmendmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectLeaseAmendmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.CreditAddress) > 0 {
-		i -= len(m.CreditAddress)
-		copy(dAtA[i:], m.CreditAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CreditAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateLease) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRejectLeaseAmendmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectLeaseAmendmentResponse.Merge(m, src)
+}
+func (m *MsgRejectLeaseAmendmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectLeaseAmendmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectLeaseAmendmentResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateLease) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRejectLeaseAmendmentResponse proto.InternalMessageInfo
+
+// MsgSetLeaseScheduledEnd sets or extends the scheduled end time of a lease.
+type MsgSetLeaseScheduledEnd struct {
+	// tenant is the address of the lease owner.
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// lease_uuid is the UUID of the lease to schedule.
+	LeaseUuid string `protobuf:"bytes,2,opt,name=lease_uuid,json=leaseUuid,proto3" json:"lease_uuid,omitempty"`
+	// scheduled_end_at is the new end time. It must be after the current block
+	// time and, if the lease already has one, after the existing end time.
+	ScheduledEndAt time.Time `protobuf:"bytes,3,opt,name=scheduled_end_at,json=scheduledEndAt,proto3,stdtime" json:"scheduled_end_at"`
 }
 
-func (m *MsgCreateLease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MetaHash) > 0 {
-		i -= len(m.MetaHash)
-		copy(dAtA[i:], m.MetaHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MetaHash)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgSetLeaseScheduledEnd) Reset()         { *m = MsgSetLeaseScheduledEnd{} }
+func (m *MsgSetLeaseScheduledEnd) String() string { return proto.CompactTextString(m) }
+func (*MsgSetLeaseScheduledEnd) ProtoMessage()    {}
+func (*MsgSetLeaseScheduledEnd) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{33}
+}
+func (m *MsgSetLeaseScheduledEnd) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetLeaseScheduledEnd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetLeaseScheduledEnd.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Tenant) > 0 {
-		i -= len(m.Tenant)
-		copy(dAtA[i:], m.Tenant)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateLeaseResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetLeaseScheduledEnd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetLeaseScheduledEnd.Merge(m, src)
+}
+func (m *MsgSetLeaseScheduledEnd) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetLeaseScheduledEnd) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetLeaseScheduledEnd.DiscardUnknown(m)
 }
 
-func (m *MsgCreateLeaseResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetLeaseScheduledEnd proto.InternalMessageInfo
+
+func (m *MsgSetLeaseScheduledEnd) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
 }
 
-func (m *MsgCreateLeaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LeaseUuid) > 0 {
-		i -= len(m.LeaseUuid)
-		copy(dAtA[i:], m.LeaseUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.LeaseUuid)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSetLeaseScheduledEnd) GetLeaseUuid() string {
+	if m != nil {
+		return m.LeaseUuid
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateLeaseForTenant) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetLeaseScheduledEnd) GetScheduledEndAt() time.Time {
+	if m != nil {
+		return m.ScheduledEndAt
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *MsgCreateLeaseForTenant) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetLeaseScheduledEndResponse is the response type for
+// MsgSetLeaseScheduledEnd.
+type MsgSetLeaseScheduledEndResponse struct {
 }
 
-func (m *MsgCreateLeaseForTenant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MetaHash) > 0 {
-		i -= len(m.MetaHash)
-		copy(dAtA[i:], m.MetaHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MetaHash)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgSetLeaseScheduledEndResponse) Reset()         { *m = MsgSetLeaseScheduledEndResponse{} }
+func (m *MsgSetLeaseScheduledEndResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetLeaseScheduledEndResponse) ProtoMessage()    {}
+func (*MsgSetLeaseScheduledEndResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{34}
+}
+func (m *MsgSetLeaseScheduledEndResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetLeaseScheduledEndResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetLeaseScheduledEndResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Tenant) > 0 {
-		i -= len(m.Tenant)
-		copy(dAtA[i:], m.Tenant)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateLeaseForTenantResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetLeaseScheduledEndResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetLeaseScheduledEndResponse.Merge(m, src)
 }
-
-func (m *MsgCreateLeaseForTenantResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetLeaseScheduledEndResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetLeaseScheduledEndResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetLeaseScheduledEndResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateLeaseForTenantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LeaseUuid) > 0 {
-		i -= len(m.LeaseUuid)
-		copy(dAtA[i:], m.LeaseUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.LeaseUuid)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgSetLeaseScheduledEndResponse proto.InternalMessageInfo
+
+// UsageReport is the cumulative usage of one metered lease item.
+type UsageReport struct {
+	// sku_uuid identifies the item in legacy leases.
+	SkuUuid string `protobuf:"bytes,1,opt,name=sku_uuid,json=skuUuid,proto3" json:"sku_uuid,omitempty"`
+	// service_name identifies the item in service-name mode leases.
+	ServiceName string `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// counter is the total usage of the item since the lease started. It must
+	// not be lower than the previously reported counter.
+	Counter uint64 `protobuf:"varint,3,opt,name=counter,proto3" json:"counter,omitempty,string"`
+}
+
+func (m *UsageReport) Reset()         { *m = UsageReport{} }
+func (m *UsageReport) String() string { return proto.CompactTextString(m) }
+func (*UsageReport) ProtoMessage()    {}
+func (*UsageReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{35}
+}
+func (m *UsageReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UsageReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UsageReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *UsageReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsageReport.Merge(m, src)
+}
+func (m *UsageReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *UsageReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsageReport.DiscardUnknown(m)
 }
 
-func (m *MsgCloseLease) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UsageReport proto.InternalMessageInfo
+
+func (m *UsageReport) GetSkuUuid() string {
+	if m != nil {
+		return m.SkuUuid
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCloseLease) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *UsageReport) GetServiceName() string {
+	if m != nil {
+		return m.ServiceName
+	}
+	return ""
 }
 
-func (m *MsgCloseLease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x1a
+func (m *UsageReport) GetCounter() uint64 {
+	if m != nil {
+		return m.Counter
 	}
-	if len(m.LeaseUuids) > 0 {
-		for iNdEx := len(m.LeaseUuids) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.LeaseUuids[iNdEx])
-			copy(dAtA[i:], m.LeaseUuids[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.LeaseUuids[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+	return 0
+}
+
+// MsgReportUsage reports usage counters for the metered items of a lease.
+type MsgReportUsage struct {
+	// sender is the address of the lease's provider.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// lease_uuid is the UUID of the metered lease.
+	LeaseUuid string `protobuf:"bytes,2,opt,name=lease_uuid,json=leaseUuid,proto3" json:"lease_uuid,omitempty"`
+	// usages holds the new counter of each reported item.
+	Usages []UsageReport `protobuf:"bytes,3,rep,name=usages,proto3" json:"usages"`
+}
+
+func (m *MsgReportUsage) Reset()         { *m = MsgReportUsage{} }
+func (m *MsgReportUsage) String() string { return proto.CompactTextString(m) }
+func (*MsgReportUsage) ProtoMessage()    {}
+func (*MsgReportUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{36}
+}
+func (m *MsgReportUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgReportUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportUsage.Merge(m, src)
+}
+func (m *MsgReportUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReportUsage proto.InternalMessageInfo
+
+func (m *MsgReportUsage) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCloseLeaseResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReportUsage) GetLeaseUuid() string {
+	if m != nil {
+		return m.LeaseUuid
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCloseLeaseResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgReportUsage) GetUsages() []UsageReport {
+	if m != nil {
+		return m.Usages
+	}
+	return nil
 }
 
-func (m *MsgCloseLeaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TotalSettledAmounts) > 0 {
-		for iNdEx := len(m.TotalSettledAmounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalSettledAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+// MsgReportUsageResponse is the response type for MsgReportUsage.
+type MsgReportUsageResponse struct {
+	// charged_amounts is the amount transferred to the provider.
+	ChargedAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=charged_amounts,json=chargedAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"charged_amounts"`
+	// uncharged_amounts is the usage cost that was not charged because it
+	// exceeded the spend limit or the tenant's credit. It stays billable and is
+	// charged by a later report once there is room.
+	UnchargedAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=uncharged_amounts,json=unchargedAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"uncharged_amounts"`
+}
+
+func (m *MsgReportUsageResponse) Reset()         { *m = MsgReportUsageResponse{} }
+func (m *MsgReportUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReportUsageResponse) ProtoMessage()    {}
+func (*MsgReportUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{37}
+}
+func (m *MsgReportUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.ClosedCount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ClosedCount))
-		i--
-		dAtA[i] = 0x10
-	}
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ClosedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ClosedAt):])
-	if err3 != nil {
-		return 0, err3
+}
+func (m *MsgReportUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportUsageResponse.Merge(m, src)
+}
+func (m *MsgReportUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReportUsageResponse proto.InternalMessageInfo
+
+func (m *MsgReportUsageResponse) GetChargedAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ChargedAmounts
 	}
-	i -= n3
-	i = encodeVarintTx(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgWithdraw) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReportUsageResponse) GetUnchargedAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UnchargedAmounts
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgWithdraw) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetUsageSpendLimit sets the usage spend limit of a lease.
+type MsgSetUsageSpendLimit struct {
+	// tenant is the address of the lease owner.
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// lease_uuid is the UUID of the metered lease.
+	LeaseUuid string `protobuf:"bytes,2,opt,name=lease_uuid,json=leaseUuid,proto3" json:"lease_uuid,omitempty"`
+	// spend_limit is the most usage charges allowed per period. An empty limit
+	// stops usage from being charged.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// period is the spend period in seconds, between one hour and one year.
+	Period uint64 `protobuf:"varint,4,opt,name=period,proto3" json:"period,omitempty,string"`
 }
 
-func (m *MsgWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Limit != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x20
+func (m *MsgSetUsageSpendLimit) Reset()         { *m = MsgSetUsageSpendLimit{} }
+func (m *MsgSetUsageSpendLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetUsageSpendLimit) ProtoMessage()    {}
+func (*MsgSetUsageSpendLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{38}
+}
+func (m *MsgSetUsageSpendLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetUsageSpendLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetUsageSpendLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ProviderUuid) > 0 {
-		i -= len(m.ProviderUuid)
-		copy(dAtA[i:], m.ProviderUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProviderUuid)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *MsgSetUsageSpendLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetUsageSpendLimit.Merge(m, src)
+}
+func (m *MsgSetUsageSpendLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetUsageSpendLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetUsageSpendLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetUsageSpendLimit proto.InternalMessageInfo
+
+func (m *MsgSetUsageSpendLimit) GetTenant() string {
+	if m != nil {
+		return m.Tenant
 	}
-	if len(m.LeaseUuids) > 0 {
-		for iNdEx := len(m.LeaseUuids) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.LeaseUuids[iNdEx])
-			copy(dAtA[i:], m.LeaseUuids[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.LeaseUuids[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	return ""
+}
+
+func (m *MsgSetUsageSpendLimit) GetLeaseUuid() string {
+	if m != nil {
+		return m.LeaseUuid
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgSetUsageSpendLimit) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgWithdrawResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetUsageSpendLimit) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetUsageSpendLimitResponse is the response type for
+// MsgSetUsageSpendLimit.
+type MsgSetUsageSpendLimitResponse struct {
 }
 
-func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.HasMore {
-		i--
-		if m.HasMore {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgSetUsageSpendLimitResponse) Reset()         { *m = MsgSetUsageSpendLimitResponse{} }
+func (m *MsgSetUsageSpendLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetUsageSpendLimitResponse) ProtoMessage()    {}
+func (*MsgSetUsageSpendLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{39}
+}
+func (m *MsgSetUsageSpendLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetUsageSpendLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetUsageSpendLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x20
+		return b[:n], nil
 	}
-	if m.WithdrawalCount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.WithdrawalCount))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *MsgSetUsageSpendLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetUsageSpendLimitResponse.Merge(m, src)
+}
+func (m *MsgSetUsageSpendLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetUsageSpendLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetUsageSpendLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetUsageSpendLimitResponse proto.InternalMessageInfo
+
+// MsgOpenDispute opens a dispute on a lease.
+type MsgOpenDispute struct {
+	// tenant is the address of the lease owner.
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// lease_uuid is the UUID of the disputed lease.
+	LeaseUuid string `protobuf:"bytes,2,opt,name=lease_uuid,json=leaseUuid,proto3" json:"lease_uuid,omitempty"`
+	// reason describes the problem. Required, maximum 256 characters.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgOpenDispute) Reset()         { *m = MsgOpenDispute{} }
+func (m *MsgOpenDispute) String() string { return proto.CompactTextString(m) }
+func (*MsgOpenDispute) ProtoMessage()    {}
+func (*MsgOpenDispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{40}
+}
+func (m *MsgOpenDispute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOpenDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOpenDispute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.PayoutAddress) > 0 {
-		i -= len(m.PayoutAddress)
-		copy(dAtA[i:], m.PayoutAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PayoutAddress)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgOpenDispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOpenDispute.Merge(m, src)
+}
+func (m *MsgOpenDispute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOpenDispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOpenDispute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOpenDispute proto.InternalMessageInfo
+
+func (m *MsgOpenDispute) GetTenant() string {
+	if m != nil {
+		return m.Tenant
 	}
-	if len(m.TotalAmounts) > 0 {
-		for iNdEx := len(m.TotalAmounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return ""
+}
+
+func (m *MsgOpenDispute) GetLeaseUuid() string {
+	if m != nil {
+		return m.LeaseUuid
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgOpenDispute) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgOpenDisputeResponse is the response type for MsgOpenDispute.
+type MsgOpenDisputeResponse struct {
+	// escrowed is the amount moved into escrow when the dispute was opened.
+	Escrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=escrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"escrowed"`
+	// response_deadline is the time by which the provider must respond.
+	ResponseDeadline time.Time `protobuf:"bytes,2,opt,name=response_deadline,json=responseDeadline,proto3,stdtime" json:"response_deadline"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgOpenDisputeResponse) Reset()         { *m = MsgOpenDisputeResponse{} }
+func (m *MsgOpenDisputeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgOpenDisputeResponse) ProtoMessage()    {}
+func (*MsgOpenDisputeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{41}
+}
+func (m *MsgOpenDisputeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOpenDisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOpenDisputeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgOpenDisputeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOpenDisputeResponse.Merge(m, src)
 }
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgOpenDisputeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOpenDisputeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOpenDisputeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgOpenDisputeResponse proto.InternalMessageInfo
+
+func (m *MsgOpenDisputeResponse) GetEscrowed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Escrowed
+	}
+	return nil
 }
 
-func (m *MsgAcknowledgeLease) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgOpenDisputeResponse) GetResponseDeadline() time.Time {
+	if m != nil {
+		return m.ResponseDeadline
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *MsgAcknowledgeLease) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRespondToDispute records the provider's answer to a dispute.
+type MsgRespondToDispute struct {
+	// sender is the address of the lease's provider.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// lease_uuid is the UUID of the disputed lease.
+	LeaseUuid string `protobuf:"bytes,2,opt,name=lease_uuid,json=leaseUuid,proto3" json:"lease_uuid,omitempty"`
+	// response is the provider's answer. Required, maximum 256 characters.
+	Response string `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
 }
 
-func (m *MsgAcknowledgeLease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LeaseUuids) > 0 {
-		for iNdEx := len(m.LeaseUuids) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.LeaseUuids[iNdEx])
-			copy(dAtA[i:], m.LeaseUuids[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.LeaseUuids[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *MsgRespondToDispute) Reset()         { *m = MsgRespondToDispute{} }
+func (m *MsgRespondToDispute) String() string { return proto.CompactTextString(m) }
+func (*MsgRespondToDispute) ProtoMessage()    {}
+func (*MsgRespondToDispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{42}
+}
+func (m *MsgRespondToDispute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRespondToDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRespondToDispute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRespondToDispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRespondToDispute.Merge(m, src)
+}
+func (m *MsgRespondToDispute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRespondToDispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRespondToDispute.DiscardUnknown(m)
 }
 
-func (m *MsgAcknowledgeLeaseResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRespondToDispute proto.InternalMessageInfo
+
+func (m *MsgRespondToDispute) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAcknowledgeLeaseResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRespondToDispute) GetLeaseUuid() string {
+	if m != nil {
+		return m.LeaseUuid
+	}
+	return ""
 }
 
-func (m *MsgAcknowledgeLeaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AcknowledgedCount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AcknowledgedCount))
-		i--
-		dAtA[i] = 0x10
-	}
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.AcknowledgedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AcknowledgedAt):])
-	if err5 != nil {
-		return 0, err5
+func (m *MsgRespondToDispute) GetResponse() string {
+	if m != nil {
+		return m.Response
 	}
-	i -= n5
-	i = encodeVarintTx(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRejectLease) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgRespondToDisputeResponse is the response type for MsgRespondToDispute.
+type MsgRespondToDisputeResponse struct {
 }
 
-func (m *MsgRejectLease) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRespondToDisputeResponse) Reset()         { *m = MsgRespondToDisputeResponse{} }
+func (m *MsgRespondToDisputeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRespondToDisputeResponse) ProtoMessage()    {}
+func (*MsgRespondToDisputeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{43}
+}
+func (m *MsgRespondToDisputeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRespondToDisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRespondToDisputeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRespondToDisputeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRespondToDisputeResponse.Merge(m, src)
+}
+func (m *MsgRespondToDisputeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRespondToDisputeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRespondToDisputeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRejectLease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.LeaseUuids) > 0 {
-		for iNdEx := len(m.LeaseUuids) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.LeaseUuids[iNdEx])
-			copy(dAtA[i:], m.LeaseUuids[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.LeaseUuids[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+var xxx_messageInfo_MsgRespondToDisputeResponse proto.InternalMessageInfo
+
+// MsgResolveDispute settles an open dispute.
+type MsgResolveDispute struct {
+	// authority is the address of the module authority.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// lease_uuid is the UUID of the disputed lease.
+	LeaseUuid string `protobuf:"bytes,2,opt,name=lease_uuid,json=leaseUuid,proto3" json:"lease_uuid,omitempty"`
+	// tenant_share is the fraction of the escrow, between 0 and 1, returned to
+	// the tenant's credit account. The rest is paid to the provider.
+	TenantShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=tenant_share,json=tenantShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"tenant_share"`
+}
+
+func (m *MsgResolveDispute) Reset()         { *m = MsgResolveDispute{} }
+func (m *MsgResolveDispute) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveDispute) ProtoMessage()    {}
+func (*MsgResolveDispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{44}
+}
+func (m *MsgResolveDispute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveDispute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgResolveDispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveDispute.Merge(m, src)
+}
+func (m *MsgResolveDispute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveDispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveDispute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolveDispute proto.InternalMessageInfo
+
+func (m *MsgResolveDispute) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRejectLeaseResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgResolveDispute) GetLeaseUuid() string {
+	if m != nil {
+		return m.LeaseUuid
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRejectLeaseResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgResolveDisputeResponse is the response type for MsgResolveDispute.
+type MsgResolveDisputeResponse struct {
+	// tenant_amounts is the amount returned to the tenant's credit account.
+	TenantAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=tenant_amounts,json=tenantAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tenant_amounts"`
+	// provider_amounts is the amount paid to the provider.
+	ProviderAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=provider_amounts,json=providerAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"provider_amounts"`
 }
 
-func (m *MsgRejectLeaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RejectedCount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RejectedCount))
-		i--
-		dAtA[i] = 0x10
-	}
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RejectedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RejectedAt):])
-	if err6 != nil {
-		return 0, err6
+func (m *MsgResolveDisputeResponse) Reset()         { *m = MsgResolveDisputeResponse{} }
+func (m *MsgResolveDisputeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveDisputeResponse) ProtoMessage()    {}
+func (*MsgResolveDisputeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{45}
+}
+func (m *MsgResolveDisputeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveDisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveDisputeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i -= n6
-	i = encodeVarintTx(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgResolveDisputeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveDisputeResponse.Merge(m, src)
+}
+func (m *MsgResolveDisputeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveDisputeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveDisputeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCancelLease) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgResolveDisputeResponse proto.InternalMessageInfo
+
+func (m *MsgResolveDisputeResponse) GetTenantAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TenantAmounts
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCancelLease) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgResolveDisputeResponse) GetProviderAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ProviderAmounts
+	}
+	return nil
 }
 
-func (m *MsgCancelLease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LeaseUuids) > 0 {
-		for iNdEx := len(m.LeaseUuids) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.LeaseUuids[iNdEx])
-			copy(dAtA[i:], m.LeaseUuids[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.LeaseUuids[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+// MsgTransferCredit moves credit from one tenant's credit account to another
+// tenant's credit account, creating the destination account if needed.
+type MsgTransferCredit struct {
+	// sender is the address requesting the transfer: the source tenant, or the
+	// module authority.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// from_tenant is the tenant whose credit account the funds leave.
+	FromTenant string `protobuf:"bytes,2,opt,name=from_tenant,json=fromTenant,proto3" json:"from_tenant,omitempty"`
+	// to_tenant is the tenant whose credit account receives the funds.
+	ToTenant string `protobuf:"bytes,3,opt,name=to_tenant,json=toTenant,proto3" json:"to_tenant,omitempty"`
+	// amount is the amount to transfer (one entry per denom). Each denom must not
+	// exceed the withdrawable credit reported by Query/AvailableCredit for
+	// from_tenant.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgTransferCredit) Reset()         { *m = MsgTransferCredit{} }
+func (m *MsgTransferCredit) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferCredit) ProtoMessage()    {}
+func (*MsgTransferCredit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{46}
+}
+func (m *MsgTransferCredit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferCredit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferCredit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Tenant) > 0 {
-		i -= len(m.Tenant)
-		copy(dAtA[i:], m.Tenant)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgTransferCredit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferCredit.Merge(m, src)
+}
+func (m *MsgTransferCredit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferCredit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferCredit.DiscardUnknown(m)
 }
 
-func (m *MsgCancelLeaseResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgTransferCredit proto.InternalMessageInfo
+
+func (m *MsgTransferCredit) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCancelLeaseResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTransferCredit) GetFromTenant() string {
+	if m != nil {
+		return m.FromTenant
+	}
+	return ""
 }
 
-func (m *MsgCancelLeaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CancelledCount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CancelledCount))
-		i--
-		dAtA[i] = 0x10
-	}
-	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CancelledAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CancelledAt):])
-	if err7 != nil {
-		return 0, err7
+func (m *MsgTransferCredit) GetToTenant() string {
+	if m != nil {
+		return m.ToTenant
 	}
-	i -= n7
-	i = encodeVarintTx(dAtA, i, uint64(n7))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSetItemCustomDomain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTransferCredit) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgSetItemCustomDomain) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgTransferCreditResponse is the response type for MsgTransferCredit.
+type MsgTransferCreditResponse struct {
+	// from_credit_address is the source tenant's credit address.
+	FromCreditAddress string `protobuf:"bytes,1,opt,name=from_credit_address,json=fromCreditAddress,proto3" json:"from_credit_address,omitempty"`
+	// to_credit_address is the destination tenant's credit address.
+	ToCreditAddress string `protobuf:"bytes,2,opt,name=to_credit_address,json=toCreditAddress,proto3" json:"to_credit_address,omitempty"`
 }
 
-func (m *MsgSetItemCustomDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CustomDomain) > 0 {
-		i -= len(m.CustomDomain)
-		copy(dAtA[i:], m.CustomDomain)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CustomDomain)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ServiceName) > 0 {
-		i -= len(m.ServiceName)
-		copy(dAtA[i:], m.ServiceName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.LeaseUuid) > 0 {
-		i -= len(m.LeaseUuid)
-		copy(dAtA[i:], m.LeaseUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.LeaseUuid)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgTransferCreditResponse) Reset()         { *m = MsgTransferCreditResponse{} }
+func (m *MsgTransferCreditResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferCreditResponse) ProtoMessage()    {}
+func (*MsgTransferCreditResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{47}
+}
+func (m *MsgTransferCreditResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferCreditResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferCreditResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgTransferCreditResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferCreditResponse.Merge(m, src)
+}
+func (m *MsgTransferCreditResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferCreditResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferCreditResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferCreditResponse proto.InternalMessageInfo
+
+func (m *MsgTransferCreditResponse) GetFromCreditAddress() string {
+	if m != nil {
+		return m.FromCreditAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSetItemCustomDomainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTransferCreditResponse) GetToCreditAddress() string {
+	if m != nil {
+		return m.ToCreditAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSetItemCustomDomainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCreateLeases creates several leases for the tenant in one transaction.
+// Each lease may use a different provider. The combined reservation must fit
+// in the tenant's available credit, and the new leases count towards
+// max_pending_leases_per_tenant together.
+type MsgCreateLeases struct {
+	// tenant is the address of the tenant creating the leases.
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// leases are the leases to create (1-100).
+	Leases []LeaseInput `protobuf:"bytes,2,rep,name=leases,proto3" json:"leases"`
 }
 
-func (m *MsgSetItemCustomDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCreateLeases) Reset()         { *m = MsgCreateLeases{} }
+func (m *MsgCreateLeases) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateLeases) ProtoMessage()    {}
+func (*MsgCreateLeases) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{48}
 }
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgCreateLeases) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *LeaseItemInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SkuUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Quantity != 0 {
-		n += 1 + sovTx(uint64(m.Quantity))
-	}
-	l = len(m.ServiceName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCreateLeases) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateLeases.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgCreateLeases) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateLeases.Merge(m, src)
+}
+func (m *MsgCreateLeases) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateLeases) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateLeases.DiscardUnknown(m)
 }
 
-func (m *MsgFundCredit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Tenant)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+var xxx_messageInfo_MsgCreateLeases proto.InternalMessageInfo
+
+func (m *MsgCreateLeases) GetTenant() string {
+	if m != nil {
+		return m.Tenant
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return ""
 }
 
-func (m *MsgFundCreditResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CreditAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCreateLeases) GetLeases() []LeaseInput {
+	if m != nil {
+		return m.Leases
 	}
-	l = m.NewBalance.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return nil
 }
 
-func (m *MsgCreateLease) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Tenant)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+// MsgCreateLeasesResponse is the response type for MsgCreateLeases.
+type MsgCreateLeasesResponse struct {
+	// lease_uuids are the UUIDs of the created leases, in request order.
+	LeaseUuids []string `protobuf:"bytes,1,rep,name=lease_uuids,json=leaseUuids,proto3" json:"lease_uuids"`
+}
+
+func (m *MsgCreateLeasesResponse) Reset()         { *m = MsgCreateLeasesResponse{} }
+func (m *MsgCreateLeasesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateLeasesResponse) ProtoMessage()    {}
+func (*MsgCreateLeasesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{49}
+}
+func (m *MsgCreateLeasesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateLeasesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateLeasesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.MetaHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+}
+func (m *MsgCreateLeasesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateLeasesResponse.Merge(m, src)
+}
+func (m *MsgCreateLeasesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateLeasesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateLeasesResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateLeaseResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.LeaseUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+var xxx_messageInfo_MsgCreateLeasesResponse proto.InternalMessageInfo
+
+func (m *MsgCreateLeasesResponse) GetLeaseUuids() []string {
+	if m != nil {
+		return m.LeaseUuids
 	}
-	return n
+	return nil
 }
 
-func (m *MsgCreateLeaseForTenant) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Tenant)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+// MsgCreateLeasesForTenant allows the authority or an allowed address to
+// create several leases on behalf of a tenant in one transaction.
+type MsgCreateLeasesForTenant struct {
+	// authority is the module authority address or an address in allowed_list.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// tenant is the address of the tenant for whom the leases are created.
+	Tenant string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// leases are the leases to create (1-100).
+	Leases []LeaseInput `protobuf:"bytes,3,rep,name=leases,proto3" json:"leases"`
+}
+
+func (m *MsgCreateLeasesForTenant) Reset()         { *m = MsgCreateLeasesForTenant{} }
+func (m *MsgCreateLeasesForTenant) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateLeasesForTenant) ProtoMessage()    {}
+func (*MsgCreateLeasesForTenant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{50}
+}
+func (m *MsgCreateLeasesForTenant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateLeasesForTenant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateLeasesForTenant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.MetaHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+}
+func (m *MsgCreateLeasesForTenant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateLeasesForTenant.Merge(m, src)
+}
+func (m *MsgCreateLeasesForTenant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateLeasesForTenant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateLeasesForTenant.DiscardUnknown(m)
 }
 
-func (m *MsgCreateLeaseForTenantResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.LeaseUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+var xxx_messageInfo_MsgCreateLeasesForTenant proto.InternalMessageInfo
+
+func (m *MsgCreateLeasesForTenant) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return n
+	return ""
 }
 
-func (m *MsgCloseLease) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateLeasesForTenant) GetTenant() string {
+	if m != nil {
+		return m.Tenant
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgCreateLeasesForTenant) GetLeases() []LeaseInput {
+	if m != nil {
+		return m.Leases
 	}
-	if len(m.LeaseUuids) > 0 {
-		for _, s := range m.LeaseUuids {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+// MsgCreateLeasesForTenantResponse is the response type for
+// MsgCreateLeasesForTenant.
+type MsgCreateLeasesForTenantResponse struct {
+	// lease_uuids are the UUIDs of the created leases, in request order.
+	LeaseUuids []string `protobuf:"bytes,1,rep,name=lease_uuids,json=leaseUuids,proto3" json:"lease_uuids"`
+}
+
+func (m *MsgCreateLeasesForTenantResponse) Reset()         { *m = MsgCreateLeasesForTenantResponse{} }
+func (m *MsgCreateLeasesForTenantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateLeasesForTenantResponse) ProtoMessage()    {}
+func (*MsgCreateLeasesForTenantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{51}
+}
+func (m *MsgCreateLeasesForTenantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateLeasesForTenantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateLeasesForTenantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+}
+func (m *MsgCreateLeasesForTenantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateLeasesForTenantResponse.Merge(m, src)
+}
+func (m *MsgCreateLeasesForTenantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateLeasesForTenantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateLeasesForTenantResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCloseLeaseResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ClosedAt)
-	n += 1 + l + sovTx(uint64(l))
-	if m.ClosedCount != 0 {
-		n += 1 + sovTx(uint64(m.ClosedCount))
+var xxx_messageInfo_MsgCreateLeasesForTenantResponse proto.InternalMessageInfo
+
+func (m *MsgCreateLeasesForTenantResponse) GetLeaseUuids() []string {
+	if m != nil {
+		return m.LeaseUuids
 	}
-	if len(m.TotalSettledAmounts) > 0 {
-		for _, e := range m.TotalSettledAmounts {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+// MsgSetProviderPolicy sets or removes a provider's lease policy.
+type MsgSetProviderPolicy struct {
+	// sender is the provider address or the module authority.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// policy is the new policy. It replaces any policy already set for
+	// policy.provider_uuid. Only provider_uuid is read when reset is set.
+	Policy ProviderPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
+	// reset removes the policy so the provider's leases follow params again.
+	Reset_ bool `protobuf:"varint,3,opt,name=reset,proto3" json:"reset,omitempty"`
+}
+
+func (m *MsgSetProviderPolicy) Reset()         { *m = MsgSetProviderPolicy{} }
+func (m *MsgSetProviderPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetProviderPolicy) ProtoMessage()    {}
+func (*MsgSetProviderPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{52}
+}
+func (m *MsgSetProviderPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetProviderPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetProviderPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgSetProviderPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetProviderPolicy.Merge(m, src)
+}
+func (m *MsgSetProviderPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetProviderPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetProviderPolicy.DiscardUnknown(m)
 }
 
-func (m *MsgWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.LeaseUuids) > 0 {
-		for _, s := range m.LeaseUuids {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	l = len(m.ProviderUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+var xxx_messageInfo_MsgSetProviderPolicy proto.InternalMessageInfo
+
+func (m *MsgSetProviderPolicy) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if m.Limit != 0 {
-		n += 1 + sovTx(uint64(m.Limit))
+	return ""
+}
+
+func (m *MsgSetProviderPolicy) GetPolicy() ProviderPolicy {
+	if m != nil {
+		return m.Policy
 	}
-	return n
+	return ProviderPolicy{}
 }
 
-func (m *MsgWithdrawResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetProviderPolicy) GetReset_() bool {
+	if m != nil {
+		return m.Reset_
 	}
-	var l int
-	_ = l
-	if len(m.TotalAmounts) > 0 {
-		for _, e := range m.TotalAmounts {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return false
+}
+
+// MsgSetProviderPolicyResponse is the response type for MsgSetProviderPolicy.
+type MsgSetProviderPolicyResponse struct {
+}
+
+func (m *MsgSetProviderPolicyResponse) Reset()         { *m = MsgSetProviderPolicyResponse{} }
+func (m *MsgSetProviderPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetProviderPolicyResponse) ProtoMessage()    {}
+func (*MsgSetProviderPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{53}
+}
+func (m *MsgSetProviderPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetProviderPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetProviderPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.PayoutAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.WithdrawalCount != 0 {
-		n += 1 + sovTx(uint64(m.WithdrawalCount))
-	}
-	if m.HasMore {
-		n += 2
-	}
-	return n
+}
+func (m *MsgSetProviderPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetProviderPolicyResponse.Merge(m, src)
+}
+func (m *MsgSetProviderPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetProviderPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetProviderPolicyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+var xxx_messageInfo_MsgSetProviderPolicyResponse proto.InternalMessageInfo
+
+// MsgGrantPromoCredit grants a tenant promotional credit. The amount is moved
+// from the authority into the promo escrow account.
+type MsgGrantPromoCredit struct {
+	// authority is the address of the module authority.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// tenant is the address of the tenant receiving the grant.
+	Tenant string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// amount is the amount granted (one entry per denom).
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// expires_at is the time from which the grant can no longer be spent. It
+	// must be in the future.
+	ExpiresAt time.Time `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+	// provider_uuids restricts the grant to leases of these providers. Empty
+	// means any provider.
+	ProviderUuids []string `protobuf:"bytes,5,rep,name=provider_uuids,json=providerUuids,proto3" json:"provider_uuids,omitempty"`
+	// sku_uuids restricts the grant to lease items of these SKUs. Empty means
+	// any SKU.
+	SkuUuids []string `protobuf:"bytes,6,rep,name=sku_uuids,json=skuUuids,proto3" json:"sku_uuids,omitempty"`
+}
+
+func (m *MsgGrantPromoCredit) Reset()         { *m = MsgGrantPromoCredit{} }
+func (m *MsgGrantPromoCredit) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantPromoCredit) ProtoMessage()    {}
+func (*MsgGrantPromoCredit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{54}
+}
+func (m *MsgGrantPromoCredit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantPromoCredit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantPromoCredit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgGrantPromoCredit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantPromoCredit.Merge(m, src)
+}
+func (m *MsgGrantPromoCredit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantPromoCredit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantPromoCredit.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgGrantPromoCredit proto.InternalMessageInfo
+
+func (m *MsgGrantPromoCredit) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgAcknowledgeLease) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.LeaseUuids) > 0 {
-		for _, s := range m.LeaseUuids {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (m *MsgGrantPromoCredit) GetTenant() string {
+	if m != nil {
+		return m.Tenant
 	}
-	return n
+	return ""
 }
 
-func (m *MsgAcknowledgeLeaseResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGrantPromoCredit) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	var l int
-	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AcknowledgedAt)
-	n += 1 + l + sovTx(uint64(l))
-	if m.AcknowledgedCount != 0 {
-		n += 1 + sovTx(uint64(m.AcknowledgedCount))
+	return nil
+}
+
+func (m *MsgGrantPromoCredit) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	return n
+	return time.Time{}
 }
 
-func (m *MsgRejectLease) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGrantPromoCredit) GetProviderUuids() []string {
+	if m != nil {
+		return m.ProviderUuids
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *MsgGrantPromoCredit) GetSkuUuids() []string {
+	if m != nil {
+		return m.SkuUuids
 	}
-	if len(m.LeaseUuids) > 0 {
-		for _, s := range m.LeaseUuids {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+// MsgGrantPromoCreditResponse is the response type for MsgGrantPromoCredit.
+type MsgGrantPromoCreditResponse struct {
+	// grant_id is the id of the new grant.
+	GrantId uint64 `protobuf:"varint,1,opt,name=grant_id,json=grantId,proto3" json:"grant_id,omitempty,string"`
+}
+
+func (m *MsgGrantPromoCreditResponse) Reset()         { *m = MsgGrantPromoCreditResponse{} }
+func (m *MsgGrantPromoCreditResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantPromoCreditResponse) ProtoMessage()    {}
+func (*MsgGrantPromoCreditResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{55}
+}
+func (m *MsgGrantPromoCreditResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantPromoCreditResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantPromoCreditResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+}
+func (m *MsgGrantPromoCreditResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantPromoCreditResponse.Merge(m, src)
+}
+func (m *MsgGrantPromoCreditResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantPromoCreditResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantPromoCreditResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRejectLeaseResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RejectedAt)
-	n += 1 + l + sovTx(uint64(l))
-	if m.RejectedCount != 0 {
-		n += 1 + sovTx(uint64(m.RejectedCount))
+var xxx_messageInfo_MsgGrantPromoCreditResponse proto.InternalMessageInfo
+
+func (m *MsgGrantPromoCreditResponse) GetGrantId() uint64 {
+	if m != nil {
+		return m.GrantId
 	}
-	return n
+	return 0
 }
 
-func (m *MsgCancelLease) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Tenant)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.LeaseUuids) > 0 {
-		for _, s := range m.LeaseUuids {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+// MsgSetExchangeRates sets or removes entries of the exchange-rate table. A
+// rate replaces any rate already set for its denom.
+type MsgSetExchangeRates struct {
+	// sender is a price feeder from params or the module authority.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// rates are the entries to set or remove, at most one per denom.
+	Rates []ExchangeRateInput `protobuf:"bytes,2,rep,name=rates,proto3" json:"rates"`
+}
+
+func (m *MsgSetExchangeRates) Reset()         { *m = MsgSetExchangeRates{} }
+func (m *MsgSetExchangeRates) String() string { return proto.CompactTextString(m) }
+func (*MsgSetExchangeRates) ProtoMessage()    {}
+func (*MsgSetExchangeRates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{56}
+}
+func (m *MsgSetExchangeRates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetExchangeRates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetExchangeRates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgSetExchangeRates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetExchangeRates.Merge(m, src)
+}
+func (m *MsgSetExchangeRates) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetExchangeRates) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetExchangeRates.DiscardUnknown(m)
 }
 
-func (m *MsgCancelLeaseResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CancelledAt)
-	n += 1 + l + sovTx(uint64(l))
-	if m.CancelledCount != 0 {
-		n += 1 + sovTx(uint64(m.CancelledCount))
+var xxx_messageInfo_MsgSetExchangeRates proto.InternalMessageInfo
+
+func (m *MsgSetExchangeRates) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return n
+	return ""
 }
 
-func (m *MsgSetItemCustomDomain) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetExchangeRates) GetRates() []ExchangeRateInput {
+	if m != nil {
+		return m.Rates
 	}
-	var l int
+	return nil
+}
+
+// MsgSetExchangeRatesResponse is the response type for MsgSetExchangeRates.
+type MsgSetExchangeRatesResponse struct {
+}
+
+func (m *MsgSetExchangeRatesResponse) Reset()         { *m = MsgSetExchangeRatesResponse{} }
+func (m *MsgSetExchangeRatesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetExchangeRatesResponse) ProtoMessage()    {}
+func (*MsgSetExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e88d178776fa191, []int{57}
+}
+func (m *MsgSetExchangeRatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetExchangeRatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetExchangeRatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetExchangeRatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetExchangeRatesResponse.Merge(m, src)
+}
+func (m *MsgSetExchangeRatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetExchangeRatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetExchangeRatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetExchangeRatesResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ExchangeRateInput)(nil), "liftedinit.billing.v1.ExchangeRateInput")
+	proto.RegisterType((*LeaseItemInput)(nil), "liftedinit.billing.v1.LeaseItemInput")
+	proto.RegisterType((*LeaseInput)(nil), "liftedinit.billing.v1.LeaseInput")
+	proto.RegisterType((*MsgFundCredit)(nil), "liftedinit.billing.v1.MsgFundCredit")
+	proto.RegisterType((*MsgFundCreditResponse)(nil), "liftedinit.billing.v1.MsgFundCreditResponse")
+	proto.RegisterType((*MsgCreateLease)(nil), "liftedinit.billing.v1.MsgCreateLease")
+	proto.RegisterType((*MsgCreateLeaseResponse)(nil), "liftedinit.billing.v1.MsgCreateLeaseResponse")
+	proto.RegisterType((*MsgCreateLeaseForTenant)(nil), "liftedinit.billing.v1.MsgCreateLeaseForTenant")
+	proto.RegisterType((*MsgCreateLeaseForTenantResponse)(nil), "liftedinit.billing.v1.MsgCreateLeaseForTenantResponse")
+	proto.RegisterType((*MsgCloseLease)(nil), "liftedinit.billing.v1.MsgCloseLease")
+	proto.RegisterType((*MsgCloseLeaseResponse)(nil), "liftedinit.billing.v1.MsgCloseLeaseResponse")
+	proto.RegisterType((*MsgWithdraw)(nil), "liftedinit.billing.v1.MsgWithdraw")
+	proto.RegisterType((*MsgWithdrawResponse)(nil), "liftedinit.billing.v1.MsgWithdrawResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "liftedinit.billing.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "liftedinit.billing.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgAcknowledgeLease)(nil), "liftedinit.billing.v1.MsgAcknowledgeLease")
+	proto.RegisterType((*MsgAcknowledgeLeaseResponse)(nil), "liftedinit.billing.v1.MsgAcknowledgeLeaseResponse")
+	proto.RegisterType((*MsgRejectLease)(nil), "liftedinit.billing.v1.MsgRejectLease")
+	proto.RegisterType((*MsgRejectLeaseResponse)(nil), "liftedinit.billing.v1.MsgRejectLeaseResponse")
+	proto.RegisterType((*MsgCancelLease)(nil), "liftedinit.billing.v1.MsgCancelLease")
+	proto.RegisterType((*MsgCancelLeaseResponse)(nil), "liftedinit.billing.v1.MsgCancelLeaseResponse")
+	proto.RegisterType((*MsgSetItemCustomDomain)(nil), "liftedinit.billing.v1.MsgSetItemCustomDomain")
+	proto.RegisterType((*MsgSetItemCustomDomainResponse)(nil), "liftedinit.billing.v1.MsgSetItemCustomDomainResponse")
+	proto.RegisterType((*MsgWithdrawCredit)(nil), "liftedinit.billing.v1.MsgWithdrawCredit")
+	proto.RegisterType((*MsgWithdrawCreditResponse)(nil), "liftedinit.billing.v1.MsgWithdrawCreditResponse")
+	proto.RegisterType((*MsgUpdateLeaseItems)(nil), "liftedinit.billing.v1.MsgUpdateLeaseItems")
+	proto.RegisterType((*MsgUpdateLeaseItemsResponse)(nil), "liftedinit.billing.v1.MsgUpdateLeaseItemsResponse")
+	proto.RegisterType((*MsgProposeLeaseAmendment)(nil), "liftedinit.billing.v1.MsgProposeLeaseAmendment")
+	proto.RegisterType((*MsgProposeLeaseAmendmentResponse)(nil), "liftedinit.billing.v1.MsgProposeLeaseAmendmentResponse")
+	proto.RegisterType((*MsgAcknowledgeLeaseAmendment)(nil), "liftedinit.billing.v1.MsgAcknowledgeLeaseAmendment")
+	proto.RegisterType((*MsgAcknowledgeLeaseAmendmentResponse)(nil), "liftedinit.billing.v1.MsgAcknowledgeLeaseAmendmentResponse")
+	proto.RegisterType((*MsgRejectLeaseAmendment)(nil), "liftedinit.billing.v1.MsgRejectLeaseAmendment")
+	proto.RegisterType((*MsgRejectLeaseAmendmentResponse)(nil), "liftedinit.billing.v1.MsgRejectLeaseAmendmentResponse")
+	proto.RegisterType((*MsgSetLeaseScheduledEnd)(nil), "liftedinit.billing.v1.MsgSetLeaseScheduledEnd")
+	proto.RegisterType((*MsgSetLeaseScheduledEndResponse)(nil), "liftedinit.billing.v1.MsgSetLeaseScheduledEndResponse")
+	proto.RegisterType((*UsageReport)(nil), "liftedinit.billing.v1.UsageReport")
+	proto.RegisterType((*MsgReportUsage)(nil), "liftedinit.billing.v1.MsgReportUsage")
+	proto.RegisterType((*MsgReportUsageResponse)(nil), "liftedinit.billing.v1.MsgReportUsageResponse")
+	proto.RegisterType((*MsgSetUsageSpendLimit)(nil), "liftedinit.billing.v1.MsgSetUsageSpendLimit")
+	proto.RegisterType((*MsgSetUsageSpendLimitResponse)(nil), "liftedinit.billing.v1.MsgSetUsageSpendLimitResponse")
+	proto.RegisterType((*MsgOpenDispute)(nil), "liftedinit.billing.v1.MsgOpenDispute")
+	proto.RegisterType((*MsgOpenDisputeResponse)(nil), "liftedinit.billing.v1.MsgOpenDisputeResponse")
+	proto.RegisterType((*MsgRespondToDispute)(nil), "liftedinit.billing.v1.MsgRespondToDispute")
+	proto.RegisterType((*MsgRespondToDisputeResponse)(nil), "liftedinit.billing.v1.MsgRespondToDisputeResponse")
+	proto.RegisterType((*MsgResolveDispute)(nil), "liftedinit.billing.v1.MsgResolveDispute")
+	proto.RegisterType((*MsgResolveDisputeResponse)(nil), "liftedinit.billing.v1.MsgResolveDisputeResponse")
+	proto.RegisterType((*MsgTransferCredit)(nil), "liftedinit.billing.v1.MsgTransferCredit")
+	proto.RegisterType((*MsgTransferCreditResponse)(nil), "liftedinit.billing.v1.MsgTransferCreditResponse")
+	proto.RegisterType((*MsgCreateLeases)(nil), "liftedinit.billing.v1.MsgCreateLeases")
+	proto.RegisterType((*MsgCreateLeasesResponse)(nil), "liftedinit.billing.v1.MsgCreateLeasesResponse")
+	proto.RegisterType((*MsgCreateLeasesForTenant)(nil), "liftedinit.billing.v1.MsgCreateLeasesForTenant")
+	proto.RegisterType((*MsgCreateLeasesForTenantResponse)(nil), "liftedinit.billing.v1.MsgCreateLeasesForTenantResponse")
+	proto.RegisterType((*MsgSetProviderPolicy)(nil), "liftedinit.billing.v1.MsgSetProviderPolicy")
+	proto.RegisterType((*MsgSetProviderPolicyResponse)(nil), "liftedinit.billing.v1.MsgSetProviderPolicyResponse")
+	proto.RegisterType((*MsgGrantPromoCredit)(nil), "liftedinit.billing.v1.MsgGrantPromoCredit")
+	proto.RegisterType((*MsgGrantPromoCreditResponse)(nil), "liftedinit.billing.v1.MsgGrantPromoCreditResponse")
+	proto.RegisterType((*MsgSetExchangeRates)(nil), "liftedinit.billing.v1.MsgSetExchangeRates")
+	proto.RegisterType((*MsgSetExchangeRatesResponse)(nil), "liftedinit.billing.v1.MsgSetExchangeRatesResponse")
+}
+
+func init() { proto.RegisterFile("liftedinit/billing/v1/tx.proto", fileDescriptor_5e88d178776fa191) }
+
+var fileDescriptor_5e88d178776fa191 = []byte{
+	// 3429 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3b, 0x4b, 0x8c, 0x1c, 0x47,
+	0xd9, 0xee, 0xd9, 0xf5, 0x66, 0xf7, 0x9b, 0x7d, 0xb6, 0x5f, 0xb3, 0x6d, 0x7b, 0x67, 0xdd, 0x7f,
+	0xec, 0xf8, 0x5f, 0x76, 0x67, 0xbc, 0x6b, 0xc7, 0xc6, 0x13, 0x22, 0xd8, 0x5d, 0xdb, 0xe0, 0xe0,
+	0x4d, 0x9c, 0x59, 0x5b, 0x96, 0xc2, 0x61, 0xd2, 0x3b, 0x5d, 0x9e, 0x69, 0x3c, 0xdd, 0x3d, 0x74,
+	0xf5, 0xac, 0x6d, 0x45, 0x42, 0x80, 0x90, 0x10, 0x09, 0x91, 0x72, 0xe0, 0x90, 0x03, 0xe2, 0x0c,
+	0x5c, 0x12, 0xa1, 0x08, 0x84, 0x38, 0x71, 0x00, 0x05, 0x71, 0x20, 0xb2, 0x84, 0x14, 0xe5, 0x30,
+	0x81, 0xf8, 0x10, 0x34, 0xbc, 0xc4, 0x01, 0x71, 0x00, 0x45, 0xa8, 0xab, 0xaa, 0xab, 0xab, 0x1f,
+	0x33, 0xdb, 0xe3, 0x9d, 0xc4, 0x86, 0x8b, 0xc7, 0xfd, 0x3d, 0xaa, 0xea, 0x7b, 0xd6, 0x57, 0x5f,
+	0xd5, 0xc2, 0x5c, 0xc3, 0xb8, 0xe9, 0x22, 0xdd, 0xb0, 0x0c, 0xb7, 0xb8, 0x65, 0x34, 0x1a, 0x86,
+	0x55, 0x2b, 0x6e, 0x2f, 0x17, 0xdd, 0x3b, 0x85, 0xa6, 0x63, 0xbb, 0xb6, 0x7c, 0x20, 0xc0, 0x17,
+	0x18, 0xbe, 0xb0, 0xbd, 0xac, 0xcc, 0x68, 0xa6, 0x61, 0xd9, 0x45, 0xf2, 0x2f, 0xa5, 0x54, 0xe6,
+	0xaa, 0x36, 0x36, 0x6d, 0x5c, 0xdc, 0xd2, 0x30, 0x2a, 0x6e, 0x2f, 0x6f, 0x21, 0x57, 0x5b, 0x2e,
+	0x56, 0x6d, 0xc3, 0x62, 0xf8, 0x43, 0x0c, 0x6f, 0x62, 0x32, 0x83, 0x89, 0x6b, 0x0c, 0x31, 0x4b,
+	0x11, 0x15, 0xf2, 0x55, 0xa4, 0x1f, 0x0c, 0xb5, 0xbf, 0x66, 0xd7, 0x6c, 0x0a, 0xf7, 0xfe, 0xc7,
+	0xa0, 0xf9, 0x9a, 0x6d, 0xd7, 0x1a, 0xa8, 0x48, 0xbe, 0xb6, 0x5a, 0x37, 0x8b, 0xae, 0x61, 0x22,
+	0xec, 0x6a, 0x66, 0x93, 0x11, 0x1c, 0xeb, 0x22, 0xd4, 0xdd, 0x26, 0x62, 0x23, 0xab, 0xf7, 0x33,
+	0x30, 0x73, 0xf1, 0x4e, 0xb5, 0xae, 0x59, 0x35, 0x54, 0xd6, 0x5c, 0x74, 0xd9, 0x6a, 0xb6, 0x5c,
+	0xf9, 0xff, 0x61, 0xaf, 0x8e, 0x2c, 0xdb, 0xcc, 0x49, 0xf3, 0xd2, 0xc9, 0xb1, 0xb5, 0x7d, 0x9d,
+	0x76, 0x7e, 0x8a, 0x00, 0x16, 0x6d, 0xd3, 0x70, 0x91, 0xd9, 0x74, 0xef, 0x96, 0x29, 0x85, 0xbc,
+	0x01, 0xc3, 0x8e, 0xe6, 0xa2, 0x5c, 0x86, 0x50, 0x9e, 0x7f, 0xbb, 0x9d, 0xdf, 0xf3, 0x5e, 0x3b,
+	0x7f, 0x98, 0x2e, 0x1f, 0xeb, 0xb7, 0x0a, 0x86, 0x5d, 0x34, 0x35, 0xb7, 0x5e, 0xb8, 0x82, 0x6a,
+	0x5a, 0xf5, 0xee, 0x05, 0x54, 0xed, 0xb4, 0xf3, 0x84, 0xe5, 0xde, 0x5b, 0x4b, 0xc0, 0xa4, 0xbc,
+	0x80, 0xaa, 0x65, 0x02, 0x93, 0x6f, 0x00, 0x6c, 0x6b, 0x0d, 0x43, 0xaf, 0xdc, 0x74, 0x6c, 0x33,
+	0x37, 0x34, 0x2f, 0x9d, 0xcc, 0xae, 0x28, 0x05, 0x2a, 0x68, 0xc1, 0x17, 0xb4, 0x70, 0xcd, 0x17,
+	0x74, 0xed, 0x48, 0xa7, 0x9d, 0xdf, 0x1f, 0x70, 0x04, 0xeb, 0x7b, 0xed, 0xfd, 0xbc, 0x54, 0x1e,
+	0x23, 0x98, 0x4b, 0x8e, 0x6d, 0xca, 0xd7, 0x20, 0x4b, 0xc9, 0x5a, 0x96, 0x6b, 0x34, 0x72, 0xc3,
+	0x3b, 0x8e, 0x7c, 0xc8, 0x13, 0xa5, 0xd3, 0xce, 0x8b, 0x6c, 0x64, 0x50, 0xba, 0xc0, 0xeb, 0xde,
+	0x77, 0xe9, 0xf8, 0xcb, 0x1f, 0xbe, 0xb9, 0x30, 0x4f, 0xd5, 0xcc, 0x55, 0x1c, 0xd3, 0xa7, 0xfa,
+	0x07, 0x09, 0x26, 0xaf, 0x20, 0x0d, 0xa3, 0xcb, 0x2e, 0x32, 0xa9, 0x8a, 0x97, 0x61, 0x14, 0xdf,
+	0x6a, 0x55, 0x5a, 0x2d, 0x43, 0x67, 0x5a, 0x3e, 0xd8, 0x69, 0xe7, 0x65, 0x1f, 0x26, 0x28, 0xfa,
+	0x31, 0x7c, 0xab, 0x75, 0xbd, 0x65, 0xe8, 0xf2, 0x79, 0x18, 0xfd, 0x4a, 0x4b, 0xb3, 0x5c, 0xc3,
+	0xbd, 0x4b, 0xd4, 0x3d, 0xbc, 0x76, 0xb4, 0xd3, 0xce, 0xcf, 0xfa, 0xb0, 0x80, 0x65, 0x11, 0xbb,
+	0x8e, 0x61, 0xd5, 0xca, 0x9c, 0x5c, 0x7e, 0x1a, 0xc6, 0x31, 0x72, 0xb6, 0x8d, 0x2a, 0xaa, 0x58,
+	0x9a, 0x89, 0x88, 0x62, 0xc7, 0xd6, 0x94, 0x4e, 0x3b, 0x7f, 0x50, 0x84, 0x0b, 0xb3, 0x66, 0x19,
+	0xfc, 0x59, 0xcd, 0x44, 0x25, 0xd5, 0x13, 0xf3, 0x68, 0x44, 0xcc, 0xb0, 0x40, 0xea, 0xeb, 0x19,
+	0x00, 0x0a, 0x22, 0xf2, 0x3d, 0x03, 0x7b, 0xbd, 0xa1, 0x70, 0x4e, 0x9a, 0x1f, 0x3a, 0x99, 0x5d,
+	0x39, 0x5e, 0x48, 0x0c, 0xa0, 0x42, 0x78, 0x90, 0xb5, 0x09, 0xa6, 0x74, 0xca, 0x5b, 0xa6, 0x3f,
+	0xf2, 0x19, 0x18, 0x33, 0x91, 0xab, 0x55, 0xea, 0x1a, 0xae, 0x13, 0xc9, 0xc7, 0xd7, 0x0e, 0x75,
+	0xda, 0xf9, 0x7d, 0x1c, 0x28, 0xac, 0x7b, 0xd4, 0x03, 0x7e, 0x41, 0xc3, 0x75, 0xb9, 0x0e, 0xd3,
+	0xb8, 0x5a, 0x47, 0x7a, 0xab, 0x81, 0xf4, 0x0a, 0xb2, 0xf4, 0x8a, 0xe6, 0xa6, 0x70, 0x28, 0xb5,
+	0xd3, 0xce, 0x2b, 0x51, 0xbe, 0x88, 0x5b, 0x4d, 0x72, 0xfc, 0x45, 0x4b, 0x5f, 0x75, 0x4b, 0x73,
+	0x9e, 0x7a, 0x66, 0x13, 0xd5, 0x43, 0x54, 0xf3, 0x9d, 0x0c, 0x4c, 0x6c, 0xe0, 0xda, 0xa5, 0x96,
+	0xa5, 0xaf, 0x3b, 0x48, 0x37, 0x5c, 0xf9, 0x02, 0x8c, 0x60, 0x64, 0xe9, 0xc8, 0x61, 0xb6, 0x5f,
+	0xec, 0xb4, 0xf3, 0xd3, 0x14, 0x12, 0xcc, 0x75, 0xef, 0xad, 0xa5, 0xfd, 0x2c, 0x40, 0x56, 0x75,
+	0xdd, 0x41, 0x18, 0x6f, 0x52, 0xbb, 0x32, 0x5e, 0x6f, 0x14, 0x17, 0x59, 0x9a, 0xe5, 0xe6, 0x32,
+	0xc1, 0x28, 0x14, 0x92, 0x66, 0x14, 0x4a, 0x29, 0xaf, 0xc2, 0x88, 0x66, 0xda, 0x2d, 0xcb, 0xd7,
+	0xce, 0x6c, 0x81, 0x91, 0x7b, 0x19, 0xac, 0xc0, 0x32, 0x58, 0x61, 0xdd, 0x36, 0xac, 0xb5, 0x49,
+	0x66, 0x1e, 0xc6, 0x50, 0x66, 0xbf, 0xa5, 0xc5, 0x6f, 0x7c, 0xf8, 0xe6, 0x02, 0x5b, 0x95, 0xa7,
+	0x8b, 0x23, 0x11, 0x5d, 0x84, 0x84, 0x57, 0x7f, 0x29, 0xc1, 0x81, 0x10, 0xa4, 0x8c, 0x70, 0xd3,
+	0xb6, 0x30, 0x92, 0xbf, 0x04, 0x93, 0x55, 0x02, 0xa9, 0x68, 0x74, 0xa9, 0x4c, 0x3d, 0x67, 0x3a,
+	0xed, 0x7c, 0x2e, 0x8c, 0x49, 0x21, 0xe0, 0x04, 0xe5, 0x60, 0x40, 0xf9, 0x39, 0xc8, 0x5a, 0xe8,
+	0x76, 0x65, 0x4b, 0x6b, 0x68, 0x56, 0x95, 0x26, 0xac, 0x9e, 0xc2, 0xee, 0xf3, 0x13, 0x80, 0xc0,
+	0x55, 0x06, 0x0b, 0xdd, 0x5e, 0xa3, 0xff, 0x57, 0xff, 0x9e, 0x81, 0xc9, 0x0d, 0x5c, 0x5b, 0x77,
+	0x90, 0xe6, 0x22, 0x62, 0x6e, 0xc1, 0x22, 0xd2, 0x2e, 0x2c, 0xc2, 0x63, 0x27, 0x33, 0xe0, 0xd8,
+	0x19, 0xda, 0x4d, 0xec, 0x0c, 0x7f, 0x2c, 0xb1, 0xb3, 0x44, 0x5c, 0x87, 0x0a, 0x9e, 0x94, 0x65,
+	0xc2, 0x0a, 0x56, 0x9f, 0x87, 0x83, 0x61, 0x08, 0xf7, 0x9d, 0x73, 0x00, 0x0d, 0x0f, 0x20, 0xa6,
+	0xd4, 0x9c, 0xb7, 0x3b, 0x04, 0x50, 0x41, 0xd4, 0x31, 0x02, 0xf5, 0xd2, 0xaa, 0xfa, 0xbb, 0x21,
+	0x38, 0x14, 0x1e, 0xf3, 0x92, 0xed, 0x5c, 0xa3, 0x96, 0xd8, 0x80, 0x31, 0xad, 0xe5, 0xd6, 0x6d,
+	0xc7, 0xcb, 0xb9, 0x74, 0xcc, 0xa2, 0xa7, 0x3d, 0x0e, 0x4c, 0x61, 0xd5, 0x60, 0x84, 0x01, 0x05,
+	0x2c, 0x77, 0x8f, 0xa1, 0x01, 0xbb, 0xc7, 0xf0, 0x6e, 0xdc, 0x63, 0xef, 0xc7, 0xe2, 0x1e, 0xe7,
+	0x3d, 0xf7, 0x08, 0x34, 0xe8, 0x79, 0xc8, 0x89, 0x9e, 0x1e, 0xc2, 0x6d, 0xa7, 0xbe, 0x00, 0xf9,
+	0x2e, 0xa8, 0xdd, 0xfb, 0xcc, 0x5f, 0x24, 0x92, 0xd1, 0xd7, 0x1b, 0x36, 0x0e, 0x22, 0x7f, 0x00,
+	0x19, 0xbd, 0x04, 0xd9, 0x60, 0x6a, 0x1a, 0xff, 0x63, 0x6b, 0xb3, 0x9d, 0x76, 0xfe, 0x80, 0x00,
+	0x16, 0x96, 0x04, 0x7c, 0x49, 0x58, 0x5e, 0x84, 0x11, 0x07, 0x69, 0xd8, 0xb6, 0xd8, 0xee, 0xbe,
+	0xdf, 0x5b, 0x01, 0x85, 0x08, 0x1c, 0x8c, 0x26, 0x4d, 0xca, 0x0e, 0xa4, 0x53, 0x7f, 0x9a, 0x81,
+	0x03, 0x21, 0x08, 0x57, 0xe1, 0xb3, 0x30, 0x56, 0xf5, 0xa0, 0xc4, 0x07, 0xa4, 0x1d, 0x7d, 0xe0,
+	0x00, 0xf3, 0xc2, 0x80, 0x89, 0x98, 0x7d, 0x94, 0x7e, 0xae, 0xba, 0xf2, 0x69, 0x18, 0x67, 0xa8,
+	0xaa, 0xdd, 0x62, 0x81, 0x32, 0xbc, 0x36, 0xdd, 0x69, 0xe7, 0x43, 0xf0, 0x72, 0x96, 0x7e, 0xad,
+	0x7b, 0x1f, 0xf2, 0xeb, 0x12, 0x1c, 0x70, 0x6d, 0x57, 0x6b, 0x54, 0x30, 0x72, 0x5d, 0xcf, 0xb9,
+	0xe8, 0xc6, 0xe4, 0x87, 0x48, 0x8f, 0x2c, 0x7f, 0x99, 0x2d, 0x28, 0x99, 0xff, 0x47, 0xef, 0xe7,
+	0x4f, 0xd6, 0x0c, 0xb7, 0xde, 0xda, 0x2a, 0x54, 0x6d, 0x93, 0x15, 0xe5, 0xec, 0x67, 0x09, 0xeb,
+	0xb7, 0x58, 0x2d, 0xed, 0x8d, 0x84, 0xcb, 0xfb, 0xc8, 0x10, 0x9b, 0x74, 0x84, 0x55, 0x3a, 0x80,
+	0xfa, 0x46, 0x06, 0xb2, 0x1b, 0xb8, 0x76, 0xc3, 0x70, 0xeb, 0xba, 0xa3, 0xdd, 0x7e, 0x04, 0xfc,
+	0xe4, 0x73, 0x30, 0xd1, 0x74, 0xec, 0x6d, 0x43, 0x47, 0x0e, 0xf5, 0x7b, 0xea, 0x2e, 0x87, 0x3b,
+	0xed, 0xfc, 0xa1, 0x10, 0x42, 0xe0, 0x1f, 0xf7, 0x11, 0xa4, 0x10, 0x3d, 0x05, 0x7b, 0x1b, 0x86,
+	0x69, 0xd0, 0x2d, 0x61, 0x98, 0x96, 0x91, 0x04, 0x10, 0x2f, 0x41, 0x29, 0x61, 0x69, 0x21, 0xe2,
+	0x6d, 0x4a, 0xdc, 0xdb, 0x7c, 0x0d, 0xa9, 0xff, 0xca, 0xc0, 0x3e, 0xe1, 0x9b, 0x7b, 0xda, 0xd7,
+	0x25, 0x98, 0xa0, 0x46, 0xf2, 0x8d, 0x2b, 0xed, 0x64, 0xdc, 0x55, 0x66, 0xdc, 0x30, 0x5f, 0x5f,
+	0x46, 0x1d, 0x27, 0xac, 0xcc, 0x9a, 0x5e, 0x81, 0xd2, 0xd4, 0xee, 0xda, 0xad, 0xa0, 0x40, 0xc9,
+	0x04, 0x05, 0x4a, 0x18, 0x93, 0xa6, 0x40, 0xa1, 0x1c, 0x0c, 0x28, 0x5f, 0x85, 0xe9, 0xdb, 0x4c,
+	0x68, 0xad, 0xc1, 0xdc, 0x7f, 0x88, 0x68, 0xf8, 0x78, 0xa7, 0x9d, 0x3f, 0x16, 0xc5, 0xc5, 0x95,
+	0x3d, 0x15, 0x90, 0xd0, 0xb8, 0x58, 0x86, 0xd1, 0xba, 0x86, 0x2b, 0xa6, 0xed, 0x20, 0x62, 0xab,
+	0x51, 0x7a, 0xc8, 0xf0, 0x61, 0xe2, 0x21, 0xa3, 0xae, 0xe1, 0x0d, 0xdb, 0x41, 0xea, 0x7b, 0x12,
+	0x4c, 0x6d, 0xe0, 0xda, 0xf5, 0xa6, 0xae, 0xb9, 0xe8, 0xaa, 0xe6, 0x68, 0x26, 0x1e, 0xf4, 0x2e,
+	0x78, 0x11, 0x46, 0x9a, 0x64, 0x60, 0x56, 0x83, 0x1d, 0xed, 0xb2, 0x81, 0xd1, 0xd9, 0x83, 0xa2,
+	0x93, 0x32, 0x95, 0xd9, 0x6f, 0x69, 0x39, 0xbe, 0x35, 0xcc, 0xc5, 0xdd, 0x4a, 0x14, 0x44, 0x9d,
+	0x85, 0x43, 0x11, 0x90, 0xef, 0x5d, 0xea, 0x6f, 0x24, 0xe2, 0x75, 0xab, 0xd5, 0x5b, 0x96, 0x7d,
+	0xbb, 0x81, 0xf4, 0xda, 0xa3, 0x92, 0xd7, 0x4b, 0x2b, 0x91, 0xd8, 0x51, 0xe3, 0x42, 0x46, 0x57,
+	0xad, 0xfe, 0x4a, 0x82, 0xc3, 0x09, 0x70, 0x1e, 0x4b, 0x2f, 0xc2, 0x94, 0x16, 0xe0, 0x52, 0xe6,
+	0xee, 0xc3, 0xcc, 0x10, 0x51, 0x56, 0xba, 0x71, 0x8b, 0xc0, 0x55, 0x57, 0xbe, 0x08, 0x72, 0x88,
+	0x4c, 0xcc, 0xe6, 0xc4, 0x09, 0xe3, 0xd8, 0xf2, 0x8c, 0x08, 0x23, 0x1e, 0xac, 0xfe, 0x4d, 0x22,
+	0x35, 0x76, 0x19, 0x7d, 0x19, 0x55, 0xdd, 0xff, 0xce, 0x9d, 0x76, 0x29, 0x62, 0xbf, 0x84, 0x0a,
+	0x57, 0x10, 0x4f, 0xfd, 0xa1, 0x04, 0x07, 0xc3, 0x20, 0x6e, 0xb5, 0x6b, 0x90, 0x75, 0x08, 0x38,
+	0xad, 0xc5, 0x78, 0x0f, 0x43, 0x60, 0xa3, 0x3d, 0x0c, 0x1f, 0xb0, 0xea, 0xca, 0xe7, 0x61, 0x92,
+	0xa3, 0x45, 0x2b, 0xc9, 0x9d, 0x76, 0x3e, 0x82, 0x29, 0x4f, 0xf8, 0xdf, 0xd4, 0x3a, 0xbf, 0xa0,
+	0xd6, 0x59, 0xf7, 0x8e, 0x43, 0x8d, 0x41, 0x9e, 0x80, 0x76, 0x13, 0x2f, 0x69, 0x4e, 0x14, 0xc1,
+	0x82, 0xd5, 0x9f, 0x51, 0x7d, 0x0b, 0x20, 0xae, 0xef, 0x1b, 0x30, 0x5e, 0x25, 0xe0, 0x46, 0x5a,
+	0x85, 0xe7, 0x98, 0xc2, 0x43, 0x7c, 0x44, 0xe3, 0x59, 0x0e, 0x59, 0x75, 0xe5, 0x4b, 0x30, 0x15,
+	0x10, 0x88, 0x3a, 0x27, 0x0d, 0x9d, 0x08, 0x4a, 0x10, 0x73, 0x92, 0xa3, 0xa8, 0xfe, 0x7f, 0x9b,
+	0x21, 0x6b, 0xdf, 0x44, 0xae, 0x57, 0xe7, 0xaf, 0xb7, 0xb0, 0x6b, 0x9b, 0x17, 0x6c, 0x53, 0x33,
+	0xac, 0x01, 0x45, 0x49, 0xb8, 0x40, 0xce, 0xa4, 0x2e, 0x90, 0x77, 0xd9, 0x70, 0xf2, 0x6a, 0x94,
+	0x2a, 0x91, 0xa6, 0xa2, 0x13, 0x71, 0x72, 0xc3, 0x41, 0x8d, 0x12, 0x42, 0x88, 0x35, 0x4a, 0x55,
+	0x90, 0xbf, 0xf4, 0x64, 0x24, 0xea, 0x8e, 0xc7, 0xbd, 0x20, 0x41, 0x6d, 0xea, 0x3c, 0xcc, 0x25,
+	0x63, 0xf8, 0x46, 0xf1, 0xeb, 0x0c, 0xcc, 0x08, 0xe5, 0x49, 0xd0, 0xd0, 0x19, 0x80, 0xdb, 0x6f,
+	0xc0, 0x98, 0x83, 0xaa, 0x46, 0xd3, 0x40, 0xfc, 0x88, 0x48, 0x36, 0x5a, 0x0e, 0x4c, 0xb3, 0xd1,
+	0x72, 0x62, 0xd9, 0x14, 0x3a, 0x3b, 0x3b, 0x54, 0x4a, 0xa5, 0x70, 0x67, 0xa7, 0xaf, 0x12, 0xc9,
+	0xef, 0x02, 0x9d, 0x8a, 0x04, 0xde, 0x7c, 0xf7, 0x22, 0x8f, 0x75, 0x82, 0x5e, 0xc9, 0xc0, 0x6c,
+	0x0c, 0xfa, 0xc9, 0x74, 0x83, 0x5e, 0x93, 0x40, 0x76, 0x90, 0x67, 0x59, 0xc3, 0xaa, 0xf9, 0xed,
+	0x1d, 0xbf, 0xe3, 0xd2, 0x43, 0x51, 0x97, 0x98, 0xa2, 0x12, 0x98, 0xfb, 0x52, 0xda, 0x0c, 0xe7,
+	0x67, 0xed, 0x24, 0xac, 0x7e, 0x97, 0x16, 0xbe, 0xb4, 0x3c, 0xe1, 0xa7, 0x77, 0x3c, 0x20, 0xdf,
+	0x7a, 0xe0, 0x50, 0x1e, 0x60, 0xbb, 0x81, 0xd5, 0x32, 0x81, 0x8b, 0xa8, 0xdd, 0x0a, 0xb6, 0x40,
+	0x7c, 0xf5, 0xaf, 0xb4, 0x96, 0x89, 0xc2, 0xb9, 0x9b, 0x5c, 0x0c, 0x77, 0x9a, 0xe7, 0x77, 0x5a,
+	0x5f, 0x97, 0x4e, 0xc8, 0xb7, 0x24, 0x98, 0x8a, 0x9e, 0x1e, 0x77, 0xf4, 0x86, 0x75, 0xbf, 0x24,
+	0xda, 0xcd, 0xb9, 0x71, 0x12, 0x87, 0x8f, 0x8c, 0x7f, 0xcc, 0x40, 0x6e, 0x03, 0xd7, 0xae, 0x3a,
+	0x76, 0xd3, 0x3f, 0x6e, 0xaf, 0x9a, 0xc8, 0xd2, 0x4d, 0x2f, 0xa6, 0x1f, 0xb2, 0x33, 0x5c, 0x87,
+	0x31, 0x4d, 0xd7, 0x2b, 0x0f, 0xe0, 0x10, 0x33, 0xfe, 0xc9, 0x9f, 0xf3, 0x97, 0x47, 0x35, 0x5d,
+	0xa7, 0x2e, 0xfe, 0x34, 0x8c, 0x3b, 0xc8, 0xb4, 0xb7, 0x11, 0x1b, 0x79, 0x78, 0x7e, 0xc8, 0xdf,
+	0x2e, 0x44, 0xb8, 0xb8, 0x5d, 0x50, 0x38, 0x61, 0x2f, 0x9d, 0x8b, 0xb8, 0xd5, 0x13, 0x71, 0xb7,
+	0x4a, 0xd4, 0xa6, 0xfa, 0x86, 0x04, 0xf3, 0xdd, 0x90, 0xdc, 0xc1, 0x5e, 0x96, 0x60, 0xda, 0x41,
+	0xde, 0xf6, 0x24, 0xb8, 0xc6, 0x8e, 0x67, 0xcf, 0x0b, 0x4c, 0xde, 0x18, 0x6b, 0x5f, 0xbe, 0x31,
+	0xe5, 0x73, 0xfb, 0xce, 0x71, 0x4f, 0x82, 0x23, 0x09, 0x95, 0x7d, 0xc8, 0x41, 0x1e, 0xe2, 0xc6,
+	0x5f, 0x3a, 0x1d, 0xd9, 0x77, 0xff, 0x2f, 0xf1, 0xb4, 0x12, 0x31, 0xc3, 0x3f, 0x25, 0x78, 0xbc,
+	0x97, 0x50, 0xff, 0xbb, 0xb1, 0xfe, 0x91, 0x04, 0x87, 0xc2, 0xd5, 0xfe, 0xa3, 0x62, 0xc9, 0x3e,
+	0x4f, 0x39, 0x67, 0x23, 0x76, 0x3f, 0xd1, 0xf3, 0x94, 0x13, 0x98, 0xfe, 0x18, 0xe4, 0xbb, 0xa0,
+	0x78, 0xc5, 0xf5, 0x56, 0x86, 0xe8, 0x68, 0x13, 0x51, 0x82, 0x4d, 0xa1, 0x43, 0xfc, 0xb0, 0xd3,
+	0xe1, 0xd6, 0x03, 0xdd, 0x31, 0x1e, 0xf1, 0x53, 0x43, 0x94, 0x37, 0xb1, 0x05, 0x7e, 0x36, 0x92,
+	0xdc, 0x4e, 0x24, 0x56, 0xb2, 0x31, 0xd5, 0x30, 0xcd, 0x26, 0xa1, 0xb8, 0x66, 0xdf, 0x95, 0x20,
+	0x7b, 0x1d, 0x6b, 0x35, 0x54, 0x46, 0x4d, 0xdb, 0x79, 0xa0, 0x4b, 0xe9, 0x68, 0xa1, 0x9f, 0xe9,
+	0xaf, 0xd0, 0x3f, 0x0b, 0x8f, 0x91, 0x43, 0x0e, 0x72, 0x58, 0xab, 0xeb, 0x08, 0x29, 0xee, 0x28,
+	0x28, 0xde, 0xe1, 0xf2, 0x89, 0x4b, 0xf9, 0xa4, 0x2e, 0xa2, 0x20, 0x8a, 0xfa, 0x72, 0x86, 0x35,
+	0x0e, 0xbc, 0x2f, 0x82, 0x78, 0xd8, 0xf1, 0xf4, 0x0c, 0x8c, 0xb4, 0xbc, 0x75, 0xf8, 0xfb, 0xa6,
+	0xda, 0x25, 0x79, 0x09, 0x52, 0x04, 0xbd, 0x2f, 0xca, 0x59, 0x66, 0xbf, 0xe9, 0x7a, 0x0a, 0x5c,
+	0x72, 0xf5, 0xc7, 0x19, 0x38, 0x18, 0x06, 0xf1, 0x8c, 0xea, 0xa5, 0xc2, 0x6a, 0x5d, 0x73, 0x6a,
+	0xfd, 0xec, 0x6d, 0x3c, 0x15, 0x46, 0x38, 0xfb, 0x4b, 0x85, 0x8c, 0xd9, 0xef, 0xad, 0xbe, 0x2a,
+	0xc1, 0x4c, 0xcb, 0x8a, 0xae, 0x65, 0xc7, 0xb4, 0x7c, 0x91, 0xad, 0x25, 0xce, 0xdb, 0xd7, 0x6a,
+	0xa6, 0x5b, 0x56, 0x78, 0x3d, 0xea, 0x47, 0xf4, 0xce, 0x63, 0x13, 0x51, 0x8d, 0x6d, 0x36, 0x91,
+	0xa5, 0x5f, 0x31, 0x4c, 0xe3, 0xa1, 0xd7, 0x60, 0x2f, 0x41, 0x16, 0x7b, 0x8b, 0xa9, 0xd0, 0x26,
+	0xfc, 0x8e, 0x67, 0xbb, 0xcf, 0xfa, 0x5d, 0x20, 0x81, 0xab, 0x2f, 0xdd, 0x00, 0x0e, 0x64, 0x3f,
+	0x0d, 0x23, 0x4d, 0xe4, 0x18, 0xb6, 0xce, 0x9a, 0xff, 0xf4, 0xda, 0x80, 0x40, 0xe2, 0xe1, 0xca,
+	0x48, 0x4b, 0x67, 0x22, 0x29, 0xec, 0xf1, 0xc4, 0x14, 0x16, 0x51, 0xb3, 0x9a, 0x87, 0xa3, 0x89,
+	0x08, 0x9e, 0xbe, 0xfe, 0x4c, 0xdb, 0x4f, 0xcf, 0x35, 0x91, 0x75, 0xc1, 0xc0, 0xcd, 0x96, 0x8b,
+	0x1e, 0xb6, 0x69, 0x1e, 0xa4, 0x33, 0xd8, 0xb3, 0x53, 0x25, 0xc8, 0xa6, 0xfe, 0x83, 0x76, 0xaa,
+	0x04, 0x10, 0x8f, 0x62, 0x0c, 0xa3, 0x08, 0x57, 0x1d, 0xfb, 0x36, 0xd2, 0x77, 0x8e, 0xde, 0xcf,
+	0x30, 0x7f, 0xe0, 0x2c, 0x7d, 0x39, 0x03, 0xe7, 0x92, 0x6f, 0xc2, 0x8c, 0xc3, 0x16, 0x50, 0xd1,
+	0x91, 0xa6, 0x37, 0x0c, 0xcb, 0x7f, 0x56, 0xd1, 0x6b, 0xf7, 0x3b, 0xea, 0x07, 0x6c, 0x8c, 0x99,
+	0x6c, 0x7f, 0xd3, 0x3e, 0xf8, 0x02, 0x83, 0xaa, 0xff, 0xa6, 0xad, 0x79, 0x2a, 0xac, 0x7e, 0xcd,
+	0x16, 0x6c, 0xfd, 0x30, 0xf3, 0xf9, 0x0a, 0x8c, 0xfa, 0x4b, 0xcd, 0x0d, 0x05, 0x9b, 0xa5, 0x0f,
+	0x13, 0x2f, 0xce, 0x7d, 0x58, 0x9a, 0x5e, 0x7e, 0x54, 0x4c, 0xf5, 0x28, 0x1c, 0x4e, 0x00, 0xf3,
+	0x20, 0xf8, 0x39, 0xed, 0x47, 0x95, 0x11, 0xb6, 0x1b, 0xdb, 0xc8, 0xd7, 0xcd, 0x80, 0xaf, 0x6c,
+	0x1e, 0x58, 0x49, 0x06, 0x8c, 0x53, 0xff, 0xae, 0xe0, 0xba, 0xe6, 0xf8, 0x8a, 0xba, 0x94, 0xee,
+	0x99, 0x60, 0x88, 0x35, 0xfc, 0x5c, 0xf0, 0x07, 0x1f, 0xbe, 0xb9, 0x20, 0x95, 0xb3, 0x94, 0x60,
+	0xd3, 0xc3, 0xd3, 0x93, 0x47, 0xf8, 0x3e, 0x68, 0x3e, 0x51, 0xbd, 0x82, 0x9e, 0xd4, 0x37, 0x68,
+	0x07, 0x2a, 0x0c, 0xe5, 0x61, 0xf5, 0x4d, 0x09, 0x26, 0xd9, 0x1a, 0x52, 0xef, 0x8d, 0x6b, 0xcc,
+	0xbd, 0x23, 0x8c, 0x7d, 0xc5, 0xd8, 0x04, 0xe5, 0xf5, 0x77, 0x46, 0xef, 0x00, 0xca, 0x6f, 0x66,
+	0x53, 0x6f, 0x8c, 0xfc, 0x00, 0x1a, 0x65, 0xed, 0xef, 0x00, 0xea, 0x73, 0xfb, 0xdb, 0xe2, 0xf7,
+	0x86, 0x88, 0xbf, 0x5d, 0x73, 0x34, 0x0b, 0xdf, 0x44, 0xce, 0x40, 0x1f, 0xb4, 0x95, 0x21, 0xeb,
+	0xbd, 0xe2, 0xac, 0x84, 0x1e, 0xc9, 0x2c, 0x7b, 0x6d, 0x7f, 0x01, 0x9c, 0x62, 0x3c, 0xf0, 0xc8,
+	0xd9, 0x13, 0x9e, 0x2b, 0x30, 0xe6, 0xda, 0xfe, 0x88, 0x43, 0x41, 0x24, 0xb8, 0x76, 0xfa, 0xf1,
+	0x46, 0x5d, 0x9b, 0x8d, 0x16, 0xb4, 0x54, 0x87, 0x3f, 0xb9, 0x96, 0x6a, 0x90, 0x2f, 0x12, 0x1c,
+	0x3a, 0x6c, 0x08, 0xf5, 0x4f, 0x12, 0xcc, 0xc6, 0xa0, 0xdc, 0xa1, 0x0d, 0xd8, 0x47, 0x34, 0x99,
+	0xd8, 0x57, 0x3d, 0xdf, 0x69, 0xe7, 0x8f, 0x26, 0xa0, 0x53, 0x28, 0x68, 0xc6, 0x63, 0x5b, 0x0f,
+	0x35, 0x58, 0xab, 0x30, 0xe3, 0xda, 0xd1, 0x89, 0xa8, 0x45, 0xcf, 0x75, 0xda, 0xf9, 0xc3, 0xae,
+	0xdd, 0xff, 0x34, 0x53, 0xae, 0x1d, 0x9a, 0x44, 0xbd, 0x47, 0x6f, 0xab, 0x85, 0x47, 0x3e, 0x83,
+	0x6a, 0x97, 0x5e, 0x86, 0x11, 0x92, 0xc5, 0xfc, 0x40, 0x3b, 0xd6, 0xb3, 0xd5, 0x40, 0x3a, 0x5c,
+	0xbc, 0x58, 0xa7, 0x8c, 0x65, 0xf6, 0x5b, 0x2a, 0x44, 0xb6, 0xf9, 0xb9, 0x9e, 0x0f, 0x98, 0xb0,
+	0xfa, 0xc5, 0xe8, 0x7b, 0xb4, 0xa0, 0xd7, 0x79, 0x2a, 0x7c, 0x2f, 0x26, 0x91, 0x36, 0xd9, 0x94,
+	0x57, 0xdb, 0x09, 0x60, 0xf1, 0x36, 0x4c, 0xfd, 0x09, 0x6d, 0x26, 0x8a, 0xa3, 0x3d, 0xe2, 0xcf,
+	0xdb, 0x02, 0xcd, 0x0f, 0xed, 0x56, 0xf3, 0xa5, 0xf8, 0x96, 0xf0, 0x44, 0x6f, 0xe5, 0x07, 0xcf,
+	0xc7, 0xae, 0xc1, 0x7c, 0x37, 0xdc, 0x2e, 0xcc, 0xf1, 0x4a, 0x06, 0xf6, 0xd3, 0xa2, 0xf6, 0x2a,
+	0xcb, 0xab, 0x57, 0xed, 0x86, 0x51, 0xbd, 0x3b, 0xa0, 0x04, 0xba, 0x01, 0x23, 0x4d, 0x32, 0x1e,
+	0xab, 0xc3, 0xba, 0xf5, 0x66, 0xc3, 0x93, 0x07, 0xfa, 0xa3, 0xcc, 0x65, 0xf6, 0xeb, 0xfd, 0x1d,
+	0x80, 0x83, 0x30, 0xa2, 0x79, 0x73, 0x94, 0xfe, 0x1d, 0x00, 0x01, 0x88, 0x7f, 0x07, 0x40, 0x00,
+	0x69, 0xfa, 0x7e, 0x31, 0xa1, 0xd5, 0x39, 0x38, 0x92, 0x04, 0xe7, 0xb5, 0xcd, 0xf7, 0x87, 0x49,
+	0xe5, 0xf7, 0x79, 0x47, 0xb3, 0x3c, 0x12, 0x93, 0x45, 0xff, 0xa3, 0xe9, 0xb7, 0x9f, 0xec, 0x6d,
+	0x9b, 0xfc, 0x3c, 0x00, 0xba, 0xd3, 0x34, 0x1c, 0x84, 0xd3, 0x3d, 0xce, 0x3d, 0xc8, 0xe6, 0x14,
+	0xb8, 0xe8, 0xdf, 0x48, 0xb0, 0xef, 0x55, 0x57, 0x5e, 0x87, 0xc9, 0xd0, 0x03, 0x30, 0x9c, 0xdb,
+	0x4b, 0x3c, 0x9a, 0xf4, 0x64, 0xc2, 0x18, 0xc1, 0x01, 0x26, 0xc4, 0xb7, 0x61, 0xe4, 0x45, 0xa9,
+	0xdf, 0x2f, 0xc2, 0xb9, 0x11, 0xc2, 0x4f, 0x5e, 0x94, 0x72, 0xa0, 0x58, 0x18, 0xb3, 0x2e, 0x12,
+	0xa6, 0xd7, 0xb5, 0xe1, 0x48, 0x4d, 0xa8, 0x8d, 0xa3, 0x8e, 0xa0, 0xde, 0x80, 0xc3, 0x09, 0x60,
+	0x1e, 0x9f, 0x9f, 0x86, 0xd1, 0x9a, 0x87, 0xab, 0xb0, 0x7e, 0x16, 0xbb, 0x60, 0xf7, 0x61, 0x09,
+	0xfd, 0x25, 0x82, 0xba, 0xac, 0xab, 0x6d, 0x7a, 0xe6, 0xd8, 0x44, 0xae, 0xf8, 0xd7, 0x1c, 0x78,
+	0x60, 0x61, 0xba, 0xd7, 0xd1, 0x5c, 0xbe, 0xb7, 0x9c, 0xec, 0x12, 0xa5, 0xb1, 0x3f, 0x24, 0x09,
+	0xda, 0xd9, 0x84, 0xbd, 0x4c, 0x7f, 0xd2, 0x9c, 0x2a, 0xa2, 0x82, 0xb0, 0x53, 0x45, 0x14, 0xec,
+	0x6b, 0x6e, 0xe5, 0xdb, 0x39, 0x18, 0xda, 0xc0, 0x35, 0xf9, 0x45, 0x00, 0xe1, 0xcf, 0x16, 0x1e,
+	0xef, 0xb2, 0xd0, 0xd0, 0x6b, 0x7e, 0x65, 0x31, 0x0d, 0x15, 0xb7, 0x51, 0x15, 0xb2, 0xe2, 0x0b,
+	0xfa, 0xe3, 0xdd, 0x99, 0x05, 0x32, 0x65, 0x29, 0x15, 0x19, 0x9f, 0xe4, 0xab, 0xb0, 0x3f, 0xf1,
+	0x7d, 0x77, 0x21, 0xd5, 0x30, 0x9c, 0x5e, 0x39, 0xdb, 0x1f, 0x3d, 0x9f, 0xdf, 0x81, 0xe9, 0xd8,
+	0xcb, 0xb2, 0x85, 0xee, 0x63, 0x45, 0x69, 0x95, 0x95, 0xf4, 0xb4, 0xa2, 0x62, 0xc5, 0x67, 0x53,
+	0x3d, 0x14, 0x2b, 0x90, 0x29, 0x4b, 0xa9, 0xc8, 0x42, 0xd6, 0x13, 0x5e, 0xff, 0xf4, 0xb2, 0x5e,
+	0x40, 0xa6, 0x2c, 0xa5, 0x22, 0x13, 0x5e, 0xab, 0x81, 0xf0, 0xd2, 0xba, 0x87, 0x13, 0x06, 0x54,
+	0xca, 0x62, 0x1a, 0x2a, 0x3e, 0xc3, 0x0b, 0x30, 0xca, 0x5f, 0xe8, 0xaa, 0xdd, 0x39, 0x7d, 0x1a,
+	0x65, 0x61, 0x67, 0x1a, 0x3e, 0xf6, 0x4d, 0x18, 0x0f, 0xbd, 0xa6, 0x3c, 0xd1, 0x9d, 0x57, 0xa4,
+	0x53, 0x0a, 0xe9, 0xe8, 0xf8, 0x3c, 0x2f, 0xc1, 0xbe, 0xa4, 0x87, 0x40, 0x3d, 0x74, 0x9d, 0x40,
+	0xae, 0x3c, 0xd9, 0x17, 0x39, 0x9f, 0xbc, 0x01, 0x93, 0x91, 0x17, 0x31, 0x27, 0x77, 0x56, 0x11,
+	0xcb, 0x17, 0xa7, 0xd2, 0x52, 0x8a, 0xe1, 0x14, 0x7b, 0x25, 0xb1, 0xb0, 0x93, 0xba, 0x02, 0x5a,
+	0x65, 0x25, 0x3d, 0xad, 0xf8, 0xfc, 0xf8, 0x40, 0xf2, 0x95, 0x7c, 0xb1, 0xfb, 0x68, 0x89, 0x0c,
+	0xca, 0xb9, 0x3e, 0x19, 0xf8, 0x1a, 0x5e, 0x95, 0x60, 0xb6, 0xfb, 0xcd, 0xef, 0xe9, 0xf4, 0x49,
+	0x22, 0x58, 0xcb, 0x53, 0x0f, 0xc0, 0x24, 0xa6, 0xd5, 0xc4, 0x9b, 0xcb, 0x42, 0xaa, 0x24, 0x12,
+	0x2c, 0xe2, 0x6c, 0x7f, 0xf4, 0xe2, 0xfc, 0x89, 0xb7, 0x82, 0x85, 0x9e, 0x4e, 0x1c, 0xa3, 0x57,
+	0xce, 0xf6, 0x47, 0x1f, 0x4e, 0xb1, 0xc1, 0x05, 0x53, 0xcf, 0x14, 0xcb, 0xc9, 0x94, 0xa5, 0x54,
+	0x64, 0x7c, 0x92, 0x3b, 0x20, 0x27, 0xdc, 0x41, 0x2c, 0xf6, 0x5c, 0x72, 0x84, 0x5a, 0x39, 0xd3,
+	0x0f, 0xb5, 0x28, 0x9e, 0xd8, 0x5b, 0xef, 0x21, 0x9e, 0x40, 0xa6, 0x2c, 0xa5, 0x22, 0x13, 0x63,
+	0x39, 0xd6, 0xd9, 0x5d, 0xe8, 0xa5, 0xa1, 0x30, 0xad, 0xb2, 0x92, 0x9e, 0x56, 0xcc, 0x56, 0x91,
+	0x7e, 0xe9, 0xc9, 0x9e, 0xa3, 0x08, 0x94, 0xca, 0xa9, 0xb4, 0x94, 0xe2, 0x6c, 0x91, 0x6e, 0x59,
+	0x8f, 0xd9, 0xc2, 0x94, 0xca, 0xa9, 0xb4, 0x94, 0xe2, 0x76, 0x13, 0x6a, 0x87, 0x9c, 0x48, 0x55,
+	0xb2, 0xf4, 0xdc, 0x6e, 0x12, 0x5b, 0x11, 0x5e, 0x3e, 0x4c, 0xee, 0x2a, 0x14, 0xd3, 0x8d, 0x14,
+	0x54, 0x55, 0xe7, 0xfa, 0x64, 0xe0, 0x6b, 0x68, 0xc1, 0x4c, 0xfc, 0x24, 0xfd, 0xa9, 0x9e, 0xbe,
+	0x1e, 0x26, 0x56, 0x4e, 0xf7, 0x41, 0x2c, 0xba, 0x6c, 0xec, 0x48, 0xda, 0xc3, 0x65, 0xa3, 0xb4,
+	0xca, 0x4a, 0x7a, 0x5a, 0x71, 0xce, 0xd8, 0x61, 0x64, 0xa1, 0xe7, 0xe2, 0x43, 0xb4, 0xca, 0x4a,
+	0x7a, 0x5a, 0x7f, 0x4e, 0x65, 0xef, 0xd7, 0xbc, 0x2e, 0xfb, 0xda, 0xf5, 0xb7, 0x3f, 0x98, 0x93,
+	0xde, 0xf9, 0x60, 0x4e, 0xfa, 0xfd, 0x07, 0x73, 0xd2, 0x6b, 0xf7, 0xe7, 0xf6, 0xbc, 0x73, 0x7f,
+	0x6e, 0xcf, 0xbb, 0xf7, 0xe7, 0xf6, 0xbc, 0xf0, 0x94, 0x70, 0x6a, 0x35, 0x35, 0xcb, 0xb8, 0x89,
+	0xb0, 0xbb, 0x64, 0x21, 0xf7, 0xb6, 0xed, 0xdc, 0x0a, 0x00, 0x64, 0x07, 0x71, 0x8a, 0x77, 0xf8,
+	0x79, 0x84, 0x1c, 0x67, 0xb7, 0x46, 0xc8, 0x21, 0xf5, 0xf4, 0x7f, 0x06, 0x00, 0x07, 0xa8, 0xfb,
+	0xe1, 0x7b, 0x41, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// FundCredit funds a tenant's credit account.
+	FundCredit(ctx context.Context, in *MsgFundCredit, opts ...grpc.CallOption) (*MsgFundCreditResponse, error)
+	// CreateLease creates a new lease for the tenant.
+	// The lease starts in PENDING state awaiting provider acknowledgement.
+	CreateLease(ctx context.Context, in *MsgCreateLease, opts ...grpc.CallOption) (*MsgCreateLeaseResponse, error)
+	// CreateLeaseForTenant allows authority to create a lease on behalf of a tenant.
+	// This is used for migrating off-chain leases to on-chain.
+	// The lease starts in PENDING state awaiting provider acknowledgement.
+	CreateLeaseForTenant(ctx context.Context, in *MsgCreateLeaseForTenant, opts ...grpc.CallOption) (*MsgCreateLeaseForTenantResponse, error)
+	// AcknowledgeLease allows a provider to acknowledge a PENDING lease.
+	// This transitions the lease to ACTIVE state and starts billing.
+	AcknowledgeLease(ctx context.Context, in *MsgAcknowledgeLease, opts ...grpc.CallOption) (*MsgAcknowledgeLeaseResponse, error)
+	// RejectLease allows a provider to reject a PENDING lease.
+	// This transitions the lease to REJECTED state and unlocks tenant credit.
+	RejectLease(ctx context.Context, in *MsgRejectLease, opts ...grpc.CallOption) (*MsgRejectLeaseResponse, error)
+	// CancelLease allows a tenant to cancel their own PENDING lease.
+	// This transitions the lease to REJECTED state and unlocks tenant credit.
+	CancelLease(ctx context.Context, in *MsgCancelLease, opts ...grpc.CallOption) (*MsgCancelLeaseResponse, error)
+	// CloseLease closes an active lease.
+	CloseLease(ctx context.Context, in *MsgCloseLease, opts ...grpc.CallOption) (*MsgCloseLeaseResponse, error)
+	// Withdraw allows a provider to withdraw accrued funds from leases.
+	// Two modes: specific lease UUIDs, or provider-wide with pagination.
+	Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
+	// UpdateParams updates the module parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetItemCustomDomain sets or clears the custom_domain on a specific
+	// LeaseItem, identified by service_name. Authorised senders: the lease's
+	// tenant, the module authority, or any address in params.allowed_list. The
+	// lease must be in PENDING or ACTIVE state; an empty custom_domain clears
+	// the field and frees the index entry.
+	SetItemCustomDomain(ctx context.Context, in *MsgSetItemCustomDomain, opts ...grpc.CallOption) (*MsgSetItemCustomDomainResponse, error)
+	// WithdrawCredit allows a tenant to withdraw unreserved credit from their
+	// credit account to any address. Only credit that is neither reserved by
+	// PENDING/ACTIVE leases nor owed to providers for unsettled usage can be
+	// withdrawn, and only once params.credit_withdrawal_cooldown has elapsed
+	// since the account was last funded.
+	WithdrawCredit(ctx context.Context, in *MsgWithdrawCredit, opts ...grpc.CallOption) (*MsgWithdrawCreditResponse, error)
+	// UpdateLeaseItems changes the quantities of items on an ACTIVE lease. The
+	// lease is settled up to the current block time first, then the new
+	// quantities apply at each item's original locked_price. Scale-up requires
+	// enough available credit to cover the added reservation; scale-down never
+	// requires provider approval.
+	UpdateLeaseItems(ctx context.Context, in *MsgUpdateLeaseItems, opts ...grpc.CallOption) (*MsgUpdateLeaseItemsResponse, error)
+	// ProposeLeaseAmendment proposes adding and/or removing items on an ACTIVE
+	// lease. Credit for the added items is reserved at current SKU prices until
+	// the provider acknowledges or rejects the amendment, or it expires.
+	ProposeLeaseAmendment(ctx context.Context, in *MsgProposeLeaseAmendment, opts ...grpc.CallOption) (*MsgProposeLeaseAmendmentResponse, error)
+	// AcknowledgeLeaseAmendment allows the provider (or authority) to accept a
+	// pending amendment. The lease is settled and its item list replaced.
+	AcknowledgeLeaseAmendment(ctx context.Context, in *MsgAcknowledgeLeaseAmendment, opts ...grpc.CallOption) (*MsgAcknowledgeLeaseAmendmentResponse, error)
+	// RejectLeaseAmendment allows the provider (or authority) to reject a
+	// pending amendment, releasing its credit reservation.
+	RejectLeaseAmendment(ctx context.Context, in *MsgRejectLeaseAmendment, opts ...grpc.CallOption) (*MsgRejectLeaseAmendmentResponse, error)
+	// SetLeaseScheduledEnd sets or extends the scheduled end time of a PENDING
+	// or ACTIVE lease. Once block time reaches scheduled_end_at, the EndBlocker
+	// settles and closes the lease.
+	SetLeaseScheduledEnd(ctx context.Context, in *MsgSetLeaseScheduledEnd, opts ...grpc.CallOption) (*MsgSetLeaseScheduledEndResponse, error)
+	// ReportUsage allows a provider to report cumulative usage counters for the
+	// metered items of a lease. The increase since the last report is charged
+	// to the tenant's credit, capped by the tenant's spend limit.
+	ReportUsage(ctx context.Context, in *MsgReportUsage, opts ...grpc.CallOption) (*MsgReportUsageResponse, error)
+	// SetUsageSpendLimit allows a tenant to set how much usage may be charged
+	// to a lease per spend period.
+	SetUsageSpendLimit(ctx context.Context, in *MsgSetUsageSpendLimit, opts ...grpc.CallOption) (*MsgSetUsageSpendLimitResponse, error)
+	// OpenDispute allows a tenant to dispute an ACTIVE lease. Until the
+	// dispute is settled, amounts settled for the lease are held in escrow.
+	OpenDispute(ctx context.Context, in *MsgOpenDispute, opts ...grpc.CallOption) (*MsgOpenDisputeResponse, error)
+	// RespondToDispute allows the provider of a disputed lease to answer the
+	// dispute before its response deadline.
+	RespondToDispute(ctx context.Context, in *MsgRespondToDispute, opts ...grpc.CallOption) (*MsgRespondToDisputeResponse, error)
+	// ResolveDispute allows the authority to settle an open dispute by
+	// splitting the escrow between the tenant and the provider.
+	ResolveDispute(ctx context.Context, in *MsgResolveDispute, opts ...grpc.CallOption) (*MsgResolveDisputeResponse, error)
+	// TransferCredit moves unreserved credit from one tenant's credit account
+	// to another's. Signed by the source tenant or by the authority.
+	TransferCredit(ctx context.Context, in *MsgTransferCredit, opts ...grpc.CallOption) (*MsgTransferCreditResponse, error)
+	// CreateLeases creates several leases for the tenant at once, each for its
+	// own provider. Either all leases are created or none are.
+	CreateLeases(ctx context.Context, in *MsgCreateLeases, opts ...grpc.CallOption) (*MsgCreateLeasesResponse, error)
+	// CreateLeasesForTenant is the batch variant of CreateLeaseForTenant.
+	CreateLeasesForTenant(ctx context.Context, in *MsgCreateLeasesForTenant, opts ...grpc.CallOption) (*MsgCreateLeasesForTenantResponse, error)
+	// SetProviderPolicy sets or removes a provider's lease policy, including its
+	// grace period override. Signed by the provider or by the authority.
+	SetProviderPolicy(ctx context.Context, in *MsgSetProviderPolicy, opts ...grpc.CallOption) (*MsgSetProviderPolicyResponse, error)
+	// GrantPromoCredit grants a tenant promotional credit funded by the
+	// authority. Signed by the authority.
+	GrantPromoCredit(ctx context.Context, in *MsgGrantPromoCredit, opts ...grpc.CallOption) (*MsgGrantPromoCreditResponse, error)
+	// SetExchangeRates sets or removes entries of the exchange-rate table.
+	// Signed by a price feeder from params or the authority.
+	SetExchangeRates(ctx context.Context, in *MsgSetExchangeRates, opts ...grpc.CallOption) (*MsgSetExchangeRatesResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) FundCredit(ctx context.Context, in *MsgFundCredit, opts ...grpc.CallOption) (*MsgFundCreditResponse, error) {
+	out := new(MsgFundCreditResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/FundCredit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateLease(ctx context.Context, in *MsgCreateLease, opts ...grpc.CallOption) (*MsgCreateLeaseResponse, error) {
+	out := new(MsgCreateLeaseResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/CreateLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateLeaseForTenant(ctx context.Context, in *MsgCreateLeaseForTenant, opts ...grpc.CallOption) (*MsgCreateLeaseForTenantResponse, error) {
+	out := new(MsgCreateLeaseForTenantResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/CreateLeaseForTenant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcknowledgeLease(ctx context.Context, in *MsgAcknowledgeLease, opts ...grpc.CallOption) (*MsgAcknowledgeLeaseResponse, error) {
+	out := new(MsgAcknowledgeLeaseResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/AcknowledgeLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RejectLease(ctx context.Context, in *MsgRejectLease, opts ...grpc.CallOption) (*MsgRejectLeaseResponse, error) {
+	out := new(MsgRejectLeaseResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/RejectLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelLease(ctx context.Context, in *MsgCancelLease, opts ...grpc.CallOption) (*MsgCancelLeaseResponse, error) {
+	out := new(MsgCancelLeaseResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/CancelLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CloseLease(ctx context.Context, in *MsgCloseLease, opts ...grpc.CallOption) (*MsgCloseLeaseResponse, error) {
+	out := new(MsgCloseLeaseResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/CloseLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
+	out := new(MsgWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/Withdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetItemCustomDomain(ctx context.Context, in *MsgSetItemCustomDomain, opts ...grpc.CallOption) (*MsgSetItemCustomDomainResponse, error) {
+	out := new(MsgSetItemCustomDomainResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/SetItemCustomDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawCredit(ctx context.Context, in *MsgWithdrawCredit, opts ...grpc.CallOption) (*MsgWithdrawCreditResponse, error) {
+	out := new(MsgWithdrawCreditResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/WithdrawCredit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateLeaseItems(ctx context.Context, in *MsgUpdateLeaseItems, opts ...grpc.CallOption) (*MsgUpdateLeaseItemsResponse, error) {
+	out := new(MsgUpdateLeaseItemsResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/UpdateLeaseItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposeLeaseAmendment(ctx context.Context, in *MsgProposeLeaseAmendment, opts ...grpc.CallOption) (*MsgProposeLeaseAmendmentResponse, error) {
+	out := new(MsgProposeLeaseAmendmentResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/ProposeLeaseAmendment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcknowledgeLeaseAmendment(ctx context.Context, in *MsgAcknowledgeLeaseAmendment, opts ...grpc.CallOption) (*MsgAcknowledgeLeaseAmendmentResponse, error) {
+	out := new(MsgAcknowledgeLeaseAmendmentResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/AcknowledgeLeaseAmendment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RejectLeaseAmendment(ctx context.Context, in *MsgRejectLeaseAmendment, opts ...grpc.CallOption) (*MsgRejectLeaseAmendmentResponse, error) {
+	out := new(MsgRejectLeaseAmendmentResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/RejectLeaseAmendment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetLeaseScheduledEnd(ctx context.Context, in *MsgSetLeaseScheduledEnd, opts ...grpc.CallOption) (*MsgSetLeaseScheduledEndResponse, error) {
+	out := new(MsgSetLeaseScheduledEndResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/SetLeaseScheduledEnd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReportUsage(ctx context.Context, in *MsgReportUsage, opts ...grpc.CallOption) (*MsgReportUsageResponse, error) {
+	out := new(MsgReportUsageResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/ReportUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetUsageSpendLimit(ctx context.Context, in *MsgSetUsageSpendLimit, opts ...grpc.CallOption) (*MsgSetUsageSpendLimitResponse, error) {
+	out := new(MsgSetUsageSpendLimitResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/SetUsageSpendLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) OpenDispute(ctx context.Context, in *MsgOpenDispute, opts ...grpc.CallOption) (*MsgOpenDisputeResponse, error) {
+	out := new(MsgOpenDisputeResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/OpenDispute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RespondToDispute(ctx context.Context, in *MsgRespondToDispute, opts ...grpc.CallOption) (*MsgRespondToDisputeResponse, error) {
+	out := new(MsgRespondToDisputeResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/RespondToDispute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResolveDispute(ctx context.Context, in *MsgResolveDispute, opts ...grpc.CallOption) (*MsgResolveDisputeResponse, error) {
+	out := new(MsgResolveDisputeResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/ResolveDispute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferCredit(ctx context.Context, in *MsgTransferCredit, opts ...grpc.CallOption) (*MsgTransferCreditResponse, error) {
+	out := new(MsgTransferCreditResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/TransferCredit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateLeases(ctx context.Context, in *MsgCreateLeases, opts ...grpc.CallOption) (*MsgCreateLeasesResponse, error) {
+	out := new(MsgCreateLeasesResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/CreateLeases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateLeasesForTenant(ctx context.Context, in *MsgCreateLeasesForTenant, opts ...grpc.CallOption) (*MsgCreateLeasesForTenantResponse, error) {
+	out := new(MsgCreateLeasesForTenantResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/CreateLeasesForTenant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetProviderPolicy(ctx context.Context, in *MsgSetProviderPolicy, opts ...grpc.CallOption) (*MsgSetProviderPolicyResponse, error) {
+	out := new(MsgSetProviderPolicyResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/SetProviderPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantPromoCredit(ctx context.Context, in *MsgGrantPromoCredit, opts ...grpc.CallOption) (*MsgGrantPromoCreditResponse, error) {
+	out := new(MsgGrantPromoCreditResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/GrantPromoCredit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetExchangeRates(ctx context.Context, in *MsgSetExchangeRates, opts ...grpc.CallOption) (*MsgSetExchangeRatesResponse, error) {
+	out := new(MsgSetExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.billing.v1.Msg/SetExchangeRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// FundCredit funds a tenant's credit account.
+	FundCredit(context.Context, *MsgFundCredit) (*MsgFundCreditResponse, error)
+	// CreateLease creates a new lease for the tenant.
+	// The lease starts in PENDING state awaiting provider acknowledgement.
+	CreateLease(context.Context, *MsgCreateLease) (*MsgCreateLeaseResponse, error)
+	// CreateLeaseForTenant allows authority to create a lease on behalf of a tenant.
+	// This is used for migrating off-chain leases to on-chain.
+	// The lease starts in PENDING state awaiting provider acknowledgement.
+	CreateLeaseForTenant(context.Context, *MsgCreateLeaseForTenant) (*MsgCreateLeaseForTenantResponse, error)
+	// AcknowledgeLease allows a provider to acknowledge a PENDING lease.
+	// This transitions the lease to ACTIVE state and starts billing.
+	AcknowledgeLease(context.Context, *MsgAcknowledgeLease) (*MsgAcknowledgeLeaseResponse, error)
+	// RejectLease allows a provider to reject a PENDING lease.
+	// This transitions the lease to REJECTED state and unlocks tenant credit.
+	RejectLease(context.Context, *MsgRejectLease) (*MsgRejectLeaseResponse, error)
+	// CancelLease allows a tenant to cancel their own PENDING lease.
+	// This transitions the lease to REJECTED state and unlocks tenant credit.
+	CancelLease(context.Context, *MsgCancelLease) (*MsgCancelLeaseResponse, error)
+	// CloseLease closes an active lease.
+	CloseLease(context.Context, *MsgCloseLease) (*MsgCloseLeaseResponse, error)
+	// Withdraw allows a provider to withdraw accrued funds from leases.
+	// Two modes: specific lease UUIDs, or provider-wide with pagination.
+	Withdraw(context.Context, *MsgWithdraw) (*MsgWithdrawResponse, error)
+	// UpdateParams updates the module parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetItemCustomDomain sets or clears the custom_domain on a specific
+	// LeaseItem, identified by service_name. Authorised senders: the lease's
+	// tenant, the module authority, or any address in params.allowed_list. The
+	// lease must be in PENDING or ACTIVE state; an empty custom_domain clears
+	// the field and frees the index entry.
+	SetItemCustomDomain(context.Context, *MsgSetItemCustomDomain) (*MsgSetItemCustomDomainResponse, error)
+	// WithdrawCredit allows a tenant to withdraw unreserved credit from their
+	// credit account to any address. Only credit that is neither reserved by
+	// PENDING/ACTIVE leases nor owed to providers for unsettled usage can be
+	// withdrawn, and only once params.credit_withdrawal_cooldown has elapsed
+	// since the account was last funded.
+	WithdrawCredit(context.Context, *MsgWithdrawCredit) (*MsgWithdrawCreditResponse, error)
+	// UpdateLeaseItems changes the quantities of items on an ACTIVE lease. The
+	// lease is settled up to the current block time first, then the new
+	// quantities apply at each item's original locked_price. Scale-up requires
+	// enough available credit to cover the added reservation; scale-down never
+	// requires provider approval.
+	UpdateLeaseItems(context.Context, *MsgUpdateLeaseItems) (*MsgUpdateLeaseItemsResponse, error)
+	// ProposeLeaseAmendment proposes adding and/or removing items on an ACTIVE
+	// lease. Credit for the added items is reserved at current SKU prices until
+	// the provider acknowledges or rejects the amendment, or it expires.
+	ProposeLeaseAmendment(context.Context, *MsgProposeLeaseAmendment) (*MsgProposeLeaseAmendmentResponse, error)
+	// AcknowledgeLeaseAmendment allows the provider (or authority) to accept a
+	// pending amendment. The lease is settled and its item list replaced.
+	AcknowledgeLeaseAmendment(context.Context, *MsgAcknowledgeLeaseAmendment) (*MsgAcknowledgeLeaseAmendmentResponse, error)
+	// RejectLeaseAmendment allows the provider (or authority) to reject a
+	// pending amendment, releasing its credit reservation.
+	RejectLeaseAmendment(context.Context, *MsgRejectLeaseAmendment) (*MsgRejectLeaseAmendmentResponse, error)
+	// SetLeaseScheduledEnd sets or extends the scheduled end time of a PENDING
+	// or ACTIVE lease. Once block time reaches scheduled_end_at, the EndBlocker
+	// settles and closes the lease.
+	SetLeaseScheduledEnd(context.Context, *MsgSetLeaseScheduledEnd) (*MsgSetLeaseScheduledEndResponse, error)
+	// ReportUsage allows a provider to report cumulative usage counters for the
+	// metered items of a lease. The increase since the last report is charged
+	// to the tenant's credit, capped by the tenant's spend limit.
+	ReportUsage(context.Context, *MsgReportUsage) (*MsgReportUsageResponse, error)
+	// SetUsageSpendLimit allows a tenant to set how much usage may be charged
+	// to a lease per spend period.
+	SetUsageSpendLimit(context.Context, *MsgSetUsageSpendLimit) (*MsgSetUsageSpendLimitResponse, error)
+	// OpenDispute allows a tenant to dispute an ACTIVE lease. Until the
+	// dispute is settled, amounts settled for the lease are held in escrow.
+	OpenDispute(context.Context, *MsgOpenDispute) (*MsgOpenDisputeResponse, error)
+	// RespondToDispute allows the provider of a disputed lease to answer the
+	// dispute before its response deadline.
+	RespondToDispute(context.Context, *MsgRespondToDispute) (*MsgRespondToDisputeResponse, error)
+	// ResolveDispute allows the authority to settle an open dispute by
+	// splitting the escrow between the tenant and the provider.
+	ResolveDispute(context.Context, *MsgResolveDispute) (*MsgResolveDisputeResponse, error)
+	// TransferCredit moves unreserved credit from one tenant's credit account
+	// to another's. Signed by the source tenant or by the authority.
+	TransferCredit(context.Context, *MsgTransferCredit) (*MsgTransferCreditResponse, error)
+	// CreateLeases creates several leases for the tenant at once, each for its
+	// own provider. Either all leases are created or none are.
+	CreateLeases(context.Context, *MsgCreateLeases) (*MsgCreateLeasesResponse, error)
+	// CreateLeasesForTenant is the batch variant of CreateLeaseForTenant.
+	CreateLeasesForTenant(context.Context, *MsgCreateLeasesForTenant) (*MsgCreateLeasesForTenantResponse, error)
+	// SetProviderPolicy sets or removes a provider's lease policy, including its
+	// grace period override. Signed by the provider or by the authority.
+	SetProviderPolicy(context.Context, *MsgSetProviderPolicy) (*MsgSetProviderPolicyResponse, error)
+	// GrantPromoCredit grants a tenant promotional credit funded by the
+	// authority. Signed by the authority.
+	GrantPromoCredit(context.Context, *MsgGrantPromoCredit) (*MsgGrantPromoCreditResponse, error)
+	// SetExchangeRates sets or removes entries of the exchange-rate table.
+	// Signed by a price feeder from params or the authority.
+	SetExchangeRates(context.Context, *MsgSetExchangeRates) (*MsgSetExchangeRatesResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) FundCredit(ctx context.Context, req *MsgFundCredit) (*MsgFundCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundCredit not implemented")
+}
+func (*UnimplementedMsgServer) CreateLease(ctx context.Context, req *MsgCreateLease) (*MsgCreateLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLease not implemented")
+}
+func (*UnimplementedMsgServer) CreateLeaseForTenant(ctx context.Context, req *MsgCreateLeaseForTenant) (*MsgCreateLeaseForTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLeaseForTenant not implemented")
+}
+func (*UnimplementedMsgServer) AcknowledgeLease(ctx context.Context, req *MsgAcknowledgeLease) (*MsgAcknowledgeLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeLease not implemented")
+}
+func (*UnimplementedMsgServer) RejectLease(ctx context.Context, req *MsgRejectLease) (*MsgRejectLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectLease not implemented")
+}
+func (*UnimplementedMsgServer) CancelLease(ctx context.Context, req *MsgCancelLease) (*MsgCancelLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelLease not implemented")
+}
+func (*UnimplementedMsgServer) CloseLease(ctx context.Context, req *MsgCloseLease) (*MsgCloseLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseLease not implemented")
+}
+func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdraw) (*MsgWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) SetItemCustomDomain(ctx context.Context, req *MsgSetItemCustomDomain) (*MsgSetItemCustomDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetItemCustomDomain not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawCredit(ctx context.Context, req *MsgWithdrawCredit) (*MsgWithdrawCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCredit not implemented")
+}
+func (*UnimplementedMsgServer) UpdateLeaseItems(ctx context.Context, req *MsgUpdateLeaseItems) (*MsgUpdateLeaseItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLeaseItems not implemented")
+}
+func (*UnimplementedMsgServer) ProposeLeaseAmendment(ctx context.Context, req *MsgProposeLeaseAmendment) (*MsgProposeLeaseAmendmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeLeaseAmendment not implemented")
+}
+func (*UnimplementedMsgServer) AcknowledgeLeaseAmendment(ctx context.Context, req *MsgAcknowledgeLeaseAmendment) (*MsgAcknowledgeLeaseAmendmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeLeaseAmendment not implemented")
+}
+func (*UnimplementedMsgServer) RejectLeaseAmendment(ctx context.Context, req *MsgRejectLeaseAmendment) (*MsgRejectLeaseAmendmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectLeaseAmendment not implemented")
+}
+func (*UnimplementedMsgServer) SetLeaseScheduledEnd(ctx context.Context, req *MsgSetLeaseScheduledEnd) (*MsgSetLeaseScheduledEndResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLeaseScheduledEnd not implemented")
+}
+func (*UnimplementedMsgServer) ReportUsage(ctx context.Context, req *MsgReportUsage) (*MsgReportUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportUsage not implemented")
+}
+func (*UnimplementedMsgServer) SetUsageSpendLimit(ctx context.Context, req *MsgSetUsageSpendLimit) (*MsgSetUsageSpendLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUsageSpendLimit not implemented")
+}
+func (*UnimplementedMsgServer) OpenDispute(ctx context.Context, req *MsgOpenDispute) (*MsgOpenDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenDispute not implemented")
+}
+func (*UnimplementedMsgServer) RespondToDispute(ctx context.Context, req *MsgRespondToDispute) (*MsgRespondToDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondToDispute not implemented")
+}
+func (*UnimplementedMsgServer) ResolveDispute(ctx context.Context, req *MsgResolveDispute) (*MsgResolveDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveDispute not implemented")
+}
+func (*UnimplementedMsgServer) TransferCredit(ctx context.Context, req *MsgTransferCredit) (*MsgTransferCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferCredit not implemented")
+}
+func (*UnimplementedMsgServer) CreateLeases(ctx context.Context, req *MsgCreateLeases) (*MsgCreateLeasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLeases not implemented")
+}
+func (*UnimplementedMsgServer) CreateLeasesForTenant(ctx context.Context, req *MsgCreateLeasesForTenant) (*MsgCreateLeasesForTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLeasesForTenant not implemented")
+}
+func (*UnimplementedMsgServer) SetProviderPolicy(ctx context.Context, req *MsgSetProviderPolicy) (*MsgSetProviderPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProviderPolicy not implemented")
+}
+func (*UnimplementedMsgServer) GrantPromoCredit(ctx context.Context, req *MsgGrantPromoCredit) (*MsgGrantPromoCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantPromoCredit not implemented")
+}
+func (*UnimplementedMsgServer) SetExchangeRates(ctx context.Context, req *MsgSetExchangeRates) (*MsgSetExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExchangeRates not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_FundCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundCredit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Msg/FundCredit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundCredit(ctx, req.(*MsgFundCredit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateLease)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Msg/CreateLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateLease(ctx, req.(*MsgCreateLease))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateLeaseForTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateLeaseForTenant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateLeaseForTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Msg/CreateLeaseForTenant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateLeaseForTenant(ctx, req.(*MsgCreateLeaseForTenant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcknowledgeLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcknowledgeLease)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcknowledgeLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Msg/AcknowledgeLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcknowledgeLease(ctx, req.(*MsgAcknowledgeLease))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RejectLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectLease)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RejectLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Msg/RejectLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectLease(ctx, req.(*MsgRejectLease))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelLease)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Msg/CancelLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelLease(ctx, req.(*MsgCancelLease))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CloseLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseLease)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CloseLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Msg/CloseLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseLease(ctx, req.(*MsgCloseLease))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdraw)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Withdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Msg/Withdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Withdraw(ctx, req.(*MsgWithdraw))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetItemCustomDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetItemCustomDomain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetItemCustomDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Msg/SetItemCustomDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetItemCustomDomain(ctx, req.(*MsgSetItemCustomDomain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawCredit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Msg/WithdrawCredit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawCredit(ctx, req.(*MsgWithdrawCredit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateLeaseItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateLeaseItems)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateLeaseItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Msg/UpdateLeaseItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateLeaseItems(ctx, req.(*MsgUpdateLeaseItems))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposeLeaseAmendment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeLeaseAmendment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeLeaseAmendment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Msg/ProposeLeaseAmendment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeLeaseAmendment(ctx, req.(*MsgProposeLeaseAmendment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcknowledgeLeaseAmendment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcknowledgeLeaseAmendment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcknowledgeLeaseAmendment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Msg/AcknowledgeLeaseAmendment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcknowledgeLeaseAmendment(ctx, req.(*MsgAcknowledgeLeaseAmendment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RejectLeaseAmendment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectLeaseAmendment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RejectLeaseAmendment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Msg/RejectLeaseAmendment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectLeaseAmendment(ctx, req.(*MsgRejectLeaseAmendment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetLeaseScheduledEnd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetLeaseScheduledEnd)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetLeaseScheduledEnd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Msg/SetLeaseScheduledEnd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetLeaseScheduledEnd(ctx, req.(*MsgSetLeaseScheduledEnd))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReportUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportUsage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReportUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Msg/ReportUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportUsage(ctx, req.(*MsgReportUsage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetUsageSpendLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetUsageSpendLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetUsageSpendLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Msg/SetUsageSpendLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetUsageSpendLimit(ctx, req.(*MsgSetUsageSpendLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OpenDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOpenDispute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OpenDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Msg/OpenDispute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OpenDispute(ctx, req.(*MsgOpenDispute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RespondToDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRespondToDispute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RespondToDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Msg/RespondToDispute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RespondToDispute(ctx, req.(*MsgRespondToDispute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResolveDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolveDispute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResolveDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Msg/ResolveDispute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolveDispute(ctx, req.(*MsgResolveDispute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferCredit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Msg/TransferCredit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferCredit(ctx, req.(*MsgTransferCredit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateLeases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateLeases)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateLeases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Msg/CreateLeases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateLeases(ctx, req.(*MsgCreateLeases))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateLeasesForTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateLeasesForTenant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateLeasesForTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Msg/CreateLeasesForTenant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateLeasesForTenant(ctx, req.(*MsgCreateLeasesForTenant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetProviderPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetProviderPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetProviderPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Msg/SetProviderPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetProviderPolicy(ctx, req.(*MsgSetProviderPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantPromoCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantPromoCredit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantPromoCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Msg/GrantPromoCredit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantPromoCredit(ctx, req.(*MsgGrantPromoCredit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetExchangeRates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.billing.v1.Msg/SetExchangeRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetExchangeRates(ctx, req.(*MsgSetExchangeRates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "liftedinit.billing.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FundCredit",
+			Handler:    _Msg_FundCredit_Handler,
+		},
+		{
+			MethodName: "CreateLease",
+			Handler:    _Msg_CreateLease_Handler,
+		},
+		{
+			MethodName: "CreateLeaseForTenant",
+			Handler:    _Msg_CreateLeaseForTenant_Handler,
+		},
+		{
+			MethodName: "AcknowledgeLease",
+			Handler:    _Msg_AcknowledgeLease_Handler,
+		},
+		{
+			MethodName: "RejectLease",
+			Handler:    _Msg_RejectLease_Handler,
+		},
+		{
+			MethodName: "CancelLease",
+			Handler:    _Msg_CancelLease_Handler,
+		},
+		{
+			MethodName: "CloseLease",
+			Handler:    _Msg_CloseLease_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _Msg_Withdraw_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SetItemCustomDomain",
+			Handler:    _Msg_SetItemCustomDomain_Handler,
+		},
+		{
+			MethodName: "WithdrawCredit",
+			Handler:    _Msg_WithdrawCredit_Handler,
+		},
+		{
+			MethodName: "UpdateLeaseItems",
+			Handler:    _Msg_UpdateLeaseItems_Handler,
+		},
+		{
+			MethodName: "ProposeLeaseAmendment",
+			Handler:    _Msg_ProposeLeaseAmendment_Handler,
+		},
+		{
+			MethodName: "AcknowledgeLeaseAmendment",
+			Handler:    _Msg_AcknowledgeLeaseAmendment_Handler,
+		},
+		{
+			MethodName: "RejectLeaseAmendment",
+			Handler:    _Msg_RejectLeaseAmendment_Handler,
+		},
+		{
+			MethodName: "SetLeaseScheduledEnd",
+			Handler:    _Msg_SetLeaseScheduledEnd_Handler,
+		},
+		{
+			MethodName: "ReportUsage",
+			Handler:    _Msg_ReportUsage_Handler,
+		},
+		{
+			MethodName: "SetUsageSpendLimit",
+			Handler:    _Msg_SetUsageSpendLimit_Handler,
+		},
+		{
+			MethodName: "OpenDispute",
+			Handler:    _Msg_OpenDispute_Handler,
+		},
+		{
+			MethodName: "RespondToDispute",
+			Handler:    _Msg_RespondToDispute_Handler,
+		},
+		{
+			MethodName: "ResolveDispute",
+			Handler:    _Msg_ResolveDispute_Handler,
+		},
+		{
+			MethodName: "TransferCredit",
+			Handler:    _Msg_TransferCredit_Handler,
+		},
+		{
+			MethodName: "CreateLeases",
+			Handler:    _Msg_CreateLeases_Handler,
+		},
+		{
+			MethodName: "CreateLeasesForTenant",
+			Handler:    _Msg_CreateLeasesForTenant_Handler,
+		},
+		{
+			MethodName: "SetProviderPolicy",
+			Handler:    _Msg_SetProviderPolicy_Handler,
+		},
+		{
+			MethodName: "GrantPromoCredit",
+			Handler:    _Msg_GrantPromoCredit_Handler,
+		},
+		{
+			MethodName: "SetExchangeRates",
+			Handler:    _Msg_SetExchangeRates_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "liftedinit/billing/v1/tx.proto",
+}
+
+func (m *ExchangeRateInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeRateInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeRateInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ValidUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ValidUntil):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.ValidFrom != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ValidFrom, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ValidFrom):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTx(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LeaseItemInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LeaseItemInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeaseItemInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ServiceName) > 0 {
+		i -= len(m.ServiceName)
+		copy(dAtA[i:], m.ServiceName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SkuUuid) > 0 {
+		i -= len(m.SkuUuid)
+		copy(dAtA[i:], m.SkuUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SkuUuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LeaseInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LeaseInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeaseInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScheduledEndAt != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ScheduledEndAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ScheduledEndAt):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTx(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MetaHash) > 0 {
+		i -= len(m.MetaHash)
+		copy(dAtA[i:], m.MetaHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MetaHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundCredit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundCredit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundCredit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundCreditResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundCreditResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundCreditResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.NewBalance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CreditAddress) > 0 {
+		i -= len(m.CreditAddress)
+		copy(dAtA[i:], m.CreditAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CreditAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateLease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateLease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateLease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScheduledEndAt != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ScheduledEndAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ScheduledEndAt):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintTx(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MetaHash) > 0 {
+		i -= len(m.MetaHash)
+		copy(dAtA[i:], m.MetaHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MetaHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateLeaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateLeaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateLeaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LeaseUuid) > 0 {
+		i -= len(m.LeaseUuid)
+		copy(dAtA[i:], m.LeaseUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LeaseUuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateLeaseForTenant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateLeaseForTenant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateLeaseForTenant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScheduledEndAt != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ScheduledEndAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ScheduledEndAt):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintTx(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MetaHash) > 0 {
+		i -= len(m.MetaHash)
+		copy(dAtA[i:], m.MetaHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MetaHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateLeaseForTenantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateLeaseForTenantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateLeaseForTenantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LeaseUuid) > 0 {
+		i -= len(m.LeaseUuid)
+		copy(dAtA[i:], m.LeaseUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LeaseUuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseLease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseLease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseLease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LeaseUuids) > 0 {
+		for iNdEx := len(m.LeaseUuids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LeaseUuids[iNdEx])
+			copy(dAtA[i:], m.LeaseUuids[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.LeaseUuids[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseLeaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseLeaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseLeaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalSettledAmounts) > 0 {
+		for iNdEx := len(m.TotalSettledAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalSettledAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ClosedCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ClosedCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ClosedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ClosedAt):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintTx(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProviderUuid) > 0 {
+		i -= len(m.ProviderUuid)
+		copy(dAtA[i:], m.ProviderUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProviderUuid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LeaseUuids) > 0 {
+		for iNdEx := len(m.LeaseUuids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LeaseUuids[iNdEx])
+			copy(dAtA[i:], m.LeaseUuids[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.LeaseUuids[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HasMore {
+		i--
+		if m.HasMore {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WithdrawalCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WithdrawalCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PayoutAddress) > 0 {
+		i -= len(m.PayoutAddress)
+		copy(dAtA[i:], m.PayoutAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PayoutAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TotalAmounts) > 0 {
+		for iNdEx := len(m.TotalAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcknowledgeLease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcknowledgeLease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcknowledgeLease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LeaseUuids) > 0 {
+		for iNdEx := len(m.LeaseUuids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LeaseUuids[iNdEx])
+			copy(dAtA[i:], m.LeaseUuids[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.LeaseUuids[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcknowledgeLeaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcknowledgeLeaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcknowledgeLeaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AcknowledgedCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AcknowledgedCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.AcknowledgedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AcknowledgedAt):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintTx(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectLease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectLease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectLease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LeaseUuids) > 0 {
+		for iNdEx := len(m.LeaseUuids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LeaseUuids[iNdEx])
+			copy(dAtA[i:], m.LeaseUuids[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.LeaseUuids[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectLeaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectLeaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectLeaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RejectedCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RejectedCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RejectedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RejectedAt):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintTx(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelLease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelLease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelLease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LeaseUuids) > 0 {
+		for iNdEx := len(m.LeaseUuids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LeaseUuids[iNdEx])
+			copy(dAtA[i:], m.LeaseUuids[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.LeaseUuids[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelLeaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelLeaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelLeaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CancelledCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CancelledCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CancelledAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CancelledAt):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintTx(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetItemCustomDomain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetItemCustomDomain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetItemCustomDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CustomDomain) > 0 {
+		i -= len(m.CustomDomain)
+		copy(dAtA[i:], m.CustomDomain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CustomDomain)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ServiceName) > 0 {
+		i -= len(m.ServiceName)
+		copy(dAtA[i:], m.ServiceName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LeaseUuid) > 0 {
+		i -= len(m.LeaseUuid)
+		copy(dAtA[i:], m.LeaseUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LeaseUuid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetItemCustomDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetItemCustomDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetItemCustomDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawCredit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawCredit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawCredit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawCreditResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawCreditResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawCreditResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemainingBalances) > 0 {
+		for iNdEx := len(m.RemainingBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemainingBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CreditAddress) > 0 {
+		i -= len(m.CreditAddress)
+		copy(dAtA[i:], m.CreditAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CreditAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateLeaseItems) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateLeaseItems) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateLeaseItems) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.LeaseUuid) > 0 {
+		i -= len(m.LeaseUuid)
+		copy(dAtA[i:], m.LeaseUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LeaseUuid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateLeaseItemsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateLeaseItemsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateLeaseItemsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SettledAmounts) > 0 {
+		for iNdEx := len(m.SettledAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettledAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeLeaseAmendment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeLeaseAmendment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeLeaseAmendment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemoveItems) > 0 {
+		for iNdEx := len(m.RemoveItems) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveItems[iNdEx])
+			copy(dAtA[i:], m.RemoveItems[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveItems[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AddItems) > 0 {
+		for iNdEx := len(m.AddItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.LeaseUuid) > 0 {
+		i -= len(m.LeaseUuid)
+		copy(dAtA[i:], m.LeaseUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LeaseUuid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeLeaseAmendmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeLeaseAmendmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeLeaseAmendmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReservedAmounts) > 0 {
+		for iNdEx := len(m.ReservedAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReservedAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcknowledgeLeaseAmendment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcknowledgeLeaseAmendment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcknowledgeLeaseAmendment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LeaseUuid) > 0 {
+		i -= len(m.LeaseUuid)
+		copy(dAtA[i:], m.LeaseUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LeaseUuid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcknowledgeLeaseAmendmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcknowledgeLeaseAmendmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcknowledgeLeaseAmendmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SettledAmounts) > 0 {
+		for iNdEx := len(m.SettledAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettledAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectLeaseAmendment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectLeaseAmendment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectLeaseAmendment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LeaseUuid) > 0 {
+		i -= len(m.LeaseUuid)
+		copy(dAtA[i:], m.LeaseUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LeaseUuid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectLeaseAmendmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectLeaseAmendmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectLeaseAmendmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetLeaseScheduledEnd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetLeaseScheduledEnd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetLeaseScheduledEnd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ScheduledEndAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ScheduledEndAt):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintTx(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.LeaseUuid) > 0 {
+		i -= len(m.LeaseUuid)
+		copy(dAtA[i:], m.LeaseUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LeaseUuid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetLeaseScheduledEndResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetLeaseScheduledEndResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetLeaseScheduledEndResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *UsageReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UsageReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsageReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Counter != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Counter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ServiceName) > 0 {
+		i -= len(m.ServiceName)
+		copy(dAtA[i:], m.ServiceName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SkuUuid) > 0 {
+		i -= len(m.SkuUuid)
+		copy(dAtA[i:], m.SkuUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SkuUuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Usages) > 0 {
+		for iNdEx := len(m.Usages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Usages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.LeaseUuid) > 0 {
+		i -= len(m.LeaseUuid)
+		copy(dAtA[i:], m.LeaseUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LeaseUuid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnchargedAmounts) > 0 {
+		for iNdEx := len(m.UnchargedAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnchargedAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ChargedAmounts) > 0 {
+		for iNdEx := len(m.ChargedAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChargedAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetUsageSpendLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetUsageSpendLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetUsageSpendLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Period != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.LeaseUuid) > 0 {
+		i -= len(m.LeaseUuid)
+		copy(dAtA[i:], m.LeaseUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LeaseUuid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetUsageSpendLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetUsageSpendLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetUsageSpendLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenDispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenDispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LeaseUuid) > 0 {
+		i -= len(m.LeaseUuid)
+		copy(dAtA[i:], m.LeaseUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LeaseUuid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenDisputeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ResponseDeadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ResponseDeadline):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintTx(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Escrowed) > 0 {
+		for iNdEx := len(m.Escrowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRespondToDispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRespondToDispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRespondToDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Response) > 0 {
+		i -= len(m.Response)
+		copy(dAtA[i:], m.Response)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Response)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LeaseUuid) > 0 {
+		i -= len(m.LeaseUuid)
+		copy(dAtA[i:], m.LeaseUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LeaseUuid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRespondToDisputeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRespondToDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRespondToDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveDispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveDispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TenantShare.Size()
+		i -= size
+		if _, err := m.TenantShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.LeaseUuid) > 0 {
+		i -= len(m.LeaseUuid)
+		copy(dAtA[i:], m.LeaseUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LeaseUuid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveDisputeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderAmounts) > 0 {
+		for iNdEx := len(m.ProviderAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TenantAmounts) > 0 {
+		for iNdEx := len(m.TenantAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TenantAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferCredit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferCredit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferCredit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ToTenant) > 0 {
+		i -= len(m.ToTenant)
+		copy(dAtA[i:], m.ToTenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToTenant)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FromTenant) > 0 {
+		i -= len(m.FromTenant)
+		copy(dAtA[i:], m.FromTenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromTenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferCreditResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferCreditResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferCreditResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToCreditAddress) > 0 {
+		i -= len(m.ToCreditAddress)
+		copy(dAtA[i:], m.ToCreditAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToCreditAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromCreditAddress) > 0 {
+		i -= len(m.FromCreditAddress)
+		copy(dAtA[i:], m.FromCreditAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromCreditAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateLeases) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateLeases) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateLeases) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Leases) > 0 {
+		for iNdEx := len(m.Leases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Leases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateLeasesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateLeasesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateLeasesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LeaseUuids) > 0 {
+		for iNdEx := len(m.LeaseUuids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LeaseUuids[iNdEx])
+			copy(dAtA[i:], m.LeaseUuids[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.LeaseUuids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateLeasesForTenant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateLeasesForTenant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateLeasesForTenant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Leases) > 0 {
+		for iNdEx := len(m.Leases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Leases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateLeasesForTenantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateLeasesForTenantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateLeasesForTenantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LeaseUuids) > 0 {
+		for iNdEx := len(m.LeaseUuids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LeaseUuids[iNdEx])
+			copy(dAtA[i:], m.LeaseUuids[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.LeaseUuids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetProviderPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetProviderPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetProviderPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reset_ {
+		i--
+		if m.Reset_ {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetProviderPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetProviderPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetProviderPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantPromoCredit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantPromoCredit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantPromoCredit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SkuUuids) > 0 {
+		for iNdEx := len(m.SkuUuids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SkuUuids[iNdEx])
+			copy(dAtA[i:], m.SkuUuids[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.SkuUuids[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ProviderUuids) > 0 {
+		for iNdEx := len(m.ProviderUuids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProviderUuids[iNdEx])
+			copy(dAtA[i:], m.ProviderUuids[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ProviderUuids[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintTx(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantPromoCreditResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantPromoCreditResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantPromoCreditResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GrantId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GrantId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetExchangeRates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetExchangeRates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetExchangeRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rates) > 0 {
+		for iNdEx := len(m.Rates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ExchangeRateInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ValidFrom != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ValidFrom)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ValidUntil)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *LeaseItemInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SkuUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovTx(uint64(m.Quantity))
+	}
+	l = len(m.ServiceName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *LeaseInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.MetaHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ScheduledEndAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ScheduledEndAt)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFundCredit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFundCreditResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CreditAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.NewBalance.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateLease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.MetaHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ScheduledEndAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ScheduledEndAt)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateLeaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LeaseUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateLeaseForTenant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.MetaHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ScheduledEndAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ScheduledEndAt)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateLeaseForTenantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LeaseUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCloseLease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.LeaseUuids) > 0 {
+		for _, s := range m.LeaseUuids {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCloseLeaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ClosedAt)
+	n += 1 + l + sovTx(uint64(l))
+	if m.ClosedCount != 0 {
+		n += 1 + sovTx(uint64(m.ClosedCount))
+	}
+	if len(m.TotalSettledAmounts) > 0 {
+		for _, e := range m.TotalSettledAmounts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.LeaseUuids) > 0 {
+		for _, s := range m.LeaseUuids {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ProviderUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovTx(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *MsgWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalAmounts) > 0 {
+		for _, e := range m.TotalAmounts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.PayoutAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.WithdrawalCount != 0 {
+		n += 1 + sovTx(uint64(m.WithdrawalCount))
+	}
+	if m.HasMore {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcknowledgeLease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.LeaseUuids) > 0 {
+		for _, s := range m.LeaseUuids {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAcknowledgeLeaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AcknowledgedAt)
+	n += 1 + l + sovTx(uint64(l))
+	if m.AcknowledgedCount != 0 {
+		n += 1 + sovTx(uint64(m.AcknowledgedCount))
+	}
+	return n
+}
+
+func (m *MsgRejectLease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.LeaseUuids) > 0 {
+		for _, s := range m.LeaseUuids {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRejectLeaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RejectedAt)
+	n += 1 + l + sovTx(uint64(l))
+	if m.RejectedCount != 0 {
+		n += 1 + sovTx(uint64(m.RejectedCount))
+	}
+	return n
+}
+
+func (m *MsgCancelLease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.LeaseUuids) > 0 {
+		for _, s := range m.LeaseUuids {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCancelLeaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CancelledAt)
+	n += 1 + l + sovTx(uint64(l))
+	if m.CancelledCount != 0 {
+		n += 1 + sovTx(uint64(m.CancelledCount))
+	}
+	return n
+}
+
+func (m *MsgSetItemCustomDomain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LeaseUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ServiceName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CustomDomain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetItemCustomDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawCredit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawCreditResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CreditAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RemainingBalances) > 0 {
+		for _, e := range m.RemainingBalances {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateLeaseItems) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LeaseUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateLeaseItemsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.SettledAmounts) > 0 {
+		for _, e := range m.SettledAmounts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgProposeLeaseAmendment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LeaseUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AddItems) > 0 {
+		for _, e := range m.AddItems {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RemoveItems) > 0 {
+		for _, s := range m.RemoveItems {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgProposeLeaseAmendmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReservedAmounts) > 0 {
+		for _, e := range m.ReservedAmounts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAcknowledgeLeaseAmendment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LeaseUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcknowledgeLeaseAmendmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.SettledAmounts) > 0 {
+		for _, e := range m.SettledAmounts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRejectLeaseAmendment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LeaseUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRejectLeaseAmendmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetLeaseScheduledEnd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LeaseUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ScheduledEndAt)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetLeaseScheduledEndResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UsageReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SkuUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ServiceName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Counter != 0 {
+		n += 1 + sovTx(uint64(m.Counter))
+	}
+	return n
+}
+
+func (m *MsgReportUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LeaseUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Usages) > 0 {
+		for _, e := range m.Usages {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgReportUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChargedAmounts) > 0 {
+		for _, e := range m.ChargedAmounts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.UnchargedAmounts) > 0 {
+		for _, e := range m.UnchargedAmounts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetUsageSpendLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LeaseUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Period != 0 {
+		n += 1 + sovTx(uint64(m.Period))
+	}
+	return n
+}
+
+func (m *MsgSetUsageSpendLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgOpenDispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LeaseUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOpenDisputeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Escrowed) > 0 {
+		for _, e := range m.Escrowed {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ResponseDeadline)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRespondToDispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LeaseUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Response)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRespondToDisputeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResolveDispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LeaseUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TenantShare.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgResolveDisputeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TenantAmounts) > 0 {
+		for _, e := range m.TenantAmounts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ProviderAmounts) > 0 {
+		for _, e := range m.ProviderAmounts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgTransferCredit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromTenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToTenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgTransferCreditResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromCreditAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToCreditAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateLeases) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Leases) > 0 {
+		for _, e := range m.Leases {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateLeasesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LeaseUuids) > 0 {
+		for _, s := range m.LeaseUuids {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateLeasesForTenant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Leases) > 0 {
+		for _, e := range m.Leases {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateLeasesForTenantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LeaseUuids) > 0 {
+		for _, s := range m.LeaseUuids {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetProviderPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Reset_ {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetProviderPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantPromoCredit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.ProviderUuids) > 0 {
+		for _, s := range m.ProviderUuids {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.SkuUuids) > 0 {
+		for _, s := range m.SkuUuids {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgGrantPromoCreditResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GrantId != 0 {
+		n += 1 + sovTx(uint64(m.GrantId))
+	}
+	return n
+}
+
+func (m *MsgSetExchangeRates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.LeaseUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Rates) > 0 {
+		for _, e := range m.Rates {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetExchangeRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ExchangeRateInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRateInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRateInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidFrom == nil {
+				m.ValidFrom = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ValidFrom, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ValidUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeaseItemInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaseItemInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaseItemInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkuUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SkuUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeaseInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaseInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaseInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, LeaseItemInput{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetaHash = append(m.MetaHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.MetaHash == nil {
+				m.MetaHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEndAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledEndAt == nil {
+				m.ScheduledEndAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ScheduledEndAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundCredit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundCredit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundCredit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundCreditResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundCreditResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundCreditResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateLease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateLease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateLease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, LeaseItemInput{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetaHash = append(m.MetaHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.MetaHash == nil {
+				m.MetaHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEndAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledEndAt == nil {
+				m.ScheduledEndAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ScheduledEndAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateLeaseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateLeaseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateLeaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateLeaseForTenant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateLeaseForTenant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateLeaseForTenant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, LeaseItemInput{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetaHash = append(m.MetaHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.MetaHash == nil {
+				m.MetaHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEndAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledEndAt == nil {
+				m.ScheduledEndAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ScheduledEndAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateLeaseForTenantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateLeaseForTenantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateLeaseForTenantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseLease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseLease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseLease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaseUuids = append(m.LeaseUuids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseLeaseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseLeaseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseLeaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ClosedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedCount", wireType)
+			}
+			m.ClosedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClosedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSettledAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalSettledAmounts = append(m.TotalSettledAmounts, types.Coin{})
+			if err := m.TotalSettledAmounts[len(m.TotalSettledAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaseUuids = append(m.LeaseUuids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalAmounts = append(m.TotalAmounts, types.Coin{})
+			if err := m.TotalAmounts[len(m.TotalAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayoutAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalCount", wireType)
+			}
+			m.WithdrawalCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMore", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMore = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcknowledgeLease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcknowledgeLease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcknowledgeLease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaseUuids = append(m.LeaseUuids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcknowledgeLeaseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcknowledgeLeaseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcknowledgeLeaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcknowledgedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.AcknowledgedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcknowledgedCount", wireType)
+			}
+			m.AcknowledgedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcknowledgedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRejectLease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRejectLease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRejectLease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaseUuids = append(m.LeaseUuids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRejectLeaseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRejectLeaseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRejectLeaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RejectedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedCount", wireType)
+			}
+			m.RejectedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelLease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelLease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelLease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaseUuids = append(m.LeaseUuids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelLeaseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelLeaseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelLeaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CancelledAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledCount", wireType)
+			}
+			m.CancelledCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CancelledCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetItemCustomDomain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetItemCustomDomain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetItemCustomDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomDomain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CustomDomain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetItemCustomDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetItemCustomDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetItemCustomDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawCredit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawCredit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawCredit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawCreditResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawCreditResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawCreditResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingBalances = append(m.RemainingBalances, types.Coin{})
+			if err := m.RemainingBalances[len(m.RemainingBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateLeaseItems) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateLeaseItems: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateLeaseItems: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, LeaseItemInput{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateLeaseItemsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateLeaseItemsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateLeaseItemsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, LeaseItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettledAmounts = append(m.SettledAmounts, types.Coin{})
+			if err := m.SettledAmounts[len(m.SettledAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeLeaseAmendment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeLeaseAmendment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeLeaseAmendment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddItems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddItems = append(m.AddItems, LeaseItemInput{})
+			if err := m.AddItems[len(m.AddItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveItems", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveItems = append(m.RemoveItems, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeLeaseAmendmentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeLeaseAmendmentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeLeaseAmendmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReservedAmounts = append(m.ReservedAmounts, types.Coin{})
+			if err := m.ReservedAmounts[len(m.ReservedAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcknowledgeLeaseAmendment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcknowledgeLeaseAmendment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcknowledgeLeaseAmendment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcknowledgeLeaseAmendmentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcknowledgeLeaseAmendmentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcknowledgeLeaseAmendmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, LeaseItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettledAmounts = append(m.SettledAmounts, types.Coin{})
+			if err := m.SettledAmounts[len(m.SettledAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRejectLeaseAmendment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRejectLeaseAmendment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRejectLeaseAmendment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = len(m.ServiceName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+func (m *MsgRejectLeaseAmendmentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRejectLeaseAmendmentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRejectLeaseAmendmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = len(m.CustomDomain)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetLeaseScheduledEnd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetLeaseScheduledEnd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetLeaseScheduledEnd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEndAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ScheduledEndAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgSetItemCustomDomainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgSetLeaseScheduledEndResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetLeaseScheduledEndResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetLeaseScheduledEndResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *LeaseItemInput) Unmarshal(dAtA []byte) error {
+func (m *UsageReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3182,10 +12912,10 @@ func (m *LeaseItemInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LeaseItemInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: UsageReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LeaseItemInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UsageReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3221,25 +12951,6 @@ func (m *LeaseItemInput) Unmarshal(dAtA []byte) error {
 			m.SkuUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
-			}
-			m.Quantity = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Quantity |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
 			}
@@ -3271,6 +12982,25 @@ func (m *LeaseItemInput) Unmarshal(dAtA []byte) error {
 			}
 			m.ServiceName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
+			}
+			m.Counter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Counter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3292,7 +13022,7 @@ func (m *LeaseItemInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundCredit) Unmarshal(dAtA []byte) error {
+func (m *MsgReportUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3315,10 +13045,10 @@ func (m *MsgFundCredit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundCredit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReportUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundCredit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReportUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3355,7 +13085,7 @@ func (m *MsgFundCredit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3383,11 +13113,11 @@ func (m *MsgFundCredit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tenant = string(dAtA[iNdEx:postIndex])
+			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3414,7 +13144,8 @@ func (m *MsgFundCredit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Usages = append(m.Usages, UsageReport{})
+			if err := m.Usages[len(m.Usages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3439,7 +13170,7 @@ func (m *MsgFundCredit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundCreditResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReportUsageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3462,15 +13193,165 @@ func (m *MsgFundCreditResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundCreditResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReportUsageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundCreditResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReportUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargedAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChargedAmounts = append(m.ChargedAmounts, types.Coin{})
+			if err := m.ChargedAmounts[len(m.ChargedAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnchargedAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnchargedAmounts = append(m.UnchargedAmounts, types.Coin{})
+			if err := m.UnchargedAmounts[len(m.UnchargedAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetUsageSpendLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetUsageSpendLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetUsageSpendLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreditAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3498,11 +13379,11 @@ func (m *MsgFundCreditResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreditAddress = string(dAtA[iNdEx:postIndex])
+			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3529,10 +13410,30 @@ func (m *MsgFundCreditResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NewBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3554,7 +13455,7 @@ func (m *MsgFundCreditResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateLease) Unmarshal(dAtA []byte) error {
+func (m *MsgSetUsageSpendLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3577,10 +13478,60 @@ func (m *MsgCreateLease) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateLease: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetUsageSpendLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateLease: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetUsageSpendLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenDispute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenDispute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenDispute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3617,9 +13568,9 @@ func (m *MsgCreateLease) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3629,31 +13580,29 @@ func (m *MsgCreateLease) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, LeaseItemInput{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3663,25 +13612,23 @@ func (m *MsgCreateLease) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetaHash = append(m.MetaHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.MetaHash == nil {
-				m.MetaHash = []byte{}
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3704,7 +13651,7 @@ func (m *MsgCreateLease) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateLeaseResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgOpenDisputeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3727,17 +13674,17 @@ func (m *MsgCreateLeaseResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateLeaseResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOpenDisputeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateLeaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOpenDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3747,23 +13694,58 @@ func (m *MsgCreateLeaseResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
+			m.Escrowed = append(m.Escrowed, types.Coin{})
+			if err := m.Escrowed[len(m.Escrowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseDeadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ResponseDeadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3786,7 +13768,7 @@ func (m *MsgCreateLeaseResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateLeaseForTenant) Unmarshal(dAtA []byte) error {
+func (m *MsgRespondToDispute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3809,15 +13791,15 @@ func (m *MsgCreateLeaseForTenant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateLeaseForTenant: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRespondToDispute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateLeaseForTenant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRespondToDispute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3845,11 +13827,11 @@ func (m *MsgCreateLeaseForTenant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3877,47 +13859,13 @@ func (m *MsgCreateLeaseForTenant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tenant = string(dAtA[iNdEx:postIndex])
+			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Items = append(m.Items, LeaseItemInput{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3927,25 +13875,23 @@ func (m *MsgCreateLeaseForTenant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetaHash = append(m.MetaHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.MetaHash == nil {
-				m.MetaHash = []byte{}
-			}
+			m.Response = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3968,7 +13914,7 @@ func (m *MsgCreateLeaseForTenant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateLeaseForTenantResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRespondToDisputeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3991,44 +13937,12 @@ func (m *MsgCreateLeaseForTenantResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateLeaseForTenantResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRespondToDisputeResponse: wiretype end group for non-group")
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateLeaseForTenantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRespondToDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4050,7 +13964,7 @@ func (m *MsgCreateLeaseForTenantResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCloseLease) Unmarshal(dAtA []byte) error {
+func (m *MsgResolveDispute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4073,15 +13987,15 @@ func (m *MsgCloseLease) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCloseLease: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResolveDispute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCloseLease: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResolveDispute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4109,11 +14023,11 @@ func (m *MsgCloseLease) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4141,11 +14055,11 @@ func (m *MsgCloseLease) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LeaseUuids = append(m.LeaseUuids, string(dAtA[iNdEx:postIndex]))
+			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantShare", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4173,7 +14087,9 @@ func (m *MsgCloseLease) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			if err := m.TenantShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4196,7 +14112,7 @@ func (m *MsgCloseLease) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCloseLeaseResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResolveDisputeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4219,15 +14135,15 @@ func (m *MsgCloseLeaseResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCloseLeaseResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResolveDisputeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCloseLeaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResolveDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClosedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantAmounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4254,32 +14170,14 @@ func (m *MsgCloseLeaseResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ClosedAt, dAtA[iNdEx:postIndex]); err != nil {
+			m.TenantAmounts = append(m.TenantAmounts, types.Coin{})
+			if err := m.TenantAmounts[len(m.TenantAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClosedCount", wireType)
-			}
-			m.ClosedCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ClosedCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSettledAmounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAmounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4306,8 +14204,8 @@ func (m *MsgCloseLeaseResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalSettledAmounts = append(m.TotalSettledAmounts, types.Coin{})
-			if err := m.TotalSettledAmounts[len(m.TotalSettledAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ProviderAmounts = append(m.ProviderAmounts, types.Coin{})
+			if err := m.ProviderAmounts[len(m.ProviderAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4332,7 +14230,7 @@ func (m *MsgCloseLeaseResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferCredit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4355,10 +14253,10 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferCredit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferCredit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4395,7 +14293,7 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromTenant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4423,11 +14321,11 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LeaseUuids = append(m.LeaseUuids, string(dAtA[iNdEx:postIndex]))
+			m.FromTenant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToTenant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4455,13 +14353,13 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderUuid = string(dAtA[iNdEx:postIndex])
+			m.ToTenant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Limit = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4471,11 +14369,26 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4497,7 +14410,7 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferCreditResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4520,15 +14433,161 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferCreditResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferCreditResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromCreditAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromCreditAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToCreditAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToCreditAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateLeases) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateLeases: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateLeases: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leases", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4555,14 +14614,64 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalAmounts = append(m.TotalAmounts, types.Coin{})
-			if err := m.TotalAmounts[len(m.TotalAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Leases = append(m.Leases, LeaseInput{})
+			if err := m.Leases[len(m.Leases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateLeasesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateLeasesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateLeasesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayoutAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuids", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4590,47 +14699,8 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PayoutAddress = string(dAtA[iNdEx:postIndex])
+			m.LeaseUuids = append(m.LeaseUuids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalCount", wireType)
-			}
-			m.WithdrawalCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WithdrawalCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasMore", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.HasMore = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4652,7 +14722,7 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateLeasesForTenant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4675,10 +14745,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateLeasesForTenant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateLeasesForTenant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4715,7 +14785,39 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leases", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4742,7 +14844,8 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Leases = append(m.Leases, LeaseInput{})
+			if err := m.Leases[len(m.Leases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4767,7 +14870,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateLeasesForTenantResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4790,12 +14893,44 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateLeasesForTenantResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateLeasesForTenantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaseUuids = append(m.LeaseUuids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4817,7 +14952,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAcknowledgeLease) Unmarshal(dAtA []byte) error {
+func (m *MsgSetProviderPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4840,10 +14975,10 @@ func (m *MsgAcknowledgeLease) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAcknowledgeLease: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetProviderPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAcknowledgeLease: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetProviderPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4880,9 +15015,9 @@ func (m *MsgAcknowledgeLease) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4892,24 +15027,45 @@ func (m *MsgAcknowledgeLease) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LeaseUuids = append(m.LeaseUuids, string(dAtA[iNdEx:postIndex]))
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reset_", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reset_ = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4931,7 +15087,7 @@ func (m *MsgAcknowledgeLease) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAcknowledgeLeaseResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetProviderPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4954,64 +15110,12 @@ func (m *MsgAcknowledgeLeaseResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAcknowledgeLeaseResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetProviderPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAcknowledgeLeaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetProviderPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AcknowledgedAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.AcknowledgedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AcknowledgedCount", wireType)
-			}
-			m.AcknowledgedCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AcknowledgedCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5033,7 +15137,7 @@ func (m *MsgAcknowledgeLeaseResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRejectLease) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantPromoCredit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5056,47 +15160,15 @@ func (m *MsgRejectLease) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRejectLease: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRejectLease: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantPromoCredit: wiretype end group for non-group")
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantPromoCredit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5124,11 +15196,11 @@ func (m *MsgRejectLease) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LeaseUuids = append(m.LeaseUuids, string(dAtA[iNdEx:postIndex]))
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5156,61 +15228,11 @@ func (m *MsgRejectLease) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.Tenant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRejectLeaseResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRejectLeaseResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRejectLeaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejectedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5237,15 +15259,16 @@ func (m *MsgRejectLeaseResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RejectedAt, dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejectedCount", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			m.RejectedCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5255,64 +15278,28 @@ func (m *MsgRejectLeaseResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RejectedCount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCancelLease) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelLease: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelLease: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderUuids", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5340,11 +15327,11 @@ func (m *MsgCancelLease) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tenant = string(dAtA[iNdEx:postIndex])
+			m.ProviderUuids = append(m.ProviderUuids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SkuUuids", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5372,7 +15359,7 @@ func (m *MsgCancelLease) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LeaseUuids = append(m.LeaseUuids, string(dAtA[iNdEx:postIndex]))
+			m.SkuUuids = append(m.SkuUuids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5395,7 +15382,7 @@ func (m *MsgCancelLease) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelLeaseResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantPromoCreditResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5418,50 +15405,17 @@ func (m *MsgCancelLeaseResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelLeaseResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantPromoCreditResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelLeaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantPromoCreditResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelledAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CancelledAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelledCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantId", wireType)
 			}
-			m.CancelledCount = 0
+			m.GrantId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5471,7 +15425,7 @@ func (m *MsgCancelLeaseResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CancelledCount |= uint64(b&0x7F) << shift
+				m.GrantId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5497,7 +15451,7 @@ func (m *MsgCancelLeaseResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetItemCustomDomain) Unmarshal(dAtA []byte) error {
+func (m *MsgSetExchangeRates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5520,10 +15474,10 @@ func (m *MsgSetItemCustomDomain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetItemCustomDomain: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetExchangeRates: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetItemCustomDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetExchangeRates: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5560,41 +15514,9 @@ func (m *MsgSetItemCustomDomain) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5604,55 +15526,25 @@ func (m *MsgSetItemCustomDomain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CustomDomain", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Rates = append(m.Rates, ExchangeRateInput{})
+			if err := m.Rates[len(m.Rates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.CustomDomain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5675,7 +15567,7 @@ func (m *MsgSetItemCustomDomain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetItemCustomDomainResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5698,10 +15590,10 @@ func (m *MsgSetItemCustomDomainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetItemCustomDomainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetExchangeRatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetItemCustomDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
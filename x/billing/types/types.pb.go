@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -81,6 +82,105 @@ func (LeaseState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_9636bb21eb29c389, []int{0}
 }
 
+// SettlementTrigger defines what caused a lease settlement.
+type SettlementTrigger int32
+
+const (
+	// SETTLEMENT_TRIGGER_UNSPECIFIED is the default value.
+	SETTLEMENT_TRIGGER_UNSPECIFIED SettlementTrigger = 0
+	// SETTLEMENT_TRIGGER_WITHDRAW is a provider withdrawal.
+	SETTLEMENT_TRIGGER_WITHDRAW SettlementTrigger = 1
+	// SETTLEMENT_TRIGGER_CLOSE is the final settlement of MsgCloseLease.
+	SETTLEMENT_TRIGGER_CLOSE SettlementTrigger = 2
+	// SETTLEMENT_TRIGGER_AUTO_CLOSE is the final settlement of a lease closed by
+	// the module: credit exhaustion, grace period expiry or its scheduled end.
+	SETTLEMENT_TRIGGER_AUTO_CLOSE SettlementTrigger = 3
+	// SETTLEMENT_TRIGGER_ITEMS_CHANGED settles at the old prices before a
+	// quantity update or lease amendment takes effect.
+	SETTLEMENT_TRIGGER_ITEMS_CHANGED SettlementTrigger = 4
+	// SETTLEMENT_TRIGGER_GRACE_PERIOD settles a lease as it enters its grace
+	// period, or when the credit account is funded during it.
+	SETTLEMENT_TRIGGER_GRACE_PERIOD SettlementTrigger = 5
+	// SETTLEMENT_TRIGGER_DISPUTE settles a lease before a dispute starts
+	// escrowing its payments.
+	SETTLEMENT_TRIGGER_DISPUTE SettlementTrigger = 6
+	// SETTLEMENT_TRIGGER_USAGE charges reported usage of metered items.
+	SETTLEMENT_TRIGGER_USAGE SettlementTrigger = 7
+	// SETTLEMENT_TRIGGER_DEBT_PAYMENT pays grace period debt out of new credit.
+	SETTLEMENT_TRIGGER_DEBT_PAYMENT SettlementTrigger = 8
+)
+
+var SettlementTrigger_name = map[int32]string{
+	0: "SETTLEMENT_TRIGGER_UNSPECIFIED",
+	1: "SETTLEMENT_TRIGGER_WITHDRAW",
+	2: "SETTLEMENT_TRIGGER_CLOSE",
+	3: "SETTLEMENT_TRIGGER_AUTO_CLOSE",
+	4: "SETTLEMENT_TRIGGER_ITEMS_CHANGED",
+	5: "SETTLEMENT_TRIGGER_GRACE_PERIOD",
+	6: "SETTLEMENT_TRIGGER_DISPUTE",
+	7: "SETTLEMENT_TRIGGER_USAGE",
+	8: "SETTLEMENT_TRIGGER_DEBT_PAYMENT",
+}
+
+var SettlementTrigger_value = map[string]int32{
+	"SETTLEMENT_TRIGGER_UNSPECIFIED":   0,
+	"SETTLEMENT_TRIGGER_WITHDRAW":      1,
+	"SETTLEMENT_TRIGGER_CLOSE":         2,
+	"SETTLEMENT_TRIGGER_AUTO_CLOSE":    3,
+	"SETTLEMENT_TRIGGER_ITEMS_CHANGED": 4,
+	"SETTLEMENT_TRIGGER_GRACE_PERIOD":  5,
+	"SETTLEMENT_TRIGGER_DISPUTE":       6,
+	"SETTLEMENT_TRIGGER_USAGE":         7,
+	"SETTLEMENT_TRIGGER_DEBT_PAYMENT":  8,
+}
+
+func (x SettlementTrigger) String() string {
+	return proto.EnumName(SettlementTrigger_name, int32(x))
+}
+
+func (SettlementTrigger) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9636bb21eb29c389, []int{1}
+}
+
+// DisputeState defines the state of a lease dispute.
+type DisputeState int32
+
+const (
+	// DISPUTE_STATE_UNSPECIFIED is the default value.
+	DISPUTE_STATE_UNSPECIFIED DisputeState = 0
+	// DISPUTE_STATE_OPEN indicates the dispute awaits resolution. Amounts
+	// settled for the lease are held in escrow.
+	DISPUTE_STATE_OPEN DisputeState = 1
+	// DISPUTE_STATE_RESOLVED indicates the authority split the escrow between
+	// the tenant and the provider.
+	DISPUTE_STATE_RESOLVED DisputeState = 2
+	// DISPUTE_STATE_DEFAULTED indicates the provider did not respond in time and
+	// the escrow was returned to the tenant.
+	DISPUTE_STATE_DEFAULTED DisputeState = 3
+)
+
+var DisputeState_name = map[int32]string{
+	0: "DISPUTE_STATE_UNSPECIFIED",
+	1: "DISPUTE_STATE_OPEN",
+	2: "DISPUTE_STATE_RESOLVED",
+	3: "DISPUTE_STATE_DEFAULTED",
+}
+
+var DisputeState_value = map[string]int32{
+	"DISPUTE_STATE_UNSPECIFIED": 0,
+	"DISPUTE_STATE_OPEN":        1,
+	"DISPUTE_STATE_RESOLVED":    2,
+	"DISPUTE_STATE_DEFAULTED":   3,
+}
+
+func (x DisputeState) String() string {
+	return proto.EnumName(DisputeState_name, int32(x))
+}
+
+func (DisputeState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9636bb21eb29c389, []int{2}
+}
+
 // Params defines the parameters for the billing module.
 type Params struct {
 	// max_leases_per_tenant is the maximum number of active leases a tenant can have.
@@ -112,6 +212,43 @@ type Params struct {
 	// (e.g. `barney0.manifest0.net` itself). Tunable via MsgUpdateParams so new
 	// provider zones can be reserved without a chain upgrade.
 	ReservedDomainSuffixes []string `protobuf:"bytes,7,rep,name=reserved_domain_suffixes,json=reservedDomainSuffixes,proto3" json:"reserved_domain_suffixes,omitempty"`
+	// credit_withdrawal_cooldown is the notice period (in seconds) each deposit
+	// to a credit account is locked for before the tenant may withdraw or
+	// transfer it. This stops a tenant from depositing credit to pass a
+	// lease-creation check and pulling it back in the next block. Zero disables
+	// the cooldown. Default is 86400 (24 hours); maximum is 30 days.
+	CreditWithdrawalCooldown uint64 `protobuf:"varint,8,opt,name=credit_withdrawal_cooldown,json=creditWithdrawalCooldown,proto3" json:"credit_withdrawal_cooldown,omitempty,string"`
+	// grace_period is how long (in seconds) an ACTIVE lease keeps running after
+	// its tenant's credit is exhausted. During grace the lease accrues debt
+	// instead of being closed; MsgFundCredit pays the debt and ends the grace
+	// period, otherwise the lease is closed when it expires. It may be
+	// overridden per provider. Zero closes leases as soon as credit is
+	// exhausted. Default is 0; maximum is 30 days.
+	GracePeriod uint64 `protobuf:"varint,9,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty,string"`
+	// lease_retention_period is how long (in seconds) a CLOSED, REJECTED or
+	// EXPIRED lease is kept in state after it reached that state. Older leases
+	// are pruned by the EndBlocker, which first emits EventLeaseArchived with
+	// the full lease. Leases with unpaid debt are kept until the debt is paid.
+	// Zero keeps terminal leases forever. Default is 0; maximum is 10 years.
+	LeaseRetentionPeriod uint64 `protobuf:"varint,10,opt,name=lease_retention_period,json=leaseRetentionPeriod,proto3" json:"lease_retention_period,omitempty,string"`
+	// dispute_response_period is how long (in seconds) a provider has to
+	// respond to a dispute. A dispute left unanswered is resolved in the
+	// tenant's favor by the EndBlocker. Zero disables disputes. Default is
+	// 259200 (3 days); otherwise must be between 1 hour and 30 days.
+	DisputeResponsePeriod uint64 `protobuf:"varint,11,opt,name=dispute_response_period,json=disputeResponsePeriod,proto3" json:"dispute_response_period,omitempty,string"`
+	// reference_denom is the denom exchange rates are quoted in. When set,
+	// leases priced in the reference denom or a denom with a valid exchange
+	// rate can be paid from credit in any other such denom. Empty disables
+	// conversion.
+	ReferenceDenom string `protobuf:"bytes,12,opt,name=reference_denom,json=referenceDenom,proto3" json:"reference_denom,omitempty"`
+	// price_feeders is the list of addresses allowed to set exchange rates with
+	// MsgSetExchangeRates, in addition to the module authority.
+	PriceFeeders []string `protobuf:"bytes,13,rep,name=price_feeders,json=priceFeeders,proto3" json:"price_feeders,omitempty"`
+	// max_provider_grace_period is the longest grace period override (in
+	// seconds) that a provider or the authority can set in the provider's
+	// ProviderPolicy. Stored overrides are clamped to it when read. Zero
+	// disables overrides. Default is 0; maximum is 30 days.
+	MaxProviderGracePeriod uint64 `protobuf:"varint,14,opt,name=max_provider_grace_period,json=maxProviderGracePeriod,proto3" json:"max_provider_grace_period,omitempty,string"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -196,14 +333,66 @@ func (m *Params) GetReservedDomainSuffixes() []string {
 	return nil
 }
 
+func (m *Params) GetCreditWithdrawalCooldown() uint64 {
+	if m != nil {
+		return m.CreditWithdrawalCooldown
+	}
+	return 0
+}
+
+func (m *Params) GetGracePeriod() uint64 {
+	if m != nil {
+		return m.GracePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetLeaseRetentionPeriod() uint64 {
+	if m != nil {
+		return m.LeaseRetentionPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetDisputeResponsePeriod() uint64 {
+	if m != nil {
+		return m.DisputeResponsePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetReferenceDenom() string {
+	if m != nil {
+		return m.ReferenceDenom
+	}
+	return ""
+}
+
+func (m *Params) GetPriceFeeders() []string {
+	if m != nil {
+		return m.PriceFeeders
+	}
+	return nil
+}
+
+func (m *Params) GetMaxProviderGracePeriod() uint64 {
+	if m != nil {
+		return m.MaxProviderGracePeriod
+	}
+	return 0
+}
+
 // LeaseItem represents a single SKU item within a lease.
 type LeaseItem struct {
 	// sku_uuid is the UUID of the SKU being leased.
 	SkuUuid string `protobuf:"bytes,1,opt,name=sku_uuid,json=skuUuid,proto3" json:"sku_uuid,omitempty"`
 	// quantity is the number of instances of this SKU.
 	Quantity uint64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty,string"`
-	// locked_price is the price per unit locked at lease creation (per second).
-	// The denom comes from the SKU's base_price at lease creation time.
+	// locked_price is the price of one unit locked at lease creation. The denom
+	// comes from the SKU's base_price at lease creation time. For a time-billed
+	// item the amount is the per-second locked_rate rounded up to a whole unit
+	// and only informational; accrual and reservations use locked_rate. For a
+	// metered item it is the price per unit of reported usage instead.
 	LockedPrice types.Coin `protobuf:"bytes,3,opt,name=locked_price,json=lockedPrice,proto3" json:"locked_price"`
 	// service_name is an optional DNS-label identifier for this item within a stack deployment.
 	// Must be a valid RFC 1123 DNS label: 1-63 lowercase alphanumeric characters or hyphens,
@@ -227,6 +416,23 @@ type LeaseItem struct {
 	// migrate this (and service_name) into x/deployment via a state migration.
 	// Tracked: ENG-80.
 	CustomDomain string `protobuf:"bytes,5,opt,name=custom_domain,json=customDomain,proto3" json:"custom_domain,omitempty"`
+	// metered is true when the item's SKU is billed per unit of usage
+	// (UNIT_PER_USAGE). Metered items do not accrue over time; they are charged
+	// when the provider reports usage with MsgReportUsage.
+	Metered bool `protobuf:"varint,6,opt,name=metered,proto3" json:"metered,omitempty"`
+	// locked_rate is the exact per-second price per unit of a time-billed item,
+	// in locked_price's denom: the SKU's price for the tenant's volume tier
+	// divided by the seconds in its unit. It is zero for metered items.
+	LockedRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=locked_rate,json=lockedRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"locked_rate"`
+	// calendar_month is true when the item's SKU is billed per calendar month
+	// (UNIT_PER_MONTH). It accrues monthly_price over each month's actual
+	// length, and locked_rate is the highest rate that gives, in a 28-day
+	// month, used for reservations.
+	CalendarMonth bool `protobuf:"varint,8,opt,name=calendar_month,json=calendarMonth,proto3" json:"calendar_month,omitempty"`
+	// monthly_price is the price of one unit per UTC calendar month of a
+	// calendar_month item, in locked_price's denom. It is unset for other
+	// items.
+	MonthlyPrice types.Coin `protobuf:"bytes,9,opt,name=monthly_price,json=monthlyPrice,proto3" json:"monthly_price,omitempty"`
 }
 
 func (m *LeaseItem) Reset()         { *m = LeaseItem{} }
@@ -297,6 +503,27 @@ func (m *LeaseItem) GetCustomDomain() string {
 	return ""
 }
 
+func (m *LeaseItem) GetMetered() bool {
+	if m != nil {
+		return m.Metered
+	}
+	return false
+}
+
+func (m *LeaseItem) GetCalendarMonth() bool {
+	if m != nil {
+		return m.CalendarMonth
+	}
+	return false
+}
+
+func (m *LeaseItem) GetMonthlyPrice() types.Coin {
+	if m != nil {
+		return m.MonthlyPrice
+	}
+	return types.Coin{}
+}
+
 // Lease represents a billing lease between a tenant and provider.
 type Lease struct {
 	// uuid is the unique identifier of the lease (UUIDv7 format).
@@ -339,8 +566,36 @@ type Lease struct {
 	// min_lease_duration_at_creation stores the min_lease_duration parameter value
 	// at the time this lease was created. This ensures consistent reservation
 	// calculation regardless of subsequent parameter changes.
-	// reservation = sum(locked_price × quantity) × min_lease_duration_at_creation
+	// reservation = sum(ceil(locked_rate × quantity × min_lease_duration_at_creation))
 	MinLeaseDurationAtCreation uint64 `protobuf:"varint,15,opt,name=min_lease_duration_at_creation,json=minLeaseDurationAtCreation,proto3" json:"min_lease_duration_at_creation,omitempty,string"`
+	// scheduled_end_at is the optional time at which an ACTIVE lease is settled
+	// and closed by the EndBlocker, with closure_reason "scheduled end".
+	// Set at creation or later by the tenant; it can only be moved later.
+	ScheduledEndAt *time.Time `protobuf:"bytes,16,opt,name=scheduled_end_at,json=scheduledEndAt,proto3,stdtime" json:"scheduled_end_at,omitempty"`
+	// grace_ends_at is set when the tenant's credit ran out while the lease was
+	// ACTIVE and a grace period applied. The lease keeps accruing, as debt, and
+	// is closed at this time unless the tenant funds the credit account first.
+	// It is kept on the lease after closure for reference.
+	GraceEndsAt *time.Time `protobuf:"bytes,17,opt,name=grace_ends_at,json=graceEndsAt,proto3,stdtime" json:"grace_ends_at,omitempty"`
+	// debt is the amount accrued during a grace period that the credit account
+	// could not cover. It is owed to the provider and paid first out of the next
+	// MsgFundCredit, including after the lease has been closed.
+	Debt github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,18,rep,name=debt,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"debt,omitempty"`
+	// disputed is true while the tenant's dispute of the lease is open. Amounts
+	// settled for the lease go to the dispute escrow instead of the provider,
+	// and provider withdrawals of the lease are refused.
+	Disputed bool `protobuf:"varint,19,opt,name=disputed,proto3" json:"disputed,omitempty"`
+	// total_settled is the sum of the transferred amounts of every
+	// LeaseSettlement recorded for the lease, including amounts escrowed while
+	// it was disputed. Leases settled before settlements were recorded only
+	// count what has been settled since.
+	TotalSettled github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,20,rep,name=total_settled,json=totalSettled,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_settled,omitempty"`
+	// accrual_carry is the fraction of a unit, per denom, that accrued since
+	// the lease started but has not been charged yet because settlements only
+	// transfer whole units. It is added to the next settlement's accrual, so
+	// truncation neither loses nor creates value across settlements. Each
+	// amount is below one.
+	AccrualCarry github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,21,rep,name=accrual_carry,json=accrualCarry,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"accrual_carry,omitempty"`
 }
 
 func (m *Lease) Reset()         { *m = Lease{} }
@@ -481,29 +736,85 @@ func (m *Lease) GetMinLeaseDurationAtCreation() uint64 {
 	return 0
 }
 
-// CustomDomainTarget identifies which lease + item a custom_domain resolves to.
-// Used as the value type of the CustomDomainIndex reverse-lookup map so that
-// queries by domain return the routing target without iterating lease items.
-type CustomDomainTarget struct {
-	// lease_uuid is the UUID of the lease that holds the domain claim.
+func (m *Lease) GetScheduledEndAt() *time.Time {
+	if m != nil {
+		return m.ScheduledEndAt
+	}
+	return nil
+}
+
+func (m *Lease) GetGraceEndsAt() *time.Time {
+	if m != nil {
+		return m.GraceEndsAt
+	}
+	return nil
+}
+
+func (m *Lease) GetDebt() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Debt
+	}
+	return nil
+}
+
+func (m *Lease) GetDisputed() bool {
+	if m != nil {
+		return m.Disputed
+	}
+	return false
+}
+
+func (m *Lease) GetTotalSettled() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalSettled
+	}
+	return nil
+}
+
+func (m *Lease) GetAccrualCarry() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.AccrualCarry
+	}
+	return nil
+}
+
+// LeaseAmendment is a tenant-proposed change to the item list of an ACTIVE
+// lease. It waits for the provider to acknowledge or reject it and expires
+// after params.pending_timeout. At most one amendment is pending per lease.
+type LeaseAmendment struct {
+	// lease_uuid is the UUID of the lease being amended.
 	LeaseUuid string `protobuf:"bytes,1,opt,name=lease_uuid,json=leaseUuid,proto3" json:"lease_uuid,omitempty"`
-	// service_name is the addressing key of the LeaseItem within that lease.
-	// For a 1-item legacy lease (item.service_name == ""), this is "".
-	ServiceName string `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// tenant is the address of the lease owner who proposed the amendment.
+	Tenant string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// provider_uuid is the provider that must approve the amendment
+	// (denormalized from the lease).
+	ProviderUuid string `protobuf:"bytes,3,opt,name=provider_uuid,json=providerUuid,proto3" json:"provider_uuid,omitempty"`
+	// add_items are the items appended to the lease on acknowledgement. Their
+	// locked_price is the SKU price at the time the amendment was proposed.
+	AddItems []LeaseItem `protobuf:"bytes,4,rep,name=add_items,json=addItems,proto3" json:"add_items"`
+	// remove_items identifies the lease items dropped on acknowledgement: the
+	// service_name for service-name mode leases, the sku_uuid for legacy leases.
+	RemoveItems []string `protobuf:"bytes,5,rep,name=remove_items,json=removeItems,proto3" json:"remove_items,omitempty"`
+	// reserved_amounts is the credit reserved for add_items while the amendment
+	// is pending. It is folded into the lease reservation on acknowledgement and
+	// released on rejection or expiry.
+	ReservedAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=reserved_amounts,json=reservedAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reserved_amounts"`
+	// created_at is the block time at which the amendment was proposed.
+	CreatedAt time.Time `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
 }
 
-func (m *CustomDomainTarget) Reset()         { *m = CustomDomainTarget{} }
-func (m *CustomDomainTarget) String() string { return proto.CompactTextString(m) }
-func (*CustomDomainTarget) ProtoMessage()    {}
-func (*CustomDomainTarget) Descriptor() ([]byte, []int) {
+func (m *LeaseAmendment) Reset()         { *m = LeaseAmendment{} }
+func (m *LeaseAmendment) String() string { return proto.CompactTextString(m) }
+func (*LeaseAmendment) ProtoMessage()    {}
+func (*LeaseAmendment) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9636bb21eb29c389, []int{3}
 }
-func (m *CustomDomainTarget) XXX_Unmarshal(b []byte) error {
+func (m *LeaseAmendment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CustomDomainTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *LeaseAmendment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CustomDomainTarget.Marshal(b, m, deterministic)
+		return xxx_messageInfo_LeaseAmendment.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -513,62 +824,91 @@ func (m *CustomDomainTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *CustomDomainTarget) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CustomDomainTarget.Merge(m, src)
+func (m *LeaseAmendment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaseAmendment.Merge(m, src)
 }
-func (m *CustomDomainTarget) XXX_Size() int {
+func (m *LeaseAmendment) XXX_Size() int {
 	return m.Size()
 }
-func (m *CustomDomainTarget) XXX_DiscardUnknown() {
-	xxx_messageInfo_CustomDomainTarget.DiscardUnknown(m)
+func (m *LeaseAmendment) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaseAmendment.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CustomDomainTarget proto.InternalMessageInfo
+var xxx_messageInfo_LeaseAmendment proto.InternalMessageInfo
 
-func (m *CustomDomainTarget) GetLeaseUuid() string {
+func (m *LeaseAmendment) GetLeaseUuid() string {
 	if m != nil {
 		return m.LeaseUuid
 	}
 	return ""
 }
 
-func (m *CustomDomainTarget) GetServiceName() string {
+func (m *LeaseAmendment) GetTenant() string {
 	if m != nil {
-		return m.ServiceName
+		return m.Tenant
 	}
 	return ""
 }
 
-// CreditAccount represents a tenant's credit account.
-// The actual balance is tracked by the bank module at the credit_address.
-type CreditAccount struct {
-	// tenant is the address of the tenant who owns this credit account.
-	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
-	// credit_address is the derived address where credit funds are held.
-	CreditAddress string `protobuf:"bytes,2,opt,name=credit_address,json=creditAddress,proto3" json:"credit_address,omitempty"`
-	// active_lease_count tracks the number of active leases for O(1) count queries.
-	ActiveLeaseCount uint64 `protobuf:"varint,3,opt,name=active_lease_count,json=activeLeaseCount,proto3" json:"active_lease_count,omitempty,string"`
-	// pending_lease_count tracks the number of pending leases for O(1) count queries.
-	// Used to enforce max_pending_leases_per_tenant limit.
-	PendingLeaseCount uint64 `protobuf:"varint,4,opt,name=pending_lease_count,json=pendingLeaseCount,proto3" json:"pending_lease_count,omitempty,string"`
-	// reserved_amounts holds the sum of all credit reservations for active and pending leases.
-	// Each lease reserves: rate_per_second × min_lease_duration for each denom.
-	// This prevents overbooking by ensuring credit availability before lease creation.
-	ReservedAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=reserved_amounts,json=reservedAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reserved_amounts"`
+func (m *LeaseAmendment) GetProviderUuid() string {
+	if m != nil {
+		return m.ProviderUuid
+	}
+	return ""
 }
 
-func (m *CreditAccount) Reset()         { *m = CreditAccount{} }
-func (m *CreditAccount) String() string { return proto.CompactTextString(m) }
-func (*CreditAccount) ProtoMessage()    {}
-func (*CreditAccount) Descriptor() ([]byte, []int) {
+func (m *LeaseAmendment) GetAddItems() []LeaseItem {
+	if m != nil {
+		return m.AddItems
+	}
+	return nil
+}
+
+func (m *LeaseAmendment) GetRemoveItems() []string {
+	if m != nil {
+		return m.RemoveItems
+	}
+	return nil
+}
+
+func (m *LeaseAmendment) GetReservedAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ReservedAmounts
+	}
+	return nil
+}
+
+func (m *LeaseAmendment) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+// UsageCounter is the cumulative usage charged for one metered lease item.
+type UsageCounter struct {
+	// sku_uuid is the SKU of the metered item.
+	SkuUuid string `protobuf:"bytes,1,opt,name=sku_uuid,json=skuUuid,proto3" json:"sku_uuid,omitempty"`
+	// service_name identifies the item in service-name mode leases.
+	ServiceName string `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// counter is the total usage charged for the item since the lease started.
+	// Reported usage above it did not fit the spend limit or the credit yet and
+	// is charged by a later report.
+	Counter uint64 `protobuf:"varint,3,opt,name=counter,proto3" json:"counter,omitempty,string"`
+}
+
+func (m *UsageCounter) Reset()         { *m = UsageCounter{} }
+func (m *UsageCounter) String() string { return proto.CompactTextString(m) }
+func (*UsageCounter) ProtoMessage()    {}
+func (*UsageCounter) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9636bb21eb29c389, []int{4}
 }
-func (m *CreditAccount) XXX_Unmarshal(b []byte) error {
+func (m *UsageCounter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CreditAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UsageCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CreditAccount.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UsageCounter.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -578,769 +918,6717 @@ func (m *CreditAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *CreditAccount) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreditAccount.Merge(m, src)
+func (m *UsageCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsageCounter.Merge(m, src)
 }
-func (m *CreditAccount) XXX_Size() int {
+func (m *UsageCounter) XXX_Size() int {
 	return m.Size()
 }
-func (m *CreditAccount) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreditAccount.DiscardUnknown(m)
+func (m *UsageCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsageCounter.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreditAccount proto.InternalMessageInfo
+var xxx_messageInfo_UsageCounter proto.InternalMessageInfo
 
-func (m *CreditAccount) GetTenant() string {
+func (m *UsageCounter) GetSkuUuid() string {
 	if m != nil {
-		return m.Tenant
+		return m.SkuUuid
 	}
 	return ""
 }
 
-func (m *CreditAccount) GetCreditAddress() string {
+func (m *UsageCounter) GetServiceName() string {
 	if m != nil {
-		return m.CreditAddress
+		return m.ServiceName
 	}
 	return ""
 }
 
-func (m *CreditAccount) GetActiveLeaseCount() uint64 {
+func (m *UsageCounter) GetCounter() uint64 {
 	if m != nil {
-		return m.ActiveLeaseCount
+		return m.Counter
 	}
 	return 0
 }
 
-func (m *CreditAccount) GetPendingLeaseCount() uint64 {
+// LeaseUsage is the metering state of a lease with metered items: the usage
+// counters reported by the provider and the tenant's spend limit.
+type LeaseUsage struct {
+	// lease_uuid is the UUID of the metered lease.
+	LeaseUuid string `protobuf:"bytes,1,opt,name=lease_uuid,json=leaseUuid,proto3" json:"lease_uuid,omitempty"`
+	// counters holds the charged counter of each metered item.
+	Counters []UsageCounter `protobuf:"bytes,2,rep,name=counters,proto3" json:"counters"`
+	// spend_limit is the most usage charges the tenant allows per spend period.
+	// Usage beyond the limit is held back, not charged, until a later period
+	// or a higher limit makes room. Without a limit no usage is charged.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// spend_period is the length of a spend period in seconds.
+	SpendPeriod uint64 `protobuf:"varint,4,opt,name=spend_period,json=spendPeriod,proto3" json:"spend_period,omitempty,string"`
+	// period_started_at is the start of the current spend period.
+	PeriodStartedAt time.Time `protobuf:"bytes,5,opt,name=period_started_at,json=periodStartedAt,proto3,stdtime" json:"period_started_at"`
+	// period_spent is the usage charged in the current spend period.
+	PeriodSpent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=period_spent,json=periodSpent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_spent"`
+}
+
+func (m *LeaseUsage) Reset()         { *m = LeaseUsage{} }
+func (m *LeaseUsage) String() string { return proto.CompactTextString(m) }
+func (*LeaseUsage) ProtoMessage()    {}
+func (*LeaseUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9636bb21eb29c389, []int{5}
+}
+func (m *LeaseUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LeaseUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LeaseUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LeaseUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaseUsage.Merge(m, src)
+}
+func (m *LeaseUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *LeaseUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaseUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaseUsage proto.InternalMessageInfo
+
+func (m *LeaseUsage) GetLeaseUuid() string {
 	if m != nil {
-		return m.PendingLeaseCount
+		return m.LeaseUuid
 	}
-	return 0
+	return ""
 }
 
-func (m *CreditAccount) GetReservedAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *LeaseUsage) GetCounters() []UsageCounter {
 	if m != nil {
-		return m.ReservedAmounts
+		return m.Counters
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("liftedinit.billing.v1.LeaseState", LeaseState_name, LeaseState_value)
-	proto.RegisterType((*Params)(nil), "liftedinit.billing.v1.Params")
-	proto.RegisterType((*LeaseItem)(nil), "liftedinit.billing.v1.LeaseItem")
-	proto.RegisterType((*Lease)(nil), "liftedinit.billing.v1.Lease")
-	proto.RegisterType((*CustomDomainTarget)(nil), "liftedinit.billing.v1.CustomDomainTarget")
-	proto.RegisterType((*CreditAccount)(nil), "liftedinit.billing.v1.CreditAccount")
+func (m *LeaseUsage) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("liftedinit/billing/v1/types.proto", fileDescriptor_9636bb21eb29c389) }
+func (m *LeaseUsage) GetSpendPeriod() uint64 {
+	if m != nil {
+		return m.SpendPeriod
+	}
+	return 0
+}
 
-var fileDescriptor_9636bb21eb29c389 = []byte{
-	// 1436 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x5f, 0x6f, 0x1b, 0xc5,
-	0x16, 0x8f, 0x13, 0x27, 0x8d, 0xc7, 0xf9, 0xe3, 0x4e, 0xd2, 0x64, 0x93, 0xb6, 0xde, 0x24, 0xb7,
-	0xea, 0x8d, 0xaa, 0xd6, 0x56, 0xd2, 0x4a, 0x57, 0x37, 0x57, 0x57, 0xc2, 0x7f, 0x16, 0x08, 0x0a,
-	0xc1, 0xd8, 0x0e, 0xad, 0x8a, 0xd0, 0x32, 0xd9, 0x9d, 0x38, 0x43, 0xbc, 0xbb, 0x66, 0x67, 0x36,
-	0x49, 0xbf, 0x01, 0xaa, 0x78, 0xa8, 0xc4, 0x17, 0x40, 0xe2, 0x0d, 0x5e, 0x78, 0x80, 0xef, 0xd0,
-	0x17, 0xa4, 0x8a, 0x27, 0x9e, 0xb6, 0xa8, 0x7d, 0x00, 0xad, 0xc4, 0x77, 0x40, 0xf3, 0xc7, 0xf6,
-	0x7a, 0xed, 0x26, 0x20, 0x5e, 0xea, 0xce, 0xf9, 0xfd, 0xce, 0x6f, 0xce, 0x9c, 0x39, 0xe7, 0xec,
-	0x04, 0xac, 0xb7, 0xc9, 0x11, 0xc3, 0x36, 0x71, 0x09, 0x2b, 0x1e, 0x92, 0x76, 0x9b, 0xb8, 0xad,
-	0xe2, 0xe9, 0x56, 0x91, 0x3d, 0xe9, 0x60, 0x5a, 0xe8, 0xf8, 0x1e, 0xf3, 0xe0, 0xb5, 0x3e, 0xa5,
-	0xa0, 0x28, 0x85, 0xd3, 0xad, 0xd5, 0xab, 0xc8, 0x21, 0xae, 0x57, 0x14, 0xff, 0x4a, 0xe6, 0x6a,
-	0xde, 0xf2, 0xa8, 0xe3, 0xd1, 0xe2, 0x21, 0xa2, 0xb8, 0x78, 0xba, 0x75, 0x88, 0x19, 0xda, 0x2a,
-	0x5a, 0x1e, 0x71, 0x15, 0xbe, 0x22, 0x71, 0x53, 0xac, 0x8a, 0x72, 0xa1, 0xa0, 0xc5, 0x96, 0xd7,
-	0xf2, 0xa4, 0x9d, 0xff, 0x4f, 0x59, 0xf5, 0x96, 0xe7, 0xb5, 0xda, 0xb8, 0x28, 0x56, 0x87, 0xc1,
-	0x51, 0x91, 0x11, 0x07, 0x53, 0x86, 0x9c, 0x8e, 0x24, 0x6c, 0xfc, 0x38, 0x09, 0xa6, 0x6a, 0xc8,
-	0x47, 0x0e, 0x85, 0x9f, 0x80, 0x6b, 0x0e, 0x3a, 0x37, 0xdb, 0x18, 0x51, 0x4c, 0xcd, 0x0e, 0xf6,
-	0x4d, 0x86, 0x5d, 0xe4, 0x32, 0x2d, 0xb5, 0x96, 0xda, 0x4c, 0x97, 0xef, 0x44, 0xa1, 0x7e, 0x7b,
-	0x24, 0xe1, 0xae, 0xe7, 0x10, 0x86, 0x9d, 0x0e, 0x7b, 0x72, 0x97, 0x32, 0x9f, 0xb8, 0xad, 0x3a,
-	0x74, 0xd0, 0xf9, 0x9e, 0xa0, 0xd5, 0xb0, 0xdf, 0x14, 0x24, 0x78, 0x00, 0x66, 0x50, 0xbb, 0xed,
-	0x9d, 0x61, 0xdb, 0x6c, 0x13, 0xca, 0xb4, 0xf1, 0xb5, 0x89, 0xcd, 0x4c, 0x79, 0x3b, 0x0a, 0xf5,
-	0xa5, 0xb8, 0xbd, 0x2f, 0xf6, 0xf3, 0x0f, 0xf7, 0x16, 0xd5, 0x11, 0x4b, 0xb6, 0xed, 0x63, 0x4a,
-	0x1b, 0x52, 0x3d, 0xab, 0xf8, 0x7b, 0x84, 0x32, 0xf8, 0x10, 0x2c, 0xf0, 0xa0, 0xb8, 0x8f, 0x8c,
-	0x49, 0x84, 0xa7, 0x4d, 0x88, 0x98, 0x37, 0xa3, 0x50, 0xbf, 0x35, 0x02, 0x1e, 0x8e, 0x38, 0xe7,
-	0xa0, 0xf3, 0x5d, 0x4e, 0xaa, 0x61, 0x5f, 0x44, 0x0e, 0x0f, 0x00, 0x74, 0x88, 0x2b, 0xf9, 0xa6,
-	0x1d, 0xf8, 0x88, 0x11, 0xcf, 0xd5, 0xd2, 0x42, 0xf7, 0xdf, 0x51, 0xa8, 0xff, 0x6b, 0x18, 0x1d,
-	0x25, 0x4b, 0x5c, 0x21, 0x57, 0x55, 0x14, 0xc8, 0xc0, 0x4d, 0x1e, 0x50, 0x07, 0xbb, 0x36, 0x71,
-	0x5b, 0x23, 0xb2, 0x3d, 0x29, 0x76, 0xe0, 0x79, 0x29, 0x5c, 0x48, 0x1c, 0xde, 0x6c, 0xc5, 0x41,
-	0xe7, 0x35, 0x49, 0x4f, 0x26, 0xff, 0x7d, 0x30, 0xdf, 0x15, 0xe2, 0x15, 0xe0, 0x05, 0x4c, 0x9b,
-	0x12, 0xfb, 0xdc, 0x8a, 0x42, 0x7d, 0x2d, 0x01, 0x0d, 0x2b, 0xcf, 0x29, 0x46, 0x53, 0x12, 0xe0,
-	0xa7, 0x40, 0xf3, 0x31, 0xc5, 0xfe, 0x29, 0xb6, 0x4d, 0xdb, 0x73, 0x10, 0x71, 0x4d, 0x1a, 0x1c,
-	0x1d, 0x91, 0x73, 0x4c, 0xb5, 0x2b, 0xe2, 0x5e, 0x6f, 0x47, 0xa1, 0xbe, 0xf1, 0x26, 0x4e, 0x7f,
-	0x83, 0xfa, 0x52, 0x97, 0x53, 0x15, 0x94, 0x86, 0x62, 0xec, 0xe4, 0x7f, 0xff, 0x5a, 0x4f, 0x3d,
-	0xfd, 0xed, 0xfb, 0x3b, 0xaa, 0x79, 0x7a, 0xbd, 0x25, 0x8b, 0x75, 0xe3, 0x8f, 0x71, 0x90, 0x11,
-	0x87, 0xe4, 0x97, 0x06, 0xb7, 0xc0, 0x34, 0x3d, 0x09, 0xcc, 0x20, 0x20, 0xb6, 0xa8, 0xd6, 0x4c,
-	0x79, 0x29, 0x0a, 0x75, 0xd8, 0xb5, 0xc5, 0xf6, 0xbb, 0x42, 0x4f, 0x82, 0x83, 0x80, 0xd8, 0xf0,
-	0xbf, 0x60, 0xfa, 0xf3, 0x00, 0xb9, 0x8c, 0xb0, 0x27, 0xda, 0xb8, 0x48, 0xc5, 0xcd, 0x28, 0xd4,
-	0x57, 0xba, 0xb6, 0xe1, 0x1c, 0xf4, 0xe8, 0xb0, 0x0e, 0x66, 0xda, 0x9e, 0x75, 0x82, 0x6d, 0xb3,
-	0xe3, 0x13, 0x4b, 0xd6, 0x5a, 0x76, 0x7b, 0xa5, 0xa0, 0x8a, 0x95, 0x37, 0x6f, 0x41, 0x35, 0x6f,
-	0xa1, 0xe2, 0x11, 0xb7, 0xbc, 0xf8, 0x3c, 0xd4, 0xc7, 0xa2, 0x50, 0x1f, 0x70, 0xab, 0x67, 0xe5,
-	0xaa, 0xc6, 0x17, 0xf0, 0xff, 0x60, 0x86, 0xe7, 0x81, 0x58, 0xd8, 0x74, 0x91, 0x83, 0x45, 0x9d,
-	0x65, 0xca, 0xab, 0xbc, 0x3b, 0xe2, 0xf6, 0xd8, 0x49, 0xb2, 0xca, 0xbe, 0x8f, 0x1c, 0x0c, 0xdf,
-	0x02, 0xb3, 0x56, 0x40, 0x99, 0xe7, 0xa8, 0x54, 0x8b, 0x2a, 0xca, 0x94, 0xaf, 0x47, 0xa1, 0xbe,
-	0x3c, 0x00, 0xc4, 0x04, 0x66, 0x24, 0x20, 0x13, 0xbf, 0x73, 0x93, 0x27, 0x5b, 0x4b, 0x24, 0xbb,
-	0x97, 0xe1, 0x8d, 0x9f, 0x32, 0x60, 0x52, 0xf6, 0xc5, 0x6d, 0x90, 0x8e, 0xe5, 0x19, 0x46, 0xa1,
-	0x3e, 0x97, 0xc8, 0xb1, 0xc0, 0x61, 0x15, 0x4c, 0xa9, 0x8a, 0x1e, 0x17, 0xcc, 0xbb, 0x51, 0xa8,
-	0xe7, 0x92, 0xa5, 0xfb, 0xc6, 0x1e, 0x57, 0xbe, 0xfc, 0x60, 0x1d, 0xdf, 0x3b, 0x25, 0x36, 0xf6,
-	0xe5, 0xf5, 0x4e, 0xf4, 0x0f, 0x36, 0x00, 0xc4, 0x0f, 0xd6, 0x05, 0xc4, 0x45, 0x1b, 0x60, 0x52,
-	0x74, 0xbf, 0x96, 0x5e, 0x9b, 0xd8, 0xcc, 0x6e, 0xaf, 0x15, 0x46, 0x4e, 0xe3, 0x42, 0xef, 0xa8,
-	0xe5, 0x59, 0x75, 0x5b, 0xd2, 0xad, 0x2e, 0x7f, 0xe0, 0x1e, 0x98, 0xa4, 0x0c, 0x31, 0x2c, 0x32,
-	0x3b, 0xb7, 0xbd, 0x7e, 0x91, 0x4c, 0x83, 0x13, 0xcb, 0x0b, 0x51, 0xa8, 0xcf, 0x0b, 0x9f, 0x58,
-	0x6c, 0x52, 0x04, 0x7e, 0x08, 0x80, 0xe5, 0x63, 0xc4, 0xb0, 0x6d, 0x22, 0xd9, 0x8a, 0xd9, 0xed,
-	0xd5, 0x82, 0x1c, 0xd6, 0x85, 0xee, 0xb0, 0x2e, 0x34, 0xbb, 0xc3, 0xba, 0xbc, 0xa4, 0x62, 0x8a,
-	0x79, 0x3d, 0x7b, 0xa9, 0xa7, 0xea, 0x19, 0xb5, 0x2e, 0x31, 0xd8, 0x04, 0x19, 0xab, 0xed, 0x51,
-	0xa9, 0x78, 0xe5, 0x52, 0x45, 0x9e, 0xc1, 0x85, 0x9e, 0x43, 0x3f, 0x42, 0x21, 0x3b, 0x2d, 0x81,
-	0x12, 0x83, 0x26, 0x98, 0x6f, 0x23, 0xca, 0x4c, 0x8a, 0x19, 0x6b, 0x4b, 0xed, 0xe9, 0xcb, 0xb5,
-	0x55, 0xb4, 0x49, 0x57, 0xa1, 0x3d, 0xcb, 0x8d, 0x0d, 0x69, 0x2b, 0x31, 0x78, 0x04, 0xe6, 0x91,
-	0x75, 0xe2, 0x7a, 0x67, 0x6d, 0x6c, 0xb7, 0xe4, 0x06, 0x99, 0x4b, 0x37, 0x58, 0xe7, 0xad, 0x9a,
-	0x70, 0x4b, 0x1c, 0x61, 0x2e, 0x0e, 0x97, 0x18, 0x7c, 0x0c, 0xb2, 0x3e, 0xfe, 0x0c, 0x5b, 0x2a,
-	0xe5, 0xe0, 0xd2, 0x3d, 0xf8, 0x38, 0xb8, 0x16, 0x73, 0x49, 0xe8, 0x83, 0x2e, 0x54, 0x62, 0x70,
-	0x17, 0xe4, 0xe4, 0x8a, 0x78, 0xae, 0xe9, 0x63, 0x44, 0x3d, 0x57, 0xcb, 0x8a, 0x3a, 0xcd, 0x47,
-	0xa1, 0xbe, 0x9a, 0xc4, 0x62, 0xe5, 0x30, 0xdf, 0xc3, 0xea, 0x02, 0x82, 0x0f, 0x01, 0xc0, 0xe7,
-	0x1d, 0xe2, 0xcb, 0x28, 0x67, 0x2e, 0x8d, 0xf2, 0x46, 0x14, 0xea, 0x8b, 0x7d, 0x8f, 0x44, 0x90,
-	0x19, 0x85, 0x94, 0x18, 0xac, 0x80, 0x39, 0x7e, 0xa9, 0x81, 0x8f, 0xbb, 0x11, 0xce, 0x8a, 0x08,
-	0xb9, 0x80, 0x36, 0x88, 0xc4, 0xe2, 0x9b, 0x55, 0x88, 0x8a, 0xee, 0x01, 0xc8, 0x38, 0x98, 0x21,
-	0xf3, 0x18, 0xd1, 0x63, 0x6d, 0x6e, 0x2d, 0xb5, 0x39, 0x53, 0x5e, 0xe6, 0x75, 0xd4, 0x33, 0xc6,
-	0x5c, 0xa7, 0xb9, 0xf1, 0x5d, 0x44, 0x8f, 0xe1, 0x19, 0xc8, 0x0f, 0x7f, 0x2b, 0x4d, 0xc4, 0x4c,
-	0x51, 0xbc, 0xfc, 0xab, 0x3a, 0x2f, 0x06, 0xf0, 0xfd, 0x28, 0xd4, 0x8b, 0x17, 0x33, 0x87, 0xc7,
-	0xf2, 0x6a, 0xf2, 0x0b, 0x5b, 0x62, 0x15, 0x45, 0xde, 0x59, 0xe1, 0x33, 0x6d, 0x71, 0xd4, 0x4c,
-	0xdb, 0xf8, 0x32, 0x05, 0x60, 0x25, 0x36, 0xff, 0x9a, 0xc8, 0x6f, 0x61, 0x06, 0xff, 0x03, 0x80,
-	0xdc, 0x3c, 0x36, 0xe2, 0x34, 0x9e, 0xe2, 0xbe, 0x35, 0x76, 0xc4, 0x8c, 0xb0, 0x8a, 0x29, 0x93,
-	0x9c, 0xdf, 0xe3, 0x7f, 0x6b, 0x7e, 0x6f, 0x7c, 0x95, 0x06, 0xb3, 0x15, 0x1f, 0xdb, 0x84, 0x95,
-	0x2c, 0xcb, 0x0b, 0x5c, 0x16, 0x1b, 0x9f, 0xa9, 0x7f, 0x30, 0x3e, 0x3f, 0x06, 0x73, 0x96, 0x90,
-	0x35, 0x91, 0xc4, 0x55, 0x60, 0x0f, 0xc4, 0xad, 0x0f, 0x20, 0x7f, 0x41, 0x75, 0x56, 0x7a, 0x28,
-	0x23, 0x7f, 0x21, 0x21, 0x8b, 0x91, 0x53, 0xac, 0x2e, 0x4c, 0x04, 0xae, 0x5e, 0x5e, 0xe2, 0x85,
-	0x34, 0x8c, 0x8e, 0x78, 0x21, 0x49, 0x92, 0xb8, 0x95, 0x8a, 0x38, 0xf9, 0x23, 0xb0, 0x30, 0xf0,
-	0xe8, 0x51, 0xba, 0xe9, 0xfe, 0x8b, 0x6e, 0x04, 0x3c, 0x2c, 0x7c, 0xb5, 0x13, 0x7b, 0x0a, 0x49,
-	0xe5, 0xa7, 0x29, 0xde, 0xa8, 0xea, 0x4d, 0x82, 0x1c, 0x6e, 0xa3, 0xda, 0xa4, 0xf8, 0x2c, 0x5c,
-	0xf0, 0xf5, 0xae, 0xaa, 0x69, 0x36, 0xe4, 0xfa, 0xed, 0x4b, 0x7d, 0xb3, 0x45, 0xd8, 0x71, 0x70,
-	0x58, 0xb0, 0x3c, 0x47, 0x3d, 0xc7, 0xd5, 0xcf, 0x3d, 0x6a, 0x9f, 0xa8, 0x3f, 0x02, 0xb8, 0x08,
-	0xe5, 0x9d, 0x2e, 0xbd, 0x4b, 0xd2, 0x79, 0x67, 0x9d, 0x17, 0xe7, 0x8d, 0x44, 0x71, 0x0e, 0xd4,
-	0xc0, 0x9d, 0xef, 0x52, 0x00, 0xf4, 0x3f, 0x28, 0xf0, 0x3a, 0x58, 0xde, 0x33, 0x4a, 0x0d, 0xc3,
-	0x6c, 0x34, 0x4b, 0x4d, 0xc3, 0x3c, 0xd8, 0x6f, 0xd4, 0x8c, 0xca, 0xee, 0xdb, 0xbb, 0x46, 0x35,
-	0x37, 0x06, 0x97, 0xc1, 0x42, 0x1c, 0xac, 0x19, 0xfb, 0xd5, 0xdd, 0xfd, 0x77, 0x72, 0x29, 0xb8,
-	0x04, 0x60, 0x1c, 0x28, 0x55, 0x9a, 0xbb, 0x1f, 0x19, 0xb9, 0xf1, 0xa4, 0xbd, 0xb2, 0xf7, 0x41,
-	0xc3, 0xa8, 0xe6, 0x26, 0xa0, 0x06, 0x16, 0xe3, 0xf6, 0xba, 0xf1, 0x9e, 0x51, 0x69, 0x1a, 0xd5,
-	0x5c, 0x3a, 0xb9, 0x85, 0xf1, 0xa8, 0xb6, 0x5b, 0x37, 0xaa, 0xb9, 0xc9, 0xd5, 0xf4, 0x17, 0xdf,
-	0xe4, 0xc7, 0xca, 0x07, 0xcf, 0x5f, 0xe5, 0x53, 0x2f, 0x5e, 0xe5, 0x53, 0xbf, 0xbe, 0xca, 0xa7,
-	0x9e, 0xbd, 0xce, 0x8f, 0xbd, 0x78, 0x9d, 0x1f, 0xfb, 0xe5, 0x75, 0x7e, 0xec, 0xf1, 0xff, 0x62,
-	0x59, 0x72, 0x90, 0x4b, 0x8e, 0x30, 0x65, 0xf7, 0x5c, 0xcc, 0xce, 0x3c, 0xff, 0xa4, 0x6f, 0x10,
-	0xa3, 0xda, 0x2f, 0x9e, 0xf7, 0x92, 0x21, 0xd2, 0x77, 0x38, 0x25, 0xa6, 0xde, 0xfd, 0x3f, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0x06, 0x2c, 0x37, 0x25, 0x69, 0x0d, 0x00, 0x00,
+func (m *LeaseUsage) GetPeriodStartedAt() time.Time {
+	if m != nil {
+		return m.PeriodStartedAt
+	}
+	return time.Time{}
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *LeaseUsage) GetPeriodSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodSpent
 	}
+	return nil
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.MaxLeasesPerTenant != that1.MaxLeasesPerTenant {
-		return false
-	}
-	if len(this.AllowedList) != len(that1.AllowedList) {
-		return false
-	}
-	for i := range this.AllowedList {
-		if this.AllowedList[i] != that1.AllowedList[i] {
-			return false
+// LeaseSettlement records one transfer of a lease's charges out of the
+// tenant's credit account. Records are kept until the lease is pruned.
+type LeaseSettlement struct {
+	// id is the chain-wide sequence number of the settlement.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty,string"`
+	// lease_uuid is the UUID of the settled lease.
+	LeaseUuid string `protobuf:"bytes,2,opt,name=lease_uuid,json=leaseUuid,proto3" json:"lease_uuid,omitempty"`
+	// trigger is what caused the settlement.
+	Trigger SettlementTrigger `protobuf:"varint,3,opt,name=trigger,proto3,enum=liftedinit.billing.v1.SettlementTrigger" json:"trigger"`
+	// period_start is the start of the settled accrual period. It equals
+	// period_end for usage charges and debt payments.
+	PeriodStart time.Time `protobuf:"bytes,4,opt,name=period_start,json=periodStart,proto3,stdtime" json:"period_start"`
+	// period_end is the end of the settled accrual period. It is earlier than
+	// settled_at when a lease is closed at the time its credit ran out.
+	PeriodEnd time.Time `protobuf:"bytes,5,opt,name=period_end,json=periodEnd,proto3,stdtime" json:"period_end"`
+	// settled_at is the block time of the settlement.
+	SettledAt time.Time `protobuf:"bytes,6,opt,name=settled_at,json=settledAt,proto3,stdtime" json:"settled_at"`
+	// accrued is the amount charged for the period. It is empty for debt
+	// payments, whose charge was recorded as shortfall when it accrued.
+	Accrued github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=accrued,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"accrued"`
+	// transferred is the amount taken from the credit account, to the provider
+	// or to the dispute escrow.
+	Transferred github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=transferred,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"transferred"`
+	// shortfall is the part of accrued the credit account could not cover. It
+	// became debt if the lease was in its grace period and was forgiven
+	// otherwise.
+	Shortfall github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=shortfall,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"shortfall"`
+	// promo is the part of transferred paid out of promotional credit grants
+	// rather than the credit account.
+	Promo github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=promo,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"promo"`
+	// converted is the part of transferred paid in denoms other than the one
+	// accrued, at the exchange rates of the block.
+	Converted github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=converted,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"converted"`
+	// converted_value is what converted paid for, in the accrued denoms. The
+	// accrued amount equals transferred - converted + converted_value +
+	// shortfall.
+	ConvertedValue github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=converted_value,json=convertedValue,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"converted_value"`
+}
+
+func (m *LeaseSettlement) Reset()         { *m = LeaseSettlement{} }
+func (m *LeaseSettlement) String() string { return proto.CompactTextString(m) }
+func (*LeaseSettlement) ProtoMessage()    {}
+func (*LeaseSettlement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9636bb21eb29c389, []int{6}
+}
+func (m *LeaseSettlement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LeaseSettlement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LeaseSettlement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.MaxItemsPerLease != that1.MaxItemsPerLease {
-		return false
-	}
-	if this.MinLeaseDuration != that1.MinLeaseDuration {
-		return false
+}
+func (m *LeaseSettlement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaseSettlement.Merge(m, src)
+}
+func (m *LeaseSettlement) XXX_Size() int {
+	return m.Size()
+}
+func (m *LeaseSettlement) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaseSettlement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaseSettlement proto.InternalMessageInfo
+
+func (m *LeaseSettlement) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if this.MaxPendingLeasesPerTenant != that1.MaxPendingLeasesPerTenant {
-		return false
+	return 0
+}
+
+func (m *LeaseSettlement) GetLeaseUuid() string {
+	if m != nil {
+		return m.LeaseUuid
 	}
-	if this.PendingTimeout != that1.PendingTimeout {
-		return false
+	return ""
+}
+
+func (m *LeaseSettlement) GetTrigger() SettlementTrigger {
+	if m != nil {
+		return m.Trigger
 	}
-	if len(this.ReservedDomainSuffixes) != len(that1.ReservedDomainSuffixes) {
-		return false
+	return SETTLEMENT_TRIGGER_UNSPECIFIED
+}
+
+func (m *LeaseSettlement) GetPeriodStart() time.Time {
+	if m != nil {
+		return m.PeriodStart
 	}
-	for i := range this.ReservedDomainSuffixes {
-		if this.ReservedDomainSuffixes[i] != that1.ReservedDomainSuffixes[i] {
-			return false
-		}
+	return time.Time{}
+}
+
+func (m *LeaseSettlement) GetPeriodEnd() time.Time {
+	if m != nil {
+		return m.PeriodEnd
 	}
-	return true
+	return time.Time{}
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *LeaseSettlement) GetSettledAt() time.Time {
+	if m != nil {
+		return m.SettledAt
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *LeaseSettlement) GetAccrued() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Accrued
+	}
+	return nil
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ReservedDomainSuffixes) > 0 {
-		for iNdEx := len(m.ReservedDomainSuffixes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ReservedDomainSuffixes[iNdEx])
-			copy(dAtA[i:], m.ReservedDomainSuffixes[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.ReservedDomainSuffixes[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
-		}
+func (m *LeaseSettlement) GetTransferred() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Transferred
 	}
-	if m.PendingTimeout != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.PendingTimeout))
-		i--
-		dAtA[i] = 0x30
+	return nil
+}
+
+func (m *LeaseSettlement) GetShortfall() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Shortfall
 	}
-	if m.MaxPendingLeasesPerTenant != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.MaxPendingLeasesPerTenant))
-		i--
-		dAtA[i] = 0x28
+	return nil
+}
+
+func (m *LeaseSettlement) GetPromo() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Promo
 	}
-	if m.MinLeaseDuration != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.MinLeaseDuration))
-		i--
-		dAtA[i] = 0x20
+	return nil
+}
+
+func (m *LeaseSettlement) GetConverted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Converted
 	}
-	if m.MaxItemsPerLease != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.MaxItemsPerLease))
-		i--
-		dAtA[i] = 0x18
+	return nil
+}
+
+func (m *LeaseSettlement) GetConvertedValue() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ConvertedValue
 	}
-	if len(m.AllowedList) > 0 {
-		for iNdEx := len(m.AllowedList) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AllowedList[iNdEx])
-			copy(dAtA[i:], m.AllowedList[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.AllowedList[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+	return nil
+}
+
+// Dispute is a tenant's claim that a lease was not delivered as paid for.
+// A lease can be disputed once; the record is kept until the lease is pruned.
+type Dispute struct {
+	// lease_uuid is the UUID of the disputed lease.
+	LeaseUuid string `protobuf:"bytes,1,opt,name=lease_uuid,json=leaseUuid,proto3" json:"lease_uuid,omitempty"`
+	// tenant is the address of the lease owner who opened the dispute.
+	Tenant string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// provider_uuid is the provider of the lease (denormalized from the lease).
+	ProviderUuid string `protobuf:"bytes,3,opt,name=provider_uuid,json=providerUuid,proto3" json:"provider_uuid,omitempty"`
+	// state is the current state of the dispute.
+	State DisputeState `protobuf:"varint,4,opt,name=state,proto3,enum=liftedinit.billing.v1.DisputeState" json:"state,omitempty"`
+	// reason is the tenant's description of the problem. Maximum 256 characters.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// escrowed is the amount settled for the lease while the dispute is open,
+	// held by the dispute escrow account.
+	Escrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=escrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"escrowed"`
+	// opened_at is the block time at which the dispute was opened.
+	OpenedAt time.Time `protobuf:"bytes,7,opt,name=opened_at,json=openedAt,proto3,stdtime" json:"opened_at"`
+	// response_deadline is the time by which the provider must respond.
+	ResponseDeadline time.Time `protobuf:"bytes,8,opt,name=response_deadline,json=responseDeadline,proto3,stdtime" json:"response_deadline"`
+	// response is the provider's answer to the dispute. Maximum 256 characters.
+	Response string `protobuf:"bytes,9,opt,name=response,proto3" json:"response,omitempty"`
+	// responded_at is the block time at which the provider responded.
+	RespondedAt *time.Time `protobuf:"bytes,10,opt,name=responded_at,json=respondedAt,proto3,stdtime" json:"responded_at,omitempty"`
+	// resolved_at is the block time at which the dispute was resolved or
+	// defaulted.
+	ResolvedAt *time.Time `protobuf:"bytes,11,opt,name=resolved_at,json=resolvedAt,proto3,stdtime" json:"resolved_at,omitempty"`
+	// tenant_amounts is the part of the escrow returned to the tenant's credit
+	// account.
+	TenantAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=tenant_amounts,json=tenantAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tenant_amounts"`
+	// provider_amounts is the part of the escrow paid to the provider.
+	ProviderAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,13,rep,name=provider_amounts,json=providerAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"provider_amounts"`
+}
+
+func (m *Dispute) Reset()         { *m = Dispute{} }
+func (m *Dispute) String() string { return proto.CompactTextString(m) }
+func (*Dispute) ProtoMessage()    {}
+func (*Dispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9636bb21eb29c389, []int{7}
+}
+func (m *Dispute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Dispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Dispute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.MaxLeasesPerTenant != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.MaxLeasesPerTenant))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *Dispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Dispute.Merge(m, src)
+}
+func (m *Dispute) XXX_Size() int {
+	return m.Size()
+}
+func (m *Dispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_Dispute.DiscardUnknown(m)
 }
 
-func (m *LeaseItem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Dispute proto.InternalMessageInfo
+
+func (m *Dispute) GetLeaseUuid() string {
+	if m != nil {
+		return m.LeaseUuid
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *LeaseItem) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Dispute) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
 }
 
-func (m *LeaseItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CustomDomain) > 0 {
-		i -= len(m.CustomDomain)
-		copy(dAtA[i:], m.CustomDomain)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.CustomDomain)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ServiceName) > 0 {
-		i -= len(m.ServiceName)
-		copy(dAtA[i:], m.ServiceName)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ServiceName)))
-		i--
-		dAtA[i] = 0x22
+func (m *Dispute) GetProviderUuid() string {
+	if m != nil {
+		return m.ProviderUuid
 	}
-	{
-		size, err := m.LockedPrice.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *Dispute) GetState() DisputeState {
+	if m != nil {
+		return m.State
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.Quantity != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Quantity))
-		i--
-		dAtA[i] = 0x10
+	return DISPUTE_STATE_UNSPECIFIED
+}
+
+func (m *Dispute) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if len(m.SkuUuid) > 0 {
-		i -= len(m.SkuUuid)
-		copy(dAtA[i:], m.SkuUuid)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.SkuUuid)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *Dispute) GetEscrowed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Escrowed
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Lease) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Dispute) GetOpenedAt() time.Time {
+	if m != nil {
+		return m.OpenedAt
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *Lease) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Dispute) GetResponseDeadline() time.Time {
+	if m != nil {
+		return m.ResponseDeadline
+	}
+	return time.Time{}
 }
 
-func (m *Lease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MinLeaseDurationAtCreation != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.MinLeaseDurationAtCreation))
-		i--
-		dAtA[i] = 0x78
+func (m *Dispute) GetResponse() string {
+	if m != nil {
+		return m.Response
 	}
-	if len(m.MetaHash) > 0 {
-		i -= len(m.MetaHash)
-		copy(dAtA[i:], m.MetaHash)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.MetaHash)))
-		i--
-		dAtA[i] = 0x72
+	return ""
+}
+
+func (m *Dispute) GetRespondedAt() *time.Time {
+	if m != nil {
+		return m.RespondedAt
 	}
-	if len(m.ClosureReason) > 0 {
-		i -= len(m.ClosureReason)
-		copy(dAtA[i:], m.ClosureReason)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ClosureReason)))
-		i--
-		dAtA[i] = 0x6a
+	return nil
+}
+
+func (m *Dispute) GetResolvedAt() *time.Time {
+	if m != nil {
+		return m.ResolvedAt
 	}
-	if m.ExpiredAt != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiredAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiredAt):])
-		if err2 != nil {
-			return 0, err2
-		}
-		i -= n2
-		i = encodeVarintTypes(dAtA, i, uint64(n2))
-		i--
-		dAtA[i] = 0x62
+	return nil
+}
+
+func (m *Dispute) GetTenantAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TenantAmounts
 	}
-	if len(m.RejectionReason) > 0 {
-		i -= len(m.RejectionReason)
-		copy(dAtA[i:], m.RejectionReason)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.RejectionReason)))
-		i--
-		dAtA[i] = 0x5a
+	return nil
+}
+
+func (m *Dispute) GetProviderAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ProviderAmounts
 	}
-	if m.RejectedAt != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.RejectedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RejectedAt):])
-		if err3 != nil {
-			return 0, err3
+	return nil
+}
+
+// ProviderPolicy holds the lease handling settings a provider chooses for its
+// own leases.
+type ProviderPolicy struct {
+	// provider_uuid is the provider the policy applies to.
+	ProviderUuid string `protobuf:"bytes,1,opt,name=provider_uuid,json=providerUuid,proto3" json:"provider_uuid,omitempty"`
+	// pending_timeout overrides params.pending_timeout for the provider's
+	// PENDING leases, in seconds. Zero keeps params.pending_timeout.
+	PendingTimeout uint64 `protobuf:"varint,2,opt,name=pending_timeout,json=pendingTimeout,proto3" json:"pending_timeout,omitempty,string"`
+	// auto_acknowledge creates the provider's leases directly in the ACTIVE
+	// state, without waiting for MsgAcknowledgeLease.
+	AutoAcknowledge bool `protobuf:"varint,3,opt,name=auto_acknowledge,json=autoAcknowledge,proto3" json:"auto_acknowledge,omitempty"`
+	// allowed_tenants, when not empty, are the only tenants that may create
+	// leases with the provider.
+	AllowedTenants []string `protobuf:"bytes,4,rep,name=allowed_tenants,json=allowedTenants,proto3" json:"allowed_tenants,omitempty"`
+	// denied_tenants may not create leases with the provider.
+	DeniedTenants []string `protobuf:"bytes,5,rep,name=denied_tenants,json=deniedTenants,proto3" json:"denied_tenants,omitempty"`
+	// override_grace_period applies grace_period to the provider's leases in
+	// place of params.grace_period. It can only be set while
+	// params.max_provider_grace_period is not zero.
+	OverrideGracePeriod bool `protobuf:"varint,6,opt,name=override_grace_period,json=overrideGracePeriod,proto3" json:"override_grace_period,omitempty"`
+	// grace_period is the provider's grace period in seconds, used when
+	// override_grace_period is set; zero then disables grace for the
+	// provider's leases. It is clamped to params.max_provider_grace_period when
+	// read, and must be zero when override_grace_period is not set.
+	GracePeriod uint64 `protobuf:"varint,7,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty,string"`
+}
+
+func (m *ProviderPolicy) Reset()         { *m = ProviderPolicy{} }
+func (m *ProviderPolicy) String() string { return proto.CompactTextString(m) }
+func (*ProviderPolicy) ProtoMessage()    {}
+func (*ProviderPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9636bb21eb29c389, []int{8}
+}
+func (m *ProviderPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n3
-		i = encodeVarintTypes(dAtA, i, uint64(n3))
-		i--
-		dAtA[i] = 0x52
+		return b[:n], nil
 	}
-	if m.AcknowledgedAt != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.AcknowledgedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.AcknowledgedAt):])
-		if err4 != nil {
-			return 0, err4
-		}
-		i -= n4
-		i = encodeVarintTypes(dAtA, i, uint64(n4))
-		i--
-		dAtA[i] = 0x4a
+}
+func (m *ProviderPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderPolicy.Merge(m, src)
+}
+func (m *ProviderPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderPolicy proto.InternalMessageInfo
+
+func (m *ProviderPolicy) GetProviderUuid() string {
+	if m != nil {
+		return m.ProviderUuid
 	}
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastSettledAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastSettledAt):])
-	if err5 != nil {
-		return 0, err5
+	return ""
+}
+
+func (m *ProviderPolicy) GetPendingTimeout() uint64 {
+	if m != nil {
+		return m.PendingTimeout
 	}
-	i -= n5
-	i = encodeVarintTypes(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x42
-	if m.ClosedAt != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ClosedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClosedAt):])
-		if err6 != nil {
-			return 0, err6
-		}
-		i -= n6
-		i = encodeVarintTypes(dAtA, i, uint64(n6))
-		i--
-		dAtA[i] = 0x3a
+	return 0
+}
+
+func (m *ProviderPolicy) GetAutoAcknowledge() bool {
+	if m != nil {
+		return m.AutoAcknowledge
 	}
-	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
-	if err7 != nil {
-		return 0, err7
+	return false
+}
+
+func (m *ProviderPolicy) GetAllowedTenants() []string {
+	if m != nil {
+		return m.AllowedTenants
 	}
-	i -= n7
-	i = encodeVarintTypes(dAtA, i, uint64(n7))
-	i--
-	dAtA[i] = 0x32
-	if m.State != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.State))
-		i--
-		dAtA[i] = 0x28
+	return nil
+}
+
+func (m *ProviderPolicy) GetDeniedTenants() []string {
+	if m != nil {
+		return m.DeniedTenants
 	}
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+	return nil
+}
+
+func (m *ProviderPolicy) GetOverrideGracePeriod() bool {
+	if m != nil {
+		return m.OverrideGracePeriod
 	}
-	if len(m.ProviderUuid) > 0 {
-		i -= len(m.ProviderUuid)
-		copy(dAtA[i:], m.ProviderUuid)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ProviderUuid)))
-		i--
-		dAtA[i] = 0x1a
+	return false
+}
+
+func (m *ProviderPolicy) GetGracePeriod() uint64 {
+	if m != nil {
+		return m.GracePeriod
 	}
-	if len(m.Tenant) > 0 {
-		i -= len(m.Tenant)
-		copy(dAtA[i:], m.Tenant)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Tenant)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+// ProviderStats holds the lease counters of one provider, updated as its
+// leases change state. Durations are in seconds.
+type ProviderStats struct {
+	// provider_uuid is the provider the counters belong to.
+	ProviderUuid string `protobuf:"bytes,1,opt,name=provider_uuid,json=providerUuid,proto3" json:"provider_uuid,omitempty"`
+	// leases_created counts the leases created with the provider.
+	LeasesCreated uint64 `protobuf:"varint,2,opt,name=leases_created,json=leasesCreated,proto3" json:"leases_created,omitempty,string"`
+	// leases_acknowledged counts the leases that moved from PENDING to ACTIVE,
+	// including those auto-acknowledged at creation.
+	LeasesAcknowledged uint64 `protobuf:"varint,3,opt,name=leases_acknowledged,json=leasesAcknowledged,proto3" json:"leases_acknowledged,omitempty,string"`
+	// leases_rejected counts the PENDING leases the provider rejected.
+	LeasesRejected uint64 `protobuf:"varint,4,opt,name=leases_rejected,json=leasesRejected,proto3" json:"leases_rejected,omitempty,string"`
+	// leases_cancelled counts the PENDING leases their tenant cancelled.
+	LeasesCancelled uint64 `protobuf:"varint,5,opt,name=leases_cancelled,json=leasesCancelled,proto3" json:"leases_cancelled,omitempty,string"`
+	// leases_expired counts the PENDING leases that expired unacknowledged.
+	LeasesExpired uint64 `protobuf:"varint,6,opt,name=leases_expired,json=leasesExpired,proto3" json:"leases_expired,omitempty,string"`
+	// leases_closed counts the ACTIVE leases that were closed, for any reason.
+	LeasesClosed uint64 `protobuf:"varint,7,opt,name=leases_closed,json=leasesClosed,proto3" json:"leases_closed,omitempty,string"`
+	// credit_exhaustion_closures counts the closures of leases whose tenant ran
+	// out of credit. They are included in leases_closed.
+	CreditExhaustionClosures uint64 `protobuf:"varint,8,opt,name=credit_exhaustion_closures,json=creditExhaustionClosures,proto3" json:"credit_exhaustion_closures,omitempty,string"`
+	// ack_latency_sum is the sum over acknowledged leases, other than those
+	// counted in leases_acknowledged_at_creation, of the time between creation
+	// and acknowledgement.
+	AckLatencySum uint64 `protobuf:"varint,9,opt,name=ack_latency_sum,json=ackLatencySum,proto3" json:"ack_latency_sum,omitempty,string"`
+	// ack_latency_buckets counts the acknowledged leases per latency bucket,
+	// leaving out those counted in leases_acknowledged_at_creation. Entry i
+	// counts latencies up to AckLatencyBucketBounds()[i] that do not fit an
+	// earlier bucket; the last entry counts those above every bound.
+	AckLatencyBuckets []uint64 `protobuf:"varint,10,rep,packed,name=ack_latency_buckets,json=ackLatencyBuckets,proto3" json:"ack_latency_buckets,omitempty"`
+	// lifetime_sum is the sum over closed leases of the time between
+	// acknowledgement and closure.
+	LifetimeSum uint64 `protobuf:"varint,11,opt,name=lifetime_sum,json=lifetimeSum,proto3" json:"lifetime_sum,omitempty,string"`
+	// leases_acknowledged_at_creation counts the leases acknowledged in the
+	// block that created them, which includes every lease auto-acknowledged by
+	// the provider's policy. They are included in leases_acknowledged but not in
+	// the latency sum and buckets, which measure the provider's response time.
+	LeasesAcknowledgedAtCreation uint64 `protobuf:"varint,12,opt,name=leases_acknowledged_at_creation,json=leasesAcknowledgedAtCreation,proto3" json:"leases_acknowledged_at_creation,omitempty,string"`
+}
+
+func (m *ProviderStats) Reset()         { *m = ProviderStats{} }
+func (m *ProviderStats) String() string { return proto.CompactTextString(m) }
+func (*ProviderStats) ProtoMessage()    {}
+func (*ProviderStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9636bb21eb29c389, []int{9}
+}
+func (m *ProviderStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Uuid) > 0 {
-		i -= len(m.Uuid)
-		copy(dAtA[i:], m.Uuid)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Uuid)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ProviderStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderStats.Merge(m, src)
+}
+func (m *ProviderStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderStats proto.InternalMessageInfo
+
+func (m *ProviderStats) GetProviderUuid() string {
+	if m != nil {
+		return m.ProviderUuid
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *CustomDomainTarget) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProviderStats) GetLeasesCreated() uint64 {
+	if m != nil {
+		return m.LeasesCreated
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *CustomDomainTarget) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ProviderStats) GetLeasesAcknowledged() uint64 {
+	if m != nil {
+		return m.LeasesAcknowledged
+	}
+	return 0
 }
 
-func (m *CustomDomainTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ServiceName) > 0 {
-		i -= len(m.ServiceName)
-		copy(dAtA[i:], m.ServiceName)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ServiceName)))
-		i--
-		dAtA[i] = 0x12
+func (m *ProviderStats) GetLeasesRejected() uint64 {
+	if m != nil {
+		return m.LeasesRejected
 	}
-	if len(m.LeaseUuid) > 0 {
-		i -= len(m.LeaseUuid)
-		copy(dAtA[i:], m.LeaseUuid)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.LeaseUuid)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *ProviderStats) GetLeasesCancelled() uint64 {
+	if m != nil {
+		return m.LeasesCancelled
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *CreditAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProviderStats) GetLeasesExpired() uint64 {
+	if m != nil {
+		return m.LeasesExpired
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *CreditAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ProviderStats) GetLeasesClosed() uint64 {
+	if m != nil {
+		return m.LeasesClosed
+	}
+	return 0
 }
 
-func (m *CreditAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ReservedAmounts) > 0 {
-		for iNdEx := len(m.ReservedAmounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReservedAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+func (m *ProviderStats) GetCreditExhaustionClosures() uint64 {
+	if m != nil {
+		return m.CreditExhaustionClosures
 	}
-	if m.PendingLeaseCount != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.PendingLeaseCount))
-		i--
-		dAtA[i] = 0x20
+	return 0
+}
+
+func (m *ProviderStats) GetAckLatencySum() uint64 {
+	if m != nil {
+		return m.AckLatencySum
 	}
-	if m.ActiveLeaseCount != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ActiveLeaseCount))
-		i--
-		dAtA[i] = 0x18
+	return 0
+}
+
+func (m *ProviderStats) GetAckLatencyBuckets() []uint64 {
+	if m != nil {
+		return m.AckLatencyBuckets
 	}
-	if len(m.CreditAddress) > 0 {
-		i -= len(m.CreditAddress)
-		copy(dAtA[i:], m.CreditAddress)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.CreditAddress)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *ProviderStats) GetLifetimeSum() uint64 {
+	if m != nil {
+		return m.LifetimeSum
 	}
-	if len(m.Tenant) > 0 {
-		i -= len(m.Tenant)
-		copy(dAtA[i:], m.Tenant)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Tenant)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *ProviderStats) GetLeasesAcknowledgedAtCreation() uint64 {
+	if m != nil {
+		return m.LeasesAcknowledgedAtCreation
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+// CustomDomainTarget identifies which lease + item a custom_domain resolves to.
+// Used as the value type of the CustomDomainIndex reverse-lookup map so that
+// queries by domain return the routing target without iterating lease items.
+type CustomDomainTarget struct {
+	// lease_uuid is the UUID of the lease that holds the domain claim.
+	LeaseUuid string `protobuf:"bytes,1,opt,name=lease_uuid,json=leaseUuid,proto3" json:"lease_uuid,omitempty"`
+	// service_name is the addressing key of the LeaseItem within that lease.
+	// For a 1-item legacy lease (item.service_name == ""), this is "".
+	ServiceName string `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+}
+
+func (m *CustomDomainTarget) Reset()         { *m = CustomDomainTarget{} }
+func (m *CustomDomainTarget) String() string { return proto.CompactTextString(m) }
+func (*CustomDomainTarget) ProtoMessage()    {}
+func (*CustomDomainTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9636bb21eb29c389, []int{10}
+}
+func (m *CustomDomainTarget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CustomDomainTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CustomDomainTarget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CustomDomainTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomDomainTarget.Merge(m, src)
+}
+func (m *CustomDomainTarget) XXX_Size() int {
+	return m.Size()
+}
+func (m *CustomDomainTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomDomainTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomDomainTarget proto.InternalMessageInfo
+
+func (m *CustomDomainTarget) GetLeaseUuid() string {
+	if m != nil {
+		return m.LeaseUuid
 	}
-	var l int
-	_ = l
-	if m.MaxLeasesPerTenant != 0 {
-		n += 1 + sovTypes(uint64(m.MaxLeasesPerTenant))
+	return ""
+}
+
+func (m *CustomDomainTarget) GetServiceName() string {
+	if m != nil {
+		return m.ServiceName
 	}
-	if len(m.AllowedList) > 0 {
-		for _, s := range m.AllowedList {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+// CreditAccount represents a tenant's credit account.
+// The actual balance is tracked by the bank module at the credit_address.
+type CreditAccount struct {
+	// tenant is the address of the tenant who owns this credit account.
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// credit_address is the derived address where credit funds are held.
+	CreditAddress string `protobuf:"bytes,2,opt,name=credit_address,json=creditAddress,proto3" json:"credit_address,omitempty"`
+	// active_lease_count tracks the number of active leases for O(1) count queries.
+	ActiveLeaseCount uint64 `protobuf:"varint,3,opt,name=active_lease_count,json=activeLeaseCount,proto3" json:"active_lease_count,omitempty,string"`
+	// pending_lease_count tracks the number of pending leases for O(1) count queries.
+	// Used to enforce max_pending_leases_per_tenant limit.
+	PendingLeaseCount uint64 `protobuf:"varint,4,opt,name=pending_lease_count,json=pendingLeaseCount,proto3" json:"pending_lease_count,omitempty,string"`
+	// reserved_amounts holds the sum of all credit reservations for active and pending leases.
+	// Each lease reserves: rate_per_second × min_lease_duration for each denom.
+	// This prevents overbooking by ensuring credit availability before lease creation.
+	ReservedAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=reserved_amounts,json=reservedAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reserved_amounts"`
+}
+
+func (m *CreditAccount) Reset()         { *m = CreditAccount{} }
+func (m *CreditAccount) String() string { return proto.CompactTextString(m) }
+func (*CreditAccount) ProtoMessage()    {}
+func (*CreditAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9636bb21eb29c389, []int{11}
+}
+func (m *CreditAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreditAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreditAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.MaxItemsPerLease != 0 {
-		n += 1 + sovTypes(uint64(m.MaxItemsPerLease))
-	}
-	if m.MinLeaseDuration != 0 {
-		n += 1 + sovTypes(uint64(m.MinLeaseDuration))
-	}
-	if m.MaxPendingLeasesPerTenant != 0 {
-		n += 1 + sovTypes(uint64(m.MaxPendingLeasesPerTenant))
+}
+func (m *CreditAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreditAccount.Merge(m, src)
+}
+func (m *CreditAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreditAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreditAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreditAccount proto.InternalMessageInfo
+
+func (m *CreditAccount) GetTenant() string {
+	if m != nil {
+		return m.Tenant
 	}
-	if m.PendingTimeout != 0 {
-		n += 1 + sovTypes(uint64(m.PendingTimeout))
+	return ""
+}
+
+func (m *CreditAccount) GetCreditAddress() string {
+	if m != nil {
+		return m.CreditAddress
 	}
-	if len(m.ReservedDomainSuffixes) > 0 {
-		for _, s := range m.ReservedDomainSuffixes {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	return ""
+}
+
+func (m *CreditAccount) GetActiveLeaseCount() uint64 {
+	if m != nil {
+		return m.ActiveLeaseCount
 	}
-	return n
+	return 0
 }
 
-func (m *LeaseItem) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreditAccount) GetPendingLeaseCount() uint64 {
+	if m != nil {
+		return m.PendingLeaseCount
 	}
-	var l int
-	_ = l
-	l = len(m.SkuUuid)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *CreditAccount) GetReservedAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ReservedAmounts
 	}
-	if m.Quantity != 0 {
-		n += 1 + sovTypes(uint64(m.Quantity))
+	return nil
+}
+
+// CreditDeposit is credit that reached a tenant's credit account less than
+// params.credit_withdrawal_cooldown ago through MsgFundCredit from any
+// sender or MsgTransferCredit. It cannot be withdrawn or
+// transferred until the cooldown has passed since deposited_at, but it can
+// back leases straight away. Inflows are grouped per denom and hour, so
+// deposited_at is the end of the hour they arrived in.
+type CreditDeposit struct {
+	// tenant is the address of the tenant whose credit account was funded.
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// amount is the credit that arrived in the hour.
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// deposited_at is the end of the hour the credit arrived in. The credit
+	// unlocks credit_withdrawal_cooldown seconds later.
+	DepositedAt time.Time `protobuf:"bytes,3,opt,name=deposited_at,json=depositedAt,proto3,stdtime" json:"deposited_at"`
+}
+
+func (m *CreditDeposit) Reset()         { *m = CreditDeposit{} }
+func (m *CreditDeposit) String() string { return proto.CompactTextString(m) }
+func (*CreditDeposit) ProtoMessage()    {}
+func (*CreditDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9636bb21eb29c389, []int{12}
+}
+func (m *CreditDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreditDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreditDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.LockedPrice.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.ServiceName)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+}
+func (m *CreditDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreditDeposit.Merge(m, src)
+}
+func (m *CreditDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreditDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreditDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreditDeposit proto.InternalMessageInfo
+
+func (m *CreditDeposit) GetTenant() string {
+	if m != nil {
+		return m.Tenant
 	}
-	l = len(m.CustomDomain)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *CreditDeposit) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return n
+	return types.Coin{}
 }
 
-func (m *Lease) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreditDeposit) GetDepositedAt() time.Time {
+	if m != nil {
+		return m.DepositedAt
 	}
-	var l int
-	_ = l
-	l = len(m.Uuid)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return time.Time{}
+}
+
+// PromoGrant is promotional credit granted to a tenant by the authority. It
+// is held by the promo escrow account, not the tenant's credit address, so it
+// can only be spent by settlement: it cannot be withdrawn or transferred.
+type PromoGrant struct {
+	// id is the chain-wide sequence number of the grant.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty,string"`
+	// tenant is the address of the tenant the grant was made to.
+	Tenant string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// granter is the authority that funded the grant. What is left of the
+	// grant at expiry is returned to it.
+	Granter string `protobuf:"bytes,3,opt,name=granter,proto3" json:"granter,omitempty"`
+	// amount is the amount originally granted.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// remaining is the part of amount not spent yet.
+	Remaining github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=remaining,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining"`
+	// created_at is the block time at which the grant was made.
+	CreatedAt time.Time `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	// expires_at is the time from which the grant can no longer be spent. The
+	// EndBlocker then reclaims what remains.
+	ExpiresAt time.Time `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+	// provider_uuids restricts the grant to leases of these providers. Empty
+	// means any provider.
+	ProviderUuids []string `protobuf:"bytes,8,rep,name=provider_uuids,json=providerUuids,proto3" json:"provider_uuids,omitempty"`
+	// sku_uuids restricts the grant to lease items of these SKUs. Empty means
+	// any SKU.
+	SkuUuids []string `protobuf:"bytes,9,rep,name=sku_uuids,json=skuUuids,proto3" json:"sku_uuids,omitempty"`
+}
+
+func (m *PromoGrant) Reset()         { *m = PromoGrant{} }
+func (m *PromoGrant) String() string { return proto.CompactTextString(m) }
+func (*PromoGrant) ProtoMessage()    {}
+func (*PromoGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9636bb21eb29c389, []int{13}
+}
+func (m *PromoGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PromoGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PromoGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Tenant)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+}
+func (m *PromoGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromoGrant.Merge(m, src)
+}
+func (m *PromoGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *PromoGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromoGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PromoGrant proto.InternalMessageInfo
+
+func (m *PromoGrant) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	l = len(m.ProviderUuid)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *PromoGrant) GetTenant() string {
+	if m != nil {
+		return m.Tenant
 	}
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	return ""
+}
+
+func (m *PromoGrant) GetGranter() string {
+	if m != nil {
+		return m.Granter
 	}
-	if m.State != 0 {
-		n += 1 + sovTypes(uint64(m.State))
+	return ""
+}
+
+func (m *PromoGrant) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
-	n += 1 + l + sovTypes(uint64(l))
-	if m.ClosedAt != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClosedAt)
-		n += 1 + l + sovTypes(uint64(l))
+	return nil
+}
+
+func (m *PromoGrant) GetRemaining() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Remaining
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastSettledAt)
-	n += 1 + l + sovTypes(uint64(l))
-	if m.AcknowledgedAt != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.AcknowledgedAt)
-		n += 1 + l + sovTypes(uint64(l))
+	return nil
+}
+
+func (m *PromoGrant) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if m.RejectedAt != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RejectedAt)
-		n += 1 + l + sovTypes(uint64(l))
+	return time.Time{}
+}
+
+func (m *PromoGrant) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	l = len(m.RejectionReason)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return time.Time{}
+}
+
+func (m *PromoGrant) GetProviderUuids() []string {
+	if m != nil {
+		return m.ProviderUuids
 	}
-	if m.ExpiredAt != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiredAt)
-		n += 1 + l + sovTypes(uint64(l))
+	return nil
+}
+
+func (m *PromoGrant) GetSkuUuids() []string {
+	if m != nil {
+		return m.SkuUuids
 	}
-	l = len(m.ClosureReason)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return nil
+}
+
+// ExchangeRate is the value of one unit of a denom in the reference denom of
+// the module params, usable between valid_from and valid_until.
+type ExchangeRate struct {
+	// denom is the denom the rate applies to.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate is the amount of reference denom one unit of denom is worth. It is
+	// always positive.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+	// valid_from is the first time the rate may be used.
+	ValidFrom time.Time `protobuf:"bytes,3,opt,name=valid_from,json=validFrom,proto3,stdtime" json:"valid_from"`
+	// valid_until is the time from which the rate may no longer be used.
+	ValidUntil time.Time `protobuf:"bytes,4,opt,name=valid_until,json=validUntil,proto3,stdtime" json:"valid_until"`
+	// set_by is the address of the price feeder or authority that set the
+	// rate.
+	SetBy string `protobuf:"bytes,5,opt,name=set_by,json=setBy,proto3" json:"set_by,omitempty"`
+	// set_at is the block time at which the rate was set.
+	SetAt time.Time `protobuf:"bytes,6,opt,name=set_at,json=setAt,proto3,stdtime" json:"set_at"`
+}
+
+func (m *ExchangeRate) Reset()         { *m = ExchangeRate{} }
+func (m *ExchangeRate) String() string { return proto.CompactTextString(m) }
+func (*ExchangeRate) ProtoMessage()    {}
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9636bb21eb29c389, []int{14}
+}
+func (m *ExchangeRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.MetaHash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+}
+func (m *ExchangeRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRate.Merge(m, src)
+}
+func (m *ExchangeRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeRate proto.InternalMessageInfo
+
+func (m *ExchangeRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if m.MinLeaseDurationAtCreation != 0 {
-		n += 1 + sovTypes(uint64(m.MinLeaseDurationAtCreation))
+	return ""
+}
+
+func (m *ExchangeRate) GetValidFrom() time.Time {
+	if m != nil {
+		return m.ValidFrom
 	}
-	return n
+	return time.Time{}
 }
 
-func (m *CustomDomainTarget) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExchangeRate) GetValidUntil() time.Time {
+	if m != nil {
+		return m.ValidUntil
 	}
-	var l int
-	_ = l
-	l = len(m.LeaseUuid)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return time.Time{}
+}
+
+func (m *ExchangeRate) GetSetBy() string {
+	if m != nil {
+		return m.SetBy
 	}
-	l = len(m.ServiceName)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *ExchangeRate) GetSetAt() time.Time {
+	if m != nil {
+		return m.SetAt
 	}
-	return n
+	return time.Time{}
 }
 
-func (m *CreditAccount) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterEnum("liftedinit.billing.v1.LeaseState", LeaseState_name, LeaseState_value)
+	proto.RegisterEnum("liftedinit.billing.v1.SettlementTrigger", SettlementTrigger_name, SettlementTrigger_value)
+	proto.RegisterEnum("liftedinit.billing.v1.DisputeState", DisputeState_name, DisputeState_value)
+	proto.RegisterType((*Params)(nil), "liftedinit.billing.v1.Params")
+	proto.RegisterType((*LeaseItem)(nil), "liftedinit.billing.v1.LeaseItem")
+	proto.RegisterType((*Lease)(nil), "liftedinit.billing.v1.Lease")
+	proto.RegisterType((*LeaseAmendment)(nil), "liftedinit.billing.v1.LeaseAmendment")
+	proto.RegisterType((*UsageCounter)(nil), "liftedinit.billing.v1.UsageCounter")
+	proto.RegisterType((*LeaseUsage)(nil), "liftedinit.billing.v1.LeaseUsage")
+	proto.RegisterType((*LeaseSettlement)(nil), "liftedinit.billing.v1.LeaseSettlement")
+	proto.RegisterType((*Dispute)(nil), "liftedinit.billing.v1.Dispute")
+	proto.RegisterType((*ProviderPolicy)(nil), "liftedinit.billing.v1.ProviderPolicy")
+	proto.RegisterType((*ProviderStats)(nil), "liftedinit.billing.v1.ProviderStats")
+	proto.RegisterType((*CustomDomainTarget)(nil), "liftedinit.billing.v1.CustomDomainTarget")
+	proto.RegisterType((*CreditAccount)(nil), "liftedinit.billing.v1.CreditAccount")
+	proto.RegisterType((*CreditDeposit)(nil), "liftedinit.billing.v1.CreditDeposit")
+	proto.RegisterType((*PromoGrant)(nil), "liftedinit.billing.v1.PromoGrant")
+	proto.RegisterType((*ExchangeRate)(nil), "liftedinit.billing.v1.ExchangeRate")
+}
+
+func init() { proto.RegisterFile("liftedinit/billing/v1/types.proto", fileDescriptor_9636bb21eb29c389) }
+
+var fileDescriptor_9636bb21eb29c389 = []byte{
+	// 3645 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0x4d, 0x6f, 0x23, 0xc9,
+	0x75, 0x43, 0x89, 0x94, 0xc8, 0xe2, 0x87, 0xa8, 0x92, 0x46, 0xd3, 0xd2, 0xcc, 0xb0, 0x35, 0x9c,
+	0xcd, 0xee, 0x64, 0x76, 0x47, 0xca, 0x8c, 0x17, 0x09, 0x56, 0x8e, 0x91, 0xe5, 0x47, 0x8f, 0x56,
+	0x8e, 0x46, 0xc3, 0xa5, 0xa8, 0x9d, 0xb5, 0x03, 0xa3, 0xd3, 0xea, 0x2e, 0x51, 0x6d, 0xb1, 0xbb,
+	0xe9, 0xae, 0xa2, 0x46, 0x82, 0x83, 0x9c, 0x0c, 0x24, 0x70, 0x72, 0x70, 0x90, 0xe4, 0x1e, 0x20,
+	0x01, 0x02, 0x24, 0x97, 0x1c, 0x7c, 0x0c, 0x82, 0x1c, 0x7d, 0x09, 0x60, 0xf8, 0x14, 0xf8, 0xc0,
+	0x4d, 0x76, 0x81, 0x24, 0x20, 0xf2, 0x23, 0x82, 0xfa, 0xe8, 0xee, 0x62, 0xb3, 0x35, 0x24, 0x77,
+	0x37, 0x48, 0x7c, 0x91, 0xd8, 0xef, 0xab, 0x5e, 0xbd, 0x7a, 0xf5, 0xea, 0xbd, 0x57, 0x05, 0x1e,
+	0xf4, 0xec, 0x33, 0x82, 0x2c, 0xdb, 0xb5, 0xc9, 0xee, 0xa9, 0xdd, 0xeb, 0xd9, 0x6e, 0x77, 0xf7,
+	0xf2, 0xe9, 0x2e, 0xb9, 0xee, 0x23, 0xbc, 0xd3, 0xf7, 0x3d, 0xe2, 0xc1, 0xdb, 0x11, 0xc9, 0x8e,
+	0x20, 0xd9, 0xb9, 0x7c, 0xba, 0xb5, 0x6a, 0x38, 0xb6, 0xeb, 0xed, 0xb2, 0xbf, 0x9c, 0x72, 0xab,
+	0x62, 0x7a, 0xd8, 0xf1, 0xf0, 0xee, 0xa9, 0x81, 0xd1, 0xee, 0xe5, 0xd3, 0x53, 0x44, 0x8c, 0xa7,
+	0xbb, 0xa6, 0x67, 0xbb, 0x02, 0xbf, 0xc9, 0xf1, 0x3a, 0xfb, 0xda, 0xe5, 0x1f, 0x02, 0xb5, 0xde,
+	0xf5, 0xba, 0x1e, 0x87, 0xd3, 0x5f, 0x02, 0xaa, 0x76, 0x3d, 0xaf, 0xdb, 0x43, 0xbb, 0xec, 0xeb,
+	0x74, 0x70, 0xb6, 0x4b, 0x6c, 0x07, 0x61, 0x62, 0x38, 0x7d, 0x4e, 0x50, 0xfd, 0x67, 0x00, 0x96,
+	0x5a, 0x86, 0x6f, 0x38, 0x18, 0x7e, 0x0f, 0xdc, 0x76, 0x8c, 0x2b, 0xbd, 0x87, 0x0c, 0x8c, 0xb0,
+	0xde, 0x47, 0xbe, 0x4e, 0x90, 0x6b, 0xb8, 0x44, 0x49, 0x6d, 0xa7, 0x1e, 0xa5, 0xeb, 0x8f, 0x47,
+	0x43, 0xf5, 0xed, 0x44, 0x82, 0xf7, 0x3c, 0xc7, 0x26, 0xc8, 0xe9, 0x93, 0xeb, 0xf7, 0x30, 0xf1,
+	0x6d, 0xb7, 0xdb, 0x86, 0x8e, 0x71, 0x75, 0xc8, 0xc8, 0x5a, 0xc8, 0xef, 0x30, 0x22, 0x78, 0x02,
+	0x0a, 0x46, 0xaf, 0xe7, 0xbd, 0x46, 0x96, 0xde, 0xb3, 0x31, 0x51, 0x16, 0xb6, 0x17, 0x1f, 0xe5,
+	0xea, 0xcf, 0x46, 0x43, 0x75, 0x43, 0x86, 0x47, 0xc2, 0x7e, 0xf1, 0xd3, 0x27, 0xeb, 0x62, 0x8a,
+	0x35, 0xcb, 0xf2, 0x11, 0xc6, 0xc7, 0x5c, 0x7a, 0x5e, 0xd0, 0x1f, 0xda, 0x98, 0xc0, 0x57, 0x60,
+	0x8d, 0x2a, 0x45, 0x79, 0xb8, 0x4e, 0x4c, 0x3d, 0x65, 0x91, 0xe9, 0xfc, 0x68, 0x34, 0x54, 0xdf,
+	0x4a, 0x40, 0x4f, 0x6a, 0x5c, 0x76, 0x8c, 0xab, 0x03, 0x4a, 0xd4, 0x42, 0x3e, 0xd3, 0x1c, 0x9e,
+	0x00, 0xe8, 0xd8, 0x2e, 0xa7, 0xd7, 0xad, 0x81, 0x6f, 0x10, 0xdb, 0x73, 0x95, 0x34, 0x93, 0xfb,
+	0xce, 0x68, 0xa8, 0x3e, 0x9c, 0xc4, 0x26, 0x89, 0xb5, 0x5d, 0x26, 0xae, 0x29, 0x48, 0x20, 0x01,
+	0xf7, 0xa9, 0x42, 0x7d, 0xe4, 0x5a, 0xb6, 0xdb, 0x4d, 0xb0, 0x76, 0x86, 0x8d, 0x40, 0xed, 0xb2,
+	0xf3, 0x46, 0xc2, 0xc9, 0xc1, 0x36, 0x1d, 0xe3, 0xaa, 0xc5, 0xc9, 0xe3, 0xc6, 0x7f, 0x01, 0x56,
+	0x02, 0x41, 0xd4, 0x03, 0xbc, 0x01, 0x51, 0x96, 0xd8, 0x38, 0x6f, 0x8d, 0x86, 0xea, 0x76, 0x0c,
+	0x35, 0x29, 0xb9, 0x24, 0x28, 0x3a, 0x9c, 0x00, 0xfe, 0x3e, 0x50, 0x7c, 0x84, 0x91, 0x7f, 0x89,
+	0x2c, 0xdd, 0xf2, 0x1c, 0xc3, 0x76, 0x75, 0x3c, 0x38, 0x3b, 0xb3, 0xaf, 0x10, 0x56, 0x96, 0xd9,
+	0xba, 0xbe, 0x3d, 0x1a, 0xaa, 0xd5, 0x9b, 0x68, 0xa2, 0x01, 0xda, 0x1b, 0x01, 0x4d, 0x93, 0x91,
+	0x1c, 0x0b, 0x0a, 0xe8, 0x80, 0x2d, 0xd3, 0x47, 0x96, 0x4d, 0xf4, 0xd7, 0x36, 0x39, 0xb7, 0x7c,
+	0xe3, 0xb5, 0xd1, 0xd3, 0x4d, 0xcf, 0xeb, 0x59, 0xde, 0x6b, 0x57, 0xc9, 0x32, 0xdd, 0x77, 0x47,
+	0x43, 0xf5, 0xdd, 0x9b, 0xa9, 0x26, 0xa7, 0xa1, 0x70, 0xe2, 0x57, 0x21, 0x6d, 0x43, 0x90, 0xc2,
+	0x26, 0x28, 0x74, 0x7d, 0xc3, 0x44, 0xd4, 0xbe, 0xb6, 0x67, 0x29, 0x39, 0x36, 0xc0, 0x83, 0xd1,
+	0x50, 0xbd, 0x2f, 0xc3, 0x27, 0x45, 0xe6, 0x19, 0xba, 0xc5, 0xb0, 0xd0, 0x00, 0x1b, 0xdc, 0x21,
+	0x7c, 0x44, 0x90, 0x4b, 0x97, 0x3b, 0x90, 0x07, 0x98, 0xbc, 0x77, 0x47, 0x43, 0xf5, 0x9d, 0x64,
+	0x8a, 0x49, 0xc9, 0xeb, 0x8c, 0xb0, 0x1d, 0xd0, 0x89, 0x21, 0x2c, 0x70, 0xc7, 0xb2, 0x71, 0x7f,
+	0x40, 0xa8, 0x08, 0xdc, 0xf7, 0x5c, 0x1c, 0xea, 0x9c, 0x67, 0x63, 0xbc, 0x37, 0x1a, 0xaa, 0x8f,
+	0x6e, 0x20, 0x99, 0x1c, 0xe4, 0xb6, 0xa0, 0x6c, 0x0b, 0x42, 0x31, 0xca, 0x73, 0xb0, 0xe2, 0xa3,
+	0x33, 0xe4, 0x23, 0xd7, 0x44, 0xba, 0x85, 0x5c, 0xcf, 0x51, 0x0a, 0xdb, 0xa9, 0x47, 0xb9, 0xfa,
+	0xfd, 0xd1, 0x50, 0xdd, 0x8c, 0xa1, 0xa4, 0xd5, 0x2c, 0x85, 0xa8, 0x26, 0xc5, 0xc0, 0x4f, 0x41,
+	0xb1, 0xef, 0xdb, 0x26, 0xd2, 0xcf, 0x10, 0xb2, 0x90, 0x8f, 0x95, 0x22, 0x73, 0x8e, 0x6f, 0x8c,
+	0x86, 0xea, 0x9d, 0x31, 0xc4, 0x0c, 0xbb, 0xbe, 0xc0, 0x18, 0x9e, 0x73, 0x7a, 0x68, 0x83, 0x4d,
+	0xb6, 0x3b, 0x7c, 0xef, 0xd2, 0xb6, 0x90, 0xaf, 0x8f, 0xad, 0x5e, 0x89, 0x59, 0x62, 0x67, 0x34,
+	0x54, 0x1f, 0xdf, 0x48, 0x34, 0x69, 0x8b, 0x0d, 0xba, 0x7d, 0x04, 0xe9, 0x7e, 0xb4, 0xaa, 0x7b,
+	0x95, 0xff, 0xfa, 0x2b, 0x35, 0xf5, 0xe3, 0xff, 0xfc, 0x87, 0xc7, 0x22, 0x8e, 0x87, 0x61, 0x9e,
+	0xc7, 0xcd, 0xea, 0xdf, 0x66, 0x40, 0x8e, 0xed, 0x37, 0x1a, 0x3f, 0xe0, 0x53, 0x90, 0xc5, 0x17,
+	0x03, 0x7d, 0x30, 0xb0, 0x2d, 0x16, 0x38, 0x73, 0xf5, 0x8d, 0xd1, 0x50, 0x85, 0x01, 0x4c, 0x32,
+	0xd6, 0x32, 0xbe, 0x18, 0x9c, 0x0c, 0x6c, 0x0b, 0x7e, 0x00, 0xb2, 0x3f, 0x18, 0x18, 0x2e, 0xb1,
+	0xc9, 0xb5, 0xb2, 0xc0, 0x54, 0x67, 0x66, 0x0e, 0x60, 0x93, 0x9a, 0x86, 0xe4, 0xb0, 0x0d, 0x0a,
+	0x3d, 0xcf, 0xbc, 0x40, 0x96, 0xce, 0xac, 0xc3, 0xc2, 0x5e, 0xfe, 0xd9, 0xe6, 0x8e, 0xb0, 0x20,
+	0x3d, 0x47, 0x76, 0xc4, 0x39, 0xb2, 0xd3, 0xf0, 0x6c, 0xb7, 0xbe, 0xfe, 0xb3, 0xa1, 0x7a, 0x6b,
+	0x34, 0x54, 0xc7, 0xd8, 0xda, 0x79, 0xfe, 0xd5, 0xa2, 0x1f, 0xf0, 0x5b, 0xa0, 0x40, 0xb7, 0x24,
+	0x5d, 0x1d, 0xd7, 0x70, 0x10, 0x0b, 0x79, 0xb9, 0xfa, 0x16, 0x0d, 0xd4, 0x32, 0x5c, 0x9a, 0x49,
+	0x5e, 0xc0, 0x8f, 0x0c, 0x07, 0xc1, 0x0f, 0x41, 0xd1, 0x1c, 0x60, 0xe2, 0x39, 0x62, 0xd7, 0xb3,
+	0x80, 0x96, 0xab, 0xdf, 0xa5, 0x6b, 0x3e, 0x86, 0x90, 0x04, 0x14, 0x38, 0x82, 0xc7, 0x00, 0xb8,
+	0x0b, 0x96, 0x1d, 0x44, 0x90, 0x8f, 0x2c, 0x16, 0xa4, 0xb2, 0xf5, 0xdb, 0xa3, 0xa1, 0xba, 0x2a,
+	0x40, 0xb2, 0x01, 0x05, 0x08, 0x1a, 0x40, 0x4c, 0x40, 0xf7, 0x0d, 0x82, 0x94, 0x65, 0x36, 0xe0,
+	0x87, 0x74, 0xa6, 0xbf, 0x1c, 0xaa, 0x77, 0xb9, 0x2d, 0xb0, 0x75, 0xb1, 0x63, 0x7b, 0xbb, 0x8e,
+	0x41, 0xce, 0x77, 0x0e, 0x51, 0xd7, 0x30, 0xaf, 0x9b, 0xc8, 0x1c, 0x0d, 0x55, 0x99, 0xf3, 0x17,
+	0x3f, 0x7d, 0x02, 0x84, 0xe5, 0x9a, 0xc8, 0x6c, 0x03, 0x8e, 0x6a, 0x1b, 0x04, 0xc1, 0x06, 0x28,
+	0x99, 0x46, 0x0f, 0xb9, 0x96, 0xe1, 0xeb, 0x8e, 0xe7, 0x92, 0x73, 0x16, 0x83, 0xb2, 0xf5, 0x7b,
+	0xa3, 0xa1, 0xaa, 0x8c, 0x63, 0x24, 0x0d, 0x8b, 0x01, 0xe6, 0x05, 0x45, 0x40, 0x1d, 0x14, 0x19,
+	0x45, 0xef, 0x5a, 0x2c, 0x57, 0x6e, 0xda, 0x72, 0xa9, 0x62, 0xb9, 0xee, 0x8c, 0xf1, 0xc9, 0x96,
+	0x13, 0x08, 0xb6, 0x74, 0x7b, 0xf7, 0xa9, 0x9b, 0x2a, 0x31, 0x37, 0x0d, 0x7d, 0xb3, 0xfa, 0xef,
+	0x25, 0x90, 0x61, 0x5f, 0xf0, 0x6d, 0x90, 0x96, 0x3c, 0x14, 0x8e, 0x86, 0x6a, 0x29, 0xe6, 0x9d,
+	0x0c, 0x0f, 0x9b, 0x60, 0x49, 0x1c, 0x4b, 0x0b, 0x8c, 0x92, 0x46, 0x97, 0x72, 0xfc, 0xfc, 0xb9,
+	0x71, 0xcb, 0x0a, 0x5e, 0xea, 0x12, 0xe1, 0x1e, 0x64, 0xc3, 0x2e, 0x46, 0x2e, 0x31, 0x86, 0x90,
+	0x27, 0x16, 0x20, 0xd8, 0x16, 0xd1, 0x40, 0x86, 0x22, 0xb0, 0x92, 0xde, 0x5e, 0x7c, 0x94, 0x7f,
+	0xb6, 0xbd, 0x93, 0x98, 0x52, 0xed, 0x84, 0x53, 0xad, 0x17, 0x85, 0xe1, 0x38, 0x5b, 0x9b, 0xff,
+	0x83, 0x87, 0x20, 0x83, 0x09, 0x75, 0x11, 0xea, 0x93, 0xa5, 0x67, 0x0f, 0xde, 0x24, 0xe6, 0x98,
+	0x12, 0xd6, 0xd7, 0x46, 0x43, 0x75, 0x85, 0xf1, 0x48, 0xba, 0x71, 0x21, 0xf0, 0x63, 0x00, 0x4c,
+	0x1f, 0x19, 0x04, 0x59, 0xba, 0xc1, 0xcf, 0xd3, 0xfc, 0xb3, 0xad, 0x1d, 0x9e, 0x71, 0xed, 0x04,
+	0x19, 0xd7, 0x4e, 0x27, 0xc8, 0xb8, 0xea, 0x1b, 0x42, 0x27, 0x89, 0xeb, 0x27, 0x9f, 0xa9, 0xa9,
+	0x76, 0x4e, 0x7c, 0xd7, 0x08, 0xec, 0x80, 0x9c, 0xd9, 0xf3, 0x30, 0x97, 0xb8, 0x3c, 0x55, 0x22,
+	0xb5, 0xe0, 0x5a, 0xc8, 0x10, 0x69, 0xc8, 0xc4, 0x66, 0x39, 0xa2, 0x46, 0xa0, 0x0e, 0x56, 0x7a,
+	0x06, 0x26, 0x3a, 0x46, 0x84, 0xf4, 0xb8, 0xec, 0xec, 0x74, 0xd9, 0x42, 0xdb, 0x38, 0x2b, 0x93,
+	0x5d, 0xa4, 0xc0, 0x63, 0x0e, 0xab, 0x11, 0x78, 0x06, 0x56, 0x0c, 0xf3, 0xc2, 0xf5, 0x5e, 0xf7,
+	0x90, 0xd5, 0xe5, 0x03, 0xe4, 0xa6, 0x0e, 0x40, 0x4f, 0xd7, 0xcd, 0x18, 0x5b, 0x6c, 0x0a, 0x25,
+	0x19, 0x5d, 0x23, 0xf0, 0xbb, 0x20, 0xef, 0xa3, 0xef, 0x23, 0x53, 0x98, 0x1c, 0x4c, 0x1d, 0x83,
+	0x06, 0xd2, 0xdb, 0x12, 0x4b, 0x4c, 0x3e, 0x08, 0x50, 0x35, 0x02, 0x0f, 0x40, 0x99, 0x7f, 0xd1,
+	0x43, 0xd9, 0x47, 0x06, 0xf6, 0x5c, 0x76, 0xa4, 0xe6, 0xea, 0x95, 0xd1, 0x50, 0xdd, 0x8a, 0xe3,
+	0x24, 0x77, 0x58, 0x09, 0x71, 0x6d, 0x86, 0x82, 0xaf, 0x00, 0x40, 0x57, 0x7d, 0xdb, 0xe7, 0x5a,
+	0x16, 0xa6, 0x6a, 0x49, 0x83, 0xc8, 0x7a, 0xc4, 0x11, 0x53, 0x32, 0x27, 0x30, 0x35, 0xc2, 0xa2,
+	0x50, 0xcf, 0xc3, 0x03, 0x1f, 0x05, 0x1a, 0x16, 0x99, 0x86, 0x3c, 0x0a, 0x8d, 0x61, 0xc6, 0xa2,
+	0x10, 0xc7, 0x08, 0xed, 0xde, 0x07, 0x39, 0x07, 0x11, 0x43, 0x3f, 0x37, 0xf0, 0x39, 0x3b, 0x2a,
+	0x0b, 0xf5, 0x3b, 0xd4, 0x8f, 0x42, 0xa0, 0xc4, 0x9a, 0xa5, 0xc0, 0x8f, 0x0c, 0x7c, 0x0e, 0x5f,
+	0x83, 0xca, 0x64, 0xc2, 0xab, 0x1b, 0x44, 0x67, 0xce, 0x4b, 0x53, 0xe3, 0x15, 0x76, 0x74, 0xd1,
+	0xb3, 0x7d, 0xf7, 0xcd, 0x94, 0x93, 0x07, 0xda, 0x56, 0x3c, 0x4d, 0xae, 0x91, 0x86, 0x20, 0x86,
+	0xe7, 0xa0, 0x8c, 0xcd, 0x73, 0x64, 0x0d, 0xa8, 0xfb, 0x21, 0x97, 0x99, 0xb4, 0x3c, 0xd5, 0xa4,
+	0x55, 0xba, 0x66, 0x71, 0xbe, 0xb8, 0x77, 0x85, 0x78, 0xcd, 0xe5, 0xdb, 0xa4, 0xc8, 0x33, 0x04,
+	0xe4, 0x5a, 0x98, 0x0e, 0xb3, 0x3a, 0x75, 0x18, 0x95, 0x86, 0xb0, 0x31, 0xa6, 0xd8, 0x18, 0x3c,
+	0x3f, 0xd4, 0x5c, 0x0b, 0xd7, 0x08, 0xfc, 0x01, 0x48, 0x5b, 0xe8, 0x94, 0x28, 0x70, 0x7b, 0xf1,
+	0xcd, 0x61, 0xbf, 0x2e, 0xf6, 0x5e, 0x89, 0x92, 0x47, 0x12, 0xff, 0xee, 0x33, 0xf5, 0x51, 0xd7,
+	0x26, 0xe7, 0x83, 0xd3, 0x1d, 0xd3, 0x73, 0x44, 0xfd, 0x27, 0xfe, 0x3d, 0xc1, 0xd6, 0x85, 0xa8,
+	0x3a, 0xa9, 0x08, 0xdc, 0x66, 0x43, 0xc1, 0x67, 0x20, 0x2b, 0x52, 0x3c, 0x4b, 0x59, 0x63, 0x27,
+	0x16, 0x4b, 0x47, 0x02, 0x98, 0xbc, 0xd4, 0x01, 0x0c, 0xfe, 0x49, 0x0a, 0x14, 0x89, 0x47, 0x8c,
+	0x5e, 0xb0, 0xeb, 0x95, 0xf5, 0x69, 0x0a, 0xff, 0x6e, 0x70, 0x4e, 0x8d, 0xf1, 0x7d, 0x49, 0xcd,
+	0x0b, 0x4c, 0x88, 0x88, 0x2e, 0xf0, 0xcf, 0x52, 0xa0, 0x68, 0x98, 0xa6, 0x3f, 0xa0, 0xa9, 0xbd,
+	0xe1, 0xfb, 0xd7, 0xca, 0x6d, 0xa6, 0xcd, 0xbd, 0x44, 0x6d, 0x9a, 0xc8, 0x64, 0x0a, 0x1d, 0x05,
+	0x0a, 0x8d, 0xb1, 0x8e, 0x29, 0xf4, 0xee, 0x0c, 0x0a, 0x09, 0x71, 0xb8, 0x5d, 0x10, 0x72, 0x1a,
+	0x54, 0xcc, 0xde, 0x26, 0x3d, 0x67, 0xd7, 0x93, 0xce, 0xd9, 0xea, 0x7f, 0xa4, 0x41, 0x89, 0xfd,
+	0xaa, 0x39, 0xc8, 0xb5, 0x1c, 0xe4, 0x12, 0xf8, 0x5b, 0x00, 0xf0, 0xcd, 0x20, 0x1d, 0xb9, 0x0a,
+	0xdd, 0xf2, 0x11, 0x54, 0x5a, 0x87, 0x1c, 0x83, 0x9e, 0xfc, 0x7f, 0x3a, 0x7d, 0x5b, 0x20, 0x67,
+	0x58, 0x96, 0x3e, 0xdf, 0x09, 0xbc, 0x2a, 0x56, 0x20, 0x62, 0x6d, 0x67, 0x0d, 0xcb, 0xa2, 0x38,
+	0x4c, 0x73, 0x4c, 0x1f, 0x39, 0xde, 0x25, 0x12, 0x42, 0x33, 0xdb, 0x8b, 0x41, 0x8e, 0x29, 0xc3,
+	0xe5, 0x1c, 0x93, 0xc3, 0x39, 0xfb, 0x8f, 0x53, 0x34, 0x58, 0x8b, 0xe2, 0xd2, 0x70, 0xbc, 0x81,
+	0x4b, 0xb0, 0xb2, 0x34, 0xcd, 0x49, 0x9b, 0x42, 0xa3, 0x09, 0xd6, 0xb9, 0xbc, 0x73, 0x25, 0xe0,
+	0xae, 0x71, 0xe6, 0x58, 0x1a, 0xb0, 0xfc, 0x35, 0xa4, 0x01, 0x7b, 0x55, 0xea, 0x5f, 0xf7, 0x93,
+	0xfc, 0x2b, 0xf4, 0xaa, 0xea, 0x2f, 0x53, 0xa0, 0x70, 0x82, 0x8d, 0x2e, 0x6a, 0x50, 0x35, 0x90,
+	0xff, 0x65, 0x2a, 0x8f, 0x78, 0xaa, 0xbf, 0x30, 0x5f, 0xaa, 0xff, 0x9b, 0x60, 0xd9, 0xe4, 0x83,
+	0x8b, 0x7e, 0x0b, 0x3f, 0x87, 0x38, 0x68, 0x32, 0xca, 0x07, 0xc4, 0x7b, 0xdb, 0x74, 0x7a, 0x77,
+	0x63, 0xd3, 0x93, 0xe7, 0x52, 0xfd, 0x3c, 0x0d, 0x00, 0x9b, 0x2f, 0x83, 0x7e, 0xf9, 0x1d, 0xf4,
+	0x31, 0xc8, 0x8a, 0x41, 0x31, 0x6b, 0x38, 0xe5, 0x9f, 0x3d, 0xbc, 0xc1, 0x71, 0xe5, 0xe1, 0xeb,
+	0x65, 0xb1, 0x44, 0x21, 0x73, 0x3b, 0xfc, 0x05, 0x7f, 0x08, 0xf2, 0x98, 0xb6, 0x43, 0xf4, 0x9e,
+	0xed, 0xd8, 0x44, 0x59, 0x9c, 0xe6, 0x75, 0xbf, 0x23, 0x64, 0xc9, 0x5c, 0x73, 0x39, 0x1c, 0x60,
+	0x8c, 0x87, 0x94, 0x8f, 0xf6, 0x29, 0xb8, 0x18, 0x51, 0xe9, 0xa6, 0xa3, 0x3e, 0x85, 0x0c, 0x4f,
+	0xe8, 0x53, 0x30, 0xb4, 0x28, 0xef, 0x11, 0x58, 0xe5, 0x74, 0x3a, 0x26, 0x86, 0x2f, 0x1c, 0x37,
+	0x33, 0x3d, 0x99, 0x12, 0x33, 0x99, 0x64, 0x66, 0xfe, 0xbb, 0xc2, 0xc1, 0xc7, 0x1c, 0x5a, 0x23,
+	0xf0, 0x0f, 0x41, 0x21, 0xa0, 0xec, 0x23, 0x97, 0x4c, 0xdf, 0xa0, 0x1f, 0x06, 0xc5, 0xa9, 0xcc,
+	0x36, 0x97, 0xad, 0xf2, 0x42, 0x07, 0xca, 0xb8, 0x57, 0xa1, 0x6e, 0xb6, 0x99, 0xb4, 0x8b, 0xd8,
+	0x62, 0x57, 0xff, 0x29, 0x07, 0x56, 0xd8, 0x27, 0x3f, 0x6a, 0x58, 0xac, 0x7e, 0x07, 0x2c, 0x08,
+	0x0f, 0x4b, 0xf3, 0xac, 0xc8, 0x4e, 0x30, 0xe6, 0x82, 0x6d, 0xc5, 0x5c, 0x72, 0x61, 0x76, 0x97,
+	0x7c, 0x09, 0x96, 0x89, 0x6f, 0x77, 0xbb, 0x62, 0xd3, 0x94, 0x9e, 0x3d, 0xba, 0xc1, 0x23, 0x23,
+	0xad, 0x3a, 0x9c, 0xbe, 0x9e, 0x1f, 0x0d, 0xd5, 0x80, 0xb9, 0x1d, 0xfc, 0x80, 0xaf, 0x22, 0x33,
+	0x53, 0xd3, 0x2b, 0xe9, 0xa9, 0x0b, 0xa9, 0xc4, 0xed, 0x4c, 0xf9, 0x78, 0xba, 0x22, 0xad, 0x21,
+	0x0d, 0x6c, 0x82, 0x00, 0xb9, 0x96, 0x92, 0x99, 0x3d, 0xb0, 0x45, 0x5c, 0x3c, 0xb0, 0xf1, 0x6f,
+	0xcd, 0xa5, 0xfb, 0x11, 0x48, 0x45, 0xc8, 0x1c, 0x25, 0x53, 0xac, 0xfe, 0xc8, 0xe1, 0xb0, 0xf6,
+	0xe8, 0x83, 0x65, 0x76, 0x36, 0x23, 0x4b, 0x59, 0x9e, 0xe6, 0x60, 0xdf, 0x14, 0xe2, 0x02, 0x8e,
+	0xb9, 0x7c, 0x2b, 0x60, 0x82, 0x7f, 0x00, 0xf2, 0xc4, 0x37, 0x5c, 0x7c, 0x86, 0x7c, 0xda, 0xa3,
+	0xc8, 0xce, 0x1c, 0x01, 0x24, 0xae, 0xf9, 0xbc, 0x5a, 0x62, 0x84, 0x97, 0x20, 0x87, 0xcf, 0x3d,
+	0x9f, 0x9c, 0x19, 0xbd, 0x9e, 0x92, 0x9b, 0x36, 0xf6, 0xb7, 0x82, 0x53, 0x38, 0xe4, 0x99, 0x6b,
+	0xe4, 0x88, 0x0d, 0x7e, 0x1f, 0x64, 0xfa, 0xbe, 0xe7, 0x78, 0x0a, 0x98, 0x36, 0xe6, 0x07, 0x41,
+	0xed, 0xcd, 0xe8, 0xe7, 0x1a, 0x8f, 0xb3, 0xd0, 0x39, 0x9a, 0x9e, 0x7b, 0x89, 0x68, 0x20, 0x51,
+	0xf2, 0x33, 0xcf, 0x31, 0xe4, 0x99, 0x6f, 0x8e, 0x21, 0x1b, 0xfc, 0xa3, 0x14, 0x58, 0x09, 0xbf,
+	0xf4, 0x4b, 0xa3, 0x37, 0x40, 0x4a, 0x61, 0xda, 0xf0, 0x8d, 0xa0, 0x50, 0x8e, 0x71, 0xce, 0xa5,
+	0x44, 0x29, 0x64, 0xfe, 0x84, 0xf2, 0xee, 0x3d, 0xa4, 0xb1, 0xab, 0x92, 0x14, 0xbb, 0xa2, 0xb0,
+	0x50, 0xfd, 0xcb, 0x1c, 0x58, 0x6e, 0xf2, 0xac, 0xfd, 0x57, 0x3f, 0xc9, 0x3c, 0x0a, 0x7a, 0x33,
+	0x69, 0x16, 0x15, 0x6f, 0x3a, 0xa7, 0xc5, 0x7c, 0xa7, 0x77, 0x67, 0xde, 0x03, 0x4b, 0xa2, 0x46,
+	0xe6, 0x0d, 0xc8, 0x75, 0x9e, 0xf9, 0xc5, 0x6a, 0x63, 0x41, 0x03, 0x31, 0xc8, 0x22, 0x6c, 0xfa,
+	0xf4, 0x5a, 0x69, 0xfa, 0x39, 0xf5, 0xdb, 0x41, 0x7a, 0x10, 0xb0, 0xcc, 0xb5, 0xd4, 0x21, 0x17,
+	0x3c, 0x02, 0x39, 0xaf, 0x8f, 0xdc, 0x59, 0x13, 0xc7, 0xdb, 0x81, 0x9f, 0x87, 0x4c, 0xbc, 0xcf,
+	0xc3, 0x3f, 0x59, 0x1b, 0x66, 0x35, 0xec, 0xf8, 0x5b, 0xc8, 0xb0, 0x7a, 0xb6, 0x8b, 0x94, 0xec,
+	0xec, 0xe7, 0xfa, 0x04, 0x33, 0x93, 0x5f, 0x0e, 0xc0, 0x4d, 0x01, 0xa5, 0x45, 0x65, 0x00, 0x53,
+	0x72, 0x51, 0xa6, 0x19, 0xc0, 0xe4, 0xa2, 0x32, 0x80, 0xc1, 0xef, 0x81, 0x02, 0xff, 0x6d, 0xcd,
+	0xda, 0xbb, 0xa9, 0xf0, 0x6a, 0x20, 0xe2, 0x89, 0x97, 0xd6, 0x21, 0x2e, 0xe8, 0x0c, 0x61, 0xaf,
+	0x77, 0xc9, 0xa5, 0xe7, 0x67, 0xed, 0x0c, 0x85, 0x2c, 0x93, 0x9d, 0x21, 0x8e, 0xaa, 0x11, 0xf8,
+	0xa3, 0x14, 0x28, 0x71, 0x37, 0x0f, 0x6b, 0x8d, 0xc2, 0xcc, 0x15, 0xfc, 0x38, 0xe3, 0x5c, 0x8e,
+	0x52, 0xe4, 0xbc, 0x41, 0x9d, 0x41, 0x8b, 0x9e, 0x70, 0x2b, 0x05, 0x8a, 0x14, 0x67, 0x2e, 0x7a,
+	0xe2, 0xac, 0xf3, 0x15, 0x3d, 0x01, 0xb7, 0x50, 0x66, 0xef, 0x2e, 0x8d, 0x4f, 0x1b, 0xb1, 0xf8,
+	0x24, 0xf6, 0x66, 0xf5, 0x1f, 0xd3, 0xa0, 0x14, 0xdc, 0xa4, 0xb4, 0xbc, 0x9e, 0x6d, 0x5e, 0x4f,
+	0xc6, 0x87, 0xd4, 0xbc, 0xf1, 0x21, 0xe1, 0x0a, 0x73, 0xe1, 0x2b, 0x5c, 0x61, 0x1e, 0x80, 0xb2,
+	0x31, 0x20, 0x9e, 0x2e, 0x75, 0x18, 0x59, 0xcc, 0xca, 0xf2, 0x76, 0x5f, 0x1c, 0x27, 0xb7, 0xfb,
+	0x28, 0xae, 0x16, 0xa1, 0xe8, 0x6d, 0x59, 0x70, 0x83, 0xcd, 0x57, 0x8c, 0x17, 0xc9, 0xe2, 0xb6,
+	0x2c, 0x86, 0x92, 0x6f, 0xcb, 0x04, 0x8a, 0xdf, 0xd1, 0x62, 0xda, 0xdd, 0xb3, 0x90, 0x6b, 0x4b,
+	0x62, 0x78, 0x59, 0xcc, 0xaa, 0xaa, 0x71, 0x8c, 0xdc, 0xdd, 0xe3, 0x98, 0x40, 0xc8, 0x2b, 0x70,
+	0xdb, 0xbb, 0x44, 0xbe, 0x6f, 0x5b, 0x68, 0xfc, 0x52, 0x8c, 0x5f, 0xa5, 0x3c, 0x1c, 0x0d, 0x55,
+	0x35, 0x91, 0x40, 0x12, 0xb9, 0x16, 0x10, 0x48, 0xd7, 0x60, 0x13, 0x57, 0xa4, 0xcb, 0x5f, 0xe6,
+	0x8a, 0x34, 0xb9, 0xb2, 0x1d, 0xf7, 0x95, 0xea, 0xdf, 0x64, 0x41, 0x31, 0x00, 0xd1, 0x38, 0x8f,
+	0xbf, 0x06, 0xef, 0xf9, 0x36, 0x28, 0x89, 0x1b, 0x74, 0x51, 0x65, 0x0b, 0xe7, 0x61, 0xf6, 0x18,
+	0xc7, 0x4c, 0xce, 0xa0, 0xc8, 0x09, 0x1a, 0x1c, 0x0f, 0xbf, 0x03, 0xd6, 0x04, 0x87, 0xdc, 0x9e,
+	0x96, 0x9f, 0x1c, 0x24, 0xa0, 0x27, 0xa5, 0x42, 0x4e, 0x25, 0x79, 0x12, 0x73, 0x72, 0xc1, 0x1b,
+	0x74, 0xa6, 0x95, 0x74, 0xe4, 0xe4, 0x31, 0x54, 0x82, 0x93, 0x73, 0x8a, 0xb6, 0x20, 0x80, 0x2d,
+	0x50, 0x0e, 0xe6, 0x66, 0xb8, 0x26, 0xea, 0xd1, 0x5e, 0x1e, 0x7f, 0x5f, 0xf0, 0x6b, 0xa3, 0xa1,
+	0xfa, 0x20, 0x8e, 0x9b, 0x14, 0x28, 0xb4, 0x69, 0x04, 0x14, 0x92, 0x1d, 0x45, 0x57, 0x5a, 0x59,
+	0x9a, 0xb0, 0xa3, 0xc0, 0xdc, 0x68, 0x47, 0x8d, 0xe3, 0xe1, 0x3e, 0x28, 0x06, 0x1a, 0xb0, 0x9b,
+	0x0a, 0xe1, 0x52, 0xb4, 0x75, 0x5b, 0x19, 0x43, 0x4c, 0x4a, 0x2a, 0x08, 0xbd, 0x18, 0x5a, 0x7a,
+	0x2c, 0x80, 0xae, 0xce, 0x8d, 0x01, 0x66, 0x0d, 0x67, 0xd1, 0xf4, 0xc6, 0x09, 0x8f, 0x05, 0x12,
+	0xa8, 0x6e, 0x7c, 0x2c, 0xa0, 0x85, 0xb4, 0x0d, 0x41, 0x0a, 0x0f, 0xd9, 0x6d, 0x87, 0xde, 0x33,
+	0x08, 0x72, 0xcd, 0x6b, 0x1d, 0x0f, 0x1c, 0x25, 0x17, 0x2d, 0x52, 0x0c, 0x95, 0x60, 0x05, 0xc3,
+	0xbc, 0x38, 0xe4, 0x04, 0xc7, 0x03, 0x07, 0x7e, 0x0c, 0xd6, 0x64, 0x96, 0xd3, 0x81, 0x79, 0x81,
+	0x08, 0x66, 0x59, 0xb6, 0xd8, 0x5e, 0x09, 0x68, 0xc9, 0xcf, 0x57, 0x23, 0x71, 0x75, 0x8e, 0xa4,
+	0x5b, 0xb5, 0x67, 0x9f, 0x21, 0x1a, 0x0b, 0x99, 0x76, 0xf9, 0x68, 0xab, 0xca, 0xf0, 0x84, 0xad,
+	0x1a, 0xa0, 0xa9, 0x62, 0x3f, 0x04, 0x6a, 0x82, 0x1f, 0x8f, 0xb5, 0xfc, 0x0b, 0x4c, 0xf0, 0xfb,
+	0xa3, 0xa1, 0xfa, 0x1b, 0x53, 0x48, 0x27, 0xc7, 0xba, 0x37, 0xe9, 0xfe, 0x51, 0xd7, 0x7f, 0xef,
+	0x01, 0x8d, 0x13, 0xf7, 0x6e, 0x88, 0x13, 0x2c, 0x28, 0x54, 0xff, 0x34, 0x05, 0x60, 0x43, 0xba,
+	0x37, 0xee, 0x18, 0x7e, 0x17, 0x7d, 0x85, 0x6e, 0xeb, 0x57, 0x6b, 0x86, 0x55, 0xff, 0x3c, 0x0d,
+	0x8a, 0x0d, 0xe6, 0x32, 0x35, 0x93, 0x35, 0x8b, 0xa4, 0xcc, 0x3a, 0xf5, 0x15, 0x32, 0xeb, 0xdf,
+	0x03, 0x25, 0xe1, 0xb6, 0x06, 0xc7, 0x0b, 0xc5, 0xde, 0x67, 0xbd, 0xb6, 0x31, 0xcc, 0x0c, 0x52,
+	0x8b, 0x9c, 0x43, 0x00, 0xe9, 0x23, 0x27, 0xc3, 0x24, 0xf6, 0x25, 0x12, 0xd7, 0x35, 0x4c, 0x71,
+	0x65, 0x31, 0x7a, 0xe4, 0x34, 0x89, 0x4d, 0x78, 0xe4, 0xc4, 0x89, 0x58, 0x75, 0xc2, 0x3a, 0x68,
+	0xf0, 0x53, 0xb0, 0x36, 0xf6, 0x6e, 0x49, 0xc8, 0x4d, 0x47, 0x11, 0x32, 0x01, 0x3d, 0x29, 0x78,
+	0xb5, 0x2f, 0xbd, 0x66, 0xe2, 0x92, 0x13, 0x3b, 0xbf, 0x99, 0xff, 0x9b, 0xce, 0x6f, 0xb2, 0x93,
+	0x8e, 0xf9, 0x40, 0xf5, 0x2f, 0x16, 0x02, 0xaf, 0x68, 0xa2, 0xbe, 0x87, 0xed, 0xaf, 0xcb, 0x2b,
+	0x6a, 0x60, 0x89, 0x4f, 0x81, 0x79, 0xc3, 0x1b, 0x27, 0x5f, 0x12, 0x93, 0x17, 0x0c, 0x6d, 0xf1,
+	0x9f, 0xf6, 0x8d, 0x2c, 0xae, 0x13, 0xcf, 0x99, 0x17, 0x67, 0xef, 0x1b, 0xc9, 0x7c, 0x3c, 0x17,
+	0x0f, 0x21, 0x35, 0xf2, 0x26, 0xb3, 0x08, 0x23, 0x54, 0xff, 0x3b, 0x03, 0x40, 0x8b, 0x96, 0xfa,
+	0xfb, 0xbe, 0x31, 0x4f, 0xd7, 0xed, 0xeb, 0x29, 0x56, 0xf7, 0xc1, 0x72, 0x97, 0x8e, 0x2b, 0x5a,
+	0x70, 0xb9, 0xfa, 0x13, 0x5a, 0xfd, 0x08, 0xd0, 0x0c, 0x72, 0x02, 0x6e, 0xe8, 0x84, 0xab, 0x90,
+	0x9e, 0xe6, 0x82, 0x7b, 0xe3, 0xab, 0x30, 0x97, 0xe3, 0x05, 0x2b, 0x76, 0x09, 0x72, 0x3e, 0xa2,
+	0xa1, 0xce, 0x76, 0xbb, 0x4a, 0x66, 0xe6, 0xb6, 0x48, 0xc8, 0x33, 0x5f, 0x5b, 0x24, 0x64, 0xfb,
+	0xdf, 0x78, 0xe8, 0xf0, 0x71, 0x70, 0x45, 0x8e, 0xe7, 0xbc, 0x34, 0x89, 0xb8, 0xe4, 0xcb, 0x71,
+	0xcc, 0x2f, 0xc7, 0xc7, 0x72, 0x41, 0xac, 0x64, 0xa3, 0xf4, 0x79, 0x1c, 0x23, 0xa7, 0xcf, 0x72,
+	0x9a, 0x88, 0xe9, 0xe5, 0x78, 0x70, 0x61, 0x82, 0x59, 0x77, 0x2d, 0xc7, 0x1d, 0x32, 0x04, 0xca,
+	0xc5, 0xad, 0xb8, 0x46, 0xc1, 0xc9, 0x9d, 0xe6, 0xc8, 0xbf, 0xab, 0x9f, 0x2d, 0x82, 0x82, 0x76,
+	0x65, 0x9e, 0x1b, 0x6e, 0x17, 0xb1, 0xe7, 0x44, 0xbf, 0x0e, 0x32, 0xfc, 0x59, 0x1d, 0x8f, 0x01,
+	0xac, 0x8f, 0x11, 0x7f, 0x4c, 0xc7, 0x29, 0xe0, 0x0b, 0x90, 0x66, 0xaf, 0x9a, 0xb8, 0xc3, 0x7f,
+	0x30, 0xdb, 0xab, 0xa6, 0x74, 0xc2, 0x73, 0x26, 0x06, 0xa3, 0x86, 0xbf, 0x34, 0x7a, 0xb6, 0xa5,
+	0x9f, 0xf9, 0x9e, 0xa3, 0x2c, 0xce, 0x6e, 0xf8, 0x88, 0x8b, 0x1b, 0x9e, 0x7d, 0x3f, 0xf7, 0x3d,
+	0x07, 0x76, 0x40, 0x9e, 0x23, 0x07, 0x2e, 0xb1, 0x7b, 0x33, 0xf4, 0x9f, 0xef, 0x04, 0x0d, 0x51,
+	0x89, 0x8d, 0x57, 0xdd, 0x0c, 0x70, 0x42, 0xbf, 0x61, 0x03, 0x2c, 0x61, 0x44, 0xf4, 0xd3, 0x6b,
+	0x25, 0x13, 0x6d, 0x75, 0x0e, 0x99, 0x61, 0x8b, 0x66, 0x30, 0x22, 0xf5, 0x6b, 0xa8, 0x71, 0x21,
+	0x33, 0x79, 0x2d, 0x0c, 0x76, 0x28, 0xe7, 0x60, 0x0a, 0x51, 0x31, 0x35, 0x92, 0x7c, 0x61, 0x25,
+	0x2f, 0xe8, 0xe3, 0xbf, 0x4f, 0x89, 0x0b, 0x2b, 0xd6, 0x98, 0x82, 0x77, 0xc1, 0x9d, 0x43, 0xad,
+	0x76, 0xac, 0xe9, 0xc7, 0x9d, 0x5a, 0x47, 0xd3, 0x4f, 0x8e, 0x8e, 0x5b, 0x5a, 0xe3, 0xe0, 0xf9,
+	0x81, 0xd6, 0x2c, 0xdf, 0x82, 0x77, 0xc0, 0x9a, 0x8c, 0x6c, 0x69, 0x47, 0xcd, 0x83, 0xa3, 0xfd,
+	0x72, 0x0a, 0x6e, 0x00, 0x28, 0x23, 0x6a, 0x8d, 0xce, 0xc1, 0x27, 0x5a, 0x79, 0x21, 0x0e, 0x6f,
+	0x1c, 0xbe, 0x3c, 0xd6, 0x9a, 0xe5, 0x45, 0xa8, 0x80, 0x75, 0x19, 0xde, 0xd6, 0xbe, 0xad, 0x35,
+	0x3a, 0x5a, 0xb3, 0x9c, 0x8e, 0x0f, 0xa1, 0x7d, 0xda, 0x3a, 0x68, 0x6b, 0xcd, 0x72, 0x66, 0x2b,
+	0xfd, 0xc7, 0x7f, 0x5d, 0xb9, 0xf5, 0xf8, 0x5f, 0x16, 0xc0, 0xea, 0xc4, 0xf5, 0x02, 0xac, 0x82,
+	0xca, 0xb1, 0xd6, 0xe9, 0x1c, 0x6a, 0x2f, 0xb4, 0xa3, 0x8e, 0xde, 0x69, 0x1f, 0xec, 0xef, 0x6b,
+	0xed, 0x98, 0xee, 0x2a, 0xb8, 0x9b, 0x40, 0xf3, 0xea, 0xa0, 0xf3, 0x51, 0xb3, 0x5d, 0x7b, 0x55,
+	0x4e, 0xc1, 0x7b, 0x40, 0x49, 0x20, 0x60, 0x2a, 0x97, 0x17, 0xe0, 0x03, 0x70, 0x3f, 0x01, 0x5b,
+	0x3b, 0xe9, 0xbc, 0x14, 0x24, 0x8b, 0xf0, 0x2d, 0xb0, 0x9d, 0x40, 0x72, 0xd0, 0xd1, 0x5e, 0x1c,
+	0xeb, 0x8d, 0x8f, 0x6a, 0x47, 0xfb, 0x6c, 0x82, 0x0f, 0x81, 0x9a, 0x40, 0xb5, 0xdf, 0xae, 0x35,
+	0xa8, 0x41, 0xdb, 0x07, 0x2f, 0x9b, 0xe5, 0x0c, 0xac, 0x80, 0xad, 0x04, 0xa2, 0xe6, 0xc1, 0x71,
+	0xeb, 0xa4, 0xa3, 0x95, 0x97, 0x6e, 0xd0, 0xf5, 0xe4, 0xb8, 0xb6, 0xaf, 0x95, 0x97, 0x6f, 0x18,
+	0xa2, 0xa9, 0xd5, 0x3b, 0x7a, 0xab, 0xf6, 0x1d, 0x0a, 0x2c, 0x67, 0x85, 0x3d, 0x7f, 0x94, 0x02,
+	0x05, 0xb9, 0x31, 0x09, 0xef, 0x83, 0x4d, 0x31, 0x4c, 0xa2, 0x07, 0x6c, 0x00, 0x38, 0x8e, 0x7e,
+	0xd9, 0xd2, 0x8e, 0xca, 0x29, 0xb8, 0x05, 0x36, 0xc6, 0xe1, 0x6d, 0xed, 0xf8, 0xe5, 0xe1, 0x27,
+	0x5a, 0xb3, 0xbc, 0x40, 0x5d, 0x6a, 0x1c, 0xd7, 0xd4, 0x9e, 0xd7, 0x4e, 0x0e, 0xe9, 0x7a, 0x2f,
+	0x72, 0x35, 0xea, 0x27, 0x3f, 0xfb, 0xbc, 0x92, 0xfa, 0xf9, 0xe7, 0x95, 0xd4, 0xbf, 0x7d, 0x5e,
+	0x49, 0xfd, 0xe4, 0x8b, 0xca, 0xad, 0x9f, 0x7f, 0x51, 0xb9, 0xf5, 0xaf, 0x5f, 0x54, 0x6e, 0x7d,
+	0xf7, 0x9b, 0x52, 0xd8, 0x77, 0x0c, 0xd7, 0x3e, 0x43, 0x98, 0x3c, 0x71, 0x11, 0x79, 0xed, 0xf9,
+	0x17, 0x11, 0x80, 0x65, 0xe1, 0xfe, 0xee, 0x55, 0xe8, 0xe2, 0xec, 0x3c, 0x38, 0x5d, 0x62, 0x9b,
+	0xe5, 0x1b, 0xff, 0x33, 0x00, 0x8d, 0x93, 0x08, 0x9b, 0xeb, 0x30, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	l = len(m.Tenant)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.CreditAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.ActiveLeaseCount != 0 {
-		n += 1 + sovTypes(uint64(m.ActiveLeaseCount))
+	if this.MaxLeasesPerTenant != that1.MaxLeasesPerTenant {
+		return false
 	}
-	if m.PendingLeaseCount != 0 {
-		n += 1 + sovTypes(uint64(m.PendingLeaseCount))
+	if len(this.AllowedList) != len(that1.AllowedList) {
+		return false
 	}
-	if len(m.ReservedAmounts) > 0 {
-		for _, e := range m.ReservedAmounts {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	for i := range this.AllowedList {
+		if this.AllowedList[i] != that1.AllowedList[i] {
+			return false
+		}
+	}
+	if this.MaxItemsPerLease != that1.MaxItemsPerLease {
+		return false
+	}
+	if this.MinLeaseDuration != that1.MinLeaseDuration {
+		return false
+	}
+	if this.MaxPendingLeasesPerTenant != that1.MaxPendingLeasesPerTenant {
+		return false
+	}
+	if this.PendingTimeout != that1.PendingTimeout {
+		return false
+	}
+	if len(this.ReservedDomainSuffixes) != len(that1.ReservedDomainSuffixes) {
+		return false
+	}
+	for i := range this.ReservedDomainSuffixes {
+		if this.ReservedDomainSuffixes[i] != that1.ReservedDomainSuffixes[i] {
+			return false
+		}
+	}
+	if this.CreditWithdrawalCooldown != that1.CreditWithdrawalCooldown {
+		return false
+	}
+	if this.GracePeriod != that1.GracePeriod {
+		return false
+	}
+	if this.LeaseRetentionPeriod != that1.LeaseRetentionPeriod {
+		return false
+	}
+	if this.DisputeResponsePeriod != that1.DisputeResponsePeriod {
+		return false
+	}
+	if this.ReferenceDenom != that1.ReferenceDenom {
+		return false
+	}
+	if len(this.PriceFeeders) != len(that1.PriceFeeders) {
+		return false
+	}
+	for i := range this.PriceFeeders {
+		if this.PriceFeeders[i] != that1.PriceFeeders[i] {
+			return false
+		}
+	}
+	if this.MaxProviderGracePeriod != that1.MaxProviderGracePeriod {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxProviderGracePeriod != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxProviderGracePeriod))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.PriceFeeders) > 0 {
+		for iNdEx := len(m.PriceFeeders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PriceFeeders[iNdEx])
+			copy(dAtA[i:], m.PriceFeeders[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.PriceFeeders[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ReferenceDenom) > 0 {
+		i -= len(m.ReferenceDenom)
+		copy(dAtA[i:], m.ReferenceDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ReferenceDenom)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.DisputeResponsePeriod != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DisputeResponsePeriod))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.LeaseRetentionPeriod != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LeaseRetentionPeriod))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.GracePeriod != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GracePeriod))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CreditWithdrawalCooldown != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CreditWithdrawalCooldown))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ReservedDomainSuffixes) > 0 {
+		for iNdEx := len(m.ReservedDomainSuffixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReservedDomainSuffixes[iNdEx])
+			copy(dAtA[i:], m.ReservedDomainSuffixes[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.ReservedDomainSuffixes[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.PendingTimeout != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PendingTimeout))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxPendingLeasesPerTenant != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxPendingLeasesPerTenant))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinLeaseDuration != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinLeaseDuration))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxItemsPerLease != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxItemsPerLease))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AllowedList) > 0 {
+		for iNdEx := len(m.AllowedList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedList[iNdEx])
+			copy(dAtA[i:], m.AllowedList[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.AllowedList[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.MaxLeasesPerTenant != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxLeasesPerTenant))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LeaseItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LeaseItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeaseItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MonthlyPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.CalendarMonth {
+		i--
+		if m.CalendarMonth {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.LockedRate.Size()
+		i -= size
+		if _, err := m.LockedRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Metered {
+		i--
+		if m.Metered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CustomDomain) > 0 {
+		i -= len(m.CustomDomain)
+		copy(dAtA[i:], m.CustomDomain)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CustomDomain)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ServiceName) > 0 {
+		i -= len(m.ServiceName)
+		copy(dAtA[i:], m.ServiceName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ServiceName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.LockedPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Quantity != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SkuUuid) > 0 {
+		i -= len(m.SkuUuid)
+		copy(dAtA[i:], m.SkuUuid)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SkuUuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Lease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Lease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Lease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccrualCarry) > 0 {
+		for iNdEx := len(m.AccrualCarry) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccrualCarry[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.TotalSettled) > 0 {
+		for iNdEx := len(m.TotalSettled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalSettled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.Disputed {
+		i--
+		if m.Disputed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.Debt) > 0 {
+		for iNdEx := len(m.Debt) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Debt[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.GraceEndsAt != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.GraceEndsAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.GraceEndsAt):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTypes(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.ScheduledEndAt != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ScheduledEndAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ScheduledEndAt):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTypes(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.MinLeaseDurationAtCreation != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinLeaseDurationAtCreation))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.MetaHash) > 0 {
+		i -= len(m.MetaHash)
+		copy(dAtA[i:], m.MetaHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MetaHash)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.ClosureReason) > 0 {
+		i -= len(m.ClosureReason)
+		copy(dAtA[i:], m.ClosureReason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ClosureReason)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.ExpiredAt != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiredAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiredAt):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintTypes(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.RejectionReason) > 0 {
+		i -= len(m.RejectionReason)
+		copy(dAtA[i:], m.RejectionReason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RejectionReason)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.RejectedAt != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.RejectedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RejectedAt):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintTypes(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.AcknowledgedAt != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.AcknowledgedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.AcknowledgedAt):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintTypes(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x4a
+	}
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastSettledAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastSettledAt):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintTypes(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x42
+	if m.ClosedAt != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ClosedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClosedAt):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintTypes(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x32
+	if m.State != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ProviderUuid) > 0 {
+		i -= len(m.ProviderUuid)
+		copy(dAtA[i:], m.ProviderUuid)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ProviderUuid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Uuid) > 0 {
+		i -= len(m.Uuid)
+		copy(dAtA[i:], m.Uuid)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Uuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LeaseAmendment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LeaseAmendment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeaseAmendment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintTypes(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.ReservedAmounts) > 0 {
+		for iNdEx := len(m.ReservedAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReservedAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RemoveItems) > 0 {
+		for iNdEx := len(m.RemoveItems) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveItems[iNdEx])
+			copy(dAtA[i:], m.RemoveItems[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.RemoveItems[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AddItems) > 0 {
+		for iNdEx := len(m.AddItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ProviderUuid) > 0 {
+		i -= len(m.ProviderUuid)
+		copy(dAtA[i:], m.ProviderUuid)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ProviderUuid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LeaseUuid) > 0 {
+		i -= len(m.LeaseUuid)
+		copy(dAtA[i:], m.LeaseUuid)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LeaseUuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UsageCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UsageCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsageCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Counter != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Counter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ServiceName) > 0 {
+		i -= len(m.ServiceName)
+		copy(dAtA[i:], m.ServiceName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ServiceName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SkuUuid) > 0 {
+		i -= len(m.SkuUuid)
+		copy(dAtA[i:], m.SkuUuid)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SkuUuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LeaseUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LeaseUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeaseUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PeriodSpent) > 0 {
+		for iNdEx := len(m.PeriodSpent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodSpent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodStartedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodStartedAt):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintTypes(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x2a
+	if m.SpendPeriod != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpendPeriod))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Counters) > 0 {
+		for iNdEx := len(m.Counters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Counters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.LeaseUuid) > 0 {
+		i -= len(m.LeaseUuid)
+		copy(dAtA[i:], m.LeaseUuid)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LeaseUuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LeaseSettlement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LeaseSettlement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeaseSettlement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConvertedValue) > 0 {
+		for iNdEx := len(m.ConvertedValue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConvertedValue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Converted) > 0 {
+		for iNdEx := len(m.Converted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Converted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Promo) > 0 {
+		for iNdEx := len(m.Promo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Promo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Shortfall) > 0 {
+		for iNdEx := len(m.Shortfall) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shortfall[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Transferred) > 0 {
+		for iNdEx := len(m.Transferred) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transferred[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Accrued) > 0 {
+		for iNdEx := len(m.Accrued) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accrued[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SettledAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SettledAt):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintTypes(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x32
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodEnd):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintTypes(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x2a
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodStart):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintTypes(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x22
+	if m.Trigger != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Trigger))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.LeaseUuid) > 0 {
+		i -= len(m.LeaseUuid)
+		copy(dAtA[i:], m.LeaseUuid)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LeaseUuid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Dispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Dispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Dispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderAmounts) > 0 {
+		for iNdEx := len(m.ProviderAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.TenantAmounts) > 0 {
+		for iNdEx := len(m.TenantAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TenantAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.ResolvedAt != nil {
+		n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ResolvedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ResolvedAt):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintTypes(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.RespondedAt != nil {
+		n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.RespondedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RespondedAt):])
+		if err17 != nil {
+			return 0, err17
+		}
+		i -= n17
+		i = encodeVarintTypes(dAtA, i, uint64(n17))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Response) > 0 {
+		i -= len(m.Response)
+		copy(dAtA[i:], m.Response)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Response)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ResponseDeadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ResponseDeadline):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintTypes(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x42
+	n19, err19 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.OpenedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.OpenedAt):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintTypes(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Escrowed) > 0 {
+		for iNdEx := len(m.Escrowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.State != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProviderUuid) > 0 {
+		i -= len(m.ProviderUuid)
+		copy(dAtA[i:], m.ProviderUuid)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ProviderUuid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LeaseUuid) > 0 {
+		i -= len(m.LeaseUuid)
+		copy(dAtA[i:], m.LeaseUuid)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LeaseUuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GracePeriod != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GracePeriod))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.OverrideGracePeriod {
+		i--
+		if m.OverrideGracePeriod {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.DeniedTenants) > 0 {
+		for iNdEx := len(m.DeniedTenants) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedTenants[iNdEx])
+			copy(dAtA[i:], m.DeniedTenants[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.DeniedTenants[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AllowedTenants) > 0 {
+		for iNdEx := len(m.AllowedTenants) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedTenants[iNdEx])
+			copy(dAtA[i:], m.AllowedTenants[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.AllowedTenants[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.AutoAcknowledge {
+		i--
+		if m.AutoAcknowledge {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PendingTimeout != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PendingTimeout))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProviderUuid) > 0 {
+		i -= len(m.ProviderUuid)
+		copy(dAtA[i:], m.ProviderUuid)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ProviderUuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LeasesAcknowledgedAtCreation != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LeasesAcknowledgedAtCreation))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.LifetimeSum != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LifetimeSum))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.AckLatencyBuckets) > 0 {
+		dAtA21 := make([]byte, len(m.AckLatencyBuckets)*10)
+		var j20 int
+		for _, num := range m.AckLatencyBuckets {
+			for num >= 1<<7 {
+				dAtA21[j20] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j20++
+			}
+			dAtA21[j20] = uint8(num)
+			j20++
+		}
+		i -= j20
+		copy(dAtA[i:], dAtA21[:j20])
+		i = encodeVarintTypes(dAtA, i, uint64(j20))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.AckLatencySum != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AckLatencySum))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CreditExhaustionClosures != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CreditExhaustionClosures))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.LeasesClosed != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LeasesClosed))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LeasesExpired != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LeasesExpired))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LeasesCancelled != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LeasesCancelled))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LeasesRejected != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LeasesRejected))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LeasesAcknowledged != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LeasesAcknowledged))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LeasesCreated != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LeasesCreated))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProviderUuid) > 0 {
+		i -= len(m.ProviderUuid)
+		copy(dAtA[i:], m.ProviderUuid)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ProviderUuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CustomDomainTarget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustomDomainTarget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CustomDomainTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ServiceName) > 0 {
+		i -= len(m.ServiceName)
+		copy(dAtA[i:], m.ServiceName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ServiceName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LeaseUuid) > 0 {
+		i -= len(m.LeaseUuid)
+		copy(dAtA[i:], m.LeaseUuid)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LeaseUuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreditAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreditAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreditAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReservedAmounts) > 0 {
+		for iNdEx := len(m.ReservedAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReservedAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.PendingLeaseCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PendingLeaseCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ActiveLeaseCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ActiveLeaseCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CreditAddress) > 0 {
+		i -= len(m.CreditAddress)
+		copy(dAtA[i:], m.CreditAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CreditAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreditDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreditDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreditDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n22, err22 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.DepositedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DepositedAt):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintTypes(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PromoGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PromoGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PromoGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SkuUuids) > 0 {
+		for iNdEx := len(m.SkuUuids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SkuUuids[iNdEx])
+			copy(dAtA[i:], m.SkuUuids[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.SkuUuids[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ProviderUuids) > 0 {
+		for iNdEx := len(m.ProviderUuids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProviderUuids[iNdEx])
+			copy(dAtA[i:], m.ProviderUuids[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.ProviderUuids[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	n24, err24 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err24 != nil {
+		return 0, err24
+	}
+	i -= n24
+	i = encodeVarintTypes(dAtA, i, uint64(n24))
+	i--
+	dAtA[i] = 0x3a
+	n25, err25 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err25 != nil {
+		return 0, err25
+	}
+	i -= n25
+	i = encodeVarintTypes(dAtA, i, uint64(n25))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Remaining) > 0 {
+		for iNdEx := len(m.Remaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExchangeRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n26, err26 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SetAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SetAt):])
+	if err26 != nil {
+		return 0, err26
+	}
+	i -= n26
+	i = encodeVarintTypes(dAtA, i, uint64(n26))
+	i--
+	dAtA[i] = 0x32
+	if len(m.SetBy) > 0 {
+		i -= len(m.SetBy)
+		copy(dAtA[i:], m.SetBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SetBy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n27, err27 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ValidUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ValidUntil):])
+	if err27 != nil {
+		return 0, err27
+	}
+	i -= n27
+	i = encodeVarintTypes(dAtA, i, uint64(n27))
+	i--
+	dAtA[i] = 0x22
+	n28, err28 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ValidFrom, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ValidFrom):])
+	if err28 != nil {
+		return 0, err28
+	}
+	i -= n28
+	i = encodeVarintTypes(dAtA, i, uint64(n28))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxLeasesPerTenant != 0 {
+		n += 1 + sovTypes(uint64(m.MaxLeasesPerTenant))
+	}
+	if len(m.AllowedList) > 0 {
+		for _, s := range m.AllowedList {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.MaxItemsPerLease != 0 {
+		n += 1 + sovTypes(uint64(m.MaxItemsPerLease))
+	}
+	if m.MinLeaseDuration != 0 {
+		n += 1 + sovTypes(uint64(m.MinLeaseDuration))
+	}
+	if m.MaxPendingLeasesPerTenant != 0 {
+		n += 1 + sovTypes(uint64(m.MaxPendingLeasesPerTenant))
+	}
+	if m.PendingTimeout != 0 {
+		n += 1 + sovTypes(uint64(m.PendingTimeout))
+	}
+	if len(m.ReservedDomainSuffixes) > 0 {
+		for _, s := range m.ReservedDomainSuffixes {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.CreditWithdrawalCooldown != 0 {
+		n += 1 + sovTypes(uint64(m.CreditWithdrawalCooldown))
+	}
+	if m.GracePeriod != 0 {
+		n += 1 + sovTypes(uint64(m.GracePeriod))
+	}
+	if m.LeaseRetentionPeriod != 0 {
+		n += 1 + sovTypes(uint64(m.LeaseRetentionPeriod))
+	}
+	if m.DisputeResponsePeriod != 0 {
+		n += 1 + sovTypes(uint64(m.DisputeResponsePeriod))
+	}
+	l = len(m.ReferenceDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.PriceFeeders) > 0 {
+		for _, s := range m.PriceFeeders {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.MaxProviderGracePeriod != 0 {
+		n += 1 + sovTypes(uint64(m.MaxProviderGracePeriod))
+	}
+	return n
+}
+
+func (m *LeaseItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SkuUuid)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovTypes(uint64(m.Quantity))
+	}
+	l = m.LockedPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.ServiceName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.CustomDomain)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Metered {
+		n += 2
+	}
+	l = m.LockedRate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.CalendarMonth {
+		n += 2
+	}
+	l = m.MonthlyPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *Lease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uuid)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ProviderUuid)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.State != 0 {
+		n += 1 + sovTypes(uint64(m.State))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.ClosedAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClosedAt)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastSettledAt)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.AcknowledgedAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.AcknowledgedAt)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.RejectedAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RejectedAt)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.RejectionReason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ExpiredAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiredAt)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ClosureReason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.MetaHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.MinLeaseDurationAtCreation != 0 {
+		n += 1 + sovTypes(uint64(m.MinLeaseDurationAtCreation))
+	}
+	if m.ScheduledEndAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ScheduledEndAt)
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.GraceEndsAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.GraceEndsAt)
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if len(m.Debt) > 0 {
+		for _, e := range m.Debt {
+			l = e.Size()
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Disputed {
+		n += 3
+	}
+	if len(m.TotalSettled) > 0 {
+		for _, e := range m.TotalSettled {
+			l = e.Size()
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.AccrualCarry) > 0 {
+		for _, e := range m.AccrualCarry {
+			l = e.Size()
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LeaseAmendment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LeaseUuid)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ProviderUuid)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.AddItems) > 0 {
+		for _, e := range m.AddItems {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.RemoveItems) > 0 {
+		for _, s := range m.RemoveItems {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.ReservedAmounts) > 0 {
+		for _, e := range m.ReservedAmounts {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *UsageCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SkuUuid)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ServiceName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Counter != 0 {
+		n += 1 + sovTypes(uint64(m.Counter))
+	}
+	return n
+}
+
+func (m *LeaseUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LeaseUuid)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Counters) > 0 {
+		for _, e := range m.Counters {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.SpendPeriod != 0 {
+		n += 1 + sovTypes(uint64(m.SpendPeriod))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodStartedAt)
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.PeriodSpent) > 0 {
+		for _, e := range m.PeriodSpent {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LeaseSettlement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.LeaseUuid)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Trigger != 0 {
+		n += 1 + sovTypes(uint64(m.Trigger))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodStart)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodEnd)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SettledAt)
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.Accrued) > 0 {
+		for _, e := range m.Accrued {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Transferred) > 0 {
+		for _, e := range m.Transferred {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Shortfall) > 0 {
+		for _, e := range m.Shortfall {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Promo) > 0 {
+		for _, e := range m.Promo {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Converted) > 0 {
+		for _, e := range m.Converted {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.ConvertedValue) > 0 {
+		for _, e := range m.ConvertedValue {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Dispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LeaseUuid)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ProviderUuid)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovTypes(uint64(m.State))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Escrowed) > 0 {
+		for _, e := range m.Escrowed {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.OpenedAt)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ResponseDeadline)
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Response)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.RespondedAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RespondedAt)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ResolvedAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ResolvedAt)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.TenantAmounts) > 0 {
+		for _, e := range m.TenantAmounts {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.ProviderAmounts) > 0 {
+		for _, e := range m.ProviderAmounts {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProviderPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderUuid)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.PendingTimeout != 0 {
+		n += 1 + sovTypes(uint64(m.PendingTimeout))
+	}
+	if m.AutoAcknowledge {
+		n += 2
+	}
+	if len(m.AllowedTenants) > 0 {
+		for _, s := range m.AllowedTenants {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.DeniedTenants) > 0 {
+		for _, s := range m.DeniedTenants {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.OverrideGracePeriod {
+		n += 2
+	}
+	if m.GracePeriod != 0 {
+		n += 1 + sovTypes(uint64(m.GracePeriod))
+	}
+	return n
+}
+
+func (m *ProviderStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderUuid)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.LeasesCreated != 0 {
+		n += 1 + sovTypes(uint64(m.LeasesCreated))
+	}
+	if m.LeasesAcknowledged != 0 {
+		n += 1 + sovTypes(uint64(m.LeasesAcknowledged))
+	}
+	if m.LeasesRejected != 0 {
+		n += 1 + sovTypes(uint64(m.LeasesRejected))
+	}
+	if m.LeasesCancelled != 0 {
+		n += 1 + sovTypes(uint64(m.LeasesCancelled))
+	}
+	if m.LeasesExpired != 0 {
+		n += 1 + sovTypes(uint64(m.LeasesExpired))
+	}
+	if m.LeasesClosed != 0 {
+		n += 1 + sovTypes(uint64(m.LeasesClosed))
+	}
+	if m.CreditExhaustionClosures != 0 {
+		n += 1 + sovTypes(uint64(m.CreditExhaustionClosures))
+	}
+	if m.AckLatencySum != 0 {
+		n += 1 + sovTypes(uint64(m.AckLatencySum))
+	}
+	if len(m.AckLatencyBuckets) > 0 {
+		l = 0
+		for _, e := range m.AckLatencyBuckets {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if m.LifetimeSum != 0 {
+		n += 1 + sovTypes(uint64(m.LifetimeSum))
+	}
+	if m.LeasesAcknowledgedAtCreation != 0 {
+		n += 1 + sovTypes(uint64(m.LeasesAcknowledgedAtCreation))
+	}
+	return n
+}
+
+func (m *CustomDomainTarget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LeaseUuid)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ServiceName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *CreditAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.CreditAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ActiveLeaseCount != 0 {
+		n += 1 + sovTypes(uint64(m.ActiveLeaseCount))
+	}
+	if m.PendingLeaseCount != 0 {
+		n += 1 + sovTypes(uint64(m.PendingLeaseCount))
+	}
+	if len(m.ReservedAmounts) > 0 {
+		for _, e := range m.ReservedAmounts {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CreditDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DepositedAt)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *PromoGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Remaining) > 0 {
+		for _, e := range m.Remaining {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.ProviderUuids) > 0 {
+		for _, s := range m.ProviderUuids {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.SkuUuids) > 0 {
+		for _, s := range m.SkuUuids {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExchangeRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ValidFrom)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ValidUntil)
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.SetBy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SetAt)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeasesPerTenant", wireType)
+			}
+			m.MaxLeasesPerTenant = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLeasesPerTenant |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedList = append(m.AllowedList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxItemsPerLease", wireType)
+			}
+			m.MaxItemsPerLease = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxItemsPerLease |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLeaseDuration", wireType)
+			}
+			m.MinLeaseDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinLeaseDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPendingLeasesPerTenant", wireType)
+			}
+			m.MaxPendingLeasesPerTenant = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPendingLeasesPerTenant |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTimeout", wireType)
+			}
+			m.PendingTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedDomainSuffixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReservedDomainSuffixes = append(m.ReservedDomainSuffixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditWithdrawalCooldown", wireType)
+			}
+			m.CreditWithdrawalCooldown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreditWithdrawalCooldown |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+			}
+			m.GracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GracePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseRetentionPeriod", wireType)
+			}
+			m.LeaseRetentionPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaseRetentionPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeResponsePeriod", wireType)
+			}
+			m.DisputeResponsePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeResponsePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferenceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceFeeders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceFeeders = append(m.PriceFeeders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProviderGracePeriod", wireType)
+			}
+			m.MaxProviderGracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxProviderGracePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeaseItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaseItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaseItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkuUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SkuUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockedPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomDomain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CustomDomain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Metered = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockedRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CalendarMonth", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CalendarMonth = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthlyPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MonthlyPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Lease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Lease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Lease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, LeaseItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= LeaseState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClosedAt == nil {
+				m.ClosedAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ClosedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSettledAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastSettledAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcknowledgedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AcknowledgedAt == nil {
+				m.AcknowledgedAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.AcknowledgedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RejectedAt == nil {
+				m.RejectedAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.RejectedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectionReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RejectionReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiredAt == nil {
+				m.ExpiredAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiredAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClosureReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetaHash = append(m.MetaHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.MetaHash == nil {
+				m.MetaHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLeaseDurationAtCreation", wireType)
+			}
+			m.MinLeaseDurationAtCreation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinLeaseDurationAtCreation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEndAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledEndAt == nil {
+				m.ScheduledEndAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ScheduledEndAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GraceEndsAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GraceEndsAt == nil {
+				m.GraceEndsAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.GraceEndsAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Debt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Debt = append(m.Debt, types.Coin{})
+			if err := m.Debt[len(m.Debt)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disputed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disputed = bool(v != 0)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSettled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalSettled = append(m.TotalSettled, types.Coin{})
+			if err := m.TotalSettled[len(m.TotalSettled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccrualCarry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccrualCarry = append(m.AccrualCarry, types.DecCoin{})
+			if err := m.AccrualCarry[len(m.AccrualCarry)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeaseAmendment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaseAmendment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaseAmendment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddItems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddItems = append(m.AddItems, LeaseItem{})
+			if err := m.AddItems[len(m.AddItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveItems", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveItems = append(m.RemoveItems, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReservedAmounts = append(m.ReservedAmounts, types.Coin{})
+			if err := m.ReservedAmounts[len(m.ReservedAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UsageCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UsageCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UsageCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkuUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SkuUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
+			}
+			m.Counter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Counter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeaseUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaseUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaseUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counters = append(m.Counters, UsageCounter{})
+			if err := m.Counters[len(m.Counters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendPeriod", wireType)
+			}
+			m.SpendPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpendPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodStartedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodSpent = append(m.PeriodSpent, types.Coin{})
+			if err := m.PeriodSpent[len(m.PeriodSpent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeaseSettlement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaseSettlement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaseSettlement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+			}
+			m.Trigger = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Trigger |= SettlementTrigger(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodEnd, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SettledAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accrued", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accrued = append(m.Accrued, types.Coin{})
+			if err := m.Accrued[len(m.Accrued)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transferred", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transferred = append(m.Transferred, types.Coin{})
+			if err := m.Transferred[len(m.Transferred)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shortfall", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shortfall = append(m.Shortfall, types.Coin{})
+			if err := m.Shortfall[len(m.Shortfall)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Promo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Promo = append(m.Promo, types.Coin{})
+			if err := m.Promo[len(m.Promo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Converted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Converted = append(m.Converted, types.Coin{})
+			if err := m.Converted[len(m.Converted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvertedValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConvertedValue = append(m.ConvertedValue, types.Coin{})
+			if err := m.ConvertedValue[len(m.ConvertedValue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Dispute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Dispute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Dispute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= DisputeState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrowed = append(m.Escrowed, types.Coin{})
+			if err := m.Escrowed[len(m.Escrowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.OpenedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseDeadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ResponseDeadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Response = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RespondedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RespondedAt == nil {
+				m.RespondedAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.RespondedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResolvedAt == nil {
+				m.ResolvedAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ResolvedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TenantAmounts = append(m.TenantAmounts, types.Coin{})
+			if err := m.TenantAmounts[len(m.TenantAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAmounts = append(m.ProviderAmounts, types.Coin{})
+			if err := m.ProviderAmounts[len(m.ProviderAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTimeout", wireType)
+			}
+			m.PendingTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoAcknowledge", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoAcknowledge = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedTenants", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedTenants = append(m.AllowedTenants, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedTenants", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedTenants = append(m.DeniedTenants, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverrideGracePeriod", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OverrideGracePeriod = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+			}
+			m.GracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GracePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ProviderStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1363,34 +7651,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProviderStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProviderStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeasesPerTenant", wireType)
-			}
-			m.MaxLeasesPerTenant = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxLeasesPerTenant |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderUuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1418,13 +7687,32 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedList = append(m.AllowedList, string(dAtA[iNdEx:postIndex]))
+			m.ProviderUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeasesCreated", wireType)
+			}
+			m.LeasesCreated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeasesCreated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxItemsPerLease", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeasesAcknowledged", wireType)
 			}
-			m.MaxItemsPerLease = 0
+			m.LeasesAcknowledged = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1434,16 +7722,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxItemsPerLease |= uint64(b&0x7F) << shift
+				m.LeasesAcknowledged |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinLeaseDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeasesRejected", wireType)
 			}
-			m.MinLeaseDuration = 0
+			m.LeasesRejected = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1453,16 +7741,275 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinLeaseDuration |= uint64(b&0x7F) << shift
+				m.LeasesRejected |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPendingLeasesPerTenant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeasesCancelled", wireType)
+			}
+			m.LeasesCancelled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeasesCancelled |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeasesExpired", wireType)
+			}
+			m.LeasesExpired = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeasesExpired |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeasesClosed", wireType)
+			}
+			m.LeasesClosed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeasesClosed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditExhaustionClosures", wireType)
+			}
+			m.CreditExhaustionClosures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreditExhaustionClosures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckLatencySum", wireType)
+			}
+			m.AckLatencySum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckLatencySum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AckLatencyBuckets = append(m.AckLatencyBuckets, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AckLatencyBuckets) == 0 {
+					m.AckLatencyBuckets = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AckLatencyBuckets = append(m.AckLatencyBuckets, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckLatencyBuckets", wireType)
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LifetimeSum", wireType)
+			}
+			m.LifetimeSum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LifetimeSum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeasesAcknowledgedAtCreation", wireType)
+			}
+			m.LeasesAcknowledgedAtCreation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeasesAcknowledgedAtCreation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CustomDomainTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomDomainTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomDomainTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
 			}
-			m.MaxPendingLeasesPerTenant = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1472,33 +8019,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxPendingLeasesPerTenant |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingTimeout", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.PendingTimeout = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PendingTimeout |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-		case 7:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReservedDomainSuffixes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1526,7 +8067,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReservedDomainSuffixes = append(m.ReservedDomainSuffixes, string(dAtA[iNdEx:postIndex]))
+			m.ServiceName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1549,7 +8090,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LeaseItem) Unmarshal(dAtA []byte) error {
+func (m *CreditAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1572,15 +8113,15 @@ func (m *LeaseItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LeaseItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreditAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LeaseItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreditAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkuUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1608,13 +8149,13 @@ func (m *LeaseItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SkuUuid = string(dAtA[iNdEx:postIndex])
+			m.Tenant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditAddress", wireType)
 			}
-			m.Quantity = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1624,16 +8165,29 @@ func (m *LeaseItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Quantity |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockedPrice", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveLeaseCount", wireType)
 			}
-			var msglen int
+			m.ActiveLeaseCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1643,30 +8197,16 @@ func (m *LeaseItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ActiveLeaseCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LockedPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingLeaseCount", wireType)
 			}
-			var stringLen uint64
+			m.PendingLeaseCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1676,29 +8216,16 @@ func (m *LeaseItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PendingLeaseCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ServiceName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CustomDomain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedAmounts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1708,23 +8235,25 @@ func (m *LeaseItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CustomDomain = string(dAtA[iNdEx:postIndex])
+			m.ReservedAmounts = append(m.ReservedAmounts, types.Coin{})
+			if err := m.ReservedAmounts[len(m.ReservedAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1747,7 +8276,7 @@ func (m *LeaseItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Lease) Unmarshal(dAtA []byte) error {
+func (m *CreditDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1770,45 +8299,13 @@ func (m *Lease) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Lease: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreditDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Lease: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreditDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Uuid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
 			}
@@ -1840,11 +8337,11 @@ func (m *Lease) Unmarshal(dAtA []byte) error {
 			}
 			m.Tenant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1854,27 +8351,28 @@ func (m *Lease) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderUuid = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1901,35 +8399,65 @@ func (m *Lease) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, LeaseItem{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.DepositedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= LeaseState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromoGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromoGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromoGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1939,30 +8467,16 @@ func (m *Lease) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClosedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1972,33 +8486,29 @@ func (m *Lease) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClosedAt == nil {
-				m.ClosedAt = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ClosedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastSettledAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2008,28 +8518,27 @@ func (m *Lease) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastSettledAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AcknowledgedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2056,16 +8565,14 @@ func (m *Lease) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AcknowledgedAt == nil {
-				m.AcknowledgedAt = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.AcknowledgedAt, dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejectedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2092,18 +8599,16 @@ func (m *Lease) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RejectedAt == nil {
-				m.RejectedAt = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.RejectedAt, dAtA[iNdEx:postIndex]); err != nil {
+			m.Remaining = append(m.Remaining, types.Coin{})
+			if err := m.Remaining[len(m.Remaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejectionReason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2113,27 +8618,28 @@ func (m *Lease) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RejectionReason = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2160,16 +8666,13 @@ func (m *Lease) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpiredAt == nil {
-				m.ExpiredAt = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiredAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClosureReason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderUuids", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2197,13 +8700,13 @@ func (m *Lease) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClosureReason = string(dAtA[iNdEx:postIndex])
+			m.ProviderUuids = append(m.ProviderUuids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 14:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SkuUuids", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2213,45 +8716,24 @@ func (m *Lease) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetaHash = append(m.MetaHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.MetaHash == nil {
-				m.MetaHash = []byte{}
-			}
+			m.SkuUuids = append(m.SkuUuids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinLeaseDurationAtCreation", wireType)
-			}
-			m.MinLeaseDurationAtCreation = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinLeaseDurationAtCreation |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2273,7 +8755,7 @@ func (m *Lease) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CustomDomainTarget) Unmarshal(dAtA []byte) error {
+func (m *ExchangeRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2296,15 +8778,15 @@ func (m *CustomDomainTarget) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CustomDomainTarget: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExchangeRate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CustomDomainTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExchangeRate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaseUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2332,11 +8814,11 @@ func (m *CustomDomainTarget) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LeaseUuid = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2364,63 +8846,15 @@ func (m *CustomDomainTarget) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreditAccount) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreditAccount: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreditAccount: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2430,29 +8864,30 @@ func (m *CreditAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tenant = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ValidFrom, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreditAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2462,29 +8897,30 @@ func (m *CreditAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreditAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ValidUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveLeaseCount", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetBy", wireType)
 			}
-			m.ActiveLeaseCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2494,33 +8930,27 @@ func (m *CreditAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActiveLeaseCount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingLeaseCount", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.PendingLeaseCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PendingLeaseCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-		case 5:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SetBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReservedAmounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2547,8 +8977,7 @@ func (m *CreditAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReservedAmounts = append(m.ReservedAmounts, types.Coin{})
-			if err := m.ReservedAmounts[len(m.ReservedAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SetAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11,6 +11,7 @@ package types_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -146,6 +147,93 @@ func TestParams_Validate(t *testing.T) {
 			params:    types.NewParams(10, []string{}, 20, 3600, types.MaxPendingLeasesPerTenantUpperBound, 1800),
 			expectErr: false,
 		},
+		{
+			name: "credit withdrawal cooldown exceeds upper bound",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.CreditWithdrawalCooldown = types.MaxCreditWithdrawalCooldown + 1
+				return p
+			}(),
+			expectErr: true,
+			errMsg:    "credit_withdrawal_cooldown",
+		},
+		{
+			name: "credit withdrawal cooldown disabled",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.CreditWithdrawalCooldown = 0
+				return p
+			}(),
+			expectErr: false,
+		},
+		{
+			name: "grace period exceeds upper bound",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.GracePeriod = types.MaxGracePeriod + 1
+				return p
+			}(),
+			expectErr: true,
+			errMsg:    "grace_period",
+		},
+		{
+			name: "grace period at upper bound",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.GracePeriod = types.MaxGracePeriod
+				return p
+			}(),
+			expectErr: false,
+		},
+		{
+			name: "max provider grace period exceeds upper bound",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.MaxProviderGracePeriod = types.MaxGracePeriod + 1
+				return p
+			}(),
+			expectErr: true,
+			errMsg:    "max_provider_grace_period",
+		},
+		{
+			name: "lease retention period exceeds upper bound",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.LeaseRetentionPeriod = types.MaxLeaseRetentionPeriod + 1
+				return p
+			}(),
+			expectErr: true,
+			errMsg:    "lease_retention_period",
+		},
+		{
+			name: "dispute response period below minimum",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.DisputeResponsePeriod = types.MinDisputeResponsePeriod - 1
+				return p
+			}(),
+			expectErr: true,
+			errMsg:    "dispute_response_period",
+		},
+		{
+			name: "dispute response period exceeds upper bound",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.DisputeResponsePeriod = types.MaxDisputeResponsePeriod + 1
+				return p
+			}(),
+			expectErr: true,
+			errMsg:    "dispute_response_period",
+		},
+		{
+			name: "zero dispute response period disables disputes",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.DisputeResponsePeriod = 0
+				return p
+			}(),
+			expectErr: false,
+		},
 		{
 			name:      "valid params with allowed list",
 			params:    types.NewParams(10, []string{"manifest1xyz"}, 20, 3600, 10, 1800),
@@ -301,6 +389,557 @@ func TestMsgFundCredit_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgWithdrawCredit_ValidateBasic(t *testing.T) {
+	_, _, tenantAddr := testdata.KeyTestPubAddr()
+	_, _, recipientAddr := testdata.KeyTestPubAddr()
+	tenant := tenantAddr.String()
+	recipient := recipientAddr.String()
+	amount := sdk.NewCoins(sdk.NewCoin(testDenom, math.NewInt(1000)))
+
+	tests := []struct {
+		name      string
+		msg       types.MsgWithdrawCredit
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			name:      "valid message",
+			msg:       types.MsgWithdrawCredit{Tenant: tenant, Recipient: recipient, Amount: amount},
+			expectErr: false,
+		},
+		{
+			name:      "valid message - withdraw to self",
+			msg:       types.MsgWithdrawCredit{Tenant: tenant, Recipient: tenant, Amount: amount},
+			expectErr: false,
+		},
+		{
+			name:      "invalid tenant address",
+			msg:       types.MsgWithdrawCredit{Tenant: invalidAddr, Recipient: recipient, Amount: amount},
+			expectErr: true,
+			errMsg:    "invalid tenant address",
+		},
+		{
+			name:      "invalid recipient address",
+			msg:       types.MsgWithdrawCredit{Tenant: tenant, Recipient: invalidAddr, Amount: amount},
+			expectErr: true,
+			errMsg:    "invalid recipient address",
+		},
+		{
+			name:      "empty amount",
+			msg:       types.MsgWithdrawCredit{Tenant: tenant, Recipient: recipient, Amount: sdk.NewCoins()},
+			expectErr: true,
+			errMsg:    "amount must be positive",
+		},
+		{
+			name: "negative amount",
+			msg: types.MsgWithdrawCredit{
+				Tenant:    tenant,
+				Recipient: recipient,
+				Amount:    sdk.Coins{sdk.Coin{Denom: testDenom, Amount: math.NewInt(-1)}},
+			},
+			expectErr: true,
+			errMsg:    "amount must be positive",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgTransferCredit_ValidateBasic(t *testing.T) {
+	_, _, fromAddr := testdata.KeyTestPubAddr()
+	_, _, toAddr := testdata.KeyTestPubAddr()
+	from := fromAddr.String()
+	to := toAddr.String()
+	amount := sdk.NewCoins(sdk.NewCoin(testDenom, math.NewInt(1000)))
+
+	tests := []struct {
+		name      string
+		msg       types.MsgTransferCredit
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			name:      "valid message",
+			msg:       types.MsgTransferCredit{Sender: from, FromTenant: from, ToTenant: to, Amount: amount},
+			expectErr: false,
+		},
+		{
+			name:      "invalid sender address",
+			msg:       types.MsgTransferCredit{Sender: invalidAddr, FromTenant: from, ToTenant: to, Amount: amount},
+			expectErr: true,
+			errMsg:    "invalid sender address",
+		},
+		{
+			name:      "invalid from_tenant address",
+			msg:       types.MsgTransferCredit{Sender: from, FromTenant: invalidAddr, ToTenant: to, Amount: amount},
+			expectErr: true,
+			errMsg:    "invalid from_tenant address",
+		},
+		{
+			name:      "invalid to_tenant address",
+			msg:       types.MsgTransferCredit{Sender: from, FromTenant: from, ToTenant: invalidAddr, Amount: amount},
+			expectErr: true,
+			errMsg:    "invalid to_tenant address",
+		},
+		{
+			name:      "same source and destination",
+			msg:       types.MsgTransferCredit{Sender: from, FromTenant: from, ToTenant: from, Amount: amount},
+			expectErr: true,
+			errMsg:    "must differ",
+		},
+		{
+			name:      "empty amount",
+			msg:       types.MsgTransferCredit{Sender: from, FromTenant: from, ToTenant: to, Amount: sdk.NewCoins()},
+			expectErr: true,
+			errMsg:    "amount must be positive",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateLeaseItems_ValidateBasic(t *testing.T) {
+	_, _, tenantAddr := testdata.KeyTestPubAddr()
+	tenant := tenantAddr.String()
+	leaseUUID := "01912345-6789-7abc-8def-0123456789ab"
+	skuUUID := "01912345-6789-7abc-8def-0123456789ac"
+
+	tests := []struct {
+		name      string
+		msg       types.MsgUpdateLeaseItems
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			name: "valid message",
+			msg: types.MsgUpdateLeaseItems{
+				Tenant:    tenant,
+				LeaseUuid: leaseUUID,
+				Items:     []types.LeaseItemInput{{SkuUuid: skuUUID, Quantity: 4}},
+			},
+			expectErr: false,
+		},
+		{
+			name: "valid message - service_name mode",
+			msg: types.MsgUpdateLeaseItems{
+				Tenant:    tenant,
+				LeaseUuid: leaseUUID,
+				Items: []types.LeaseItemInput{
+					{SkuUuid: skuUUID, Quantity: 4, ServiceName: "web"},
+					{SkuUuid: skuUUID, Quantity: 1, ServiceName: "db"},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid tenant address",
+			msg: types.MsgUpdateLeaseItems{
+				Tenant:    invalidAddr,
+				LeaseUuid: leaseUUID,
+				Items:     []types.LeaseItemInput{{SkuUuid: skuUUID, Quantity: 4}},
+			},
+			expectErr: true,
+			errMsg:    "invalid tenant address",
+		},
+		{
+			name: "empty lease uuid",
+			msg: types.MsgUpdateLeaseItems{
+				Tenant: tenant,
+				Items:  []types.LeaseItemInput{{SkuUuid: skuUUID, Quantity: 4}},
+			},
+			expectErr: true,
+			errMsg:    "lease_uuid cannot be empty",
+		},
+		{
+			name: "invalid lease uuid",
+			msg: types.MsgUpdateLeaseItems{
+				Tenant:    tenant,
+				LeaseUuid: "not-a-uuid",
+				Items:     []types.LeaseItemInput{{SkuUuid: skuUUID, Quantity: 4}},
+			},
+			expectErr: true,
+			errMsg:    "invalid lease_uuid format",
+		},
+		{
+			name: "no items",
+			msg: types.MsgUpdateLeaseItems{
+				Tenant:    tenant,
+				LeaseUuid: leaseUUID,
+			},
+			expectErr: true,
+			errMsg:    "lease must contain at least one item",
+		},
+		{
+			name: "zero quantity",
+			msg: types.MsgUpdateLeaseItems{
+				Tenant:    tenant,
+				LeaseUuid: leaseUUID,
+				Items:     []types.LeaseItemInput{{SkuUuid: skuUUID, Quantity: 0}},
+			},
+			expectErr: true,
+			errMsg:    "zero quantity",
+		},
+		{
+			name: "duplicate sku in legacy mode",
+			msg: types.MsgUpdateLeaseItems{
+				Tenant:    tenant,
+				LeaseUuid: leaseUUID,
+				Items: []types.LeaseItemInput{
+					{SkuUuid: skuUUID, Quantity: 1},
+					{SkuUuid: skuUUID, Quantity: 2},
+				},
+			},
+			expectErr: true,
+			errMsg:    "appears multiple times",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgProposeLeaseAmendment_ValidateBasic(t *testing.T) {
+	_, _, tenantAddr := testdata.KeyTestPubAddr()
+	tenant := tenantAddr.String()
+	leaseUUID := "01912345-6789-7abc-8def-0123456789ab"
+	skuUUID := "01912345-6789-7abc-8def-0123456789ac"
+
+	tests := []struct {
+		name      string
+		msg       types.MsgProposeLeaseAmendment
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			name: "valid add",
+			msg: types.MsgProposeLeaseAmendment{
+				Tenant:    tenant,
+				LeaseUuid: leaseUUID,
+				AddItems:  []types.LeaseItemInput{{SkuUuid: skuUUID, Quantity: 1, ServiceName: "cache"}},
+			},
+			expectErr: false,
+		},
+		{
+			name: "valid add and remove",
+			msg: types.MsgProposeLeaseAmendment{
+				Tenant:      tenant,
+				LeaseUuid:   leaseUUID,
+				AddItems:    []types.LeaseItemInput{{SkuUuid: skuUUID, Quantity: 1}},
+				RemoveItems: []string{"01912345-6789-7abc-8def-0123456789ad"},
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid tenant address",
+			msg: types.MsgProposeLeaseAmendment{
+				Tenant:      invalidAddr,
+				LeaseUuid:   leaseUUID,
+				RemoveItems: []string{"web"},
+			},
+			expectErr: true,
+			errMsg:    "invalid tenant address",
+		},
+		{
+			name: "invalid lease uuid",
+			msg: types.MsgProposeLeaseAmendment{
+				Tenant:      tenant,
+				LeaseUuid:   "not-a-uuid",
+				RemoveItems: []string{"web"},
+			},
+			expectErr: true,
+			errMsg:    "invalid lease_uuid format",
+		},
+		{
+			name: "nothing to change",
+			msg: types.MsgProposeLeaseAmendment{
+				Tenant:    tenant,
+				LeaseUuid: leaseUUID,
+			},
+			expectErr: true,
+			errMsg:    "at least one item",
+		},
+		{
+			name: "zero quantity add",
+			msg: types.MsgProposeLeaseAmendment{
+				Tenant:    tenant,
+				LeaseUuid: leaseUUID,
+				AddItems:  []types.LeaseItemInput{{SkuUuid: skuUUID, Quantity: 0}},
+			},
+			expectErr: true,
+			errMsg:    "zero quantity",
+		},
+		{
+			name: "invalid remove key",
+			msg: types.MsgProposeLeaseAmendment{
+				Tenant:      tenant,
+				LeaseUuid:   leaseUUID,
+				RemoveItems: []string{"Not_A_Label"},
+			},
+			expectErr: true,
+			errMsg:    "neither a sku_uuid nor a service_name",
+		},
+		{
+			name: "duplicate remove key",
+			msg: types.MsgProposeLeaseAmendment{
+				Tenant:      tenant,
+				LeaseUuid:   leaseUUID,
+				RemoveItems: []string{"web", "web"},
+			},
+			expectErr: true,
+			errMsg:    "listed more than once",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgLeaseAmendmentDecision_ValidateBasic(t *testing.T) {
+	_, _, senderAddr := testdata.KeyTestPubAddr()
+	sender := senderAddr.String()
+	leaseUUID := "01912345-6789-7abc-8def-0123456789ab"
+
+	require.NoError(t, (&types.MsgAcknowledgeLeaseAmendment{Sender: sender, LeaseUuid: leaseUUID}).ValidateBasic())
+	err := (&types.MsgAcknowledgeLeaseAmendment{Sender: invalidAddr, LeaseUuid: leaseUUID}).ValidateBasic()
+	require.ErrorContains(t, err, "invalid sender address")
+	err = (&types.MsgAcknowledgeLeaseAmendment{Sender: sender}).ValidateBasic()
+	require.ErrorContains(t, err, "lease_uuid cannot be empty")
+
+	require.NoError(t, (&types.MsgRejectLeaseAmendment{Sender: sender, LeaseUuid: leaseUUID, Reason: "no capacity"}).ValidateBasic())
+	err = (&types.MsgRejectLeaseAmendment{Sender: invalidAddr, LeaseUuid: leaseUUID}).ValidateBasic()
+	require.ErrorContains(t, err, "invalid sender address")
+	err = (&types.MsgRejectLeaseAmendment{Sender: sender, LeaseUuid: leaseUUID, Reason: strings.Repeat("x", types.MaxRejectionReasonLength+1)}).ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidRejectionReason)
+}
+
+func TestMsgSetLeaseScheduledEnd_ValidateBasic(t *testing.T) {
+	_, _, tenantAddr := testdata.KeyTestPubAddr()
+	leaseUUID := "01912345-6789-7abc-8def-0123456789ab"
+	endAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	require.NoError(t, (&types.MsgSetLeaseScheduledEnd{Tenant: tenantAddr.String(), LeaseUuid: leaseUUID, ScheduledEndAt: endAt}).ValidateBasic())
+
+	err := (&types.MsgSetLeaseScheduledEnd{Tenant: invalidAddr, LeaseUuid: leaseUUID, ScheduledEndAt: endAt}).ValidateBasic()
+	require.ErrorContains(t, err, "invalid tenant address")
+	err = (&types.MsgSetLeaseScheduledEnd{Tenant: tenantAddr.String(), LeaseUuid: "bad", ScheduledEndAt: endAt}).ValidateBasic()
+	require.ErrorContains(t, err, "invalid lease_uuid format")
+	err = (&types.MsgSetLeaseScheduledEnd{Tenant: tenantAddr.String(), LeaseUuid: leaseUUID}).ValidateBasic()
+	require.ErrorContains(t, err, "scheduled_end_at cannot be empty")
+}
+
+func TestMsgSetProviderPolicy_ValidateBasic(t *testing.T) {
+	_, _, senderAddr := testdata.KeyTestPubAddr()
+	_, _, tenantAddr := testdata.KeyTestPubAddr()
+	sender := senderAddr.String()
+	tenant := tenantAddr.String()
+	providerUUID := "01912345-6789-7abc-8def-0123456789ab"
+
+	valid := types.ProviderPolicy{
+		ProviderUuid:        providerUUID,
+		PendingTimeout:      types.MaxPendingTimeout,
+		AutoAcknowledge:     true,
+		AllowedTenants:      []string{tenant},
+		OverrideGracePeriod: true,
+		GracePeriod:         types.MaxGracePeriod,
+	}
+	require.NoError(t, (&types.MsgSetProviderPolicy{Sender: sender, Policy: valid}).ValidateBasic())
+	require.NoError(t, (&types.MsgSetProviderPolicy{Sender: sender, Policy: types.ProviderPolicy{ProviderUuid: providerUUID}}).ValidateBasic())
+	// Only provider_uuid is checked on reset.
+	require.NoError(t, (&types.MsgSetProviderPolicy{Sender: sender, Policy: types.ProviderPolicy{ProviderUuid: providerUUID, PendingTimeout: 1}, Reset_: true}).ValidateBasic())
+
+	tests := []struct {
+		name   string
+		msg    types.MsgSetProviderPolicy
+		errMsg string
+	}{
+		{"invalid sender", types.MsgSetProviderPolicy{Sender: invalidAddr, Policy: valid}, "invalid sender address"},
+		{"invalid provider uuid", types.MsgSetProviderPolicy{Sender: sender, Policy: types.ProviderPolicy{ProviderUuid: "bad"}}, "invalid provider_uuid format"},
+		{"invalid provider uuid on reset", types.MsgSetProviderPolicy{Sender: sender, Policy: types.ProviderPolicy{ProviderUuid: "bad"}, Reset_: true}, "invalid provider_uuid format"},
+		{"pending timeout too long", types.MsgSetProviderPolicy{Sender: sender, Policy: types.ProviderPolicy{ProviderUuid: providerUUID, PendingTimeout: types.MaxPendingTimeout + 1}}, "pending_timeout"},
+		{"invalid tenant", types.MsgSetProviderPolicy{Sender: sender, Policy: types.ProviderPolicy{ProviderUuid: providerUUID, DeniedTenants: []string{invalidAddr}}}, "invalid address"},
+		{"duplicate tenant", types.MsgSetProviderPolicy{Sender: sender, Policy: types.ProviderPolicy{ProviderUuid: providerUUID, AllowedTenants: []string{tenant, tenant}}}, "duplicate address"},
+		{"allowed and denied", types.MsgSetProviderPolicy{Sender: sender, Policy: types.ProviderPolicy{ProviderUuid: providerUUID, AllowedTenants: []string{tenant}, DeniedTenants: []string{tenant}}}, "both allowed and denied"},
+		{"grace period without override", types.MsgSetProviderPolicy{Sender: sender, Policy: types.ProviderPolicy{ProviderUuid: providerUUID, GracePeriod: 3600}}, "override_grace_period"},
+		{"grace period too long", types.MsgSetProviderPolicy{Sender: sender, Policy: types.ProviderPolicy{ProviderUuid: providerUUID, OverrideGracePeriod: true, GracePeriod: types.MaxGracePeriod + 1}}, "exceeds upper bound"},
+		{"too many tenants", types.MsgSetProviderPolicy{Sender: sender, Policy: types.ProviderPolicy{ProviderUuid: providerUUID, DeniedTenants: make([]string, types.MaxPolicyTenants+1)}}, "maximum is"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.ErrorContains(t, tc.msg.ValidateBasic(), tc.errMsg)
+		})
+	}
+}
+
+func TestProviderPolicy_AllowsTenant(t *testing.T) {
+	_, _, aAddr := testdata.KeyTestPubAddr()
+	_, _, bAddr := testdata.KeyTestPubAddr()
+	a, b := aAddr.String(), bAddr.String()
+
+	require.True(t, types.ProviderPolicy{}.AllowsTenant(a))
+	require.False(t, types.ProviderPolicy{DeniedTenants: []string{a}}.AllowsTenant(a))
+	require.True(t, types.ProviderPolicy{DeniedTenants: []string{a}}.AllowsTenant(b))
+	require.True(t, types.ProviderPolicy{AllowedTenants: []string{a}}.AllowsTenant(a))
+	require.False(t, types.ProviderPolicy{AllowedTenants: []string{a}}.AllowsTenant(b))
+}
+
+func TestProviderStats_RecordAcknowledgement(t *testing.T) {
+	stats := types.NewProviderStats("01912345-6789-7abc-8def-0123456789ac")
+	for _, latency := range []uint64{0, 10, 11, 21600, 21601} {
+		require.NoError(t, stats.RecordAcknowledgement(latency, false))
+	}
+	// Acknowledgements at creation stay out of the histogram.
+	require.NoError(t, stats.RecordAcknowledgement(0, true))
+
+	// Bounds are inclusive; anything past the last bound lands in the final bucket.
+	require.Equal(t, []uint64{2, 1, 0, 0, 0, 0, 0, 1, 1}, stats.AckLatencyBuckets)
+	require.Equal(t, uint64(6), stats.LeasesAcknowledged)
+	require.Equal(t, uint64(1), stats.LeasesAcknowledgedAtCreation)
+	require.Equal(t, uint64(43222), stats.AckLatencySum)
+	require.NoError(t, stats.Validate())
+
+	// A histogram of the wrong size is reported rather than reset.
+	stats.AckLatencyBuckets = stats.AckLatencyBuckets[:3]
+	require.ErrorContains(t, stats.RecordAcknowledgement(5, false), "latency buckets")
+	require.Equal(t, uint64(6), stats.LeasesAcknowledged)
+	require.Len(t, types.AckLatencyBucketBounds(), types.AckLatencyBucketCount-1)
+}
+
+func TestMsgReportUsage_ValidateBasic(t *testing.T) {
+	_, _, senderAddr := testdata.KeyTestPubAddr()
+	sender := senderAddr.String()
+	leaseUUID := "01912345-6789-7abc-8def-0123456789ab"
+	skuUUID := "01912345-6789-7abc-8def-0123456789ac"
+
+	require.NoError(t, (&types.MsgReportUsage{Sender: sender, LeaseUuid: leaseUUID, Usages: []types.UsageReport{
+		{SkuUuid: skuUUID, Counter: 10},
+	}}).ValidateBasic())
+	require.NoError(t, (&types.MsgReportUsage{Sender: sender, LeaseUuid: leaseUUID, Usages: []types.UsageReport{
+		{SkuUuid: skuUUID, ServiceName: "egress", Counter: 10},
+		{SkuUuid: skuUUID, ServiceName: "ingress", Counter: 0},
+	}}).ValidateBasic())
+
+	err := (&types.MsgReportUsage{Sender: invalidAddr, LeaseUuid: leaseUUID, Usages: []types.UsageReport{{SkuUuid: skuUUID}}}).ValidateBasic()
+	require.ErrorContains(t, err, "invalid sender address")
+	err = (&types.MsgReportUsage{Sender: sender, LeaseUuid: leaseUUID}).ValidateBasic()
+	require.ErrorContains(t, err, "usages cannot be empty")
+	err = (&types.MsgReportUsage{Sender: sender, LeaseUuid: leaseUUID, Usages: []types.UsageReport{{SkuUuid: "bad"}}}).ValidateBasic()
+	require.ErrorContains(t, err, "invalid sku_uuid format")
+	err = (&types.MsgReportUsage{Sender: sender, LeaseUuid: leaseUUID, Usages: []types.UsageReport{{SkuUuid: skuUUID, ServiceName: "Bad_Name"}}}).ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidServiceName)
+	err = (&types.MsgReportUsage{Sender: sender, LeaseUuid: leaseUUID, Usages: []types.UsageReport{
+		{SkuUuid: skuUUID, Counter: 1},
+		{SkuUuid: skuUUID, Counter: 2},
+	}}).ValidateBasic()
+	require.ErrorContains(t, err, "reported more than once")
+	err = (&types.MsgReportUsage{Sender: sender, LeaseUuid: leaseUUID, Usages: make([]types.UsageReport, types.MaxItemsPerLeaseHardLimit+1)}).ValidateBasic()
+	require.ErrorIs(t, err, types.ErrTooManyLeaseItems)
+}
+
+func TestMsgSetUsageSpendLimit_ValidateBasic(t *testing.T) {
+	_, _, tenantAddr := testdata.KeyTestPubAddr()
+	tenant := tenantAddr.String()
+	leaseUUID := "01912345-6789-7abc-8def-0123456789ab"
+	limit := sdk.NewCoins(sdk.NewCoin(testDenom, math.NewInt(1000)))
+
+	require.NoError(t, (&types.MsgSetUsageSpendLimit{Tenant: tenant, LeaseUuid: leaseUUID, SpendLimit: limit, Period: types.MinUsageSpendPeriod}).ValidateBasic())
+	require.NoError(t, (&types.MsgSetUsageSpendLimit{Tenant: tenant, LeaseUuid: leaseUUID, Period: types.MaxUsageSpendPeriod}).ValidateBasic())
+
+	err := (&types.MsgSetUsageSpendLimit{Tenant: invalidAddr, LeaseUuid: leaseUUID, Period: types.MinUsageSpendPeriod}).ValidateBasic()
+	require.ErrorContains(t, err, "invalid tenant address")
+	err = (&types.MsgSetUsageSpendLimit{Tenant: tenant, LeaseUuid: leaseUUID, SpendLimit: limit, Period: types.MinUsageSpendPeriod - 1}).ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+	err = (&types.MsgSetUsageSpendLimit{Tenant: tenant, LeaseUuid: leaseUUID, SpendLimit: limit, Period: types.MaxUsageSpendPeriod + 1}).ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+	err = (&types.MsgSetUsageSpendLimit{Tenant: tenant, LeaseUuid: leaseUUID, SpendLimit: sdk.Coins{sdk.Coin{Denom: testDenom, Amount: math.NewInt(-1)}}, Period: types.MinUsageSpendPeriod}).ValidateBasic()
+	require.ErrorContains(t, err, "invalid spend_limit")
+}
+
+func TestMsgOpenDispute_ValidateBasic(t *testing.T) {
+	_, _, tenantAddr := testdata.KeyTestPubAddr()
+	tenant := tenantAddr.String()
+	leaseUUID := "01912345-6789-7abc-8def-0123456789ab"
+
+	require.NoError(t, (&types.MsgOpenDispute{Tenant: tenant, LeaseUuid: leaseUUID, Reason: "service was down"}).ValidateBasic())
+
+	err := (&types.MsgOpenDispute{Tenant: invalidAddr, LeaseUuid: leaseUUID, Reason: "service was down"}).ValidateBasic()
+	require.ErrorContains(t, err, "invalid tenant address")
+	err = (&types.MsgOpenDispute{Tenant: tenant, LeaseUuid: "not-a-uuid", Reason: "service was down"}).ValidateBasic()
+	require.Error(t, err)
+	err = (&types.MsgOpenDispute{Tenant: tenant, LeaseUuid: leaseUUID, Reason: "  "}).ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidDisputeReason)
+	err = (&types.MsgOpenDispute{Tenant: tenant, LeaseUuid: leaseUUID, Reason: strings.Repeat("a", types.MaxDisputeReasonLength+1)}).ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidDisputeReason)
+}
+
+func TestMsgRespondToDispute_ValidateBasic(t *testing.T) {
+	_, _, senderAddr := testdata.KeyTestPubAddr()
+	sender := senderAddr.String()
+	leaseUUID := "01912345-6789-7abc-8def-0123456789ab"
+
+	require.NoError(t, (&types.MsgRespondToDispute{Sender: sender, LeaseUuid: leaseUUID, Response: "logs attached"}).ValidateBasic())
+
+	err := (&types.MsgRespondToDispute{Sender: invalidAddr, LeaseUuid: leaseUUID, Response: "logs attached"}).ValidateBasic()
+	require.ErrorContains(t, err, "invalid sender address")
+	err = (&types.MsgRespondToDispute{Sender: sender, LeaseUuid: leaseUUID}).ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidDisputeReason)
+}
+
+func TestMsgResolveDispute_ValidateBasic(t *testing.T) {
+	_, _, authorityAddr := testdata.KeyTestPubAddr()
+	authority := authorityAddr.String()
+	leaseUUID := "01912345-6789-7abc-8def-0123456789ab"
+
+	require.NoError(t, (&types.MsgResolveDispute{Authority: authority, LeaseUuid: leaseUUID, TenantShare: math.LegacyZeroDec()}).ValidateBasic())
+	require.NoError(t, (&types.MsgResolveDispute{Authority: authority, LeaseUuid: leaseUUID, TenantShare: math.LegacyOneDec()}).ValidateBasic())
+
+	err := (&types.MsgResolveDispute{Authority: invalidAddr, LeaseUuid: leaseUUID, TenantShare: math.LegacyOneDec()}).ValidateBasic()
+	require.ErrorContains(t, err, "invalid authority address")
+	err = (&types.MsgResolveDispute{Authority: authority, LeaseUuid: leaseUUID}).ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidRequest, "tenant_share is required")
+	err = (&types.MsgResolveDispute{Authority: authority, LeaseUuid: leaseUUID, TenantShare: math.LegacyNewDecWithPrec(101, 2)}).ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+	err = (&types.MsgResolveDispute{Authority: authority, LeaseUuid: leaseUUID, TenantShare: math.LegacyNewDec(-1)}).ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+}
+
 // ============================================================================
 // MsgCreateLease Tests
 // ============================================================================
@@ -467,6 +1106,101 @@ func TestMsgCreateLease_ValidateBasic(t *testing.T) {
 // MsgCreateLeaseForTenant Tests
 // ============================================================================
 
+func TestMsgCreateLeases_ValidateBasic(t *testing.T) {
+	_, _, tenantAddr := testdata.KeyTestPubAddr()
+	tenant := tenantAddr.String()
+
+	validLease := types.LeaseInput{
+		Items: []types.LeaseItemInput{{SkuUuid: "01912345-6789-7abc-8def-0123456789ab", Quantity: 1}},
+	}
+	tooMany := make([]types.LeaseInput, types.MaxBatchLeaseSize+1)
+	for i := range tooMany {
+		tooMany[i] = validLease
+	}
+
+	tests := []struct {
+		name      string
+		msg       types.MsgCreateLeases
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			name:      "valid message",
+			msg:       types.MsgCreateLeases{Tenant: tenant, Leases: []types.LeaseInput{validLease, validLease}},
+			expectErr: false,
+		},
+		{
+			name:      "invalid tenant address",
+			msg:       types.MsgCreateLeases{Tenant: invalidAddr, Leases: []types.LeaseInput{validLease}},
+			expectErr: true,
+			errMsg:    "invalid tenant address",
+		},
+		{
+			name:      "no leases",
+			msg:       types.MsgCreateLeases{Tenant: tenant},
+			expectErr: true,
+			errMsg:    "at least one lease is required",
+		},
+		{
+			name:      "too many leases",
+			msg:       types.MsgCreateLeases{Tenant: tenant, Leases: tooMany},
+			expectErr: true,
+			errMsg:    "too many leases",
+		},
+		{
+			name: "lease without items",
+			msg: types.MsgCreateLeases{Tenant: tenant, Leases: []types.LeaseInput{
+				validLease,
+				{},
+			}},
+			expectErr: true,
+			errMsg:    "lease 1",
+		},
+		{
+			name: "meta_hash too long",
+			msg: types.MsgCreateLeases{Tenant: tenant, Leases: []types.LeaseInput{{
+				Items:    validLease.Items,
+				MetaHash: make([]byte, types.MaxMetaHashLength+1),
+			}}},
+			expectErr: true,
+			errMsg:    "meta_hash exceeds maximum length",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgCreateLeasesForTenant_ValidateBasic(t *testing.T) {
+	_, _, authorityAddr := testdata.KeyTestPubAddr()
+	_, _, tenantAddr := testdata.KeyTestPubAddr()
+	leases := []types.LeaseInput{{
+		Items: []types.LeaseItemInput{{SkuUuid: "01912345-6789-7abc-8def-0123456789ab", Quantity: 1}},
+	}}
+
+	require.NoError(t, (&types.MsgCreateLeasesForTenant{
+		Authority: authorityAddr.String(), Tenant: tenantAddr.String(), Leases: leases,
+	}).ValidateBasic())
+	require.ErrorContains(t, (&types.MsgCreateLeasesForTenant{
+		Authority: invalidAddr, Tenant: tenantAddr.String(), Leases: leases,
+	}).ValidateBasic(), "invalid authority address")
+	require.ErrorContains(t, (&types.MsgCreateLeasesForTenant{
+		Authority: authorityAddr.String(), Tenant: invalidAddr, Leases: leases,
+	}).ValidateBasic(), "invalid tenant address")
+	require.ErrorContains(t, (&types.MsgCreateLeasesForTenant{
+		Authority: authorityAddr.String(), Tenant: tenantAddr.String(),
+	}).ValidateBasic(), "at least one lease is required")
+}
+
 func TestMsgCreateLeaseForTenant_ValidateBasic(t *testing.T) {
 	_, _, authorityAddr := testdata.KeyTestPubAddr()
 	_, _, tenantAddr := testdata.KeyTestPubAddr()
@@ -1100,6 +1834,17 @@ func TestGenesisState_Validate(t *testing.T) {
 		ReservedAmounts:  validLeaseReservation, // Must match sum of active/pending lease reservations
 	}
 
+	validAmendment := types.LeaseAmendment{
+		LeaseUuid:    validLease.Uuid,
+		Tenant:       tenant,
+		ProviderUuid: validLease.ProviderUuid,
+		AddItems: []types.LeaseItem{
+			{SkuUuid: "01912345-6789-7abc-8def-0123456789ae", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(100))},
+		},
+		ReservedAmounts: validLeaseReservation,
+		CreatedAt:       now,
+	}
+
 	tests := []struct {
 		name      string
 		genesis   *types.GenesisState
@@ -1121,6 +1866,62 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			expectErr: false,
 		},
+		{
+			name: "valid genesis with pending amendment",
+			genesis: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Leases: []types.Lease{validLease},
+				CreditAccounts: []types.CreditAccount{func() types.CreditAccount {
+					ca := validCreditAccount
+					ca.ReservedAmounts = validLeaseReservation.Add(validAmendment.ReservedAmounts...)
+					return ca
+				}()},
+				LeaseAmendments: []types.LeaseAmendment{validAmendment},
+				LeaseSequence:   1,
+			},
+			expectErr: false,
+		},
+		{
+			name: "amendment reservation not counted",
+			genesis: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				Leases:          []types.Lease{validLease},
+				CreditAccounts:  []types.CreditAccount{validCreditAccount},
+				LeaseAmendments: []types.LeaseAmendment{validAmendment},
+				LeaseSequence:   1,
+			},
+			expectErr: true,
+			errMsg:    "reserved",
+		},
+		{
+			name: "scheduled end before creation",
+			genesis: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Leases: []types.Lease{func() types.Lease {
+					l := validLease
+					endAt := now.Add(-time.Second)
+					l.ScheduledEndAt = &endAt
+					return l
+				}()},
+				CreditAccounts: []types.CreditAccount{validCreditAccount},
+				LeaseSequence:  1,
+			},
+			expectErr: true,
+			errMsg:    "scheduled_end_at not after created_at",
+		},
+		{
+			name: "amendment for unknown lease",
+			genesis: &types.GenesisState{
+				Params: types.DefaultParams(),
+				LeaseAmendments: []types.LeaseAmendment{func() types.LeaseAmendment {
+					a := validAmendment
+					a.LeaseUuid = "01912345-6789-7abc-8def-0123456789af"
+					return a
+				}()},
+			},
+			expectErr: true,
+			errMsg:    "unknown lease",
+		},
 		{
 			name: "invalid params - zero max leases",
 			genesis: &types.GenesisState{
@@ -1632,70 +2433,366 @@ func TestGenesisState_Validate(t *testing.T) {
 						ReservedAmounts: sdk.NewCoins(sdk.NewCoin(testDenom, math.NewInt(720000))),
 					},
 				},
-				LeaseSequence: 2,
+				LeaseSequence: 2,
+			},
+			expectErr: false,
+		},
+		{
+			name: "lease with stored min_lease_duration_at_creation",
+			genesis: &types.GenesisState{
+				Params: types.DefaultParams(), // Current param is 3600
+				Leases: []types.Lease{
+					{
+						Uuid:                       "01912345-6789-7abc-8def-0123456789ab",
+						Tenant:                     tenant,
+						ProviderUuid:               "01912345-6789-7abc-8def-0123456789ac",
+						Items:                      []types.LeaseItem{{SkuUuid: "01912345-6789-7abc-8def-0123456789ad", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(100))}},
+						State:                      types.LEASE_STATE_ACTIVE,
+						CreatedAt:                  now,
+						LastSettledAt:              now,
+						MinLeaseDurationAtCreation: 7200, // Was 7200 at creation, not 3600
+					},
+				},
+				CreditAccounts: []types.CreditAccount{
+					{
+						Tenant:           tenant,
+						CreditAddress:    creditAddr.String(),
+						ActiveLeaseCount: 1, // 1 ACTIVE lease
+						// Should use stored duration: 100 * 1 * 7200 = 720000
+						ReservedAmounts: sdk.NewCoins(sdk.NewCoin(testDenom, math.NewInt(720000))),
+					},
+				},
+				LeaseSequence: 1,
+			},
+			expectErr: false,
+		},
+		{
+			name: "tenant with leases but no credit account",
+			genesis: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				Leases:         []types.Lease{validLease}, // Active lease for tenant
+				CreditAccounts: []types.CreditAccount{
+					// Missing credit account for tenant
+				},
+			},
+			expectErr: true,
+			errMsg:    "no credit account",
+		},
+		{
+			name: "active_lease_count mismatch",
+			genesis: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Leases: []types.Lease{validLease}, // 1 ACTIVE lease
+				CreditAccounts: []types.CreditAccount{
+					{
+						Tenant:           tenant,
+						CreditAddress:    creditAddr.String(),
+						ActiveLeaseCount: 5, // Wrong: says 5 but only 1 active
+						ReservedAmounts:  validLeaseReservation,
+					},
+				},
+			},
+			expectErr: true,
+			errMsg:    "active_lease_count 5 but has 1 active leases",
+		},
+		{
+			name: "pending_lease_count mismatch",
+			genesis: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Leases: []types.Lease{
+					{
+						Uuid:         "01912345-6789-7abc-8def-0123456789ab",
+						Tenant:       tenant,
+						ProviderUuid: "01912345-6789-7abc-8def-0123456789ac",
+						Items:        []types.LeaseItem{{SkuUuid: "01912345-6789-7abc-8def-0123456789ad", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(100))}},
+						State:        types.LEASE_STATE_PENDING,
+						CreatedAt:    now,
+					},
+				},
+				CreditAccounts: []types.CreditAccount{
+					{
+						Tenant:            tenant,
+						CreditAddress:     creditAddr.String(),
+						PendingLeaseCount: 3, // Wrong: says 3 but only 1 pending
+						ReservedAmounts:   validLeaseReservation,
+					},
+				},
+			},
+			expectErr: true,
+			errMsg:    "pending_lease_count 3 but has 1 pending leases",
+		},
+		// ---- service_name genesis validation ----
+		{
+			name: "valid lease with service_names on all items",
+			genesis: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Leases: []types.Lease{
+					{
+						Uuid:         "01912345-6789-7abc-8def-0123456789ab",
+						Tenant:       tenant,
+						ProviderUuid: "01912345-6789-7abc-8def-0123456789ac",
+						Items: []types.LeaseItem{
+							{SkuUuid: "01912345-6789-7abc-8def-0123456789ad", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(100)), ServiceName: "web"},
+							{SkuUuid: "01912345-6789-7abc-8def-0123456789ad", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(100)), ServiceName: "db"},
+						},
+						State:         types.LEASE_STATE_ACTIVE,
+						CreatedAt:     now,
+						LastSettledAt: now,
+					},
+				},
+				CreditAccounts: []types.CreditAccount{
+					{
+						Tenant:           tenant,
+						CreditAddress:    creditAddr.String(),
+						ActiveLeaseCount: 1,
+						// 2 items: 100 * 1 * 3600 * 2 = 720000
+						ReservedAmounts: sdk.NewCoins(sdk.NewCoin(testDenom, math.NewInt(720000))),
+					},
+				},
+				LeaseSequence: 1,
+			},
+			expectErr: false,
+		},
+		{
+			name: "lease with mixed service_names (some set, some not)",
+			genesis: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Leases: []types.Lease{
+					{
+						Uuid:         "01912345-6789-7abc-8def-0123456789ab",
+						Tenant:       tenant,
+						ProviderUuid: "01912345-6789-7abc-8def-0123456789ac",
+						Items: []types.LeaseItem{
+							{SkuUuid: "01912345-6789-7abc-8def-0123456789ad", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(100)), ServiceName: "web"},
+							{SkuUuid: "01912345-6789-7abc-8def-0123456789ae", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(100))},
+						},
+						State:         types.LEASE_STATE_ACTIVE,
+						CreatedAt:     now,
+						LastSettledAt: now,
+					},
+				},
+				CreditAccounts: []types.CreditAccount{validCreditAccount},
+			},
+			expectErr: true,
+			errMsg:    "all items must have service_name or none",
+		},
+		{
+			name: "lease with duplicate service_name",
+			genesis: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Leases: []types.Lease{
+					{
+						Uuid:         "01912345-6789-7abc-8def-0123456789ab",
+						Tenant:       tenant,
+						ProviderUuid: "01912345-6789-7abc-8def-0123456789ac",
+						Items: []types.LeaseItem{
+							{SkuUuid: "01912345-6789-7abc-8def-0123456789ad", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(100)), ServiceName: "web"},
+							{SkuUuid: "01912345-6789-7abc-8def-0123456789ae", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(100)), ServiceName: "web"},
+						},
+						State:         types.LEASE_STATE_ACTIVE,
+						CreatedAt:     now,
+						LastSettledAt: now,
+					},
+				},
+				CreditAccounts: []types.CreditAccount{validCreditAccount},
+			},
+			expectErr: true,
+			errMsg:    "duplicate service_name",
+		},
+		{
+			name: "lease with invalid DNS label in service_name",
+			genesis: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Leases: []types.Lease{
+					{
+						Uuid:         "01912345-6789-7abc-8def-0123456789ab",
+						Tenant:       tenant,
+						ProviderUuid: "01912345-6789-7abc-8def-0123456789ac",
+						Items: []types.LeaseItem{
+							{SkuUuid: "01912345-6789-7abc-8def-0123456789ad", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(100)), ServiceName: "INVALID"},
+						},
+						State:         types.LEASE_STATE_ACTIVE,
+						CreatedAt:     now,
+						LastSettledAt: now,
+					},
+				},
+				CreditAccounts: []types.CreditAccount{validCreditAccount},
+			},
+			expectErr: true,
+			errMsg:    "invalid service_name",
+		},
+		{
+			name: "lease with duplicate sku_uuid in legacy mode (no service_names)",
+			genesis: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Leases: []types.Lease{
+					{
+						Uuid:         "01912345-6789-7abc-8def-0123456789ab",
+						Tenant:       tenant,
+						ProviderUuid: "01912345-6789-7abc-8def-0123456789ac",
+						Items: []types.LeaseItem{
+							{SkuUuid: "01912345-6789-7abc-8def-0123456789ad", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(100))},
+							{SkuUuid: "01912345-6789-7abc-8def-0123456789ad", Quantity: 2, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(100))},
+						},
+						State:         types.LEASE_STATE_ACTIVE,
+						CreatedAt:     now,
+						LastSettledAt: now,
+					},
+				},
+				CreditAccounts: []types.CreditAccount{validCreditAccount},
+			},
+			expectErr: true,
+			errMsg:    "duplicate sku_uuid",
+		},
+		{
+			name: "valid provider policy",
+			genesis: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ProviderPolicies: []types.ProviderPolicy{
+					{ProviderUuid: "01912345-6789-7abc-8def-0123456789ac", PendingTimeout: 600, AutoAcknowledge: true, DeniedTenants: []string{tenant}, OverrideGracePeriod: true, GracePeriod: 3600},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "duplicate provider policy",
+			genesis: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ProviderPolicies: []types.ProviderPolicy{
+					{ProviderUuid: "01912345-6789-7abc-8def-0123456789ac"},
+					{ProviderUuid: "01912345-6789-7abc-8def-0123456789ac", AutoAcknowledge: true},
+				},
+			},
+			expectErr: true,
+			errMsg:    "duplicate policy",
+		},
+		{
+			name: "provider policy pending timeout below minimum",
+			genesis: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ProviderPolicies: []types.ProviderPolicy{
+					{ProviderUuid: "01912345-6789-7abc-8def-0123456789ac", PendingTimeout: types.MinPendingTimeout - 1},
+				},
+			},
+			expectErr: true,
+			errMsg:    "pending_timeout",
+		},
+		{
+			name: "provider policy grace period exceeds upper bound",
+			genesis: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ProviderPolicies: []types.ProviderPolicy{
+					{ProviderUuid: "01912345-6789-7abc-8def-0123456789ac", OverrideGracePeriod: true, GracePeriod: types.MaxGracePeriod + 1},
+				},
+			},
+			expectErr: true,
+			errMsg:    "exceeds upper bound",
+		},
+		{
+			name: "valid provider stats",
+			genesis: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ProviderStats: []types.ProviderStats{
+					{
+						ProviderUuid:                 "01912345-6789-7abc-8def-0123456789ac",
+						LeasesCreated:                3,
+						LeasesAcknowledged:           2,
+						LeasesAcknowledgedAtCreation: 1,
+						AckLatencySum:                45,
+						AckLatencyBuckets:            []uint64{0, 0, 1, 0, 0, 0, 0, 0, 0},
+						LeasesRejected:               1,
+					},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "duplicate provider stats",
+			genesis: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ProviderStats: []types.ProviderStats{
+					types.NewProviderStats("01912345-6789-7abc-8def-0123456789ac"),
+					types.NewProviderStats("01912345-6789-7abc-8def-0123456789ac"),
+				},
+			},
+			expectErr: true,
+			errMsg:    "duplicate stats",
+		},
+		{
+			name: "provider stats with wrong bucket count",
+			genesis: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ProviderStats: []types.ProviderStats{
+					{ProviderUuid: "01912345-6789-7abc-8def-0123456789ac", AckLatencyBuckets: []uint64{0}},
+				},
 			},
-			expectErr: false,
+			expectErr: true,
+			errMsg:    "latency buckets",
 		},
 		{
-			name: "lease with stored min_lease_duration_at_creation",
+			name: "provider stats with buckets not matching acknowledgements",
 			genesis: &types.GenesisState{
-				Params: types.DefaultParams(), // Current param is 3600
-				Leases: []types.Lease{
+				Params: types.DefaultParams(),
+				ProviderStats: []types.ProviderStats{
 					{
-						Uuid:                       "01912345-6789-7abc-8def-0123456789ab",
-						Tenant:                     tenant,
-						ProviderUuid:               "01912345-6789-7abc-8def-0123456789ac",
-						Items:                      []types.LeaseItem{{SkuUuid: "01912345-6789-7abc-8def-0123456789ad", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(100))}},
-						State:                      types.LEASE_STATE_ACTIVE,
-						CreatedAt:                  now,
-						LastSettledAt:              now,
-						MinLeaseDurationAtCreation: 7200, // Was 7200 at creation, not 3600
+						ProviderUuid:       "01912345-6789-7abc-8def-0123456789ac",
+						LeasesAcknowledged: 2,
+						AckLatencyBuckets:  []uint64{1, 0, 0, 0, 0, 0, 0, 0, 0},
 					},
 				},
-				CreditAccounts: []types.CreditAccount{
-					{
-						Tenant:           tenant,
-						CreditAddress:    creditAddr.String(),
-						ActiveLeaseCount: 1, // 1 ACTIVE lease
-						// Should use stored duration: 100 * 1 * 7200 = 720000
-						ReservedAmounts: sdk.NewCoins(sdk.NewCoin(testDenom, math.NewInt(720000))),
-					},
+			},
+			expectErr: true,
+			errMsg:    "bucket 1 acknowledgements",
+		},
+		{
+			name: "settlement references unknown lease",
+			genesis: &types.GenesisState{
+				Params: types.DefaultParams(),
+				LeaseSettlements: []types.LeaseSettlement{
+					{Id: 0, LeaseUuid: "01912345-6789-7abc-8def-0123456789ab", Trigger: types.SETTLEMENT_TRIGGER_WITHDRAW},
 				},
-				LeaseSequence: 1,
+				SettlementSequence: 1,
 			},
-			expectErr: false,
+			expectErr: true,
+			errMsg:    "unknown lease",
 		},
 		{
-			name: "tenant with leases but no credit account",
+			name: "settlement id not below sequence",
 			genesis: &types.GenesisState{
-				Params:         types.DefaultParams(),
-				Leases:         []types.Lease{validLease}, // Active lease for tenant
-				CreditAccounts: []types.CreditAccount{
-					// Missing credit account for tenant
+				Params: types.DefaultParams(),
+				LeaseSettlements: []types.LeaseSettlement{
+					{Id: 3, LeaseUuid: "01912345-6789-7abc-8def-0123456789ab", Trigger: types.SETTLEMENT_TRIGGER_WITHDRAW},
 				},
+				SettlementSequence: 3,
 			},
 			expectErr: true,
-			errMsg:    "no credit account",
+			errMsg:    "not below settlement_sequence",
 		},
 		{
-			name: "active_lease_count mismatch",
+			name: "lease total_settled without settlement records",
 			genesis: &types.GenesisState{
 				Params: types.DefaultParams(),
-				Leases: []types.Lease{validLease}, // 1 ACTIVE lease
-				CreditAccounts: []types.CreditAccount{
+				Leases: []types.Lease{
 					{
-						Tenant:           tenant,
-						CreditAddress:    creditAddr.String(),
-						ActiveLeaseCount: 5, // Wrong: says 5 but only 1 active
-						ReservedAmounts:  validLeaseReservation,
+						Uuid:         "01912345-6789-7abc-8def-0123456789ab",
+						Tenant:       tenant,
+						ProviderUuid: "01912345-6789-7abc-8def-0123456789ac",
+						Items: []types.LeaseItem{
+							{SkuUuid: "01912345-6789-7abc-8def-0123456789ad", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(100))},
+						},
+						State:         types.LEASE_STATE_CLOSED,
+						CreatedAt:     now,
+						LastSettledAt: now,
+						ClosedAt:      &now,
+						TotalSettled:  sdk.NewCoins(sdk.NewCoin(testDenom, math.NewInt(500))),
 					},
 				},
 			},
 			expectErr: true,
-			errMsg:    "active_lease_count 5 but has 1 active leases",
+			errMsg:    "does not match its settlement records",
 		},
 		{
-			name: "pending_lease_count mismatch",
+			name: "lease with invalid debt",
 			genesis: &types.GenesisState{
 				Params: types.DefaultParams(),
 				Leases: []types.Lease{
@@ -1703,26 +2800,48 @@ func TestGenesisState_Validate(t *testing.T) {
 						Uuid:         "01912345-6789-7abc-8def-0123456789ab",
 						Tenant:       tenant,
 						ProviderUuid: "01912345-6789-7abc-8def-0123456789ac",
-						Items:        []types.LeaseItem{{SkuUuid: "01912345-6789-7abc-8def-0123456789ad", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(100))}},
-						State:        types.LEASE_STATE_PENDING,
-						CreatedAt:    now,
+						Items: []types.LeaseItem{
+							{SkuUuid: "01912345-6789-7abc-8def-0123456789ad", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(100))},
+						},
+						State:         types.LEASE_STATE_CLOSED,
+						CreatedAt:     now,
+						LastSettledAt: now,
+						ClosedAt:      &now,
+						Debt:          sdk.Coins{sdk.Coin{Denom: testDenom, Amount: math.NewInt(-1)}},
 					},
 				},
-				CreditAccounts: []types.CreditAccount{
+				CreditAccounts: []types.CreditAccount{validCreditAccount},
+				LeaseSequence:  1,
+			},
+			expectErr: true,
+			errMsg:    "invalid debt",
+		},
+		{
+			name: "lease with fractional locked_rate and accrual_carry",
+			genesis: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Leases: []types.Lease{
 					{
-						Tenant:            tenant,
-						CreditAddress:     creditAddr.String(),
-						PendingLeaseCount: 3, // Wrong: says 3 but only 1 pending
-						ReservedAmounts:   validLeaseReservation,
+						Uuid:         "01912345-6789-7abc-8def-0123456789ab",
+						Tenant:       tenant,
+						ProviderUuid: "01912345-6789-7abc-8def-0123456789ac",
+						Items: []types.LeaseItem{
+							{SkuUuid: "01912345-6789-7abc-8def-0123456789ad", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(100)), LockedRate: math.LegacyMustNewDecFromStr("0.25")},
+						},
+						State:         types.LEASE_STATE_CLOSED,
+						CreatedAt:     now,
+						LastSettledAt: now,
+						ClosedAt:      &now,
+						AccrualCarry:  sdk.NewDecCoins(sdk.NewDecCoinFromDec(testDenom, math.LegacyMustNewDecFromStr("0.75"))),
 					},
 				},
+				LeaseSequence: 1,
 			},
-			expectErr: true,
-			errMsg:    "pending_lease_count 3 but has 1 pending leases",
+			expectErr: false,
+			errMsg:    "",
 		},
-		// ---- service_name genesis validation ----
 		{
-			name: "valid lease with service_names on all items",
+			name: "lease with negative locked_rate",
 			genesis: &types.GenesisState{
 				Params: types.DefaultParams(),
 				Leases: []types.Lease{
@@ -1731,29 +2850,45 @@ func TestGenesisState_Validate(t *testing.T) {
 						Tenant:       tenant,
 						ProviderUuid: "01912345-6789-7abc-8def-0123456789ac",
 						Items: []types.LeaseItem{
-							{SkuUuid: "01912345-6789-7abc-8def-0123456789ad", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(100)), ServiceName: "web"},
-							{SkuUuid: "01912345-6789-7abc-8def-0123456789ad", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(100)), ServiceName: "db"},
+							{SkuUuid: "01912345-6789-7abc-8def-0123456789ad", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(100)), LockedRate: math.LegacyNewDec(-1)},
 						},
-						State:         types.LEASE_STATE_ACTIVE,
+						State:         types.LEASE_STATE_CLOSED,
 						CreatedAt:     now,
 						LastSettledAt: now,
+						ClosedAt:      &now,
 					},
 				},
-				CreditAccounts: []types.CreditAccount{
+				CreditAccounts: []types.CreditAccount{validCreditAccount},
+				LeaseSequence:  1,
+			},
+			expectErr: true,
+			errMsg:    "negative locked_rate",
+		},
+		{
+			name: "calendar-month lease item without locked_rate",
+			genesis: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Leases: []types.Lease{
 					{
-						Tenant:           tenant,
-						CreditAddress:    creditAddr.String(),
-						ActiveLeaseCount: 1,
-						// 2 items: 100 * 1 * 3600 * 2 = 720000
-						ReservedAmounts: sdk.NewCoins(sdk.NewCoin(testDenom, math.NewInt(720000))),
+						Uuid:         "01912345-6789-7abc-8def-0123456789ab",
+						Tenant:       tenant,
+						ProviderUuid: "01912345-6789-7abc-8def-0123456789ac",
+						Items: []types.LeaseItem{
+							{SkuUuid: "01912345-6789-7abc-8def-0123456789ad", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(3000000)), CalendarMonth: true},
+						},
+						State:         types.LEASE_STATE_CLOSED,
+						CreatedAt:     now,
+						LastSettledAt: now,
+						ClosedAt:      &now,
 					},
 				},
 				LeaseSequence: 1,
 			},
-			expectErr: false,
+			expectErr: true,
+			errMsg:    "billed per calendar month",
 		},
 		{
-			name: "lease with mixed service_names (some set, some not)",
+			name: "calendar-month lease item without monthly_price",
 			genesis: &types.GenesisState{
 				Params: types.DefaultParams(),
 				Leases: []types.Lease{
@@ -1762,21 +2897,21 @@ func TestGenesisState_Validate(t *testing.T) {
 						Tenant:       tenant,
 						ProviderUuid: "01912345-6789-7abc-8def-0123456789ac",
 						Items: []types.LeaseItem{
-							{SkuUuid: "01912345-6789-7abc-8def-0123456789ad", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(100)), ServiceName: "web"},
-							{SkuUuid: "01912345-6789-7abc-8def-0123456789ae", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(100))},
+							{SkuUuid: "01912345-6789-7abc-8def-0123456789ad", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(31)), LockedRate: math.LegacyNewDec(31), CalendarMonth: true},
 						},
-						State:         types.LEASE_STATE_ACTIVE,
+						State:         types.LEASE_STATE_CLOSED,
 						CreatedAt:     now,
 						LastSettledAt: now,
+						ClosedAt:      &now,
 					},
 				},
-				CreditAccounts: []types.CreditAccount{validCreditAccount},
+				LeaseSequence: 1,
 			},
 			expectErr: true,
-			errMsg:    "all items must have service_name or none",
+			errMsg:    "invalid monthly_price",
 		},
 		{
-			name: "lease with duplicate service_name",
+			name: "monthly_price on a lease item not billed per calendar month",
 			genesis: &types.GenesisState{
 				Params: types.DefaultParams(),
 				Leases: []types.Lease{
@@ -1785,21 +2920,21 @@ func TestGenesisState_Validate(t *testing.T) {
 						Tenant:       tenant,
 						ProviderUuid: "01912345-6789-7abc-8def-0123456789ac",
 						Items: []types.LeaseItem{
-							{SkuUuid: "01912345-6789-7abc-8def-0123456789ad", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(100)), ServiceName: "web"},
-							{SkuUuid: "01912345-6789-7abc-8def-0123456789ae", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(100)), ServiceName: "web"},
+							{SkuUuid: "01912345-6789-7abc-8def-0123456789ad", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(31)), LockedRate: math.LegacyNewDec(31), MonthlyPrice: sdk.NewCoin(testDenom, math.NewInt(74995200))},
 						},
-						State:         types.LEASE_STATE_ACTIVE,
+						State:         types.LEASE_STATE_CLOSED,
 						CreatedAt:     now,
 						LastSettledAt: now,
+						ClosedAt:      &now,
 					},
 				},
-				CreditAccounts: []types.CreditAccount{validCreditAccount},
+				LeaseSequence: 1,
 			},
 			expectErr: true,
-			errMsg:    "duplicate service_name",
+			errMsg:    "not billed per calendar month",
 		},
 		{
-			name: "lease with invalid DNS label in service_name",
+			name: "stored lease item not billed per calendar month with a zero monthly_price",
 			genesis: &types.GenesisState{
 				Params: types.DefaultParams(),
 				Leases: []types.Lease{
@@ -1808,20 +2943,20 @@ func TestGenesisState_Validate(t *testing.T) {
 						Tenant:       tenant,
 						ProviderUuid: "01912345-6789-7abc-8def-0123456789ac",
 						Items: []types.LeaseItem{
-							{SkuUuid: "01912345-6789-7abc-8def-0123456789ad", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(100)), ServiceName: "INVALID"},
+							{SkuUuid: "01912345-6789-7abc-8def-0123456789ad", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(31)), LockedRate: math.LegacyNewDec(31), MonthlyPrice: sdk.Coin{Amount: math.ZeroInt()}},
 						},
-						State:         types.LEASE_STATE_ACTIVE,
+						State:         types.LEASE_STATE_CLOSED,
 						CreatedAt:     now,
 						LastSettledAt: now,
+						ClosedAt:      &now,
 					},
 				},
-				CreditAccounts: []types.CreditAccount{validCreditAccount},
+				LeaseSequence: 1,
 			},
-			expectErr: true,
-			errMsg:    "invalid service_name",
+			expectErr: false,
 		},
 		{
-			name: "lease with duplicate sku_uuid in legacy mode (no service_names)",
+			name: "lease with accrual_carry of a whole unit",
 			genesis: &types.GenesisState{
 				Params: types.DefaultParams(),
 				Leases: []types.Lease{
@@ -1831,17 +2966,19 @@ func TestGenesisState_Validate(t *testing.T) {
 						ProviderUuid: "01912345-6789-7abc-8def-0123456789ac",
 						Items: []types.LeaseItem{
 							{SkuUuid: "01912345-6789-7abc-8def-0123456789ad", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(100))},
-							{SkuUuid: "01912345-6789-7abc-8def-0123456789ad", Quantity: 2, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(100))},
 						},
-						State:         types.LEASE_STATE_ACTIVE,
+						State:         types.LEASE_STATE_CLOSED,
 						CreatedAt:     now,
 						LastSettledAt: now,
+						ClosedAt:      &now,
+						AccrualCarry:  sdk.DecCoins{sdk.NewDecCoinFromDec(testDenom, math.LegacyOneDec())},
 					},
 				},
 				CreditAccounts: []types.CreditAccount{validCreditAccount},
+				LeaseSequence:  1,
 			},
 			expectErr: true,
-			errMsg:    "duplicate sku_uuid",
+			errMsg:    "not below one unit",
 		},
 	}
 
@@ -2034,3 +3171,312 @@ func TestGenesisState_ValidateWithBlockTime(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisState_ValidateDisputes(t *testing.T) {
+	_, _, tenantAddr := testdata.KeyTestPubAddr()
+	tenant := tenantAddr.String()
+	now := time.Now().UTC()
+
+	lease := types.Lease{
+		Uuid:         "01912345-6789-7abc-8def-0123456789ab",
+		Tenant:       tenant,
+		ProviderUuid: "01912345-6789-7abc-8def-0123456789ac",
+		Items: []types.LeaseItem{
+			{SkuUuid: "01912345-6789-7abc-8def-0123456789ad", Quantity: 1, LockedPrice: sdk.NewCoin(testDenom, math.NewInt(100))},
+		},
+		State:         types.LEASE_STATE_CLOSED,
+		CreatedAt:     now,
+		LastSettledAt: now,
+		ClosedAt:      &now,
+		Disputed:      true,
+	}
+	dispute := types.Dispute{
+		LeaseUuid:        lease.Uuid,
+		Tenant:           tenant,
+		ProviderUuid:     lease.ProviderUuid,
+		State:            types.DISPUTE_STATE_OPEN,
+		Reason:           "service was down",
+		Escrowed:         sdk.NewCoins(sdk.NewCoin(testDenom, math.NewInt(100))),
+		OpenedAt:         now,
+		ResponseDeadline: now.Add(time.Hour),
+	}
+	genesis := func(lease types.Lease, disputes ...types.Dispute) *types.GenesisState {
+		return &types.GenesisState{
+			Params:        types.DefaultParams(),
+			Leases:        []types.Lease{lease},
+			LeaseSequence: 1,
+			Disputes:      disputes,
+		}
+	}
+
+	require.NoError(t, genesis(lease, dispute).Validate())
+
+	require.ErrorContains(t, genesis(lease, dispute, dispute).Validate(), "duplicate dispute")
+
+	notDisputed := lease
+	notDisputed.Disputed = false
+	require.ErrorContains(t, genesis(notDisputed, dispute).Validate(), "disputed flag")
+	require.ErrorContains(t, genesis(lease).Validate(), "disputed flag")
+
+	resolved := dispute
+	resolved.State = types.DISPUTE_STATE_RESOLVED
+	require.ErrorContains(t, genesis(notDisputed, resolved).Validate(), "no resolved_at")
+	resolved.ResolvedAt = &now
+	require.NoError(t, genesis(notDisputed, resolved).Validate())
+
+	otherTenant := dispute
+	_, _, otherAddr := testdata.KeyTestPubAddr()
+	otherTenant.Tenant = otherAddr.String()
+	require.ErrorContains(t, genesis(lease, otherTenant).Validate(), "does not match")
+
+	unknown := dispute
+	unknown.LeaseUuid = "01912345-6789-7abc-8def-0123456789ff"
+	require.ErrorIs(t, genesis(notDisputed, unknown).Validate(), types.ErrDisputeNotFound)
+}
+
+func TestMsgGrantPromoCredit_ValidateBasic(t *testing.T) {
+	_, _, authorityAddr := testdata.KeyTestPubAddr()
+	_, _, tenantAddr := testdata.KeyTestPubAddr()
+	uuid := "01912345-6789-7abc-8def-0123456789ab"
+
+	valid := types.MsgGrantPromoCredit{
+		Authority:     authorityAddr.String(),
+		Tenant:        tenantAddr.String(),
+		Amount:        sdk.NewCoins(sdk.NewCoin(testDenom, math.NewInt(100))),
+		ExpiresAt:     time.Now().Add(time.Hour),
+		ProviderUuids: []string{uuid},
+	}
+	require.NoError(t, valid.ValidateBasic())
+
+	tests := []struct {
+		name   string
+		modify func(*types.MsgGrantPromoCredit)
+		errMsg string
+	}{
+		{"invalid authority", func(m *types.MsgGrantPromoCredit) { m.Authority = invalidAddr }, "invalid authority address"},
+		{"invalid tenant", func(m *types.MsgGrantPromoCredit) { m.Tenant = invalidAddr }, "invalid tenant address"},
+		{"empty amount", func(m *types.MsgGrantPromoCredit) { m.Amount = nil }, "amount must be positive"},
+		{"no expiry", func(m *types.MsgGrantPromoCredit) { m.ExpiresAt = time.Time{} }, "expires_at must be set"},
+		{"invalid provider uuid", func(m *types.MsgGrantPromoCredit) { m.ProviderUuids = []string{"bad"} }, "provider_uuids"},
+		{"duplicate sku uuid", func(m *types.MsgGrantPromoCredit) { m.SkuUuids = []string{uuid, uuid} }, "duplicate UUID"},
+		{"too many skus", func(m *types.MsgGrantPromoCredit) { m.SkuUuids = make([]string, types.MaxPromoGrantRestrictions+1) }, "maximum is"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := valid
+			tc.modify(&msg)
+			require.ErrorContains(t, msg.ValidateBasic(), tc.errMsg)
+		})
+	}
+}
+
+func TestPromoGrant_AppliesTo(t *testing.T) {
+	provider := "01912345-6789-7abc-8def-0123456789ab"
+	sku := "01912345-6789-7abc-8def-0123456789ac"
+	other := "01912345-6789-7abc-8def-0123456789ad"
+
+	require.True(t, types.PromoGrant{}.AppliesTo(provider, sku))
+	require.True(t, types.PromoGrant{ProviderUuids: []string{provider}}.AppliesTo(provider, sku))
+	require.False(t, types.PromoGrant{ProviderUuids: []string{other}}.AppliesTo(provider, sku))
+	require.True(t, types.PromoGrant{SkuUuids: []string{other, sku}}.AppliesTo(provider, sku))
+	require.False(t, types.PromoGrant{ProviderUuids: []string{provider}, SkuUuids: []string{other}}.AppliesTo(provider, sku))
+
+	lease := types.Lease{ProviderUuid: provider, Items: []types.LeaseItem{{SkuUuid: sku, Metered: true}}}
+	require.False(t, types.PromoGrant{}.AppliesToLease(lease), "metered items are not paid by promo credit")
+	lease.Items = append(lease.Items, types.LeaseItem{SkuUuid: other})
+	require.True(t, types.PromoGrant{SkuUuids: []string{other}}.AppliesToLease(lease))
+}
+
+func TestGenesisState_ValidatePromoGrants(t *testing.T) {
+	_, _, tenantAddr := testdata.KeyTestPubAddr()
+	_, _, granterAddr := testdata.KeyTestPubAddr()
+	now := time.Now().UTC()
+
+	grant := types.PromoGrant{
+		Id:        0,
+		Tenant:    tenantAddr.String(),
+		Granter:   granterAddr.String(),
+		Amount:    sdk.NewCoins(sdk.NewCoin(testDenom, math.NewInt(100))),
+		Remaining: sdk.NewCoins(sdk.NewCoin(testDenom, math.NewInt(40))),
+		CreatedAt: now,
+		ExpiresAt: now.Add(time.Hour),
+	}
+	genesis := func(sequence uint64, grants ...types.PromoGrant) *types.GenesisState {
+		gs := types.DefaultGenesis()
+		gs.PromoGrants = grants
+		gs.PromoGrantSequence = sequence
+		return gs
+	}
+
+	require.NoError(t, genesis(1, grant).Validate())
+	require.ErrorContains(t, genesis(0, grant).Validate(), "promo_grant_sequence")
+	require.ErrorContains(t, genesis(1, grant, grant).Validate(), "duplicate promo grant")
+
+	overspent := grant
+	overspent.Remaining = sdk.NewCoins(sdk.NewCoin(testDenom, math.NewInt(101)))
+	require.ErrorContains(t, genesis(1, overspent).Validate(), "not within amount")
+
+	expired := grant
+	expired.ExpiresAt = now
+	require.ErrorContains(t, genesis(1, expired).Validate(), "expires at or before")
+
+	badGranter := grant
+	badGranter.Granter = invalidAddr
+	require.ErrorIs(t, genesis(1, badGranter).Validate(), types.ErrInvalidPromoGrant)
+}
+
+func TestParams_ValidateExchangeRateFields(t *testing.T) {
+	_, _, feederAddr := testdata.KeyTestPubAddr()
+
+	params := types.DefaultParams()
+	params.ReferenceDenom = "umfx"
+	params.PriceFeeders = []string{feederAddr.String()}
+	require.NoError(t, params.Validate())
+	require.True(t, params.IsPriceFeeder(feederAddr.String()))
+
+	badDenom := params
+	badDenom.ReferenceDenom = "1bad"
+	require.ErrorContains(t, badDenom.Validate(), "invalid reference_denom")
+
+	badFeeder := params
+	badFeeder.PriceFeeders = []string{invalidAddr}
+	require.ErrorContains(t, badFeeder.Validate(), "invalid address in price feeders")
+
+	duplicate := params
+	duplicate.PriceFeeders = []string{feederAddr.String(), feederAddr.String()}
+	require.ErrorContains(t, duplicate.Validate(), "duplicate address in price feeders")
+}
+
+func TestMsgSetExchangeRates_ValidateBasic(t *testing.T) {
+	_, _, senderAddr := testdata.KeyTestPubAddr()
+	now := time.Now().UTC()
+
+	valid := types.MsgSetExchangeRates{
+		Sender: senderAddr.String(),
+		Rates: []types.ExchangeRateInput{
+			{Denom: testDenom, Rate: math.LegacyMustNewDecFromStr("0.25"), ValidUntil: now.Add(time.Hour)},
+			{Denom: "uatom", Rate: math.LegacyZeroDec()},
+		},
+	}
+	require.NoError(t, valid.ValidateBasic())
+
+	tests := []struct {
+		name   string
+		modify func(*types.MsgSetExchangeRates)
+		errMsg string
+	}{
+		{"invalid sender", func(m *types.MsgSetExchangeRates) { m.Sender = invalidAddr }, "invalid sender address"},
+		{"no rates", func(m *types.MsgSetExchangeRates) { m.Rates = nil }, "rates cannot be empty"},
+		{"too many rates", func(m *types.MsgSetExchangeRates) {
+			m.Rates = make([]types.ExchangeRateInput, types.MaxExchangeRates+1)
+		}, "exceed the maximum"},
+		{"invalid denom", func(m *types.MsgSetExchangeRates) {
+			m.Rates = []types.ExchangeRateInput{{Denom: "1bad", Rate: math.LegacyZeroDec()}}
+		}, "invalid denom"},
+		{"negative rate", func(m *types.MsgSetExchangeRates) {
+			m.Rates = []types.ExchangeRateInput{{Denom: testDenom, Rate: math.LegacyNewDec(-1), ValidUntil: now.Add(time.Hour)}}
+		}, "must not be negative"},
+		{"no valid_until", func(m *types.MsgSetExchangeRates) {
+			m.Rates = []types.ExchangeRateInput{{Denom: testDenom, Rate: math.LegacyOneDec()}}
+		}, "needs valid_until"},
+		{"empty window", func(m *types.MsgSetExchangeRates) {
+			from := now.Add(time.Hour)
+			m.Rates = []types.ExchangeRateInput{{Denom: testDenom, Rate: math.LegacyOneDec(), ValidFrom: &from, ValidUntil: now}}
+		}, "at or before valid_from"},
+		{"duplicate denom", func(m *types.MsgSetExchangeRates) { m.Rates = append(m.Rates, m.Rates[1]) }, "duplicate denom"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := valid
+			msg.Rates = append([]types.ExchangeRateInput(nil), valid.Rates...)
+			tc.modify(&msg)
+			require.ErrorContains(t, msg.ValidateBasic(), tc.errMsg)
+		})
+	}
+}
+
+func TestExchangeRate_IsValidAt(t *testing.T) {
+	now := time.Now().UTC()
+	rate := types.ExchangeRate{ValidFrom: now, ValidUntil: now.Add(time.Hour)}
+
+	require.False(t, rate.IsValidAt(now.Add(-time.Second)))
+	require.True(t, rate.IsValidAt(now))
+	require.True(t, rate.IsValidAt(now.Add(time.Hour-time.Second)))
+	require.False(t, rate.IsValidAt(now.Add(time.Hour)))
+}
+
+func TestGenesisState_ValidateExchangeRates(t *testing.T) {
+	_, _, feederAddr := testdata.KeyTestPubAddr()
+	now := time.Now().UTC()
+
+	rate := types.ExchangeRate{
+		Denom:      testDenom,
+		Rate:       math.LegacyMustNewDecFromStr("0.25"),
+		ValidFrom:  now,
+		ValidUntil: now.Add(time.Hour),
+		SetBy:      feederAddr.String(),
+		SetAt:      now,
+	}
+	genesis := func(rates ...types.ExchangeRate) *types.GenesisState {
+		gs := types.DefaultGenesis()
+		gs.Params.ReferenceDenom = "umfx"
+		gs.ExchangeRates = rates
+		return gs
+	}
+
+	require.NoError(t, genesis(rate).Validate())
+	require.ErrorContains(t, genesis(rate, rate).Validate(), "duplicate exchange rate")
+
+	reference := rate
+	reference.Denom = "umfx"
+	require.ErrorContains(t, genesis(reference).Validate(), "reference denom")
+
+	zero := rate
+	zero.Rate = math.LegacyZeroDec()
+	require.ErrorContains(t, genesis(zero).Validate(), "must be positive")
+
+	emptyWindow := rate
+	emptyWindow.ValidUntil = now
+	require.ErrorIs(t, genesis(emptyWindow).Validate(), types.ErrInvalidExchangeRate)
+}
+
+func TestGenesisState_ValidateCreditDeposits(t *testing.T) {
+	_, _, tenantAddr := testdata.KeyTestPubAddr()
+	tenant := tenantAddr.String()
+	hour := time.Now().UTC().Truncate(time.Hour)
+
+	deposit := types.CreditDeposit{
+		Tenant:      tenant,
+		Amount:      sdk.NewCoin(testDenom, math.NewInt(100)),
+		DepositedAt: hour,
+	}
+	genesis := func(deposits ...types.CreditDeposit) *types.GenesisState {
+		gs := types.DefaultGenesis()
+		gs.CreditAccounts = []types.CreditAccount{{
+			Tenant:        tenant,
+			CreditAddress: types.DeriveCreditAddress(tenantAddr).String(),
+		}}
+		gs.CreditDeposits = deposits
+		return gs
+	}
+
+	require.NoError(t, genesis(deposit).Validate())
+	require.ErrorContains(t, genesis(deposit, deposit).Validate(), "duplicate credit deposit")
+
+	otherDenom := deposit
+	otherDenom.Amount = sdk.NewCoin("uother", math.NewInt(1))
+	require.NoError(t, genesis(deposit, otherDenom).Validate())
+
+	_, _, stranger := testdata.KeyTestPubAddr()
+	noAccount := deposit
+	noAccount.Tenant = stranger.String()
+	require.ErrorContains(t, genesis(noAccount).Validate(), "without a credit account")
+
+	zero := deposit
+	zero.Amount = sdk.NewCoin(testDenom, math.ZeroInt())
+	require.ErrorContains(t, genesis(zero).Validate(), "invalid amount")
+
+	offHour := deposit
+	offHour.DepositedAt = hour.Add(time.Minute)
+	require.ErrorContains(t, genesis(offHour).Validate(), "off the hour")
+}
@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -27,6 +30,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQuerySKU(),
 		GetCmdQuerySKUs(),
 		GetCmdQuerySKUsByProvider(),
+		GetCmdSearchSKUs(),
+		GetCmdQuerySKUPriceHistory(),
+		GetCmdQueryPendingPriceChanges(),
 	)
 
 	return queryCmd
@@ -261,6 +267,96 @@ func GetCmdQuerySKUsByProvider() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQuerySKUPriceHistory returns the command to query the price history
+// of a SKU.
+func GetCmdQuerySKUPriceHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "price-history [sku-uuid]",
+		Short:   "Query the price history of a SKU",
+		Example: "price-history 01912345-6789-7abc-8def-0123456789ab",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			flagSet, err := client.FlagSetWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(flagSet)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SKUPriceHistory(cmd.Context(), &types.QuerySKUPriceHistoryRequest{
+				SkuUuid:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "price-history")
+	return cmd
+}
+
+// GetCmdQueryPendingPriceChanges returns the command to query scheduled SKU
+// price changes.
+func GetCmdQueryPendingPriceChanges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-price-changes",
+		Short:   "Query scheduled SKU price changes",
+		Example: "pending-price-changes --sku-uuid 01912345-6789-7abc-8def-0123456789ab",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			flagSet, err := client.FlagSetWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(flagSet)
+			if err != nil {
+				return err
+			}
+
+			skuUUID, err := cmd.Flags().GetString("sku-uuid")
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PendingPriceChanges(cmd.Context(), &types.QueryPendingPriceChangesRequest{
+				SkuUuid:    skuUUID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String("sku-uuid", "", "Only return the change pending for this SKU")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-price-changes")
+	return cmd
+}
+
 // GetCmdQueryProviderByAddress returns the command to query Providers by address.
 func GetCmdQueryProviderByAddress() *cobra.Command {
 	cmd := &cobra.Command{
@@ -312,3 +408,100 @@ providers associated with the given address.`,
 	flags.AddPaginationFlagsToCmd(cmd, "providers-by-address")
 	return cmd
 }
+
+// GetCmdSearchSKUs returns the command to search SKUs by attribute.
+func GetCmdSearchSKUs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "search-skus",
+		Short: "Search SKUs by attribute",
+		Long: `Search SKUs by their attributes and the attributes of their providers.
+
+Each filter is KEY=VALUE for an exact match or KEY=MIN..MAX for an inclusive
+range on a uint attribute; either bound of a range may be left out. All filters
+must match.`,
+		Example: "search-skus --filter gpu_model=h100 --filter vcpus=8..32 --provider-filter region=eu --active-only",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			flagSet, err := client.FlagSetWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(flagSet)
+			if err != nil {
+				return err
+			}
+
+			activeOnly, err := cmd.Flags().GetBool("active-only")
+			if err != nil {
+				return err
+			}
+
+			filters, err := parseAttributeFilters(cmd, "filter")
+			if err != nil {
+				return err
+			}
+
+			providerFilters, err := parseAttributeFilters(cmd, "provider-filter")
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SearchSKUs(cmd.Context(), &types.QuerySearchSKUsRequest{
+				Filters:         filters,
+				ProviderFilters: providerFilters,
+				ActiveOnly:      activeOnly,
+				Pagination:      pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().StringArray("filter", nil, "SKU attribute filter as KEY=VALUE or KEY=MIN..MAX (repeatable)")
+	cmd.Flags().StringArray("provider-filter", nil, "Provider attribute filter as KEY=VALUE or KEY=MIN..MAX (repeatable)")
+	cmd.Flags().Bool("active-only", false, "Filter to return only active SKUs")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "search-skus")
+	return cmd
+}
+
+// parseAttributeFilters reads a repeatable filter flag. A value of the form
+// MIN..MAX, where each side is empty or all digits, is a range; anything else
+// is an exact match.
+func parseAttributeFilters(cmd *cobra.Command, flag string) ([]types.AttributeFilter, error) {
+	filterStrs, _ := cmd.Flags().GetStringArray(flag)
+	filters := make([]types.AttributeFilter, 0, len(filterStrs))
+	for _, s := range filterStrs {
+		key, value, ok := strings.Cut(s, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid --%s %q (expected KEY=VALUE or KEY=MIN..MAX)", flag, s)
+		}
+		if minStr, maxStr, isRange := strings.Cut(value, ".."); isRange && isDigits(minStr) && isDigits(maxStr) {
+			filters = append(filters, types.AttributeFilter{Key: key, Min: minStr, Max: maxStr})
+			continue
+		}
+		filters = append(filters, types.AttributeFilter{Key: key, Value: value})
+	}
+	return filters, nil
+}
+
+// isDigits reports whether s contains only ASCII digits. The empty string
+// counts, so that open-ended ranges parse.
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
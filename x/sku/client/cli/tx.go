@@ -3,8 +3,10 @@ package cli
 import (
 	"encoding/hex"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -34,6 +36,7 @@ func NewTxCmd() *cobra.Command {
 		MsgCreateSKU(),
 		MsgUpdateSKU(),
 		MsgDeactivateSKU(),
+		MsgScheduleSKUPriceChange(),
 		MsgUpdateParams(),
 	)
 
@@ -68,12 +71,18 @@ off-chain API is hosted for tenant authentication and connection details.`,
 
 			apiURL, _ := cmd.Flags().GetString("api-url")
 
+			attributes, err := parseAttributes(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgCreateProvider(
 				authority.String(),
 				address,
 				payoutAddress,
 				metaHash,
 				apiURL,
+				attributes,
 			)
 
 			if err := msg.Validate(); err != nil {
@@ -86,6 +95,7 @@ off-chain API is hosted for tenant authentication and connection details.`,
 
 	cmd.Flags().String("meta-hash", "", "Hex-encoded hash of off-chain metadata")
 	cmd.Flags().String("api-url", "", "HTTPS endpoint where the provider's off-chain API is hosted")
+	cmd.Flags().StringArray("attribute", nil, "Attribute as KEY=VALUE (repeatable)")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -131,6 +141,11 @@ The api-url is the HTTPS endpoint where the provider's off-chain API is hosted.`
 
 			apiURL, _ := cmd.Flags().GetString("api-url")
 
+			attributes, err := parseAttributes(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgUpdateProvider(
 				authority.String(),
 				uuid,
@@ -139,6 +154,7 @@ The api-url is the HTTPS endpoint where the provider's off-chain API is hosted.`
 				metaHash,
 				active,
 				apiURL,
+				attributes,
 			)
 
 			if err := msg.Validate(); err != nil {
@@ -151,6 +167,7 @@ The api-url is the HTTPS endpoint where the provider's off-chain API is hosted.`
 
 	cmd.Flags().String("meta-hash", "", "Hex-encoded hash of off-chain metadata")
 	cmd.Flags().String("api-url", "", "HTTPS endpoint where the provider's off-chain API is hosted")
+	cmd.Flags().StringArray("attribute", nil, "Attribute as KEY=VALUE (repeatable)")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -212,8 +229,20 @@ func MsgCreateSKU() *cobra.Command {
 
 Unit values:
   1 = per hour
-  2 = per day`,
-		Example: "create-sku 01912345-6789-7abc-8def-0123456789ab \"Compute Instance\" 1 100umfx --meta-hash deadbeef",
+  2 = per day
+  3 = per usage (charged per unit of provider-reported usage)
+  4 = per minute
+  5 = per week
+  6 = per calendar month (UTC, billed over each month's actual length)
+
+Volume prices are added with --price-tier MIN_QUANTITY:PRICE, repeated in
+ascending order. A lease item is priced at the last tier reached by its
+quantity plus the tenant's quantity of the SKU in pending and active leases;
+below the first tier it pays the base price.
+
+--capacity limits the total quantity PENDING and ACTIVE leases may hold at
+once; zero (the default) means unlimited.`,
+		Example: "create-sku 01912345-6789-7abc-8def-0123456789ab \"GPU\" 1 36000umfx --price-tier 5:28800umfx --meta-hash deadbeef",
 		Args:    cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -242,6 +271,21 @@ Unit values:
 				return err
 			}
 
+			priceTiers, err := parsePriceTiers(cmd)
+			if err != nil {
+				return err
+			}
+
+			capacity, err := cmd.Flags().GetUint64("capacity")
+			if err != nil {
+				return err
+			}
+
+			attributes, err := parseAttributes(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgCreateSKU(
 				authority.String(),
 				providerUUID,
@@ -249,6 +293,9 @@ Unit values:
 				unit,
 				basePrice,
 				metaHash,
+				priceTiers,
+				capacity,
+				attributes,
 			)
 
 			if err := msg.Validate(); err != nil {
@@ -260,6 +307,9 @@ Unit values:
 	}
 
 	cmd.Flags().String("meta-hash", "", "Hex-encoded hash of off-chain metadata")
+	cmd.Flags().StringArray("price-tier", nil, "Volume price as MIN_QUANTITY:PRICE (repeatable, ascending)")
+	cmd.Flags().Uint64("capacity", 0, "Total quantity leases may hold at once (0 = unlimited)")
+	cmd.Flags().StringArray("attribute", nil, "Attribute as KEY=VALUE (repeatable)")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -274,11 +324,21 @@ func MsgUpdateSKU() *cobra.Command {
 Unit values:
   1 = per hour
   2 = per day
+  3 = per usage (charged per unit of provider-reported usage)
+  4 = per minute
+  5 = per week
+  6 = per calendar month (UTC, billed over each month's actual length)
 
 Active values:
   true  - keep active or reactivate an inactive SKU (requires active provider)
   false - NOT ALLOWED (use deactivate-sku instead)
 
+Price tiers are replaced by the --price-tier flags given (MIN_QUANTITY:PRICE);
+omitting them removes all tiers. Existing leases keep their locked prices.
+
+--capacity replaces the quantity limit (zero means unlimited). Lowering it
+below what leases hold keeps those leases and refuses new quantity.
+
 Note: To deactivate a SKU, use the 'deactivate-sku' command.`,
 		Example: "update-sku 01912345-6789-7abc-8def-0123456789ab 01912345-6789-7abc-8def-0123456789ab \"Updated Name\" 2 200umfx true --meta-hash deadbeef",
 		Args:    cobra.ExactArgs(6),
@@ -315,6 +375,21 @@ Note: To deactivate a SKU, use the 'deactivate-sku' command.`,
 				return err
 			}
 
+			priceTiers, err := parsePriceTiers(cmd)
+			if err != nil {
+				return err
+			}
+
+			capacity, err := cmd.Flags().GetUint64("capacity")
+			if err != nil {
+				return err
+			}
+
+			attributes, err := parseAttributes(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgUpdateSKU(
 				authority.String(),
 				uuid,
@@ -324,6 +399,9 @@ Note: To deactivate a SKU, use the 'deactivate-sku' command.`,
 				basePrice,
 				metaHash,
 				active,
+				priceTiers,
+				capacity,
+				attributes,
 			)
 
 			if err := msg.Validate(); err != nil {
@@ -335,6 +413,9 @@ Note: To deactivate a SKU, use the 'deactivate-sku' command.`,
 	}
 
 	cmd.Flags().String("meta-hash", "", "Hex-encoded hash of off-chain metadata")
+	cmd.Flags().StringArray("price-tier", nil, "Volume price as MIN_QUANTITY:PRICE (repeatable, ascending)")
+	cmd.Flags().Uint64("capacity", 0, "Total quantity leases may hold at once (0 = unlimited)")
+	cmd.Flags().StringArray("attribute", nil, "Attribute as KEY=VALUE (repeatable)")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -375,12 +456,75 @@ Inactive SKUs cannot be used for new leases but existing leases continue with th
 	return cmd
 }
 
+// MsgScheduleSKUPriceChange returns a CLI command handler for scheduling a
+// SKU price change.
+func MsgScheduleSKUPriceChange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-price-change [sku-uuid] [base-price] [effective-at]",
+		Short: "Schedule a new price for a SKU",
+		Long: `Schedule a new base price and price tiers for a SKU, applied at the end of
+the first block at or after effective-at (RFC3339, must be in the future).
+
+A SKU has at most one pending change; scheduling again replaces it. Price
+tiers are given with --price-tier flags (MIN_QUANTITY:PRICE); omitting them
+removes all tiers when the change applies. Existing leases keep their locked
+prices.`,
+		Example: "schedule-price-change 01912345-6789-7abc-8def-0123456789ab 150umfx 2026-01-01T00:00:00Z --price-tier 10:120umfx",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority := clientCtx.GetFromAddress()
+
+			skuUUID := args[0]
+
+			basePrice, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid base price: %w", err)
+			}
+
+			effectiveAt, err := time.Parse(time.RFC3339, args[2])
+			if err != nil {
+				return fmt.Errorf("invalid effective-at (must be RFC3339): %w", err)
+			}
+
+			priceTiers, err := parsePriceTiers(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgScheduleSKUPriceChange(
+				authority.String(),
+				skuUUID,
+				basePrice,
+				priceTiers,
+				effectiveAt,
+			)
+
+			if err := msg.Validate(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringArray("price-tier", nil, "Volume price as MIN_QUANTITY:PRICE (repeatable, ascending)")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // MsgUpdateParams returns a CLI command handler for updating the module parameters.
 func MsgUpdateParams() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-params",
 		Short: "Update the module parameters",
-		Long: `Update the module parameters including the allowed list.
+		Long: `Update the module parameters including the allowed list and the
+attribute schema. Parameters are replaced as a whole, so every attribute
+definition to keep must be passed again.
 Only the module authority can execute this command.`,
 		Example: "update-params --allowed-list manifest1abc...,manifest1def...",
 		Args:    cobra.NoArgs,
@@ -398,8 +542,14 @@ Only the module authority can execute this command.`,
 				allowedList = splitAddresses(allowedListStr)
 			}
 
+			schema, err := parseAttributeSchema(cmd)
+			if err != nil {
+				return err
+			}
+
 			params := types.Params{
-				AllowedList: allowedList,
+				AllowedList:     allowedList,
+				AttributeSchema: schema,
 			}
 
 			msg := types.NewMsgUpdateParams(authority.String(), params)
@@ -413,6 +563,7 @@ Only the module authority can execute this command.`,
 	}
 
 	cmd.Flags().String("allowed-list", "", "Comma-separated list of addresses allowed to manage SKUs")
+	cmd.Flags().StringArray("attribute-def", nil, "Attribute schema entry as KEY:string or KEY:uint (repeatable)")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -444,3 +595,65 @@ func parseMetaHash(cmd *cobra.Command) ([]byte, error) {
 	}
 	return metaHash, nil
 }
+
+// parsePriceTiers reads the --price-tier flags, each MIN_QUANTITY:PRICE.
+func parsePriceTiers(cmd *cobra.Command) ([]types.PriceTier, error) {
+	tierStrs, _ := cmd.Flags().GetStringArray("price-tier")
+	tiers := make([]types.PriceTier, 0, len(tierStrs))
+	for _, s := range tierStrs {
+		minStr, priceStr, ok := strings.Cut(s, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid price tier %q (expected MIN_QUANTITY:PRICE)", s)
+		}
+		minQuantity, err := strconv.ParseUint(minStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid price tier min quantity %q: %w", minStr, err)
+		}
+		price, err := sdk.ParseCoinNormalized(priceStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid price tier price %q: %w", priceStr, err)
+		}
+		tiers = append(tiers, types.PriceTier{MinQuantity: minQuantity, Price: price})
+	}
+	return tiers, nil
+}
+
+// parseAttributes reads the --attribute flags, each KEY=VALUE, and returns
+// them sorted by key.
+func parseAttributes(cmd *cobra.Command) ([]types.Attribute, error) {
+	attrStrs, _ := cmd.Flags().GetStringArray("attribute")
+	attrs := make([]types.Attribute, 0, len(attrStrs))
+	for _, s := range attrStrs {
+		key, value, ok := strings.Cut(s, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid attribute %q (expected KEY=VALUE)", s)
+		}
+		attrs = append(attrs, types.Attribute{Key: key, Value: value})
+	}
+	sort.Slice(attrs, func(i, j int) bool { return attrs[i].Key < attrs[j].Key })
+	return attrs, nil
+}
+
+// parseAttributeSchema reads the --attribute-def flags, each KEY:TYPE where
+// TYPE is string or uint.
+func parseAttributeSchema(cmd *cobra.Command) ([]types.AttributeDefinition, error) {
+	defStrs, _ := cmd.Flags().GetStringArray("attribute-def")
+	defs := make([]types.AttributeDefinition, 0, len(defStrs))
+	for _, s := range defStrs {
+		key, typeStr, ok := strings.Cut(s, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid attribute definition %q (expected KEY:TYPE)", s)
+		}
+		var valueType types.AttributeType
+		switch typeStr {
+		case "string":
+			valueType = types.AttributeType_ATTRIBUTE_TYPE_STRING
+		case "uint":
+			valueType = types.AttributeType_ATTRIBUTE_TYPE_UINT
+		default:
+			return nil, fmt.Errorf("invalid attribute type %q (must be string or uint)", typeStr)
+		}
+		defs = append(defs, types.AttributeDefinition{Key: key, ValueType: valueType})
+	}
+	return defs, nil
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	"github.com/manifest-network/manifest-ledger/x/sku/types"
+)
+
+// attributeIndexKey is an (attribute key, encoded value, primary key) entry
+// of an AttributeIndex.
+type attributeIndexKey = collections.Triple[string, string, string]
+
+// AttributeIndex indexes the entries of an IndexedMap by each of their
+// attributes. Unlike indexes.Multi, an entry is referenced once per
+// attribute. Values are stored with encodeAttributeValue, so uint values sort
+// numerically and can be scanned by range.
+type AttributeIndex[V any] struct {
+	refKeys    collections.KeySet[attributeIndexKey]
+	attributes func(V) []types.Attribute
+}
+
+// NewAttributeIndex creates an AttributeIndex over the attributes returned
+// by attributes.
+func NewAttributeIndex[V any](
+	sb *collections.SchemaBuilder,
+	prefix collections.Prefix,
+	name string,
+	attributes func(V) []types.Attribute,
+) *AttributeIndex[V] {
+	return &AttributeIndex[V]{
+		refKeys: collections.NewKeySet(
+			sb,
+			prefix,
+			name,
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey),
+		),
+		attributes: attributes,
+	}
+}
+
+// Reference implements collections.Index.
+func (i *AttributeIndex[V]) Reference(ctx context.Context, pk string, newValue V, lazyOldValue func() (V, error)) error {
+	oldValue, err := lazyOldValue()
+	switch {
+	case err == nil:
+		if err := i.unreference(ctx, pk, oldValue); err != nil {
+			return err
+		}
+	case errors.Is(err, collections.ErrNotFound):
+	default:
+		return err
+	}
+	for _, attr := range i.attributes(newValue) {
+		if err := i.refKeys.Set(ctx, collections.Join3(attr.Key, encodeAttributeValue(attr.Value), pk)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Unreference implements collections.Index.
+func (i *AttributeIndex[V]) Unreference(ctx context.Context, pk string, getValue func() (V, error)) error {
+	value, err := getValue()
+	if err != nil {
+		return err
+	}
+	return i.unreference(ctx, pk, value)
+}
+
+func (i *AttributeIndex[V]) unreference(ctx context.Context, pk string, value V) error {
+	for _, attr := range i.attributes(value) {
+		if err := i.refKeys.Remove(ctx, collections.Join3(attr.Key, encodeAttributeValue(attr.Value), pk)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Match returns an iterator over the primary keys of the entries matching
+// filter, ordered by value and then primary key. The filter must pass
+// AttributeFilter.Validate.
+func (i *AttributeIndex[V]) Match(ctx context.Context, filter types.AttributeFilter, reverse bool) (*AttributeIndexIterator, error) {
+	var rng attributeRange
+	if filter.IsRange() {
+		lo, hi := filter.Bounds()
+		rng = attributeRange{
+			start: collections.RangeKeyExact(collections.TripleSuperPrefix[string, string, string](filter.Key, encodeUintAttribute(lo))),
+			end:   collections.RangeKeyPrefixEnd(collections.TripleSuperPrefix[string, string, string](filter.Key, encodeUintAttribute(hi))),
+		}
+	} else {
+		key := collections.TripleSuperPrefix[string, string, string](filter.Key, encodeAttributeValue(filter.Value))
+		rng = attributeRange{
+			start: collections.RangeKeyExact(key),
+			end:   collections.RangeKeyPrefixEnd(key),
+		}
+	}
+	if reverse {
+		rng.order = collections.OrderDescending
+	}
+	iter, err := i.refKeys.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	return &AttributeIndexIterator{iter}, nil
+}
+
+// AttributeIndexIterator iterates over the primary keys matched by an
+// AttributeIndex. It satisfies pagination.StringIndexIterator.
+type AttributeIndexIterator struct {
+	collections.KeySetIterator[attributeIndexKey]
+}
+
+// PrimaryKey returns the primary key of the current entry.
+func (it *AttributeIndexIterator) PrimaryKey() (string, error) {
+	key, err := it.Key()
+	if err != nil {
+		return "", err
+	}
+	return key.K3(), nil
+}
+
+// attributeRange is a collections.Ranger over attribute index keys.
+type attributeRange struct {
+	start, end *collections.RangeKey[attributeIndexKey]
+	order      collections.Order
+}
+
+// RangeValues implements collections.Ranger.
+func (r attributeRange) RangeValues() (start, end *collections.RangeKey[attributeIndexKey], order collections.Order, err error) {
+	return r.start, r.end, r.order, nil
+}
+
+// encodeAttributeValue returns the index form of an attribute value. Values
+// in canonical uint form are zero-padded to a fixed width behind a "0" tag, so
+// they sort numerically; any other value is stored behind a "1" tag. The tags
+// keep the two forms from colliding.
+func encodeAttributeValue(value string) string {
+	if n, err := types.ParseUintAttribute(value); err == nil {
+		return encodeUintAttribute(n)
+	}
+	return "1" + value
+}
+
+func encodeUintAttribute(n uint64) string {
+	return fmt.Sprintf("0%020d", n)
+}
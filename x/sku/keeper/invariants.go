@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/sku/types"
+)
+
+// SKUProvidersInvariantName is the route of the invariant that checks SKU
+// provider references, e.g. sku/sku-providers for MsgVerifyInvariant.
+const SKUProvidersInvariantName = "sku-providers"
+
+// RegisterInvariants registers all SKU invariants with the crisis module.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, SKUProvidersInvariantName, SKUProvidersInvariant(k))
+}
+
+// SKUProvidersInvariant checks that every SKU references an existing
+// provider. Providers are never deleted, so a dangling reference means
+// corrupted state.
+func SKUProvidersInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		err := k.SKUs.Walk(ctx, nil, func(uuid string, sku types.SKU) (bool, error) {
+			has, err := k.Providers.Has(ctx, sku.ProviderUuid)
+			if err != nil {
+				return true, err
+			}
+			if !has {
+				broken++
+				msg += fmt.Sprintf("\tsku %s references non-existent provider %s\n", uuid, sku.ProviderUuid)
+			}
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, SKUProvidersInvariantName,
+				fmt.Sprintf("failed to read state: %s", err)), true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, SKUProvidersInvariantName,
+			fmt.Sprintf("found %d SKUs with non-existent providers\n%s", broken, msg)), broken != 0
+	}
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"errors"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/collections/indexes"
@@ -27,11 +28,15 @@ type ProviderIndexes struct {
 	// Active is a multi-index that indexes Providers by their active status.
 	// This enables efficient queries for active providers only (O(k) instead of O(n)).
 	Active *indexes.Multi[bool, string, types.Provider]
+
+	// Attribute indexes Providers by each of their attributes, so that
+	// SearchSKUs can resolve provider filters without a full scan.
+	Attribute *AttributeIndex[types.Provider]
 }
 
 // IndexesList returns all indexes defined for the Provider collection.
 func (i ProviderIndexes) IndexesList() []collections.Index[string, types.Provider] {
-	return []collections.Index[string, types.Provider]{i.Address, i.Active}
+	return []collections.Index[string, types.Provider]{i.Address, i.Active, i.Attribute}
 }
 
 // NewProviderIndexes creates a new ProviderIndexes instance.
@@ -57,6 +62,14 @@ func NewProviderIndexes(sb *collections.SchemaBuilder) ProviderIndexes {
 				return provider.Active, nil
 			},
 		),
+		Attribute: NewAttributeIndex(
+			sb,
+			types.ProviderByAttributeIndexKey,
+			"providers_by_attribute",
+			func(provider types.Provider) []types.Attribute {
+				return provider.Attributes
+			},
+		),
 	}
 }
 
@@ -72,11 +85,15 @@ type SKUIndexes struct {
 	// ProviderActive is a compound multi-index that indexes SKUs by (provider_uuid, active).
 	// This enables efficient queries for active SKUs filtered by provider.
 	ProviderActive *indexes.Multi[collections.Pair[string, bool], string, types.SKU]
+
+	// Attribute indexes SKUs by each of their attributes.
+	// This enables SearchSKUs to match a value or range in O(k).
+	Attribute *AttributeIndex[types.SKU]
 }
 
 // IndexesList returns all indexes defined for the SKU collection.
 func (i SKUIndexes) IndexesList() []collections.Index[string, types.SKU] {
-	return []collections.Index[string, types.SKU]{i.Provider, i.Active, i.ProviderActive}
+	return []collections.Index[string, types.SKU]{i.Provider, i.Active, i.ProviderActive, i.Attribute}
 }
 
 // NewSKUIndexes creates a new SKUIndexes instance.
@@ -112,6 +129,44 @@ func NewSKUIndexes(sb *collections.SchemaBuilder) SKUIndexes {
 				return collections.Join(sku.ProviderUuid, sku.Active), nil
 			},
 		),
+		Attribute: NewAttributeIndex(
+			sb,
+			types.SKUByAttributeIndexKey,
+			"skus_by_attribute",
+			func(sku types.SKU) []types.Attribute {
+				return sku.Attributes
+			},
+		),
+	}
+}
+
+// PendingPriceChangeIndexes defines the indexes for the PendingPriceChange
+// collection.
+type PendingPriceChangeIndexes struct {
+	// EffectiveAt indexes pending changes by the time they take effect, so
+	// the EndBlocker only walks the ones that are due.
+	EffectiveAt *indexes.Multi[time.Time, string, types.PendingPriceChange]
+}
+
+// IndexesList returns all indexes defined for the PendingPriceChange
+// collection.
+func (i PendingPriceChangeIndexes) IndexesList() []collections.Index[string, types.PendingPriceChange] {
+	return []collections.Index[string, types.PendingPriceChange]{i.EffectiveAt}
+}
+
+// NewPendingPriceChangeIndexes creates a new PendingPriceChangeIndexes instance.
+func NewPendingPriceChangeIndexes(sb *collections.SchemaBuilder) PendingPriceChangeIndexes {
+	return PendingPriceChangeIndexes{
+		EffectiveAt: indexes.NewMulti(
+			sb,
+			types.PendingPriceChangeByTimeIndexKey,
+			"pending_price_changes_by_time",
+			sdk.TimeKey,
+			collections.StringKey,
+			func(_ string, change types.PendingPriceChange) (time.Time, error) {
+				return change.EffectiveAt, nil
+			},
+		),
 	}
 }
 
@@ -134,6 +189,15 @@ type Keeper struct {
 	ProviderSequence collections.Sequence
 	SKUSequence      collections.Sequence
 
+	// PriceHistory holds the prices each SKU has taken, keyed by
+	// (sku_uuid, entry id) so that a SKU's entries iterate oldest first.
+	PriceHistory collections.Map[collections.Pair[string, uint64], types.PriceHistoryEntry]
+	// PriceHistorySequence assigns PriceHistoryEntry ids.
+	PriceHistorySequence collections.Sequence
+
+	// PendingPriceChanges holds at most one scheduled price change per SKU.
+	PendingPriceChanges *collections.IndexedMap[string, types.PendingPriceChange, PendingPriceChangeIndexes]
+
 	authority string
 }
 
@@ -186,6 +250,26 @@ func NewKeeper(
 			types.SKUSequenceKey,
 			"sku_sequence",
 		),
+		PriceHistory: collections.NewMap(
+			sb,
+			types.PriceHistoryKey,
+			"price_history",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.PriceHistoryEntry](cdc),
+		),
+		PriceHistorySequence: collections.NewSequence(
+			sb,
+			types.PriceHistorySequenceKey,
+			"price_history_sequence",
+		),
+		PendingPriceChanges: collections.NewIndexedMap(
+			sb,
+			types.PendingPriceChangeKey,
+			"pending_price_changes",
+			collections.StringKey,
+			codec.CollValue[types.PendingPriceChange](cdc),
+			NewPendingPriceChangeIndexes(sb),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -274,6 +358,23 @@ func (k *Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error
 		}
 	}
 
+	for _, entry := range gs.PriceHistory {
+		if err := k.PriceHistory.Set(ctx, collections.Join(entry.SkuUuid, entry.Id), entry); err != nil {
+			return err
+		}
+	}
+	if gs.PriceHistorySequence > 0 {
+		if err := k.PriceHistorySequence.Set(ctx, gs.PriceHistorySequence); err != nil {
+			return err
+		}
+	}
+
+	for _, change := range gs.PendingPriceChanges {
+		if err := k.PendingPriceChanges.Set(ctx, change.SkuUuid, change); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -311,12 +412,37 @@ func (k *Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	var priceHistory []types.PriceHistoryEntry
+	err = k.PriceHistory.Walk(ctx, nil, func(_ collections.Pair[string, uint64], entry types.PriceHistoryEntry) (bool, error) {
+		priceHistory = append(priceHistory, entry)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	priceHistorySeq, err := k.PriceHistorySequence.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	var pendingPriceChanges []types.PendingPriceChange
+	err = k.PendingPriceChanges.Walk(ctx, nil, func(_ string, change types.PendingPriceChange) (bool, error) {
+		pendingPriceChanges = append(pendingPriceChanges, change)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
-		Params:           params,
-		Providers:        providers,
-		Skus:             skus,
-		ProviderSequence: providerSeq,
-		SkuSequence:      skuSeq,
+		Params:               params,
+		Providers:            providers,
+		Skus:                 skus,
+		ProviderSequence:     providerSeq,
+		SkuSequence:          skuSeq,
+		PriceHistory:         priceHistory,
+		PriceHistorySequence: priceHistorySeq,
+		PendingPriceChanges:  pendingPriceChanges,
 	}
 }
 
@@ -378,9 +504,23 @@ func (k *Keeper) GetSKU(ctx context.Context, uuid string) (types.SKU, error) {
 	return sku, nil
 }
 
-// SetSKU sets a SKU in the store.
+// SetSKU sets a SKU in the store. When the SKU is new or its base price or
+// price tiers change, the new price is appended to its price history.
 func (k *Keeper) SetSKU(ctx context.Context, sku types.SKU) error {
-	return k.SKUs.Set(ctx, sku.Uuid, sku)
+	prev, err := k.SKUs.Get(ctx, sku.Uuid)
+	hadPrev := err == nil
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	if err := k.SKUs.Set(ctx, sku.Uuid, sku); err != nil {
+		return err
+	}
+
+	if hadPrev && samePrice(prev, sku) {
+		return nil
+	}
+	return k.recordPriceHistory(ctx, sku)
 }
 
 // GenerateSKUUUID generates a new deterministic UUIDv7 for a SKU.
@@ -1,10 +1,13 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -15,6 +18,7 @@ import (
 	"github.com/manifest-network/manifest-ledger/app"
 	"github.com/manifest-network/manifest-ledger/app/apptesting"
 	appparams "github.com/manifest-network/manifest-ledger/app/params"
+	"github.com/manifest-network/manifest-ledger/x/sku/keeper"
 	"github.com/manifest-network/manifest-ledger/x/sku/types"
 )
 
@@ -632,3 +636,180 @@ func TestSKUsByProviderUUIDPagination(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, skus, 0)
 }
+
+func TestSKUProvidersInvariant(t *testing.T) {
+	_, _, providerAddr := testdata.KeyTestPubAddr()
+	f := initFixture(t)
+	k := f.App.SKUKeeper
+	invariant := keeper.SKUProvidersInvariant(k)
+
+	require.NoError(t, k.SetProvider(f.Ctx, types.Provider{
+		Uuid:          testProviderUUID,
+		Address:       providerAddr.String(),
+		PayoutAddress: providerAddr.String(),
+		Active:        true,
+	}))
+	sku := types.SKU{
+		Uuid:         testSKU1UUID,
+		ProviderUuid: testProviderUUID,
+		Name:         "Test SKU",
+		Unit:         types.Unit_UNIT_PER_HOUR,
+		BasePrice:    sdk.NewCoin("umfx", sdkmath.NewInt(100)),
+		Active:       true,
+	}
+	require.NoError(t, k.SetSKU(f.Ctx, sku))
+
+	_, broken := invariant(f.Ctx)
+	require.False(t, broken)
+
+	sku.Uuid = testSKU2UUID
+	sku.ProviderUuid = testProvider1UUID
+	require.NoError(t, k.SetSKU(f.Ctx, sku))
+
+	msg, broken := invariant(f.Ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, testProvider1UUID)
+}
+
+func TestSKUPriceHistory(t *testing.T) {
+	f := initFixture(t)
+	k := f.App.SKUKeeper
+
+	// Generated UUIDs keep the sequences consistent for the genesis check below.
+	providerUUID, err := k.GenerateProviderUUID(f.Ctx)
+	require.NoError(t, err)
+	require.NoError(t, k.SetProvider(f.Ctx, types.Provider{
+		Uuid: providerUUID, Address: f.TestAccs[0].String(),
+		PayoutAddress: f.TestAccs[1].String(), Active: true,
+	}))
+	skuUUID, err := k.GenerateSKUUUID(f.Ctx)
+	require.NoError(t, err)
+
+	start := f.Ctx.BlockTime()
+	sku := types.SKU{
+		Uuid: skuUUID, ProviderUuid: providerUUID,
+		Name: "Test SKU", Unit: types.Unit_UNIT_PER_HOUR,
+		BasePrice: sdk.NewCoin("umfx", sdkmath.NewInt(7200)), Active: true,
+	}
+	require.NoError(t, k.SetSKU(f.Ctx, sku))
+
+	// Changes that leave the price alone are not recorded.
+	sku.Name = "Renamed SKU"
+	require.NoError(t, k.SetSKU(f.Ctx, sku))
+
+	f.Ctx = f.Ctx.WithBlockTime(start.Add(time.Hour))
+	sku.PriceTiers = []types.PriceTier{{MinQuantity: 10, Price: sdk.NewCoin("umfx", sdkmath.NewInt(3600))}}
+	require.NoError(t, k.SetSKU(f.Ctx, sku))
+
+	f.Ctx = f.Ctx.WithBlockTime(start.Add(2 * time.Hour))
+	sku.BasePrice = sdk.NewCoin("umfx", sdkmath.NewInt(10800))
+	require.NoError(t, k.SetSKU(f.Ctx, sku))
+
+	var entries []types.PriceHistoryEntry
+	err = k.PriceHistory.Walk(f.Ctx, collections.NewPrefixedPairRange[string, uint64](skuUUID), func(_ collections.Pair[string, uint64], e types.PriceHistoryEntry) (bool, error) {
+		entries = append(entries, e)
+		return false, nil
+	})
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	require.Equal(t, sdkmath.NewInt(7200), entries[0].BasePrice.Amount)
+	require.Empty(t, entries[0].PriceTiers)
+	require.True(t, start.Equal(entries[0].ChangedAt))
+
+	require.Equal(t, sdkmath.NewInt(7200), entries[1].BasePrice.Amount)
+	require.Len(t, entries[1].PriceTiers, 1)
+	require.True(t, start.Add(time.Hour).Equal(entries[1].ChangedAt))
+
+	require.Equal(t, sdkmath.NewInt(10800), entries[2].BasePrice.Amount)
+	require.True(t, start.Add(2*time.Hour).Equal(entries[2].ChangedAt))
+	require.Less(t, entries[1].Id, entries[2].Id)
+
+	// History and pending changes survive an export/import round trip.
+	_, _, err = k.SchedulePriceChange(f.Ctx, types.PendingPriceChange{
+		SkuUuid:     skuUUID,
+		BasePrice:   sdk.NewCoin("umfx", sdkmath.NewInt(1800)),
+		EffectiveAt: start.Add(24 * time.Hour),
+		ScheduledBy: f.TestAccs[0].String(),
+	})
+	require.NoError(t, err)
+
+	exported := k.ExportGenesis(f.Ctx)
+	require.Len(t, exported.PriceHistory, 3)
+	require.Len(t, exported.PendingPriceChanges, 1)
+	require.NoError(t, exported.Validate())
+
+	f2 := initFixture(t)
+	k2 := f2.App.SKUKeeper
+	require.NoError(t, k2.InitGenesis(f2.Ctx, exported))
+
+	reexported := k2.ExportGenesis(f2.Ctx)
+	require.Equal(t, exported.PriceHistory, reexported.PriceHistory)
+	require.Equal(t, exported.PriceHistorySequence, reexported.PriceHistorySequence)
+	require.Equal(t, exported.PendingPriceChanges, reexported.PendingPriceChanges)
+}
+
+func TestMigrate1to2_SeedsPriceHistory(t *testing.T) {
+	f := initFixture(t)
+	k := f.App.SKUKeeper
+
+	providerUUID, err := k.GenerateProviderUUID(f.Ctx)
+	require.NoError(t, err)
+	require.NoError(t, k.SetProvider(f.Ctx, types.Provider{
+		Uuid: providerUUID, Address: f.TestAccs[0].String(),
+		PayoutAddress: f.TestAccs[1].String(), Active: true,
+	}))
+
+	// SKUs written straight to the store stand in for SKUs created before v2,
+	// which have no price history.
+	var skuUUIDs []string
+	for i, amount := range []int64{3600, 7200} {
+		skuUUID, err := k.GenerateSKUUUID(f.Ctx)
+		require.NoError(t, err)
+		require.NoError(t, k.SKUs.Set(f.Ctx, skuUUID, types.SKU{
+			Uuid: skuUUID, ProviderUuid: providerUUID,
+			Name: fmt.Sprintf("SKU %d", i), Unit: types.Unit_UNIT_PER_HOUR,
+			BasePrice: sdk.NewCoin("umfx", sdkmath.NewInt(amount)), Active: true,
+		}))
+		skuUUIDs = append(skuUUIDs, skuUUID)
+	}
+
+	historyOf := func(skuUUID string) []types.PriceHistoryEntry {
+		var entries []types.PriceHistoryEntry
+		err := k.PriceHistory.Walk(f.Ctx, collections.NewPrefixedPairRange[string, uint64](skuUUID), func(_ collections.Pair[string, uint64], e types.PriceHistoryEntry) (bool, error) {
+			entries = append(entries, e)
+			return false, nil
+		})
+		require.NoError(t, err)
+		return entries
+	}
+	for _, skuUUID := range skuUUIDs {
+		require.Empty(t, historyOf(skuUUID))
+	}
+
+	// v1 params carry no attribute schema.
+	params := types.DefaultParams()
+	params.AttributeSchema = nil
+	require.NoError(t, k.SetParams(f.Ctx, params))
+
+	migrator := keeper.NewMigrator(k)
+	require.NoError(t, migrator.Migrate1to2(f.Ctx))
+
+	params, err = k.GetParams(f.Ctx)
+	require.NoError(t, err)
+	require.Empty(t, params.AttributeSchema)
+	require.NoError(t, params.Validate())
+
+	for i, skuUUID := range skuUUIDs {
+		entries := historyOf(skuUUID)
+		require.Len(t, entries, 1)
+		require.Equal(t, []int64{3600, 7200}[i], entries[0].BasePrice.Amount.Int64())
+		require.True(t, f.Ctx.BlockTime().Equal(entries[0].ChangedAt))
+	}
+
+	// Running the migration again does not add entries.
+	require.NoError(t, migrator.Migrate1to2(f.Ctx))
+	for _, skuUUID := range skuUUIDs {
+		require.Len(t, historyOf(skuUUID), 1)
+	}
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/sku/types"
+)
+
+// Migrator is a wrapper around Keeper used to register state migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a Migrator for the given keeper.
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{keeper: k}
+}
+
+// Migrate1to2 migrates the module from consensus version 1 to 2.
+//
+// Version 2 adds price history, scheduled price changes and typed
+// attributes:
+//   - Every existing SKU gets its current price recorded as the first entry
+//     of its PriceHistory, dated at the upgrade block. SKUs that already
+//     have a history are left alone.
+//   - Params.attribute_schema is absent from v1 params and decodes as an
+//     empty schema, which is valid; the params are checked, not rewritten.
+//   - SKUs and providers carry no attributes before v2, so the attribute
+//     indexes and the pending price change store start empty under their
+//     new prefixes and need no backfill.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := m.migrateAttributeSchema(ctx); err != nil {
+		return err
+	}
+	return m.seedPriceHistory(ctx)
+}
+
+// migrateAttributeSchema checks that the stored params, whose attribute
+// schema is empty after a v1 upgrade, are valid under v2.
+func (m Migrator) migrateAttributeSchema(ctx sdk.Context) error {
+	params, err := m.keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	return params.Validate()
+}
+
+// seedPriceHistory records the current price of every SKU without a
+// PriceHistory entry.
+func (m Migrator) seedPriceHistory(ctx sdk.Context) error {
+	skus, err := m.keeper.GetAllSKUs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, sku := range skus {
+		has, err := m.hasPriceHistory(ctx, sku.Uuid)
+		if err != nil {
+			return err
+		}
+		if has {
+			continue
+		}
+		if err := m.keeper.recordPriceHistory(ctx, sku); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hasPriceHistory reports whether a SKU has at least one PriceHistory entry.
+func (m Migrator) hasPriceHistory(ctx sdk.Context, skuUUID string) (bool, error) {
+	found := false
+	err := m.keeper.PriceHistory.Walk(ctx, collections.NewPrefixedPairRange[string, uint64](skuUUID), func(_ collections.Pair[string, uint64], _ types.PriceHistoryEntry) (bool, error) {
+		found = true
+		return true, nil
+	})
+	return found, err
+}
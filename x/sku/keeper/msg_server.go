@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -32,6 +34,18 @@ func (ms msgServer) isAuthorizedSender(ctx context.Context, sender string) (bool
 	return params.IsAllowed(sender), nil
 }
 
+// checkAttributes checks attributes against the attribute schema in params.
+func (ms msgServer) checkAttributes(ctx context.Context, attrs []types.Attribute) error {
+	if len(attrs) == 0 {
+		return nil
+	}
+	params, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	return params.Schema().CheckAttributes(attrs)
+}
+
 // CreateProvider creates a new Provider.
 func (ms msgServer) CreateProvider(ctx context.Context, req *types.MsgCreateProvider) (*types.MsgCreateProviderResponse, error) {
 	authorized, err := ms.isAuthorizedSender(ctx, req.Authority)
@@ -46,6 +60,10 @@ func (ms msgServer) CreateProvider(ctx context.Context, req *types.MsgCreateProv
 		return nil, types.ErrInvalidProvider.Wrapf("invalid create provider message: %s", err)
 	}
 
+	if err := ms.checkAttributes(ctx, req.Attributes); err != nil {
+		return nil, err
+	}
+
 	uuid, err := ms.k.GenerateProviderUUID(ctx)
 	if err != nil {
 		return nil, err
@@ -58,6 +76,7 @@ func (ms msgServer) CreateProvider(ctx context.Context, req *types.MsgCreateProv
 		MetaHash:      req.MetaHash,
 		Active:        true,
 		ApiUrl:        req.ApiUrl,
+		Attributes:    req.Attributes,
 	}
 
 	if err := ms.k.SetProvider(ctx, provider); err != nil {
@@ -94,6 +113,10 @@ func (ms msgServer) UpdateProvider(ctx context.Context, req *types.MsgUpdateProv
 		return nil, types.ErrInvalidProvider.Wrapf("invalid update provider message: %s", err)
 	}
 
+	if err := ms.checkAttributes(ctx, req.Attributes); err != nil {
+		return nil, err
+	}
+
 	existingProvider, err := ms.k.GetProvider(ctx, req.Uuid)
 	if err != nil {
 		return nil, types.ErrProviderNotFound.Wrapf("provider %s not found", req.Uuid)
@@ -120,6 +143,7 @@ func (ms msgServer) UpdateProvider(ctx context.Context, req *types.MsgUpdateProv
 		MetaHash:      req.MetaHash,
 		Active:        req.Active,
 		ApiUrl:        apiURL,
+		Attributes:    req.Attributes,
 	}
 
 	if err := ms.k.SetProvider(ctx, provider); err != nil {
@@ -288,6 +312,10 @@ func (ms msgServer) CreateSKU(ctx context.Context, req *types.MsgCreateSKU) (*ty
 		return nil, types.ErrInvalidSKU.Wrapf("invalid create sku message: %s", err)
 	}
 
+	if err := ms.checkAttributes(ctx, req.Attributes); err != nil {
+		return nil, err
+	}
+
 	// Verify provider exists and is active
 	provider, err := ms.k.GetProvider(ctx, req.ProviderUuid)
 	if err != nil {
@@ -310,6 +338,9 @@ func (ms msgServer) CreateSKU(ctx context.Context, req *types.MsgCreateSKU) (*ty
 		BasePrice:    req.BasePrice,
 		MetaHash:     req.MetaHash,
 		Active:       true,
+		PriceTiers:   req.PriceTiers,
+		Capacity:     req.Capacity,
+		Attributes:   req.Attributes,
 	}
 
 	if err := ms.k.SetSKU(ctx, sku); err != nil {
@@ -350,6 +381,10 @@ func (ms msgServer) UpdateSKU(ctx context.Context, req *types.MsgUpdateSKU) (*ty
 		return nil, types.ErrInvalidSKU.Wrapf("invalid update sku message: %s", err)
 	}
 
+	if err := ms.checkAttributes(ctx, req.Attributes); err != nil {
+		return nil, err
+	}
+
 	existingSKU, err := ms.k.GetSKU(ctx, req.Uuid)
 	if err != nil {
 		return nil, types.ErrSKUNotFound.Wrapf("sku %s not found", req.Uuid)
@@ -386,6 +421,9 @@ func (ms msgServer) UpdateSKU(ctx context.Context, req *types.MsgUpdateSKU) (*ty
 		BasePrice:    req.BasePrice,
 		MetaHash:     req.MetaHash,
 		Active:       req.Active,
+		PriceTiers:   req.PriceTiers,
+		Capacity:     req.Capacity,
+		Attributes:   req.Attributes,
 	}
 
 	if err := ms.k.SetSKU(ctx, sku); err != nil {
@@ -495,3 +533,49 @@ func (ms msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// ScheduleSKUPriceChange schedules a SKU's price to change at a future time.
+// The change is announced now, with the price it replaces, so that tenants
+// can see it before it applies; the EndBlocker applies it at effective_at.
+func (ms msgServer) ScheduleSKUPriceChange(ctx context.Context, req *types.MsgScheduleSKUPriceChange) (*types.MsgScheduleSKUPriceChangeResponse, error) {
+	authorized, err := ms.isAuthorizedSender(ctx, req.Authority)
+	if err != nil {
+		return nil, types.ErrUnauthorized.Wrapf("failed to check authorization: %s", err)
+	}
+	if !authorized {
+		return nil, types.ErrUnauthorized.Wrapf("%s is not the authority or in the allowed list", req.Authority)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, types.ErrInvalidSKU.Wrapf("invalid schedule sku price change message: %s", err)
+	}
+
+	sku, replaced, err := ms.k.SchedulePriceChange(ctx, types.PendingPriceChange{
+		SkuUuid:     req.SkuUuid,
+		BasePrice:   req.BasePrice,
+		PriceTiers:  req.PriceTiers,
+		EffectiveAt: req.EffectiveAt,
+		ScheduledBy: req.Authority,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePriceChangeScheduled,
+			sdk.NewAttribute(types.AttributeKeySKUUUID, sku.Uuid),
+			sdk.NewAttribute(types.AttributeKeyProviderUUID, sku.ProviderUuid),
+			sdk.NewAttribute(types.AttributeKeyPreviousPrice, sku.BasePrice.String()),
+			sdk.NewAttribute(types.AttributeKeyBasePrice, req.BasePrice.String()),
+			sdk.NewAttribute(types.AttributeKeyEffectiveAt, req.EffectiveAt.UTC().Format(time.RFC3339)),
+			sdk.NewAttribute(types.AttributeKeyScheduledBy, req.Authority),
+			sdk.NewAttribute(types.AttributeKeyReplaced, strconv.FormatBool(replaced)),
+		),
+	})
+
+	ms.k.Logger().Info("SKU price change scheduled", "uuid", sku.Uuid, "effective_at", req.EffectiveAt.UTC().Format(time.RFC3339))
+
+	return &types.MsgScheduleSKUPriceChangeResponse{}, nil
+}
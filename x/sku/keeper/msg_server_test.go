@@ -64,11 +64,22 @@
 //   - Success: address in allowed list can deactivate SKU
 //   - Fail: address not in allowed list cannot create provider
 //   - Fail: address not in allowed list cannot create SKU
+//
+// ## Price Schedule Tests
+//
+// TestScheduleSKUPriceChangeMsg:
+//   - Fail: unauthorized sender, unknown SKU, past effective_at, invalid tiers
+//   - Success: rescheduling replaces the pending change
+//   - Success: EndBlocker applies the change at effective_at and records history
+//
+// TestScheduledPriceChangeDroppedForInvalidUnit:
+//   - A due change that no longer fits the SKU's unit is dropped
 package keeper_test
 
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -1290,6 +1301,128 @@ func TestUpdateSKU(t *testing.T) {
 	}
 }
 
+func TestSKUPriceTiers(t *testing.T) {
+	_, _, authority := testdata.KeyTestPubAddr()
+	_, _, providerAddr := testdata.KeyTestPubAddr()
+
+	f := initFixture(t)
+
+	k := f.App.SKUKeeper
+	k.SetAuthority(authority.String())
+	ms := keeper.NewMsgServerImpl(k)
+
+	err := k.SetProvider(f.Ctx, types.Provider{
+		Uuid:          testProvider1UUID,
+		Address:       providerAddr.String(),
+		PayoutAddress: providerAddr.String(),
+		Active:        true,
+	})
+	require.NoError(t, err)
+
+	basePrice := sdk.NewCoin("umfx", sdkmath.NewInt(36000))
+	tiers := []types.PriceTier{
+		{MinQuantity: 5, Price: sdk.NewCoin("umfx", sdkmath.NewInt(28800))},
+	}
+
+	resp, err := ms.CreateSKU(f.Ctx, types.NewMsgCreateSKU(authority.String(), testProvider1UUID, "GPU", types.Unit_UNIT_PER_HOUR, basePrice, nil, tiers, 0, nil))
+	require.NoError(t, err)
+
+	sku, err := k.GetSKU(f.Ctx, resp.Uuid)
+	require.NoError(t, err)
+	require.Equal(t, tiers, sku.PriceTiers)
+
+	// Updating without tiers removes them.
+	_, err = ms.UpdateSKU(f.Ctx, types.NewMsgUpdateSKU(authority.String(), resp.Uuid, testProvider1UUID, "GPU", types.Unit_UNIT_PER_HOUR, basePrice, nil, true, nil, 0, nil))
+	require.NoError(t, err)
+
+	sku, err = k.GetSKU(f.Ctx, resp.Uuid)
+	require.NoError(t, err)
+	require.Empty(t, sku.PriceTiers)
+}
+
+func TestCreateAndUpdateSKUCapacity(t *testing.T) {
+	_, _, authority := testdata.KeyTestPubAddr()
+	_, _, providerAddr := testdata.KeyTestPubAddr()
+
+	f := initFixture(t)
+
+	k := f.App.SKUKeeper
+	k.SetAuthority(authority.String())
+	ms := keeper.NewMsgServerImpl(k)
+
+	err := k.SetProvider(f.Ctx, types.Provider{
+		Uuid:          testProvider1UUID,
+		Address:       providerAddr.String(),
+		PayoutAddress: providerAddr.String(),
+		Active:        true,
+	})
+	require.NoError(t, err)
+
+	basePrice := sdk.NewCoin("umfx", sdkmath.NewInt(36000))
+	resp, err := ms.CreateSKU(f.Ctx, types.NewMsgCreateSKU(authority.String(), testProvider1UUID, "GPU", types.Unit_UNIT_PER_HOUR, basePrice, nil, nil, 8, nil))
+	require.NoError(t, err)
+
+	sku, err := k.GetSKU(f.Ctx, resp.Uuid)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), sku.Capacity)
+
+	// Zero lifts the limit.
+	_, err = ms.UpdateSKU(f.Ctx, types.NewMsgUpdateSKU(authority.String(), resp.Uuid, testProvider1UUID, "GPU", types.Unit_UNIT_PER_HOUR, basePrice, nil, true, nil, 0, nil))
+	require.NoError(t, err)
+
+	sku, err = k.GetSKU(f.Ctx, resp.Uuid)
+	require.NoError(t, err)
+	require.Zero(t, sku.Capacity)
+}
+
+func TestSKUAttributesMsg(t *testing.T) {
+	_, _, authority := testdata.KeyTestPubAddr()
+	_, _, providerAddr := testdata.KeyTestPubAddr()
+
+	f := initFixture(t)
+
+	k := f.App.SKUKeeper
+	k.SetAuthority(authority.String())
+	ms := keeper.NewMsgServerImpl(k)
+
+	require.NoError(t, k.SetParams(f.Ctx, types.Params{AttributeSchema: []types.AttributeDefinition{
+		{Key: "region", ValueType: types.AttributeType_ATTRIBUTE_TYPE_STRING},
+		{Key: "vcpus", ValueType: types.AttributeType_ATTRIBUTE_TYPE_UINT},
+	}}))
+
+	// Provider attributes are checked against the schema.
+	_, err := ms.CreateProvider(f.Ctx, types.NewMsgCreateProvider(authority.String(), providerAddr.String(), providerAddr.String(), nil, "", []types.Attribute{{Key: "gpu_model", Value: "h100"}}))
+	require.ErrorIs(t, err, types.ErrInvalidAttribute)
+
+	provResp, err := ms.CreateProvider(f.Ctx, types.NewMsgCreateProvider(authority.String(), providerAddr.String(), providerAddr.String(), nil, "", []types.Attribute{{Key: "region", Value: "eu"}}))
+	require.NoError(t, err)
+
+	provider, err := k.GetProvider(f.Ctx, provResp.Uuid)
+	require.NoError(t, err)
+	require.Equal(t, []types.Attribute{{Key: "region", Value: "eu"}}, provider.Attributes)
+
+	basePrice := sdk.NewCoin("umfx", sdkmath.NewInt(36000))
+
+	// A uint attribute must hold a canonical unsigned integer.
+	_, err = ms.CreateSKU(f.Ctx, types.NewMsgCreateSKU(authority.String(), provResp.Uuid, "VM", types.Unit_UNIT_PER_HOUR, basePrice, nil, nil, 0, []types.Attribute{{Key: "vcpus", Value: "eight"}}))
+	require.ErrorIs(t, err, types.ErrInvalidAttribute)
+
+	resp, err := ms.CreateSKU(f.Ctx, types.NewMsgCreateSKU(authority.String(), provResp.Uuid, "VM", types.Unit_UNIT_PER_HOUR, basePrice, nil, nil, 0, []types.Attribute{{Key: "vcpus", Value: "8"}}))
+	require.NoError(t, err)
+
+	sku, err := k.GetSKU(f.Ctx, resp.Uuid)
+	require.NoError(t, err)
+	require.Equal(t, []types.Attribute{{Key: "vcpus", Value: "8"}}, sku.Attributes)
+
+	// Updating without attributes removes them.
+	_, err = ms.UpdateSKU(f.Ctx, types.NewMsgUpdateSKU(authority.String(), resp.Uuid, provResp.Uuid, "VM", types.Unit_UNIT_PER_HOUR, basePrice, nil, true, nil, 0, nil))
+	require.NoError(t, err)
+
+	sku, err = k.GetSKU(f.Ctx, resp.Uuid)
+	require.NoError(t, err)
+	require.Empty(t, sku.Attributes)
+}
+
 func TestDeactivateSKUMsg(t *testing.T) {
 	_, _, authority := testdata.KeyTestPubAddr()
 	_, _, acc := testdata.KeyTestPubAddr()
@@ -1853,3 +1986,173 @@ func TestParamsAllowedListRemoval(t *testing.T) {
 	require.Error(t, err)
 	require.ErrorContains(t, err, "unauthorized")
 }
+
+func TestScheduleSKUPriceChangeMsg(t *testing.T) {
+	_, _, authority := testdata.KeyTestPubAddr()
+	_, _, acc := testdata.KeyTestPubAddr()
+	_, _, providerAddr := testdata.KeyTestPubAddr()
+
+	f := initFixture(t)
+
+	k := f.App.SKUKeeper
+	k.SetAuthority(authority.String())
+	ms := keeper.NewMsgServerImpl(k)
+
+	provResp, err := ms.CreateProvider(f.Ctx, types.NewMsgCreateProvider(authority.String(), providerAddr.String(), providerAddr.String(), nil, "", nil))
+	require.NoError(t, err)
+
+	skuResp, err := ms.CreateSKU(f.Ctx, types.NewMsgCreateSKU(authority.String(), provResp.Uuid, "VM", types.Unit_UNIT_PER_HOUR, sdk.NewCoin("umfx", sdkmath.NewInt(3600)), nil, nil, 0, nil))
+	require.NoError(t, err)
+
+	now := f.Ctx.BlockTime()
+	newPrice := sdk.NewCoin("umfx", sdkmath.NewInt(7200))
+	tiers := []types.PriceTier{{MinQuantity: 10, Price: sdk.NewCoin("umfx", sdkmath.NewInt(3600))}}
+
+	cases := []struct {
+		name   string
+		msg    *types.MsgScheduleSKUPriceChange
+		errMsg string
+	}{
+		{
+			name:   "fail; unauthorized sender",
+			msg:    types.NewMsgScheduleSKUPriceChange(acc.String(), skuResp.Uuid, newPrice, nil, now.Add(time.Hour)),
+			errMsg: "unauthorized",
+		},
+		{
+			name:   "fail; sku not found",
+			msg:    types.NewMsgScheduleSKUPriceChange(authority.String(), "01912345-6789-7abc-8def-999999999999", newPrice, nil, now.Add(time.Hour)),
+			errMsg: "not found",
+		},
+		{
+			name:   "fail; effective_at not in the future",
+			msg:    types.NewMsgScheduleSKUPriceChange(authority.String(), skuResp.Uuid, newPrice, nil, now),
+			errMsg: "must be after the current block time",
+		},
+		{
+			name:   "fail; tier denom mismatch",
+			msg:    types.NewMsgScheduleSKUPriceChange(authority.String(), skuResp.Uuid, newPrice, []types.PriceTier{{MinQuantity: 10, Price: sdk.NewCoin("uother", sdkmath.NewInt(6000))}}, now.Add(time.Hour)),
+			errMsg: "does not match base price denom",
+		},
+		{
+			name:   "fail; tier not divisible under an integer-rate base price",
+			msg:    types.NewMsgScheduleSKUPriceChange(authority.String(), skuResp.Uuid, newPrice, []types.PriceTier{{MinQuantity: 10, Price: sdk.NewCoin("umfx", sdkmath.NewInt(6000))}}, now.Add(time.Hour)),
+			errMsg: "not evenly divisible",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := ms.ScheduleSKUPriceChange(f.Ctx, c.msg)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), c.errMsg)
+		})
+	}
+
+	// Scheduling twice replaces the pending change.
+	_, err = ms.ScheduleSKUPriceChange(f.Ctx, types.NewMsgScheduleSKUPriceChange(authority.String(), skuResp.Uuid, sdk.NewCoin("umfx", sdkmath.NewInt(5400)), nil, now.Add(2*time.Hour)))
+	require.NoError(t, err)
+
+	f.Ctx = f.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = ms.ScheduleSKUPriceChange(f.Ctx, types.NewMsgScheduleSKUPriceChange(authority.String(), skuResp.Uuid, newPrice, tiers, now.Add(time.Hour)))
+	require.NoError(t, err)
+
+	event, found := findEvent(f.Ctx.EventManager().Events(), types.EventTypePriceChangeScheduled)
+	require.True(t, found)
+	require.Equal(t, "true", eventAttribute(event, types.AttributeKeyReplaced))
+	require.Equal(t, authority.String(), eventAttribute(event, types.AttributeKeyScheduledBy))
+
+	pending, found, err := k.GetPendingPriceChange(f.Ctx, skuResp.Uuid)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, newPrice, pending.BasePrice)
+	require.True(t, now.Add(time.Hour).Equal(pending.EffectiveAt))
+
+	// Nothing changes before the effective time.
+	f.Ctx = f.Ctx.WithBlockTime(now.Add(59 * time.Minute))
+	require.NoError(t, k.EndBlocker(f.Ctx))
+
+	sku, err := k.GetSKU(f.Ctx, skuResp.Uuid)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(3600), sku.BasePrice.Amount)
+
+	// The first EndBlocker at or after the effective time applies it.
+	f.Ctx = f.Ctx.WithBlockTime(now.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.EndBlocker(f.Ctx))
+
+	sku, err = k.GetSKU(f.Ctx, skuResp.Uuid)
+	require.NoError(t, err)
+	require.Equal(t, newPrice, sku.BasePrice)
+	require.Equal(t, tiers, sku.PriceTiers)
+
+	_, found, err = k.GetPendingPriceChange(f.Ctx, skuResp.Uuid)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	event, found = findEvent(f.Ctx.EventManager().Events(), types.EventTypePriceChanged)
+	require.True(t, found)
+	require.Equal(t, "3600umfx", eventAttribute(event, types.AttributeKeyPreviousPrice))
+	require.Equal(t, "7200umfx", eventAttribute(event, types.AttributeKeyBasePrice))
+
+	history, err := keeper.NewQuerier(k).SKUPriceHistory(f.Ctx, &types.QuerySKUPriceHistoryRequest{SkuUuid: skuResp.Uuid})
+	require.NoError(t, err)
+	require.Len(t, history.Entries, 2)
+	require.Equal(t, newPrice, history.Entries[1].BasePrice)
+}
+
+func TestScheduledPriceChangeDroppedForInvalidUnit(t *testing.T) {
+	f := initFixture(t)
+	k := f.App.SKUKeeper
+
+	require.NoError(t, k.SetProvider(f.Ctx, types.Provider{
+		Uuid: testProviderUUID, Address: f.TestAccs[0].String(),
+		PayoutAddress: f.TestAccs[1].String(), Active: true,
+	}))
+	sku := types.SKU{
+		Uuid: testSKU1UUID, ProviderUuid: testProviderUUID,
+		Name: "Test SKU", Unit: types.Unit_UNIT_PER_HOUR,
+		BasePrice: sdk.NewCoin("umfx", sdkmath.NewInt(3600)), Active: true,
+	}
+	require.NoError(t, k.SetSKU(f.Ctx, sku))
+
+	now := f.Ctx.BlockTime()
+	_, _, err := k.SchedulePriceChange(f.Ctx, types.PendingPriceChange{
+		SkuUuid:     testSKU1UUID,
+		BasePrice:   sdk.NewCoin("umfx", sdkmath.NewInt(7200)),
+		EffectiveAt: now.Add(time.Hour),
+		ScheduledBy: f.TestAccs[0].String(),
+	})
+	require.NoError(t, err)
+
+	// A unit the scheduled price no longer fits makes the change void.
+	sku.Unit = types.Unit_UNIT_UNSPECIFIED
+	require.NoError(t, k.SetSKU(f.Ctx, sku))
+
+	f.Ctx = f.Ctx.WithBlockTime(now.Add(time.Hour))
+	require.NoError(t, k.EndBlocker(f.Ctx))
+
+	got, err := k.GetSKU(f.Ctx, testSKU1UUID)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(3600), got.BasePrice.Amount)
+
+	_, found, err := k.GetPendingPriceChange(f.Ctx, testSKU1UUID)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func findEvent(events sdk.Events, eventType string) (sdk.Event, bool) {
+	for _, event := range events {
+		if event.Type == eventType {
+			return event, true
+		}
+	}
+	return sdk.Event{}, false
+}
+
+func eventAttribute(event sdk.Event, key string) string {
+	for _, attr := range event.Attributes {
+		if attr.Key == key {
+			return attr.Value
+		}
+	}
+	return ""
+}
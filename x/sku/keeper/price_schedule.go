@@ -0,0 +1,205 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/manifest-network/manifest-ledger/x/sku/types"
+)
+
+// samePrice reports whether two SKUs have the same base price and price
+// tiers. Coins are compared by denom and amount rather than with Coin.IsEqual,
+// which panics on differing denoms.
+func samePrice(a, b types.SKU) bool {
+	if !sameCoin(a.BasePrice, b.BasePrice) || len(a.PriceTiers) != len(b.PriceTiers) {
+		return false
+	}
+	for i := range a.PriceTiers {
+		if a.PriceTiers[i].MinQuantity != b.PriceTiers[i].MinQuantity ||
+			!sameCoin(a.PriceTiers[i].Price, b.PriceTiers[i].Price) {
+			return false
+		}
+	}
+	return true
+}
+
+func sameCoin(a, b sdk.Coin) bool {
+	return a.Denom == b.Denom && a.Amount.Equal(b.Amount)
+}
+
+// recordPriceHistory appends the current price of sku to its price history at
+// block time.
+func (k *Keeper) recordPriceHistory(ctx context.Context, sku types.SKU) error {
+	id, err := k.PriceHistorySequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+	entry := types.PriceHistoryEntry{
+		Id:         id,
+		SkuUuid:    sku.Uuid,
+		BasePrice:  sku.BasePrice,
+		PriceTiers: sku.PriceTiers,
+		ChangedAt:  sdk.UnwrapSDKContext(ctx).BlockTime(),
+	}
+	return k.PriceHistory.Set(ctx, collections.Join(sku.Uuid, id), entry)
+}
+
+// GetPendingPriceChange returns the price change scheduled for a SKU, and
+// whether there is one.
+func (k *Keeper) GetPendingPriceChange(ctx context.Context, skuUUID string) (types.PendingPriceChange, bool, error) {
+	change, err := k.PendingPriceChanges.Get(ctx, skuUUID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.PendingPriceChange{}, false, nil
+	}
+	if err != nil {
+		return types.PendingPriceChange{}, false, err
+	}
+	return change, true, nil
+}
+
+// SchedulePriceChange stores change as the pending price change of its SKU,
+// replacing any change already pending. The effective time must be after
+// block time and the price valid for the SKU's current unit. scheduled_at is
+// set to block time. It returns the SKU and whether a change was replaced.
+func (k *Keeper) SchedulePriceChange(ctx context.Context, change types.PendingPriceChange) (types.SKU, bool, error) {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	sku, err := k.GetSKU(ctx, change.SkuUuid)
+	if err != nil {
+		return types.SKU{}, false, types.ErrSKUNotFound.Wrapf("sku %s not found", change.SkuUuid)
+	}
+
+	if !change.EffectiveAt.After(blockTime) {
+		return types.SKU{}, false, types.ErrInvalidSKU.Wrapf(
+			"effective_at %s must be after the current block time %s",
+			change.EffectiveAt.UTC().Format(time.RFC3339), blockTime.UTC().Format(time.RFC3339),
+		)
+	}
+
+	if err := types.ValidatePrice(change.BasePrice, sku.Unit, change.PriceTiers); err != nil {
+		return types.SKU{}, false, types.ErrInvalidSKU.Wrapf("invalid scheduled price: %s", err)
+	}
+
+	_, replaced, err := k.GetPendingPriceChange(ctx, change.SkuUuid)
+	if err != nil {
+		return types.SKU{}, false, err
+	}
+
+	change.EffectiveAt = change.EffectiveAt.UTC()
+	change.ScheduledAt = blockTime
+	if err := k.PendingPriceChanges.Set(ctx, change.SkuUuid, change); err != nil {
+		return types.SKU{}, false, err
+	}
+
+	return sku, replaced, nil
+}
+
+// EndBlocker applies the price changes that have become due.
+func (k *Keeper) EndBlocker(ctx context.Context) error {
+	return k.applyDuePriceChanges(ctx)
+}
+
+// applyDuePriceChanges replaces the price of every SKU whose pending change
+// has reached its effective time, capped at MaxPriceChangesPerBlock. Due
+// changes are collected first and then applied, since applying one removes
+// it from the index being walked. The price is checked again against the
+// SKU's unit, which MsgUpdateSKU may have changed since scheduling; a change
+// that no longer fits is dropped.
+func (k *Keeper) applyDuePriceChanges(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+
+	iter, err := k.PendingPriceChanges.Indexes.EffectiveAt.Iterate(ctx, collections.NewPrefixUntilPairRange[time.Time, string](blockTime))
+	if err != nil {
+		return err
+	}
+
+	var dueUUIDs []string
+	for ; iter.Valid(); iter.Next() {
+		if len(dueUUIDs) >= types.MaxPriceChangesPerBlock {
+			break
+		}
+		skuUUID, err := iter.PrimaryKey()
+		if err != nil {
+			k.logger.Error("failed to decode pending price change index key", "error", err)
+			continue
+		}
+		dueUUIDs = append(dueUUIDs, skuUUID)
+	}
+
+	if err := iter.Close(); err != nil {
+		k.logger.Error("failed to close iterator", "error", err)
+	}
+
+	appliedCount := 0
+	for _, skuUUID := range dueUUIDs {
+		change, err := k.PendingPriceChanges.Get(ctx, skuUUID)
+		if err != nil {
+			k.logger.Error("failed to get pending price change", "sku_uuid", skuUUID, "error", err)
+			continue
+		}
+
+		cacheCtx, writeCache := sdkCtx.CacheContext()
+		if err := k.PendingPriceChanges.Remove(cacheCtx, skuUUID); err != nil {
+			k.logger.Error("failed to remove pending price change", "sku_uuid", skuUUID, "error", err)
+			continue
+		}
+
+		sku, err := k.GetSKU(cacheCtx, skuUUID)
+		if err != nil {
+			k.logger.Error("dropping price change for missing sku", "sku_uuid", skuUUID, "error", err)
+			writeCache()
+			continue
+		}
+		if err := types.ValidatePrice(change.BasePrice, sku.Unit, change.PriceTiers); err != nil {
+			k.logger.Error("dropping price change that no longer fits the sku unit",
+				"sku_uuid", skuUUID,
+				"unit", sku.Unit.String(),
+				"error", err,
+			)
+			writeCache()
+			continue
+		}
+
+		previousPrice := sku.BasePrice
+		sku.BasePrice = change.BasePrice
+		sku.PriceTiers = change.PriceTiers
+		if err := k.SetSKU(cacheCtx, sku); err != nil {
+			k.logger.Error("failed to apply price change", "sku_uuid", skuUUID, "error", err)
+			continue
+		}
+		writeCache()
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePriceChanged,
+				sdk.NewAttribute(types.AttributeKeySKUUUID, sku.Uuid),
+				sdk.NewAttribute(types.AttributeKeyProviderUUID, sku.ProviderUuid),
+				sdk.NewAttribute(types.AttributeKeyPreviousPrice, previousPrice.String()),
+				sdk.NewAttribute(types.AttributeKeyBasePrice, sku.BasePrice.String()),
+				sdk.NewAttribute(types.AttributeKeyEffectiveAt, change.EffectiveAt.UTC().Format(time.RFC3339)),
+			),
+		)
+		appliedCount++
+	}
+
+	if appliedCount > 0 {
+		k.logger.Info("applied scheduled price changes in EndBlocker",
+			"applied_count", appliedCount,
+			"collected_count", len(dueUUIDs),
+		)
+	}
+
+	if len(dueUUIDs) >= types.MaxPriceChangesPerBlock {
+		k.logger.Warn("reached max price changes per block",
+			"limit", types.MaxPriceChangesPerBlock,
+		)
+	}
+
+	return nil
+}
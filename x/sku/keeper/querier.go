@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -279,3 +281,236 @@ func (q Querier) ProviderByAddress(ctx context.Context, req *types.QueryProvider
 		Pagination: pageRes,
 	}, nil
 }
+
+// SearchSKUs queries SKUs whose attributes, and those of their provider,
+// match every filter. The first SKU filter drives the scan through the SKU
+// attribute index; the rest are checked on each SKU found. Provider filters
+// are resolved to a set of providers first, through the provider attribute
+// index. A search with provider filters only scans the active SKUs (or all
+// SKUs), so it is cheaper with at least one SKU filter.
+func (q Querier) SearchSKUs(ctx context.Context, req *types.QuerySearchSKUsRequest) (*types.QuerySearchSKUsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Filters) == 0 && len(req.ProviderFilters) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one filter is required")
+	}
+
+	params, err := q.k.GetParams(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	schema := params.Schema()
+	if err := checkSearchFilters(schema, req.Filters); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := checkSearchFilters(schema, req.ProviderFilters); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("provider filter: %s", err))
+	}
+
+	var providers map[string]bool
+	if len(req.ProviderFilters) > 0 {
+		providers, err = q.matchProviders(ctx, req.ProviderFilters)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if len(providers) == 0 {
+			return &types.QuerySearchSKUsResponse{Pagination: &query.PageResponse{}}, nil
+		}
+	}
+
+	reverse := req.Pagination != nil && req.Pagination.Reverse
+	var iter pagination.StringIndexIterator
+	switch {
+	case len(req.Filters) > 0:
+		iter, err = q.k.SKUs.Indexes.Attribute.Match(ctx, req.Filters[0], reverse)
+	case req.ActiveOnly:
+		iter, err = pagination.MatchExactWithOrder(ctx, q.k.SKUs.Indexes.Active, true, req.Pagination)
+	default:
+		rng := new(collections.Range[string])
+		if reverse {
+			rng = rng.Descending()
+		}
+		var skus collections.Iterator[string, types.SKU]
+		skus, err = q.k.SKUs.Iterate(ctx, rng)
+		iter = skuKeyIterator{skus}
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	filter := func(sku types.SKU) bool {
+		if req.ActiveOnly && !sku.Active {
+			return false
+		}
+		if providers != nil && !providers[sku.ProviderUuid] {
+			return false
+		}
+		for _, f := range req.Filters {
+			if !f.Matches(sku.Attributes) {
+				return false
+			}
+		}
+		return true
+	}
+
+	skus, pageRes, err := pagination.PaginateStringIndex(
+		ctx,
+		iter,
+		q.k.SKUs.Get,
+		req.Pagination,
+		filter,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySearchSKUsResponse{
+		Skus:       skus,
+		Pagination: pageRes,
+	}, nil
+}
+
+// checkSearchFilters checks the filters of a search against the attribute
+// schema: every key must be declared, and ranges are only allowed on uint keys.
+func checkSearchFilters(schema types.AttributeSchema, filters []types.AttributeFilter) error {
+	if len(filters) > types.MaxSearchFilters {
+		return fmt.Errorf("%d filters exceed the maximum of %d", len(filters), types.MaxSearchFilters)
+	}
+	for _, f := range filters {
+		if err := f.Validate(); err != nil {
+			return err
+		}
+		valueType, ok := schema[f.Key]
+		if !ok {
+			return fmt.Errorf("attribute key %q is not in the attribute schema", f.Key)
+		}
+		if f.IsRange() && valueType != types.AttributeType_ATTRIBUTE_TYPE_UINT {
+			return fmt.Errorf("range filter on %q requires a uint attribute", f.Key)
+		}
+	}
+	return nil
+}
+
+// matchProviders returns the UUIDs of the providers matching every filter.
+func (q Querier) matchProviders(ctx context.Context, filters []types.AttributeFilter) (map[string]bool, error) {
+	iter, err := q.k.Providers.Indexes.Attribute.Match(ctx, filters[0], false)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	matched := make(map[string]bool)
+	for ; iter.Valid(); iter.Next() {
+		uuid, err := iter.PrimaryKey()
+		if err != nil {
+			return nil, err
+		}
+		provider, err := q.k.Providers.Get(ctx, uuid)
+		if err != nil {
+			return nil, err
+		}
+		ok := true
+		for _, f := range filters[1:] {
+			if !f.Matches(provider.Attributes) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			matched[uuid] = true
+		}
+	}
+	return matched, nil
+}
+
+// skuKeyIterator adapts an iterator over the SKU collection to
+// pagination.StringIndexIterator.
+type skuKeyIterator struct {
+	collections.Iterator[string, types.SKU]
+}
+
+// PrimaryKey returns the UUID of the current SKU.
+func (it skuKeyIterator) PrimaryKey() (string, error) {
+	return it.Key()
+}
+
+// SKUPriceHistory returns the prices a SKU has taken, oldest first, with
+// pagination.
+func (q Querier) SKUPriceHistory(ctx context.Context, req *types.QuerySKUPriceHistoryRequest) (*types.QuerySKUPriceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.SkuUuid == "" {
+		return nil, status.Error(codes.InvalidArgument, "sku_uuid cannot be empty")
+	}
+
+	if _, err := q.k.GetSKU(ctx, req.SkuUuid); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	entries, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.PriceHistory,
+		req.Pagination,
+		func(_ collections.Pair[string, uint64], entry types.PriceHistoryEntry) (types.PriceHistoryEntry, error) {
+			return entry, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.SkuUuid),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySKUPriceHistoryResponse{
+		Entries:    entries,
+		Pagination: pageRes,
+	}, nil
+}
+
+// PendingPriceChanges returns the scheduled price changes ordered by
+// effective time, or the one pending for sku_uuid when it is set.
+func (q Querier) PendingPriceChanges(ctx context.Context, req *types.QueryPendingPriceChangesRequest) (*types.QueryPendingPriceChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.SkuUuid != "" {
+		change, found, err := q.k.GetPendingPriceChange(ctx, req.SkuUuid)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		resp := &types.QueryPendingPriceChangesResponse{Pagination: &query.PageResponse{}}
+		if found {
+			resp.Changes = []types.PendingPriceChange{change}
+		}
+		return resp, nil
+	}
+
+	var rng collections.Ranger[collections.Pair[time.Time, string]]
+	if req.Pagination != nil && req.Pagination.Reverse {
+		rng = new(collections.Range[collections.Pair[time.Time, string]]).Descending()
+	}
+	iter, err := q.k.PendingPriceChanges.Indexes.EffectiveAt.Iterate(ctx, rng)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	changes, pageRes, err := pagination.PaginateStringIndex(
+		ctx,
+		iter,
+		q.k.PendingPriceChanges.Get,
+		req.Pagination,
+		nil,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingPriceChangesResponse{
+		Changes:    changes,
+		Pagination: pageRes,
+	}, nil
+}
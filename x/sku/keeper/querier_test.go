@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"slices"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -847,3 +848,229 @@ func TestQueryErrorCasesComprehensive(t *testing.T) {
 		require.Empty(t, resp2.Providers, "should return empty list")
 	})
 }
+
+func TestQuerierSearchSKUs(t *testing.T) {
+	f := initFixture(t)
+	k := f.App.SKUKeeper
+	q := keeper.NewQuerier(k)
+
+	require.NoError(t, k.SetParams(f.Ctx, types.Params{AttributeSchema: []types.AttributeDefinition{
+		{Key: "gpu_model", ValueType: types.AttributeType_ATTRIBUTE_TYPE_STRING},
+		{Key: "region", ValueType: types.AttributeType_ATTRIBUTE_TYPE_STRING},
+		{Key: "vcpus", ValueType: types.AttributeType_ATTRIBUTE_TYPE_UINT},
+	}}))
+
+	basePrice := sdk.NewCoin("umfx", sdkmath.NewInt(100))
+
+	providers := []types.Provider{
+		{Uuid: testProvider1UUID, Address: f.TestAccs[0].String(), PayoutAddress: f.TestAccs[0].String(), Active: true, Attributes: []types.Attribute{{Key: "region", Value: "eu"}}},
+		{Uuid: testProvider2UUID, Address: f.TestAccs[1].String(), PayoutAddress: f.TestAccs[1].String(), Active: true, Attributes: []types.Attribute{{Key: "region", Value: "us"}}},
+	}
+	for _, provider := range providers {
+		require.NoError(t, k.SetProvider(f.Ctx, provider))
+	}
+
+	vm := func(uuid, providerUUID, vcpus string, active bool, extra ...types.Attribute) types.SKU {
+		attrs := append(extra, types.Attribute{Key: "vcpus", Value: vcpus})
+		return types.SKU{Uuid: uuid, ProviderUuid: providerUUID, Name: "VM " + vcpus, Unit: types.Unit_UNIT_PER_HOUR, BasePrice: basePrice, Active: active, Attributes: attrs}
+	}
+	skus := []types.SKU{
+		vm("01912345-6789-7abc-8def-0123456789b1", testProvider1UUID, "4", true),
+		vm("01912345-6789-7abc-8def-0123456789b2", testProvider1UUID, "16", true, types.Attribute{Key: "gpu_model", Value: "h100"}),
+		vm("01912345-6789-7abc-8def-0123456789b3", testProvider1UUID, "32", false),
+		vm("01912345-6789-7abc-8def-0123456789b4", testProvider2UUID, "16", true, types.Attribute{Key: "gpu_model", Value: "h100"}),
+		vm("01912345-6789-7abc-8def-0123456789b5", testProvider2UUID, "100", true),
+		{Uuid: "01912345-6789-7abc-8def-0123456789b6", ProviderUuid: testProvider2UUID, Name: "Plain", Unit: types.Unit_UNIT_PER_HOUR, BasePrice: basePrice, Active: true},
+	}
+	for _, sku := range skus {
+		require.NoError(t, k.SetSKU(f.Ctx, sku))
+	}
+
+	uuids := func(res *types.QuerySearchSKUsResponse) []string {
+		var out []string
+		for _, sku := range res.Skus {
+			out = append(out, sku.Uuid)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name     string
+		req      *types.QuerySearchSKUsRequest
+		expected []string
+	}{
+		{
+			name:     "exact match",
+			req:      &types.QuerySearchSKUsRequest{Filters: []types.AttributeFilter{{Key: "gpu_model", Value: "h100"}}},
+			expected: []string{"01912345-6789-7abc-8def-0123456789b2", "01912345-6789-7abc-8def-0123456789b4"},
+		},
+		{
+			// Numeric order, not lexical: 100 is above 32.
+			name:     "range",
+			req:      &types.QuerySearchSKUsRequest{Filters: []types.AttributeFilter{{Key: "vcpus", Min: "16", Max: "64"}}},
+			expected: []string{"01912345-6789-7abc-8def-0123456789b2", "01912345-6789-7abc-8def-0123456789b4", "01912345-6789-7abc-8def-0123456789b3"},
+		},
+		{
+			name:     "open range",
+			req:      &types.QuerySearchSKUsRequest{Filters: []types.AttributeFilter{{Key: "vcpus", Min: "32"}}},
+			expected: []string{"01912345-6789-7abc-8def-0123456789b3", "01912345-6789-7abc-8def-0123456789b5"},
+		},
+		{
+			name:     "active only",
+			req:      &types.QuerySearchSKUsRequest{Filters: []types.AttributeFilter{{Key: "vcpus", Min: "32"}}, ActiveOnly: true},
+			expected: []string{"01912345-6789-7abc-8def-0123456789b5"},
+		},
+		{
+			name: "combined filters",
+			req: &types.QuerySearchSKUsRequest{Filters: []types.AttributeFilter{
+				{Key: "vcpus", Max: "16"},
+				{Key: "gpu_model", Value: "h100"},
+			}},
+			expected: []string{"01912345-6789-7abc-8def-0123456789b2", "01912345-6789-7abc-8def-0123456789b4"},
+		},
+		{
+			name: "provider filter",
+			req: &types.QuerySearchSKUsRequest{
+				Filters:         []types.AttributeFilter{{Key: "gpu_model", Value: "h100"}},
+				ProviderFilters: []types.AttributeFilter{{Key: "region", Value: "us"}},
+			},
+			expected: []string{"01912345-6789-7abc-8def-0123456789b4"},
+		},
+		{
+			name:     "provider filter only",
+			req:      &types.QuerySearchSKUsRequest{ProviderFilters: []types.AttributeFilter{{Key: "region", Value: "us"}}, ActiveOnly: true},
+			expected: []string{"01912345-6789-7abc-8def-0123456789b4", "01912345-6789-7abc-8def-0123456789b5", "01912345-6789-7abc-8def-0123456789b6"},
+		},
+		{
+			name:     "no provider match",
+			req:      &types.QuerySearchSKUsRequest{ProviderFilters: []types.AttributeFilter{{Key: "region", Value: "ap"}}},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := q.SearchSKUs(f.Ctx, tc.req)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, uuids(res))
+		})
+	}
+
+	// Pagination walks the same result set.
+	req := &types.QuerySearchSKUsRequest{
+		Filters:    []types.AttributeFilter{{Key: "vcpus", Min: "0"}},
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	}
+	res, err := q.SearchSKUs(f.Ctx, req)
+	require.NoError(t, err)
+	require.Len(t, res.Skus, 2)
+	require.Equal(t, uint64(5), res.Pagination.Total)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 10}
+	res, err = q.SearchSKUs(f.Ctx, req)
+	require.NoError(t, err)
+	require.Len(t, res.Skus, 3)
+
+	// Invalid requests.
+	for name, bad := range map[string]*types.QuerySearchSKUsRequest{
+		"no filters":          {},
+		"key not in schema":   {Filters: []types.AttributeFilter{{Key: "disk_gb", Value: "10"}}},
+		"range on string key": {Filters: []types.AttributeFilter{{Key: "region", Min: "1"}}},
+		"malformed filter":    {Filters: []types.AttributeFilter{{Key: "vcpus", Min: "9", Max: "1"}}},
+	} {
+		_, err := q.SearchSKUs(f.Ctx, bad)
+		require.Error(t, err, name)
+	}
+	_, err = q.SearchSKUs(f.Ctx, nil)
+	require.Error(t, err)
+}
+
+func TestQuerierPriceSchedule(t *testing.T) {
+	f := initFixture(t)
+	k := f.App.SKUKeeper
+	q := keeper.NewQuerier(k)
+
+	require.NoError(t, k.SetProvider(f.Ctx, types.Provider{
+		Uuid: testProviderUUID, Address: f.TestAccs[0].String(),
+		PayoutAddress: f.TestAccs[1].String(), Active: true,
+	}))
+	for _, uuid := range []string{testSKU1UUID, testSKU2UUID, testSKU3UUID} {
+		require.NoError(t, k.SetSKU(f.Ctx, types.SKU{
+			Uuid: uuid, ProviderUuid: testProviderUUID,
+			Name: "Test SKU", Unit: types.Unit_UNIT_PER_HOUR,
+			BasePrice: sdk.NewCoin("umfx", sdkmath.NewInt(3600)), Active: true,
+		}))
+	}
+
+	now := f.Ctx.BlockTime()
+	schedule := map[string]time.Duration{
+		testSKU1UUID: 3 * time.Hour,
+		testSKU2UUID: time.Hour,
+		testSKU3UUID: 2 * time.Hour,
+	}
+	for uuid, delay := range schedule {
+		_, _, err := k.SchedulePriceChange(f.Ctx, types.PendingPriceChange{
+			SkuUuid:     uuid,
+			BasePrice:   sdk.NewCoin("umfx", sdkmath.NewInt(7200)),
+			EffectiveAt: now.Add(delay),
+			ScheduledBy: f.TestAccs[0].String(),
+		})
+		require.NoError(t, err)
+	}
+
+	// Changes are listed soonest first.
+	res, err := q.PendingPriceChanges(f.Ctx, &types.QueryPendingPriceChangesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Changes, 3)
+	require.Equal(t, testSKU2UUID, res.Changes[0].SkuUuid)
+	require.Equal(t, testSKU3UUID, res.Changes[1].SkuUuid)
+	require.Equal(t, testSKU1UUID, res.Changes[2].SkuUuid)
+
+	res, err = q.PendingPriceChanges(f.Ctx, &types.QueryPendingPriceChangesRequest{Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Len(t, res.Changes, 2)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	res, err = q.PendingPriceChanges(f.Ctx, &types.QueryPendingPriceChangesRequest{Pagination: &query.PageRequest{Reverse: true}})
+	require.NoError(t, err)
+	require.Equal(t, testSKU1UUID, res.Changes[0].SkuUuid)
+
+	res, err = q.PendingPriceChanges(f.Ctx, &types.QueryPendingPriceChangesRequest{SkuUuid: testSKU3UUID})
+	require.NoError(t, err)
+	require.Len(t, res.Changes, 1)
+	require.Equal(t, testSKU3UUID, res.Changes[0].SkuUuid)
+
+	// Applying the first change removes it and extends the SKU's history.
+	f.Ctx = f.Ctx.WithBlockTime(now.Add(time.Hour))
+	require.NoError(t, k.EndBlocker(f.Ctx))
+
+	res, err = q.PendingPriceChanges(f.Ctx, &types.QueryPendingPriceChangesRequest{SkuUuid: testSKU2UUID})
+	require.NoError(t, err)
+	require.Empty(t, res.Changes)
+
+	history, err := q.SKUPriceHistory(f.Ctx, &types.QuerySKUPriceHistoryRequest{SkuUuid: testSKU2UUID})
+	require.NoError(t, err)
+	require.Len(t, history.Entries, 2)
+
+	history, err = q.SKUPriceHistory(f.Ctx, &types.QuerySKUPriceHistoryRequest{SkuUuid: testSKU2UUID, Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(t, err)
+	require.Len(t, history.Entries, 1)
+	require.Equal(t, sdkmath.NewInt(3600), history.Entries[0].BasePrice.Amount)
+
+	history, err = q.SKUPriceHistory(f.Ctx, &types.QuerySKUPriceHistoryRequest{SkuUuid: testSKU1UUID})
+	require.NoError(t, err)
+	require.Len(t, history.Entries, 1)
+
+	_, err = q.SKUPriceHistory(f.Ctx, &types.QuerySKUPriceHistoryRequest{SkuUuid: "01912345-6789-7abc-8def-999999999999"})
+	require.Error(t, err)
+
+	_, err = q.SKUPriceHistory(f.Ctx, &types.QuerySKUPriceHistoryRequest{})
+	require.Error(t, err)
+
+	_, err = q.SKUPriceHistory(f.Ctx, nil)
+	require.Error(t, err)
+
+	_, err = q.PendingPriceChanges(f.Ctx, nil)
+	require.Error(t, err)
+}
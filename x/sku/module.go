@@ -30,7 +30,7 @@ import (
 
 const (
 	// ConsensusVersion defines the current x/sku module consensus version.
-	ConsensusVersion = 1
+	ConsensusVersion = 2
 )
 
 var (
@@ -150,7 +150,8 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, marshaler codec.JSONCodec) js
 }
 
 // RegisterInvariants registers the module's invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
 }
 
 // QuerierRoute returns the module's query routing key.
@@ -162,6 +163,11 @@ func (am AppModule) QuerierRoute() string {
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(am.keeper))
+
+	migrator := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, migrator.Migrate1to2); err != nil {
+		panic(fmt.Errorf("failed to register %s migration v1→v2: %w", types.ModuleName, err))
+	}
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
@@ -169,6 +175,12 @@ func (AppModule) ConsensusVersion() uint64 {
 	return ConsensusVersion
 }
 
+// EndBlock contains the logic that is executed at the end of each block.
+// It applies scheduled SKU price changes that have become effective.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.keeper.EndBlocker(ctx)
+}
+
 // GenerateGenesisState creates a randomized GenState of the sku module.
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	simulation.RandomizedGenState(simState)
@@ -78,7 +78,7 @@ func generateRandomProvider(r *rand.Rand, accs []simtypes.Account, uuid string)
 func generateRandomSKU(r *rand.Rand, providerUUID string, uuid string) types.SKU {
 	name := skuNames[r.Intn(len(skuNames))]
 	unit := units[r.Intn(len(units))]
-	// Generate a valid price that is exactly divisible by the unit's seconds
+	// Generate a valid positive price
 	basePrice := generateValidPrice(r, unit)
 	active := r.Float32() > 0.2
 
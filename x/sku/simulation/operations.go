@@ -34,7 +34,7 @@ const (
 
 var (
 	skuNames = []string{"Compute Small", "Compute Medium", "Compute Large", "Storage 100GB", "Storage 1TB", "Bandwidth 1Gbps"}
-	units    = []types.Unit{types.Unit_UNIT_PER_HOUR, types.Unit_UNIT_PER_DAY}
+	units    = []types.Unit{types.Unit_UNIT_PER_MINUTE, types.Unit_UNIT_PER_HOUR, types.Unit_UNIT_PER_DAY, types.Unit_UNIT_PER_WEEK, types.Unit_UNIT_PER_MONTH}
 )
 
 // WeightedOperations returns the all the sku module operations with their respective weights.
@@ -262,10 +262,8 @@ func SimulateMsgCreateSKU(txGen client.TxConfig, k keeper.Keeper) simtypes.Opera
 		name := skuNames[r.Intn(len(skuNames))]
 		unit := units[r.Intn(len(units))]
 
-		// Generate a price that is EXACTLY divisible by the unit's seconds
-		// to pass the exact division validation.
-		// For UNIT_PER_HOUR: price must be a multiple of 3600
-		// For UNIT_PER_DAY: price must be a multiple of 86400
+		// Generate a positive price, usually not a multiple of the unit's
+		// seconds, so leases exercise fractional per-second rates.
 		basePrice := generateValidPrice(r, unit)
 
 		msg := &types.MsgCreateSKU{
@@ -308,8 +306,8 @@ func SimulateMsgUpdateSKU(txGen client.TxConfig, k keeper.Keeper) simtypes.Opera
 		name := skuNames[r.Intn(len(skuNames))]
 		unit := units[r.Intn(len(units))]
 
-		// Generate a price that is EXACTLY divisible by the unit's seconds
-		// to pass the exact division validation.
+		// Generate a positive price, usually not a multiple of the unit's
+		// seconds.
 		basePrice := generateValidPrice(r, unit)
 
 		// Determine active status based on current SKU state:
@@ -412,16 +410,23 @@ func generateRandomAPIURL(r *rand.Rand) string {
 	return "https://" + domains[r.Intn(len(domains))]
 }
 
-// generateValidPrice generates a price that is exactly divisible by the unit's seconds.
-// This ensures the per-second rate calculation has no remainder.
+// generateValidPrice generates a positive price of roughly 1 to 100 per second
+// that is usually not a multiple of the unit's seconds, giving a fractional
+// per-second rate.
 // Uses sdk.DefaultBondDenom ("stake") to match simulation account funding.
 func generateValidPrice(r *rand.Rand, unit types.Unit) sdk.Coin {
 	var secondsInUnit int64
 	switch unit {
+	case types.Unit_UNIT_PER_MINUTE:
+		secondsInUnit = types.SecondsPerMinute
 	case types.Unit_UNIT_PER_HOUR:
 		secondsInUnit = 3600
 	case types.Unit_UNIT_PER_DAY:
 		secondsInUnit = 86400
+	case types.Unit_UNIT_PER_WEEK:
+		secondsInUnit = types.SecondsPerWeek
+	case types.Unit_UNIT_PER_MONTH:
+		secondsInUnit = types.MinSecondsPerMonth
 	default:
 		secondsInUnit = 3600 // fallback to hourly
 	}
@@ -429,8 +434,8 @@ func generateValidPrice(r *rand.Rand, unit types.Unit) sdk.Coin {
 	// Generate a multiplier between 1 and 100 to create varied but valid prices
 	multiplier := int64(r.Intn(100) + 1)
 
-	// Price = multiplier * secondsInUnit, ensuring exact divisibility
-	price := multiplier * secondsInUnit
+	// Price = multiplier * secondsInUnit plus a remainder below one unit period
+	price := multiplier*secondsInUnit + r.Int63n(secondsInUnit)
 
 	// Use DefaultBondDenom to match simulation account funding
 	return sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(price))
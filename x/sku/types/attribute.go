@@ -0,0 +1,185 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"unicode"
+)
+
+// ValidateAttributeKey checks that key starts with a lowercase letter and
+// contains only lowercase letters, digits and underscores.
+func ValidateAttributeKey(key string) error {
+	if key == "" {
+		return fmt.Errorf("attribute key cannot be empty")
+	}
+	if len(key) > MaxAttributeKeyLength {
+		return fmt.Errorf("attribute key %q exceeds maximum length of %d", key, MaxAttributeKeyLength)
+	}
+	for i, r := range key {
+		switch {
+		case r >= 'a' && r <= 'z':
+		case i > 0 && (r >= '0' && r <= '9' || r == '_'):
+		default:
+			return fmt.Errorf("attribute key %q must match [a-z][a-z0-9_]*", key)
+		}
+	}
+	return nil
+}
+
+// ParseUintAttribute parses a uint attribute value, which must be in
+// canonical decimal form so that every number has exactly one spelling.
+func ParseUintAttribute(value string) (uint64, error) {
+	n, err := strconv.ParseUint(value, 10, 64)
+	if err != nil || strconv.FormatUint(n, 10) != value {
+		return 0, fmt.Errorf("%q is not an unsigned integer in canonical form", value)
+	}
+	return n, nil
+}
+
+// ValidateAttributes checks the shape of a provider's or SKU's attributes:
+// valid keys in strictly ascending order, and non-empty printable values.
+// Whether each key is declared, and the value fits its type, is checked
+// against the schema by CheckAttributes.
+func ValidateAttributes(attrs []Attribute) error {
+	if len(attrs) > MaxAttributes {
+		return fmt.Errorf("%d attributes exceed the maximum of %d", len(attrs), MaxAttributes)
+	}
+	for i, attr := range attrs {
+		if err := ValidateAttributeKey(attr.Key); err != nil {
+			return err
+		}
+		if i > 0 && attr.Key <= attrs[i-1].Key {
+			return fmt.Errorf("attribute keys must be unique and in ascending order; %q follows %q", attr.Key, attrs[i-1].Key)
+		}
+		if err := validateAttributeValue(attr.Value); err != nil {
+			return fmt.Errorf("attribute %q: %w", attr.Key, err)
+		}
+	}
+	return nil
+}
+
+func validateAttributeValue(value string) error {
+	if value == "" {
+		return fmt.Errorf("value cannot be empty")
+	}
+	if len(value) > MaxAttributeValueLength {
+		return fmt.Errorf("value exceeds maximum length of %d", MaxAttributeValueLength)
+	}
+	for _, r := range value {
+		if !unicode.IsPrint(r) {
+			return fmt.Errorf("value must be printable")
+		}
+	}
+	return nil
+}
+
+// AttributeSchema is Params.attribute_schema by key.
+type AttributeSchema map[string]AttributeType
+
+// Schema returns the attribute schema of the params by key.
+func (p Params) Schema() AttributeSchema {
+	schema := make(AttributeSchema, len(p.AttributeSchema))
+	for _, def := range p.AttributeSchema {
+		schema[def.Key] = def.ValueType
+	}
+	return schema
+}
+
+// CheckAttributes checks that every attribute key is declared in the schema
+// and that its value fits the declared type. The attributes must already
+// pass ValidateAttributes.
+func (s AttributeSchema) CheckAttributes(attrs []Attribute) error {
+	for _, attr := range attrs {
+		valueType, ok := s[attr.Key]
+		if !ok {
+			return ErrInvalidAttribute.Wrapf("attribute key %q is not in the attribute schema", attr.Key)
+		}
+		if valueType == AttributeType_ATTRIBUTE_TYPE_UINT {
+			if _, err := ParseUintAttribute(attr.Value); err != nil {
+				return ErrInvalidAttribute.Wrapf("attribute %q: %s", attr.Key, err)
+			}
+		}
+	}
+	return nil
+}
+
+// GetAttribute returns the value of the attribute key, and whether it is set.
+func GetAttribute(attrs []Attribute, key string) (string, bool) {
+	for _, attr := range attrs {
+		if attr.Key == key {
+			return attr.Value, true
+		}
+	}
+	return "", false
+}
+
+// Validate checks the shape of a filter: a declared-looking key and either an
+// exact value or at least one range bound, which must be canonical unsigned
+// integers with min not above max.
+func (f AttributeFilter) Validate() error {
+	if err := ValidateAttributeKey(f.Key); err != nil {
+		return err
+	}
+	ranged := f.Min != "" || f.Max != ""
+	switch {
+	case f.Value != "" && ranged:
+		return fmt.Errorf("filter on %q sets both value and a range", f.Key)
+	case f.Value == "" && !ranged:
+		return fmt.Errorf("filter on %q sets neither value nor a range", f.Key)
+	case f.Value != "":
+		return validateAttributeValue(f.Value)
+	}
+
+	var lo, hi uint64 = 0, ^uint64(0)
+	var err error
+	if f.Min != "" {
+		if lo, err = ParseUintAttribute(f.Min); err != nil {
+			return fmt.Errorf("filter on %q min: %w", f.Key, err)
+		}
+	}
+	if f.Max != "" {
+		if hi, err = ParseUintAttribute(f.Max); err != nil {
+			return fmt.Errorf("filter on %q max: %w", f.Key, err)
+		}
+	}
+	if lo > hi {
+		return fmt.Errorf("filter on %q has min %d above max %d", f.Key, lo, hi)
+	}
+	return nil
+}
+
+// IsRange reports whether the filter matches a range rather than a value.
+func (f AttributeFilter) IsRange() bool {
+	return f.Value == ""
+}
+
+// Bounds returns the inclusive range of a range filter, with unset bounds
+// open. The filter must pass Validate.
+func (f AttributeFilter) Bounds() (lo, hi uint64) {
+	lo, hi = 0, ^uint64(0)
+	if f.Min != "" {
+		lo, _ = ParseUintAttribute(f.Min)
+	}
+	if f.Max != "" {
+		hi, _ = ParseUintAttribute(f.Max)
+	}
+	return lo, hi
+}
+
+// Matches reports whether the attributes satisfy the filter. A range filter
+// only matches canonical unsigned integer values.
+func (f AttributeFilter) Matches(attrs []Attribute) bool {
+	value, ok := GetAttribute(attrs, f.Key)
+	if !ok {
+		return false
+	}
+	if !f.IsRange() {
+		return value == f.Value
+	}
+	n, err := ParseUintAttribute(value)
+	if err != nil {
+		return false
+	}
+	lo, hi := f.Bounds()
+	return n >= lo && n <= hi
+}
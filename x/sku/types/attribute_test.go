@@ -0,0 +1,94 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAttributes(t *testing.T) {
+	tests := []struct {
+		name   string
+		attrs  []Attribute
+		errMsg string
+	}{
+		{name: "valid: none"},
+		{name: "valid: sorted", attrs: []Attribute{{Key: "gpu_model", Value: "h100"}, {Key: "vcpus", Value: "8"}}},
+		{name: "invalid: empty key", attrs: []Attribute{{Key: "", Value: "x"}}, errMsg: "cannot be empty"},
+		{name: "invalid: uppercase key", attrs: []Attribute{{Key: "Region", Value: "eu"}}, errMsg: "must match"},
+		{name: "invalid: leading digit", attrs: []Attribute{{Key: "1gpu", Value: "x"}}, errMsg: "must match"},
+		{name: "invalid: key too long", attrs: []Attribute{{Key: strings.Repeat("a", MaxAttributeKeyLength+1), Value: "x"}}, errMsg: "maximum length"},
+		{name: "invalid: unsorted", attrs: []Attribute{{Key: "vcpus", Value: "8"}, {Key: "gpu_model", Value: "h100"}}, errMsg: "ascending"},
+		{name: "invalid: duplicate", attrs: []Attribute{{Key: "vcpus", Value: "8"}, {Key: "vcpus", Value: "16"}}, errMsg: "ascending"},
+		{name: "invalid: empty value", attrs: []Attribute{{Key: "region", Value: ""}}, errMsg: "cannot be empty"},
+		{name: "invalid: value too long", attrs: []Attribute{{Key: "region", Value: strings.Repeat("x", MaxAttributeValueLength+1)}}, errMsg: "maximum length"},
+		{name: "invalid: control character", attrs: []Attribute{{Key: "region", Value: "eu\n"}}, errMsg: "printable"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateAttributes(tc.attrs)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.errMsg)
+		})
+	}
+
+	tooMany := make([]Attribute, MaxAttributes+1)
+	for i := range tooMany {
+		tooMany[i] = Attribute{Key: "k" + strings.Repeat("a", i), Value: "x"}
+	}
+	require.ErrorContains(t, ValidateAttributes(tooMany), "maximum")
+}
+
+func TestAttributeSchema_CheckAttributes(t *testing.T) {
+	schema := Params{AttributeSchema: []AttributeDefinition{
+		{Key: "region", ValueType: AttributeType_ATTRIBUTE_TYPE_STRING},
+		{Key: "vcpus", ValueType: AttributeType_ATTRIBUTE_TYPE_UINT},
+	}}.Schema()
+
+	require.NoError(t, schema.CheckAttributes([]Attribute{{Key: "region", Value: "eu-west"}, {Key: "vcpus", Value: "16"}}))
+	require.ErrorIs(t, schema.CheckAttributes([]Attribute{{Key: "gpu_model", Value: "h100"}}), ErrInvalidAttribute)
+	for _, value := range []string{"sixteen", "016", "-1", "+1", "18446744073709551616"} {
+		require.ErrorIs(t, schema.CheckAttributes([]Attribute{{Key: "vcpus", Value: value}}), ErrInvalidAttribute, value)
+	}
+}
+
+func TestAttributeFilter(t *testing.T) {
+	attrs := []Attribute{{Key: "region", Value: "eu"}, {Key: "vcpus", Value: "16"}}
+
+	tests := []struct {
+		name    string
+		filter  AttributeFilter
+		errMsg  string
+		matches bool
+	}{
+		{name: "value match", filter: AttributeFilter{Key: "region", Value: "eu"}, matches: true},
+		{name: "value mismatch", filter: AttributeFilter{Key: "region", Value: "us"}},
+		{name: "missing key", filter: AttributeFilter{Key: "gpu_model", Value: "h100"}},
+		{name: "closed range", filter: AttributeFilter{Key: "vcpus", Min: "8", Max: "16"}, matches: true},
+		{name: "min only", filter: AttributeFilter{Key: "vcpus", Min: "17"}},
+		{name: "max only", filter: AttributeFilter{Key: "vcpus", Max: "16"}, matches: true},
+		{name: "range on string value", filter: AttributeFilter{Key: "region", Min: "0"}},
+		{name: "invalid: value and range", filter: AttributeFilter{Key: "vcpus", Value: "16", Min: "8"}, errMsg: "both value and a range"},
+		{name: "invalid: empty", filter: AttributeFilter{Key: "vcpus"}, errMsg: "neither value nor a range"},
+		{name: "invalid: non-canonical bound", filter: AttributeFilter{Key: "vcpus", Min: "08"}, errMsg: "canonical"},
+		{name: "invalid: min above max", filter: AttributeFilter{Key: "vcpus", Min: "32", Max: "8"}, errMsg: "above max"},
+		{name: "invalid: key", filter: AttributeFilter{Key: "VCPUs", Value: "8"}, errMsg: "must match"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.filter.Validate()
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.matches, tc.filter.Matches(attrs))
+		})
+	}
+}
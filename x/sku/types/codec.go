@@ -17,6 +17,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateSKU{}, "lifted/sku/MsgUpdateSKU")
 	legacy.RegisterAminoMsg(cdc, &MsgDeactivateSKU{}, "lifted/sku/MsgDeactivateSKU")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "lifted/sku/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgScheduleSKUPriceChange{}, "lifted/sku/MsgScheduleSKUPriceChange")
 }
 
 // RegisterInterfaces registers the module's interface types.
@@ -30,6 +31,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgUpdateSKU{},
 		&MsgDeactivateSKU{},
 		&MsgUpdateParams{},
+		&MsgScheduleSKUPriceChange{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
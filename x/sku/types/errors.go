@@ -10,6 +10,7 @@ var (
 	ErrInvalidProvider  = errors.Register(ModuleName, 5, "invalid provider")
 	ErrProviderNotFound = errors.Register(ModuleName, 6, "provider not found")
 	ErrInvalidAPIURL    = errors.Register(ModuleName, 7, "invalid API URL")
+	ErrInvalidAttribute = errors.Register(ModuleName, 8, "invalid attribute")
 )
 
 // Validation constants for provider and SKU fields
@@ -24,6 +25,26 @@ const (
 	// Set to 64 to accommodate SHA-512 and similar hash algorithms.
 	MaxMetaHashLength = 64
 
+	// MaxPriceTiers is the maximum number of price tiers on a SKU.
+	MaxPriceTiers = 16
+
+	// MaxAttributes is the maximum number of attributes on a provider or SKU.
+	MaxAttributes = 32
+
+	// MaxAttributeKeyLength is the maximum length of an attribute key.
+	MaxAttributeKeyLength = 32
+
+	// MaxAttributeValueLength is the maximum length of an attribute value.
+	MaxAttributeValueLength = 128
+
+	// MaxAttributeDefinitions is the maximum number of keys in the attribute
+	// schema.
+	MaxAttributeDefinitions = 64
+
+	// MaxSearchFilters is the maximum number of filters of each kind in a
+	// SearchSKUs query.
+	MaxSearchFilters = 8
+
 	// DefaultDeactivateSKULimit is the default number of SKUs to deactivate
 	// per DeactivateProvider call when limit is not specified (0).
 	DefaultDeactivateSKULimit uint64 = 50
@@ -31,4 +52,9 @@ const (
 	// MaxDeactivateSKULimit is the maximum number of SKUs that can be
 	// deactivated in a single DeactivateProvider call.
 	MaxDeactivateSKULimit uint64 = 100
+
+	// MaxPriceChangesPerBlock is the maximum number of due price changes the
+	// EndBlocker applies in a single block. Changes past the limit are
+	// applied in later blocks.
+	MaxPriceChangesPerBlock = 100
 )
@@ -14,6 +14,9 @@ func DefaultGenesis() *GenesisState {
 		Params:    DefaultParams(),
 		Providers: []Provider{},
 		Skus:      []SKU{},
+
+		PriceHistory:        []PriceHistoryEntry{},
+		PendingPriceChanges: []PendingPriceChange{},
 	}
 }
 
@@ -66,10 +69,17 @@ func (gs *GenesisState) Validate() error {
 				return ErrInvalidProvider.Wrapf("provider %s has invalid api_url: %s", provider.Uuid, err)
 			}
 		}
+
+		// Attributes are not checked against the schema: keys removed from
+		// it stay on the entries that already had them.
+		if err := ValidateAttributes(provider.Attributes); err != nil {
+			return ErrInvalidProvider.Wrapf("provider %s has invalid attributes: %s", provider.Uuid, err)
+		}
 	}
 
 	// Validate SKUs
 	seenSKUUUIDs := make(map[string]bool)
+	skuUnits := make(map[string]Unit, len(gs.Skus))
 	for _, sku := range gs.Skus {
 		if err := pkguuid.ValidateUUIDv7(sku.Uuid); err != nil {
 			return ErrInvalidSKU.Wrapf("invalid sku uuid %s: %s", sku.Uuid, err)
@@ -79,6 +89,7 @@ func (gs *GenesisState) Validate() error {
 			return ErrInvalidSKU.Wrapf("duplicate sku uuid: %s", sku.Uuid)
 		}
 		seenSKUUUIDs[sku.Uuid] = true
+		skuUnits[sku.Uuid] = sku.Unit
 
 		if err := pkguuid.ValidateUUIDv7(sku.ProviderUuid); err != nil {
 			return ErrInvalidSKU.Wrapf("sku %s has invalid provider_uuid: %s", sku.Uuid, err)
@@ -109,6 +120,14 @@ func (gs *GenesisState) Validate() error {
 		if err := ValidatePriceAndUnit(sku.BasePrice, sku.Unit); err != nil {
 			return ErrInvalidSKU.Wrapf("sku %s has invalid price/unit combination: %s", sku.Uuid, err)
 		}
+
+		if err := ValidatePriceTiers(sku.BasePrice, sku.Unit, sku.PriceTiers); err != nil {
+			return ErrInvalidSKU.Wrapf("sku %s has invalid price tiers: %s", sku.Uuid, err)
+		}
+
+		if err := ValidateAttributes(sku.Attributes); err != nil {
+			return ErrInvalidSKU.Wrapf("sku %s has invalid attributes: %s", sku.Uuid, err)
+		}
 	}
 
 	// Validate sequences: must be >= number of entities to prevent UUID
@@ -127,5 +146,66 @@ func (gs *GenesisState) Validate() error {
 		)
 	}
 
+	if err := gs.validatePriceHistory(skuUnits); err != nil {
+		return err
+	}
+
+	return gs.validatePendingPriceChanges(skuUnits)
+}
+
+// validatePriceHistory checks that every price history entry belongs to a SKU
+// in the genesis, has a valid base price and a unique id below
+// price_history_sequence. Tiers are not checked against the SKU's unit,
+// which may have changed since the entry was written.
+func (gs *GenesisState) validatePriceHistory(skuUnits map[string]Unit) error {
+	seen := make(map[uint64]bool, len(gs.PriceHistory))
+	for _, entry := range gs.PriceHistory {
+		if seen[entry.Id] {
+			return ErrInvalidSKU.Wrapf("duplicate price history id %d", entry.Id)
+		}
+		seen[entry.Id] = true
+
+		if entry.Id >= gs.PriceHistorySequence {
+			return ErrInvalidSKU.Wrapf("price history id %d is not below price_history_sequence %d", entry.Id, gs.PriceHistorySequence)
+		}
+		if _, ok := skuUnits[entry.SkuUuid]; !ok {
+			return ErrInvalidSKU.Wrapf("price history %d references non-existent sku %s", entry.Id, entry.SkuUuid)
+		}
+		if !entry.BasePrice.IsValid() || entry.BasePrice.IsZero() {
+			return ErrInvalidSKU.Wrapf("price history %d has invalid or zero base price", entry.Id)
+		}
+		if entry.ChangedAt.IsZero() {
+			return ErrInvalidSKU.Wrapf("price history %d has no changed_at", entry.Id)
+		}
+	}
+	return nil
+}
+
+// validatePendingPriceChanges checks that each pending price change belongs
+// to a SKU in the genesis, that no SKU has two, and that the new price is
+// valid for the SKU's unit. A change already due is allowed: the EndBlocker
+// applies it in the first block.
+func (gs *GenesisState) validatePendingPriceChanges(skuUnits map[string]Unit) error {
+	seen := make(map[string]bool, len(gs.PendingPriceChanges))
+	for _, change := range gs.PendingPriceChanges {
+		unit, ok := skuUnits[change.SkuUuid]
+		if !ok {
+			return ErrInvalidSKU.Wrapf("pending price change references non-existent sku %s", change.SkuUuid)
+		}
+		if seen[change.SkuUuid] {
+			return ErrInvalidSKU.Wrapf("duplicate pending price change for sku %s", change.SkuUuid)
+		}
+		seen[change.SkuUuid] = true
+
+		if err := ValidatePrice(change.BasePrice, unit, change.PriceTiers); err != nil {
+			return ErrInvalidSKU.Wrapf("pending price change for sku %s: %s", change.SkuUuid, err)
+		}
+		if change.EffectiveAt.IsZero() {
+			return ErrInvalidSKU.Wrapf("pending price change for sku %s has no effective_at", change.SkuUuid)
+		}
+		if _, err := sdk.AccAddressFromBech32(change.ScheduledBy); err != nil {
+			return ErrInvalidSKU.Wrapf("pending price change for sku %s has invalid scheduled_by: %s", change.SkuUuid, err)
+		}
+	}
 	return nil
 }
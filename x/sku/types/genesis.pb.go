@@ -37,6 +37,12 @@ type GenesisState struct {
 	// sku_sequence is the sequence counter for deterministic SKU UUID generation.
 	// Exported via Sequence.Peek(); the next Sequence.Next() call will return this value.
 	SkuSequence uint64 `protobuf:"varint,5,opt,name=sku_sequence,json=skuSequence,proto3" json:"sku_sequence,omitempty"`
+	// price_history is the price history of the SKUs.
+	PriceHistory []PriceHistoryEntry `protobuf:"bytes,6,rep,name=price_history,json=priceHistory,proto3" json:"price_history"`
+	// price_history_sequence is the id the next PriceHistoryEntry will get.
+	PriceHistorySequence uint64 `protobuf:"varint,7,opt,name=price_history_sequence,json=priceHistorySequence,proto3" json:"price_history_sequence,omitempty"`
+	// pending_price_changes are the scheduled SKU price changes.
+	PendingPriceChanges []PendingPriceChange `protobuf:"bytes,8,rep,name=pending_price_changes,json=pendingPriceChanges,proto3" json:"pending_price_changes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -107,6 +113,27 @@ func (m *GenesisState) GetSkuSequence() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetPriceHistory() []PriceHistoryEntry {
+	if m != nil {
+		return m.PriceHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPriceHistorySequence() uint64 {
+	if m != nil {
+		return m.PriceHistorySequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPendingPriceChanges() []PendingPriceChange {
+	if m != nil {
+		return m.PendingPriceChanges
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "liftedinit.sku.v1.GenesisState")
 }
@@ -114,27 +141,32 @@ func init() {
 func init() { proto.RegisterFile("liftedinit/sku/v1/genesis.proto", fileDescriptor_6c794ae458716d56) }
 
 var fileDescriptor_6c794ae458716d56 = []byte{
-	// 309 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x31, 0x4f, 0xc2, 0x40,
-	0x18, 0x86, 0x5b, 0xa8, 0x24, 0x1e, 0x0c, 0xd2, 0x18, 0x53, 0x31, 0x16, 0x74, 0x22, 0x31, 0xf6,
-	0x04, 0x07, 0x46, 0x13, 0x16, 0x07, 0x17, 0x85, 0xb8, 0xb8, 0x98, 0x02, 0x1f, 0xf5, 0x72, 0xd0,
-	0xab, 0xf7, 0xdd, 0xa1, 0xfe, 0x05, 0x27, 0x7f, 0x16, 0x23, 0xa3, 0x93, 0x31, 0xf0, 0x47, 0x8c,
-	0xc7, 0x91, 0x0e, 0xb0, 0x5d, 0xbe, 0xf7, 0x79, 0xde, 0x4b, 0x5e, 0x52, 0x9f, 0xb0, 0xb1, 0x82,
-	0x11, 0x4b, 0x99, 0xa2, 0xc8, 0x35, 0x9d, 0xb5, 0x68, 0x02, 0x29, 0x20, 0xc3, 0x28, 0x93, 0x42,
-	0x09, 0xbf, 0x9a, 0x03, 0x11, 0x72, 0x1d, 0xcd, 0x5a, 0xb5, 0xc3, 0x44, 0x24, 0xc2, 0xa4, 0xf4,
-	0xff, 0xb5, 0x06, 0x6b, 0xa7, 0xdb, 0x4d, 0xea, 0x23, 0x03, 0xdb, 0x73, 0xfe, 0x59, 0x20, 0x95,
-	0xdb, 0x75, 0x73, 0x5f, 0xc5, 0x0a, 0xfc, 0x0e, 0x29, 0x65, 0xb1, 0x8c, 0xa7, 0x18, 0xb8, 0x0d,
-	0xb7, 0x59, 0x6e, 0x1f, 0x47, 0x5b, 0x3f, 0x45, 0xf7, 0x06, 0xe8, 0x7a, 0xf3, 0x9f, 0xba, 0xd3,
-	0xb3, 0xb8, 0x7f, 0x43, 0xf6, 0x33, 0x29, 0x66, 0x6c, 0x04, 0x12, 0x83, 0x42, 0xa3, 0xd8, 0x2c,
-	0xb7, 0x4f, 0x76, 0xb9, 0x96, 0xb1, 0x76, 0xee, 0xf8, 0x57, 0xc4, 0x43, 0xae, 0x31, 0x28, 0x1a,
-	0xf7, 0x68, 0x87, 0xdb, 0xbf, 0x7b, 0xb4, 0x9a, 0x21, 0xfd, 0x0b, 0x52, 0xdd, 0xe8, 0xcf, 0x08,
-	0xaf, 0x1a, 0xd2, 0x21, 0x04, 0x5e, 0xc3, 0x6d, 0x7a, 0xbd, 0x83, 0x4d, 0xd0, 0xb7, 0x77, 0xff,
-	0x8c, 0x54, 0x90, 0xeb, 0x9c, 0xdb, 0x33, 0x5c, 0x19, 0xb9, 0xde, 0x20, 0xdd, 0x87, 0xf9, 0x32,
-	0x74, 0x17, 0xcb, 0xd0, 0xfd, 0x5d, 0x86, 0xee, 0xd7, 0x2a, 0x74, 0x16, 0xab, 0xd0, 0xf9, 0x5e,
-	0x85, 0xce, 0x53, 0x27, 0x61, 0xea, 0x45, 0x0f, 0xa2, 0xa1, 0x98, 0xd2, 0x69, 0x9c, 0xb2, 0x31,
-	0xa0, 0xba, 0x4c, 0x41, 0xbd, 0x09, 0xc9, 0xf3, 0xc3, 0x04, 0x46, 0x09, 0x48, 0xfa, 0x6e, 0x86,
-	0x36, 0x2b, 0x0f, 0x4a, 0x66, 0xe6, 0xeb, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1f, 0xca, 0x82,
-	0x54, 0xd1, 0x01, 0x00, 0x00,
+	// 397 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0xae, 0xd2, 0x40,
+	0x14, 0x86, 0x5b, 0xa9, 0xa8, 0x03, 0x26, 0x52, 0x91, 0x54, 0x8c, 0x05, 0x8d, 0x26, 0x24, 0xc6,
+	0x56, 0xd0, 0x84, 0xa5, 0x09, 0xc6, 0x68, 0xe2, 0x42, 0x85, 0xb8, 0x71, 0xd3, 0x94, 0xf6, 0x50,
+	0x26, 0x85, 0x99, 0x71, 0x66, 0x8a, 0xf2, 0x16, 0x3e, 0x16, 0x4b, 0x96, 0xae, 0xcc, 0x0d, 0xbc,
+	0xc1, 0x7d, 0x82, 0x9b, 0x3b, 0x1d, 0x28, 0x37, 0x74, 0xd7, 0xcc, 0xf9, 0xbe, 0xff, 0xfc, 0x4d,
+	0x0e, 0xea, 0x2c, 0xf0, 0x4c, 0x42, 0x8c, 0x09, 0x96, 0xbe, 0x48, 0x33, 0x7f, 0xd5, 0xf7, 0x13,
+	0x20, 0x20, 0xb0, 0xf0, 0x18, 0xa7, 0x92, 0xda, 0x8d, 0x02, 0xf0, 0x44, 0x9a, 0x79, 0xab, 0x7e,
+	0xbb, 0x99, 0xd0, 0x84, 0xaa, 0xa9, 0x7f, 0xfd, 0x95, 0x83, 0xed, 0xa7, 0xe7, 0x49, 0x72, 0xcd,
+	0x40, 0xe7, 0x3c, 0xbf, 0xac, 0xa0, 0xfa, 0xa7, 0x3c, 0x79, 0x22, 0x43, 0x09, 0xf6, 0x10, 0x55,
+	0x59, 0xc8, 0xc3, 0xa5, 0x70, 0xcc, 0xae, 0xd9, 0xab, 0x0d, 0x1e, 0x7b, 0x67, 0x9b, 0xbc, 0x6f,
+	0x0a, 0x18, 0x59, 0x9b, 0xff, 0x1d, 0x63, 0xac, 0x71, 0xfb, 0x3d, 0xba, 0xc7, 0x38, 0x5d, 0xe1,
+	0x18, 0xb8, 0x70, 0x6e, 0x75, 0x2b, 0xbd, 0xda, 0xe0, 0x49, 0x99, 0xab, 0x19, 0x6d, 0x17, 0x8e,
+	0xfd, 0x06, 0x59, 0x22, 0xcd, 0x84, 0x53, 0x51, 0x6e, 0xab, 0xc4, 0x9d, 0x7c, 0xf9, 0xa1, 0x35,
+	0x45, 0xda, 0xaf, 0x50, 0xe3, 0xa0, 0x07, 0x02, 0x7e, 0x65, 0x40, 0x22, 0x70, 0xac, 0xae, 0xd9,
+	0xb3, 0xc6, 0x0f, 0x0e, 0x83, 0x89, 0x7e, 0xb7, 0x9f, 0xa1, 0xba, 0x48, 0xb3, 0x82, 0xbb, 0xad,
+	0xb8, 0x9a, 0x48, 0xb3, 0x23, 0xf2, 0x15, 0xdd, 0x67, 0x1c, 0x47, 0x10, 0xcc, 0xb1, 0x90, 0x94,
+	0xaf, 0x9d, 0xaa, 0xaa, 0xf2, 0xa2, 0xf4, 0x37, 0x70, 0x04, 0x9f, 0x73, 0xec, 0x23, 0x91, 0x7c,
+	0xad, 0x8b, 0xd5, 0xd9, 0xc9, 0xc0, 0x7e, 0x87, 0x5a, 0x37, 0x02, 0x8b, 0xed, 0x77, 0xd4, 0xf6,
+	0xe6, 0x29, 0x7d, 0xac, 0x11, 0xa0, 0x47, 0x0c, 0x48, 0x8c, 0x49, 0x12, 0xe4, 0x76, 0x34, 0x0f,
+	0x49, 0x02, 0xc2, 0xb9, 0xab, 0xea, 0xbc, 0x2c, 0xab, 0x93, 0xf3, 0xaa, 0xd5, 0x07, 0x45, 0xeb,
+	0x3e, 0x0f, 0xd9, 0xd9, 0x44, 0x8c, 0xbe, 0x6f, 0x76, 0xae, 0xb9, 0xdd, 0xb9, 0xe6, 0xc5, 0xce,
+	0x35, 0xff, 0xee, 0x5d, 0x63, 0xbb, 0x77, 0x8d, 0x7f, 0x7b, 0xd7, 0xf8, 0x39, 0x4c, 0xb0, 0x9c,
+	0x67, 0x53, 0x2f, 0xa2, 0x4b, 0x7f, 0x19, 0x12, 0x3c, 0x03, 0x21, 0x5f, 0x13, 0x90, 0xbf, 0x29,
+	0x4f, 0x8b, 0x87, 0x05, 0xc4, 0x09, 0x70, 0xff, 0x8f, 0x3a, 0x28, 0x75, 0x4d, 0xd3, 0xaa, 0x3a,
+	0xa7, 0xb7, 0x57, 0x03, 0x00, 0x9d, 0x3b, 0xbd, 0xf6, 0xb9, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -157,6 +189,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingPriceChanges) > 0 {
+		for iNdEx := len(m.PendingPriceChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingPriceChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.PriceHistorySequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PriceHistorySequence))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.PriceHistory) > 0 {
+		for iNdEx := len(m.PriceHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.SkuSequence != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.SkuSequence))
 		i--
@@ -245,6 +310,21 @@ func (m *GenesisState) Size() (n int) {
 	if m.SkuSequence != 0 {
 		n += 1 + sovGenesis(uint64(m.SkuSequence))
 	}
+	if len(m.PriceHistory) > 0 {
+		for _, e := range m.PriceHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PriceHistorySequence != 0 {
+		n += 1 + sovGenesis(uint64(m.PriceHistorySequence))
+	}
+	if len(m.PendingPriceChanges) > 0 {
+		for _, e := range m.PendingPriceChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -422,6 +502,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceHistory = append(m.PriceHistory, PriceHistoryEntry{})
+			if err := m.PriceHistory[len(m.PriceHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistorySequence", wireType)
+			}
+			m.PriceHistorySequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceHistorySequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPriceChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingPriceChanges = append(m.PendingPriceChanges, PendingPriceChange{})
+			if err := m.PendingPriceChanges[len(m.PendingPriceChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -32,6 +33,21 @@ func TestGenesisState_Validate(t *testing.T) {
 		Active:       true,
 	}
 
+	changedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	validHistory := PriceHistoryEntry{
+		Id:        0,
+		SkuUuid:   validSKU.Uuid,
+		BasePrice: validSKU.BasePrice,
+		ChangedAt: changedAt,
+	}
+	validPending := PendingPriceChange{
+		SkuUuid:     validSKU.Uuid,
+		BasePrice:   sdk.NewCoin("umfx", math.NewInt(7200)),
+		EffectiveAt: changedAt.Add(24 * time.Hour),
+		ScheduledAt: changedAt,
+		ScheduledBy: providerAddr,
+	}
+
 	tests := []struct {
 		name      string
 		genesis   *GenesisState
@@ -58,6 +74,101 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			expectErr: false,
 		},
+		{
+			name: "valid: with price history and pending price change",
+			genesis: &GenesisState{
+				Params:               DefaultParams(),
+				Providers:            []Provider{validProvider},
+				Skus:                 []SKU{validSKU},
+				ProviderSequence:     1,
+				SkuSequence:          1,
+				PriceHistory:         []PriceHistoryEntry{validHistory},
+				PriceHistorySequence: 1,
+				PendingPriceChanges:  []PendingPriceChange{validPending},
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid: price history id not below sequence",
+			genesis: &GenesisState{
+				Params:           DefaultParams(),
+				Providers:        []Provider{validProvider},
+				Skus:             []SKU{validSKU},
+				ProviderSequence: 1,
+				SkuSequence:      1,
+				PriceHistory:     []PriceHistoryEntry{validHistory},
+			},
+			expectErr: true,
+			errMsg:    "is not below price_history_sequence",
+		},
+		{
+			name: "invalid: duplicate pending price change",
+			genesis: &GenesisState{
+				Params:              DefaultParams(),
+				Providers:           []Provider{validProvider},
+				Skus:                []SKU{validSKU},
+				ProviderSequence:    1,
+				SkuSequence:         1,
+				PendingPriceChanges: []PendingPriceChange{validPending, validPending},
+			},
+			expectErr: true,
+			errMsg:    "duplicate pending price change",
+		},
+		{
+			name: "invalid: pending price change for non-existent SKU",
+			genesis: &GenesisState{
+				Params:              DefaultParams(),
+				Providers:           []Provider{validProvider},
+				ProviderSequence:    1,
+				PendingPriceChanges: []PendingPriceChange{validPending},
+			},
+			expectErr: true,
+			errMsg:    "references non-existent sku",
+		},
+		{
+			name: "invalid: SKU price tier in another denom",
+			genesis: &GenesisState{
+				Params:    DefaultParams(),
+				Providers: []Provider{validProvider},
+				Skus: []SKU{
+					{
+						Uuid:         "01912345-6789-7abc-8def-0123456789ac",
+						ProviderUuid: validProvider.Uuid,
+						Name:         "Test SKU",
+						Unit:         Unit_UNIT_PER_HOUR,
+						BasePrice:    sdk.NewCoin("umfx", math.NewInt(3600)),
+						Active:       true,
+						PriceTiers:   []PriceTier{{MinQuantity: 2, Price: sdk.NewCoin("upwr", math.NewInt(3600))}},
+					},
+				},
+				ProviderSequence: 1,
+				SkuSequence:      1,
+			},
+			expectErr: true,
+			errMsg:    "invalid price tiers",
+		},
+		{
+			name: "invalid: SKU attributes out of order",
+			genesis: &GenesisState{
+				Params:    DefaultParams(),
+				Providers: []Provider{validProvider},
+				Skus: []SKU{
+					{
+						Uuid:         "01912345-6789-7abc-8def-0123456789ac",
+						ProviderUuid: validProvider.Uuid,
+						Name:         "Test SKU",
+						Unit:         Unit_UNIT_PER_HOUR,
+						BasePrice:    sdk.NewCoin("umfx", math.NewInt(3600)),
+						Active:       true,
+						Attributes:   []Attribute{{Key: "vcpus", Value: "8"}, {Key: "region", Value: "eu"}},
+					},
+				},
+				ProviderSequence: 1,
+				SkuSequence:      1,
+			},
+			expectErr: true,
+			errMsg:    "invalid attributes",
+		},
 		{
 			name: "invalid: SKU name exceeds max length",
 			genesis: &GenesisState{
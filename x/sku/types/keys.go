@@ -36,6 +36,28 @@ var (
 	// SKUByProviderActiveIndexKey saves the compound (provider, active) index for SKUs.
 	// This enables efficient queries for active SKUs filtered by provider.
 	SKUByProviderActiveIndexKey = collections.NewPrefix(9)
+
+	// SKUByAttributeIndexKey saves the (key, value) attribute index for SKUs.
+	// This enables SearchSKUs to look up SKUs by attribute value or range.
+	SKUByAttributeIndexKey = collections.NewPrefix(10)
+
+	// ProviderByAttributeIndexKey saves the (key, value) attribute index for
+	// Providers.
+	ProviderByAttributeIndexKey = collections.NewPrefix(11)
+
+	// PriceHistoryKey saves the price history of SKUs, keyed by
+	// (sku_uuid, entry id).
+	PriceHistoryKey = collections.NewPrefix(12)
+
+	// PriceHistorySequenceKey saves the next price history entry id.
+	PriceHistorySequenceKey = collections.NewPrefix(13)
+
+	// PendingPriceChangeKey saves the scheduled price changes by SKU UUID.
+	PendingPriceChangeKey = collections.NewPrefix(14)
+
+	// PendingPriceChangeByTimeIndexKey saves the effective_at index for
+	// pending price changes, walked in time order by the EndBlocker.
+	PendingPriceChangeByTimeIndexKey = collections.NewPrefix(15)
 )
 
 const (
@@ -48,15 +70,17 @@ const (
 
 // Event types for the sku module.
 const (
-	EventTypeProviderCreated     = "provider_created"
-	EventTypeProviderUpdated     = "provider_updated"
-	EventTypeProviderActivated   = "provider_activated"
-	EventTypeProviderDeactivated = "provider_deactivated"
-	EventTypeSKUCreated          = "sku_created"
-	EventTypeSKUUpdated          = "sku_updated"
-	EventTypeSKUActivated        = "sku_activated"
-	EventTypeSKUDeactivated      = "sku_deactivated"
-	EventTypeParamsUpdated       = "params_updated"
+	EventTypeProviderCreated      = "provider_created"
+	EventTypeProviderUpdated      = "provider_updated"
+	EventTypeProviderActivated    = "provider_activated"
+	EventTypeProviderDeactivated  = "provider_deactivated"
+	EventTypeSKUCreated           = "sku_created"
+	EventTypeSKUUpdated           = "sku_updated"
+	EventTypeSKUActivated         = "sku_activated"
+	EventTypeSKUDeactivated       = "sku_deactivated"
+	EventTypeParamsUpdated        = "params_updated"
+	EventTypePriceChangeScheduled = "sku_price_change_scheduled"
+	EventTypePriceChanged         = "sku_price_changed"
 
 	AttributeKeyProviderUUID  = "provider_uuid"
 	AttributeKeySKUUUID       = "sku_uuid"
@@ -68,4 +92,8 @@ const (
 	AttributeKeyUnit          = "unit"
 	AttributeKeyCreatedBy     = "created_by"
 	AttributeKeyDeactivatedBy = "deactivated_by"
+	AttributeKeyPreviousPrice = "previous_price"
+	AttributeKeyEffectiveAt   = "effective_at"
+	AttributeKeyScheduledBy   = "scheduled_by"
+	AttributeKeyReplaced      = "replaced"
 )
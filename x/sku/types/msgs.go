@@ -2,6 +2,7 @@ package types
 
 import (
 	"net/url"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -16,6 +17,7 @@ var (
 	_ sdk.Msg = &MsgUpdateSKU{}
 	_ sdk.Msg = &MsgDeactivateSKU{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgScheduleSKUPriceChange{}
 )
 
 // NewMsgCreateProvider creates a new MsgCreateProvider instance.
@@ -25,6 +27,7 @@ func NewMsgCreateProvider(
 	payoutAddress string,
 	metaHash []byte,
 	apiURL string,
+	attributes []Attribute,
 ) *MsgCreateProvider {
 	return &MsgCreateProvider{
 		Authority:     authority,
@@ -32,6 +35,7 @@ func NewMsgCreateProvider(
 		PayoutAddress: payoutAddress,
 		MetaHash:      metaHash,
 		ApiUrl:        apiURL,
+		Attributes:    attributes,
 	}
 }
 
@@ -61,6 +65,10 @@ func (msg *MsgCreateProvider) Validate() error {
 		}
 	}
 
+	if err := ValidateAttributes(msg.Attributes); err != nil {
+		return ErrInvalidProvider.Wrapf("invalid attributes: %s", err)
+	}
+
 	return nil
 }
 
@@ -73,6 +81,7 @@ func NewMsgUpdateProvider(
 	metaHash []byte,
 	active bool,
 	apiURL string,
+	attributes []Attribute,
 ) *MsgUpdateProvider {
 	return &MsgUpdateProvider{
 		Authority:     authority,
@@ -82,6 +91,7 @@ func NewMsgUpdateProvider(
 		MetaHash:      metaHash,
 		Active:        active,
 		ApiUrl:        apiURL,
+		Attributes:    attributes,
 	}
 }
 
@@ -115,6 +125,10 @@ func (msg *MsgUpdateProvider) Validate() error {
 		}
 	}
 
+	if err := ValidateAttributes(msg.Attributes); err != nil {
+		return ErrInvalidProvider.Wrapf("invalid attributes: %s", err)
+	}
+
 	return nil
 }
 
@@ -156,6 +170,9 @@ func NewMsgCreateSKU(
 	unit Unit,
 	basePrice sdk.Coin,
 	metaHash []byte,
+	priceTiers []PriceTier,
+	capacity uint64,
+	attributes []Attribute,
 ) *MsgCreateSKU {
 	return &MsgCreateSKU{
 		Authority:    authority,
@@ -164,6 +181,9 @@ func NewMsgCreateSKU(
 		Unit:         unit,
 		BasePrice:    basePrice,
 		MetaHash:     metaHash,
+		PriceTiers:   priceTiers,
+		Capacity:     capacity,
+		Attributes:   attributes,
 	}
 }
 
@@ -193,16 +213,24 @@ func (msg *MsgCreateSKU) Validate() error {
 		return ErrInvalidSKU.Wrap("base price must be valid and non-zero")
 	}
 
-	// Validate that price and unit combination produces a non-zero per-second rate
+	// Validate that price and unit combination produces a positive per-second rate
 	if err := ValidatePriceAndUnit(msg.BasePrice, msg.Unit); err != nil {
 		return ErrInvalidSKU.Wrapf("invalid price/unit combination: %s", err)
 	}
 
+	if err := ValidatePriceTiers(msg.BasePrice, msg.Unit, msg.PriceTiers); err != nil {
+		return ErrInvalidSKU.Wrapf("invalid price tiers: %s", err)
+	}
+
 	// Validate meta_hash length if provided
 	if len(msg.MetaHash) > MaxMetaHashLength {
 		return ErrInvalidSKU.Wrapf("meta_hash exceeds maximum length of %d bytes", MaxMetaHashLength)
 	}
 
+	if err := ValidateAttributes(msg.Attributes); err != nil {
+		return ErrInvalidSKU.Wrapf("invalid attributes: %s", err)
+	}
+
 	return nil
 }
 
@@ -216,6 +244,9 @@ func NewMsgUpdateSKU(
 	basePrice sdk.Coin,
 	metaHash []byte,
 	active bool,
+	priceTiers []PriceTier,
+	capacity uint64,
+	attributes []Attribute,
 ) *MsgUpdateSKU {
 	return &MsgUpdateSKU{
 		Authority:    authority,
@@ -226,6 +257,9 @@ func NewMsgUpdateSKU(
 		BasePrice:    basePrice,
 		MetaHash:     metaHash,
 		Active:       active,
+		PriceTiers:   priceTiers,
+		Capacity:     capacity,
+		Attributes:   attributes,
 	}
 }
 
@@ -259,16 +293,24 @@ func (msg *MsgUpdateSKU) Validate() error {
 		return ErrInvalidSKU.Wrap("base price must be valid and non-zero")
 	}
 
-	// Validate that price and unit combination produces a non-zero per-second rate
+	// Validate that price and unit combination produces a positive per-second rate
 	if err := ValidatePriceAndUnit(msg.BasePrice, msg.Unit); err != nil {
 		return ErrInvalidSKU.Wrapf("invalid price/unit combination: %s", err)
 	}
 
+	if err := ValidatePriceTiers(msg.BasePrice, msg.Unit, msg.PriceTiers); err != nil {
+		return ErrInvalidSKU.Wrapf("invalid price tiers: %s", err)
+	}
+
 	// Validate meta_hash length if provided
 	if len(msg.MetaHash) > MaxMetaHashLength {
 		return ErrInvalidSKU.Wrapf("meta_hash exceeds maximum length of %d bytes", MaxMetaHashLength)
 	}
 
+	if err := ValidateAttributes(msg.Attributes); err != nil {
+		return ErrInvalidSKU.Wrapf("invalid attributes: %s", err)
+	}
+
 	return nil
 }
 
@@ -313,6 +355,49 @@ func (msg *MsgUpdateParams) Validate() error {
 	return msg.Params.Validate()
 }
 
+// NewMsgScheduleSKUPriceChange creates a new MsgScheduleSKUPriceChange instance.
+func NewMsgScheduleSKUPriceChange(
+	authority string,
+	skuUUID string,
+	basePrice sdk.Coin,
+	priceTiers []PriceTier,
+	effectiveAt time.Time,
+) *MsgScheduleSKUPriceChange {
+	return &MsgScheduleSKUPriceChange{
+		Authority:   authority,
+		SkuUuid:     skuUUID,
+		BasePrice:   basePrice,
+		PriceTiers:  priceTiers,
+		EffectiveAt: effectiveAt,
+	}
+}
+
+// Validate performs basic validation. The price is checked against the SKU's
+// unit by the keeper, which knows it.
+func (msg *MsgScheduleSKUPriceChange) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return ErrUnauthorized.Wrapf("invalid authority address: %s", err)
+	}
+
+	if err := pkguuid.ValidateUUIDv7(msg.SkuUuid); err != nil {
+		return ErrInvalidSKU.Wrapf("invalid sku_uuid: %s", err)
+	}
+
+	if !msg.BasePrice.IsValid() || msg.BasePrice.IsZero() {
+		return ErrInvalidSKU.Wrap("base price must be valid and non-zero")
+	}
+
+	if len(msg.PriceTiers) > MaxPriceTiers {
+		return ErrInvalidSKU.Wrapf("%d price tiers exceed the maximum of %d", len(msg.PriceTiers), MaxPriceTiers)
+	}
+
+	if msg.EffectiveAt.IsZero() {
+		return ErrInvalidSKU.Wrap("effective_at must be set")
+	}
+
+	return nil
+}
+
 // ValidateAPIURL validates that the API URL is a valid HTTPS URL.
 func ValidateAPIURL(apiURL string) error {
 	if len(apiURL) > MaxAPIURLLength {
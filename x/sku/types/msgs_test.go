@@ -13,6 +13,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -55,19 +56,55 @@ func TestMsgCreateSKUValidate(t *testing.T) {
 			expectErr: false,
 		},
 		{
-			name: "invalid: per hour with too low price (zero per-second rate)",
+			name: "valid: with price tiers",
 			msg: &MsgCreateSKU{
 				Authority:    authority.String(),
 				ProviderUuid: "01912345-6789-7abc-8def-0123456789ab",
 				Name:         "Test SKU",
 				Unit:         Unit_UNIT_PER_HOUR,
-				BasePrice:    sdk.NewCoin(testDenom, math.NewInt(100)),
+				BasePrice:    sdk.NewCoin(testDenom, math.NewInt(36000)),
+				PriceTiers:   []PriceTier{{MinQuantity: 5, Price: sdk.NewCoin(testDenom, math.NewInt(28800))}},
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid: price tier not divisible under an integer-rate base price",
+			msg: &MsgCreateSKU{
+				Authority:    authority.String(),
+				ProviderUuid: "01912345-6789-7abc-8def-0123456789ab",
+				Name:         "Test SKU",
+				Unit:         Unit_UNIT_PER_HOUR,
+				BasePrice:    sdk.NewCoin(testDenom, math.NewInt(36000)),
+				PriceTiers:   []PriceTier{{MinQuantity: 5, Price: sdk.NewCoin(testDenom, math.NewInt(28000))}},
 			},
 			expectErr: true,
-			errMsg:    "zero per-second rate",
+			errMsg:    "not evenly divisible",
+		},
+		{
+			name: "valid: price tier not divisible under a fractional-rate base price",
+			msg: &MsgCreateSKU{
+				Authority:    authority.String(),
+				ProviderUuid: "01912345-6789-7abc-8def-0123456789ab",
+				Name:         "Test SKU",
+				Unit:         Unit_UNIT_PER_HOUR,
+				BasePrice:    sdk.NewCoin(testDenom, math.NewInt(5000)),
+				PriceTiers:   []PriceTier{{MinQuantity: 5, Price: sdk.NewCoin(testDenom, math.NewInt(4000))}},
+			},
+			expectErr: false,
+		},
+		{
+			name: "valid: per hour with small price (fractional per-second rate)",
+			msg: &MsgCreateSKU{
+				Authority:    authority.String(),
+				ProviderUuid: "01912345-6789-7abc-8def-0123456789ab",
+				Name:         "Test SKU",
+				Unit:         Unit_UNIT_PER_HOUR,
+				BasePrice:    sdk.NewCoin(testDenom, math.NewInt(100)),
+			},
+			expectErr: false,
 		},
 		{
-			name: "invalid: per day with too low price (zero per-second rate)",
+			name: "valid: per day with small price (fractional per-second rate)",
 			msg: &MsgCreateSKU{
 				Authority:    authority.String(),
 				ProviderUuid: "01912345-6789-7abc-8def-0123456789ab",
@@ -75,11 +112,10 @@ func TestMsgCreateSKUValidate(t *testing.T) {
 				Unit:         Unit_UNIT_PER_DAY,
 				BasePrice:    sdk.NewCoin(testDenom, math.NewInt(1000)),
 			},
-			expectErr: true,
-			errMsg:    "zero per-second rate",
+			expectErr: false,
 		},
 		{
-			name: "invalid: per hour not evenly divisible (3601)",
+			name: "valid: per hour fractional per-second rate, no tiers (3601)",
 			msg: &MsgCreateSKU{
 				Authority:    authority.String(),
 				ProviderUuid: "01912345-6789-7abc-8def-0123456789ab",
@@ -87,11 +123,10 @@ func TestMsgCreateSKUValidate(t *testing.T) {
 				Unit:         Unit_UNIT_PER_HOUR,
 				BasePrice:    sdk.NewCoin(testDenom, math.NewInt(3601)),
 			},
-			expectErr: true,
-			errMsg:    "not evenly divisible",
+			expectErr: false,
 		},
 		{
-			name: "invalid: per day not evenly divisible (86401)",
+			name: "valid: per day fractional per-second rate, no tiers (86401)",
 			msg: &MsgCreateSKU{
 				Authority:    authority.String(),
 				ProviderUuid: "01912345-6789-7abc-8def-0123456789ab",
@@ -99,11 +134,10 @@ func TestMsgCreateSKUValidate(t *testing.T) {
 				Unit:         Unit_UNIT_PER_DAY,
 				BasePrice:    sdk.NewCoin(testDenom, math.NewInt(86401)),
 			},
-			expectErr: true,
-			errMsg:    "not evenly divisible",
+			expectErr: false,
 		},
 		{
-			name: "invalid: per hour not evenly divisible (5000)",
+			name: "valid: per hour fractional per-second rate, no tiers (5000)",
 			msg: &MsgCreateSKU{
 				Authority:    authority.String(),
 				ProviderUuid: "01912345-6789-7abc-8def-0123456789ab",
@@ -111,8 +145,7 @@ func TestMsgCreateSKUValidate(t *testing.T) {
 				Unit:         Unit_UNIT_PER_HOUR,
 				BasePrice:    sdk.NewCoin(testDenom, math.NewInt(5000)),
 			},
-			expectErr: true,
-			errMsg:    "not evenly divisible",
+			expectErr: false,
 		},
 		{
 			name: "invalid: authority address",
@@ -263,7 +296,7 @@ func TestMsgUpdateSKUValidate(t *testing.T) {
 			expectErr: false,
 		},
 		{
-			name: "invalid: per hour with too low price (zero per-second rate)",
+			name: "valid: per hour with small price (fractional per-second rate)",
 			msg: &MsgUpdateSKU{
 				Authority:    authority.String(),
 				Uuid:         "01912345-6789-7abc-8def-0123456789ac",
@@ -273,11 +306,10 @@ func TestMsgUpdateSKUValidate(t *testing.T) {
 				BasePrice:    sdk.NewCoin(testDenom, math.NewInt(100)),
 				Active:       true,
 			},
-			expectErr: true,
-			errMsg:    "zero per-second rate",
+			expectErr: false,
 		},
 		{
-			name: "invalid: per day with too low price (zero per-second rate)",
+			name: "valid: per day with small price (fractional per-second rate)",
 			msg: &MsgUpdateSKU{
 				Authority:    authority.String(),
 				Uuid:         "01912345-6789-7abc-8def-0123456789ac",
@@ -287,11 +319,10 @@ func TestMsgUpdateSKUValidate(t *testing.T) {
 				BasePrice:    sdk.NewCoin(testDenom, math.NewInt(1000)),
 				Active:       true,
 			},
-			expectErr: true,
-			errMsg:    "zero per-second rate",
+			expectErr: false,
 		},
 		{
-			name: "invalid: per hour not evenly divisible (3601)",
+			name: "valid: per hour fractional per-second rate, no tiers (3601)",
 			msg: &MsgUpdateSKU{
 				Authority:    authority.String(),
 				Uuid:         "01912345-6789-7abc-8def-0123456789ac",
@@ -301,11 +332,10 @@ func TestMsgUpdateSKUValidate(t *testing.T) {
 				BasePrice:    sdk.NewCoin(testDenom, math.NewInt(3601)),
 				Active:       true,
 			},
-			expectErr: true,
-			errMsg:    "not evenly divisible",
+			expectErr: false,
 		},
 		{
-			name: "invalid: per day not evenly divisible (86401)",
+			name: "valid: per day fractional per-second rate, no tiers (86401)",
 			msg: &MsgUpdateSKU{
 				Authority:    authority.String(),
 				Uuid:         "01912345-6789-7abc-8def-0123456789ac",
@@ -315,11 +345,10 @@ func TestMsgUpdateSKUValidate(t *testing.T) {
 				BasePrice:    sdk.NewCoin(testDenom, math.NewInt(86401)),
 				Active:       true,
 			},
-			expectErr: true,
-			errMsg:    "not evenly divisible",
+			expectErr: false,
 		},
 		{
-			name: "invalid: per hour not evenly divisible (5000)",
+			name: "valid: per hour fractional per-second rate, no tiers (5000)",
 			msg: &MsgUpdateSKU{
 				Authority:    authority.String(),
 				Uuid:         "01912345-6789-7abc-8def-0123456789ac",
@@ -329,8 +358,7 @@ func TestMsgUpdateSKUValidate(t *testing.T) {
 				BasePrice:    sdk.NewCoin(testDenom, math.NewInt(5000)),
 				Active:       true,
 			},
-			expectErr: true,
-			errMsg:    "not evenly divisible",
+			expectErr: false,
 		},
 		{
 			name: "invalid: zero SKU ID",
@@ -628,6 +656,63 @@ func TestMsgDeactivateSKUValidate(t *testing.T) {
 	}
 }
 
+func TestMsgScheduleSKUPriceChangeValidate(t *testing.T) {
+	_, _, authority := testdata.KeyTestPubAddr()
+
+	skuUUID := "01912345-6789-7abc-8def-0123456789ac"
+	price := sdk.NewCoin("umfx", math.NewInt(7200))
+	effectiveAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		msg       *MsgScheduleSKUPriceChange
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			name:      "valid",
+			msg:       NewMsgScheduleSKUPriceChange(authority.String(), skuUUID, price, nil, effectiveAt),
+			expectErr: false,
+		},
+		{
+			name:      "invalid: authority address",
+			msg:       NewMsgScheduleSKUPriceChange("invalid", skuUUID, price, nil, effectiveAt),
+			expectErr: true,
+			errMsg:    "invalid authority address",
+		},
+		{
+			name:      "invalid: sku uuid",
+			msg:       NewMsgScheduleSKUPriceChange(authority.String(), "", price, nil, effectiveAt),
+			expectErr: true,
+			errMsg:    "invalid sku_uuid",
+		},
+		{
+			name:      "invalid: zero base price",
+			msg:       NewMsgScheduleSKUPriceChange(authority.String(), skuUUID, sdk.NewCoin("umfx", math.ZeroInt()), nil, effectiveAt),
+			expectErr: true,
+			errMsg:    "base price must be valid and non-zero",
+		},
+		{
+			name:      "invalid: effective_at not set",
+			msg:       NewMsgScheduleSKUPriceChange(authority.String(), skuUUID, price, nil, time.Time{}),
+			expectErr: true,
+			errMsg:    "effective_at must be set",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestMsgDeactivateProviderValidate(t *testing.T) {
 	_, _, authority := testdata.KeyTestPubAddr()
 
@@ -9,7 +9,8 @@ import (
 // DefaultParams returns the default module parameters.
 func DefaultParams() Params {
 	return Params{
-		AllowedList: []string{},
+		AllowedList:     []string{},
+		AttributeSchema: []AttributeDefinition{},
 	}
 }
 
@@ -25,6 +26,23 @@ func (p Params) Validate() error {
 		}
 		seen[addr] = true
 	}
+
+	if len(p.AttributeSchema) > MaxAttributeDefinitions {
+		return ErrInvalidConfig.Wrapf("attribute schema has %d keys, maximum is %d", len(p.AttributeSchema), MaxAttributeDefinitions)
+	}
+	seenKeys := make(map[string]bool)
+	for _, def := range p.AttributeSchema {
+		if err := ValidateAttributeKey(def.Key); err != nil {
+			return ErrInvalidConfig.Wrapf("invalid attribute schema: %s", err)
+		}
+		if seenKeys[def.Key] {
+			return ErrInvalidConfig.Wrapf("duplicate attribute key in schema: %s", def.Key)
+		}
+		seenKeys[def.Key] = true
+		if _, ok := AttributeType_name[int32(def.ValueType)]; !ok || def.ValueType == AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED {
+			return ErrInvalidConfig.Wrapf("attribute %s has invalid value_type %d", def.Key, def.ValueType)
+		}
+	}
 	return nil
 }
 
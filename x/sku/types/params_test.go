@@ -57,6 +57,35 @@ func TestParams_Validate(t *testing.T) {
 			expectErr: true,
 			errMsg:    "duplicate address in allowed list",
 		},
+		{
+			name: "valid: attribute schema",
+			params: Params{AttributeSchema: []AttributeDefinition{
+				{Key: "region", ValueType: AttributeType_ATTRIBUTE_TYPE_STRING},
+				{Key: "vcpus", ValueType: AttributeType_ATTRIBUTE_TYPE_UINT},
+			}},
+			expectErr: false,
+		},
+		{
+			name:      "invalid: attribute schema key",
+			params:    Params{AttributeSchema: []AttributeDefinition{{Key: "Region", ValueType: AttributeType_ATTRIBUTE_TYPE_STRING}}},
+			expectErr: true,
+			errMsg:    "invalid attribute schema",
+		},
+		{
+			name: "invalid: duplicate attribute schema key",
+			params: Params{AttributeSchema: []AttributeDefinition{
+				{Key: "region", ValueType: AttributeType_ATTRIBUTE_TYPE_STRING},
+				{Key: "region", ValueType: AttributeType_ATTRIBUTE_TYPE_UINT},
+			}},
+			expectErr: true,
+			errMsg:    "duplicate attribute key in schema",
+		},
+		{
+			name:      "invalid: unspecified attribute type",
+			params:    Params{AttributeSchema: []AttributeDefinition{{Key: "region"}}},
+			expectErr: true,
+			errMsg:    "invalid value_type",
+		},
 	}
 
 	for _, tc := range tests {
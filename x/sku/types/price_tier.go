@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PriceForQuantity returns the price per unit of the SKU for a lease item of
+// quantity units: the price of the last tier whose min_quantity is reached,
+// or the base price if none is.
+func (s SKU) PriceForQuantity(quantity uint64) sdk.Coin {
+	price := s.BasePrice
+	for _, tier := range s.PriceTiers {
+		if quantity < tier.MinQuantity {
+			break
+		}
+		price = tier.Price
+	}
+	return price
+}
+
+// ValidatePrice checks a complete SKU price for unit: a positive base price
+// with a positive per-second rate and valid price tiers.
+func ValidatePrice(basePrice sdk.Coin, unit Unit, tiers []PriceTier) error {
+	if !basePrice.IsValid() || basePrice.IsZero() {
+		return fmt.Errorf("base price must be valid and non-zero")
+	}
+	if err := ValidatePriceAndUnit(basePrice, unit); err != nil {
+		return fmt.Errorf("invalid price/unit combination: %w", err)
+	}
+	if err := ValidatePriceTiers(basePrice, unit, tiers); err != nil {
+		return fmt.Errorf("invalid price tiers: %w", err)
+	}
+	return nil
+}
+
+// ValidatePriceTiers checks the volume prices of a SKU priced at basePrice
+// per unit. Tiers must be ordered by strictly ascending min_quantity starting
+// above 1, be priced in the base price's denom, and each price must pass the
+// same positive per-second rate check as the base price.
+//
+// A SKU whose base price has an integer per-second rate (see HasIntegerRate)
+// keeps the exact-divisibility rule for every tier, so its leases accrue whole
+// base units whichever tier they lock. Only SKUs priced at a fractional rate
+// may have fractional tiers.
+func ValidatePriceTiers(basePrice sdk.Coin, unit Unit, tiers []PriceTier) error {
+	if len(tiers) > MaxPriceTiers {
+		return fmt.Errorf("%d price tiers exceed the maximum of %d", len(tiers), MaxPriceTiers)
+	}
+	integerRate := HasIntegerRate(basePrice, unit)
+
+	prevMin := uint64(1)
+	for i, tier := range tiers {
+		if tier.MinQuantity <= prevMin {
+			return fmt.Errorf("price tier %d min_quantity %d must be greater than %d", i, tier.MinQuantity, prevMin)
+		}
+		prevMin = tier.MinQuantity
+
+		if !tier.Price.IsValid() || tier.Price.IsZero() {
+			return fmt.Errorf("price tier %d price must be valid and non-zero", i)
+		}
+		if tier.Price.Denom != basePrice.Denom {
+			return fmt.Errorf("price tier %d denom %s does not match base price denom %s", i, tier.Price.Denom, basePrice.Denom)
+		}
+		if err := ValidatePriceAndUnit(tier.Price, unit); err != nil {
+			return fmt.Errorf("price tier %d: %w", i, err)
+		}
+		if integerRate {
+			if err := ValidateExactPrice(tier.Price, unit); err != nil {
+				return fmt.Errorf("price tier %d: %w", i, err)
+			}
+		}
+	}
+	return nil
+}
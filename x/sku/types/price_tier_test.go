@@ -0,0 +1,73 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSKU_PriceForQuantity(t *testing.T) {
+	sku := SKU{
+		Unit:      Unit_UNIT_PER_HOUR,
+		BasePrice: sdk.NewCoin("umfx", math.NewInt(36000)),
+		PriceTiers: []PriceTier{
+			{MinQuantity: 5, Price: sdk.NewCoin("umfx", math.NewInt(28800))},
+			{MinQuantity: 10, Price: sdk.NewCoin("umfx", math.NewInt(21600))},
+		},
+	}
+
+	for quantity, want := range map[uint64]int64{
+		0: 36000, 1: 36000, 4: 36000, 5: 28800, 9: 28800, 10: 21600, 1000: 21600,
+	} {
+		require.Equal(t, sdk.NewCoin("umfx", math.NewInt(want)), sku.PriceForQuantity(quantity), "quantity %d", quantity)
+	}
+
+	sku.PriceTiers = nil
+	require.Equal(t, sku.BasePrice, sku.PriceForQuantity(100))
+}
+
+func TestValidatePriceTiers(t *testing.T) {
+	base := sdk.NewCoin("umfx", math.NewInt(36000))
+	tier := func(minQuantity uint64, amount int64) PriceTier {
+		return PriceTier{MinQuantity: minQuantity, Price: sdk.NewCoin("umfx", math.NewInt(amount))}
+	}
+
+	tests := []struct {
+		name   string
+		unit   Unit
+		tiers  []PriceTier
+		errMsg string
+	}{
+		{name: "no tiers", unit: Unit_UNIT_PER_HOUR},
+		{name: "ascending tiers", unit: Unit_UNIT_PER_HOUR, tiers: []PriceTier{tier(2, 32400), tier(5, 28800)}},
+		{name: "metered tier", unit: Unit_UNIT_PER_USAGE, tiers: []PriceTier{tier(100, 7)}},
+		{name: "min quantity 1", unit: Unit_UNIT_PER_HOUR, tiers: []PriceTier{tier(1, 28800)}, errMsg: "must be greater than 1"},
+		{name: "not ascending", unit: Unit_UNIT_PER_HOUR, tiers: []PriceTier{tier(5, 28800), tier(5, 21600)}, errMsg: "must be greater than 5"},
+		{name: "zero price", unit: Unit_UNIT_PER_HOUR, tiers: []PriceTier{tier(5, 0)}, errMsg: "valid and non-zero"},
+		{
+			name:   "other denom",
+			unit:   Unit_UNIT_PER_HOUR,
+			tiers:  []PriceTier{{MinQuantity: 5, Price: sdk.NewCoin("upwr", math.NewInt(3600))}},
+			errMsg: "does not match base price denom",
+		},
+		{name: "not divisible", unit: Unit_UNIT_PER_HOUR, tiers: []PriceTier{tier(5, 28000)}, errMsg: "not evenly divisible"},
+		{name: "fractional base rate", unit: Unit_UNIT_PER_DAY, tiers: []PriceTier{tier(5, 3600)}},
+		{name: "calendar month", unit: Unit_UNIT_PER_MONTH, tiers: []PriceTier{tier(5, 28000)}},
+		{name: "too many tiers", unit: Unit_UNIT_PER_HOUR, tiers: make([]PriceTier, MaxPriceTiers+1), errMsg: "exceed the maximum"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidatePriceTiers(base, tc.unit, tc.tiers)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.errMsg)
+		})
+	}
+}
@@ -760,6 +760,437 @@ func (m *QueryProviderByAddressResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// AttributeFilter matches entries by one attribute. Set value to match it
+// exactly, or min and/or max to match an inclusive range. Ranges are only
+// allowed on ATTRIBUTE_TYPE_UINT keys.
+type AttributeFilter struct {
+	// key is the attribute to match. It must be declared in
+	// Params.attribute_schema.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value is the exact value to match.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// min is the inclusive lower bound of a range, in decimal.
+	Min string `protobuf:"bytes,3,opt,name=min,proto3" json:"min,omitempty"`
+	// max is the inclusive upper bound of a range, in decimal.
+	Max string `protobuf:"bytes,4,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (m *AttributeFilter) Reset()         { *m = AttributeFilter{} }
+func (m *AttributeFilter) String() string { return proto.CompactTextString(m) }
+func (*AttributeFilter) ProtoMessage()    {}
+func (*AttributeFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5fb9c4344400a82, []int{14}
+}
+func (m *AttributeFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttributeFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttributeFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttributeFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeFilter.Merge(m, src)
+}
+func (m *AttributeFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttributeFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributeFilter proto.InternalMessageInfo
+
+func (m *AttributeFilter) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *AttributeFilter) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *AttributeFilter) GetMin() string {
+	if m != nil {
+		return m.Min
+	}
+	return ""
+}
+
+func (m *AttributeFilter) GetMax() string {
+	if m != nil {
+		return m.Max
+	}
+	return ""
+}
+
+// QuerySearchSKUsRequest is the request type for the Query/SearchSKUs RPC
+// method. All filters must match. At least one filter is required.
+type QuerySearchSKUsRequest struct {
+	// filters match the SKU's own attributes.
+	Filters []AttributeFilter `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters"`
+	// provider_filters match the attributes of the SKU's provider.
+	ProviderFilters []AttributeFilter `protobuf:"bytes,2,rep,name=provider_filters,json=providerFilters,proto3" json:"provider_filters"`
+	// active_only filters to return only active SKUs when true.
+	ActiveOnly bool `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySearchSKUsRequest) Reset()         { *m = QuerySearchSKUsRequest{} }
+func (m *QuerySearchSKUsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySearchSKUsRequest) ProtoMessage()    {}
+func (*QuerySearchSKUsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5fb9c4344400a82, []int{15}
+}
+func (m *QuerySearchSKUsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySearchSKUsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySearchSKUsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySearchSKUsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySearchSKUsRequest.Merge(m, src)
+}
+func (m *QuerySearchSKUsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySearchSKUsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySearchSKUsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySearchSKUsRequest proto.InternalMessageInfo
+
+func (m *QuerySearchSKUsRequest) GetFilters() []AttributeFilter {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+func (m *QuerySearchSKUsRequest) GetProviderFilters() []AttributeFilter {
+	if m != nil {
+		return m.ProviderFilters
+	}
+	return nil
+}
+
+func (m *QuerySearchSKUsRequest) GetActiveOnly() bool {
+	if m != nil {
+		return m.ActiveOnly
+	}
+	return false
+}
+
+func (m *QuerySearchSKUsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySearchSKUsResponse is the response type for the Query/SearchSKUs RPC
+// method.
+type QuerySearchSKUsResponse struct {
+	// skus is the list of matching SKUs.
+	Skus []SKU `protobuf:"bytes,1,rep,name=skus,proto3" json:"skus"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySearchSKUsResponse) Reset()         { *m = QuerySearchSKUsResponse{} }
+func (m *QuerySearchSKUsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySearchSKUsResponse) ProtoMessage()    {}
+func (*QuerySearchSKUsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5fb9c4344400a82, []int{16}
+}
+func (m *QuerySearchSKUsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySearchSKUsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySearchSKUsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySearchSKUsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySearchSKUsResponse.Merge(m, src)
+}
+func (m *QuerySearchSKUsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySearchSKUsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySearchSKUsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySearchSKUsResponse proto.InternalMessageInfo
+
+func (m *QuerySearchSKUsResponse) GetSkus() []SKU {
+	if m != nil {
+		return m.Skus
+	}
+	return nil
+}
+
+func (m *QuerySearchSKUsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySKUPriceHistoryRequest is the request type for the
+// Query/SKUPriceHistory RPC method.
+type QuerySKUPriceHistoryRequest struct {
+	// sku_uuid is the unique identifier of the SKU.
+	SkuUuid string `protobuf:"bytes,1,opt,name=sku_uuid,json=skuUuid,proto3" json:"sku_uuid,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySKUPriceHistoryRequest) Reset()         { *m = QuerySKUPriceHistoryRequest{} }
+func (m *QuerySKUPriceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySKUPriceHistoryRequest) ProtoMessage()    {}
+func (*QuerySKUPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5fb9c4344400a82, []int{17}
+}
+func (m *QuerySKUPriceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySKUPriceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySKUPriceHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySKUPriceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySKUPriceHistoryRequest.Merge(m, src)
+}
+func (m *QuerySKUPriceHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySKUPriceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySKUPriceHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySKUPriceHistoryRequest proto.InternalMessageInfo
+
+func (m *QuerySKUPriceHistoryRequest) GetSkuUuid() string {
+	if m != nil {
+		return m.SkuUuid
+	}
+	return ""
+}
+
+func (m *QuerySKUPriceHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySKUPriceHistoryResponse is the response type for the
+// Query/SKUPriceHistory RPC method.
+type QuerySKUPriceHistoryResponse struct {
+	// entries are the SKU's prices, oldest first.
+	Entries []PriceHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySKUPriceHistoryResponse) Reset()         { *m = QuerySKUPriceHistoryResponse{} }
+func (m *QuerySKUPriceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySKUPriceHistoryResponse) ProtoMessage()    {}
+func (*QuerySKUPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5fb9c4344400a82, []int{18}
+}
+func (m *QuerySKUPriceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySKUPriceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySKUPriceHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySKUPriceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySKUPriceHistoryResponse.Merge(m, src)
+}
+func (m *QuerySKUPriceHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySKUPriceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySKUPriceHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySKUPriceHistoryResponse proto.InternalMessageInfo
+
+func (m *QuerySKUPriceHistoryResponse) GetEntries() []PriceHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QuerySKUPriceHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingPriceChangesRequest is the request type for the
+// Query/PendingPriceChanges RPC method.
+type QueryPendingPriceChangesRequest struct {
+	// sku_uuid optionally restricts the result to the change pending for one
+	// SKU.
+	SkuUuid string `protobuf:"bytes,1,opt,name=sku_uuid,json=skuUuid,proto3" json:"sku_uuid,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingPriceChangesRequest) Reset()         { *m = QueryPendingPriceChangesRequest{} }
+func (m *QueryPendingPriceChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPriceChangesRequest) ProtoMessage()    {}
+func (*QueryPendingPriceChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5fb9c4344400a82, []int{19}
+}
+func (m *QueryPendingPriceChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPriceChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPriceChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingPriceChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPriceChangesRequest.Merge(m, src)
+}
+func (m *QueryPendingPriceChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPriceChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPriceChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingPriceChangesRequest proto.InternalMessageInfo
+
+func (m *QueryPendingPriceChangesRequest) GetSkuUuid() string {
+	if m != nil {
+		return m.SkuUuid
+	}
+	return ""
+}
+
+func (m *QueryPendingPriceChangesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingPriceChangesResponse is the response type for the
+// Query/PendingPriceChanges RPC method.
+type QueryPendingPriceChangesResponse struct {
+	// changes are the pending price changes, ordered by effective_at.
+	Changes []PendingPriceChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingPriceChangesResponse) Reset()         { *m = QueryPendingPriceChangesResponse{} }
+func (m *QueryPendingPriceChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPriceChangesResponse) ProtoMessage()    {}
+func (*QueryPendingPriceChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5fb9c4344400a82, []int{20}
+}
+func (m *QueryPendingPriceChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPriceChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPriceChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingPriceChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPriceChangesResponse.Merge(m, src)
+}
+func (m *QueryPendingPriceChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPriceChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPriceChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingPriceChangesResponse proto.InternalMessageInfo
+
+func (m *QueryPendingPriceChangesResponse) GetChanges() []PendingPriceChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func (m *QueryPendingPriceChangesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "liftedinit.sku.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "liftedinit.sku.v1.QueryParamsResponse")
@@ -775,65 +1206,93 @@ func init() {
 	proto.RegisterType((*QuerySKUsByProviderResponse)(nil), "liftedinit.sku.v1.QuerySKUsByProviderResponse")
 	proto.RegisterType((*QueryProviderByAddressRequest)(nil), "liftedinit.sku.v1.QueryProviderByAddressRequest")
 	proto.RegisterType((*QueryProviderByAddressResponse)(nil), "liftedinit.sku.v1.QueryProviderByAddressResponse")
+	proto.RegisterType((*AttributeFilter)(nil), "liftedinit.sku.v1.AttributeFilter")
+	proto.RegisterType((*QuerySearchSKUsRequest)(nil), "liftedinit.sku.v1.QuerySearchSKUsRequest")
+	proto.RegisterType((*QuerySearchSKUsResponse)(nil), "liftedinit.sku.v1.QuerySearchSKUsResponse")
+	proto.RegisterType((*QuerySKUPriceHistoryRequest)(nil), "liftedinit.sku.v1.QuerySKUPriceHistoryRequest")
+	proto.RegisterType((*QuerySKUPriceHistoryResponse)(nil), "liftedinit.sku.v1.QuerySKUPriceHistoryResponse")
+	proto.RegisterType((*QueryPendingPriceChangesRequest)(nil), "liftedinit.sku.v1.QueryPendingPriceChangesRequest")
+	proto.RegisterType((*QueryPendingPriceChangesResponse)(nil), "liftedinit.sku.v1.QueryPendingPriceChangesResponse")
 }
 
 func init() { proto.RegisterFile("liftedinit/sku/v1/query.proto", fileDescriptor_d5fb9c4344400a82) }
 
 var fileDescriptor_d5fb9c4344400a82 = []byte{
-	// 836 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x3b, 0x6f, 0xdb, 0x56,
-	0x14, 0xd6, 0x95, 0x54, 0x3f, 0x8e, 0xfb, 0xf2, 0xad, 0xea, 0x5a, 0xb4, 0x4d, 0xb9, 0xf4, 0xb3,
-	0x06, 0x44, 0x5a, 0x2a, 0x0a, 0x77, 0x29, 0x8a, 0x6a, 0x68, 0x07, 0x03, 0xad, 0x2d, 0x41, 0x1d,
-	0xba, 0x18, 0x94, 0x75, 0xcd, 0x12, 0x92, 0x48, 0x99, 0x0f, 0xb5, 0xaa, 0x61, 0xa0, 0xe8, 0x50,
-	0x20, 0x4b, 0x10, 0x20, 0x4b, 0xe6, 0x6c, 0xf1, 0x92, 0x25, 0x63, 0x7e, 0x80, 0x47, 0x23, 0x59,
-	0x32, 0x05, 0x89, 0x9d, 0x1f, 0x12, 0xf0, 0xf2, 0x50, 0x4f, 0x8a, 0x72, 0x00, 0xc3, 0xc9, 0x24,
-	0xea, 0xf0, 0x3b, 0xe7, 0xfb, 0xbe, 0x73, 0x78, 0x0f, 0x09, 0x4b, 0x75, 0xfd, 0xc8, 0x61, 0x55,
-	0xdd, 0xd0, 0x1d, 0xc5, 0xae, 0xb9, 0x4a, 0x2b, 0xa7, 0x1c, 0xbb, 0xcc, 0x6a, 0xcb, 0x4d, 0xcb,
-	0x74, 0x4c, 0x3a, 0xdb, 0xbd, 0x2d, 0xdb, 0x35, 0x57, 0x6e, 0xe5, 0x84, 0xad, 0x43, 0xd3, 0x6e,
-	0x98, 0xb6, 0x52, 0x51, 0x6d, 0xe6, 0x63, 0x95, 0x56, 0xae, 0xc2, 0x1c, 0x35, 0xa7, 0x34, 0x55,
-	0x4d, 0x37, 0x54, 0x47, 0x37, 0x0d, 0x3f, 0x5d, 0x48, 0xfb, 0xd8, 0x03, 0xfe, 0x4f, 0xf1, 0xff,
-	0xe0, 0xad, 0x94, 0x66, 0x6a, 0xa6, 0x1f, 0xf7, 0xae, 0x30, 0xba, 0xa8, 0x99, 0xa6, 0x56, 0x67,
-	0x8a, 0xda, 0xd4, 0x15, 0xd5, 0x30, 0x4c, 0x87, 0x57, 0x0b, 0x72, 0x42, 0xc4, 0x3a, 0xed, 0x26,
-	0xc3, 0xdb, 0x52, 0x0a, 0xe8, 0xbe, 0xa7, 0x67, 0x4f, 0xb5, 0xd4, 0x86, 0x5d, 0x64, 0xc7, 0x2e,
-	0xb3, 0x1d, 0xe9, 0x57, 0xf8, 0xa2, 0x2f, 0x6a, 0x37, 0x4d, 0xc3, 0x66, 0x74, 0x07, 0x26, 0x9a,
-	0x3c, 0x32, 0x4f, 0x96, 0xc9, 0xe6, 0x4c, 0x3e, 0x2d, 0x0f, 0x59, 0x95, 0xfd, 0x94, 0x42, 0xf2,
-	0xfc, 0x65, 0x26, 0x56, 0x44, 0xb8, 0xb4, 0x05, 0x29, 0xbf, 0x9e, 0x65, 0xb6, 0xf4, 0x2a, 0xb3,
-	0x90, 0x87, 0x52, 0x48, 0xba, 0xae, 0x5e, 0xe5, 0xe5, 0xa6, 0x8b, 0xfc, 0x5a, 0xfa, 0x1d, 0xbe,
-	0x1c, 0xc0, 0x22, 0xfb, 0x0f, 0x30, 0xd5, 0xc4, 0x18, 0xf2, 0x2f, 0x84, 0xf1, 0x23, 0x04, 0x15,
-	0x74, 0x52, 0xa4, 0x7f, 0xc9, 0x40, 0xe1, 0xc0, 0x2d, 0xfd, 0x19, 0xa0, 0x3b, 0x05, 0x2c, 0xbd,
-	0x2e, 0x63, 0xe7, 0xbd, 0x91, 0xc9, 0xfe, 0x78, 0x71, 0x64, 0xf2, 0x9e, 0xaa, 0x31, 0xcc, 0x2d,
-	0xf6, 0x64, 0xd2, 0x0c, 0xcc, 0xa8, 0x87, 0x8e, 0xde, 0x62, 0x07, 0xa6, 0x51, 0x6f, 0xcf, 0xc7,
-	0x97, 0xc9, 0xe6, 0x54, 0x11, 0xfc, 0xd0, 0x6f, 0x46, 0xbd, 0x2d, 0x3d, 0x24, 0x30, 0x37, 0x28,
-	0x01, 0xcd, 0xfd, 0x08, 0xd3, 0x81, 0x52, 0xaf, 0xbb, 0x89, 0xeb, 0xb9, 0xeb, 0xe6, 0xd0, 0x5f,
-	0xfa, 0x4c, 0xc4, 0xb9, 0x89, 0x8d, 0xb1, 0x26, 0x7c, 0xf6, 0x5e, 0x17, 0xd2, 0x1a, 0x7c, 0xc6,
-	0x35, 0x96, 0x76, 0xcb, 0x51, 0x63, 0x2a, 0xc0, 0xe7, 0x5d, 0x18, 0x9a, 0x90, 0x21, 0x61, 0xd7,
-	0x5c, 0xec, 0xe0, 0x5c, 0x88, 0xfc, 0xd2, 0x6e, 0x19, 0x95, 0x7b, 0x40, 0xe9, 0xa4, 0x5b, 0xe3,
-	0xf6, 0x87, 0x71, 0x97, 0xc0, 0x6c, 0x0f, 0x3b, 0x5a, 0xd8, 0x86, 0xa4, 0x5d, 0x73, 0x83, 0x11,
-	0x44, 0x7b, 0xe0, 0xc8, 0x9b, 0x6b, 0xfc, 0x19, 0x01, 0xa1, 0x23, 0xa8, 0x30, 0x74, 0x56, 0x56,
-	0xe0, 0x93, 0x60, 0xda, 0x07, 0x3d, 0xd3, 0xf8, 0x38, 0x08, 0x96, 0x5d, 0xbd, 0x3a, 0xd0, 0xbd,
-	0xf8, 0x4d, 0x75, 0x2f, 0x31, 0xd4, 0xbd, 0x07, 0x04, 0x16, 0x42, 0xc5, 0xbe, 0xff, 0x3e, 0x3e,
-	0x25, 0xb0, 0xd4, 0x77, 0xca, 0x0a, 0xed, 0x9f, 0xaa, 0x55, 0x8b, 0xd9, 0x9d, 0x67, 0x2c, 0x0f,
-	0x93, 0xaa, 0x1f, 0xf1, 0x9b, 0x58, 0x98, 0x7f, 0xf6, 0x24, 0x9b, 0x42, 0x2a, 0xc4, 0x96, 0x1c,
-	0x4b, 0x37, 0xb4, 0x62, 0x00, 0xbc, 0xbd, 0xce, 0x9e, 0x11, 0x10, 0x47, 0xc9, 0xff, 0xd0, 0x96,
-	0x45, 0xfe, 0xf5, 0x24, 0x7c, 0xc4, 0xc5, 0xd2, 0x7f, 0x60, 0xc2, 0x5f, 0xfd, 0x74, 0x2d, 0x44,
-	0xca, 0xf0, 0x3b, 0x46, 0x58, 0x1f, 0x07, 0xf3, 0xe9, 0xa4, 0xaf, 0xff, 0x7b, 0xfe, 0xe6, 0x7e,
-	0x7c, 0x81, 0xa6, 0x95, 0xe1, 0x37, 0x99, 0xff, 0x7a, 0xa1, 0x77, 0x08, 0x4c, 0x05, 0x66, 0xe9,
-	0xc6, 0xc8, 0xba, 0xfd, 0x07, 0x4a, 0xd8, 0x1c, 0x0f, 0x44, 0x09, 0x5b, 0x5c, 0xc2, 0x2a, 0x95,
-	0xc2, 0x24, 0x20, 0x58, 0x39, 0xf1, 0x0e, 0xe5, 0x29, 0xfd, 0x9f, 0xc0, 0x74, 0x67, 0xbd, 0xd3,
-	0xb1, 0x1c, 0x9d, 0x76, 0x7c, 0x73, 0x0d, 0x24, 0xca, 0x59, 0xe5, 0x72, 0x44, 0xba, 0x18, 0x21,
-	0xc7, 0xa6, 0x2e, 0x24, 0x4a, 0xbb, 0x65, 0x2a, 0x8d, 0xaa, 0xdb, 0xdd, 0xef, 0xc2, 0x4a, 0x24,
-	0x06, 0x59, 0xd7, 0x38, 0x6b, 0x86, 0x2e, 0x85, 0xb0, 0x7a, 0x3f, 0xe8, 0xdf, 0x82, 0xa4, 0xb7,
-	0x12, 0x68, 0x54, 0xcd, 0x8e, 0xe9, 0xd5, 0x68, 0x10, 0x32, 0x67, 0x38, 0x73, 0x9a, 0x7e, 0x15,
-	0xce, 0x6c, 0xd3, 0x47, 0x04, 0x3e, 0xed, 0xdf, 0x43, 0x34, 0x1b, 0x55, 0x79, 0x68, 0xb9, 0x0a,
-	0xf2, 0x75, 0xe1, 0x28, 0xe9, 0x7b, 0x2e, 0x29, 0x4f, 0xb7, 0x47, 0x48, 0xea, 0x79, 0x2c, 0xfa,
-	0x96, 0xf6, 0x29, 0x7d, 0x4c, 0x60, 0x76, 0xe8, 0x64, 0xd3, 0xed, 0x71, 0xd3, 0x1f, 0xdc, 0x61,
-	0x42, 0xee, 0x1d, 0x32, 0x50, 0xf4, 0x77, 0x5c, 0xb4, 0x42, 0xb3, 0x51, 0x8f, 0x31, 0xee, 0x3b,
-	0xe5, 0x04, 0x2f, 0x4e, 0x0b, 0xfb, 0xe7, 0x97, 0x22, 0xb9, 0xb8, 0x14, 0xc9, 0xab, 0x4b, 0x91,
-	0xdc, 0xbb, 0x12, 0x63, 0x17, 0x57, 0x62, 0xec, 0xc5, 0x95, 0x18, 0xfb, 0x63, 0x47, 0xd3, 0x9d,
-	0x3f, 0xdd, 0x8a, 0x7c, 0x68, 0x36, 0x94, 0x86, 0x6a, 0xe8, 0x47, 0xcc, 0x76, 0xb2, 0x06, 0x73,
-	0xfe, 0x32, 0xad, 0x5a, 0x37, 0x50, 0x67, 0x55, 0x8d, 0x59, 0xca, 0xdf, 0x9c, 0x8a, 0x7f, 0x7b,
-	0x56, 0x26, 0xf8, 0xc7, 0xe7, 0xb7, 0x6f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x01, 0x53, 0x85,
-	0x4a, 0x0b, 0x00, 0x00,
+	// 1174 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xdf, 0x4f, 0x23, 0xd5,
+	0x17, 0xe7, 0x42, 0x97, 0x1f, 0x87, 0xef, 0x57, 0xe0, 0x2e, 0xee, 0xc2, 0x00, 0x05, 0x07, 0xd8,
+	0x65, 0x9b, 0x30, 0x43, 0xd9, 0x18, 0x8c, 0x89, 0x31, 0x5b, 0x5d, 0x34, 0x21, 0x51, 0xb6, 0x0d,
+	0x3e, 0x98, 0x18, 0x32, 0xb4, 0x97, 0x61, 0xd2, 0x32, 0xd3, 0x9d, 0x7b, 0xa7, 0x52, 0x09, 0xc9,
+	0xc6, 0x18, 0x13, 0x5f, 0x36, 0x26, 0xfa, 0x60, 0x7c, 0xf4, 0xcd, 0x35, 0x46, 0x63, 0x7c, 0xf4,
+	0x0f, 0xd8, 0xc7, 0x8d, 0xfb, 0xe2, 0x93, 0x31, 0xe0, 0x1f, 0x62, 0xe6, 0xde, 0x33, 0xfd, 0x39,
+	0x6d, 0xc1, 0x10, 0xd6, 0xa7, 0xce, 0x9c, 0xf9, 0x9c, 0x73, 0x3e, 0xe7, 0x73, 0xee, 0x8f, 0x93,
+	0xc2, 0x5c, 0xc9, 0xd9, 0x17, 0xac, 0xe0, 0xb8, 0x8e, 0x30, 0x79, 0x31, 0x30, 0x2b, 0x69, 0xf3,
+	0x61, 0xc0, 0xfc, 0xaa, 0x51, 0xf6, 0x3d, 0xe1, 0xd1, 0x89, 0xfa, 0x67, 0x83, 0x17, 0x03, 0xa3,
+	0x92, 0xd6, 0x52, 0x79, 0x8f, 0x1f, 0x7a, 0xdc, 0xdc, 0xb3, 0x38, 0x53, 0x58, 0xb3, 0x92, 0xde,
+	0x63, 0xc2, 0x4a, 0x9b, 0x65, 0xcb, 0x76, 0x5c, 0x4b, 0x38, 0x9e, 0xab, 0xdc, 0xb5, 0x69, 0x85,
+	0xdd, 0x95, 0x6f, 0xa6, 0x7a, 0xc1, 0x4f, 0x93, 0xb6, 0x67, 0x7b, 0xca, 0x1e, 0x3e, 0xa1, 0x75,
+	0xd6, 0xf6, 0x3c, 0xbb, 0xc4, 0x4c, 0xab, 0xec, 0x98, 0x96, 0xeb, 0x7a, 0x42, 0x46, 0x8b, 0x7c,
+	0x62, 0xc8, 0x8a, 0x6a, 0x99, 0xe1, 0x67, 0x7d, 0x12, 0xe8, 0x83, 0x90, 0xcf, 0xb6, 0xe5, 0x5b,
+	0x87, 0x3c, 0xcb, 0x1e, 0x06, 0x8c, 0x0b, 0xfd, 0x3d, 0xb8, 0xde, 0x64, 0xe5, 0x65, 0xcf, 0xe5,
+	0x8c, 0x6e, 0xc0, 0x60, 0x59, 0x5a, 0xa6, 0xc8, 0x02, 0x59, 0x19, 0x5d, 0x9f, 0x36, 0xda, 0x4a,
+	0x35, 0x94, 0x4b, 0x26, 0xf1, 0xf4, 0xcf, 0xf9, 0xbe, 0x2c, 0xc2, 0xf5, 0x14, 0x4c, 0xaa, 0x78,
+	0xbe, 0x57, 0x71, 0x0a, 0xcc, 0xc7, 0x3c, 0x94, 0x42, 0x22, 0x08, 0x9c, 0x82, 0x0c, 0x37, 0x92,
+	0x95, 0xcf, 0xfa, 0x07, 0xf0, 0x72, 0x0b, 0x16, 0xb3, 0xbf, 0x01, 0xc3, 0x65, 0xb4, 0x61, 0xfe,
+	0x99, 0xb8, 0xfc, 0x08, 0x41, 0x06, 0x35, 0x17, 0xfd, 0x11, 0x69, 0x09, 0x1c, 0x55, 0x4b, 0x37,
+	0x01, 0xea, 0x5d, 0xc0, 0xd0, 0xb7, 0x0c, 0x54, 0x3e, 0x6c, 0x99, 0xa1, 0xda, 0x8b, 0x2d, 0x33,
+	0xb6, 0x2d, 0x9b, 0xa1, 0x6f, 0xb6, 0xc1, 0x93, 0xce, 0xc3, 0xa8, 0x95, 0x17, 0x4e, 0x85, 0xed,
+	0x7a, 0x6e, 0xa9, 0x3a, 0xd5, 0xbf, 0x40, 0x56, 0x86, 0xb3, 0xa0, 0x4c, 0xef, 0xbb, 0xa5, 0xaa,
+	0xfe, 0x1d, 0x81, 0x1b, 0xad, 0x14, 0xb0, 0xb8, 0x37, 0x61, 0x24, 0x62, 0x1a, 0xaa, 0x3b, 0x70,
+	0xbe, 0xea, 0xea, 0x3e, 0xf4, 0x9d, 0xa6, 0x22, 0xfa, 0x65, 0x11, 0xb7, 0x7b, 0x16, 0xa1, 0xb2,
+	0x37, 0x56, 0xa1, 0x2f, 0xc3, 0x98, 0xe4, 0x98, 0xdb, 0xda, 0xe9, 0xd6, 0xa6, 0x0c, 0x8c, 0xd7,
+	0x61, 0x58, 0x84, 0x01, 0x03, 0xbc, 0x18, 0xa0, 0x82, 0x37, 0x62, 0xe8, 0xe7, 0xb6, 0x76, 0x90,
+	0x79, 0x08, 0xd4, 0x8f, 0xeb, 0x31, 0xae, 0xbe, 0x19, 0x8f, 0x09, 0x4c, 0x34, 0x64, 0xc7, 0x12,
+	0xd6, 0x20, 0xc1, 0x8b, 0x41, 0xd4, 0x82, 0xee, 0x35, 0x48, 0xe4, 0xe5, 0x09, 0xff, 0x84, 0x80,
+	0x56, 0x23, 0x94, 0x69, 0xdb, 0x2b, 0x8b, 0xf0, 0xff, 0xa8, 0xdb, 0xbb, 0x0d, 0xdd, 0xf8, 0x5f,
+	0x64, 0xdc, 0x09, 0x9c, 0x02, 0xdd, 0x8c, 0x21, 0x73, 0x09, 0xea, 0x0d, 0xb4, 0xa9, 0xf7, 0x0d,
+	0x81, 0x99, 0x58, 0xb2, 0x2f, 0x5e, 0xc7, 0xdf, 0x08, 0xcc, 0x35, 0xed, 0xb2, 0x4c, 0xf5, 0x5e,
+	0xa1, 0xe0, 0x33, 0x5e, 0x5b, 0x63, 0xeb, 0x30, 0x64, 0x29, 0x8b, 0x12, 0x31, 0x33, 0xf5, 0xfb,
+	0xaf, 0xab, 0x93, 0x98, 0x0a, 0xb1, 0x39, 0xe1, 0x3b, 0xae, 0x9d, 0x8d, 0x80, 0x57, 0xa7, 0xec,
+	0x13, 0x02, 0xc9, 0x4e, 0xf4, 0xff, 0x73, 0x87, 0xc5, 0x47, 0x30, 0x76, 0x4f, 0x08, 0xdf, 0xd9,
+	0x0b, 0x04, 0xdb, 0x74, 0x4a, 0x82, 0xf9, 0x74, 0x1c, 0x06, 0x8a, 0xac, 0x8a, 0xab, 0x33, 0x7c,
+	0xa4, 0x93, 0x70, 0xad, 0x62, 0x95, 0x02, 0x26, 0x13, 0x8d, 0x64, 0xd5, 0x4b, 0x88, 0x3b, 0x74,
+	0x5c, 0x29, 0xc0, 0x48, 0x36, 0x7c, 0x94, 0x16, 0xeb, 0x68, 0x2a, 0x81, 0x16, 0xeb, 0x48, 0xff,
+	0xb6, 0x1f, 0x0f, 0xcc, 0x1c, 0xb3, 0xfc, 0xfc, 0x41, 0xe3, 0x39, 0x91, 0x81, 0xa1, 0x7d, 0x99,
+	0x30, 0x52, 0x40, 0x8f, 0x51, 0xa0, 0x85, 0x1b, 0x0a, 0x11, 0x39, 0xd2, 0x1c, 0x8c, 0xd7, 0xb6,
+	0x54, 0x14, 0xac, 0xff, 0x82, 0xc1, 0xc6, 0xa2, 0x08, 0x9b, 0x18, 0xb4, 0x57, 0x83, 0x5b, 0x56,
+	0x52, 0xe2, 0xdf, 0xae, 0x24, 0xfd, 0x6b, 0x02, 0x37, 0xdb, 0xc4, 0x79, 0xf1, 0xdb, 0xef, 0x51,
+	0xc3, 0xc9, 0xb0, 0xed, 0x3b, 0x79, 0xf6, 0xae, 0xc3, 0x85, 0xe7, 0x57, 0xa3, 0xc6, 0x4d, 0xc3,
+	0x30, 0x2f, 0x06, 0x8d, 0x47, 0xd8, 0x10, 0x2f, 0x06, 0x97, 0x79, 0x7a, 0xe9, 0x3f, 0x12, 0x98,
+	0x8d, 0xa7, 0x80, 0xf2, 0xbc, 0x0d, 0x43, 0xcc, 0x15, 0xbe, 0xc3, 0x22, 0x85, 0x96, 0x62, 0xb7,
+	0x4f, 0xdd, 0xf3, 0xbe, 0x2b, 0xfc, 0x6a, 0xb4, 0x7c, 0xd0, 0xf5, 0xf2, 0x24, 0xfb, 0x8c, 0xc0,
+	0xbc, 0xda, 0xf2, 0xcc, 0x2d, 0x38, 0xae, 0x2d, 0x33, 0xbf, 0x75, 0x60, 0xb9, 0x36, 0xe3, 0x57,
+	0x28, 0xdb, 0x2f, 0x04, 0x16, 0x3a, 0xd3, 0x40, 0xe9, 0xee, 0xc3, 0x50, 0x5e, 0x99, 0x50, 0xba,
+	0xe5, 0x38, 0xe9, 0xda, 0x02, 0x44, 0xda, 0xa1, 0xef, 0xa5, 0x69, 0xb7, 0xfe, 0x7c, 0x14, 0xae,
+	0x49, 0xd2, 0xf4, 0x13, 0x18, 0x54, 0xc3, 0x27, 0x8d, 0xa3, 0xd4, 0x3e, 0xe5, 0x6a, 0xb7, 0x7a,
+	0xc1, 0x54, 0x3a, 0xfd, 0x95, 0x4f, 0x9f, 0xff, 0xfd, 0x55, 0xff, 0x0c, 0x9d, 0x36, 0xdb, 0x67,
+	0x69, 0x35, 0xe0, 0xd2, 0x2f, 0x08, 0x0c, 0x47, 0xc7, 0x2d, 0xbd, 0xdd, 0x31, 0x6e, 0xf3, 0x95,
+	0xae, 0xad, 0xf4, 0x06, 0x22, 0x85, 0x94, 0xa4, 0xb0, 0x44, 0xf5, 0x38, 0x0a, 0x08, 0x36, 0x8f,
+	0xc3, 0xc5, 0x71, 0x42, 0x3f, 0x27, 0x30, 0xb2, 0x5d, 0x3b, 0xea, 0x7b, 0xe6, 0xa8, 0xc9, 0x71,
+	0xe7, 0x1c, 0x48, 0xa4, 0xb3, 0x24, 0xe9, 0x24, 0xe9, 0x6c, 0x17, 0x3a, 0x9c, 0x06, 0x30, 0x90,
+	0xdb, 0xda, 0xa1, 0x7a, 0xa7, 0xb8, 0xf5, 0x09, 0x53, 0x5b, 0xec, 0x8a, 0xc1, 0xac, 0xcb, 0x32,
+	0xeb, 0x3c, 0x9d, 0x8b, 0xc9, 0x1a, 0xfe, 0x60, 0xfd, 0x3e, 0x24, 0xc2, 0xb3, 0x90, 0x76, 0x8b,
+	0x59, 0x2b, 0x7a, 0xa9, 0x3b, 0x08, 0x33, 0xcf, 0xcb, 0xcc, 0xd3, 0xf4, 0x66, 0x7c, 0x66, 0x4e,
+	0xbf, 0x27, 0xf0, 0x52, 0xf3, 0x24, 0x44, 0x57, 0xbb, 0x45, 0x6e, 0x1b, 0xef, 0x34, 0xe3, 0xbc,
+	0x70, 0xa4, 0xf4, 0x9a, 0xa4, 0xb4, 0x4e, 0xd7, 0x3a, 0x50, 0x6a, 0x58, 0x16, 0x4d, 0x63, 0xe3,
+	0x09, 0xfd, 0x89, 0xc0, 0x44, 0xdb, 0x6c, 0x41, 0xd7, 0x7a, 0x75, 0xbf, 0x75, 0x8a, 0xd2, 0xd2,
+	0x17, 0xf0, 0x40, 0xd2, 0xaf, 0x4a, 0xd2, 0x26, 0x5d, 0xed, 0xb6, 0x8c, 0x71, 0xe2, 0x32, 0x8f,
+	0xf1, 0xe1, 0x84, 0x3e, 0x26, 0x00, 0xf5, 0x4b, 0x8e, 0x76, 0x5c, 0xa8, 0x6d, 0x53, 0x82, 0x96,
+	0x3a, 0x0f, 0x14, 0xc9, 0xdd, 0x91, 0xe4, 0x16, 0x5f, 0x27, 0x29, 0x3d, 0xd9, 0x49, 0x54, 0x2e,
+	0xdd, 0xe8, 0x0f, 0x04, 0xc6, 0x5a, 0xee, 0x16, 0xda, 0xad, 0x81, 0x31, 0xf7, 0xa0, 0x66, 0x9e,
+	0x1b, 0x8f, 0xfc, 0x36, 0x24, 0xbf, 0x34, 0x35, 0x3b, 0x2d, 0xff, 0xe8, 0x7e, 0x38, 0x31, 0xcb,
+	0xa1, 0xff, 0xee, 0x01, 0x32, 0xfb, 0x99, 0xc0, 0xf5, 0x98, 0x23, 0x9d, 0xae, 0x77, 0x6c, 0x60,
+	0xc7, 0x6b, 0x48, 0xbb, 0x7b, 0x21, 0x1f, 0x64, 0xbe, 0x26, 0x99, 0xa7, 0xe8, 0x4a, 0x5c, 0xdb,
+	0x95, 0xdf, 0xae, 0xa2, 0x8c, 0xd7, 0x43, 0xe6, 0xc1, 0xd3, 0xd3, 0x24, 0x79, 0x76, 0x9a, 0x24,
+	0x7f, 0x9d, 0x26, 0xc9, 0x97, 0x67, 0xc9, 0xbe, 0x67, 0x67, 0xc9, 0xbe, 0x3f, 0xce, 0x92, 0x7d,
+	0x1f, 0x6e, 0xd8, 0x8e, 0x38, 0x08, 0xf6, 0x8c, 0xbc, 0x77, 0x68, 0x1e, 0x5a, 0xae, 0xb3, 0xcf,
+	0xb8, 0x58, 0x75, 0x99, 0xf8, 0xd8, 0xf3, 0x8b, 0x75, 0x43, 0x89, 0x15, 0x6c, 0xe6, 0x9b, 0x47,
+	0x32, 0x8b, 0xfc, 0xbf, 0x63, 0x6f, 0x50, 0xfe, 0xe1, 0x71, 0xf7, 0x9f, 0x01, 0x00, 0x72, 0x4b,
+	0x47, 0xd4, 0xbe, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -862,6 +1321,14 @@ type QueryClient interface {
 	SKUsByProvider(ctx context.Context, in *QuerySKUsByProviderRequest, opts ...grpc.CallOption) (*QuerySKUsByProviderResponse, error)
 	// ProviderByAddress queries a provider by management address.
 	ProviderByAddress(ctx context.Context, in *QueryProviderByAddressRequest, opts ...grpc.CallOption) (*QueryProviderByAddressResponse, error)
+	// SearchSKUs queries SKUs by their attributes and those of their provider.
+	// It is served over POST because its filters do not fit a query string.
+	SearchSKUs(ctx context.Context, in *QuerySearchSKUsRequest, opts ...grpc.CallOption) (*QuerySearchSKUsResponse, error)
+	// SKUPriceHistory queries the price history of a SKU, oldest first.
+	SKUPriceHistory(ctx context.Context, in *QuerySKUPriceHistoryRequest, opts ...grpc.CallOption) (*QuerySKUPriceHistoryResponse, error)
+	// PendingPriceChanges queries the scheduled SKU price changes, soonest
+	// first.
+	PendingPriceChanges(ctx context.Context, in *QueryPendingPriceChangesRequest, opts ...grpc.CallOption) (*QueryPendingPriceChangesResponse, error)
 }
 
 type queryClient struct {
@@ -935,6 +1402,33 @@ func (c *queryClient) ProviderByAddress(ctx context.Context, in *QueryProviderBy
 	return out, nil
 }
 
+func (c *queryClient) SearchSKUs(ctx context.Context, in *QuerySearchSKUsRequest, opts ...grpc.CallOption) (*QuerySearchSKUsResponse, error) {
+	out := new(QuerySearchSKUsResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.sku.v1.Query/SearchSKUs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SKUPriceHistory(ctx context.Context, in *QuerySKUPriceHistoryRequest, opts ...grpc.CallOption) (*QuerySKUPriceHistoryResponse, error) {
+	out := new(QuerySKUPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.sku.v1.Query/SKUPriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingPriceChanges(ctx context.Context, in *QueryPendingPriceChangesRequest, opts ...grpc.CallOption) (*QueryPendingPriceChangesResponse, error) {
+	out := new(QueryPendingPriceChangesResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.sku.v1.Query/PendingPriceChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the module parameters.
@@ -951,6 +1445,14 @@ type QueryServer interface {
 	SKUsByProvider(context.Context, *QuerySKUsByProviderRequest) (*QuerySKUsByProviderResponse, error)
 	// ProviderByAddress queries a provider by management address.
 	ProviderByAddress(context.Context, *QueryProviderByAddressRequest) (*QueryProviderByAddressResponse, error)
+	// SearchSKUs queries SKUs by their attributes and those of their provider.
+	// It is served over POST because its filters do not fit a query string.
+	SearchSKUs(context.Context, *QuerySearchSKUsRequest) (*QuerySearchSKUsResponse, error)
+	// SKUPriceHistory queries the price history of a SKU, oldest first.
+	SKUPriceHistory(context.Context, *QuerySKUPriceHistoryRequest) (*QuerySKUPriceHistoryResponse, error)
+	// PendingPriceChanges queries the scheduled SKU price changes, soonest
+	// first.
+	PendingPriceChanges(context.Context, *QueryPendingPriceChangesRequest) (*QueryPendingPriceChangesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -978,6 +1480,15 @@ func (*UnimplementedQueryServer) SKUsByProvider(ctx context.Context, req *QueryS
 func (*UnimplementedQueryServer) ProviderByAddress(ctx context.Context, req *QueryProviderByAddressRequest) (*QueryProviderByAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProviderByAddress not implemented")
 }
+func (*UnimplementedQueryServer) SearchSKUs(ctx context.Context, req *QuerySearchSKUsRequest) (*QuerySearchSKUsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchSKUs not implemented")
+}
+func (*UnimplementedQueryServer) SKUPriceHistory(ctx context.Context, req *QuerySKUPriceHistoryRequest) (*QuerySKUPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SKUPriceHistory not implemented")
+}
+func (*UnimplementedQueryServer) PendingPriceChanges(ctx context.Context, req *QueryPendingPriceChangesRequest) (*QueryPendingPriceChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingPriceChanges not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1109,6 +1620,61 @@ func _Query_ProviderByAddress_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SearchSKUs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySearchSKUsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SearchSKUs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.sku.v1.Query/SearchSKUs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SearchSKUs(ctx, req.(*QuerySearchSKUsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SKUPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySKUPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SKUPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.sku.v1.Query/SKUPriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SKUPriceHistory(ctx, req.(*QuerySKUPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingPriceChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingPriceChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingPriceChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.sku.v1.Query/PendingPriceChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingPriceChanges(ctx, req.(*QueryPendingPriceChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "liftedinit.sku.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1141,6 +1707,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ProviderByAddress",
 			Handler:    _Query_ProviderByAddress_Handler,
 		},
+		{
+			MethodName: "SearchSKUs",
+			Handler:    _Query_SearchSKUs_Handler,
+		},
+		{
+			MethodName: "SKUPriceHistory",
+			Handler:    _Query_SKUPriceHistory_Handler,
+		},
+		{
+			MethodName: "PendingPriceChanges",
+			Handler:    _Query_PendingPriceChanges_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "liftedinit/sku/v1/query.proto",
@@ -1718,204 +2296,422 @@ func (m *QueryProviderByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AttributeFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *AttributeFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryProviderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AttributeFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Uuid)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Max) > 0 {
+		i -= len(m.Max)
+		copy(dAtA[i:], m.Max)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Max)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if len(m.Min) > 0 {
+		i -= len(m.Min)
+		copy(dAtA[i:], m.Min)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Min)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySearchSKUsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Provider.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryProvidersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySearchSKUsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySearchSKUsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.ActiveOnly {
-		n += 2
-	}
-	return n
-}
-
-func (m *QueryProvidersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+		i--
+		if m.ActiveOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	if len(m.Providers) > 0 {
-		for _, e := range m.Providers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.ProviderFilters) > 0 {
+		for iNdEx := len(m.ProviderFilters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderFilters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Filters) > 0 {
+		for iNdEx := len(m.Filters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Filters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySKURequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Uuid)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySearchSKUsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySKUResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Sku.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QuerySearchSKUsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySKUsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySearchSKUsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ActiveOnly {
-		n += 2
-	}
-	return n
-}
-
-func (m *QuerySKUsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
 	if len(m.Skus) > 0 {
-		for _, e := range m.Skus {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Skus) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Skus[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySKUPriceHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySKUPriceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySKUPriceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.SkuUuid) > 0 {
+		i -= len(m.SkuUuid)
+		copy(dAtA[i:], m.SkuUuid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SkuUuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySKUsByProviderRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySKUPriceHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySKUPriceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySKUPriceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ProviderUuid)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPriceChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPriceChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPriceChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ActiveOnly {
-		n += 2
+	if len(m.SkuUuid) > 0 {
+		i -= len(m.SkuUuid)
+		copy(dAtA[i:], m.SkuUuid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SkuUuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPriceChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPriceChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPriceChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	return n
 }
 
-func (m *QuerySKUsByProviderResponse) Size() (n int) {
+func (m *QueryParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Skus) > 0 {
-		for _, e := range m.Skus {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
+	var l int
+	_ = l
+	l = len(m.Uuid)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryProviderByAddressRequest) Size() (n int) {
+func (m *QueryProviderResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	l = m.Provider.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProvidersRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
+	var l int
+	_ = l
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
@@ -1926,7 +2722,7 @@ func (m *QueryProviderByAddressRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryProviderByAddressResponse) Size() (n int) {
+func (m *QueryProvidersResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1945,11 +2741,292 @@ func (m *QueryProviderByAddressResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QuerySKURequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uuid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QuerySKUResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Sku.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySKUsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ActiveOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuerySKUsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Skus) > 0 {
+		for _, e := range m.Skus {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySKUsByProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderUuid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ActiveOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuerySKUsByProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Skus) > 0 {
+		for _, e := range m.Skus {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ActiveOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryProviderByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Providers) > 0 {
+		for _, e := range m.Providers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AttributeFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Min)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Max)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySearchSKUsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Filters) > 0 {
+		for _, e := range m.Filters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ProviderFilters) > 0 {
+		for _, e := range m.ProviderFilters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ActiveOnly {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySearchSKUsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Skus) > 0 {
+		for _, e := range m.Skus {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySKUPriceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SkuUuid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySKUPriceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingPriceChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SkuUuid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingPriceChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -1961,25 +3038,770 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProvidersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProvidersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ActiveOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProvidersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProvidersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Providers = append(m.Providers, Provider{})
+			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySKURequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySKURequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySKURequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySKUResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySKUResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySKUResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sku", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sku.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySKUsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySKUsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySKUsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ActiveOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2001,7 +3823,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySKUsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2024,15 +3846,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySKUsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySKUsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Skus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2059,7 +3881,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Skus = append(m.Skus, SKU{})
+			if err := m.Skus[len(m.Skus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2084,7 +3943,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProviderRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySKUsByProviderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2107,15 +3966,15 @@ func (m *QueryProviderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProviderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySKUsByProviderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySKUsByProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderUuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2143,8 +4002,64 @@ func (m *QueryProviderRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uuid = string(dAtA[iNdEx:postIndex])
+			m.ProviderUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ActiveOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2166,7 +4081,7 @@ func (m *QueryProviderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySKUsByProviderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2189,15 +4104,15 @@ func (m *QueryProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySKUsByProviderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySKUsByProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Skus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2224,7 +4139,44 @@ func (m *QueryProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Skus = append(m.Skus, SKU{})
+			if err := m.Skus[len(m.Skus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2249,7 +4201,7 @@ func (m *QueryProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProvidersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderByAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2272,13 +4224,45 @@ func (m *QueryProvidersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProvidersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderByAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2314,7 +4298,7 @@ func (m *QueryProvidersRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ActiveOnly", wireType)
 			}
@@ -2355,7 +4339,7 @@ func (m *QueryProvidersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProvidersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderByAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2378,10 +4362,10 @@ func (m *QueryProvidersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProvidersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderByAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2475,7 +4459,7 @@ func (m *QueryProvidersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySKURequest) Unmarshal(dAtA []byte) error {
+func (m *AttributeFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2498,15 +4482,15 @@ func (m *QuerySKURequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySKURequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AttributeFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySKURequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AttributeFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2534,63 +4518,77 @@ func (m *QuerySKURequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uuid = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySKUResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySKUResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySKUResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Min = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sku", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2600,24 +4598,23 @@ func (m *QuerySKUResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Sku.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Max = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2640,7 +4637,7 @@ func (m *QuerySKUResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySKUsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySearchSKUsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2663,15 +4660,15 @@ func (m *QuerySKUsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySKUsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySearchSKUsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySKUsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySearchSKUsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2698,14 +4695,46 @@ func (m *QuerySKUsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Filters = append(m.Filters, AttributeFilter{})
+			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderFilters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderFilters = append(m.ProviderFilters, AttributeFilter{})
+			if err := m.ProviderFilters[len(m.ProviderFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ActiveOnly", wireType)
 			}
@@ -2725,6 +4754,42 @@ func (m *QuerySKUsRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ActiveOnly = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2746,7 +4811,7 @@ func (m *QuerySKUsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySKUsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySearchSKUsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2769,10 +4834,10 @@ func (m *QuerySKUsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySKUsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySearchSKUsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySKUsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySearchSKUsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2866,7 +4931,7 @@ func (m *QuerySKUsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySKUsByProviderRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySKUPriceHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2889,15 +4954,15 @@ func (m *QuerySKUsByProviderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySKUsByProviderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySKUPriceHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySKUsByProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySKUPriceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SkuUuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2925,7 +4990,7 @@ func (m *QuerySKUsByProviderRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderUuid = string(dAtA[iNdEx:postIndex])
+			m.SkuUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2963,26 +5028,6 @@ func (m *QuerySKUsByProviderRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveOnly", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ActiveOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3004,7 +5049,7 @@ func (m *QuerySKUsByProviderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySKUsByProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySKUPriceHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3027,15 +5072,15 @@ func (m *QuerySKUsByProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySKUsByProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySKUPriceHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySKUsByProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySKUPriceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Skus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3062,8 +5107,8 @@ func (m *QuerySKUsByProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Skus = append(m.Skus, SKU{})
-			if err := m.Skus[len(m.Skus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, PriceHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3124,7 +5169,7 @@ func (m *QuerySKUsByProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProviderByAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingPriceChangesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3147,15 +5192,15 @@ func (m *QueryProviderByAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProviderByAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingPriceChangesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProviderByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingPriceChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SkuUuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3183,7 +5228,7 @@ func (m *QueryProviderByAddressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.SkuUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3221,26 +5266,6 @@ func (m *QueryProviderByAddressRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveOnly", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ActiveOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3262,7 +5287,7 @@ func (m *QueryProviderByAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProviderByAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingPriceChangesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3285,15 +5310,15 @@ func (m *QueryProviderByAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProviderByAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingPriceChangesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProviderByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingPriceChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3320,8 +5345,8 @@ func (m *QueryProviderByAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Providers = append(m.Providers, Provider{})
-			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Changes = append(m.Changes, PendingPriceChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
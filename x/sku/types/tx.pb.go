@@ -14,18 +14,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -46,6 +50,8 @@ type MsgCreateProvider struct {
 	// api_url is the HTTPS endpoint where the provider's off-chain API is hosted.
 	// Must be a valid HTTPS URL.
 	ApiUrl string `protobuf:"bytes,5,opt,name=api_url,json=apiUrl,proto3" json:"api_url,omitempty"`
+	// attributes are the optional catalog attributes; see Provider.attributes.
+	Attributes []Attribute `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes"`
 }
 
 func (m *MsgCreateProvider) Reset()         { *m = MsgCreateProvider{} }
@@ -116,6 +122,13 @@ func (m *MsgCreateProvider) GetApiUrl() string {
 	return ""
 }
 
+func (m *MsgCreateProvider) GetAttributes() []Attribute {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
 // MsgCreateProviderResponse is the Msg/CreateProvider response type.
 type MsgCreateProviderResponse struct {
 	// uuid is the unique identifier of the created provider (UUIDv7 format).
@@ -180,6 +193,9 @@ type MsgUpdateProvider struct {
 	Active bool `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`
 	// api_url is the HTTPS endpoint where the provider's off-chain API is hosted.
 	ApiUrl string `protobuf:"bytes,7,opt,name=api_url,json=apiUrl,proto3" json:"api_url,omitempty"`
+	// attributes replace the provider's catalog attributes; leave empty to
+	// remove them.
+	Attributes []Attribute `protobuf:"bytes,8,rep,name=attributes,proto3" json:"attributes"`
 }
 
 func (m *MsgUpdateProvider) Reset()         { *m = MsgUpdateProvider{} }
@@ -264,6 +280,13 @@ func (m *MsgUpdateProvider) GetApiUrl() string {
 	return ""
 }
 
+func (m *MsgUpdateProvider) GetAttributes() []Attribute {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
 // MsgUpdateProviderResponse is the Msg/UpdateProvider response type.
 type MsgUpdateProviderResponse struct {
 }
@@ -439,6 +462,12 @@ type MsgCreateSKU struct {
 	BasePrice github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=base_price,json=basePrice,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin" json:"base_price"`
 	// meta_hash is a hash of the off-chain metadata.
 	MetaHash []byte `protobuf:"bytes,6,opt,name=meta_hash,json=metaHash,proto3" json:"meta_hash,omitempty"`
+	// price_tiers are optional volume prices; see SKU.price_tiers.
+	PriceTiers []PriceTier `protobuf:"bytes,7,rep,name=price_tiers,json=priceTiers,proto3" json:"price_tiers"`
+	// capacity is the optional quantity limit; see SKU.capacity.
+	Capacity uint64 `protobuf:"varint,8,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// attributes are the optional catalog attributes; see SKU.attributes.
+	Attributes []Attribute `protobuf:"bytes,9,rep,name=attributes,proto3" json:"attributes"`
 }
 
 func (m *MsgCreateSKU) Reset()         { *m = MsgCreateSKU{} }
@@ -516,6 +545,27 @@ func (m *MsgCreateSKU) GetMetaHash() []byte {
 	return nil
 }
 
+func (m *MsgCreateSKU) GetPriceTiers() []PriceTier {
+	if m != nil {
+		return m.PriceTiers
+	}
+	return nil
+}
+
+func (m *MsgCreateSKU) GetCapacity() uint64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *MsgCreateSKU) GetAttributes() []Attribute {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
 // MsgCreateSKUResponse is the Msg/CreateSKU response type.
 type MsgCreateSKUResponse struct {
 	// uuid is the unique identifier of the created SKU (UUIDv7 format).
@@ -582,6 +632,16 @@ type MsgUpdateSKU struct {
 	MetaHash []byte `protobuf:"bytes,7,opt,name=meta_hash,json=metaHash,proto3" json:"meta_hash,omitempty"`
 	// active indicates whether the SKU is active.
 	Active bool `protobuf:"varint,8,opt,name=active,proto3" json:"active,omitempty"`
+	// price_tiers replace the SKU's volume prices; leave empty to remove them.
+	// Existing leases keep the price locked at their creation.
+	PriceTiers []PriceTier `protobuf:"bytes,9,rep,name=price_tiers,json=priceTiers,proto3" json:"price_tiers"`
+	// capacity replaces the SKU's quantity limit; zero means unlimited. It may
+	// be set below what leases already hold: they are kept, and new quantity
+	// is refused until enough is released.
+	Capacity uint64 `protobuf:"varint,10,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// attributes replace the SKU's catalog attributes; leave empty to remove
+	// them.
+	Attributes []Attribute `protobuf:"bytes,11,rep,name=attributes,proto3" json:"attributes"`
 }
 
 func (m *MsgUpdateSKU) Reset()         { *m = MsgUpdateSKU{} }
@@ -673,6 +733,27 @@ func (m *MsgUpdateSKU) GetActive() bool {
 	return false
 }
 
+func (m *MsgUpdateSKU) GetPriceTiers() []PriceTier {
+	if m != nil {
+		return m.PriceTiers
+	}
+	return nil
+}
+
+func (m *MsgUpdateSKU) GetCapacity() uint64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *MsgUpdateSKU) GetAttributes() []Attribute {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
 // MsgUpdateSKUResponse is the Msg/UpdateSKU response type.
 type MsgUpdateSKUResponse struct {
 }
@@ -895,6 +976,129 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgScheduleSKUPriceChange is the Msg/ScheduleSKUPriceChange request type.
+type MsgScheduleSKUPriceChange struct {
+	// authority is the address of the controlling account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// sku_uuid is the unique identifier of the SKU to reprice.
+	SkuUuid string `protobuf:"bytes,2,opt,name=sku_uuid,json=skuUuid,proto3" json:"sku_uuid,omitempty"`
+	// base_price is the base price the SKU takes at effective_at. It is
+	// validated against the SKU's unit both now and when it takes effect.
+	BasePrice github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=base_price,json=basePrice,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin" json:"base_price"`
+	// price_tiers replace the SKU's volume prices at effective_at; leave empty
+	// to remove them.
+	PriceTiers []PriceTier `protobuf:"bytes,4,rep,name=price_tiers,json=priceTiers,proto3" json:"price_tiers"`
+	// effective_at is the time from which the new price applies. It must be
+	// after the current block time.
+	EffectiveAt time.Time `protobuf:"bytes,5,opt,name=effective_at,json=effectiveAt,proto3,stdtime" json:"effective_at"`
+}
+
+func (m *MsgScheduleSKUPriceChange) Reset()         { *m = MsgScheduleSKUPriceChange{} }
+func (m *MsgScheduleSKUPriceChange) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleSKUPriceChange) ProtoMessage()    {}
+func (*MsgScheduleSKUPriceChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_092ddbc4d8a77f4f, []int{14}
+}
+func (m *MsgScheduleSKUPriceChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleSKUPriceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleSKUPriceChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleSKUPriceChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleSKUPriceChange.Merge(m, src)
+}
+func (m *MsgScheduleSKUPriceChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleSKUPriceChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleSKUPriceChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleSKUPriceChange proto.InternalMessageInfo
+
+func (m *MsgScheduleSKUPriceChange) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgScheduleSKUPriceChange) GetSkuUuid() string {
+	if m != nil {
+		return m.SkuUuid
+	}
+	return ""
+}
+
+func (m *MsgScheduleSKUPriceChange) GetBasePrice() github_com_cosmos_cosmos_sdk_types.Coin {
+	if m != nil {
+		return m.BasePrice
+	}
+	return github_com_cosmos_cosmos_sdk_types.Coin{}
+}
+
+func (m *MsgScheduleSKUPriceChange) GetPriceTiers() []PriceTier {
+	if m != nil {
+		return m.PriceTiers
+	}
+	return nil
+}
+
+func (m *MsgScheduleSKUPriceChange) GetEffectiveAt() time.Time {
+	if m != nil {
+		return m.EffectiveAt
+	}
+	return time.Time{}
+}
+
+// MsgScheduleSKUPriceChangeResponse is the Msg/ScheduleSKUPriceChange
+// response type.
+type MsgScheduleSKUPriceChangeResponse struct {
+}
+
+func (m *MsgScheduleSKUPriceChangeResponse) Reset()         { *m = MsgScheduleSKUPriceChangeResponse{} }
+func (m *MsgScheduleSKUPriceChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleSKUPriceChangeResponse) ProtoMessage()    {}
+func (*MsgScheduleSKUPriceChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_092ddbc4d8a77f4f, []int{15}
+}
+func (m *MsgScheduleSKUPriceChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleSKUPriceChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleSKUPriceChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleSKUPriceChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleSKUPriceChangeResponse.Merge(m, src)
+}
+func (m *MsgScheduleSKUPriceChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleSKUPriceChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleSKUPriceChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleSKUPriceChangeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateProvider)(nil), "liftedinit.sku.v1.MsgCreateProvider")
 	proto.RegisterType((*MsgCreateProviderResponse)(nil), "liftedinit.sku.v1.MsgCreateProviderResponse")
@@ -910,74 +1114,90 @@ func init() {
 	proto.RegisterType((*MsgDeactivateSKUResponse)(nil), "liftedinit.sku.v1.MsgDeactivateSKUResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "liftedinit.sku.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "liftedinit.sku.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgScheduleSKUPriceChange)(nil), "liftedinit.sku.v1.MsgScheduleSKUPriceChange")
+	proto.RegisterType((*MsgScheduleSKUPriceChangeResponse)(nil), "liftedinit.sku.v1.MsgScheduleSKUPriceChangeResponse")
 }
 
 func init() { proto.RegisterFile("liftedinit/sku/v1/tx.proto", fileDescriptor_092ddbc4d8a77f4f) }
 
 var fileDescriptor_092ddbc4d8a77f4f = []byte{
-	// 991 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xae, 0x1b, 0xe7, 0xd7, 0x6c, 0x5b, 0xa8, 0xe9, 0x6e, 0x12, 0x97, 0x4d, 0x2a, 0x2f, 0xd2,
-	0x56, 0x59, 0x6a, 0x93, 0xac, 0x44, 0xa5, 0x5c, 0x10, 0xed, 0x1e, 0x90, 0xaa, 0x4a, 0x8b, 0xa3,
-	0x5c, 0x38, 0x60, 0x4d, 0xe3, 0x59, 0x67, 0x94, 0xd8, 0x63, 0x79, 0xc6, 0x61, 0x7b, 0x41, 0x88,
-	0x23, 0x27, 0xc4, 0x91, 0x13, 0x17, 0x24, 0x24, 0x2e, 0x15, 0x82, 0x13, 0xff, 0xc0, 0x1e, 0x57,
-	0x5c, 0xe0, 0xb4, 0x42, 0xed, 0xa1, 0xfc, 0x0b, 0x70, 0x42, 0x1e, 0x3b, 0x4e, 0x1c, 0xbb, 0x49,
-	0xe9, 0x2e, 0xda, 0x4b, 0xeb, 0x79, 0xef, 0xcd, 0x7b, 0x6f, 0xbe, 0xef, 0xcd, 0x37, 0x01, 0xf2,
-	0x08, 0x3f, 0x61, 0xc8, 0xc4, 0x0e, 0x66, 0x1a, 0x1d, 0xfa, 0xda, 0xb8, 0xa5, 0xb1, 0xa7, 0xaa,
-	0xeb, 0x11, 0x46, 0xa4, 0xcd, 0xa9, 0x4f, 0xa5, 0x43, 0x5f, 0x1d, 0xb7, 0xe4, 0x4a, 0x9f, 0x50,
-	0x9b, 0x50, 0xcd, 0xa6, 0x56, 0x10, 0x6a, 0x53, 0x2b, 0x8c, 0x95, 0xb7, 0x2c, 0x62, 0x11, 0xfe,
-	0xa9, 0x05, 0x5f, 0x91, 0xb5, 0x16, 0x86, 0x1b, 0xa1, 0x23, 0x5c, 0x44, 0xae, 0x7a, 0x94, 0xe9,
-	0x04, 0x52, 0xa4, 0x8d, 0x5b, 0x27, 0x88, 0xc1, 0x96, 0xd6, 0x27, 0xd8, 0x89, 0xfc, 0x9b, 0xd0,
-	0xc6, 0x0e, 0xd1, 0xf8, 0xdf, 0xc8, 0x74, 0x37, 0xa3, 0xd7, 0x53, 0x17, 0x45, 0x19, 0x95, 0x1f,
-	0x57, 0xc1, 0xe6, 0x31, 0xb5, 0x0e, 0x3d, 0x04, 0x19, 0x7a, 0xec, 0x91, 0x31, 0x36, 0x91, 0x27,
-	0xbd, 0x0f, 0xca, 0xd0, 0x67, 0x03, 0xe2, 0x61, 0x76, 0x5a, 0x15, 0x76, 0x84, 0xdd, 0xf2, 0x41,
-	0xf5, 0xb7, 0x9f, 0xf7, 0xb6, 0xa2, 0x66, 0x3e, 0x34, 0x4d, 0x0f, 0x51, 0xda, 0x65, 0x1e, 0x76,
-	0x2c, 0x7d, 0x1a, 0x2a, 0xb5, 0x41, 0x11, 0x86, 0xbe, 0xea, 0xea, 0x92, 0x5d, 0x93, 0x40, 0xe9,
-	0x03, 0xb0, 0xe1, 0xc2, 0x53, 0xe2, 0x33, 0x63, 0xb2, 0x35, 0xb7, 0x64, 0xeb, 0x7a, 0x18, 0x1f,
-	0x19, 0xa5, 0x6d, 0x50, 0xb6, 0x11, 0x83, 0xc6, 0x00, 0xd2, 0x41, 0x55, 0xdc, 0x11, 0x76, 0xd7,
-	0xf4, 0x52, 0x60, 0xf8, 0x08, 0xd2, 0x81, 0x54, 0x01, 0x45, 0xe8, 0x62, 0xc3, 0xf7, 0x46, 0xd5,
-	0x7c, 0x90, 0x56, 0x2f, 0x40, 0x17, 0xf7, 0xbc, 0x51, 0xe7, 0xe1, 0x5f, 0xdf, 0x35, 0x56, 0xbe,
-	0xbc, 0x3c, 0x6b, 0x4e, 0xdb, 0xff, 0xea, 0xf2, 0xac, 0xf9, 0x76, 0x08, 0x17, 0x87, 0x2a, 0x85,
-	0x8b, 0xa2, 0x81, 0x5a, 0xca, 0xa8, 0x23, 0xea, 0x12, 0x87, 0x22, 0x49, 0x02, 0xa2, 0xef, 0x63,
-	0x33, 0xc4, 0x4b, 0xe7, 0xdf, 0xca, 0xef, 0x21, 0xbc, 0x3d, 0xd7, 0x7c, 0x15, 0xf0, 0x4e, 0x2a,
-	0xac, 0x4e, 0x2b, 0xcc, 0x42, 0x9e, 0xbb, 0x39, 0xe4, 0xe2, 0x4b, 0x40, 0x9e, 0x9f, 0x83, 0xfc,
-	0x0e, 0x28, 0xc0, 0x3e, 0xc3, 0x63, 0x54, 0x2d, 0xec, 0x08, 0xbb, 0x25, 0x3d, 0x5a, 0xcd, 0x52,
-	0x51, 0xbc, 0x01, 0x15, 0x49, 0x0c, 0x95, 0x6d, 0x4e, 0x45, 0xd2, 0x38, 0xa1, 0x42, 0xf9, 0x49,
-	0x00, 0xb7, 0x8f, 0xa9, 0xf5, 0x08, 0xf1, 0xd2, 0xff, 0x17, 0xf4, 0x5b, 0x20, 0x3f, 0xc2, 0x36,
-	0x66, 0x1c, 0x78, 0x51, 0x0f, 0x17, 0x9d, 0xfd, 0xec, 0xd3, 0xec, 0x24, 0x4f, 0x93, 0x6e, 0x4d,
-	0x71, 0xc0, 0xdd, 0x4c, 0x47, 0x3c, 0x60, 0x6d, 0x70, 0xdb, 0x8c, 0xbd, 0xa6, 0x41, 0x87, 0xbe,
-	0xd1, 0x27, 0xbe, 0xc3, 0xf8, 0x39, 0x44, 0xfd, 0xad, 0x19, 0x67, 0x77, 0xe8, 0x1f, 0x06, 0x2e,
-	0xa9, 0x06, 0x4a, 0x03, 0x48, 0x0d, 0x9b, 0x78, 0x88, 0xf7, 0x5e, 0xd2, 0x8b, 0x03, 0x48, 0x8f,
-	0x89, 0x87, 0x94, 0xbf, 0x57, 0xc1, 0x5a, 0x3c, 0xcd, 0xdd, 0xa3, 0xde, 0x8d, 0xb1, 0xb9, 0x07,
-	0xd6, 0xdd, 0xa8, 0x57, 0x63, 0x06, 0xa4, 0xb5, 0x89, 0xb1, 0x17, 0x80, 0x25, 0x01, 0xd1, 0x81,
-	0x36, 0x0a, 0x87, 0x54, 0xe7, 0xdf, 0xd2, 0x03, 0x20, 0xfa, 0x0e, 0x66, 0x7c, 0xfa, 0x36, 0xda,
-	0x15, 0x35, 0x25, 0x9d, 0x6a, 0xcf, 0xc1, 0x4c, 0xe7, 0x41, 0xd2, 0xe7, 0x00, 0x04, 0xb2, 0x67,
-	0xb8, 0x1e, 0xee, 0x23, 0x3e, 0x74, 0xb7, 0xda, 0x35, 0x35, 0xea, 0x2d, 0xf0, 0xa8, 0x91, 0x20,
-	0xaa, 0x87, 0x04, 0x3b, 0x07, 0x8f, 0x9e, 0xbd, 0x68, 0xac, 0xfc, 0xf3, 0xa2, 0x71, 0xdf, 0xc2,
-	0x6c, 0xe0, 0x9f, 0xa8, 0x7d, 0x62, 0x47, 0x5a, 0x1a, 0xfd, 0xdb, 0xa3, 0xe6, 0x30, 0x92, 0xc2,
-	0x60, 0xc3, 0xb7, 0x97, 0x67, 0xcd, 0x5b, 0x23, 0x64, 0xc1, 0xfe, 0xa9, 0x11, 0x28, 0xea, 0x0f,
-	0x97, 0x67, 0x4d, 0x41, 0x2f, 0x07, 0x89, 0x1f, 0x07, 0x15, 0x93, 0x33, 0x5f, 0x48, 0xce, 0x7c,
-	0x47, 0xcd, 0x26, 0xbd, 0x92, 0xa5, 0x26, 0xdd, 0xa3, 0x9e, 0xd2, 0x04, 0x5b, 0xb3, 0xeb, 0x85,
-	0x1a, 0xf2, 0x7d, 0x8e, 0xf3, 0x14, 0x8e, 0xfa, 0xcb, 0xf0, 0x94, 0x35, 0xc3, 0x29, 0xee, 0x72,
-	0x0b, 0xb8, 0x13, 0x33, 0xb8, 0xcb, 0xff, 0x77, 0xee, 0x0a, 0xaf, 0x97, 0xbb, 0xe2, 0x95, 0x7a,
-	0x55, 0x9a, 0xd5, 0xab, 0x6b, 0x72, 0x1a, 0xd3, 0xa2, 0xdc, 0xe1, 0x9c, 0xc6, 0xeb, 0x58, 0x8c,
-	0xbe, 0x11, 0xc0, 0x9b, 0x89, 0x8b, 0xfd, 0x8a, 0x39, 0xec, 0xb4, 0xb3, 0x1b, 0xdd, 0xbe, 0x4a,
-	0x71, 0x82, 0x66, 0x65, 0x50, 0x9d, 0xb7, 0xc5, 0x0d, 0xff, 0x22, 0x80, 0x37, 0xa6, 0xda, 0x0a,
-	0x3d, 0x68, 0xd3, 0x1b, 0xf7, 0xbb, 0x0f, 0x0a, 0x2e, 0xcf, 0xc0, 0x3b, 0x0e, 0x58, 0x4f, 0x0f,
-	0x4a, 0x58, 0xe2, 0x40, 0x0c, 0x58, 0xd7, 0xa3, 0xf0, 0x4e, 0x2b, 0xfb, 0x50, 0x72, 0xe6, 0xa3,
-	0xc0, 0xb7, 0x28, 0x35, 0x50, 0x99, 0x33, 0x4d, 0x8e, 0xd4, 0xfe, 0x35, 0x0f, 0x72, 0xc7, 0xd4,
-	0x92, 0x4c, 0xb0, 0x31, 0xf7, 0x53, 0xe7, 0x9d, 0x8c, 0x86, 0x52, 0x6f, 0xbc, 0xfc, 0xee, 0x75,
-	0xa2, 0xe2, 0x5b, 0x6c, 0x82, 0x8d, 0xb9, 0x17, 0xff, 0x8a, 0x2a, 0xc9, 0xa8, 0xab, 0xaa, 0x64,
-	0x3f, 0x72, 0x92, 0x0b, 0xa4, 0x8c, 0x07, 0x6e, 0x37, 0x3b, 0x47, 0x3a, 0x52, 0x7e, 0xef, 0xba,
-	0x91, 0x71, 0xc5, 0x1e, 0x28, 0x4f, 0x5f, 0x8b, 0xc6, 0x22, 0x48, 0xba, 0x47, 0x3d, 0xf9, 0xfe,
-	0x92, 0x80, 0xd9, 0xb4, 0x53, 0x71, 0x6b, 0x2c, 0xc2, 0x60, 0x41, 0xda, 0xd4, 0xbd, 0x93, 0x20,
-	0x58, 0x4f, 0xde, 0xb9, 0x7b, 0xcb, 0x0e, 0x1c, 0xa4, 0x7f, 0x70, 0x8d, 0xa0, 0xb8, 0xc4, 0xa7,
-	0x60, 0x2d, 0x71, 0x4b, 0x94, 0x85, 0x04, 0xf2, 0x18, 0xb9, 0xb9, 0x3c, 0x66, 0x92, 0x5f, 0xce,
-	0x7f, 0x11, 0x28, 0xd9, 0xc1, 0xc7, 0xcf, 0xce, 0xeb, 0xc2, 0xf3, 0xf3, 0xba, 0xf0, 0xe7, 0x79,
-	0x5d, 0xf8, 0xfa, 0xa2, 0xbe, 0xf2, 0xfc, 0xa2, 0xbe, 0xf2, 0xc7, 0x45, 0x7d, 0xe5, 0x93, 0xfd,
-	0x19, 0x85, 0xb4, 0xa1, 0x83, 0x9f, 0x20, 0xca, 0xf6, 0x1c, 0xc4, 0x3e, 0x23, 0xde, 0x70, 0x6a,
-	0x18, 0x21, 0xd3, 0x42, 0x9e, 0xf6, 0x94, 0xdf, 0x1a, 0x2e, 0x9b, 0x27, 0x05, 0xfe, 0xf3, 0xff,
-	0xe1, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x92, 0xe9, 0x91, 0xd8, 0xcb, 0x0c, 0x00, 0x00,
+	// 1213 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xc6, 0x1b, 0x7f, 0x8c, 0xd3, 0x40, 0x96, 0x34, 0xb1, 0x37, 0xad, 0x1d, 0x36, 0x95,
+	0x1a, 0xb9, 0xcd, 0x2e, 0x71, 0x11, 0x91, 0xc2, 0x01, 0xc5, 0xa9, 0x04, 0x52, 0x14, 0xa9, 0xac,
+	0xeb, 0x0b, 0x07, 0x56, 0x63, 0xef, 0x64, 0x3d, 0xb2, 0xf7, 0x43, 0x3b, 0xb3, 0xa1, 0x91, 0x10,
+	0x42, 0x1c, 0x39, 0x55, 0x1c, 0x41, 0x42, 0x1c, 0x39, 0x46, 0x08, 0x0e, 0xfd, 0x0f, 0x2a, 0x4e,
+	0x15, 0x27, 0x4e, 0x05, 0x25, 0x87, 0x70, 0xe3, 0xce, 0x09, 0xed, 0xec, 0x87, 0xbf, 0xd6, 0x4e,
+	0xea, 0x34, 0x70, 0xb1, 0x77, 0xde, 0xfb, 0xcd, 0x9b, 0x37, 0xf3, 0xfb, 0xbd, 0x37, 0xbb, 0x40,
+	0xec, 0xe2, 0x43, 0x8a, 0x74, 0x6c, 0x61, 0xaa, 0x90, 0x8e, 0xa7, 0x1c, 0x6d, 0x29, 0xf4, 0x89,
+	0xec, 0xb8, 0x36, 0xb5, 0x85, 0xc5, 0x9e, 0x4f, 0x26, 0x1d, 0x4f, 0x3e, 0xda, 0x12, 0x57, 0x5a,
+	0x36, 0x31, 0x6d, 0xa2, 0x98, 0xc4, 0xf0, 0xa1, 0x26, 0x31, 0x02, 0xac, 0xb8, 0x64, 0xd8, 0x86,
+	0xcd, 0x1e, 0x15, 0xff, 0x29, 0xb4, 0x16, 0x03, 0xb8, 0x16, 0x38, 0x82, 0x41, 0xe8, 0x2a, 0x85,
+	0x91, 0x9a, 0x90, 0x20, 0xe5, 0x68, 0xab, 0x89, 0x28, 0xdc, 0x52, 0x5a, 0x36, 0xb6, 0x42, 0xff,
+	0x22, 0x34, 0xb1, 0x65, 0x2b, 0xec, 0x37, 0x34, 0x95, 0x0d, 0xdb, 0x36, 0xba, 0x48, 0x61, 0xa3,
+	0xa6, 0x77, 0xa8, 0x50, 0x6c, 0x22, 0x42, 0xa1, 0xe9, 0x84, 0x80, 0xdb, 0x09, 0x9b, 0x39, 0x76,
+	0x50, 0xb8, 0xa4, 0xf4, 0xf7, 0x2c, 0x58, 0x3c, 0x20, 0xc6, 0x9e, 0x8b, 0x20, 0x45, 0x8f, 0x5c,
+	0xfb, 0x08, 0xeb, 0xc8, 0x15, 0xde, 0x03, 0x39, 0xe8, 0xd1, 0xb6, 0xed, 0x62, 0x7a, 0x5c, 0xe0,
+	0xd6, 0xb8, 0x8d, 0x5c, 0xad, 0xf0, 0xdb, 0xcf, 0x9b, 0x4b, 0x61, 0xb6, 0xbb, 0xba, 0xee, 0x22,
+	0x42, 0xea, 0xd4, 0xc5, 0x96, 0xa1, 0xf6, 0xa0, 0x42, 0x15, 0x64, 0x60, 0xe0, 0x2b, 0xcc, 0x5e,
+	0x30, 0x2b, 0x02, 0x0a, 0x1f, 0x80, 0x05, 0x07, 0x1e, 0xdb, 0x1e, 0xd5, 0xa2, 0xa9, 0xa9, 0x0b,
+	0xa6, 0xde, 0x08, 0xf0, 0xa1, 0x51, 0x58, 0x05, 0x39, 0x13, 0x51, 0xa8, 0xb5, 0x21, 0x69, 0x17,
+	0xf8, 0x35, 0x6e, 0x63, 0x5e, 0xcd, 0xfa, 0x86, 0x8f, 0x20, 0x69, 0x0b, 0x2b, 0x20, 0x03, 0x1d,
+	0xac, 0x79, 0x6e, 0xb7, 0x30, 0xe7, 0x87, 0x55, 0xd3, 0xd0, 0xc1, 0x0d, 0xb7, 0x2b, 0xd4, 0x00,
+	0x80, 0x94, 0xba, 0xb8, 0xe9, 0x51, 0x44, 0x0a, 0xe9, 0xb5, 0xd4, 0x46, 0xbe, 0x7a, 0x4b, 0x1e,
+	0x61, 0x57, 0xde, 0x8d, 0x40, 0x35, 0xfe, 0xf9, 0xcb, 0xf2, 0x8c, 0xda, 0x37, 0x6b, 0xe7, 0xc1,
+	0x5f, 0x3f, 0x94, 0x67, 0xbe, 0x3a, 0x3f, 0xa9, 0xf4, 0x8e, 0xe0, 0xeb, 0xf3, 0x93, 0xca, 0xad,
+	0x20, 0x0a, 0x3b, 0xee, 0x91, 0xb3, 0x95, 0x14, 0x50, 0x1c, 0x31, 0xaa, 0x88, 0x38, 0xb6, 0x45,
+	0x90, 0x20, 0x00, 0xde, 0xf3, 0xb0, 0x1e, 0x9c, 0xb9, 0xca, 0x9e, 0xa5, 0xef, 0x52, 0x8c, 0xa2,
+	0x86, 0xa3, 0xbf, 0x0e, 0x8a, 0xa2, 0x15, 0x66, 0x7b, 0x2b, 0xf4, 0xd3, 0x96, 0x9a, 0x9e, 0x36,
+	0xfe, 0x0a, 0xb4, 0xcd, 0x0d, 0xd1, 0xb6, 0x0c, 0xd2, 0xb0, 0x45, 0xf1, 0x11, 0x2a, 0xa4, 0xd7,
+	0xb8, 0x8d, 0xac, 0x1a, 0x8e, 0xfa, 0xe9, 0xcc, 0x4c, 0xa0, 0x33, 0x7b, 0x8d, 0x74, 0x0e, 0xf2,
+	0x20, 0xad, 0x82, 0xe2, 0x88, 0x31, 0xa2, 0x53, 0xfa, 0x89, 0x03, 0x37, 0x0f, 0x88, 0xf1, 0x10,
+	0xb1, 0xf4, 0xaf, 0x8b, 0xbe, 0x25, 0x30, 0xd7, 0xc5, 0x26, 0xa6, 0x8c, 0x3c, 0x5e, 0x0d, 0x06,
+	0x3b, 0xdb, 0xc9, 0xbb, 0x59, 0x1b, 0xdc, 0xcd, 0x68, 0x6a, 0x92, 0x05, 0x6e, 0x27, 0x3a, 0x62,
+	0x91, 0x56, 0xc1, 0x4d, 0x3d, 0xf6, 0xea, 0x1a, 0xe9, 0x78, 0x5a, 0xcb, 0xf6, 0x2c, 0xca, 0xf6,
+	0xc1, 0xab, 0x6f, 0xf5, 0x39, 0xeb, 0x1d, 0x6f, 0xcf, 0x77, 0x09, 0x45, 0x90, 0x6d, 0x43, 0xa2,
+	0x99, 0xb6, 0x8b, 0x58, 0xee, 0x59, 0x35, 0xd3, 0x86, 0xe4, 0xc0, 0x76, 0x91, 0xf4, 0x3d, 0x0f,
+	0xe6, 0xe3, 0x8a, 0xa8, 0xef, 0x37, 0xa6, 0x3e, 0x9b, 0x75, 0x70, 0xc3, 0x09, 0x73, 0xd5, 0xfa,
+	0x0e, 0x69, 0x3e, 0x32, 0x36, 0xfc, 0xc3, 0x12, 0x00, 0x6f, 0x41, 0x13, 0x05, 0x42, 0x57, 0xd9,
+	0xb3, 0x70, 0x0f, 0xf0, 0x9e, 0x85, 0x29, 0x53, 0xf0, 0x42, 0x75, 0x25, 0x41, 0x36, 0x0d, 0x0b,
+	0x53, 0x95, 0x81, 0x84, 0x2f, 0x00, 0xf0, 0xfb, 0xb3, 0xe6, 0xb8, 0xb8, 0x85, 0x98, 0x70, 0xf3,
+	0xd5, 0xa2, 0x1c, 0xe6, 0xe6, 0x7b, 0xe4, 0xb0, 0x73, 0xcb, 0x7b, 0x36, 0xb6, 0x6a, 0x0f, 0x7d,
+	0x99, 0xfd, 0xf3, 0xb2, 0x7c, 0xd7, 0xc0, 0xb4, 0xed, 0x35, 0xe5, 0x96, 0x6d, 0x86, 0x4d, 0x3f,
+	0xfc, 0xdb, 0x24, 0x7a, 0x27, 0x6c, 0xc9, 0xfe, 0x84, 0x6f, 0xcf, 0x4f, 0x2a, 0xf9, 0x2e, 0x32,
+	0x60, 0xeb, 0x58, 0xf3, 0x5b, 0xff, 0x8f, 0xe7, 0x27, 0x15, 0x4e, 0xcd, 0xf9, 0x81, 0x1f, 0xf9,
+	0x2b, 0x0e, 0xd6, 0x4d, 0x7a, 0xa8, 0x6e, 0xf6, 0x40, 0x9e, 0xe5, 0xa5, 0x51, 0x8c, 0x5c, 0x52,
+	0xc8, 0x8c, 0xad, 0x03, 0x16, 0xeb, 0x31, 0x46, 0x6e, 0x54, 0x07, 0x4e, 0x64, 0x20, 0x82, 0x08,
+	0xb2, 0x2d, 0xe8, 0xc0, 0x96, 0x7f, 0xfc, 0x59, 0x46, 0x69, 0x3c, 0x1e, 0xaa, 0xb3, 0xdc, 0x54,
+	0x75, 0x26, 0x27, 0x2b, 0x73, 0x25, 0xa9, 0x6d, 0xd6, 0xf7, 0x1b, 0x52, 0x05, 0x2c, 0xf5, 0x8f,
+	0x27, 0x36, 0xcb, 0x5f, 0x03, 0x31, 0x05, 0xf5, 0x78, 0x15, 0x31, 0x25, 0x15, 0xda, 0x88, 0xc0,
+	0x52, 0x13, 0x04, 0xc6, 0x27, 0x08, 0x6c, 0xee, 0xd5, 0x05, 0x96, 0xfe, 0x7f, 0x05, 0x96, 0x19,
+	0xdb, 0x98, 0xb3, 0x03, 0x8d, 0x79, 0x48, 0x78, 0xb9, 0x2b, 0x0b, 0x0f, 0x4c, 0x14, 0x5e, 0xfe,
+	0x1a, 0x85, 0x17, 0x6b, 0x47, 0x5a, 0x06, 0x4b, 0xfd, 0xe3, 0xb8, 0xad, 0x7f, 0xc3, 0x81, 0x37,
+	0x07, 0x5a, 0xe4, 0x6b, 0x16, 0xda, 0x4e, 0x35, 0x39, 0xd1, 0xd5, 0x71, 0xbd, 0xdb, 0x4f, 0x56,
+	0x04, 0x85, 0x61, 0x5b, 0x9c, 0xf0, 0x2f, 0x1c, 0x78, 0xa3, 0x77, 0x4b, 0x41, 0x17, 0x9a, 0x64,
+	0xea, 0x7c, 0xb7, 0x41, 0xda, 0x61, 0x11, 0x0a, 0xb3, 0xa1, 0x34, 0x13, 0x48, 0x66, 0x80, 0x90,
+	0x81, 0x10, 0xbe, 0xb3, 0x95, 0xbc, 0x29, 0x31, 0xf1, 0x7a, 0x65, 0x53, 0xa4, 0x22, 0x58, 0x19,
+	0x32, 0xc5, 0x5b, 0x7a, 0x96, 0x62, 0x17, 0x6f, 0xbd, 0xd5, 0x46, 0xba, 0xd7, 0xf5, 0x77, 0xcb,
+	0x94, 0xb5, 0xd7, 0x86, 0x96, 0x81, 0xa6, 0xde, 0x5c, 0x11, 0x64, 0xfd, 0xeb, 0xac, 0x8f, 0x90,
+	0x0c, 0xe9, 0x78, 0xac, 0xae, 0x07, 0xcb, 0x32, 0xf5, 0x9f, 0x97, 0xe5, 0x50, 0x85, 0xf1, 0x53,
+	0x55, 0xd8, 0x87, 0x60, 0x1e, 0x1d, 0x1e, 0x22, 0x56, 0xb3, 0x1a, 0xa4, 0xe1, 0xf5, 0x25, 0xca,
+	0xc1, 0x57, 0x84, 0x1c, 0x7d, 0x45, 0xc8, 0x8f, 0xa3, 0xaf, 0x88, 0x5a, 0xd6, 0x8f, 0xf1, 0xf4,
+	0x8f, 0x32, 0xa7, 0xe6, 0xe3, 0x99, 0xbb, 0x74, 0xe7, 0xfd, 0x64, 0x32, 0xef, 0x0c, 0x92, 0x99,
+	0xcc, 0x8e, 0xb4, 0x0e, 0xde, 0x1e, 0xeb, 0x8c, 0x08, 0xae, 0x3e, 0x4b, 0x83, 0xd4, 0x01, 0x31,
+	0x04, 0x1d, 0x2c, 0x0c, 0x7d, 0x9d, 0xdc, 0x49, 0xd8, 0xf4, 0xc8, 0x2b, 0xb5, 0x78, 0xff, 0x32,
+	0xa8, 0xf8, 0x2e, 0xd1, 0xc1, 0xc2, 0xd0, 0x0b, 0xf6, 0x98, 0x55, 0x06, 0x51, 0xe2, 0xfd, 0xcb,
+	0xa0, 0xe2, 0x55, 0x1c, 0x20, 0x24, 0xbc, 0x0b, 0x6e, 0x24, 0xc7, 0x18, 0x45, 0x8a, 0xef, 0x5c,
+	0x16, 0x19, 0xaf, 0xd8, 0x00, 0xb9, 0xde, 0x8b, 0x55, 0x79, 0xd2, 0x91, 0xd4, 0xf7, 0x1b, 0xe2,
+	0xdd, 0x0b, 0x00, 0xfd, 0x61, 0x7b, 0x57, 0x6c, 0x79, 0xd2, 0x19, 0x4c, 0x08, 0x3b, 0xd2, 0x58,
+	0x05, 0x08, 0x6e, 0x0c, 0x36, 0xd5, 0xf5, 0x8b, 0x36, 0xec, 0x87, 0xbf, 0x77, 0x09, 0x50, 0xbc,
+	0xc4, 0xa7, 0x60, 0x7e, 0xa0, 0x0d, 0x4a, 0x13, 0x09, 0x64, 0x18, 0xb1, 0x72, 0x31, 0x26, 0x8e,
+	0xff, 0x39, 0x58, 0x1e, 0xd3, 0x93, 0xc6, 0x48, 0x25, 0x19, 0x2d, 0xbe, 0xfb, 0x2a, 0xe8, 0x68,
+	0x75, 0x71, 0xee, 0x4b, 0xbf, 0x6d, 0xd4, 0x3e, 0x7e, 0x7e, 0x5a, 0xe2, 0x5e, 0x9c, 0x96, 0xb8,
+	0x3f, 0x4f, 0x4b, 0xdc, 0xd3, 0xb3, 0xd2, 0xcc, 0x8b, 0xb3, 0xd2, 0xcc, 0xef, 0x67, 0xa5, 0x99,
+	0x4f, 0xb6, 0xfb, 0xda, 0x91, 0x09, 0x2d, 0x7c, 0x88, 0x08, 0xdd, 0xb4, 0x10, 0xfd, 0xcc, 0x76,
+	0x3b, 0x3d, 0x43, 0x17, 0xe9, 0x06, 0x72, 0x95, 0x27, 0xac, 0x8e, 0x59, 0x8f, 0x6a, 0xa6, 0x59,
+	0x6f, 0x78, 0xf0, 0xef, 0x00, 0x01, 0x19, 0x68, 0x21, 0x1d, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1012,6 +1232,10 @@ type MsgClient interface {
 	DeactivateSKU(ctx context.Context, in *MsgDeactivateSKU, opts ...grpc.CallOption) (*MsgDeactivateSKUResponse, error)
 	// UpdateParams updates the module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ScheduleSKUPriceChange schedules a SKU's base price and price tiers to
+	// change at a future time, replacing any change already pending for it.
+	// Existing leases keep their locked prices.
+	ScheduleSKUPriceChange(ctx context.Context, in *MsgScheduleSKUPriceChange, opts ...grpc.CallOption) (*MsgScheduleSKUPriceChangeResponse, error)
 }
 
 type msgClient struct {
@@ -1085,6 +1309,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ScheduleSKUPriceChange(ctx context.Context, in *MsgScheduleSKUPriceChange, opts ...grpc.CallOption) (*MsgScheduleSKUPriceChangeResponse, error) {
+	out := new(MsgScheduleSKUPriceChangeResponse)
+	err := c.cc.Invoke(ctx, "/liftedinit.sku.v1.Msg/ScheduleSKUPriceChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateProvider creates a new provider.
@@ -1107,6 +1340,10 @@ type MsgServer interface {
 	DeactivateSKU(context.Context, *MsgDeactivateSKU) (*MsgDeactivateSKUResponse, error)
 	// UpdateParams updates the module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ScheduleSKUPriceChange schedules a SKU's base price and price tiers to
+	// change at a future time, replacing any change already pending for it.
+	// Existing leases keep their locked prices.
+	ScheduleSKUPriceChange(context.Context, *MsgScheduleSKUPriceChange) (*MsgScheduleSKUPriceChangeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1134,6 +1371,9 @@ func (*UnimplementedMsgServer) DeactivateSKU(ctx context.Context, req *MsgDeacti
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) ScheduleSKUPriceChange(ctx context.Context, req *MsgScheduleSKUPriceChange) (*MsgScheduleSKUPriceChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleSKUPriceChange not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1265,6 +1505,25 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleSKUPriceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleSKUPriceChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleSKUPriceChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/liftedinit.sku.v1.Msg/ScheduleSKUPriceChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleSKUPriceChange(ctx, req.(*MsgScheduleSKUPriceChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "liftedinit.sku.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1297,6 +1556,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ScheduleSKUPriceChange",
+			Handler:    _Msg_ScheduleSKUPriceChange_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "liftedinit/sku/v1/tx.proto",
@@ -1322,6 +1585,20 @@ func (m *MsgCreateProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.ApiUrl) > 0 {
 		i -= len(m.ApiUrl)
 		copy(dAtA[i:], m.ApiUrl)
@@ -1410,6 +1687,20 @@ func (m *MsgUpdateProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.ApiUrl) > 0 {
 		i -= len(m.ApiUrl)
 		copy(dAtA[i:], m.ApiUrl)
@@ -1588,6 +1879,39 @@ func (m *MsgCreateSKU) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Capacity != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Capacity))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.PriceTiers) > 0 {
+		for iNdEx := len(m.PriceTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.MetaHash) > 0 {
 		i -= len(m.MetaHash)
 		copy(dAtA[i:], m.MetaHash)
@@ -1684,6 +2008,39 @@ func (m *MsgUpdateSKU) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.Capacity != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Capacity))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.PriceTiers) > 0 {
+		for iNdEx := len(m.PriceTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Active {
 		i--
 		if m.Active {
@@ -1893,6 +2250,98 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgScheduleSKUPriceChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleSKUPriceChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleSKUPriceChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EffectiveAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveAt):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.PriceTiers) > 0 {
+		for iNdEx := len(m.PriceTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.BasePrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.SkuUuid) > 0 {
+		i -= len(m.SkuUuid)
+		copy(dAtA[i:], m.SkuUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SkuUuid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleSKUPriceChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleSKUPriceChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleSKUPriceChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1930,6 +2379,12 @@ func (m *MsgCreateProvider) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1979,6 +2434,12 @@ func (m *MsgUpdateProvider) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2053,6 +2514,21 @@ func (m *MsgCreateSKU) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.PriceTiers) > 0 {
+		for _, e := range m.PriceTiers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Capacity != 0 {
+		n += 1 + sovTx(uint64(m.Capacity))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2103,6 +2579,21 @@ func (m *MsgUpdateSKU) Size() (n int) {
 	if m.Active {
 		n += 2
 	}
+	if len(m.PriceTiers) > 0 {
+		for _, e := range m.PriceTiers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Capacity != 0 {
+		n += 1 + sovTx(uint64(m.Capacity))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2165,25 +2656,61 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *MsgCreateProvider) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
+func (m *MsgScheduleSKUPriceChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SkuUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BasePrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.PriceTiers) > 0 {
+		for _, e := range m.PriceTiers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveAt)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgScheduleSKUPriceChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
 			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
@@ -2362,6 +2889,40 @@ func (m *MsgCreateProvider) Unmarshal(dAtA []byte) error {
 			}
 			m.ApiUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2708,6 +3269,40 @@ func (m *MsgUpdateProvider) Unmarshal(dAtA []byte) error {
 			}
 			m.ApiUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3212,6 +3807,93 @@ func (m *MsgCreateSKU) Unmarshal(dAtA []byte) error {
 				m.MetaHash = []byte{}
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceTiers = append(m.PriceTiers, PriceTier{})
+			if err := m.PriceTiers[len(m.PriceTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capacity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3578,6 +4260,93 @@ func (m *MsgUpdateSKU) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Active = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceTiers = append(m.PriceTiers, PriceTier{})
+			if err := m.PriceTiers[len(m.PriceTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capacity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3978,6 +4747,270 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgScheduleSKUPriceChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleSKUPriceChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleSKUPriceChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkuUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SkuUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasePrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BasePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceTiers = append(m.PriceTiers, PriceTier{})
+			if err := m.PriceTiers[len(m.PriceTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EffectiveAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleSKUPriceChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleSKUPriceChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleSKUPriceChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
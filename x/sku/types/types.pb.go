@@ -11,15 +11,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -37,18 +41,36 @@ const (
 	Unit_UNIT_PER_HOUR Unit = 1
 	// UNIT_PER_DAY is a per-day billing unit.
 	Unit_UNIT_PER_DAY Unit = 2
+	// UNIT_PER_USAGE is a metered billing unit: base_price is charged per unit
+	// of usage reported by the provider rather than over time.
+	Unit_UNIT_PER_USAGE Unit = 3
+	// UNIT_PER_MINUTE is a per-minute billing unit.
+	Unit_UNIT_PER_MINUTE Unit = 4
+	// UNIT_PER_WEEK is a per-week billing unit.
+	Unit_UNIT_PER_WEEK Unit = 5
+	// UNIT_PER_MONTH is a per-calendar-month billing unit: base_price is charged
+	// once per UTC calendar month, spread over the month's actual length.
+	Unit_UNIT_PER_MONTH Unit = 6
 )
 
 var Unit_name = map[int32]string{
 	0: "UNIT_UNSPECIFIED",
 	1: "UNIT_PER_HOUR",
 	2: "UNIT_PER_DAY",
+	3: "UNIT_PER_USAGE",
+	4: "UNIT_PER_MINUTE",
+	5: "UNIT_PER_WEEK",
+	6: "UNIT_PER_MONTH",
 }
 
 var Unit_value = map[string]int32{
 	"UNIT_UNSPECIFIED": 0,
 	"UNIT_PER_HOUR":    1,
 	"UNIT_PER_DAY":     2,
+	"UNIT_PER_USAGE":   3,
+	"UNIT_PER_MINUTE":  4,
+	"UNIT_PER_WEEK":    5,
+	"UNIT_PER_MONTH":   6,
 }
 
 func (x Unit) String() string {
@@ -59,18 +81,169 @@ func (Unit) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_1c58681cc8b08534, []int{0}
 }
 
+// AttributeType defines the value type of a catalog attribute.
+type AttributeType int32
+
+const (
+	// ATTRIBUTE_TYPE_UNSPECIFIED is the default unspecified type.
+	AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED AttributeType = 0
+	// ATTRIBUTE_TYPE_STRING values are free text, matched by equality.
+	AttributeType_ATTRIBUTE_TYPE_STRING AttributeType = 1
+	// ATTRIBUTE_TYPE_UINT values are unsigned integers in canonical decimal
+	// form (no sign, no leading zeros), matched by equality or range.
+	AttributeType_ATTRIBUTE_TYPE_UINT AttributeType = 2
+)
+
+var AttributeType_name = map[int32]string{
+	0: "ATTRIBUTE_TYPE_UNSPECIFIED",
+	1: "ATTRIBUTE_TYPE_STRING",
+	2: "ATTRIBUTE_TYPE_UINT",
+}
+
+var AttributeType_value = map[string]int32{
+	"ATTRIBUTE_TYPE_UNSPECIFIED": 0,
+	"ATTRIBUTE_TYPE_STRING":      1,
+	"ATTRIBUTE_TYPE_UINT":        2,
+}
+
+func (x AttributeType) String() string {
+	return proto.EnumName(AttributeType_name, int32(x))
+}
+
+func (AttributeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1c58681cc8b08534, []int{1}
+}
+
+// AttributeDefinition declares an attribute key that providers and SKUs may
+// set, and the type of its values.
+type AttributeDefinition struct {
+	// key is the attribute name, e.g. "region" or "memory_mb". It must start
+	// with a lowercase letter and contain only lowercase letters, digits and
+	// underscores.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value_type is the type of the attribute's values.
+	ValueType AttributeType `protobuf:"varint,2,opt,name=value_type,json=valueType,proto3,enum=liftedinit.sku.v1.AttributeType" json:"value_type,omitempty"`
+}
+
+func (m *AttributeDefinition) Reset()         { *m = AttributeDefinition{} }
+func (m *AttributeDefinition) String() string { return proto.CompactTextString(m) }
+func (*AttributeDefinition) ProtoMessage()    {}
+func (*AttributeDefinition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c58681cc8b08534, []int{0}
+}
+func (m *AttributeDefinition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttributeDefinition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttributeDefinition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttributeDefinition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeDefinition.Merge(m, src)
+}
+func (m *AttributeDefinition) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttributeDefinition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeDefinition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributeDefinition proto.InternalMessageInfo
+
+func (m *AttributeDefinition) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *AttributeDefinition) GetValueType() AttributeType {
+	if m != nil {
+		return m.ValueType
+	}
+	return AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED
+}
+
+// Attribute is a typed key/value property of a provider or SKU. The key must
+// be declared in Params.attribute_schema.
+type Attribute struct {
+	// key is the attribute name.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value is the attribute value, formatted for the key's type.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *Attribute) Reset()         { *m = Attribute{} }
+func (m *Attribute) String() string { return proto.CompactTextString(m) }
+func (*Attribute) ProtoMessage()    {}
+func (*Attribute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c58681cc8b08534, []int{1}
+}
+func (m *Attribute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Attribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Attribute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Attribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Attribute.Merge(m, src)
+}
+func (m *Attribute) XXX_Size() int {
+	return m.Size()
+}
+func (m *Attribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_Attribute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Attribute proto.InternalMessageInfo
+
+func (m *Attribute) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *Attribute) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 // Params defines the parameters for the sku module.
 type Params struct {
 	// allowed_list is the list of addresses allowed to manage SKUs
 	// in addition to the module authority.
 	AllowedList []string `protobuf:"bytes,1,rep,name=allowed_list,json=allowedList,proto3" json:"allowed_list,omitempty"`
+	// attribute_schema declares the attribute keys providers and SKUs may set.
+	// Removing a key does not strip it from existing entries, but they cannot
+	// be saved with it again.
+	AttributeSchema []AttributeDefinition `protobuf:"bytes,2,rep,name=attribute_schema,json=attributeSchema,proto3" json:"attribute_schema"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c58681cc8b08534, []int{0}
+	return fileDescriptor_1c58681cc8b08534, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -106,6 +279,13 @@ func (m *Params) GetAllowedList() []string {
 	return nil
 }
 
+func (m *Params) GetAttributeSchema() []AttributeDefinition {
+	if m != nil {
+		return m.AttributeSchema
+	}
+	return nil
+}
+
 // Provider defines a SKU provider entity.
 type Provider struct {
 	// uuid is the unique identifier for the provider (UUIDv7 format).
@@ -122,13 +302,16 @@ type Provider struct {
 	// Tenants use this URL to retrieve connection details for their leases.
 	// Must be a valid HTTPS URL.
 	ApiUrl string `protobuf:"bytes,6,opt,name=api_url,json=apiUrl,proto3" json:"api_url,omitempty"`
+	// attributes are the provider's catalog attributes, ordered by key, e.g.
+	// its region. At most one value per key.
+	Attributes []Attribute `protobuf:"bytes,7,rep,name=attributes,proto3" json:"attributes,omitempty"`
 }
 
 func (m *Provider) Reset()         { *m = Provider{} }
 func (m *Provider) String() string { return proto.CompactTextString(m) }
 func (*Provider) ProtoMessage()    {}
 func (*Provider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c58681cc8b08534, []int{1}
+	return fileDescriptor_1c58681cc8b08534, []int{3}
 }
 func (m *Provider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -199,6 +382,13 @@ func (m *Provider) GetApiUrl() string {
 	return ""
 }
 
+func (m *Provider) GetAttributes() []Attribute {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
 // SKU defines a stock keeping unit for on-chain billing.
 type SKU struct {
 	// uuid is the unique identifier for the SKU (UUIDv7 format).
@@ -215,13 +405,26 @@ type SKU struct {
 	MetaHash []byte `protobuf:"bytes,6,opt,name=meta_hash,json=metaHash,proto3" json:"meta_hash,omitempty"`
 	// active indicates whether the SKU is active.
 	Active bool `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+	// price_tiers are volume prices that replace base_price once a tenant
+	// holds at least min_quantity units, ordered by ascending min_quantity. A
+	// lease item is priced at the last tier reached by its quantity plus the
+	// tenant's quantity of this SKU in PENDING and ACTIVE leases, or at
+	// base_price below the first.
+	PriceTiers []PriceTier `protobuf:"bytes,8,rep,name=price_tiers,json=priceTiers,proto3" json:"price_tiers,omitempty"`
+	// capacity is the total quantity of this SKU that PENDING and ACTIVE leases
+	// may hold at once. Zero means unlimited.
+	Capacity uint64 `protobuf:"varint,9,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// attributes are the SKU's catalog attributes, ordered by key, e.g. its
+	// gpu_model or memory_mb. At most one value per key. They are indexed for
+	// Query/SearchSKUs.
+	Attributes []Attribute `protobuf:"bytes,10,rep,name=attributes,proto3" json:"attributes,omitempty"`
 }
 
 func (m *SKU) Reset()         { *m = SKU{} }
 func (m *SKU) String() string { return proto.CompactTextString(m) }
 func (*SKU) ProtoMessage()    {}
 func (*SKU) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c58681cc8b08534, []int{2}
+	return fileDescriptor_1c58681cc8b08534, []int{4}
 }
 func (m *SKU) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -299,64 +502,392 @@ func (m *SKU) GetActive() bool {
 	return false
 }
 
+func (m *SKU) GetPriceTiers() []PriceTier {
+	if m != nil {
+		return m.PriceTiers
+	}
+	return nil
+}
+
+func (m *SKU) GetCapacity() uint64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *SKU) GetAttributes() []Attribute {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+// PriceTier is the price of a SKU from a quantity threshold upwards.
+type PriceTier struct {
+	// min_quantity is the smallest tenant quantity priced at this tier. It
+	// must be greater than 1, since smaller quantities use the SKU's
+	// base_price.
+	MinQuantity uint64 `protobuf:"varint,1,opt,name=min_quantity,json=minQuantity,proto3" json:"min_quantity,omitempty"`
+	// price is the price per unit at this tier, in the SKU's base_price denom.
+	Price github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=price,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin" json:"price"`
+}
+
+func (m *PriceTier) Reset()         { *m = PriceTier{} }
+func (m *PriceTier) String() string { return proto.CompactTextString(m) }
+func (*PriceTier) ProtoMessage()    {}
+func (*PriceTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c58681cc8b08534, []int{5}
+}
+func (m *PriceTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceTier.Merge(m, src)
+}
+func (m *PriceTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceTier proto.InternalMessageInfo
+
+func (m *PriceTier) GetMinQuantity() uint64 {
+	if m != nil {
+		return m.MinQuantity
+	}
+	return 0
+}
+
+func (m *PriceTier) GetPrice() github_com_cosmos_cosmos_sdk_types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return github_com_cosmos_cosmos_sdk_types.Coin{}
+}
+
+// PriceHistoryEntry records a price a SKU took: its base_price and
+// price_tiers as of a block. An entry is written when a SKU is created and
+// whenever its price changes, whether directly through MsgUpdateSKU or when a
+// scheduled change takes effect.
+type PriceHistoryEntry struct {
+	// id is the chain-wide sequence number of the entry.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty,string"`
+	// sku_uuid is the UUID of the SKU.
+	SkuUuid string `protobuf:"bytes,2,opt,name=sku_uuid,json=skuUuid,proto3" json:"sku_uuid,omitempty"`
+	// base_price is the SKU's base price from changed_at.
+	BasePrice github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=base_price,json=basePrice,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin" json:"base_price"`
+	// price_tiers are the SKU's volume prices from changed_at.
+	PriceTiers []PriceTier `protobuf:"bytes,4,rep,name=price_tiers,json=priceTiers,proto3" json:"price_tiers,omitempty"`
+	// changed_at is the block time at which the price took effect.
+	ChangedAt time.Time `protobuf:"bytes,5,opt,name=changed_at,json=changedAt,proto3,stdtime" json:"changed_at"`
+}
+
+func (m *PriceHistoryEntry) Reset()         { *m = PriceHistoryEntry{} }
+func (m *PriceHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*PriceHistoryEntry) ProtoMessage()    {}
+func (*PriceHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c58681cc8b08534, []int{6}
+}
+func (m *PriceHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceHistoryEntry.Merge(m, src)
+}
+func (m *PriceHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceHistoryEntry proto.InternalMessageInfo
+
+func (m *PriceHistoryEntry) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PriceHistoryEntry) GetSkuUuid() string {
+	if m != nil {
+		return m.SkuUuid
+	}
+	return ""
+}
+
+func (m *PriceHistoryEntry) GetBasePrice() github_com_cosmos_cosmos_sdk_types.Coin {
+	if m != nil {
+		return m.BasePrice
+	}
+	return github_com_cosmos_cosmos_sdk_types.Coin{}
+}
+
+func (m *PriceHistoryEntry) GetPriceTiers() []PriceTier {
+	if m != nil {
+		return m.PriceTiers
+	}
+	return nil
+}
+
+func (m *PriceHistoryEntry) GetChangedAt() time.Time {
+	if m != nil {
+		return m.ChangedAt
+	}
+	return time.Time{}
+}
+
+// PendingPriceChange is a price change scheduled for a SKU. In the first
+// block at or after effective_at, the EndBlocker replaces the SKU's
+// base_price and price_tiers with the ones below. A SKU has at most one
+// pending change.
+type PendingPriceChange struct {
+	// sku_uuid is the UUID of the SKU.
+	SkuUuid string `protobuf:"bytes,1,opt,name=sku_uuid,json=skuUuid,proto3" json:"sku_uuid,omitempty"`
+	// base_price is the base price the SKU takes at effective_at.
+	BasePrice github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=base_price,json=basePrice,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin" json:"base_price"`
+	// price_tiers are the volume prices the SKU takes at effective_at.
+	PriceTiers []PriceTier `protobuf:"bytes,3,rep,name=price_tiers,json=priceTiers,proto3" json:"price_tiers,omitempty"`
+	// effective_at is the time from which the new price applies.
+	EffectiveAt time.Time `protobuf:"bytes,4,opt,name=effective_at,json=effectiveAt,proto3,stdtime" json:"effective_at"`
+	// scheduled_at is the block time at which the change was scheduled.
+	ScheduledAt time.Time `protobuf:"bytes,5,opt,name=scheduled_at,json=scheduledAt,proto3,stdtime" json:"scheduled_at"`
+	// scheduled_by is the address that scheduled the change.
+	ScheduledBy string `protobuf:"bytes,6,opt,name=scheduled_by,json=scheduledBy,proto3" json:"scheduled_by,omitempty"`
+}
+
+func (m *PendingPriceChange) Reset()         { *m = PendingPriceChange{} }
+func (m *PendingPriceChange) String() string { return proto.CompactTextString(m) }
+func (*PendingPriceChange) ProtoMessage()    {}
+func (*PendingPriceChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c58681cc8b08534, []int{7}
+}
+func (m *PendingPriceChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingPriceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingPriceChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingPriceChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingPriceChange.Merge(m, src)
+}
+func (m *PendingPriceChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingPriceChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingPriceChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingPriceChange proto.InternalMessageInfo
+
+func (m *PendingPriceChange) GetSkuUuid() string {
+	if m != nil {
+		return m.SkuUuid
+	}
+	return ""
+}
+
+func (m *PendingPriceChange) GetBasePrice() github_com_cosmos_cosmos_sdk_types.Coin {
+	if m != nil {
+		return m.BasePrice
+	}
+	return github_com_cosmos_cosmos_sdk_types.Coin{}
+}
+
+func (m *PendingPriceChange) GetPriceTiers() []PriceTier {
+	if m != nil {
+		return m.PriceTiers
+	}
+	return nil
+}
+
+func (m *PendingPriceChange) GetEffectiveAt() time.Time {
+	if m != nil {
+		return m.EffectiveAt
+	}
+	return time.Time{}
+}
+
+func (m *PendingPriceChange) GetScheduledAt() time.Time {
+	if m != nil {
+		return m.ScheduledAt
+	}
+	return time.Time{}
+}
+
+func (m *PendingPriceChange) GetScheduledBy() string {
+	if m != nil {
+		return m.ScheduledBy
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("liftedinit.sku.v1.Unit", Unit_name, Unit_value)
+	proto.RegisterEnum("liftedinit.sku.v1.AttributeType", AttributeType_name, AttributeType_value)
+	proto.RegisterType((*AttributeDefinition)(nil), "liftedinit.sku.v1.AttributeDefinition")
+	proto.RegisterType((*Attribute)(nil), "liftedinit.sku.v1.Attribute")
 	proto.RegisterType((*Params)(nil), "liftedinit.sku.v1.Params")
 	proto.RegisterType((*Provider)(nil), "liftedinit.sku.v1.Provider")
 	proto.RegisterType((*SKU)(nil), "liftedinit.sku.v1.SKU")
+	proto.RegisterType((*PriceTier)(nil), "liftedinit.sku.v1.PriceTier")
+	proto.RegisterType((*PriceHistoryEntry)(nil), "liftedinit.sku.v1.PriceHistoryEntry")
+	proto.RegisterType((*PendingPriceChange)(nil), "liftedinit.sku.v1.PendingPriceChange")
 }
 
 func init() { proto.RegisterFile("liftedinit/sku/v1/types.proto", fileDescriptor_1c58681cc8b08534) }
 
 var fileDescriptor_1c58681cc8b08534 = []byte{
-	// 706 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xee, 0xd2, 0xfe, 0x0a, 0x1d, 0x4a, 0xd3, 0x2e, 0xfd, 0x85, 0x05, 0x63, 0xb7, 0xe1, 0x80,
-	0x4d, 0x43, 0x77, 0x53, 0x24, 0x31, 0xc1, 0x83, 0x52, 0x40, 0x21, 0x18, 0xac, 0xad, 0x7b, 0x50,
-	0x0f, 0x9b, 0x69, 0x77, 0x68, 0x27, 0xdd, 0x7f, 0xd9, 0x9d, 0x2d, 0xf6, 0x0b, 0x78, 0x30, 0x1e,
-	0x3c, 0x7b, 0xf2, 0xe8, 0x91, 0x83, 0x1f, 0x82, 0x23, 0xf1, 0xe4, 0x69, 0x43, 0xe0, 0x80, 0xe9,
-	0x47, 0xf0, 0x64, 0x66, 0x76, 0x80, 0x6d, 0x8c, 0x11, 0x2f, 0x6d, 0xdf, 0xe7, 0x79, 0xdf, 0x67,
-	0xde, 0x79, 0xde, 0x79, 0x0b, 0xee, 0x9a, 0xf8, 0x90, 0x20, 0x03, 0xdb, 0x98, 0xa8, 0xfe, 0x20,
-	0x50, 0x87, 0x75, 0x95, 0x8c, 0x5c, 0xe4, 0x2b, 0xae, 0xe7, 0x10, 0x47, 0x2c, 0xdc, 0xd0, 0x8a,
-	0x3f, 0x08, 0x94, 0x61, 0x7d, 0xa9, 0x00, 0x2d, 0x6c, 0x3b, 0x2a, 0xfb, 0x8c, 0xb2, 0x96, 0x4a,
-	0x5d, 0xc7, 0xb7, 0x1c, 0x5f, 0xed, 0x40, 0x1f, 0xa9, 0xc3, 0x7a, 0x07, 0x11, 0x58, 0x57, 0xbb,
-	0x0e, 0xb6, 0x39, 0xbf, 0x18, 0xf1, 0x3a, 0x8b, 0xd4, 0x28, 0xe0, 0x54, 0xb1, 0xe7, 0xf4, 0x9c,
-	0x08, 0xa7, 0xbf, 0x22, 0x74, 0x19, 0x82, 0x74, 0x13, 0x7a, 0xd0, 0xf2, 0xc5, 0x87, 0x20, 0x0b,
-	0x4d, 0xd3, 0x39, 0x42, 0x86, 0x6e, 0x62, 0x9f, 0x48, 0x42, 0x39, 0x59, 0xc9, 0x34, 0xa4, 0x6f,
-	0x5f, 0x6b, 0x45, 0xae, 0xb3, 0x69, 0x18, 0x1e, 0xf2, 0xfd, 0x36, 0xf1, 0xb0, 0xdd, 0x6b, 0xcd,
-	0xf2, 0xec, 0x67, 0xd8, 0x27, 0x1b, 0x4b, 0x3f, 0x3e, 0xcb, 0xc2, 0xfb, 0xcb, 0xe3, 0x2a, 0xbf,
-	0x06, 0xbb, 0x61, 0x24, 0xbc, 0xfc, 0x2e, 0x09, 0x66, 0x9a, 0x9e, 0x33, 0xc4, 0x06, 0xf2, 0xc4,
-	0x15, 0x90, 0x0a, 0x02, 0x6c, 0x48, 0x42, 0x59, 0xa8, 0x64, 0x1a, 0xe2, 0x38, 0x94, 0x73, 0x34,
-	0x5e, 0x75, 0x2c, 0x4c, 0x90, 0xe5, 0x92, 0x51, 0x8b, 0xf1, 0xe2, 0x53, 0x30, 0x0d, 0xa3, 0xe3,
-	0xa4, 0x29, 0x96, 0x5a, 0x1b, 0x87, 0x72, 0x81, 0x43, 0x37, 0xd9, 0x7f, 0xec, 0xee, 0xaa, 0x5a,
-	0x7c, 0x03, 0x72, 0x2e, 0x1c, 0x39, 0x01, 0xd1, 0xaf, 0xf4, 0x92, 0x4c, 0x6f, 0x7d, 0x1c, 0xca,
-	0xd2, 0x24, 0x73, 0x0b, 0xd9, 0xb9, 0xa8, 0x82, 0x83, 0xe2, 0x3a, 0xc8, 0x58, 0x88, 0x40, 0xbd,
-	0x0f, 0xfd, 0xbe, 0x94, 0x2a, 0x0b, 0x95, 0x6c, 0x63, 0x61, 0x1c, 0xca, 0xf3, 0xd7, 0x60, 0xec,
-	0x5e, 0x33, 0x14, 0xdc, 0x85, 0x7e, 0x5f, 0x5c, 0x05, 0x69, 0xd8, 0x25, 0x78, 0x88, 0xa4, 0xff,
-	0xca, 0x42, 0x65, 0xa6, 0x51, 0x1c, 0x87, 0x72, 0x3e, 0x42, 0x62, 0xf9, 0x3c, 0x47, 0x54, 0xc0,
-	0x34, 0x74, 0xb1, 0x1e, 0x78, 0xa6, 0x94, 0x66, 0x9d, 0xff, 0xcf, 0x9c, 0x88, 0xa0, 0x89, 0x7c,
-	0x17, 0x6b, 0x9e, 0xb9, 0x21, 0xd1, 0x31, 0xcc, 0xc7, 0xc7, 0xc0, 0xbd, 0x5f, 0x3e, 0x4b, 0x82,
-	0x64, 0x7b, 0x5f, 0xbb, 0xf5, 0x0c, 0x1e, 0x83, 0x39, 0x97, 0xd7, 0xea, 0xac, 0x20, 0x9a, 0xc4,
-	0x9d, 0x71, 0x28, 0x2f, 0x4c, 0x10, 0xb1, 0xca, 0xec, 0x15, 0xa1, 0x51, 0x85, 0x15, 0x90, 0xb2,
-	0xa1, 0x85, 0xb8, 0xe5, 0xec, 0x24, 0x1a, 0xc7, 0x4f, 0xa2, 0xb1, 0xf8, 0x08, 0xa4, 0x02, 0x1b,
-	0x13, 0x66, 0x61, 0x6e, 0x6d, 0x41, 0xf9, 0x6d, 0x17, 0x14, 0xcd, 0xc6, 0x84, 0xb7, 0x6a, 0x63,
-	0x32, 0xd1, 0xaa, 0x8d, 0x89, 0xf8, 0x41, 0x00, 0x80, 0xee, 0x84, 0xee, 0x7a, 0xb8, 0x1b, 0xf9,
-	0x3a, 0xbb, 0xb6, 0xa8, 0xf0, 0x19, 0x52, 0x46, 0xe1, 0xdb, 0xa2, 0x6c, 0x39, 0xd8, 0x6e, 0xb4,
-	0x4e, 0x42, 0x39, 0x31, 0x0e, 0xe5, 0x58, 0xd1, 0xcf, 0x50, 0xbe, 0xd7, 0xc3, 0xa4, 0x1f, 0x74,
-	0x94, 0xae, 0x63, 0xf1, 0xdd, 0xe1, 0x5f, 0x35, 0xdf, 0x18, 0xf0, 0x6d, 0xa5, 0xe5, 0x9f, 0x2e,
-	0x8f, 0xab, 0xb3, 0x26, 0xea, 0xc1, 0xee, 0x48, 0xa7, 0xcb, 0xf7, 0xe5, 0xf2, 0xb8, 0x2a, 0xb4,
-	0x32, 0x54, 0xab, 0x49, 0xa5, 0x26, 0xdf, 0x45, 0xfa, 0xdf, 0xdf, 0xc5, 0xf4, 0xdf, 0xdf, 0xc5,
-	0xc6, 0x3c, 0x9d, 0x73, 0x2e, 0x36, 0xe7, 0xf6, 0xbe, 0x56, 0xdd, 0x04, 0x29, 0xea, 0x94, 0x58,
-	0x04, 0x79, 0xed, 0x60, 0xef, 0xa5, 0xae, 0x1d, 0xb4, 0x9b, 0x3b, 0x5b, 0x7b, 0x4f, 0xf6, 0x76,
-	0xb6, 0xf3, 0x09, 0xb1, 0x00, 0xe6, 0x18, 0xda, 0xdc, 0x69, 0xe9, 0xbb, 0xcf, 0xb5, 0x56, 0x5e,
-	0x10, 0xf3, 0x20, 0x7b, 0x0d, 0x6d, 0x6f, 0xbe, 0xca, 0x4f, 0x35, 0x5e, 0x9c, 0x9c, 0x97, 0x84,
-	0xd3, 0xf3, 0x92, 0x70, 0x76, 0x5e, 0x12, 0x3e, 0x5e, 0x94, 0x12, 0xa7, 0x17, 0xa5, 0xc4, 0xf7,
-	0x8b, 0x52, 0xe2, 0xf5, 0x83, 0x98, 0x3d, 0x16, 0xb4, 0xf1, 0x21, 0xf2, 0x49, 0xcd, 0x46, 0xe4,
-	0xc8, 0xf1, 0x06, 0x37, 0x80, 0x89, 0x8c, 0x1e, 0xf2, 0xd4, 0xb7, 0xac, 0x27, 0xe6, 0x59, 0x27,
-	0xcd, 0xfe, 0x6b, 0xee, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x8b, 0xf5, 0xb5, 0x81, 0x03, 0x05,
-	0x00, 0x00,
+	// 1319 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xce, 0xda, 0xce, 0x87, 0xc7, 0x4e, 0xea, 0x8c, 0xd3, 0x66, 0x1b, 0x5a, 0xaf, 0x31, 0x52,
+	0x6b, 0xa2, 0x66, 0xad, 0x84, 0x4a, 0x48, 0x41, 0x08, 0xbc, 0xad, 0x69, 0xac, 0xd2, 0xd4, 0xf5,
+	0x87, 0xaa, 0xb6, 0x48, 0xab, 0xb1, 0x3d, 0x71, 0x46, 0xf6, 0xee, 0x9a, 0xdd, 0xd9, 0x14, 0x5f,
+	0xf8, 0x01, 0x88, 0x43, 0xb9, 0xc2, 0x85, 0x23, 0xc7, 0x1e, 0x38, 0xf1, 0x0b, 0x2a, 0x0e, 0xa8,
+	0xea, 0x89, 0xd3, 0x02, 0xe9, 0xa1, 0xc8, 0x3f, 0x81, 0x13, 0x9a, 0xd9, 0xb1, 0x3d, 0x76, 0x69,
+	0x09, 0x28, 0x12, 0x5c, 0xda, 0xcc, 0xf3, 0x7e, 0xec, 0xfb, 0xbe, 0xcf, 0x33, 0xaf, 0x07, 0x5c,
+	0xec, 0x91, 0x03, 0x8a, 0xdb, 0xc4, 0x26, 0xb4, 0xe0, 0x75, 0xfd, 0xc2, 0xd1, 0x76, 0x81, 0x0e,
+	0xfa, 0xd8, 0xd3, 0xfb, 0xae, 0x43, 0x1d, 0xb8, 0x3a, 0x31, 0xeb, 0x5e, 0xd7, 0xd7, 0x8f, 0xb6,
+	0x37, 0x56, 0x91, 0x45, 0x6c, 0xa7, 0xc0, 0xff, 0x0d, 0xbd, 0x36, 0x32, 0x2d, 0xc7, 0xb3, 0x1c,
+	0xaf, 0xd0, 0x44, 0x1e, 0x2e, 0x1c, 0x6d, 0x37, 0x31, 0x45, 0xdb, 0x85, 0x96, 0x43, 0x6c, 0x61,
+	0x3f, 0x1f, 0xda, 0x4d, 0x7e, 0x2a, 0x84, 0x07, 0x61, 0x5a, 0xeb, 0x38, 0x1d, 0x27, 0xc4, 0xd9,
+	0x5f, 0x02, 0xd5, 0x3a, 0x8e, 0xd3, 0xe9, 0xe1, 0x02, 0x3f, 0x35, 0xfd, 0x83, 0x02, 0x25, 0x16,
+	0xf6, 0x28, 0xb2, 0xfa, 0xa1, 0x43, 0xee, 0x1b, 0x05, 0xa4, 0x8b, 0x94, 0xba, 0xa4, 0xe9, 0x53,
+	0x7c, 0x1d, 0x1f, 0xb0, 0x02, 0x89, 0x63, 0xc3, 0xb7, 0x40, 0xb4, 0x8b, 0x07, 0xaa, 0x92, 0x55,
+	0xf2, 0x71, 0x63, 0x75, 0x18, 0x68, 0xcb, 0x5d, 0x3c, 0xb8, 0xe2, 0x58, 0x84, 0x62, 0xab, 0x4f,
+	0x07, 0x55, 0x66, 0x85, 0xf7, 0x00, 0x38, 0x42, 0x3d, 0x1f, 0x9b, 0xac, 0x53, 0x35, 0x92, 0x55,
+	0xf2, 0x2b, 0x3b, 0x59, 0xfd, 0xa5, 0x4e, 0xf5, 0xf1, 0x07, 0xea, 0x83, 0x3e, 0x36, 0xd4, 0x61,
+	0xa0, 0xad, 0x4d, 0xe2, 0xa4, 0xa4, 0x71, 0x8e, 0x32, 0xa7, 0xdd, 0xd8, 0xef, 0xdf, 0x6a, 0x4a,
+	0xee, 0x01, 0x88, 0x8f, 0x63, 0x4f, 0x56, 0xd2, 0xdb, 0x60, 0x9e, 0x27, 0xe1, 0xd5, 0xc4, 0x8d,
+	0xf4, 0x30, 0xd0, 0xce, 0x70, 0x40, 0x72, 0x0c, 0x3d, 0x72, 0x3f, 0x28, 0x60, 0xa1, 0x82, 0x5c,
+	0x64, 0x79, 0xf0, 0x3d, 0x90, 0x44, 0xbd, 0x9e, 0xf3, 0x10, 0xb7, 0xcd, 0x1e, 0xf1, 0xa8, 0xaa,
+	0x64, 0xa3, 0xf9, 0xb8, 0xa1, 0x3e, 0xfb, 0x7e, 0x6b, 0x4d, 0x0c, 0xb9, 0xd8, 0x6e, 0xbb, 0xd8,
+	0xf3, 0x6a, 0xd4, 0x25, 0x76, 0xa7, 0x9a, 0x10, 0xde, 0x1f, 0x13, 0x8f, 0xc2, 0xbb, 0x20, 0x85,
+	0x46, 0x45, 0x9a, 0x5e, 0xeb, 0x10, 0x5b, 0x48, 0x8d, 0x64, 0xa3, 0xf9, 0xc4, 0xce, 0xa5, 0xd7,
+	0xcd, 0x62, 0x32, 0x6c, 0x23, 0xf6, 0x24, 0xd0, 0xe6, 0xaa, 0x67, 0xc6, 0x59, 0x6a, 0x3c, 0xc9,
+	0xee, 0x06, 0x9b, 0xc1, 0x17, 0x2f, 0x1e, 0x6f, 0x0a, 0xf1, 0x70, 0x5d, 0x85, 0x15, 0xe7, 0x8e,
+	0xa3, 0x60, 0xa9, 0xe2, 0x3a, 0x47, 0xa4, 0x8d, 0x5d, 0x78, 0x09, 0xc4, 0x7c, 0x9f, 0xb4, 0xc5,
+	0x68, 0xe0, 0x30, 0xd0, 0x56, 0xd8, 0x59, 0x6a, 0x99, 0xdb, 0xe1, 0x0d, 0xb0, 0x88, 0xc2, 0x3e,
+	0xc4, 0x78, 0xb6, 0x86, 0x81, 0xb6, 0x2a, 0xa0, 0x89, 0xf7, 0x2b, 0xdb, 0x1e, 0x45, 0xc3, 0x07,
+	0x60, 0xa5, 0x8f, 0x06, 0x8e, 0x4f, 0xcd, 0x51, 0xbe, 0x28, 0xcf, 0x77, 0x75, 0x18, 0x68, 0xea,
+	0xb4, 0xe5, 0x04, 0x69, 0x97, 0xc3, 0x08, 0x01, 0xc2, 0xab, 0x20, 0x6e, 0x61, 0x8a, 0xcc, 0x43,
+	0xe4, 0x1d, 0xaa, 0xb1, 0xac, 0x92, 0x4f, 0x1a, 0xeb, 0xc3, 0x40, 0x4b, 0x8f, 0x41, 0xa9, 0xaf,
+	0x25, 0x06, 0xee, 0x21, 0xef, 0x10, 0x5e, 0x01, 0x0b, 0xa8, 0x45, 0xc9, 0x11, 0x56, 0xe7, 0xb3,
+	0x4a, 0x7e, 0xc9, 0x58, 0x1b, 0x06, 0x5a, 0x2a, 0x44, 0x24, 0x7f, 0xe1, 0x03, 0x75, 0xb0, 0x88,
+	0xfa, 0xc4, 0xf4, 0xdd, 0x9e, 0xba, 0xc0, 0x2b, 0x3f, 0xcb, 0x27, 0x11, 0x42, 0x53, 0xfe, 0x7d,
+	0xd2, 0x70, 0x7b, 0xf0, 0x3e, 0x00, 0x63, 0x76, 0x3c, 0x75, 0x91, 0xb3, 0x7b, 0xe1, 0x75, 0xec,
+	0x1a, 0x17, 0x18, 0xa7, 0x4c, 0xe9, 0x93, 0x38, 0x29, 0xaf, 0x94, 0x6d, 0x57, 0x65, 0x14, 0xa7,
+	0x65, 0x8a, 0x05, 0xaf, 0xb9, 0x9f, 0xe6, 0x41, 0xb4, 0x76, 0xb3, 0x71, 0x62, 0x7e, 0x3f, 0x04,
+	0xcb, 0x7d, 0x11, 0x6b, 0xf2, 0x80, 0x90, 0xe5, 0x37, 0x86, 0x81, 0xb6, 0x3e, 0x65, 0x90, 0x22,
+	0x93, 0x23, 0x43, 0x83, 0x65, 0xb8, 0x04, 0x62, 0x36, 0xb2, 0xb0, 0x1a, 0x9d, 0x7c, 0x89, 0x9d,
+	0xe5, 0x2f, 0xb1, 0x33, 0xfc, 0x00, 0xc4, 0x7c, 0x9b, 0x50, 0x4e, 0xcf, 0xca, 0xce, 0xfa, 0x5f,
+	0x4c, 0xa2, 0x61, 0x13, 0x2a, 0x4a, 0xb5, 0x09, 0x9d, 0x2a, 0xd5, 0x26, 0x14, 0x7e, 0xa9, 0x00,
+	0xc0, 0xb6, 0x9c, 0xd9, 0x77, 0x49, 0x2b, 0xe4, 0x2c, 0xb1, 0x73, 0x5e, 0x17, 0xfa, 0x60, 0x16,
+	0x5d, 0xec, 0x3f, 0xfd, 0x9a, 0x43, 0x6c, 0xa3, 0x2a, 0xc6, 0x29, 0x05, 0xfd, 0x11, 0x68, 0x97,
+	0x3b, 0x84, 0x1e, 0xfa, 0x4d, 0xbd, 0xe5, 0x58, 0x62, 0x1b, 0x8a, 0xff, 0xb6, 0xbc, 0x76, 0x57,
+	0xec, 0x5f, 0x16, 0xfe, 0xf5, 0x8b, 0xc7, 0x9b, 0x89, 0x1e, 0xee, 0xa0, 0xd6, 0xc0, 0x64, 0xeb,
+	0xf4, 0xbb, 0x17, 0x8f, 0x37, 0x95, 0x6a, 0x9c, 0xe5, 0xaa, 0xb0, 0x54, 0xd3, 0x9a, 0x5b, 0xf8,
+	0xe7, 0x9a, 0x5b, 0x3c, 0x81, 0xe6, 0x3e, 0x01, 0x09, 0x5e, 0xb7, 0x49, 0x09, 0x76, 0x3d, 0x75,
+	0xe9, 0x95, 0x22, 0xe2, 0x25, 0xd5, 0x09, 0x76, 0x8d, 0x8b, 0xa2, 0xeb, 0xb3, 0x52, 0xa0, 0xac,
+	0xa2, 0xfe, 0xc8, 0xd3, 0x83, 0x3b, 0x60, 0xa9, 0x85, 0xfa, 0xa8, 0x45, 0xe8, 0x40, 0x8d, 0x67,
+	0x95, 0x7c, 0xcc, 0x38, 0x37, 0x0c, 0x34, 0x38, 0xc2, 0xe4, 0xfa, 0x47, 0xd8, 0x8c, 0xaa, 0xc1,
+	0xa9, 0xaa, 0x3a, 0xcd, 0x54, 0xbd, 0x22, 0xa9, 0xba, 0x76, 0xb3, 0x91, 0xfb, 0x51, 0x01, 0xf1,
+	0x71, 0x77, 0xf0, 0x7d, 0x90, 0xb4, 0x88, 0x6d, 0x7e, 0xea, 0x23, 0x9b, 0xb2, 0xb2, 0x15, 0x5e,
+	0xf6, 0xc6, 0x30, 0xd0, 0xce, 0xc9, 0xb8, 0xf4, 0x81, 0x84, 0x45, 0xec, 0x3b, 0x02, 0x86, 0x9f,
+	0x83, 0xf9, 0x50, 0x3c, 0x91, 0xbf, 0x13, 0xcf, 0x2d, 0x51, 0xf5, 0xfc, 0xe9, 0xe8, 0x26, 0x4c,
+	0x93, 0x7b, 0x16, 0x05, 0xab, 0xbc, 0x99, 0x3d, 0xe2, 0x51, 0xc7, 0x1d, 0x94, 0x6c, 0xea, 0x0e,
+	0xe0, 0x65, 0x10, 0x11, 0x37, 0x35, 0x16, 0x4a, 0x48, 0xbe, 0x6d, 0x57, 0xbc, 0x70, 0xe3, 0x45,
+	0x48, 0x1b, 0x6e, 0x83, 0x25, 0xaf, 0xeb, 0xcb, 0xf7, 0x94, 0x13, 0x36, 0xc2, 0xa4, 0xae, 0x17,
+	0xbd, 0xae, 0xcf, 0x6f, 0xe7, 0xcc, 0xa5, 0x89, 0xfe, 0xc7, 0x97, 0x66, 0x46, 0xd0, 0xb1, 0xd3,
+	0x15, 0xf4, 0x1d, 0x00, 0x5a, 0x87, 0xc8, 0xee, 0xe0, 0xb6, 0x89, 0xa8, 0x58, 0x10, 0x1b, 0x7a,
+	0xf8, 0x9e, 0xd1, 0x47, 0xef, 0x19, 0xbd, 0x3e, 0x7a, 0xcf, 0x18, 0xe7, 0x46, 0xcd, 0x4e, 0xa2,
+	0x1e, 0xfd, 0xa2, 0x29, 0xd5, 0xb8, 0x38, 0x17, 0xe9, 0xee, 0x9b, 0x4c, 0x93, 0x17, 0xa6, 0x36,
+	0xed, 0x0c, 0x7d, 0xb9, 0xdf, 0x62, 0x00, 0x56, 0xb0, 0xdd, 0x26, 0x76, 0x87, 0x1b, 0xaf, 0xf1,
+	0xe0, 0x29, 0xb2, 0x94, 0x7f, 0x45, 0x56, 0xe4, 0xff, 0x45, 0x56, 0xf4, 0x74, 0xc9, 0xba, 0x0b,
+	0x92, 0xf8, 0xe0, 0x00, 0xf3, 0x45, 0x67, 0xa2, 0xf0, 0x77, 0xe1, 0xf5, 0x74, 0xa9, 0x22, 0xf9,
+	0x54, 0x1c, 0x27, 0x2c, 0x31, 0x46, 0x8a, 0xec, 0x71, 0x95, 0x64, 0x4f, 0xaa, 0xb6, 0xdf, 0x3b,
+	0xa9, 0x0e, 0xc6, 0x89, 0xe5, 0xb8, 0x30, 0xf1, 0x18, 0x29, 0x52, 0xd8, 0x90, 0x13, 0x37, 0x07,
+	0xe2, 0x19, 0xb0, 0xc3, 0x96, 0x8f, 0x8c, 0x9f, 0xe0, 0xf9, 0x32, 0x49, 0x6b, 0x0c, 0x76, 0x73,
+	0x4c, 0x62, 0x17, 0x65, 0x89, 0xbd, 0x24, 0xa6, 0xcd, 0xaf, 0x14, 0x10, 0x63, 0x3f, 0x8f, 0x70,
+	0x0d, 0xa4, 0x1a, 0xfb, 0xe5, 0xba, 0xd9, 0xd8, 0xaf, 0x55, 0x4a, 0xd7, 0xca, 0x1f, 0x95, 0x4b,
+	0xd7, 0x53, 0x73, 0x70, 0x15, 0x2c, 0x73, 0xb4, 0x52, 0xaa, 0x9a, 0x7b, 0xb7, 0x1b, 0xd5, 0x94,
+	0x02, 0x53, 0x20, 0x39, 0x86, 0xae, 0x17, 0xef, 0xa5, 0x22, 0x10, 0x82, 0x95, 0x31, 0xd2, 0xa8,
+	0x15, 0x6f, 0x94, 0x52, 0x51, 0x98, 0x06, 0x67, 0xc6, 0xd8, 0xad, 0xf2, 0x7e, 0xa3, 0x5e, 0x4a,
+	0xc5, 0xa6, 0xb2, 0xdd, 0x2d, 0x95, 0x6e, 0xa6, 0xe6, 0xa7, 0x62, 0x6f, 0xdd, 0xde, 0xaf, 0xef,
+	0xa5, 0x16, 0x36, 0x5b, 0x60, 0x79, 0xea, 0x95, 0x0e, 0x33, 0x60, 0xa3, 0x58, 0xaf, 0x57, 0xcb,
+	0x46, 0xa3, 0x5e, 0x32, 0xeb, 0xf7, 0x2a, 0xa5, 0x99, 0x2a, 0xcf, 0x83, 0xb3, 0x33, 0xf6, 0x5a,
+	0xbd, 0x5a, 0xde, 0xbf, 0x91, 0x52, 0xe0, 0x3a, 0x48, 0xcf, 0x86, 0x96, 0xf7, 0xeb, 0xa9, 0x88,
+	0x71, 0xe7, 0xc9, 0x71, 0x46, 0x79, 0x7a, 0x9c, 0x51, 0x7e, 0x3d, 0xce, 0x28, 0x8f, 0x9e, 0x67,
+	0xe6, 0x9e, 0x3e, 0xcf, 0xcc, 0xfd, 0xfc, 0x3c, 0x33, 0x77, 0xff, 0x5d, 0x49, 0xe6, 0x16, 0xb2,
+	0xc9, 0x01, 0xf6, 0xe8, 0x96, 0x8d, 0xe9, 0x43, 0xc7, 0xed, 0x4e, 0x80, 0x1e, 0x6e, 0x77, 0xb0,
+	0x5b, 0xf8, 0x8c, 0x0f, 0x96, 0x6b, 0xbf, 0xb9, 0xc0, 0x15, 0xf0, 0xce, 0x9f, 0x03, 0x00, 0xf1,
+	0xed, 0x6d, 0x68, 0x7f, 0x0d, 0x00, 0x00,
 }
 
+func (this *AttributeDefinition) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AttributeDefinition)
+	if !ok {
+		that2, ok := that.(AttributeDefinition)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.ValueType != that1.ValueType {
+		return false
+	}
+	return true
+}
 func (this *Params) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -384,8 +915,88 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.AttributeSchema) != len(that1.AttributeSchema) {
+		return false
+	}
+	for i := range this.AttributeSchema {
+		if !this.AttributeSchema[i].Equal(&that1.AttributeSchema[i]) {
+			return false
+		}
+	}
 	return true
 }
+func (m *AttributeDefinition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttributeDefinition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeDefinition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValueType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ValueType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Attribute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Attribute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -406,6 +1017,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AttributeSchema) > 0 {
+		for iNdEx := len(m.AttributeSchema) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttributeSchema[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.AllowedList) > 0 {
 		for iNdEx := len(m.AllowedList) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedList[iNdEx])
@@ -438,6 +1063,20 @@ func (m *Provider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.ApiUrl) > 0 {
 		i -= len(m.ApiUrl)
 		copy(dAtA[i:], m.ApiUrl)
@@ -506,6 +1145,39 @@ func (m *SKU) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Capacity != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Capacity))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.PriceTiers) > 0 {
+		for iNdEx := len(m.PriceTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.Active {
 		i--
 		if m.Active {
@@ -562,6 +1234,188 @@ func (m *SKU) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PriceTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MinQuantity != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinQuantity))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ChangedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ChangedAt):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTypes(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.PriceTiers) > 0 {
+		for iNdEx := len(m.PriceTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.BasePrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.SkuUuid) > 0 {
+		i -= len(m.SkuUuid)
+		copy(dAtA[i:], m.SkuUuid)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SkuUuid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingPriceChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingPriceChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingPriceChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScheduledBy) > 0 {
+		i -= len(m.ScheduledBy)
+		copy(dAtA[i:], m.ScheduledBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ScheduledBy)))
+		i--
+		dAtA[i] = 0x32
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ScheduledAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ScheduledAt):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTypes(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x2a
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EffectiveAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveAt):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	if len(m.PriceTiers) > 0 {
+		for iNdEx := len(m.PriceTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.BasePrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.SkuUuid) > 0 {
+		i -= len(m.SkuUuid)
+		copy(dAtA[i:], m.SkuUuid)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SkuUuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -573,6 +1427,39 @@ func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *AttributeDefinition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ValueType != 0 {
+		n += 1 + sovTypes(uint64(m.ValueType))
+	}
+	return n
+}
+
+func (m *Attribute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -585,6 +1472,12 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	if len(m.AttributeSchema) > 0 {
+		for _, e := range m.AttributeSchema {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -617,49 +1510,1075 @@ func (m *Provider) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	return n
-}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SKU) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uuid)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ProviderUuid)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Unit != 0 {
+		n += 1 + sovTypes(uint64(m.Unit))
+	}
+	l = m.BasePrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.MetaHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Active {
+		n += 2
+	}
+	if len(m.PriceTiers) > 0 {
+		for _, e := range m.PriceTiers {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Capacity != 0 {
+		n += 1 + sovTypes(uint64(m.Capacity))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PriceTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinQuantity != 0 {
+		n += 1 + sovTypes(uint64(m.MinQuantity))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *PriceHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.SkuUuid)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.BasePrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.PriceTiers) > 0 {
+		for _, e := range m.PriceTiers {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ChangedAt)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *PendingPriceChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SkuUuid)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.BasePrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.PriceTiers) > 0 {
+		for _, e := range m.PriceTiers {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveAt)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ScheduledAt)
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.ScheduledBy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AttributeDefinition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttributeDefinition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttributeDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
+			}
+			m.ValueType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValueType |= AttributeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Attribute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attribute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attribute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedList = append(m.AllowedList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttributeSchema = append(m.AttributeSchema, AttributeDefinition{})
+			if err := m.AttributeSchema[len(m.AttributeSchema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Provider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Provider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Provider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayoutAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetaHash = append(m.MetaHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.MetaHash == nil {
+				m.MetaHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SKU) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SKU: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SKU: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+			}
+			m.Unit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Unit |= Unit(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasePrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BasePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetaHash = append(m.MetaHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.MetaHash == nil {
+				m.MetaHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceTiers = append(m.PriceTiers, PriceTier{})
+			if err := m.PriceTiers[len(m.PriceTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capacity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *SKU) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Uuid)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.ProviderUuid)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Unit != 0 {
-		n += 1 + sovTypes(uint64(m.Unit))
-	}
-	l = m.BasePrice.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.MetaHash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Active {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *PriceTier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -682,17 +2601,36 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceTier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceTier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinQuantity", wireType)
+			}
+			m.MinQuantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinQuantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -702,23 +2640,24 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedList = append(m.AllowedList, string(dAtA[iNdEx:postIndex]))
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -741,7 +2680,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Provider) Unmarshal(dAtA []byte) error {
+func (m *PriceHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -764,17 +2703,17 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Provider: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Provider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -784,27 +2723,14 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Uuid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SkuUuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -832,13 +2758,13 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.SkuUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayoutAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasePrice", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -848,29 +2774,30 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PayoutAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.BasePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceTiers", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -880,51 +2807,31 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetaHash = append(m.MetaHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.MetaHash == nil {
-				m.MetaHash = []byte{}
+			m.PriceTiers = append(m.PriceTiers, PriceTier{})
+			if err := m.PriceTiers[len(m.PriceTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Active = bool(v != 0)
-		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -934,23 +2841,24 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApiUrl = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ChangedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -973,7 +2881,7 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SKU) Unmarshal(dAtA []byte) error {
+func (m *PendingPriceChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -996,15 +2904,15 @@ func (m *SKU) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SKU: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingPriceChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SKU: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingPriceChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SkuUuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1032,13 +2940,13 @@ func (m *SKU) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uuid = string(dAtA[iNdEx:postIndex])
+			m.SkuUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderUuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasePrice", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1048,29 +2956,30 @@ func (m *SKU) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderUuid = string(dAtA[iNdEx:postIndex])
+			if err := m.BasePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceTiers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1080,29 +2989,31 @@ func (m *SKU) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.PriceTiers = append(m.PriceTiers, PriceTier{})
+			if err := m.PriceTiers[len(m.PriceTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveAt", wireType)
 			}
-			m.Unit = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1112,14 +3023,28 @@ func (m *SKU) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Unit |= Unit(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EffectiveAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1146,15 +3071,15 @@ func (m *SKU) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BasePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ScheduledAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledBy", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1164,46 +3089,24 @@ func (m *SKU) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetaHash = append(m.MetaHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.MetaHash == nil {
-				m.MetaHash = []byte{}
-			}
+			m.ScheduledBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Active = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
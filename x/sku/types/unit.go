@@ -9,12 +9,34 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// SecondsPerMinute is the number of seconds in a minute.
+const SecondsPerMinute = 60
+
 // SecondsPerHour is the number of seconds in an hour.
 const SecondsPerHour = 3600
 
 // SecondsPerDay is the number of seconds in a day.
 const SecondsPerDay = 86400
 
+// SecondsPerWeek is the number of seconds in a week.
+const SecondsPerWeek = 7 * SecondsPerDay
+
+// MinSecondsPerMonth is the number of seconds in the shortest calendar month,
+// a 28-day February.
+const MinSecondsPerMonth = 28 * SecondsPerDay
+
+// IsMetered reports whether the unit bills per unit of reported usage rather
+// than over time.
+func (u Unit) IsMetered() bool {
+	return u == Unit_UNIT_PER_USAGE
+}
+
+// IsCalendarMonth reports whether the unit bills per calendar month, whose
+// length in seconds varies.
+func (u Unit) IsCalendarMonth() bool {
+	return u == Unit_UNIT_PER_MONTH
+}
+
 // MarshalJSON implements the json.Marshaler interface for Unit.
 func (u Unit) MarshalJSON() ([]byte, error) {
 	return json.Marshal(u.String())
@@ -24,76 +46,86 @@ func (u Unit) MarshalJSON() ([]byte, error) {
 type PriceValidationError struct {
 	BasePrice sdk.Coin
 	Unit      Unit
-	IsZero    bool // true if rate is zero, false if not evenly divisible
+	IsZero    bool // true if the price yields a zero per-second rate, false if not evenly divisible
 	Remainder math.Int
 }
 
 func (e *PriceValidationError) Error() string {
 	if e.IsZero {
-		return fmt.Sprintf("base price %s with unit %s results in zero per-second rate; increase price or change unit", e.BasePrice, e.Unit)
+		return fmt.Sprintf("base price %s with unit %s results in zero per-second rate; price must be positive", e.BasePrice, e.Unit)
 	}
-	return fmt.Sprintf("base price %s is not evenly divisible by %s (remainder: %s); price must be exactly divisible to avoid rounding errors", e.BasePrice, e.Unit, e.Remainder)
+	return fmt.Sprintf("price %s is not evenly divisible by %s (remainder: %s); price must be exactly divisible to keep a whole per-second rate", e.BasePrice, e.Unit, e.Remainder)
 }
 
 // divisorForUnit returns the number of seconds in the given unit's period.
+// UNIT_PER_MONTH uses its shortest month, which gives its highest rate.
 // Returns zero and false for invalid/unspecified units.
 func divisorForUnit(unit Unit) (math.Int, bool) {
 	switch unit {
+	case Unit_UNIT_PER_MINUTE:
+		return math.NewInt(SecondsPerMinute), true
 	case Unit_UNIT_PER_HOUR:
 		return math.NewInt(SecondsPerHour), true
 	case Unit_UNIT_PER_DAY:
 		return math.NewInt(SecondsPerDay), true
+	case Unit_UNIT_PER_WEEK:
+		return math.NewInt(SecondsPerWeek), true
+	case Unit_UNIT_PER_MONTH:
+		return math.NewInt(MinSecondsPerMonth), true
 	default:
 		return math.Int{}, false
 	}
 }
 
-// CalculatePricePerSecond converts a base price to a per-second rate based on the unit.
-// Returns the per-second rate and whether the conversion is valid (non-zero and exact).
-// The conversion is considered valid only if:
-// 1. The per-second rate is non-zero
-// 2. The division is exact (no remainder/truncation)
-func CalculatePricePerSecond(basePrice sdk.Coin, unit Unit) (math.Int, bool) {
+// CalculatePricePerSecond converts a base price to a decimal per-second rate
+// based on the unit. Returns the rate and whether the conversion is valid:
+// the unit must be time-based and the resulting rate must be positive.
+//
+// The rate is not truncated to whole base units, so a price such as
+// 10umfx per day yields 0.000115740740740741umfx per second rather than zero.
+// Billing accrues in decimal and carries the sub-unit remainder forward.
+//
+// A calendar month has no fixed length, so UNIT_PER_MONTH yields the rate of
+// a 28-day month: the most a monthly price can bill per second. Billing uses
+// it as an upper bound and accrues monthly items over each month's length.
+func CalculatePricePerSecond(basePrice sdk.Coin, unit Unit) (math.LegacyDec, bool) {
 	divisor, ok := divisorForUnit(unit)
 	if !ok {
-		return math.ZeroInt(), false
+		return math.LegacyZeroDec(), false
 	}
 
-	perSecond := basePrice.Amount.Quo(divisor)
-
-	// Check if per-second rate is zero (would result in free usage)
-	if perSecond.IsZero() {
-		return math.ZeroInt(), false
+	if basePrice.Amount.IsNil() || !basePrice.Amount.IsPositive() {
+		return math.LegacyZeroDec(), false
 	}
 
-	// Check if division is exact (no remainder)
-	remainder := basePrice.Amount.Mod(divisor)
-	if !remainder.IsZero() {
-		return math.ZeroInt(), false
+	perSecond := math.LegacyNewDecFromInt(basePrice.Amount).Quo(math.LegacyNewDecFromInt(divisor))
+	if !perSecond.IsPositive() {
+		return math.LegacyZeroDec(), false
 	}
 
 	return perSecond, true
 }
 
 // ValidatePriceAndUnit checks that the combination of base price and unit
-// produces a valid per-second rate. This prevents SKUs that would:
-// 1. Be effectively free due to integer division truncation (zero rate)
-// 2. Have rounding errors due to non-exact division
+// produces a valid per-second rate. Time-based prices need only be positive:
+// the per-second rate is kept in decimal, so prices that are not a multiple
+// of the seconds in the unit period are billed exactly over time.
 //
-// For exact billing, the base price must be evenly divisible by the number
-// of seconds in the unit period:
-// - UNIT_PER_HOUR: price must be divisible by 3600
-// - UNIT_PER_DAY: price must be divisible by 86400
+// UNIT_PER_USAGE prices are charged per reported unit of usage and have no
+// per-second rate, so any positive price is accepted.
 func ValidatePriceAndUnit(basePrice sdk.Coin, unit Unit) error {
-	divisor, ok := divisorForUnit(unit)
-	if !ok {
-		return fmt.Errorf("invalid unit: %s", unit)
+	if unit.IsMetered() {
+		if !basePrice.Amount.IsPositive() {
+			return fmt.Errorf("base price %s for unit %s must be positive", basePrice, unit)
+		}
+		return nil
 	}
 
-	perSecond := basePrice.Amount.Quo(divisor)
+	if _, ok := divisorForUnit(unit); !ok {
+		return fmt.Errorf("invalid unit: %s", unit)
+	}
 
-	// Check if per-second rate is zero (would result in free usage)
-	if perSecond.IsZero() {
+	if _, ok := CalculatePricePerSecond(basePrice, unit); !ok {
 		return &PriceValidationError{
 			BasePrice: basePrice,
 			Unit:      unit,
@@ -101,18 +133,39 @@ func ValidatePriceAndUnit(basePrice sdk.Coin, unit Unit) error {
 		}
 	}
 
-	// Check if division is exact (no remainder)
-	remainder := basePrice.Amount.Mod(divisor)
-	if !remainder.IsZero() {
-		return &PriceValidationError{
-			BasePrice: basePrice,
-			Unit:      unit,
-			IsZero:    false,
-			Remainder: remainder,
-		}
+	return nil
+}
+
+// HasIntegerRate reports whether price bills a whole number of base units per
+// second in unit: the unit has a fixed period and the price is evenly
+// divisible by its seconds. Calendar-month and metered prices never do.
+func HasIntegerRate(price sdk.Coin, unit Unit) bool {
+	if unit.IsCalendarMonth() {
+		return false
+	}
+	divisor, ok := divisorForUnit(unit)
+	if !ok || price.Amount.IsNil() || !price.Amount.IsPositive() {
+		return false
 	}
+	return price.Amount.Mod(divisor).IsZero()
+}
 
-	return nil
+// ValidateExactPrice checks that price is evenly divisible by the seconds in
+// the unit's period, so its per-second rate is a whole number of base units.
+func ValidateExactPrice(price sdk.Coin, unit Unit) error {
+	if HasIntegerRate(price, unit) {
+		return nil
+	}
+	divisor, ok := divisorForUnit(unit)
+	if !ok || unit.IsCalendarMonth() {
+		return fmt.Errorf("unit %s has no fixed per-second rate", unit)
+	}
+	return &PriceValidationError{
+		BasePrice: price,
+		Unit:      unit,
+		IsZero:    false,
+		Remainder: price.Amount.Mod(divisor),
+	}
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface for Unit.
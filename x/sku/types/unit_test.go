@@ -2,8 +2,8 @@
 Package types contains unit tests for SKU types validation.
 
 Test Coverage:
-- CalculatePricePerSecond: converts base price to per-second rate with exact divisibility
-- ValidatePriceAndUnit: validates price/unit combinations for exact division
+- CalculatePricePerSecond: converts base price to a decimal per-second rate
+- ValidatePriceAndUnit: validates price/unit combinations yield a positive rate
 - PriceValidationError: structured error type for validation failures
 - Unit JSON marshaling/unmarshaling
 */
@@ -28,7 +28,7 @@ func TestCalculatePricePerSecond(t *testing.T) {
 		name      string
 		basePrice sdk.Coin
 		unit      Unit
-		expected  math.Int
+		expected  math.LegacyDec
 		valid     bool
 	}{
 		// Valid cases: exact division
@@ -36,102 +36,104 @@ func TestCalculatePricePerSecond(t *testing.T) {
 			name:      "per hour: 3600 -> 1 per second (exact)",
 			basePrice: sdk.NewCoin(testDenom, math.NewInt(3600)),
 			unit:      Unit_UNIT_PER_HOUR,
-			expected:  math.NewInt(1),
+			expected:  math.LegacyNewDec(1),
 			valid:     true,
 		},
 		{
 			name:      "per hour: 7200 -> 2 per second (exact)",
 			basePrice: sdk.NewCoin(testDenom, math.NewInt(7200)),
 			unit:      Unit_UNIT_PER_HOUR,
-			expected:  math.NewInt(2),
+			expected:  math.LegacyNewDec(2),
 			valid:     true,
 		},
 		{
 			name:      "per day: 86400 -> 1 per second (exact)",
 			basePrice: sdk.NewCoin(testDenom, math.NewInt(86400)),
 			unit:      Unit_UNIT_PER_DAY,
-			expected:  math.NewInt(1),
+			expected:  math.LegacyNewDec(1),
 			valid:     true,
 		},
 		{
 			name:      "per day: 172800 -> 2 per second (exact)",
 			basePrice: sdk.NewCoin(testDenom, math.NewInt(172800)),
 			unit:      Unit_UNIT_PER_DAY,
-			expected:  math.NewInt(2),
+			expected:  math.LegacyNewDec(2),
 			valid:     true,
 		},
 		{
 			name:      "per hour: large amount 36000000 -> 10000 per second (exact)",
 			basePrice: sdk.NewCoin(testDenom, math.NewInt(36000000)),
 			unit:      Unit_UNIT_PER_HOUR,
-			expected:  math.NewInt(10000),
+			expected:  math.LegacyNewDec(10000),
 			valid:     true,
 		},
-
-		// Invalid cases: zero result (price too low)
-		{
-			name:      "per hour: 100 results in zero (invalid)",
-			basePrice: sdk.NewCoin(testDenom, math.NewInt(100)),
-			unit:      Unit_UNIT_PER_HOUR,
-			expected:  math.ZeroInt(),
-			valid:     false,
-		},
 		{
-			name:      "per day: 1000 results in zero (invalid)",
-			basePrice: sdk.NewCoin(testDenom, math.NewInt(1000)),
-			unit:      Unit_UNIT_PER_DAY,
-			expected:  math.ZeroInt(),
-			valid:     false,
+			name:      "per minute: 120 -> 2 per second (exact)",
+			basePrice: sdk.NewCoin(testDenom, math.NewInt(120)),
+			unit:      Unit_UNIT_PER_MINUTE,
+			expected:  math.LegacyNewDec(2),
+			valid:     true,
 		},
 		{
-			name:      "per hour: 3599 results in zero (just below minimum)",
-			basePrice: sdk.NewCoin(testDenom, math.NewInt(3599)),
-			unit:      Unit_UNIT_PER_HOUR,
-			expected:  math.ZeroInt(),
-			valid:     false,
+			name:      "per week: 604800 -> 1 per second (exact)",
+			basePrice: sdk.NewCoin(testDenom, math.NewInt(604800)),
+			unit:      Unit_UNIT_PER_WEEK,
+			expected:  math.LegacyNewDec(1),
+			valid:     true,
 		},
 		{
-			name:      "per day: 86399 results in zero (just below minimum)",
-			basePrice: sdk.NewCoin(testDenom, math.NewInt(86399)),
-			unit:      Unit_UNIT_PER_DAY,
-			expected:  math.ZeroInt(),
-			valid:     false,
+			name:      "per month: 2419200 -> 1 per second over a 28-day month",
+			basePrice: sdk.NewCoin(testDenom, math.NewInt(2419200)),
+			unit:      Unit_UNIT_PER_MONTH,
+			expected:  math.LegacyNewDec(1),
+			valid:     true,
 		},
 
-		// Invalid cases: not evenly divisible (remainder exists)
+		// Valid cases: fractional per-second rates
 		{
-			name:      "per hour: 3601 not evenly divisible (remainder 1)",
-			basePrice: sdk.NewCoin(testDenom, math.NewInt(3601)),
+			name:      "per hour: 1800 -> 0.5 per second",
+			basePrice: sdk.NewCoin(testDenom, math.NewInt(1800)),
 			unit:      Unit_UNIT_PER_HOUR,
-			expected:  math.ZeroInt(),
-			valid:     false,
+			expected:  math.LegacyMustNewDecFromStr("0.5"),
+			valid:     true,
 		},
 		{
-			name:      "per hour: 7201 not evenly divisible (remainder 1)",
-			basePrice: sdk.NewCoin(testDenom, math.NewInt(7201)),
+			name:      "per hour: 5400 -> 1.5 per second",
+			basePrice: sdk.NewCoin(testDenom, math.NewInt(5400)),
 			unit:      Unit_UNIT_PER_HOUR,
-			expected:  math.ZeroInt(),
-			valid:     false,
+			expected:  math.LegacyMustNewDecFromStr("1.5"),
+			valid:     true,
 		},
 		{
-			name:      "per day: 86401 not evenly divisible (remainder 1)",
-			basePrice: sdk.NewCoin(testDenom, math.NewInt(86401)),
+			name:      "per day: 10 -> fractional rate below one unit",
+			basePrice: sdk.NewCoin(testDenom, math.NewInt(10)),
 			unit:      Unit_UNIT_PER_DAY,
-			expected:  math.ZeroInt(),
-			valid:     false,
+			expected:  math.LegacyMustNewDecFromStr("0.000115740740740741"),
+			valid:     true,
 		},
 		{
-			name:      "per day: 100000 not evenly divisible (remainder 13600)",
-			basePrice: sdk.NewCoin(testDenom, math.NewInt(100000)),
-			unit:      Unit_UNIT_PER_DAY,
-			expected:  math.ZeroInt(),
-			valid:     false,
+			name:      "per hour: 3601 is no longer rejected",
+			basePrice: sdk.NewCoin(testDenom, math.NewInt(3601)),
+			unit:      Unit_UNIT_PER_HOUR,
+			expected:  math.LegacyMustNewDecFromStr("1.000277777777777778"),
+			valid:     true,
 		},
+
+		// Invalid cases: zero price
 		{
-			name:      "per hour: 5000 not evenly divisible (remainder 1400)",
-			basePrice: sdk.NewCoin(testDenom, math.NewInt(5000)),
+			name:      "per hour: zero price (invalid)",
+			basePrice: sdk.NewCoin(testDenom, math.ZeroInt()),
 			unit:      Unit_UNIT_PER_HOUR,
-			expected:  math.ZeroInt(),
+			expected:  math.LegacyZeroDec(),
+			valid:     false,
+		},
+
+		// Invalid cases: metered units have no per-second rate
+		{
+			name:      "per usage has no per-second rate (invalid)",
+			basePrice: sdk.NewCoin(testDenom, math.NewInt(3600)),
+			unit:      Unit_UNIT_PER_USAGE,
+			expected:  math.LegacyZeroDec(),
 			valid:     false,
 		},
 
@@ -140,7 +142,7 @@ func TestCalculatePricePerSecond(t *testing.T) {
 			name:      "unspecified unit (invalid)",
 			basePrice: sdk.NewCoin(testDenom, math.NewInt(3600)),
 			unit:      Unit_UNIT_UNSPECIFIED,
-			expected:  math.ZeroInt(),
+			expected:  math.LegacyZeroDec(),
 			valid:     false,
 		},
 	}
@@ -158,12 +160,11 @@ func TestValidatePriceAndUnit(t *testing.T) {
 	const testDenom = "upwr"
 
 	tests := []struct {
-		name         string
-		basePrice    sdk.Coin
-		unit         Unit
-		expectErr    bool
-		errIsZero    bool   // if error, is it a zero rate error?
-		errRemainder string // if error due to remainder, what's the expected remainder?
+		name      string
+		basePrice sdk.Coin
+		unit      Unit
+		expectErr bool
+		errIsZero bool // if error, is it a zero rate error?
 	}{
 		// Valid cases: exact division
 		{
@@ -173,86 +174,97 @@ func TestValidatePriceAndUnit(t *testing.T) {
 			expectErr: false,
 		},
 		{
-			name:      "valid: per hour with exact division (7200)",
-			basePrice: sdk.NewCoin(testDenom, math.NewInt(7200)),
+			name:      "valid: per day with exact division (86400)",
+			basePrice: sdk.NewCoin(testDenom, math.NewInt(86400)),
+			unit:      Unit_UNIT_PER_DAY,
+			expectErr: false,
+		},
+		{
+			name:      "valid: large per hour amount (36000000)",
+			basePrice: sdk.NewCoin(testDenom, math.NewInt(36000000)),
 			unit:      Unit_UNIT_PER_HOUR,
 			expectErr: false,
 		},
+
+		// Valid cases: prices that do not divide the unit period
 		{
-			name:      "valid: per day with exact division (86400)",
-			basePrice: sdk.NewCoin(testDenom, math.NewInt(86400)),
-			unit:      Unit_UNIT_PER_DAY,
+			name:      "valid: per hour 100 (sub-unit per-second rate)",
+			basePrice: sdk.NewCoin(testDenom, math.NewInt(100)),
+			unit:      Unit_UNIT_PER_HOUR,
 			expectErr: false,
 		},
 		{
-			name:      "valid: per day with exact division (172800)",
-			basePrice: sdk.NewCoin(testDenom, math.NewInt(172800)),
+			name:      "valid: per day 10 (sub-unit per-second rate)",
+			basePrice: sdk.NewCoin(testDenom, math.NewInt(10)),
 			unit:      Unit_UNIT_PER_DAY,
 			expectErr: false,
 		},
 		{
-			name:      "valid: large per hour amount (36000000)",
-			basePrice: sdk.NewCoin(testDenom, math.NewInt(36000000)),
+			name:      "valid: per hour 3601 not evenly divisible",
+			basePrice: sdk.NewCoin(testDenom, math.NewInt(3601)),
 			unit:      Unit_UNIT_PER_HOUR,
 			expectErr: false,
 		},
+		{
+			name:      "valid: per day 100000 not evenly divisible",
+			basePrice: sdk.NewCoin(testDenom, math.NewInt(100000)),
+			unit:      Unit_UNIT_PER_DAY,
+			expectErr: false,
+		},
 
-		// Invalid cases: zero result
 		{
-			name:      "invalid: per hour with too low price (zero result)",
-			basePrice: sdk.NewCoin(testDenom, math.NewInt(100)),
-			unit:      Unit_UNIT_PER_HOUR,
-			expectErr: true,
-			errIsZero: true,
+			name:      "valid: per minute 1 (sub-unit per-second rate)",
+			basePrice: sdk.NewCoin(testDenom, math.NewInt(1)),
+			unit:      Unit_UNIT_PER_MINUTE,
+			expectErr: false,
 		},
 		{
-			name:      "invalid: per day with too low price (zero result)",
+			name:      "valid: per week 1000",
 			basePrice: sdk.NewCoin(testDenom, math.NewInt(1000)),
-			unit:      Unit_UNIT_PER_DAY,
+			unit:      Unit_UNIT_PER_WEEK,
+			expectErr: false,
+		},
+		{
+			name:      "valid: per month 30000000",
+			basePrice: sdk.NewCoin(testDenom, math.NewInt(30000000)),
+			unit:      Unit_UNIT_PER_MONTH,
+			expectErr: false,
+		},
+		{
+			name:      "invalid: per month with zero price",
+			basePrice: sdk.NewCoin(testDenom, math.ZeroInt()),
+			unit:      Unit_UNIT_PER_MONTH,
 			expectErr: true,
 			errIsZero: true,
 		},
 
-		// Invalid cases: not evenly divisible
 		{
-			name:         "invalid: per hour 3601 not evenly divisible",
-			basePrice:    sdk.NewCoin(testDenom, math.NewInt(3601)),
-			unit:         Unit_UNIT_PER_HOUR,
-			expectErr:    true,
-			errIsZero:    false,
-			errRemainder: "1",
-		},
-		{
-			name:         "invalid: per hour 7201 not evenly divisible",
-			basePrice:    sdk.NewCoin(testDenom, math.NewInt(7201)),
-			unit:         Unit_UNIT_PER_HOUR,
-			expectErr:    true,
-			errIsZero:    false,
-			errRemainder: "1",
+			name:      "valid: per usage (7)",
+			basePrice: sdk.NewCoin(testDenom, math.NewInt(7)),
+			unit:      Unit_UNIT_PER_USAGE,
+			expectErr: false,
 		},
 		{
-			name:         "invalid: per day 86401 not evenly divisible",
-			basePrice:    sdk.NewCoin(testDenom, math.NewInt(86401)),
-			unit:         Unit_UNIT_PER_DAY,
-			expectErr:    true,
-			errIsZero:    false,
-			errRemainder: "1",
+			name:      "invalid: per usage with zero price",
+			basePrice: sdk.NewCoin(testDenom, math.ZeroInt()),
+			unit:      Unit_UNIT_PER_USAGE,
+			expectErr: true,
 		},
+
+		// Invalid cases: zero price
 		{
-			name:         "invalid: per hour 5000 not evenly divisible",
-			basePrice:    sdk.NewCoin(testDenom, math.NewInt(5000)),
-			unit:         Unit_UNIT_PER_HOUR,
-			expectErr:    true,
-			errIsZero:    false,
-			errRemainder: "1400",
+			name:      "invalid: per hour with zero price",
+			basePrice: sdk.NewCoin(testDenom, math.ZeroInt()),
+			unit:      Unit_UNIT_PER_HOUR,
+			expectErr: true,
+			errIsZero: true,
 		},
 		{
-			name:         "invalid: per day 100000 not evenly divisible",
-			basePrice:    sdk.NewCoin(testDenom, math.NewInt(100000)),
-			unit:         Unit_UNIT_PER_DAY,
-			expectErr:    true,
-			errIsZero:    false,
-			errRemainder: "13600",
+			name:      "invalid: per day with zero price",
+			basePrice: sdk.NewCoin(testDenom, math.ZeroInt()),
+			unit:      Unit_UNIT_PER_DAY,
+			expectErr: true,
+			errIsZero: true,
 		},
 
 		// Invalid cases: unspecified unit
@@ -274,9 +286,6 @@ func TestValidatePriceAndUnit(t *testing.T) {
 				var priceErr *PriceValidationError
 				if errors.As(err, &priceErr) {
 					require.Equal(t, tc.errIsZero, priceErr.IsZero, "error IsZero mismatch")
-					if !tc.errIsZero && tc.errRemainder != "" {
-						require.Equal(t, tc.errRemainder, priceErr.Remainder.String(), "remainder mismatch")
-					}
 				}
 			} else {
 				require.NoError(t, err)
@@ -290,26 +299,14 @@ func TestPriceValidationError(t *testing.T) {
 
 	t.Run("zero rate error message", func(t *testing.T) {
 		err := &PriceValidationError{
-			BasePrice: sdk.NewCoin(testDenom, math.NewInt(100)),
+			BasePrice: sdk.NewCoin(testDenom, math.ZeroInt()),
 			Unit:      Unit_UNIT_PER_HOUR,
 			IsZero:    true,
 		}
 		require.Contains(t, err.Error(), "zero per-second rate")
-		require.Contains(t, err.Error(), "100upwr")
+		require.Contains(t, err.Error(), "0upwr")
 		require.Contains(t, err.Error(), "UNIT_PER_HOUR")
 	})
-
-	t.Run("not evenly divisible error message", func(t *testing.T) {
-		err := &PriceValidationError{
-			BasePrice: sdk.NewCoin(testDenom, math.NewInt(3601)),
-			Unit:      Unit_UNIT_PER_HOUR,
-			IsZero:    false,
-			Remainder: math.NewInt(1),
-		}
-		require.Contains(t, err.Error(), "not evenly divisible")
-		require.Contains(t, err.Error(), "3601upwr")
-		require.Contains(t, err.Error(), "remainder: 1")
-	})
 }
 
 func TestUnitJSONMarshal(t *testing.T) {